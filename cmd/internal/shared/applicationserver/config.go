@@ -21,7 +21,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 )
@@ -40,6 +42,14 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 		PublicAddress:    fmt.Sprintf("%s:1883", shared.DefaultPublicHost),
 		PublicTLSAddress: fmt.Sprintf("%s:8883", shared.DefaultPublicHost),
 	},
+	MQTTShaping: mqtt.ShapingConfig{
+		ConnectionRate:   100,
+		ConnectionBurst:  100,
+		ApplicationRate:  1000,
+		ApplicationBurst: 1000,
+		QueueSize:        1024,
+		DropOldest:       true,
+	},
 	Webhooks: applicationserver.WebhooksConfig{
 		Templates: DefaultWebhookTemplatesConfig,
 		Target:    "direct",
@@ -83,6 +93,11 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 			"mqtt": "enabled",
 			"nats": "enabled",
 		},
+		Supervision: pubsub.SupervisionConfig{
+			HealthCheckInterval: 30 * time.Second,
+			MinBackoff:          time.Second,
+			MaxBackoff:          5 * time.Minute,
+		},
 	},
 	Packages: applicationserver.ApplicationPackagesConfig{
 		Config: packages.Config{
@@ -103,4 +118,21 @@ var DefaultApplicationServerConfig = applicationserver.Config{
 			MaxRetryAttempts:     32,
 		},
 	},
+	UplinkPipeline: applicationserver.UplinkPipelineConfig{
+		QueueSize:     100000,
+		NumConsumers:  16,
+		BatchSize:     64,
+		RetryInterval: time.Minute,
+	},
+	Coverage: applicationserver.CoverageConfig{
+		Precision: 7,
+		TTL:       30 * 24 * time.Hour,
+	},
+	Retention: applicationserver.RetentionConfig{
+		CleanupInterval: time.Hour,
+	},
+	DownlinkResults: applicationserver.DownlinkResultsConfig{
+		TTL:     24 * time.Hour,
+		MaxWait: time.Minute,
+	},
 }
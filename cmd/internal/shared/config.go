@@ -23,6 +23,8 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbroker"
 	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/circuitbreaker"
+	"go.thethings.network/lorawan-stack/v3/pkg/spiffe"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing"
 	"golang.org/x/crypto/acme"
@@ -37,6 +39,21 @@ var DefaultBaseConfig = config.Base{
 var DefaultLogConfig = config.Log{
 	Format: "console",
 	Level:  log.InfoLevel,
+	Sampling: config.LogSampling{
+		Interval:   time.Second,
+		Initial:    100,
+		Thereafter: 100,
+	},
+	Syslog: config.LogSyslog{
+		Network:  "udp",
+		AppName:  "ttn-lw-stack",
+		Facility: 16, // local0
+	},
+	Loki: config.LogLoki{
+		BatchSize:     100,
+		BatchInterval: time.Second,
+		Timeout:       10 * time.Second,
+	},
 }
 
 // DefaultTLSConfig is the default TLS config.
@@ -51,7 +68,21 @@ var DefaultTLSConfig = tlsconfig.Config{
 }
 
 // DefaultClusterConfig is the default cluster configuration.
-var DefaultClusterConfig = cluster.Config{}
+var DefaultClusterConfig = cluster.Config{
+	SPIFFE: spiffe.Config{
+		RefreshInterval: 30 * time.Second,
+	},
+	Calls: cluster.CallConfig{
+		Timeout: 30 * time.Second,
+		Retry: cluster.RetryConfig{
+			Timeout: 100 * time.Millisecond,
+		},
+		CircuitBreaker: circuitbreaker.Config{
+			FailureThreshold: 5,
+			OpenTimeout:      10 * time.Second,
+		},
+	},
+}
 
 // DefaultHTTPConfig is the default HTTP config.
 var DefaultHTTPConfig = config.HTTP{
@@ -71,6 +102,9 @@ var DefaultHTTPConfig = config.HTTP{
 	Health: config.Health{
 		Enable: true,
 	},
+	Status: config.Status{
+		Interval: 30 * time.Second,
+	},
 }
 
 // DefaultInteropServerConfig is the default interop server config.
@@ -102,6 +136,13 @@ var DefaultCacheConfig = config.Cache{
 	Redis: DefaultRedisConfig,
 }
 
+// DefaultRateLimitingConfig is the default rate limiting configuration.
+var DefaultRateLimitingConfig = config.RateLimiting{
+	Provider:          "memory",
+	Redis:             DefaultRedisConfig,
+	OverridesCacheTTL: time.Minute,
+}
+
 // DefaultEventsConfig is the default config for Events.
 var DefaultEventsConfig = func() config.Events {
 	c := config.Events{
@@ -136,6 +177,9 @@ var DefaultFrequencyPlansConfig = config.FrequencyPlansConfig{
 // DefaultRightsConfig is the default config to fetch rights from the Identity Server.
 var DefaultRightsConfig = config.Rights{
 	TTL: 2 * time.Minute,
+	Tokens: config.RightsTokens{
+		CacheTTL: 10 * time.Minute,
+	},
 }
 
 // DefaultKeyVaultConfig is the default config for key vaults.
@@ -184,6 +228,7 @@ var DefaultServiceBase = config.ServiceBase{
 	KeyVault:       DefaultKeyVaultConfig,
 	Tracing:        DefaultTracingConfig,
 	Telemetry:      DefaultTelemetryConfig,
+	RateLimiting:   DefaultRateLimitingConfig,
 }
 
 // DefaultPublicHost is the default public host where The Things Stack is served.
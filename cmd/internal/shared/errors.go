@@ -21,6 +21,7 @@ var (
 	ErrInitializeBaseComponent              = errors.Define("initialize_base_component", "could not initialize base component")
 	ErrInvalidLogFormat                     = errors.DefineInvalidArgument("log_format", "invalid log format `{format}`")
 	ErrInitializeLogger                     = errors.Define("initialize_logger", "could not initialize logger")
+	ErrInitializeLogSink                    = errors.Define("initialize_log_sink", "could not initialize `{sink}` log sink")
 	ErrInitializeIdentityServer             = errors.Define("initialize_identity_server", "could not initialize Identity Server")
 	ErrInitializeGatewayServer              = errors.Define("initialize_gateway_server", "could not initialize Gateway Server")
 	ErrInitializeNetworkServer              = errors.Define("initialize_network_server", "could not initialize Network Server")
@@ -33,4 +34,7 @@ var (
 	ErrInitializePacketBrokerAgent          = errors.Define("initialize_packet_broker_agent", "could not initialize Packet Broker Agent")
 	ErrInitializeDeviceRepository           = errors.Define("initialize_device_repository", "could not initialize Device Repository")
 	ErrInitializeDeviceClaimingServer       = errors.Define("initialize_device_claiming_server", "could not initialize Device Claiming Server")
+	ErrInitializeNOC                        = errors.Define("initialize_noc", "could not initialize Network Operations Center")
+	ErrInitializeMetering                   = errors.Define("initialize_metering", "could not initialize usage metering")
+	ErrInitializeEventBridge                = errors.Define("initialize_event_bridge", "could not initialize events bridge")
 )
@@ -0,0 +1,31 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package shared
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/eventbridge"
+)
+
+// DefaultEventBridgeConfig is the default configuration for the events bridge.
+var DefaultEventBridgeConfig = eventbridge.Config{
+	BatchSize:     100,
+	BatchInterval: time.Second,
+	QueueSize:     16,
+	Timeout:       10 * time.Second,
+	MaxRetries:    3,
+	RetryBackoff:  time.Second,
+}
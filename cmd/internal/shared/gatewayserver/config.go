@@ -45,6 +45,18 @@ var DefaultGatewayServerConfig = gatewayserver.Config{
 		UpdateGatewayJitter:   packetbroker.DefaultUpdateGatewayJitter,
 		OnlineTTLMargin:       packetbroker.DefaultOnlineTTLMargin,
 	},
+	UplinkDeduplication: gatewayserver.UplinkDeduplicationConfig{
+		DeduplicationWindow: 200 * time.Millisecond,
+		CooldownWindow:      time.Second,
+	},
+	NetworkTime: gatewayserver.NetworkTimeConfig{
+		TTL:        10 * time.Minute,
+		MinSamples: 3,
+	},
+	SpectralScan: gatewayserver.SpectralScanConfig{
+		HistorySize: 1440,
+		TTL:         24 * time.Hour,
+	},
 	UDP: gatewayserver.UDPConfig{
 		Config: udp.DefaultConfig,
 		Listeners: map[string]string{
@@ -48,6 +48,23 @@ var DefaultIdentityServerConfig = identityserver.Config{
 				ConsoleURL: "/console",
 			},
 		},
+		ExternalAccounts: oauth.ExternalAccountsConfig{
+			OIDC: oauth.OIDCProviderConfig{
+				Scopes: []string{"openid", "email", "profile"},
+				Claims: oauth.OIDCClaimsConfig{
+					Subject:       "sub",
+					Email:         "email",
+					EmailVerified: "email_verified",
+					Name:          "name",
+				},
+				Provisioning: oauth.OIDCProvisioningConfig{
+					RequireVerifiedEmail: true,
+				},
+			},
+		},
+		OpenIDConnect: oauth.OpenIDConnectConfig{
+			IDTokenTTL: time.Hour,
+		},
 	},
 }
 
@@ -76,5 +93,11 @@ func init() {
 	DefaultIdentityServerConfig.UserRights.CreateOrganizations = true
 	DefaultIdentityServerConfig.CollaboratorRights.SetOthersAsContacts = true
 	DefaultIdentityServerConfig.LoginTokens.TokenTTL = time.Hour
+	DefaultIdentityServerConfig.APIKeyTokens.TTL = 15 * time.Minute
+	DefaultIdentityServerConfig.EmailChange.TokenTTL = 24 * time.Hour
+	DefaultIdentityServerConfig.EmailChange.RevertWindow = 7 * 24 * time.Hour
 	DefaultIdentityServerConfig.Delete.Restore = 24 * time.Hour
+	DefaultIdentityServerConfig.EndDevices.Inactivity.CheckInterval = 5 * time.Minute
+	DefaultIdentityServerConfig.Notifications.Digest.Interval = 24 * time.Hour
+	DefaultIdentityServerConfig.Branding.CacheTTL = time.Minute
 }
@@ -19,6 +19,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/log/middleware/sink"
 )
 
 // InitializeFallbacks initializes configuration fallbacks.
@@ -31,6 +32,10 @@ func InitializeFallbacks(conf *config.ServiceBase) error {
 	if conf.Events.Redis.Config.IsZero() {
 		conf.Events.Redis.Config = conf.Redis
 	}
+	// Fallback to the default Redis configuration for the rate limiting store
+	if conf.RateLimiting.Redis.IsZero() {
+		conf.RateLimiting.Redis = conf.Redis
+	}
 	if !conf.Redis.Equals(DefaultRedisConfig) {
 		// Fallback to the default Redis configuration for the cache system
 		if conf.Cache.Redis.Equals(DefaultRedisConfig) {
@@ -40,6 +45,10 @@ func InitializeFallbacks(conf *config.ServiceBase) error {
 		if conf.Events.Redis.Config.Equals(DefaultRedisConfig) {
 			conf.Events.Redis.Config = conf.Redis
 		}
+		// Fallback to the default Redis configuration for the rate limiting store
+		if conf.RateLimiting.Redis.Equals(DefaultRedisConfig) {
+			conf.RateLimiting.Redis = conf.Redis
+		}
 	}
 	return nil
 }
@@ -60,8 +69,36 @@ func InitializeLogger(conf *config.Log) (log.Stack, error) {
 	default:
 		return nil, ErrInvalidLogFormat.WithAttributes("format", format)
 	}
-	return log.NewLogger(
+	namespaceLevels, err := conf.NamespaceLevels()
+	if err != nil {
+		return nil, ErrInitializeLogger.WithCause(err)
+	}
+	logger := log.NewLogger(
 		logHandler,
 		log.WithLevel(conf.Level),
-	), nil
+		log.WithNamespaceLevels(namespaceLevels),
+	)
+	if conf.Sampling.Enable {
+		logger.Use(log.NewSampler(conf.Sampling.Interval, conf.Sampling.Initial, conf.Sampling.Thereafter))
+	}
+	return logger, nil
+}
+
+// InitializeLogSinks initializes the configured log sinks and installs them on the logger.
+func InitializeLogSinks(c sink.Component, logger log.Stack, conf *config.Log) error {
+	if conf.Syslog.Enable {
+		syslog, err := sink.NewSyslog(c, conf.Syslog)
+		if err != nil {
+			return ErrInitializeLogSink.WithAttributes("sink", "syslog").WithCause(err)
+		}
+		logger.Use(syslog)
+	}
+	if conf.Loki.Enable {
+		loki, err := sink.NewLoki(c, conf.Loki)
+		if err != nil {
+			return ErrInitializeLogSink.WithAttributes("sink", "loki").WithCause(err)
+		}
+		logger.Use(loki)
+	}
+	return nil
 }
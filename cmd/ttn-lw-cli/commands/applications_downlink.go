@@ -15,12 +15,15 @@
 package commands
 
 import (
+	"context"
 	"os"
 
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
 	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/sync/errgroup"
 )
 
 var (
@@ -48,6 +51,25 @@ var (
 			if len(antennas) > 0 {
 				paths = append(paths, "class-b-c.gateways")
 			}
+			wait, _ := cmd.Flags().GetBool("wait")
+			var waiter *downlinkWaiter
+			ctx, cancel := context.WithCancel(ctx)
+			defer cancel()
+			g, gCtx := errgroup.WithContext(ctx)
+			if wait {
+				timeout, _ := cmd.Flags().GetDuration("wait-timeout")
+				ctx, cancel = context.WithTimeout(gCtx, timeout)
+				defer cancel()
+				waiter = &downlinkWaiter{
+					devID:         devID,
+					correlationID: newDownlinkWaitCorrelationID(),
+					confirmed:     downlink.Confirmed,
+				}
+				downlink.CorrelationIds = append(downlink.CorrelationIds, waiter.correlationID)
+				if err := waiter.start(ctx, g); err != nil {
+					return err
+				}
+			}
 			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
 			if err != nil {
 				return err
@@ -59,8 +81,17 @@ var (
 			if err != nil {
 				return err
 			}
+			if waiter == nil {
+				return nil
+			}
 
-			return nil
+			logger.WithField("correlation_id", waiter.correlationID).Info("Downlink pushed, waiting for transmission")
+			err = waiter.wait(ctx)
+			cancel()
+			if gErr := g.Wait(); gErr != nil && !errors.IsCanceled(gErr) && (err == nil || errors.IsCanceled(err)) {
+				return gErr
+			}
+			return err
 		},
 	}
 	applicationsDownlinkReplaceCommand = &cobra.Command{
@@ -149,6 +180,7 @@ func init() {
 	ttnpb.AddSetFlagsForApplicationDownlink(applicationsDownlinkPushCommand.Flags(), "", false)
 	AddGatewayAntennaIdentifierFlags(applicationsDownlinkPushCommand.Flags(), "class-b-c")
 	applicationsDownlinkPushCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	applicationsDownlinkPushCommand.Flags().AddFlagSet(downlinkWaitFlags())
 	applicationsDownlinkCommand.AddCommand(applicationsDownlinkPushCommand)
 	ttnpb.AddSetFlagsForApplicationDownlink(applicationsDownlinkReplaceCommand.Flags(), "", false)
 	AddGatewayAntennaIdentifierFlags(applicationsDownlinkReplaceCommand.Flags(), "class-b-c")
@@ -0,0 +1,244 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+)
+
+var (
+	errDownlinkWaitTimeout = errors.DefineDeadlineExceeded("downlink_wait_timeout", "timed out waiting for downlink `{correlation_id}`")
+	errDownlinkNotSent     = errors.DefineAborted("downlink_not_sent", "downlink `{correlation_id}` was not sent")
+	errDownlinkNacked      = errors.DefineAborted("downlink_nacked", "downlink `{correlation_id}` was not acknowledged by the end device")
+)
+
+// downlinkWaitEventNames are the Network Server events that describe the scheduling of a downlink.
+var downlinkWaitEventNames = []string{
+	"ns.down.data.schedule.attempt",
+	"ns.down.data.schedule.success",
+	"ns.down.data.schedule.fail",
+	"ns.down.transmission.success",
+	"ns.down.transmission.fail",
+}
+
+func downlinkWaitFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.Bool("wait", false, "wait until the downlink is transmitted or acknowledged")
+	flagSet.Duration("wait-timeout", 5*time.Minute, "maximum duration to wait for the downlink")
+	return flagSet
+}
+
+// newDownlinkWaitCorrelationID returns a correlation ID that is used to track a downlink pushed by the CLI.
+func newDownlinkWaitCorrelationID() string {
+	return fmt.Sprintf("cli:downlink:%s", events.NewCorrelationID())
+}
+
+func hasCorrelationID(cids []string, cid string) bool {
+	for _, c := range cids {
+		if c == cid {
+			return true
+		}
+	}
+	return false
+}
+
+// downlinkWaiter follows the lifecycle of a downlink with a given correlation ID.
+type downlinkWaiter struct {
+	devID         *ttnpb.EndDeviceIdentifiers
+	correlationID string
+	confirmed     bool
+
+	events chan *ttnpb.Event
+	ups    chan *ttnpb.ApplicationUp
+}
+
+// start subscribes to the event and application uplink streams.
+// It must be called before the downlink is pushed, in order to not miss any messages.
+func (w *downlinkWaiter) start(ctx context.Context, g *errgroup.Group) error {
+	w.events = make(chan *ttnpb.Event)
+	w.ups = make(chan *ttnpb.ApplicationUp)
+
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	upStream, err := ttnpb.NewAppAsClient(as).Subscribe(ctx, w.devID.ApplicationIds)
+	if err != nil {
+		return err
+	}
+	ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	evtStream, err := ttnpb.NewEventsClient(ns).Stream(ctx, &ttnpb.StreamEventsRequest{
+		Identifiers: []*ttnpb.EntityIdentifiers{w.devID.GetEntityIdentifiers()},
+		Names:       downlinkWaitEventNames,
+	})
+	if err != nil {
+		return err
+	}
+
+	g.Go(func() error {
+		for {
+			up, err := upStream.Recv()
+			if err != nil {
+				return err
+			}
+			if up.GetEndDeviceIds().GetDeviceId() != w.devID.GetDeviceId() {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case w.ups <- up:
+			}
+		}
+	})
+	g.Go(func() error {
+		for {
+			evt, err := evtStream.Recv()
+			if err != nil {
+				return err
+			}
+			if !hasCorrelationID(evt.GetCorrelationIds(), w.correlationID) {
+				continue
+			}
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case w.events <- evt:
+			}
+		}
+	})
+	return nil
+}
+
+func (w *downlinkWaiter) logSchedule(evt *ttnpb.Event) {
+	var res ttnpb.ScheduleDownlinkResponse
+	if err := evt.GetData().UnmarshalTo(&res); err != nil {
+		logger.WithError(err).Warn("Failed to decode schedule response")
+		return
+	}
+	logFields := []any{
+		"delay", res.GetDelay().AsDuration(),
+	}
+	switch {
+	case res.Rx1 && res.Rx2:
+		logFields = append(logFields, "rx_window", "RX1+RX2")
+	case res.Rx1:
+		logFields = append(logFields, "rx_window", "RX1")
+	case res.Rx2:
+		logFields = append(logFields, "rx_window", "RX2")
+	}
+	switch path := res.GetDownlinkPath().GetPath().(type) {
+	case *ttnpb.DownlinkPath_Fixed:
+		logFields = append(logFields,
+			"gateway_id", path.Fixed.GetGatewayIds().GetGatewayId(),
+			"antenna_index", path.Fixed.GetAntennaIndex(),
+		)
+	case *ttnpb.DownlinkPath_UplinkToken:
+		var token ttnpb.UplinkToken
+		if err := proto.Unmarshal(path.UplinkToken, &token); err == nil {
+			logFields = append(logFields,
+				"gateway_id", token.GetIds().GetGatewayIds().GetGatewayId(),
+				"antenna_index", token.GetIds().GetAntennaIndex(),
+			)
+		}
+	}
+	logger.WithFields(log.Fields(logFields...)).Info("Downlink scheduled")
+}
+
+func (w *downlinkWaiter) matchesDownlink(down *ttnpb.ApplicationDownlink) bool {
+	return hasCorrelationID(down.GetCorrelationIds(), w.correlationID)
+}
+
+// wait blocks until the downlink reached a final state.
+// Unconfirmed downlinks are final once they are sent, confirmed downlinks once they are acknowledged.
+func (w *downlinkWaiter) wait(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return errDownlinkWaitTimeout.WithAttributes("correlation_id", w.correlationID)
+			}
+			return ctx.Err()
+
+		case evt := <-w.events:
+			if err := io.Write(os.Stdout, config.OutputFormat, evt); err != nil {
+				return err
+			}
+			switch evt.GetName() {
+			case "ns.down.data.schedule.success":
+				w.logSchedule(evt)
+			case "ns.down.data.schedule.fail":
+				logger.Warn("Downlink scheduling attempt failed")
+			case "ns.down.transmission.fail":
+				logger.Warn("Downlink transmission failed")
+			}
+
+		case up := <-w.ups:
+			switch p := up.GetUp().(type) {
+			case *ttnpb.ApplicationUp_DownlinkQueued:
+				if !w.matchesDownlink(p.DownlinkQueued) {
+					continue
+				}
+				logger.WithField("f_cnt", p.DownlinkQueued.GetFCnt()).Info("Downlink queued")
+			case *ttnpb.ApplicationUp_DownlinkSent:
+				if !w.matchesDownlink(p.DownlinkSent) {
+					continue
+				}
+				logger.WithField("f_cnt", p.DownlinkSent.GetFCnt()).Info("Downlink sent")
+				if !w.confirmed {
+					return io.Write(os.Stdout, config.OutputFormat, up)
+				}
+			case *ttnpb.ApplicationUp_DownlinkAck:
+				if !w.matchesDownlink(p.DownlinkAck) {
+					continue
+				}
+				logger.WithField("f_cnt", p.DownlinkAck.GetFCnt()).Info("Downlink acknowledged")
+				return io.Write(os.Stdout, config.OutputFormat, up)
+			case *ttnpb.ApplicationUp_DownlinkNack:
+				if !w.matchesDownlink(p.DownlinkNack) {
+					continue
+				}
+				if err := io.Write(os.Stdout, config.OutputFormat, up); err != nil {
+					return err
+				}
+				return errDownlinkNacked.WithAttributes("correlation_id", w.correlationID)
+			case *ttnpb.ApplicationUp_DownlinkFailed:
+				if !w.matchesDownlink(p.DownlinkFailed.GetDownlink()) {
+					continue
+				}
+				if err := io.Write(os.Stdout, config.OutputFormat, up); err != nil {
+					return err
+				}
+				return errDownlinkNotSent.WithAttributes("correlation_id", w.correlationID)
+			}
+		}
+	}
+}
@@ -0,0 +1,459 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var (
+	errNoManifest      = errors.DefineInvalidArgument("no_manifest", "no manifest file set")
+	errAmbiguousAPIKey = errors.DefineFailedPrecondition(
+		"ambiguous_api_key", "multiple API keys with name `{name}` on `{entity_id}`",
+	)
+)
+
+// apiKeysPageLimit is the number of API keys that are listed per page when looking up API keys by name.
+const apiKeysPageLimit = 100
+
+// applier applies a manifest to the live state.
+type applier struct {
+	dryRun       bool
+	collaborator *ttnpb.OrganizationOrUserIdentifiers
+	is           *grpc.ClientConn
+}
+
+func (a *applier) log(action, kind, id string, paths []string) {
+	logger := logger.WithFields(log.Fields(
+		"kind", kind,
+		"id", id,
+	))
+	if len(paths) > 0 {
+		logger = logger.WithField("paths", paths)
+	}
+	if a.dryRun {
+		logger.Infof("Would %s %s", action, kind)
+		return
+	}
+	logger.Infof("%s %s", action, kind)
+}
+
+func (a *applier) applyApplication(desired manifestEntity[*ttnpb.Application]) error {
+	ids := desired.Entity.GetIds()
+	if ids == nil {
+		return errNoApplicationID.New()
+	}
+	client := ttnpb.NewApplicationRegistryClient(a.is)
+	live, err := client.Get(ctx, &ttnpb.GetApplicationRequest{
+		ApplicationIds: ids,
+		FieldMask:      ttnpb.FieldMask(desired.Paths...),
+	})
+	if errors.IsNotFound(err) {
+		a.log("create", "application", ids.GetApplicationId(), desired.Paths)
+		if a.dryRun {
+			return nil
+		}
+		if a.collaborator == nil {
+			return errNoCollaborator.New()
+		}
+		_, err := client.Create(ctx, &ttnpb.CreateApplicationRequest{
+			Application:  desired.Entity,
+			Collaborator: a.collaborator,
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+	paths, err := changedPaths(desired.Entity, live, func() *ttnpb.Application { return &ttnpb.Application{} }, desired.Paths)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		logger.WithField("id", ids.GetApplicationId()).Debug("Application unchanged")
+		return nil
+	}
+	a.log("update", "application", ids.GetApplicationId(), paths)
+	if a.dryRun {
+		return nil
+	}
+	_, err = client.Update(ctx, &ttnpb.UpdateApplicationRequest{
+		Application: desired.Entity,
+		FieldMask:   ttnpb.FieldMask(paths...),
+	})
+	return err
+}
+
+func (a *applier) applyGateway(desired manifestEntity[*ttnpb.Gateway]) error {
+	ids := desired.Entity.GetIds()
+	if ids == nil {
+		return errNoGatewayID.New()
+	}
+	client := ttnpb.NewGatewayRegistryClient(a.is)
+	live, err := client.Get(ctx, &ttnpb.GetGatewayRequest{
+		GatewayIds: ids,
+		FieldMask:  ttnpb.FieldMask(desired.Paths...),
+	})
+	if errors.IsNotFound(err) {
+		a.log("create", "gateway", ids.GetGatewayId(), desired.Paths)
+		if a.dryRun {
+			return nil
+		}
+		if a.collaborator == nil {
+			return errNoCollaborator.New()
+		}
+		_, err := client.Create(ctx, &ttnpb.CreateGatewayRequest{
+			Gateway:      desired.Entity,
+			Collaborator: a.collaborator,
+		})
+		return err
+	} else if err != nil {
+		return err
+	}
+	paths, err := changedPaths(desired.Entity, live, func() *ttnpb.Gateway { return &ttnpb.Gateway{} }, desired.Paths)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		logger.WithField("id", ids.GetGatewayId()).Debug("Gateway unchanged")
+		return nil
+	}
+	a.log("update", "gateway", ids.GetGatewayId(), paths)
+	if a.dryRun {
+		return nil
+	}
+	_, err = client.Update(ctx, &ttnpb.UpdateGatewayRequest{
+		Gateway:   desired.Entity,
+		FieldMask: ttnpb.FieldMask(paths...),
+	})
+	return err
+}
+
+func (a *applier) applyEndDevice(desired manifestEntity[*ttnpb.EndDevice]) error {
+	device := desired.Entity
+	ids := device.GetIds()
+	if ids.GetApplicationIds().GetApplicationId() == "" {
+		return errNoApplicationID.New()
+	}
+	if ids.GetDeviceId() == "" {
+		return errNoEndDeviceID.New()
+	}
+	uid := ids.GetApplicationIds().GetApplicationId() + "." + ids.GetDeviceId()
+	isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceGetPaths(desired.Paths...)
+	isLive, err := ttnpb.NewEndDeviceRegistryClient(a.is).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    ttnpb.FieldMask(isPaths...),
+	})
+	if errors.IsNotFound(err) {
+		a.log("create", "end device", uid, desired.Paths)
+		if a.dryRun {
+			return nil
+		}
+		isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(device.SupportsJoin, desired.Paths...)
+		if len(jsPaths) > 0 && (ids.JoinEui == nil || ids.DevEui == nil) {
+			return errNoEndDeviceEUI.New()
+		}
+		isDevice := &ttnpb.EndDevice{}
+		if err := isDevice.SetFields(device, append(isPaths, "ids")...); err != nil {
+			return err
+		}
+		isRes, err := ttnpb.NewEndDeviceRegistryClient(a.is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+			EndDevice: isDevice,
+		})
+		if err != nil {
+			return err
+		}
+		if err := device.SetFields(isRes, append(isPaths, "created_at", "updated_at")...); err != nil {
+			return err
+		}
+		if _, err := setEndDevice(device, nil, nsPaths, asPaths, jsPaths, nil, true, false); err != nil {
+			logger.WithError(err).Error("Could not create end device, rolling back...")
+			if err := deleteEndDevice(ctx, ids, false); err != nil {
+				logger.WithError(err).Error("Could not roll back end device creation")
+			}
+			return err
+		}
+		return nil
+	} else if err != nil {
+		return err
+	}
+	live, err := getEndDevice(ids, nsPaths, asPaths, jsPaths, false)
+	if err != nil {
+		return err
+	}
+	if err := live.SetFields(isLive, isPaths...); err != nil {
+		return err
+	}
+	paths, err := changedPaths(device, live, func() *ttnpb.EndDevice { return &ttnpb.EndDevice{} }, desired.Paths)
+	if err != nil {
+		return err
+	}
+	if len(paths) == 0 {
+		logger.WithField("id", uid).Debug("End device unchanged")
+		return nil
+	}
+	a.log("update", "end device", uid, paths)
+	if a.dryRun {
+		return nil
+	}
+	isPaths, nsPaths, asPaths, jsPaths = splitEndDeviceSetPaths(device.SupportsJoin || isLive.SupportsJoin, paths...)
+	_, err = setEndDevice(device, isPaths, nsPaths, asPaths, jsPaths, nil, false, false)
+	return err
+}
+
+func (a *applier) applyWebhook(desired manifestEntity[*ttnpb.ApplicationWebhook]) error {
+	ids := desired.Entity.GetIds()
+	if ids.GetApplicationIds().GetApplicationId() == "" {
+		return errNoApplicationID.New()
+	}
+	if ids.GetWebhookId() == "" {
+		return errNoWebhookID.New()
+	}
+	uid := ids.GetApplicationIds().GetApplicationId() + "." + ids.GetWebhookId()
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return err
+	}
+	client := ttnpb.NewApplicationWebhookRegistryClient(as)
+	live, err := client.Get(ctx, &ttnpb.GetApplicationWebhookRequest{
+		Ids:       ids,
+		FieldMask: ttnpb.FieldMask(desired.Paths...),
+	})
+	var paths []string
+	switch {
+	case errors.IsNotFound(err):
+		a.log("create", "webhook", uid, desired.Paths)
+		paths = desired.Paths
+	case err != nil:
+		return err
+	default:
+		paths, err = changedPaths(desired.Entity, live, func() *ttnpb.ApplicationWebhook { return &ttnpb.ApplicationWebhook{} }, desired.Paths)
+		if err != nil {
+			return err
+		}
+		if len(paths) == 0 {
+			logger.WithField("id", uid).Debug("Webhook unchanged")
+			return nil
+		}
+		a.log("update", "webhook", uid, paths)
+	}
+	if a.dryRun {
+		return nil
+	}
+	_, err = client.Set(ctx, &ttnpb.SetApplicationWebhookRequest{
+		Webhook:   desired.Entity,
+		FieldMask: ttnpb.FieldMask(paths...),
+	})
+	return err
+}
+
+func sameRights(a, b []ttnpb.Right) bool {
+	as, bs := ttnpb.RightsFrom(a...), ttnpb.RightsFrom(b...)
+	return len(as.Sub(bs).GetRights()) == 0 && len(bs.Sub(as).GetRights()) == 0
+}
+
+func (a *applier) applyAPIKey(desired manifestAPIKey) error {
+	rights, err := desired.rights()
+	if err != nil {
+		return err
+	}
+	var (
+		entityID string
+		list     func(page uint32) ([]*ttnpb.APIKey, error)
+		create   func() (*ttnpb.APIKey, error)
+		update   func(id string) error
+	)
+	switch {
+	case desired.ApplicationID != "":
+		ids := &ttnpb.ApplicationIdentifiers{ApplicationId: desired.ApplicationID}
+		client := ttnpb.NewApplicationAccessClient(a.is)
+		entityID = desired.ApplicationID
+		list = func(page uint32) ([]*ttnpb.APIKey, error) {
+			res, err := client.ListAPIKeys(ctx, &ttnpb.ListApplicationAPIKeysRequest{
+				ApplicationIds: ids, Limit: apiKeysPageLimit, Page: page,
+			})
+			return res.GetApiKeys(), err
+		}
+		create = func() (*ttnpb.APIKey, error) {
+			return client.CreateAPIKey(ctx, &ttnpb.CreateApplicationAPIKeyRequest{ApplicationIds: ids, Name: desired.Name, Rights: rights})
+		}
+		update = func(id string) error {
+			_, err := client.UpdateAPIKey(ctx, &ttnpb.UpdateApplicationAPIKeyRequest{
+				ApplicationIds: ids,
+				ApiKey:         &ttnpb.APIKey{Id: id, Name: desired.Name, Rights: rights},
+				FieldMask:      ttnpb.FieldMask("rights"),
+			})
+			return err
+		}
+	default:
+		ids := &ttnpb.GatewayIdentifiers{GatewayId: desired.GatewayID}
+		client := ttnpb.NewGatewayAccessClient(a.is)
+		entityID = desired.GatewayID
+		list = func(page uint32) ([]*ttnpb.APIKey, error) {
+			res, err := client.ListAPIKeys(ctx, &ttnpb.ListGatewayAPIKeysRequest{
+				GatewayIds: ids, Limit: apiKeysPageLimit, Page: page,
+			})
+			return res.GetApiKeys(), err
+		}
+		create = func() (*ttnpb.APIKey, error) {
+			return client.CreateAPIKey(ctx, &ttnpb.CreateGatewayAPIKeyRequest{GatewayIds: ids, Name: desired.Name, Rights: rights})
+		}
+		update = func(id string) error {
+			_, err := client.UpdateAPIKey(ctx, &ttnpb.UpdateGatewayAPIKeyRequest{
+				GatewayIds: ids,
+				ApiKey:     &ttnpb.APIKey{Id: id, Name: desired.Name, Rights: rights},
+				FieldMask:  ttnpb.FieldMask("rights"),
+			})
+			return err
+		}
+	}
+	uid := entityID + "/" + desired.Name
+	var existing *ttnpb.APIKey
+	for page := uint32(1); ; page++ {
+		keys, err := list(page)
+		if err != nil {
+			return err
+		}
+		for _, key := range keys {
+			if key.GetName() != desired.Name {
+				continue
+			}
+			if existing != nil {
+				return errAmbiguousAPIKey.WithAttributes("name", desired.Name, "entity_id", entityID)
+			}
+			existing = key
+		}
+		if len(keys) < apiKeysPageLimit {
+			break
+		}
+	}
+	if existing != nil {
+		if sameRights(existing.GetRights(), rights) {
+			logger.WithField("id", uid).Debug("API key unchanged")
+			return nil
+		}
+		a.log("update", "API key", uid, []string{"rights"})
+		if a.dryRun {
+			return nil
+		}
+		return update(existing.GetId())
+	}
+	a.log("create", "API key", uid, nil)
+	if a.dryRun {
+		return nil
+	}
+	key, err := create()
+	if err != nil {
+		return err
+	}
+	logger.Infof("API key ID: %s", key.GetId())
+	logger.Infof("API key value: %s", key.GetKey())
+	logger.Warn("The API key value will never be shown again")
+	logger.Warn("Make sure to copy it to a safe place")
+	return io.Write(os.Stdout, config.OutputFormat, key)
+}
+
+func (a *applier) apply(m *manifest) error {
+	// Entities are applied in order of dependency: applications and gateways first,
+	// then the entities that belong to them.
+	for _, app := range m.Applications {
+		if err := a.applyApplication(app); err != nil {
+			return err
+		}
+	}
+	for _, gtw := range m.Gateways {
+		if err := a.applyGateway(gtw); err != nil {
+			return err
+		}
+	}
+	for _, dev := range m.EndDevices {
+		if err := a.applyEndDevice(dev); err != nil {
+			return err
+		}
+	}
+	for _, webhook := range m.Webhooks {
+		if err := a.applyWebhook(webhook); err != nil {
+			return err
+		}
+	}
+	for _, key := range m.APIKeys {
+		if err := a.applyAPIKey(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func applyFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.StringSliceP("filename", "f", nil, "manifest files to apply")
+	flagSet.Bool("dry-run", false, "only show the changes that would be applied")
+	flagSet.AddFlagSet(collaboratorFlags())
+	return flagSet
+}
+
+var applyCommand = &cobra.Command{
+	Use:   "apply",
+	Short: "Apply declarative manifests of applications, gateways, end devices, webhooks and API keys",
+	Long: `Apply declarative manifests of applications, gateways, end devices, webhooks and API keys.
+
+Manifests are YAML files with the lists applications, gateways, end_devices,
+webhooks and api_keys. Entities use the same fields as the API. Only the fields
+that are declared in the manifest are compared with the live state, and only the
+fields that differ are updated. Entities that do not exist yet are created, with
+the collaborator given by --user-id or --organization-id.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filenames, _ := cmd.Flags().GetStringSlice("filename")
+		if len(filenames) == 0 {
+			return errNoManifest.New()
+		}
+		var m manifest
+		for _, filename := range filenames {
+			f, err := os.Open(filename)
+			if err != nil {
+				return err
+			}
+			err = decodeManifest(f, &m)
+			f.Close()
+			if err != nil {
+				return err
+			}
+		}
+		is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+		if err != nil {
+			return err
+		}
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		a := &applier{
+			dryRun:       dryRun,
+			collaborator: getCollaborator(cmd.Flags()),
+			is:           is,
+		}
+		return a.apply(&m)
+	},
+}
+
+func init() {
+	applyCommand.Flags().AddFlagSet(applyFlags())
+	Root.AddCommand(applyCommand)
+}
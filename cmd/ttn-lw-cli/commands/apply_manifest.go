@@ -0,0 +1,204 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	stdio "io"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errManifestDecode       = errors.DefineInvalidArgument("manifest_decode", "decode manifest")
+	errManifestEntity       = errors.DefineInvalidArgument("manifest_entity", "invalid {kind} at index {index}")
+	errManifestAPIKey       = errors.DefineInvalidArgument("manifest_api_key", "API key `{name}` must have exactly one of `application_id` or `gateway_id`")
+	errManifestUnknownRight = errors.DefineInvalidArgument("manifest_unknown_right", "unknown right `{right}`")
+)
+
+// manifestAPIKey is an API key in a manifest.
+// API keys are identified by their name within the application or gateway.
+type manifestAPIKey struct {
+	ApplicationID string   `yaml:"application_id"`
+	GatewayID     string   `yaml:"gateway_id"`
+	Name          string   `yaml:"name"`
+	Rights        []string `yaml:"rights"`
+}
+
+func (k manifestAPIKey) rights() ([]ttnpb.Right, error) {
+	rights := make([]ttnpb.Right, 0, len(k.Rights))
+	for _, name := range k.Rights {
+		name = strings.ToUpper(name)
+		if !strings.HasPrefix(name, "RIGHT_") {
+			name = "RIGHT_" + name
+		}
+		v, ok := ttnpb.Right_value[name]
+		if !ok {
+			return nil, errManifestUnknownRight.WithAttributes("right", name)
+		}
+		rights = append(rights, ttnpb.Right(v))
+	}
+	return rights, nil
+}
+
+// manifestDocument is a single YAML document of a manifest.
+// Entities are in the JSON format of the API.
+type manifestDocument struct {
+	Applications []any            `yaml:"applications"`
+	Gateways     []any            `yaml:"gateways"`
+	EndDevices   []any            `yaml:"end_devices"`
+	Webhooks     []any            `yaml:"webhooks"`
+	APIKeys      []manifestAPIKey `yaml:"api_keys"`
+}
+
+// manifestEntity is an entity in a manifest with the field paths that are declared.
+type manifestEntity[T proto.Message] struct {
+	Entity T
+	Paths  []string
+}
+
+// manifest is the desired state declared in one or multiple manifest files.
+type manifest struct {
+	Applications []manifestEntity[*ttnpb.Application]
+	Gateways     []manifestEntity[*ttnpb.Gateway]
+	EndDevices   []manifestEntity[*ttnpb.EndDevice]
+	Webhooks     []manifestEntity[*ttnpb.ApplicationWebhook]
+	APIKeys      []manifestAPIKey
+}
+
+// normalizeYAML converts the maps decoded by YAML to maps that can be encoded to JSON.
+func normalizeYAML(v any) any {
+	switch v := v.(type) {
+	case map[any]any:
+		m := make(map[string]any, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = normalizeYAML(val)
+		}
+		return m
+	case []any:
+		for i, val := range v {
+			v[i] = normalizeYAML(val)
+		}
+		return v
+	default:
+		return v
+	}
+}
+
+func decodeManifestEntities[T proto.Message](kind string, items []any, newFn func() T) ([]manifestEntity[T], error) {
+	res := make([]manifestEntity[T], 0, len(items))
+	for i, item := range items {
+		m, ok := normalizeYAML(item).(map[string]any)
+		if !ok {
+			return nil, errManifestEntity.WithAttributes("kind", kind, "index", i)
+		}
+		b, err := json.Marshal(m)
+		if err != nil {
+			return nil, errManifestEntity.WithAttributes("kind", kind, "index", i).WithCause(err)
+		}
+		entity := newFn()
+		if err := jsonpb.TTN().Unmarshal(b, entity); err != nil {
+			return nil, errManifestEntity.WithAttributes("kind", kind, "index", i).WithCause(err)
+		}
+		paths := make([]string, 0, len(m))
+		for path := range m {
+			if path == "ids" || path == "created_at" || path == "updated_at" {
+				continue
+			}
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		res = append(res, manifestEntity[T]{Entity: entity, Paths: paths})
+	}
+	return res, nil
+}
+
+// decodeManifest decodes the (multi-document) YAML manifest from r and appends it to m.
+func decodeManifest(r stdio.Reader, m *manifest) error {
+	b, err := stdio.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	for {
+		var doc manifestDocument
+		if err := dec.Decode(&doc); err != nil {
+			if err == stdio.EOF {
+				return nil
+			}
+			return errManifestDecode.WithCause(err)
+		}
+		apps, err := decodeManifestEntities("application", doc.Applications, func() *ttnpb.Application { return &ttnpb.Application{} })
+		if err != nil {
+			return err
+		}
+		m.Applications = append(m.Applications, apps...)
+		gtws, err := decodeManifestEntities("gateway", doc.Gateways, func() *ttnpb.Gateway { return &ttnpb.Gateway{} })
+		if err != nil {
+			return err
+		}
+		m.Gateways = append(m.Gateways, gtws...)
+		devs, err := decodeManifestEntities("end_device", doc.EndDevices, func() *ttnpb.EndDevice { return &ttnpb.EndDevice{} })
+		if err != nil {
+			return err
+		}
+		m.EndDevices = append(m.EndDevices, devs...)
+		webhooks, err := decodeManifestEntities("webhook", doc.Webhooks, func() *ttnpb.ApplicationWebhook { return &ttnpb.ApplicationWebhook{} })
+		if err != nil {
+			return err
+		}
+		m.Webhooks = append(m.Webhooks, webhooks...)
+		for _, key := range doc.APIKeys {
+			if (key.ApplicationID == "") == (key.GatewayID == "") {
+				return errManifestAPIKey.WithAttributes("name", key.Name)
+			}
+			if _, err := key.rights(); err != nil {
+				return err
+			}
+		}
+		m.APIKeys = append(m.APIKeys, doc.APIKeys...)
+	}
+}
+
+type fieldSetter[T any] interface {
+	proto.Message
+	SetFields(T, ...string) error
+}
+
+// changedPaths returns the paths of desired that differ from live.
+func changedPaths[T fieldSetter[T]](desired, live T, newFn func() T, paths []string) ([]string, error) {
+	var changed []string
+	for _, path := range paths {
+		a, b := newFn(), newFn()
+		if err := a.SetFields(desired, path); err != nil {
+			return nil, err
+		}
+		if err := b.SetFields(live, path); err != nil {
+			return nil, err
+		}
+		if !proto.Equal(a, b) {
+			changed = append(changed, path)
+		}
+	}
+	return changed, nil
+}
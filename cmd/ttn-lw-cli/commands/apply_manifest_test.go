@@ -0,0 +1,228 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name           string
+		Manifest       string
+		Expected       *manifest
+		ErrorAssertion func(error) bool
+	}{
+		{
+			Name:     "Empty",
+			Manifest: "",
+			Expected: &manifest{},
+		},
+		{
+			Name: "Application",
+			Manifest: `
+applications:
+  - ids:
+      application_id: test-app
+    name: Test Application
+    description: Test
+`,
+			Expected: &manifest{
+				Applications: []manifestEntity[*ttnpb.Application]{
+					{
+						Entity: &ttnpb.Application{
+							Ids:         &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+							Name:        "Test Application",
+							Description: "Test",
+						},
+						Paths: []string{"description", "name"},
+					},
+				},
+			},
+		},
+		{
+			Name: "MultipleDocuments",
+			Manifest: `
+gateways:
+  - ids:
+      gateway_id: test-gtw
+    frequency_plan_ids:
+      - EU_863_870
+---
+api_keys:
+  - gateway_id: test-gtw
+    name: test-key
+    rights:
+      - gateway_link
+`,
+			Expected: &manifest{
+				Gateways: []manifestEntity[*ttnpb.Gateway]{
+					{
+						Entity: &ttnpb.Gateway{
+							Ids:              &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"},
+							FrequencyPlanIds: []string{"EU_863_870"},
+						},
+						Paths: []string{"frequency_plan_ids"},
+					},
+				},
+				APIKeys: []manifestAPIKey{
+					{
+						GatewayID: "test-gtw",
+						Name:      "test-key",
+						Rights:    []string{"gateway_link"},
+					},
+				},
+			},
+		},
+		{
+			Name:           "InvalidYAML",
+			Manifest:       "applications: [",
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "InvalidEntity",
+			Manifest: `
+applications:
+  - test-app
+`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "APIKeyWithoutEntity",
+			Manifest: `
+api_keys:
+  - name: test-key
+    rights:
+      - application_link
+`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "APIKeyWithBothEntities",
+			Manifest: `
+api_keys:
+  - application_id: test-app
+    gateway_id: test-gtw
+    name: test-key
+`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "APIKeyUnknownRight",
+			Manifest: `
+api_keys:
+  - application_id: test-app
+    name: test-key
+    rights:
+      - unknown
+`,
+			ErrorAssertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			m := &manifest{}
+			err := decodeManifest(strings.NewReader(tc.Manifest), m)
+			if tc.ErrorAssertion != nil {
+				a.So(tc.ErrorAssertion(err), should.BeTrue)
+				return
+			}
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(m, should.Resemble, tc.Expected)
+		})
+	}
+}
+
+func TestChangedPaths(t *testing.T) {
+	t.Parallel()
+	newApplication := func() *ttnpb.Application { return &ttnpb.Application{} }
+	for _, tc := range []struct {
+		Name     string
+		Desired  *ttnpb.Application
+		Live     *ttnpb.Application
+		Paths    []string
+		Expected []string
+	}{
+		{
+			Name: "Unchanged",
+			Desired: &ttnpb.Application{
+				Name:        "Test Application",
+				Description: "Test",
+			},
+			Live: &ttnpb.Application{
+				Name:        "Test Application",
+				Description: "Test",
+			},
+			Paths: []string{"description", "name"},
+		},
+		{
+			Name: "Changed",
+			Desired: &ttnpb.Application{
+				Name:        "Test Application",
+				Description: "Changed",
+			},
+			Live: &ttnpb.Application{
+				Name:        "Test Application",
+				Description: "Test",
+			},
+			Paths:    []string{"description", "name"},
+			Expected: []string{"description"},
+		},
+		{
+			Name: "UndeclaredPath",
+			Desired: &ttnpb.Application{
+				Name: "Test Application",
+			},
+			Live: &ttnpb.Application{
+				Name:        "Test Application",
+				Description: "Test",
+			},
+			Paths: []string{"name"},
+		},
+		{
+			Name: "Map",
+			Desired: &ttnpb.Application{
+				Attributes: map[string]string{"foo": "bar"},
+			},
+			Live: &ttnpb.Application{
+				Attributes: map[string]string{"foo": "baz"},
+			},
+			Paths:    []string{"attributes"},
+			Expected: []string{"attributes"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			paths, err := changedPaths(tc.Desired, tc.Live, newApplication, tc.Paths)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			a.So(paths, should.Resemble, tc.Expected)
+		})
+	}
+}
@@ -0,0 +1,266 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	completionTimeout = 3 * time.Second
+	completionLimit   = 100
+)
+
+var (
+	completionOnce sync.Once
+	completionErr  error
+
+	usageArgRegexp = regexp.MustCompile(`\[([a-z-]+)\]`)
+)
+
+// prepareCompletion initializes the configuration and authentication for dynamic completion.
+// The completion command does not run the persistent pre-run of the root command, as that
+// would check for version updates and submit telemetry on every key press.
+func prepareCompletion() error {
+	completionOnce.Do(func() {
+		if completionErr = mgr.ReadInConfig(); completionErr != nil {
+			return
+		}
+		if completionErr = mgr.Unmarshal(config); completionErr != nil {
+			return
+		}
+		if cache, completionErr = util.GetAuthCache(); completionErr != nil {
+			return
+		}
+		cache = cache.ForID(config.CredentialsID)
+		if logger, completionErr = shared.InitializeLogger(&config.Log); completionErr != nil {
+			return
+		}
+		api.SetLogger(logger)
+		if config.Insecure {
+			api.SetInsecure(true)
+		}
+		if config.CA != "" {
+			pemBytes, err := os.ReadFile(config.CA)
+			if err != nil {
+				completionErr = err
+				return
+			}
+			if completionErr = api.AddCA(pemBytes); completionErr != nil {
+				return
+			}
+		}
+		completionErr = requireAuth()
+	})
+	return completionErr
+}
+
+// completionFunc returns the IDs of the entities that start with toComplete.
+type completionFunc func(ctx context.Context, cmd *cobra.Command, args []string) ([]string, error)
+
+func completeApplicationIDs(ctx context.Context, _ *cobra.Command, _ []string) ([]string, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewApplicationRegistryClient(is).List(ctx, &ttnpb.ListApplicationsRequest{
+		FieldMask: ttnpb.FieldMask("ids"),
+		Limit:     completionLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(res.Applications))
+	for _, app := range res.Applications {
+		ids = append(ids, app.GetIds().GetApplicationId())
+	}
+	return ids, nil
+}
+
+func completeGatewayIDs(ctx context.Context, _ *cobra.Command, _ []string) ([]string, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewGatewayRegistryClient(is).List(ctx, &ttnpb.ListGatewaysRequest{
+		FieldMask: ttnpb.FieldMask("ids"),
+		Limit:     completionLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(res.Gateways))
+	for _, gtw := range res.Gateways {
+		ids = append(ids, gtw.GetIds().GetGatewayId())
+	}
+	return ids, nil
+}
+
+func completeOrganizationIDs(ctx context.Context, _ *cobra.Command, _ []string) ([]string, error) {
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewOrganizationRegistryClient(is).List(ctx, &ttnpb.ListOrganizationsRequest{
+		FieldMask: ttnpb.FieldMask("ids"),
+		Limit:     completionLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(res.Organizations))
+	for _, org := range res.Organizations {
+		ids = append(ids, org.GetIds().GetOrganizationId())
+	}
+	return ids, nil
+}
+
+// completionApplicationID returns the application ID that is already given in the arguments or flags.
+func completionApplicationID(cmd *cobra.Command, args []string) *ttnpb.ApplicationIdentifiers {
+	if appID, _ := cmd.Flags().GetString("application-id"); appID != "" {
+		return &ttnpb.ApplicationIdentifiers{ApplicationId: appID}
+	}
+	if len(args) > 0 && strings.Contains(cmd.Use, "[application-id]") {
+		return &ttnpb.ApplicationIdentifiers{ApplicationId: args[0]}
+	}
+	return nil
+}
+
+func completeDeviceIDs(ctx context.Context, cmd *cobra.Command, args []string) ([]string, error) {
+	appID := completionApplicationID(cmd, args)
+	if appID == nil {
+		return nil, nil
+	}
+	is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewEndDeviceRegistryClient(is).List(ctx, &ttnpb.ListEndDevicesRequest{
+		ApplicationIds: appID,
+		FieldMask:      ttnpb.FieldMask("ids"),
+		Limit:          completionLimit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(res.EndDevices))
+	for _, dev := range res.EndDevices {
+		ids = append(ids, dev.GetIds().GetDeviceId())
+	}
+	return ids, nil
+}
+
+func completeWebhookIDs(ctx context.Context, cmd *cobra.Command, args []string) ([]string, error) {
+	appID := completionApplicationID(cmd, args)
+	if appID == nil || !config.ApplicationServerEnabled {
+		return nil, nil
+	}
+	as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+	if err != nil {
+		return nil, err
+	}
+	res, err := ttnpb.NewApplicationWebhookRegistryClient(as).List(ctx, &ttnpb.ListApplicationWebhooksRequest{
+		ApplicationIds: appID,
+		FieldMask:      ttnpb.FieldMask("ids"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	ids := make([]string, 0, len(res.Webhooks))
+	for _, webhook := range res.Webhooks {
+		ids = append(ids, webhook.GetIds().GetWebhookId())
+	}
+	return ids, nil
+}
+
+// completionFuncs are the completion functions by argument or flag name.
+var completionFuncs = map[string]completionFunc{
+	"application-id":  completeApplicationIDs,
+	"device-id":       completeDeviceIDs,
+	"gateway-id":      completeGatewayIDs,
+	"organization-id": completeOrganizationIDs,
+	"webhook-id":      completeWebhookIDs,
+}
+
+func runCompletion(f completionFunc, cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+	if err := prepareCompletion(); err != nil {
+		return nil, cobra.ShellCompDirectiveError
+	}
+	ctx, cancel := context.WithTimeout(log.NewContext(ctx, logger), completionTimeout)
+	defer cancel()
+	ids, err := f(ctx, cmd, args)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to complete IDs")
+		return nil, cobra.ShellCompDirectiveError
+	}
+	matches := make([]string, 0, len(ids))
+	for _, id := range ids {
+		if strings.HasPrefix(id, toComplete) {
+			matches = append(matches, id)
+		}
+	}
+	return matches, cobra.ShellCompDirectiveNoFileComp
+}
+
+// registerCompletions registers the completion functions for the positional arguments
+// and identifier flags of cmd and its subcommands.
+func registerCompletions(cmd *cobra.Command) {
+	if cmd.ValidArgsFunction == nil {
+		var argFuncs []completionFunc
+		for _, match := range usageArgRegexp.FindAllStringSubmatch(cmd.Use, -1) {
+			argFuncs = append(argFuncs, completionFuncs[match[1]])
+		}
+		if len(argFuncs) > 0 {
+			cmd.ValidArgsFunction = func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+				if len(args) >= len(argFuncs) || argFuncs[len(args)] == nil {
+					return nil, cobra.ShellCompDirectiveNoFileComp
+				}
+				return runCompletion(argFuncs[len(args)], cmd, args, toComplete)
+			}
+		}
+	}
+	for name, f := range completionFuncs {
+		if flag := cmd.Flags().Lookup(name); flag == nil || flag.Value.Type() != "string" {
+			continue
+		}
+		f := f
+		// Registering fails if the flag already has a completion function, which is fine.
+		_ = cmd.RegisterFlagCompletionFunc(name, func(cmd *cobra.Command, args []string, toComplete string) ([]string, cobra.ShellCompDirective) {
+			return runCompletion(f, cmd, args, toComplete)
+		})
+	}
+	for _, sub := range cmd.Commands() {
+		registerCompletions(sub)
+	}
+}
+
+func init() {
+	// Completions are registered on initialization of the command, when all commands are added.
+	cobra.OnInitialize(func() {
+		registerCompletions(Root)
+	})
+}
@@ -0,0 +1,295 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"fmt"
+	stdio "io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/sync/errgroup"
+)
+
+// traceCorrelationIDPrefixes are the prefixes of correlation IDs of individual uplink and downlink messages.
+// Events are traced across components by following these correlation IDs.
+var traceCorrelationIDPrefixes = []string{
+	"gs:uplink:",
+	"ns:uplink:",
+	"as:up:",
+	"pba:uplink:",
+	"as:downlink:",
+	"ns:downlink:",
+	"ns:transmission:",
+	"pba:downlink:",
+	"gs:tx_ack:",
+	"ns:tx_ack:",
+}
+
+func isTraceCorrelationID(correlationID string) bool {
+	for _, prefix := range traceCorrelationIDPrefixes {
+		if strings.HasPrefix(correlationID, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+var errNoCorrelationID = errors.DefineInvalidArgument("no_correlation_id", "no correlation ID set")
+
+// findRelatedEvents finds the events with the given correlation ID in all components.
+func findRelatedEvents(ctx context.Context, correlationID string) ([]*ttnpb.Event, error) {
+	var (
+		mu     sync.Mutex
+		events []*ttnpb.Event
+	)
+	g, gCtx := errgroup.WithContext(ctx)
+	for _, address := range getEventsAddresses() {
+		address := address // shadow loop variable.
+		g.Go(func() error {
+			conn, err := api.Dial(gCtx, address)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewEventsClient(conn).FindRelated(gCtx, &ttnpb.FindRelatedEventsRequest{
+				CorrelationId: correlationID,
+			})
+			if err != nil {
+				return err
+			}
+			mu.Lock()
+			events = append(events, res.GetEvents()...)
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// traceEvents finds the events with the given correlation ID, and follows the correlation IDs of the messages in
+// these events up to the given depth. The events are deduplicated and sorted by time.
+func traceEvents(ctx context.Context, correlationID string, depth uint) ([]*ttnpb.Event, []string, error) {
+	var (
+		seenEvents = make(map[string]bool)
+		seenIDs    = map[string]bool{correlationID: true}
+		queue      = []string{correlationID}
+		events     []*ttnpb.Event
+	)
+	for level := uint(0); level <= depth && len(queue) > 0; level++ {
+		var next []string
+		for _, correlationID := range queue {
+			found, err := findRelatedEvents(ctx, correlationID)
+			if err != nil {
+				return nil, nil, err
+			}
+			for _, evt := range found {
+				key := evt.UniqueId
+				if key == "" {
+					key = fmt.Sprintf("%s:%s:%s", evt.Name, evt.Time.AsTime().Format(time.RFC3339Nano), evt.Origin)
+				}
+				if seenEvents[key] {
+					continue
+				}
+				seenEvents[key] = true
+				events = append(events, evt)
+				for _, cid := range evt.CorrelationIds {
+					if seenIDs[cid] || !isTraceCorrelationID(cid) {
+						continue
+					}
+					seenIDs[cid] = true
+					next = append(next, cid)
+				}
+			}
+		}
+		queue = next
+	}
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].Time.AsTime().Before(events[j].Time.AsTime())
+	})
+	correlationIDs := make([]string, 0, len(seenIDs))
+	for cid := range seenIDs {
+		correlationIDs = append(correlationIDs, cid)
+	}
+	sort.Strings(correlationIDs)
+	return events, correlationIDs, nil
+}
+
+// eventComponent returns the component that emitted the event, based on the event name.
+func eventComponent(evt *ttnpb.Event) string {
+	component, _, _ := strings.Cut(evt.Name, ".")
+	return strings.ToUpper(component)
+}
+
+func eventEntities(evt *ttnpb.Event) string {
+	entities := make([]string, 0, len(evt.Identifiers))
+	for _, ids := range evt.Identifiers {
+		entities = append(entities, fmt.Sprintf("%s %s", ids.EntityType(), ids.IDString()))
+	}
+	return strings.Join(entities, ", ")
+}
+
+func formatTraceDuration(d time.Duration) string {
+	return d.Round(time.Microsecond).String()
+}
+
+// writeTrace writes an annotated timeline of the events.
+func writeTrace(w stdio.Writer, correlationID string, events []*ttnpb.Event, correlationIDs []string) error {
+	fmt.Fprintf(w, "Trace of %s\n\n", correlationID)
+	if len(events) == 0 {
+		fmt.Fprintln(w, "No events found. Events may have expired, or the event store may be disabled.")
+		return nil
+	}
+
+	start := events[0].Time.AsTime()
+	end := events[len(events)-1].Time.AsTime()
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "TIME\tOFFSET\tDELTA\tCOMPONENT\tEVENT\tENTITIES\tORIGIN")
+	var (
+		prev       time.Time
+		prevComp   string
+		hops       []string
+		components []string
+		spans      = make(map[string][2]time.Time)
+		origins    = make(map[string]bool)
+	)
+	for i, evt := range events {
+		t := evt.Time.AsTime()
+		comp := eventComponent(evt)
+		if i > 0 && comp != prevComp {
+			hop := fmt.Sprintf("%s -> %s", prevComp, comp)
+			hops = append(hops, fmt.Sprintf("%s after %s", hop, formatTraceDuration(t.Sub(prev))))
+			fmt.Fprintf(tw, "\t\t\t\t-- %s\t\t\n", hop)
+		}
+		delta := "-"
+		if i > 0 {
+			delta = "+" + formatTraceDuration(t.Sub(prev))
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			t.UTC().Format(time.RFC3339Nano),
+			formatTraceDuration(t.Sub(start)),
+			delta,
+			comp,
+			evt.Name,
+			eventEntities(evt),
+			evt.Origin,
+		)
+		span, ok := spans[comp]
+		if !ok {
+			components = append(components, comp)
+			span[0] = t
+		}
+		span[1] = t
+		spans[comp] = span
+		if evt.Origin != "" {
+			origins[evt.Origin] = true
+		}
+		prev, prevComp = t, comp
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(w, "\nTotal: %d events in %s\n", len(events), formatTraceDuration(end.Sub(start)))
+	fmt.Fprintln(w, "\nComponents:")
+	for _, comp := range components {
+		span := spans[comp]
+		fmt.Fprintf(w, "  %-4s from %s to %s (%s)\n",
+			comp,
+			formatTraceDuration(span[0].Sub(start)),
+			formatTraceDuration(span[1].Sub(start)),
+			formatTraceDuration(span[1].Sub(span[0])),
+		)
+	}
+	if len(hops) > 0 {
+		fmt.Fprintln(w, "\nHops:")
+		for _, hop := range hops {
+			fmt.Fprintf(w, "  %s\n", hop)
+		}
+	}
+
+	fmt.Fprintln(w, "\nLog references:")
+	fmt.Fprintln(w, "  Search the logs for the following correlation IDs in the correlation_ids field:")
+	for _, cid := range correlationIDs {
+		fmt.Fprintf(w, "    %s\n", cid)
+	}
+	if len(origins) > 0 {
+		hosts := make([]string, 0, len(origins))
+		for origin := range origins {
+			hosts = append(hosts, origin)
+		}
+		sort.Strings(hosts)
+		fmt.Fprintf(w, "  The events originate from the following hosts: %s\n", strings.Join(hosts, ", "))
+	}
+	return nil
+}
+
+var (
+	debugCommand = &cobra.Command{
+		Use:   "debug",
+		Short: "Debugging commands",
+	}
+	debugTraceUplinkCommand = &cobra.Command{
+		Use:     "trace-uplink [correlation-id]",
+		Aliases: []string{"trace-downlink", "trace"},
+		Short:   "Trace an uplink or downlink message across components",
+		Long: `Trace an uplink or downlink message across components
+
+The events related to the correlation ID are gathered from all components,
+including the events related to the correlation IDs of the messages in these
+events. The events are printed as a timeline, annotated with the time spent
+in and between components, and references to find the related logs.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var correlationID string
+			if len(args) > 0 {
+				if len(args) > 1 {
+					logger.Warn("Multiple IDs found in arguments, considering only the first")
+				}
+				correlationID = args[0]
+			} else {
+				correlationID, _ = cmd.Flags().GetString("correlation-id")
+			}
+			if correlationID == "" {
+				return errNoCorrelationID.New()
+			}
+			depth, _ := cmd.Flags().GetUint("depth")
+
+			events, correlationIDs, err := traceEvents(ctx, correlationID, depth)
+			if err != nil {
+				return err
+			}
+			return writeTrace(os.Stdout, correlationID, events, correlationIDs)
+		},
+	}
+)
+
+func init() {
+	debugTraceUplinkCommand.Flags().String("correlation-id", "", "")
+	debugTraceUplinkCommand.Flags().Uint("depth", 2, "number of times to follow the correlation IDs of messages in the found events") //nolint:lll
+	debugCommand.AddCommand(debugTraceUplinkCommand)
+	Root.AddCommand(debugCommand)
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"encoding/hex"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var errInvalidPHYPayload = errors.DefineInvalidArgument("invalid_phy_payload", "invalid PHYPayload")
+
+// decodedPHYPayload is the output of the decode-phy command.
+type decodedPHYPayload struct {
+	Message *ttnpb.Message `json:"message"`
+	// NetworkServer is the PHYPayload decoded by the Network Server with the network session keys.
+	NetworkServer *ttnpb.DecodeNsPHYPayloadResponse `json:"network_server,omitempty"`
+	// ApplicationServer is the application payload decrypted by the Application Server with the AppSKey.
+	ApplicationServer *ttnpb.DecodeAsPHYPayloadResponse `json:"application_server,omitempty"`
+}
+
+var decodePHYCommand = &cobra.Command{
+	Use:   "decode-phy [phy-payload] [application-id] [device-id]",
+	Short: "Decode a LoRaWAN PHYPayload",
+	Long: `Decode a LoRaWAN PHYPayload.
+
+The hex encoded PHYPayload is decoded structurally. If an end device is
+specified, the Network Server verifies the MIC and decrypts the MAC commands
+with the network session keys, and the Application Server decrypts the
+application payload with the AppSKey. This requires the right to read the
+end device keys.`,
+	Example: `  Decode a PHYPayload structurally:
+    $ ttn-lw-cli decode-phy 4042420126000100013ff2c0aa2b
+
+  Decode a PHYPayload with the session keys of an end device:
+    $ ttn-lw-cli decode-phy 4042420126000100013ff2c0aa2b app1 dev1`,
+	// Authentication is only required to decode with the session keys of an end device.
+	PersistentPreRunE: preRun(checkAuth, refreshToken),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errInvalidPHYPayload.New()
+		}
+		phyPayload := strings.TrimPrefix(strings.ReplaceAll(args[0], " ", ""), "0x")
+		rawPayload, err := hex.DecodeString(phyPayload)
+		if err != nil {
+			return errInvalidPHYPayload.WithCause(err)
+		}
+		decoded := &decodedPHYPayload{
+			Message: &ttnpb.Message{},
+		}
+		if err := lorawan.UnmarshalMessage(rawPayload, decoded.Message); err != nil {
+			return errInvalidPHYPayload.WithCause(err)
+		}
+
+		devID, err := getEndDeviceID(cmd.Flags(), args[1:], false)
+		if err != nil {
+			return err
+		}
+		if devID.ApplicationIds.ApplicationId == "" || devID.DeviceId == "" || decoded.Message.GetMacPayload() == nil {
+			return io.Write(os.Stdout, config.OutputFormat, decoded)
+		}
+
+		asReq := &ttnpb.DecodeAsPHYPayloadRequest{
+			EndDeviceIds: devID,
+			PhyPayload:   rawPayload,
+		}
+		if config.NetworkServerEnabled {
+			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			decoded.NetworkServer, err = ttnpb.NewNsClient(ns).DecodePHYPayload(ctx, &ttnpb.DecodeNsPHYPayloadRequest{
+				EndDeviceIds: devID,
+				PhyPayload:   rawPayload,
+			})
+			if err != nil {
+				return err
+			}
+			// Use the full frame counter inferred by the Network Server to decrypt the application payload.
+			asReq.FullFCnt = &wrapperspb.UInt32Value{Value: decoded.NetworkServer.FullFCnt}
+		}
+		if config.ApplicationServerEnabled {
+			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			decoded.ApplicationServer, err = ttnpb.NewAsClient(as).DecodePHYPayload(ctx, asReq)
+			if err != nil {
+				return err
+			}
+		}
+		return io.Write(os.Stdout, config.OutputFormat, decoded)
+	},
+}
+
+func init() {
+	decodePHYCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	Root.AddCommand(decodePHYCommand)
+}
@@ -1128,7 +1128,12 @@ var (
 		Short: "Delete a batch of end devices within the same application (EXPERIMENTAL).",
 		Long: `Delete a batch of end devices within the same application (EXPERIMENTAL).
 Devices are also unclaimed from an external Join Server if applicable.
-Devices not found in the Identity Server are skipped and no error is returned.`,
+Devices not found in the Identity Server are skipped and no error is returned.
+
+The Identity Server deletes the devices from the Application Server, Network
+Server, Join Server and Identity Server, in that order. If the deletion fails
+in one of these components, the devices are restored in the components that
+they were already deleted from. This requires the right to read device keys.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if err := checkComponentsEnabled(); err != nil {
 				return err
@@ -1177,8 +1182,7 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 				return err
 			}
 			var (
-				del     = make([]string, 0)              // Common devices to delete from IS/AS/NS.
-				js      = make([]string, 0)              // Devices to delete from JS.
+				del     = make([]string, 0)              // Devices to delete from the cluster.
 				unclaim = make(map[types.EUI64][]string) // Devices to unclaim from DCS.
 			)
 
@@ -1214,7 +1218,6 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 					if jsMismatch {
 						return errAddressMismatchEndDevice.New()
 					}
-					js = append(js, dev.GetIds().GetDeviceId())
 				}
 			}
 
@@ -1245,9 +1248,8 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 					if !claimInfo.SupportsClaiming {
 						// These devices cannot be claimed but they also are not registered in the cluster Join Server.
 						// We assume that these devices are in the cluster Join Server with invalid registration information.
-						// We attempt to delete them from the cluster Join Server.
+						// These are deleted from the cluster Join Server by the Identity Server.
 						key := types.MustEUI64(claimInfo.JoinEui).OrZero()
-						js = append(js, unclaim[key]...)
 						delete(unclaim, key)
 					}
 				}
@@ -1270,54 +1272,23 @@ Devices not found in the Identity Server are skipped and no error is returned.`,
 				}
 			}
 
-			// Batch Delete from JS.
-			if len(js) > 0 {
-				jsConn, err := api.Dial(ctx, config.JoinServerGRPCAddress)
-				if err != nil {
-					return err
-				}
-				_, err = ttnpb.NewJsEndDeviceBatchRegistryClient(jsConn).Delete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
-					ApplicationIds: appID,
-					DeviceIds:      js,
-				})
-				if err != nil {
-					return err
-				}
-			}
-
-			// Batch Delete from AS.
-			as, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
-			if err != nil {
-				return err
-			}
-			_, err = ttnpb.NewAsEndDeviceBatchRegistryClient(as).Delete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
-				ApplicationIds: appID,
-				DeviceIds:      del,
-			})
-			if err != nil {
-				return err
+			if len(del) == 0 {
+				return nil
 			}
-
-			// Batch Delete from NS.
-			ns, err := api.Dial(ctx, config.NetworkServerGRPCAddress)
+			res, err := ttnpb.NewEndDeviceBatchRegistryClient(is).DeleteFromCluster(
+				ctx, &ttnpb.BatchDeleteEndDevicesRequest{
+					ApplicationIds: appID,
+					DeviceIds:      del,
+				},
+			)
 			if err != nil {
 				return err
 			}
-			_, err = ttnpb.NewNsEndDeviceBatchRegistryClient(ns).Delete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
-				ApplicationIds: appID,
-				DeviceIds:      del,
-			})
-			if err != nil {
+			if err := io.Write(os.Stdout, config.OutputFormat, res); err != nil {
 				return err
 			}
-
-			// Delete from IS.
-			_, err = ttnpb.NewEndDeviceBatchRegistryClient(is).Delete(ctx, &ttnpb.BatchDeleteEndDevicesRequest{
-				ApplicationIds: appID,
-				DeviceIds:      del,
-			})
-			if err != nil {
-				return err
+			if !res.Deleted {
+				return errBatchDeleteNotApplied.New()
 			}
 			return nil
 		},
@@ -0,0 +1,193 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/util"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+var (
+	errBatchUpdateNotApplied = errors.DefineAborted(
+		"batch_update_not_applied", "batch update not applied in `{component}`",
+	)
+	errBatchDeleteNotApplied = errors.DefineAborted("batch_delete_not_applied", "batch delete not applied")
+)
+
+type endDeviceBatchUpdater interface {
+	Update(
+		context.Context, *ttnpb.BatchUpdateEndDevicesRequest, ...grpc.CallOption,
+	) (*ttnpb.BatchUpdateEndDevicesResponse, error)
+}
+
+// selectEndDevicesByLabels returns the IDs of the end devices of the application that match the label selector.
+func selectEndDevicesByLabels(is *grpc.ClientConn, appID, selector string) ([]string, error) {
+	res, err := ttnpb.NewLabelRegistryClient(is).Search(ctx, &ttnpb.SearchEntitiesByLabelsRequest{
+		Selector:    selector,
+		EntityTypes: []string{"end_devices"},
+		Limit:       1000,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var deviceIDs []string
+	for _, ids := range res.Entities {
+		if devIDs := ids.GetDeviceIds(); devIDs.GetApplicationIds().GetApplicationId() == appID {
+			deviceIDs = append(deviceIDs, devIDs.GetDeviceId())
+		}
+	}
+	return deviceIDs, nil
+}
+
+var endDevicesBatchUpdateCommand = &cobra.Command{
+	Use:   "batch-update [application-id] [device-ids]",
+	Short: "Update properties of multiple end devices (EXPERIMENTAL)",
+	Long: `Update properties of multiple end devices (EXPERIMENTAL)
+
+The same properties are set on all end devices, which are selected by their
+IDs, by a label selector, or both. The update is atomic per component: when
+the update of one end device fails in the Identity Server, Network Server,
+Application Server or Join Server, none of the end devices is updated in that
+component, and the remaining components are not updated.`,
+	Example: `
+  To enable ADR on all end devices with label site=plant-7:
+    $ ttn-lw-cli end-devices batch-update app1 --label-selector site=plant-7 \
+      --mac-settings.adr.mode.dynamic`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return errNoApplicationID.New()
+		}
+		appID, deviceIDs := args[0], args[1:]
+		if selector, _ := cmd.Flags().GetString("label-selector"); selector != "" {
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			selected, err := selectEndDevicesByLabels(is, appID, selector)
+			if err != nil {
+				return err
+			}
+			seen := make(map[string]bool, len(deviceIDs))
+			for _, devID := range deviceIDs {
+				seen[devID] = true
+			}
+			for _, devID := range selected {
+				if !seen[devID] {
+					seen[devID] = true
+					deviceIDs = append(deviceIDs, devID)
+				}
+			}
+		}
+		if len(deviceIDs) == 0 {
+			return errNoIDs.New()
+		}
+
+		paths := util.UpdateFieldMask(cmd.Flags(), setEndDeviceFlags)
+		rawUnsetPaths, _ := cmd.Flags().GetStringSlice("unset")
+		unsetPaths := util.NormalizePaths(rawUnsetPaths)
+		if len(paths)+len(unsetPaths) == 0 {
+			logger.Warn("No fields selected, won't update anything")
+			return nil
+		}
+		device := &ttnpb.EndDevice{}
+		if _, err := device.SetFromFlags(setEndDeviceFlags, ""); err != nil {
+			return err
+		}
+		newPaths, err := parsePayloadFormatterParameterFlags("formatters", device.Formatters, cmd.Flags())
+		if err != nil {
+			return err
+		}
+		paths = append(paths, newPaths...)
+		device.Attributes = mergeAttributes(device.Attributes, cmd.Flags())
+		paths = append(paths, unsetPaths...)
+
+		isPaths, nsPaths, asPaths, jsPaths := splitEndDeviceSetPaths(
+			ttnpb.HasAnyField(paths, setEndDeviceToJS...), paths...,
+		)
+		for _, component := range []struct {
+			name    string
+			enabled bool
+			address string
+			paths   []string
+			client  func(*grpc.ClientConn) endDeviceBatchUpdater
+		}{
+			{
+				"is", true, config.IdentityServerGRPCAddress, isPaths,
+				func(cc *grpc.ClientConn) endDeviceBatchUpdater {
+					return ttnpb.NewEndDeviceBatchRegistryClient(cc)
+				},
+			},
+			{
+				"ns", config.NetworkServerEnabled, config.NetworkServerGRPCAddress, nsPaths,
+				func(cc *grpc.ClientConn) endDeviceBatchUpdater {
+					return ttnpb.NewNsEndDeviceBatchRegistryClient(cc)
+				},
+			},
+			{
+				"as", config.ApplicationServerEnabled, config.ApplicationServerGRPCAddress, asPaths,
+				func(cc *grpc.ClientConn) endDeviceBatchUpdater {
+					return ttnpb.NewAsEndDeviceBatchRegistryClient(cc)
+				},
+			},
+			{
+				"js", config.JoinServerEnabled, config.JoinServerGRPCAddress, jsPaths,
+				func(cc *grpc.ClientConn) endDeviceBatchUpdater {
+					return ttnpb.NewJsEndDeviceBatchRegistryClient(cc)
+				},
+			},
+		} {
+			if !component.enabled || len(component.paths) == 0 {
+				continue
+			}
+			logger.WithField("paths", component.paths).Debugf("Batch update end devices in %s", component.name)
+			cc, err := api.Dial(ctx, component.address)
+			if err != nil {
+				return err
+			}
+			res, err := component.client(cc).Update(ctx, &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+				DeviceIds:      deviceIDs,
+				EndDevice:      device,
+				FieldMask:      ttnpb.FieldMask(component.paths...),
+			})
+			if err != nil {
+				return err
+			}
+			if err := io.Write(os.Stdout, config.OutputFormat, res); err != nil {
+				return err
+			}
+			if !res.Updated {
+				return errBatchUpdateNotApplied.WithAttributes("component", component.name)
+			}
+		}
+		return nil
+	},
+}
+
+func init() {
+	endDevicesBatchUpdateCommand.Flags().AddFlagSet(setEndDeviceFlags)
+	endDevicesBatchUpdateCommand.Flags().AddFlagSet(payloadFormatterParameterFlags("formatters"))
+	endDevicesBatchUpdateCommand.Flags().AddFlagSet(util.UnsetFlagSet())
+	endDevicesBatchUpdateCommand.Flags().String("label-selector", "", "select end devices by labels (key=value[,key=value])")
+	endDevicesCommand.AddCommand(endDevicesBatchUpdateCommand)
+}
@@ -0,0 +1,268 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/bundle"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errNoDevEUIBlock       = errors.DefineInvalidArgument("no_dev_eui_block", "no DevEUI block set")
+	errDevEUIBlockExceeded = errors.DefineInvalidArgument("dev_eui_block_exceeded", "DevEUI block `{block}` can not hold {count} DevEUIs from offset {offset}")
+	errNoBundleCount       = errors.DefineInvalidArgument("no_bundle_count", "no number of end devices set")
+	errNoBundleFile        = errors.DefineInvalidArgument("no_bundle_file", "no bundle file set")
+	errNoPassphraseFile    = errors.DefineInvalidArgument("no_passphrase_file", "no passphrase file set")
+)
+
+func readPassphrase(flagSet *pflag.FlagSet) ([]byte, error) {
+	filename, _ := flagSet.GetString("passphrase-file")
+	if filename == "" {
+		return nil, errNoPassphraseFile.New()
+	}
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(b), nil
+}
+
+// devEUIFromBlock returns the DevEUI at the given offset in the block.
+func devEUIFromBlock(block types.EUI64Prefix, offset uint64) types.EUI64 {
+	hostMask := uint64(1)<<(64-block.Length) - 1
+	var eui types.EUI64
+	eui.UnmarshalNumber(block.EUI64.MarshalNumber()&^hostMask | offset&hostMask)
+	return eui
+}
+
+func generateClaimAuthenticationCode() (string, error) {
+	var b [4]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "", err
+	}
+	return strings.ToUpper(hex.EncodeToString(b[:])), nil
+}
+
+var (
+	endDevicesBundleCommand = &cobra.Command{
+		Use:   "bundle",
+		Short: "Encrypted provisioning bundle commands",
+	}
+	endDevicesBundleGenerateCommand = &cobra.Command{
+		Use:   "generate",
+		Short: "Generate an encrypted provisioning bundle offline",
+		Long: `Generate an encrypted provisioning bundle offline.
+
+The bundle contains DevEUIs allocated from the given DevEUI block, root keys
+and claim authentication codes. No connection to The Things Stack is made,
+so this command can be used on air-gapped manufacturing lines. The bundle is
+encrypted with the passphrase in the passphrase file, and can be imported with
+the import command.`,
+		PersistentPreRunE: preRun(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			appID := getApplicationID(cmd.Flags(), args)
+			if appID == nil {
+				return errNoApplicationID.New()
+			}
+			blockStr, _ := cmd.Flags().GetString("dev-eui-block")
+			if blockStr == "" {
+				return errNoDevEUIBlock.New()
+			}
+			var block types.EUI64Prefix
+			if err := block.UnmarshalText([]byte(blockStr)); err != nil {
+				return err
+			}
+			count, _ := cmd.Flags().GetUint64("count")
+			if count == 0 {
+				return errNoBundleCount.New()
+			}
+			offset, _ := cmd.Flags().GetUint64("dev-eui-offset")
+			if size := uint64(1) << (64 - block.Length); block.Length > 0 && (offset >= size || count > size-offset) {
+				return errDevEUIBlockExceeded.WithAttributes(
+					"block", block.String(),
+					"count", count,
+					"offset", offset,
+				)
+			}
+			var joinEUI types.EUI64
+			if s, _ := cmd.Flags().GetString("join-eui"); s != "" {
+				if err := joinEUI.UnmarshalText([]byte(s)); err != nil {
+					return err
+				}
+			}
+			var macVersion ttnpb.MACVersion
+			if s, _ := cmd.Flags().GetString("lorawan-version"); s != "" {
+				if err := macVersion.UnmarshalText([]byte(s)); err != nil {
+					return errInvalidMACVersion.WithCause(err)
+				}
+			}
+			withCAC, _ := cmd.Flags().GetBool("with-claim-authentication-code")
+			filename, _ := cmd.Flags().GetString("output-file")
+			if filename == "" {
+				return errNoBundleFile.New()
+			}
+			passphrase, err := readPassphrase(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			devices := make([]*ttnpb.EndDevice, 0, count)
+			for i := uint64(0); i < count; i++ {
+				devEUI := devEUIFromBlock(block, offset+i)
+				dev := &ttnpb.EndDevice{
+					Ids: &ttnpb.EndDeviceIdentifiers{
+						ApplicationIds: appID,
+						DeviceId:       fmt.Sprintf("eui-%s", strings.ToLower(devEUI.String())),
+						DevEui:         devEUI.Bytes(),
+						JoinEui:        joinEUI.Bytes(),
+					},
+					SupportsJoin: true,
+					RootKeys: &ttnpb.RootKeys{
+						RootKeyId: "ttn-lw-cli-generated",
+						AppKey:    &ttnpb.KeyEnvelope{Key: generateKey().Bytes()},
+					},
+				}
+				if macspec.UseNwkKey(macVersion) {
+					dev.RootKeys.NwkKey = &ttnpb.KeyEnvelope{Key: generateKey().Bytes()}
+				}
+				if withCAC {
+					code, err := generateClaimAuthenticationCode()
+					if err != nil {
+						return err
+					}
+					dev.ClaimAuthenticationCode = &ttnpb.EndDeviceAuthenticationCode{Value: code}
+				}
+				devices = append(devices, dev)
+			}
+
+			f, err := os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := bundle.Seal(f, passphrase, joinEUI.String(), devices); err != nil {
+				return err
+			}
+			logger.WithFields(log.Fields(
+				"count", count,
+				"first_dev_eui", devEUIFromBlock(block, offset),
+				"next_offset", offset+count,
+				"file", filename,
+			)).Info("Generated provisioning bundle")
+			return nil
+		},
+	}
+	endDevicesBundleImportCommand = &cobra.Command{
+		Use:   "import",
+		Short: "Import an encrypted provisioning bundle",
+		Long: `Import an encrypted provisioning bundle.
+
+The end devices in the bundle are registered in the Identity Server and their
+root keys are stored in the Join Server. Claim authentication codes are stored
+in the Identity Server.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			filename, _ := cmd.Flags().GetString("input-file")
+			if filename == "" {
+				return errNoBundleFile.New()
+			}
+			passphrase, err := readPassphrase(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			f, err := os.Open(filename)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			_, devices, err := bundle.Open(f, passphrase)
+			if err != nil {
+				return err
+			}
+			if !config.JoinServerEnabled {
+				return errJoinServerDisabled.New()
+			}
+			appID := getApplicationID(cmd.Flags(), args)
+
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			isPaths := []string{"join_server_address", "claim_authentication_code"}
+			jsPaths := []string{
+				"root_keys.root_key_id",
+				"root_keys.app_key.key",
+				"root_keys.nwk_key.key",
+			}
+			for _, dev := range devices {
+				if appID != nil {
+					dev.Ids.ApplicationIds = appID
+				}
+				dev.JoinServerAddress = getHost(config.JoinServerGRPCAddress)
+				logger := logger.WithField("device_uid", fmt.Sprintf("%s.%s", dev.Ids.ApplicationIds.GetApplicationId(), dev.Ids.DeviceId))
+
+				isDevice := &ttnpb.EndDevice{}
+				if err := isDevice.SetFields(dev, append(isPaths, "ids")...); err != nil {
+					return err
+				}
+				if _, err := ttnpb.NewEndDeviceRegistryClient(is).Create(ctx, &ttnpb.CreateEndDeviceRequest{
+					EndDevice: isDevice,
+				}); err != nil {
+					return err
+				}
+				if _, err := setEndDevice(dev, nil, nil, nil, jsPaths, nil, true, false); err != nil {
+					logger.WithError(err).Error("Could not import end device, rolling back...")
+					if err := deleteEndDevice(ctx, dev.Ids, false); err != nil {
+						logger.WithError(err).Error("Could not roll back end device import")
+					}
+					return err
+				}
+				logger.Info("Imported end device")
+			}
+			return nil
+		},
+	}
+)
+
+func init() {
+	endDevicesBundleGenerateCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesBundleGenerateCommand.Flags().String("dev-eui-block", "", "DevEUI block to allocate DevEUIs from (EUI/length)")
+	endDevicesBundleGenerateCommand.Flags().Uint64("dev-eui-offset", 0, "offset of the first DevEUI in the block")
+	endDevicesBundleGenerateCommand.Flags().Uint64("count", 0, "number of end devices to generate")
+	endDevicesBundleGenerateCommand.Flags().String("join-eui", "", "(hex)")
+	endDevicesBundleGenerateCommand.Flags().String("lorawan-version", "", "LoRaWAN version of the end devices")
+	endDevicesBundleGenerateCommand.Flags().Bool("with-claim-authentication-code", false, "generate claim authentication codes of 4 bytes")
+	endDevicesBundleGenerateCommand.Flags().String("output-file", "", "bundle file to write")
+	endDevicesBundleGenerateCommand.Flags().String("passphrase-file", "", "file containing the bundle passphrase")
+	endDevicesBundleCommand.AddCommand(endDevicesBundleGenerateCommand)
+	endDevicesBundleImportCommand.Flags().AddFlagSet(applicationIDFlags())
+	endDevicesBundleImportCommand.Flags().String("input-file", "", "bundle file to import")
+	endDevicesBundleImportCommand.Flags().String("passphrase-file", "", "file containing the bundle passphrase")
+	endDevicesBundleCommand.AddCommand(endDevicesBundleImportCommand)
+	endDevicesCommand.AddCommand(endDevicesBundleCommand)
+}
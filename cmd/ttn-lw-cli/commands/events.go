@@ -59,10 +59,12 @@ var eventsCommand = &cobra.Command{
 		}
 		tail, _ := cmd.Flags().GetUint32("tail")
 		names, _ := cmd.Flags().GetStringSlice("names")
+		filter, _ := cmd.Flags().GetString("filter")
 		req := &ttnpb.StreamEventsRequest{
 			Identifiers: ids,
 			Tail:        tail,
 			Names:       names,
+			Filter:      filter,
 		}
 
 		g, gCtx := errgroup.WithContext(ctx)
@@ -170,6 +172,7 @@ func init() {
 	eventsCommand.Flags().AddFlagSet(entityIdentifiersSliceFlags())
 	eventsCommand.Flags().Uint32("tail", 0, "")
 	eventsCommand.Flags().StringSlice("names", nil, "")
+	eventsCommand.Flags().String("filter", "", "CEL expression that events must match")
 	Root.AddCommand(eventsCommand)
 	eventsFindRelatedCommand.Flags().String("correlation-id", "", "")
 	eventsCommand.AddCommand(eventsFindRelatedCommand)
@@ -0,0 +1,186 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/io"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/api"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errInvalidLabel = errors.DefineInvalidArgument("invalid_label", "invalid label `{label}`")
+	errNoSelector   = errors.DefineInvalidArgument("no_selector", "no label selector set")
+)
+
+func labelEntityFlags() *pflag.FlagSet {
+	flagSet := &pflag.FlagSet{}
+	flagSet.String("application-id", "", "")
+	flagSet.String("client-id", "", "")
+	flagSet.String("device-id", "", "requires --application-id")
+	flagSet.String("gateway-id", "", "")
+	flagSet.String("organization-id", "", "")
+	flagSet.String("user-id", "", "")
+	return flagSet
+}
+
+// getLabeledEntityID returns the identifiers of the entity selected by the flags.
+func getLabeledEntityID(flagSet *pflag.FlagSet) (*ttnpb.EntityIdentifiers, error) {
+	applicationID, _ := flagSet.GetString("application-id")
+	if deviceID, _ := flagSet.GetString("device-id"); deviceID != "" {
+		if applicationID == "" {
+			return nil, errNoApplicationID.New()
+		}
+		return (&ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: applicationID},
+			DeviceId:       deviceID,
+		}).GetEntityIdentifiers(), nil
+	}
+	if applicationID != "" {
+		return (&ttnpb.ApplicationIdentifiers{ApplicationId: applicationID}).GetEntityIdentifiers(), nil
+	}
+	if clientID, _ := flagSet.GetString("client-id"); clientID != "" {
+		return (&ttnpb.ClientIdentifiers{ClientId: clientID}).GetEntityIdentifiers(), nil
+	}
+	if gatewayID, _ := flagSet.GetString("gateway-id"); gatewayID != "" {
+		return (&ttnpb.GatewayIdentifiers{GatewayId: gatewayID}).GetEntityIdentifiers(), nil
+	}
+	if organizationID, _ := flagSet.GetString("organization-id"); organizationID != "" {
+		return (&ttnpb.OrganizationIdentifiers{OrganizationId: organizationID}).GetEntityIdentifiers(), nil
+	}
+	if userID, _ := flagSet.GetString("user-id"); userID != "" {
+		return (&ttnpb.UserIdentifiers{UserId: userID}).GetEntityIdentifiers(), nil
+	}
+	return nil, errNoIDs.New()
+}
+
+var (
+	labelsCommand = &cobra.Command{
+		Use:     "labels",
+		Aliases: []string{"label", "lbl"},
+		Short:   "Manage labels of entities",
+	}
+	labelsGetCommand = &cobra.Command{
+		Use:   "get",
+		Short: "Get the labels of an entity",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getLabeledEntityID(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewLabelRegistryClient(is).Get(ctx, &ttnpb.GetLabelsRequest{
+				EntityIds: ids,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	labelsSetCommand = &cobra.Command{
+		Use:   "set",
+		Short: "Set the labels of an entity",
+		Long: `Set the labels of an entity
+
+The given labels replace all existing labels of the entity. Use --label
+multiple times to set multiple labels, or leave it out to remove all labels.`,
+		Example: `
+  To label a gateway and an end device:
+    $ ttn-lw-cli labels set --gateway-id gtw1 --label site=plant-7 --label floor=2
+    $ ttn-lw-cli labels set --application-id app1 --device-id dev1 --label site=plant-7`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ids, err := getLabeledEntityID(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			rawLabels, _ := cmd.Flags().GetStringSlice("label")
+			labels := make(map[string]string, len(rawLabels))
+			for _, label := range rawLabels {
+				key, value, ok := strings.Cut(label, "=")
+				if !ok || key == "" {
+					return errInvalidLabel.WithAttributes("label", label)
+				}
+				labels[key] = value
+			}
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewLabelRegistryClient(is).Set(ctx, &ttnpb.SetLabelsRequest{
+				EntityIds: ids,
+				Labels:    labels,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
+	labelsSearchCommand = &cobra.Command{
+		Use:   "search",
+		Short: "Search for entities by their labels",
+		Example: `
+  To list all end devices and gateways with label site=plant-7:
+    $ ttn-lw-cli labels search --selector site=plant-7 --entity-types end_devices,gateways`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			selector, _ := cmd.Flags().GetString("selector")
+			if selector == "" {
+				return errNoSelector.New()
+			}
+			entityTypes, _ := cmd.Flags().GetStringSlice("entity-types")
+			limit, page, opt, getTotal := withPagination(cmd.Flags())
+			is, err := api.Dial(ctx, config.IdentityServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewLabelRegistryClient(is).Search(ctx, &ttnpb.SearchEntitiesByLabelsRequest{
+				Selector:    selector,
+				EntityTypes: entityTypes,
+				Limit:       limit,
+				Page:        page,
+			}, opt)
+			if err != nil {
+				return err
+			}
+			getTotal()
+
+			return io.Write(os.Stdout, config.OutputFormat, res.Entities)
+		},
+	}
+)
+
+func init() {
+	labelsGetCommand.Flags().AddFlagSet(labelEntityFlags())
+	labelsCommand.AddCommand(labelsGetCommand)
+	labelsSetCommand.Flags().AddFlagSet(labelEntityFlags())
+	labelsSetCommand.Flags().StringSlice("label", nil, "label in the form key=value")
+	labelsCommand.AddCommand(labelsSetCommand)
+	labelsSearchCommand.Flags().String("selector", "", "labels to match in the form key=value[,key=value]")
+	labelsSearchCommand.Flags().StringSlice("entity-types", nil,
+		"entity types to search (applications, clients, end_devices, gateways, organizations, users)")
+	labelsSearchCommand.Flags().AddFlagSet(paginationFlags())
+	labelsCommand.AddCommand(labelsSearchCommand)
+	Root.AddCommand(labelsCommand)
+}
@@ -333,6 +333,60 @@ var (
 			})(cmd, args)
 		},
 	}
+	lorawanDecryptPayloadCmd = &cobra.Command{
+		Use:   "decrypt-payload",
+		Short: "Decrypt LoRaWAN application payloads",
+		Long: `Decrypt LoRaWAN application payloads
+
+This command decrypts the FRMPayload of application uplinks and downlinks that
+are kept encrypted by the Application Server. The session key ID in the message
+metadata identifies the AppSKey to use.`,
+		Example: `
+  Data Uplink:
+    $ echo 'Kw==' | ttn-lw-cli lorawan decrypt-payload --input-format base64 --app-s-key CAE4B67DA7EA96144AFD687CD1EF1F23 --dev-addr 01DDD43E --f-cnt 18
+		`,
+		PersistentPreRunE: preRun(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			switch config.InputFormat {
+			case "hex", "base64":
+			default:
+				return fmt.Errorf("command supports only hex and base64 input formats")
+			}
+			var appSKey types.AES128Key
+			appSKeyStr, _ := cmd.Flags().GetString("app-s-key")
+			if err := appSKey.UnmarshalText([]byte(appSKeyStr)); err != nil {
+				return err
+			}
+			var devAddr types.DevAddr
+			devAddrStr, _ := cmd.Flags().GetString("dev-addr")
+			if err := devAddr.UnmarshalText([]byte(devAddrStr)); err != nil {
+				return err
+			}
+			fCnt, _ := cmd.Flags().GetUint32("f-cnt")
+			decrypt := crypto.DecryptUplink
+			if downlink, _ := cmd.Flags().GetBool("downlink"); downlink {
+				decrypt = crypto.DecryptDownlink
+			}
+
+			return asBulk(func(cmd *cobra.Command, args []string) error {
+				if inputDecoder == nil {
+					return nil
+				}
+				var input []byte
+				if err := inputDecoder.Decode(&input); err != nil {
+					return err
+				}
+				frmPayload, err := decrypt(appSKey, devAddr, fCnt, input)
+				if err != nil {
+					return err
+				}
+				return io.Write(os.Stdout, config.OutputFormat, &ttnpb.ApplicationUplink{
+					FCnt:       fCnt,
+					FrmPayload: frmPayload,
+				})
+			})(cmd, args)
+		},
+	}
 )
 
 func init() {
@@ -349,5 +403,11 @@ func init() {
 
 	lorawanCmd.AddCommand(lorawanDecodeCmd)
 
+	lorawanDecryptPayloadCmd.Flags().String("app-s-key", "", "LoRaWAN AppSKey")
+	lorawanDecryptPayloadCmd.Flags().String("dev-addr", "", "LoRaWAN DevAddr")
+	lorawanDecryptPayloadCmd.Flags().Uint32("f-cnt", 0, "full frame counter")
+	lorawanDecryptPayloadCmd.Flags().Bool("downlink", false, "decrypt a downlink payload")
+	lorawanCmd.AddCommand(lorawanDecryptPayloadCmd)
+
 	Root.AddCommand(lorawanCmd)
 }
@@ -680,6 +680,43 @@ var (
 			return err
 		},
 	}
+	simulateNetworkUplinkCommand = &cobra.Command{
+		Use:   "network-uplink [application-id] [device-id]",
+		Short: "Simulate an uplink message from a registered end device, encrypted by the Application Server and handled by the Network Server", //nolint:lll
+		Long: `Simulate an uplink message from a registered end device, encrypted by the Application Server and handled by the Network Server.
+
+The Application Server encrypts the payload with the application session key
+of the end device, and the Network Server computes the MIC with the network
+session keys and handles the uplink as if it was received by a gateway.
+This requires the rights to read the end device and to write uplink traffic.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			devID, err := getEndDeviceID(cmd.Flags(), args, true)
+			if err != nil {
+				return err
+			}
+			if !config.ApplicationServerEnabled {
+				return errApplicationServerDisabled.New()
+			}
+			fPort, _ := cmd.Flags().GetUint32("f-port")
+			confirmed, _ := cmd.Flags().GetBool("confirmed")
+			frmPayload, _ := cmd.Flags().GetBytesHex("frm-payload")
+
+			cc, err := api.Dial(ctx, config.ApplicationServerGRPCAddress)
+			if err != nil {
+				return err
+			}
+			res, err := ttnpb.NewAsClient(cc).SimulateNetworkUplink(ctx, &ttnpb.SimulateNetworkUplinkRequest{
+				EndDeviceIds: devID,
+				FPort:        fPort,
+				FrmPayload:   frmPayload,
+				Confirmed:    confirmed,
+			})
+			if err != nil {
+				return err
+			}
+			return io.Write(os.Stdout, config.OutputFormat, res)
+		},
+	}
 )
 
 func init() {
@@ -710,5 +747,12 @@ func init() {
 
 	simulateCommand.AddCommand(simulateApplicationUplinkCommand)
 
+	simulateNetworkUplinkCommand.Flags().AddFlagSet(endDeviceIDFlags())
+	simulateNetworkUplinkCommand.Flags().Uint32("f-port", 1, "FPort of the uplink")
+	simulateNetworkUplinkCommand.Flags().BytesHex("frm-payload", nil, "FRMPayload of the uplink (hex)")
+	simulateNetworkUplinkCommand.Flags().Bool("confirmed", false, "send a confirmed uplink")
+
+	simulateCommand.AddCommand(simulateNetworkUplinkCommand)
+
 	Root.AddCommand(simulateCommand)
 }
@@ -0,0 +1,217 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package bundle implements encrypted provisioning bundles of end devices.
+//
+// A provisioning bundle contains the identifiers, root keys and claim authentication codes
+// of end devices. Bundles are encrypted with AES-256-GCM using a key that is derived from a
+// passphrase with scrypt, so that they can be generated on air-gapped machines and transported
+// safely to be imported later.
+package bundle
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/crypto/scrypt"
+)
+
+// Version is the current version of the bundle format.
+const Version = 1
+
+const (
+	saltLength = 16
+	keyLength  = 32
+	scryptN    = 1 << 15
+	scryptR    = 8
+	scryptP    = 1
+
+	// Bounds of the KDF parameters that are accepted when opening a bundle. These prevent a crafted
+	// bundle from making the key derivation consume excessive memory or time.
+	minScryptN = 1 << 14
+	maxScryptN = 1 << 20
+	maxScryptR = 32
+	maxScryptP = 16
+)
+
+var (
+	errUnsupportedVersion = errors.DefineInvalidArgument("unsupported_version", "unsupported bundle version `{version}`")
+	errDecrypt            = errors.DefineInvalidArgument("decrypt", "decrypt bundle; check the passphrase")
+	errNoPassphrase       = errors.DefineInvalidArgument("no_passphrase", "no passphrase set")
+	errKDFParameters      = errors.DefineInvalidArgument(
+		"kdf_parameters", "invalid KDF parameters with N `{n}`, r `{r}`, p `{p}` and salt length `{salt_length}`",
+	)
+)
+
+// KDF contains the parameters of the scrypt key derivation.
+type KDF struct {
+	Salt []byte `json:"salt"`
+	N    int    `json:"n"`
+	R    int    `json:"r"`
+	P    int    `json:"p"`
+}
+
+// Envelope is the encrypted bundle as it is written to disk.
+type Envelope struct {
+	Version    int    `json:"version"`
+	KDF        KDF    `json:"kdf"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Contents is the plaintext contents of a bundle.
+type Contents struct {
+	JoinEUI string            `json:"join_eui"`
+	Devices []json.RawMessage `json:"end_devices"`
+}
+
+func (kdf KDF) validate() error {
+	if kdf.N < minScryptN || kdf.N > maxScryptN || kdf.N&(kdf.N-1) != 0 ||
+		kdf.R < 1 || kdf.R > maxScryptR ||
+		kdf.P < 1 || kdf.P > maxScryptP ||
+		len(kdf.Salt) < saltLength {
+		return errKDFParameters.WithAttributes(
+			"n", kdf.N,
+			"r", kdf.R,
+			"p", kdf.P,
+			"salt_length", len(kdf.Salt),
+		)
+	}
+	return nil
+}
+
+// header returns the serialized version and KDF parameters of the envelope.
+// The header is authenticated as additional data, so that it cannot be altered without detection.
+func (env Envelope) header() ([]byte, error) {
+	return json.Marshal(struct {
+		Version int `json:"version"`
+		KDF     KDF `json:"kdf"`
+	}{
+		Version: env.Version,
+		KDF:     env.KDF,
+	})
+}
+
+func aead(passphrase []byte, kdf KDF) (cipher.AEAD, error) {
+	if err := kdf.validate(); err != nil {
+		return nil, err
+	}
+	key, err := scrypt.Key(passphrase, kdf.Salt, kdf.N, kdf.R, kdf.P, keyLength)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Seal encrypts the end devices with the passphrase and writes the bundle to w.
+func Seal(w io.Writer, passphrase []byte, joinEUI string, devices []*ttnpb.EndDevice) error {
+	if len(passphrase) == 0 {
+		return errNoPassphrase.New()
+	}
+	contents := Contents{
+		JoinEUI: joinEUI,
+		Devices: make([]json.RawMessage, 0, len(devices)),
+	}
+	for _, dev := range devices {
+		b, err := jsonpb.TTN().Marshal(dev)
+		if err != nil {
+			return err
+		}
+		contents.Devices = append(contents.Devices, b)
+	}
+	plaintext, err := json.Marshal(contents)
+	if err != nil {
+		return err
+	}
+	env := Envelope{
+		Version: Version,
+		KDF: KDF{
+			Salt: make([]byte, saltLength),
+			N:    scryptN,
+			R:    scryptR,
+			P:    scryptP,
+		},
+	}
+	if _, err := rand.Read(env.KDF.Salt); err != nil {
+		return err
+	}
+	gcm, err := aead(passphrase, env.KDF)
+	if err != nil {
+		return err
+	}
+	env.Nonce = make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(env.Nonce); err != nil {
+		return err
+	}
+	header, err := env.header()
+	if err != nil {
+		return err
+	}
+	env.Ciphertext = gcm.Seal(nil, env.Nonce, plaintext, header)
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(env)
+}
+
+// Open reads the bundle from r and decrypts the end devices with the passphrase.
+func Open(r io.Reader, passphrase []byte) (joinEUI string, devices []*ttnpb.EndDevice, err error) {
+	if len(passphrase) == 0 {
+		return "", nil, errNoPassphrase.New()
+	}
+	var env Envelope
+	if err := json.NewDecoder(r).Decode(&env); err != nil {
+		return "", nil, err
+	}
+	if env.Version != Version {
+		return "", nil, errUnsupportedVersion.WithAttributes("version", env.Version)
+	}
+	gcm, err := aead(passphrase, env.KDF)
+	if err != nil {
+		return "", nil, err
+	}
+	if len(env.Nonce) != gcm.NonceSize() {
+		return "", nil, errDecrypt.New()
+	}
+	header, err := env.header()
+	if err != nil {
+		return "", nil, err
+	}
+	plaintext, err := gcm.Open(nil, env.Nonce, env.Ciphertext, header)
+	if err != nil {
+		return "", nil, errDecrypt.WithCause(err)
+	}
+	var contents Contents
+	if err := json.Unmarshal(plaintext, &contents); err != nil {
+		return "", nil, err
+	}
+	devices = make([]*ttnpb.EndDevice, 0, len(contents.Devices))
+	for _, b := range contents.Devices {
+		dev := &ttnpb.EndDevice{}
+		if err := jsonpb.TTN().Unmarshal(b, dev); err != nil {
+			return "", nil, err
+		}
+		devices = append(devices, dev)
+	}
+	return contents.JoinEUI, devices, nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package bundle_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/internal/bundle"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var testDevices = []*ttnpb.EndDevice{
+	{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       "test-dev",
+			JoinEui:        types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x00}.Bytes(),
+			DevEui:         types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}.Bytes(),
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{
+				Key: types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+			},
+		},
+	},
+}
+
+func seal(t *testing.T, passphrase string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := bundle.Seal(&buf, []byte(passphrase), "70B3D57ED0000000", testDevices); err != nil {
+		t.Fatalf("Failed to seal bundle: %v", err)
+	}
+	return buf.Bytes()
+}
+
+func TestSealOpen(t *testing.T) {
+	a := assertions.New(t)
+
+	b := seal(t, "correct horse battery staple")
+	joinEUI, devices, err := bundle.Open(bytes.NewReader(b), []byte("correct horse battery staple"))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(joinEUI, should.Equal, "70B3D57ED0000000")
+	a.So(devices, should.Resemble, testDevices)
+}
+
+func TestOpenWrongPassphrase(t *testing.T) {
+	a := assertions.New(t)
+
+	b := seal(t, "correct horse battery staple")
+	_, _, err := bundle.Open(bytes.NewReader(b), []byte("incorrect horse battery staple"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestOpenTampered(t *testing.T) {
+	for _, tc := range []struct {
+		Name   string
+		Tamper func(*bundle.Envelope)
+	}{
+		{
+			Name: "Ciphertext",
+			Tamper: func(env *bundle.Envelope) {
+				env.Ciphertext[0] ^= 0xff
+			},
+		},
+		{
+			Name: "Nonce",
+			Tamper: func(env *bundle.Envelope) {
+				env.Nonce[0] ^= 0xff
+			},
+		},
+		{
+			Name: "KDFParameters",
+			Tamper: func(env *bundle.Envelope) {
+				env.KDF.N <<= 1
+			},
+		},
+		{
+			Name: "ExcessiveKDFParameters",
+			Tamper: func(env *bundle.Envelope) {
+				env.KDF.N = 1 << 30
+			},
+		},
+		{
+			Name: "ShortSalt",
+			Tamper: func(env *bundle.Envelope) {
+				env.KDF.Salt = env.KDF.Salt[:4]
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+
+			var env bundle.Envelope
+			if err := json.Unmarshal(seal(t, "correct horse battery staple"), &env); err != nil {
+				t.Fatalf("Failed to unmarshal bundle: %v", err)
+			}
+			tc.Tamper(&env)
+			b, err := json.Marshal(env)
+			if err != nil {
+				t.Fatalf("Failed to marshal bundle: %v", err)
+			}
+			_, _, err = bundle.Open(bytes.NewReader(b), []byte("correct horse battery staple"))
+			a.So(errors.IsInvalidArgument(err), should.BeTrue)
+		})
+	}
+}
@@ -22,11 +22,14 @@ import (
 	shared_deviceclaimingserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/deviceclaimingserver"
 	shared_devicerepository "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/devicerepository"
 	shared_devicetemplateconverter "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/devicetemplateconverter"
+	shared_eventbridge "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/eventbridge"
 	shared_gatewayconfigurationserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayconfigurationserver"
 	shared_gatewayserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/gatewayserver"
 	shared_identityserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/identityserver"
 	shared_joinserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/joinserver"
+	shared_metering "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/metering"
 	shared_networkserver "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/networkserver"
+	shared_noc "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/noc"
 	shared_packetbrokeragent "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/packetbrokeragent"
 	shared_qrcodegenerator "go.thethings.network/lorawan-stack/v3/cmd/internal/shared/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
@@ -35,11 +38,14 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
+	"go.thethings.network/lorawan-stack/v3/pkg/eventbridge"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/noc"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 )
@@ -59,7 +65,11 @@ type Config struct {
 	PBA              packetbrokeragent.Config          `name:"pba"`
 	DR               devicerepository.Config           `name:"dr"`
 	DCS              deviceclaimingserver.Config       `name:"dcs"`
+	NOC              noc.Config                        `name:"noc"`
+	Metering         metering.Config                   `name:"metering"`
+	EventBridge      eventbridge.Config                `name:"event-bridge"`
 	OutputFormat     string                            `name:"output-format" yaml:"output-format" description:"Output format"`
+	StartProfiles    map[string][]string               `name:"start-profiles" yaml:"start-profiles" description:"Profiles of components that can be started with start --profile"` //nolint:lll
 }
 
 // DefaultConfig contains the default config for the ttn-lw-stack binary.
@@ -77,6 +87,9 @@ var DefaultConfig = Config{
 	PBA:          shared_packetbrokeragent.DefaultPacketBrokerAgentConfig,
 	DR:           shared_devicerepository.DefaultDeviceRepositoryConfig,
 	DCS:          shared_deviceclaimingserver.DefaultDeviceClaimingServerConfig,
+	NOC:          shared_noc.DefaultNOCConfig,
+	Metering:     shared_metering.DefaultMeteringConfig,
+	EventBridge:  shared_eventbridge.DefaultEventBridgeConfig,
 	OutputFormat: "json",
 }
 
@@ -0,0 +1,182 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/v3/pkg/basicstation/simulation"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errConformanceFailed = errors.DefineAborted("conformance_failed", "{count} conformance step(s) failed")
+
+var (
+	debugCommand = &cobra.Command{
+		Use:   "debug",
+		Short: "Debug commands",
+	}
+	debugLBSConformanceCommand = &cobra.Command{
+		Use:   "lbs-conformance",
+		Short: "Run a LoRa Basics Station conformance script against a running Gateway Server",
+		Long: `Run a LoRa Basics Station conformance script against a running Gateway Server.
+
+The command connects a simulated LoRa Basics Station to the LNS endpoint of the
+Gateway Server and performs the steps of the script. Each step sends a version,
+timesync, jreq, updf or dntxed message and verifies the response of the Gateway
+Server. If frequency plans are given, the router_config message is compared
+with the router configuration that is generated for these frequency plans.
+
+The gateway must be registered with the given EUI and frequency plans. Without
+a script, the version and timesync steps are performed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			address, _ := cmd.Flags().GetString("address")
+			if address == "" {
+				return errMissingFlag.WithAttributes("flag", "address")
+			}
+			eui, _ := cmd.Flags().GetString("gateway-eui")
+			if eui == "" {
+				return errMissingFlag.WithAttributes("flag", "gateway-eui")
+			}
+			var gatewayEUI types.EUI64
+			if err := gatewayEUI.UnmarshalText([]byte(eui)); err != nil {
+				return err
+			}
+			apiKey, _ := cmd.Flags().GetString("api-key")
+			timeout, _ := cmd.Flags().GetDuration("timeout")
+
+			script := simulation.DefaultScript()
+			if scriptPath, _ := cmd.Flags().GetString("script"); scriptPath != "" {
+				f, err := os.Open(scriptPath)
+				if err != nil {
+					return err
+				}
+				defer f.Close()
+				if script, err = simulation.ReadScript(f); err != nil {
+					return err
+				}
+			}
+
+			c, err := component.New(logger, &component.Config{ServiceBase: config.ServiceBase})
+			if err != nil {
+				return shared.ErrInitializeBaseComponent.WithCause(err)
+			}
+
+			var expectations simulation.Expectations
+			if fpIDs, _ := cmd.Flags().GetStringSlice("frequency-plan-id"); len(fpIDs) > 0 {
+				expectations.RouterConfig, err = expectedRouterConfig(ctx, c, script, fpIDs, cmd)
+				if err != nil {
+					return err
+				}
+			}
+
+			conf := simulation.Config{
+				Address:    address,
+				GatewayEUI: gatewayEUI,
+				APIKey:     apiKey,
+				Timeout:    timeout,
+			}
+			if strings.HasPrefix(address, "wss://") {
+				if conf.TLSConfig, err = c.GetTLSClientConfig(ctx); err != nil {
+					return err
+				}
+			}
+			client, err := simulation.Connect(ctx, conf)
+			if err != nil {
+				return err
+			}
+			defer client.Close()
+
+			results, err := simulation.Run(ctx, client, script, expectations)
+			if err != nil {
+				return err
+			}
+			failed := 0
+			for _, res := range results {
+				logger := logger.WithFields(log.Fields(
+					"step", res.Step,
+					"msgtype", res.Type,
+					"duration", res.Duration,
+				))
+				if res.Passed() {
+					logger.Info("Step passed")
+					continue
+				}
+				failed++
+				for _, msg := range res.Errors {
+					logger.WithField("error", msg).Error("Step failed")
+				}
+			}
+			if failed > 0 {
+				return errConformanceFailed.WithAttributes("count", failed)
+			}
+			logger.WithField("steps", len(results)).Info("All steps passed")
+			return nil
+		},
+	}
+)
+
+// expectedRouterConfig returns the router_config message that is expected for the frequency plans and the first
+// version step of the script.
+func expectedRouterConfig(
+	ctx context.Context, c *component.Component, script *simulation.Script, fpIDs []string, cmd *cobra.Command,
+) ([]byte, error) {
+	store, err := c.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fps := make([]*frequencyplans.FrequencyPlan, 0, len(fpIDs))
+	for _, id := range fpIDs {
+		fp, err := store.GetByID(id)
+		if err != nil {
+			return nil, err
+		}
+		fps = append(fps, fp)
+	}
+	var version simulation.VersionStep
+	for _, step := range script.Steps {
+		if step.Version != nil {
+			version = *step.Version
+			break
+		}
+	}
+	antennaGain, _ := cmd.Flags().GetInt("antenna-gain")
+	return simulation.ExpectedRouterConfig(ctx, fps, version, antennaGain)
+}
+
+func init() {
+	Root.AddCommand(debugCommand)
+
+	debugLBSConformanceCommand.Flags().String("address", "", "Base URI of the LNS endpoint (e.g. wss://localhost:8887)")
+	debugLBSConformanceCommand.Flags().String("gateway-eui", "", "Gateway EUI")
+	debugLBSConformanceCommand.Flags().String("api-key", "", "Gateway API key")
+	debugLBSConformanceCommand.Flags().String("script", "", "Path to the YAML script")
+	debugLBSConformanceCommand.Flags().StringSlice(
+		"frequency-plan-id", nil, "Frequency plan IDs of the gateway to verify the router configuration",
+	)
+	debugLBSConformanceCommand.Flags().Int("antenna-gain", 0, "Antenna gain of the gateway (dBi)")
+	debugLBSConformanceCommand.Flags().Duration(
+		"timeout", simulation.DefaultTimeout, "Time to wait for a message from the Gateway Server",
+	)
+	debugCommand.AddCommand(debugLBSConformanceCommand)
+}
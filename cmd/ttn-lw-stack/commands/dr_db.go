@@ -15,7 +15,15 @@
 package commands
 
 import (
+	"os"
+
 	"github.com/spf13/cobra"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+var errPrivateCatalogNotConfigured = errors.DefineFailedPrecondition(
+	"private_catalog_not_configured", "private vendor catalog not configured",
 )
 
 var (
@@ -32,6 +40,63 @@ var (
 			return config.DR.Initialize(ctx, config.Blob, overwrite)
 		},
 	}
+	drUploadVendorCommand = &cobra.Command{
+		Use:   "upload-vendor",
+		Short: "Upload a vendor to the private vendor catalog",
+		Long: `Upload a vendor to the private vendor catalog.
+
+The archive is a gzipped tar archive that contains the vendor.yaml file with the
+vendor definition, the index.yaml file with the models of the vendor and the
+end device definitions, profiles and codecs of the vendor, in the format of the
+public Device Repository. An existing vendor with the same ID is replaced.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog := config.DR.Store.Private.Catalog()
+			if catalog == nil {
+				return errPrivateCatalogNotConfigured.New()
+			}
+			vendorID, _ := cmd.Flags().GetString("vendor-id")
+			if vendorID == "" {
+				return errMissingFlag.WithAttributes("flag", "vendor-id")
+			}
+			archive, _ := cmd.Flags().GetString("archive")
+			if archive == "" {
+				return errMissingFlag.WithAttributes("flag", "archive")
+			}
+			f, err := os.Open(archive)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			vendor, err := catalog.Upload(vendorID, f)
+			if err != nil {
+				return err
+			}
+			logger.WithFields(log.Fields(
+				"vendor_id", vendor.ID,
+				"name", vendor.Name,
+			)).Info("Uploaded vendor")
+			return nil
+		},
+	}
+	drDeleteVendorCommand = &cobra.Command{
+		Use:   "delete-vendor",
+		Short: "Delete a vendor from the private vendor catalog",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			catalog := config.DR.Store.Private.Catalog()
+			if catalog == nil {
+				return errPrivateCatalogNotConfigured.New()
+			}
+			vendorID, _ := cmd.Flags().GetString("vendor-id")
+			if vendorID == "" {
+				return errMissingFlag.WithAttributes("flag", "vendor-id")
+			}
+			if err := catalog.Delete(vendorID); err != nil {
+				return err
+			}
+			logger.WithField("vendor_id", vendorID).Info("Deleted vendor")
+			return nil
+		},
+	}
 )
 
 func init() {
@@ -39,4 +104,11 @@ func init() {
 
 	drInitCommand.Flags().Bool("overwrite", true, "Overwrite existing index files")
 	drDBCommand.AddCommand(drInitCommand)
+
+	drUploadVendorCommand.Flags().String("vendor-id", "", "Vendor ID")
+	drUploadVendorCommand.Flags().String("archive", "", "Path to the gzipped tar archive of the vendor")
+	drDBCommand.AddCommand(drUploadVendorCommand)
+
+	drDeleteVendorCommand.Flags().String("vendor-id", "", "Vendor ID")
+	drDBCommand.AddCommand(drDeleteVendorCommand)
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/uptrace/bun"
 	"github.com/uptrace/bun/dialect/pgdialect"
 	"github.com/uptrace/bun/migrate"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	bunstore "go.thethings.network/lorawan-stack/v3/pkg/identityserver/bunstore"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	ismigrations "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store/migrations"
@@ -313,6 +314,49 @@ var (
 			return nil
 		},
 	}
+	isDBRotateGatewaySecretsCommand = &cobra.Command{
+		Use:   "rotate-gateway-secrets",
+		Short: "Re-encrypt gateway secrets with the current gateway encryption keys",
+		Long: `Re-encrypt gateway secrets with the current gateway encryption keys.
+
+Gateway secrets are encrypted with the per-gateway key if it exists in the key vault,
+and with the key configured in is.gateways.encryption-key-id otherwise.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			logger.Info("Connecting to Identity Server database...")
+			db, err := storeutil.OpenDB(ctx, config.IS.DatabaseURI)
+			if err != nil {
+				return err
+			}
+			bunDB := bun.NewDB(db, pgdialect.New())
+			st, err := bunstore.NewStore(ctx, bunDB)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				return err
+			}
+			ks, err := config.KeyVault.KeyService(ctx, nil)
+			if err != nil {
+				return err
+			}
+			if dryRun {
+				logger.Warn("Command is running in dry run mode")
+			}
+			rotated, err := identityserver.RotateGatewaySecrets(ctx, st, identityserver.GatewaySecrets{
+				KeyService:  ks,
+				KeyID:       config.IS.Gateways.EncryptionKeyID,
+				KeyIDFormat: config.IS.Gateways.EncryptionKeyIDFormat,
+			}, dryRun)
+			if err != nil {
+				return err
+			}
+			logger.WithField("count", rotated).Info("Rotated gateway secrets")
+			return nil
+		},
+	}
 	isDBEUIBlockCreationCommand = &cobra.Command{
 		Use:   "create-eui-block",
 		Short: "Create an EUI block in IS db (currently only DevEUI block supported)",
@@ -375,6 +419,8 @@ func init() {
 	isDBCommand.AddCommand(isDBMigrateCommand)
 	isDBCleanupCommand.Flags().Bool("dry-run", false, "Dry run")
 	isDBCommand.AddCommand(isDBCleanupCommand)
+	isDBRotateGatewaySecretsCommand.Flags().Bool("dry-run", false, "Dry run")
+	isDBCommand.AddCommand(isDBRotateGatewaySecretsCommand)
 	isDBEUIBlockCreationCommand.Flags().Bool("use-config", false, "Create block using values from config")
 	isDBEUIBlockCreationCommand.Flags().String("eui-type", "dev_eui", "EUI block type")
 	isDBEUIBlockCreationCommand.Flags().String("prefix", "", "Block prefix (format: 1234567800000000/32)")
@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"github.com/spf13/cobra"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	bunstore "go.thethings.network/lorawan-stack/v3/pkg/identityserver/bunstore"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+var isDBDoctorCommand = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the referential integrity of the Identity Server database",
+	Long: `Check the referential integrity of the Identity Server database.
+
+The doctor reports rows that refer to entities that no longer exist, such as
+memberships, API keys and contact info of purged entities. These can be left
+behind by partial failures or by old migrations. With --repair, the reported
+rows are deleted in a single transaction.`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		logger.Info("Connecting to Identity Server database...")
+		db, err := storeutil.OpenDB(ctx, config.IS.DatabaseURI)
+		if err != nil {
+			return err
+		}
+		bunDB := bun.NewDB(db, pgdialect.New())
+		st, err := bunstore.NewStore(ctx, bunDB)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		repair, err := cmd.Flags().GetBool("repair")
+		if err != nil {
+			return err
+		}
+		findings, err := st.CheckIntegrity(ctx, repair)
+		if err != nil {
+			return err
+		}
+		if len(findings) == 0 {
+			logger.Info("No integrity problems found")
+			return nil
+		}
+		for _, finding := range findings {
+			logger.WithFields(log.Fields(
+				"check", finding.Check,
+				"count", len(finding.IDs),
+				"ids", finding.IDs,
+			)).Warnf("Found %s", finding.Description)
+		}
+		if !repair {
+			logger.Warn("No data repaired. Run with --repair to delete the rows with integrity problems")
+			return nil
+		}
+		logger.WithField("checks", len(findings)).Info("Repaired integrity problems")
+		return nil
+	},
+}
+
+func init() {
+	isDBDoctorCommand.Flags().Bool("repair", false, "Delete the rows with integrity problems")
+	isDBCommand.AddCommand(isDBDoctorCommand)
+}
@@ -0,0 +1,55 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+)
+
+// configReloader re-reads the configuration when the component reloads its configuration.
+// The last loaded configuration is kept for the reloaders of the individual components.
+type configReloader struct {
+	mu     sync.Mutex
+	config *Config
+}
+
+// load implements component.ConfigLoader.
+func (r *configReloader) load(context.Context) (*component.Config, error) {
+	if err := mgr.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	reloaded := new(Config)
+	if err := mgr.Unmarshal(reloaded); err != nil {
+		return nil, err
+	}
+	if err := shared.InitializeFallbacks(&reloaded.ServiceBase); err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.config = reloaded
+	r.mu.Unlock()
+	return &component.Config{ServiceBase: reloaded.ServiceBase}, nil
+}
+
+// current returns the last loaded configuration.
+func (r *configReloader) current() *Config {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.config
+}
@@ -15,6 +15,7 @@
 package commands
 
 import (
+	"context"
 	"math"
 	"net/http"
 	"strings"
@@ -23,18 +24,24 @@ import (
 	"github.com/spf13/cobra"
 	"go.thethings.network/lorawan-stack/v3/cmd/internal/shared"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	ascoverageredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage/redis"
 	asdistribredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution/redis"
+	asdownlinkresultredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult/redis"
+	asfportfilterredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter/redis"
 	asioapredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/redis"
 	asiopsredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/redis"
 	asiowebredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web/redis"
 	asmetaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata/redis"
+	aspayloadschemaredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema/redis"
 	asredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	asretentionredis "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/console"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplateconverter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/eventbridge"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	events_grpc "go.thethings.network/lorawan-stack/v3/pkg/events/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayconfigurationserver"
@@ -43,8 +50,12 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
 	"go.thethings.network/lorawan-stack/v3/pkg/joinserver"
 	jsredis "go.thethings.network/lorawan-stack/v3/pkg/joinserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	meteringredis "go.thethings.network/lorawan-stack/v3/pkg/metering/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
 	nsredis "go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/noc"
 	"go.thethings.network/lorawan-stack/v3/pkg/packetbrokeragent"
 	"go.thethings.network/lorawan-stack/v3/pkg/qrcodegenerator"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
@@ -106,84 +117,48 @@ func NewJoinServerSessionKeyRegistryRedis(conf *Config) *redis.Client {
 var errUnknownComponent = errors.DefineInvalidArgument("unknown_component", "unknown component `{component}`")
 
 var startCommand = &cobra.Command{
-	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dcs|all]... [flags]",
+	Use:   "start [is|gs|ns|as|js|console|gcs|dtc|qrg|pba|dr|dcs|all]... [flags]",
 	Short: "Start The Things Stack",
+	Long: `Start The Things Stack.
+
+Components can be selected by name, or by profile with the --profile flag.
+Built-in profiles are core, identity, edge and network. Additional profiles
+can be configured with the profiles option, and may include other profiles.
+Before starting, the dependencies of the selected components are validated:
+each dependency must either be started or have a cluster address configured.`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		var start struct {
-			IdentityServer             bool
-			GatewayServer              bool
-			NetworkServer              bool
-			ApplicationServer          bool
-			JoinServer                 bool
-			Console                    bool
-			GatewayConfigurationServer bool
-			DeviceTemplateConverter    bool
-			QRCodeGenerator            bool
-			PacketBrokerAgent          bool
-			DeviceRepository           bool
-			DeviceClaimingServer       bool
+		var start startComponents
+		profiles, _ := cmd.Flags().GetStringSlice("profile")
+		for _, profile := range profiles {
+			if err := start.enableProfile(profile, config.StartProfiles, make(map[string]bool)); err != nil {
+				return err
+			}
 		}
-		startDefault := len(args) == 0
 		for _, arg := range args {
-			switch strings.ToLower(arg) {
-			case "is", "identityserver":
-				start.IdentityServer = true
-			case "gs", "gatewayserver":
-				start.GatewayServer = true
-			case "ns", "networkserver":
-				start.NetworkServer = true
-			case "as", "applicationserver":
-				start.ApplicationServer = true
-			case "js", "joinserver":
-				start.JoinServer = true
-			case "console":
-				start.Console = true
-			case "gcs":
-				start.GatewayConfigurationServer = true
-			case "dtc":
-				start.DeviceTemplateConverter = true
-			case "qrg":
-				start.QRCodeGenerator = true
-			case "pba":
-				start.PacketBrokerAgent = true
-			case "dr":
-				start.DeviceRepository = true
-			case "dcs":
-				start.DeviceClaimingServer = true
-				start.DeviceTemplateConverter = true
-				start.QRCodeGenerator = true
-
-			case "all":
-				start.IdentityServer = true
-				start.GatewayServer = true
-				start.NetworkServer = true
-				start.ApplicationServer = true
-				start.JoinServer = true
-				start.Console = true
-				start.GatewayConfigurationServer = true
-				start.DeviceTemplateConverter = true
-				start.QRCodeGenerator = true
-				start.PacketBrokerAgent = true
-				start.DeviceRepository = true
-				start.DeviceClaimingServer = true
-			default:
-				return errUnknownComponent.WithAttributes("component", arg)
+			if err := start.enable(arg); err != nil {
+				return err
+			}
+		}
+		if len(args) == 0 && len(profiles) == 0 {
+			if err := start.enable("all"); err != nil {
+				return err
 			}
 		}
+		if start.isZero() {
+			return errNoComponentsSelected.New()
+		}
 
-		if startDefault {
-			start.IdentityServer = true
-			start.GatewayServer = true
-			start.NetworkServer = true
-			start.ApplicationServer = true
-			start.JoinServer = true
-			start.Console = true
-			start.GatewayConfigurationServer = true
-			start.DeviceTemplateConverter = true
-			start.QRCodeGenerator = true
-			start.PacketBrokerAgent = true
-			start.DeviceRepository = true
-			start.DeviceClaimingServer = true
+		if skip, _ := cmd.Flags().GetBool("skip-dependency-check"); !skip {
+			missing, err := start.validate(config.ServiceBase.Cluster)
+			if err != nil {
+				return err
+			}
+			for _, dep := range missing {
+				logger.WithFields(log.Fields(
+					"component", dep.component,
+					"dependency", dep.dependency,
+				)).Warn("Dependency not started and no cluster address configured")
+			}
 		}
 
 		tp, shutdown, err := tracing.Initialize(ctx, &config.Tracing)
@@ -200,8 +175,10 @@ var startCommand = &cobra.Command{
 
 		var rootRedirect web.Registerer
 
+		reloader := &configReloader{config: config}
 		componentOptions := []component.Option{
 			component.WithTracerProvider(tp),
+			component.WithConfigLoader(reloader.load),
 		}
 
 		cookieHashKey, cookieBlockKey := config.ServiceBase.HTTP.Cookie.HashKey, config.ServiceBase.HTTP.Cookie.BlockKey
@@ -223,12 +200,19 @@ var startCommand = &cobra.Command{
 			return shared.ErrInitializeBaseComponent.WithCause(err)
 		}
 
+		if err := shared.InitializeLogSinks(c, logger, &config.Log); err != nil {
+			return err
+		}
+
 		if err := shared.InitializeEvents(ctx, c, config.ServiceBase); err != nil {
 			return err
 		}
 
 		c.RegisterGRPC(events_grpc.NewEventsServer(c.Context(), events.DefaultPubSub()))
-		c.RegisterGRPC(component.NewConfigurationServer(c))
+		configurationServer := component.NewConfigurationServer(c)
+		c.RegisterGRPC(configurationServer)
+		c.RegisterWeb(configurationServer)
+		c.RegisterGRPC(component.NewRateLimitOverrideServer(c))
 
 		if start.IdentityServer {
 			logger.Info("Setting up Identity Server")
@@ -281,6 +265,16 @@ var startCommand = &cobra.Command{
 				}
 				config.GS.Stats = gatewayConnectionStatsRegistry
 			}
+			if config.GS.UplinkDeduplication.Enable {
+				config.GS.UplinkDeduplication.Deduplicator = &gsredis.UplinkDeduplicator{
+					Redis: redis.New(config.Cache.Redis.WithNamespace("gs", "uplink-deduplication")),
+				}
+			}
+			if config.GS.SpectralScan.Enable {
+				config.GS.SpectralScan.Registry = &gsredis.SpectralScanRegistry{
+					Redis: redis.New(config.Redis.WithNamespace("gs", "spectral-scans")),
+				}
+			}
 			gs, err := gatewayserver.New(c, &config.GS)
 			if err != nil {
 				return shared.ErrInitializeGatewayServer.WithCause(err)
@@ -334,6 +328,30 @@ var startCommand = &cobra.Command{
 			config.NS.ScheduledDownlinkMatcher = &nsredis.ScheduledDownlinkMatcher{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("ns", "scheduled-downlinks")),
 			}
+			config.NS.DeviceStatusHistory.History = &nsredis.DeviceStatusHistory{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "device-status-history")),
+			}
+			config.NS.SessionHistory.History = &nsredis.SessionHistory{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "session-history")),
+			}
+			config.NS.PingSlotRetries.Attempts = &nsredis.PingSlotAttempts{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "ping-slot-attempts")),
+			}
+			config.NS.AdaptiveRx.Failures = &nsredis.DownlinkFailures{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "downlink-failures")),
+			}
+			config.NS.ADRDownlinkLoss.Outcomes = &nsredis.ConfirmedDownlinkOutcomes{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "confirmed-downlink-outcomes")),
+			}
+			config.NS.MICFailures.History = &nsredis.MICFailureHistory{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "mic-failures")),
+			}
+			config.NS.DevAddrBlocks.Registry = &nsredis.DevAddrBlockRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "dev-addr-blocks")),
+			}
+			config.NS.GatewayMaintenance.Registry = &nsredis.GatewayMaintenanceRegistry{
+				Redis: redis.New(config.Redis.WithNamespace("ns", "gateway-maintenance")),
+			}
 			ns, err := networkserver.New(c, &config.NS)
 			if err != nil {
 				return shared.ErrInitializeNetworkServer.WithCause(err)
@@ -359,6 +377,46 @@ var startCommand = &cobra.Command{
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
 			config.AS.Devices = deviceRegistry
+			if config.AS.UplinkPipeline.Enable {
+				uplinkQueue := &asredis.UplinkQueue{
+					Redis:            redis.New(config.Redis.WithNamespace("as", "uplink-pipeline")),
+					MaxLen:           config.AS.UplinkPipeline.QueueSize,
+					Group:            "as",
+					MinIdle:          config.AS.UplinkPipeline.RetryInterval,
+					StreamBlockLimit: redis.DefaultStreamBlockLimit,
+				}
+				if err := uplinkQueue.Init(ctx, applicationserver.UplinkStages...); err != nil {
+					return shared.ErrInitializeApplicationServer.WithCause(err)
+				}
+				config.AS.UplinkPipeline.Queue = uplinkQueue
+			}
+			if config.AS.Coverage.Enable {
+				config.AS.Coverage.Registry = &ascoverageredis.Registry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "coverage")),
+					TTL:   config.AS.Coverage.TTL,
+				}
+			}
+			if config.AS.Retention.Enable {
+				config.AS.Retention.Registry = &asretentionredis.Registry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "retention")),
+				}
+			}
+			if config.AS.FPortFilters.Enable {
+				config.AS.FPortFilters.Registry = &asfportfilterredis.Registry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "fport-filters")),
+				}
+			}
+			if config.AS.PayloadSchema.Enable {
+				config.AS.PayloadSchema.Registry = &aspayloadschemaredis.Registry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "payload-schema")),
+				}
+			}
+			if config.AS.DownlinkResults.Enable {
+				config.AS.DownlinkResults.Registry = &asdownlinkresultredis.Registry{
+					Redis: redis.New(config.Redis.WithNamespace("as", "downlink-results")),
+					TTL:   config.AS.DownlinkResults.TTL,
+				}
+			}
 			config.AS.Distribution.Global.PubSub = &asdistribredis.PubSub{
 				Redis: redis.New(config.Cache.Redis.WithNamespace("as", "traffic")),
 			}
@@ -408,7 +466,9 @@ var startCommand = &cobra.Command{
 			if err != nil {
 				return shared.ErrInitializeApplicationServer.WithCause(err)
 			}
-			_ = as
+			c.RegisterReloader("webhook_templates", func(ctx context.Context) error {
+				return as.ReloadWebhookTemplates(ctx, reloader.current().AS.Webhooks.Templates)
+			})
 		}
 
 		if start.JoinServer {
@@ -526,6 +586,36 @@ var startCommand = &cobra.Command{
 			_ = dcs
 		}
 
+		if config.NOC.Enable {
+			logger.Info("Setting up Network Operations Center")
+			nc, err := noc.New(c, &config.NOC)
+			if err != nil {
+				return shared.ErrInitializeNOC.WithCause(err)
+			}
+			_ = nc
+		}
+
+		if config.Metering.Enable {
+			logger.Info("Setting up usage metering")
+			m, err := metering.New(c, &config.Metering, &meteringredis.UsageStore{
+				Redis: redis.New(config.Cache.Redis.WithNamespace("metering")),
+				TTL:   config.Metering.Retention,
+			})
+			if err != nil {
+				return shared.ErrInitializeMetering.WithCause(err)
+			}
+			_ = m
+		}
+
+		if config.EventBridge.Enable {
+			logger.Info("Setting up events bridge")
+			eb, err := eventbridge.New(c, &config.EventBridge)
+			if err != nil {
+				return shared.ErrInitializeEventBridge.WithCause(err)
+			}
+			_ = eb
+		}
+
 		if rootRedirect != nil {
 			c.RegisterWeb(rootRedirect)
 		}
@@ -547,5 +637,7 @@ func isZeros(buf []byte) bool {
 }
 
 func init() {
+	startCommand.Flags().StringSlice("profile", nil, "profiles of components to start")
+	startCommand.Flags().Bool("skip-dependency-check", false, "start without validating component dependencies")
 	Root.AddCommand(startCommand)
 }
@@ -0,0 +1,237 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package commands
+
+import (
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errUnknownProfile       = errors.DefineInvalidArgument("unknown_profile", "unknown profile `{profile}`")
+	errRecursiveProfile     = errors.DefineInvalidArgument("recursive_profile", "profile `{profile}` includes itself")
+	errMissingDependency    = errors.DefineFailedPrecondition("missing_dependency", "component `{component}` requires `{dependency}`, which is not started and has no cluster address configured")
+	errNoComponentsSelected = errors.DefineInvalidArgument("no_components_selected", "no components selected")
+)
+
+// startComponents are the components to start.
+type startComponents struct {
+	IdentityServer             bool
+	GatewayServer              bool
+	NetworkServer              bool
+	ApplicationServer          bool
+	JoinServer                 bool
+	Console                    bool
+	GatewayConfigurationServer bool
+	DeviceTemplateConverter    bool
+	QRCodeGenerator            bool
+	PacketBrokerAgent          bool
+	DeviceRepository           bool
+	DeviceClaimingServer       bool
+}
+
+// builtinStartProfiles are the profiles that are available without configuration.
+var builtinStartProfiles = map[string][]string{
+	"core":     {"is", "gs", "ns", "as", "js", "console"},
+	"identity": {"is", "console"},
+	"edge":     {"gs", "ns", "as", "js"},
+	"network":  {"gs", "ns", "pba"},
+}
+
+// enable enables the component with the given name.
+func (s *startComponents) enable(name string) error {
+	switch name = strings.ToLower(name); name {
+	case "is", "identityserver":
+		s.IdentityServer = true
+	case "gs", "gatewayserver":
+		s.GatewayServer = true
+	case "ns", "networkserver":
+		s.NetworkServer = true
+	case "as", "applicationserver":
+		s.ApplicationServer = true
+	case "js", "joinserver":
+		s.JoinServer = true
+	case "console":
+		s.Console = true
+	case "gcs":
+		s.GatewayConfigurationServer = true
+	case "dtc":
+		s.DeviceTemplateConverter = true
+	case "qrg":
+		s.QRCodeGenerator = true
+	case "pba":
+		s.PacketBrokerAgent = true
+	case "dr":
+		s.DeviceRepository = true
+	case "dcs":
+		s.DeviceClaimingServer = true
+		s.DeviceTemplateConverter = true
+		s.QRCodeGenerator = true
+	case "all":
+		*s = startComponents{
+			IdentityServer:             true,
+			GatewayServer:              true,
+			NetworkServer:              true,
+			ApplicationServer:          true,
+			JoinServer:                 true,
+			Console:                    true,
+			GatewayConfigurationServer: true,
+			DeviceTemplateConverter:    true,
+			QRCodeGenerator:            true,
+			PacketBrokerAgent:          true,
+			DeviceRepository:           true,
+			DeviceClaimingServer:       true,
+		}
+	default:
+		return errUnknownComponent.WithAttributes("component", name)
+	}
+	return nil
+}
+
+// enableProfile enables the components of the profile with the given name.
+// Configured profiles take precedence over built-in profiles, and may include other profiles.
+func (s *startComponents) enableProfile(name string, profiles map[string][]string, seen map[string]bool) error {
+	components, ok := profiles[name]
+	if !ok {
+		components, ok = builtinStartProfiles[name]
+	}
+	if !ok {
+		return errUnknownProfile.WithAttributes("profile", name)
+	}
+	if seen[name] {
+		return errRecursiveProfile.WithAttributes("profile", name)
+	}
+	seen[name] = true
+	defer delete(seen, name)
+	for _, component := range components {
+		err := s.enable(component)
+		if errors.Resemble(err, errUnknownComponent) {
+			err = s.enableProfile(component, profiles, seen)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s startComponents) isZero() bool {
+	return s == startComponents{}
+}
+
+// startDependency is a dependency of a component on another component.
+type startDependency struct {
+	component  string
+	dependency string
+	address    func(cluster.Config) string
+	// required indicates that the component can not function without the dependency.
+	// Dependencies that are not required only log a warning when missing.
+	required bool
+}
+
+var startDependencies = func() []startDependency {
+	identityServer := func(component string) startDependency {
+		return startDependency{
+			component:  component,
+			dependency: "is",
+			address:    func(c cluster.Config) string { return c.IdentityServer },
+			required:   true,
+		}
+	}
+	return []startDependency{
+		identityServer("gs"),
+		identityServer("ns"),
+		identityServer("as"),
+		identityServer("js"),
+		identityServer("console"),
+		identityServer("gcs"),
+		identityServer("dcs"),
+		{
+			component:  "gs",
+			dependency: "ns",
+			address:    func(c cluster.Config) string { return c.NetworkServer },
+		},
+		{
+			component:  "ns",
+			dependency: "js",
+			address:    func(c cluster.Config) string { return c.JoinServer },
+		},
+		{
+			component:  "ns",
+			dependency: "gs",
+			address:    func(c cluster.Config) string { return c.GatewayServer },
+		},
+		{
+			component:  "as",
+			dependency: "ns",
+			address:    func(c cluster.Config) string { return c.NetworkServer },
+		},
+		{
+			component:  "pba",
+			dependency: "ns",
+			address:    func(c cluster.Config) string { return c.NetworkServer },
+			required:   true,
+		},
+		{
+			component:  "gcs",
+			dependency: "gs",
+			address:    func(c cluster.Config) string { return c.GatewayServer },
+		},
+	}
+}()
+
+func (s startComponents) started(component string) bool {
+	switch component {
+	case "is":
+		return s.IdentityServer
+	case "gs":
+		return s.GatewayServer
+	case "ns":
+		return s.NetworkServer
+	case "as":
+		return s.ApplicationServer
+	case "js":
+		return s.JoinServer
+	case "console":
+		return s.Console
+	case "gcs":
+		return s.GatewayConfigurationServer
+	case "dcs":
+		return s.DeviceClaimingServer
+	case "pba":
+		return s.PacketBrokerAgent
+	}
+	return false
+}
+
+// validate checks that the dependencies of the components are either started or reachable in the cluster.
+// It returns an error for the first missing required dependency, and the missing optional dependencies.
+func (s startComponents) validate(c cluster.Config) (missing []startDependency, err error) {
+	for _, dep := range startDependencies {
+		if !s.started(dep.component) || s.started(dep.dependency) || dep.address(c) != "" {
+			continue
+		}
+		if dep.required {
+			return nil, errMissingDependency.WithAttributes(
+				"component", dep.component,
+				"dependency", dep.dependency,
+			)
+		}
+		missing = append(missing, dep)
+	}
+	return missing, nil
+}
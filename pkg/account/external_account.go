@@ -0,0 +1,463 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"encoding/gob"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web/cookie"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/endpoints"
+)
+
+// externalAccountClaims are the attributes of an account at an external identity provider.
+type externalAccountClaims struct {
+	Subject       string
+	Email         string
+	EmailVerified bool
+	Name          string
+	Groups        []string
+}
+
+// externalAccountProvider is an external identity provider of which users can link accounts.
+type externalAccountProvider struct {
+	endpoint    oauth2.Endpoint
+	scopes      []string
+	userInfoURL string
+	// claims decodes the attributes of the account from the user info response.
+	claims func(r io.Reader) (*externalAccountClaims, error)
+}
+
+var externalAccountProviders = map[string]externalAccountProvider{
+	"github": {
+		endpoint:    endpoints.GitHub,
+		scopes:      []string{"read:user"},
+		userInfoURL: "https://api.github.com/user",
+		claims: func(r io.Reader) (*externalAccountClaims, error) {
+			var info struct {
+				ID int64 `json:"id"`
+			}
+			if err := json.NewDecoder(r).Decode(&info); err != nil {
+				return nil, err
+			}
+			if info.ID == 0 {
+				return &externalAccountClaims{}, nil
+			}
+			return &externalAccountClaims{Subject: strconv.FormatInt(info.ID, 10)}, nil
+		},
+	},
+	"google": {
+		endpoint:    endpoints.Google,
+		scopes:      []string{"openid"},
+		userInfoURL: "https://openidconnect.googleapis.com/v1/userinfo",
+		claims: func(r io.Reader) (*externalAccountClaims, error) {
+			var info struct {
+				Sub string `json:"sub"`
+			}
+			if err := json.NewDecoder(r).Decode(&info); err != nil {
+				return nil, err
+			}
+			return &externalAccountClaims{Subject: info.Sub}, nil
+		},
+	},
+}
+
+// getExternalAccountProvider returns the external identity provider and its client configuration.
+// The generic OpenID Connect provider is built from the configuration.
+func getExternalAccountProvider(
+	config oauth.ExternalAccountsConfig, provider string,
+) (externalAccountProvider, oauth.ExternalAccountProviderConfig, bool) {
+	switch provider {
+	case "github":
+		return externalAccountProviders[provider], config.GitHub, config.GitHub.Enabled()
+	case "google":
+		return externalAccountProviders[provider], config.Google, config.Google.Enabled()
+	case oidcProvider:
+		return newOIDCProvider(config.OIDC), config.OIDC.ExternalAccountProviderConfig, config.OIDC.Enabled()
+	default:
+		return externalAccountProvider{}, oauth.ExternalAccountProviderConfig{}, false
+	}
+}
+
+const (
+	externalAccountActionLink  = "link"
+	externalAccountActionLogin = "login"
+)
+
+// externalAccountState is the shape of the state for the external account flow.
+type externalAccountState struct {
+	Secret   string
+	Provider string
+	Action   string
+	UserID   string
+	Next     string
+}
+
+func init() {
+	gob.Register(externalAccountState{})
+}
+
+func (s *server) externalAccountStateCookie() *cookie.Cookie {
+	return &cookie.Cookie{
+		Name:     "_external_account_state",
+		HTTPOnly: true,
+		Path:     s.config.Mount,
+		MaxAge:   10 * time.Minute,
+	}
+}
+
+var (
+	errExternalAccountProviderNotFound = errors.DefineNotFound(
+		"external_account_provider_not_found", "external account provider `{provider}` not found",
+	)
+	errNoExternalAccountState = errors.DefineInvalidArgument(
+		"no_external_account_state", "no external account state",
+	)
+	errInvalidExternalAccountState = errors.DefineInvalidArgument(
+		"invalid_external_account_state", "invalid external account state",
+	)
+	errExternalAccountAuthorization = errors.DefinePermissionDenied(
+		"external_account_authorization", "authorization by external account provider `{provider}` failed",
+	)
+	errExternalAccountUserInfo = errors.DefineUnavailable(
+		"external_account_user_info", "get user info from external account provider `{provider}`",
+	)
+	errExternalAccountLinkedToOtherUser = errors.DefineAlreadyExists(
+		"external_account_linked_to_other_user", "external account of provider `{provider}` is linked to another user",
+	)
+	errUnlinkLastExternalAccount = errors.DefineFailedPrecondition(
+		"unlink_last_external_account",
+		"can not unlink the last external account of a user without password",
+	)
+)
+
+func (s *server) externalAccountOAuth2Config(
+	ctx context.Context, provider string,
+) (*oauth2.Config, error) {
+	config := s.configFromContext(ctx)
+	p, providerConfig, ok := getExternalAccountProvider(config.ExternalAccounts, provider)
+	if !ok {
+		return nil, errExternalAccountProviderNotFound.WithAttributes("provider", provider)
+	}
+	return &oauth2.Config{
+		ClientID:     providerConfig.ClientID,
+		ClientSecret: providerConfig.ClientSecret,
+		Endpoint:     p.endpoint,
+		Scopes:       p.scopes,
+		RedirectURL: strings.TrimSuffix(config.UI.CanonicalURL, "/") +
+			"/api/auth/external/" + provider + "/callback",
+	}, nil
+}
+
+func (s *server) redirectToExternalAccountProvider(
+	w http.ResponseWriter, r *http.Request, action string, userIDs *ttnpb.UserIdentifiers,
+) {
+	provider := mux.Vars(r)["provider"]
+	conf, err := s.externalAccountOAuth2Config(r.Context(), provider)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	next := r.URL.Query().Get(nextKey)
+	// Only allow relative paths.
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") {
+		next = ""
+	}
+	state := externalAccountState{
+		Secret:   random.String(16),
+		Provider: provider,
+		Action:   action,
+		UserID:   userIDs.GetUserId(),
+		Next:     next,
+	}
+	if err := s.externalAccountStateCookie().Set(w, r, state); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	http.Redirect(w, r, conf.AuthCodeURL(state.Secret), http.StatusFound)
+}
+
+// LinkExternalAccount redirects the logged in user to the external identity provider, in order to
+// link the external account to the user.
+func (s *server) LinkExternalAccount(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	s.redirectToExternalAccountProvider(w, r, externalAccountActionLink, session.GetUserIds())
+}
+
+// ExternalAccountLogin redirects the user to the external identity provider, in order to login
+// with a linked external account.
+func (s *server) ExternalAccountLogin(w http.ResponseWriter, r *http.Request) {
+	s.redirectToExternalAccountProvider(w, r, externalAccountActionLogin, nil)
+}
+
+// externalAccountClaims exchanges the authorization code and returns the attributes of the
+// account at the external identity provider.
+func (s *server) externalAccountClaims(
+	ctx context.Context, provider, code string,
+) (*externalAccountClaims, error) {
+	conf, err := s.externalAccountOAuth2Config(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+	p, _, _ := getExternalAccountProvider(s.configFromContext(ctx).ExternalAccounts, provider)
+	client, err := s.c.HTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ctx = context.WithValue(ctx, oauth2.HTTPClient, client)
+	token, err := conf.Exchange(ctx, code)
+	if err != nil {
+		return nil, errExternalAccountAuthorization.WithAttributes("provider", provider).WithCause(err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.userInfoURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	res, err := conf.Client(ctx, token).Do(req)
+	if err != nil {
+		return nil, errExternalAccountUserInfo.WithAttributes("provider", provider).WithCause(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errExternalAccountUserInfo.WithAttributes("provider", provider)
+	}
+	claims, err := p.claims(res.Body)
+	if err != nil {
+		return nil, errExternalAccountUserInfo.WithAttributes("provider", provider).WithCause(err)
+	}
+	if claims.Subject == "" {
+		return nil, errExternalAccountUserInfo.WithAttributes("provider", provider)
+	}
+	return claims, nil
+}
+
+// ExternalAccountCallback handles the callback of the external identity provider. Depending on the
+// state, it links the external account to the logged in user, or logs in the user that the external
+// account is linked to.
+func (s *server) ExternalAccountCallback(w http.ResponseWriter, r *http.Request) {
+	provider := mux.Vars(r)["provider"]
+	stateCookie := s.externalAccountStateCookie()
+	var state externalAccountState
+	ok, err := stateCookie.Get(w, r, &state)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if !ok {
+		webhandlers.Error(w, r, errNoExternalAccountState.New())
+		return
+	}
+	stateCookie.Remove(w, r)
+	query := r.URL.Query()
+	if state.Secret != query.Get("state") || state.Provider != provider {
+		webhandlers.Error(w, r, errInvalidExternalAccountState.New())
+		return
+	}
+	if query.Get("error") != "" || query.Get("code") == "" {
+		webhandlers.Error(w, r, errExternalAccountAuthorization.WithAttributes("provider", provider))
+		return
+	}
+	ctx := r.Context()
+	claims, err := s.externalAccountClaims(ctx, provider, query.Get("code"))
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	subject := claims.Subject
+
+	switch state.Action {
+	case externalAccountActionLink:
+		r, session, err := s.session.Get(w, r)
+		if err != nil {
+			webhandlers.Error(w, r, errUnauthenticated.WithCause(err))
+			return
+		}
+		userIDs := session.GetUserIds()
+		if userIDs.GetUserId() != state.UserID {
+			webhandlers.Error(w, r, errInvalidExternalAccountState.New())
+			return
+		}
+		err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+			existing, err := st.GetExternalAccount(ctx, provider, subject)
+			if err == nil {
+				if existing.UserIDs.GetUserId() != userIDs.GetUserId() {
+					return errExternalAccountLinkedToOtherUser.WithAttributes("provider", provider)
+				}
+				return nil
+			}
+			if !errors.IsNotFound(err) {
+				return err
+			}
+			_, err = st.CreateExternalAccount(ctx, &store.ExternalAccount{
+				UserIDs:  userIDs,
+				Provider: provider,
+				Subject:  subject,
+			})
+			return err
+		})
+		if err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+	case externalAccountActionLogin:
+		var userIDs *ttnpb.UserIdentifiers
+		config := s.configFromContext(ctx).ExternalAccounts
+		err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+			var user *ttnpb.User
+			externalAccount, err := st.GetExternalAccount(ctx, provider, subject)
+			switch {
+			case err == nil:
+				// Make sure that the user still exists.
+				user, err = st.GetUser(ctx, externalAccount.UserIDs, []string{"ids", "admin"})
+				if err != nil {
+					return err
+				}
+			case errors.IsNotFound(err) && provider == oidcProvider && config.OIDC.Provisioning.Enabled:
+				user, err = provisionOIDCUser(ctx, st, config.OIDC, claims)
+				if err != nil {
+					return err
+				}
+			default:
+				return err
+			}
+			if provider == oidcProvider {
+				if err := syncOIDCAdmin(ctx, st, config.OIDC, user, claims); err != nil {
+					return err
+				}
+			}
+			userIDs = user.GetIds()
+			return nil
+		})
+		if err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		if err := s.evaluateLogin(r, userIDs, LoginMethodExternalAccount); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		if err := s.CreateUserSession(w, r, userIDs); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+	default:
+		webhandlers.Error(w, r, errInvalidExternalAccountState.New())
+		return
+	}
+
+	next := state.Next
+	if next == "" {
+		next = s.config.Mount
+	}
+	http.Redirect(w, r, next, http.StatusFound)
+}
+
+type externalAccount struct {
+	Provider  string    `json:"provider"`
+	Subject   string    `json:"subject"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// ListExternalAccounts lists the external accounts that are linked to the logged in user.
+func (s *server) ListExternalAccounts(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	var linked []*store.ExternalAccount
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) (err error) {
+		linked, err = st.ListExternalAccounts(ctx, session.GetUserIds())
+		return err
+	})
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	accounts := make([]externalAccount, len(linked))
+	for i, account := range linked {
+		accounts[i] = externalAccount{
+			Provider:  account.Provider,
+			Subject:   account.Subject,
+			CreatedAt: account.CreatedAt,
+		}
+	}
+	webhandlers.JSON(w, r, struct {
+		ExternalAccounts []externalAccount `json:"external_accounts"`
+	}{
+		ExternalAccounts: accounts,
+	})
+}
+
+// UnlinkExternalAccount unlinks the external account of the provider from the logged in user.
+// Users without password can not unlink their last external account, as they would no longer
+// be able to login.
+func (s *server) UnlinkExternalAccount(w http.ResponseWriter, r *http.Request) {
+	r, session, err := s.session.Get(w, r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ctx := r.Context()
+	provider := mux.Vars(r)["provider"]
+	userIDs := session.GetUserIds()
+	err = s.store.Transact(ctx, func(ctx context.Context, st store.Interface) error {
+		user, err := st.GetUser(ctx, userIDs, []string{"password"})
+		if err != nil {
+			return err
+		}
+		if user.Password == "" {
+			linked, err := st.ListExternalAccounts(ctx, userIDs)
+			if err != nil {
+				return err
+			}
+			var others int
+			for _, account := range linked {
+				if account.Provider != provider {
+					others++
+				}
+			}
+			if others == 0 {
+				return errUnlinkLastExternalAccount.New()
+			}
+		}
+		return st.DeleteExternalAccount(ctx, userIDs, provider)
+	})
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
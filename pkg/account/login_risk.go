@@ -0,0 +1,154 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"net"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	evtLoginStepUp = events.Define(
+		"oauth.user.login.step_up", "login user requires step-up authentication",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_ALL),
+		events.WithClientInfoFromContext(),
+	)
+	evtLoginDenied = events.Define(
+		"oauth.user.login.denied", "login user denied",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_ALL),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errLoginDenied = errors.DefinePermissionDenied(
+		"login_denied", "login denied",
+	)
+	errLoginStepUpRequired = errors.DefineUnauthenticated(
+		"login_step_up_required", "additional verification required, log in with a login token",
+	)
+)
+
+// Login methods of login attempts.
+const (
+	LoginMethodPassword        = "password"
+	LoginMethodToken           = "token"
+	LoginMethodExternalAccount = "external_account"
+)
+
+// LoginAttempt is a login attempt with valid credentials that is evaluated by the LoginRiskEvaluator.
+type LoginAttempt struct {
+	UserIDs *ttnpb.UserIdentifiers
+	// Method is the login method, which is one of the LoginMethod constants.
+	Method    string
+	IPAddress string
+	UserAgent string
+	// Sessions are the current sessions of the user, which are the history of the logins of the user.
+	Sessions []*ttnpb.UserSession
+}
+
+// LoginRiskDecision is the decision of the LoginRiskEvaluator on a login attempt.
+type LoginRiskDecision int
+
+const (
+	// LoginRiskAllow allows the login.
+	LoginRiskAllow LoginRiskDecision = iota
+	// LoginRiskStepUp requires the user to log in with a login token that is sent by email.
+	// Logins with a login token are allowed.
+	LoginRiskStepUp
+	// LoginRiskDeny denies the login.
+	LoginRiskDeny
+)
+
+// LoginRiskEvaluator evaluates the risk of login attempts, so that operators can integrate their fraud or risk
+// systems, for example to detect high login rates or logins from unusual locations.
+type LoginRiskEvaluator interface {
+	EvaluateLogin(ctx context.Context, attempt *LoginAttempt) (LoginRiskDecision, error)
+}
+
+// LoginRiskEvaluatorFunc is a function that implements LoginRiskEvaluator.
+type LoginRiskEvaluatorFunc func(ctx context.Context, attempt *LoginAttempt) (LoginRiskDecision, error)
+
+// EvaluateLogin implements LoginRiskEvaluator.
+func (f LoginRiskEvaluatorFunc) EvaluateLogin(ctx context.Context, attempt *LoginAttempt) (LoginRiskDecision, error) {
+	return f(ctx, attempt)
+}
+
+// SetLoginRiskEvaluator sets the evaluator of the risk of login attempts.
+func (s *server) SetLoginRiskEvaluator(evaluator LoginRiskEvaluator) {
+	s.loginRiskEvaluator = evaluator
+}
+
+func remoteIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
+
+// evaluateLogin evaluates the risk of the login of the user, after the credentials of the user are verified.
+// If the evaluator fails, the login is allowed, so that logins do not depend on the availability of the evaluator.
+func (s *server) evaluateLogin(r *http.Request, userIDs *ttnpb.UserIdentifiers, method string) error {
+	evaluator := s.loginRiskEvaluator
+	if evaluator == nil {
+		return nil
+	}
+	ctx := r.Context()
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"user_id", userIDs.GetUserId(),
+		"method", method,
+	))
+	attempt := &LoginAttempt{
+		UserIDs:   userIDs,
+		Method:    method,
+		IPAddress: remoteIP(r),
+		UserAgent: r.UserAgent(),
+	}
+	err := s.store.Transact(ctx, func(ctx context.Context, st store.Interface) (err error) {
+		attempt.Sessions, err = st.FindSessions(ctx, userIDs)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	decision, err := evaluator.EvaluateLogin(ctx, attempt)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to evaluate login risk")
+		return nil
+	}
+	switch decision {
+	case LoginRiskStepUp:
+		if method == LoginMethodToken {
+			return nil
+		}
+		logger.Info("Login requires step-up authentication")
+		events.Publish(evtLoginStepUp.NewWithIdentifiersAndData(ctx, userIDs, nil))
+		return errLoginStepUpRequired.New()
+	case LoginRiskDeny:
+		logger.Info("Login denied")
+		events.Publish(evtLoginDenied.NewWithIdentifiersAndData(ctx, userIDs, nil))
+		return errLoginDenied.New()
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,295 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/account/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// oidcProvider is the name of the generic OpenID Connect provider.
+const oidcProvider = "oidc"
+
+func newOIDCProvider(config oauth.OIDCProviderConfig) externalAccountProvider {
+	return externalAccountProvider{
+		endpoint: oauth2.Endpoint{
+			AuthURL:  config.AuthURL,
+			TokenURL: config.TokenURL,
+		},
+		scopes:      config.Scopes,
+		userInfoURL: config.UserInfoURL,
+		claims: func(r io.Reader) (*externalAccountClaims, error) {
+			return decodeOIDCClaims(r, config.Claims)
+		},
+	}
+}
+
+// lookupOIDCClaim returns the value of the claim. Nested claims can be referenced with dots,
+// for example `realm_access.roles`.
+func lookupOIDCClaim(info map[string]any, name string) (any, bool) {
+	if name == "" {
+		return nil, false
+	}
+	var v any = info
+	for _, part := range strings.Split(name, ".") {
+		m, ok := v.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+		if v, ok = m[part]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+func oidcClaimString(info map[string]any, name string) string {
+	v, _ := lookupOIDCClaim(info, name)
+	switch v := v.(type) {
+	case string:
+		return v
+	case json.Number:
+		return v.String()
+	default:
+		return ""
+	}
+}
+
+// oidcClaimBool returns the boolean value of the claim. Some providers encode booleans as strings.
+func oidcClaimBool(info map[string]any, name string) bool {
+	v, _ := lookupOIDCClaim(info, name)
+	switch v := v.(type) {
+	case bool:
+		return v
+	case string:
+		b, _ := strconv.ParseBool(v)
+		return b
+	default:
+		return false
+	}
+}
+
+// oidcClaimStrings returns the values of the claim. Single values are returned as a slice.
+func oidcClaimStrings(info map[string]any, name string) []string {
+	v, _ := lookupOIDCClaim(info, name)
+	switch v := v.(type) {
+	case string:
+		return []string{v}
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				values = append(values, s)
+			}
+		}
+		return values
+	default:
+		return nil
+	}
+}
+
+func decodeOIDCClaims(r io.Reader, config oauth.OIDCClaimsConfig) (*externalAccountClaims, error) {
+	dec := json.NewDecoder(r)
+	dec.UseNumber()
+	var info map[string]any
+	if err := dec.Decode(&info); err != nil {
+		return nil, err
+	}
+	return &externalAccountClaims{
+		Subject:       oidcClaimString(info, config.Subject),
+		Email:         strings.ToLower(oidcClaimString(info, config.Email)),
+		EmailVerified: oidcClaimBool(info, config.EmailVerified),
+		Name:          oidcClaimString(info, config.Name),
+		Groups:        oidcClaimStrings(info, config.Groups),
+	}, nil
+}
+
+// isOIDCAdmin returns whether the claims contain one of the admin groups.
+func isOIDCAdmin(config oauth.OIDCProviderConfig, claims *externalAccountClaims) bool {
+	for _, group := range claims.Groups {
+		for _, adminGroup := range config.AdminGroups {
+			if group == adminGroup {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// syncOIDCAdmin updates the admin status of the user to the group claims, if admin groups are configured.
+func syncOIDCAdmin(
+	ctx context.Context, st store.Interface, config oauth.OIDCProviderConfig, user *ttnpb.User, claims *externalAccountClaims,
+) error {
+	if len(config.AdminGroups) == 0 {
+		return nil
+	}
+	admin := isOIDCAdmin(config, claims)
+	if user.Admin == admin {
+		return nil
+	}
+	user.Admin = admin
+	_, err := st.UpdateUser(ctx, &ttnpb.User{
+		Ids:   user.GetIds(),
+		Admin: admin,
+	}, []string{"admin"})
+	return err
+}
+
+var (
+	errOIDCProvisioningEmail = errors.DefinePermissionDenied(
+		"oidc_provisioning_email", "external account has no verified email address",
+	)
+	errOIDCProvisioningEmailDomain = errors.DefinePermissionDenied(
+		"oidc_provisioning_email_domain", "email domain `{domain}` is not allowed",
+	)
+	errOIDCProvisioningEmailTaken = errors.DefineAlreadyExists(
+		"oidc_provisioning_email_taken",
+		"a user with the email address of the external account already exists, login to link the account",
+	)
+	errOIDCProvisioningUserID = errors.DefineAborted(
+		"oidc_provisioning_user_id", "no available user ID for email address",
+	)
+)
+
+// checkOIDCProvisioning checks whether a user can be provisioned for the claims.
+func checkOIDCProvisioning(config oauth.OIDCProvisioningConfig, claims *externalAccountClaims) error {
+	if claims.Email == "" || (config.RequireVerifiedEmail && !claims.EmailVerified) {
+		return errOIDCProvisioningEmail.New()
+	}
+	if len(config.AllowedEmailDomains) == 0 {
+		return nil
+	}
+	domain := claims.Email[strings.LastIndex(claims.Email, "@")+1:]
+	for _, allowed := range config.AllowedEmailDomains {
+		if strings.EqualFold(domain, allowed) {
+			return nil
+		}
+	}
+	return errOIDCProvisioningEmailDomain.WithAttributes("domain", domain)
+}
+
+var invalidUserIDChars = regexp.MustCompile("[^a-z0-9]+")
+
+const maxUserIDLength = 36
+
+// oidcUserID derives a user ID from the local part of the email address. If suffix is set,
+// a random suffix is appended to avoid conflicts with existing users.
+func oidcUserID(email string, suffix bool) string {
+	local := strings.ToLower(email)
+	if i := strings.LastIndex(local, "@"); i >= 0 {
+		local = local[:i]
+	}
+	id := strings.Trim(invalidUserIDChars.ReplaceAllString(local, "-"), "-")
+	maxLength := maxUserIDLength
+	if suffix {
+		maxLength -= 7
+	}
+	if len(id) > maxLength {
+		id = strings.TrimRight(id[:maxLength], "-")
+	}
+	if len(id) < 2 {
+		id = "user"
+	}
+	if suffix {
+		id = fmt.Sprintf("%s-%s", id, hex.EncodeToString(random.Bytes(3)))
+	}
+	return id
+}
+
+// userIDTaken returns whether the user ID is used by an existing or deleted user.
+func userIDTaken(ctx context.Context, st store.Interface, ids *ttnpb.UserIdentifiers) (bool, error) {
+	for _, ctx := range []context.Context{ctx, store.WithSoftDeleted(ctx, true)} {
+		_, err := st.GetUser(ctx, ids, []string{"ids"})
+		if err == nil {
+			return true, nil
+		}
+		if !errors.IsNotFound(err) {
+			return false, err
+		}
+	}
+	return false, nil
+}
+
+const maxUserIDAttempts = 5
+
+// provisionOIDCUser creates a user for the claims and links the external account to it.
+// Existing users are never linked automatically, as the email address may not be verified by the
+// identity provider.
+func provisionOIDCUser(
+	ctx context.Context, st store.Interface, config oauth.OIDCProviderConfig, claims *externalAccountClaims,
+) (*ttnpb.User, error) {
+	if err := checkOIDCProvisioning(config.Provisioning, claims); err != nil {
+		return nil, err
+	}
+	_, err := st.GetUserByPrimaryEmailAddress(ctx, claims.Email, []string{"ids"})
+	if err == nil {
+		return nil, errOIDCProvisioningEmailTaken.New()
+	}
+	if !errors.IsNotFound(err) {
+		return nil, err
+	}
+	var ids *ttnpb.UserIdentifiers
+	for i := 0; i < maxUserIDAttempts; i++ {
+		candidate := &ttnpb.UserIdentifiers{UserId: oidcUserID(claims.Email, i > 0)}
+		taken, err := userIDTaken(ctx, st, candidate)
+		if err != nil {
+			return nil, err
+		}
+		if !taken {
+			ids = candidate
+			break
+		}
+	}
+	if ids == nil {
+		return nil, errOIDCProvisioningUserID.New()
+	}
+	user := &ttnpb.User{
+		Ids:                 ids,
+		Name:                claims.Name,
+		PrimaryEmailAddress: claims.Email,
+		State:               ttnpb.State_STATE_APPROVED,
+		StateDescription:    "provisioned by external account provider",
+		Admin:               isOIDCAdmin(config, claims),
+	}
+	if claims.EmailVerified {
+		user.PrimaryEmailAddressValidatedAt = timestamppb.Now()
+	}
+	user, err = st.CreateUser(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := st.CreateExternalAccount(ctx, &store.ExternalAccount{
+		UserIDs:  user.GetIds(),
+		Provider: oidcProvider,
+		Subject:  claims.Subject,
+	}); err != nil {
+		return nil, err
+	}
+	return user, nil
+}
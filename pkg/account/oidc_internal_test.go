@@ -0,0 +1,147 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package account
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodeOIDCClaims(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	claims, err := decodeOIDCClaims(strings.NewReader(`{
+		"sub": "a5b8b6a0-5a3b-4a4d-9b7e-3b0e4f4e7c1d",
+		"email": "Jane.Doe@Example.COM",
+		"email_verified": "true",
+		"name": "Jane Doe",
+		"realm_access": {"roles": ["operators", "admins", 42]}
+	}`), oauth.OIDCClaimsConfig{
+		Subject:       "sub",
+		Email:         "email",
+		EmailVerified: "email_verified",
+		Name:          "name",
+		Groups:        "realm_access.roles",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(claims, should.Resemble, &externalAccountClaims{
+		Subject:       "a5b8b6a0-5a3b-4a4d-9b7e-3b0e4f4e7c1d",
+		Email:         "jane.doe@example.com",
+		EmailVerified: true,
+		Name:          "Jane Doe",
+		Groups:        []string{"operators", "admins"},
+	})
+
+	claims, err = decodeOIDCClaims(strings.NewReader(`{"id": 12345678901234, "groups": "admins"}`), oauth.OIDCClaimsConfig{
+		Subject: "id",
+		Email:   "email",
+		Groups:  "groups",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(claims, should.Resemble, &externalAccountClaims{
+		Subject: "12345678901234",
+		Groups:  []string{"admins"},
+	})
+}
+
+func TestIsOIDCAdmin(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	config := oauth.OIDCProviderConfig{AdminGroups: []string{"admins"}}
+	a.So(isOIDCAdmin(config, &externalAccountClaims{Groups: []string{"operators", "admins"}}), should.BeTrue)
+	a.So(isOIDCAdmin(config, &externalAccountClaims{Groups: []string{"operators"}}), should.BeFalse)
+	a.So(isOIDCAdmin(oauth.OIDCProviderConfig{}, &externalAccountClaims{Groups: []string{"admins"}}), should.BeFalse)
+}
+
+func TestCheckOIDCProvisioning(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name      string
+		Config    oauth.OIDCProvisioningConfig
+		Claims    *externalAccountClaims
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "NoEmail",
+			Claims:    &externalAccountClaims{Subject: "test"},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "UnverifiedEmail",
+			Config:    oauth.OIDCProvisioningConfig{RequireVerifiedEmail: true},
+			Claims:    &externalAccountClaims{Subject: "test", Email: "jane@example.com"},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:   "UnverifiedEmailAllowed",
+			Claims: &externalAccountClaims{Subject: "test", Email: "jane@example.com"},
+		},
+		{
+			Name:      "DomainNotAllowed",
+			Config:    oauth.OIDCProvisioningConfig{AllowedEmailDomains: []string{"example.com"}},
+			Claims:    &externalAccountClaims{Subject: "test", Email: "jane@example.org"},
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name: "DomainAllowed",
+			Config: oauth.OIDCProvisioningConfig{
+				AllowedEmailDomains:  []string{"example.org", "Example.com"},
+				RequireVerifiedEmail: true,
+			},
+			Claims: &externalAccountClaims{Subject: "test", Email: "jane@example.com", EmailVerified: true},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			err := checkOIDCProvisioning(tc.Config, tc.Claims)
+			if tc.Assertion == nil {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(tc.Assertion(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestOIDCUserID(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(oidcUserID("Jane.Doe@example.com", false), should.Equal, "jane-doe")
+	a.So(oidcUserID("_jane__doe+ttn@example.com", false), should.Equal, "jane-doe-ttn")
+	a.So(oidcUserID("j@example.com", false), should.Equal, "user")
+	a.So(oidcUserID(strings.Repeat("a", 50)+"@example.com", false), should.Equal, strings.Repeat("a", 36))
+
+	for _, email := range []string{"jane.doe@example.com", "j@example.com", strings.Repeat("a", 50) + "@example.com"} {
+		id := oidcUserID(email, true)
+		a.So(len(id), should.BeLessThanOrEqualTo, 36)
+		a.So((&ttnpb.UserIdentifiers{UserId: id}).ValidateFields(), should.BeNil)
+	}
+}
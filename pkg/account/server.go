@@ -40,6 +40,8 @@ type Server interface {
 	Login(w http.ResponseWriter, r *http.Request)
 	CurrentUser(w http.ResponseWriter, r *http.Request)
 	Logout(w http.ResponseWriter, r *http.Request)
+
+	SetLoginRiskEvaluator(evaluator LoginRiskEvaluator)
 }
 
 type server struct {
@@ -49,6 +51,8 @@ type server struct {
 	session       sess.Session
 	generateCSP   func(config *oauth.Config, nonce string) string
 	schemaDecoder *schema.Decoder
+
+	loginRiskEvaluator LoginRiskEvaluator
 }
 
 type sessionStore struct {
@@ -139,6 +143,14 @@ func (s *server) RegisterRoutes(server *web.Server) {
 	api.Path("/auth/token-login").HandlerFunc(s.TokenLogin).Methods(http.MethodPost)
 	api.Path("/auth/logout").Handler(logoutHandler).Methods(http.MethodPost)
 	api.Path("/me").Handler(currentUserHandler).Methods(http.MethodGet)
+	api.Path("/auth/external").
+		Handler(s.requireLogin(http.HandlerFunc(s.ListExternalAccounts))).Methods(http.MethodGet)
+	api.Path("/auth/external/{provider}").
+		Handler(s.requireLogin(http.HandlerFunc(s.UnlinkExternalAccount))).Methods(http.MethodDelete)
+	api.Path("/auth/external/{provider}/link").
+		Handler(s.requireLogin(http.HandlerFunc(s.LinkExternalAccount))).Methods(http.MethodGet)
+	api.Path("/auth/external/{provider}/login").HandlerFunc(s.ExternalAccountLogin).Methods(http.MethodGet)
+	api.Path("/auth/external/{provider}/callback").HandlerFunc(s.ExternalAccountCallback).Methods(http.MethodGet)
 
 	loginHandler := s.redirectToNext(webui.Template)
 	page := router.NewRoute().Subrouter()
@@ -16,6 +16,7 @@ package account_test
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -34,6 +35,7 @@ import (
 	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -69,6 +71,16 @@ var (
 	mockUser = &ttnpb.User{
 		Ids: &ttnpb.UserIdentifiers{UserId: "user"},
 	}
+	mockUserWithoutPassword = &ttnpb.User{
+		Ids: &ttnpb.UserIdentifiers{UserId: "user"},
+	}
+	mockExternalAccounts = []*store.ExternalAccount{
+		{
+			UserIDs:  &ttnpb.UserIdentifiers{UserId: "user"},
+			Provider: "github",
+			Subject:  "12345",
+		},
+	}
 )
 
 func init() {
@@ -115,6 +127,12 @@ func TestAuthentication(t *testing.T) {
 	if err != nil {
 		panic(err)
 	}
+	riskDecision := account.LoginRiskAllow
+	s.SetLoginRiskEvaluator(account.LoginRiskEvaluatorFunc(
+		func(context.Context, *account.LoginAttempt) (account.LoginRiskDecision, error) {
+			return riskDecision, nil
+		},
+	))
 	c.RegisterWeb(s)
 	componenttest.StartComponent(t, c)
 
@@ -140,6 +158,7 @@ func TestAuthentication(t *testing.T) {
 		Name             string
 		StoreSetup       func(*mockStore)
 		StoreCheck       func(*testing.T, *mockStore)
+		LoginRisk        account.LoginRiskDecision
 		Method           string
 		Path             string
 		Body             any
@@ -256,6 +275,61 @@ func TestAuthentication(t *testing.T) {
 				a.So(s.req.sessionID, should.Equal, "session_id") // actually the before-last call.
 			},
 		},
+		{
+			Name: "list external accounts",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.externalAccounts = mockExternalAccounts
+			},
+			Method:       "GET",
+			Path:         "/oauth/api/auth/external",
+			ExpectedCode: http.StatusOK,
+			ExpectedBody: `"provider":"github"`,
+		},
+		{
+			Name: "link external account of disabled provider",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+			},
+			Method:       "GET",
+			Path:         "/oauth/api/auth/external/github/link",
+			ExpectedCode: http.StatusNotFound,
+		},
+		{
+			Name: "unlink last external account without password",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUserWithoutPassword
+				s.res.externalAccounts = mockExternalAccounts
+			},
+			Method:       "DELETE",
+			Path:         "/oauth/api/auth/external/github",
+			ExpectedCode: http.StatusBadRequest,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "ListExternalAccounts")
+				a.So(s.calls, should.NotContain, "DeleteExternalAccount")
+			},
+		},
+		{
+			Name: "unlink external account",
+			StoreSetup: func(s *mockStore) {
+				s.res.session = mockSession
+				s.res.user = mockUser
+				s.res.externalAccounts = mockExternalAccounts
+			},
+			Method:       "DELETE",
+			Path:         "/oauth/api/auth/external/github",
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "DeleteExternalAccount")
+				a.So(s.req.userIDs.GetUserId(), should.Equal, "user")
+				a.So(s.req.provider, should.Equal, "github")
+			},
+		},
 		{
 			Name: "redirect to root",
 			StoreSetup: func(s *mockStore) {
@@ -316,6 +390,78 @@ func TestAuthentication(t *testing.T) {
 				a.So(s.req.session.GetUserIds(), should.Resemble, mockUser.GetIds())
 			},
 		},
+		{
+			Name: "token login with step-up",
+			StoreSetup: func(s *mockStore) {
+				s.res.loginToken = &ttnpb.LoginToken{
+					UserIds: mockUser.GetIds(),
+				}
+				s.res.session = mockSession
+			},
+			LoginRisk:    account.LoginRiskStepUp,
+			Method:       "POST",
+			Path:         "/oauth/api/auth/token-login",
+			Body:         tokenFormData{"form", "this-is-the-token"},
+			ExpectedCode: http.StatusNoContent,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindSessions")
+				a.So(s.calls, should.Contain, "CreateSession")
+			},
+		},
+		{
+			Name: "token login denied",
+			StoreSetup: func(s *mockStore) {
+				s.res.loginToken = &ttnpb.LoginToken{
+					UserIds: mockUser.GetIds(),
+				}
+				s.res.session = mockSession
+			},
+			LoginRisk:    account.LoginRiskDeny,
+			Method:       "POST",
+			Path:         "/oauth/api/auth/token-login",
+			Body:         tokenFormData{"form", "this-is-the-token"},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindSessions")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login with step-up",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+			},
+			LoginRisk:    account.LoginRiskStepUp,
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusUnauthorized,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindSessions")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
+		{
+			Name: "login denied",
+			StoreSetup: func(s *mockStore) {
+				s.res.user = mockUser
+				s.res.session = mockSession
+			},
+			LoginRisk:    account.LoginRiskDeny,
+			Method:       "POST",
+			Path:         "/oauth/api/auth/login",
+			Body:         loginFormData{"json", "user", "pass"},
+			ExpectedCode: http.StatusForbidden,
+			StoreCheck: func(t *testing.T, s *mockStore) {
+				a := assertions.New(t)
+				a.So(s.calls, should.Contain, "FindSessions")
+				a.So(s.calls, should.NotContain, "CreateSession")
+			},
+		},
 	} {
 		name := tt.Name
 		if name == "" {
@@ -323,6 +469,7 @@ func TestAuthentication(t *testing.T) {
 		}
 		t.Run(name, func(t *testing.T) {
 			store.reset()
+			riskDecision = tt.LoginRisk
 			if tt.StoreSetup != nil {
 				tt.StoreSetup(store)
 			}
@@ -26,6 +26,8 @@ type Interface interface {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	// ExternalAccountStore is needed for linking external accounts to users.
+	store.ExternalAccountStore
 }
 
 // TransactionalStore is Interface, but with a method that uses a transaction.
@@ -36,5 +38,8 @@ type TransactionalInterface interface {
 	Transact(context.Context, func(context.Context, Interface) error) error
 }
 
+// ExternalAccount is an account of an external identity provider that is linked to a user.
+type ExternalAccount = store.ExternalAccount
+
 // WithSoftDeleted returns a context that tells the store to include (only) deleted entities.
 var WithSoftDeleted = store.WithSoftDeleted
@@ -33,11 +33,15 @@ type mockStoreContents struct {
 		sessionID string
 		userIDs   *ttnpb.UserIdentifiers
 		token     string
+		provider  string
 	}
 	res struct {
 		session    *ttnpb.UserSession
 		user       *ttnpb.User
 		loginToken *ttnpb.LoginToken
+
+		externalAccount  *store.ExternalAccount
+		externalAccounts []*store.ExternalAccount
 	}
 	err struct {
 		getUser       error
@@ -45,6 +49,8 @@ type mockStoreContents struct {
 		getSession    error
 		deleteSession error
 		loginToken    error
+
+		externalAccount error
 	}
 }
 
@@ -52,6 +58,7 @@ type mockStore struct {
 	store.UserStore
 	store.LoginTokenStore
 	store.UserSessionStore
+	store.ExternalAccountStore
 
 	mockStoreContents
 }
@@ -89,12 +96,46 @@ func (s *mockStore) DeleteSession(ctx context.Context, userIDs *ttnpb.UserIdenti
 	return s.err.deleteSession
 }
 
+func (s *mockStore) FindSessions(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ttnpb.UserSession, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "FindSessions")
+	return []*ttnpb.UserSession{s.res.session}, nil
+}
+
 func (s *mockStore) ConsumeLoginToken(ctx context.Context, token string) (*ttnpb.LoginToken, error) {
 	s.req.ctx, s.req.token = ctx, token
 	s.calls = append(s.calls, "ConsumeLoginToken")
 	return s.res.loginToken, s.err.loginToken
 }
 
+func (s *mockStore) CreateExternalAccount(
+	ctx context.Context, account *store.ExternalAccount,
+) (*store.ExternalAccount, error) {
+	s.req.ctx, s.req.userIDs, s.req.provider = ctx, account.UserIDs, account.Provider
+	s.calls = append(s.calls, "CreateExternalAccount")
+	return account, s.err.externalAccount
+}
+
+func (s *mockStore) GetExternalAccount(ctx context.Context, provider, subject string) (*store.ExternalAccount, error) {
+	s.req.ctx, s.req.provider = ctx, provider
+	s.calls = append(s.calls, "GetExternalAccount")
+	return s.res.externalAccount, s.err.externalAccount
+}
+
+func (s *mockStore) ListExternalAccounts(
+	ctx context.Context, userIDs *ttnpb.UserIdentifiers,
+) ([]*store.ExternalAccount, error) {
+	s.req.ctx, s.req.userIDs = ctx, userIDs
+	s.calls = append(s.calls, "ListExternalAccounts")
+	return s.res.externalAccounts, s.err.externalAccount
+}
+
+func (s *mockStore) DeleteExternalAccount(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider string) error {
+	s.req.ctx, s.req.userIDs, s.req.provider = ctx, userIDs, provider
+	s.calls = append(s.calls, "DeleteExternalAccount")
+	return s.err.externalAccount
+}
+
 func (s *mockStore) WithSoftDeleted(ctx context.Context, b bool) context.Context {
 	return ctx
 }
@@ -120,7 +120,12 @@ func (s *server) Login(w http.ResponseWriter, r *http.Request) {
 		webhandlers.Error(w, r, err)
 		return
 	}
-	if err := s.CreateUserSession(w, r, &ttnpb.UserIdentifiers{UserId: loginRequest.UserID}); err != nil {
+	userIDs := &ttnpb.UserIdentifiers{UserId: loginRequest.UserID}
+	if err := s.evaluateLogin(r, userIDs, LoginMethodPassword); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := s.CreateUserSession(w, r, userIDs); err != nil {
 		webhandlers.Error(w, r, err)
 		return
 	}
@@ -173,6 +178,10 @@ func (s *server) TokenLogin(w http.ResponseWriter, r *http.Request) {
 		webhandlers.Error(w, r, err)
 		return
 	}
+	if err := s.evaluateLogin(r, loginToken.GetUserIds(), LoginMethodToken); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
 	if err := s.CreateUserSession(w, r, loginToken.GetUserIds()); err != nil {
 		webhandlers.Error(w, r, err)
 		return
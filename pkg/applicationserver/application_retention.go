@@ -0,0 +1,279 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	errRetentionRegistry = errors.DefineInvalidArgument(
+		"retention_registry", "retention registry is not configured",
+	)
+	errRetentionCleanupInterval = errors.DefineInvalidArgument(
+		"retention_cleanup_interval", "invalid retention cleanup interval `{interval}`",
+	)
+)
+
+const (
+	retentionCacheSize = 1024
+	retentionCacheTTL  = time.Minute
+)
+
+func (as *ApplicationServer) initRetention(ctx context.Context, conf RetentionConfig) error {
+	if conf.Registry == nil {
+		return errRetentionRegistry.New()
+	}
+	if conf.CleanupInterval <= 0 {
+		return errRetentionCleanupInterval.WithAttributes("interval", conf.CleanupInterval)
+	}
+	as.retentionRegistry = conf.Registry
+	as.retentionCache = gcache.New(retentionCacheSize).LRU().Expiration(retentionCacheTTL).Build()
+	as.RegisterTask(&task.Config{
+		Context: ctx,
+		ID:      "as_enforce_retention_policies",
+		Backoff: task.DefaultBackoffConfig,
+		Restart: task.RestartAlways,
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(conf.CleanupInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case now := <-ticker.C:
+					// The policies are enforced by one instance in the cluster per cleanup interval.
+					ok, err := as.retentionRegistry.Lease(ctx, conf.CleanupInterval)
+					if err != nil {
+						log.FromContext(ctx).WithError(err).Warn("Failed to acquire retention enforcement lease")
+						continue
+					}
+					if !ok {
+						continue
+					}
+					historyTrimmer, _ := events.DefaultPubSub().(events.HistoryTrimmer)
+					if err := as.enforceRetentionPolicies(ctx, now, historyTrimmer); err != nil {
+						log.FromContext(ctx).WithError(err).Warn("Failed to enforce retention policies")
+					}
+				}
+			}
+		},
+	})
+	return nil
+}
+
+// retentionPolicy returns the retention policy of the application, or nil if the application has no policy.
+// Policies are cached, so that changes take effect on all instances within the cache TTL.
+func (as *ApplicationServer) retentionPolicy(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*retention.Policy, error) {
+	uid := unique.ID(ctx, ids)
+	if v, err := as.retentionCache.Get(uid); err == nil {
+		return v.(*retention.Policy), nil
+	}
+	policy, err := as.retentionRegistry.Get(ctx, ids)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		policy = nil
+	}
+	_ = as.retentionCache.Set(uid, policy)
+	return policy, nil
+}
+
+// applyRetentionPolicy returns the upstream message as it may be forwarded according to the retention policy of
+// the application. If the policy allows only the decoded payload to be forwarded, the FRMPayload is removed from
+// a copy of uplink messages.
+func (as *ApplicationServer) applyRetentionPolicy(
+	ctx context.Context, up *ttnpb.ApplicationUp,
+) (*ttnpb.ApplicationUp, error) {
+	if as.retentionRegistry == nil || up.GetUplinkMessage() == nil {
+		return up, nil
+	}
+	policy, err := as.retentionPolicy(ctx, up.EndDeviceIds.ApplicationIds)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil || !policy.DecodedPayloadOnly {
+		return up, nil
+	}
+	up = ttnpb.Clone(up)
+	up.GetUplinkMessage().FrmPayload = nil
+	return up, nil
+}
+
+// enforceRetentionPolicies deletes the historical events and stored uplink messages of applications that are
+// older than the retention periods of their policies. If historyTrimmer is nil, the event history is not trimmed.
+// Only the end devices of applications that have a policy are ranged.
+func (as *ApplicationServer) enforceRetentionPolicies(
+	ctx context.Context, now time.Time, historyTrimmer events.HistoryTrimmer,
+) error {
+	caps := as.config.Retention.caps()
+	policies := make(map[string]*retention.Policy)
+	if err := as.retentionRegistry.Range(
+		ctx,
+		func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *retention.Policy) bool {
+			policies[unique.ID(ctx, ids)] = policy
+			return true
+		},
+	); err != nil {
+		return err
+	}
+
+	uplinkTrimmer, _ := as.config.UplinkStorage.Registry.(ApplicationUplinkTrimmer)
+	if historyTrimmer == nil && uplinkTrimmer == nil {
+		return nil
+	}
+	for uid, policy := range policies {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		historyBefore, trimHistory := policy.EventHistoryBefore(now, caps)
+		trimHistory = trimHistory && historyTrimmer != nil
+		uplinksBefore, trimUplinks := policy.UplinkStorageBefore(now, caps)
+		trimUplinks = trimUplinks && uplinkTrimmer != nil
+		if !trimHistory && !trimUplinks {
+			continue
+		}
+		appIDs, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return err
+		}
+		logger := log.FromContext(ctx).WithField("application_uid", uid)
+		if trimHistory {
+			if err := historyTrimmer.TrimHistory(ctx, appIDs.GetEntityIdentifiers(), historyBefore); err != nil {
+				logger.WithError(err).Warn("Failed to trim application event history")
+			}
+		}
+		if err := as.deviceRegistry.RangeByApplication(
+			ctx, appIDs, []string{"ids"},
+			func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, _ *ttnpb.EndDevice) bool {
+				logger := logger.WithField("device_uid", unique.ID(ctx, ids))
+				if trimHistory {
+					if err := historyTrimmer.TrimHistory(ctx, ids.GetEntityIdentifiers(), historyBefore); err != nil {
+						logger.WithError(err).Warn("Failed to trim end device event history")
+					}
+				}
+				if trimUplinks {
+					if err := uplinkTrimmer.Trim(ctx, ids, uplinksBefore); err != nil {
+						logger.WithError(err).Warn("Failed to trim stored uplink messages")
+					}
+				}
+				return true
+			},
+		); err != nil {
+			logger.WithError(err).Warn("Failed to range end devices of application")
+		}
+	}
+	return nil
+}
+
+// GetRetentionPolicy returns the retention policy of the application.
+func (as *ApplicationServer) GetRetentionPolicy(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*retention.Policy, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_INFO); err != nil {
+		return nil, err
+	}
+	return as.retentionRegistry.Get(ctx, ids)
+}
+
+// SetRetentionPolicy sets the retention policy of the application. If policy is nil, the policy is deleted.
+// The retention periods of the policy may not exceed the caps configured by the administrator.
+func (as *ApplicationServer) SetRetentionPolicy(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *retention.Policy,
+) error {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return err
+	}
+	if policy != nil {
+		if err := policy.Validate(as.config.Retention.caps()); err != nil {
+			return err
+		}
+	}
+	if err := as.retentionRegistry.Set(ctx, ids, policy); err != nil {
+		return err
+	}
+	as.retentionCache.Remove(unique.ID(ctx, ids))
+	return nil
+}
+
+func retentionPolicyToPB(policy *retention.Policy) *ttnpb.ApplicationRetentionPolicy {
+	return &ttnpb.ApplicationRetentionPolicy{
+		UplinkStorageDays:  policy.UplinkStorageDays,
+		EventHistoryDays:   policy.EventHistoryDays,
+		DecodedPayloadOnly: policy.DecodedPayloadOnly,
+	}
+}
+
+func retentionPolicyFromPB(pb *ttnpb.ApplicationRetentionPolicy) *retention.Policy {
+	return &retention.Policy{
+		UplinkStorageDays:  pb.GetUplinkStorageDays(),
+		EventHistoryDays:   pb.GetEventHistoryDays(),
+		DecodedPayloadOnly: pb.GetDecodedPayloadOnly(),
+	}
+}
+
+type retentionPolicyRegistryServer struct {
+	ttnpb.UnimplementedApplicationRetentionPolicyRegistryServer
+
+	AS *ApplicationServer
+}
+
+// Get implements ttnpb.ApplicationRetentionPolicyRegistryServer.
+func (s *retentionPolicyRegistryServer) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.ApplicationRetentionPolicy, error) {
+	policy, err := s.AS.GetRetentionPolicy(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return retentionPolicyToPB(policy), nil
+}
+
+// Set implements ttnpb.ApplicationRetentionPolicyRegistryServer.
+func (s *retentionPolicyRegistryServer) Set(
+	ctx context.Context, req *ttnpb.SetApplicationRetentionPolicyRequest,
+) (*ttnpb.ApplicationRetentionPolicy, error) {
+	if err := s.AS.SetRetentionPolicy(
+		ctx, req.GetApplicationIds(), retentionPolicyFromPB(req.GetPolicy()),
+	); err != nil {
+		return nil, err
+	}
+	return req.GetPolicy(), nil
+}
+
+// Delete implements ttnpb.ApplicationRetentionPolicyRegistryServer.
+func (s *retentionPolicyRegistryServer) Delete(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := s.AS.SetRetentionPolicy(ctx, ids, nil); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
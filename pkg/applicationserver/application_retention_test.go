@@ -0,0 +1,268 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var errMockRetentionPolicyNotFound = errors.DefineNotFound("mock_retention_policy_not_found", "policy not found")
+
+type mockRetentionRegistry struct {
+	policies map[string]*retention.Policy
+	gets     int
+	leased   bool
+}
+
+func (r *mockRetentionRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*retention.Policy, error) {
+	r.gets++
+	policy, ok := r.policies[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errMockRetentionPolicyNotFound.New()
+	}
+	return policy, nil
+}
+
+func (r *mockRetentionRegistry) Set(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *retention.Policy,
+) error {
+	if policy == nil {
+		delete(r.policies, unique.ID(ctx, ids))
+		return nil
+	}
+	r.policies[unique.ID(ctx, ids)] = policy
+	return nil
+}
+
+func (r *mockRetentionRegistry) Range(
+	ctx context.Context, f func(context.Context, *ttnpb.ApplicationIdentifiers, *retention.Policy) bool,
+) error {
+	for uid, policy := range r.policies {
+		ids, err := unique.ToApplicationID(uid)
+		if err != nil {
+			return err
+		}
+		if !f(ctx, ids, policy) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (r *mockRetentionRegistry) Lease(context.Context, time.Duration) (bool, error) {
+	if r.leased {
+		return false, nil
+	}
+	r.leased = true
+	return true, nil
+}
+
+type mockRetentionDeviceRegistry struct {
+	DeviceRegistry
+	devices []*ttnpb.EndDeviceIdentifiers
+	ranged  []string
+}
+
+func (r *mockRetentionDeviceRegistry) RangeByApplication(
+	ctx context.Context,
+	appIDs *ttnpb.ApplicationIdentifiers,
+	_ []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	r.ranged = append(r.ranged, unique.ID(ctx, appIDs))
+	for _, ids := range r.devices {
+		if ids.ApplicationIds.ApplicationId != appIDs.ApplicationId {
+			continue
+		}
+		if !f(ctx, ids, &ttnpb.EndDevice{Ids: ids}) {
+			return nil
+		}
+	}
+	return nil
+}
+
+type mockHistoryTrimmer map[string]time.Time
+
+func (m mockHistoryTrimmer) TrimHistory(ctx context.Context, ids *ttnpb.EntityIdentifiers, before time.Time) error {
+	m[unique.ID(ctx, ids)] = before
+	return nil
+}
+
+type mockUplinkTrimmer struct {
+	ApplicationUplinkRegistry
+	trimmed map[string]time.Time
+}
+
+func (m *mockUplinkTrimmer) Trim(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, before time.Time) error {
+	m.trimmed[unique.ID(ctx, ids)] = before
+	return nil
+}
+
+func TestApplyRetentionPolicy(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	registry := &mockRetentionRegistry{
+		policies: map[string]*retention.Policy{
+			"app-1": {DecodedPayloadOnly: true},
+		},
+	}
+	as := &ApplicationServer{
+		retentionRegistry: registry,
+		retentionCache:    gcache.New(retentionCacheSize).LRU().Expiration(retentionCacheTTL).Build(),
+	}
+	newUp := func(appID string) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+				DeviceId:       "dev-1",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      1,
+				FrmPayload: []byte{0x01, 0x02},
+			}},
+		}
+	}
+
+	up := newUp("app-1")
+	res, err := as.applyRetentionPolicy(ctx, up)
+	if a.So(err, should.BeNil) {
+		a.So(res.GetUplinkMessage().FrmPayload, should.BeNil)
+		a.So(res.GetUplinkMessage().FPort, should.Equal, 1)
+		// The original message is not modified.
+		a.So(up.GetUplinkMessage().FrmPayload, should.Resemble, []byte{0x01, 0x02})
+	}
+
+	up = newUp("app-2")
+	res, err = as.applyRetentionPolicy(ctx, up)
+	if a.So(err, should.BeNil) {
+		a.So(res, should.Equal, up)
+	}
+
+	// Policies are cached, including the absence of a policy.
+	gets := registry.gets
+	_, err = as.applyRetentionPolicy(ctx, newUp("app-1"))
+	a.So(err, should.BeNil)
+	_, err = as.applyRetentionPolicy(ctx, newUp("app-2"))
+	a.So(err, should.BeNil)
+	a.So(registry.gets, should.Equal, gets)
+}
+
+func TestSetRetentionPolicy(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	registry := &mockRetentionRegistry{policies: map[string]*retention.Policy{}}
+	as := &ApplicationServer{
+		config: &Config{
+			Retention: RetentionConfig{MaxUplinkStorageDays: 30, MaxEventHistoryDays: 7},
+		},
+		retentionRegistry: registry,
+		retentionCache:    gcache.New(retentionCacheSize).LRU().Expiration(retentionCacheTTL).Build(),
+	}
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}
+
+	err := as.SetRetentionPolicy(rights.NewContext(ctx, &rights.Rights{
+		ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO),
+		}),
+	}), ids, &retention.Policy{})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx = rights.NewContext(ctx, &rights.Rights{
+		ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(
+				ttnpb.Right_RIGHT_APPLICATION_INFO,
+				ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			),
+		}),
+	})
+	err = as.SetRetentionPolicy(ctx, ids, &retention.Policy{EventHistoryDays: 8})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	srv := &retentionPolicyRegistryServer{AS: as}
+	policy := &ttnpb.ApplicationRetentionPolicy{UplinkStorageDays: 30, EventHistoryDays: 7, DecodedPayloadOnly: true}
+	_, err = srv.Set(ctx, &ttnpb.SetApplicationRetentionPolicyRequest{ApplicationIds: ids, Policy: policy})
+	a.So(err, should.BeNil)
+	got, err := srv.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(got, should.Resemble, policy)
+	}
+
+	_, err = srv.Delete(ctx, ids)
+	a.So(err, should.BeNil)
+	_, err = srv.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
+
+func TestEnforceRetentionPolicies(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	uplinkTrimmer := &mockUplinkTrimmer{trimmed: map[string]time.Time{}}
+	deviceRegistry := &mockRetentionDeviceRegistry{
+		devices: []*ttnpb.EndDeviceIdentifiers{
+			{ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}, DeviceId: "dev-1"},
+			{ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-2"}, DeviceId: "dev-2"},
+			{ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-3"}, DeviceId: "dev-3"},
+		},
+	}
+	as := &ApplicationServer{
+		config: &Config{
+			UplinkStorage: UplinkStorageConfig{Registry: uplinkTrimmer},
+			Retention:     RetentionConfig{MaxEventHistoryDays: 7},
+		},
+		retentionRegistry: &mockRetentionRegistry{
+			policies: map[string]*retention.Policy{
+				"app-1": {UplinkStorageDays: 2, EventHistoryDays: 1},
+				"app-2": {UplinkStorageDays: 3},
+			},
+		},
+		deviceRegistry: deviceRegistry,
+	}
+	now := time.Unix(1000000, 0)
+	day := 24 * time.Hour
+	historyTrimmer := mockHistoryTrimmer{}
+
+	a.So(as.enforceRetentionPolicies(ctx, now, historyTrimmer), should.BeNil)
+	a.So(historyTrimmer, should.Resemble, mockHistoryTrimmer{
+		"app-1":       now.Add(-day),
+		"app-1.dev-1": now.Add(-day),
+		// The event history of applications without an event history retention is capped.
+		"app-2":       now.Add(-7 * day),
+		"app-2.dev-2": now.Add(-7 * day),
+	})
+	a.So(uplinkTrimmer.trimmed, should.Resemble, map[string]time.Time{
+		"app-1.dev-1": now.Add(-2 * day),
+		"app-2.dev-2": now.Add(-3 * day),
+	})
+	// Only the end devices of applications with a policy are ranged.
+	sort.Strings(deviceRegistry.ranged)
+	a.So(deviceRegistry.ranged, should.Resemble, []string{"app-1", "app-2"})
+}
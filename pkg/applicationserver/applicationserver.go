@@ -23,9 +23,13 @@ import (
 	"runtime/trace"
 	"time"
 
+	"github.com/bluele/gcache"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
 	iogrpc "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/grpc"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
@@ -35,6 +39,8 @@ import (
 	ioweb "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -79,11 +85,18 @@ type ApplicationServer struct {
 	locationRegistry       metadata.EndDeviceLocationRegistry
 	formatters             messageprocessors.MapPayloadProcessor
 	webhooks               ioweb.Webhooks
-	webhookTemplates       ioweb.TemplateStore
+	webhookTemplates       *ioweb.ReloadableTemplateStore
 	pubsub                 *pubsub.PubSub
 	appPackages            packages.Server
 	appPkgRegistry         packages.Registry
 	deviceLastSeenProvider lastseen.LastSeenProvider
+	coverageRegistry       coverage.Registry
+	retentionRegistry      retention.Registry
+	retentionCache         gcache.Cache
+	downlinkResultRegistry downlinkresult.Registry
+	fPortFilterRegistry    fportfilter.Registry
+	payloadSchemaRegistry  payloadschema.Registry
+	payloadSchemaCache     gcache.Cache
 
 	clusterDistributor distribution.Distributor
 	localDistributor   distribution.Distributor
@@ -98,9 +111,16 @@ type ApplicationServer struct {
 	interopClient InteropClient
 	interopID     string
 
+	keepPayloadEncryptedApplications map[string]struct{}
+
 	activationPool     workerpool.WorkerPool[*ttnpb.EndDeviceIdentifiers]
 	processingPool     workerpool.WorkerPool[*ttnpb.ApplicationUp]
 	deviceLastSeenPool workerpool.WorkerPool[lastSeenAtInfo]
+	coveragePool       workerpool.WorkerPool[coverage.GatewaySample]
+
+	uplinkQueue UplinkQueue
+
+	subscriptions subscriptionCounts
 }
 
 // Context returns the context of the Application Server.
@@ -175,6 +195,11 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		),
 		interopClient: interopCl,
 		interopID:     conf.Interop.ID,
+
+		keepPayloadEncryptedApplications: make(map[string]struct{}, len(conf.KeepPayloadEncrypted.Applications)),
+	}
+	for _, appID := range conf.KeepPayloadEncrypted.Applications {
+		as.keepPayloadEncryptedApplications[appID] = struct{}{}
 	}
 
 	as.formatters[ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT] = javascript.New()
@@ -199,6 +224,35 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		Name:      "store_device_last_seen_from_uplink",
 		Handler:   as.storeDeviceLastSeen,
 	})
+	if conf.Coverage.Enable {
+		if err := as.initCoverage(ctx, conf.Coverage); err != nil {
+			return nil, err
+		}
+	}
+	if conf.Retention.Enable {
+		if err := as.initRetention(ctx, conf.Retention); err != nil {
+			return nil, err
+		}
+	}
+	if conf.PayloadSchema.Enable {
+		if err := as.initPayloadSchemas(conf.PayloadSchema); err != nil {
+			return nil, err
+		}
+	}
+	if conf.DownlinkResults.Enable {
+		if err := as.initDownlinkResults(conf.DownlinkResults); err != nil {
+			return nil, err
+		}
+	}
+	if conf.UplinkPipeline.Enable {
+		if conf.UplinkPipeline.Queue == nil {
+			return nil, errUplinkQueue.New()
+		}
+		as.uplinkQueue = conf.UplinkPipeline.Queue
+		if err := as.startUplinkPipeline(ctx, conf.UplinkPipeline); err != nil {
+			return nil, err
+		}
+	}
 
 	as.grpc.asDevices = asEndDeviceRegistryServer{
 		AS:       as,
@@ -238,6 +292,10 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}()
 
+	var mqttOpts []mqtt.Option
+	if conf.MQTTShaping.Enable {
+		mqttOpts = append(mqttOpts, mqtt.WithShaper(mqtt.NewShaper(conf.MQTTShaping)))
+	}
 	for _, version := range []struct {
 		Format mqtt.Format
 		Config config.MQTT
@@ -272,7 +330,7 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 						)
 					}
 					defer lis.Close()
-					return mqtt.Serve(ctx, as, lis, version.Format, endpoint.Protocol())
+					return mqtt.Serve(ctx, as, lis, version.Format, endpoint.Protocol(), mqttOpts...)
 				},
 				Restart: task.RestartOnFailure,
 				Backoff: task.DefaultBackoffConfig,
@@ -280,15 +338,26 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 		}
 	}
 
-	if as.webhooks, err = conf.Webhooks.NewWebhooks(ctx, as); err != nil {
+	webhookTemplates, err := conf.Webhooks.Templates.NewTemplateStore(ctx, as)
+	if err != nil {
 		return nil, err
 	}
+	as.webhookTemplates = ioweb.NewReloadableTemplateStore(webhookTemplates)
+
+	if conf.FPortFilters.Enable {
+		if conf.FPortFilters.Registry == nil {
+			return nil, errFPortFilterRegistry.New()
+		}
+		as.fPortFilterRegistry = conf.FPortFilters.Registry
+	}
 
-	if as.webhookTemplates, err = conf.Webhooks.Templates.NewTemplateStore(ctx, as); err != nil {
+	if as.webhooks, err = conf.Webhooks.NewWebhooks(
+		ctx, as, as.webhookTemplates, as.KeyService(), as.fPortFilterRegistry,
+	); err != nil {
 		return nil, err
 	}
 
-	if as.pubsub, err = conf.PubSub.NewPubSub(c, as); err != nil {
+	if as.pubsub, err = conf.PubSub.NewPubSub(c, as, as.fPortFilterRegistry); err != nil {
 		return nil, err
 	}
 
@@ -315,6 +384,12 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 			"/ttn.lorawan.v3.AppAs",
 			"/ttn.lorawan.v3.ApplicationWebhookRegistry",
 			"/ttn.lorawan.v3.ApplicationPubSubRegistry",
+			"/ttn.lorawan.v3.ApplicationRetentionPolicyRegistry",
+			"/ttn.lorawan.v3.AsDownlinkResultRegistry",
+			"/ttn.lorawan.v3.ApplicationPayloadSchemaPolicyRegistry",
+			"/ttn.lorawan.v3.ApplicationIntegrationStatusService",
+			"/ttn.lorawan.v3.GatewayCoverageService",
+			"/ttn.lorawan.v3.ApplicationFPortFilterRegistry",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
@@ -329,6 +404,16 @@ func New(c *component.Component, conf *Config) (as *ApplicationServer, err error
 	return as, nil
 }
 
+// ReloadWebhookTemplates replaces the webhook templates with the templates from the given configuration.
+func (as *ApplicationServer) ReloadWebhookTemplates(ctx context.Context, conf ioweb.TemplatesConfig) error {
+	webhookTemplates, err := conf.NewTemplateStore(ctx, as)
+	if err != nil {
+		return err
+	}
+	as.webhookTemplates.Set(webhookTemplates)
+	return nil
+}
+
 // RegisterServices registers services provided by as at s.
 func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterAsServer(s, as)
@@ -345,6 +430,22 @@ func (as *ApplicationServer) RegisterServices(s *grpc.Server) {
 		pkgs.RegisterServices(s)
 	}
 	ttnpb.RegisterAsEndDeviceBatchRegistryServer(s, as.grpc.asBatchDevices)
+	if as.retentionRegistry != nil {
+		ttnpb.RegisterApplicationRetentionPolicyRegistryServer(s, &retentionPolicyRegistryServer{AS: as})
+	}
+	if as.downlinkResultRegistry != nil {
+		ttnpb.RegisterAsDownlinkResultRegistryServer(s, &downlinkResultRegistryServer{AS: as})
+	}
+	if as.payloadSchemaRegistry != nil {
+		ttnpb.RegisterApplicationPayloadSchemaPolicyRegistryServer(s, &payloadSchemaPolicyRegistryServer{AS: as})
+	}
+	ttnpb.RegisterApplicationIntegrationStatusServiceServer(s, &integrationStatusServer{AS: as})
+	if as.coverageRegistry != nil {
+		ttnpb.RegisterGatewayCoverageServiceServer(s, &gatewayCoverageServer{AS: as})
+	}
+	if as.fPortFilterRegistry != nil {
+		ttnpb.RegisterApplicationFPortFilterRegistryServer(s, &fPortFilterRegistryServer{AS: as})
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
@@ -362,6 +463,22 @@ func (as *ApplicationServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Cl
 		pkgs.RegisterHandlers(s, conn)
 	}
 	ttnpb.RegisterAsEndDeviceBatchRegistryHandler(as.Context(), s, conn) // nolint:errcheck
+	if as.retentionRegistry != nil {
+		ttnpb.RegisterApplicationRetentionPolicyRegistryHandler(as.Context(), s, conn) //nolint:errcheck
+	}
+	if as.downlinkResultRegistry != nil {
+		ttnpb.RegisterAsDownlinkResultRegistryHandler(as.Context(), s, conn) //nolint:errcheck
+	}
+	if as.payloadSchemaRegistry != nil {
+		ttnpb.RegisterApplicationPayloadSchemaPolicyRegistryHandler(as.Context(), s, conn) //nolint:errcheck
+	}
+	ttnpb.RegisterApplicationIntegrationStatusServiceHandler(as.Context(), s, conn) //nolint:errcheck
+	if as.coverageRegistry != nil {
+		ttnpb.RegisterGatewayCoverageServiceHandler(as.Context(), s, conn) //nolint:errcheck
+	}
+	if as.fPortFilterRegistry != nil {
+		ttnpb.RegisterApplicationFPortFilterRegistryHandler(as.Context(), s, conn) //nolint:errcheck
+	}
 }
 
 // RegisterRoutes registers HTTP routes.
@@ -388,14 +505,26 @@ func (as *ApplicationServer) Subscribe(ctx context.Context, protocol string, ids
 		uid := unique.ID(ctx, ids)
 		ctx = log.NewContextWithField(ctx, "application_uid", uid)
 	}
+	distributor := as.localDistributor
 	if cluster {
-		return as.clusterDistributor.Subscribe(ctx, protocol, ids)
+		distributor = as.clusterDistributor
+	}
+	sub, err := distributor.Subscribe(ctx, protocol, ids)
+	if err != nil {
+		return nil, err
+	}
+	if ids != nil {
+		as.subscriptions.track(unique.ID(ctx, ids), protocol, sub)
 	}
-	return as.localDistributor.Subscribe(ctx, protocol, ids)
+	return sub, nil
 }
 
 // Publish processes the given upstream message and then publishes it to the application frontends.
+// If the uplink pipeline is enabled, the upstream message is added to the queue of the first stage.
 func (as *ApplicationServer) Publish(ctx context.Context, up *ttnpb.ApplicationUp) error {
+	if as.uplinkQueue != nil {
+		return as.uplinkQueue.Add(ctx, UplinkStageProcess, up)
+	}
 	return as.processingPool.Publish(ctx, up)
 }
 
@@ -426,13 +555,19 @@ func (as *ApplicationServer) storeDeviceLastSeen(ctx context.Context, lastSeenEn
 	}
 }
 
-func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.ApplicationUp, link *ttnpb.ApplicationLink) error {
-	defer trace.StartRegion(ctx, "process up").End()
-
+// receiveUp registers the reception of the upstream message, and returns the context to handle it with.
+func (as *ApplicationServer) receiveUp(ctx context.Context, up *ttnpb.ApplicationUp) context.Context {
 	ctx = log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, up.EndDeviceIds))
 	ctx = events.ContextWithCorrelationID(ctx, append(up.CorrelationIds, fmt.Sprintf("as:up:%s", events.NewCorrelationID()))...)
 	up.CorrelationIds = events.CorrelationIDsFromContext(ctx)
 	registerReceiveUp(ctx, up)
+	return ctx
+}
+
+func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.ApplicationUp, link *ttnpb.ApplicationLink) error {
+	defer trace.StartRegion(ctx, "process up").End()
+
+	ctx = as.receiveUp(ctx, up)
 
 	pass, err := as.handleUp(ctx, up, link)
 	if err != nil {
@@ -444,6 +579,16 @@ func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.Applicatio
 		return nil
 	}
 
+	if webhookID := as.validatePayloadSchema(ctx, up); webhookID != "" {
+		if err := as.quarantineUp(ctx, up, webhookID); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to quarantine upstream message")
+			registerDropUp(ctx, up, err)
+			return nil
+		}
+		registerQuarantineUp(ctx, up)
+		return nil
+	}
+
 	if err := as.publishUp(ctx, up); err != nil {
 		log.FromContext(ctx).WithError(err).Warn("Failed to broadcast upstream message")
 		registerDropUp(ctx, up, err)
@@ -457,16 +602,33 @@ func (as *ApplicationServer) processUp(ctx context.Context, up *ttnpb.Applicatio
 func (as *ApplicationServer) publishUp(ctx context.Context, up *ttnpb.ApplicationUp) error {
 	defer trace.StartRegion(ctx, "publish up").End()
 
+	as.recordDownlinkResult(ctx, up)
+	up, err := as.applyRetentionPolicy(ctx, up)
+	if err != nil {
+		return err
+	}
 	if err := as.localDistributor.Publish(ctx, up); err != nil {
 		return err
 	}
 	return as.clusterDistributor.Publish(ctx, up)
 }
 
+// keepPayloadEncrypted indicates whether the application payload of the end device is kept encrypted end-to-end.
+// The AppSKey of these end devices is never requested nor stored by the Application Server.
+func (as *ApplicationServer) keepPayloadEncrypted(ids *ttnpb.EndDeviceIdentifiers) bool {
+	_, ok := as.keepPayloadEncryptedApplications[ids.GetApplicationIds().GetApplicationId()]
+	return ok
+}
+
 // skipPayloadCrypto indicates whether LoRaWAN FRMPayload encryption and decryption should be skipped.
-// This method returns true if the AppSKey of the given session is wrapped and cannot be unwrapped by the Application
-// Server, and if the end device's skip_payload_crypto_override is true or if the link's skip_payload_crypto is true.
+// This method returns true if the application payload of the end device is kept encrypted end-to-end.
+// Otherwise, this method returns true if the AppSKey of the given session is wrapped and cannot be unwrapped by the
+// Application Server, and if the end device's skip_payload_crypto_override is true or if the link's
+// skip_payload_crypto is true.
 func (as *ApplicationServer) skipPayloadCrypto(ctx context.Context, link *ttnpb.ApplicationLink, dev *ttnpb.EndDevice, session *ttnpb.Session) bool {
+	if as.keepPayloadEncrypted(dev.GetIds()) {
+		return true
+	}
 	if appSKey := session.GetKeys().GetAppSKey(); appSKey != nil {
 		if _, err := cryptoutil.UnwrapAES128Key(ctx, appSKey, as.KeyService()); err == nil {
 			return false
@@ -517,9 +679,13 @@ func (as *ApplicationServer) buildSessionsFromError(ctx context.Context, dev *tt
 	defer trace.StartRegion(ctx, "rebuild sessions from error").End()
 
 	reconstructSession := func(sessionKeyID []byte, devAddr *types.DevAddr, minFCntDown uint32) (*ttnpb.Session, error) {
-		appSKey, err := as.fetchAppSKey(ctx, dev.Ids, sessionKeyID)
-		if err != nil {
-			return nil, errFetchAppSKey.WithCause(err)
+		var appSKey *ttnpb.KeyEnvelope
+		if !as.keepPayloadEncrypted(dev.Ids) {
+			var err error
+			appSKey, err = as.fetchAppSKey(ctx, dev.Ids, sessionKeyID)
+			if err != nil {
+				return nil, errFetchAppSKey.WithCause(err)
+			}
 		}
 		return &ttnpb.Session{
 			DevAddr: devAddr.Bytes(),
@@ -830,7 +996,7 @@ func (as *ApplicationServer) DownlinkQueueList(ctx context.Context, ids *ttnpb.E
 	default:
 		return nil, errNoDeviceSession.New()
 	}
-	if session.GetKeys().GetAppSKey() == nil {
+	if session.GetKeys().GetAppSKey() == nil && !as.keepPayloadEncrypted(dev.Ids) {
 		return nil, errNoAppSKey.New()
 	}
 	queue, _ = ttnpb.PartitionDownlinksBySessionKeyIDEquality(session.Keys.SessionKeyId, res.Downlinks...)
@@ -966,9 +1132,14 @@ func (as *ApplicationServer) handleJoinAccept(
 			if dev == nil {
 				return nil, nil, errDeviceNotFound.WithAttributes("device_uid", unique.ID(ctx, ids))
 			}
-			if joinAccept.AppSKey != nil {
+			switch {
+			case as.keepPayloadEncrypted(ids):
+				// The AppSKey is not stored, even if the Network Server received it from the Join Server.
+				logger.Debug("Keep payload encrypted, skip AppSKey")
+				joinAccept.AppSKey = nil
+			case joinAccept.AppSKey != nil:
 				logger.Debug("Received AppSKey from Network Server")
-			} else {
+			default:
 				logger.Debug("Fetch AppSKey from Join Server")
 				key, err := as.fetchAppSKey(ctx, ids, joinAccept.SessionKeyId)
 				if err != nil {
@@ -1070,9 +1241,13 @@ func (as *ApplicationServer) matchSession(ctx context.Context, ids *ttnpb.EndDev
 		mask = ttnpb.AddFields(mask, "session", "pending_session")
 		logger.Debug("Switched to pending session")
 	default:
-		appSKey, err := as.fetchAppSKey(ctx, ids, sessionKeyID)
-		if err != nil {
-			return nil, errFetchAppSKey.WithCause(err)
+		var appSKey *ttnpb.KeyEnvelope
+		if !as.keepPayloadEncrypted(ids) {
+			var err error
+			appSKey, err = as.fetchAppSKey(ctx, ids, sessionKeyID)
+			if err != nil {
+				return nil, errFetchAppSKey.WithCause(err)
+			}
 		}
 		dev.Session = &ttnpb.Session{
 			DevAddr: ids.DevAddr,
@@ -1188,7 +1363,7 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 			if err != nil {
 				return nil, nil, err
 			}
-			if dev.Session.GetKeys().GetAppSKey() == nil {
+			if dev.Session.GetKeys().GetAppSKey() == nil && !as.keepPayloadEncrypted(info.ids) {
 				return nil, nil, errNoAppSKey.New()
 			}
 			return dev, mask, nil
@@ -1205,8 +1380,10 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 		if err := as.publishNormalizedUplink(ctx, info); err != nil {
 			return err
 		}
-	} else if appSKey := dev.GetSession().GetKeys().GetAppSKey(); appSKey != nil {
-		info.uplink.AppSKey = appSKey
+	} else {
+		// The AppSKey is not set if the application payload is kept encrypted end-to-end. The application decrypts the
+		// FRMPayload with the AppSKey of the session key ID in the uplink.
+		info.uplink.AppSKey = dev.GetSession().GetKeys().GetAppSKey()
 		info.uplink.LastAFCntDown = dev.Session.LastAFCntDown
 	}
 
@@ -1243,6 +1420,8 @@ func (as *ApplicationServer) handleUplink(ctx context.Context, info uplinkInfo)
 		}
 	}
 
+	as.addCoverageSamples(ctx, info.uplink)
+
 	// If the device has not been activated before, publish the activation event.
 	if dev.ActivatedAt == nil {
 		if err := as.activationPool.Publish(ctx, info.ids); err != nil {
@@ -59,6 +59,16 @@ func (r MockDeviceRegistry) Range(ctx context.Context, paths []string, f func(co
 	return nil
 }
 
+// RangeByApplication is a no-op.
+func (r MockDeviceRegistry) RangeByApplication(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	return nil
+}
+
 // MockLinkRegistry is a mock LinkRegistry used for testing.
 type MockLinkRegistry struct {
 	GetFunc   func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string) (*ttnpb.ApplicationLink, error)
@@ -18,18 +18,30 @@ import (
 	"context"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/distribution"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/mqtt"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
 	alcsyncv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/alcsync/v1"
+	awsiotv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/awsiot/v1"
+	fragmentationv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/fragmentation/v1"
+	influxdbv2 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/influxdb/v2"
 	loraclouddevicemanagementv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loradms/v1"
 	loracloudgeolocationv3 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/loragls/v3"
+	multicastsetupv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/multicastsetup/v1"
+	thingsboardv1 "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages/thingsboard/v1"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/lastseen"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/metadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/protobuf/types/known/durationpb"
@@ -115,6 +127,7 @@ type Config struct {
 	EndDeviceFetcher         EndDeviceFetcherConfig         `name:"fetcher" description:"Deprecated - End Device fetcher configuration"`
 	EndDeviceMetadataStorage EndDeviceMetadataStorageConfig `name:"end-device-metadata-storage" description:"End device metadata storage configuration"`
 	MQTT                     config.MQTT                    `name:"mqtt" description:"MQTT configuration"`
+	MQTTShaping              mqtt.ShapingConfig             `name:"mqtt-shaping" description:"MQTT frontend publish rate shaping configuration"`
 	Webhooks                 WebhooksConfig                 `name:"webhooks" description:"Webhooks configuration"`
 	PubSub                   PubSubConfig                   `name:"pubsub" description:"Pub/sub messaging configuration"`
 	Packages                 ApplicationPackagesConfig      `name:"packages" description:"Application packages configuration"`
@@ -122,6 +135,86 @@ type Config struct {
 	DeviceKEKLabel           string                         `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DeviceLastSeen           LastSeenConfig                 `name:"device-last-seen" description:"End Device last seen batch update configuration"`
 	Downlinks                DownlinksConfig                `name:"downlinks" description:"Downlink configuration"`
+	KeepPayloadEncrypted     KeepPayloadEncryptedConfig     `name:"keep-payload-encrypted" description:"End-to-end encrypted application payload configuration"`
+	UplinkPipeline           UplinkPipelineConfig           `name:"uplink-pipeline" description:"Asynchronous upstream message processing pipeline configuration"`
+	Coverage                 CoverageConfig                 `name:"coverage" description:"Gateway coverage estimation configuration"`
+	Retention                RetentionConfig                `name:"retention" description:"Data retention policies configuration"`
+	DownlinkResults          DownlinkResultsConfig          `name:"downlink-results" description:"Downlink result tracking configuration"`
+	FPortFilters             FPortFiltersConfig             `name:"fport-filters" description:"FPort filters of integrations configuration"`
+	PayloadSchema            PayloadSchemaConfig            `name:"payload-schema" description:"Uplink payload schema validation configuration"`
+}
+
+// PayloadSchemaConfig defines the configuration of the validation of decoded uplink payloads against JSON schemas.
+// If enabled, applications can configure a JSON schema for their decoded uplink payloads. Uplink messages that fail
+// validation are tagged with decoded payload warnings, and optionally sent to a quarantine webhook only.
+type PayloadSchemaConfig struct {
+	Registry payloadschema.Registry `name:"-"`
+	Enable   bool                   `name:"enable" description:"Enable payload schema validation of applications"`
+}
+
+// FPortFiltersConfig defines the configuration of the FPort filters of webhooks and pub/sub integrations.
+// If enabled, integrations can be configured to only receive the upstream messages with FPorts in given ranges.
+type FPortFiltersConfig struct {
+	Registry fportfilter.Registry `name:"-"`
+	Enable   bool                 `name:"enable" description:"Enable FPort filters of webhooks and pub/sub integrations"`
+}
+
+// DownlinkResultsConfig defines the configuration of the downlink result tracking.
+// If enabled, the results of downlink messages are stored by correlation ID, so that applications can await the
+// terminal result of a downlink message.
+type DownlinkResultsConfig struct {
+	Registry downlinkresult.Registry `name:"-"`
+	Enable   bool                    `name:"enable" description:"Track the results of downlink messages by correlation ID"`
+	TTL      time.Duration           `name:"ttl" description:"Time after which the downlink results of an end device expire"`
+	MaxWait  time.Duration           `name:"max-wait" description:"Maximum duration to wait for the result of a downlink message"`
+}
+
+// RetentionConfig defines the configuration of the data retention policies of applications.
+// If enabled, applications can configure how long their uplink messages and historical events are retained, and
+// whether the raw application payload is forwarded. The policies are enforced by a periodic cleanup task.
+type RetentionConfig struct {
+	Registry             retention.Registry `name:"-"`
+	Enable               bool               `name:"enable" description:"Enable data retention policies of applications"`
+	MaxUplinkStorageDays uint32             `name:"max-uplink-storage-days" description:"Maximum number of days that uplink messages are retained (0 is unlimited)"`
+	MaxEventHistoryDays  uint32             `name:"max-event-history-days" description:"Maximum number of days that historical events are retained (0 is unlimited)"`
+	CleanupInterval      time.Duration      `name:"cleanup-interval" description:"Interval at which the retention policies are enforced"`
+}
+
+func (c RetentionConfig) caps() retention.Caps {
+	return retention.Caps{
+		MaxUplinkStorageDays: c.MaxUplinkStorageDays,
+		MaxEventHistoryDays:  c.MaxEventHistoryDays,
+	}
+}
+
+// CoverageConfig defines the configuration of the gateway coverage estimation.
+// If enabled, the RX metadata of uplink messages of end devices with a known location is aggregated into coverage
+// buckets of the receiving gateways.
+type CoverageConfig struct {
+	Registry  coverage.Registry `name:"-"`
+	Enable    bool              `name:"enable" description:"Aggregate the RX metadata of uplink messages into gateway coverage maps"`
+	Precision int               `name:"geohash-precision" description:"Geohash precision of the coverage buckets (1-12)"`
+	TTL       time.Duration     `name:"ttl" description:"Time after which the coverage of a gateway expires when no uplink messages are received"`
+}
+
+// UplinkPipelineConfig defines the configuration of the asynchronous upstream message processing pipeline.
+// If enabled, upstream messages are processed and published to the integrations in independent stages, which are
+// connected by durable queues. Messages are acknowledged only once they are handled by a stage, so that they are
+// retried instead of dropped when a stage is slow or temporarily unavailable.
+type UplinkPipelineConfig struct {
+	Queue         UplinkQueue   `name:"-"`
+	Enable        bool          `name:"enable" description:"Process upstream messages asynchronously in stages connected by durable queues"`
+	QueueSize     int64         `name:"queue-size" description:"Approximate maximum number of queued upstream messages per stage"`
+	NumConsumers  int           `name:"num-consumers" description:"Number of consumers per stage"`
+	BatchSize     int           `name:"batch-size" description:"Maximum number of upstream messages handled by a consumer at once"`
+	RetryInterval time.Duration `name:"retry-interval" description:"Time after which upstream messages that are not acknowledged are retried"`
+}
+
+// KeepPayloadEncryptedConfig defines the applications of which the application payload is kept encrypted end-to-end.
+// The Application Server never requests the AppSKey of end devices of these applications, and forwards the
+// FRMPayload encrypted, together with the session key ID, so that the application can decrypt the FRMPayload.
+type KeepPayloadEncryptedConfig struct {
+	Applications []string `name:"applications" description:"IDs of the applications of which the AppSKey is never requested and the FRMPayload is forwarded encrypted"`
 }
 
 func (c Config) toProto() *ttnpb.AsConfiguration {
@@ -153,6 +246,7 @@ type WebhooksConfig struct {
 	UnhealthyRetryInterval     time.Duration       `name:"unhealthy-retry-interval" description:"Time interval after which disabled webhooks may execute again"`
 	Templates                  web.TemplatesConfig `name:"templates" description:"The store of the webhook templates"`
 	Downlinks                  web.DownlinksConfig `name:"downlink" description:"The downlink queue operations configuration"`
+	EncryptionKeyID            string              `name:"encryption-key-id" description:"ID of the key used to encrypt secret template fields at rest"`
 }
 
 func (c WebhooksConfig) toProto() *ttnpb.AsConfiguration_Webhooks {
@@ -205,7 +299,8 @@ type GlobalDistributorConfig struct {
 type PubSubConfig struct {
 	Registry pubsub.Registry `name:"-"`
 
-	Providers map[string]string `name:"providers" description:"Controls the status of each provider (enabled, disabled, warning)"`
+	Providers   map[string]string        `name:"providers" description:"Controls the status of each provider (enabled, disabled, warning)"`
+	Supervision pubsub.SupervisionConfig `name:"supervision" description:"Supervision of the pub/sub connections"`
 }
 
 func (c PubSubConfig) toProto() *ttnpb.AsConfiguration_PubSub {
@@ -241,7 +336,13 @@ type ApplicationPackagesConfig struct {
 
 // NewWebhooks returns a new web.Webhooks based on the configuration.
 // If Target is empty, this method returns nil.
-func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.Webhooks, error) {
+func (c WebhooksConfig) NewWebhooks(
+	ctx context.Context,
+	server io.Server,
+	templates web.TemplateStore,
+	keyService crypto.KeyService,
+	fPortFilters fportfilter.Registry,
+) (web.Webhooks, error) {
 	var sink web.Sink
 	switch c.Target {
 	case "":
@@ -261,20 +362,31 @@ func (c WebhooksConfig) NewWebhooks(ctx context.Context, server io.Server) (web.
 	if c.Registry == nil {
 		return nil, errWebhooksRegistry.New()
 	}
+	webhookRegistry := c.Registry
+	if c.EncryptionKeyID != "" {
+		webhookRegistry = web.NewTemplateSecretsRegistry(webhookRegistry, templates, keyService, c.EncryptionKeyID)
+	}
+	opts := []web.Option{web.WithTemplateStore(templates)}
+	if fPortFilters != nil {
+		webhookRegistry = web.NewFPortFilterRegistry(webhookRegistry, fPortFilters)
+		opts = append(opts, web.WithFPortFilters(fPortFilters))
+	}
 	if c.UnhealthyAttemptsThreshold > 0 || c.UnhealthyRetryInterval > 0 {
-		registry := web.NewHealthStatusRegistry(c.Registry)
+		registry := web.NewHealthStatusRegistry(webhookRegistry)
 		registry = web.NewCachedHealthStatusRegistry(registry)
 		sink = web.NewHealthCheckSink(sink, registry, c.UnhealthyAttemptsThreshold, c.UnhealthyRetryInterval)
 	}
 	if c.QueueSize > 0 || c.Workers > 0 {
 		sink = web.NewPooledSink(ctx, server, sink, c.Workers, c.QueueSize)
 	}
-	return web.NewWebhooks(ctx, server, c.Registry, sink, c.Downlinks)
+	return web.NewWebhooks(ctx, server, webhookRegistry, sink, c.Downlinks, opts...)
 }
 
 // NewPubSub returns a new pubsub.PubSub based on the configuration.
 // If the registry is nil, it returns nil.
-func (c PubSubConfig) NewPubSub(comp *component.Component, server io.Server) (*pubsub.PubSub, error) {
+func (c PubSubConfig) NewPubSub(
+	comp *component.Component, server io.Server, fPortFilters fportfilter.Registry,
+) (*pubsub.PubSub, error) {
 	if c.Registry == nil {
 		return nil, nil
 	}
@@ -282,7 +394,11 @@ func (c PubSubConfig) NewPubSub(comp *component.Component, server io.Server) (*p
 	if err != nil {
 		return nil, err
 	}
-	return pubsub.New(comp, server, c.Registry, statuses)
+	opts := []pubsub.Option{pubsub.WithSupervision(c.Supervision)}
+	if fPortFilters != nil {
+		opts = append(opts, pubsub.WithFPortFilters(fPortFilters))
+	}
+	return pubsub.New(comp, server, c.Registry, statuses, opts...)
 }
 
 // NewApplicationPackages returns a new applications packages frontend based on the configuration.
@@ -302,6 +418,21 @@ func (c ApplicationPackagesConfig) NewApplicationPackages(ctx context.Context, s
 	// Initialize LoRa Application Layer Clock Synchronization v1 package handler.
 	handlers[alcsyncv1.PackageName] = alcsyncv1.New(server, c.Registry)
 
+	// Initialize LoRa Fragmented Data Block Transport v1 package handler.
+	handlers[fragmentationv1.PackageName] = fragmentationv1.New(server, c.Registry)
+
+	// Initialize LoRa Remote Multicast Setup v1 package handler.
+	handlers[multicastsetupv1.PackageName] = multicastsetupv1.New(server, c.Registry)
+
+	// Initialize AWS IoT v1 package handler.
+	handlers[awsiotv1.PackageName] = awsiotv1.New(server, c.Registry)
+
+	// Initialize InfluxDB v2 package handler.
+	handlers[influxdbv2.PackageName] = influxdbv2.New(server, c.Registry)
+
+	// Initialize ThingsBoard v1 package handler.
+	handlers[thingsboardv1.PackageName] = thingsboardv1.New(server, c.Registry)
+
 	return packages.New(ctx, server, c.Registry, handlers, c.Workers, c.Timeout)
 }
 
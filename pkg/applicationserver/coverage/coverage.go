@@ -0,0 +1,114 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package coverage aggregates the RX metadata of uplink messages of end devices with a known location into
+// coverage maps of the receiving gateways. The coverage of a gateway consists of buckets of geohash cells
+// with the RSSI and SNR statistics of the uplink messages that were sent from within the cell.
+package coverage
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Stats are the statistics of a signal quality metric in a bucket.
+type Stats struct {
+	Min  float64 `json:"min"`
+	Max  float64 `json:"max"`
+	Mean float64 `json:"mean"`
+}
+
+// Bucket is the coverage of a gateway in a geohash cell.
+type Bucket struct {
+	Geohash    string    `json:"geohash"`
+	Count      uint64    `json:"count"`
+	RSSI       Stats     `json:"rssi"`
+	SNR        Stats     `json:"snr"`
+	LastSeenAt time.Time `json:"last_seen_at"`
+}
+
+// Sample is the signal quality of an uplink message received by a gateway from within a geohash cell.
+type Sample struct {
+	Geohash    string
+	RSSI       float32
+	SNR        float32
+	ReceivedAt time.Time
+}
+
+// Registry stores the coverage buckets of gateways.
+type Registry interface {
+	// Add adds the sample to the bucket of the gateway.
+	Add(ctx context.Context, ids *ttnpb.GatewayIdentifiers, sample Sample) error
+	// Range calls f for the buckets of the gateway, until f returns false.
+	Range(ctx context.Context, ids *ttnpb.GatewayIdentifiers, f func(*Bucket) bool) error
+}
+
+// frmPayloadLocationService is the service of locations that are decoded from the application payload.
+const frmPayloadLocationService = "frm-payload"
+
+// uplinkLocation returns the location from which the uplink message was sent. Locations decoded from the
+// application payload take precedence, as these are typically measured by GNSS when the uplink is sent.
+func uplinkLocation(up *ttnpb.ApplicationUplink) *ttnpb.Location {
+	if loc := up.GetLocations()[frmPayloadLocationService]; loc != nil {
+		return loc
+	}
+	keys := make([]string, 0, len(up.GetLocations()))
+	for key := range up.GetLocations() {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if loc := up.GetLocations()[key]; loc != nil {
+			return loc
+		}
+	}
+	return nil
+}
+
+// GatewaySample is a sample of a gateway.
+type GatewaySample struct {
+	GatewayIds *ttnpb.GatewayIdentifiers
+	Sample
+}
+
+// Samples returns the samples of the gateways that received the uplink message, if the location of the end device
+// is known. Gateways that are not identified, such as gateways of Packet Broker, are skipped.
+func Samples(up *ttnpb.ApplicationUplink, precision int) []GatewaySample {
+	loc := uplinkLocation(up)
+	if loc == nil || math.IsNaN(loc.Latitude) || math.IsNaN(loc.Longitude) {
+		return nil
+	}
+	geohash := EncodeGeohash(loc.Latitude, loc.Longitude, precision)
+	receivedAt := up.GetReceivedAt().AsTime()
+	samples := make([]GatewaySample, 0, len(up.GetRxMetadata()))
+	for _, md := range up.GetRxMetadata() {
+		if md.GetGatewayIds().GetGatewayId() == "" || md.GetPacketBroker() != nil {
+			continue
+		}
+		samples = append(samples, GatewaySample{
+			GatewayIds: md.GetGatewayIds(),
+			Sample: Sample{
+				Geohash:    geohash,
+				RSSI:       md.GetRssi(),
+				SNR:        md.GetSnr(),
+				ReceivedAt: receivedAt,
+			},
+		})
+	}
+	return samples
+}
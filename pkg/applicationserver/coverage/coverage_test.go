@@ -0,0 +1,80 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestSamples(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	receivedAt := time.Unix(1000, 0).UTC()
+	rxMetadata := []*ttnpb.RxMetadata{
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}, Rssi: -100, Snr: 5},
+		{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}, Rssi: -120, Snr: -10},
+		{
+			GatewayIds:   &ttnpb.GatewayIdentifiers{GatewayId: "packetbroker"},
+			PacketBroker: &ttnpb.PacketBrokerMetadata{},
+			Rssi:         -90,
+		},
+	}
+
+	// Without location.
+	a.So(Samples(&ttnpb.ApplicationUplink{
+		RxMetadata: rxMetadata,
+		ReceivedAt: timestamppb.New(receivedAt),
+	}, 7), should.BeEmpty)
+
+	// The location from the payload takes precedence.
+	samples := Samples(&ttnpb.ApplicationUplink{
+		RxMetadata: rxMetadata,
+		ReceivedAt: timestamppb.New(receivedAt),
+		Locations: map[string]*ttnpb.Location{
+			"user":        {Latitude: 48.8566, Longitude: 2.3522},
+			"frm-payload": {Latitude: 52.3676, Longitude: 4.9041},
+		},
+	}, 7)
+	a.So(samples, should.Resemble, []GatewaySample{
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"},
+			Sample:     Sample{Geohash: "u173zt5", RSSI: -100, SNR: 5, ReceivedAt: receivedAt},
+		},
+		{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+			Sample:     Sample{Geohash: "u173zt5", RSSI: -120, SNR: -10, ReceivedAt: receivedAt},
+		},
+	})
+
+	// Other locations are used if the payload does not contain a location.
+	samples = Samples(&ttnpb.ApplicationUplink{
+		RxMetadata: rxMetadata[:1],
+		ReceivedAt: timestamppb.New(receivedAt),
+		Locations: map[string]*ttnpb.Location{
+			"user": {Latitude: 48.8566, Longitude: 2.3522},
+		},
+	}, 5)
+	if a.So(samples, should.HaveLength, 1) {
+		a.So(samples[0].Geohash, should.Equal, "u09tv")
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// MaxGeohashPrecision is the maximum number of characters of a geohash.
+const MaxGeohashPrecision = 12
+
+var errGeohash = errors.DefineInvalidArgument("geohash", "invalid geohash `{geohash}`")
+
+// Cell is the bounding box of a geohash cell in degrees.
+type Cell struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// Center returns the center of the cell.
+func (c Cell) Center() (latitude, longitude float64) {
+	return (c.MinLatitude + c.MaxLatitude) / 2, (c.MinLongitude + c.MaxLongitude) / 2
+}
+
+// Intersects returns whether the cell intersects with the other cell.
+func (c Cell) Intersects(other Cell) bool {
+	return c.MinLatitude <= other.MaxLatitude && c.MaxLatitude >= other.MinLatitude &&
+		c.MinLongitude <= other.MaxLongitude && c.MaxLongitude >= other.MinLongitude
+}
+
+// EncodeGeohash returns the geohash of the location with the given precision.
+func EncodeGeohash(latitude, longitude float64, precision int) string {
+	if precision < 1 {
+		precision = 1
+	}
+	if precision > MaxGeohashPrecision {
+		precision = MaxGeohashPrecision
+	}
+	cell := Cell{MinLatitude: -90, MinLongitude: -180, MaxLatitude: 90, MaxLongitude: 180}
+	var (
+		sb   strings.Builder
+		bits int
+		ch   int
+		even = true
+	)
+	for sb.Len() < precision {
+		if even {
+			if mid := (cell.MinLongitude + cell.MaxLongitude) / 2; longitude >= mid {
+				ch = ch<<1 | 1
+				cell.MinLongitude = mid
+			} else {
+				ch <<= 1
+				cell.MaxLongitude = mid
+			}
+		} else {
+			if mid := (cell.MinLatitude + cell.MaxLatitude) / 2; latitude >= mid {
+				ch = ch<<1 | 1
+				cell.MinLatitude = mid
+			} else {
+				ch <<= 1
+				cell.MaxLatitude = mid
+			}
+		}
+		even = !even
+		if bits++; bits == 5 {
+			sb.WriteByte(geohashAlphabet[ch])
+			bits, ch = 0, 0
+		}
+	}
+	return sb.String()
+}
+
+// DecodeGeohash returns the cell of the geohash.
+func DecodeGeohash(geohash string) (Cell, error) {
+	if len(geohash) == 0 || len(geohash) > MaxGeohashPrecision {
+		return Cell{}, errGeohash.WithAttributes("geohash", geohash)
+	}
+	cell := Cell{MinLatitude: -90, MinLongitude: -180, MaxLatitude: 90, MaxLongitude: 180}
+	even := true
+	for i := 0; i < len(geohash); i++ {
+		ch := strings.IndexByte(geohashAlphabet, geohash[i])
+		if ch < 0 {
+			return Cell{}, errGeohash.WithAttributes("geohash", geohash)
+		}
+		for mask := 16; mask > 0; mask >>= 1 {
+			if even {
+				mid := (cell.MinLongitude + cell.MaxLongitude) / 2
+				if ch&mask != 0 {
+					cell.MinLongitude = mid
+				} else {
+					cell.MaxLongitude = mid
+				}
+			} else {
+				mid := (cell.MinLatitude + cell.MaxLatitude) / 2
+				if ch&mask != 0 {
+					cell.MinLatitude = mid
+				} else {
+					cell.MaxLatitude = mid
+				}
+			}
+			even = !even
+		}
+	}
+	return cell, nil
+}
@@ -0,0 +1,90 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGeohash(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	for _, tc := range []struct {
+		Latitude, Longitude float64
+		Precision           int
+		Geohash             string
+	}{
+		{Latitude: 57.64911, Longitude: 10.40744, Precision: 11, Geohash: "u4pruydqqvj"},
+		{Latitude: 52.3676, Longitude: 4.9041, Precision: 7, Geohash: "u173zt5"},
+		{Latitude: -33.8688, Longitude: 151.2093, Precision: 5, Geohash: "r3gx2"},
+		{Latitude: 0, Longitude: 0, Precision: 1, Geohash: "s"},
+	} {
+		geohash := EncodeGeohash(tc.Latitude, tc.Longitude, tc.Precision)
+		a.So(geohash, should.Equal, tc.Geohash)
+
+		cell, err := DecodeGeohash(geohash)
+		if a.So(err, should.BeNil) {
+			a.So(tc.Latitude, should.BeBetweenOrEqual, cell.MinLatitude, cell.MaxLatitude)
+			a.So(tc.Longitude, should.BeBetweenOrEqual, cell.MinLongitude, cell.MaxLongitude)
+		}
+	}
+
+	a.So(EncodeGeohash(52.3676, 4.9041, 0), should.HaveLength, 1)
+	a.So(EncodeGeohash(52.3676, 4.9041, 20), should.HaveLength, MaxGeohashPrecision)
+
+	for _, geohash := range []string{"", "u173zqa", "u173zt5u173zt5"} {
+		_, err := DecodeGeohash(geohash)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
+
+func TestTileCell(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	cell, err := TileCell(0, 0, 0)
+	if a.So(err, should.BeNil) {
+		a.So(cell.MinLongitude, should.Equal, -180)
+		a.So(cell.MaxLongitude, should.Equal, 180)
+		a.So(cell.MaxLatitude, should.AlmostEqual, 85.0511, 1e-4)
+		a.So(cell.MinLatitude, should.AlmostEqual, -85.0511, 1e-4)
+	}
+
+	// Amsterdam at zoom level 10.
+	cell, err = TileCell(10, 525, 336)
+	if a.So(err, should.BeNil) {
+		a.So(52.3676, should.BeBetweenOrEqual, cell.MinLatitude, cell.MaxLatitude)
+		a.So(4.9041, should.BeBetweenOrEqual, cell.MinLongitude, cell.MaxLongitude)
+		geohashCell, err := DecodeGeohash(EncodeGeohash(52.3676, 4.9041, 7))
+		if a.So(err, should.BeNil) {
+			a.So(cell.Intersects(geohashCell), should.BeTrue)
+		}
+	}
+
+	for _, zxy := range [][3]int{
+		{1, 2, 0},
+		{23, 0, 0},
+		{-1, 0, 0},
+	} {
+		_, err := TileCell(zxy[0], zxy[1], zxy[2])
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
@@ -0,0 +1,144 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the coverage registry of the Application Server using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database corruption")
+
+// bucket is the stored coverage bucket. The sums are stored so that the mean can be updated atomically.
+type bucket struct {
+	Count    uint64  `json:"count"`
+	RSSIMin  float64 `json:"rssi_min"`
+	RSSIMax  float64 `json:"rssi_max"`
+	RSSISum  float64 `json:"rssi_sum"`
+	SNRMin   float64 `json:"snr_min"`
+	SNRMax   float64 `json:"snr_max"`
+	SNRSum   float64 `json:"snr_sum"`
+	LastSeen int64   `json:"last_seen"`
+}
+
+// addSampleScript adds the sample in ARGV to the bucket of the geohash in the hash KEYS[1].
+// ARGV: geohash, RSSI, SNR, received at (Unix seconds), TTL (milliseconds).
+var addSampleScript = redis.NewScript(`local rssi = tonumber(ARGV[2])
+local snr = tonumber(ARGV[3])
+local t = tonumber(ARGV[4])
+local b
+local v = redis.call('hget', KEYS[1], ARGV[1])
+if v then
+	b = cjson.decode(v)
+	b.count = b.count + 1
+	b.rssi_min = math.min(b.rssi_min, rssi)
+	b.rssi_max = math.max(b.rssi_max, rssi)
+	b.rssi_sum = b.rssi_sum + rssi
+	b.snr_min = math.min(b.snr_min, snr)
+	b.snr_max = math.max(b.snr_max, snr)
+	b.snr_sum = b.snr_sum + snr
+	b.last_seen = math.max(b.last_seen, t)
+else
+	b = {
+		count = 1,
+		rssi_min = rssi, rssi_max = rssi, rssi_sum = rssi,
+		snr_min = snr, snr_max = snr, snr_sum = snr,
+		last_seen = t,
+	}
+end
+redis.call('hset', KEYS[1], ARGV[1], cjson.encode(b))
+local ttl = tonumber(ARGV[5])
+if ttl > 0 then
+	redis.call('pexpire', KEYS[1], ttl)
+end
+return 1`)
+
+// Registry is an implementation of coverage.Registry.
+// The buckets of a gateway are stored in a hash keyed by geohash, which expires after TTL without new samples.
+type Registry struct {
+	Redis *ttnredis.Client
+	TTL   time.Duration
+}
+
+func (r *Registry) key(ctx context.Context, ids *ttnpb.GatewayIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids))
+}
+
+// Add implements coverage.Registry.
+func (r *Registry) Add(ctx context.Context, ids *ttnpb.GatewayIdentifiers, sample coverage.Sample) error {
+	if err := addSampleScript.Run(ctx, r.Redis, []string{r.key(ctx, ids)},
+		sample.Geohash,
+		sample.RSSI,
+		sample.SNR,
+		sample.ReceivedAt.Unix(),
+		r.TTL.Milliseconds(),
+	).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+const rangeScanCount = 1000
+
+// Range implements coverage.Registry.
+func (r *Registry) Range(ctx context.Context, ids *ttnpb.GatewayIdentifiers, f func(*coverage.Bucket) bool) error {
+	k := r.key(ctx, ids)
+	var cursor uint64
+	for {
+		kvs, next, err := r.Redis.HScan(ctx, k, cursor, "", rangeScanCount).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for i := 0; i+1 < len(kvs); i += 2 {
+			stored := &bucket{}
+			if err := json.Unmarshal([]byte(kvs[i+1]), stored); err != nil {
+				return errDatabaseCorruption.WithCause(err)
+			}
+			if stored.Count == 0 {
+				continue
+			}
+			if !f(&coverage.Bucket{
+				Geohash: kvs[i],
+				Count:   stored.Count,
+				RSSI: coverage.Stats{
+					Min:  stored.RSSIMin,
+					Max:  stored.RSSIMax,
+					Mean: stored.RSSISum / float64(stored.Count),
+				},
+				SNR: coverage.Stats{
+					Min:  stored.SNRMin,
+					Max:  stored.SNRMax,
+					Mean: stored.SNRSum / float64(stored.Count),
+				},
+				LastSeenAt: time.Unix(stored.LastSeen, 0).UTC(),
+			}) {
+				return nil
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
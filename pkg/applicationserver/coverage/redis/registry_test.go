@@ -0,0 +1,92 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.Registry{Redis: cl, TTL: time.Hour}
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}
+
+	rangeBuckets := func() []*coverage.Bucket {
+		var buckets []*coverage.Bucket
+		a.So(r.Range(ctx, ids, func(bucket *coverage.Bucket) bool {
+			buckets = append(buckets, bucket)
+			return true
+		}), should.BeNil)
+		return buckets
+	}
+	a.So(rangeBuckets(), should.BeEmpty)
+
+	for _, sample := range []coverage.Sample{
+		{Geohash: "u173zt5", RSSI: -100, SNR: 5, ReceivedAt: time.Unix(100, 0)},
+		{Geohash: "u173zt5", RSSI: -110, SNR: -5, ReceivedAt: time.Unix(300, 0)},
+		{Geohash: "u173zt5", RSSI: -90, SNR: 3, ReceivedAt: time.Unix(200, 0)},
+		{Geohash: "u09tvw0", RSSI: -120, SNR: -15, ReceivedAt: time.Unix(400, 0)},
+	} {
+		if !a.So(r.Add(ctx, ids, sample), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	buckets := make(map[string]*coverage.Bucket)
+	for _, bucket := range rangeBuckets() {
+		buckets[bucket.Geohash] = bucket
+	}
+	a.So(buckets, should.Resemble, map[string]*coverage.Bucket{
+		"u173zt5": {
+			Geohash:    "u173zt5",
+			Count:      3,
+			RSSI:       coverage.Stats{Min: -110, Max: -90, Mean: -100},
+			SNR:        coverage.Stats{Min: -5, Max: 5, Mean: 1},
+			LastSeenAt: time.Unix(300, 0).UTC(),
+		},
+		"u09tvw0": {
+			Geohash:    "u09tvw0",
+			Count:      1,
+			RSSI:       coverage.Stats{Min: -120, Max: -120, Mean: -120},
+			SNR:        coverage.Stats{Min: -15, Max: -15, Mean: -15},
+			LastSeenAt: time.Unix(400, 0).UTC(),
+		},
+	})
+
+	var n int
+	a.So(r.Range(ctx, ids, func(*coverage.Bucket) bool {
+		n++
+		return false
+	}), should.BeNil)
+	a.So(n, should.Equal, 1)
+
+	ttl, err := cl.PTTL(ctx, cl.Key("uid", "gtw-1")).Result()
+	if a.So(err, should.BeNil) {
+		a.So(ttl, should.BeGreaterThan, 59*time.Minute)
+	}
+}
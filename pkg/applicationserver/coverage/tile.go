@@ -0,0 +1,43 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package coverage
+
+import (
+	"math"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// MaxTileZoom is the maximum zoom level of coverage tiles.
+const MaxTileZoom = 22
+
+var errTile = errors.DefineInvalidArgument("tile", "invalid tile `{z}/{x}/{y}`")
+
+// TileCell returns the cell of the Web Mercator map tile z/x/y, as used by slippy maps.
+func TileCell(z, x, y int) (Cell, error) {
+	if z < 0 || z > MaxTileZoom || x < 0 || y < 0 || x >= 1<<z || y >= 1<<z {
+		return Cell{}, errTile.WithAttributes("z", z, "x", x, "y", y)
+	}
+	n := float64(int(1) << z)
+	latitude := func(y float64) float64 {
+		return math.Atan(math.Sinh(math.Pi*(1-2*y/n))) * 180 / math.Pi
+	}
+	return Cell{
+		MinLatitude:  latitude(float64(y + 1)),
+		MinLongitude: float64(x)/n*360 - 180,
+		MaxLatitude:  latitude(float64(y)),
+		MaxLongitude: float64(x+1)/n*360 - 180,
+	}, nil
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errDecodePHYPayload    = errors.DefineInvalidArgument("decode_phy_payload", "decode PHYPayload")
+	errNotDataFrame        = errors.DefineInvalidArgument("not_data_frame", "PHYPayload is not a data frame")
+	errNoSessionForDevAddr = errors.DefineNotFound(
+		"no_session_for_dev_addr", "no session of the end device with DevAddr `{dev_addr}`",
+	)
+)
+
+// decryptPHYPayload decrypts the application payload of the data frame PHYPayload with the AppSKey.
+// If fullFCnt is nil, the frame counter is inferred from lastAFCntDown for downlinks.
+func decryptPHYPayload(
+	msg *ttnpb.Message, appSKey types.AES128Key, fullFCnt *uint32, lastAFCntDown uint32,
+) (*ttnpb.DecodeAsPHYPayloadResponse, error) {
+	pld := msg.GetMacPayload()
+	devAddr := types.MustDevAddr(pld.FHdr.DevAddr).OrZero()
+	res := &ttnpb.DecodeAsPHYPayloadResponse{
+		DevAddr:  devAddr.Bytes(),
+		FullFCnt: pld.FHdr.FCnt,
+		FPort:    pld.FPort,
+	}
+	var downlink bool
+	switch msg.MHdr.MType {
+	case ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP:
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		downlink = true
+		if res.FullFCnt < lastAFCntDown&0xffff && lastAFCntDown < 0xffff0000 {
+			lastAFCntDown += 0x10000
+		}
+		res.FullFCnt = lastAFCntDown&^0xffff | pld.FHdr.FCnt
+	default:
+		return nil, errNotDataFrame.New()
+	}
+	if fullFCnt != nil {
+		res.FullFCnt = *fullFCnt
+	}
+	if pld.FPort == 0 || len(pld.FrmPayload) == 0 {
+		return res, nil
+	}
+	decrypt := crypto.DecryptUplink
+	if downlink {
+		decrypt = crypto.DecryptDownlink
+	}
+	frmPayload, err := decrypt(appSKey, devAddr, res.FullFCnt, pld.FrmPayload)
+	if err != nil {
+		return nil, err
+	}
+	res.FrmPayload = frmPayload
+	return res, nil
+}
+
+// DecodePHYPayload implements ttnpb.AsServer.
+// The application payload is decrypted with the AppSKey of the current or pending session of the end device.
+func (as *ApplicationServer) DecodePHYPayload(
+	ctx context.Context, req *ttnpb.DecodeAsPHYPayloadRequest,
+) (*ttnpb.DecodeAsPHYPayloadResponse, error) {
+	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(req.PhyPayload, msg); err != nil {
+		return nil, errDecodePHYPayload.WithCause(err)
+	}
+	pld := msg.GetMacPayload()
+	if pld == nil {
+		return nil, errNotDataFrame.New()
+	}
+	devAddr := types.MustDevAddr(pld.FHdr.DevAddr).OrZero()
+
+	dev, err := as.deviceRegistry.Get(ctx, req.EndDeviceIds, []string{"pending_session", "session"})
+	if err != nil {
+		return nil, err
+	}
+	session, pending := dev.Session, false
+	if !devAddr.Equal(types.MustDevAddr(session.GetDevAddr()).OrZero()) {
+		session, pending = dev.PendingSession, true
+		if !devAddr.Equal(types.MustDevAddr(session.GetDevAddr()).OrZero()) {
+			return nil, errNoSessionForDevAddr.WithAttributes("dev_addr", devAddr)
+		}
+	}
+	if session.GetKeys().GetAppSKey() == nil {
+		return nil, errNoAppSKey.New()
+	}
+	appSKey, err := cryptoutil.UnwrapAES128Key(ctx, session.Keys.AppSKey, as.KeyService())
+	if err != nil {
+		return nil, err
+	}
+	var fullFCnt *uint32
+	if req.FullFCnt != nil {
+		fullFCnt = &req.FullFCnt.Value
+	}
+	res, err := decryptPHYPayload(msg, appSKey, fullFCnt, session.LastAFCntDown)
+	if err != nil {
+		return nil, err
+	}
+	res.PendingSession = pending
+	return res, nil
+}
@@ -0,0 +1,125 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecryptPHYPayload(t *testing.T) {
+	t.Parallel()
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	appSKey := types.AES128Key{0x01}
+	frmPayload := []byte{0x01, 0x02, 0x03}
+	makeMessage := func(mType ttnpb.MType, fCnt uint32, fPort uint32, frmPayload []byte) *ttnpb.Message {
+		return &ttnpb.Message{
+			MHdr: &ttnpb.MHDR{MType: mType, Major: ttnpb.Major_LORAWAN_R1},
+			Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: devAddr.Bytes(),
+					FCtrl:   &ttnpb.FCtrl{},
+					FCnt:    fCnt & 0xffff,
+				},
+				FPort:      fPort,
+				FrmPayload: frmPayload,
+			}},
+		}
+	}
+	fullFCnt := uint32(0x10042)
+
+	for _, tc := range []struct {
+		Name          string
+		Message       *ttnpb.Message
+		FullFCnt      *uint32
+		LastAFCntDown uint32
+		Expected      *ttnpb.DecodeAsPHYPayloadResponse
+	}{
+		{
+			Name: "Uplink",
+			Message: makeMessage(ttnpb.MType_UNCONFIRMED_UP, 0x42, 1, func() []byte {
+				b, _ := crypto.EncryptUplink(appSKey, devAddr, 0x42, frmPayload)
+				return b
+			}()),
+			Expected: &ttnpb.DecodeAsPHYPayloadResponse{
+				DevAddr:    devAddr.Bytes(),
+				FullFCnt:   0x42,
+				FPort:      1,
+				FrmPayload: frmPayload,
+			},
+		},
+		{
+			Name: "Uplink/FullFCnt",
+			Message: makeMessage(ttnpb.MType_CONFIRMED_UP, fullFCnt, 2, func() []byte {
+				b, _ := crypto.EncryptUplink(appSKey, devAddr, fullFCnt, frmPayload)
+				return b
+			}()),
+			FullFCnt: &fullFCnt,
+			Expected: &ttnpb.DecodeAsPHYPayloadResponse{
+				DevAddr:    devAddr.Bytes(),
+				FullFCnt:   fullFCnt,
+				FPort:      2,
+				FrmPayload: frmPayload,
+			},
+		},
+		{
+			Name: "Downlink",
+			Message: makeMessage(ttnpb.MType_UNCONFIRMED_DOWN, 0x20001, 3, func() []byte {
+				b, _ := crypto.EncryptDownlink(appSKey, devAddr, 0x20001, frmPayload)
+				return b
+			}()),
+			LastAFCntDown: 0x1fffe,
+			Expected: &ttnpb.DecodeAsPHYPayloadResponse{
+				DevAddr:    devAddr.Bytes(),
+				FullFCnt:   0x20001,
+				FPort:      3,
+				FrmPayload: frmPayload,
+			},
+		},
+		{
+			Name:    "FPort 0",
+			Message: makeMessage(ttnpb.MType_UNCONFIRMED_UP, 0x42, 0, []byte{0x02}),
+			Expected: &ttnpb.DecodeAsPHYPayloadResponse{
+				DevAddr:  devAddr.Bytes(),
+				FullFCnt: 0x42,
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			res, err := decryptPHYPayload(tc.Message, appSKey, tc.FullFCnt, tc.LastAFCntDown)
+			if a.So(err, should.BeNil) {
+				a.So(res, should.Resemble, tc.Expected)
+			}
+		})
+	}
+
+	t.Run("Join-accept", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		msg := makeMessage(ttnpb.MType_JOIN_ACCEPT, 0, 1, nil)
+		_, err := decryptPHYPayload(msg, appSKey, nil, 0)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+}
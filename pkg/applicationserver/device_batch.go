@@ -0,0 +1,43 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/devicebatch"
+)
+
+// Update implements ttnpb.AsEndDeviceBatchRegistryServer.
+// It applies the same field mask update to multiple end devices of an application, such as changing the
+// payload formatters of a fleet of end devices.
+func (r asEndDeviceBatchRegistryServer) Update(
+	ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest,
+) (*ttnpb.BatchUpdateEndDevicesResponse, error) {
+	if err := rights.RequireApplication(
+		ctx, req.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	updater := &devicebatch.Updater{
+		GetMethod: ttnpb.AsEndDeviceRegistry_Get_FullMethodName,
+		SetMethod: ttnpb.AsEndDeviceRegistry_Set_FullMethodName,
+		Get:       r.AS.grpc.asDevices.Get,
+		Set:       r.AS.grpc.asDevices.Set,
+	}
+	return updater.Update(ctx, req)
+}
@@ -0,0 +1,182 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"golang.org/x/exp/slices"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errDownlinkResultRegistry = errors.DefineInvalidArgument(
+		"downlink_result_registry", "downlink result registry is not configured",
+	)
+	errDownlinkResultWait = errors.DefineInvalidArgument(
+		"downlink_result_wait", "invalid downlink result wait duration `{wait}`",
+	)
+	errDownlinkResultNotFound = errors.DefineNotFound(
+		"downlink_result_not_found", "result of downlink `{correlation_id}` not found",
+	)
+)
+
+const downlinkResultsProtocol = "downlink-results"
+
+func (as *ApplicationServer) initDownlinkResults(conf DownlinkResultsConfig) error {
+	if conf.Registry == nil {
+		return errDownlinkResultRegistry.New()
+	}
+	as.downlinkResultRegistry = conf.Registry
+	return nil
+}
+
+// recordDownlinkResult stores the result of the downlink message in the upstream message, if any.
+func (as *ApplicationServer) recordDownlinkResult(ctx context.Context, up *ttnpb.ApplicationUp) {
+	if as.downlinkResultRegistry == nil {
+		return
+	}
+	correlationIDs, result, ok := downlinkresult.FromApplicationUp(up)
+	if !ok {
+		return
+	}
+	if err := as.downlinkResultRegistry.Set(ctx, up.EndDeviceIds, correlationIDs, result); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to store downlink result")
+	}
+}
+
+// WaitForDownlinkResult returns the result of the downlink message with the given correlation ID. If the result is
+// not terminal, it waits up to the given duration for the terminal result, capped by the configured maximum. If the
+// wait duration passes, the latest known result is returned. If no result is known, an error is returned for which
+// errors.IsNotFound is true.
+func (as *ApplicationServer) WaitForDownlinkResult(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationID string, wait time.Duration,
+) (*downlinkresult.Result, error) {
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+		return nil, err
+	}
+	if wait < 0 {
+		return nil, errDownlinkResultWait.WithAttributes("wait", wait)
+	}
+	if max := as.config.DownlinkResults.MaxWait; wait > max {
+		wait = max
+	}
+
+	result, err := as.waitForDownlinkResult(ctx, ids, correlationID, wait)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil {
+		return nil, errDownlinkResultNotFound.WithAttributes("correlation_id", correlationID)
+	}
+	return result, nil
+}
+
+// waitForDownlinkResult returns the latest known result of the downlink message, or nil if no result is known.
+func (as *ApplicationServer) waitForDownlinkResult(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationID string, wait time.Duration,
+) (*downlinkresult.Result, error) {
+	ctx, cancel := context.WithTimeout(ctx, wait)
+	defer cancel()
+
+	// Subscribe before reading the registry, so that no result is missed in between.
+	var sub *io.Subscription
+	if wait > 0 {
+		var err error
+		if sub, err = as.Subscribe(ctx, downlinkResultsProtocol, ids.ApplicationIds, true); err != nil {
+			return nil, err
+		}
+	}
+	result, err := as.downlinkResultRegistry.Get(ctx, ids, correlationID)
+	if err != nil {
+		if !errors.IsNotFound(err) {
+			return nil, err
+		}
+		result = nil
+	}
+	if sub == nil || (result != nil && result.Terminal()) {
+		return result, nil
+	}
+	uid := unique.ID(ctx, ids)
+	for {
+		select {
+		case <-ctx.Done():
+			return result, nil
+		case up := <-sub.Up():
+			if unique.ID(up.Context, up.EndDeviceIds) != uid {
+				continue
+			}
+			correlationIDs, r, ok := downlinkresult.FromApplicationUp(up.ApplicationUp)
+			if !ok || !slices.Contains(correlationIDs, correlationID) {
+				continue
+			}
+			if result = r; result.Terminal() {
+				return result, nil
+			}
+		}
+	}
+}
+
+var downlinkResultStates = map[downlinkresult.State]ttnpb.DownlinkResultState{
+	downlinkresult.StateQueued:          ttnpb.DownlinkResultState_DOWNLINK_RESULT_QUEUED,
+	downlinkresult.StateSent:            ttnpb.DownlinkResultState_DOWNLINK_RESULT_SENT,
+	downlinkresult.StateAcknowledged:    ttnpb.DownlinkResultState_DOWNLINK_RESULT_ACKNOWLEDGED,
+	downlinkresult.StateNotAcknowledged: ttnpb.DownlinkResultState_DOWNLINK_RESULT_NOT_ACKNOWLEDGED,
+	downlinkresult.StateFailed:          ttnpb.DownlinkResultState_DOWNLINK_RESULT_FAILED,
+}
+
+func downlinkResultToPB(result *downlinkresult.Result) *ttnpb.DownlinkResult {
+	pb := &ttnpb.DownlinkResult{
+		State:     downlinkResultStates[result.State],
+		FCnt:      result.FCnt,
+		Confirmed: result.Confirmed,
+		UpdatedAt: timestamppb.New(result.UpdatedAt),
+	}
+	if err := result.Error; err != nil {
+		pb.Error = &ttnpb.ErrorDetails{
+			Namespace:     err.Namespace,
+			Name:          err.Name,
+			MessageFormat: err.Message,
+		}
+	}
+	return pb
+}
+
+type downlinkResultRegistryServer struct {
+	ttnpb.UnimplementedAsDownlinkResultRegistryServer
+
+	AS *ApplicationServer
+}
+
+// Get implements ttnpb.AsDownlinkResultRegistryServer.
+func (s *downlinkResultRegistryServer) Get(
+	ctx context.Context, req *ttnpb.GetDownlinkResultRequest,
+) (*ttnpb.DownlinkResult, error) {
+	result, err := s.AS.WaitForDownlinkResult(
+		ctx, req.GetEndDeviceIds(), req.GetCorrelationId(), req.GetWait().AsDuration(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return downlinkResultToPB(result), nil
+}
@@ -0,0 +1,61 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestDownlinkResultToPB(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	updatedAt := time.Unix(1700000000, 0).UTC()
+	a.So(downlinkResultToPB(&downlinkresult.Result{
+		State:     downlinkresult.StateSent,
+		FCnt:      42,
+		UpdatedAt: updatedAt,
+	}), should.Resemble, &ttnpb.DownlinkResult{
+		State:     ttnpb.DownlinkResultState_DOWNLINK_RESULT_SENT,
+		FCnt:      42,
+		UpdatedAt: timestamppb.New(updatedAt),
+	})
+	a.So(downlinkResultToPB(&downlinkresult.Result{
+		State:     downlinkresult.StateFailed,
+		Confirmed: true,
+		Error: &downlinkresult.Error{
+			Namespace: "pkg/networkserver",
+			Name:      "application_downlink_too_long",
+			Message:   "application downlink too long",
+		},
+		UpdatedAt: updatedAt,
+	}), should.Resemble, &ttnpb.DownlinkResult{
+		State:     ttnpb.DownlinkResultState_DOWNLINK_RESULT_FAILED,
+		Confirmed: true,
+		Error: &ttnpb.ErrorDetails{
+			Namespace:     "pkg/networkserver",
+			Name:          "application_downlink_too_long",
+			MessageFormat: "application downlink too long",
+		},
+		UpdatedAt: timestamppb.New(updatedAt),
+	})
+}
@@ -0,0 +1,136 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package downlinkresult tracks the results of downlink messages of the Application Server by correlation ID.
+package downlinkresult
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// CorrelationIDPrefix is the prefix of the correlation IDs that the Application Server assigns to downlink messages
+// when they are queued. Results are tracked by these correlation IDs.
+const CorrelationIDPrefix = "as:downlink:"
+
+// State is the state of a downlink message.
+type State string
+
+const (
+	// StateQueued indicates that the downlink message is queued in the Network Server.
+	StateQueued State = "queued"
+	// StateSent indicates that the downlink message is sent to the end device.
+	StateSent State = "sent"
+	// StateAcknowledged indicates that the confirmed downlink message is acknowledged by the end device.
+	StateAcknowledged State = "acknowledged"
+	// StateNotAcknowledged indicates that the confirmed downlink message is not acknowledged by the end device.
+	// The Application Server retries the downlink message until the maximum number of attempts is reached, after
+	// which the downlink message fails.
+	StateNotAcknowledged State = "not_acknowledged"
+	// StateFailed indicates that the downlink message failed.
+	StateFailed State = "failed"
+)
+
+// Error is the error of a failed downlink message.
+type Error struct {
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+	Message   string `json:"message"`
+}
+
+// Result is the result of a downlink message.
+type Result struct {
+	State     State     `json:"state"`
+	FCnt      uint32    `json:"f_cnt,omitempty"`
+	Confirmed bool      `json:"confirmed,omitempty"`
+	Error     *Error    `json:"error,omitempty"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// Terminal returns whether the result is final. Unconfirmed downlink messages are final when they are sent, and
+// confirmed downlink messages when they are acknowledged. Failed downlink messages are always final.
+func (r *Result) Terminal() bool {
+	switch r.State {
+	case StateSent:
+		return !r.Confirmed
+	case StateAcknowledged, StateFailed:
+		return true
+	default:
+		return false
+	}
+}
+
+// FromApplicationUp returns the result of the downlink message in the upstream message, and the correlation IDs of
+// the downlink message by which the result is tracked. The returned boolean is false if the upstream message does
+// not contain the result of a tracked downlink message.
+func FromApplicationUp(up *ttnpb.ApplicationUp) ([]string, *Result, bool) {
+	var (
+		msg   *ttnpb.ApplicationDownlink
+		state State
+		err   *Error
+	)
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		msg, state = p.DownlinkQueued, StateQueued
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		msg, state = p.DownlinkSent, StateSent
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		msg, state = p.DownlinkAck, StateAcknowledged
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		msg, state = p.DownlinkNack, StateNotAcknowledged
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		msg, state = p.DownlinkFailed.GetDownlink(), StateFailed
+		if details := p.DownlinkFailed.GetError(); details != nil {
+			err = &Error{
+				Namespace: details.GetNamespace(),
+				Name:      details.GetName(),
+				Message:   details.GetMessageFormat(),
+			}
+		}
+	default:
+		return nil, nil, false
+	}
+	var correlationIDs []string
+	for _, id := range msg.GetCorrelationIds() {
+		if strings.HasPrefix(id, CorrelationIDPrefix) {
+			correlationIDs = append(correlationIDs, id)
+		}
+	}
+	if len(correlationIDs) == 0 {
+		return nil, nil, false
+	}
+	updatedAt := time.Now()
+	if up.ReceivedAt != nil {
+		updatedAt = up.ReceivedAt.AsTime()
+	}
+	return correlationIDs, &Result{
+		State:     state,
+		FCnt:      msg.GetFCnt(),
+		Confirmed: msg.GetConfirmed(),
+		Error:     err,
+		UpdatedAt: updatedAt,
+	}, true
+}
+
+// Registry stores the results of downlink messages.
+type Registry interface {
+	// Get returns the result of the downlink message with the given correlation ID.
+	// If the result is unknown, an error is returned for which errors.IsNotFound is true.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationID string) (*Result, error)
+	// Set sets the result of the downlink message with the given correlation IDs.
+	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationIDs []string, result *Result) error
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package downlinkresult_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestFromApplicationUp(t *testing.T) {
+	t.Parallel()
+
+	receivedAt := time.Unix(42, 0).UTC()
+	msg := func(confirmed bool) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{
+			FCnt:           42,
+			Confirmed:      confirmed,
+			CorrelationIds: []string{"as:up:1", "as:downlink:1", "ns:downlink:1"},
+		}
+	}
+	for _, tc := range []struct {
+		Name           string
+		Up             *ttnpb.ApplicationUp
+		ExpectedResult *Result
+		Terminal       bool
+	}{
+		{
+			Name: "Queued",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkQueued{DownlinkQueued: msg(false)}},
+			ExpectedResult: &Result{
+				State: StateQueued, FCnt: 42, UpdatedAt: receivedAt,
+			},
+		},
+		{
+			Name: "SentUnconfirmed",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: msg(false)}},
+			ExpectedResult: &Result{
+				State: StateSent, FCnt: 42, UpdatedAt: receivedAt,
+			},
+			Terminal: true,
+		},
+		{
+			Name: "SentConfirmed",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: msg(true)}},
+			ExpectedResult: &Result{
+				State: StateSent, FCnt: 42, Confirmed: true, UpdatedAt: receivedAt,
+			},
+		},
+		{
+			Name: "Ack",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkAck{DownlinkAck: msg(true)}},
+			ExpectedResult: &Result{
+				State: StateAcknowledged, FCnt: 42, Confirmed: true, UpdatedAt: receivedAt,
+			},
+			Terminal: true,
+		},
+		{
+			Name: "Nack",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkNack{DownlinkNack: msg(true)}},
+			ExpectedResult: &Result{
+				State: StateNotAcknowledged, FCnt: 42, Confirmed: true, UpdatedAt: receivedAt,
+			},
+		},
+		{
+			Name: "Failed",
+			Up: &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkFailed{DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+				Downlink: msg(true),
+				Error: &ttnpb.ErrorDetails{
+					Namespace:     "pkg/applicationserver",
+					Name:          "max_retries_reached",
+					MessageFormat: "maximum number of retries reached",
+				},
+			}}},
+			ExpectedResult: &Result{
+				State:     StateFailed,
+				FCnt:      42,
+				Confirmed: true,
+				Error: &Error{
+					Namespace: "pkg/applicationserver",
+					Name:      "max_retries_reached",
+					Message:   "maximum number of retries reached",
+				},
+				UpdatedAt: receivedAt,
+			},
+			Terminal: true,
+		},
+		{
+			Name: "Uplink",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{}}},
+		},
+		{
+			Name: "Untracked",
+			Up:   &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: &ttnpb.ApplicationDownlink{FCnt: 42}}},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			up := tc.Up
+			up.ReceivedAt = timestamppb.New(receivedAt)
+			correlationIDs, result, ok := FromApplicationUp(up)
+			if tc.ExpectedResult == nil {
+				a.So(ok, should.BeFalse)
+				return
+			}
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(correlationIDs, should.Resemble, []string{"as:downlink:1"})
+			a.So(result, should.Resemble, tc.ExpectedResult)
+			a.So(result.Terminal(), should.Equal, tc.Terminal)
+		})
+	}
+}
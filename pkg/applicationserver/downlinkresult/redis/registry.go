@@ -0,0 +1,90 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the downlink result registry of the Application Server using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database corruption")
+	errResultNotFound     = errors.DefineNotFound("result_not_found", "downlink result not found")
+)
+
+// Registry is an implementation of downlinkresult.Registry.
+// The results of an end device are stored in a hash keyed by correlation ID, which expires after TTL without new
+// results.
+type Registry struct {
+	Redis *ttnredis.Client
+	TTL   time.Duration
+}
+
+func (r *Registry) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids))
+}
+
+// Get implements downlinkresult.Registry.
+func (r *Registry) Get(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationID string,
+) (*downlinkresult.Result, error) {
+	v, err := r.Redis.HGet(ctx, r.key(ctx, ids), correlationID).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errResultNotFound.New()
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	result := &downlinkresult.Result{}
+	if err := json.Unmarshal([]byte(v), result); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return result, nil
+}
+
+// Set implements downlinkresult.Registry.
+func (r *Registry) Set(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, correlationIDs []string, result *downlinkresult.Result,
+) error {
+	if len(correlationIDs) == 0 {
+		return nil
+	}
+	b, err := json.Marshal(result)
+	if err != nil {
+		return err
+	}
+	values := make([]any, 0, 2*len(correlationIDs))
+	for _, id := range correlationIDs {
+		values = append(values, id, b)
+	}
+	k := r.key(ctx, ids)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HSet(ctx, k, values...)
+		p.PExpire(ctx, k, r.TTL)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
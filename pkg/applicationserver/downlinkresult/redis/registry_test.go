@@ -0,0 +1,75 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/downlinkresult/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.Registry{Redis: cl, TTL: time.Hour}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"},
+		DeviceId:       "dev-1",
+	}
+
+	_, err := r.Get(ctx, ids, "as:downlink:1")
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	queued := &downlinkresult.Result{
+		State:     downlinkresult.StateQueued,
+		FCnt:      42,
+		Confirmed: true,
+		UpdatedAt: time.Unix(1, 0).UTC(),
+	}
+	a.So(r.Set(ctx, ids, []string{"as:downlink:1", "as:downlink:2"}, queued), should.BeNil)
+
+	failed := &downlinkresult.Result{
+		State:     downlinkresult.StateFailed,
+		FCnt:      42,
+		Confirmed: true,
+		Error:     &downlinkresult.Error{Namespace: "pkg/applicationserver", Name: "max_retries", Message: "failed"},
+		UpdatedAt: time.Unix(2, 0).UTC(),
+	}
+	a.So(r.Set(ctx, ids, []string{"as:downlink:2"}, failed), should.BeNil)
+
+	result, err := r.Get(ctx, ids, "as:downlink:1")
+	a.So(err, should.BeNil)
+	a.So(result, should.Resemble, queued)
+
+	result, err = r.Get(ctx, ids, "as:downlink:2")
+	a.So(err, should.BeNil)
+	a.So(result, should.Resemble, failed)
+
+	ttl, err := cl.PTTL(ctx, cl.Key("uid", "app-1.dev-1")).Result()
+	a.So(err, should.BeNil)
+	a.So(ttl, should.BeGreaterThan, 0)
+	a.So(ttl, should.BeLessThanOrEqualTo, time.Hour)
+}
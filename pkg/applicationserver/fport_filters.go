@@ -0,0 +1,258 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	errFPortFilterRegistry = errors.DefineInvalidArgument(
+		"fport_filter_registry", "FPort filter registry is not configured",
+	)
+	errFPortFilterIntegration = errors.DefineUnimplemented(
+		"fport_filter_integration", "FPort filters of `{integration}` are not available",
+	)
+)
+
+// fPortFilterIntegration is an integration that supports FPort filters.
+type fPortFilterIntegration struct {
+	// name returns the integration name of the integration in the FPort filter registry.
+	name func(id string) string
+	// ensure returns an error if the integration does not exist.
+	ensure func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string) error
+	// set sets the FPort filter of the integration. If filter is nil, the filter is deleted.
+	set func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string, filter *fportfilter.Filter) error
+}
+
+func (as *ApplicationServer) webhookFPortFilters() *fPortFilterIntegration {
+	if as.webhooks == nil {
+		return nil
+	}
+	return &fPortFilterIntegration{
+		name: fportfilter.Webhook,
+		ensure: func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string) error {
+			_, err := as.webhooks.Registry().Get(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIds: ids,
+				WebhookId:      id,
+			}, []string{"ids"})
+			return err
+		},
+		set: func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string, filter *fportfilter.Filter) error {
+			return as.fPortFilterRegistry.Set(ctx, ids, fportfilter.Webhook(id), filter)
+		},
+	}
+}
+
+func (as *ApplicationServer) pubSubFPortFilters() *fPortFilterIntegration {
+	if as.pubsub == nil {
+		return nil
+	}
+	return &fPortFilterIntegration{
+		name: fportfilter.PubSub,
+		ensure: func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string) error {
+			_, err := as.pubsub.Registry().Get(ctx, &ttnpb.ApplicationPubSubIdentifiers{
+				ApplicationIds: ids,
+				PubSubId:       id,
+			}, []string{"ids"})
+			return err
+		},
+		set: func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, id string, filter *fportfilter.Filter) error {
+			return as.pubsub.SetFPortFilter(ctx, &ttnpb.ApplicationPubSubIdentifiers{
+				ApplicationIds: ids,
+				PubSubId:       id,
+			}, filter)
+		},
+	}
+}
+
+// getFPortFilter returns the FPort filter of the integration.
+func (as *ApplicationServer) getFPortFilter(
+	ctx context.Context, integration *fPortFilterIntegration, ids *ttnpb.ApplicationIdentifiers, id string,
+) (*fportfilter.Filter, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	if err := integration.ensure(ctx, ids, id); err != nil {
+		return nil, err
+	}
+	return as.fPortFilterRegistry.Get(ctx, ids, integration.name(id))
+}
+
+// setFPortFilter sets the FPort filter of the integration. If filter is nil, the filter is deleted.
+func (as *ApplicationServer) setFPortFilter(
+	ctx context.Context,
+	integration *fPortFilterIntegration,
+	ids *ttnpb.ApplicationIdentifiers,
+	id string,
+	filter *fportfilter.Filter,
+) error {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return err
+	}
+	if filter != nil {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := integration.ensure(ctx, ids, id); err != nil {
+		return err
+	}
+	return integration.set(ctx, ids, id, filter)
+}
+
+func fPortFilterToPB(filter *fportfilter.Filter) *ttnpb.ApplicationFPortFilter {
+	pb := &ttnpb.ApplicationFPortFilter{
+		Ranges: make([]*ttnpb.ApplicationFPortRange, 0, len(filter.Ranges)),
+	}
+	for _, r := range filter.Ranges {
+		pb.Ranges = append(pb.Ranges, &ttnpb.ApplicationFPortRange{Min: r.Min, Max: r.Max})
+	}
+	return pb
+}
+
+func fPortFilterFromPB(pb *ttnpb.ApplicationFPortFilter) *fportfilter.Filter {
+	filter := &fportfilter.Filter{
+		Ranges: make([]fportfilter.Range, 0, len(pb.GetRanges())),
+	}
+	for _, r := range pb.GetRanges() {
+		filter.Ranges = append(filter.Ranges, fportfilter.Range{Min: r.GetMin(), Max: r.GetMax()})
+	}
+	return filter
+}
+
+type fPortFilterRegistryServer struct {
+	ttnpb.UnimplementedApplicationFPortFilterRegistryServer
+
+	AS *ApplicationServer
+}
+
+func (s *fPortFilterRegistryServer) get(
+	ctx context.Context, integration *fPortFilterIntegration, ids *ttnpb.ApplicationIdentifiers, id string,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	filter, err := s.AS.getFPortFilter(ctx, integration, ids, id)
+	if err != nil {
+		return nil, err
+	}
+	return fPortFilterToPB(filter), nil
+}
+
+func (s *fPortFilterRegistryServer) set(
+	ctx context.Context,
+	integration *fPortFilterIntegration,
+	ids *ttnpb.ApplicationIdentifiers,
+	id string,
+	pb *ttnpb.ApplicationFPortFilter,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	if err := s.AS.setFPortFilter(ctx, integration, ids, id, fPortFilterFromPB(pb)); err != nil {
+		return nil, err
+	}
+	return pb, nil
+}
+
+func (s *fPortFilterRegistryServer) delete(
+	ctx context.Context, integration *fPortFilterIntegration, ids *ttnpb.ApplicationIdentifiers, id string,
+) (*emptypb.Empty, error) {
+	if err := s.AS.setFPortFilter(ctx, integration, ids, id, nil); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (s *fPortFilterRegistryServer) webhooks() (*fPortFilterIntegration, error) {
+	if integration := s.AS.webhookFPortFilters(); integration != nil {
+		return integration, nil
+	}
+	return nil, errFPortFilterIntegration.WithAttributes("integration", "webhooks")
+}
+
+func (s *fPortFilterRegistryServer) pubSubs() (*fPortFilterIntegration, error) {
+	if integration := s.AS.pubSubFPortFilters(); integration != nil {
+		return integration, nil
+	}
+	return nil, errFPortFilterIntegration.WithAttributes("integration", "pub/subs")
+}
+
+// GetWebhookFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) GetWebhookFilter(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	integration, err := s.webhooks()
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, integration, ids.GetApplicationIds(), ids.GetWebhookId())
+}
+
+// SetWebhookFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) SetWebhookFilter(
+	ctx context.Context, req *ttnpb.SetApplicationWebhookFPortFilterRequest,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	integration, err := s.webhooks()
+	if err != nil {
+		return nil, err
+	}
+	return s.set(ctx, integration, req.GetIds().GetApplicationIds(), req.GetIds().GetWebhookId(), req.GetFilter())
+}
+
+// DeleteWebhookFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) DeleteWebhookFilter(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers,
+) (*emptypb.Empty, error) {
+	integration, err := s.webhooks()
+	if err != nil {
+		return nil, err
+	}
+	return s.delete(ctx, integration, ids.GetApplicationIds(), ids.GetWebhookId())
+}
+
+// GetPubSubFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) GetPubSubFilter(
+	ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	integration, err := s.pubSubs()
+	if err != nil {
+		return nil, err
+	}
+	return s.get(ctx, integration, ids.GetApplicationIds(), ids.GetPubSubId())
+}
+
+// SetPubSubFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) SetPubSubFilter(
+	ctx context.Context, req *ttnpb.SetApplicationPubSubFPortFilterRequest,
+) (*ttnpb.ApplicationFPortFilter, error) {
+	integration, err := s.pubSubs()
+	if err != nil {
+		return nil, err
+	}
+	return s.set(ctx, integration, req.GetIds().GetApplicationIds(), req.GetIds().GetPubSubId(), req.GetFilter())
+}
+
+// DeletePubSubFilter implements ttnpb.ApplicationFPortFilterRegistryServer.
+func (s *fPortFilterRegistryServer) DeletePubSubFilter(
+	ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers,
+) (*emptypb.Empty, error) {
+	integration, err := s.pubSubs()
+	if err != nil {
+		return nil, err
+	}
+	return s.delete(ctx, integration, ids.GetApplicationIds(), ids.GetPubSubId())
+}
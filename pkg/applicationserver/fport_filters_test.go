@@ -0,0 +1,54 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFPortFilterPB(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	filter := &fportfilter.Filter{Ranges: []fportfilter.Range{{Min: 10, Max: 10}, {Min: 200, Max: 210}}}
+	pb := fPortFilterToPB(filter)
+	a.So(pb, should.Resemble, &ttnpb.ApplicationFPortFilter{
+		Ranges: []*ttnpb.ApplicationFPortRange{{Min: 10, Max: 10}, {Min: 200, Max: 210}},
+	})
+	a.So(fPortFilterFromPB(pb), should.Resemble, filter)
+}
+
+func TestFPortFilterRegistryServerIntegrations(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	srv := &fPortFilterRegistryServer{AS: &ApplicationServer{}}
+	_, err := srv.GetWebhookFilter(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"},
+		WebhookId:      "webhook-1",
+	})
+	a.So(errors.IsUnimplemented(err), should.BeTrue)
+	_, err = srv.DeletePubSubFilter(ctx, &ttnpb.ApplicationPubSubIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"},
+		PubSubId:       "pubsub-1",
+	})
+	a.So(errors.IsUnimplemented(err), should.BeTrue)
+}
@@ -0,0 +1,224 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	errCoverageRegistry = errors.DefineInvalidArgument(
+		"coverage_registry", "coverage registry is not configured",
+	)
+	errCoveragePrecision = errors.DefineInvalidArgument(
+		"coverage_precision", "invalid coverage geohash precision `{precision}`",
+	)
+)
+
+func (as *ApplicationServer) initCoverage(ctx context.Context, conf CoverageConfig) error {
+	if conf.Registry == nil {
+		return errCoverageRegistry.New()
+	}
+	if conf.Precision < 1 || conf.Precision > coverage.MaxGeohashPrecision {
+		return errCoveragePrecision.WithAttributes("precision", conf.Precision)
+	}
+	as.coverageRegistry = conf.Registry
+	as.coveragePool = workerpool.NewWorkerPool(workerpool.Config[coverage.GatewaySample]{
+		Component: as.Component,
+		Context:   ctx,
+		Name:      "add_gateway_coverage_samples",
+		Handler:   as.addCoverageSample,
+	})
+	return nil
+}
+
+// addCoverageSamples adds the samples of the gateways that received the uplink message to the coverage of the
+// gateways, if coverage estimation is enabled and the location of the end device is known.
+func (as *ApplicationServer) addCoverageSamples(ctx context.Context, up *ttnpb.ApplicationUplink) {
+	if as.coverageRegistry == nil {
+		return
+	}
+	for _, sample := range coverage.Samples(up, as.config.Coverage.Precision) {
+		if err := as.coveragePool.Publish(ctx, sample); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to publish gateway coverage sample")
+			return
+		}
+	}
+}
+
+func (as *ApplicationServer) addCoverageSample(ctx context.Context, sample coverage.GatewaySample) {
+	if err := as.coverageRegistry.Add(ctx, sample.GatewayIds, sample.Sample); err != nil {
+		log.FromContext(ctx).WithError(err).WithField(
+			"gateway_uid", unique.ID(ctx, sample.GatewayIds),
+		).Warn("Failed to add gateway coverage sample")
+	}
+}
+
+// GetGatewayCoverage returns the coverage buckets of the gateway. If cell is not nil, only the buckets that
+// intersect with the cell are returned.
+func (as *ApplicationServer) GetGatewayCoverage(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, cell *coverage.Cell,
+) ([]*coverage.Bucket, error) {
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	var (
+		buckets  []*coverage.Bucket
+		rangeErr error
+	)
+	if err := as.coverageRegistry.Range(ctx, ids, func(bucket *coverage.Bucket) bool {
+		if cell != nil {
+			bucketCell, err := coverage.DecodeGeohash(bucket.Geohash)
+			if err != nil {
+				rangeErr = err
+				return false
+			}
+			if !bucketCell.Intersects(*cell) {
+				return true
+			}
+		}
+		buckets = append(buckets, bucket)
+		return true
+	}); err != nil {
+		return nil, err
+	}
+	if rangeErr != nil {
+		return nil, rangeErr
+	}
+	return buckets, nil
+}
+
+func coverageStatsToPB(stats coverage.Stats) *ttnpb.GatewayCoverageStats {
+	return &ttnpb.GatewayCoverageStats{
+		Min:  stats.Min,
+		Max:  stats.Max,
+		Mean: stats.Mean,
+	}
+}
+
+func coverageBucketToPB(bucket *coverage.Bucket) *ttnpb.GatewayCoverageBucket {
+	return &ttnpb.GatewayCoverageBucket{
+		Geohash:    bucket.Geohash,
+		Count:      bucket.Count,
+		Rssi:       coverageStatsToPB(bucket.RSSI),
+		Snr:        coverageStatsToPB(bucket.SNR),
+		LastSeenAt: timestamppb.New(bucket.LastSeenAt),
+	}
+}
+
+type coverageTileGeometry struct {
+	Type        string         `json:"type"`
+	Coordinates [][][2]float64 `json:"coordinates"`
+}
+
+type coverageTileFeature struct {
+	Type       string               `json:"type"`
+	Geometry   coverageTileGeometry `json:"geometry"`
+	Properties *coverage.Bucket     `json:"properties"`
+}
+
+type coverageTile struct {
+	Type     string                `json:"type"`
+	Features []coverageTileFeature `json:"features"`
+}
+
+// newCoverageTile returns the GeoJSON feature collection with the cells of the buckets as polygons.
+func newCoverageTile(buckets []*coverage.Bucket) (*coverageTile, error) {
+	tile := &coverageTile{
+		Type:     "FeatureCollection",
+		Features: make([]coverageTileFeature, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		cell, err := coverage.DecodeGeohash(bucket.Geohash)
+		if err != nil {
+			return nil, err
+		}
+		tile.Features = append(tile.Features, coverageTileFeature{
+			Type: "Feature",
+			Geometry: coverageTileGeometry{
+				Type: "Polygon",
+				Coordinates: [][][2]float64{{
+					{cell.MinLongitude, cell.MinLatitude},
+					{cell.MaxLongitude, cell.MinLatitude},
+					{cell.MaxLongitude, cell.MaxLatitude},
+					{cell.MinLongitude, cell.MaxLatitude},
+					{cell.MinLongitude, cell.MinLatitude},
+				}},
+			},
+			Properties: bucket,
+		})
+	}
+	return tile, nil
+}
+
+type gatewayCoverageServer struct {
+	ttnpb.UnimplementedGatewayCoverageServiceServer
+
+	AS *ApplicationServer
+}
+
+// Get implements ttnpb.GatewayCoverageServiceServer.
+func (s *gatewayCoverageServer) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayCoverage, error) {
+	buckets, err := s.AS.GetGatewayCoverage(ctx, ids, nil)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.GatewayCoverage{
+		Buckets: make([]*ttnpb.GatewayCoverageBucket, 0, len(buckets)),
+	}
+	for _, bucket := range buckets {
+		res.Buckets = append(res.Buckets, coverageBucketToPB(bucket))
+	}
+	return res, nil
+}
+
+// GetTile implements ttnpb.GatewayCoverageServiceServer.
+func (s *gatewayCoverageServer) GetTile(
+	ctx context.Context, req *ttnpb.GetGatewayCoverageTileRequest,
+) (*httpbody.HttpBody, error) {
+	cell, err := coverage.TileCell(int(req.GetZ()), int(req.GetX()), int(req.GetY()))
+	if err != nil {
+		return nil, err
+	}
+	buckets, err := s.AS.GetGatewayCoverage(ctx, req.GetGatewayIds(), &cell)
+	if err != nil {
+		return nil, err
+	}
+	tile, err := newCoverageTile(buckets)
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.Marshal(tile)
+	if err != nil {
+		return nil, err
+	}
+	return &httpbody.HttpBody{
+		ContentType: "application/geo+json",
+		Data:        data,
+	}, nil
+}
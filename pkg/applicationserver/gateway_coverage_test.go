@@ -0,0 +1,136 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/coverage"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockCoverageRegistry struct {
+	buckets []*coverage.Bucket
+}
+
+func (r *mockCoverageRegistry) Add(context.Context, *ttnpb.GatewayIdentifiers, coverage.Sample) error {
+	return nil
+}
+
+func (r *mockCoverageRegistry) Range(
+	_ context.Context, _ *ttnpb.GatewayIdentifiers, f func(*coverage.Bucket) bool,
+) error {
+	for _, bucket := range r.buckets {
+		if !f(bucket) {
+			return nil
+		}
+	}
+	return nil
+}
+
+func TestGetGatewayCoverage(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	amsterdam := &coverage.Bucket{
+		Geohash:    coverage.EncodeGeohash(52.3676, 4.9041, 7),
+		Count:      2,
+		RSSI:       coverage.Stats{Min: -110, Max: -90, Mean: -100},
+		SNR:        coverage.Stats{Min: -5, Max: 5, Mean: 0},
+		LastSeenAt: time.Unix(100, 0).UTC(),
+	}
+	paris := &coverage.Bucket{
+		Geohash: coverage.EncodeGeohash(48.8566, 2.3522, 7),
+		Count:   1,
+	}
+	as := &ApplicationServer{
+		coverageRegistry: &mockCoverageRegistry{buckets: []*coverage.Bucket{amsterdam, paris}},
+	}
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}
+
+	_, err := as.GetGatewayCoverage(rights.NewContext(ctx, &rights.Rights{
+		GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		}),
+	}), ids, nil)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx = rights.NewContext(ctx, &rights.Rights{
+		GatewayRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_STATUS_READ),
+		}),
+	})
+	buckets, err := as.GetGatewayCoverage(ctx, ids, nil)
+	if a.So(err, should.BeNil) {
+		a.So(buckets, should.Resemble, []*coverage.Bucket{amsterdam, paris})
+	}
+
+	cell, err := coverage.TileCell(10, 525, 336)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	buckets, err = as.GetGatewayCoverage(ctx, ids, &cell)
+	if !a.So(err, should.BeNil) || !a.So(buckets, should.Resemble, []*coverage.Bucket{amsterdam}) {
+		t.FailNow()
+	}
+
+	tile, err := newCoverageTile(buckets)
+	if !a.So(err, should.BeNil) || !a.So(tile.Features, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	feature := tile.Features[0]
+	a.So(feature.Properties, should.Equal, amsterdam)
+	a.So(feature.Geometry.Type, should.Equal, "Polygon")
+	if a.So(feature.Geometry.Coordinates, should.HaveLength, 1) {
+		ring := feature.Geometry.Coordinates[0]
+		a.So(ring, should.HaveLength, 5)
+		a.So(ring[0], should.Equal, ring[4])
+		a.So(4.9041, should.BeBetween, ring[0][0], ring[2][0])
+		a.So(52.3676, should.BeBetween, ring[0][1], ring[2][1])
+	}
+
+	srv := &gatewayCoverageServer{AS: as}
+	res, err := srv.Get(ctx, ids)
+	if a.So(err, should.BeNil) && a.So(res.Buckets, should.HaveLength, 2) {
+		a.So(res.Buckets[0], should.Resemble, &ttnpb.GatewayCoverageBucket{
+			Geohash:    amsterdam.Geohash,
+			Count:      2,
+			Rssi:       &ttnpb.GatewayCoverageStats{Min: -110, Max: -90, Mean: -100},
+			Snr:        &ttnpb.GatewayCoverageStats{Min: -5, Max: 5, Mean: 0},
+			LastSeenAt: timestamppb.New(amsterdam.LastSeenAt),
+		})
+	}
+
+	body, err := srv.GetTile(ctx, &ttnpb.GetGatewayCoverageTileRequest{GatewayIds: ids, Z: 10, X: 525, Y: 336})
+	if a.So(err, should.BeNil) {
+		a.So(body.ContentType, should.Equal, "application/geo+json")
+		var collection coverageTile
+		if a.So(json.Unmarshal(body.Data, &collection), should.BeNil) {
+			a.So(collection.Features, should.HaveLength, 1)
+		}
+	}
+
+	_, err = srv.GetTile(ctx, &ttnpb.GetGatewayCoverageTileRequest{GatewayIds: ids, Z: 1, X: 2})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
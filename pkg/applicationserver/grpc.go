@@ -135,6 +135,15 @@ func (as *ApplicationServer) HandleUplink(ctx context.Context, req *ttnpb.NsAsHa
 	if err := clusterauth.Authorized(ctx); err != nil {
 		return nil, err
 	}
+	if as.uplinkQueue != nil {
+		for _, up := range req.ApplicationUps {
+			up.ReceivedAt = timestamppb.New(now)
+		}
+		if err := as.uplinkQueue.Add(ctx, UplinkStageProcess, req.ApplicationUps...); err != nil {
+			return nil, err
+		}
+		return ttnpb.Empty, nil
+	}
 	link, err := as.getLink(ctx, req.ApplicationUps[0].EndDeviceIds.ApplicationIds, []string{
 		"default_formatters",
 		"skip_payload_crypto",
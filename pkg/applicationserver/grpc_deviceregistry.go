@@ -177,8 +177,11 @@ func (r asEndDeviceRegistryServer) Get(ctx context.Context, req *ttnpb.GetEndDev
 }
 
 var (
-	errInvalidFieldMask        = errors.DefineInvalidArgument("field_mask", "invalid field mask")
-	errFormatterScriptTooLarge = errors.DefineInvalidArgument("formatter_script_too_large", "formatter script size exceeds maximum allowed size", "size", "max_size")
+	errInvalidFieldMask         = errors.DefineInvalidArgument("field_mask", "invalid field mask")
+	errFormatterScriptTooLarge  = errors.DefineInvalidArgument("formatter_script_too_large", "formatter script size exceeds maximum allowed size", "size", "max_size")
+	errAppSKeyKeptByApplication = errors.DefineFailedPrecondition(
+		"app_s_key_kept_by_application", "AppSKey of end devices of application `{application_id}` is kept by the application",
+	)
 )
 
 // Set implements ttnpb.AsEndDeviceRegistryServer.
@@ -191,6 +194,12 @@ func (r asEndDeviceRegistryServer) Set(ctx context.Context, req *ttnpb.SetEndDev
 		types.MustAES128Key(req.EndDevice.GetSession().GetKeys().GetAppSKey().GetKey()).OrZero().IsZero() {
 		return nil, errInvalidFieldValue.WithAttributes("field", "session.keys.app_s_key.key")
 	}
+	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "session.keys.app_s_key.key") &&
+		r.AS.keepPayloadEncrypted(req.EndDevice.GetIds()) {
+		return nil, errAppSKeyKeptByApplication.WithAttributes(
+			"application_id", req.EndDevice.GetIds().GetApplicationIds().GetApplicationId(),
+		)
+	}
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "formatters.up_formatter_parameter") {
 		if size := len(req.EndDevice.GetFormatters().GetUpFormatterParameter()); size > r.AS.config.Formatters.MaxParameterLength {
 			return nil, errInvalidFieldValue.WithAttributes("field", "formatters.up_formatter_parameter").WithCause(
@@ -0,0 +1,185 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func integrationErrorFromDetails(
+	details *ttnpb.ErrorDetails, at *timestamppb.Timestamp,
+) *ttnpb.ApplicationIntegrationError {
+	return &ttnpb.ApplicationIntegrationError{
+		Namespace: details.GetNamespace(),
+		Name:      details.GetName(),
+		Message:   details.GetMessageFormat(),
+		At:        at,
+	}
+}
+
+func integrationErrorFromError(err error, at time.Time) *ttnpb.ApplicationIntegrationError {
+	if ttnErr, ok := errors.From(err); ok {
+		return &ttnpb.ApplicationIntegrationError{
+			Namespace: ttnErr.Namespace(),
+			Name:      ttnErr.Name(),
+			Message:   ttnErr.FormatMessage(ttnErr.PublicAttributes()),
+			At:        timestamppb.New(at),
+		}
+	}
+	return &ttnpb.ApplicationIntegrationError{
+		Message: err.Error(),
+		At:      timestamppb.New(at),
+	}
+}
+
+// subscriptionCounts counts the active subscriptions by application and protocol.
+type subscriptionCounts struct {
+	mu     sync.Mutex
+	counts map[string]map[string]int
+}
+
+// track counts the subscription until its context is done.
+func (c *subscriptionCounts) track(uid, protocol string, sub *io.Subscription) {
+	c.mu.Lock()
+	if c.counts == nil {
+		c.counts = make(map[string]map[string]int)
+	}
+	if c.counts[uid] == nil {
+		c.counts[uid] = make(map[string]int)
+	}
+	c.counts[uid][protocol]++
+	c.mu.Unlock()
+	go func() {
+		<-sub.Context().Done()
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		if c.counts[uid][protocol]--; c.counts[uid][protocol] == 0 {
+			delete(c.counts[uid], protocol)
+		}
+		if len(c.counts[uid]) == 0 {
+			delete(c.counts, uid)
+		}
+	}()
+}
+
+func (c *subscriptionCounts) get(uid string) map[string]int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	res := make(map[string]int, len(c.counts[uid]))
+	for protocol, n := range c.counts[uid] {
+		res[protocol] = n
+	}
+	return res
+}
+
+func pubSubProviderName(pb *ttnpb.ApplicationPubSub) string {
+	switch pb.GetProvider().(type) {
+	case *ttnpb.ApplicationPubSub_Nats:
+		return "nats"
+	case *ttnpb.ApplicationPubSub_Mqtt:
+		return "mqtt"
+	case *ttnpb.ApplicationPubSub_AwsIot:
+		return "aws_iot"
+	default:
+		return ""
+	}
+}
+
+// ListIntegrationStatuses returns the webhook health, the pub/sub connection states, the number of MQTT consumers
+// and the last delivery errors of the integrations of the application.
+func (as *ApplicationServer) ListIntegrationStatuses(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.ApplicationIntegrationStatuses, error) {
+	res := &ttnpb.ApplicationIntegrationStatuses{
+		Mqtt:          &ttnpb.ApplicationMQTTStatus{},
+		Subscriptions: make(map[string]uint32),
+	}
+	for protocol, n := range as.subscriptions.get(unique.ID(ctx, ids)) {
+		res.Subscriptions[protocol] = uint32(n)
+	}
+	res.Mqtt.Consumers = res.Subscriptions["mqtt"]
+
+	if as.webhooks != nil {
+		webhooks, err := as.webhooks.Registry().List(ctx, ids, []string{"ids", "health_status"})
+		if err != nil {
+			return nil, err
+		}
+		for _, wh := range webhooks {
+			status := &ttnpb.ApplicationWebhookStatus{
+				WebhookId: wh.GetIds().GetWebhookId(),
+				Healthy:   true,
+			}
+			if unhealthy := wh.GetHealthStatus().GetUnhealthy(); unhealthy != nil {
+				status.Healthy = false
+				status.FailedAttempts = unhealthy.FailedAttempts
+				if details := unhealthy.LastFailedAttemptDetails; details != nil {
+					status.LastError = integrationErrorFromDetails(details, unhealthy.LastFailedAttemptAt)
+				}
+			}
+			res.Webhooks = append(res.Webhooks, status)
+		}
+		sort.Slice(res.Webhooks, func(i, j int) bool { return res.Webhooks[i].WebhookId < res.Webhooks[j].WebhookId })
+	}
+
+	if as.pubsub != nil {
+		pubsubs, err := as.pubsub.Registry().List(ctx, ids, []string{"ids", "provider"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pubsubs {
+			psStatus := as.pubsub.Status(ctx, pb.GetIds())
+			status := &ttnpb.ApplicationPubSubStatus{
+				PubSubId:            pb.GetIds().GetPubSubId(),
+				Provider:            pubSubProviderName(pb),
+				Connected:           psStatus.Connected,
+				Disabled:            psStatus.Disabled,
+				Failures:            uint32(psStatus.Failures),
+				ConsecutiveFailures: uint32(psStatus.ConsecutiveFailures),
+			}
+			if psStatus.LastError != nil {
+				status.LastError = integrationErrorFromError(psStatus.LastError, psStatus.LastErrorAt)
+			}
+			res.PubSubs = append(res.PubSubs, status)
+		}
+		sort.Slice(res.PubSubs, func(i, j int) bool { return res.PubSubs[i].PubSubId < res.PubSubs[j].PubSubId })
+	}
+	return res, nil
+}
+
+type integrationStatusServer struct {
+	ttnpb.UnimplementedApplicationIntegrationStatusServiceServer
+
+	AS *ApplicationServer
+}
+
+// List implements ttnpb.ApplicationIntegrationStatusServiceServer.
+func (s *integrationStatusServer) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.ApplicationIntegrationStatuses, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	return s.AS.ListIntegrationStatuses(ctx, ids)
+}
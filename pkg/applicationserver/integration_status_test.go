@@ -0,0 +1,103 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSubscriptionCounts(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := context.Background()
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+
+	var counts subscriptionCounts
+	a.So(counts.get("foo-app"), should.BeEmpty)
+
+	mqtt1 := io.NewSubscription(ctx, "mqtt", ids)
+	mqtt2 := io.NewSubscription(ctx, "mqtt", ids)
+	grpc := io.NewSubscription(ctx, "grpc", ids)
+	counts.track("foo-app", "mqtt", mqtt1)
+	counts.track("foo-app", "mqtt", mqtt2)
+	counts.track("foo-app", "grpc", grpc)
+	a.So(counts.get("foo-app"), should.Resemble, map[string]int{"mqtt": 2, "grpc": 1})
+	a.So(counts.get("bar-app"), should.BeEmpty)
+
+	waitFor := func(expected map[string]int) map[string]int {
+		deadline := time.Now().Add(time.Second)
+		for time.Now().Before(deadline) {
+			if current := counts.get("foo-app"); len(current) == len(expected) && current["mqtt"] == expected["mqtt"] {
+				break
+			}
+			time.Sleep(time.Millisecond)
+		}
+		return counts.get("foo-app")
+	}
+
+	mqtt1.Disconnect(context.Canceled)
+	a.So(waitFor(map[string]int{"mqtt": 1, "grpc": 1}), should.Resemble, map[string]int{"mqtt": 1, "grpc": 1})
+
+	mqtt2.Disconnect(context.Canceled)
+	grpc.Disconnect(context.Canceled)
+	a.So(waitFor(map[string]int{}), should.BeEmpty)
+}
+
+func TestPubSubProviderName(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	a.So(pubSubProviderName(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Nats{Nats: &ttnpb.ApplicationPubSub_NATSProvider{}},
+	}), should.Equal, "nats")
+	a.So(pubSubProviderName(&ttnpb.ApplicationPubSub{
+		Provider: &ttnpb.ApplicationPubSub_Mqtt{Mqtt: &ttnpb.ApplicationPubSub_MQTTProvider{}},
+	}), should.Equal, "mqtt")
+	a.So(pubSubProviderName(&ttnpb.ApplicationPubSub{}), should.BeEmpty)
+}
+
+func TestIntegrationStatusServer(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := context.Background()
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+
+	as := &ApplicationServer{}
+	as.subscriptions.track("foo-app", "mqtt", io.NewSubscription(ctx, "mqtt", ids))
+	srv := &integrationStatusServer{AS: as}
+
+	_, err := srv.List(rights.NewContext(ctx, &rights.Rights{}), ids)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	statuses, err := srv.List(rights.NewContext(ctx, &rights.Rights{
+		ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			"foo-app": ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC),
+		}),
+	}), ids)
+	if a.So(err, should.BeNil) {
+		a.So(statuses, should.Resemble, &ttnpb.ApplicationIntegrationStatuses{
+			Mqtt:          &ttnpb.ApplicationMQTTStatus{Consumers: 1},
+			Subscriptions: map[string]uint32{"mqtt": 1},
+		})
+	}
+}
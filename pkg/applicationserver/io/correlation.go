@@ -0,0 +1,57 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// CorrelationIDHeader is the HTTP header and gRPC metadata key with which clients supply their own correlation IDs
+// for downlink messages, so that they can correlate their requests with the events of the stack.
+const CorrelationIDHeader = "X-Correlation-ID"
+
+const maxCorrelationIDLength = 100
+
+var errInvalidCorrelationID = errors.DefineInvalidArgument(
+	"invalid_correlation_id", "invalid correlation ID `{correlation_id}`",
+)
+
+// AppendCorrelationIDs appends the correlation IDs supplied by the client to the downlink messages.
+// Each value may contain multiple comma separated correlation IDs.
+func AppendCorrelationIDs(items []*ttnpb.ApplicationDownlink, values ...string) error {
+	var ids []string
+	for _, value := range values {
+		for _, id := range strings.Split(value, ",") {
+			id = strings.TrimSpace(id)
+			if id == "" {
+				continue
+			}
+			if len(id) > maxCorrelationIDLength {
+				return errInvalidCorrelationID.WithAttributes("correlation_id", id)
+			}
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	for _, item := range items {
+		item.CorrelationIds = append(item.CorrelationIds, ids...)
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fportfilter implements FPort filters of the integrations of the Application Server, so that upstream
+// messages can be routed to different integrations based on their FPort.
+package fportfilter
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Range is an inclusive range of FPorts.
+type Range struct {
+	Min uint32 `json:"min"`
+	Max uint32 `json:"max"`
+}
+
+// Filter is the FPort filter of an integration.
+// Upstream messages that carry an FPort are forwarded to the integration only if the FPort is in one of the ranges.
+// Upstream messages without FPort, such as join-accepts and location solutions, are always forwarded.
+type Filter struct {
+	Ranges []Range `json:"ranges"`
+}
+
+var (
+	errNoRanges     = errors.DefineInvalidArgument("no_ranges", "no FPort ranges")
+	errInvalidRange = errors.DefineInvalidArgument("invalid_range", "invalid FPort range `{min}`-`{max}`")
+)
+
+// Validate returns an error if the filter has no ranges, or if a range is not within 1-255.
+func (f *Filter) Validate() error {
+	if len(f.Ranges) == 0 {
+		return errNoRanges.New()
+	}
+	for _, r := range f.Ranges {
+		if r.Min < 1 || r.Max > 255 || r.Min > r.Max {
+			return errInvalidRange.WithAttributes(
+				"min", r.Min,
+				"max", r.Max,
+			)
+		}
+	}
+	return nil
+}
+
+// FPort returns the FPort of the uplink or downlink message in the upstream message.
+// The returned boolean is false if the upstream message does not carry an FPort.
+func FPort(up *ttnpb.ApplicationUp) (uint32, bool) {
+	switch p := up.Up.(type) {
+	case *ttnpb.ApplicationUp_UplinkMessage:
+		return p.UplinkMessage.GetFPort(), true
+	case *ttnpb.ApplicationUp_UplinkNormalized:
+		return p.UplinkNormalized.GetFPort(), true
+	case *ttnpb.ApplicationUp_DownlinkAck:
+		return p.DownlinkAck.GetFPort(), true
+	case *ttnpb.ApplicationUp_DownlinkNack:
+		return p.DownlinkNack.GetFPort(), true
+	case *ttnpb.ApplicationUp_DownlinkSent:
+		return p.DownlinkSent.GetFPort(), true
+	case *ttnpb.ApplicationUp_DownlinkFailed:
+		return p.DownlinkFailed.GetDownlink().GetFPort(), true
+	case *ttnpb.ApplicationUp_DownlinkQueued:
+		return p.DownlinkQueued.GetFPort(), true
+	default:
+		return 0, false
+	}
+}
+
+// Match returns whether the upstream message passes the filter. A nil filter passes all upstream messages.
+func (f *Filter) Match(up *ttnpb.ApplicationUp) bool {
+	if f == nil {
+		return true
+	}
+	fPort, ok := FPort(up)
+	if !ok {
+		return true
+	}
+	for _, r := range f.Ranges {
+		if fPort >= r.Min && fPort <= r.Max {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook returns the integration name of the webhook with the given ID.
+func Webhook(webhookID string) string {
+	return "webhook/" + webhookID
+}
+
+// PubSub returns the integration name of the pub/sub with the given ID.
+func PubSub(pubSubID string) string {
+	return "pubsub/" + pubSubID
+}
+
+// Registry stores the FPort filters of the integrations of applications.
+type Registry interface {
+	// Get returns the filter of the integration.
+	// If the integration has no filter, an error is returned for which errors.IsNotFound is true.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, integration string) (*Filter, error)
+	// List returns the filters of the integrations of the application by integration name.
+	List(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (map[string]*Filter, error)
+	// Set sets the filter of the integration. If filter is nil, the filter is deleted.
+	Set(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, integration string, filter *Filter) error
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fportfilter_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(errors.IsInvalidArgument((&Filter{}).Validate()), should.BeTrue)
+	a.So(errors.IsInvalidArgument((&Filter{Ranges: []Range{{Min: 0, Max: 10}}}).Validate()), should.BeTrue)
+	a.So(errors.IsInvalidArgument((&Filter{Ranges: []Range{{Min: 10, Max: 256}}}).Validate()), should.BeTrue)
+	a.So(errors.IsInvalidArgument((&Filter{Ranges: []Range{{Min: 20, Max: 10}}}).Validate()), should.BeTrue)
+	a.So((&Filter{Ranges: []Range{{Min: 10, Max: 10}, {Min: 200, Max: 255}}}).Validate(), should.BeNil)
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	uplink := func(fPort uint32) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{FPort: fPort},
+		}}
+	}
+	filter := &Filter{Ranges: []Range{{Min: 10, Max: 10}, {Min: 200, Max: 210}}}
+	for _, tc := range []struct {
+		Name   string
+		Filter *Filter
+		Up     *ttnpb.ApplicationUp
+		Match  bool
+	}{
+		{Name: "NoFilter", Up: uplink(1), Match: true},
+		{Name: "SinglePort", Filter: filter, Up: uplink(10), Match: true},
+		{Name: "RangeStart", Filter: filter, Up: uplink(200), Match: true},
+		{Name: "RangeEnd", Filter: filter, Up: uplink(210), Match: true},
+		{Name: "OutOfRange", Filter: filter, Up: uplink(11), Match: false},
+		{
+			Name:   "DownlinkFailed",
+			Filter: filter,
+			Up: &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_DownlinkFailed{
+				DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+					Downlink: &ttnpb.ApplicationDownlink{FPort: 42},
+				},
+			}},
+			Match: false,
+		},
+		{
+			Name:   "JoinAccept",
+			Filter: filter,
+			Up: &ttnpb.ApplicationUp{Up: &ttnpb.ApplicationUp_JoinAccept{
+				JoinAccept: &ttnpb.ApplicationJoinAccept{},
+			}},
+			Match: true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(tc.Filter.Match(tc.Up), should.Equal, tc.Match)
+		})
+	}
+}
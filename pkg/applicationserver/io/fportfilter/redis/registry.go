@@ -0,0 +1,101 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the FPort filter registry of the Application Server using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database corruption")
+	errFilterNotFound     = errors.DefineNotFound("filter_not_found", "FPort filter not found")
+)
+
+// Registry is an implementation of fportfilter.Registry.
+// The filters of an application are stored in a hash keyed by integration name.
+type Registry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *Registry) key(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids))
+}
+
+// Get implements fportfilter.Registry.
+func (r *Registry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, integration string,
+) (*fportfilter.Filter, error) {
+	v, err := r.Redis.HGet(ctx, r.key(ctx, ids), integration).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errFilterNotFound.New()
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	filter := &fportfilter.Filter{}
+	if err := json.Unmarshal([]byte(v), filter); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return filter, nil
+}
+
+// List implements fportfilter.Registry.
+func (r *Registry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (map[string]*fportfilter.Filter, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.key(ctx, ids)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	filters := make(map[string]*fportfilter.Filter, len(vs))
+	for integration, v := range vs {
+		filter := &fportfilter.Filter{}
+		if err := json.Unmarshal([]byte(v), filter); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		filters[integration] = filter
+	}
+	return filters, nil
+}
+
+// Set implements fportfilter.Registry.
+func (r *Registry) Set(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, integration string, filter *fportfilter.Filter,
+) error {
+	k := r.key(ctx, ids)
+	if filter == nil {
+		if err := r.Redis.HDel(ctx, k, integration).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.HSet(ctx, k, integration, b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
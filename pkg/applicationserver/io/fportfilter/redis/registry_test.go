@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.Registry{Redis: cl}
+
+	app1 := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}
+
+	_, err := r.Get(ctx, app1, fportfilter.Webhook("hook-1"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	filters, err := r.List(ctx, app1)
+	a.So(err, should.BeNil)
+	a.So(filters, should.BeEmpty)
+
+	filter1 := &fportfilter.Filter{Ranges: []fportfilter.Range{{Min: 10, Max: 10}}}
+	filter2 := &fportfilter.Filter{Ranges: []fportfilter.Range{{Min: 200, Max: 223}}}
+	a.So(r.Set(ctx, app1, fportfilter.Webhook("hook-1"), filter1), should.BeNil)
+	a.So(r.Set(ctx, app1, fportfilter.PubSub("ps-1"), filter2), should.BeNil)
+
+	filter, err := r.Get(ctx, app1, fportfilter.Webhook("hook-1"))
+	a.So(err, should.BeNil)
+	a.So(filter, should.Resemble, filter1)
+
+	filters, err = r.List(ctx, app1)
+	a.So(err, should.BeNil)
+	a.So(filters, should.Resemble, map[string]*fportfilter.Filter{
+		"webhook/hook-1": filter1,
+		"pubsub/ps-1":    filter2,
+	})
+
+	a.So(r.Set(ctx, app1, fportfilter.Webhook("hook-1"), nil), should.BeNil)
+	_, err = r.Get(ctx, app1, fportfilter.Webhook("hook-1"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
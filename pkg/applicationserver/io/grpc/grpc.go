@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/timestamppb"
@@ -157,8 +158,17 @@ func (s *impl) Subscribe(ids *ttnpb.ApplicationIdentifiers, stream ttnpb.AppAs_S
 	}
 }
 
+// appendCorrelationIDs appends the correlation IDs supplied by the client in the request metadata to the downlinks.
+func appendCorrelationIDs(ctx context.Context, items []*ttnpb.ApplicationDownlink) error {
+	md, _ := metadata.FromIncomingContext(ctx)
+	return io.AppendCorrelationIDs(items, md.Get(io.CorrelationIDHeader)...)
+}
+
 func (s *impl) DownlinkQueuePush(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*emptypb.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := appendCorrelationIDs(ctx, req.Downlinks); err != nil {
 		return nil, err
 	}
 	if err := s.server.DownlinkQueuePush(ctx, req.EndDeviceIds, req.Downlinks); err != nil {
@@ -168,7 +178,10 @@ func (s *impl) DownlinkQueuePush(ctx context.Context, req *ttnpb.DownlinkQueueRe
 }
 
 func (s *impl) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueueRequest) (*emptypb.Empty, error) {
-	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, req.EndDeviceIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE); err != nil {
+		return nil, err
+	}
+	if err := appendCorrelationIDs(ctx, req.Downlinks); err != nil {
 		return nil, err
 	}
 	if err := s.server.DownlinkQueueReplace(ctx, req.EndDeviceIds, req.Downlinks); err != nil {
@@ -178,7 +191,7 @@ func (s *impl) DownlinkQueueReplace(ctx context.Context, req *ttnpb.DownlinkQueu
 }
 
 func (s *impl) DownlinkQueueList(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (*ttnpb.ApplicationDownlinks, error) {
-	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
+	if err := rights.RequireEndDevice(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ); err != nil {
 		return nil, err
 	}
 	items, err := s.server.DownlinkQueueList(ctx, ids)
@@ -213,7 +226,7 @@ func (s *impl) GetMQTTConnectionInfo(ctx context.Context, ids *ttnpb.Application
 var errPayloadCryptoSkipped = errors.DefineFailedPrecondition("payload_crypto_skipped", "payload crypto skipped")
 
 func (s *impl) SimulateUplink(ctx context.Context, up *ttnpb.ApplicationUp) (*emptypb.Empty, error) {
-	if err := rights.RequireApplication(ctx, up.EndDeviceIds.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_UP_WRITE); err != nil {
+	if err := rights.RequireEndDevice(ctx, up.EndDeviceIds, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_UP_WRITE); err != nil {
 		return nil, err
 	}
 	skip, err := s.skipPayloadCrypto(ctx, up.EndDeviceIds)
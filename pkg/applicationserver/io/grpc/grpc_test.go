@@ -41,6 +41,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/protobuf/types/known/structpb"
 )
 
@@ -284,6 +285,8 @@ func TestTraffic(t *testing.T) {
 			a.So(err, should.BeNil)
 		}
 		{
+			// Correlation IDs supplied by the client in the metadata are added to the downlinks.
+			ctx := metadata.AppendToOutgoingContext(ctx, "x-correlation-id", "client-1, client-2")
 			_, err := client.DownlinkQueuePush(ctx, &ttnpb.DownlinkQueueRequest{
 				EndDeviceIds: &ids,
 				Downlinks: []*ttnpb.ApplicationDownlink{
@@ -312,8 +315,9 @@ func TestTraffic(t *testing.T) {
 					FrmPayload: []byte{0x02, 0x02, 0x02},
 				},
 				{
-					FPort:      3,
-					FrmPayload: []byte{0x03, 0x03, 0x03},
+					FPort:          3,
+					FrmPayload:     []byte{0x03, 0x03, 0x03},
+					CorrelationIds: []string{"client-1", "client-2"},
 				},
 			})
 		}
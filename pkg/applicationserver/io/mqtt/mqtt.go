@@ -41,8 +41,28 @@ import (
 
 const qosUpstream byte = 0
 
+type options struct {
+	shaper *Shaper
+}
+
+// Option is an option for the MQTT frontend.
+type Option func(*options)
+
+// WithShaper shapes the publish rate of the connections with the given shaper.
+func WithShaper(shaper *Shaper) Option {
+	return func(opts *options) {
+		opts.shaper = shaper
+	}
+}
+
 // Serve serves the MQTT frontend.
-func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
+func Serve(
+	ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option,
+) error {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
 	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/mqtt")
 	lis := mqttnet.NewListener(listener, protocol)
 	go func() {
@@ -53,7 +73,7 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 		ctx, lis, server,
 		ratelimit.ApplicationAcceptMQTTConnectionResource, server.RateLimiter(),
 		func(ctx context.Context, mqttConn mqttnet.Conn) error {
-			return setupConnection(ctx, mqttConn, format, server)
+			return setupConnection(ctx, mqttConn, format, server, o.shaper)
 		},
 	)
 }
@@ -65,7 +85,9 @@ type connection struct {
 	resource ratelimit.Resource
 }
 
-func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
+func setupConnection(
+	ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server, shaper *Shaper,
+) error {
 	c := &connection{
 		format: format,
 		server: server,
@@ -83,6 +105,34 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 
 	wg := &sync.WaitGroup{}
 	wg.Add(1)
+	publish := session.Publish
+	if shaper != nil {
+		cs, release := shaper.connection(unique.ID(ctx, c.io.ApplicationIDs()))
+		publish = func(pkt *packet.PublishPacket) {
+			if cs.push(pkt) {
+				registerShapingDropped()
+				log.FromContext(ctx).WithField("topic", pkt.TopicName).Debug("Publish rate exceeded, drop message")
+			}
+		}
+		wg.Add(1)
+		server.StartTask(&task.Config{
+			Context: ctx,
+			ID:      "mqtt_shape_uplinks",
+			Func: func(ctx context.Context) error {
+				defer release()
+				for {
+					pkt, err := cs.pop(ctx)
+					if err != nil {
+						return err
+					}
+					session.Publish(pkt)
+				}
+			},
+			Done:    wg.Done,
+			Restart: task.RestartNever,
+			Backoff: task.DefaultBackoffConfig,
+		})
+	}
 	f := func(ctx context.Context) error {
 		for {
 			select {
@@ -101,7 +151,7 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 				}
 				topicName := topic.Join(topicParts)
 				logger.WithField("topic", topicName).Debug("Publish upstream message")
-				session.Publish(&packet.PublishPacket{
+				publish(&packet.PublishPacket{
 					TopicName:  topicName,
 					TopicParts: topicParts,
 					QoS:        qosUpstream,
@@ -17,7 +17,9 @@ package mqtt
 import (
 	"context"
 
+	"github.com/prometheus/client_golang/prometheus"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -30,3 +32,19 @@ var evtConnectFail = events.Define(
 func registerConnectFail(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, err error) {
 	events.Publish(evtConnectFail.NewWithIdentifiersAndData(ctx, ids, err))
 }
+
+var shapingDropped = prometheus.NewCounter(
+	prometheus.CounterOpts{
+		Subsystem: "as_mqtt",
+		Name:      "shaping_dropped_total",
+		Help:      "Total number of messages dropped by the publish rate shaping of the MQTT frontend",
+	},
+)
+
+func init() {
+	metrics.MustRegister(shapingDropped)
+}
+
+func registerShapingDropped() {
+	shapingDropped.Inc()
+}
@@ -0,0 +1,167 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"sync"
+
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"golang.org/x/time/rate"
+)
+
+// ShapingConfig is the configuration of the publish rate shaping of the MQTT frontend.
+// When the publish rate of a connection or an application is exceeded, messages are queued per connection.
+// When the queue is full, either the oldest or the newest message is dropped.
+type ShapingConfig struct {
+	Enable           bool    `name:"enable" description:"Enable publish rate shaping"`
+	ConnectionRate   float64 `name:"connection-rate" description:"Maximum number of messages per second published to a connection (0 is unlimited)"`                       //nolint:lll
+	ConnectionBurst  uint    `name:"connection-burst" description:"Maximum number of messages published to a connection at once"`                                          //nolint:lll
+	ApplicationRate  float64 `name:"application-rate" description:"Maximum number of messages per second published to all connections of an application (0 is unlimited)"` //nolint:lll
+	ApplicationBurst uint    `name:"application-burst" description:"Maximum number of messages published to all connections of an application at once"`                    //nolint:lll
+	QueueSize        uint    `name:"queue-size" description:"Maximum number of messages queued per connection"`
+	DropOldest       bool    `name:"drop-oldest" description:"Drop the oldest queued message instead of the newest message when the queue is full"` //nolint:lll
+}
+
+func newLimiter(r float64, burst uint) *rate.Limiter {
+	if r <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+	if burst == 0 {
+		burst = 1
+	}
+	return rate.NewLimiter(rate.Limit(r), int(burst))
+}
+
+type applicationLimiter struct {
+	limiter     *rate.Limiter
+	connections int
+}
+
+// Shaper shapes the rate of messages published to the connections of the MQTT frontend.
+// The application publish rate is shared by the connections of the application on this instance.
+type Shaper struct {
+	conf ShapingConfig
+
+	mu           sync.Mutex
+	applications map[string]*applicationLimiter
+}
+
+// NewShaper returns a new Shaper.
+func NewShaper(conf ShapingConfig) *Shaper {
+	return &Shaper{
+		conf:         conf,
+		applications: make(map[string]*applicationLimiter),
+	}
+}
+
+// connection returns the shaper of a new connection of the application.
+// The returned function must be called when the connection is closed.
+func (s *Shaper) connection(appUID string) (*connectionShaper, func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	app, ok := s.applications[appUID]
+	if !ok {
+		app = &applicationLimiter{
+			limiter: newLimiter(s.conf.ApplicationRate, s.conf.ApplicationBurst),
+		}
+		s.applications[appUID] = app
+	}
+	app.connections++
+	queueSize := int(s.conf.QueueSize)
+	if queueSize == 0 {
+		queueSize = 1
+	}
+	cs := &connectionShaper{
+		appUID:      appUID,
+		application: app.limiter,
+		connection:  newLimiter(s.conf.ConnectionRate, s.conf.ConnectionBurst),
+		queueSize:   queueSize,
+		dropOldest:  s.conf.DropOldest,
+		notify:      make(chan struct{}, 1),
+	}
+	return cs, func() {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if app.connections--; app.connections == 0 {
+			delete(s.applications, appUID)
+		}
+	}
+}
+
+// connectionShaper queues the messages published to a connection, and releases them at the publish rate of the
+// connection and the application.
+type connectionShaper struct {
+	appUID      string
+	application *rate.Limiter
+	connection  *rate.Limiter
+	queueSize   int
+	dropOldest  bool
+	notify      chan struct{}
+
+	mu    sync.Mutex
+	queue []*packet.PublishPacket
+}
+
+// push queues the message. It returns whether a message is dropped because the queue is full.
+func (cs *connectionShaper) push(pkt *packet.PublishPacket) (dropped bool) {
+	cs.mu.Lock()
+	if len(cs.queue) >= cs.queueSize {
+		dropped = true
+		if !cs.dropOldest {
+			cs.mu.Unlock()
+			return dropped
+		}
+		cs.queue[0] = nil
+		cs.queue = cs.queue[1:]
+	}
+	cs.queue = append(cs.queue, pkt)
+	cs.mu.Unlock()
+	select {
+	case cs.notify <- struct{}{}:
+	default:
+	}
+	return dropped
+}
+
+// pop waits for a queued message and for the publish rate of the connection and the application to allow it.
+func (cs *connectionShaper) pop(ctx context.Context) (*packet.PublishPacket, error) {
+	for {
+		cs.mu.Lock()
+		if len(cs.queue) > 0 {
+			cs.mu.Unlock()
+			break
+		}
+		cs.mu.Unlock()
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-cs.notify:
+		}
+	}
+	if err := cs.connection.Wait(ctx); err != nil {
+		return nil, err
+	}
+	if err := cs.application.Wait(ctx); err != nil {
+		return nil, err
+	}
+	// Take the message only after waiting, so that messages that are dropped in the meantime are not published.
+	cs.mu.Lock()
+	defer cs.mu.Unlock()
+	pkt := cs.queue[0]
+	cs.queue[0] = nil
+	cs.queue = cs.queue[1:]
+	return pkt, nil
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package mqtt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/TheThingsIndustries/mystique/pkg/packet"
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestConnectionShaperQueue(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name       string
+		DropOldest bool
+		Expected   []string
+	}{
+		{
+			Name:     "DropNewest",
+			Expected: []string{"a", "b"},
+		},
+		{
+			Name:       "DropOldest",
+			DropOldest: true,
+			Expected:   []string{"b", "c"},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			ctx := test.Context()
+
+			shaper := NewShaper(ShapingConfig{
+				Enable:     true,
+				QueueSize:  2,
+				DropOldest: tc.DropOldest,
+			})
+			cs, release := shaper.connection("test-app")
+			defer release()
+
+			a.So(cs.push(&packet.PublishPacket{TopicName: "a"}), should.BeFalse)
+			a.So(cs.push(&packet.PublishPacket{TopicName: "b"}), should.BeFalse)
+			a.So(cs.push(&packet.PublishPacket{TopicName: "c"}), should.BeTrue)
+
+			for _, expected := range tc.Expected {
+				pkt, err := cs.pop(ctx)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(pkt.TopicName, should.Equal, expected)
+			}
+
+			ctx, cancel := context.WithTimeout(ctx, test.Delay)
+			defer cancel()
+			_, err := cs.pop(ctx)
+			a.So(err, should.Equal, context.DeadlineExceeded)
+		})
+	}
+}
+
+func TestShaperRate(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	shaper := NewShaper(ShapingConfig{
+		Enable:           true,
+		ApplicationRate:  1,
+		ApplicationBurst: 2,
+		QueueSize:        10,
+	})
+	cs1, release1 := shaper.connection("test-app")
+	cs2, release2 := shaper.connection("test-app")
+	other, releaseOther := shaper.connection("other-app")
+	a.So(shaper.applications, should.HaveLength, 2)
+
+	for _, cs := range []*connectionShaper{cs1, cs2, other} {
+		cs.push(&packet.PublishPacket{})
+		cs.push(&packet.PublishPacket{})
+	}
+
+	// The application burst is shared by the connections of the application.
+	popCtx, cancel := context.WithTimeout(ctx, test.Delay)
+	defer cancel()
+	_, err := cs1.pop(popCtx)
+	a.So(err, should.BeNil)
+	_, err = cs2.pop(popCtx)
+	a.So(err, should.BeNil)
+	_, err = cs1.pop(popCtx)
+	a.So(err, should.NotBeNil)
+
+	// Other applications are not affected.
+	_, err = other.pop(popCtx)
+	a.So(err, should.BeNil)
+	_, err = other.pop(popCtx)
+	a.So(err, should.BeNil)
+
+	// The application rate replenishes the tokens.
+	popCtx, cancel = context.WithTimeout(ctx, 2*time.Second)
+	defer cancel()
+	_, err = cs1.pop(popCtx)
+	a.So(err, should.BeNil)
+
+	release1()
+	release2()
+	a.So(shaper.applications, should.HaveLength, 1)
+	releaseOther()
+	a.So(shaper.applications, should.BeEmpty)
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	lastSyncAtField     = "last_sync_at"
+	lastOffsetField     = "last_offset"
+	residualOffsetField = "residual_offset"
+	driftPPMField       = "drift_ppm"
+)
+
+// minDriftInterval is the minimum time between the AppTimeReq commands of which the drift is computed.
+// The device time has a resolution of one second, so shorter intervals result in a too coarse drift.
+var minDriftInterval = time.Hour
+
+// clockSync is the clock synchronization state of an end device, which is stored as the package state of the
+// end device.
+type clockSync struct {
+	// LastSyncAt is the time at which the AppTimeReq was received of which the drift is computed.
+	LastSyncAt time.Time
+	// LastOffset is the offset of the device clock to the network time in the most recent AppTimeReq.
+	LastOffset time.Duration
+	// ResidualOffset is the offset of the device clock at LastSyncAt, after the time corrections answered since.
+	ResidualOffset time.Duration
+	// DriftPPM is the drift of the device clock in parts per million. It is positive if the device clock runs fast.
+	DriftPPM *float64
+}
+
+// observe updates the clock synchronization state with the offset of the device clock to the network time
+// measured at receivedAt, and the time correction that is answered to the end device.
+func (s *clockSync) observe(receivedAt time.Time, offset, correction time.Duration) {
+	s.LastOffset = offset
+	if s.LastSyncAt.IsZero() || receivedAt.Before(s.LastSyncAt) {
+		s.LastSyncAt, s.ResidualOffset = receivedAt, offset-correction
+		return
+	}
+	elapsed := receivedAt.Sub(s.LastSyncAt)
+	if elapsed < minDriftInterval {
+		// Keep the reference point, and account for the time correction that is applied by the end device.
+		s.ResidualOffset -= correction
+		return
+	}
+	drift := float64(s.ResidualOffset-offset) / float64(elapsed) * 1e6
+	s.DriftPPM = &drift
+	s.LastSyncAt, s.ResidualOffset = receivedAt, offset-correction
+}
+
+// Struct serializes the clock synchronization state to *structpb.Struct.
+func (s *clockSync) Struct() *structpb.Struct {
+	fields := map[string]*structpb.Value{
+		lastSyncAtField:     structpb.NewStringValue(s.LastSyncAt.UTC().Format(time.RFC3339Nano)),
+		lastOffsetField:     structpb.NewNumberValue(s.LastOffset.Seconds()),
+		residualOffsetField: structpb.NewNumberValue(s.ResidualOffset.Seconds()),
+	}
+	if s.DriftPPM != nil {
+		fields[driftPPMField] = structpb.NewNumberValue(*s.DriftPPM)
+	}
+	return &structpb.Struct{Fields: fields}
+}
+
+func secondsFromValue(fields map[string]*structpb.Value, field string) (time.Duration, error) {
+	value, ok := fields[field]
+	if !ok {
+		return 0, nil
+	}
+	numberValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, errInvalidFieldType.WithAttributes("field", field, "type", "number").New()
+	}
+	return time.Duration(numberValue.NumberValue * float64(time.Second)), nil
+}
+
+// fromStruct deserializes the clock synchronization state from the package state.
+func (s *clockSync) fromStruct(st *structpb.Struct) error {
+	fields := st.GetFields()
+	if value, ok := fields[lastSyncAtField]; ok {
+		stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes("field", lastSyncAtField, "type", "string").New()
+		}
+		t, err := time.Parse(time.RFC3339Nano, stringValue.StringValue)
+		if err != nil {
+			return errInvalidFieldType.WithCause(err).WithAttributes("field", lastSyncAtField, "type", "string").New()
+		}
+		s.LastSyncAt = t
+	}
+	var err error
+	if s.LastOffset, err = secondsFromValue(fields, lastOffsetField); err != nil {
+		return err
+	}
+	if s.ResidualOffset, err = secondsFromValue(fields, residualOffsetField); err != nil {
+		return err
+	}
+	if value, ok := fields[driftPPMField]; ok {
+		numberValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes("field", driftPPMField, "type", "number").New()
+		}
+		drift := numberValue.NumberValue
+		s.DriftPPM = &drift
+	}
+	return nil
+}
+
+// clockSyncObservation is the offset of the device clock measured in an AppTimeReq, and the answered correction.
+type clockSyncObservation struct {
+	receivedAt time.Time
+	offset     time.Duration
+	correction time.Duration
+}
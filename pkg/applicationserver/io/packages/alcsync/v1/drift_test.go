@@ -0,0 +1,85 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package alcsyncv1
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestClockSyncObserve(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	start := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	state := &clockSync{}
+
+	// The first request is the reference point, and the answered correction is applied by the end device.
+	state.observe(start, 10*time.Second, 10*time.Second)
+	a.So(state.LastSyncAt, should.Equal, start)
+	a.So(state.ResidualOffset, should.Equal, 0)
+	a.So(state.DriftPPM, should.BeNil)
+
+	// Requests within the minimum interval do not change the reference point.
+	state.observe(start.Add(time.Minute), -time.Second, 0)
+	a.So(state.LastSyncAt, should.Equal, start)
+	a.So(state.LastOffset, should.Equal, -time.Second)
+	a.So(state.DriftPPM, should.BeNil)
+
+	// The device clock runs 3.6 seconds fast in 10 hours, which is 100 ppm.
+	state.observe(start.Add(10*time.Hour), -3600*time.Millisecond, -3*time.Second)
+	if a.So(state.DriftPPM, should.NotBeNil) {
+		a.So(*state.DriftPPM, should.AlmostEqual, 100, 0.001)
+	}
+	a.So(state.LastSyncAt, should.Equal, start.Add(10*time.Hour))
+	a.So(state.ResidualOffset, should.Equal, -600*time.Millisecond)
+
+	// A correction within the minimum interval shifts the residual offset of the reference point.
+	state.observe(start.Add(10*time.Hour+time.Minute), -5*time.Second, -5*time.Second)
+	a.So(state.ResidualOffset, should.Equal, 4400*time.Millisecond)
+
+	// The device clock is in sync, taking into account the corrections.
+	state.observe(start.Add(20*time.Hour), 4400*time.Millisecond, 0)
+	if a.So(state.DriftPPM, should.NotBeNil) {
+		a.So(*state.DriftPPM, should.AlmostEqual, 0, 0.001)
+	}
+}
+
+func TestClockSyncStruct(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	drift := -12.5
+	expected := &clockSync{
+		LastSyncAt:     time.Date(2023, time.January, 1, 12, 30, 0, 0, time.UTC),
+		LastOffset:     1500 * time.Millisecond,
+		ResidualOffset: -500 * time.Millisecond,
+		DriftPPM:       &drift,
+	}
+	actual := &clockSync{}
+	if !a.So(actual.fromStruct(expected.Struct()), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(actual, should.Resemble, expected)
+
+	a.So((&clockSync{}).fromStruct(&structpb.Struct{}), should.BeNil)
+	a.So((&clockSync{}).fromStruct(&structpb.Struct{Fields: map[string]*structpb.Value{
+		driftPPMField: structpb.NewStringValue("invalid"),
+	}}), should.HaveSameErrorDefinitionAs, errInvalidFieldType)
+}
@@ -21,6 +21,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 func publishEvents(ctx context.Context, builders ...events.Builder) {
@@ -69,6 +70,13 @@ var (
 		events.WithDataType(&ttnpb.ALCSyncCommand_AppTimeAns{}),
 	)()
 
+	// EvtClockDriftUpdate is the event that is published when the drift of the device clock is updated.
+	EvtClockDriftUpdate = events.Define(
+		"as.packages.alcsync.v1.clock_drift.update", "clock drift updated", eventOptions(
+			events.WithDataType(&structpb.Struct{}),
+		)...,
+	)
+
 	// EvtPkgFail is the event that is published when an error occurs in the package.
 	EvtPkgFail = events.Define(
 		"as.packages.alcsync.v1.fail", "package failed due to error", eventOptions(
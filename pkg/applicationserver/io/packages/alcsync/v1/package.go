@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // PackageName is the name of the package.
@@ -95,8 +96,12 @@ func (a *alcsyncpkg) HandleUp(
 	}
 
 	results := make([]Result, 0, len(commands))
+	observations := make([]clockSyncObservation, 0, len(commands))
 	for _, cmd := range commands {
 		result, err := cmd.Execute()
+		if timeSync, ok := cmd.(*TimeSyncCommand); ok && (err == nil || errors.IsUnavailable(err)) {
+			observations = append(observations, timeSync.observation(result))
+		}
 		if errors.IsUnavailable(err) {
 			continue
 		}
@@ -122,6 +127,51 @@ func (a *alcsyncpkg) HandleUp(
 		logger.WithError(err).Debug("Failed to push downlinks to queue")
 		return err
 	}
+	if len(observations) > 0 {
+		if err := a.recordClockSync(ctx, up.EndDeviceIds, observations...); err != nil {
+			logger.WithError(err).Warn("Failed to record clock synchronization")
+		}
+	}
+	return nil
+}
+
+// recordClockSync updates the clock synchronization state of the end device in its package state.
+// The EvtClockDriftUpdate event is published when the drift of the device clock changes.
+func (a *alcsyncpkg) recordClockSync(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	observations ...clockSyncObservation,
+) error {
+	var prevDriftPPM *float64
+	st, err := a.registry.SetState(ctx, ids, PackageName, func(st *structpb.Struct) (*structpb.Struct, error) {
+		state := &clockSync{}
+		if err := state.fromStruct(st); err != nil {
+			return nil, err
+		}
+		prevDriftPPM = state.DriftPPM
+		for _, obs := range observations {
+			state.observe(obs.receivedAt, obs.offset, obs.correction)
+		}
+		return state.Struct(), nil
+	})
+	if err != nil {
+		return err
+	}
+	state := &clockSync{}
+	if err := state.fromStruct(st); err != nil {
+		return err
+	}
+	logger := log.FromContext(ctx).WithField("clock_offset", state.LastOffset)
+	if state.DriftPPM != nil {
+		logger = logger.WithField("clock_drift_ppm", *state.DriftPPM)
+		if prevDriftPPM == nil || *prevDriftPPM != *state.DriftPPM {
+			publishEvents(ctx, EvtClockDriftUpdate.With(
+				events.WithIdentifiers(ids),
+				events.WithData(st),
+			))
+		}
+	}
+	logger.Debug("Recorded clock synchronization")
 	return nil
 }
 
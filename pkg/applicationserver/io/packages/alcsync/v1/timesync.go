@@ -54,6 +54,18 @@ func (cmd *TimeSyncCommand) Execute() (Result, error) {
 	return result, nil
 }
 
+// observation returns the offset of the device clock and the time correction in the result, if any.
+func (cmd *TimeSyncCommand) observation(result Result) clockSyncObservation {
+	obs := clockSyncObservation{
+		receivedAt: cmd.receivedAt,
+		offset:     cmd.receivedAt.Sub(cmd.req.DeviceTime.AsTime()),
+	}
+	if r, ok := result.(*TimeSyncCommandResult); ok && r != nil {
+		obs.correction = time.Duration(r.ans.TimeCorrection) * time.Second
+	}
+	return obs
+}
+
 // CommandReceivedEventBuilder implements commands.Command.
 func (cmd *TimeSyncCommand) CommandReceivedEventBuilder() events.Builder {
 	return EvtTimeCorrectionCmdReceived.With(events.WithData(cmd.req))
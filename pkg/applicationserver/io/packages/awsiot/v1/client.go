@@ -0,0 +1,124 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+)
+
+const (
+	// connectTimeout is the timeout of connecting to AWS IoT.
+	connectTimeout = 10 * time.Second
+	// presignExpiry is the validity of presigned WebSocket URLs. The URL is signed again on each connection attempt.
+	presignExpiry = 5 * time.Minute
+	// iotService is the name of the AWS IoT data plane service for Signature Version 4.
+	iotService = "iotdevicegateway"
+)
+
+// clientFunc returns a new MQTT client for the AWS IoT endpoint.
+type clientFunc func(data *packageData, clientID string, onConnect mqtt.OnConnectHandler) (mqtt.Client, error)
+
+func createTLSConfig(caPEM, certPEM, keyPEM string) (*tls.Config, error) {
+	// Change the CA certificate pool only if a CA has been provided.
+	// This allows the system-wide CA pool to be used.
+	config := &tls.Config{}
+	if caPEM != "" {
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM([]byte(caPEM)) {
+			return nil, errInvalidCAPEMData.New()
+		}
+	}
+	if certPEM != "" {
+		cert, err := tls.X509KeyPair([]byte(certPEM), []byte(keyPEM))
+		if err != nil {
+			return nil, errInvalidFieldValue.WithCause(err).WithAttributes("field", certificateField).New()
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// presignURL returns the WebSocket URL of the AWS IoT endpoint, presigned with Signature Version 4.
+// The session token is not part of the signature for AWS IoT, so it is appended after signing.
+func presignURL(data *packageData, now time.Time) (string, error) {
+	u := &url.URL{Scheme: "wss", Host: data.Endpoint, Path: "/mqtt"}
+	req, err := http.NewRequest(http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	signer := v4.NewSigner(credentials.NewStaticCredentials(data.AccessKeyID, data.SecretAccessKey, ""))
+	if _, err := signer.Presign(req, nil, iotService, data.region(), presignExpiry, now); err != nil {
+		return "", err
+	}
+	if data.SessionToken != "" {
+		query := req.URL.RawQuery
+		req.URL.RawQuery = query + "&X-Amz-Security-Token=" + url.QueryEscape(data.SessionToken)
+	}
+	return req.URL.String(), nil
+}
+
+// newClient returns a new MQTT client for the AWS IoT endpoint.
+// Mutual TLS is used if a client certificate is configured, and Signature Version 4 over WebSockets otherwise.
+func newClient(data *packageData, clientID string, onConnect mqtt.OnConnectHandler) (mqtt.Client, error) {
+	opts := mqtt.NewClientOptions().
+		SetClientID(clientID).
+		SetCleanSession(true).
+		SetAutoReconnect(true).
+		SetOrderMatters(false).
+		SetConnectTimeout(connectTimeout).
+		SetOnConnectHandler(onConnect)
+	if data.Certificate != "" {
+		tlsConfig, err := createTLSConfig(data.CACertificate, data.Certificate, data.PrivateKey)
+		if err != nil {
+			return nil, err
+		}
+		opts.AddBroker("ssl://" + net.JoinHostPort(data.Endpoint, "8883")).SetTLSConfig(tlsConfig)
+		return mqtt.NewClient(opts), nil
+	}
+	tlsConfig, err := createTLSConfig(data.CACertificate, "", "")
+	if err != nil {
+		return nil, err
+	}
+	opts.AddBroker("wss://" + net.JoinHostPort(data.Endpoint, "443") + "/mqtt").
+		SetCustomOpenConnectionFn(func(*url.URL, mqtt.ClientOptions) (net.Conn, error) {
+			u, err := presignURL(data, time.Now())
+			if err != nil {
+				return nil, err
+			}
+			return mqtt.NewWebsocket(u, tlsConfig, connectTimeout, nil, nil)
+		})
+	return mqtt.NewClient(opts), nil
+}
+
+// waitToken awaits the token operation to finish in parallel with the context.
+// Keep in mind that context deadlines do not cancel the underlying MQTT client operation.
+func waitToken(ctx context.Context, token mqtt.Token) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-token.Done():
+		return token.Error()
+	}
+}
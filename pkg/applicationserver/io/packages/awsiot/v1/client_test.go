@@ -0,0 +1,59 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPresignURL(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	data := &packageData{
+		Endpoint:        "example-ats.iot.eu-west-1.amazonaws.com",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+	}
+	now := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	signed, err := presignURL(data, now)
+	a.So(err, should.BeNil)
+	u, err := url.Parse(signed)
+	a.So(err, should.BeNil)
+	a.So(u.Scheme, should.Equal, "wss")
+	a.So(u.Host, should.Equal, data.Endpoint)
+	a.So(u.Path, should.Equal, "/mqtt")
+	query := u.Query()
+	a.So(query.Get("X-Amz-Algorithm"), should.Equal, "AWS4-HMAC-SHA256")
+	a.So(query.Get("X-Amz-Credential"), should.Equal, "AKIDEXAMPLE/20261015/eu-west-1/iotdevicegateway/aws4_request")
+	a.So(query.Get("X-Amz-Date"), should.Equal, "20261015T120000Z")
+	a.So(query.Get("X-Amz-Signature"), should.HaveLength, 64)
+	a.So(query.Has("X-Amz-Security-Token"), should.BeFalse)
+
+	// The session token is appended after signing, so that the signature is the same.
+	data.SessionToken = "token/+="
+	withToken, err := presignURL(data, now)
+	a.So(err, should.BeNil)
+	a.So(strings.HasPrefix(withToken, signed+"&X-Amz-Security-Token="), should.BeTrue)
+	u, err = url.Parse(withToken)
+	a.So(err, should.BeNil)
+	a.So(u.Query().Get("X-Amz-Security-Token"), should.Equal, "token/+=")
+}
@@ -0,0 +1,267 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/formatters"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// connectionIdleTimeout is the duration after which a connection is closed if no uplinks are published.
+	connectionIdleTimeout = 24 * time.Hour
+	// publishQoS is the QoS of published messages and of subscriptions.
+	publishQoS = 1
+)
+
+// connection is a connection to AWS IoT for an application.
+type connection struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	server io.Server
+
+	ids      *ttnpb.ApplicationIdentifiers
+	data     *packageData
+	fPort    uint32
+	clientID string
+	client   mqtt.Client
+
+	// ready is closed when the connection is established, or when establishing the connection failed with err.
+	ready chan struct{}
+	err   error
+	idle  *time.Timer
+}
+
+func (c *connection) devicesTopic(parts ...string) string {
+	return strings.Join(append([]string{c.data.TopicPrefix, c.ids.ApplicationId, "devices"}, parts...), "/")
+}
+
+// uplinkTopic returns the topic of uplink messages of the given end device.
+func (c *connection) uplinkTopic(deviceID string) string {
+	return c.devicesTopic(deviceID, "up")
+}
+
+// shadowTopic returns the topic of the named shadow of the given thing.
+func (c *connection) shadowTopic(thingName string, parts ...string) string {
+	return strings.Join(append([]string{"$aws/things", thingName, "shadow/name", c.data.ShadowName}, parts...), "/")
+}
+
+// deviceIDFromTopic returns the end device ID in the topic at the index of the given wildcard topic.
+func deviceIDFromTopic(wildcard, topic string) (string, bool) {
+	wildcardParts, topicParts := strings.Split(wildcard, "/"), strings.Split(topic, "/")
+	if len(wildcardParts) != len(topicParts) {
+		return "", false
+	}
+	for i, part := range wildcardParts {
+		if part == "+" {
+			return topicParts[i], true
+		}
+	}
+	return "", false
+}
+
+// publish publishes the given payload.
+func (c *connection) publish(ctx context.Context, topic string, payload []byte) error {
+	if err := waitToken(ctx, c.client.Publish(topic, publishQoS, false, payload)); err != nil {
+		return errPublish.WithCause(err).WithAttributes("topic", topic).New()
+	}
+	return nil
+}
+
+// shadowUpdate returns the shadow update document that reports the given fields.
+// If names is not empty, only the fields with the given names are reported.
+func shadowUpdate(fields *structpb.Struct, names []string) ([]byte, bool) {
+	reported := fields.GetFields()
+	if len(names) > 0 {
+		reported = make(map[string]*structpb.Value, len(names))
+		for _, name := range names {
+			if value, ok := fields.GetFields()[name]; ok {
+				reported[name] = value
+			}
+		}
+	}
+	if len(reported) == 0 {
+		return nil, false
+	}
+	b, err := json.Marshal(map[string]any{
+		"state": map[string]any{
+			"reported": (&structpb.Struct{Fields: reported}).AsMap(),
+		},
+	})
+	if err != nil {
+		return nil, false
+	}
+	return b, true
+}
+
+// publishUp publishes the uplink message to the uplink topic of the end device, and reports the decoded payload
+// in the named shadow of the thing of the end device.
+func (c *connection) publishUp(ctx context.Context, up *ttnpb.ApplicationUp, shadowFields []string) error {
+	payload, err := formatters.JSON.FromUp(up)
+	if err != nil {
+		return err
+	}
+	if err := c.publish(ctx, c.uplinkTopic(up.EndDeviceIds.DeviceId), payload); err != nil {
+		return err
+	}
+	if c.data.ShadowName == "" {
+		return nil
+	}
+	update, ok := shadowUpdate(up.GetUplinkMessage().GetDecodedPayload(), shadowFields)
+	if !ok {
+		return nil
+	}
+	return c.publish(ctx, c.shadowTopic(c.data.thingName(up.EndDeviceIds.DeviceId), "update"), update)
+}
+
+// subscribe subscribes to the downlinks of the configured downlink source.
+// It is called each time the client (re)connects, as the sessions are not persistent.
+func (c *connection) subscribe(client mqtt.Client) {
+	logger := log.FromContext(c.ctx)
+	var token mqtt.Token
+	switch c.data.DownlinkSource {
+	case downlinkSourceTopic:
+		token = client.SubscribeMultiple(map[string]byte{
+			c.devicesTopic("+", "down", "push"):    publishQoS,
+			c.devicesTopic("+", "down", "replace"): publishQoS,
+		}, c.handleDownlinks)
+	case downlinkSourceShadow:
+		token = client.Subscribe(c.shadowTopic("+", "update", "delta"), publishQoS, c.handleShadowDelta)
+	default:
+		return
+	}
+	go func() {
+		if err := waitToken(c.ctx, token); err != nil {
+			logger.WithError(err).Warn("Failed to subscribe to downlinks")
+			return
+		}
+		logger.WithField("downlink_source", c.data.DownlinkSource).Debug("Subscribed to downlinks")
+	}()
+}
+
+func (c *connection) deviceContext(deviceID, topic string) (context.Context, *ttnpb.EndDeviceIdentifiers) {
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: c.ids,
+		DeviceId:       deviceID,
+	}
+	return log.NewContextWithFields(c.ctx, log.Fields(
+		"device_uid", unique.ID(c.ctx, ids),
+		"topic", topic,
+	)), ids
+}
+
+// handleDownlinks handles the downlink messages on the downlink topics of the end devices.
+func (c *connection) handleDownlinks(_ mqtt.Client, msg mqtt.Message) {
+	var (
+		wildcard string
+		op       func(io.Server, context.Context, *ttnpb.EndDeviceIdentifiers, []*ttnpb.ApplicationDownlink) error
+	)
+	switch {
+	case strings.HasSuffix(msg.Topic(), "/down/push"):
+		wildcard, op = c.devicesTopic("+", "down", "push"), io.Server.DownlinkQueuePush
+	case strings.HasSuffix(msg.Topic(), "/down/replace"):
+		wildcard, op = c.devicesTopic("+", "down", "replace"), io.Server.DownlinkQueueReplace
+	default:
+		return
+	}
+	deviceID, ok := deviceIDFromTopic(wildcard, msg.Topic())
+	if !ok {
+		return
+	}
+	ctx, ids := c.deviceContext(deviceID, msg.Topic())
+	logger := log.FromContext(ctx)
+	err := func() error {
+		downlinks, err := formatters.JSON.ToDownlinks(msg.Payload())
+		if err != nil {
+			return err
+		}
+		req := &ttnpb.DownlinkQueueRequest{
+			EndDeviceIds: ids,
+			Downlinks:    downlinks.Downlinks,
+		}
+		if err := req.ValidateFields(); err != nil {
+			return err
+		}
+		logger.WithField("count", len(req.Downlinks)).Debug("Handle downlink messages")
+		return op(c.server, ctx, ids, req.Downlinks)
+	}()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to handle downlink messages")
+		publishEvents(ctx, ids, EvtDownlinkFail.With(events.WithData(err)))
+	}
+}
+
+// shadowDelta is the delta document of a shadow.
+type shadowDelta struct {
+	Version int64          `json:"version"`
+	State   map[string]any `json:"state"`
+}
+
+// handleShadowDelta handles the delta of the desired state of the named shadows. The delta is enqueued as decoded
+// payload on the FPort of the association, and then reported in the shadow, so that the delta is sent once.
+func (c *connection) handleShadowDelta(_ mqtt.Client, msg mqtt.Message) {
+	thingName, ok := deviceIDFromTopic(c.shadowTopic("+", "update", "delta"), msg.Topic())
+	if !ok || !strings.HasPrefix(thingName, c.data.ThingNamePrefix) {
+		return
+	}
+	ctx, ids := c.deviceContext(strings.TrimPrefix(thingName, c.data.ThingNamePrefix), msg.Topic())
+	logger := log.FromContext(ctx)
+	err := func() error {
+		delta := &shadowDelta{}
+		if err := json.Unmarshal(msg.Payload(), delta); err != nil {
+			return errInvalidShadowDelta.WithCause(err).WithAttributes("thing", thingName).New()
+		}
+		state, err := structpb.NewStruct(delta.State)
+		if err != nil {
+			return errInvalidShadowDelta.WithCause(err).WithAttributes("thing", thingName).New()
+		}
+		if len(state.GetFields()) == 0 {
+			return errInvalidShadowDelta.WithAttributes("thing", thingName).New()
+		}
+		down := &ttnpb.ApplicationDownlink{
+			FPort:          c.fPort,
+			DecodedPayload: state,
+		}
+		if err := ids.ValidateFields(); err != nil {
+			return err
+		}
+		logger.WithField("version", delta.Version).Debug("Handle shadow delta")
+		if err := c.server.DownlinkQueuePush(ctx, ids, []*ttnpb.ApplicationDownlink{down}); err != nil {
+			return err
+		}
+		update, _ := shadowUpdate(state, nil)
+		return c.publish(ctx, c.shadowTopic(thingName, "update"), update)
+	}()
+	if err != nil {
+		logger.WithError(err).Warn("Failed to handle shadow delta")
+		publishEvents(ctx, ids, EvtDownlinkFail.With(events.WithData(err)))
+	}
+}
+
+// close closes the connection.
+func (c *connection) close() {
+	c.cancel()
+}
@@ -0,0 +1,267 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	endpointField        = "endpoint"
+	regionField          = "region"
+	certificateField     = "certificate"
+	privateKeyField      = "private_key"
+	caCertificateField   = "ca_certificate"
+	accessKeyIDField     = "access_key_id"
+	secretAccessKeyField = "secret_access_key"
+	sessionTokenField    = "session_token"
+	clientIDField        = "client_id"
+	topicPrefixField     = "topic_prefix"
+	thingNamePrefixField = "thing_name_prefix"
+	shadowNameField      = "shadow_name"
+	shadowFieldsField    = "shadow_fields"
+	downlinkSourceField  = "downlink_source"
+)
+
+// defaultTopicPrefix is the default prefix of the uplink and downlink topics.
+const defaultTopicPrefix = "lorawan"
+
+// downlinkSource is the source of the downlinks that are consumed from AWS IoT.
+type downlinkSource string
+
+const (
+	// downlinkSourceTopic consumes downlink messages from the downlink topics of the end devices.
+	downlinkSourceTopic downlinkSource = "topic"
+	// downlinkSourceShadow consumes the delta of the desired state of the named shadows as decoded payload.
+	downlinkSourceShadow downlinkSource = "shadow"
+	// downlinkSourceNone does not consume downlinks.
+	downlinkSourceNone downlinkSource = "none"
+)
+
+// packageData is the configuration of the AWS IoT integration.
+type packageData struct {
+	// Endpoint is the AWS IoT device data endpoint.
+	Endpoint string
+	// Region is the AWS region of the endpoint. If not set, it is derived from the endpoint.
+	Region string
+	// Certificate and PrivateKey are the PEM encoded X.509 client certificate and private key for mutual TLS.
+	Certificate string
+	PrivateKey  string
+	// CACertificate is the PEM encoded CA certificate of the endpoint. If not set, the system CAs are used.
+	CACertificate string
+	// AccessKeyID, SecretAccessKey and SessionToken are the AWS credentials for Signature Version 4 authentication
+	// over WebSockets. They are used if no client certificate is configured.
+	AccessKeyID     string
+	SecretAccessKey string
+	SessionToken    string
+	// ClientID is the MQTT client ID. If not set, a client ID is derived from the application.
+	ClientID string
+	// TopicPrefix is the prefix of the uplink and downlink topics.
+	TopicPrefix string
+	// ThingNamePrefix is prepended to the end device ID to get the thing name.
+	ThingNamePrefix string
+	// ShadowName is the name of the thing shadow that is updated with the decoded payload.
+	// If not set, no shadow is updated.
+	ShadowName string
+	// ShadowFields are the decoded payload fields that are reported in the shadow. If not set, all fields are reported.
+	ShadowFields []string
+	// DownlinkSource is the source of downlinks.
+	DownlinkSource downlinkSource
+}
+
+func stringFromStruct(fields map[string]*structpb.Value, field string) (string, error) {
+	value, ok := fields[field]
+	if !ok {
+		return "", nil
+	}
+	stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", errInvalidFieldType.WithAttributes("field", field, "type", "string").New()
+	}
+	return stringValue.StringValue, nil
+}
+
+func stringsFromStruct(fields map[string]*structpb.Value, field string) ([]string, error) {
+	value, ok := fields[field]
+	if !ok {
+		return nil, nil
+	}
+	listValue, ok := value.GetKind().(*structpb.Value_ListValue)
+	if !ok {
+		return nil, errInvalidFieldType.WithAttributes("field", field, "type", "list").New()
+	}
+	values := make([]string, 0, len(listValue.ListValue.GetValues()))
+	for _, v := range listValue.ListValue.GetValues() {
+		stringValue, ok := v.GetKind().(*structpb.Value_StringValue)
+		if !ok {
+			return nil, errInvalidFieldType.WithAttributes("field", field, "type", "string").New()
+		}
+		values = append(values, stringValue.StringValue)
+	}
+	return values, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) (err error) {
+	fields := st.GetFields()
+	for _, f := range []struct {
+		field string
+		dst   *string
+	}{
+		{endpointField, &d.Endpoint},
+		{regionField, &d.Region},
+		{certificateField, &d.Certificate},
+		{privateKeyField, &d.PrivateKey},
+		{caCertificateField, &d.CACertificate},
+		{accessKeyIDField, &d.AccessKeyID},
+		{secretAccessKeyField, &d.SecretAccessKey},
+		{sessionTokenField, &d.SessionToken},
+		{clientIDField, &d.ClientID},
+		{topicPrefixField, &d.TopicPrefix},
+		{thingNamePrefixField, &d.ThingNamePrefix},
+		{shadowNameField, &d.ShadowName},
+	} {
+		if *f.dst, err = stringFromStruct(fields, f.field); err != nil {
+			return err
+		}
+	}
+	source, err := stringFromStruct(fields, downlinkSourceField)
+	if err != nil {
+		return err
+	}
+	switch source := downlinkSource(source); source {
+	case "", downlinkSourceTopic, downlinkSourceShadow, downlinkSourceNone:
+		d.DownlinkSource = source
+	default:
+		return errInvalidFieldValue.WithAttributes("field", downlinkSourceField).New()
+	}
+	if d.ShadowFields, err = stringsFromStruct(fields, shadowFieldsField); err != nil {
+		return err
+	}
+	return nil
+}
+
+// validate checks whether the configuration is complete.
+func (d *packageData) validate() error {
+	switch {
+	case d.Endpoint == "":
+		return errMissingField.WithAttributes("field", endpointField).New()
+	case d.Certificate != "" && d.PrivateKey == "":
+		return errMissingField.WithAttributes("field", privateKeyField).New()
+	case d.Certificate == "" && d.AccessKeyID == "":
+		return errNoCredentials.New()
+	case d.Certificate == "" && d.SecretAccessKey == "":
+		return errMissingField.WithAttributes("field", secretAccessKeyField).New()
+	case d.Certificate == "" && d.region() == "":
+		return errMissingField.WithAttributes("field", regionField).New()
+	case d.DownlinkSource == downlinkSourceShadow && d.ShadowName == "":
+		return errMissingField.WithAttributes("field", shadowNameField).New()
+	}
+	return nil
+}
+
+// region returns the AWS region of the endpoint.
+// If no region is configured, it is derived from the endpoint, i.e. `<prefix>-ats.iot.<region>.amazonaws.com`.
+func (d *packageData) region() string {
+	if d.Region != "" {
+		return d.Region
+	}
+	parts := strings.Split(d.Endpoint, ".")
+	for i, part := range parts[:len(parts)-1] {
+		if part == "iot" {
+			return parts[i+1]
+		}
+	}
+	return ""
+}
+
+// thingName returns the name of the thing of the given end device.
+func (d *packageData) thingName(deviceID string) string {
+	return d.ThingNamePrefix + deviceID
+}
+
+// digest returns the digest of the settings of the connection to AWS IoT.
+// A new connection is established when the digest changes.
+func (d *packageData) digest(fPort uint32) string {
+	h := sha256.New()
+	for _, s := range []string{
+		d.Endpoint, d.region(),
+		d.Certificate, d.PrivateKey, d.CACertificate,
+		d.AccessKeyID, d.SecretAccessKey, d.SessionToken,
+		d.ClientID, d.TopicPrefix, d.ThingNamePrefix, d.ShadowName, string(d.DownlinkSource),
+	} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	h.Write([]byte{byte(fPort), byte(fPort >> 8)})
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, uint32, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+
+	merged := &packageData{
+		TopicPrefix:    defaultTopicPrefix,
+		DownlinkSource: downlinkSourceTopic,
+	}
+	for _, data := range []packageData{defaultData, associationData} {
+		for _, f := range []struct {
+			src string
+			dst *string
+		}{
+			{data.Endpoint, &merged.Endpoint},
+			{data.Region, &merged.Region},
+			{data.Certificate, &merged.Certificate},
+			{data.PrivateKey, &merged.PrivateKey},
+			{data.CACertificate, &merged.CACertificate},
+			{data.AccessKeyID, &merged.AccessKeyID},
+			{data.SecretAccessKey, &merged.SecretAccessKey},
+			{data.SessionToken, &merged.SessionToken},
+			{data.ClientID, &merged.ClientID},
+			{data.TopicPrefix, &merged.TopicPrefix},
+			{data.ThingNamePrefix, &merged.ThingNamePrefix},
+			{data.ShadowName, &merged.ShadowName},
+		} {
+			if f.src != "" {
+				*f.dst = f.src
+			}
+		}
+		if data.ShadowFields != nil {
+			merged.ShadowFields = data.ShadowFields
+		}
+		if data.DownlinkSource != "" {
+			merged.DownlinkSource = data.DownlinkSource
+		}
+	}
+	fPort := def.GetIds().GetFPort()
+	assocFPort := assoc.GetIds().GetFPort()
+	if assocFPort != 0 {
+		fPort = assocFPort
+	}
+	return merged, fPort, nil
+}
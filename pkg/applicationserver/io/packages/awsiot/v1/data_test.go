@@ -0,0 +1,100 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPackageDataMerge(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{FPort: 1},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			endpointField:        structpb.NewStringValue("example-ats.iot.eu-west-1.amazonaws.com"),
+			certificateField:     structpb.NewStringValue("certificate"),
+			privateKeyField:      structpb.NewStringValue("key"),
+			shadowNameField:      structpb.NewStringValue("lorawan"),
+			thingNamePrefixField: structpb.NewStringValue("tts-"),
+		}},
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		Ids: &ttnpb.ApplicationPackageAssociationIdentifiers{FPort: 2},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			downlinkSourceField: structpb.NewStringValue("shadow"),
+			shadowFieldsField: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewStringValue("temperature"),
+			}}),
+		}},
+	}
+	data, fPort, err := mergePackageData(def, assoc)
+	a.So(err, should.BeNil)
+	a.So(fPort, should.Equal, 2)
+	a.So(data, should.Resemble, &packageData{
+		Endpoint:        "example-ats.iot.eu-west-1.amazonaws.com",
+		Certificate:     "certificate",
+		PrivateKey:      "key",
+		TopicPrefix:     defaultTopicPrefix,
+		ThingNamePrefix: "tts-",
+		ShadowName:      "lorawan",
+		ShadowFields:    []string{"temperature"},
+		DownlinkSource:  downlinkSourceShadow,
+	})
+	a.So(data.validate(), should.BeNil)
+	a.So(data.region(), should.Equal, "eu-west-1")
+	a.So(data.thingName("test-dev"), should.Equal, "tts-test-dev")
+	a.So(data.digest(1), should.NotEqual, data.digest(2))
+
+	data, _, err = mergePackageData(def, nil)
+	a.So(err, should.BeNil)
+	a.So(data.DownlinkSource, should.Equal, downlinkSourceTopic)
+
+	for _, tc := range []struct {
+		data     *packageData
+		errorDef error
+	}{
+		{&packageData{}, errMissingField},
+		{&packageData{Endpoint: "example.com"}, errNoCredentials},
+		{&packageData{Endpoint: "example.com", Certificate: "certificate"}, errMissingField},
+		{&packageData{Endpoint: "example.com", AccessKeyID: "id", SecretAccessKey: "secret"}, errMissingField},
+		{
+			&packageData{
+				Endpoint: "example.com", Certificate: "certificate", PrivateKey: "key", DownlinkSource: downlinkSourceShadow,
+			},
+			errMissingField,
+		},
+	} {
+		a.So(tc.data.validate(), should.HaveSameErrorDefinitionAs, tc.errorDef)
+	}
+	a.So((&packageData{
+		Endpoint: "example.com", Region: "us-east-1", AccessKeyID: "id", SecretAccessKey: "secret",
+	}).validate(), should.BeNil)
+
+	for _, st := range []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{endpointField: structpb.NewNumberValue(1)}},
+		{Fields: map[string]*structpb.Value{downlinkSourceField: structpb.NewStringValue("queue")}},
+		{Fields: map[string]*structpb.Value{shadowFieldsField: structpb.NewStringValue("temperature")}},
+	} {
+		_, _, err := mergePackageData(nil, &ttnpb.ApplicationPackageAssociation{Data: st})
+		a.So(err, should.HaveSameErrorDefinitionAs, errPkgDataMerge)
+	}
+}
@@ -0,0 +1,35 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation = errors.DefineInternal("no_association", "no association available")
+
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineInvalidArgument("invalid_field_value", "invalid value of field `{field}`")
+	errMissingField      = errors.DefineInvalidArgument("missing_field", "missing field `{field}`")
+	errPkgDataMerge      = errors.DefineCorruption("pkg_data_merge", "failed to merge package data")
+	errNoCredentials     = errors.DefineInvalidArgument(
+		"no_credentials", "no certificate and private key, or access key configured",
+	)
+	errInvalidCAPEMData = errors.DefineInvalidArgument("ca_pem_data", "CA PEM data is invalid")
+
+	errConnect = errors.DefineUnavailable("connect", "connect to AWS IoT endpoint `{endpoint}`")
+	errPublish = errors.DefineUnavailable("publish", "publish to topic `{topic}`")
+
+	errInvalidShadowDelta = errors.DefineInvalidArgument("invalid_shadow_delta", "invalid shadow delta of thing `{thing}`")
+)
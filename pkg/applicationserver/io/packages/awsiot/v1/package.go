@@ -0,0 +1,226 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package awsiotv1 implements the AWS IoT Core integration as application package.
+package awsiotv1
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// PackageName is the name of the package.
+const PackageName = "aws-iot-v1"
+
+// awsIoTPackage is the AWS IoT Core integration.
+// Uplink messages are published to AWS IoT, and the decoded payload is reported in a named shadow of the thing of
+// the end device. Downlinks are consumed from the downlink topics of the end devices or from the delta of the named
+// shadows. The connections to AWS IoT are established on the first uplink of an application, and closed when the
+// application has no uplinks for a while.
+type awsIoTPackage struct {
+	server     io.Server
+	newClient  clientFunc
+	instanceID string
+
+	connectionsMu sync.Mutex
+	connections   map[string]*connection
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *awsIoTPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/awsiot/v1")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	if up.GetUplinkMessage() == nil {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			publishEvents(ctx, up.EndDeviceIds, EvtPkgFail.With(events.WithData(err)))
+		}
+	}()
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to merge package data")
+		return err
+	}
+	if err := data.validate(); err != nil {
+		logger.WithError(err).Debug("Invalid package data")
+		return err
+	}
+
+	conn, err := p.connection(ctx, up.EndDeviceIds.ApplicationIds, data, fPort)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to connect to AWS IoT")
+		return err
+	}
+	if err := conn.publishUp(ctx, up, data.ShadowFields); err != nil {
+		logger.WithError(err).Debug("Failed to publish uplink message")
+		return err
+	}
+	return nil
+}
+
+// connection returns the connection to AWS IoT of the application with the given configuration.
+// If there is no such connection, a new connection is established.
+func (p *awsIoTPackage) connection(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, data *packageData, fPort uint32,
+) (*connection, error) {
+	digest := data.digest(fPort)
+	key := fmt.Sprintf("%s/%s", unique.ID(ctx, ids), digest)
+
+	p.connectionsMu.Lock()
+	conn, ok := p.connections[key]
+	if ok {
+		conn.idle.Reset(connectionIdleTimeout)
+	} else {
+		clientID := data.ClientID
+		if clientID == "" {
+			clientID = fmt.Sprintf("%s-%s-%s", ids.ApplicationId, digest, p.instanceID)
+		}
+		// AWS IoT disconnects the existing connection with the same client ID, so it is closed.
+		for existingKey, existing := range p.connections {
+			if unique.ID(ctx, existing.ids) == unique.ID(ctx, ids) && existing.clientID == clientID {
+				existing.close()
+				delete(p.connections, existingKey)
+			}
+		}
+		conn = p.newConnection(ctx, key, ids, data, fPort, clientID)
+		p.connections[key] = conn
+	}
+	p.connectionsMu.Unlock()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-conn.ready:
+	}
+	if conn.err != nil {
+		return nil, conn.err
+	}
+	return conn, nil
+}
+
+// newConnection returns a new connection that connects to AWS IoT in the background.
+// The connection lives until it is idle or until the Application Server stops.
+func (p *awsIoTPackage) newConnection(
+	ctx context.Context,
+	key string,
+	ids *ttnpb.ApplicationIdentifiers,
+	data *packageData,
+	fPort uint32,
+	clientID string,
+) *connection {
+	ctx = log.NewContextWithFields(p.server.FromRequestContext(ctx), log.Fields(
+		"application_uid", unique.ID(ctx, ids),
+		"endpoint", data.Endpoint,
+		"client_id", clientID,
+	))
+	ctx, cancel := context.WithCancel(ctx)
+	conn := &connection{
+		ctx:      ctx,
+		cancel:   cancel,
+		server:   p.server,
+		ids:      ids,
+		data:     data,
+		fPort:    fPort,
+		clientID: clientID,
+		ready:    make(chan struct{}),
+	}
+	conn.idle = time.AfterFunc(connectionIdleTimeout, func() {
+		log.FromContext(ctx).Debug("Close idle connection")
+		p.closeConnection(key, conn)
+	})
+	go func() {
+		logger := log.FromContext(ctx)
+		conn.client, conn.err = p.newClient(data, clientID, conn.subscribe)
+		if conn.err == nil {
+			if err := waitToken(ctx, conn.client.Connect()); err != nil {
+				conn.err = errConnect.WithCause(err).WithAttributes("endpoint", data.Endpoint).New()
+			}
+		}
+		close(conn.ready)
+		if conn.err != nil {
+			logger.WithError(conn.err).Warn("Failed to connect to AWS IoT")
+			if conn.client != nil {
+				conn.client.Disconnect(0)
+			}
+			p.closeConnection(key, conn)
+			return
+		}
+		logger.Info("Connected to AWS IoT")
+		<-ctx.Done()
+		conn.client.Disconnect(uint(connectTimeout / time.Millisecond))
+		logger.Info("Disconnected from AWS IoT")
+	}()
+	return conn
+}
+
+// closeConnection closes the given connection and removes it.
+func (p *awsIoTPackage) closeConnection(key string, conn *connection) {
+	p.connectionsMu.Lock()
+	if p.connections[key] == conn {
+		delete(p.connections, key)
+	}
+	p.connectionsMu.Unlock()
+	conn.idle.Stop()
+	conn.close()
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*awsIoTPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 1,
+	}
+}
+
+// New returns a new AWS IoT package.
+func New(server io.Server, _ packages.Registry) packages.ApplicationPackageHandler {
+	return newPackage(server, newClient)
+}
+
+func newPackage(server io.Server, newClient clientFunc) *awsIoTPackage {
+	var instanceID [4]byte
+	_, _ = rand.Read(instanceID[:])
+	return &awsIoTPackage{
+		server:      server,
+		newClient:   newClient,
+		instanceID:  hex.EncodeToString(instanceID[:]),
+		connections: make(map[string]*connection),
+	}
+}
@@ -0,0 +1,224 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package awsiotv1
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	mqttnet "github.com/TheThingsIndustries/mystique/pkg/net"
+	"github.com/TheThingsIndustries/mystique/pkg/server"
+	mqtt "github.com/eclipse/paho.mqtt.golang"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var timeout = (1 << 8) * test.Delay
+
+type downlinkOperation struct {
+	ids       *ttnpb.EndDeviceIdentifiers
+	downlinks []*ttnpb.ApplicationDownlink
+	replace   bool
+}
+
+type mockServer struct {
+	io.Server
+	ctx context.Context
+	ch  chan downlinkOperation
+}
+
+func (s *mockServer) FromRequestContext(context.Context) context.Context {
+	return s.ctx
+}
+
+func (s *mockServer) DownlinkQueuePush(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.ch <- downlinkOperation{ids: ids, downlinks: items}
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueReplace(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.ch <- downlinkOperation{ids: ids, downlinks: items, replace: true}
+	return nil
+}
+
+func startMQTTServer(ctx context.Context, t *testing.T) string {
+	t.Helper()
+	s := server.New(ctx)
+	lis, err := mqttnet.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Failed to listen: %v", err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			go s.Handle(conn)
+		}
+	}()
+	return "tcp://" + lis.Addr().String()
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	broker := startMQTTServer(ctx, t)
+	srv := &mockServer{ctx: ctx, ch: make(chan downlinkOperation, 16)}
+	pkg := newPackage(srv, func(_ *packageData, clientID string, onConnect mqtt.OnConnectHandler) (mqtt.Client, error) {
+		return mqtt.NewClient(mqtt.NewClientOptions().
+			AddBroker(broker).
+			SetClientID(clientID).
+			SetOrderMatters(false).
+			SetOnConnectHandler(onConnect),
+		), nil
+	})
+
+	testClient := mqtt.NewClient(mqtt.NewClientOptions().AddBroker(broker).SetClientID("test").SetOrderMatters(false))
+	if err := waitToken(ctx, testClient.Connect()); err != nil {
+		t.Fatalf("Failed to connect: %v", err)
+	}
+	defer testClient.Disconnect(0)
+	messages := make(chan mqtt.Message, 16)
+	if err := waitToken(ctx, testClient.SubscribeMultiple(map[string]byte{
+		"lorawan/#": 1,
+		"$aws/things/+/shadow/name/lorawan/update":       1,
+		"$aws/things/+/shadow/name/lorawan/update/delta": 1,
+	}, func(_ mqtt.Client, msg mqtt.Message) {
+		messages <- msg
+	})); err != nil {
+		t.Fatalf("Failed to subscribe: %v", err)
+	}
+	receive := func(topic string) mqtt.Message {
+		t.Helper()
+		for {
+			select {
+			case msg := <-messages:
+				if msg.Topic() == topic {
+					return msg
+				}
+			case <-time.After(timeout):
+				t.Fatalf("Timeout waiting for message on topic `%s`", topic)
+			}
+		}
+	}
+	// publishDownlink publishes the payload until the downlink is handled, as subscribing happens in the background.
+	publishDownlink := func(topic string, payload []byte) downlinkOperation {
+		t.Helper()
+		for i := 0; i < 8; i++ {
+			if err := waitToken(ctx, testClient.Publish(topic, 1, false, payload)); err != nil {
+				t.Fatalf("Failed to publish: %v", err)
+			}
+			select {
+			case op := <-srv.ch:
+				return op
+			case <-time.After(timeout / 8):
+			}
+		}
+		t.Fatalf("Timeout waiting for downlink from topic `%s`", topic)
+		return downlinkOperation{}
+	}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	ids := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "test-dev"}
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+			ApplicationIds: appIDs,
+			FPort:          1,
+		},
+		PackageName: PackageName,
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			endpointField:        structpb.NewStringValue("example-ats.iot.eu-west-1.amazonaws.com"),
+			accessKeyIDField:     structpb.NewStringValue("AKIDEXAMPLE"),
+			secretAccessKeyField: structpb.NewStringValue("secret"),
+			shadowNameField:      structpb.NewStringValue("lorawan"),
+			shadowFieldsField: structpb.NewListValue(&structpb.ListValue{Values: []*structpb.Value{
+				structpb.NewStringValue("temperature"),
+			}}),
+		}},
+	}
+	decodedPayload, err := structpb.NewStruct(map[string]any{"temperature": 21.5, "humidity": 40})
+	a.So(err, should.BeNil)
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIds: ids,
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+			FPort:          2,
+			FrmPayload:     []byte{0x1, 0x2},
+			DecodedPayload: decodedPayload,
+		}},
+	}
+
+	// The uplink message is published and the selected fields are reported in the shadow.
+	a.So(pkg.HandleUp(ctx, def, nil, up), should.BeNil)
+	msg := receive("lorawan/test-app/devices/test-dev/up")
+	var published struct {
+		EndDeviceIDs struct {
+			DeviceID string `json:"device_id"`
+		} `json:"end_device_ids"`
+	}
+	a.So(json.Unmarshal(msg.Payload(), &published), should.BeNil)
+	a.So(published.EndDeviceIDs.DeviceID, should.Equal, "test-dev")
+	msg = receive("$aws/things/test-dev/shadow/name/lorawan/update")
+	a.So(string(msg.Payload()), should.Equal, `{"state":{"reported":{"temperature":21.5}}}`)
+	a.So(pkg.connections, should.HaveLength, 1)
+
+	// Downlinks are consumed from the downlink topics.
+	op := publishDownlink(
+		"lorawan/test-app/devices/test-dev/down/push", []byte(`{"downlinks":[{"f_port":2,"frm_payload":"AQI="}]}`),
+	)
+	a.So(op.ids, should.Resemble, ids)
+	a.So(op.replace, should.BeFalse)
+	a.So(op.downlinks, should.HaveLength, 1)
+	a.So(op.downlinks[0].FrmPayload, should.Resemble, []byte{0x1, 0x2})
+
+	// Downlinks are consumed from the shadow delta when configured, which uses a new connection.
+	def.Data.Fields[downlinkSourceField] = structpb.NewStringValue("shadow")
+	a.So(pkg.HandleUp(ctx, def, nil, up), should.BeNil)
+	a.So(pkg.connections, should.HaveLength, 2)
+	receive("lorawan/test-app/devices/test-dev/up")
+	op = publishDownlink(
+		"$aws/things/test-dev/shadow/name/lorawan/update/delta", []byte(`{"version":3,"state":{"interval":60}}`),
+	)
+	a.So(op.ids, should.Resemble, ids)
+	a.So(op.downlinks, should.HaveLength, 1)
+	a.So(op.downlinks[0].FPort, should.Equal, 1)
+	a.So(op.downlinks[0].DecodedPayload.AsMap(), should.Resemble, map[string]any{"interval": 60.0})
+
+	// The delta is reported after the downlink is enqueued.
+	for {
+		msg := receive("$aws/things/test-dev/shadow/name/lorawan/update")
+		if string(msg.Payload()) == `{"state":{"reported":{"interval":60}}}` {
+			break
+		}
+	}
+
+	// Invalid package data is rejected.
+	def.Data.Fields[secretAccessKeyField] = structpb.NewStringValue("")
+	def.Data.Fields[accessKeyIDField] = structpb.NewStringValue("")
+	a.So(pkg.HandleUp(ctx, def, nil, up), should.HaveSameErrorDefinitionAs, errNoCredentials)
+}
@@ -0,0 +1,170 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import "encoding/binary"
+
+// Command identifiers of the Fragmented Data Block Transport package.
+const (
+	packageVersionCID    = 0x00
+	fragSessionStatusCID = 0x01
+	fragSessionSetupCID  = 0x02
+	fragSessionDeleteCID = 0x03
+	dataFragmentCID      = 0x08
+)
+
+// fragSessionSetupReq is the FragSessionSetupReq command.
+type fragSessionSetupReq struct {
+	FragIndex     uint8
+	NbFrag        uint16
+	FragSize      uint8
+	BlockAckDelay uint8
+	Padding       uint8
+	Descriptor    uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req *fragSessionSetupReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// FragSession - byte 1 (bits: RFU [7:6]; FragIndex [5:4]; McGroupBitMask [3:0]).
+	// NbFrag - bytes [2,3].
+	// FragSize - byte 4.
+	// Control - byte 5 (bits: RFU [7:6]; FragmentationMatrix [5:3]; BlockAckDelay [2:0]).
+	// Padding - byte 6.
+	// Descriptor - bytes [7,10].
+	b := make([]byte, 11)
+	b[0] = fragSessionSetupCID
+	b[1] = (req.FragIndex & 0x03) << 4
+	binary.LittleEndian.PutUint16(b[2:4], req.NbFrag)
+	b[4] = req.FragSize
+	b[5] = req.BlockAckDelay & 0x07
+	b[6] = req.Padding
+	binary.LittleEndian.PutUint32(b[7:11], req.Descriptor)
+	return b, nil
+}
+
+// marshalFragSessionStatusReq returns the FragSessionStatusReq command, to which all participants answer.
+func marshalFragSessionStatusReq(fragIndex uint8) []byte {
+	// FragStatusReqParam - byte 1 (bits: RFU [7:3]; FragIndex [2:1]; Participants 0).
+	return []byte{fragSessionStatusCID, (fragIndex&0x03)<<1 | 0x01}
+}
+
+// marshalDataFragment returns the DataFragment command with the fragment n.
+func marshalDataFragment(fragIndex uint8, n int, payload []byte) []byte {
+	// IndexAndN - bytes [1,2] (bits: FragIndex [15:14]; N [13:0]).
+	b := make([]byte, 3, 3+len(payload))
+	b[0] = dataFragmentCID
+	binary.LittleEndian.PutUint16(b[1:3], uint16(fragIndex&0x03)<<14|uint16(n&maxFragments))
+	return append(b, payload...)
+}
+
+// packageVersionAns is the PackageVersionAns command.
+type packageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// fragSessionStatusAns is the FragSessionStatusAns command.
+type fragSessionStatusAns struct {
+	FragIndex             uint8
+	NbFragReceived        uint16
+	MissingFrag           uint8
+	NotEnoughMatrixMemory bool
+}
+
+// fragSessionSetupAns is the FragSessionSetupAns command.
+type fragSessionSetupAns struct {
+	FragIndex                    uint8
+	WrongDescriptor              bool
+	FragSessionIndexNotSupported bool
+	NotEnoughMemory              bool
+	EncodingUnsupported          bool
+}
+
+// OK returns whether the end device accepted the session.
+func (ans *fragSessionSetupAns) OK() bool {
+	return !ans.WrongDescriptor && !ans.FragSessionIndexNotSupported && !ans.NotEnoughMemory &&
+		!ans.EncodingUnsupported
+}
+
+// fragSessionDeleteAns is the FragSessionDeleteAns command.
+type fragSessionDeleteAns struct {
+	FragIndex           uint8
+	SessionDoesNotExist bool
+}
+
+// parseAnswers parses the answers in the uplink payload.
+// The answers are of type *packageVersionAns, *fragSessionStatusAns, *fragSessionSetupAns or *fragSessionDeleteAns.
+func parseAnswers(b []byte) ([]any, error) {
+	var answers []any
+	for len(b) > 0 {
+		cID, rest := b[0], b[1:]
+		var n int
+		switch cID {
+		case packageVersionCID:
+			n = 2
+		case fragSessionStatusCID:
+			n = 4
+		case fragSessionSetupCID, fragSessionDeleteCID:
+			n = 1
+		default:
+			return answers, errUnknownCommand.WithAttributes("command_id", cID).New()
+		}
+		if len(rest) < n {
+			return answers, errInsufficientLength.WithAttributes(
+				"command_id", cID,
+				"expected_length", n,
+				"actual_length", len(rest),
+			).New()
+		}
+		cPayload := rest[:n]
+		switch cID {
+		case packageVersionCID:
+			answers = append(answers, &packageVersionAns{
+				PackageIdentifier: cPayload[0],
+				PackageVersion:    cPayload[1],
+			})
+		case fragSessionStatusCID:
+			// ReceivedAndIndex - bytes [0,1] (bits: FragIndex [15:14]; NbFragReceived [13:0]).
+			// MissingFrag - byte 2.
+			// Status - byte 3 (bits: RFU [7:1]; NotEnoughMatrixMemory 0).
+			receivedAndIndex := binary.LittleEndian.Uint16(cPayload[0:2])
+			answers = append(answers, &fragSessionStatusAns{
+				FragIndex:             uint8(receivedAndIndex >> 14),
+				NbFragReceived:        receivedAndIndex & maxFragments,
+				MissingFrag:           cPayload[2],
+				NotEnoughMatrixMemory: cPayload[3]&0x01 != 0,
+			})
+		case fragSessionSetupCID:
+			// StatusBitMask - byte 0 (bits: FragIndex [7:6]; RFU [5:4]; WrongDescriptor 3;
+			// FragSessionIndexNotSupported 2; NotEnoughMemory 1; EncodingUnsupported 0).
+			answers = append(answers, &fragSessionSetupAns{
+				FragIndex:                    cPayload[0] >> 6,
+				WrongDescriptor:              cPayload[0]&0x08 != 0,
+				FragSessionIndexNotSupported: cPayload[0]&0x04 != 0,
+				NotEnoughMemory:              cPayload[0]&0x02 != 0,
+				EncodingUnsupported:          cPayload[0]&0x01 != 0,
+			})
+		case fragSessionDeleteCID:
+			// Status - byte 0 (bits: RFU [7:3]; SessionDoesNotExist 2; FragIndex [1:0]).
+			answers = append(answers, &fragSessionDeleteAns{
+				FragIndex:           cPayload[0] & 0x03,
+				SessionDoesNotExist: cPayload[0]&0x04 != 0,
+			})
+		}
+		b = rest[n:]
+	}
+	return answers, nil
+}
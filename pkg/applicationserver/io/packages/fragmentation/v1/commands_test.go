@@ -0,0 +1,101 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalCommands(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	b, err := (&fragSessionSetupReq{
+		FragIndex:     1,
+		NbFrag:        0x0102,
+		FragSize:      48,
+		BlockAckDelay: 2,
+		Padding:       8,
+		Descriptor:    0x04030201,
+	}).MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{0x02, 0x10, 0x02, 0x01, 48, 0x02, 8, 0x01, 0x02, 0x03, 0x04})
+
+	a.So(marshalFragSessionStatusReq(2), should.Resemble, []byte{0x01, 0x05})
+	a.So(marshalDataFragment(3, 0x0102, []byte{0x42, 0x43}), should.Resemble, []byte{0x08, 0x02, 0xc1, 0x42, 0x43})
+}
+
+func TestParseAnswers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Expected []any
+		Error    error
+	}{
+		{
+			Name:    "PackageVersionAns",
+			Payload: []byte{0x00, 0x03, 0x01},
+			Expected: []any{
+				&packageVersionAns{PackageIdentifier: 3, PackageVersion: 1},
+			},
+		},
+		{
+			Name:    "FragSessionSetupAnsAndStatusAns",
+			Payload: []byte{0x02, 0x4a, 0x01, 0x05, 0x40, 0x02, 0x01},
+			Expected: []any{
+				&fragSessionSetupAns{FragIndex: 1, WrongDescriptor: true, NotEnoughMemory: true},
+				&fragSessionStatusAns{FragIndex: 1, NbFragReceived: 5, MissingFrag: 2, NotEnoughMatrixMemory: true},
+			},
+		},
+		{
+			Name:    "FragSessionDeleteAns",
+			Payload: []byte{0x03, 0x06},
+			Expected: []any{
+				&fragSessionDeleteAns{FragIndex: 2, SessionDoesNotExist: true},
+			},
+		},
+		{
+			Name:    "InsufficientLength",
+			Payload: []byte{0x02, 0x00, 0x01, 0x05},
+			Expected: []any{
+				&fragSessionSetupAns{},
+			},
+			Error: errInsufficientLength,
+		},
+		{
+			Name:    "UnknownCommand",
+			Payload: []byte{0x42},
+			Error:   errUnknownCommand,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			answers, err := parseAnswers(tc.Payload)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(answers, should.Resemble, tc.Expected)
+		})
+	}
+}
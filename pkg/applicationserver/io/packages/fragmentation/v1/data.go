@@ -0,0 +1,314 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	dataField          = "data"
+	fragmentSizeField  = "fragment_size"
+	redundancyField    = "redundancy"
+	fragIndexField     = "frag_index"
+	descriptorField    = "descriptor"
+	blockAckDelayField = "block_ack_delay"
+	queueSizeField     = "queue_size"
+	sessionField       = "session"
+
+	digestField         = "digest"
+	stateField          = "state"
+	nextFragmentField   = "next_fragment"
+	attemptsField       = "attempts"
+	uplinksWaitingField = "uplinks_waiting"
+	nbFragReceivedField = "nb_frag_received"
+	missingFragField    = "missing_frag"
+)
+
+const (
+	// defaultFragmentSize is the default fragment size in bytes. The DataFragment command of this size fits in
+	// the application payload at the lowest data rates in most regions.
+	defaultFragmentSize = 48
+	// defaultQueueSize is the default number of fragmentation downlinks in the application downlink queue.
+	defaultQueueSize = 4
+	// maxAttempts is the number of times a request is sent before the session fails.
+	maxAttempts = 3
+	// answerUplinks is the number of uplinks after which an unanswered request is sent again.
+	answerUplinks = 3
+)
+
+// packageData is the configuration of a fragmentation session.
+type packageData struct {
+	// Data is the data block to transport.
+	Data []byte
+	// FragmentSize is the size of the fragments in bytes.
+	FragmentSize int
+	// Redundancy is the number of coded fragments that is sent after the uncoded fragments.
+	// If not set, 10% of the number of uncoded fragments is sent, with a minimum of one.
+	Redundancy *int
+	// FragIndex is the fragmentation session index in the end device.
+	FragIndex uint8
+	// Descriptor is the application specific descriptor of the data block, such as the firmware version.
+	Descriptor uint32
+	// BlockAckDelay is the BlockAckDelay exponent of the fragmentation session.
+	BlockAckDelay uint8
+	// QueueSize is the number of fragmentation downlinks that are kept in the application downlink queue.
+	QueueSize int
+}
+
+func numberFromStruct(fields map[string]*structpb.Value, field string, max float64) (float64, bool, error) {
+	value, ok := fields[field]
+	if !ok {
+		return 0, false, nil
+	}
+	numberValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false, errInvalidFieldType.WithAttributes("field", field, "type", "number").New()
+	}
+	if n := numberValue.NumberValue; n < 0 || n > max || n != float64(int64(n)) {
+		return 0, false, errInvalidFieldValue.WithAttributes("field", field).New()
+	}
+	return numberValue.NumberValue, true, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) error {
+	fields := st.GetFields()
+	if value, ok := fields[dataField]; ok {
+		stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes("field", dataField, "type", "string").New()
+		}
+		b, err := base64.StdEncoding.DecodeString(stringValue.StringValue)
+		if err != nil {
+			return errInvalidFieldValue.WithCause(err).WithAttributes("field", dataField).New()
+		}
+		d.Data = b
+	}
+	for _, f := range []struct {
+		field string
+		max   float64
+		set   func(float64)
+	}{
+		{fragmentSizeField, 255, func(v float64) { d.FragmentSize = int(v) }},
+		{redundancyField, maxFragments, func(v float64) { n := int(v); d.Redundancy = &n }},
+		{fragIndexField, 3, func(v float64) { d.FragIndex = uint8(v) }},
+		{descriptorField, 1<<32 - 1, func(v float64) { d.Descriptor = uint32(v) }},
+		{blockAckDelayField, 7, func(v float64) { d.BlockAckDelay = uint8(v) }},
+		{queueSizeField, 64, func(v float64) { d.QueueSize = int(v) }},
+	} {
+		v, ok, err := numberFromStruct(fields, f.field, f.max)
+		if err != nil {
+			return err
+		}
+		if ok {
+			f.set(v)
+		}
+	}
+	return nil
+}
+
+// fragmenter returns the fragmenter of the data block.
+func (d *packageData) fragmenter() fragmenter {
+	return fragmenter{data: d.Data, size: d.FragmentSize}
+}
+
+// redundancy returns the number of coded fragments.
+func (d *packageData) redundancy() int {
+	if d.Redundancy != nil {
+		return *d.Redundancy
+	}
+	n := d.fragmenter().nbFrag() / 10
+	if n < 1 {
+		n = 1
+	}
+	return n
+}
+
+// validate checks whether the data block fits in a fragmentation session.
+func (d *packageData) validate() error {
+	if d.FragmentSize == 0 {
+		return errInvalidFieldValue.WithAttributes("field", fragmentSizeField).New()
+	}
+	if n := d.fragmenter().nbFrag() + d.redundancy(); n > maxFragments {
+		return errTooManyFragments.WithAttributes("fragments", n, "max", maxFragments).New()
+	}
+	return nil
+}
+
+// digest returns the digest of the data block and the session parameters. A new session is set up when the digest
+// changes.
+func (d *packageData) digest() string {
+	h := sha256.New()
+	var b [12]byte
+	b[0] = d.FragIndex
+	b[1] = d.BlockAckDelay
+	binary.LittleEndian.PutUint16(b[2:4], uint16(d.FragmentSize))
+	binary.LittleEndian.PutUint16(b[4:6], uint16(d.redundancy()))
+	binary.LittleEndian.PutUint32(b[6:10], d.Descriptor)
+	h.Write(b[:])
+	h.Write(d.Data)
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, uint32, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+
+	merged := &packageData{
+		FragmentSize: defaultFragmentSize,
+		QueueSize:    defaultQueueSize,
+	}
+	for _, data := range []packageData{defaultData, associationData} {
+		if data.Data != nil {
+			merged.Data = data.Data
+		}
+		if data.FragmentSize != 0 {
+			merged.FragmentSize = data.FragmentSize
+		}
+		if data.Redundancy != nil {
+			merged.Redundancy = data.Redundancy
+		}
+		if data.FragIndex != 0 {
+			merged.FragIndex = data.FragIndex
+		}
+		if data.Descriptor != 0 {
+			merged.Descriptor = data.Descriptor
+		}
+		if data.BlockAckDelay != 0 {
+			merged.BlockAckDelay = data.BlockAckDelay
+		}
+		if data.QueueSize != 0 {
+			merged.QueueSize = data.QueueSize
+		}
+	}
+	fPort := def.GetIds().GetFPort()
+	assocFPort := assoc.GetIds().GetFPort()
+	if assocFPort != 0 {
+		fPort = assocFPort
+	}
+	return merged, fPort, nil
+}
+
+// sessionStateKind is the state of a fragmentation session.
+type sessionStateKind string
+
+const (
+	// sessionSetup is the state in which the FragSessionSetupReq is sent.
+	sessionSetup sessionStateKind = "setup"
+	// sessionTransfer is the state in which the fragments are sent.
+	sessionTransfer sessionStateKind = "transfer"
+	// sessionStatus is the state in which the FragSessionStatusReq is sent.
+	sessionStatus sessionStateKind = "status"
+	// sessionCompleted is the state in which the end device reconstructed the data block.
+	sessionCompleted sessionStateKind = "completed"
+	// sessionFailed is the state in which the session failed.
+	sessionFailed sessionStateKind = "failed"
+)
+
+// sessionState is the state of the fragmentation session of an end device, which is stored in the data of its
+// association. The fragments are not stored, but generated from the data block as they are sent.
+type sessionState struct {
+	// Digest is the digest of the session configuration.
+	Digest string
+	// State is the state of the session.
+	State sessionStateKind
+	// NextFragment is the next fragment to send. The fragment starts at 1.
+	NextFragment int
+	// Attempts is the number of times the request of the current state was sent.
+	Attempts int
+	// UplinksWaiting is the number of uplinks received since the request of the current state was sent.
+	UplinksWaiting int
+	// NbFragReceived is the number of fragments received by the end device, as reported in the FragSessionStatusAns.
+	NbFragReceived int
+	// MissingFrag is the number of fragments that the end device misses to reconstruct the data block, as reported in
+	// the FragSessionStatusAns.
+	MissingFrag int
+}
+
+// Value serializes the session state to *structpb.Value.
+func (s *sessionState) Value() *structpb.Value {
+	return structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+		digestField:         structpb.NewStringValue(s.Digest),
+		stateField:          structpb.NewStringValue(string(s.State)),
+		nextFragmentField:   structpb.NewNumberValue(float64(s.NextFragment)),
+		attemptsField:       structpb.NewNumberValue(float64(s.Attempts)),
+		uplinksWaitingField: structpb.NewNumberValue(float64(s.UplinksWaiting)),
+		nbFragReceivedField: structpb.NewNumberValue(float64(s.NbFragReceived)),
+		missingFragField:    structpb.NewNumberValue(float64(s.MissingFrag)),
+	}})
+}
+
+// fromStruct deserializes the session state from the association data.
+func (s *sessionState) fromStruct(st *structpb.Struct) error {
+	value, ok := st.GetFields()[sessionField]
+	if !ok {
+		return nil
+	}
+	structValue, ok := value.GetKind().(*structpb.Value_StructValue)
+	if !ok {
+		return errInvalidFieldType.WithAttributes("field", sessionField, "type", "object").New()
+	}
+	fields := structValue.StructValue.GetFields()
+	for _, f := range []struct {
+		field string
+		set   func(string)
+	}{
+		{digestField, func(v string) { s.Digest = v }},
+		{stateField, func(v string) { s.State = sessionStateKind(v) }},
+	} {
+		value, ok := fields[f.field]
+		if !ok {
+			continue
+		}
+		stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+		if !ok {
+			return errInvalidFieldType.WithAttributes("field", f.field, "type", "string").New()
+		}
+		f.set(stringValue.StringValue)
+	}
+	for _, f := range []struct {
+		field string
+		dst   *int
+	}{
+		{nextFragmentField, &s.NextFragment},
+		{attemptsField, &s.Attempts},
+		{uplinksWaitingField, &s.UplinksWaiting},
+		{nbFragReceivedField, &s.NbFragReceived},
+		{missingFragField, &s.MissingFrag},
+	} {
+		v, ok, err := numberFromStruct(fields, f.field, maxFragments+1)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*f.dst = int(v)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,105 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPackageDataMerge(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{FPort: 201},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			dataField:         structpb.NewStringValue("AQIDBA=="),
+			fragmentSizeField: structpb.NewNumberValue(2),
+			descriptorField:   structpb.NewNumberValue(42),
+		}},
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		Ids: &ttnpb.ApplicationPackageAssociationIdentifiers{FPort: 202},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			redundancyField: structpb.NewNumberValue(0),
+			fragIndexField:  structpb.NewNumberValue(1),
+			queueSizeField:  structpb.NewNumberValue(2),
+		}},
+	}
+	redundancy := 0
+	data, fPort, err := mergePackageData(def, assoc)
+	a.So(err, should.BeNil)
+	a.So(fPort, should.Equal, 202)
+	a.So(data, should.Resemble, &packageData{
+		Data:         []byte{0x1, 0x2, 0x3, 0x4},
+		FragmentSize: 2,
+		Redundancy:   &redundancy,
+		FragIndex:    1,
+		Descriptor:   42,
+		QueueSize:    2,
+	})
+	a.So(data.redundancy(), should.Equal, 0)
+	a.So(data.validate(), should.BeNil)
+
+	data, _, err = mergePackageData(def, nil)
+	a.So(err, should.BeNil)
+	a.So(data.QueueSize, should.Equal, defaultQueueSize)
+	a.So(data.redundancy(), should.Equal, 1)
+	a.So(data.digest(), should.NotEqual, (&packageData{Data: data.Data, FragmentSize: 4}).digest())
+
+	for _, st := range []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{dataField: structpb.NewStringValue("not base64")}},
+		{Fields: map[string]*structpb.Value{fragmentSizeField: structpb.NewNumberValue(256)}},
+		{Fields: map[string]*structpb.Value{fragIndexField: structpb.NewNumberValue(1.5)}},
+		{Fields: map[string]*structpb.Value{queueSizeField: structpb.NewStringValue("4")}},
+	} {
+		_, _, err := mergePackageData(nil, &ttnpb.ApplicationPackageAssociation{Data: st})
+		a.So(err, should.HaveSameErrorDefinitionAs, errPkgDataMerge)
+	}
+
+	data = &packageData{Data: make([]byte, maxFragments), FragmentSize: 1}
+	a.So(data.validate(), should.HaveSameErrorDefinitionAs, errTooManyFragments)
+}
+
+func TestSessionStateStruct(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	expected := &sessionState{
+		Digest:         "0123456789abcdef",
+		State:          sessionStatus,
+		NextFragment:   42,
+		Attempts:       2,
+		UplinksWaiting: 1,
+		NbFragReceived: 40,
+		MissingFrag:    2,
+	}
+	st := &structpb.Struct{Fields: map[string]*structpb.Value{
+		dataField:    structpb.NewStringValue("AQIDBA=="),
+		sessionField: expected.Value(),
+	}}
+	actual := &sessionState{}
+	a.So(actual.fromStruct(st), should.BeNil)
+	a.So(actual, should.Resemble, expected)
+
+	a.So((&sessionState{}).fromStruct(&structpb.Struct{Fields: map[string]*structpb.Value{
+		sessionField: structpb.NewNumberValue(1),
+	}}), should.HaveSameErrorDefinitionAs, errInvalidFieldType)
+}
@@ -0,0 +1,93 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+// maxFragments is the maximum number of fragments of a session, including the coded fragments.
+// The fragment counter N in the DataFragment command is 14 bits.
+const maxFragments = 1<<14 - 1
+
+// prbs23 is the 23-bit pseudo-random binary sequence generator of the fragmentation parity matrix.
+func prbs23(x uint32) uint32 {
+	b0 := x & 0x01
+	b1 := (x & 0x20) >> 5
+	return (x >> 1) + ((b0 ^ b1) << 22)
+}
+
+// parityRow returns the row n of the parity matrix for m uncoded fragments, as defined in the annex of the
+// Fragmented Data Block Transport specification. The row starts at 1.
+func parityRow(n, m int) []bool {
+	row := make([]bool, m)
+	mm := 0
+	if m&(m-1) == 0 {
+		mm = 1
+	}
+	x := uint32(1 + 1001*n)
+	for i := 0; i < m/2; i++ {
+		r := 1 << 16
+		for r >= m {
+			x = prbs23(x)
+			r = int(x % uint32(m+mm))
+		}
+		row[r] = true
+	}
+	return row
+}
+
+// fragmenter generates the fragments of a data block.
+// The fragments are generated on demand, so that the sessions only store the data block and a fragment counter.
+type fragmenter struct {
+	data []byte
+	size int
+}
+
+// nbFrag returns the number of uncoded fragments.
+func (f fragmenter) nbFrag() int {
+	return (len(f.data) + f.size - 1) / f.size
+}
+
+// padding returns the number of padding bytes of the last uncoded fragment.
+func (f fragmenter) padding() int {
+	return f.nbFrag()*f.size - len(f.data)
+}
+
+// block xors the uncoded fragment i into dst. The fragment starts at 0.
+func (f fragmenter) block(dst []byte, i int) {
+	start := i * f.size
+	end := start + f.size
+	if end > len(f.data) {
+		end = len(f.data)
+	}
+	for j, b := range f.data[start:end] {
+		dst[j] ^= b
+	}
+}
+
+// fragment returns the fragment n. The fragment starts at 1. The fragments up to the number of uncoded fragments
+// are the data block itself, with the last fragment padded with zeros. The fragments beyond are coded fragments,
+// that are the XOR of the uncoded fragments selected by the rows of the parity matrix.
+func (f fragmenter) fragment(n int) []byte {
+	b := make([]byte, f.size)
+	m := f.nbFrag()
+	if n <= m {
+		f.block(b, n-1)
+		return b
+	}
+	for i, ok := range parityRow(n-m, m) {
+		if ok {
+			f.block(b, i)
+		}
+	}
+	return b
+}
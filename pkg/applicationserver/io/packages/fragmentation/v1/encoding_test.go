@@ -0,0 +1,143 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// decode reconstructs the uncoded fragments from the received fragments by Gaussian elimination over GF(2).
+func decode(m int, received map[int][]byte) ([][]byte, bool) {
+	type equation struct {
+		coefficients []bool
+		payload      []byte
+	}
+	pivots := make([]*equation, m)
+	for n, payload := range received {
+		eq := &equation{payload: append([]byte(nil), payload...)}
+		if n <= m {
+			eq.coefficients = make([]bool, m)
+			eq.coefficients[n-1] = true
+		} else {
+			eq.coefficients = parityRow(n-m, m)
+		}
+		for i := 0; i < m; i++ {
+			if !eq.coefficients[i] {
+				continue
+			}
+			if pivots[i] == nil {
+				pivots[i] = eq
+				break
+			}
+			for j := range eq.coefficients {
+				eq.coefficients[j] = eq.coefficients[j] != pivots[i].coefficients[j]
+			}
+			for j := range eq.payload {
+				eq.payload[j] ^= pivots[i].payload[j]
+			}
+		}
+	}
+	for i := m - 1; i >= 0; i-- {
+		if pivots[i] == nil {
+			return nil, false
+		}
+		for j := i + 1; j < m; j++ {
+			if !pivots[i].coefficients[j] {
+				continue
+			}
+			pivots[i].coefficients[j] = false
+			for k := range pivots[i].payload {
+				pivots[i].payload[k] ^= pivots[j].payload[k]
+			}
+		}
+	}
+	blocks := make([][]byte, m)
+	for i, eq := range pivots {
+		blocks[i] = eq.payload
+	}
+	return blocks, true
+}
+
+func TestFragmenter(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	data := make([]byte, 1000)
+	rand.New(rand.NewSource(42)).Read(data) // nolint: gosec
+	fr := fragmenter{data: data, size: 48}
+	m := fr.nbFrag()
+	a.So(m, should.Equal, 21)
+	a.So(fr.padding(), should.Equal, 8)
+
+	var uncoded []byte
+	for n := 1; n <= m; n++ {
+		uncoded = append(uncoded, fr.fragment(n)...)
+	}
+	a.So(uncoded[:len(data)], should.Resemble, data)
+	a.So(uncoded[len(data):], should.Resemble, make([]byte, fr.padding()))
+
+	for n := 1; n <= 4; n++ {
+		row := parityRow(n, m)
+		a.So(row, should.Resemble, parityRow(n, m))
+		expected := make([]byte, fr.size)
+		for i, ok := range row {
+			if ok {
+				fr.block(expected, i)
+			}
+		}
+		a.So(fr.fragment(m+n), should.Resemble, expected)
+	}
+
+	// Lose every fourth uncoded fragment, and reconstruct the data block with the coded fragments.
+	received := make(map[int][]byte)
+	for n := 1; n <= m+2*m; n++ {
+		if n <= m && n%4 == 0 {
+			continue
+		}
+		received[n] = fr.fragment(n)
+	}
+	blocks, ok := decode(m, received)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(bytes.Join(blocks, nil)[:len(data)], should.Resemble, data)
+}
+
+func TestParityRow(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for _, m := range []int{1, 2, 7, 16, 100} {
+		for n := 1; n <= 10; n++ {
+			row := parityRow(n, m)
+			a.So(row, should.HaveLength, m)
+			count := 0
+			for _, ok := range row {
+				if ok {
+					count++
+				}
+			}
+			a.So(count, should.BeLessThanOrEqualTo, m/2)
+			if m > 1 {
+				a.So(count, should.BeGreaterThan, 0)
+			}
+		}
+	}
+}
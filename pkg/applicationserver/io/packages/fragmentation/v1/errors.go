@@ -0,0 +1,43 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation      = errors.DefineInternal("no_association", "no association available")
+	errUnknownCommand     = errors.DefineNotFound("unknown_command", "unknown command `{command_id}`")
+	errInsufficientLength = errors.DefineInvalidArgument(
+		"insufficient_length", "command `{command_id}` payload has insufficient length",
+		"expected_length", "actual_length",
+	)
+
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineInvalidArgument("invalid_field_value", "invalid value of field `{field}`")
+	errPkgDataMerge      = errors.DefineCorruption("pkg_data_merge", "failed to merge package data")
+	errTooManyFragments  = errors.DefineInvalidArgument(
+		"too_many_fragments", "too many fragments `{fragments}`, the maximum is `{max}`",
+	)
+
+	errSessionRejected = errors.DefineFailedPrecondition(
+		"session_rejected", "end device rejected fragmentation session",
+		"wrong_descriptor", "frag_session_index_not_supported", "not_enough_memory", "encoding_unsupported",
+	)
+	errFragmentsMissing = errors.DefineDataLoss(
+		"fragments_missing", "end device could not reconstruct the data block",
+		"nb_frag_received", "missing_frag", "not_enough_matrix_memory",
+	)
+	errNoAnswer = errors.DefineDeadlineExceeded("no_answer", "end device did not answer `{command}`")
+)
@@ -0,0 +1,356 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package fragmentationv1 implements the LoRaWAN Fragmented Data Block Transport v1.0.0 application package.
+package fragmentationv1
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PackageName is the name of the package.
+const PackageName = "fragmentation-v1"
+
+// fragmentationPackage is the Fragmented Data Block Transport package.
+// The data block to transport and the session parameters are configured in the data of the association. The state of
+// the session is stored in the data of the association of the end device. The session is driven by the uplinks of
+// the end device, and by the downlinks that are sent to the end device on the package FPort, so that the fragments
+// are enqueued as the application downlink queue drains.
+type fragmentationPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *fragmentationPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/fragmentation/v1")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	msg, sent := up.GetUplinkMessage(), up.GetDownlinkSent()
+	if msg == nil && sent == nil {
+		return nil
+	}
+
+	eventBuilders := make(events.Builders, 0)
+	defer func() {
+		if err != nil {
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(events.WithData(err)))
+		}
+		publishEvents(ctx, up.EndDeviceIds, eventBuilders...)
+	}()
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to merge package data")
+		return err
+	}
+	if sent != nil && sent.FPort != fPort {
+		return nil
+	}
+	if len(data.Data) == 0 {
+		logger.Debug("No data block to transport")
+		return nil
+	}
+	if err := data.validate(); err != nil {
+		logger.WithError(err).Debug("Invalid package data")
+		return err
+	}
+
+	var answers []any
+	if msg.GetFPort() == fPort && len(msg.GetFrmPayload()) > 0 {
+		answers, err = parseAnswers(msg.FrmPayload)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to parse frame payload into answers")
+			return err
+		}
+	}
+
+	ids := assocIDs(def, assoc, up.EndDeviceIds)
+	return p.registry.EndDeviceTransaction(ctx, up.EndDeviceIds, fPort, PackageName, func(ctx context.Context) error {
+		stored, err := p.registry.GetAssociation(ctx, ids, []string{"data"})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		state := &sessionState{}
+		if err := state.fromStruct(stored.GetData()); err != nil {
+			return err
+		}
+		if digest := data.digest(); state.Digest != digest {
+			*state = sessionState{Digest: digest}
+		}
+		initial := *state
+
+		evs := handleAnswers(ctx, data, state, answers)
+		eventBuilders = append(eventBuilders, evs...)
+		if msg != nil && (state.State == sessionSetup || state.State == sessionStatus) {
+			state.UplinksWaiting++
+		}
+		downlinks, evs, err := p.advance(ctx, up.EndDeviceIds, data, fPort, state)
+		if err != nil {
+			return err
+		}
+		if len(downlinks) > 0 {
+			if err := p.server.DownlinkQueuePush(ctx, up.EndDeviceIds, downlinks); err != nil {
+				logger.WithError(err).Debug("Failed to push downlinks to queue")
+				return err
+			}
+		}
+		eventBuilders = append(eventBuilders, evs...)
+		if *state == initial {
+			return nil
+		}
+		return p.setSessionState(ctx, ids, state)
+	})
+}
+
+// handleAnswers updates the session state with the answers of the end device.
+func handleAnswers(ctx context.Context, data *packageData, state *sessionState, answers []any) events.Builders {
+	logger := log.FromContext(ctx)
+	var evs events.Builders
+	for _, ans := range answers {
+		switch ans := ans.(type) {
+		case *packageVersionAns:
+			logger.WithFields(log.Fields(
+				"package_identifier", ans.PackageIdentifier,
+				"package_version", ans.PackageVersion,
+			)).Debug("Received package version")
+
+		case *fragSessionSetupAns:
+			if ans.FragIndex != data.FragIndex || state.State != sessionSetup {
+				continue
+			}
+			if !ans.OK() {
+				state.State = sessionFailed
+				evs = append(evs, EvtSessionFail.With(events.WithData(errSessionRejected.WithAttributes(
+					"wrong_descriptor", ans.WrongDescriptor,
+					"frag_session_index_not_supported", ans.FragSessionIndexNotSupported,
+					"not_enough_memory", ans.NotEnoughMemory,
+					"encoding_unsupported", ans.EncodingUnsupported,
+				).New())))
+				continue
+			}
+			state.State, state.NextFragment, state.Attempts, state.UplinksWaiting = sessionTransfer, 1, 0, 0
+			evs = append(evs, EvtSessionStart)
+
+		case *fragSessionStatusAns:
+			if ans.FragIndex != data.FragIndex || state.State != sessionStatus {
+				continue
+			}
+			state.NbFragReceived, state.MissingFrag = int(ans.NbFragReceived), int(ans.MissingFrag)
+			if ans.MissingFrag > 0 || ans.NotEnoughMatrixMemory {
+				state.State = sessionFailed
+				evs = append(evs, EvtSessionFail.With(events.WithData(errFragmentsMissing.WithAttributes(
+					"nb_frag_received", ans.NbFragReceived,
+					"missing_frag", ans.MissingFrag,
+					"not_enough_matrix_memory", ans.NotEnoughMatrixMemory,
+				).New())))
+				continue
+			}
+			state.State = sessionCompleted
+			evs = append(evs, EvtSessionComplete)
+
+		case *fragSessionDeleteAns:
+			logger.WithFields(log.Fields(
+				"frag_index", ans.FragIndex,
+				"session_does_not_exist", ans.SessionDoesNotExist,
+			)).Debug("Received fragmentation session delete answer")
+		}
+	}
+	return evs
+}
+
+// queuedDownlinks returns the number of downlinks on the package FPort in the application downlink queue.
+func (p *fragmentationPackage) queuedDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32,
+) (int, error) {
+	queue, err := p.server.DownlinkQueueList(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, down := range queue {
+		if down.FPort == fPort {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// advance returns the downlinks that advance the session, and updates the session state accordingly.
+// Requests are sent again when the end device did not answer them after a number of uplinks, and the session fails
+// when the end device did not answer them after a number of attempts.
+// The fragments are enqueued up to the configured queue size, so that the fragments are generated as they are sent.
+func (p *fragmentationPackage) advance(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	data *packageData,
+	fPort uint32,
+	state *sessionState,
+) ([]*ttnpb.ApplicationDownlink, events.Builders, error) {
+	if state.State == sessionCompleted || state.State == sessionFailed {
+		return nil, nil, nil
+	}
+	queued := 0
+	if state.State != "" {
+		var err error
+		if queued, err = p.queuedDownlinks(ctx, ids, fPort); err != nil {
+			return nil, nil, err
+		}
+	}
+	newDownlink := func(frmPayload []byte) *ttnpb.ApplicationDownlink {
+		return &ttnpb.ApplicationDownlink{
+			FPort:      fPort,
+			FrmPayload: frmPayload,
+		}
+	}
+	retry := func(command string) (bool, events.Builders) {
+		if state.State != "" && (queued > 0 || state.UplinksWaiting < answerUplinks) {
+			return false, nil
+		}
+		if state.Attempts >= maxAttempts {
+			state.State = sessionFailed
+			return false, events.Builders{
+				EvtSessionFail.With(events.WithData(errNoAnswer.WithAttributes("command", command).New())),
+			}
+		}
+		state.Attempts++
+		state.UplinksWaiting = 0
+		return true, nil
+	}
+
+	fr := data.fragmenter()
+	switch state.State {
+	case "", sessionSetup:
+		ok, evs := retry("FragSessionSetupReq")
+		if !ok {
+			return nil, evs, nil
+		}
+		state.State = sessionSetup
+		b, err := (&fragSessionSetupReq{
+			FragIndex:     data.FragIndex,
+			NbFrag:        uint16(fr.nbFrag()),
+			FragSize:      uint8(data.FragmentSize),
+			BlockAckDelay: data.BlockAckDelay,
+			Padding:       uint8(fr.padding()),
+			Descriptor:    data.Descriptor,
+		}).MarshalBinary()
+		if err != nil {
+			return nil, nil, err
+		}
+		return []*ttnpb.ApplicationDownlink{newDownlink(b)}, events.Builders{EvtSessionSetup}, nil
+
+	case sessionTransfer:
+		var downlinks []*ttnpb.ApplicationDownlink
+		total := fr.nbFrag() + data.redundancy()
+		for ; queued < data.QueueSize && state.NextFragment <= total; queued++ {
+			downlinks = append(downlinks, newDownlink(
+				marshalDataFragment(data.FragIndex, state.NextFragment, fr.fragment(state.NextFragment)),
+			))
+			state.NextFragment++
+		}
+		if state.NextFragment > total && queued < data.QueueSize {
+			state.State, state.Attempts, state.UplinksWaiting = sessionStatus, 1, 0
+			downlinks = append(downlinks, newDownlink(marshalFragSessionStatusReq(data.FragIndex)))
+		}
+		return downlinks, nil, nil
+
+	case sessionStatus:
+		ok, evs := retry("FragSessionStatusReq")
+		if !ok {
+			return nil, evs, nil
+		}
+		return []*ttnpb.ApplicationDownlink{newDownlink(marshalFragSessionStatusReq(data.FragIndex))}, nil, nil
+
+	default:
+		return nil, nil, nil
+	}
+}
+
+// setSessionState stores the session state in the data of the association of the end device.
+// If the end device has no association yet, an association is created from the default association.
+func (p *fragmentationPackage) setSessionState(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, state *sessionState,
+) error {
+	_, err := p.registry.SetAssociation(ctx, ids, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			paths := []string{"data"}
+			if assoc == nil {
+				assoc = &ttnpb.ApplicationPackageAssociation{
+					Ids:         ids,
+					PackageName: PackageName,
+				}
+				paths = []string{"data", "ids", "package_name"}
+			}
+			if assoc.Data == nil {
+				assoc.Data = &structpb.Struct{}
+			}
+			if assoc.Data.Fields == nil {
+				assoc.Data.Fields = make(map[string]*structpb.Value)
+			}
+			assoc.Data.Fields[sessionField] = state.Value()
+			return assoc, paths, nil
+		},
+	)
+	return err
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*fragmentationPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 201,
+	}
+}
+
+// assocIDs returns the identifiers of the given association. If the association is nil, new identifiers are created.
+func assocIDs(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	ids *ttnpb.EndDeviceIdentifiers,
+) *ttnpb.ApplicationPackageAssociationIdentifiers {
+	assocIDs := assoc.GetIds()
+	if assocIDs == nil {
+		assocIDs = &ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIds: ids,
+			FPort:        def.GetIds().GetFPort(),
+		}
+	}
+	return assocIDs
+}
+
+// New returns a new Fragmented Data Block Transport package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &fragmentationPackage{
+		server:   server,
+		registry: registry,
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package fragmentationv1
+
+import (
+	"context"
+	"encoding/base64"
+	"sync"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+type mockServer struct {
+	io.Server
+	queue []*ttnpb.ApplicationDownlink
+}
+
+func (s *mockServer) DownlinkQueuePush(
+	_ context.Context, _ *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.queue = append(s.queue, items...)
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueList(
+	context.Context, *ttnpb.EndDeviceIdentifiers,
+) ([]*ttnpb.ApplicationDownlink, error) {
+	return s.queue, nil
+}
+
+// pop removes the first downlink from the queue, as the Network Server sends it.
+func (s *mockServer) pop() *ttnpb.ApplicationDownlink {
+	down := s.queue[0]
+	s.queue = s.queue[1:]
+	return down
+}
+
+type mockRegistry struct {
+	packages.Registry
+	mu    sync.Mutex
+	assoc *ttnpb.ApplicationPackageAssociation
+}
+
+func (r *mockRegistry) EndDeviceTransaction(
+	ctx context.Context, _ *ttnpb.EndDeviceIdentifiers, _ uint32, _ string, fn func(context.Context) error,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return fn(ctx)
+}
+
+func (r *mockRegistry) GetAssociation(
+	context.Context, *ttnpb.ApplicationPackageAssociationIdentifiers, []string,
+) (*ttnpb.ApplicationPackageAssociation, error) {
+	if r.assoc == nil {
+		return nil, errNotFound.New()
+	}
+	return proto.Clone(r.assoc).(*ttnpb.ApplicationPackageAssociation), nil
+}
+
+func (r *mockRegistry) SetAssociation(
+	_ context.Context,
+	_ *ttnpb.ApplicationPackageAssociationIdentifiers,
+	_ []string,
+	f func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error),
+) (*ttnpb.ApplicationPackageAssociation, error) {
+	var stored *ttnpb.ApplicationPackageAssociation
+	if r.assoc != nil {
+		stored = proto.Clone(r.assoc).(*ttnpb.ApplicationPackageAssociation)
+	}
+	assoc, _, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	r.assoc = assoc
+	return assoc, nil
+}
+
+func (r *mockRegistry) state(t *testing.T) *sessionState {
+	t.Helper()
+	state := &sessionState{}
+	if err := state.fromStruct(r.assoc.GetData()); err != nil {
+		t.Fatalf("Failed to get session state: %v", err)
+	}
+	return state
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	block := make([]byte, 95)
+	for i := range block {
+		block[i] = byte(i)
+	}
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+			ApplicationIds: ids.ApplicationIds,
+			FPort:          201,
+		},
+		PackageName: PackageName,
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			dataField:         structpb.NewStringValue(base64.StdEncoding.EncodeToString(block)),
+			fragmentSizeField: structpb.NewNumberValue(10),
+			redundancyField:   structpb.NewNumberValue(2),
+			queueSizeField:    structpb.NewNumberValue(4),
+		}},
+	}
+	uplink := func(fPort uint32, frmPayload ...byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: ids,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FrmPayload: frmPayload,
+			}},
+		}
+	}
+	downlinkSent := func(down *ttnpb.ApplicationDownlink) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: ids,
+			Up:           &ttnpb.ApplicationUp_DownlinkSent{DownlinkSent: down},
+		}
+	}
+
+	t.Run("Completed", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := &mockServer{}, &mockRegistry{}
+		pkg := New(server, registry)
+
+		// The session is set up on the first uplink.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1, 0x42)), should.BeNil)
+		if !a.So(server.queue, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(server.queue[0].FPort, should.Equal, 201)
+		a.So(server.queue[0].FrmPayload, should.Resemble, []byte{
+			0x02, 0x00, 0x0a, 0x00, 0x0a, 0x00, 0x05, 0x00, 0x00, 0x00, 0x00,
+		})
+		a.So(registry.state(t).State, should.Equal, sessionSetup)
+		a.So(registry.assoc.GetData().GetFields(), should.HaveLength, 1)
+
+		// The request is not sent again while it is in the queue.
+		a.So(pkg.HandleUp(ctx, def, nil, downlinkSent(server.pop())), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+
+		// The fragments are enqueued up to the queue size when the end device accepts the session.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(201, 0x02, 0x00)), should.BeNil)
+		a.So(server.queue, should.HaveLength, 4)
+		fr := fragmenter{data: block, size: 10}
+		for i, down := range server.queue {
+			a.So(down.FrmPayload, should.Resemble, marshalDataFragment(0, i+1, fr.fragment(i+1)))
+		}
+		a.So(registry.state(t).State, should.Equal, sessionTransfer)
+
+		// The queue is refilled as the downlinks are sent.
+		a.So(pkg.HandleUp(ctx, def, nil, downlinkSent(server.pop())), should.BeNil)
+		a.So(server.queue, should.HaveLength, 4)
+		a.So(registry.state(t).NextFragment, should.Equal, 6)
+		server.queue = nil
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		a.So(server.queue, should.HaveLength, 4)
+		server.queue = nil
+
+		// The status request is enqueued after the coded fragments.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		if !a.So(server.queue, should.HaveLength, 4) {
+			t.FailNow()
+		}
+		a.So(server.queue[2].FrmPayload, should.Resemble, marshalDataFragment(0, 12, fr.fragment(12)))
+		a.So(server.queue[3].FrmPayload, should.Resemble, []byte{0x01, 0x01})
+		a.So(registry.state(t).State, should.Equal, sessionStatus)
+		server.queue = nil
+
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(201, 0x01, 0x0c, 0x00, 0x00, 0x00)), should.BeNil)
+		state := registry.state(t)
+		a.So(state.State, should.Equal, sessionCompleted)
+		a.So(state.NbFragReceived, should.Equal, 12)
+		a.So(server.queue, should.BeEmpty)
+
+		a.So(pkg.HandleUp(ctx, def, registry.assoc, uplink(1)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := &mockServer{}, &mockRegistry{}
+		pkg := New(server, registry)
+
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		server.queue = nil
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(201, 0x02, 0x02)), should.BeNil)
+		a.So(registry.state(t).State, should.Equal, sessionFailed)
+		a.So(server.queue, should.BeEmpty)
+	})
+
+	t.Run("NoAnswer", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := &mockServer{}, &mockRegistry{}
+		pkg := New(server, registry)
+
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		for attempt := 2; attempt <= maxAttempts; attempt++ {
+			server.queue = nil
+			for i := 0; i < answerUplinks; i++ {
+				a.So(server.queue, should.BeEmpty)
+				a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+			}
+			a.So(server.queue, should.HaveLength, 1)
+			a.So(registry.state(t).Attempts, should.Equal, attempt)
+		}
+		server.queue = nil
+		for i := 0; i < answerUplinks; i++ {
+			a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		}
+		a.So(server.queue, should.BeEmpty)
+		a.So(registry.state(t).State, should.Equal, sessionFailed)
+	})
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbv2
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	urlField         = "url"
+	orgField         = "org"
+	bucketField      = "bucket"
+	tokenField       = "token"
+	measurementField = "measurement"
+)
+
+// defaultMeasurement is the default measurement of the written points.
+const defaultMeasurement = "uplink"
+
+// packageData is the configuration of the InfluxDB integration.
+type packageData struct {
+	// URL is the base URL of the InfluxDB server.
+	URL string
+	// Org is the organization to write to.
+	Org string
+	// Bucket is the bucket to write to.
+	Bucket string
+	// Token is the API token with write access to the bucket.
+	Token string
+	// Measurement is the measurement of the written points.
+	Measurement string
+}
+
+func stringFromStruct(fields map[string]*structpb.Value, field string) (string, error) {
+	value, ok := fields[field]
+	if !ok {
+		return "", nil
+	}
+	stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", errInvalidFieldType.WithAttributes("field", field, "type", "string").New()
+	}
+	return stringValue.StringValue, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) (err error) {
+	fields := st.GetFields()
+	for _, f := range []struct {
+		field string
+		dst   *string
+	}{
+		{urlField, &d.URL},
+		{orgField, &d.Org},
+		{bucketField, &d.Bucket},
+		{tokenField, &d.Token},
+		{measurementField, &d.Measurement},
+	} {
+		if *f.dst, err = stringFromStruct(fields, f.field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks whether the configuration is complete.
+func (d *packageData) validate() error {
+	for _, f := range []struct {
+		field string
+		value string
+	}{
+		{urlField, d.URL},
+		{orgField, d.Org},
+		{bucketField, d.Bucket},
+		{tokenField, d.Token},
+	} {
+		if f.value == "" {
+			return errMissingField.WithAttributes("field", f.field).New()
+		}
+	}
+	return nil
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err).New()
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err).New()
+	}
+
+	merged := &packageData{
+		Measurement: defaultMeasurement,
+	}
+	for _, data := range []packageData{defaultData, associationData} {
+		for _, f := range []struct {
+			src string
+			dst *string
+		}{
+			{data.URL, &merged.URL},
+			{data.Org, &merged.Org},
+			{data.Bucket, &merged.Bucket},
+			{data.Token, &merged.Token},
+			{data.Measurement, &merged.Measurement},
+		} {
+			if f.src != "" {
+				*f.dst = f.src
+			}
+		}
+	}
+	return merged, nil
+}
@@ -0,0 +1,120 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbv2
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	measurementEscaper = strings.NewReplacer(`,`, `\,`, ` `, `\ `, "\n", `\n`)
+	keyEscaper         = strings.NewReplacer(`,`, `\,`, `=`, `\=`, ` `, `\ `, "\n", `\n`)
+	stringEscaper      = strings.NewReplacer(`"`, `\"`, `\`, `\\`)
+)
+
+// point is a point in the InfluxDB line protocol.
+type point struct {
+	Measurement string
+	Tags        map[string]string
+	Fields      map[string]any
+	Time        time.Time
+}
+
+// flattenFields adds the given value to the fields of the point. Nested objects and lists are flattened with
+// an underscore between the keys. Null values are omitted.
+func (p *point) flattenFields(key string, value *structpb.Value) {
+	switch kind := value.GetKind().(type) {
+	case *structpb.Value_NumberValue:
+		p.Fields[key] = kind.NumberValue
+	case *structpb.Value_StringValue:
+		p.Fields[key] = kind.StringValue
+	case *structpb.Value_BoolValue:
+		p.Fields[key] = kind.BoolValue
+	case *structpb.Value_StructValue:
+		for k, v := range kind.StructValue.GetFields() {
+			p.flattenFields(joinKey(key, k), v)
+		}
+	case *structpb.Value_ListValue:
+		for i, v := range kind.ListValue.GetValues() {
+			p.flattenFields(joinKey(key, strconv.Itoa(i)), v)
+		}
+	}
+}
+
+func joinKey(prefix, key string) string {
+	if prefix == "" {
+		return key
+	}
+	return prefix + "_" + key
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// MarshalText implements encoding.TextMarshaler.
+// The tags and fields are sorted by key, and the timestamp has nanosecond precision.
+func (p *point) MarshalText() ([]byte, error) {
+	var b strings.Builder
+	b.WriteString(measurementEscaper.Replace(p.Measurement))
+	for _, k := range sortedKeys(p.Tags) {
+		v := p.Tags[k]
+		if v == "" {
+			continue
+		}
+		b.WriteByte(',')
+		b.WriteString(keyEscaper.Replace(k))
+		b.WriteByte('=')
+		b.WriteString(keyEscaper.Replace(v))
+	}
+	for i, k := range sortedKeys(p.Fields) {
+		if i == 0 {
+			b.WriteByte(' ')
+		} else {
+			b.WriteByte(',')
+		}
+		b.WriteString(keyEscaper.Replace(k))
+		b.WriteByte('=')
+		switch v := p.Fields[k].(type) {
+		case float64:
+			b.WriteString(strconv.FormatFloat(v, 'g', -1, 64))
+		case int64:
+			b.WriteString(strconv.FormatInt(v, 10))
+			b.WriteByte('i')
+		case uint32:
+			b.WriteString(strconv.FormatUint(uint64(v), 10))
+			b.WriteByte('i')
+		case bool:
+			b.WriteString(strconv.FormatBool(v))
+		case string:
+			b.WriteByte('"')
+			b.WriteString(stringEscaper.Replace(v))
+			b.WriteByte('"')
+		}
+	}
+	b.WriteByte(' ')
+	b.WriteString(strconv.FormatInt(p.Time.UnixNano(), 10))
+	return []byte(b.String()), nil
+}
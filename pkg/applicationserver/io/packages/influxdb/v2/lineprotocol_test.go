@@ -0,0 +1,62 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbv2
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPointMarshalText(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	value, err := structpb.NewValue(map[string]any{
+		"temperature": 21.5,
+		"status":      `say "hi"`,
+		"alarm":       true,
+		"location": map[string]any{
+			"lat": 52.1,
+			"lon": 4.9,
+		},
+		"levels":  []any{1, 2},
+		"unknown": nil,
+	})
+	a.So(err, should.BeNil)
+	pt := &point{
+		Measurement: "uplink message",
+		Tags: map[string]string{
+			"device_id":      "dev,1",
+			"application_id": "app=1",
+			"dev_eui":        "",
+		},
+		Fields: map[string]any{
+			"f_port": int64(2),
+		},
+		Time: time.Unix(1, 42),
+	}
+	pt.flattenFields("", value)
+	b, err := pt.MarshalText()
+	a.So(err, should.BeNil)
+	a.So(string(b), should.Equal,
+		`uplink\ message,application_id=app\=1,device_id=dev\,1 `+
+			`alarm=true,f_port=2i,levels_0=1,levels_1=2,location_lat=52.1,location_lon=4.9,`+
+			`status="say \"hi\"",temperature=21.5 1000000042`,
+	)
+}
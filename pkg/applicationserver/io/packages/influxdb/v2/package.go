@@ -0,0 +1,180 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package influxdbv2 implements the InfluxDB v2 integration as application package.
+package influxdbv2
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	stdio "io"
+	"net/http"
+	"net/url"
+	"strings"
+	"unicode/utf8"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// PackageName is the name of the package.
+const PackageName = "influxdb-v2"
+
+// maxErrorBodySize is the maximum size of the response body that is included in errors.
+const maxErrorBodySize = 1 << 10
+
+// influxDBPackage is the InfluxDB v2 integration.
+// The decoded payload of uplink messages is written as point to the bucket configured in the association, with the
+// end device identifiers as tags. The FPort of the association is not used, as all uplinks are written.
+type influxDBPackage struct {
+	server io.Server
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *influxDBPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/influxdb/v2")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if len(msg.GetDecodedPayload().GetFields()) == 0 {
+		return nil
+	}
+
+	defer func() {
+		if err != nil {
+			publishEvents(ctx, up.EndDeviceIds, EvtPkgFail.With(events.WithData(err)))
+		}
+	}()
+
+	data, err := mergePackageData(def, assoc)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to merge package data")
+		return err
+	}
+	if err := data.validate(); err != nil {
+		logger.WithError(err).Debug("Invalid package data")
+		return err
+	}
+
+	line, err := uplinkPoint(data.Measurement, up).MarshalText()
+	if err != nil {
+		return err
+	}
+	if err := p.write(ctx, data, line); err != nil {
+		logger.WithError(err).Debug("Failed to write point")
+		return err
+	}
+	logger.Debug("Point written to InfluxDB")
+	return nil
+}
+
+// uplinkPoint returns the point of the uplink message. The fields are the flattened decoded payload, and the
+// FPort, FCnt, and the RSSI and SNR of the gateway with the best RSSI.
+func uplinkPoint(measurement string, up *ttnpb.ApplicationUp) *point {
+	msg := up.GetUplinkMessage()
+	pt := &point{
+		Measurement: measurement,
+		Tags: map[string]string{
+			"application_id": up.EndDeviceIds.GetApplicationIds().GetApplicationId(),
+			"device_id":      up.EndDeviceIds.GetDeviceId(),
+			"dev_eui":        types.MustEUI64(up.EndDeviceIds.GetDevEui()).OrZero().String(),
+		},
+		Fields: map[string]any{
+			"f_port": int64(msg.FPort),
+			"f_cnt":  int64(msg.FCnt),
+		},
+		Time: msg.GetReceivedAt().AsTime(),
+	}
+	if len(up.EndDeviceIds.GetDevEui()) == 0 {
+		delete(pt.Tags, "dev_eui")
+	}
+	var best *ttnpb.RxMetadata
+	for _, md := range msg.RxMetadata {
+		if best == nil || md.Rssi > best.Rssi {
+			best = md
+		}
+	}
+	if best != nil {
+		pt.Fields["rssi"], pt.Fields["snr"] = float64(best.Rssi), float64(best.Snr)
+	}
+	for k, v := range msg.DecodedPayload.GetFields() {
+		pt.flattenFields(k, v)
+	}
+	return pt
+}
+
+// write writes the line to the bucket with the InfluxDB v2 write API.
+func (p *influxDBPackage) write(ctx context.Context, data *packageData, line []byte) error {
+	query := url.Values{
+		"org":       []string{data.Org},
+		"bucket":    []string{data.Bucket},
+		"precision": []string{"ns"},
+	}
+	u := fmt.Sprintf("%s/api/v2/write?%s", strings.TrimSuffix(data.URL, "/"), query.Encode())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(line))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Token "+data.Token)
+	req.Header.Set("Content-Type", "text/plain; charset=utf-8")
+
+	client, err := p.server.HTTPClient(ctx)
+	if err != nil {
+		return err
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	defer stdio.Copy(stdio.Discard, res.Body) // nolint:errcheck
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := stdio.ReadAll(stdio.LimitReader(res.Body, maxErrorBodySize))
+		if !utf8.Valid(body) {
+			body = nil
+		}
+		return errRequest.WithAttributes("status_code", res.StatusCode, "body", string(body)).New()
+	}
+	return nil
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*influxDBPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 1,
+	}
+}
+
+// New returns a new InfluxDB v2 package.
+func New(server io.Server, _ packages.Registry) packages.ApplicationPackageHandler {
+	return &influxDBPackage{
+		server: server,
+	}
+}
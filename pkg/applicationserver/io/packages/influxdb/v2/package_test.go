@@ -0,0 +1,137 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package influxdbv2
+
+import (
+	"context"
+	stdio "io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockServer struct {
+	io.Server
+}
+
+func (*mockServer) HTTPClient(context.Context, ...httpclient.Option) (*http.Client, error) {
+	return http.DefaultClient, nil
+}
+
+type writeRequest struct {
+	query         string
+	authorization string
+	body          string
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	requests := make(chan writeRequest, 1)
+	status := http.StatusNoContent
+	influx := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := stdio.ReadAll(r.Body)
+		requests <- writeRequest{
+			query:         r.URL.Path + "?" + r.URL.RawQuery,
+			authorization: r.Header.Get("Authorization"),
+			body:          string(body),
+		}
+		w.WriteHeader(status)
+		if status != http.StatusNoContent {
+			w.Write([]byte(`{"code":"unauthorized","message":"unauthorized access"}`)) //nolint:errcheck
+		}
+	}))
+	defer influx.Close()
+
+	pkg := New(&mockServer{}, nil)
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			FPort:          1,
+		},
+		PackageName: PackageName,
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			urlField:    structpb.NewStringValue(influx.URL + "/"),
+			orgField:    structpb.NewStringValue("test-org"),
+			bucketField: structpb.NewStringValue("test-bucket"),
+			tokenField:  structpb.NewStringValue("test-token"),
+		}},
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			measurementField: structpb.NewStringValue("sensor"),
+		}},
+	}
+	decodedPayload, err := structpb.NewStruct(map[string]any{"temperature": 21.5})
+	a.So(err, should.BeNil)
+	up := &ttnpb.ApplicationUp{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: def.Ids.ApplicationIds,
+			DeviceId:       "test-dev",
+			DevEui:         types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}.Bytes(),
+		},
+		Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+			FPort:          2,
+			FCnt:           42,
+			DecodedPayload: decodedPayload,
+			RxMetadata: []*ttnpb.RxMetadata{
+				{Rssi: -100, Snr: 2},
+				{Rssi: -80, Snr: 7.5},
+			},
+			ReceivedAt: timestamppb.New(time.Unix(1700000000, 0)),
+		}},
+	}
+
+	a.So(pkg.HandleUp(ctx, def, assoc, up), should.BeNil)
+	select {
+	case req := <-requests:
+		a.So(req.query, should.Equal, "/api/v2/write?bucket=test-bucket&org=test-org&precision=ns")
+		a.So(req.authorization, should.Equal, "Token test-token")
+		a.So(req.body, should.Equal,
+			"sensor,application_id=test-app,dev_eui=70B3D57ED0000001,device_id=test-dev "+
+				"f_cnt=42i,f_port=2i,rssi=-80,snr=7.5,temperature=21.5 1700000000000000000",
+		)
+	default:
+		t.Fatal("No write request")
+	}
+
+	// Uplinks without decoded payload are not written.
+	a.So(pkg.HandleUp(ctx, def, assoc, &ttnpb.ApplicationUp{
+		EndDeviceIds: up.EndDeviceIds,
+		Up:           &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 2}},
+	}), should.BeNil)
+	a.So(requests, should.BeEmpty)
+
+	// Failed writes are returned as error.
+	status = http.StatusUnauthorized
+	err = pkg.HandleUp(ctx, def, nil, up)
+	a.So(err, should.HaveSameErrorDefinitionAs, errRequest)
+	<-requests
+
+	// Incomplete configurations are rejected.
+	delete(def.Data.Fields, bucketField)
+	a.So(pkg.HandleUp(ctx, def, nil, up), should.HaveSameErrorDefinitionAs, errMissingField)
+}
@@ -0,0 +1,217 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"encoding/binary"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Command identifiers of the Remote Multicast Setup package.
+const (
+	packageVersionCID     = 0x00
+	mcGroupStatusCID      = 0x01
+	mcGroupSetupCID       = 0x02
+	mcGroupDeleteCID      = 0x03
+	mcClassCSessionCID    = 0x04
+	mcClassBSessionCID    = 0x05
+	mcSessionErrorBitMask = 0x1c
+)
+
+// mcGroupSetupReq is the McGroupSetupReq command.
+type mcGroupSetupReq struct {
+	McGroupID      uint8
+	McAddr         types.DevAddr
+	McKeyEncrypted types.AES128Key
+	MinMcFCount    uint32
+	MaxMcFCount    uint32
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req *mcGroupSetupReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	// McAddr - bytes [2,5].
+	// McKey_encrypted - bytes [6,21].
+	// minMcFCount - bytes [22,25].
+	// maxMcFCount - bytes [26,29].
+	b := make([]byte, 30)
+	b[0] = mcGroupSetupCID
+	b[1] = req.McGroupID & 0x03
+	binary.LittleEndian.PutUint32(b[2:6], binary.BigEndian.Uint32(req.McAddr[:]))
+	copy(b[6:22], req.McKeyEncrypted[:])
+	binary.LittleEndian.PutUint32(b[22:26], req.MinMcFCount)
+	binary.LittleEndian.PutUint32(b[26:30], req.MaxMcFCount)
+	return b, nil
+}
+
+// mcClassCSessionReq is the McClassCSessionReq command.
+type mcClassCSessionReq struct {
+	McGroupID uint8
+	// SessionTime is the start of the session in seconds since the GPS epoch, modulo 2^32.
+	SessionTime uint32
+	// SessionTimeOut is the exponent of the maximum duration of the session in seconds.
+	SessionTimeOut uint8
+	Frequency      uint64
+	DataRateIndex  uint8
+}
+
+// MarshalBinary implements encoding.BinaryMarshaler.
+func (req *mcClassCSessionReq) MarshalBinary() ([]byte, error) {
+	// CID - byte 0.
+	// McGroupIDHeader - byte 1 (bits: RFU [7:2]; McGroupID [1:0]).
+	// SessionTime - bytes [2,5].
+	// SessionTimeOut - byte 6 (bits: RFU [7:4]; TimeOut [3:0]).
+	// DLFrequ - bytes [7,9], in hundreds of Hz.
+	// DR - byte 10.
+	b := make([]byte, 11)
+	b[0] = mcClassCSessionCID
+	b[1] = req.McGroupID & 0x03
+	binary.LittleEndian.PutUint32(b[2:6], req.SessionTime)
+	b[6] = req.SessionTimeOut & 0x0f
+	freq := uint32(req.Frequency / 100)
+	b[7], b[8], b[9] = byte(freq), byte(freq>>8), byte(freq>>16)
+	b[10] = req.DataRateIndex
+	return b, nil
+}
+
+// packageVersionAns is the PackageVersionAns command.
+type packageVersionAns struct {
+	PackageIdentifier uint8
+	PackageVersion    uint8
+}
+
+// mcGroupStatusAns is the McGroupStatusAns command.
+type mcGroupStatusAns struct {
+	NbTotalGroups uint8
+	McAddrs       map[uint8]types.DevAddr
+}
+
+// mcGroupSetupAns is the McGroupSetupAns command.
+type mcGroupSetupAns struct {
+	McGroupID uint8
+	IDError   bool
+}
+
+// mcGroupDeleteAns is the McGroupDeleteAns command.
+type mcGroupDeleteAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+}
+
+// mcSessionAns is the McClassCSessionAns or McClassBSessionAns command.
+type mcSessionAns struct {
+	McGroupID        uint8
+	McGroupUndefined bool
+	FreqError        bool
+	DRError          bool
+	// TimeToStart is the time until the start of the session. It is only set if there is no error.
+	TimeToStart time.Duration
+}
+
+// OK returns whether the end device accepted the session.
+func (ans *mcSessionAns) OK() bool {
+	return !ans.McGroupUndefined && !ans.FreqError && !ans.DRError
+}
+
+// parseAnswers parses the answers in the uplink payload.
+// The answers are of type *packageVersionAns, *mcGroupStatusAns, *mcGroupSetupAns, *mcGroupDeleteAns or
+// *mcSessionAns.
+func parseAnswers(b []byte) ([]any, error) {
+	var answers []any
+	for len(b) > 0 {
+		cID, rest := b[0], b[1:]
+		var n int
+		switch cID {
+		case packageVersionCID:
+			n = 2
+		case mcGroupStatusCID:
+			n = 1
+			if len(rest) > 0 {
+				// Each group in AnsGroupMask is answered with its McGroupID and McAddr.
+				for mask := rest[0] & 0x0f; mask != 0; mask &= mask - 1 {
+					n += 5
+				}
+			}
+		case mcGroupSetupCID, mcGroupDeleteCID:
+			n = 1
+		case mcClassCSessionCID, mcClassBSessionCID:
+			n = 1
+			if len(rest) > 0 && rest[0]&mcSessionErrorBitMask == 0 {
+				n += 3
+			}
+		default:
+			return answers, errUnknownCommand.WithAttributes("command_id", cID).New()
+		}
+		if len(rest) < n {
+			return answers, errInsufficientLength.WithAttributes(
+				"command_id", cID,
+				"expected_length", n,
+				"actual_length", len(rest),
+			).New()
+		}
+		cPayload := rest[:n]
+		switch cID {
+		case packageVersionCID:
+			answers = append(answers, &packageVersionAns{
+				PackageIdentifier: cPayload[0],
+				PackageVersion:    cPayload[1],
+			})
+		case mcGroupStatusCID:
+			// Status - byte 0 (bits: RFU 7; NbTotalGroups [6:4]; AnsGroupMask [3:0]).
+			// McGroupID and McAddr - 5 bytes for each group in AnsGroupMask.
+			ans := &mcGroupStatusAns{
+				NbTotalGroups: (cPayload[0] >> 4) & 0x07,
+				McAddrs:       make(map[uint8]types.DevAddr),
+			}
+			for p := cPayload[1:]; len(p) >= 5; p = p[5:] {
+				var addr types.DevAddr
+				binary.BigEndian.PutUint32(addr[:], binary.LittleEndian.Uint32(p[1:5]))
+				ans.McAddrs[p[0]&0x03] = addr
+			}
+			answers = append(answers, ans)
+		case mcGroupSetupCID:
+			// McGroupIDHeader - byte 0 (bits: RFU [7:3]; IDerror 2; McGroupID [1:0]).
+			answers = append(answers, &mcGroupSetupAns{
+				McGroupID: cPayload[0] & 0x03,
+				IDError:   cPayload[0]&0x04 != 0,
+			})
+		case mcGroupDeleteCID:
+			// McGroupIDHeader - byte 0 (bits: RFU [7:3]; McGroupUndefined 2; McGroupID [1:0]).
+			answers = append(answers, &mcGroupDeleteAns{
+				McGroupID:        cPayload[0] & 0x03,
+				McGroupUndefined: cPayload[0]&0x04 != 0,
+			})
+		case mcClassCSessionCID, mcClassBSessionCID:
+			// Status - byte 0 (bits: RFU [7:5]; McGroupUndefined 4; FreqError 3; DRError 2; McGroupID [1:0]).
+			// TimeToStart - bytes [1,3], only if there is no error.
+			ans := &mcSessionAns{
+				McGroupID:        cPayload[0] & 0x03,
+				McGroupUndefined: cPayload[0]&0x10 != 0,
+				FreqError:        cPayload[0]&0x08 != 0,
+				DRError:          cPayload[0]&0x04 != 0,
+			}
+			if len(cPayload) == 4 {
+				timeToStart := uint32(cPayload[1]) | uint32(cPayload[2])<<8 | uint32(cPayload[3])<<16
+				ans.TimeToStart = time.Duration(timeToStart) * time.Second
+			}
+			answers = append(answers, ans)
+		}
+		b = rest[n:]
+	}
+	return answers, nil
+}
@@ -0,0 +1,134 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMarshalCommands(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	b, err := (&mcGroupSetupReq{
+		McGroupID:      1,
+		McAddr:         types.DevAddr{0x01, 0x02, 0x03, 0x04},
+		McKeyEncrypted: types.AES128Key{0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f},
+		MinMcFCount:    0x2a,
+		MaxMcFCount:    0xffffffff,
+	}).MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{
+		0x02, 0x01,
+		0x04, 0x03, 0x02, 0x01,
+		0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f,
+		0x2a, 0x00, 0x00, 0x00,
+		0xff, 0xff, 0xff, 0xff,
+	})
+
+	b, err = (&mcClassCSessionReq{
+		McGroupID:      2,
+		SessionTime:    0x12345678,
+		SessionTimeOut: 10,
+		Frequency:      869525000,
+		DataRateIndex:  3,
+	}).MarshalBinary()
+	a.So(err, should.BeNil)
+	a.So(b, should.Resemble, []byte{
+		0x04, 0x02,
+		0x78, 0x56, 0x34, 0x12,
+		0x0a,
+		0xd2, 0xad, 0x84,
+		0x03,
+	})
+}
+
+func TestParseAnswers(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name     string
+		Payload  []byte
+		Expected []any
+		Error    error
+	}{
+		{
+			Name:     "PackageVersion",
+			Payload:  []byte{0x00, 0x02, 0x01},
+			Expected: []any{&packageVersionAns{PackageIdentifier: 2, PackageVersion: 1}},
+		},
+		{
+			Name:    "GroupStatus",
+			Payload: []byte{0x01, 0x23, 0x00, 0x04, 0x03, 0x02, 0x01, 0x01, 0x08, 0x07, 0x06, 0x05},
+			Expected: []any{&mcGroupStatusAns{
+				NbTotalGroups: 2,
+				McAddrs: map[uint8]types.DevAddr{
+					0: {0x01, 0x02, 0x03, 0x04},
+					1: {0x05, 0x06, 0x07, 0x08},
+				},
+			}},
+		},
+		{
+			Name:    "GroupSetupAndDelete",
+			Payload: []byte{0x02, 0x05, 0x03, 0x06},
+			Expected: []any{
+				&mcGroupSetupAns{McGroupID: 1, IDError: true},
+				&mcGroupDeleteAns{McGroupID: 2, McGroupUndefined: true},
+			},
+		},
+		{
+			Name:     "SessionAccepted",
+			Payload:  []byte{0x04, 0x01, 0x3c, 0x00, 0x00},
+			Expected: []any{&mcSessionAns{McGroupID: 1, TimeToStart: time.Minute}},
+		},
+		{
+			Name:    "SessionRejected",
+			Payload: []byte{0x04, 0x0d, 0x02, 0x01},
+			Expected: []any{
+				&mcSessionAns{McGroupID: 1, FreqError: true, DRError: true},
+				&mcGroupSetupAns{McGroupID: 1},
+			},
+		},
+		{
+			Name:     "UnknownCommand",
+			Payload:  []byte{0x00, 0x02, 0x01, 0x42},
+			Expected: []any{&packageVersionAns{PackageIdentifier: 2, PackageVersion: 1}},
+			Error:    errUnknownCommand,
+		},
+		{
+			Name:    "InsufficientLength",
+			Payload: []byte{0x04, 0x01, 0x3c},
+			Error:   errInsufficientLength,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			answers, err := parseAnswers(tc.Payload)
+			if tc.Error != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.Error)
+			} else {
+				a.So(err, should.BeNil)
+			}
+			a.So(answers, should.Resemble, tc.Expected)
+		})
+	}
+}
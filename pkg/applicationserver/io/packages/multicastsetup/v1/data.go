@@ -0,0 +1,382 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	mcGroupIDField         = "mc_group_id"
+	multicastDeviceIDField = "multicast_device_id"
+	mcKeyField             = "mc_key"
+	minMcFCountField       = "min_mc_f_count"
+	maxMcFCountField       = "max_mc_f_count"
+	sessionTimeField       = "session_time"
+	sessionTimeOutField    = "session_time_out"
+	frequencyField         = "frequency"
+	dataRateIndexField     = "data_rate_index"
+	appKeyField            = "app_key"
+	genAppKeyField         = "gen_app_key"
+	setupField             = "setup"
+
+	digestField         = "digest"
+	stateField          = "state"
+	attemptsField       = "attempts"
+	uplinksWaitingField = "uplinks_waiting"
+	sessionStartField   = "session_start"
+)
+
+const (
+	// defaultSessionTimeOut is the default exponent of the maximum duration of the class C session in seconds.
+	defaultSessionTimeOut = 12
+	// maxAttempts is the number of times a request is sent before the setup fails.
+	maxAttempts = 3
+	// answerUplinks is the number of uplinks after which an unanswered request is sent again.
+	answerUplinks = 3
+)
+
+// packageData is the configuration of the multicast setup.
+// The multicast group is typically configured in the default association of the application, and the root key of
+// each end device in its association.
+type packageData struct {
+	// McGroupID is the multicast group index in the end device.
+	McGroupID uint8
+	// MulticastDeviceID is the ID of the multicast end device in the application. The McAddr is the DevAddr of its
+	// session, and its AppSKey is the McAppSKey derived from the McKey.
+	MulticastDeviceID string
+	// McKey is the key of the multicast group from which the multicast session keys are derived.
+	McKey *types.AES128Key
+	// MinMcFCount is the lowest frame counter of the multicast group that the end device accepts.
+	MinMcFCount uint32
+	// MaxMcFCount is the highest frame counter of the multicast group that the end device accepts.
+	MaxMcFCount *uint32
+	// SessionTime is the start of the class C session. If not set, no class C session is set up.
+	SessionTime *time.Time
+	// SessionTimeOut is the exponent of the maximum duration of the class C session in seconds.
+	SessionTimeOut uint8
+	// Frequency is the downlink frequency of the class C session in Hz.
+	Frequency uint64
+	// DataRateIndex is the data rate index of the class C session.
+	DataRateIndex uint8
+	// AppKey is the AppKey of a LoRaWAN 1.1 end device, from which the McRootKey is derived.
+	AppKey *types.AES128Key
+	// GenAppKey is the GenAppKey of a LoRaWAN 1.0.x end device, from which the McRootKey is derived.
+	GenAppKey *types.AES128Key
+}
+
+func numberFromStruct(fields map[string]*structpb.Value, field string, max float64) (float64, bool, error) {
+	value, ok := fields[field]
+	if !ok {
+		return 0, false, nil
+	}
+	numberValue, ok := value.GetKind().(*structpb.Value_NumberValue)
+	if !ok {
+		return 0, false, errInvalidFieldType.WithAttributes("field", field, "type", "number").New()
+	}
+	if n := numberValue.NumberValue; n < 0 || n > max || n != float64(int64(n)) {
+		return 0, false, errInvalidFieldValue.WithAttributes("field", field).New()
+	}
+	return numberValue.NumberValue, true, nil
+}
+
+func stringFromStruct(fields map[string]*structpb.Value, field string) (string, bool, error) {
+	value, ok := fields[field]
+	if !ok {
+		return "", false, nil
+	}
+	stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", false, errInvalidFieldType.WithAttributes("field", field, "type", "string").New()
+	}
+	return stringValue.StringValue, true, nil
+}
+
+func keyFromStruct(fields map[string]*structpb.Value, field string) (*types.AES128Key, error) {
+	s, ok, err := stringFromStruct(fields, field)
+	if err != nil || !ok {
+		return nil, err
+	}
+	key := &types.AES128Key{}
+	if err := key.UnmarshalText([]byte(s)); err != nil {
+		return nil, errInvalidFieldValue.WithCause(err).WithAttributes("field", field).New()
+	}
+	return key, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) (err error) {
+	fields := st.GetFields()
+	if d.MulticastDeviceID, _, err = stringFromStruct(fields, multicastDeviceIDField); err != nil {
+		return err
+	}
+	for _, f := range []struct {
+		field string
+		dst   **types.AES128Key
+	}{
+		{mcKeyField, &d.McKey},
+		{appKeyField, &d.AppKey},
+		{genAppKeyField, &d.GenAppKey},
+	} {
+		if *f.dst, err = keyFromStruct(fields, f.field); err != nil {
+			return err
+		}
+	}
+	s, ok, err := stringFromStruct(fields, sessionTimeField)
+	if err != nil {
+		return err
+	}
+	if ok {
+		t, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			return errInvalidFieldValue.WithCause(err).WithAttributes("field", sessionTimeField).New()
+		}
+		d.SessionTime = &t
+	}
+	for _, f := range []struct {
+		field string
+		max   float64
+		set   func(float64)
+	}{
+		{mcGroupIDField, 3, func(v float64) { d.McGroupID = uint8(v) }},
+		{minMcFCountField, 1<<32 - 1, func(v float64) { d.MinMcFCount = uint32(v) }},
+		{maxMcFCountField, 1<<32 - 1, func(v float64) { n := uint32(v); d.MaxMcFCount = &n }},
+		{sessionTimeOutField, 15, func(v float64) { d.SessionTimeOut = uint8(v) }},
+		{frequencyField, 1<<24*100 - 1, func(v float64) { d.Frequency = uint64(v) }},
+		{dataRateIndexField, 15, func(v float64) { d.DataRateIndex = uint8(v) }},
+	} {
+		v, ok, err := numberFromStruct(fields, f.field, f.max)
+		if err != nil {
+			return err
+		}
+		if ok {
+			f.set(v)
+		}
+	}
+	return nil
+}
+
+// validate checks whether the multicast setup is complete.
+func (d *packageData) validate() error {
+	switch {
+	case d.MulticastDeviceID == "":
+		return errMissingField.WithAttributes("field", multicastDeviceIDField).New()
+	case d.McKey == nil:
+		return errMissingField.WithAttributes("field", mcKeyField).New()
+	case d.AppKey == nil && d.GenAppKey == nil:
+		return errMissingField.WithAttributes("field", appKeyField).New()
+	case d.SessionTime != nil && d.Frequency == 0:
+		return errMissingField.WithAttributes("field", frequencyField).New()
+	}
+	return nil
+}
+
+// mcKEKey returns the McKEKey of the end device.
+func (d *packageData) mcKEKey() types.AES128Key {
+	if d.AppKey != nil {
+		return crypto.DeriveMcKEKey(crypto.DeriveMcRootKey(*d.AppKey))
+	}
+	return crypto.DeriveMcKEKey(crypto.DeriveLegacyMcRootKey(*d.GenAppKey))
+}
+
+// maxMcFCount returns the highest frame counter of the multicast group that the end device accepts.
+func (d *packageData) maxMcFCount() uint32 {
+	if d.MaxMcFCount != nil {
+		return *d.MaxMcFCount
+	}
+	return 1<<32 - 1
+}
+
+// sessionEnd returns the time at which the class C session ends at the latest.
+func (d *packageData) sessionEnd() time.Time {
+	return d.SessionTime.Add(time.Duration(1<<d.SessionTimeOut) * time.Second)
+}
+
+// digest returns the digest of the multicast setup. A new setup is started when the digest changes.
+func (d *packageData) digest() string {
+	h := sha256.New()
+	var b [27]byte
+	b[0] = d.McGroupID
+	b[1] = d.SessionTimeOut
+	b[2] = d.DataRateIndex
+	binary.LittleEndian.PutUint32(b[3:7], d.MinMcFCount)
+	binary.LittleEndian.PutUint32(b[7:11], d.maxMcFCount())
+	binary.LittleEndian.PutUint64(b[11:19], d.Frequency)
+	if d.SessionTime != nil {
+		binary.LittleEndian.PutUint64(b[19:27], uint64(d.SessionTime.Unix()))
+	}
+	h.Write(b[:])
+	h.Write([]byte(d.MulticastDeviceID))
+	for _, key := range []*types.AES128Key{d.McKey, d.AppKey, d.GenAppKey} {
+		if key != nil {
+			h.Write(key[:])
+		}
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, uint32, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, 0, errPkgDataMerge.WithCause(err).New()
+	}
+
+	merged := &packageData{
+		SessionTimeOut: defaultSessionTimeOut,
+	}
+	for _, data := range []packageData{defaultData, associationData} {
+		if data.McGroupID != 0 {
+			merged.McGroupID = data.McGroupID
+		}
+		if data.MulticastDeviceID != "" {
+			merged.MulticastDeviceID = data.MulticastDeviceID
+		}
+		if data.McKey != nil {
+			merged.McKey = data.McKey
+		}
+		if data.MinMcFCount != 0 {
+			merged.MinMcFCount = data.MinMcFCount
+		}
+		if data.MaxMcFCount != nil {
+			merged.MaxMcFCount = data.MaxMcFCount
+		}
+		if data.SessionTime != nil {
+			merged.SessionTime = data.SessionTime
+		}
+		if data.SessionTimeOut != 0 {
+			merged.SessionTimeOut = data.SessionTimeOut
+		}
+		if data.Frequency != 0 {
+			merged.Frequency = data.Frequency
+		}
+		if data.DataRateIndex != 0 {
+			merged.DataRateIndex = data.DataRateIndex
+		}
+		if data.AppKey != nil {
+			merged.AppKey = data.AppKey
+		}
+		if data.GenAppKey != nil {
+			merged.GenAppKey = data.GenAppKey
+		}
+	}
+	fPort := def.GetIds().GetFPort()
+	assocFPort := assoc.GetIds().GetFPort()
+	if assocFPort != 0 {
+		fPort = assocFPort
+	}
+	return merged, fPort, nil
+}
+
+// setupStateKind is the state of a multicast setup.
+type setupStateKind string
+
+const (
+	// groupSetup is the state in which the McGroupSetupReq is sent.
+	groupSetup setupStateKind = "group_setup"
+	// sessionSetup is the state in which the McClassCSessionReq is sent.
+	sessionSetup setupStateKind = "session_setup"
+	// setupCompleted is the state in which the end device accepted the multicast group and session.
+	setupCompleted setupStateKind = "completed"
+	// setupFailed is the state in which the multicast setup failed.
+	setupFailed setupStateKind = "failed"
+)
+
+// setupState is the state of the multicast setup of an end device, which is stored in the data of its association.
+type setupState struct {
+	// Digest is the digest of the multicast setup configuration.
+	Digest string
+	// State is the state of the multicast setup.
+	State setupStateKind
+	// Attempts is the number of times the request of the current state was sent.
+	Attempts int
+	// UplinksWaiting is the number of uplinks received since the request of the current state was sent.
+	UplinksWaiting int
+	// SessionStart is the start of the class C session, as reported in the McClassCSessionAns.
+	SessionStart time.Time
+}
+
+// Value serializes the setup state to *structpb.Value.
+func (s *setupState) Value() *structpb.Value {
+	fields := map[string]*structpb.Value{
+		digestField:         structpb.NewStringValue(s.Digest),
+		stateField:          structpb.NewStringValue(string(s.State)),
+		attemptsField:       structpb.NewNumberValue(float64(s.Attempts)),
+		uplinksWaitingField: structpb.NewNumberValue(float64(s.UplinksWaiting)),
+	}
+	if !s.SessionStart.IsZero() {
+		fields[sessionStartField] = structpb.NewStringValue(s.SessionStart.UTC().Format(time.RFC3339))
+	}
+	return structpb.NewStructValue(&structpb.Struct{Fields: fields})
+}
+
+// fromStruct deserializes the setup state from the association data.
+func (s *setupState) fromStruct(st *structpb.Struct) error {
+	value, ok := st.GetFields()[setupField]
+	if !ok {
+		return nil
+	}
+	structValue, ok := value.GetKind().(*structpb.Value_StructValue)
+	if !ok {
+		return errInvalidFieldType.WithAttributes("field", setupField, "type", "object").New()
+	}
+	fields := structValue.StructValue.GetFields()
+	var err error
+	if s.Digest, _, err = stringFromStruct(fields, digestField); err != nil {
+		return err
+	}
+	state, _, err := stringFromStruct(fields, stateField)
+	if err != nil {
+		return err
+	}
+	s.State = setupStateKind(state)
+	sessionStart, ok, err := stringFromStruct(fields, sessionStartField)
+	if err != nil {
+		return err
+	}
+	if ok {
+		if s.SessionStart, err = time.Parse(time.RFC3339, sessionStart); err != nil {
+			return errInvalidFieldValue.WithCause(err).WithAttributes("field", sessionStartField).New()
+		}
+	}
+	for _, f := range []struct {
+		field string
+		dst   *int
+	}{
+		{attemptsField, &s.Attempts},
+		{uplinksWaitingField, &s.UplinksWaiting},
+	} {
+		v, ok, err := numberFromStruct(fields, f.field, 1<<16)
+		if err != nil {
+			return err
+		}
+		if ok {
+			*f.dst = int(v)
+		}
+	}
+	return nil
+}
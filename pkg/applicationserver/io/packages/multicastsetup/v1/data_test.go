@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+func TestPackageDataMerge(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{FPort: 200},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			mcGroupIDField:         structpb.NewNumberValue(1),
+			multicastDeviceIDField: structpb.NewStringValue("mc-dev"),
+			mcKeyField:             structpb.NewStringValue("01020304050607080102030405060708"),
+			sessionTimeField:       structpb.NewStringValue("2026-10-15T12:00:00Z"),
+			frequencyField:         structpb.NewNumberValue(869525000),
+			dataRateIndexField:     structpb.NewNumberValue(3),
+		}},
+	}
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		Ids: &ttnpb.ApplicationPackageAssociationIdentifiers{FPort: 201},
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			appKeyField:      structpb.NewStringValue("42424242424242424242424242424242"),
+			maxMcFCountField: structpb.NewNumberValue(1000),
+		}},
+	}
+	sessionTime := time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC)
+	maxMcFCount := uint32(1000)
+	data, fPort, err := mergePackageData(def, assoc)
+	a.So(err, should.BeNil)
+	a.So(fPort, should.Equal, 201)
+	a.So(data, should.Resemble, &packageData{
+		McGroupID:         1,
+		MulticastDeviceID: "mc-dev",
+		McKey:             &types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08},
+		MaxMcFCount:       &maxMcFCount,
+		SessionTime:       &sessionTime,
+		SessionTimeOut:    defaultSessionTimeOut,
+		Frequency:         869525000,
+		DataRateIndex:     3,
+		AppKey:            &types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42},
+	})
+	a.So(data.validate(), should.BeNil)
+	a.So(data.maxMcFCount(), should.Equal, 1000)
+	a.So(data.sessionEnd(), should.Equal, sessionTime.Add(4096*time.Second))
+
+	defData, _, err := mergePackageData(def, nil)
+	a.So(err, should.BeNil)
+	a.So(defData.maxMcFCount(), should.Equal, uint32(1<<32-1))
+	a.So(defData.validate(), should.HaveSameErrorDefinitionAs, errMissingField)
+	a.So(defData.digest(), should.NotEqual, data.digest())
+
+	for _, st := range []*structpb.Struct{
+		{Fields: map[string]*structpb.Value{mcKeyField: structpb.NewStringValue("not a key")}},
+		{Fields: map[string]*structpb.Value{mcGroupIDField: structpb.NewNumberValue(4)}},
+		{Fields: map[string]*structpb.Value{sessionTimeField: structpb.NewStringValue("tomorrow")}},
+		{Fields: map[string]*structpb.Value{multicastDeviceIDField: structpb.NewNumberValue(1)}},
+	} {
+		_, _, err := mergePackageData(nil, &ttnpb.ApplicationPackageAssociation{Data: st})
+		a.So(err, should.HaveSameErrorDefinitionAs, errPkgDataMerge)
+	}
+}
+
+func TestSetupState(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	state := &setupState{
+		Digest:         "0102030405060708",
+		State:          setupCompleted,
+		Attempts:       2,
+		UplinksWaiting: 1,
+		SessionStart:   time.Date(2026, time.October, 15, 12, 0, 0, 0, time.UTC),
+	}
+	st := &structpb.Struct{Fields: map[string]*structpb.Value{setupField: state.Value()}}
+	decoded := &setupState{}
+	a.So(decoded.fromStruct(st), should.BeNil)
+	a.So(decoded, should.Resemble, state)
+
+	a.So((&setupState{}).fromStruct(&structpb.Struct{Fields: map[string]*structpb.Value{
+		setupField: structpb.NewStringValue("completed"),
+	}}), should.HaveSameErrorDefinitionAs, errInvalidFieldType)
+}
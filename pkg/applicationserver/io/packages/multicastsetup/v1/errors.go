@@ -0,0 +1,49 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import "go.thethings.network/lorawan-stack/v3/pkg/errors"
+
+var (
+	errNoAssociation      = errors.DefineInternal("no_association", "no association available")
+	errUnknownCommand     = errors.DefineNotFound("unknown_command", "unknown command `{command_id}`")
+	errInsufficientLength = errors.DefineInvalidArgument(
+		"insufficient_length", "command `{command_id}` payload has insufficient length",
+		"expected_length", "actual_length",
+	)
+
+	errInvalidFieldType  = errors.DefineCorruption("invalid_field_type", "field `{field}` has the wrong type `{type}`")
+	errInvalidFieldValue = errors.DefineInvalidArgument("invalid_field_value", "invalid value of field `{field}`")
+	errMissingField      = errors.DefineInvalidArgument("missing_field", "missing field `{field}`")
+	errPkgDataMerge      = errors.DefineCorruption("pkg_data_merge", "failed to merge package data")
+
+	errNoMulticastSession = errors.DefineFailedPrecondition(
+		"no_multicast_session", "multicast end device `{device_uid}` has no session",
+	)
+	errMcAppSKeyMismatch = errors.DefineFailedPrecondition(
+		"mc_app_s_key_mismatch", "AppSKey of multicast end device `{device_uid}` does not match McKey",
+	)
+	errGroupSetupRejected = errors.DefineFailedPrecondition(
+		"group_setup_rejected", "end device rejected multicast group `{mc_group_id}`",
+	)
+	errSessionRejected = errors.DefineFailedPrecondition(
+		"session_rejected", "end device rejected multicast session of group `{mc_group_id}`",
+		"mc_group_undefined", "freq_error", "dr_error",
+	)
+	errSessionExpired = errors.DefineFailedPrecondition(
+		"session_expired", "multicast session of group `{mc_group_id}` ended at `{session_end}`",
+	)
+	errNoAnswer = errors.DefineDeadlineExceeded("no_answer", "end device did not answer `{command}`")
+)
@@ -0,0 +1,72 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func publishEvents(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, builders ...events.Builder) {
+	n := len(builders)
+	if n == 0 {
+		return
+	}
+
+	evts := events.Builders(builders).New(ctx, events.WithIdentifiers(ids))
+	log.FromContext(ctx).WithField("event_count", n).Debug("Publish events")
+	events.Publish(evts...)
+}
+
+func eventOptions(extraOpts ...events.Option) []events.Option {
+	return append([]events.Option{events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)}, extraOpts...)
+}
+
+var (
+	// EvtGroupSetup is the event that is published when a multicast group setup request is enqueued.
+	EvtGroupSetup = events.Define(
+		"as.packages.multicastsetup.v1.group.setup", "multicast group setup request enqueued",
+		eventOptions()...,
+	)
+
+	// EvtSessionSetup is the event that is published when a multicast class C session request is enqueued.
+	EvtSessionSetup = events.Define(
+		"as.packages.multicastsetup.v1.session.setup", "multicast class C session request enqueued",
+		eventOptions()...,
+	)
+
+	// EvtSetupComplete is the event that is published when the end device accepted the multicast group and,
+	// if configured, the multicast class C session.
+	EvtSetupComplete = events.Define(
+		"as.packages.multicastsetup.v1.setup.complete", "multicast setup completed",
+		eventOptions()...,
+	)
+
+	// EvtSetupFail is the event that is published when the multicast setup failed.
+	EvtSetupFail = events.Define(
+		"as.packages.multicastsetup.v1.setup.fail", "multicast setup failed",
+		eventOptions(events.WithErrorDataType())...,
+	)
+
+	// EvtPkgFail is the event that is published when an error occurs in the package.
+	EvtPkgFail = events.Define(
+		"as.packages.multicastsetup.v1.fail", "package failed due to error", eventOptions(
+			events.WithErrorDataType(), events.WithPropagateToParent(),
+		)...,
+	)
+)
@@ -0,0 +1,394 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package multicastsetupv1 implements the LoRaWAN Remote Multicast Setup v1.0.0 application package.
+package multicastsetupv1
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+// PackageName is the name of the package.
+const PackageName = "multicast-setup-v1"
+
+// multicastSetupPackage is the Remote Multicast Setup package.
+// The multicast group is a multicast end device in the application, which is referenced in the data of the
+// association. The McAddr is the DevAddr of the multicast end device, and the multicast session keys of the multicast
+// end device are derived from the McKey, so that the end devices receive the downlinks of the multicast end device.
+// The state of the setup is stored in the data of the association of the end device.
+type multicastSetupPackage struct {
+	server   io.Server
+	registry packages.Registry
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *multicastSetupPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/multicastsetup/v1")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if msg == nil {
+		return nil
+	}
+
+	eventBuilders := make(events.Builders, 0)
+	defer func() {
+		if err != nil {
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(events.WithData(err)))
+		}
+		publishEvents(ctx, up.EndDeviceIds, eventBuilders...)
+	}()
+
+	data, fPort, err := mergePackageData(def, assoc)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to merge package data")
+		return err
+	}
+	if data.MulticastDeviceID == "" {
+		logger.Debug("No multicast group to set up")
+		return nil
+	}
+	if err := data.validate(); err != nil {
+		logger.WithError(err).Debug("Invalid package data")
+		return err
+	}
+
+	var answers []any
+	if msg.GetFPort() == fPort && len(msg.GetFrmPayload()) > 0 {
+		answers, err = parseAnswers(msg.FrmPayload)
+		if err != nil {
+			logger.WithError(err).Debug("Failed to parse frame payload into answers")
+			return err
+		}
+	}
+
+	ids := assocIDs(def, assoc, up.EndDeviceIds)
+	return p.registry.EndDeviceTransaction(ctx, up.EndDeviceIds, fPort, PackageName, func(ctx context.Context) error {
+		stored, err := p.registry.GetAssociation(ctx, ids, []string{"data"})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		state := &setupState{}
+		if err := state.fromStruct(stored.GetData()); err != nil {
+			return err
+		}
+		if digest := data.digest(); state.Digest != digest {
+			*state = setupState{Digest: digest}
+		}
+		initial := *state
+
+		if state.State == groupSetup || state.State == sessionSetup {
+			state.UplinksWaiting++
+		}
+		receivedAt := time.Now()
+		if msg.ReceivedAt != nil {
+			receivedAt = msg.ReceivedAt.AsTime()
+		}
+		eventBuilders = append(eventBuilders, handleAnswers(ctx, data, state, answers, receivedAt)...)
+		downlinks, evs, err := p.advance(ctx, up.EndDeviceIds, data, fPort, state)
+		if err != nil {
+			return err
+		}
+		if len(downlinks) > 0 {
+			if err := p.server.DownlinkQueuePush(ctx, up.EndDeviceIds, downlinks); err != nil {
+				logger.WithError(err).Debug("Failed to push downlinks to queue")
+				return err
+			}
+		}
+		eventBuilders = append(eventBuilders, evs...)
+		if *state == initial {
+			return nil
+		}
+		return p.setSetupState(ctx, ids, state)
+	})
+}
+
+// handleAnswers updates the setup state with the answers of the end device.
+func handleAnswers(
+	ctx context.Context, data *packageData, state *setupState, answers []any, receivedAt time.Time,
+) events.Builders {
+	logger := log.FromContext(ctx)
+	var evs events.Builders
+	for _, ans := range answers {
+		switch ans := ans.(type) {
+		case *packageVersionAns:
+			logger.WithFields(log.Fields(
+				"package_identifier", ans.PackageIdentifier,
+				"package_version", ans.PackageVersion,
+			)).Debug("Received package version")
+
+		case *mcGroupStatusAns:
+			logger.WithFields(log.Fields(
+				"nb_total_groups", ans.NbTotalGroups,
+				"mc_addrs", ans.McAddrs,
+			)).Debug("Received multicast group status")
+
+		case *mcGroupSetupAns:
+			if ans.McGroupID != data.McGroupID || state.State != groupSetup {
+				continue
+			}
+			if ans.IDError {
+				state.State = setupFailed
+				evs = append(evs, EvtSetupFail.With(events.WithData(
+					errGroupSetupRejected.WithAttributes("mc_group_id", ans.McGroupID).New(),
+				)))
+				continue
+			}
+			state.Attempts, state.UplinksWaiting = 0, 0
+			if data.SessionTime == nil {
+				state.State = setupCompleted
+				evs = append(evs, EvtSetupComplete)
+				continue
+			}
+			state.State = sessionSetup
+
+		case *mcSessionAns:
+			if ans.McGroupID != data.McGroupID || state.State != sessionSetup {
+				continue
+			}
+			if !ans.OK() {
+				state.State = setupFailed
+				evs = append(evs, EvtSetupFail.With(events.WithData(errSessionRejected.WithAttributes(
+					"mc_group_id", ans.McGroupID,
+					"mc_group_undefined", ans.McGroupUndefined,
+					"freq_error", ans.FreqError,
+					"dr_error", ans.DRError,
+				).New())))
+				continue
+			}
+			state.State, state.SessionStart = setupCompleted, receivedAt.Add(ans.TimeToStart).Truncate(time.Second)
+			evs = append(evs, EvtSetupComplete)
+
+		case *mcGroupDeleteAns:
+			logger.WithFields(log.Fields(
+				"mc_group_id", ans.McGroupID,
+				"mc_group_undefined", ans.McGroupUndefined,
+			)).Debug("Received multicast group delete answer")
+		}
+	}
+	return evs
+}
+
+// queuedDownlinks returns the number of downlinks on the package FPort in the application downlink queue.
+func (p *multicastSetupPackage) queuedDownlinks(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32,
+) (int, error) {
+	queue, err := p.server.DownlinkQueueList(ctx, ids)
+	if err != nil {
+		return 0, err
+	}
+	n := 0
+	for _, down := range queue {
+		if down.FPort == fPort {
+			n++
+		}
+	}
+	return n, nil
+}
+
+// advance returns the downlink that advances the setup, and updates the setup state accordingly.
+// Requests are sent again when the end device did not answer them after a number of uplinks, and the setup fails
+// when the end device did not answer them after a number of attempts.
+func (p *multicastSetupPackage) advance(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	data *packageData,
+	fPort uint32,
+	state *setupState,
+) ([]*ttnpb.ApplicationDownlink, events.Builders, error) {
+	var command string
+	switch state.State {
+	case "", groupSetup:
+		command = "McGroupSetupReq"
+	case sessionSetup:
+		command = "McClassCSessionReq"
+	default:
+		return nil, nil, nil
+	}
+	if state.Attempts > 0 {
+		if state.UplinksWaiting < answerUplinks {
+			return nil, nil, nil
+		}
+		queued, err := p.queuedDownlinks(ctx, ids, fPort)
+		if err != nil {
+			return nil, nil, err
+		}
+		if queued > 0 {
+			return nil, nil, nil
+		}
+	}
+	if state.Attempts >= maxAttempts {
+		state.State = setupFailed
+		return nil, events.Builders{
+			EvtSetupFail.With(events.WithData(errNoAnswer.WithAttributes("command", command).New())),
+		}, nil
+	}
+
+	var (
+		b   []byte
+		evs events.Builders
+		err error
+	)
+	switch state.State {
+	case "", groupSetup:
+		var req *mcGroupSetupReq
+		if req, err = p.mcGroupSetupReq(ctx, ids, data); err != nil {
+			return nil, nil, err
+		}
+		state.State = groupSetup
+		b, err = req.MarshalBinary()
+		evs = events.Builders{EvtGroupSetup}
+
+	case sessionSetup:
+		if sessionEnd := data.sessionEnd(); !time.Now().Before(sessionEnd) {
+			state.State = setupFailed
+			return nil, events.Builders{EvtSetupFail.With(events.WithData(errSessionExpired.WithAttributes(
+				"mc_group_id", data.McGroupID,
+				"session_end", sessionEnd.UTC().Format(time.RFC3339),
+			).New()))}, nil
+		}
+		b, err = (&mcClassCSessionReq{
+			McGroupID:      data.McGroupID,
+			SessionTime:    uint32(gpstime.ToGPS(*data.SessionTime) / time.Second),
+			SessionTimeOut: data.SessionTimeOut,
+			Frequency:      data.Frequency,
+			DataRateIndex:  data.DataRateIndex,
+		}).MarshalBinary()
+		evs = events.Builders{EvtSessionSetup}
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	state.Attempts++
+	state.UplinksWaiting = 0
+	return []*ttnpb.ApplicationDownlink{{
+		FPort:      fPort,
+		FrmPayload: b,
+	}}, evs, nil
+}
+
+// mcGroupSetupReq returns the McGroupSetupReq of the multicast group for the end device.
+// The McAddr is the DevAddr of the multicast end device. If the AppSKey of the multicast end device is available,
+// it is verified that it is the McAppSKey derived from the McKey.
+func (p *multicastSetupPackage) mcGroupSetupReq(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, data *packageData,
+) (*mcGroupSetupReq, error) {
+	mcIDs := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: ids.ApplicationIds,
+		DeviceId:       data.MulticastDeviceID,
+	}
+	dev, err := p.server.GetEndDevice(ctx, mcIDs, []string{"session"})
+	if err != nil {
+		return nil, err
+	}
+	mcAddr, err := types.GetDevAddr(dev.GetSession().GetDevAddr())
+	if err != nil || mcAddr == nil || mcAddr.IsZero() {
+		return nil, errNoMulticastSession.WithAttributes("device_uid", unique.ID(ctx, mcIDs)).New()
+	}
+	if appSKey := dev.Session.GetKeys().GetAppSKey(); len(appSKey.GetKey()) > 0 {
+		if !types.MustAES128Key(appSKey.Key).Equal(crypto.DeriveMcAppSKey(*data.McKey, *mcAddr)) {
+			return nil, errMcAppSKeyMismatch.WithAttributes("device_uid", unique.ID(ctx, mcIDs)).New()
+		}
+	}
+	return &mcGroupSetupReq{
+		McGroupID:      data.McGroupID,
+		McAddr:         *mcAddr,
+		McKeyEncrypted: crypto.EncryptMcKey(data.mcKEKey(), *data.McKey),
+		MinMcFCount:    data.MinMcFCount,
+		MaxMcFCount:    data.maxMcFCount(),
+	}, nil
+}
+
+// setSetupState stores the setup state in the data of the association of the end device.
+// If the end device has no association yet, an association is created from the default association.
+func (p *multicastSetupPackage) setSetupState(
+	ctx context.Context, ids *ttnpb.ApplicationPackageAssociationIdentifiers, state *setupState,
+) error {
+	_, err := p.registry.SetAssociation(ctx, ids, []string{"data"},
+		func(assoc *ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error) {
+			paths := []string{"data"}
+			if assoc == nil {
+				assoc = &ttnpb.ApplicationPackageAssociation{
+					Ids:         ids,
+					PackageName: PackageName,
+				}
+				paths = []string{"data", "ids", "package_name"}
+			}
+			if assoc.Data == nil {
+				assoc.Data = &structpb.Struct{}
+			}
+			if assoc.Data.Fields == nil {
+				assoc.Data.Fields = make(map[string]*structpb.Value)
+			}
+			assoc.Data.Fields[setupField] = state.Value()
+			return assoc, paths, nil
+		},
+	)
+	return err
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*multicastSetupPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 200,
+	}
+}
+
+// assocIDs returns the identifiers of the given association. If the association is nil, new identifiers are created.
+func assocIDs(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	ids *ttnpb.EndDeviceIdentifiers,
+) *ttnpb.ApplicationPackageAssociationIdentifiers {
+	assocIDs := assoc.GetIds()
+	if assocIDs == nil {
+		assocIDs = &ttnpb.ApplicationPackageAssociationIdentifiers{
+			EndDeviceIds: ids,
+			FPort:        def.GetIds().GetFPort(),
+		}
+	}
+	return assocIDs
+}
+
+// New returns a new Remote Multicast Setup package.
+func New(server io.Server, registry packages.Registry) packages.ApplicationPackageHandler {
+	return &multicastSetupPackage{
+		server:   server,
+		registry: registry,
+	}
+}
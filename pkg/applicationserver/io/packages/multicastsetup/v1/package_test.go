@@ -0,0 +1,326 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package multicastsetupv1
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gpstime"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errNotFound = errors.DefineNotFound("not_found", "not found")
+
+type mockServer struct {
+	io.Server
+	devices map[string]*ttnpb.EndDevice
+	queue   []*ttnpb.ApplicationDownlink
+}
+
+func (s *mockServer) GetEndDevice(
+	_ context.Context, ids *ttnpb.EndDeviceIdentifiers, _ []string,
+) (*ttnpb.EndDevice, error) {
+	dev, ok := s.devices[ids.DeviceId]
+	if !ok {
+		return nil, errNotFound.New()
+	}
+	return dev, nil
+}
+
+func (s *mockServer) DownlinkQueuePush(
+	_ context.Context, _ *ttnpb.EndDeviceIdentifiers, items []*ttnpb.ApplicationDownlink,
+) error {
+	s.queue = append(s.queue, items...)
+	return nil
+}
+
+func (s *mockServer) DownlinkQueueList(
+	context.Context, *ttnpb.EndDeviceIdentifiers,
+) ([]*ttnpb.ApplicationDownlink, error) {
+	return s.queue, nil
+}
+
+// pop removes the first downlink from the queue, as the Network Server sends it.
+func (s *mockServer) pop() *ttnpb.ApplicationDownlink {
+	down := s.queue[0]
+	s.queue = s.queue[1:]
+	return down
+}
+
+type mockRegistry struct {
+	packages.Registry
+	mu    sync.Mutex
+	assoc *ttnpb.ApplicationPackageAssociation
+}
+
+func (r *mockRegistry) EndDeviceTransaction(
+	ctx context.Context, _ *ttnpb.EndDeviceIdentifiers, _ uint32, _ string, fn func(context.Context) error,
+) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return fn(ctx)
+}
+
+func (r *mockRegistry) GetAssociation(
+	context.Context, *ttnpb.ApplicationPackageAssociationIdentifiers, []string,
+) (*ttnpb.ApplicationPackageAssociation, error) {
+	if r.assoc == nil {
+		return nil, errNotFound.New()
+	}
+	return proto.Clone(r.assoc).(*ttnpb.ApplicationPackageAssociation), nil
+}
+
+func (r *mockRegistry) SetAssociation(
+	_ context.Context,
+	_ *ttnpb.ApplicationPackageAssociationIdentifiers,
+	_ []string,
+	f func(*ttnpb.ApplicationPackageAssociation) (*ttnpb.ApplicationPackageAssociation, []string, error),
+) (*ttnpb.ApplicationPackageAssociation, error) {
+	var stored *ttnpb.ApplicationPackageAssociation
+	if r.assoc != nil {
+		stored = proto.Clone(r.assoc).(*ttnpb.ApplicationPackageAssociation)
+	}
+	assoc, _, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	r.assoc = assoc
+	return assoc, nil
+}
+
+func (r *mockRegistry) state(t *testing.T) *setupState {
+	t.Helper()
+	state := &setupState{}
+	if err := state.fromStruct(r.assoc.GetData()); err != nil {
+		t.Fatalf("Failed to get setup state: %v", err)
+	}
+	return state
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	mcKey := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}
+	appKey := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+	mcAppSKey := crypto.DeriveMcAppSKey(mcKey, mcAddr)
+	newServer := func(appSKey types.AES128Key) *mockServer {
+		return &mockServer{
+			devices: map[string]*ttnpb.EndDevice{
+				"mc-dev": {
+					Ids: &ttnpb.EndDeviceIdentifiers{ApplicationIds: ids.ApplicationIds, DeviceId: "mc-dev"},
+					Session: &ttnpb.Session{
+						DevAddr: mcAddr.Bytes(),
+						Keys: &ttnpb.SessionKeys{
+							AppSKey: &ttnpb.KeyEnvelope{Key: appSKey.Bytes()},
+						},
+					},
+				},
+			},
+		}
+	}
+	sessionTime := time.Now().Add(time.Hour).Truncate(time.Second).UTC()
+	defaultAssociation := func(withSession bool) *ttnpb.ApplicationPackageDefaultAssociation {
+		fields := map[string]*structpb.Value{
+			mcGroupIDField:         structpb.NewNumberValue(1),
+			multicastDeviceIDField: structpb.NewStringValue("mc-dev"),
+			mcKeyField:             structpb.NewStringValue(mcKey.String()),
+			appKeyField:            structpb.NewStringValue(appKey.String()),
+		}
+		if withSession {
+			fields[sessionTimeField] = structpb.NewStringValue(sessionTime.Format(time.RFC3339))
+			fields[frequencyField] = structpb.NewNumberValue(869525000)
+			fields[dataRateIndexField] = structpb.NewNumberValue(3)
+		}
+		return &ttnpb.ApplicationPackageDefaultAssociation{
+			Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+				ApplicationIds: ids.ApplicationIds,
+				FPort:          200,
+			},
+			PackageName: PackageName,
+			Data:        &structpb.Struct{Fields: fields},
+		}
+	}
+	receivedAt := time.Now().Truncate(time.Second)
+	uplink := func(fPort uint32, frmPayload ...byte) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: ids,
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:      fPort,
+				FrmPayload: frmPayload,
+				ReceivedAt: timestamppb.New(receivedAt),
+			}},
+		}
+	}
+	groupSetupReq, err := (&mcGroupSetupReq{
+		McGroupID:      1,
+		McAddr:         mcAddr,
+		McKeyEncrypted: crypto.EncryptMcKey(crypto.DeriveMcKEKey(crypto.DeriveMcRootKey(appKey)), mcKey),
+		MaxMcFCount:    1<<32 - 1,
+	}).MarshalBinary()
+	if err != nil {
+		t.Fatalf("Failed to marshal McGroupSetupReq: %v", err)
+	}
+
+	t.Run("Completed", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(mcAppSKey), &mockRegistry{}
+		pkg := New(server, registry)
+		def := defaultAssociation(true)
+
+		// The group is set up on the first uplink.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1, 0x42)), should.BeNil)
+		if !a.So(server.queue, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		a.So(server.queue[0].FPort, should.Equal, 200)
+		a.So(server.pop().FrmPayload, should.Resemble, groupSetupReq)
+		a.So(registry.state(t).State, should.Equal, groupSetup)
+		a.So(registry.assoc.GetData().GetFields(), should.HaveLength, 1)
+
+		// The class C session is set up when the end device accepts the group.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(200, 0x02, 0x01)), should.BeNil)
+		if !a.So(server.queue, should.HaveLength, 1) {
+			t.FailNow()
+		}
+		sessionReq, err := (&mcClassCSessionReq{
+			McGroupID:      1,
+			SessionTime:    uint32(gpstime.ToGPS(sessionTime) / time.Second),
+			SessionTimeOut: defaultSessionTimeOut,
+			Frequency:      869525000,
+			DataRateIndex:  3,
+		}).MarshalBinary()
+		a.So(err, should.BeNil)
+		a.So(server.pop().FrmPayload, should.Resemble, sessionReq)
+		a.So(registry.state(t).State, should.Equal, sessionSetup)
+
+		// The setup completes when the end device accepts the session.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(200, 0x04, 0x01, 0x10, 0x0e, 0x00)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+		state := registry.state(t)
+		a.So(state.State, should.Equal, setupCompleted)
+		a.So(state.SessionStart.Equal(receivedAt.Add(3600*time.Second)), should.BeTrue)
+
+		// Nothing is sent after the setup completed.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+	})
+
+	t.Run("WithoutSession", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(mcAppSKey), &mockRegistry{}
+		pkg := New(server, registry)
+		def := defaultAssociation(false)
+
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		a.So(server.queue, should.HaveLength, 1)
+		server.pop()
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(200, 0x02, 0x01)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+		a.So(registry.state(t).State, should.Equal, setupCompleted)
+	})
+
+	t.Run("Rejected", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(mcAppSKey), &mockRegistry{}
+		pkg := New(server, registry)
+		def := defaultAssociation(true)
+
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		server.pop()
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(200, 0x02, 0x01)), should.BeNil)
+		server.pop()
+
+		// The end device does not support the frequency.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(200, 0x04, 0x09)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+		a.So(registry.state(t).State, should.Equal, setupFailed)
+	})
+
+	t.Run("Retry", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(mcAppSKey), &mockRegistry{}
+		pkg := New(server, registry)
+		def := defaultAssociation(true)
+
+		for attempt := 1; attempt <= maxAttempts; attempt++ {
+			a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+			if !a.So(server.queue, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(server.pop().FrmPayload, should.Resemble, groupSetupReq)
+			a.So(registry.state(t).Attempts, should.Equal, attempt)
+			for i := 1; i < answerUplinks; i++ {
+				a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+				a.So(server.queue, should.BeEmpty)
+			}
+		}
+
+		// The setup fails when the end device does not answer the last attempt.
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		a.So(server.queue, should.BeEmpty)
+		a.So(registry.state(t).State, should.Equal, setupFailed)
+
+		// A new setup starts when the configuration changes.
+		def.Data.Fields[mcGroupIDField] = structpb.NewNumberValue(2)
+		a.So(pkg.HandleUp(ctx, def, nil, uplink(1)), should.BeNil)
+		a.So(server.queue, should.HaveLength, 1)
+		a.So(registry.state(t).State, should.Equal, groupSetup)
+	})
+
+	t.Run("AppSKeyMismatch", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(types.AES128Key{0x1}), &mockRegistry{}
+		pkg := New(server, registry)
+
+		err := pkg.HandleUp(ctx, defaultAssociation(true), nil, uplink(1))
+		a.So(err, should.HaveSameErrorDefinitionAs, errMcAppSKeyMismatch)
+		a.So(server.queue, should.BeEmpty)
+		a.So(registry.assoc, should.BeNil)
+	})
+
+	t.Run("NoMulticastSession", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		server, registry := newServer(mcAppSKey), &mockRegistry{}
+		server.devices["mc-dev"].Session = nil
+		pkg := New(server, registry)
+
+		err := pkg.HandleUp(ctx, defaultAssociation(true), nil, uplink(1))
+		a.So(err, should.HaveSameErrorDefinitionAs, errNoMulticastSession)
+	})
+}
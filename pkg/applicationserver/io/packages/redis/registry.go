@@ -31,6 +31,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
@@ -119,6 +120,10 @@ func (r *ApplicationPackagesRegistry) transactionKey(uid string, fPort string, p
 	return r.Redis.Key("transaction", uid, fPort, packageName)
 }
 
+func (r *ApplicationPackagesRegistry) stateKey(uid string) string {
+	return r.Redis.Key("state", uid)
+}
+
 func packagesRegex(uid string) (*regexp.Regexp, error) {
 	keyRegex := strings.ReplaceAll(uid, ":", "\\:")
 	keyRegex = strings.ReplaceAll(keyRegex, "*", ".[^\\:]*")
@@ -561,6 +566,59 @@ func (r *ApplicationPackagesRegistry) EndDeviceTransaction(ctx context.Context,
 	return fn(ctx)
 }
 
+// SetState implements applicationpackages.StateRegistry.
+// The states of the packages of an end device are stored as fields of a hash, keyed by the package name.
+func (r *ApplicationPackagesRegistry) SetState(
+	ctx context.Context,
+	ids *ttnpb.EndDeviceIdentifiers,
+	packageName string,
+	f func(*structpb.Struct) (*structpb.Struct, error),
+) (*structpb.Struct, error) {
+	k := r.stateKey(unique.ID(ctx, ids))
+
+	lockerID, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return nil, err
+	}
+
+	defer trace.StartRegion(ctx, "set application package state").End()
+
+	var pb *structpb.Struct
+	err = ttnredis.LockedWatch(ctx, r.Redis, k, lockerID, r.LockTTL, func(tx *redis.Tx) error {
+		stored := &structpb.Struct{}
+		s, err := tx.HGet(ctx, k, packageName).Result()
+		switch {
+		case err == redis.Nil:
+		case err != nil:
+			return ttnredis.ConvertError(err)
+		default:
+			if err := ttnredis.UnmarshalProto(s, stored); err != nil {
+				return err
+			}
+		}
+		pb, err = f(proto.Clone(stored).(*structpb.Struct))
+		if err != nil {
+			return err
+		}
+		if proto.Equal(pb, stored) {
+			return nil
+		}
+		v, err := ttnredis.MarshalProto(pb)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
+			p.HSet(ctx, k, packageName, v)
+			return nil
+		})
+		return err
+	})
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return pb, nil
+}
+
 // Range ranges over the application packages and calls the appropriate callback function, until false is returned.
 func (r ApplicationPackagesRegistry) Range(
 	ctx context.Context, paths []string,
@@ -623,7 +681,7 @@ func (r ApplicationPackagesRegistry) clearAssociations(ctx context.Context, id t
 		for _, fPort := range fPorts {
 			keys = append(keys, r.associationKey(uid, fPort))
 		}
-		keys = append(keys, uidKey)
+		keys = append(keys, uidKey, r.stateKey(uid))
 
 		if _, err := tx.TxPipelined(ctx, func(p redis.Pipeliner) error {
 			p.Del(ctx, keys...)
@@ -235,6 +235,7 @@ type mockApplicationPackagesRegistry struct {
 	packages.AssociationRegistry
 	packages.DefaultAssociationRegistry
 	packages.TransactionRegistry
+	packages.StateRegistry
 
 	RangeFunc          func(ctx context.Context, paths []string, devFunc func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.ApplicationPackageAssociation) bool, appFunc func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationPackageDefaultAssociation) bool) error // nolint: lll
 	WithPaginationFunc func(ctx context.Context, limit uint32, page uint32, total *int64) context.Context
@@ -152,3 +152,57 @@ func TestPackageClearAssociations(t *testing.T) {
 	}
 	a.So(len(actual), should.Equal, 0)
 }
+
+func TestPackageState(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	redisCl, cleanup := test.NewRedis(ctx, "state_test")
+	t.Cleanup(func() {
+		cleanup()
+		if err := redisCl.Close(); err != nil {
+			t.FailNow()
+		}
+	})
+
+	registry, err := NewApplicationPackagesRegistry(ctx, redisCl, 10*time.Second)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	expected := &structpb.Struct{
+		Fields: map[string]*structpb.Value{
+			"key": structpb.NewStringValue("value"),
+		},
+	}
+	actual, err := registry.SetState(ctx, devIDs, "alcsync-v1", func(st *structpb.Struct) (*structpb.Struct, error) {
+		a.So(st.GetFields(), should.BeEmpty)
+		return expected, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(actual, should.Resemble, expected)
+
+	_, err = registry.SetState(ctx, devIDs, "alcsync-v1", func(st *structpb.Struct) (*structpb.Struct, error) {
+		a.So(st, should.Resemble, expected)
+		return st, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = registry.SetState(ctx, devIDs, "fragmentation-v1", func(st *structpb.Struct) (*structpb.Struct, error) {
+		a.So(st.GetFields(), should.BeEmpty)
+		return st, nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	if err := registry.ClearAssociations(ctx, devIDs); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = registry.SetState(ctx, devIDs, "alcsync-v1", func(st *structpb.Struct) (*structpb.Struct, error) {
+		a.So(st.GetFields(), should.BeEmpty)
+		return st, nil
+	})
+	a.So(err, should.BeNil)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // AssociationRegistry is a registry for application package end device associations.
@@ -53,11 +54,25 @@ type TransactionRegistry interface {
 	EndDeviceTransaction(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fPort uint32, packageName string, fn func(ctx context.Context) error) error
 }
 
+// StateRegistry is a registry for the internal state of application packages per end device.
+// The state is kept apart from the associations, so that the data of the associations remains under user control.
+type StateRegistry interface {
+	// SetState updates the state of the package of the end device.
+	// If f returns the state unchanged, the state is not written.
+	SetState(
+		ctx context.Context,
+		ids *ttnpb.EndDeviceIdentifiers,
+		packageName string,
+		f func(*structpb.Struct) (*structpb.Struct, error),
+	) (*structpb.Struct, error)
+}
+
 // Registry is a registry for application packages.
 type Registry interface {
 	AssociationRegistry
 	DefaultAssociationRegistry
 	TransactionRegistry
+	StateRegistry
 	// Range ranges over the application packages and calls the appropriate callback function, until false is returned.
 	Range(
 		ctx context.Context, paths []string,
@@ -0,0 +1,146 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thingsboardv1
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	urlField                   = "url"
+	accessTokenField           = "access_token"
+	provisionDeviceKeyField    = "provision_device_key"
+	provisionDeviceSecretField = "provision_device_secret"
+	deviceNamePrefixField      = "device_name_prefix"
+)
+
+// accessTokenLength is the length of the derived access tokens.
+const accessTokenLength = 20
+
+// packageData is the configuration of the ThingsBoard integration.
+type packageData struct {
+	// URL is the base URL of the ThingsBoard server.
+	URL string
+	// AccessToken is the access token of the ThingsBoard device. If not set, an access token is derived from
+	// the provision device secret, and the device is provisioned with it.
+	AccessToken string
+	// ProvisionDeviceKey and ProvisionDeviceSecret are the credentials of the device profile with which devices
+	// are provisioned.
+	ProvisionDeviceKey    string
+	ProvisionDeviceSecret string
+	// DeviceNamePrefix is prepended to the end device ID to get the name of the provisioned device.
+	DeviceNamePrefix string
+}
+
+func stringFromStruct(fields map[string]*structpb.Value, field string) (string, error) {
+	value, ok := fields[field]
+	if !ok {
+		return "", nil
+	}
+	stringValue, ok := value.GetKind().(*structpb.Value_StringValue)
+	if !ok {
+		return "", errInvalidFieldType.WithAttributes("field", field, "type", "string").New()
+	}
+	return stringValue.StringValue, nil
+}
+
+func (d *packageData) fromStruct(st *structpb.Struct) (err error) {
+	fields := st.GetFields()
+	for _, f := range []struct {
+		field string
+		dst   *string
+	}{
+		{urlField, &d.URL},
+		{accessTokenField, &d.AccessToken},
+		{provisionDeviceKeyField, &d.ProvisionDeviceKey},
+		{provisionDeviceSecretField, &d.ProvisionDeviceSecret},
+		{deviceNamePrefixField, &d.DeviceNamePrefix},
+	} {
+		if *f.dst, err = stringFromStruct(fields, f.field); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks whether the configuration is complete.
+func (d *packageData) validate() error {
+	switch {
+	case d.URL == "":
+		return errMissingField.WithAttributes("field", urlField).New()
+	case d.AccessToken == "" && !d.provisioning():
+		return errNoCredentials.New()
+	}
+	return nil
+}
+
+// provisioning returns whether devices are provisioned.
+func (d *packageData) provisioning() bool {
+	return d.ProvisionDeviceKey != "" && d.ProvisionDeviceSecret != ""
+}
+
+// deviceName returns the name of the ThingsBoard device of the given end device.
+func (d *packageData) deviceName(deviceID string) string {
+	return d.DeviceNamePrefix + deviceID
+}
+
+// accessToken returns the access token of the ThingsBoard device with the given name.
+// If no access token is configured, the access token is derived from the provision device secret, so that it is
+// the same each time the device is provisioned.
+func (d *packageData) accessToken(deviceName string) string {
+	if d.AccessToken != "" {
+		return d.AccessToken
+	}
+	h := hmac.New(sha256.New, []byte(d.ProvisionDeviceSecret))
+	h.Write([]byte(deviceName))
+	return hex.EncodeToString(h.Sum(nil))[:accessTokenLength]
+}
+
+func mergePackageData(
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+) (*packageData, error) {
+	var defaultData, associationData packageData
+	if err := defaultData.fromStruct(def.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err).New()
+	}
+	if err := associationData.fromStruct(assoc.GetData()); err != nil {
+		return nil, errPkgDataMerge.WithCause(err).New()
+	}
+
+	merged := &packageData{}
+	for _, data := range []packageData{defaultData, associationData} {
+		for _, f := range []struct {
+			src string
+			dst *string
+		}{
+			{data.URL, &merged.URL},
+			{data.AccessToken, &merged.AccessToken},
+			{data.ProvisionDeviceKey, &merged.ProvisionDeviceKey},
+			{data.ProvisionDeviceSecret, &merged.ProvisionDeviceSecret},
+			{data.DeviceNamePrefix, &merged.DeviceNamePrefix},
+		} {
+			if f.src != "" {
+				*f.dst = f.src
+			}
+		}
+	}
+	return merged, nil
+}
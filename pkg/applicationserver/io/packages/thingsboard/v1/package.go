@@ -0,0 +1,208 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package thingsboardv1 implements the ThingsBoard integration as application package.
+package thingsboardv1
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	stdio "io"
+	"net/http"
+	"strings"
+	"unicode/utf8"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/packages"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// PackageName is the name of the package.
+const PackageName = "thingsboard-v1"
+
+const (
+	// maxResponseSize is the maximum size of the response body that is read.
+	maxResponseSize = 1 << 16
+	// maxErrorBodySize is the maximum size of the response body that is included in errors.
+	maxErrorBodySize = 1 << 10
+)
+
+// thingsBoardPackage is the ThingsBoard integration.
+// The decoded payload of uplink messages is sent as telemetry of the ThingsBoard device of the end device using the
+// HTTP device API. If the device does not exist, it is created with the device provisioning API, with an access token
+// that is derived from the provision device secret. The FPort of the association is not used, as all uplinks are
+// sent.
+type thingsBoardPackage struct {
+	server io.Server
+}
+
+// HandleUp implements packages.ApplicationPackageHandler.
+func (p *thingsBoardPackage) HandleUp(
+	ctx context.Context,
+	def *ttnpb.ApplicationPackageDefaultAssociation,
+	assoc *ttnpb.ApplicationPackageAssociation,
+	up *ttnpb.ApplicationUp,
+) (err error) {
+	ctx = log.NewContextWithField(ctx, "namespace", "applicationserver/io/packages/thingsboard/v1")
+	logger := log.FromContext(ctx)
+
+	if def == nil && assoc == nil {
+		logger.Error("No association available")
+		return errNoAssociation.New()
+	}
+
+	msg := up.GetUplinkMessage()
+	if len(msg.GetDecodedPayload().GetFields()) == 0 {
+		return nil
+	}
+
+	eventBuilders := make(events.Builders, 0)
+	defer func() {
+		if err != nil {
+			eventBuilders = append(eventBuilders, EvtPkgFail.With(events.WithData(err)))
+		}
+		publishEvents(ctx, up.EndDeviceIds, eventBuilders...)
+	}()
+
+	data, err := mergePackageData(def, assoc)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to merge package data")
+		return err
+	}
+	if err := data.validate(); err != nil {
+		logger.WithError(err).Debug("Invalid package data")
+		return err
+	}
+
+	deviceName := data.deviceName(up.EndDeviceIds.DeviceId)
+	accessToken := data.accessToken(deviceName)
+	telemetry := map[string]any{
+		"ts":     msg.GetReceivedAt().AsTime().UnixMilli(),
+		"values": msg.DecodedPayload.AsMap(),
+	}
+	telemetryPath := fmt.Sprintf("api/v1/%s/telemetry", accessToken)
+	status, err := p.post(ctx, data, telemetryPath, telemetry, nil)
+	switch {
+	case err == nil:
+		logger.Debug("Telemetry sent to ThingsBoard")
+		return nil
+	case status != http.StatusUnauthorized || data.AccessToken != "" || !data.provisioning():
+		logger.WithError(err).Debug("Failed to send telemetry")
+		return err
+	}
+
+	// The derived access token is unknown, so the device does not exist yet.
+	if err := p.provision(ctx, data, deviceName, accessToken); err != nil {
+		logger.WithError(err).Debug("Failed to provision device")
+		return err
+	}
+	eventBuilders = append(eventBuilders, EvtDeviceProvision.With(events.WithData(map[string]string{
+		"device_name": deviceName,
+	})))
+	if _, err := p.post(ctx, data, telemetryPath, telemetry, nil); err != nil {
+		logger.WithError(err).Debug("Failed to send telemetry")
+		return err
+	}
+	logger.Debug("Telemetry sent to ThingsBoard")
+	return nil
+}
+
+// provisionResponse is the response of the device provisioning API.
+type provisionResponse struct {
+	Status           string `json:"status"`
+	CredentialsType  string `json:"credentialsType"`
+	CredentialsValue string `json:"credentialsValue"`
+	ErrorMsg         string `json:"errorMsg"`
+}
+
+// provision creates the ThingsBoard device with the given name and access token.
+func (p *thingsBoardPackage) provision(ctx context.Context, data *packageData, deviceName, accessToken string) error {
+	res := &provisionResponse{}
+	if _, err := p.post(ctx, data, "api/v1/provision", map[string]any{
+		"deviceName":            deviceName,
+		"provisionDeviceKey":    data.ProvisionDeviceKey,
+		"provisionDeviceSecret": data.ProvisionDeviceSecret,
+		"credentialsType":       "ACCESS_TOKEN",
+		"token":                 accessToken,
+	}, res); err != nil {
+		return err
+	}
+	if res.Status != "SUCCESS" {
+		return errProvision.WithAttributes("device_name", deviceName, "message", res.ErrorMsg).New()
+	}
+	log.FromContext(ctx).WithField("device_name", deviceName).Info("Provisioned device in ThingsBoard")
+	return nil
+}
+
+// post sends the request as JSON to the given path of the ThingsBoard server, and decodes the response in res if
+// it is not nil. The status code is returned.
+func (p *thingsBoardPackage) post(ctx context.Context, data *packageData, path string, req, res any) (int, error) {
+	b, err := json.Marshal(req)
+	if err != nil {
+		return 0, err
+	}
+	u := fmt.Sprintf("%s/%s", strings.TrimSuffix(data.URL, "/"), path)
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return 0, err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	client, err := p.server.HTTPClient(ctx)
+	if err != nil {
+		return 0, err
+	}
+	httpRes, err := client.Do(httpReq)
+	if err != nil {
+		return 0, err
+	}
+	defer httpRes.Body.Close()
+	defer stdio.Copy(stdio.Discard, httpRes.Body) // nolint:errcheck
+	reader := stdio.LimitReader(httpRes.Body, maxResponseSize)
+	if httpRes.StatusCode < 200 || httpRes.StatusCode > 299 {
+		body, _ := stdio.ReadAll(stdio.LimitReader(reader, maxErrorBodySize))
+		if !utf8.Valid(body) {
+			body = nil
+		}
+		return httpRes.StatusCode, errRequest.WithAttributes(
+			"status_code", httpRes.StatusCode,
+			"body", string(body),
+		).New()
+	}
+	if res != nil {
+		if err := json.NewDecoder(reader).Decode(res); err != nil {
+			return httpRes.StatusCode, err
+		}
+	}
+	return httpRes.StatusCode, nil
+}
+
+// Package implements packages.ApplicationPackageHandler.
+func (*thingsBoardPackage) Package() *ttnpb.ApplicationPackage {
+	return &ttnpb.ApplicationPackage{
+		Name:         PackageName,
+		DefaultFPort: 1,
+	}
+}
+
+// New returns a new ThingsBoard package.
+func New(server io.Server, _ packages.Registry) packages.ApplicationPackageHandler {
+	return &thingsBoardPackage{
+		server: server,
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package thingsboardv1
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockServer struct {
+	io.Server
+}
+
+func (*mockServer) HTTPClient(context.Context, ...httpclient.Option) (*http.Client, error) {
+	return http.DefaultClient, nil
+}
+
+// mockThingsBoard implements the device provisioning and telemetry HTTP device API of ThingsBoard.
+type mockThingsBoard struct {
+	mu        sync.Mutex
+	devices   map[string]string // Access token to device name.
+	telemetry map[string][]map[string]any
+}
+
+func (tb *mockThingsBoard) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	tb.mu.Lock()
+	defer tb.mu.Unlock()
+	var body map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if r.URL.Path == "/api/v1/provision" {
+		res := map[string]any{"status": "FAILURE", "errorMsg": "Provision data was not found!"}
+		if body["provisionDeviceKey"] == "test-key" && body["provisionDeviceSecret"] == "test-secret" {
+			token := body["token"].(string)
+			tb.devices[token] = body["deviceName"].(string)
+			res = map[string]any{"status": "SUCCESS", "credentialsType": "ACCESS_TOKEN", "credentialsValue": token}
+		}
+		json.NewEncoder(w).Encode(res) //nolint:errcheck
+		return
+	}
+	token := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v1/"), "/telemetry")
+	name, ok := tb.devices[token]
+	if !ok {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	tb.telemetry[name] = append(tb.telemetry[name], body)
+}
+
+func TestHandleUp(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	tb := &mockThingsBoard{
+		devices:   map[string]string{"existing-token": "existing-dev"},
+		telemetry: make(map[string][]map[string]any),
+	}
+	srv := httptest.NewServer(tb)
+	defer srv.Close()
+
+	pkg := New(&mockServer{}, nil)
+	def := &ttnpb.ApplicationPackageDefaultAssociation{
+		Ids: &ttnpb.ApplicationPackageDefaultAssociationIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			FPort:          1,
+		},
+		PackageName: PackageName,
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			urlField:                   structpb.NewStringValue(srv.URL),
+			provisionDeviceKeyField:    structpb.NewStringValue("test-key"),
+			provisionDeviceSecretField: structpb.NewStringValue("test-secret"),
+			deviceNamePrefixField:      structpb.NewStringValue("tts-"),
+		}},
+	}
+	decodedPayload, err := structpb.NewStruct(map[string]any{"temperature": 21.5})
+	a.So(err, should.BeNil)
+	uplink := func(deviceID string) *ttnpb.ApplicationUp {
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: def.Ids.ApplicationIds,
+				DeviceId:       deviceID,
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort:          2,
+				DecodedPayload: decodedPayload,
+				ReceivedAt:     timestamppb.New(time.UnixMilli(1700000000123)),
+			}},
+		}
+	}
+	expected := map[string]any{
+		"ts":     1700000000123.0,
+		"values": map[string]any{"temperature": 21.5},
+	}
+
+	// The device is provisioned on the first uplink.
+	a.So(pkg.HandleUp(ctx, def, nil, uplink("test-dev")), should.BeNil)
+	a.So(tb.devices, should.HaveLength, 2)
+	a.So(tb.telemetry["tts-test-dev"], should.Resemble, []map[string]any{expected})
+
+	// The device is not provisioned again.
+	a.So(pkg.HandleUp(ctx, def, nil, uplink("test-dev")), should.BeNil)
+	a.So(tb.devices, should.HaveLength, 2)
+	a.So(tb.telemetry["tts-test-dev"], should.HaveLength, 2)
+
+	// The access token of the association is used if configured.
+	assoc := &ttnpb.ApplicationPackageAssociation{
+		Data: &structpb.Struct{Fields: map[string]*structpb.Value{
+			accessTokenField: structpb.NewStringValue("existing-token"),
+		}},
+	}
+	a.So(pkg.HandleUp(ctx, def, assoc, uplink("other-dev")), should.BeNil)
+	a.So(tb.telemetry["existing-dev"], should.Resemble, []map[string]any{expected})
+
+	// Unknown access tokens of the association are not provisioned.
+	assoc.Data.Fields[accessTokenField] = structpb.NewStringValue("unknown-token")
+	a.So(pkg.HandleUp(ctx, def, assoc, uplink("other-dev")), should.HaveSameErrorDefinitionAs, errRequest)
+
+	// Provisioning fails with invalid credentials.
+	def.Data.Fields[provisionDeviceSecretField] = structpb.NewStringValue("other-secret")
+	a.So(pkg.HandleUp(ctx, def, nil, uplink("new-dev")), should.HaveSameErrorDefinitionAs, errProvision)
+
+	// Incomplete configurations are rejected.
+	delete(def.Data.Fields, provisionDeviceKeyField)
+	a.So(pkg.HandleUp(ctx, def, nil, uplink("new-dev")), should.HaveSameErrorDefinitionAs, errNoCredentials)
+}
+
+func TestAccessToken(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	data := &packageData{ProvisionDeviceSecret: "secret"}
+	token := data.accessToken("dev-1")
+	a.So(token, should.HaveLength, accessTokenLength)
+	a.So(data.accessToken("dev-1"), should.Equal, token)
+	a.So(data.accessToken("dev-2"), should.NotEqual, token)
+	a.So((&packageData{ProvisionDeviceSecret: "other"}).accessToken("dev-1"), should.NotEqual, token)
+	a.So((&packageData{AccessToken: "configured"}).accessToken("dev-1"), should.Equal, "configured")
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errFPortFiltersNotConfigured = errors.DefineFailedPrecondition(
+	"fport_filters_not_configured", "FPort filters are not configured",
+)
+
+// WithFPortFilters configures the registry of the FPort filters of the pub/sub integrations. Upstream messages are
+// only published by the integrations whose FPort filter matches.
+func WithFPortFilters(filters fportfilter.Registry) Option {
+	return func(ps *PubSub) {
+		ps.fPortFilters = filters
+	}
+}
+
+// fPortFilter returns the FPort filter of the integration, or nil if the integration has no filter.
+func (ps *PubSub) fPortFilter(
+	ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers,
+) (*fportfilter.Filter, error) {
+	if ps.fPortFilters == nil {
+		return nil, nil
+	}
+	filter, err := ps.fPortFilters.Get(ctx, ids.ApplicationIds, fportfilter.PubSub(ids.PubSubId))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return filter, nil
+}
+
+// SetFPortFilter sets the FPort filter of the integration and restarts the integration on this Application Server
+// instance. If filter is nil, the filter is deleted.
+func (ps *PubSub) SetFPortFilter(
+	ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers, filter *fportfilter.Filter,
+) error {
+	if ps.fPortFilters == nil {
+		return errFPortFiltersNotConfigured.New()
+	}
+	if err := ps.fPortFilters.Set(ctx, ids.ApplicationIds, fportfilter.PubSub(ids.PubSubId), filter); err != nil {
+		return err
+	}
+	if err := ps.stop(ctx, ids); err != nil {
+		log.FromContext(ctx).WithFields(log.Fields(
+			"application_uid", unique.ID(ctx, ids.ApplicationIds),
+			"pub_sub_id", ids.PubSubId,
+		)).WithError(err).Warn("Failed to cancel pub/sub")
+	}
+	ps.startTask(ps.ctx, ids)
+	return nil
+}
+
+// deleteFPortFilter deletes the FPort filter of the integration, if any.
+func (ps *PubSub) deleteFPortFilter(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) error {
+	if ps.fPortFilters == nil {
+		return nil
+	}
+	return ps.fPortFilters.Set(ctx, ids.ApplicationIds, fportfilter.PubSub(ids.PubSubId), nil)
+}
@@ -111,6 +111,8 @@ func (ps *PubSub) Set(ctx context.Context, req *ttnpb.SetApplicationPubSubReques
 			"pub_sub_id", req.Pubsub.Ids.PubSubId,
 		)).WithError(err).Warn("Failed to cancel pub/sub")
 	}
+	// Updating the pub/sub enables it again if it was disabled after consecutive failures.
+	ps.resetHealth(PubSubUID(unique.ID(ctx, req.Pubsub.Ids.ApplicationIds), req.Pubsub.Ids.PubSubId))
 	ps.startTask(ps.ctx, req.Pubsub.Ids)
 	events.Publish(evtSetPubSub.NewWithIdentifiersAndData(ctx, req.Pubsub.Ids.ApplicationIds, req.Pubsub.Ids))
 	return pubsub, nil
@@ -131,6 +133,7 @@ func (ps *PubSub) Delete(ctx context.Context, ids *ttnpb.ApplicationPubSubIdenti
 			"pub_sub_id", ids.PubSubId,
 		)).WithError(err).Warn("Failed to cancel pub/sub")
 	}
+	ps.resetHealth(PubSubUID(unique.ID(ctx, ids.ApplicationIds), ids.PubSubId))
 	_, err := ps.registry.Set(ctx, ids, nil,
 		func(pubsub *ttnpb.ApplicationPubSub) (*ttnpb.ApplicationPubSub, []string, error) {
 			return nil, nil, nil
@@ -139,6 +142,9 @@ func (ps *PubSub) Delete(ctx context.Context, ids *ttnpb.ApplicationPubSubIdenti
 	if err != nil {
 		return nil, err
 	}
+	if err := ps.deleteFPortFilter(ctx, ids); err != nil {
+		return nil, err
+	}
 	events.Publish(evtDeletePubSub.NewWithIdentifiersAndData(ctx, ids.ApplicationIds, ids))
 	return ttnpb.Empty, nil
 }
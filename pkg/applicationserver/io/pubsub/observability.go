@@ -76,6 +76,24 @@ var (
 		),
 		events.WithErrorDataType(),
 	)
+	evtPubSubUnhealthy = events.Define(
+		"as.pubsub.unhealthy", "pub/sub connection unhealthy",
+		events.WithVisibility(
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		),
+		withIdentifiersOption,
+	)
+	evtPubSubDisable = events.Define(
+		"as.pubsub.disable", "disable pub/sub after consecutive failures",
+		events.WithVisibility(
+			ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		),
+		events.WithErrorDataType(),
+	)
 )
 
 const (
@@ -109,6 +127,22 @@ var pubsubMetrics = &integrationsMetrics{
 		},
 		[]string{providerLabel},
 	),
+	integrationsUnhealthy: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "integrations_unhealthy_total",
+			Help:      "Number of integrations restarted because of an unhealthy connection",
+		},
+		[]string{providerLabel},
+	),
+	integrationsDisabled: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "integrations_disabled_total",
+			Help:      "Number of integrations disabled after consecutive failures",
+		},
+		[]string{providerLabel},
+	),
 }
 
 func init() {
@@ -116,27 +150,37 @@ func init() {
 }
 
 type integrationsMetrics struct {
-	integrationsStarted *metrics.ContextualCounterVec
-	integrationsStopped *metrics.ContextualCounterVec
-	integrationsFailed  *metrics.ContextualCounterVec
+	integrationsStarted   *metrics.ContextualCounterVec
+	integrationsStopped   *metrics.ContextualCounterVec
+	integrationsFailed    *metrics.ContextualCounterVec
+	integrationsUnhealthy *metrics.ContextualCounterVec
+	integrationsDisabled  *metrics.ContextualCounterVec
 }
 
 func (m integrationsMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.integrationsStarted.Describe(ch)
 	m.integrationsStopped.Describe(ch)
 	m.integrationsFailed.Describe(ch)
+	m.integrationsUnhealthy.Describe(ch)
+	m.integrationsDisabled.Describe(ch)
 }
 
 func (m integrationsMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.integrationsStarted.Collect(ch)
 	m.integrationsStopped.Collect(ch)
 	m.integrationsFailed.Collect(ch)
+	m.integrationsUnhealthy.Collect(ch)
+	m.integrationsDisabled.Collect(ch)
 }
 
 var psTypeName = fmt.Sprintf("%T", &ttnpb.ApplicationPubSub{})
 
 func providerLabelValue(i *integration) string {
-	return strings.ToLower(strings.TrimPrefix(fmt.Sprintf("%T", i.ApplicationPubSub.GetProvider()), psTypeName+"_"))
+	return pubSubProviderLabelValue(i.ApplicationPubSub)
+}
+
+func pubSubProviderLabelValue(pb *ttnpb.ApplicationPubSub) string {
+	return strings.ToLower(strings.TrimPrefix(fmt.Sprintf("%T", pb.GetProvider()), psTypeName+"_"))
 }
 
 func registerIntegrationStart(ctx context.Context, i *integration) {
@@ -163,3 +207,23 @@ func registerIntegrationFail(ctx context.Context, i *integration, err error) {
 	events.Publish(evtPubSubFail.NewWithIdentifiersAndData(ctx, i.Ids.ApplicationIds, err))
 	pubsubMetrics.integrationsFailed.WithLabelValues(ctx, providerLabelValue(i)).Inc()
 }
+
+func registerIntegrationUnhealthy(ctx context.Context, i *integration) {
+	events.Publish(evtPubSubUnhealthy.NewWithIdentifiersAndData(ctx, i.Ids.ApplicationIds, i.Ids))
+	pubsubMetrics.integrationsUnhealthy.WithLabelValues(ctx, providerLabelValue(i)).Inc()
+}
+
+var errIntegrationDisabled = errors.DefineAborted(
+	"integration_disabled", "integration `{pub_sub_id}` disabled after consecutive failures",
+)
+
+func registerIntegrationDisable(ctx context.Context, pb *ttnpb.ApplicationPubSub, err error) {
+	err = errIntegrationDisabled.
+		WithAttributes(
+			"application_uid", unique.ID(ctx, pb.Ids.ApplicationIds),
+			"pub_sub_id", pb.Ids.PubSubId,
+		).
+		WithCause(err)
+	events.Publish(evtPubSubDisable.NewWithIdentifiersAndData(ctx, pb.Ids.ApplicationIds, err))
+	pubsubMetrics.integrationsDisabled.WithLabelValues(ctx, pubSubProviderLabelValue(pb)).Inc()
+}
@@ -81,6 +81,12 @@ type ProviderConnection interface { //nolint:revive
 	Shutdowner
 }
 
+// HealthChecker is implemented by provider connections that can report the health of the connection to the server.
+type HealthChecker interface {
+	// Healthy returns whether the connection to the server is usable.
+	Healthy() bool
+}
+
 // Connection is a wrapper that wraps the topics and subscriptions with a ProviderConnection.
 type Connection struct {
 	Topics             UplinkTopics
@@ -17,6 +17,7 @@ package mock
 
 import (
 	"context"
+	"sync/atomic"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
@@ -58,6 +59,18 @@ type Connection struct {
 	DownlinkQueueInvalidated *pubsub.Subscription
 	LocationSolved           *pubsub.Subscription
 	ServiceData              *pubsub.Subscription
+
+	unhealthy atomic.Bool
+}
+
+// SetHealthy sets the health of the connection reported to the pub/sub frontend.
+func (c *Connection) SetHealthy(healthy bool) {
+	c.unhealthy.Store(!healthy)
+}
+
+// Healthy implements provider.HealthChecker.
+func (c *Connection) Healthy() bool {
+	return !c.unhealthy.Load()
 }
 
 // ApplicationPubSubIdentifiers returns the identifiers of the connection.
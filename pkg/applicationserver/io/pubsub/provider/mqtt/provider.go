@@ -20,6 +20,7 @@ import (
 	"crypto/tls"
 	"net/http"
 	"net/url"
+	"sync/atomic"
 	"time"
 
 	mqtt_topic "github.com/TheThingsIndustries/mystique/pkg/topic"
@@ -37,6 +38,7 @@ type impl struct{}
 
 type connection struct {
 	mqtt.Client
+	lost atomic.Bool
 }
 
 // Shutdown implements provider.Shutdowner.
@@ -45,6 +47,13 @@ func (c *connection) Shutdown(_ context.Context) error {
 	return nil
 }
 
+// Healthy implements provider.HealthChecker.
+// The connection is unhealthy once the connection to the server is lost, as the subscriptions are not restored
+// when the client reconnects.
+func (c *connection) Healthy() bool {
+	return !c.lost.Load() && c.IsConnectionOpen()
+}
+
 var errConnectFailed = errors.Define("connect_failed", "connection to MQTT server failed")
 
 // OpenConnection implements provider.Provider using the MQTT driver.
@@ -138,8 +147,10 @@ func OpenConnection(ctx context.Context, settings Settings, topics provider.Topi
 	clientOpts.SetOnConnectHandler(func(_ mqtt.Client) {
 		logger.Info("Connected to MQTT server")
 	})
+	conn := &connection{}
 	clientOpts.SetConnectionLostHandler(func(_ mqtt.Client, err error) {
 		logger.WithError(err).Info("Disconnected from MQTT server")
+		conn.lost.Store(true)
 	})
 	clientOpts.SetReconnectingHandler(func(_ mqtt.Client, clientOpts *mqtt.ClientOptions) {
 		logger.Info("Reconnect to MQTT server")
@@ -154,6 +165,7 @@ func OpenConnection(ctx context.Context, settings Settings, topics provider.Topi
 	})
 
 	client := mqtt.NewClient(clientOpts)
+	conn.Client = client
 	token := client.Connect()
 	defer func() {
 		if err != nil {
@@ -164,9 +176,7 @@ func OpenConnection(ctx context.Context, settings Settings, topics provider.Topi
 		return nil, errConnectFailed.WithCause(err)
 	}
 	pc = &provider.Connection{
-		ProviderConnection: &connection{
-			Client: client,
-		},
+		ProviderConnection: conn,
 	}
 	for _, t := range []struct {
 		topic   **pubsub.Topic
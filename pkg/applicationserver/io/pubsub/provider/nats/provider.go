@@ -37,6 +37,11 @@ func (c *connection) Shutdown(_ context.Context) error {
 	return nil
 }
 
+// Healthy implements provider.HealthChecker.
+func (c *connection) Healthy() bool {
+	return c.IsConnected()
+}
+
 // OpenConnection implements provider.Provider using the natspubsub package.
 func (impl) OpenConnection(ctx context.Context, target provider.Target, enabler provider.Enabler) (pc *provider.Connection, err error) {
 	settings, ok := target.GetProvider().(*ttnpb.ApplicationPubSub_Nats)
@@ -19,8 +19,11 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
@@ -42,8 +45,11 @@ type PubSub struct {
 	registry Registry
 
 	integrations sync.Map
+	health       sync.Map // string -> *integrationHealth
 
 	providerStatuses ProviderStatuses
+	supervision      SupervisionConfig
+	fPortFilters     fportfilter.Registry
 }
 
 // New creates a new pusub frontend.
@@ -52,6 +58,7 @@ func New(
 	server io.Server,
 	registry Registry,
 	providerStatuses ProviderStatuses,
+	opts ...Option,
 ) (*PubSub, error) {
 	ctx := log.NewContextWithField(c.Context(), "namespace", "applicationserver/io/pubsub")
 	ps := &PubSub{
@@ -62,6 +69,9 @@ func New(
 
 		providerStatuses: providerStatuses,
 	}
+	for _, opt := range opts {
+		opt(ps)
+	}
 	ps.RegisterTask(&task.Config{
 		Context: ctx,
 		ID:      "pubsubs_start_all",
@@ -82,14 +92,22 @@ func (ps *PubSub) startAll(ctx context.Context) error {
 }
 
 func (ps *PubSub) startTask(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) {
+	appUID := unique.ID(ctx, ids.ApplicationIds)
+	psUID := PubSubUID(appUID, ids.PubSubId)
 	ctx = log.NewContextWithFields(ctx, log.Fields(
-		"application_uid", unique.ID(ctx, ids.ApplicationIds),
+		"application_uid", appUID,
 		"pub_sub_id", ids.PubSubId,
 	))
 	ps.StartTask(&task.Config{
 		Context: ctx,
 		ID:      "pubsub",
 		Func: func(ctx context.Context) error {
+			health := ps.integrationHealth(psUID)
+			if health.isDisabled() {
+				log.FromContext(ctx).Debug("Pub/sub disabled")
+				return nil
+			}
+
 			target, err := ps.registry.Get(ctx, ids, ttnpb.ApplicationPubSubFieldPathsNested)
 			if err != nil && !errors.IsNotFound(err) {
 				return err
@@ -103,24 +121,37 @@ func (ps *PubSub) startTask(ctx context.Context, ids *ttnpb.ApplicationPubSubIde
 				return nil
 			}
 
-			return ps.start(ctx, target)
+			err = ps.start(ctx, target)
+			if err == nil || errors.IsCanceled(err) {
+				return err
+			}
+			if health.failed(err, ps.supervision.MaxConsecutiveFailures) {
+				log.FromContext(ctx).WithError(err).Warn("Pub/sub disabled after consecutive failures")
+				registerIntegrationDisable(ctx, target, err)
+				return nil
+			}
+			return err
 		},
 		Restart: task.RestartOnFailure,
-		Backoff: io.DialTaskBackoffConfig,
+		Backoff: ps.supervision.backoff(func() int {
+			return ps.integrationHealth(psUID).consecutiveFailureCount()
+		}),
 	})
 }
 
 type integration struct {
 	*ttnpb.ApplicationPubSub
-	ctx    context.Context
-	cancel errorcontext.CancelFunc
-	closed chan struct{}
+	ctx       context.Context
+	cancel    errorcontext.CancelFunc
+	closed    chan struct{}
+	connected atomic.Bool
 
 	conn *provider.Connection
 
-	server io.Server
-	sub    *io.Subscription
-	format Format
+	server      io.Server
+	sub         *io.Subscription
+	format      Format
+	fPortFilter *fportfilter.Filter
 }
 
 func (i *integration) handleUp(ctx context.Context) {
@@ -131,6 +162,9 @@ func (i *integration) handleUp(ctx context.Context) {
 			logger.WithError(ctx.Err()).Debug("Done sending upstream messages")
 			return
 		case up := <-i.sub.Up():
+			if !i.fPortFilter.Match(up.ApplicationUp) {
+				continue
+			}
 			var topic *pubsub.Topic
 			switch up.ApplicationUp.Up.(type) {
 			case *ttnpb.ApplicationUp_UplinkMessage:
@@ -314,9 +348,15 @@ func (ps *PubSub) start(ctx context.Context, pb *ttnpb.ApplicationPubSub) (err e
 	if i.format, ok = formats[pb.Format]; !ok {
 		return errFormatNotFound.WithAttributes("format", pb.Format)
 	}
+	if i.fPortFilter, err = ps.fPortFilter(ctx, pb.Ids); err != nil {
+		return err
+	}
 
 	go i.handleUp(ctx)
 	i.startHandleDown(ctx)
+	go i.supervise(ctx, ps.supervision.HealthCheckInterval)
+	i.connected.Store(true)
+	ps.integrationHealth(psUID).started()
 	logger.Info("Pub/sub started")
 	registerIntegrationStart(ctx, i)
 	defer func() {
@@ -342,3 +382,42 @@ func (ps *PubSub) stop(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifi
 	}
 	return nil
 }
+
+// Status is the status of a pub/sub integration on this Application Server instance.
+type Status struct {
+	// Connected indicates whether the pub/sub integration is connected to the provider.
+	Connected bool
+	// LastError is the last error that stopped the pub/sub integration, if any.
+	LastError error
+	// LastErrorAt is the time of the last error.
+	LastErrorAt time.Time
+	// Failures is the number of failures of the pub/sub integration.
+	Failures int
+	// ConsecutiveFailures is the number of failures since the pub/sub integration last started.
+	ConsecutiveFailures int
+	// Disabled indicates whether the pub/sub integration is disabled after too many consecutive failures.
+	Disabled bool
+}
+
+// Status returns the status of the pub/sub integration on this Application Server instance.
+func (ps *PubSub) Status(ctx context.Context, ids *ttnpb.ApplicationPubSubIdentifiers) Status {
+	psUID := PubSubUID(unique.ID(ctx, ids.ApplicationIds), ids.PubSubId)
+	var status Status
+	if val, ok := ps.integrations.Load(psUID); ok {
+		status.Connected = val.(*integration).connected.Load()
+	}
+	if val, ok := ps.health.Load(psUID); ok {
+		h := val.(*integrationHealth)
+		h.mu.Lock()
+		status.LastError, status.LastErrorAt = h.lastErr, h.lastErrAt
+		status.Failures, status.ConsecutiveFailures = h.failures, h.consecutiveFailures
+		status.Disabled = h.disabled
+		h.mu.Unlock()
+	}
+	return status
+}
+
+// Registry returns the pub/sub registry.
+func (ps *PubSub) Registry() Registry {
+	return ps.registry
+}
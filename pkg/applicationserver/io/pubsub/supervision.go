@@ -0,0 +1,156 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+// unhealthyChecksThreshold is the number of consecutive failed health checks after which the connection is restarted.
+// This allows providers to recover from short disconnections by themselves.
+const unhealthyChecksThreshold = 2
+
+// SupervisionConfig is the configuration of the supervision of the pub/sub integration connections.
+type SupervisionConfig struct {
+	HealthCheckInterval    time.Duration `name:"health-check-interval" description:"Interval at which the pub/sub connections are checked, and restarted when unhealthy (0 is disabled)"`                  //nolint:lll
+	MinBackoff             time.Duration `name:"min-backoff" description:"Initial interval between restarts of a failed pub/sub integration, which doubles on each consecutive failure"`                   //nolint:lll
+	MaxBackoff             time.Duration `name:"max-backoff" description:"Maximum interval between restarts of a failed pub/sub integration"`                                                              //nolint:lll
+	MaxConsecutiveFailures int           `name:"max-consecutive-failures" description:"Number of consecutive failures after which a pub/sub integration is disabled until it is updated (0 is unlimited)"` //nolint:lll
+}
+
+// Option configures the PubSub frontend.
+type Option func(*PubSub)
+
+// WithSupervision configures the supervision of the pub/sub integration connections.
+func WithSupervision(config SupervisionConfig) Option {
+	return func(ps *PubSub) {
+		ps.supervision = config
+	}
+}
+
+// backoff returns the backoff configuration of an integration task, based on its consecutive failures.
+// If no minimum backoff is configured, io.DialTaskBackoffConfig is used.
+func (c SupervisionConfig) backoff(consecutiveFailures func() int) *task.BackoffConfig {
+	if c.MinBackoff <= 0 {
+		return io.DialTaskBackoffConfig
+	}
+	return &task.BackoffConfig{
+		Jitter: task.DefaultBackoffJitter,
+		IntervalFunc: func(context.Context, time.Duration, uint, error) time.Duration {
+			interval := c.MinBackoff
+			for n := consecutiveFailures(); n > 1; n-- {
+				interval *= 2
+				if c.MaxBackoff > 0 && interval >= c.MaxBackoff {
+					return c.MaxBackoff
+				}
+			}
+			return interval
+		},
+	}
+}
+
+// integrationHealth is the health of a pub/sub integration on this Application Server instance.
+type integrationHealth struct {
+	mu                  sync.Mutex
+	failures            int
+	consecutiveFailures int
+	disabled            bool
+	lastErr             error
+	lastErrAt           time.Time
+}
+
+func (h *integrationHealth) consecutiveFailureCount() int {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.consecutiveFailures
+}
+
+func (h *integrationHealth) isDisabled() bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.disabled
+}
+
+// started resets the consecutive failures.
+func (h *integrationHealth) started() {
+	h.mu.Lock()
+	h.consecutiveFailures = 0
+	h.mu.Unlock()
+}
+
+// failed records the failure and returns whether the integration should be disabled.
+func (h *integrationHealth) failed(err error, maxConsecutiveFailures int) bool {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.failures++
+	h.consecutiveFailures++
+	h.lastErr, h.lastErrAt = err, time.Now()
+	if maxConsecutiveFailures > 0 && h.consecutiveFailures >= maxConsecutiveFailures {
+		h.disabled = true
+	}
+	return h.disabled
+}
+
+func (ps *PubSub) integrationHealth(psUID string) *integrationHealth {
+	val, _ := ps.health.LoadOrStore(psUID, &integrationHealth{})
+	return val.(*integrationHealth)
+}
+
+// resetHealth resets the health of the integration, which enables it again if it was disabled.
+func (ps *PubSub) resetHealth(psUID string) {
+	ps.health.Delete(psUID)
+}
+
+var errConnectionUnhealthy = errors.DefineUnavailable("connection_unhealthy", "pub/sub connection unhealthy")
+
+// supervise checks the health of the provider connection periodically, and cancels the integration when the
+// connection is unhealthy, so that it is restarted.
+func (i *integration) supervise(ctx context.Context, interval time.Duration) {
+	checker, ok := i.conn.ProviderConnection.(provider.HealthChecker)
+	if !ok || interval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	unhealthyChecks := 0
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		if checker.Healthy() {
+			unhealthyChecks = 0
+			i.connected.Store(true)
+			continue
+		}
+		i.connected.Store(false)
+		if unhealthyChecks++; unhealthyChecks < unhealthyChecksThreshold {
+			continue
+		}
+		log.FromContext(ctx).Warn("Pub/sub connection unhealthy, restart")
+		registerIntegrationUnhealthy(ctx, i)
+		i.cancel(errConnectionUnhealthy.New())
+		return
+	}
+}
@@ -0,0 +1,132 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package pubsub
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/pubsub/provider"
+	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSupervisionBackoff(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	config := SupervisionConfig{
+		MinBackoff: time.Second,
+		MaxBackoff: 10 * time.Second,
+	}
+	var failures int
+	backoff := config.backoff(func() int { return failures })
+	for _, tc := range []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: time.Second},
+		{failures: 1, expected: time.Second},
+		{failures: 2, expected: 2 * time.Second},
+		{failures: 3, expected: 4 * time.Second},
+		{failures: 4, expected: 8 * time.Second},
+		{failures: 5, expected: 10 * time.Second},
+		{failures: 100, expected: 10 * time.Second},
+	} {
+		failures = tc.failures
+		a.So(backoff.IntervalFunc(ctx, 0, 1, nil), should.Equal, tc.expected)
+	}
+}
+
+func TestIntegrationHealth(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	h := &integrationHealth{}
+	a.So(h.failed(errors.New("first"), 3), should.BeFalse)
+	a.So(h.failed(errors.New("second"), 3), should.BeFalse)
+	a.So(h.consecutiveFailureCount(), should.Equal, 2)
+
+	// Consecutive failures are reset when the integration starts.
+	h.started()
+	a.So(h.consecutiveFailureCount(), should.Equal, 0)
+	a.So(h.failures, should.Equal, 2)
+
+	a.So(h.failed(errors.New("third"), 3), should.BeFalse)
+	a.So(h.failed(errors.New("fourth"), 3), should.BeFalse)
+	a.So(h.isDisabled(), should.BeFalse)
+	a.So(h.failed(errors.New("fifth"), 3), should.BeTrue)
+	a.So(h.isDisabled(), should.BeTrue)
+	a.So(h.lastErr, should.EqualErrorOrDefinition, errors.New("fifth"))
+
+	// Integrations are never disabled without a maximum.
+	h = &integrationHealth{}
+	for i := 0; i < 100; i++ {
+		a.So(h.failed(errors.New("failure"), 0), should.BeFalse)
+	}
+}
+
+type mockHealthChecker struct {
+	provider.ProviderConnection
+	healthy atomic.Bool
+}
+
+func (c *mockHealthChecker) Healthy() bool { return c.healthy.Load() }
+
+func TestSupervise(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	conn := &mockHealthChecker{}
+	conn.healthy.Store(true)
+	ctx, cancel := errorcontext.New(ctx)
+	defer cancel(context.Canceled)
+	i := &integration{
+		ApplicationPubSub: &ttnpb.ApplicationPubSub{
+			Ids: &ttnpb.ApplicationPubSubIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+				PubSubId:       "foo-ps",
+			},
+		},
+		ctx:    ctx,
+		cancel: cancel,
+		conn:   &provider.Connection{ProviderConnection: conn},
+	}
+	i.connected.Store(true)
+	done := make(chan struct{})
+	go func() {
+		i.supervise(ctx, test.Delay)
+		close(done)
+	}()
+
+	// The integration keeps running while the connection is healthy.
+	time.Sleep(4 * test.Delay)
+	a.So(ctx.Err(), should.BeNil)
+
+	conn.healthy.Store(false)
+	select {
+	case <-done:
+	case <-time.After(10 * test.Delay):
+		t.Fatal("Timed out waiting for the unhealthy connection to be detected")
+	}
+	a.So(errors.IsUnavailable(ctx.Err()), should.BeTrue)
+	a.So(i.connected.Load(), should.BeFalse)
+}
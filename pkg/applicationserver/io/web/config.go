@@ -38,6 +38,9 @@ type TemplateStore interface {
 	GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error)
 	// ListTemplates returns the available templates.
 	ListTemplates(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) (*ttnpb.ApplicationWebhookTemplates, error)
+	// GetTemplateOAuth2ClientCredentials returns the OAuth 2.0 client credentials configuration of the template with
+	// the given identifiers, or nil if the template does not use OAuth 2.0 client credentials.
+	GetTemplateOAuth2ClientCredentials(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*OAuth2ClientCredentials, error)
 }
 
 // NewTemplateStore returns a TemplateStore based on the configuration.
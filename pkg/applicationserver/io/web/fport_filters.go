@@ -0,0 +1,75 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// WithFPortFilters configures the registry of the FPort filters of the webhooks. Upstream messages are only sent to
+// the webhooks whose FPort filter matches.
+func WithFPortFilters(filters fportfilter.Registry) Option {
+	return func(w *webhooks) {
+		w.fPortFilters = filters
+	}
+}
+
+// listFPortFilters returns the FPort filters of the webhooks of the application by webhook ID.
+// If no filters are configured or if the upstream message does not carry an FPort, nil is returned.
+func (w *webhooks) listFPortFilters(
+	ctx context.Context, msg *ttnpb.ApplicationUp,
+) (map[string]*fportfilter.Filter, error) {
+	if w.fPortFilters == nil {
+		return nil, nil
+	}
+	if _, ok := fportfilter.FPort(msg); !ok {
+		return nil, nil
+	}
+	return w.fPortFilters.List(ctx, msg.EndDeviceIds.ApplicationIds)
+}
+
+// fPortFilterRegistry is a WebhookRegistry that deletes the FPort filter of webhooks when they are deleted.
+type fPortFilterRegistry struct {
+	WebhookRegistry
+	filters fportfilter.Registry
+}
+
+// NewFPortFilterRegistry returns a WebhookRegistry that deletes the FPort filter of webhooks when they are deleted.
+func NewFPortFilterRegistry(registry WebhookRegistry, filters fportfilter.Registry) WebhookRegistry {
+	return &fPortFilterRegistry{
+		WebhookRegistry: registry,
+		filters:         filters,
+	}
+}
+
+// Set implements WebhookRegistry.
+func (r *fPortFilterRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationWebhookIdentifiers,
+	paths []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, err := r.WebhookRegistry.Set(ctx, ids, paths, f)
+	if err != nil || hook != nil {
+		return hook, err
+	}
+	if err := r.filters.Set(ctx, ids.ApplicationIds, fportfilter.Webhook(ids.WebhookId), nil); err != nil {
+		return nil, err
+	}
+	return nil, nil
+}
@@ -19,6 +19,7 @@ import (
 	"strconv"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/metadata"
@@ -103,7 +104,7 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 	); err != nil {
 		return nil, err
 	}
-	return s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
+	webhook, err := s.webhooks.Set(ctx, req.Webhook.Ids, appendImplicitWebhookGetPaths(req.FieldMask.GetPaths()...),
 		func(webhook *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
 			if webhook != nil {
 				return req.Webhook, req.FieldMask.GetPaths(), nil
@@ -114,6 +115,11 @@ func (s webhookRegistryRPC) Set(ctx context.Context, req *ttnpb.SetApplicationWe
 			), nil
 		},
 	)
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtSetWebhook.NewWithIdentifiersAndData(ctx, req.Webhook.Ids.ApplicationIds, req.Webhook.Ids))
+	return webhook, nil
 }
 
 func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWebhookIdentifiers) (*emptypb.Empty, error) {
@@ -132,5 +138,6 @@ func (s webhookRegistryRPC) Delete(ctx context.Context, req *ttnpb.ApplicationWe
 	if err != nil {
 		return nil, err
 	}
+	events.Publish(evtDeleteWebhook.NewWithIdentifiersAndData(ctx, req.ApplicationIds, req))
 	return ttnpb.Empty, nil
 }
@@ -0,0 +1,167 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+)
+
+// OAuth2ClientCredentials is the OAuth 2.0 client credentials configuration of a webhook template.
+// The values may contain template field placeholders, i.e. `{client_secret}`, which are replaced
+// by the template field values of the webhook.
+type OAuth2ClientCredentials struct {
+	TokenURL       string
+	ClientID       string
+	ClientSecret   string
+	Scopes         []string
+	EndpointParams map[string]string
+}
+
+// expand returns the configuration with the template field placeholders replaced by the given values.
+func (c *OAuth2ClientCredentials) expand(fields map[string]string) *clientcredentials.Config {
+	pairs := make([]string, 0, 2*len(fields))
+	for id, value := range fields {
+		pairs = append(pairs, "{"+id+"}", value)
+	}
+	r := strings.NewReplacer(pairs...)
+	config := &clientcredentials.Config{
+		TokenURL:     r.Replace(c.TokenURL),
+		ClientID:     r.Replace(c.ClientID),
+		ClientSecret: r.Replace(c.ClientSecret),
+		AuthStyle:    oauth2.AuthStyleAutoDetect,
+	}
+	for _, scope := range c.Scopes {
+		config.Scopes = append(config.Scopes, r.Replace(scope))
+	}
+	if len(c.EndpointParams) > 0 {
+		config.EndpointParams = make(url.Values, len(c.EndpointParams))
+		for key, value := range c.EndpointParams {
+			config.EndpointParams.Set(key, r.Replace(value))
+		}
+	}
+	return config
+}
+
+// fingerprintClientCredentials returns a digest of the expanded configuration, used to detect configuration changes.
+func fingerprintClientCredentials(config *clientcredentials.Config) string {
+	h := sha256.New()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	write(config.TokenURL)
+	write(config.ClientID)
+	write(config.ClientSecret)
+	for _, scope := range config.Scopes {
+		write(scope)
+	}
+	keys := make([]string, 0, len(config.EndpointParams))
+	for key := range config.EndpointParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		write(key)
+		write(config.EndpointParams.Get(key))
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+type tokenSourceEntry struct {
+	fingerprint string
+	source      oauth2.TokenSource
+}
+
+// tokenSources caches the OAuth 2.0 token sources by webhook.
+// The token sources cache the tokens until they expire, and acquire a new token afterwards.
+type tokenSources struct {
+	mu      sync.Mutex
+	entries map[string]tokenSourceEntry
+}
+
+// get returns the token source of the webhook with the given unique ID. A new token source is created
+// with the context returned by newCtx if there is none, or if the configuration changed.
+func (ts *tokenSources) get(
+	uid string, config *clientcredentials.Config, newCtx func() (context.Context, error),
+) (oauth2.TokenSource, error) {
+	fingerprint := fingerprintClientCredentials(config)
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if entry, ok := ts.entries[uid]; ok && entry.fingerprint == fingerprint {
+		return entry.source, nil
+	}
+	ctx, err := newCtx()
+	if err != nil {
+		return nil, err
+	}
+	if ts.entries == nil {
+		ts.entries = make(map[string]tokenSourceEntry)
+	}
+	source := config.TokenSource(ctx)
+	ts.entries[uid] = tokenSourceEntry{
+		fingerprint: fingerprint,
+		source:      source,
+	}
+	return source, nil
+}
+
+var errOAuth2Token = errors.DefineUnavailable("oauth2_token", "acquire OAuth 2.0 token")
+
+// authorize sets the bearer token in the request if the template of the webhook uses OAuth 2.0 client credentials.
+func (w *webhooks) authorize(ctx context.Context, req *http.Request, hook *ttnpb.ApplicationWebhook) error {
+	if w.templates == nil || hook.TemplateIds == nil {
+		return nil
+	}
+	credentials, err := w.templates.GetTemplateOAuth2ClientCredentials(ctx, hook.TemplateIds)
+	if err != nil {
+		return err
+	}
+	if credentials == nil {
+		return nil
+	}
+	source, err := w.tokenSources.get(
+		unique.ID(ctx, hook.Ids),
+		credentials.expand(hook.TemplateFields),
+		func() (context.Context, error) {
+			client, err := w.server.HTTPClient(w.ctx)
+			if err != nil {
+				return nil, err
+			}
+			return context.WithValue(w.ctx, oauth2.HTTPClient, client), nil
+		},
+	)
+	if err != nil {
+		return err
+	}
+	token, err := source.Token()
+	if err != nil {
+		return errOAuth2Token.WithCause(err)
+	}
+	token.SetAuthHeader(req)
+	return nil
+}
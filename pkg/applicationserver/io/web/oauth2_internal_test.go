@@ -0,0 +1,111 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestOAuth2ClientCredentials(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var requests atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		if clientID != "foo" || clientSecret != "secret" || r.PostForm.Get("scope") != "uplink" {
+			http.Error(w, "invalid client", http.StatusUnauthorized)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(map[string]any{ //nolint:errcheck
+			"access_token": "token-" + r.PostForm.Get("audience"),
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	}))
+	defer srv.Close()
+
+	credentials := &OAuth2ClientCredentials{
+		TokenURL:       srv.URL + "/token",
+		ClientID:       "{client_id}",
+		ClientSecret:   "{client_secret}",
+		Scopes:         []string{"uplink"},
+		EndpointParams: map[string]string{"audience": "{audience}"},
+	}
+	fields := map[string]string{
+		"client_id":     "foo",
+		"client_secret": "secret",
+		"audience":      "a",
+	}
+	config := credentials.expand(fields)
+	a.So(config.ClientID, should.Equal, "foo")
+	a.So(config.ClientSecret, should.Equal, "secret")
+	a.So(config.EndpointParams.Get("audience"), should.Equal, "a")
+
+	var sources tokenSources
+	newCtx := func() (context.Context, error) { return ctx, nil }
+
+	// Tokens are cached until they expire.
+	for i := 0; i < 2; i++ {
+		source, err := sources.get("test-app.test-hook", credentials.expand(fields), newCtx)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		token, err := source.Token()
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(token.AccessToken, should.Equal, "token-a")
+	}
+	a.So(requests.Load(), should.Equal, 1)
+
+	// A new token is acquired when the configuration changes.
+	fields["audience"] = "b"
+	source, err := sources.get("test-app.test-hook", credentials.expand(fields), newCtx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	token, err := source.Token()
+	if a.So(err, should.BeNil) {
+		a.So(token.AccessToken, should.Equal, "token-b")
+	}
+	a.So(requests.Load(), should.Equal, 2)
+
+	// Errors of the token endpoint are returned.
+	fields["client_secret"] = "invalid"
+	source, err = sources.get("test-app.test-hook", credentials.expand(fields), newCtx)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = source.Token()
+	a.So(err, should.NotBeNil)
+}
@@ -24,6 +24,36 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
+var withIdentifiersOption = events.WithDataType(&ttnpb.ApplicationWebhookIdentifiers{
+	ApplicationIds: &ttnpb.ApplicationIdentifiers{
+		ApplicationId: "application-id",
+	},
+	WebhookId: "webhook-id",
+})
+
+var (
+	evtSetWebhook = events.Define(
+		"as.webhook.set", "set webhook",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC),
+		withIdentifiersOption,
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteWebhook = events.Define(
+		"as.webhook.delete", "delete webhook",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC),
+		withIdentifiersOption,
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var evtWebhookSend = events.Define(
+	"as.webhook.send", "send webhook",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+	events.WithPropagateToParent(),
+)
+
 var evtWebhookFail = events.Define(
 	"as.webhook.fail", "fail to send webhook",
 	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -76,6 +106,8 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 
 func registerWebhookSent(ctx context.Context) {
 	webhookMetrics.webhooksSent.WithLabelValues(ctx).Inc()
+	ids := deviceIDFromContext(ctx)
+	events.Publish(evtWebhookSend.NewWithIdentifiersAndData(ctx, ids, nil))
 }
 
 func registerWebhookFailed(ctx context.Context, err error) {
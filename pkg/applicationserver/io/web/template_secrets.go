@@ -0,0 +1,205 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// encryptedTemplateFieldPrefix is the prefix of encrypted template field values.
+	// Encrypted values are stored as `encrypted:{key_id}:{hex(ciphertext)}`.
+	encryptedTemplateFieldPrefix    = "encrypted:"
+	encryptedTemplateFieldSeparator = ":"
+)
+
+var errDecryptTemplateField = errors.DefineCorruption(
+	"decrypt_template_field", "decrypt template field `{field_id}`",
+)
+
+// templateSecretsRegistry is a WebhookRegistry that encrypts the values of secret template fields at rest.
+type templateSecretsRegistry struct {
+	WebhookRegistry
+	templates  TemplateStore
+	keyService crypto.KeyService
+	keyID      string
+}
+
+// NewTemplateSecretsRegistry returns a WebhookRegistry that encrypts the values of the template fields that are
+// marked secret in the webhook template with the given key. Encrypted values are decrypted when read.
+func NewTemplateSecretsRegistry(
+	registry WebhookRegistry, templates TemplateStore, keyService crypto.KeyService, keyID string,
+) WebhookRegistry {
+	return &templateSecretsRegistry{
+		WebhookRegistry: registry,
+		templates:       templates,
+		keyService:      keyService,
+		keyID:           keyID,
+	}
+}
+
+func (r *templateSecretsRegistry) encrypt(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers, fields map[string]string,
+) (map[string]string, error) {
+	template, err := r.templates.GetTemplate(ctx, &ttnpb.GetApplicationWebhookTemplateRequest{
+		Ids:       ids,
+		FieldMask: ttnpb.FieldMask("fields"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := make(map[string]string, len(fields))
+	for id, value := range fields {
+		res[id] = value
+	}
+	for _, field := range template.Fields {
+		value, ok := res[field.Id]
+		if !field.Secret || !ok || value == "" {
+			continue
+		}
+		ciphertext, err := r.keyService.Encrypt(ctx, []byte(value), r.keyID)
+		if err != nil {
+			return nil, err
+		}
+		res[field.Id] = encryptedTemplateFieldPrefix + r.keyID + encryptedTemplateFieldSeparator +
+			hex.EncodeToString(ciphertext)
+	}
+	return res, nil
+}
+
+func (r *templateSecretsRegistry) decrypt(ctx context.Context, hook *ttnpb.ApplicationWebhook) error {
+	if hook == nil {
+		return nil
+	}
+	for id, value := range hook.TemplateFields {
+		if !strings.HasPrefix(value, encryptedTemplateFieldPrefix) {
+			continue
+		}
+		value = strings.TrimPrefix(value, encryptedTemplateFieldPrefix)
+		i := strings.LastIndex(value, encryptedTemplateFieldSeparator)
+		if i < 0 {
+			return errDecryptTemplateField.WithAttributes("field_id", id)
+		}
+		ciphertext, err := hex.DecodeString(value[i+1:])
+		if err != nil {
+			return errDecryptTemplateField.WithAttributes("field_id", id).WithCause(err)
+		}
+		plaintext, err := r.keyService.Decrypt(ctx, ciphertext, value[:i])
+		if err != nil {
+			return errDecryptTemplateField.WithAttributes("field_id", id).WithCause(err)
+		}
+		hook.TemplateFields[id] = string(plaintext)
+	}
+	return nil
+}
+
+// Get implements WebhookRegistry.
+func (r *templateSecretsRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, paths []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, err := r.WebhookRegistry.Get(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.decrypt(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// List implements WebhookRegistry.
+func (r *templateSecretsRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, paths []string,
+) ([]*ttnpb.ApplicationWebhook, error) {
+	hooks, err := r.WebhookRegistry.List(ctx, ids, paths)
+	if err != nil {
+		return nil, err
+	}
+	for _, hook := range hooks {
+		if err := r.decrypt(ctx, hook); err != nil {
+			return nil, err
+		}
+	}
+	return hooks, nil
+}
+
+// Set implements WebhookRegistry.
+func (r *templateSecretsRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationWebhookIdentifiers,
+	paths []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	hook, err := r.WebhookRegistry.Set(ctx, ids, ttnpb.AddFields(paths, "template_ids"),
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			if err := r.decrypt(ctx, stored); err != nil {
+				return nil, nil, err
+			}
+			hook, sets, err := f(stored)
+			if err != nil || hook == nil || !ttnpb.HasAnyField(sets, "template_fields") {
+				return hook, sets, err
+			}
+			templateIDs := stored.GetTemplateIds()
+			if ttnpb.HasAnyField(sets, "template_ids") {
+				templateIDs = hook.GetTemplateIds()
+			}
+			if templateIDs == nil || len(hook.TemplateFields) == 0 {
+				return hook, sets, nil
+			}
+			fields, err := r.encrypt(ctx, templateIDs, hook.TemplateFields)
+			if err != nil {
+				return nil, nil, err
+			}
+			// Do not modify the webhook of the caller.
+			hook = ttnpb.Clone(hook)
+			hook.TemplateFields = fields
+			return hook, sets, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.decrypt(ctx, hook); err != nil {
+		return nil, err
+	}
+	return hook, nil
+}
+
+// Range implements WebhookRegistry.
+func (r *templateSecretsRegistry) Range(
+	ctx context.Context,
+	paths []string,
+	f func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationWebhook) bool,
+) error {
+	var decryptErr error
+	err := r.WebhookRegistry.Range(ctx, paths,
+		func(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, hook *ttnpb.ApplicationWebhook) bool {
+			if decryptErr = r.decrypt(ctx, hook); decryptErr != nil {
+				return false
+			}
+			return f(ctx, ids, hook)
+		},
+	)
+	if err != nil {
+		return err
+	}
+	return decryptErr
+}
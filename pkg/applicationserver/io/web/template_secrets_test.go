@@ -0,0 +1,183 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package web_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// memWebhookRegistry is a WebhookRegistry that stores the webhooks in memory.
+type memWebhookRegistry struct {
+	mu       sync.Mutex
+	webhooks map[string]*ttnpb.ApplicationWebhook
+}
+
+func (r *memWebhookRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, _ []string,
+) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return ttnpb.Clone(r.webhooks[unique.ID(ctx, ids)]), nil
+}
+
+func (r *memWebhookRegistry) List(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, _ []string,
+) ([]*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var res []*ttnpb.ApplicationWebhook
+	for _, hook := range r.webhooks {
+		if hook.Ids.ApplicationIds.ApplicationId == ids.ApplicationId {
+			res = append(res, ttnpb.Clone(hook))
+		}
+	}
+	return res, nil
+}
+
+func (r *memWebhookRegistry) Set(
+	ctx context.Context,
+	ids *ttnpb.ApplicationWebhookIdentifiers,
+	_ []string,
+	f func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error),
+) (*ttnpb.ApplicationWebhook, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	uid := unique.ID(ctx, ids)
+	stored := ttnpb.Clone(r.webhooks[uid])
+	hook, sets, err := f(stored)
+	if err != nil {
+		return nil, err
+	}
+	if hook == nil {
+		delete(r.webhooks, uid)
+		return nil, nil
+	}
+	updated := ttnpb.Clone(r.webhooks[uid])
+	if updated == nil {
+		updated = &ttnpb.ApplicationWebhook{}
+	}
+	if err := updated.SetFields(hook, sets...); err != nil {
+		return nil, err
+	}
+	if r.webhooks == nil {
+		r.webhooks = make(map[string]*ttnpb.ApplicationWebhook)
+	}
+	r.webhooks[uid] = updated
+	return ttnpb.Clone(updated), nil
+}
+
+func (*memWebhookRegistry) Range(
+	context.Context, []string, func(context.Context, *ttnpb.ApplicationIdentifiers, *ttnpb.ApplicationWebhook) bool,
+) error {
+	return nil
+}
+
+func TestTemplateSecretsRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	templates, err := web.TemplatesConfig{
+		Static: map[string][]byte{
+			"templates.yml": []byte("- test-template"),
+			"test-template.yml": []byte(`template-id: test-template
+name: Test
+base-url: https://example.com
+format: json
+fields:
+  - id: username
+    name: Username
+  - id: password
+    name: Password
+    secret: true
+`),
+		},
+	}.NewTemplateStore(ctx, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	}))
+
+	underlying := &memWebhookRegistry{}
+	registry := web.NewTemplateSecretsRegistry(underlying, templates, keyService, "test")
+
+	fields := map[string]string{
+		"username": "foo",
+		"password": "secret",
+	}
+	hook := &ttnpb.ApplicationWebhook{
+		Ids:            registeredWebhookIDs,
+		BaseUrl:        "https://example.com",
+		Format:         "json",
+		TemplateIds:    &ttnpb.ApplicationWebhookTemplateIdentifiers{TemplateId: "test-template"},
+		TemplateFields: fields,
+	}
+	paths := []string{"ids", "base_url", "format", "template_ids", "template_fields"}
+	res, err := registry.Set(ctx, registeredWebhookIDs, paths,
+		func(*ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			return hook, paths, nil
+		},
+	)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.TemplateFields, should.Resemble, fields)
+	// The webhook of the caller is not modified.
+	a.So(hook.TemplateFields["password"], should.Equal, "secret")
+
+	// Only the secret field is encrypted at rest.
+	stored, err := underlying.Get(ctx, registeredWebhookIDs, nil)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(stored.TemplateFields["username"], should.Equal, "foo")
+	a.So(strings.HasPrefix(stored.TemplateFields["password"], "encrypted:test:"), should.BeTrue)
+
+	// Secret fields are decrypted when read.
+	res, err = registry.Get(ctx, registeredWebhookIDs, paths)
+	if a.So(err, should.BeNil) {
+		a.So(res.TemplateFields, should.Resemble, fields)
+	}
+	list, err := registry.List(ctx, registeredApplicationID, paths)
+	if a.So(err, should.BeNil) && a.So(list, should.HaveLength, 1) {
+		a.So(list[0].TemplateFields, should.Resemble, fields)
+	}
+
+	// Updates of other fields keep the secret fields encrypted.
+	_, err = registry.Set(ctx, registeredWebhookIDs, paths,
+		func(stored *ttnpb.ApplicationWebhook) (*ttnpb.ApplicationWebhook, []string, error) {
+			a.So(stored.TemplateFields, should.Resemble, fields)
+			stored.BaseUrl = "https://example.com/v2"
+			return stored, []string{"base_url"}, nil
+		},
+	)
+	a.So(err, should.BeNil)
+	updated, err := underlying.Get(ctx, registeredWebhookIDs, nil)
+	if a.So(err, should.BeNil) {
+		a.So(updated.TemplateFields["password"], should.Equal, stored.TemplateFields["password"])
+	}
+}
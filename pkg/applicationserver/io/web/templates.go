@@ -44,6 +44,50 @@ func (ts *noopTemplateStore) ListTemplates(ctx context.Context, req *ttnpb.ListA
 	return &ttnpb.ApplicationWebhookTemplates{}, nil
 }
 
+// GetTemplateOAuth2ClientCredentials implements TemplateStore.
+func (ts *noopTemplateStore) GetTemplateOAuth2ClientCredentials(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*OAuth2ClientCredentials, error) {
+	return nil, errTemplateNotFound.WithAttributes("template_id", ids.TemplateId)
+}
+
+// ReloadableTemplateStore is a TemplateStore of which the underlying store can be replaced.
+type ReloadableTemplateStore struct {
+	mu    sync.RWMutex
+	store TemplateStore
+}
+
+// NewReloadableTemplateStore returns a new ReloadableTemplateStore that uses the given store.
+func NewReloadableTemplateStore(store TemplateStore) *ReloadableTemplateStore {
+	return &ReloadableTemplateStore{store: store}
+}
+
+// Set replaces the underlying store.
+func (ts *ReloadableTemplateStore) Set(store TemplateStore) {
+	ts.mu.Lock()
+	ts.store = store
+	ts.mu.Unlock()
+}
+
+func (ts *ReloadableTemplateStore) get() TemplateStore {
+	ts.mu.RLock()
+	defer ts.mu.RUnlock()
+	return ts.store
+}
+
+// GetTemplate implements TemplateStore.
+func (ts *ReloadableTemplateStore) GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
+	return ts.get().GetTemplate(ctx, req)
+}
+
+// ListTemplates implements TemplateStore.
+func (ts *ReloadableTemplateStore) ListTemplates(ctx context.Context, req *ttnpb.ListApplicationWebhookTemplatesRequest) (*ttnpb.ApplicationWebhookTemplates, error) {
+	return ts.get().ListTemplates(ctx, req)
+}
+
+// GetTemplateOAuth2ClientCredentials implements TemplateStore.
+func (ts *ReloadableTemplateStore) GetTemplateOAuth2ClientCredentials(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*OAuth2ClientCredentials, error) {
+	return ts.get().GetTemplateOAuth2ClientCredentials(ctx, ids)
+}
+
 // templateStore implements TemplateStore using an underlying fetcher.
 type templateStore struct {
 	fetcher fetch.Interface
@@ -73,7 +117,7 @@ func (ts *templateStore) prependBaseURL(template *ttnpb.ApplicationWebhookTempla
 
 // GetTemplate implements the TemplateStore interface.
 func (ts *templateStore) GetTemplate(ctx context.Context, req *ttnpb.GetApplicationWebhookTemplateRequest) (*ttnpb.ApplicationWebhookTemplate, error) {
-	template, err := ts.getTemplate(req.Ids)
+	template, _, err := ts.getTemplate(req.Ids)
 	if err != nil {
 		return nil, err
 	}
@@ -97,7 +141,7 @@ func (ts *templateStore) ListTemplates(ctx context.Context, req *ttnpb.ListAppli
 
 	var templates ttnpb.ApplicationWebhookTemplates
 	for _, id := range ids {
-		template, err := ts.getTemplate(&ttnpb.ApplicationWebhookTemplateIdentifiers{
+		template, _, err := ts.getTemplate(&ttnpb.ApplicationWebhookTemplateIdentifiers{
 			TemplateId: id,
 		})
 		if err != nil {
@@ -119,10 +163,20 @@ func (ts *templateStore) ListTemplates(ctx context.Context, req *ttnpb.ListAppli
 	return &templates, nil
 }
 
+// GetTemplateOAuth2ClientCredentials implements the TemplateStore interface.
+func (ts *templateStore) GetTemplateOAuth2ClientCredentials(ctx context.Context, ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*OAuth2ClientCredentials, error) {
+	_, oauth2, err := ts.getTemplate(ids)
+	if err != nil {
+		return nil, err
+	}
+	return oauth2, nil
+}
+
 type queryResult struct {
-	t    *ttnpb.ApplicationWebhookTemplate
-	err  error
-	time time.Time
+	t      *ttnpb.ApplicationWebhookTemplate
+	oauth2 *OAuth2ClientCredentials
+	err    error
+	time   time.Time
 }
 
 var (
@@ -160,32 +214,33 @@ func (ts *templateStore) getAllTemplateIDs() ([]string, error) {
 	return ids, err
 }
 
-func (ts *templateStore) template(ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, error) {
+func (ts *templateStore) template(ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (*ttnpb.ApplicationWebhookTemplate, *OAuth2ClientCredentials, error) {
 	data, err := ts.fetcher.File(fmt.Sprintf("%s.yml", ids.TemplateId))
 	if err != nil {
-		return nil, errFetchFailed.WithCause(err)
+		return nil, nil, errFetchFailed.WithCause(err)
 	}
 	var template webhookTemplate
 	err = yaml.Unmarshal(data, &template)
 	if err != nil {
-		return nil, errParseFile.WithCause(err)
+		return nil, nil, errParseFile.WithCause(err)
 	}
-	return template.toPB(), nil
+	return template.toPB(), template.oauth2ClientCredentials(), nil
 }
 
-func (ts *templateStore) getTemplate(ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (t *ttnpb.ApplicationWebhookTemplate, err error) {
+func (ts *templateStore) getTemplate(ids *ttnpb.ApplicationWebhookTemplateIdentifiers) (t *ttnpb.ApplicationWebhookTemplate, oauth2 *OAuth2ClientCredentials, err error) {
 	ts.templatesMu.Lock()
 	defer ts.templatesMu.Unlock()
 	if cached, ok := ts.templates[ids.TemplateId]; ok && cached.err == nil && time.Since(cached.time) < yamlFetchErrorCache {
-		return cached.t, cached.err
+		return cached.t, cached.oauth2, cached.err
 	}
-	template, err := ts.template(ids)
+	template, oauth2, err := ts.template(ids)
 	ts.templates[ids.TemplateId] = queryResult{
-		t:    template,
-		err:  err,
-		time: time.Now(),
+		t:      template,
+		oauth2: oauth2,
+		err:    err,
+		time:   time.Now(),
 	}
-	return template, err
+	return template, oauth2, err
 }
 
 func appendImplicitWebhookTemplatePaths(paths ...string) []string {
@@ -236,6 +291,14 @@ type webhookTemplatePaths struct {
 	ServiceData              *string `yaml:"service-data,omitempty"`
 }
 
+type webhookTemplateOAuth2ClientCredentials struct {
+	TokenURL       string            `yaml:"token-url"`
+	ClientID       string            `yaml:"client-id"`
+	ClientSecret   string            `yaml:"client-secret"`
+	Scopes         []string          `yaml:"scopes,omitempty"`
+	EndpointParams map[string]string `yaml:"endpoint-params,omitempty"`
+}
+
 type webhookTemplate struct {
 	TemplateID           string                 `yaml:"template-id"`
 	Name                 string                 `yaml:"name"`
@@ -250,6 +313,8 @@ type webhookTemplate struct {
 	CreateDownlinkAPIKey bool                   `yaml:"create-downlink-api-key"`
 	Paths                webhookTemplatePaths   `yaml:"paths,omitempty"`
 	FieldMask            []string               `yaml:"field-mask,omitempty"`
+
+	OAuth2ClientCredentials *webhookTemplateOAuth2ClientCredentials `yaml:"oauth2-client-credentials,omitempty"`
 }
 
 func (webhookTemplate) pathToMessage(s *string) *ttnpb.ApplicationWebhookTemplate_Message {
@@ -273,6 +338,20 @@ func (t webhookTemplate) pbFieldMask() *fieldmaskpb.FieldMask {
 	return ttnpb.FieldMask(t.FieldMask...)
 }
 
+func (t webhookTemplate) oauth2ClientCredentials() *OAuth2ClientCredentials {
+	c := t.OAuth2ClientCredentials
+	if c == nil {
+		return nil
+	}
+	return &OAuth2ClientCredentials{
+		TokenURL:       c.TokenURL,
+		ClientID:       c.ClientID,
+		ClientSecret:   c.ClientSecret,
+		Scopes:         c.Scopes,
+		EndpointParams: c.EndpointParams,
+	}
+}
+
 func (t webhookTemplate) toPB() *ttnpb.ApplicationWebhookTemplate {
 	return &ttnpb.ApplicationWebhookTemplate{
 		Ids: &ttnpb.ApplicationWebhookTemplateIdentifiers{
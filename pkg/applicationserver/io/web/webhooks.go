@@ -28,6 +28,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/jtacoma/uritemplates"
 	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/fportfilter"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/goproto"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
@@ -137,6 +138,8 @@ func (s *pooledSink) Process(req *http.Request) error {
 type Webhooks interface {
 	ttnweb.Registerer
 	Registry() WebhookRegistry
+	// Send sends the upstream message to the webhook only.
+	Send(ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, msg *ttnpb.ApplicationUp) error
 }
 
 type webhooks struct {
@@ -145,6 +148,21 @@ type webhooks struct {
 	registry  WebhookRegistry
 	target    Sink
 	downlinks DownlinksConfig
+
+	templates    TemplateStore
+	tokenSources tokenSources
+	fPortFilters fportfilter.Registry
+}
+
+// Option configures Webhooks.
+type Option func(*webhooks)
+
+// WithTemplateStore configures the store of the webhook templates. This enables the authentication configured in
+// the template of a webhook, such as OAuth 2.0 client credentials.
+func WithTemplateStore(templates TemplateStore) Option {
+	return func(w *webhooks) {
+		w.templates = templates
+	}
 }
 
 // NewWebhooks returns a new Webhooks.
@@ -154,6 +172,7 @@ func NewWebhooks(
 	registry WebhookRegistry,
 	target Sink,
 	downlinks DownlinksConfig,
+	opts ...Option,
 ) (Webhooks, error) {
 	ctx = log.NewContextWithField(ctx, "namespace", namespace)
 	w := &webhooks{
@@ -163,6 +182,9 @@ func NewWebhooks(
 		target:    target,
 		downlinks: downlinks,
 	}
+	for _, opt := range opts {
+		opt(w)
+	}
 	sub, err := server.Subscribe(ctx, "webhooks", nil, false)
 	if err != nil {
 		return nil, err
@@ -239,70 +261,101 @@ func (w *webhooks) domain(_ context.Context) string {
 	return u.Host
 }
 
+// webhookUpPaths are the paths of the webhooks that are needed to send upstream messages.
+var webhookUpPaths = []string{
+	"base_url",
+	"downlink_ack",
+	"downlink_api_key",
+	"downlink_failed",
+	"downlink_nack",
+	"downlink_queue_invalidated",
+	"downlink_queued",
+	"downlink_sent",
+	"field_mask",
+	"format",
+	"headers",
+	"health_status",
+	"join_accept",
+	"location_solved",
+	"service_data",
+	"template_fields",
+	"template_ids",
+	"uplink_message",
+	"uplink_normalized",
+}
+
 func (w *webhooks) handleUp(ctx context.Context, msg *ttnpb.ApplicationUp) error {
 	ctx = log.NewContextWithField(ctx, "namespace", namespace)
-	hooks, err := w.registry.List(ctx, msg.EndDeviceIds.ApplicationIds,
-		[]string{
-			"base_url",
-			"downlink_ack",
-			"downlink_api_key",
-			"downlink_failed",
-			"downlink_nack",
-			"downlink_queue_invalidated",
-			"downlink_queued",
-			"downlink_sent",
-			"field_mask",
-			"format",
-			"headers",
-			"health_status",
-			"join_accept",
-			"location_solved",
-			"service_data",
-			"uplink_message",
-			"uplink_normalized",
-		},
-	)
+	hooks, err := w.registry.List(ctx, msg.EndDeviceIds.ApplicationIds, webhookUpPaths)
+	if err != nil {
+		return err
+	}
+	filters, err := w.listFPortFilters(ctx, msg)
 	if err != nil {
 		return err
 	}
 	ctx = withDeviceID(ctx, msg.EndDeviceIds)
 	wg := sync.WaitGroup{}
-	for i := range hooks {
-		hook := hooks[i]
-		ctx := withWebhookID(ctx, hook.Ids)
-		ctx = WithCachedHealthStatus(ctx, hook.HealthStatus)
-		logger := log.FromContext(ctx).WithField("hook", hook.Ids.WebhookId)
-		f := func(ctx context.Context) error {
-			req, err := w.newRequest(ctx, msg, hook)
-			if err != nil {
-				logger.WithError(err).Warn("Failed to create request")
-				return err
-			}
-			if req == nil {
-				return nil
-			}
-			logger.WithField("url", req.URL).Debug("Process message")
-			if err := w.target.Process(req); err != nil {
-				registerWebhookFailed(ctx, err)
-				logger.WithError(err).Warn("Failed to process message")
-				return err
-			}
-			return nil
+	for _, hook := range hooks {
+		if !filters[fportfilter.Webhook(hook.Ids.WebhookId)].Match(msg) {
+			continue
 		}
-		wg.Add(1)
-		w.server.StartTask(&task.Config{
-			Context: ctx,
-			ID:      "execute_webhook",
-			Func:    f,
-			Done:    wg.Done,
-			Restart: task.RestartNever,
-			Backoff: task.DefaultBackoffConfig,
-		})
+		w.startSend(ctx, msg, hook, &wg)
+	}
+	wg.Wait()
+	return nil
+}
+
+// Send sends the upstream message to the webhook only, regardless of its FPort filter.
+func (w *webhooks) Send(
+	ctx context.Context, ids *ttnpb.ApplicationWebhookIdentifiers, msg *ttnpb.ApplicationUp,
+) error {
+	ctx = log.NewContextWithField(ctx, "namespace", namespace)
+	hook, err := w.registry.Get(ctx, ids, webhookUpPaths)
+	if err != nil {
+		return err
 	}
+	wg := sync.WaitGroup{}
+	w.startSend(withDeviceID(ctx, msg.EndDeviceIds), msg, hook, &wg)
 	wg.Wait()
 	return nil
 }
 
+// startSend starts a task that sends the upstream message to the webhook.
+func (w *webhooks) startSend(
+	ctx context.Context, msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook, wg *sync.WaitGroup,
+) {
+	ctx = withWebhookID(ctx, hook.Ids)
+	ctx = WithCachedHealthStatus(ctx, hook.HealthStatus)
+	logger := log.FromContext(ctx).WithField("hook", hook.Ids.WebhookId)
+	f := func(ctx context.Context) error {
+		req, err := w.newRequest(ctx, msg, hook)
+		if err != nil {
+			logger.WithError(err).Warn("Failed to create request")
+			return err
+		}
+		if req == nil {
+			return nil
+		}
+		logger.WithField("url", req.URL).Debug("Process message")
+		if err := w.target.Process(req); err != nil {
+			registerWebhookFailed(ctx, err)
+			logger.WithError(err).Warn("Failed to process message")
+			return err
+		}
+		return nil
+	}
+	wg.Add(1)
+	w.server.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "execute_webhook",
+		Func:    f,
+		Done:    wg.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DefaultBackoffConfig,
+	})
+}
+
 func webhookMessage(
 	msg *ttnpb.ApplicationUp, hook *ttnpb.ApplicationWebhook,
 ) *ttnpb.ApplicationWebhook_Message {
@@ -431,6 +484,9 @@ func (w *webhooks) newRequest(
 		req.Header.Set(domainHeader, domain)
 	}
 	req.Header.Set("Content-Type", format.ContentType)
+	if err := w.authorize(ctx, req, hook); err != nil {
+		return nil, err
+	}
 	return req, nil
 }
 
@@ -478,6 +534,10 @@ func (w *webhooks) handleDown(
 			webhandlers.Error(res, req, errDecodeBody.WithCause(err))
 			return
 		}
+		if err := io.AppendCorrelationIDs(items.Downlinks, req.Header.Values(io.CorrelationIDHeader)...); err != nil {
+			webhandlers.Error(res, req, err)
+			return
+		}
 		if err := items.ValidateFields(); err != nil {
 			webhandlers.Error(res, req, errValidateBody.WithCause(err))
 			return
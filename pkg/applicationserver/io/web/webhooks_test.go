@@ -21,6 +21,7 @@ import (
 	stdio "io"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 	"time"
 
@@ -570,6 +571,37 @@ func TestWebhooks(t *testing.T) {
 				})
 			}
 		})
+
+		//nolint:paralleltest
+		t.Run("CorrelationIDs", func(t *testing.T) {
+			a := assertions.New(t)
+			url := fmt.Sprintf("/api/v3/as/applications/%s/webhooks/%s/devices/%s/down/replace",
+				registeredApplicationID.ApplicationId, registeredWebhookID, registeredDeviceID.DeviceId,
+			)
+			body := bytes.NewReader([]byte(`{"downlinks":[{"f_port":1,"frm_payload":"AQ==","correlation_ids":["body"]}]}`))
+			req := httptest.NewRequest(http.MethodPost, url, body)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", registeredApplicationKey))
+			req.Header.Add("X-Correlation-ID", "client-1")
+			req.Header.Add("X-Correlation-ID", "client-2,client-3")
+			res := httptest.NewRecorder()
+			c.ServeHTTP(res, req)
+			a.So(res.Code, should.Equal, http.StatusOK)
+			downlinks, err := io.DownlinkQueueList(ctx, registeredDeviceID)
+			if !a.So(err, should.BeNil) || !a.So(downlinks, should.HaveLength, 1) {
+				t.FailNow()
+			}
+			a.So(downlinks[0].CorrelationIds, should.Resemble, []string{"body", "client-1", "client-2", "client-3"})
+
+			body = bytes.NewReader([]byte(`{"downlinks":[]}`))
+			req = httptest.NewRequest(http.MethodPost, url, body)
+			req.Header.Set("Content-Type", "application/json")
+			req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", registeredApplicationKey))
+			req.Header.Set("X-Correlation-ID", strings.Repeat("x", 101))
+			res = httptest.NewRecorder()
+			c.ServeHTTP(res, req)
+			a.So(res.Code, should.Equal, http.StatusBadRequest)
+		})
 	})
 }
 
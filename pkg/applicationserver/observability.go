@@ -67,6 +67,18 @@ var (
 		events.WithDataType(&ttnpb.ApplicationUplink{}),
 		events.WithPropagateToParent(),
 	)
+	evtPayloadSchemaFailDataUp = events.Define(
+		"as.up.data.schema.fail", "uplink data message payload schema validation failure",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationUplink{}),
+		events.WithPropagateToParent(),
+	)
+	evtQuarantineDataUp = events.Define(
+		"as.up.data.quarantine", "quarantine uplink data message",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithDataType(&ttnpb.ApplicationUp{}),
+		events.WithPropagateToParent(),
+	)
 	evtReceiveJoinAccept = events.Define(
 		"as.up.join.receive", "receive join-accept message",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -186,6 +198,14 @@ var asMetrics = &messageMetrics{
 		},
 		[]string{"payload_formatter", "value_context", "value_type"},
 	),
+	uplinkPayloadSchemaViolations: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "uplink_payload_schema_violations_total",
+			Help:      "Total number of uplinks with decoded payloads that fail payload schema validation",
+		},
+		[]string{},
+	),
 	nsAsUplinkLatency: metrics.NewContextualHistogramVec(
 		prometheus.HistogramOpts{
 			Subsystem: "ns_as",
@@ -247,6 +267,7 @@ type messageMetrics struct {
 	uplinkForwarded                *metrics.ContextualCounterVec
 	uplinkDropped                  *metrics.ContextualCounterVec
 	uplinkPayloadValueViolations   *metrics.ContextualCounterVec
+	uplinkPayloadSchemaViolations  *metrics.ContextualCounterVec
 	nsAsUplinkLatency              *metrics.ContextualHistogramVec
 	gtwAsUplinkLatency             *metrics.ContextualHistogramVec
 	downlinkReceived               *metrics.ContextualCounterVec
@@ -260,6 +281,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
 	m.uplinkPayloadValueViolations.Describe(ch)
+	m.uplinkPayloadSchemaViolations.Describe(ch)
 	m.nsAsUplinkLatency.Describe(ch)
 	m.gtwAsUplinkLatency.Describe(ch)
 	m.downlinkReceived.Describe(ch)
@@ -273,6 +295,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
 	m.uplinkPayloadValueViolations.Collect(ch)
+	m.uplinkPayloadSchemaViolations.Collect(ch)
 	m.nsAsUplinkLatency.Collect(ch)
 	m.gtwAsUplinkLatency.Collect(ch)
 	m.downlinkReceived.Collect(ch)
@@ -293,6 +316,17 @@ func registerReceiveUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 	asMetrics.uplinkReceived.WithLabelValues(ctx).Inc()
 }
 
+func registerPayloadSchemaViolation(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, msg *ttnpb.ApplicationUplink,
+) {
+	events.Publish(evtPayloadSchemaFailDataUp.NewWithIdentifiersAndData(ctx, ids, msg))
+	asMetrics.uplinkPayloadSchemaViolations.WithLabelValues(ctx).Inc()
+}
+
+func registerQuarantineUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
+	events.Publish(evtQuarantineDataUp.NewWithIdentifiersAndData(ctx, msg.EndDeviceIds, msg))
+}
+
 func registerForwardUp(ctx context.Context, msg *ttnpb.ApplicationUp) {
 	switch msg.Up.(type) {
 	case *ttnpb.ApplicationUp_JoinAccept:
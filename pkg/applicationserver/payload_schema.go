@@ -0,0 +1,240 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"time"
+
+	"github.com/bluele/gcache"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	errPayloadSchemaRegistry = errors.DefineInvalidArgument(
+		"payload_schema_registry", "payload schema registry is not configured",
+	)
+	errPayloadSchemaPolicy = errors.DefineInvalidArgument("payload_schema_policy", "invalid payload schema policy")
+	errQuarantineWebhooks  = errors.DefineFailedPrecondition(
+		"quarantine_webhooks", "webhooks are not configured for the quarantine of uplink messages",
+	)
+)
+
+const (
+	payloadSchemaCacheSize = 1024
+	payloadSchemaCacheTTL  = time.Minute
+
+	// maxPayloadSchemaWarnings is the maximum number of payload schema violations that are added to the decoded
+	// payload warnings of an uplink message.
+	maxPayloadSchemaWarnings = 10
+)
+
+// payloadSchema is a payload schema policy with its parsed schema.
+type payloadSchema struct {
+	policy *payloadschema.Policy
+	schema *payloadschema.Schema
+}
+
+func (as *ApplicationServer) initPayloadSchemas(conf PayloadSchemaConfig) error {
+	if conf.Registry == nil {
+		return errPayloadSchemaRegistry.New()
+	}
+	as.payloadSchemaRegistry = conf.Registry
+	as.payloadSchemaCache = gcache.New(payloadSchemaCacheSize).LRU().Expiration(payloadSchemaCacheTTL).Build()
+	return nil
+}
+
+// payloadSchema returns the payload schema of the application, or nil if the application has no payload schema.
+// Payload schemas are cached, so that changes take effect on all instances within the cache TTL.
+func (as *ApplicationServer) payloadSchema(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*payloadSchema, error) {
+	uid := unique.ID(ctx, ids)
+	if v, err := as.payloadSchemaCache.Get(uid); err == nil {
+		return v.(*payloadSchema), nil
+	}
+	var res *payloadSchema
+	policy, err := as.payloadSchemaRegistry.Get(ctx, ids)
+	switch {
+	case err == nil:
+		schema, err := payloadschema.Parse(policy.Schema)
+		if err != nil {
+			return nil, err
+		}
+		res = &payloadSchema{policy: policy, schema: schema}
+	case !errors.IsNotFound(err):
+		return nil, err
+	}
+	_ = as.payloadSchemaCache.Set(uid, res)
+	return res, nil
+}
+
+// validatePayloadSchema validates the decoded payload of the uplink message against the payload schema of the
+// application. Violations are added to the decoded payload warnings of the uplink message. If the uplink message
+// fails validation and the application has a quarantine webhook, the ID of the webhook is returned.
+func (as *ApplicationServer) validatePayloadSchema(ctx context.Context, up *ttnpb.ApplicationUp) string {
+	if as.payloadSchemaRegistry == nil {
+		return ""
+	}
+	msg := up.GetUplinkMessage()
+	if msg.GetDecodedPayload() == nil {
+		return ""
+	}
+	ps, err := as.payloadSchema(ctx, up.EndDeviceIds.ApplicationIds)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get payload schema")
+		return ""
+	}
+	if ps == nil {
+		return ""
+	}
+	violations := ps.schema.Validate(msg.DecodedPayload.AsMap())
+	if len(violations) == 0 {
+		return ""
+	}
+	if len(violations) > maxPayloadSchemaWarnings {
+		violations = violations[:maxPayloadSchemaWarnings]
+	}
+	msg.DecodedPayloadWarnings = append(msg.DecodedPayloadWarnings, payloadschema.Warnings(violations)...)
+	registerPayloadSchemaViolation(ctx, up.EndDeviceIds, msg)
+	if as.webhooks == nil {
+		return ""
+	}
+	return ps.policy.QuarantineWebhookID
+}
+
+// quarantineUp sends the uplink message to the quarantine webhook of the application instead of to the
+// integrations of the application.
+func (as *ApplicationServer) quarantineUp(ctx context.Context, up *ttnpb.ApplicationUp, webhookID string) error {
+	up, err := as.applyRetentionPolicy(ctx, up)
+	if err != nil {
+		return err
+	}
+	return as.webhooks.Send(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+		ApplicationIds: up.EndDeviceIds.ApplicationIds,
+		WebhookId:      webhookID,
+	}, up)
+}
+
+// GetPayloadSchemaPolicy returns the payload schema policy of the application.
+func (as *ApplicationServer) GetPayloadSchemaPolicy(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*payloadschema.Policy, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	return as.payloadSchemaRegistry.Get(ctx, ids)
+}
+
+// SetPayloadSchemaPolicy sets the payload schema policy of the application. If policy is nil, the policy is
+// deleted. The quarantine webhook of the policy, if any, must exist.
+func (as *ApplicationServer) SetPayloadSchemaPolicy(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *payloadschema.Policy,
+) error {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return err
+	}
+	if policy != nil {
+		if _, err := payloadschema.Parse(policy.Schema); err != nil {
+			return err
+		}
+		if policy.QuarantineWebhookID != "" {
+			if as.webhooks == nil {
+				return errQuarantineWebhooks.New()
+			}
+			if _, err := as.webhooks.Registry().Get(ctx, &ttnpb.ApplicationWebhookIdentifiers{
+				ApplicationIds: ids,
+				WebhookId:      policy.QuarantineWebhookID,
+			}, []string{"ids"}); err != nil {
+				return err
+			}
+		}
+	}
+	if err := as.payloadSchemaRegistry.Set(ctx, ids, policy); err != nil {
+		return err
+	}
+	as.payloadSchemaCache.Remove(unique.ID(ctx, ids))
+	return nil
+}
+
+func payloadSchemaPolicyToPB(policy *payloadschema.Policy) (*ttnpb.ApplicationPayloadSchemaPolicy, error) {
+	schema := &structpb.Struct{}
+	if err := schema.UnmarshalJSON(policy.Schema); err != nil {
+		return nil, errPayloadSchemaPolicy.WithCause(err)
+	}
+	return &ttnpb.ApplicationPayloadSchemaPolicy{
+		Schema:              schema,
+		QuarantineWebhookId: policy.QuarantineWebhookID,
+	}, nil
+}
+
+func payloadSchemaPolicyFromPB(pb *ttnpb.ApplicationPayloadSchemaPolicy) (*payloadschema.Policy, error) {
+	schema, err := pb.GetSchema().MarshalJSON()
+	if err != nil {
+		return nil, errPayloadSchemaPolicy.WithCause(err)
+	}
+	return &payloadschema.Policy{
+		Schema:              schema,
+		QuarantineWebhookID: pb.GetQuarantineWebhookId(),
+	}, nil
+}
+
+type payloadSchemaPolicyRegistryServer struct {
+	ttnpb.UnimplementedApplicationPayloadSchemaPolicyRegistryServer
+
+	AS *ApplicationServer
+}
+
+// Get implements ttnpb.ApplicationPayloadSchemaPolicyRegistryServer.
+func (s *payloadSchemaPolicyRegistryServer) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.ApplicationPayloadSchemaPolicy, error) {
+	policy, err := s.AS.GetPayloadSchemaPolicy(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return payloadSchemaPolicyToPB(policy)
+}
+
+// Set implements ttnpb.ApplicationPayloadSchemaPolicyRegistryServer.
+func (s *payloadSchemaPolicyRegistryServer) Set(
+	ctx context.Context, req *ttnpb.SetApplicationPayloadSchemaPolicyRequest,
+) (*ttnpb.ApplicationPayloadSchemaPolicy, error) {
+	policy, err := payloadSchemaPolicyFromPB(req.GetPolicy())
+	if err != nil {
+		return nil, err
+	}
+	if err := s.AS.SetPayloadSchemaPolicy(ctx, req.GetApplicationIds(), policy); err != nil {
+		return nil, err
+	}
+	return req.GetPolicy(), nil
+}
+
+// Delete implements ttnpb.ApplicationPayloadSchemaPolicyRegistryServer.
+func (s *payloadSchemaPolicyRegistryServer) Delete(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := s.AS.SetPayloadSchemaPolicy(ctx, ids, nil); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/bluele/gcache"
+	ioweb "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/io/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errMockPayloadSchemaPolicyNotFound = errors.DefineNotFound(
+	"mock_payload_schema_policy_not_found", "policy not found",
+)
+
+type mockPayloadSchemaRegistry struct {
+	policies map[string]*payloadschema.Policy
+}
+
+func (r *mockPayloadSchemaRegistry) Get(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*payloadschema.Policy, error) {
+	policy, ok := r.policies[unique.ID(ctx, ids)]
+	if !ok {
+		return nil, errMockPayloadSchemaPolicyNotFound.New()
+	}
+	return policy, nil
+}
+
+func (r *mockPayloadSchemaRegistry) Set(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *payloadschema.Policy,
+) error {
+	if policy == nil {
+		delete(r.policies, unique.ID(ctx, ids))
+		return nil
+	}
+	r.policies[unique.ID(ctx, ids)] = policy
+	return nil
+}
+
+type mockQuarantineWebhooks struct {
+	ioweb.Webhooks
+}
+
+func TestValidatePayloadSchema(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	as := &ApplicationServer{
+		payloadSchemaRegistry: &mockPayloadSchemaRegistry{
+			policies: map[string]*payloadschema.Policy{
+				"app-1": {
+					Schema: json.RawMessage(`{
+						"type": "object",
+						"required": ["temperature"],
+						"properties": {"temperature": {"type": "number", "maximum": 85}}
+					}`),
+					QuarantineWebhookID: "quarantine",
+				},
+			},
+		},
+		payloadSchemaCache: gcache.New(payloadSchemaCacheSize).LRU().Expiration(payloadSchemaCacheTTL).Build(),
+	}
+
+	up := func(appID string, payload map[string]any) *ttnpb.ApplicationUp {
+		decoded, err := structpb.NewStruct(payload)
+		if err != nil {
+			t.Fatalf("Failed to create decoded payload: %v", err)
+		}
+		return &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+				DeviceId:       "dev-1",
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{
+				DecodedPayload: decoded,
+			}},
+		}
+	}
+
+	valid := up("app-1", map[string]any{"temperature": 21.5})
+	a.So(as.validatePayloadSchema(ctx, valid), should.BeEmpty)
+	a.So(valid.GetUplinkMessage().DecodedPayloadWarnings, should.BeEmpty)
+
+	invalid := up("app-1", map[string]any{"temperature": 120})
+	// Without webhooks, uplink messages that fail validation are tagged but not quarantined.
+	a.So(as.validatePayloadSchema(ctx, invalid), should.BeEmpty)
+	a.So(invalid.GetUplinkMessage().DecodedPayloadWarnings, should.Resemble, []string{
+		"schema: /temperature: must be at most 85",
+	})
+
+	as.webhooks = &mockQuarantineWebhooks{}
+	invalid = up("app-1", map[string]any{"humidity": 40})
+	a.So(as.validatePayloadSchema(ctx, invalid), should.Equal, "quarantine")
+	a.So(invalid.GetUplinkMessage().DecodedPayloadWarnings, should.Resemble, []string{
+		`schema: /: missing required property "temperature"`,
+	})
+
+	other := up("app-2", map[string]any{"humidity": 40})
+	a.So(as.validatePayloadSchema(ctx, other), should.BeEmpty)
+	a.So(other.GetUplinkMessage().DecodedPayloadWarnings, should.BeEmpty)
+}
+
+func TestPayloadSchemaPolicyRegistry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	as := &ApplicationServer{
+		payloadSchemaRegistry: &mockPayloadSchemaRegistry{policies: map[string]*payloadschema.Policy{}},
+		payloadSchemaCache:    gcache.New(payloadSchemaCacheSize).LRU().Expiration(payloadSchemaCacheTTL).Build(),
+	}
+	srv := &payloadSchemaPolicyRegistryServer{AS: as}
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}
+	ctx = rights.NewContext(ctx, &rights.Rights{
+		ApplicationRights: *rights.NewMap(map[string]*ttnpb.Rights{
+			unique.ID(ctx, ids): ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC),
+		}),
+	})
+
+	schema, err := structpb.NewStruct(map[string]any{
+		"type":     "object",
+		"required": []any{"temperature"},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	policy := &ttnpb.ApplicationPayloadSchemaPolicy{Schema: schema}
+	_, err = srv.Set(ctx, &ttnpb.SetApplicationPayloadSchemaPolicyRequest{ApplicationIds: ids, Policy: policy})
+	a.So(err, should.BeNil)
+	got, err := srv.Get(ctx, ids)
+	if a.So(err, should.BeNil) {
+		a.So(got, should.Resemble, policy)
+	}
+
+	// Quarantine webhooks require webhooks to be configured.
+	_, err = srv.Set(ctx, &ttnpb.SetApplicationPayloadSchemaPolicyRequest{
+		ApplicationIds: ids,
+		Policy:         &ttnpb.ApplicationPayloadSchemaPolicy{Schema: schema, QuarantineWebhookId: "quarantine"},
+	})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	_, err = srv.Delete(ctx, ids)
+	a.So(err, should.BeNil)
+	_, err = srv.Get(ctx, ids)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
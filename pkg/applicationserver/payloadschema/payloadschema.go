@@ -0,0 +1,253 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package payloadschema implements the validation of decoded uplink payloads against JSON schemas.
+//
+// The supported subset of JSON Schema consists of the keywords type, enum, properties, required,
+// additionalProperties, items, minItems, maxItems, minimum, maximum, minLength, maxLength and pattern.
+package payloadschema
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"unicode/utf8"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Schema is a JSON schema.
+type Schema struct {
+	Type                 string             `json:"type,omitempty"`
+	Enum                 []any              `json:"enum,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *bool              `json:"additionalProperties,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	MinItems             *int               `json:"minItems,omitempty"`
+	MaxItems             *int               `json:"maxItems,omitempty"`
+	Minimum              *float64           `json:"minimum,omitempty"`
+	Maximum              *float64           `json:"maximum,omitempty"`
+	MinLength            *int               `json:"minLength,omitempty"`
+	MaxLength            *int               `json:"maxLength,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+
+	pattern *regexp.Regexp
+}
+
+var (
+	errInvalidSchema  = errors.DefineInvalidArgument("invalid_schema", "invalid JSON schema")
+	errUnknownType    = errors.DefineInvalidArgument("unknown_type", "unknown type `{type}` at `{path}`")
+	errInvalidPattern = errors.DefineInvalidArgument("invalid_pattern", "invalid pattern at `{path}`")
+)
+
+var types = map[string]struct{}{
+	"object":  {},
+	"array":   {},
+	"string":  {},
+	"number":  {},
+	"integer": {},
+	"boolean": {},
+	"null":    {},
+}
+
+// Parse parses the JSON schema.
+func Parse(data []byte) (*Schema, error) {
+	s := &Schema{}
+	if err := json.Unmarshal(data, s); err != nil {
+		return nil, errInvalidSchema.WithCause(err)
+	}
+	if err := s.compile(""); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func (s *Schema) compile(path string) error {
+	if s.Type != "" {
+		if _, ok := types[s.Type]; !ok {
+			return errUnknownType.WithAttributes(
+				"type", s.Type,
+				"path", pathOrRoot(path),
+			)
+		}
+	}
+	if s.Pattern != "" {
+		pattern, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			return errInvalidPattern.WithAttributes("path", pathOrRoot(path)).WithCause(err)
+		}
+		s.pattern = pattern
+	}
+	for name, property := range s.Properties {
+		if property == nil {
+			continue
+		}
+		if err := property.compile(path + "/" + name); err != nil {
+			return err
+		}
+	}
+	if s.Items != nil {
+		if err := s.Items.compile(path + "/items"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// Validate validates the value against the schema, and returns the violations.
+// The value is a JSON value as decoded by encoding/json or as returned by structpb.Struct.AsMap.
+func (s *Schema) Validate(v any) []string {
+	var violations []string
+	s.validate("", v, &violations)
+	return violations
+}
+
+func (s *Schema) validate(path string, v any, violations *[]string) {
+	violate := func(format string, args ...any) {
+		*violations = append(*violations, fmt.Sprintf("%s: %s", pathOrRoot(path), fmt.Sprintf(format, args...)))
+	}
+	if s.Type != "" && !hasType(v, s.Type) {
+		violate("must be of type %s", s.Type)
+		return
+	}
+	if len(s.Enum) > 0 && !inEnum(v, s.Enum) {
+		violate("must be one of the enumerated values")
+	}
+	switch v := v.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, ok := v[name]; !ok {
+				violate("missing required property %q", name)
+			}
+		}
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			property, ok := s.Properties[name]
+			switch {
+			case ok && property != nil:
+				property.validate(path+"/"+name, v[name], violations)
+			case !ok && s.AdditionalProperties != nil && !*s.AdditionalProperties:
+				violate("additional property %q is not allowed", name)
+			}
+		}
+	case []any:
+		if s.MinItems != nil && len(v) < *s.MinItems {
+			violate("must have at least %d items", *s.MinItems)
+		}
+		if s.MaxItems != nil && len(v) > *s.MaxItems {
+			violate("must have at most %d items", *s.MaxItems)
+		}
+		if s.Items != nil {
+			for i, item := range v {
+				s.Items.validate(fmt.Sprintf("%s/%d", path, i), item, violations)
+			}
+		}
+	case float64:
+		if s.Minimum != nil && v < *s.Minimum {
+			violate("must be at least %v", *s.Minimum)
+		}
+		if s.Maximum != nil && v > *s.Maximum {
+			violate("must be at most %v", *s.Maximum)
+		}
+	case string:
+		n := utf8.RuneCountInString(v)
+		if s.MinLength != nil && n < *s.MinLength {
+			violate("must be at least %d characters", *s.MinLength)
+		}
+		if s.MaxLength != nil && n > *s.MaxLength {
+			violate("must be at most %d characters", *s.MaxLength)
+		}
+		if s.pattern != nil && !s.pattern.MatchString(v) {
+			violate("must match pattern %q", s.Pattern)
+		}
+	}
+}
+
+func hasType(v any, t string) bool {
+	switch v := v.(type) {
+	case map[string]any:
+		return t == "object"
+	case []any:
+		return t == "array"
+	case string:
+		return t == "string"
+	case float64:
+		return t == "number" || t == "integer" && v == math.Trunc(v)
+	case bool:
+		return t == "boolean"
+	case nil:
+		return t == "null"
+	default:
+		return false
+	}
+}
+
+func inEnum(v any, enum []any) bool {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return false
+	}
+	for _, e := range enum {
+		if eb, err := json.Marshal(e); err == nil && string(eb) == string(b) {
+			return true
+		}
+	}
+	return false
+}
+
+// Policy is the payload schema policy of an application.
+type Policy struct {
+	// Schema is the JSON schema that the decoded payload of uplink messages must match.
+	Schema json.RawMessage `json:"schema"`
+	// QuarantineWebhookID is the ID of the webhook to which uplink messages that fail validation are sent, instead
+	// of to the integrations of the application. If empty, these uplink messages are forwarded as usual.
+	QuarantineWebhookID string `json:"quarantine_webhook_id,omitempty"`
+}
+
+// WarningPrefix is the prefix of the decoded payload warnings of the violations of the payload schema.
+const WarningPrefix = "schema: "
+
+// Warnings returns the violations as decoded payload warnings.
+func Warnings(violations []string) []string {
+	warnings := make([]string, 0, len(violations))
+	for _, v := range violations {
+		warnings = append(warnings, WarningPrefix+v)
+	}
+	return warnings
+}
+
+// Registry stores the payload schema policies of applications.
+type Registry interface {
+	// Get returns the policy of the application.
+	// If the application has no policy, an error is returned for which errors.IsNotFound is true.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*Policy, error)
+	// Set sets the policy of the application. If policy is nil, the policy is deleted.
+	Set(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *Policy) error
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package payloadschema_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestParse(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	_, err := Parse([]byte(`{"type":"object"`))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = Parse([]byte(`{"properties":{"temperature":{"type":"float"}}}`))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = Parse([]byte(`{"properties":{"name":{"type":"string","pattern":"("}}}`))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	schema, err := Parse([]byte(`{
+		"type": "object",
+		"required": ["temperature", "battery"],
+		"additionalProperties": false,
+		"properties": {
+			"temperature": {"type": "number", "minimum": -40, "maximum": 85},
+			"battery": {"type": "integer", "minimum": 0, "maximum": 100},
+			"status": {"type": "string", "enum": ["ok", "alarm"]},
+			"serial": {"type": "string", "pattern": "^[0-9A-F]{8}$", "maxLength": 8},
+			"samples": {"type": "array", "maxItems": 2, "items": {"type": "number"}}
+		}
+	}`))
+	if err != nil {
+		t.Fatalf("Failed to parse schema: %v", err)
+	}
+
+	for _, tc := range []struct {
+		Name       string
+		Payload    string
+		Violations []string
+	}{
+		{
+			Name:    "Valid",
+			Payload: `{"temperature": 21.5, "battery": 90, "status": "ok", "serial": "0A1B2C3D", "samples": [1, 2]}`,
+		},
+		{
+			Name:    "MissingRequired",
+			Payload: `{"temperature": 21.5}`,
+			Violations: []string{
+				`/: missing required property "battery"`,
+			},
+		},
+		{
+			Name:    "OutOfRange",
+			Payload: `{"temperature": 120, "battery": 90.5}`,
+			Violations: []string{
+				"/battery: must be of type integer",
+				"/temperature: must be at most 85",
+			},
+		},
+		{
+			Name:    "Strings",
+			Payload: `{"temperature": 21.5, "battery": 90, "status": "unknown", "serial": "xyz"}`,
+			Violations: []string{
+				`/serial: must match pattern "^[0-9A-F]{8}$"`,
+				"/status: must be one of the enumerated values",
+			},
+		},
+		{
+			Name:    "Arrays",
+			Payload: `{"temperature": 21.5, "battery": 90, "samples": [1, "2", 3]}`,
+			Violations: []string{
+				"/samples: must have at most 2 items",
+				"/samples/1: must be of type number",
+			},
+		},
+		{
+			Name:    "AdditionalProperty",
+			Payload: `{"temperature": 21.5, "battery": 90, "humidity": 40}`,
+			Violations: []string{
+				`/: additional property "humidity" is not allowed`,
+			},
+		},
+		{
+			Name:    "WrongType",
+			Payload: `[21.5]`,
+			Violations: []string{
+				"/: must be of type object",
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+
+			var v any
+			if err := json.Unmarshal([]byte(tc.Payload), &v); err != nil {
+				t.Fatalf("Failed to unmarshal payload: %v", err)
+			}
+			a.So(schema.Validate(v), should.Resemble, tc.Violations)
+		})
+	}
+}
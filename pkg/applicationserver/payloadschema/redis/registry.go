@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the payload schema policy registry of the Application Server using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database corruption")
+	errPolicyNotFound     = errors.DefineNotFound("policy_not_found", "payload schema policy not found")
+)
+
+// Registry is an implementation of payloadschema.Registry.
+// The policies are stored in a single hash keyed by the unique ID of the application.
+type Registry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *Registry) key() string {
+	return r.Redis.Key("policies")
+}
+
+// Get implements payloadschema.Registry.
+func (r *Registry) Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*payloadschema.Policy, error) {
+	uid := unique.ID(ctx, ids)
+	v, err := r.Redis.HGet(ctx, r.key(), uid).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errPolicyNotFound.New()
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	policy := &payloadschema.Policy{}
+	if err := json.Unmarshal([]byte(v), policy); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return policy, nil
+}
+
+// Set implements payloadschema.Registry.
+func (r *Registry) Set(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *payloadschema.Policy) error {
+	uid := unique.ID(ctx, ids)
+	if policy == nil {
+		if err := r.Redis.HDel(ctx, r.key(), uid).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.HSet(ctx, r.key(), uid, b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
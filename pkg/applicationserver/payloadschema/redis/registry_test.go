@@ -0,0 +1,56 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/payloadschema/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.Registry{Redis: cl}
+
+	app1 := &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"}
+
+	_, err := r.Get(ctx, app1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	policy1 := &payloadschema.Policy{
+		Schema:              json.RawMessage(`{"type":"object","required":["temperature"]}`),
+		QuarantineWebhookID: "quarantine",
+	}
+	a.So(r.Set(ctx, app1, policy1), should.BeNil)
+
+	policy, err := r.Get(ctx, app1)
+	a.So(err, should.BeNil)
+	a.So(policy, should.Resemble, policy1)
+
+	a.So(r.Set(ctx, app1, nil), should.BeNil)
+	_, err = r.Get(ctx, app1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
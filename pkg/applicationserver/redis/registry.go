@@ -281,6 +281,38 @@ func (r *DeviceRegistry) Range(ctx context.Context, paths []string, f func(conte
 	})
 }
 
+// RangeByApplication ranges over the end devices of the application and calls the callback function,
+// until false is returned.
+func (r *DeviceRegistry) RangeByApplication(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	if err := ids.ValidateContext(ctx); err != nil {
+		return err
+	}
+	pattern := r.uidKey(unique.ID(ctx, ids) + ".*")
+	deviceEntityRegex, err := ttnredis.EntityRegex(pattern)
+	if err != nil {
+		return err
+	}
+	return ttnredis.RangeRedisKeys(ctx, r.Redis, pattern, ttnredis.DefaultRangeCount, func(key string) (bool, error) {
+		if !deviceEntityRegex.MatchString(key) {
+			return true, nil
+		}
+		dev := &ttnpb.EndDevice{}
+		if err := ttnredis.GetProto(ctx, r.Redis, key).ScanProto(dev); err != nil {
+			return false, err
+		}
+		dev, err := ttnpb.FilterGetEndDevice(dev, paths...)
+		if err != nil {
+			return false, err
+		}
+		return f(ctx, dev.Ids, dev), nil
+	})
+}
+
 func applyLinkFieldMask(dst, src *ttnpb.ApplicationLink, paths ...string) (*ttnpb.ApplicationLink, error) {
 	if dst == nil {
 		dst = &ttnpb.ApplicationLink{}
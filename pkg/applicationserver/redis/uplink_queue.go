@@ -0,0 +1,187 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"runtime/trace"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const uplinkPayloadKey = "payload"
+
+var (
+	errInvalidUplinkPayload = errors.DefineCorruption("invalid_uplink_payload", "invalid uplink payload")
+	errMissingUplinkPayload = errors.DefineDataLoss("missing_uplink_payload", "missing uplink payload")
+)
+
+// UplinkQueue is a Redis queue of upstream messages, with a stream per processing stage.
+// Messages are acknowledged only once they are processed, and messages that are not acknowledged within MinIdle
+// are redelivered to another consumer. This provides at-least-once delivery between the processing stages.
+type UplinkQueue struct {
+	Redis *ttnredis.Client
+	// MaxLen is the approximate maximum length of the stream of a stage.
+	MaxLen int64
+	// Group is the consumer group of the streams.
+	Group string
+	// MinIdle is the minimum time after which messages that are not acknowledged are redelivered.
+	MinIdle time.Duration
+	// StreamBlockLimit is the duration for which reading the stream blocks.
+	StreamBlockLimit time.Duration
+}
+
+func (q *UplinkQueue) stageKey(stage string) string {
+	return q.Redis.Key("stage", stage)
+}
+
+// Init initializes the streams of the given stages.
+func (q *UplinkQueue) Init(ctx context.Context, stages ...string) error {
+	for _, stage := range stages {
+		err := q.Redis.XGroupCreateMkStream(ctx, q.stageKey(stage), q.Group, "0").Err()
+		if err != nil && !ttnredis.IsConsumerGroupExistsErr(err) {
+			return ttnredis.ConvertError(err)
+		}
+	}
+	return nil
+}
+
+// Add adds the upstream messages to the stream of the stage.
+func (q *UplinkQueue) Add(ctx context.Context, stage string, ups ...*ttnpb.ApplicationUp) error {
+	if len(ups) == 0 {
+		return nil
+	}
+	defer trace.StartRegion(ctx, "add application uplinks to queue").End()
+
+	_, err := q.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for _, up := range ups {
+			s, err := ttnredis.MarshalProto(up)
+			if err != nil {
+				return err
+			}
+			p.XAdd(ctx, &redis.XAddArgs{
+				Stream: q.stageKey(stage),
+				MaxLen: q.MaxLen,
+				Approx: true,
+				Values: map[string]any{
+					uplinkPayloadKey: s,
+				},
+			})
+		}
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// unmarshalUplinks unmarshals the upstream messages in msgs. Invalid messages are skipped, since they can never be
+// processed, and they are acknowledged together with the valid messages.
+func unmarshalUplinks(ctx context.Context, msgs []redis.XMessage) []*ttnpb.ApplicationUp {
+	ups := make([]*ttnpb.ApplicationUp, 0, len(msgs))
+	for _, msg := range msgs {
+		up, err := unmarshalUplink(msg)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithField("message_id", msg.ID).Warn("Skip invalid queued uplink")
+			continue
+		}
+		ups = append(ups, up)
+	}
+	return ups
+}
+
+func unmarshalUplink(msg redis.XMessage) (*ttnpb.ApplicationUp, error) {
+	v, ok := msg.Values[uplinkPayloadKey]
+	if !ok {
+		return nil, errMissingUplinkPayload.New()
+	}
+	s, ok := v.(string)
+	if !ok {
+		return nil, errInvalidUplinkPayload.New()
+	}
+	up := &ttnpb.ApplicationUp{}
+	if err := ttnredis.UnmarshalProto(s, up); err != nil {
+		return nil, errInvalidUplinkPayload.WithCause(err)
+	}
+	return up, nil
+}
+
+// Pop calls f with at most limit upstream messages of the stage. Messages of which the processing by another
+// consumer timed out are claimed first. If no messages are available, Pop blocks for at most StreamBlockLimit.
+// The messages are acknowledged and removed from the stream only if f returns without error.
+func (q *UplinkQueue) Pop(
+	ctx context.Context, stage, consumerID string, limit int, f func(context.Context, ...*ttnpb.ApplicationUp) error,
+) error {
+	stream := q.stageKey(stage)
+	msgs, _, err := q.Redis.XAutoClaim(ctx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    q.Group,
+		Consumer: consumerID,
+		MinIdle:  q.MinIdle,
+		Start:    "-",
+		Count:    int64(limit),
+	}).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if len(msgs) == 0 {
+		xs, err := q.Redis.XReadGroup(ctx, &redis.XReadGroupArgs{
+			Group:    q.Group,
+			Consumer: consumerID,
+			Streams:  []string{stream, ">"},
+			Count:    int64(limit),
+			Block:    q.StreamBlockLimit,
+		}).Result()
+		if err != nil {
+			if errors.Is(err, redis.Nil) {
+				return nil
+			}
+			return ttnredis.ConvertError(err)
+		}
+		for _, x := range xs {
+			msgs = append(msgs, x.Messages...)
+		}
+	}
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	defer trace.StartRegion(ctx, "pop application uplinks from queue").End()
+
+	if ups := unmarshalUplinks(ctx, msgs); len(ups) > 0 {
+		if err := f(ctx, ups...); err != nil {
+			return err
+		}
+	}
+	ids := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		ids = append(ids, msg.ID)
+	}
+	_, err = q.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		p.XAck(ctx, stream, q.Group, ids...)
+		p.XDel(ctx, stream, ids...)
+		return nil
+	})
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
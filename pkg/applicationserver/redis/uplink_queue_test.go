@@ -0,0 +1,90 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver"
+	. "go.thethings.network/lorawan-stack/v3/pkg/applicationserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var _ applicationserver.UplinkQueue = &UplinkQueue{}
+
+func TestUplinkQueue(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	t.Cleanup(func() {
+		flush()
+		cl.Close()
+	})
+
+	q := &UplinkQueue{
+		Redis:            cl,
+		MaxLen:           100,
+		Group:            "test",
+		StreamBlockLimit: test.Delay,
+	}
+	if err := q.Init(ctx, applicationserver.UplinkStages...); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	ups := make([]*ttnpb.ApplicationUp, 0, 3)
+	for _, devID := range []string{"dev-1", "dev-2", "dev-3"} {
+		ups = append(ups, &ttnpb.ApplicationUp{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+				DeviceId:       devID,
+			},
+			Up: &ttnpb.ApplicationUp_UplinkMessage{UplinkMessage: &ttnpb.ApplicationUplink{FPort: 1}},
+		})
+	}
+	if err := q.Add(ctx, applicationserver.UplinkStageProcess, ups...); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	pop := func(stage string, err error) []*ttnpb.ApplicationUp {
+		var popped []*ttnpb.ApplicationUp
+		popErr := q.Pop(ctx, stage, "consumer", 2, func(_ context.Context, ups ...*ttnpb.ApplicationUp) error {
+			popped = append(popped, ups...)
+			return err
+		})
+		a.So(popErr, should.Equal, err)
+		return popped
+	}
+
+	// Messages are not acknowledged if the handler fails.
+	errTest := errors.New("test")
+	a.So(pop(applicationserver.UplinkStageProcess, errTest), should.Resemble, ups[:2])
+
+	// Messages that are not acknowledged are delivered again.
+	a.So(pop(applicationserver.UplinkStageProcess, nil), should.Resemble, ups[:2])
+	a.So(pop(applicationserver.UplinkStageProcess, nil), should.Resemble, ups[2:])
+	a.So(pop(applicationserver.UplinkStageProcess, nil), should.BeEmpty)
+
+	// Stages have independent queues.
+	a.So(pop(applicationserver.UplinkStagePublish, nil), should.BeEmpty)
+	if err := q.Add(ctx, applicationserver.UplinkStagePublish, ups[0]); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pop(applicationserver.UplinkStagePublish, nil), should.Resemble, ups[:1])
+	a.So(pop(applicationserver.UplinkStageProcess, nil), should.BeEmpty)
+}
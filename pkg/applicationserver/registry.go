@@ -16,6 +16,7 @@ package applicationserver
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
@@ -31,6 +32,14 @@ type DeviceRegistry interface {
 	Set(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string, f func(*ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error)) (*ttnpb.EndDevice, error)
 	// Range ranges over the end devices and calls the callback function, until false is returned.
 	Range(ctx context.Context, paths []string, f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool) error
+	// RangeByApplication ranges over the end devices of the application and calls the callback function,
+	// until false is returned.
+	RangeByApplication(
+		ctx context.Context,
+		ids *ttnpb.ApplicationIdentifiers,
+		paths []string,
+		f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+	) error
 	// BatchDelete deletes a batch of end devices.
 	BatchDelete(
 		ctx context.Context,
@@ -105,6 +114,25 @@ func (w replacedEndDeviceFieldRegistryWrapper) Range(ctx context.Context, paths
 	})
 }
 
+func (w replacedEndDeviceFieldRegistryWrapper) RangeByApplication(
+	ctx context.Context,
+	ids *ttnpb.ApplicationIdentifiers,
+	paths []string,
+	f func(context.Context, *ttnpb.EndDeviceIdentifiers, *ttnpb.EndDevice) bool,
+) error {
+	paths, replaced := registry.MatchReplacedEndDeviceFields(paths, w.fields)
+	return w.registry.RangeByApplication(ctx, ids, paths,
+		func(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, dev *ttnpb.EndDevice) bool {
+			if dev != nil {
+				for _, d := range replaced {
+					d.GetTransform(dev)
+				}
+			}
+			return f(ctx, ids, dev)
+		},
+	)
+}
+
 func wrapEndDeviceRegistryWithReplacedFields(r DeviceRegistry, fields ...registry.ReplacedEndDeviceField) DeviceRegistry {
 	return replacedEndDeviceFieldRegistryWrapper{
 		fields:   fields,
@@ -162,3 +190,10 @@ type ApplicationUplinkRegistry interface {
 	// BatchClear empties the uplink messages storage of multiple end devices.
 	BatchClear(ctx context.Context, devIDs []*ttnpb.EndDeviceIdentifiers) error
 }
+
+// ApplicationUplinkTrimmer is implemented by ApplicationUplinkRegistry implementations that can delete uplink
+// messages by age.
+type ApplicationUplinkTrimmer interface {
+	// Trim deletes the uplink messages of the end device that were received before the given time.
+	Trim(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, before time.Time) error
+}
@@ -0,0 +1,131 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the retention policy registry of the Application Server using Redis.
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database corruption")
+	errPolicyNotFound     = errors.DefineNotFound("policy_not_found", "retention policy not found")
+)
+
+// Registry is an implementation of retention.Registry.
+// The policies are stored in a single hash keyed by the unique ID of the application.
+type Registry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *Registry) key() string {
+	return r.Redis.Key("policies")
+}
+
+func (r *Registry) leaseKey() string {
+	return r.Redis.Key("lease")
+}
+
+// Get implements retention.Registry.
+func (r *Registry) Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*retention.Policy, error) {
+	uid := unique.ID(ctx, ids)
+	v, err := r.Redis.HGet(ctx, r.key(), uid).Result()
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return nil, errPolicyNotFound.New()
+		}
+		return nil, ttnredis.ConvertError(err)
+	}
+	policy := &retention.Policy{}
+	if err := json.Unmarshal([]byte(v), policy); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return policy, nil
+}
+
+// Set implements retention.Registry.
+func (r *Registry) Set(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *retention.Policy) error {
+	uid := unique.ID(ctx, ids)
+	if policy == nil {
+		if err := r.Redis.HDel(ctx, r.key(), uid).Err(); err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		return nil
+	}
+	b, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.HSet(ctx, r.key(), uid, b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Lease implements retention.Registry.
+// The lease is not released, but expires after ttl, so that the policies are enforced by one instance per period.
+func (r *Registry) Lease(ctx context.Context, ttl time.Duration) (bool, error) {
+	id, err := ttnredis.GenerateLockerID()
+	if err != nil {
+		return false, err
+	}
+	ok, err := r.Redis.SetNX(ctx, r.leaseKey(), id, ttl).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return ok, nil
+}
+
+const rangeScanCount = 1000
+
+// Range implements retention.Registry.
+func (r *Registry) Range(
+	ctx context.Context, f func(context.Context, *ttnpb.ApplicationIdentifiers, *retention.Policy) bool,
+) error {
+	k := r.key()
+	var cursor uint64
+	for {
+		kvs, next, err := r.Redis.HScan(ctx, k, cursor, "", rangeScanCount).Result()
+		if err != nil {
+			return ttnredis.ConvertError(err)
+		}
+		for i := 0; i+1 < len(kvs); i += 2 {
+			ids, err := unique.ToApplicationID(kvs[i])
+			if err != nil {
+				return errDatabaseCorruption.WithCause(err)
+			}
+			policy := &retention.Policy{}
+			if err := json.Unmarshal([]byte(kvs[i+1]), policy); err != nil {
+				return errDatabaseCorruption.WithCause(err)
+			}
+			if !f(ctx, ids, policy) {
+				return nil
+			}
+		}
+		if next == 0 {
+			return nil
+		}
+		cursor = next
+	}
+}
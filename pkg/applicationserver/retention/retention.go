@@ -0,0 +1,112 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package retention defines the data retention policies of applications in the Application Server.
+package retention
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// day is the unit of the retention periods.
+const day = 24 * time.Hour
+
+// Policy is the data retention policy of an application.
+// A retention period of zero days means that the data is retained as long as the defaults of the deployment allow.
+type Policy struct {
+	// UplinkStorageDays is the number of days that uplink messages are kept in the uplink storage.
+	UplinkStorageDays uint32 `json:"uplink_storage_days,omitempty"`
+	// EventHistoryDays is the number of days that the historical events of the application and its end devices
+	// are kept.
+	EventHistoryDays uint32 `json:"event_history_days,omitempty"`
+	// DecodedPayloadOnly indicates that the raw FRMPayload of uplink messages is not forwarded to the
+	// integrations, so that only the decoded payload leaves the Application Server.
+	DecodedPayloadOnly bool `json:"decoded_payload_only,omitempty"`
+}
+
+// Caps are the maximum retention periods that can be configured in policies. A cap of zero days means unlimited.
+type Caps struct {
+	MaxUplinkStorageDays uint32
+	MaxEventHistoryDays  uint32
+}
+
+var errRetentionExceedsCap = errors.DefineInvalidArgument(
+	"retention_exceeds_cap", "retention of `{days}` days for `{field}` exceeds the maximum of `{max}` days",
+)
+
+// Validate returns an error if the retention periods of the policy exceed the caps.
+func (p *Policy) Validate(caps Caps) error {
+	for _, c := range []struct {
+		field     string
+		days, max uint32
+	}{
+		{field: "uplink_storage_days", days: p.UplinkStorageDays, max: caps.MaxUplinkStorageDays},
+		{field: "event_history_days", days: p.EventHistoryDays, max: caps.MaxEventHistoryDays},
+	} {
+		if c.max > 0 && c.days > c.max {
+			return errRetentionExceedsCap.WithAttributes(
+				"field", c.field,
+				"days", c.days,
+				"max", c.max,
+			)
+		}
+	}
+	return nil
+}
+
+// effectiveDays returns the number of days clamped to the cap, or the cap if days is zero.
+func effectiveDays(days, max uint32) uint32 {
+	if days == 0 || (max > 0 && days > max) {
+		return max
+	}
+	return days
+}
+
+// UplinkStorageBefore returns the time before which uplink messages are deleted from the uplink storage, taking
+// into account the caps. The returned boolean is false if uplink messages are retained indefinitely.
+func (p *Policy) UplinkStorageBefore(now time.Time, caps Caps) (time.Time, bool) {
+	days := effectiveDays(p.UplinkStorageDays, caps.MaxUplinkStorageDays)
+	if days == 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-time.Duration(days) * day), true
+}
+
+// EventHistoryBefore returns the time before which historical events are deleted, taking into account the caps.
+// The returned boolean is false if historical events are retained as long as the event store allows.
+func (p *Policy) EventHistoryBefore(now time.Time, caps Caps) (time.Time, bool) {
+	days := effectiveDays(p.EventHistoryDays, caps.MaxEventHistoryDays)
+	if days == 0 {
+		return time.Time{}, false
+	}
+	return now.Add(-time.Duration(days) * day), true
+}
+
+// Registry stores the data retention policies of applications.
+type Registry interface {
+	// Get returns the policy of the application.
+	// If the application has no policy, an error is returned for which errors.IsNotFound is true.
+	Get(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) (*Policy, error)
+	// Set sets the policy of the application. If policy is nil, the policy is deleted.
+	Set(ctx context.Context, ids *ttnpb.ApplicationIdentifiers, policy *Policy) error
+	// Range calls f for the policies of all applications, until f returns false.
+	Range(ctx context.Context, f func(context.Context, *ttnpb.ApplicationIdentifiers, *Policy) bool) error
+	// Lease acquires the cluster-wide lease to enforce the policies for the duration of ttl.
+	// It returns false if the lease is held by another instance.
+	Lease(ctx context.Context, ttl time.Duration) (bool, error)
+}
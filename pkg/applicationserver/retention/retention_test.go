@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package retention_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/applicationserver/retention"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPolicyValidate(t *testing.T) {
+	t.Parallel()
+	caps := retention.Caps{MaxUplinkStorageDays: 30, MaxEventHistoryDays: 7}
+	for _, tc := range []struct {
+		Name   string
+		Policy retention.Policy
+		Caps   retention.Caps
+		OK     bool
+	}{
+		{Name: "Empty", Caps: caps, OK: true},
+		{Name: "WithinCaps", Policy: retention.Policy{UplinkStorageDays: 30, EventHistoryDays: 7}, Caps: caps, OK: true},
+		{Name: "UplinkStorageExceedsCap", Policy: retention.Policy{UplinkStorageDays: 31}, Caps: caps},
+		{Name: "EventHistoryExceedsCap", Policy: retention.Policy{EventHistoryDays: 8}, Caps: caps},
+		{Name: "Unlimited", Policy: retention.Policy{UplinkStorageDays: 365, EventHistoryDays: 365}, OK: true},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			err := tc.Policy.Validate(tc.Caps)
+			if tc.OK {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestPolicyBefore(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	now := time.Unix(1000000, 0)
+	caps := retention.Caps{MaxUplinkStorageDays: 30, MaxEventHistoryDays: 7}
+
+	_, ok := (&retention.Policy{}).UplinkStorageBefore(now, retention.Caps{})
+	a.So(ok, should.BeFalse)
+
+	before, ok := (&retention.Policy{}).UplinkStorageBefore(now, caps)
+	a.So(ok, should.BeTrue)
+	a.So(before, should.Equal, now.Add(-30*24*time.Hour))
+
+	before, ok = (&retention.Policy{UplinkStorageDays: 2}).UplinkStorageBefore(now, caps)
+	a.So(ok, should.BeTrue)
+	a.So(before, should.Equal, now.Add(-2*24*time.Hour))
+
+	// Policies that were set before the caps were lowered are clamped to the caps.
+	before, ok = (&retention.Policy{EventHistoryDays: 10}).EventHistoryBefore(now, caps)
+	a.So(ok, should.BeTrue)
+	a.So(before, should.Equal, now.Add(-7*24*time.Hour))
+}
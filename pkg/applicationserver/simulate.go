@@ -0,0 +1,97 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errSimulateSessionMismatch = errors.DefineFailedPrecondition(
+	"simulate_session_mismatch", "sessions of the Application Server and Network Server do not match",
+)
+
+// SimulateNetworkUplink implements ttnpb.AsServer.
+// The FRMPayload is encrypted with the AppSKey of the current session. The Network Server computes the MIC with
+// the network session keys, so that those keys never leave the Network Server.
+func (as *ApplicationServer) SimulateNetworkUplink(
+	ctx context.Context, req *ttnpb.SimulateNetworkUplinkRequest,
+) (*ttnpb.UplinkMessage, error) {
+	if err := rights.RequireApplication(ctx, req.EndDeviceIds.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+		ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_UP_WRITE,
+	); err != nil {
+		return nil, err
+	}
+
+	dev, err := as.deviceRegistry.Get(ctx, req.EndDeviceIds, []string{"session"})
+	if err != nil {
+		return nil, err
+	}
+	if dev.GetSession() == nil {
+		return nil, errNoDeviceSession.New()
+	}
+	if dev.Session.GetKeys().GetAppSKey() == nil {
+		return nil, errNoAppSKey.New()
+	}
+	appSKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.AppSKey, as.KeyService())
+	if err != nil {
+		return nil, err
+	}
+
+	cc, err := as.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, as.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	nsDev, err := ttnpb.NewNsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: req.EndDeviceIds,
+		FieldMask:    ttnpb.FieldMask("session.dev_addr", "session.last_f_cnt_up"),
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+	if nsDev.GetSession() == nil {
+		return nil, errNoDeviceSession.New()
+	}
+	devAddr := types.MustDevAddr(dev.Session.DevAddr).OrZero()
+	if !devAddr.Equal(types.MustDevAddr(nsDev.Session.DevAddr).OrZero()) {
+		return nil, errSimulateSessionMismatch.New()
+	}
+
+	fCnt := nsDev.Session.LastFCntUp + 1
+	frmPayload, err := crypto.EncryptUplink(appSKey, devAddr, fCnt, req.FrmPayload)
+	if err != nil {
+		return nil, err
+	}
+	return ttnpb.NewAsNsClient(cc).SimulateUplink(ctx, &ttnpb.AsNsSimulateUplinkRequest{
+		EndDeviceIds: req.EndDeviceIds,
+		DevAddr:      devAddr.Bytes(),
+		FCnt:         fCnt,
+		FPort:        req.FPort,
+		FrmPayload:   frmPayload,
+		Confirmed:    req.Confirmed,
+	}, as.WithClusterAuth())
+}
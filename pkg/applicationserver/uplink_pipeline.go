@@ -0,0 +1,151 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package applicationserver
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// UplinkQueue is a durable queue of upstream messages between the stages of the uplink pipeline.
+type UplinkQueue interface {
+	// Add adds the upstream messages to the queue of the stage.
+	// Implementations must ensure that the messages are stored durably when Add returns.
+	Add(ctx context.Context, stage string, ups ...*ttnpb.ApplicationUp) error
+	// Pop calls f with at most limit upstream messages of the stage, if available, otherwise it blocks until they are.
+	// The messages are acknowledged only if f returns without error. Messages that are not acknowledged are
+	// delivered again, possibly to another consumer.
+	// consumerID identifies the consumer and must be unique for all concurrent calls to Pop.
+	Pop(ctx context.Context, stage, consumerID string, limit int, f func(context.Context, ...*ttnpb.ApplicationUp) error) error
+}
+
+const (
+	// UplinkStageProcess is the stage in which upstream messages are decrypted, decoded and normalized.
+	UplinkStageProcess = "process"
+	// UplinkStagePublish is the stage in which upstream messages are published to the frontends and integrations.
+	UplinkStagePublish = "publish"
+)
+
+// UplinkStages are the stages of the uplink pipeline.
+var UplinkStages = []string{UplinkStageProcess, UplinkStagePublish}
+
+var errUplinkQueue = errors.DefineInvalidArgument("uplink_queue", "invalid uplink queue")
+
+var uplinkPipelineTaskBackoff = &task.BackoffConfig{
+	Jitter:       task.DefaultBackoffConfig.Jitter,
+	IntervalFunc: task.MakeBackoffIntervalFunc(true, task.DefaultBackoffResetDuration, task.DefaultBackoffIntervals[:]...),
+}
+
+// isTransientUpError returns whether handling an upstream message failed because of a temporary condition, such as
+// an unavailable registry or integration. Upstream messages that fail with transient errors are retried.
+func isTransientUpError(err error) bool {
+	return errors.IsUnavailable(err) ||
+		errors.IsDeadlineExceeded(err) ||
+		errors.IsResourceExhausted(err) ||
+		errors.IsAborted(err)
+}
+
+// startUplinkPipeline registers the consumers of the stages of the uplink pipeline.
+func (as *ApplicationServer) startUplinkPipeline(ctx context.Context, conf UplinkPipelineConfig) error {
+	hostname, err := os.Hostname()
+	if err != nil {
+		return err
+	}
+	consumerIDPrefix := fmt.Sprintf("%s:%d", hostname, os.Getpid())
+	for stage, handler := range map[string]func(context.Context, ...*ttnpb.ApplicationUp) error{
+		UplinkStageProcess: as.handleProcessStage,
+		UplinkStagePublish: as.handlePublishStage,
+	} {
+		stage, handler := stage, handler
+		for i := 0; i < conf.NumConsumers; i++ {
+			consumerID := fmt.Sprintf("%s:%d", consumerIDPrefix, i)
+			as.RegisterTask(&task.Config{
+				Context: ctx,
+				ID:      fmt.Sprintf("uplink_pipeline_%s_%d", stage, i),
+				Func: func(ctx context.Context) error {
+					return as.uplinkQueue.Pop(ctx, stage, consumerID, conf.BatchSize, handler)
+				},
+				Restart: task.RestartAlways,
+				Backoff: uplinkPipelineTaskBackoff,
+			})
+		}
+	}
+	return nil
+}
+
+// handleProcessStage handles the upstream messages and adds the messages that pass to the publish stage.
+// If an upstream message fails with a transient error, the batch is retried. Other failures drop the message.
+func (as *ApplicationServer) handleProcessStage(ctx context.Context, ups ...*ttnpb.ApplicationUp) error {
+	links := make(map[string]*ttnpb.ApplicationLink)
+	next := make([]*ttnpb.ApplicationUp, 0, len(ups))
+	for _, up := range ups {
+		uid := unique.ID(ctx, up.EndDeviceIds.ApplicationIds)
+		link, ok := links[uid]
+		if !ok {
+			var err error
+			link, err = as.getLink(ctx, up.EndDeviceIds.ApplicationIds, []string{
+				"default_formatters",
+				"skip_payload_crypto",
+			})
+			if err != nil {
+				return err
+			}
+			links[uid] = link
+		}
+		ctx := as.receiveUp(ctx, up)
+		pass, err := as.handleUp(ctx, up, link)
+		if err != nil {
+			if isTransientUpError(err) {
+				log.FromContext(ctx).WithError(err).Warn("Failed to process upstream message, retry")
+				return err
+			}
+			log.FromContext(ctx).WithError(err).Warn("Failed to process upstream message")
+			registerDropUp(ctx, up, err)
+			continue
+		}
+		if pass {
+			next = append(next, up)
+		}
+	}
+	return as.uplinkQueue.Add(ctx, UplinkStagePublish, next...)
+}
+
+// handlePublishStage publishes the upstream messages to the frontends and integrations.
+// If publishing fails with a transient error, the batch is retried. Other failures drop the message.
+func (as *ApplicationServer) handlePublishStage(ctx context.Context, ups ...*ttnpb.ApplicationUp) error {
+	for _, up := range ups {
+		ctx := log.NewContextWithField(ctx, "device_uid", unique.ID(ctx, up.EndDeviceIds))
+		ctx = events.ContextWithCorrelationID(ctx, up.CorrelationIds...)
+		if err := as.publishUp(ctx, up); err != nil {
+			if isTransientUpError(err) {
+				log.FromContext(ctx).WithError(err).Warn("Failed to broadcast upstream message, retry")
+				return err
+			}
+			log.FromContext(ctx).WithError(err).Warn("Failed to broadcast upstream message")
+			registerDropUp(ctx, up, err)
+			continue
+		}
+		registerForwardUp(ctx, up)
+	}
+	return nil
+}
@@ -21,6 +21,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
@@ -29,7 +30,7 @@ var now = time.Now // override this in unit tests
 
 func newReq(ctx context.Context) cachedReq {
 	md := rpcmetadata.FromIncomingContext(ctx)
-	return cachedReq{AuthType: md.AuthType, AuthValue: md.AuthValue}
+	return cachedReq{TenantID: tenant.IDFromContext(ctx), AuthType: md.AuthType, AuthValue: md.AuthValue}
 }
 
 func newEntityReq(ctx context.Context, id *ttnpb.EntityIdentifiers) cachedReq {
@@ -39,6 +40,7 @@ func newEntityReq(ctx context.Context, id *ttnpb.EntityIdentifiers) cachedReq {
 }
 
 type cachedReq struct {
+	TenantID  string
 	UniqueID  string
 	AuthType  string
 	AuthValue string
@@ -21,6 +21,7 @@ import (
 
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -112,6 +113,14 @@ func TestCache(t *testing.T) {
 	a.So(mockFetcher.gatewayCtx, should.Equal, ctxB)
 	a.So(mockFetcher.organizationCtx, should.Equal, ctxB)
 
+	ctxC := tenant.NewContextWithID(ctxB, "other") // Responses are cached per tenant.
+	_, _ = fetchAuthInfo(ctxC, c)
+	a.So(mockFetcher.authInfoCtx, should.Equal, ctxC)
+	_ = fetchEntityRights(ctxC, "foo", c)
+	a.So(mockFetcher.applicationCtx, should.Equal, ctxC)
+	a.So(mockFetcher.gatewayCtx, should.Equal, ctxC)
+	a.So(mockFetcher.organizationCtx, should.Equal, ctxC)
+
 	timeTravel(time.Hour)
 
 	c.maybeCleanup()
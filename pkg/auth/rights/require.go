@@ -18,6 +18,7 @@ import (
 	"context"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
@@ -46,6 +47,15 @@ var (
 		"insufficient rights for application `{uid}`",
 		"missing",
 	)
+	ErrNoEndDeviceRights = errors.DefinePermissionDenied(
+		"no_end_device_rights",
+		"no rights for end device `{uid}`",
+	)
+	ErrInsufficientEndDeviceRights = errors.DefinePermissionDenied(
+		"insufficient_end_device_rights",
+		"insufficient rights for end device `{uid}`",
+		"missing",
+	)
 	ErrNoClientRights = errors.DefinePermissionDenied(
 		"no_client_rights",
 		"no rights for client `{uid}`",
@@ -136,6 +146,33 @@ func RequireApplication(ctx context.Context, id *ttnpb.ApplicationIdentifiers, r
 	return nil
 }
 
+// RequireEndDevice checks that context contains the required rights for the
+// given end device. These are the rights for the application of the end device,
+// or the rights of a token that is restricted to the end device.
+func RequireEndDevice(ctx context.Context, id *ttnpb.EndDeviceIdentifiers, required ...ttnpb.Right) error {
+	if md := rpcmetadata.FromIncomingContext(ctx); !IsToken(md.AuthValue) {
+		return RequireApplication(ctx, id.GetApplicationIds(), required...)
+	}
+	authInfo, err := AuthInfo(ctx)
+	if err != nil {
+		return err
+	}
+	scope := authInfo.GetEntityIdentifiers().GetDeviceIds()
+	if scope == nil {
+		return RequireApplication(ctx, id.GetApplicationIds(), required...)
+	}
+	uid := unique.ID(ctx, id)
+	if scope.GetApplicationIds().GetApplicationId() != id.GetApplicationIds().GetApplicationId() ||
+		scope.GetDeviceId() != id.GetDeviceId() {
+		return ErrNoEndDeviceRights.WithAttributes("uid", uid)
+	}
+	missing := ttnpb.RightsFrom(required...).Sub(ttnpb.RightsFrom(authInfo.GetRights()...)).GetRights()
+	if len(missing) > 0 {
+		return ErrInsufficientEndDeviceRights.WithAttributes("uid", uid, "missing", rightsNames(missing...))
+	}
+	return nil
+}
+
 // RequireClient checks that context contains the required rights for the
 // given client ID.
 func RequireClient(ctx context.Context, id *ttnpb.ClientIdentifiers, required ...ttnpb.Right) (err error) {
@@ -0,0 +1,256 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// TokenType is the type header of the JSON Web Tokens that the Identity Server issues for API keys.
+// It distinguishes these tokens from ID tokens that are signed with the same keys.
+const TokenType = "at+jwt"
+
+// TokenClaims are the claims of a JSON Web Token that the Identity Server issues for an API key.
+// The subject is the ID of the API key. The entity identifiers are those of the API key, or the end device
+// identifiers if the token is restricted to an end device (see RequireEndDevice).
+type TokenClaims struct {
+	jwt.Claims
+	EntityIDs       *ttnpb.EntityIdentifiers `json:"entity_ids"`
+	Rights          *ttnpb.Rights            `json:"rights"`
+	UniversalRights *ttnpb.Rights            `json:"universal_rights,omitempty"`
+	IsAdmin         bool                     `json:"is_admin,omitempty"`
+}
+
+// AuthInfo returns the authentication info of the API key of the token.
+func (c *TokenClaims) AuthInfo() *ttnpb.AuthInfoResponse {
+	return &ttnpb.AuthInfoResponse{
+		AccessMethod: &ttnpb.AuthInfoResponse_ApiKey{
+			ApiKey: &ttnpb.AuthInfoResponse_APIKeyAccess{
+				ApiKey: &ttnpb.APIKey{
+					Id:     c.Subject,
+					Rights: c.Rights.GetRights(),
+				},
+				EntityIds: c.EntityIDs,
+			},
+		},
+		UniversalRights: c.UniversalRights,
+		IsAdmin:         c.IsAdmin,
+	}
+}
+
+// IsToken returns whether the given bearer token is a JSON Web Token.
+// The tokens of the Identity Server (see auth.SplitToken) never start with an encoded JSON object.
+func IsToken(token string) bool {
+	return strings.HasPrefix(token, "eyJ") && strings.Count(token, ".") == 2
+}
+
+var errInvalidToken = errors.DefineUnauthenticated("invalid_token", "invalid or expired token")
+
+// ParseToken parses the token, verifies its signature with the given keys and validates its issuer and lifetime.
+func ParseToken(token string, keys *jose.JSONWebKeySet, issuer string, now time.Time) (*TokenClaims, error) {
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		return nil, errInvalidToken.WithCause(err)
+	}
+	if len(parsed.Headers) != 1 || parsed.Headers[0].ExtraHeaders[jose.HeaderType] != TokenType {
+		return nil, errInvalidToken.New()
+	}
+	claims := &TokenClaims{}
+	if err := parsed.Claims(keys, claims); err != nil {
+		return nil, errInvalidToken.WithCause(err)
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Issuer: issuer, Time: now}, 0); err != nil {
+		return nil, errInvalidToken.WithCause(err)
+	}
+	if claims.Subject == "" || claims.EntityIDs == nil || claims.Expiry == nil {
+		return nil, errInvalidToken.New()
+	}
+	return claims, nil
+}
+
+// KeySetProvider provides the JSON Web Key Set that verifies tokens.
+type KeySetProvider interface {
+	KeySet(context.Context) (*jose.JSONWebKeySet, error)
+}
+
+// KeySetProviderFunc is a function that implements KeySetProvider.
+type KeySetProviderFunc func(context.Context) (*jose.JSONWebKeySet, error)
+
+// KeySet implements KeySetProvider.
+func (f KeySetProviderFunc) KeySet(ctx context.Context) (*jose.JSONWebKeySet, error) {
+	return f(ctx)
+}
+
+var errFetchKeySet = errors.DefineUnavailable("fetch_key_set", "fetch JSON Web Key Set from `{url}`")
+
+// KeySetFromURL loads the JSON Web Key Set from the given URL.
+func KeySetFromURL(client *http.Client, url string) KeySetProvider {
+	return KeySetProviderFunc(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, errFetchKeySet.WithAttributes("url", url).WithCause(err)
+		}
+		res, err := client.Do(req)
+		if err != nil {
+			return nil, errFetchKeySet.WithAttributes("url", url).WithCause(err)
+		}
+		defer res.Body.Close()
+		if res.StatusCode < 200 || res.StatusCode >= 300 {
+			return nil, errFetchKeySet.WithAttributes("url", url)
+		}
+		b, err := io.ReadAll(res.Body)
+		if err != nil {
+			return nil, errFetchKeySet.WithAttributes("url", url).WithCause(err)
+		}
+		keys := &jose.JSONWebKeySet{}
+		if err := json.Unmarshal(b, keys); err != nil {
+			return nil, errFetchKeySet.WithAttributes("url", url).WithCause(err)
+		}
+		return keys, nil
+	})
+}
+
+// CacheKeySet caches the JSON Web Key Set of the given provider for the TTL.
+// Errors are not cached.
+func CacheKeySet(provider KeySetProvider, ttl time.Duration) KeySetProvider {
+	var (
+		keys *jose.JSONWebKeySet
+		t    time.Time
+		mu   sync.Mutex
+	)
+	return KeySetProviderFunc(func(ctx context.Context) (*jose.JSONWebKeySet, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if keys != nil && time.Since(t) < ttl {
+			return keys, nil
+		}
+		res, err := provider.KeySet(ctx)
+		if err != nil {
+			return nil, err
+		}
+		keys, t = res, time.Now()
+		return keys, nil
+	})
+}
+
+// NewTokenFetcher returns a new rights fetcher that verifies JSON Web Tokens of the Identity Server locally.
+// The rights of the entity of the API key are taken from the token, without a request to the Identity Server.
+// Other tokens, and the rights of other entities (such as through membership), are fetched with the given fetcher.
+func NewTokenFetcher(fetcher Fetcher, keys KeySetProvider, issuer string) Fetcher {
+	return &tokenFetcher{Fetcher: fetcher, keys: keys, issuer: issuer}
+}
+
+type tokenFetcher struct {
+	Fetcher
+	keys   KeySetProvider
+	issuer string
+}
+
+// claims returns the verified claims of the token in the context.
+// If the context does not contain a JSON Web Token, this method returns false.
+func (f *tokenFetcher) claims(ctx context.Context) (*TokenClaims, bool, error) {
+	md := rpcmetadata.FromIncomingContext(ctx)
+	if !strings.EqualFold(md.AuthType, "bearer") || !IsToken(md.AuthValue) {
+		return nil, false, nil
+	}
+	keys, err := f.keys.KeySet(ctx)
+	if err != nil {
+		return nil, true, err
+	}
+	claims, err := ParseToken(md.AuthValue, keys, f.issuer, time.Now())
+	if err != nil {
+		return nil, true, err
+	}
+	return claims, true, nil
+}
+
+// entityRights returns the rights of the token in the context if the token belongs to the given entity.
+func (f *tokenFetcher) entityRights(ctx context.Context, ids *ttnpb.EntityIdentifiers) (*ttnpb.Rights, bool, error) {
+	claims, ok, err := f.claims(ctx)
+	if !ok || err != nil {
+		return nil, ok, err
+	}
+	if !proto.Equal(claims.EntityIDs, ids) {
+		// Tokens that are restricted to an end device have no rights on any entity.
+		if claims.EntityIDs.GetDeviceIds() != nil {
+			return &ttnpb.Rights{}, true, nil
+		}
+		return nil, false, nil
+	}
+	return claims.Rights, true, nil
+}
+
+func (f *tokenFetcher) AuthInfo(ctx context.Context) (*ttnpb.AuthInfoResponse, error) {
+	claims, ok, err := f.claims(ctx)
+	if !ok {
+		return f.Fetcher.AuthInfo(ctx)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return claims.AuthInfo(), nil
+}
+
+func (f *tokenFetcher) ApplicationRights(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.Rights, error) {
+	if rights, ok, err := f.entityRights(ctx, ids.GetEntityIdentifiers()); ok {
+		return rights, err
+	}
+	return f.Fetcher.ApplicationRights(ctx, ids)
+}
+
+func (f *tokenFetcher) ClientRights(ctx context.Context, ids *ttnpb.ClientIdentifiers) (*ttnpb.Rights, error) {
+	if rights, ok, err := f.entityRights(ctx, ids.GetEntityIdentifiers()); ok {
+		return rights, err
+	}
+	return f.Fetcher.ClientRights(ctx, ids)
+}
+
+func (f *tokenFetcher) GatewayRights(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*ttnpb.Rights, error) {
+	if rights, ok, err := f.entityRights(ctx, ids.GetEntityIdentifiers()); ok {
+		return rights, err
+	}
+	return f.Fetcher.GatewayRights(ctx, ids)
+}
+
+func (f *tokenFetcher) OrganizationRights(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers,
+) (*ttnpb.Rights, error) {
+	if rights, ok, err := f.entityRights(ctx, ids.GetEntityIdentifiers()); ok {
+		return rights, err
+	}
+	return f.Fetcher.OrganizationRights(ctx, ids)
+}
+
+func (f *tokenFetcher) UserRights(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.Rights, error) {
+	if rights, ok, err := f.entityRights(ctx, ids.GetEntityIdentifiers()); ok {
+		return rights, err
+	}
+	return f.Fetcher.UserRights(ctx, ids)
+}
@@ -0,0 +1,232 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rights
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/metadata"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const testTokenIssuer = "https://example.com/oauth"
+
+func signTestToken(t *testing.T, key jose.JSONWebKey, typ string, claims *TokenClaims) string {
+	t.Helper()
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.SignatureAlgorithm(key.Algorithm), Key: key},
+		(&jose.SignerOptions{}).WithType(jose.ContentType(typ)),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestTokenFetcher(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := jose.JSONWebKey{Key: privateKey, KeyID: "test", Algorithm: string(jose.ES256), Use: "sig"}
+	keySet := &jose.JSONWebKeySet{Keys: []jose.JSONWebKey{key.Public()}}
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	now := time.Now()
+	newClaims := func() *TokenClaims {
+		return &TokenClaims{
+			Claims: jwt.Claims{
+				Issuer:   testTokenIssuer,
+				Subject:  "KEYID",
+				IssuedAt: jwt.NewNumericDate(now),
+				Expiry:   jwt.NewNumericDate(now.Add(time.Minute)),
+			},
+			EntityIDs: appIDs.GetEntityIdentifiers(),
+			Rights:    ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		}
+	}
+	token := signTestToken(t, key, TokenType, newClaims())
+	a.So(IsToken(token), should.BeTrue)
+	a.So(IsToken("NNSXS.KEYID.SECRET"), should.BeFalse)
+
+	t.Run("ParseToken", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		claims, err := ParseToken(token, keySet, testTokenIssuer, now)
+		if a.So(err, should.BeNil) {
+			a.So(claims.Subject, should.Equal, "KEYID")
+			a.So(claims.EntityIDs, should.Resemble, appIDs.GetEntityIdentifiers())
+			a.So(claims.Rights, should.Resemble, ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ))
+		}
+
+		_, err = ParseToken(token, keySet, "https://other.example.com", now)
+		a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+		_, err = ParseToken(token, keySet, testTokenIssuer, now.Add(2*time.Minute))
+		a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+		// ID tokens are signed with the same keys, but they are not tokens for API keys.
+		idToken := signTestToken(t, key, "JWT", newClaims())
+		_, err = ParseToken(idToken, keySet, testTokenIssuer, now)
+		a.So(errors.IsUnauthenticated(err), should.BeTrue)
+
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			t.Fatal(err)
+		}
+		forged := signTestToken(
+			t, jose.JSONWebKey{Key: otherKey, KeyID: "test", Algorithm: string(jose.ES256)}, TokenType, newClaims(),
+		)
+		_, err = ParseToken(forged, keySet, testTokenIssuer, now)
+		a.So(errors.IsUnauthenticated(err), should.BeTrue)
+	})
+
+	t.Run("Fetcher", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		mockFetcher := &mockFetcher{
+			authInfoResponse:  &ttnpb.AuthInfoResponse{},
+			applicationRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO),
+		}
+		f := NewTokenFetcher(mockFetcher, KeySetProviderFunc(func(context.Context) (*jose.JSONWebKeySet, error) {
+			return keySet, nil
+		}), testTokenIssuer)
+
+		tokenCtx := metadata.NewIncomingContext(test.Context(), metadata.Pairs("authorization", "Bearer "+token))
+
+		authInfo, err := f.AuthInfo(tokenCtx)
+		if a.So(err, should.BeNil) {
+			a.So(authInfo.GetApiKey().GetApiKey().GetId(), should.Equal, "KEYID")
+			a.So(authInfo.GetEntityIdentifiers(), should.Resemble, appIDs.GetEntityIdentifiers())
+		}
+		a.So(mockFetcher.authInfoCtx, should.BeNil)
+
+		rights, err := f.ApplicationRights(tokenCtx, appIDs)
+		a.So(err, should.BeNil)
+		a.So(rights, should.Resemble, ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ))
+		a.So(mockFetcher.applicationCtx, should.BeNil)
+
+		// Rights of other entities are fetched from the Identity Server.
+		otherAppIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "bar-app"}
+		rights, err = f.ApplicationRights(tokenCtx, otherAppIDs)
+		a.So(err, should.BeNil)
+		a.So(rights, should.Resemble, mockFetcher.applicationRights)
+		a.So(mockFetcher.applicationIDs, should.Resemble, otherAppIDs)
+
+		// Other tokens are passed to the Identity Server.
+		apiKeyCtx := metadata.NewIncomingContext(
+			test.Context(), metadata.Pairs("authorization", "Bearer NNSXS.KEYID.SECRET"),
+		)
+		_, err = f.AuthInfo(apiKeyCtx)
+		a.So(err, should.BeNil)
+		a.So(mockFetcher.authInfoCtx, should.Equal, apiKeyCtx)
+
+		expiredCtx := metadata.NewIncomingContext(test.Context(), metadata.Pairs(
+			"authorization", "Bearer "+signTestToken(t, key, TokenType, &TokenClaims{
+				Claims: jwt.Claims{
+					Issuer:  testTokenIssuer,
+					Subject: "KEYID",
+					Expiry:  jwt.NewNumericDate(now.Add(-time.Minute)),
+				},
+				EntityIDs: appIDs.GetEntityIdentifiers(),
+				Rights:    ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+			}),
+		))
+		_, err = f.ApplicationRights(expiredCtx, otherAppIDs)
+		a.So(errors.IsUnauthenticated(err), should.BeTrue)
+	})
+
+	t.Run("EndDevice", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "foo-dev"}
+		claims := newClaims()
+		claims.EntityIDs = devIDs.GetEntityIdentifiers()
+		devToken := signTestToken(t, key, TokenType, claims)
+
+		mockFetcher := &mockFetcher{
+			applicationRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_ALL).Implied(),
+		}
+		f := NewTokenFetcher(mockFetcher, KeySetProviderFunc(func(context.Context) (*jose.JSONWebKeySet, error) {
+			return keySet, nil
+		}), testTokenIssuer)
+		ctx := NewContextWithFetcher(
+			metadata.NewIncomingContext(test.Context(), metadata.Pairs("authorization", "Bearer "+devToken)), f,
+		)
+
+		a.So(RequireEndDevice(ctx, devIDs, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ), should.BeNil)
+		err := RequireEndDevice(ctx, devIDs, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE)
+		a.So(errors.Resemble(err, ErrInsufficientEndDeviceRights), should.BeTrue)
+		err = RequireEndDevice(ctx, &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "bar-dev"})
+		a.So(errors.Resemble(err, ErrNoEndDeviceRights), should.BeTrue)
+
+		// Tokens that are restricted to an end device have no rights on the application.
+		err = RequireApplication(ctx, appIDs, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ)
+		a.So(errors.Resemble(err, ErrNoApplicationRights), should.BeTrue)
+		a.So(mockFetcher.applicationCtx, should.BeNil)
+
+		// Other tokens require the rights on the application.
+		apiKeyCtx := NewContextWithFetcher(metadata.NewIncomingContext(
+			test.Context(), metadata.Pairs("authorization", "Bearer NNSXS.KEYID.SECRET"),
+		), f)
+		a.So(RequireEndDevice(apiKeyCtx, devIDs, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE), should.BeNil)
+		a.So(mockFetcher.applicationIDs, should.Resemble, appIDs)
+	})
+
+	t.Run("KeySetFromURL", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+
+		var requests int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests++
+			_ = json.NewEncoder(w).Encode(keySet)
+		}))
+		defer srv.Close()
+
+		provider := CacheKeySet(KeySetFromURL(srv.Client(), srv.URL), time.Hour)
+		for i := 0; i < 2; i++ {
+			keys, err := provider.KeySet(test.Context())
+			if a.So(err, should.BeNil) && a.So(keys.Keys, should.HaveLength, 1) {
+				a.So(keys.Keys[0].KeyID, should.Equal, "test")
+			}
+		}
+		a.So(requests, should.Equal, 1)
+	})
+}
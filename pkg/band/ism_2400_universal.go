@@ -20,6 +20,8 @@ import "go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 var ISM_2400_Universal = Band{
 	ID: ISM_2400,
 
+	SupportsDynamicADR: true,
+
 	MaxUplinkChannels: 16,
 	UplinkChannels:    ism2400DefaultChannels,
 
@@ -47,6 +49,8 @@ var ISM_2400_Universal = Band{
 	},
 	StrictCodingRate: true,
 
+	MaxADRDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_7,
+
 	DefaultMaxEIRP: 10,
 	TxOffset: []float32{
 		0,
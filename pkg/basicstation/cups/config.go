@@ -35,15 +35,23 @@ type ServerConfig struct {
 	Default struct {
 		LNSURI string `name:"lns-uri" description:"The default LNS URI that the gateways should use"`
 	} `name:"default" description:"Default gateway settings"`
-	AllowCUPSURIUpdate bool `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
+	LNSURITemplate     string `name:"lns-uri-template" description:"Template of the LNS URI based on the gateway attributes, for example wss://{{.Attributes.region}}.example.com:8887. Takes precedence over the gateway server address of gateways with the attributes"` //nolint:lll
+	AllowCUPSURIUpdate bool   `name:"allow-cups-uri-update" description:"Allow CUPS URI updates"`
 }
 
 // NewServer returns a new CUPS server from this config on top of the component.
-func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) *Server {
+func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option) (*Server, error) {
 	opts := []Option{
 		WithAllowCUPSURIUpdate(conf.AllowCUPSURIUpdate),
 		WithDefaultLNSURI(conf.Default.LNSURI),
 	}
+	if conf.LNSURITemplate != "" {
+		tmpl, err := ParseLNSURITemplate(conf.LNSURITemplate)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, WithLNSURITemplate(tmpl))
+	}
 	var registerUnknownTo *ttnpb.OrganizationOrUserIdentifiers
 	switch conf.RegisterUnknown.Type {
 	case "user":
@@ -73,5 +81,5 @@ func (conf ServerConfig) NewServer(c *component.Component, customOpts ...Option)
 	}
 	s := NewServer(c, append(opts, customOpts...)...)
 	c.RegisterWeb(s)
-	return s
+	return s, nil
 }
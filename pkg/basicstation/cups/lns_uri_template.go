@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cups
+
+import (
+	"strings"
+	"text/template"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var errLNSURITemplate = errors.DefineInvalidArgument("lns_uri_template", "invalid LNS URI template")
+
+// LNSURITemplateData is the data that is available in LNS URI templates.
+// Attributes contains the attributes of the gateway, for example {{.Attributes.region}}.
+type LNSURITemplateData struct {
+	GatewayID  string
+	EUI        string
+	Attributes map[string]string
+}
+
+// ParseLNSURITemplate parses the LNS URI template.
+// Templates that refer to attributes that the gateway does not have do not apply to the gateway.
+func ParseLNSURITemplate(text string) (*template.Template, error) {
+	tmpl, err := template.New("lns_uri").Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, errLNSURITemplate.WithCause(err)
+	}
+	return tmpl, nil
+}
+
+// executeLNSURITemplate returns the LNS URI of the gateway from the LNS URI template.
+// It returns false if there is no LNS URI template, or if the template does not apply to the gateway.
+func (s *Server) executeLNSURITemplate(gtw *ttnpb.Gateway) (string, bool) {
+	if s.lnsURITemplate == nil {
+		return "", false
+	}
+	data := LNSURITemplateData{
+		GatewayID:  gtw.GetIds().GetGatewayId(),
+		Attributes: gtw.Attributes,
+	}
+	if eui := types.MustEUI64(gtw.GetIds().GetEui()); eui != nil {
+		data.EUI = eui.String()
+	}
+	var b strings.Builder
+	if err := s.lnsURITemplate.Execute(&b, data); err != nil {
+		return "", false
+	}
+	uri := strings.TrimSpace(b.String())
+	return uri, uri != ""
+}
@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"strings"
 	"sync"
+	"text/template"
 	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -51,6 +52,7 @@ type Server struct {
 	defaultOwner          *ttnpb.OrganizationOrUserIdentifiers
 	defaultOwnerAuth      func(context.Context) grpc.CallOption
 	defaultLNSURI         string
+	lnsURITemplate        *template.Template
 
 	allowCUPSURIUpdate bool
 
@@ -125,6 +127,15 @@ func WithDefaultLNSURI(uri string) Option {
 	}
 }
 
+// WithLNSURITemplate configures the CUPS server to compute the LNS URI of gateways from the template.
+// The LNS URI from the template takes precedence over the gateway server address of the gateway, so that fleets of
+// gateways can be moved between Gateway Servers by changing their attributes. See ParseLNSURITemplate.
+func WithLNSURITemplate(tmpl *template.Template) Option {
+	return func(s *Server) {
+		s.lnsURITemplate = tmpl
+	}
+}
+
 // WithTrust configures the CUPS server to return the given certificate to gateways
 // as trusted certificate for the CUPS server. This should typically be the certificate
 // of the Root CA in the chain of the CUPS server's TLS certificate.
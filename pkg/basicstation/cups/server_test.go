@@ -187,6 +187,11 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 
 	var kv config.KeyVault //nolint:gosimple
 
+	lnsURITemplate, err := ParseLNSURITemplate("ws://{{.Attributes.region}}.lns.example.com:1885")
+	if err != nil {
+		t.Fatalf("Failed to parse LNS URI template: %v", err)
+	}
+
 	mockGateway := func(hasLNSSecret, redirectCUPS, updateCUPSCreds bool) *ttnpb.Gateway {
 		secret := &ttnpb.Secret{
 			KeyId: "test-key",
@@ -474,6 +479,56 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				}
 			},
 		},
+		{
+			Name: "Existing Gateway with LNS URI Template",
+			StoreSetup: func(c *mockGatewayClient) {
+				gtw := mockGateway(true, false, false)
+				gtw.GatewayServerAddress = "ws://192.168.2.3:1885"
+				gtw.Attributes["region"] = "eu1"
+				c.res.Get = gtw
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithLNSURITemplate(lnsURITemplate),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.LNSURI, should.Equal, "ws://eu1.lns.example.com:1885")
+				a.So(res.LNSCredentials, should.BeEmpty)
+			},
+		},
+		{
+			Name: "Existing Gateway without LNS URI Template Attributes",
+			StoreSetup: func(c *mockGatewayClient) {
+				gtw := mockGateway(true, false, false)
+				gtw.GatewayServerAddress = "ws://192.168.2.3:1885"
+				c.res.Get = gtw
+				c.res.GetIdentifiersForEUI = c.res.Get.GetIds()
+			},
+			Options: []Option{
+				WithLNSURITemplate(lnsURITemplate),
+			},
+			RequestSetup: func(req *http.Request) {
+				req.Header.Set("Authorization", "Bearer KEYCONTENTS")
+			},
+			AssertError: func(err error) bool {
+				return err == nil
+			},
+			AssertResponse: func(a *assertions.Assertion, rec *httptest.ResponseRecorder) {
+				var res UpdateInfoResponse
+				err := res.UnmarshalBinary(rec.Body.Bytes())
+				a.So(err, should.BeNil)
+				a.So(res.LNSURI, should.Equal, "ws://192.168.2.3:1885")
+			},
+		},
 		{
 			Name: "CUPS redirection",
 			StoreSetup: func(c *mockGatewayClient) {
@@ -290,18 +290,20 @@ func (s *Server) updateInfo(w http.ResponseWriter, r *http.Request) (err error)
 			res.CUPSCredentials = cupsCredentials
 		}
 	} else {
-		logger := logger.WithField("lns_uri", gtw.GatewayServerAddress)
-		logger.Debug("Configure LNS")
 		if gtw.LbsLnsSecret == nil {
 			return errLNSCredentials.WithAttributes("gateway_uid", gtw.GetIds().GetGatewayId())
 		}
-		if gtw.GatewayServerAddress == "" {
+		if lnsURI, ok := s.executeLNSURITemplate(gtw); ok {
+			gtw.GatewayServerAddress = lnsURI
+		} else if gtw.GatewayServerAddress == "" {
 			if req.LNSURI != "" {
 				gtw.GatewayServerAddress = req.LNSURI
 			} else {
 				gtw.GatewayServerAddress = s.defaultLNSURI
 			}
 		}
+		logger := logger.WithField("lns_uri", gtw.GatewayServerAddress)
+		logger.Debug("Configure LNS")
 
 		scheme, host, port, err := parseAddress("wss", gtw.GatewayServerAddress)
 		if err != nil {
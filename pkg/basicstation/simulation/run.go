@@ -0,0 +1,190 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+)
+
+// typeRouterConfig is the message type of the router configuration.
+const typeRouterConfig = "router_config"
+
+// StepResult is the result of a step of a script.
+type StepResult struct {
+	// Step is the 1-based index of the step in the script.
+	Step int
+	// Type is the message type of the step.
+	Type string
+	// Duration is the time between sending the message and verifying the response.
+	Duration time.Duration
+	// Errors are the conformance errors of the step.
+	Errors []string
+}
+
+// Passed returns whether the step passed.
+func (r StepResult) Passed() bool {
+	return len(r.Errors) == 0
+}
+
+// Expectations are the expected responses of the Gateway Server.
+type Expectations struct {
+	// RouterConfig is the expected router_config message. If nil, only the consistency of the message is verified.
+	RouterConfig []byte
+}
+
+type runner struct {
+	client       *Client
+	expectations Expectations
+
+	routerConfig routerConfig
+	lastDownlink *lbslns.DownlinkMessage
+}
+
+// Run runs the script with the client and returns the result of each step.
+// Run returns an error if a step cannot be performed, for example because the connection is closed.
+func Run(ctx context.Context, client *Client, script *Script, expectations Expectations) ([]StepResult, error) {
+	r := &runner{
+		client:       client,
+		expectations: expectations,
+	}
+	results := make([]StepResult, 0, len(script.Steps))
+	for i, step := range script.Steps {
+		start := time.Now()
+		errs, err := r.run(ctx, step)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, StepResult{
+			Step:     i + 1,
+			Type:     step.Type(),
+			Duration: time.Since(start),
+			Errors:   errs,
+		})
+	}
+	return results, nil
+}
+
+func (r *runner) run(ctx context.Context, step Step) ([]string, error) {
+	switch {
+	case step.Version != nil:
+		return r.version(ctx, *step.Version)
+	case step.TimeSync != nil:
+		return r.timeSync(ctx)
+	case step.JoinRequest != nil:
+		req, err := step.JoinRequest.message(r.client.XTime())
+		if err != nil {
+			return nil, err
+		}
+		return r.uplink(ctx, req, uplink{
+			xTime:      req.RadioMetaData.UpInfo.XTime,
+			joinAccept: true,
+		}, step.JoinRequest.ExpectDownlink)
+	case step.UplinkDataFrame != nil:
+		updf, devAddr, err := step.UplinkDataFrame.message(r.client.XTime())
+		if err != nil {
+			return nil, err
+		}
+		return r.uplink(ctx, updf, uplink{
+			xTime:   updf.RadioMetaData.UpInfo.XTime,
+			devAddr: devAddr,
+		}, step.UplinkDataFrame.ExpectDownlink)
+	case step.TxConfirmation != nil:
+		return r.txConfirmation()
+	default:
+		return nil, errInvalidScript.New()
+	}
+}
+
+func (r *runner) version(ctx context.Context, step VersionStep) ([]string, error) {
+	if err := r.client.Send(step.message()); err != nil {
+		return nil, err
+	}
+	data, err := r.client.Wait(ctx, typeRouterConfig, nil)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	if err := json.Unmarshal(data, &r.routerConfig); err != nil {
+		return []string{fmt.Sprintf("invalid router_config: %v", err)}, nil
+	}
+	errs := verifyRouterConfigFields(r.routerConfig)
+	if r.expectations.RouterConfig != nil {
+		errs = append(errs, VerifyRouterConfig(r.expectations.RouterConfig, data)...)
+	}
+	return errs, nil
+}
+
+func (r *runner) timeSync(ctx context.Context) ([]string, error) {
+	req := lbslns.TimeSyncRequest{
+		TxTime: float64(time.Since(r.client.started).Microseconds()),
+	}
+	if err := r.client.Send(req); err != nil {
+		return nil, err
+	}
+	var res lbslns.TimeSyncResponse
+	_, err := r.client.Wait(ctx, lbslns.TypeDownstreamTimeSync, func(data []byte) bool {
+		// Time transfers that are initiated by the Gateway Server do not contain the txtime.
+		return json.Unmarshal(data, &res) == nil && res.TxTime == req.TxTime
+	})
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	return verifyTimeSync(res, time.Now()), nil
+}
+
+func (r *runner) uplink(ctx context.Context, msg any, up uplink, expectDownlink bool) ([]string, error) {
+	if err := r.client.Send(msg); err != nil {
+		return nil, err
+	}
+	if !expectDownlink {
+		return nil, nil
+	}
+	data, err := r.client.Wait(ctx, lbslns.TypeDownstreamDownlinkMessage, nil)
+	if err != nil {
+		return []string{err.Error()}, nil
+	}
+	dnmsg := &lbslns.DownlinkMessage{}
+	if err := json.Unmarshal(data, dnmsg); err != nil {
+		return []string{fmt.Sprintf("invalid dnmsg: %v", err)}, nil
+	}
+	r.lastDownlink = dnmsg
+	return verifyDownlink(*dnmsg, up, r.routerConfig), nil
+}
+
+func (r *runner) txConfirmation() ([]string, error) {
+	dnmsg := r.lastDownlink
+	if dnmsg == nil {
+		return []string{"no downlink message to confirm"}, nil
+	}
+	r.lastDownlink = nil
+	var devEUI id6.EUI
+	if err := devEUI.UnmarshalJSON([]byte(strconv.Quote(dnmsg.DevEUI))); err != nil {
+		return []string{fmt.Sprintf("invalid DevEui `%s`", dnmsg.DevEUI)}, nil
+	}
+	conf := lbslns.TxConfirmation{
+		Diid:   dnmsg.Diid,
+		DevEUI: devEUI,
+		RCtx:   dnmsg.RCtx,
+		XTime:  r.client.XTime(),
+		TxTime: float64(time.Since(r.client.started).Microseconds()),
+	}
+	return nil, r.client.Send(conf)
+}
@@ -0,0 +1,315 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"gopkg.in/yaml.v2"
+)
+
+var (
+	errInvalidScript = errors.DefineInvalidArgument("invalid_script", "invalid script")
+	errInvalidStep   = errors.DefineInvalidArgument("invalid_step", "step {step} must have exactly one message")
+	errInvalidField  = errors.DefineInvalidArgument("invalid_field", "invalid `{field}`")
+)
+
+// Script is a sequence of steps that the simulated LoRa Basics Station performs.
+type Script struct {
+	Steps []Step `yaml:"steps"`
+}
+
+// Step is a single step of a script. Exactly one of the messages must be set.
+type Step struct {
+	Version         *VersionStep         `yaml:"version,omitempty"`
+	TimeSync        *TimeSyncStep        `yaml:"timesync,omitempty"`
+	JoinRequest     *JoinRequestStep     `yaml:"jreq,omitempty"`
+	UplinkDataFrame *UplinkDataFrameStep `yaml:"updf,omitempty"`
+	TxConfirmation  *TxConfirmationStep  `yaml:"dntxed,omitempty"`
+}
+
+// Type returns the message type of the step.
+func (s Step) Type() string {
+	switch {
+	case s.Version != nil:
+		return lbslns.TypeUpstreamVersion
+	case s.TimeSync != nil:
+		return lbslns.TypeUpstreamTimeSync
+	case s.JoinRequest != nil:
+		return lbslns.TypeUpstreamJoinRequest
+	case s.UplinkDataFrame != nil:
+		return lbslns.TypeUpstreamUplinkDataFrame
+	case s.TxConfirmation != nil:
+		return lbslns.TypeUpstreamTxConfirmation
+	default:
+		return ""
+	}
+}
+
+// VersionStep sends the version message and expects the router_config message.
+type VersionStep struct {
+	Station  string `yaml:"station"`
+	Firmware string `yaml:"firmware"`
+	Package  string `yaml:"package"`
+	Model    string `yaml:"model"`
+	Protocol int    `yaml:"protocol"`
+	Features string `yaml:"features"`
+}
+
+// TimeSyncStep sends a timesync request and expects the timesync response.
+type TimeSyncStep struct{}
+
+// RadioStep contains the radio metadata of an uplink.
+type RadioStep struct {
+	DataRate  int     `yaml:"data_rate"`
+	Frequency uint64  `yaml:"frequency"`
+	RSSI      float32 `yaml:"rssi"`
+	SNR       float32 `yaml:"snr"`
+}
+
+// JoinRequestStep sends a join-request. If AppKey is set, the MIC is computed with the AppKey.
+// If ExpectDownlink is set, the join-accept downlink message is expected.
+type JoinRequestStep struct {
+	RadioStep      `yaml:",inline"`
+	JoinEUI        string `yaml:"join_eui"`
+	DevEUI         string `yaml:"dev_eui"`
+	DevNonce       uint16 `yaml:"dev_nonce"`
+	AppKey         string `yaml:"app_key"`
+	ExpectDownlink bool   `yaml:"expect_downlink"`
+}
+
+// UplinkDataFrameStep sends an uplink data frame. If NwkSKey is set, the LoRaWAN 1.0.x MIC is computed with the
+// NwkSKey. The FRMPayload is sent as is, so it must be encrypted already.
+// If ExpectDownlink is set, a downlink message is expected.
+type UplinkDataFrameStep struct {
+	RadioStep      `yaml:",inline"`
+	Confirmed      bool   `yaml:"confirmed"`
+	DevAddr        string `yaml:"dev_addr"`
+	FCtrl          uint8  `yaml:"f_ctrl"`
+	FCnt           uint16 `yaml:"f_cnt"`
+	FOpts          string `yaml:"f_opts"`
+	FPort          *uint8 `yaml:"f_port"`
+	FRMPayload     string `yaml:"frm_payload"`
+	NwkSKey        string `yaml:"nwk_s_key"`
+	ExpectDownlink bool   `yaml:"expect_downlink"`
+}
+
+// TxConfirmationStep confirms the transmission of the last downlink message.
+type TxConfirmationStep struct{}
+
+// ReadScript reads a YAML script.
+func ReadScript(r io.Reader) (*Script, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	script := &Script{}
+	if err := yaml.UnmarshalStrict(b, script); err != nil {
+		return nil, errInvalidScript.WithCause(err)
+	}
+	for i, step := range script.Steps {
+		n := 0
+		for _, set := range []bool{
+			step.Version != nil,
+			step.TimeSync != nil,
+			step.JoinRequest != nil,
+			step.UplinkDataFrame != nil,
+			step.TxConfirmation != nil,
+		} {
+			if set {
+				n++
+			}
+		}
+		if n != 1 {
+			return nil, errInvalidStep.WithAttributes("step", i+1)
+		}
+	}
+	return script, nil
+}
+
+// DefaultScript returns a script that connects the gateway and synchronizes the time.
+func DefaultScript() *Script {
+	return &Script{
+		Steps: []Step{
+			{Version: &VersionStep{
+				Station:  "2.0.6(simulation)",
+				Firmware: "simulation",
+				Package:  "simulation",
+				Model:    "simulation",
+				Protocol: 2,
+			}},
+			{TimeSync: &TimeSyncStep{}},
+		},
+	}
+}
+
+func parseText(field, s string, v interface{ UnmarshalText([]byte) error }) error {
+	if err := v.UnmarshalText([]byte(s)); err != nil {
+		return errInvalidField.WithAttributes("field", field).WithCause(err)
+	}
+	return nil
+}
+
+func (s RadioStep) metadata(xTime int64) lbslns.RadioMetaData {
+	return lbslns.RadioMetaData{
+		DataRate:  s.DataRate,
+		Frequency: s.Frequency,
+		UpInfo: lbslns.UpInfo{
+			XTime: xTime,
+			RSSI:  s.RSSI,
+			SNR:   s.SNR,
+		},
+	}
+}
+
+// micFromBytes returns the MIC as represented in the LoRa Basics Station protocol.
+func micFromBytes(mic [4]byte) int32 {
+	return int32(binary.LittleEndian.Uint32(mic[:]))
+}
+
+// message returns the version message.
+func (s VersionStep) message() lbslns.Version {
+	return lbslns.Version{
+		Station:  s.Station,
+		Firmware: s.Firmware,
+		Package:  s.Package,
+		Model:    s.Model,
+		Protocol: s.Protocol,
+		Features: s.Features,
+	}
+}
+
+// message returns the jreq message.
+func (s JoinRequestStep) message(xTime int64) (*lbslns.JoinRequest, error) {
+	var joinEUI, devEUI types.EUI64
+	if err := parseText("join_eui", s.JoinEUI, &joinEUI); err != nil {
+		return nil, err
+	}
+	if err := parseText("dev_eui", s.DevEUI, &devEUI); err != nil {
+		return nil, err
+	}
+	req := &lbslns.JoinRequest{
+		MHdr:          uint(ttnpb.MType_JOIN_REQUEST) << 5,
+		JoinEUI:       id6.EUI{EUI64: joinEUI},
+		DevEUI:        id6.EUI{EUI64: devEUI},
+		DevNonce:      uint(s.DevNonce),
+		RadioMetaData: s.metadata(xTime),
+	}
+	if s.AppKey == "" {
+		return req, nil
+	}
+	var appKey types.AES128Key
+	if err := parseText("app_key", s.AppKey, &appKey); err != nil {
+		return nil, err
+	}
+	b, err := lorawan.MarshalMessage(&ttnpb.Message{
+		MHdr: &ttnpb.MHDR{MType: ttnpb.MType_JOIN_REQUEST, Major: ttnpb.Major_LORAWAN_R1},
+		Mic:  make([]byte, 4),
+		Payload: &ttnpb.Message_JoinRequestPayload{JoinRequestPayload: &ttnpb.JoinRequestPayload{
+			JoinEui:  joinEUI.Bytes(),
+			DevEui:   devEUI.Bytes(),
+			DevNonce: []byte{byte(s.DevNonce >> 8), byte(s.DevNonce)},
+		}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	mic, err := crypto.ComputeJoinRequestMIC(appKey, b[:len(b)-4])
+	if err != nil {
+		return nil, err
+	}
+	req.MIC = micFromBytes(mic)
+	return req, nil
+}
+
+// message returns the updf message.
+func (s UplinkDataFrameStep) message(xTime int64) (*lbslns.UplinkDataFrame, types.DevAddr, error) {
+	var devAddr types.DevAddr
+	if err := parseText("dev_addr", s.DevAddr, &devAddr); err != nil {
+		return nil, devAddr, err
+	}
+	fOpts, err := hex.DecodeString(s.FOpts)
+	if err != nil {
+		return nil, devAddr, errInvalidField.WithAttributes("field", "f_opts").WithCause(err)
+	}
+	frmPayload, err := hex.DecodeString(s.FRMPayload)
+	if err != nil {
+		return nil, devAddr, errInvalidField.WithAttributes("field", "frm_payload").WithCause(err)
+	}
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if s.Confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	fPort := -1
+	if s.FPort != nil {
+		fPort = int(*s.FPort)
+	}
+	updf := &lbslns.UplinkDataFrame{
+		MHdr:          uint(mType) << 5,
+		DevAddr:       int32(devAddr.MarshalNumber()),
+		FCtrl:         uint(s.FCtrl),
+		FCnt:          uint(s.FCnt),
+		FOpts:         hex.EncodeToString(fOpts),
+		FPort:         fPort,
+		FRMPayload:    hex.EncodeToString(frmPayload),
+		RadioMetaData: s.metadata(xTime),
+	}
+	if s.NwkSKey == "" {
+		return updf, devAddr, nil
+	}
+	var nwkSKey types.AES128Key
+	if err := parseText("nwk_s_key", s.NwkSKey, &nwkSKey); err != nil {
+		return nil, devAddr, err
+	}
+	fCtrl := &ttnpb.FCtrl{}
+	if err := lorawan.UnmarshalFCtrl([]byte{s.FCtrl}, fCtrl, true); err != nil {
+		return nil, devAddr, errInvalidField.WithAttributes("field", "f_ctrl").WithCause(err)
+	}
+	macPayload := &ttnpb.MACPayload{
+		FHdr: &ttnpb.FHDR{
+			DevAddr: devAddr.Bytes(),
+			FCtrl:   fCtrl,
+			FCnt:    uint32(s.FCnt),
+			FOpts:   fOpts,
+		},
+		FrmPayload: frmPayload,
+	}
+	if s.FPort != nil {
+		macPayload.FPort = uint32(*s.FPort)
+	}
+	b, err := lorawan.MarshalMessage(&ttnpb.Message{
+		MHdr:    &ttnpb.MHDR{MType: mType, Major: ttnpb.Major_LORAWAN_R1},
+		Mic:     make([]byte, 4),
+		Payload: &ttnpb.Message_MacPayload{MacPayload: macPayload},
+	})
+	if err != nil {
+		return nil, devAddr, err
+	}
+	mic, err := crypto.ComputeLegacyUplinkMIC(nwkSKey, devAddr, uint32(s.FCnt), b[:len(b)-4])
+	if err != nil {
+		return nil, devAddr, err
+	}
+	updf.MIC = micFromBytes(mic)
+	return updf, devAddr, nil
+}
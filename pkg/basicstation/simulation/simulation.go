@@ -0,0 +1,196 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package simulation implements a scripted LoRa Basics Station client for conformance testing of the LNS protocol
+// implementation of the Gateway Server.
+package simulation
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// DefaultTimeout is the default time to wait for a message from the Gateway Server.
+const DefaultTimeout = 10 * time.Second
+
+var (
+	errDiscover = errors.DefineUnavailable("discover", "discover LNS endpoint: {message}")
+	errTimeout  = errors.DefineDeadlineExceeded("timeout", "no `{msgtype}` message received within `{timeout}`")
+	errClosed   = errors.DefineUnavailable("closed", "connection closed")
+)
+
+// Config is the configuration of a simulated LoRa Basics Station.
+type Config struct {
+	// Address is the base URI of the LNS endpoint of the Gateway Server, for example wss://localhost:8887.
+	Address string
+	// GatewayEUI is the EUI of the gateway.
+	GatewayEUI types.EUI64
+	// APIKey is the gateway API key. If empty, the connection is not authenticated.
+	APIKey string
+	// TLSConfig is the TLS configuration of wss:// connections.
+	TLSConfig *tls.Config
+	// Timeout is the time to wait for a message from the Gateway Server.
+	Timeout time.Duration
+	// SessionID is the session ID in the xtime values of the simulated concentrator.
+	SessionID int32
+}
+
+// Client is a simulated LoRa Basics Station that is connected to the traffic endpoint of the Gateway Server.
+type Client struct {
+	conf    Config
+	conn    *websocket.Conn
+	started time.Time
+
+	writeMu sync.Mutex
+	msgCh   chan []byte
+	errCh   chan error
+}
+
+// Connect discovers the traffic endpoint of the gateway and connects to it.
+func Connect(ctx context.Context, conf Config) (*Client, error) {
+	if conf.Timeout == 0 {
+		conf.Timeout = DefaultTimeout
+	}
+	if conf.SessionID == 0 {
+		conf.SessionID = 1
+	}
+	dialer := &websocket.Dialer{
+		TLSClientConfig:  conf.TLSConfig,
+		HandshakeTimeout: conf.Timeout,
+	}
+
+	uri, err := discover(ctx, dialer, conf)
+	if err != nil {
+		return nil, err
+	}
+
+	header := http.Header{}
+	if conf.APIKey != "" {
+		header.Set("Authorization", fmt.Sprintf("Bearer %s", conf.APIKey))
+	}
+	conn, _, err := dialer.DialContext(ctx, uri, header)
+	if err != nil {
+		return nil, err
+	}
+	c := &Client{
+		conf:    conf,
+		conn:    conn,
+		started: time.Now(),
+		msgCh:   make(chan []byte, 16),
+		errCh:   make(chan error, 1),
+	}
+	go c.readLoop()
+	return c, nil
+}
+
+// discover returns the URI of the traffic endpoint of the gateway.
+func discover(ctx context.Context, dialer *websocket.Dialer, conf Config) (string, error) {
+	conn, _, err := dialer.DialContext(ctx, strings.TrimRight(conf.Address, "/")+"/router-info", nil)
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	if err := conn.WriteJSON(lbslns.DiscoverQuery{EUI: id6.EUI{EUI64: conf.GatewayEUI}}); err != nil {
+		return "", err
+	}
+	if err := conn.SetReadDeadline(time.Now().Add(conf.Timeout)); err != nil {
+		return "", err
+	}
+	var res lbslns.DiscoverResponse
+	if err := conn.ReadJSON(&res); err != nil {
+		return "", err
+	}
+	if res.Error != "" {
+		return "", errDiscover.WithAttributes("message", res.Error)
+	}
+	return res.URI, nil
+}
+
+func (c *Client) readLoop() {
+	for {
+		_, data, err := c.conn.ReadMessage()
+		if err != nil {
+			c.errCh <- err
+			close(c.msgCh)
+			return
+		}
+		c.msgCh <- data
+	}
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// XTime returns the current xtime of the simulated concentrator.
+func (c *Client) XTime() int64 {
+	return ws.ConcentratorTimeToXTime(c.conf.SessionID, scheduling.ConcentratorTime(time.Since(c.started)))
+}
+
+// Send sends the message to the Gateway Server.
+func (c *Client) Send(msg any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	return c.conn.WriteMessage(websocket.TextMessage, data)
+}
+
+// Wait waits for a message of the given type for which accept returns true, and returns the raw message.
+// Other messages, such as unsolicited time transfers, are skipped.
+func (c *Client) Wait(ctx context.Context, msgType string, accept func([]byte) bool) ([]byte, error) {
+	timer := time.NewTimer(c.conf.Timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-timer.C:
+			return nil, errTimeout.WithAttributes("msgtype", msgType, "timeout", c.conf.Timeout)
+		case data, ok := <-c.msgCh:
+			if !ok {
+				select {
+				case err := <-c.errCh:
+					return nil, errClosed.WithCause(err)
+				default:
+					return nil, errClosed.New()
+				}
+			}
+			typ, err := lbslns.Type(data)
+			if err != nil || typ != msgType {
+				continue
+			}
+			if accept == nil || accept(data) {
+				return data, nil
+			}
+		}
+	}
+}
@@ -0,0 +1,212 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/basicstation/simulation"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const testScript = `steps:
+- version:
+    station: "2.0.6(test)"
+    protocol: 2
+- timesync: {}
+- jreq:
+    join_eui: "0000000000000000"
+    dev_eui: "0102030405060708"
+    dev_nonce: 1
+    app_key: "01020304050607080102030405060708"
+    data_rate: 5
+    frequency: 868100000
+    expect_downlink: true
+- dntxed: {}
+- updf:
+    dev_addr: "01020304"
+    f_cnt: 1
+    f_port: 1
+    frm_payload: "01"
+    nwk_s_key: "01020304050607080102030405060708"
+    data_rate: 5
+    frequency: 868100000
+`
+
+// fakeLNS is a minimal LNS endpoint that responds to the messages of the simulated LoRa Basics Station.
+type fakeLNS struct {
+	routerConfig []byte
+	txConfCh     chan lbslns.TxConfirmation
+}
+
+func (s *fakeLNS) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	conn, err := (&websocket.Upgrader{}).Upgrade(w, r, nil)
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+
+	if strings.HasSuffix(r.URL.Path, "/router-info") {
+		var req lbslns.DiscoverQuery
+		if err := conn.ReadJSON(&req); err != nil {
+			return
+		}
+		conn.WriteJSON(lbslns.DiscoverResponse{ //nolint:errcheck
+			EUI: req.EUI,
+			URI: "ws://" + r.Host + "/traffic/" + req.EUI.EUI64.String(),
+		})
+		return
+	}
+
+	for {
+		_, data, err := conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		typ, err := lbslns.Type(data)
+		if err != nil {
+			return
+		}
+		switch typ {
+		case lbslns.TypeUpstreamVersion:
+			conn.WriteMessage(websocket.TextMessage, s.routerConfig) //nolint:errcheck
+		case lbslns.TypeUpstreamTimeSync:
+			var req lbslns.TimeSyncRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				return
+			}
+			conn.WriteJSON(req.Response(time.Now())) //nolint:errcheck
+		case lbslns.TypeUpstreamJoinRequest:
+			var req lbslns.JoinRequest
+			if err := json.Unmarshal(data, &req); err != nil {
+				return
+			}
+			conn.WriteJSON(struct { //nolint:errcheck
+				Type string `json:"msgtype"`
+				lbslns.DownlinkMessage
+			}{
+				Type: lbslns.TypeDownstreamDownlinkMessage,
+				DownlinkMessage: lbslns.DownlinkMessage{
+					DevEUI: "00-00-00-00-00-00-00-01",
+					Diid:   42,
+					Pdu:    "20" + strings.Repeat("00", 16),
+					TimestampDownlinkMessage: &lbslns.TimestampDownlinkMessage{
+						RxDelay: 5,
+						Rx1DR:   5,
+						Rx1Freq: 868100000,
+						XTime:   req.RadioMetaData.UpInfo.XTime,
+					},
+				},
+			})
+		case lbslns.TypeUpstreamTxConfirmation:
+			var conf lbslns.TxConfirmation
+			if err := json.Unmarshal(data, &conf); err != nil {
+				return
+			}
+			s.txConfCh <- conf
+		}
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	script, err := ReadScript(strings.NewReader(testScript))
+	if !a.So(err, should.BeNil) || !a.So(script.Steps, should.HaveLength, 5) {
+		t.FailNow()
+	}
+	fps := []*frequencyplans.FrequencyPlan{test.FrequencyPlan(test.EUFrequencyPlanID)}
+	routerConfig, err := ExpectedRouterConfig(ctx, fps, *script.Steps[0].Version, 0)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	lns := &fakeLNS{
+		routerConfig: routerConfig,
+		txConfCh:     make(chan lbslns.TxConfirmation, 1),
+	}
+	srv := httptest.NewServer(lns)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(ctx, 10*time.Second)
+	defer cancel()
+	client, err := Connect(ctx, Config{
+		Address:    "ws://" + srv.Listener.Addr().String(),
+		GatewayEUI: types.EUI64{0x58, 0xa0, 0xcb, 0xff, 0xfe, 0x80, 0x00, 0x01},
+		Timeout:    time.Second,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer client.Close()
+
+	results, err := Run(ctx, client, script, Expectations{RouterConfig: routerConfig})
+	if !a.So(err, should.BeNil) || !a.So(results, should.HaveLength, 5) {
+		t.FailNow()
+	}
+	for _, res := range results {
+		a.So(res.Errors, should.BeEmpty)
+		a.So(res.Passed(), should.BeTrue)
+	}
+	a.So(results[2].Type, should.Equal, lbslns.TypeUpstreamJoinRequest)
+
+	select {
+	case conf := <-lns.txConfCh:
+		a.So(conf.Diid, should.Equal, 42)
+		a.So(conf.DevEUI, should.Resemble, id6.EUI{EUI64: types.EUI64{0, 0, 0, 0, 0, 0, 0, 1}})
+	case <-time.After(time.Second):
+		t.Fatal("Expected TX confirmation")
+	}
+}
+
+func TestVerifyRouterConfig(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	expected := []byte(`{"msgtype":"router_config","region":"EU863","freq_range":[863000000,870000000],"MuxTime":1}`)
+	a.So(VerifyRouterConfig(expected, []byte(
+		`{"msgtype":"router_config","region":"EU863","freq_range":[863000000,870000000],"MuxTime":2}`,
+	)), should.BeEmpty)
+	a.So(VerifyRouterConfig(expected, []byte(
+		`{"msgtype":"router_config","region":"EU863","freq_range":[863000000,868000000],"nocca":true}`,
+	)), should.Resemble, []string{
+		"router_config field `freq_range` is `[863000000,868000000]`, expected `[863000000,870000000]`",
+		"router_config field `nocca` is `true`, expected `null`",
+	})
+}
+
+func TestReadScript(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	_, err := ReadScript(strings.NewReader("steps:\n- version: {}\n  timesync: {}\n"))
+	a.So(err, should.NotBeNil)
+	_, err = ReadScript(strings.NewReader("steps:\n- unknown: {}\n"))
+	a.So(err, should.NotBeNil)
+}
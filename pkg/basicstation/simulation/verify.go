@@ -0,0 +1,194 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package simulation
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	pfconfig "go.thethings.network/lorawan-stack/v3/pkg/pfconfig/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+const (
+	// maxRxDelay is the maximum delay between the uplink and the downlink, including the RX2 window.
+	maxRxDelay = 16 * time.Second
+	// maxTimeSyncOffset is the maximum offset between the GPS time in a timesync response and the local time.
+	maxTimeSyncOffset = 5 * time.Second
+)
+
+var errNoFrequencyPlans = errors.DefineInvalidArgument("no_frequency_plans", "no frequency plans")
+
+// ExpectedRouterConfig returns the router_config message that the Gateway Server is expected to send to a gateway
+// with the given frequency plans, version and antenna gain. All frequency plans must use the same band.
+func ExpectedRouterConfig(
+	ctx context.Context, fps []*frequencyplans.FrequencyPlan, version VersionStep, antennaGain int,
+) ([]byte, error) {
+	if len(fps) == 0 {
+		return nil, errNoFrequencyPlans.New()
+	}
+	fpsByID := make(map[string]*frequencyplans.FrequencyPlan, len(fps))
+	for i, fp := range fps {
+		fpsByID[fmt.Sprintf("%d", i)] = fp
+	}
+	cfg, err := pfconfig.GetRouterConfig(ctx, fps[0].BandID, fpsByID, version.message(), time.Now(), antennaGain)
+	if err != nil {
+		return nil, err
+	}
+	// The Gateway Server does not specify the bandwidth of the FSK channel.
+	for _, sx1301 := range cfg.SX1301Config {
+		if ch := sx1301.FSKChannel; ch != nil {
+			ch.Bandwidth = 0
+		}
+	}
+	return cfg.MarshalJSON()
+}
+
+// VerifyRouterConfig compares the received router_config message with the expected message.
+// The MuxTime field is not compared. It returns the differences.
+func VerifyRouterConfig(expected, actual []byte) []string {
+	var expectedFields, actualFields map[string]any
+	if err := json.Unmarshal(expected, &expectedFields); err != nil {
+		return []string{fmt.Sprintf("invalid expected router_config: %v", err)}
+	}
+	if err := json.Unmarshal(actual, &actualFields); err != nil {
+		return []string{fmt.Sprintf("invalid router_config: %v", err)}
+	}
+	delete(expectedFields, "MuxTime")
+	delete(actualFields, "MuxTime")
+
+	keys := make(map[string]struct{}, len(expectedFields))
+	for k := range expectedFields {
+		keys[k] = struct{}{}
+	}
+	for k := range actualFields {
+		keys[k] = struct{}{}
+	}
+	sortedKeys := make([]string, 0, len(keys))
+	for k := range keys {
+		sortedKeys = append(sortedKeys, k)
+	}
+	sort.Strings(sortedKeys)
+
+	var diffs []string
+	for _, k := range sortedKeys {
+		e, a := expectedFields[k], actualFields[k]
+		if reflect.DeepEqual(e, a) {
+			continue
+		}
+		eJSON, _ := json.Marshal(e)
+		aJSON, _ := json.Marshal(a)
+		diffs = append(diffs, fmt.Sprintf("router_config field `%s` is `%s`, expected `%s`", k, aJSON, eJSON))
+	}
+	return diffs
+}
+
+// routerConfig contains the fields of the router_config message that are used to verify downlink messages.
+type routerConfig struct {
+	FrequencyRange []int   `json:"freq_range"`
+	DataRates      [][]int `json:"DRs"`
+}
+
+// verifyRouterConfigFields verifies the consistency of the received router_config message.
+func verifyRouterConfigFields(cfg routerConfig) []string {
+	var errs []string
+	if len(cfg.FrequencyRange) != 2 || cfg.FrequencyRange[0] >= cfg.FrequencyRange[1] {
+		errs = append(errs, fmt.Sprintf("invalid freq_range %v", cfg.FrequencyRange))
+	}
+	if len(cfg.DataRates) == 0 {
+		errs = append(errs, "no DRs")
+	}
+	return errs
+}
+
+// verifyTimeSync verifies the GPS time of the timesync response.
+func verifyTimeSync(res lbslns.TimeSyncResponse, now time.Time) []string {
+	if res.GPSTime == 0 {
+		return []string{"timesync response without gpstime"}
+	}
+	if offset := ws.TimeFromGPSTime(res.GPSTime).Sub(now); offset > maxTimeSyncOffset || offset < -maxTimeSyncOffset {
+		return []string{fmt.Sprintf("timesync gpstime is off by %s", offset)}
+	}
+	return nil
+}
+
+// uplink contains the uplink that a downlink message is expected for.
+type uplink struct {
+	xTime      int64
+	joinAccept bool
+	devAddr    types.DevAddr
+}
+
+// verifyDownlink verifies the dnmsg message that is sent in response to the uplink.
+func verifyDownlink(dnmsg lbslns.DownlinkMessage, up uplink, cfg routerConfig) []string {
+	var errs []string
+	pdu, err := hex.DecodeString(dnmsg.Pdu)
+	if err != nil || len(pdu) == 0 {
+		return append(errs, fmt.Sprintf("invalid pdu `%s`", dnmsg.Pdu))
+	}
+	msg := &ttnpb.Message{}
+	if up.joinAccept {
+		if ttnpb.MType(pdu[0]>>5) != ttnpb.MType_JOIN_ACCEPT {
+			errs = append(errs, fmt.Sprintf("pdu is not a join-accept, MHDR is 0x%02x", pdu[0]))
+		}
+	} else if err := lorawan.UnmarshalMessage(pdu, msg); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid pdu: %v", err))
+	} else if pld := msg.GetMacPayload(); pld == nil {
+		errs = append(errs, fmt.Sprintf("pdu is not a data downlink, MType is %s", msg.GetMHdr().GetMType()))
+	} else if !types.MustDevAddr(pld.FHdr.GetDevAddr()).OrZero().Equal(up.devAddr) {
+		errs = append(errs, fmt.Sprintf(
+			"pdu DevAddr is %s, expected %s", types.MustDevAddr(pld.FHdr.GetDevAddr()).OrZero(), up.devAddr,
+		))
+	}
+
+	ts := dnmsg.TimestampDownlinkMessage
+	if ts == nil {
+		return append(errs, "dnmsg is not a class A downlink")
+	}
+	if dnmsg.DeviceClass != uint(ttnpb.Class_CLASS_A) {
+		errs = append(errs, fmt.Sprintf("dC is %d, expected %d", dnmsg.DeviceClass, ttnpb.Class_CLASS_A))
+	}
+	if ts.RxDelay < 1 || ts.RxDelay > 15 {
+		errs = append(errs, fmt.Sprintf("invalid RxDelay %d", ts.RxDelay))
+	}
+	if ws.SessionIDFromXTime(ts.XTime) != ws.SessionIDFromXTime(up.xTime) {
+		errs = append(errs, fmt.Sprintf(
+			"xtime session is %d, expected %d", ws.SessionIDFromXTime(ts.XTime), ws.SessionIDFromXTime(up.xTime),
+		))
+	}
+	delay := time.Duration(ws.ConcentratorTimeFromXTime(ts.XTime)-ws.ConcentratorTimeFromXTime(up.xTime)) +
+		time.Duration(ts.RxDelay)*time.Second
+	if delay < time.Second || delay > maxRxDelay {
+		errs = append(errs, fmt.Sprintf("downlink is scheduled %s after the uplink", delay))
+	}
+	if ts.Rx1DR < 0 || ts.Rx1DR >= len(cfg.DataRates) {
+		errs = append(errs, fmt.Sprintf("RX1DR %d is not in DRs", ts.Rx1DR))
+	}
+	if len(cfg.FrequencyRange) == 2 && (ts.Rx1Freq < cfg.FrequencyRange[0] || ts.Rx1Freq > cfg.FrequencyRange[1]) {
+		errs = append(errs, fmt.Sprintf("RX1Freq %d is not in freq_range %v", ts.Rx1Freq, cfg.FrequencyRange))
+	}
+	return errs
+}
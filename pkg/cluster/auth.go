@@ -29,6 +29,9 @@ var HookName = "cluster-hook"
 func (c *cluster) TLS() bool { return c.tls }
 
 func (c *cluster) WithVerifiedSource(ctx context.Context) context.Context {
+	if c.spiffe != nil && c.spiffe.VerifyPeer(ctx) == nil {
+		return clusterauth.NewContext(ctx, nil)
+	}
 	return clusterauth.VerifySource(ctx, c.keys)
 }
 
@@ -0,0 +1,67 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/circuitbreaker"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpcretry"
+	"google.golang.org/grpc"
+)
+
+// timeoutUnaryClientInterceptor returns a unary client interceptor that sets the given timeout on calls
+// without deadline.
+func timeoutUnaryClientInterceptor(timeout time.Duration) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		if _, ok := ctx.Deadline(); !ok {
+			var cancel context.CancelFunc
+			ctx, cancel = context.WithTimeout(ctx, timeout)
+			defer cancel()
+		}
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// callDialOptions returns the dial options for unary calls to the cluster peer with the given name.
+// The default deadline covers all attempts. The circuit breaker rejects calls before they are retried, and records
+// the result of the last attempt.
+func (c *cluster) callDialOptions(name string) []grpc.DialOption {
+	var interceptors []grpc.UnaryClientInterceptor
+	if c.calls.Timeout > 0 {
+		interceptors = append(interceptors, timeoutUnaryClientInterceptor(c.calls.Timeout))
+	}
+	if c.calls.CircuitBreaker.Enable {
+		interceptors = append(interceptors, circuitbreaker.New(name, c.calls.CircuitBreaker).UnaryClientInterceptor())
+	}
+	if c.calls.Retry.Max > 0 {
+		interceptors = append(interceptors, rpcretry.UnaryClientInterceptor(
+			rpcretry.WithMax(c.calls.Retry.Max),
+			rpcretry.WithDefaultTimeout(c.calls.Retry.Timeout),
+		))
+	}
+	if len(interceptors) == 0 {
+		return nil
+	}
+	return []grpc.DialOption{grpc.WithChainUnaryInterceptor(interceptors...)}
+}
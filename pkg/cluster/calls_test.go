@@ -0,0 +1,54 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cluster_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+)
+
+func TestTimeoutUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	interceptor := TimeoutUnaryClientInterceptor(time.Minute)
+
+	var deadline time.Time
+	var hasDeadline bool
+	invoker := func(ctx context.Context, _ string, _, _ any, _ *grpc.ClientConn, _ ...grpc.CallOption) error {
+		deadline, hasDeadline = ctx.Deadline()
+		return nil
+	}
+
+	// Calls without deadline get the default deadline.
+	a.So(interceptor(ctx, "/test.Service/Method", nil, nil, nil, invoker), should.BeNil)
+	a.So(hasDeadline, should.BeTrue)
+	a.So(deadline, should.HappenWithin, time.Minute+time.Second, time.Now())
+
+	// Calls with deadline keep their deadline.
+	expected := time.Now().Add(time.Hour)
+	ctx, cancel := context.WithDeadline(ctx, expected)
+	defer cancel()
+	a.So(interceptor(ctx, "/test.Service/Method", nil, nil, nil, invoker), should.BeNil)
+	a.So(deadline, should.Equal, expected)
+}
@@ -25,6 +25,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/spiffe"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
@@ -122,6 +123,15 @@ func WithTLSConfig(tlsConfig *tls.Config) Option {
 	})
 }
 
+// WithSPIFFE authenticates cluster peers with SPIFFE X.509-SVIDs from the given source.
+// The X.509-SVID is presented as client certificate when connecting to cluster peers, and incoming calls from peers
+// with a verified X.509-SVID in the trust domain are trusted without cluster key.
+func WithSPIFFE(source *spiffe.Source) Option {
+	return optionFunc(func(c *cluster) {
+		c.spiffe = source
+	})
+}
+
 // CustomNew allows you to replace the clustering implementation. New will call CustomNew if not nil.
 var CustomNew func(ctx context.Context, config *Config, options ...Option) (Cluster, error)
 
@@ -141,16 +151,13 @@ func defaultNew(ctx context.Context, config *Config, options ...Option) (Cluster
 		ctx:           ctx,
 		tls:           config.TLS,
 		tlsServerName: config.TLSServerName,
+		calls:         config.Calls,
 		dialOptions: func(ctx context.Context) []grpc.DialOption {
 			return nil
 		},
 		peers: make(map[string]*peer),
 	}
 
-	if err := c.loadKeys(ctx, config.Keys...); err != nil {
-		return nil, err
-	}
-
 	c.self = &peer{
 		name:   config.Name,
 		target: config.Address,
@@ -164,6 +171,13 @@ func defaultNew(ctx context.Context, config *Config, options ...Option) (Cluster
 		option.apply(c)
 	}
 
+	if c.spiffe != nil && !c.tls {
+		return nil, errSPIFFEWithoutTLS.New()
+	}
+	if err := c.loadKeys(ctx, config.Keys...); err != nil {
+		return nil, err
+	}
+
 	c.addPeer("is", config.IdentityServer, ttnpb.ClusterRole_ACCESS, ttnpb.ClusterRole_ENTITY_REGISTRY)
 	c.addPeer("gs", config.GatewayServer, ttnpb.ClusterRole_GATEWAY_SERVER)
 	c.addPeer("ns", config.NetworkServer, ttnpb.ClusterRole_NETWORK_SERVER)
@@ -196,9 +210,11 @@ type cluster struct {
 	tls           bool
 	tlsConfig     *tls.Config
 	tlsServerName string
+	calls         CallConfig
 	dialOptions   func(ctx context.Context) []grpc.DialOption
 	peers         map[string]*peer
 	self          *peer
+	spiffe        *spiffe.Source
 
 	keys [][]byte
 }
@@ -208,6 +224,7 @@ var (
 	errPeerEmptyTarget   = errors.DefineInvalidArgument("peer_empty_target", "peer target address is empty")
 	errInvalidClusterKey = errors.DefineInvalidArgument("cluster_key", "invalid cluster key")
 	errInvalidKeyLength  = errors.DefineInvalidArgument("key_length", "invalid key length %d, must be 16, 24 or 32 bytes")
+	errSPIFFEWithoutTLS  = errors.DefineFailedPrecondition("spiffe_without_tls", "SPIFFE requires cluster TLS")
 )
 
 func (c *cluster) loadKeys(ctx context.Context, keys ...string) error {
@@ -225,6 +242,10 @@ func (c *cluster) loadKeys(ctx context.Context, keys ...string) error {
 	}
 	if c.keys == nil {
 		c.keys = [][]byte{random.Bytes(32)}
+		if c.spiffe != nil {
+			// The random key is only used for calls to self; peers are authenticated with their X.509-SVID.
+			return nil
+		}
 		log.FromContext(ctx).WithField("key", hex.EncodeToString(c.keys[0])).Warn("No cluster key configured, generated a random one")
 	}
 	return nil
@@ -279,13 +300,16 @@ func (c *cluster) Join() (err error) {
 			peer.connErr = errPeerEmptyTarget
 			continue
 		}
-		options := c.dialOptions(c.ctx)
+		options := append(c.dialOptions(c.ctx), c.callDialOptions(peer.name)...)
 		if c.tls {
 			tlsConfig := &tls.Config{}
 			if c.tlsConfig != nil {
 				tlsConfig = c.tlsConfig.Clone()
 			}
 			tlsConfig.ServerName = peer.tlsServerName
+			if c.spiffe != nil {
+				tlsConfig.GetClientCertificate = c.spiffe.GetClientCertificate
+			}
 			logger = logger.WithField("tls_server_name", peer.tlsServerName)
 			options = append(options, grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)))
 		} else {
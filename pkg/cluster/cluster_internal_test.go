@@ -15,3 +15,5 @@
 package cluster
 
 type ClusterImpl cluster
+
+var TimeoutUnaryClientInterceptor = timeoutUnaryClientInterceptor
@@ -14,6 +14,13 @@
 
 package cluster
 
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/circuitbreaker"
+	"go.thethings.network/lorawan-stack/v3/pkg/spiffe"
+)
+
 // Config represents clustering configuration.
 type Config struct {
 	Join                       []string `name:"join" description:"Addresses of cluster peers to join"`
@@ -32,4 +39,20 @@ type Config struct {
 	TLS                        bool     `name:"tls" description:"Do cluster gRPC over TLS"`
 	TLSServerName              string   `name:"tls-server-name" description:"Server name to use in TLS handshake to cluster peers"`                                                          //nolint:lll
 	Keys                       []string `name:"keys" description:"Keys used to communicate between components of the cluster. The first one will be used by the cluster to identify itself"` //nolint:lll
+
+	SPIFFE spiffe.Config `name:"spiffe"`
+	Calls  CallConfig    `name:"calls"`
+}
+
+// CallConfig represents the configuration of unary calls to cluster peers.
+type CallConfig struct {
+	Timeout        time.Duration         `name:"timeout" description:"Default deadline of unary calls to cluster peers without deadline"` //nolint:lll
+	Retry          RetryConfig           `name:"retry"`
+	CircuitBreaker circuitbreaker.Config `name:"circuit-breaker"`
+}
+
+// RetryConfig represents the configuration of retries of unary calls to cluster peers.
+type RetryConfig struct {
+	Max     uint          `name:"max" description:"Maximum number of retries of calls to cluster peers that are unavailable or exhausted"` //nolint:lll
+	Timeout time.Duration `name:"timeout" description:"Time to wait between retries of calls to cluster peers"`
 }
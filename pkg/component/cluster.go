@@ -20,6 +20,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/spiffe"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"google.golang.org/grpc"
 )
@@ -36,6 +37,13 @@ func (c *Component) initCluster() (err error) {
 		clusterOpts = append(clusterOpts, cluster.WithTLSConfig(tlsConfig))
 	}
 	ctx := log.NewContextWithField(c.ctx, "namespace", "cluster")
+	if conf := c.config.ServiceBase.Cluster.SPIFFE; conf.Enable {
+		c.spiffe, err = spiffe.NewSource(ctx, c, conf)
+		if err != nil {
+			return err
+		}
+		clusterOpts = append(clusterOpts, cluster.WithSPIFFE(c.spiffe))
+	}
 	c.cluster, err = c.clusterNew(ctx, &c.config.ServiceBase.Cluster, clusterOpts...)
 	if err != nil {
 		return err
@@ -38,6 +38,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/spiffe"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -66,6 +67,7 @@ type Component struct {
 
 	cluster    cluster.Cluster
 	clusterNew func(ctx context.Context, config *cluster.Config, options ...cluster.Option) (cluster.Cluster, error)
+	spiffe     *spiffe.Source
 
 	GRPC           *rpcserver.Server
 	grpcLogger     log.Interface
@@ -78,6 +80,7 @@ type Component struct {
 	interopSubsystems []interop.Registerer
 
 	healthHandler healthcheck.HealthChecker
+	statusPage    statusPage
 
 	loopback *grpc.ClientConn
 
@@ -96,7 +99,11 @@ type Component struct {
 	taskStarter task.Starter
 	taskConfigs []*task.Config
 
-	limiter ratelimit.Interface
+	limiter *reloadableLimiter
+
+	configLoader ConfigLoader
+	reloadMu     sync.Mutex
+	reloaders    []namedReloader
 }
 
 // Option allows extending the component when it is instantiated with New.
@@ -185,10 +192,14 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		return nil, err
 	}
 
-	c.limiter, err = ratelimit.New(ctx, config.RateLimiting, config.Blob, c)
+	c.limiter = &reloadableLimiter{}
+	limiter, err := ratelimit.New(
+		ctx, config.RateLimiting, config.Blob, c, c.limiter.options(config.RateLimiting)...,
+	)
 	if err != nil {
 		return nil, err
 	}
+	c.limiter.set(limiter)
 
 	for _, opt := range opts {
 		opt(c)
@@ -198,7 +209,10 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 	if err != nil {
 		return nil, err
 	}
-	c.frequencyPlans = frequencyplans.NewStore(fpsFetcher)
+	c.frequencyPlans = frequencyplans.NewStore(
+		fpsFetcher,
+		frequencyplans.WithOverrides(config.FrequencyPlans.Overrides.Fetcher()),
+	)
 
 	if c.clusterNew == nil {
 		c.clusterNew = cluster.New
@@ -218,7 +232,9 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		return nil, err
 	}
 
-	c.initRights()
+	if err := c.initRights(); err != nil {
+		return nil, err
+	}
 
 	c.initGRPC()
 
@@ -226,6 +242,14 @@ func New(logger log.Stack, config *Config, opts ...Option) (c *Component, err er
 		c.RegisterTask(versionCheckTask(ctx, c))
 	}
 
+	if interval := config.ServiceBase.Secrets.RefreshInterval; interval > 0 && c.configLoader != nil {
+		c.RegisterTask(secretsRefreshTask(ctx, c, interval))
+	}
+
+	if config.ServiceBase.HTTP.Status.Enable {
+		c.RegisterTask(statusPageTask(ctx, c, config.ServiceBase.HTTP.Status.Interval))
+	}
+
 	return c, nil
 }
 
@@ -391,10 +415,23 @@ func (c *Component) Run() error {
 
 	signal.Notify(c.terminationSignals, os.Interrupt, syscall.SIGTERM)
 
-	sig := <-c.terminationSignals
-	fmt.Println()
-	c.logger.WithField("signal", sig).Info("Received signal, exiting...")
-	return nil
+	reloadSignals := make(chan os.Signal, 1)
+	signal.Notify(reloadSignals, syscall.SIGHUP)
+	defer signal.Stop(reloadSignals)
+
+	for {
+		select {
+		case sig := <-reloadSignals:
+			c.logger.WithField("signal", sig).Info("Received signal, reloading configuration...")
+			if err := c.Reload(c.ctx); err != nil {
+				c.logger.WithError(err).Error("Failed to reload configuration")
+			}
+		case sig := <-c.terminationSignals:
+			fmt.Println()
+			c.logger.WithField("signal", sig).Info("Received signal, exiting...")
+			return nil
+		}
+	}
 }
 
 // Close closes the server.
@@ -0,0 +1,62 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+	"gopkg.in/yaml.v2"
+)
+
+// RegisterRoutes registers the web frontend routes of the Configuration service.
+//
+// The frequency plan route returns the effective contents of a frequency plan, with the base frequency plan
+// and the operator defined overrides applied, in the YAML format of the frequency plans repository.
+func (c *ConfigurationServer) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/configuration/").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("configuration")),
+		ratelimit.HTTPMiddleware(c.component.RateLimiter(), "http:configuration"),
+	)
+	router.HandleFunc("/frequency-plans/{frequency_plan_id}", c.handleGetFrequencyPlan).Methods(http.MethodGet)
+}
+
+func (c *ConfigurationServer) handleGetFrequencyPlan(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	fps, err := c.component.FrequencyPlansStore(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	fp, err := fps.GetByID(mux.Vars(r)["frequency_plan_id"])
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	b, err := yaml.Marshal(fp)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/yaml")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
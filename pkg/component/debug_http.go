@@ -0,0 +1,208 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"runtime/debug"
+	runtimemetrics "runtime/metrics"
+	rpprof "runtime/pprof"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
+)
+
+var (
+	errDecodeGCSettings = errors.DefineInvalidArgument("decode_gc_settings", "decode garbage collector settings")
+	errGCPercent        = errors.DefineInvalidArgument("gc_percent", "invalid GC percent `{gc_percent}`")
+	errMemoryLimit      = errors.DefineInvalidArgument("memory_limit", "invalid memory limit `{memory_limit}`")
+	errProfileNotFound  = errors.DefineNotFound("profile_not_found", "profile `{profile}` not found")
+)
+
+const maxGCSettingsSize = 1 << 10
+
+// registerDebug registers the routes of the debug API.
+// Only admins can retrieve profiles and runtime statistics, and tune the garbage collector.
+func (c *Component) registerDebug(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/debug").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("debug")),
+		ratelimit.HTTPMiddleware(c.RateLimiter(), "http:debug"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+		c.requireAdmin,
+	)
+	router.HandleFunc("/pprof", handleListProfiles).Methods(http.MethodGet)
+	router.HandleFunc("/pprof/profile", pprof.Profile).Methods(http.MethodGet)
+	router.HandleFunc("/pprof/trace", pprof.Trace).Methods(http.MethodGet)
+	router.HandleFunc("/pprof/{profile}", handleProfile).Methods(http.MethodGet)
+	router.HandleFunc("/runtime", handleRuntimeStats).Methods(http.MethodGet)
+	router.HandleFunc("/runtime/gc", handleSetGCSettings).Methods(http.MethodPut)
+	router.HandleFunc("/runtime/gc", handleRunGC).Methods(http.MethodPost)
+	router.HandleFunc("/workerpools", handleWorkerPoolStats).Methods(http.MethodGet)
+}
+
+func (*Component) requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := rights.RequireIsAdmin(r.Context()); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+type profileInfo struct {
+	Name  string `json:"name"`
+	Count int    `json:"count"`
+}
+
+func handleListProfiles(w http.ResponseWriter, _ *http.Request) {
+	profiles := rpprof.Profiles()
+	res := make([]profileInfo, 0, len(profiles))
+	for _, p := range profiles {
+		res = append(res, profileInfo{Name: p.Name(), Count: p.Count()})
+	}
+	writeJSON(w, struct {
+		Profiles []profileInfo `json:"profiles"`
+	}{
+		Profiles: res,
+	})
+}
+
+// handleProfile writes the profile with the given name, for example the heap profile or the goroutine dump.
+// The debug query parameter is supported as in net/http/pprof, i.e. `goroutine?debug=2` writes the stack
+// traces of all goroutines.
+func handleProfile(w http.ResponseWriter, r *http.Request) {
+	name := mux.Vars(r)["profile"]
+	if rpprof.Lookup(name) == nil {
+		webhandlers.Error(w, r, errProfileNotFound.WithAttributes("profile", name))
+		return
+	}
+	pprof.Handler(name).ServeHTTP(w, r)
+}
+
+// runtimeInfo contains the runtime statistics of the process.
+type runtimeInfo struct {
+	GoVersion    string `json:"go_version"`
+	NumCPU       int    `json:"num_cpu"`
+	GOMAXPROCS   int    `json:"gomaxprocs"`
+	NumGoroutine int    `json:"num_goroutine"`
+	GCPercent    *int64 `json:"gc_percent,omitempty"`
+	MemoryLimit  int64  `json:"memory_limit"`
+	HeapAlloc    uint64 `json:"heap_alloc"`
+	HeapSys      uint64 `json:"heap_sys"`
+	HeapObjects  uint64 `json:"heap_objects"`
+	Sys          uint64 `json:"sys"`
+	NextGC       uint64 `json:"next_gc"`
+	NumGC        uint32 `json:"num_gc"`
+	PauseTotalNs uint64 `json:"pause_total_ns"`
+}
+
+func runtimeStats() *runtimeInfo {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+	stats := &runtimeInfo{
+		GoVersion:    runtime.Version(),
+		NumCPU:       runtime.NumCPU(),
+		GOMAXPROCS:   runtime.GOMAXPROCS(0),
+		NumGoroutine: runtime.NumGoroutine(),
+		// A negative memory limit does not change the memory limit, but returns the current one.
+		MemoryLimit:  debug.SetMemoryLimit(-1),
+		HeapAlloc:    memStats.HeapAlloc,
+		HeapSys:      memStats.HeapSys,
+		HeapObjects:  memStats.HeapObjects,
+		Sys:          memStats.Sys,
+		NextGC:       memStats.NextGC,
+		NumGC:        memStats.NumGC,
+		PauseTotalNs: memStats.PauseTotalNs,
+	}
+	// The GC percent can only be read without changing it through the runtime metrics,
+	// which are not available on all Go versions.
+	samples := []runtimemetrics.Sample{{Name: "/gc/gogc:percent"}}
+	runtimemetrics.Read(samples)
+	if samples[0].Value.Kind() == runtimemetrics.KindUint64 {
+		gcPercent := int64(samples[0].Value.Uint64())
+		stats.GCPercent = &gcPercent
+	}
+	return stats
+}
+
+func handleRuntimeStats(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, runtimeStats())
+}
+
+// gcSettings contains the settings of the garbage collector.
+// Settings that are not set are not changed.
+type gcSettings struct {
+	// GCPercent is the garbage collection target percentage. A negative percentage disables the garbage collector.
+	GCPercent *int `json:"gc_percent,omitempty"`
+	// MemoryLimit is the soft memory limit in bytes.
+	MemoryLimit *int64 `json:"memory_limit,omitempty"`
+}
+
+func handleSetGCSettings(w http.ResponseWriter, r *http.Request) {
+	settings := &gcSettings{}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGCSettingsSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(settings); err != nil {
+		webhandlers.Error(w, r, errDecodeGCSettings.WithCause(err))
+		return
+	}
+	if settings.GCPercent != nil && *settings.GCPercent < -1 {
+		webhandlers.Error(w, r, errGCPercent.WithAttributes("gc_percent", *settings.GCPercent))
+		return
+	}
+	if settings.MemoryLimit != nil && *settings.MemoryLimit < 0 {
+		webhandlers.Error(w, r, errMemoryLimit.WithAttributes("memory_limit", *settings.MemoryLimit))
+		return
+	}
+	if settings.GCPercent != nil {
+		debug.SetGCPercent(*settings.GCPercent)
+	}
+	if settings.MemoryLimit != nil {
+		debug.SetMemoryLimit(*settings.MemoryLimit)
+	}
+	writeJSON(w, runtimeStats())
+}
+
+// handleRunGC runs the garbage collector and returns as much memory to the operating system as possible.
+func handleRunGC(w http.ResponseWriter, _ *http.Request) {
+	debug.FreeOSMemory()
+	writeJSON(w, runtimeStats())
+}
+
+func handleWorkerPoolStats(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, struct {
+		WorkerPools []workerpool.Stats `json:"worker_pools"`
+	}{
+		WorkerPools: workerpool.AllStats(),
+	})
+}
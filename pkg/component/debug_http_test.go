@@ -0,0 +1,155 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDebugAPI(t *testing.T) {
+	a := assertions.New(t)
+
+	c, err := New(test.GetLogger(t), &Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Debug: config.Debug{
+					Enable: true,
+				},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, path := range []string{
+		"/api/v3/debug/pprof",
+		"/api/v3/debug/pprof/heap",
+		"/api/v3/debug/runtime",
+		"/api/v3/debug/workerpools",
+	} {
+		for _, tc := range []struct {
+			name     string
+			authInfo *ttnpb.AuthInfoResponse
+			code     int
+		}{
+			{
+				name: "User",
+				authInfo: &ttnpb.AuthInfoResponse{
+					UniversalRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_INFO),
+				},
+				code: http.StatusForbidden,
+			},
+			{
+				name: "Admin",
+				authInfo: &ttnpb.AuthInfoResponse{
+					UniversalRights: ttnpb.AllAdminRights.Implied(),
+					IsAdmin:         true,
+				},
+				code: http.StatusOK,
+			},
+		} {
+			t.Run(fmt.Sprintf("%s/%s", path, tc.name), func(t *testing.T) {
+				a := assertions.New(t)
+				req := httptest.NewRequest(http.MethodGet, path, nil)
+				req = req.WithContext(rights.NewContextWithAuthInfo(req.Context(), tc.authInfo))
+				rec := httptest.NewRecorder()
+				c.ServeHTTP(rec, req)
+				a.So(rec.Code, should.Equal, tc.code)
+			})
+		}
+	}
+
+	rec := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/api/v3/debug/pprof/unknown", nil)
+	req = req.WithContext(rights.NewContextWithAuthInfo(req.Context(), &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.AllAdminRights.Implied(),
+		IsAdmin:         true,
+	}))
+	c.ServeHTTP(rec, req)
+	a.So(rec.Code, should.Equal, http.StatusNotFound)
+}
+
+func TestDebugHandlers(t *testing.T) {
+	a := assertions.New(t)
+
+	t.Run("Profiles", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handleListProfiles(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		var res struct {
+			Profiles []profileInfo `json:"profiles"`
+		}
+		a.So(json.Unmarshal(rec.Body.Bytes(), &res), should.BeNil)
+		a.So(res.Profiles, should.NotBeEmpty)
+	})
+
+	t.Run("RuntimeStats", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handleRuntimeStats(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		var res runtimeInfo
+		a.So(json.Unmarshal(rec.Body.Bytes(), &res), should.BeNil)
+		a.So(res.NumGoroutine, should.BeGreaterThan, 0)
+		a.So(res.GOMAXPROCS, should.BeGreaterThan, 0)
+		a.So(res.HeapAlloc, should.BeGreaterThan, 0)
+	})
+
+	t.Run("GCSettings", func(t *testing.T) {
+		memoryLimit := debug.SetMemoryLimit(-1)
+		defer debug.SetMemoryLimit(memoryLimit)
+
+		rec := httptest.NewRecorder()
+		handleSetGCSettings(rec, httptest.NewRequest(http.MethodPut, "/",
+			bytes.NewBufferString(`{"memory_limit":1073741824}`),
+		))
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		var res runtimeInfo
+		a.So(json.Unmarshal(rec.Body.Bytes(), &res), should.BeNil)
+		a.So(res.MemoryLimit, should.Equal, 1<<30)
+		a.So(debug.SetMemoryLimit(-1), should.Equal, 1<<30)
+
+		for _, body := range []string{
+			`{"gc_percent":-2}`,
+			`{"memory_limit":-1}`,
+			`{"unknown":1}`,
+		} {
+			rec := httptest.NewRecorder()
+			handleSetGCSettings(rec, httptest.NewRequest(http.MethodPut, "/", bytes.NewBufferString(body)))
+			a.So(rec.Code, should.Equal, http.StatusBadRequest)
+		}
+		a.So(debug.SetMemoryLimit(-1), should.Equal, 1<<30)
+	})
+
+	t.Run("WorkerPools", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		handleWorkerPoolStats(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		a.So(rec.Body.String(), should.ContainSubstring, `"worker_pools"`)
+	})
+}
@@ -16,6 +16,7 @@ package component
 
 import (
 	"context"
+	"crypto/tls"
 	"net"
 
 	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
@@ -42,6 +43,9 @@ func (c *Component) initGRPC() {
 		rpcserver.WithTrustedProxies(c.config.GRPC.TrustedProxies...),
 		rpcserver.WithLogIgnoreMethods(c.config.GRPC.LogIgnoreMethods),
 		rpcserver.WithRateLimiter(c.RateLimiter()),
+		rpcserver.WithReflection(c.config.GRPC.Reflection),
+		rpcserver.WithRichErrorDetails(c.config.GRPC.RichErrorDetails),
+		rpcserver.WithStreamHeartbeatInterval(c.config.HTTP.StreamHeartbeatInterval),
 	)
 }
 
@@ -79,9 +83,18 @@ func (c *Component) serveGRPC(lis net.Listener) error {
 }
 
 func (c *Component) grpcEndpoints() []Endpoint {
+	tlsOpts := []tlsconfig.Option{tlsconfig.WithNextProtos("h2", "http/1.1")}
+	if c.spiffe != nil {
+		// Cluster peers present their X.509-SVID as client certificate, which is verified with the current
+		// trust bundle. Other clients do not present a client certificate.
+		tlsOpts = append(tlsOpts,
+			tlsconfig.WithTLSClientAuth(tls.VerifyClientCertIfGiven, c.spiffe.Bundle(), nil),
+			tlsconfig.WithTLSClientCAsFunc(c.spiffe.Bundle),
+		)
+	}
 	return []Endpoint{
 		NewTCPEndpoint(c.config.GRPC.Listen, "gRPC"),
-		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC", tlsconfig.WithNextProtos("h2", "http/1.1")),
+		NewTLSEndpoint(c.config.GRPC.ListenTLS, "gRPC", tlsOpts...),
 	}
 }
 
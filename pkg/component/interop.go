@@ -50,6 +50,7 @@ func (c *Component) interopEndpoints() []Endpoint {
 		NewTCPEndpoint(c.config.Interop.Listen, "Interop"),
 		NewTLSEndpoint(c.config.Interop.ListenTLS, "Interop",
 			tlsconfig.WithTLSClientAuth(tls.VerifyClientCertIfGiven, c.interop.ClientCAPool(), nil),
+			tlsconfig.WithTLSClientCAsFunc(c.interop.ClientCAPool),
 			tlsconfig.WithNextProtos("h2", "http/1.1"),
 		),
 	}
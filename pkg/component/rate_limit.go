@@ -14,7 +14,58 @@
 
 package component
 
-import "go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+import (
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+// reloadableLimiter is a ratelimit.Interface of which the underlying limiter can be replaced
+// when the configuration is reloaded.
+type reloadableLimiter struct {
+	mu      sync.RWMutex
+	limiter ratelimit.Interface
+
+	// redis is the client of the Redis rate limiting store and overrides is the store of the
+	// rate limit overrides. They are created once and shared by reloaded limiters, so that
+	// reloads do not open new connections nor lose the overrides.
+	initOnce  sync.Once
+	redis     *redis.Client
+	overrides ratelimit.OverrideStore
+}
+
+// options returns the ratelimit options for the configuration.
+func (l *reloadableLimiter) options(conf config.RateLimiting) []ratelimit.Option {
+	l.initOnce.Do(func() {
+		if conf.Provider == "redis" {
+			l.redis = redis.New(conf.Redis.WithNamespace("ratelimit"))
+			l.overrides = ratelimit.NewRedisOverrideStore(l.redis)
+		} else {
+			l.overrides = ratelimit.NewMemoryOverrideStore()
+		}
+	})
+	opts := []ratelimit.Option{ratelimit.WithOverrideStore(l.overrides)}
+	if l.redis != nil {
+		opts = append(opts, ratelimit.WithRedisClient(l.redis))
+	}
+	return opts
+}
+
+// RateLimit implements ratelimit.Interface.
+func (l *reloadableLimiter) RateLimit(resource ratelimit.Resource) (bool, ratelimit.Result) {
+	l.mu.RLock()
+	limiter := l.limiter
+	l.mu.RUnlock()
+	return limiter.RateLimit(resource)
+}
+
+func (l *reloadableLimiter) set(limiter ratelimit.Interface) {
+	l.mu.Lock()
+	l.limiter = limiter
+	l.mu.Unlock()
+}
 
 func (c *Component) RateLimiter() ratelimit.Interface {
 	return c.limiter
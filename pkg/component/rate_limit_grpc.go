@@ -0,0 +1,136 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// NewRateLimitOverrideServer returns a new RateLimitOverrideServer on top of the given component.
+func NewRateLimitOverrideServer(c *Component) *RateLimitOverrideServer {
+	return &RateLimitOverrideServer{component: c}
+}
+
+// RateLimitOverrideServer implements the RateLimitOverrideRegistry RPC service.
+// Only admins can list, set and delete the overrides.
+type RateLimitOverrideServer struct {
+	ttnpb.UnimplementedRateLimitOverrideRegistryServer
+
+	component *Component
+}
+
+// Roles implements the rpcserver.Registerer interface. It just returns nil.
+func (*RateLimitOverrideServer) Roles() []ttnpb.ClusterRole { return nil }
+
+// RegisterServices registers the RateLimitOverrideRegistry service.
+func (s *RateLimitOverrideServer) RegisterServices(gs *grpc.Server) {
+	ttnpb.RegisterRateLimitOverrideRegistryServer(gs, s)
+}
+
+// RegisterHandlers registers the RateLimitOverrideRegistry service handler.
+func (s *RateLimitOverrideServer) RegisterHandlers(mux *runtime.ServeMux, conn *grpc.ClientConn) {
+	_ = ttnpb.RegisterRateLimitOverrideRegistryHandler(s.component.Context(), mux, conn)
+}
+
+func rateLimitOverrideToPB(override *ratelimit.Override) *ttnpb.RateLimitOverride {
+	return &ttnpb.RateLimitOverride{
+		Subject:   override.Subject,
+		MaxPerMin: uint32(override.MaxPerMin),
+		MaxBurst:  uint32(override.MaxBurst),
+	}
+}
+
+func (s *RateLimitOverrideServer) setOverride(
+	ctx context.Context, subject string, maxPerMin, maxBurst uint32,
+) (*ttnpb.RateLimitOverride, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	override := &ratelimit.Override{
+		Subject:   subject,
+		MaxPerMin: uint(maxPerMin),
+		MaxBurst:  uint(maxBurst),
+	}
+	if err := override.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.component.limiter.overrides.SetOverride(ctx, override); err != nil {
+		return nil, err
+	}
+	return rateLimitOverrideToPB(override), nil
+}
+
+func (s *RateLimitOverrideServer) deleteOverride(ctx context.Context, subject string) (*emptypb.Empty, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.component.limiter.overrides.DeleteOverride(ctx, subject); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// List implements ttnpb.RateLimitOverrideRegistryServer.
+func (s *RateLimitOverrideServer) List(ctx context.Context, _ *emptypb.Empty) (*ttnpb.RateLimitOverrides, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	overrides, err := s.component.limiter.overrides.ListOverrides(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.RateLimitOverrides{
+		Overrides: make([]*ttnpb.RateLimitOverride, 0, len(overrides)),
+	}
+	for _, override := range overrides {
+		res.Overrides = append(res.Overrides, rateLimitOverrideToPB(override))
+	}
+	return res, nil
+}
+
+// SetAPIKeyOverride implements ttnpb.RateLimitOverrideRegistryServer.
+func (s *RateLimitOverrideServer) SetAPIKeyOverride(
+	ctx context.Context, req *ttnpb.SetAPIKeyRateLimitOverrideRequest,
+) (*ttnpb.RateLimitOverride, error) {
+	return s.setOverride(ctx, ratelimit.APIKeySubject(req.ApiKeyId), req.MaxPerMin, req.MaxBurst)
+}
+
+// DeleteAPIKeyOverride implements ttnpb.RateLimitOverrideRegistryServer.
+func (s *RateLimitOverrideServer) DeleteAPIKeyOverride(
+	ctx context.Context, req *ttnpb.DeleteAPIKeyRateLimitOverrideRequest,
+) (*emptypb.Empty, error) {
+	return s.deleteOverride(ctx, ratelimit.APIKeySubject(req.ApiKeyId))
+}
+
+// SetApplicationOverride implements ttnpb.RateLimitOverrideRegistryServer.
+func (s *RateLimitOverrideServer) SetApplicationOverride(
+	ctx context.Context, req *ttnpb.SetApplicationRateLimitOverrideRequest,
+) (*ttnpb.RateLimitOverride, error) {
+	return s.setOverride(ctx, ratelimit.ApplicationSubject(req.ApplicationIds), req.MaxPerMin, req.MaxBurst)
+}
+
+// DeleteApplicationOverride implements ttnpb.RateLimitOverrideRegistryServer.
+func (s *RateLimitOverrideServer) DeleteApplicationOverride(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*emptypb.Empty, error) {
+	return s.deleteOverride(ctx, ratelimit.ApplicationSubject(ids))
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRateLimitOverrideServer(t *testing.T) {
+	a := assertions.New(t)
+
+	c, err := New(test.GetLogger(t), &Config{})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	srv := NewRateLimitOverrideServer(c)
+
+	userCtx := rights.NewContextWithAuthInfo(context.Background(), &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_INFO),
+	})
+	_, err = srv.List(userCtx, ttnpb.Empty)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx := rights.NewContextWithAuthInfo(context.Background(), &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.AllAdminRights.Implied(),
+		IsAdmin:         true,
+	})
+	override, err := srv.SetAPIKeyOverride(ctx, &ttnpb.SetAPIKeyRateLimitOverrideRequest{
+		ApiKeyId:  "TESTKEY",
+		MaxPerMin: 100,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(override, should.Resemble, &ttnpb.RateLimitOverride{
+		Subject:   "api-key:TESTKEY",
+		MaxPerMin: 100,
+	})
+	_, err = srv.SetApplicationOverride(ctx, &ttnpb.SetApplicationRateLimitOverrideRequest{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		MaxPerMin:      10,
+		MaxBurst:       20,
+	})
+	a.So(err, should.BeNil)
+
+	overrides, err := srv.List(ctx, ttnpb.Empty)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(overrides.Overrides, should.Resemble, []*ttnpb.RateLimitOverride{
+		{Subject: "api-key:TESTKEY", MaxPerMin: 100},
+		{Subject: "application:test-app", MaxPerMin: 10, MaxBurst: 20},
+	})
+
+	_, err = srv.DeleteAPIKeyOverride(ctx, &ttnpb.DeleteAPIKeyRateLimitOverrideRequest{ApiKeyId: "TESTKEY"})
+	a.So(err, should.BeNil)
+	_, err = srv.DeleteApplicationOverride(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"})
+	a.So(err, should.BeNil)
+
+	overrides, err = srv.List(ctx, ttnpb.Empty)
+	if a.So(err, should.BeNil) {
+		a.So(overrides.Overrides, should.BeEmpty)
+	}
+}
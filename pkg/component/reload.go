@@ -0,0 +1,135 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+)
+
+var errReload = errors.Define("reload", "reload `{subsystem}`")
+
+// ConfigLoader loads the configuration of the component when the configuration is reloaded.
+type ConfigLoader func(ctx context.Context) (*Config, error)
+
+// WithConfigLoader returns an option that sets the function that loads the configuration
+// when the component reloads its configuration.
+// Without a configuration loader, only the registered reloaders are called on reload.
+func WithConfigLoader(f ConfigLoader) Option {
+	return func(c *Component) {
+		c.configLoader = f
+	}
+}
+
+// Reloader reloads a subsystem after the configuration has been loaded.
+type Reloader func(ctx context.Context) error
+
+type namedReloader struct {
+	name string
+	f    Reloader
+}
+
+// RegisterReloader registers a reloader of the subsystem with the given name.
+// Reloaders are called in the order in which they are registered.
+func (c *Component) RegisterReloader(name string, f Reloader) {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+	c.reloaders = append(c.reloaders, namedReloader{name: name, f: f})
+}
+
+type levelSetter interface {
+	SetLevel(log.Level)
+	SetNamespaceLevels(map[string]log.Level)
+}
+
+// reloadBase applies the reloadable parts of the base configuration: the log levels,
+// the rate limiting profiles and the frequency plans source.
+func (c *Component) reloadBase(ctx context.Context, conf *Config) error {
+	if setter, ok := c.logger.(levelSetter); ok {
+		namespaceLevels, err := conf.Log.NamespaceLevels()
+		if err != nil {
+			return errReload.WithAttributes("subsystem", "log").WithCause(err)
+		}
+		setter.SetLevel(conf.Log.Level)
+		setter.SetNamespaceLevels(namespaceLevels)
+	}
+
+	limiter, err := ratelimit.New(ctx, conf.RateLimiting, conf.Blob, c, c.limiter.options(conf.RateLimiting)...)
+	if err != nil {
+		return errReload.WithAttributes("subsystem", "rate_limiting").WithCause(err)
+	}
+	c.limiter.set(limiter)
+
+	fpsFetcher, err := conf.FrequencyPlansFetcher(ctx, c)
+	if err != nil {
+		return errReload.WithAttributes("subsystem", "frequency_plans").WithCause(err)
+	}
+	c.frequencyPlans.SetFetcher(
+		fpsFetcher,
+		frequencyplans.WithOverrides(conf.FrequencyPlans.Overrides.Fetcher()),
+	)
+	return nil
+}
+
+// Reload reloads the configuration and applies it to the reloadable subsystems.
+// If one of the subsystems fails to reload, the remaining subsystems are still reloaded
+// and the first error is returned.
+func (c *Component) Reload(ctx context.Context) error {
+	c.reloadMu.Lock()
+	defer c.reloadMu.Unlock()
+
+	logger := log.FromContext(ctx)
+	var firstErr error
+	if c.configLoader != nil {
+		conf, err := c.configLoader(ctx)
+		if err != nil {
+			return err
+		}
+		if err := c.reloadBase(ctx, conf); err != nil {
+			logger.WithError(err).Warn("Failed to reload base configuration")
+			firstErr = err
+		}
+	}
+	for _, r := range c.reloaders {
+		if err := r.f(ctx); err != nil {
+			err = errReload.WithAttributes("subsystem", r.name).WithCause(err)
+			logger.WithError(err).Warn("Failed to reload subsystem")
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		logger.WithField("subsystem", r.name).Debug("Reloaded subsystem")
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	logger.Info("Reloaded configuration")
+	return nil
+}
+
+func (c *Component) handleReload(w http.ResponseWriter, r *http.Request) {
+	if err := c.Reload(c.ctx); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestReload(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	reloaded := &Config{
+		ServiceBase: config.ServiceBase{
+			RateLimiting: config.RateLimiting{
+				Profiles: []config.RateLimitingProfile{{
+					Name:         "test",
+					MaxPerMin:    1,
+					Associations: []string{"test"},
+				}},
+			},
+		},
+	}
+	c, err := New(test.GetLogger(t), &Config{}, WithConfigLoader(func(context.Context) (*Config, error) {
+		return reloaded, nil
+	}))
+	a.So(err, should.BeNil)
+
+	resource := ratelimit.NewCustomResource("key", "test")
+	for i := 0; i < 3; i++ {
+		limit, _ := c.RateLimiter().RateLimit(resource)
+		a.So(limit, should.BeFalse)
+	}
+
+	var calls []string
+	c.RegisterReloader("first", func(context.Context) error {
+		calls = append(calls, "first")
+		return errors.New("failed")
+	})
+	c.RegisterReloader("second", func(context.Context) error {
+		calls = append(calls, "second")
+		return nil
+	})
+
+	err = c.Reload(ctx)
+	a.So(err, should.NotBeNil)
+	a.So(calls, should.Resemble, []string{"first", "second"})
+
+	limit, _ := c.RateLimiter().RateLimit(resource)
+	a.So(limit, should.BeFalse)
+	limit, _ = c.RateLimiter().RateLimit(resource)
+	a.So(limit, should.BeTrue)
+}
@@ -22,7 +22,7 @@ import (
 	"google.golang.org/grpc"
 )
 
-func (c *Component) initRights() {
+func (c *Component) initRights() error {
 	fetcher := rights.NewAccessFetcher(func(ctx context.Context) *grpc.ClientConn {
 		conn, err := c.GetPeerConn(ctx, ttnpb.ClusterRole_ACCESS, nil)
 		if err != nil {
@@ -37,8 +37,21 @@ func (c *Component) initRights() {
 		c.Logger().Warn("No rights TTL configured")
 	}
 
+	if tokens := c.config.Rights.Tokens; tokens.JWKSURL != "" {
+		httpClient, err := c.HTTPClient(c.Context())
+		if err != nil {
+			return err
+		}
+		keys := rights.KeySetFromURL(httpClient, tokens.JWKSURL)
+		if tokens.CacheTTL > 0 {
+			keys = rights.CacheKeySet(keys, tokens.CacheTTL)
+		}
+		fetcher = rights.NewTokenFetcher(fetcher, keys, tokens.Issuer)
+	}
+
 	c.rightsFetcher = fetcher
 	c.AddContextFiller(func(ctx context.Context) context.Context {
 		return rights.NewContextWithFetcher(ctx, fetcher)
 	})
+	return nil
 }
@@ -0,0 +1,45 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+// secretsRefreshTask returns a task that periodically reloads the configuration, so that
+// secret references are resolved again.
+func secretsRefreshTask(ctx context.Context, c *Component, interval time.Duration) *task.Config {
+	return &task.Config{
+		Context: ctx,
+		ID:      "secrets_refresh",
+		Func: func(ctx context.Context) error {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(interval):
+			}
+			if err := c.Reload(ctx); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to refresh secrets")
+			}
+			return nil
+		},
+		Restart: task.RestartAlways,
+		Backoff: task.DefaultBackoffConfig,
+	}
+}
@@ -0,0 +1,184 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/healthcheck"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+)
+
+const (
+	defaultStatusPageInterval = 30 * time.Second
+	connectedGatewaysMetric   = metrics.Namespace + "_gs_connected_gateways"
+)
+
+// Status is the status of the component that is shown on the status page.
+type Status struct {
+	*healthcheck.Status
+	ConnectedGateways int `json:"connected_gateways"`
+}
+
+// statusPage serves the last measured status of the component.
+type statusPage struct {
+	mu     sync.RWMutex
+	status *Status
+}
+
+func (p *statusPage) get() *Status {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.status
+}
+
+func (p *statusPage) set(status *Status) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status = status
+}
+
+// connectedGateways returns the number of gateways that are connected to the Gateway Server of this process.
+func connectedGateways() (int, error) {
+	families, err := metrics.Gatherer.Gather()
+	if err != nil {
+		return 0, err
+	}
+	var connected float64
+	for _, family := range families {
+		if family.GetName() != connectedGatewaysMetric {
+			continue
+		}
+		for _, m := range family.GetMetric() {
+			connected += m.GetGauge().GetValue()
+		}
+	}
+	return int(connected), nil
+}
+
+func (c *Component) measureStatus(ctx context.Context) *Status {
+	status := &Status{
+		Status: c.healthHandler.Measure(ctx),
+	}
+	connected, err := connectedGateways()
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to gather connected gateways")
+	}
+	status.ConnectedGateways = connected
+	return status
+}
+
+// statusPageTask returns the task configuration for periodically measuring the status of the component.
+// The status page shows the last measured status, so that requests to the public status page do not run
+// the health checks.
+func statusPageTask(ctx context.Context, c *Component, interval time.Duration) *task.Config {
+	if interval <= 0 {
+		interval = defaultStatusPageInterval
+	}
+	return &task.Config{
+		Context: ctx,
+		ID:      "status_page",
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			for {
+				c.statusPage.set(c.measureStatus(ctx))
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-ticker.C:
+				}
+			}
+		},
+		Restart: task.RestartAlways,
+		Backoff: task.DefaultBackoffConfig,
+	}
+}
+
+var errStatusNotMeasured = errors.DefineUnavailable("status_not_measured", "status not measured yet")
+
+func (c *Component) registerStatusPage(server *web.Server) {
+	g := server.RootRouter().NewRoute().Subrouter()
+	g.Use(ratelimit.HTTPMiddleware(c.RateLimiter(), "http:status"))
+	g.HandleFunc("/status", c.handleStatusPage).Methods(http.MethodGet)
+	g.HandleFunc("/status.json", c.handleStatusJSON).Methods(http.MethodGet)
+}
+
+func (c *Component) handleStatusJSON(w http.ResponseWriter, r *http.Request) {
+	status := c.statusPage.get()
+	if status == nil {
+		webhandlers.Error(w, r, errStatusNotMeasured.New())
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(status)
+}
+
+var statusPageTemplate = template.Must(template.New("status").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Status</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+table { border-collapse: collapse; margin-bottom: 2em; }
+th, td { text-align: left; padding: .4em 1em; border-bottom: 1px solid #ddd; }
+.healthy { color: #1a7f37; }
+.unhealthy { color: #cf222e; }
+</style>
+</head>
+<body>
+<h1 class="{{ if .Healthy }}healthy{{ else }}unhealthy{{ end }}">{{ if .Healthy }}All systems operational{{ else }}Degraded{{ end }}</h1>
+<p>Connected gateways: {{ .ConnectedGateways }}</p>
+<h2>Components</h2>
+<table>
+<tr><th>Check</th><th>Status</th></tr>
+{{ range .Checks }}<tr><td>{{ .Name }}</td>{{ if .Healthy }}<td class="healthy">Healthy</td>{{ else }}<td class="unhealthy">{{ .Failure }}</td>{{ end }}</tr>
+{{ end }}</table>
+<h2>Recent incidents</h2>
+{{ if .Incidents }}<table>
+<tr><th>Check</th><th>Failure</th><th>Started</th><th>Resolved</th></tr>
+{{ range .Incidents }}<tr><td>{{ .Check }}</td><td>{{ .Failure }}</td><td>{{ .StartedAt.UTC.Format "2006-01-02 15:04:05 MST" }}</td><td>{{ if .ResolvedAt }}{{ .ResolvedAt.UTC.Format "2006-01-02 15:04:05 MST" }}{{ else }}Ongoing{{ end }}</td></tr>
+{{ end }}</table>{{ else }}<p>No recent incidents.</p>{{ end }}
+<p><small>Updated {{ .MeasuredAt.UTC.Format "2006-01-02 15:04:05 MST" }}</small></p>
+</body>
+</html>
+`))
+
+func (c *Component) handleStatusPage(w http.ResponseWriter, r *http.Request) {
+	status := c.statusPage.get()
+	if status == nil {
+		webhandlers.Error(w, r, errStatusNotMeasured.New())
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if err := statusPageTemplate.Execute(w, status); err != nil {
+		log.FromContext(r.Context()).WithError(err).Warn("Failed to render status page")
+	}
+}
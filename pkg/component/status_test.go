@@ -0,0 +1,82 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package component
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestStatusPage(t *testing.T) {
+	a := assertions.New(t)
+
+	c, err := New(test.GetLogger(t), &Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Status: config.Status{
+					Enable: true,
+				},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	err = c.HealthChecker().AddCheck("redis", func(context.Context) error {
+		return errors.New("connection refused")
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	get := func(path string) *httptest.ResponseRecorder {
+		rec := httptest.NewRecorder()
+		c.web.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, path, nil))
+		return rec
+	}
+
+	a.So(get("/status.json").Code, should.Equal, http.StatusServiceUnavailable)
+
+	c.statusPage.set(c.measureStatus(test.Context()))
+
+	rec := get("/status.json")
+	a.So(rec.Code, should.Equal, http.StatusOK)
+	var status Status
+	if a.So(json.NewDecoder(rec.Body).Decode(&status), should.BeNil) {
+		a.So(status.Healthy, should.BeFalse)
+		a.So(status.Checks, should.HaveLength, 1)
+		if a.So(status.Incidents, should.HaveLength, 1) {
+			a.So(status.Incidents[0].Check, should.Equal, "redis")
+			a.So(status.Incidents[0].Failure, should.Equal, "connection refused")
+		}
+	}
+
+	rec = get("/status")
+	a.So(rec.Code, should.Equal, http.StatusOK)
+	a.So(rec.Header().Get("Content-Type"), should.StartWith, "text/html")
+	body := rec.Body.String()
+	a.So(strings.Contains(body, "Degraded"), should.BeTrue)
+	a.So(strings.Contains(body, "connection refused"), should.BeTrue)
+}
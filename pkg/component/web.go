@@ -37,6 +37,7 @@ const (
 	metricsUsername = "metrics"
 	pprofUsername   = "pprof"
 	healthUsername  = "health"
+	reloadUsername  = "reload"
 )
 
 func (c *Component) initWeb() error {
@@ -111,6 +112,26 @@ func (c *Component) initWeb() error {
 		g.Handle("/healthz", c.healthHandler.GetHandler())
 	}
 
+	if c.config.HTTP.Reload.Enable {
+		g := web.RootRouter().NewRoute().Subrouter()
+		g.Use(ratelimit.HTTPMiddleware(c.RateLimiter(), "http:reload"))
+		if c.config.HTTP.Reload.Password != "" {
+			g.Use(mux.MiddlewareFunc(webmiddleware.BasicAuth(
+				"reload",
+				webmiddleware.AuthUser(reloadUsername, c.config.HTTP.Reload.Password),
+			)))
+		}
+		g.HandleFunc("/reload", c.handleReload).Methods(http.MethodPost)
+	}
+
+	if c.config.HTTP.Status.Enable {
+		c.registerStatusPage(web)
+	}
+
+	if c.config.HTTP.Debug.Enable {
+		c.registerDebug(web)
+	}
+
 	c.web = web
 	return nil
 }
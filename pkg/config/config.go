@@ -28,6 +28,7 @@ import (
 	"github.com/mitchellh/mapstructure"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/secrets"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -35,6 +36,9 @@ import (
 // TimeFormat is the format to parse times in.
 const TimeFormat = time.RFC3339Nano
 
+// secretResolveTimeout is the timeout for resolving a secret reference.
+const secretResolveTimeout = 30 * time.Second
+
 // Manager is a manager for the configuration.
 type Manager struct {
 	name         string
@@ -45,6 +49,7 @@ type Manager struct {
 	defaults     any
 	defaultPaths []string
 	configFlag   string
+	secrets      secrets.Resolvers
 }
 
 // Flags to be used in the command.
@@ -101,6 +106,14 @@ func WithDeprecatedFlag(name, usageMessage string) Option {
 	}
 }
 
+// WithSecretResolvers sets the resolvers of secret references in configuration values.
+// By default, references to Vault, AWS Secrets Manager and Google Cloud Secret Manager are resolved.
+func WithSecretResolvers(resolvers secrets.Resolvers) Option {
+	return func(m *Manager) {
+		m.secrets = resolvers
+	}
+}
+
 // DefaultOptions are the default options.
 var DefaultOptions = []Option{
 	EnvKeyReplacer(strings.NewReplacer(".", "_", "-", "_")),
@@ -155,6 +168,7 @@ func Initialize(name, envPrefix string, defaults any, opts ...Option) *Manager {
 		flags:     pflag.NewFlagSet(name, pflag.ExitOnError),
 		replacer:  strings.NewReplacer(),
 		defaults:  defaults,
+		secrets:   secrets.DefaultResolvers(),
 	}
 
 	m.viper.SetTypeByDefaultValue(true)
@@ -215,6 +229,7 @@ func (m *Manager) Parse(flags ...string) error {
 
 // Unmarshal unmarshals the available config keys into the result.
 // It matches the names of fields based on the name struct tag.
+// Values that reference secrets in a secret store (see package secrets) are resolved.
 func (m *Manager) Unmarshal(result any) error {
 	d, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
 		TagName:          "name",
@@ -222,6 +237,7 @@ func (m *Manager) Unmarshal(result any) error {
 		WeaklyTypedInput: true,
 		Result:           result,
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			secretReferenceHook(m.secrets),
 			mapstructure.StringToTimeDurationHookFunc(),
 			stringToTimeHookFunc(TimeFormat),
 			stringSliceToStringMapHookFunc,
@@ -15,6 +15,7 @@
 package config_test
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -22,6 +23,7 @@ import (
 
 	"github.com/smarty/assertions"
 	. "go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/secrets"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
@@ -228,6 +230,38 @@ func TestConfigEnv(t *testing.T) { //nolint:paralleltest // Parallel tests confl
 	})
 }
 
+func TestConfigSecretReferences(t *testing.T) { //nolint:paralleltest // Parallel tests conflict with t.Setenv.
+	a := assertions.New(t)
+
+	config := InitializeWithDefaults("test", "test", defaults, WithSecretResolvers(secrets.Resolvers{
+		"test": secrets.ResolverFunc(func(_ context.Context, ref secrets.Reference) ([]byte, error) {
+			switch ref.Path {
+			case "string":
+				return []byte("secret"), nil
+			case "bytes":
+				return []byte("0xCAFE"), nil
+			}
+			return nil, fmt.Errorf("unknown secret %s", ref.Path)
+		}),
+	}))
+	a.So(config, should.NotBeNil)
+
+	t.Setenv("TEST_STRING", "test://string")
+	t.Setenv("TEST_BYTES", "test://bytes")
+	t.Setenv("TEST_NESTED_STRING", "other://string")
+
+	a.So(config.Parse(), should.BeNil)
+
+	settings := new(example)
+	a.So(config.Unmarshal(settings), should.BeNil)
+	a.So(settings.String, should.Equal, "secret")
+	a.So(settings.Bytes, should.Resemble, []byte{0xCA, 0xFE})
+	a.So(settings.Nested.String, should.Equal, "other://string")
+
+	t.Setenv("TEST_STRING", "test://unknown")
+	a.So(config.Unmarshal(settings), should.NotBeNil)
+}
+
 func TestBadConfigEnv(t *testing.T) { //nolint:paralleltest // Parallel tests conflict with t.Setenv.
 	a := assertions.New(t)
 
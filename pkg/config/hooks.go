@@ -15,6 +15,7 @@
 package config
 
 import (
+	"context"
 	"encoding/hex"
 	"fmt"
 	"reflect"
@@ -23,6 +24,7 @@ import (
 	"time"
 
 	"github.com/mitchellh/mapstructure"
+	"go.thethings.network/lorawan-stack/v3/pkg/config/secrets"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -43,6 +45,22 @@ func stringToTimeHookFunc(layout string) mapstructure.DecodeHookFuncType {
 	}
 }
 
+// secretReferenceHook is a hook for mapstructure that resolves strings that reference secrets.
+func secretReferenceHook(resolvers secrets.Resolvers) mapstructure.DecodeHookFuncType {
+	return func(f reflect.Type, t reflect.Type, data any) (any, error) {
+		if f.Kind() != reflect.String || len(resolvers) == 0 {
+			return data, nil
+		}
+		s := reflect.ValueOf(data).String()
+		if !resolvers.IsReference(s) {
+			return data, nil
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), secretResolveTimeout)
+		defer cancel()
+		return resolvers.Resolve(ctx, s)
+	}
+}
+
 // stringSliceToStringMapHookFunc is a hook for mapstructure that decodes []string to map[string]string.
 func stringSliceToStringMapHookFunc(f reflect.Type, t reflect.Type, data any) (any, error) {
 	if f.Kind() != reflect.Slice || f.Elem().Kind() != reflect.String ||
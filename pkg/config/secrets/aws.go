@@ -0,0 +1,72 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+)
+
+// AWSSecretsManager resolves secrets from AWS Secrets Manager.
+// The path of the reference is the name or ARN of the secret.
+type AWSSecretsManager struct {
+	// Config is the AWS configuration. If nil, the default configuration is used.
+	Config *aws.Config
+
+	initOnce sync.Once
+	client   *secretsmanager.SecretsManager
+	initErr  error
+}
+
+func (m *AWSSecretsManager) init() error {
+	m.initOnce.Do(func() {
+		conf := m.Config
+		if conf == nil {
+			conf = aws.NewConfig()
+		}
+		s, err := session.NewSessionWithOptions(session.Options{
+			Config:            *conf,
+			SharedConfigState: session.SharedConfigEnable,
+		})
+		if err != nil {
+			m.initErr = err
+			return
+		}
+		m.client = secretsmanager.New(s)
+	})
+	return m.initErr
+}
+
+// Resolve implements Resolver.
+func (m *AWSSecretsManager) Resolve(ctx context.Context, ref Reference) ([]byte, error) {
+	if err := m.init(); err != nil {
+		return nil, err
+	}
+	out, err := m.client.GetSecretValueWithContext(ctx, &secretsmanager.GetSecretValueInput{
+		SecretId: aws.String(ref.Path),
+	})
+	if err != nil {
+		return nil, err
+	}
+	b := out.SecretBinary
+	if out.SecretString != nil {
+		b = []byte(*out.SecretString)
+	}
+	return selectKey(ref, b)
+}
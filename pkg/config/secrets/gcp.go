@@ -0,0 +1,92 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"golang.org/x/oauth2/google"
+)
+
+const gcpSecretManagerEndpoint = "https://secretmanager.googleapis.com/v1"
+
+var errGCPRequest = errors.Define("gcp_request", "Google Cloud Secret Manager request failed with status {status}")
+
+// GCPSecretManager resolves secrets from Google Cloud Secret Manager.
+// The path of the reference is the resource name of the secret, optionally with the version.
+type GCPSecretManager struct {
+	// Client is the authenticated HTTP client. If nil, a client with the default credentials is used.
+	Client *http.Client
+	// Endpoint is the endpoint of the API. If empty, the public endpoint is used.
+	Endpoint string
+
+	initOnce sync.Once
+	initErr  error
+}
+
+func (m *GCPSecretManager) init(ctx context.Context) error {
+	m.initOnce.Do(func() {
+		if m.Client != nil {
+			return
+		}
+		m.Client, m.initErr = google.DefaultClient(ctx, "https://www.googleapis.com/auth/cloud-platform")
+	})
+	return m.initErr
+}
+
+// Resolve implements Resolver.
+func (m *GCPSecretManager) Resolve(ctx context.Context, ref Reference) ([]byte, error) {
+	if err := m.init(ctx); err != nil {
+		return nil, err
+	}
+	name := strings.Trim(ref.Path, "/")
+	if !strings.HasPrefix(name, "projects/") || !strings.Contains(name, "/secrets/") {
+		return nil, errInvalidRef.WithAttributes("reference", ref.String())
+	}
+	if !strings.Contains(name, "/versions/") {
+		name += "/versions/latest"
+	}
+	endpoint := m.Endpoint
+	if endpoint == "" {
+		endpoint = gcpSecretManagerEndpoint
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/%s:access", endpoint, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := m.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errGCPRequest.WithAttributes("status", res.StatusCode)
+	}
+	var body struct {
+		Payload struct {
+			Data []byte `json:"data"`
+		} `json:"payload"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return selectKey(ref, body.Payload.Data)
+}
@@ -0,0 +1,176 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secrets resolves references to secrets in external secret stores.
+//
+// A reference is a URI of the form `<scheme>://<path>[#<key>]`. The path identifies the secret
+// in the secret store, and the optional key selects a field of a secret that contains a JSON
+// object (or a key-value secret in Vault). The following schemes are supported:
+//
+//   - `vault://<mount>/<path>#<key>`: Vault KV version 2 secret engine. The address and token
+//     are read from the VAULT_ADDR and VAULT_TOKEN (or VAULT_TOKEN_FILE) environment variables.
+//   - `awssm://<secret-id>[#<key>]`: AWS Secrets Manager. The secret ID may be a name or an ARN.
+//     The region and credentials are read from the default AWS configuration.
+//   - `gcpsm://projects/<project>/secrets/<secret>[/versions/<version>][#<key>]`: Google Cloud
+//     Secret Manager. The latest version is used by default. The credentials are read from the
+//     default Google Cloud configuration.
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var (
+	errUnknownScheme = errors.DefineInvalidArgument("unknown_scheme", "unknown secret reference scheme `{scheme}`")
+	errInvalidRef    = errors.DefineInvalidArgument("invalid_reference", "invalid secret reference `{reference}`")
+	errResolve       = errors.DefineUnavailable("resolve", "resolve secret `{reference}`")
+	errKeyNotFound   = errors.DefineNotFound("key_not_found", "key `{key}` not found in secret `{reference}`")
+	errNoKey         = errors.DefineInvalidArgument("no_key", "secret `{reference}` has multiple keys; select one with `#<key>`")
+)
+
+// Reference is a reference to a secret.
+type Reference struct {
+	Scheme string
+	Path   string
+	Key    string
+}
+
+// String implements fmt.Stringer.
+func (r Reference) String() string {
+	s := r.Scheme + "://" + r.Path
+	if r.Key != "" {
+		s += "#" + r.Key
+	}
+	return s
+}
+
+// ParseReference parses the secret reference. The return value ok is false if s is not a
+// reference with one of the given schemes.
+func ParseReference(s string, schemes ...string) (ref Reference, ok bool, err error) {
+	scheme, rest, found := strings.Cut(s, "://")
+	if !found {
+		return Reference{}, false, nil
+	}
+	known := false
+	for _, sc := range schemes {
+		if sc == scheme {
+			known = true
+			break
+		}
+	}
+	if !known {
+		return Reference{}, false, nil
+	}
+	path, key, _ := strings.Cut(rest, "#")
+	if path == "" {
+		return Reference{}, true, errInvalidRef.WithAttributes("reference", s)
+	}
+	return Reference{Scheme: scheme, Path: path, Key: key}, true, nil
+}
+
+// Resolver resolves references to secrets in a secret store.
+type Resolver interface {
+	Resolve(ctx context.Context, ref Reference) ([]byte, error)
+}
+
+// ResolverFunc is a function that implements Resolver.
+type ResolverFunc func(ctx context.Context, ref Reference) ([]byte, error)
+
+// Resolve implements Resolver.
+func (f ResolverFunc) Resolve(ctx context.Context, ref Reference) ([]byte, error) {
+	return f(ctx, ref)
+}
+
+// Resolvers are resolvers by scheme.
+type Resolvers map[string]Resolver
+
+// DefaultResolvers returns the resolvers of the supported secret stores.
+func DefaultResolvers() Resolvers {
+	return Resolvers{
+		"vault": VaultFromEnvironment(),
+		"awssm": &AWSSecretsManager{},
+		"gcpsm": &GCPSecretManager{},
+	}
+}
+
+func (r Resolvers) schemes() []string {
+	schemes := make([]string, 0, len(r))
+	for scheme := range r {
+		schemes = append(schemes, scheme)
+	}
+	return schemes
+}
+
+// IsReference returns whether s is a reference to a secret with one of the schemes of the resolvers.
+func (r Resolvers) IsReference(s string) bool {
+	_, ok, _ := ParseReference(s, r.schemes()...)
+	return ok
+}
+
+// Resolve resolves s if it is a reference to a secret. Otherwise, s is returned as-is.
+func (r Resolvers) Resolve(ctx context.Context, s string) (string, error) {
+	ref, ok, err := ParseReference(s, r.schemes()...)
+	if err != nil || !ok {
+		return s, err
+	}
+	resolver, ok := r[ref.Scheme]
+	if !ok {
+		return "", errUnknownScheme.WithAttributes("scheme", ref.Scheme)
+	}
+	b, err := resolver.Resolve(ctx, ref)
+	if err != nil {
+		if errors.IsNotFound(err) || errors.IsInvalidArgument(err) {
+			return "", err
+		}
+		return "", errResolve.WithAttributes("reference", ref.String()).WithCause(err)
+	}
+	return string(b), nil
+}
+
+// selectKey returns the value of the key in the JSON object in b, or b if no key is selected.
+func selectKey(ref Reference, b []byte) ([]byte, error) {
+	if ref.Key == "" {
+		return b, nil
+	}
+	var m map[string]any
+	if err := json.Unmarshal(b, &m); err != nil {
+		return nil, errKeyNotFound.WithAttributes("key", ref.Key, "reference", ref.String()).WithCause(err)
+	}
+	return valueOf(ref, m)
+}
+
+// valueOf returns the value of the selected key in m. If no key is selected, m must have exactly one key.
+func valueOf(ref Reference, m map[string]any) ([]byte, error) {
+	key := ref.Key
+	if key == "" {
+		if len(m) != 1 {
+			return nil, errNoKey.WithAttributes("reference", ref.String())
+		}
+		for k := range m {
+			key = k
+		}
+	}
+	v, ok := m[key]
+	if !ok {
+		return nil, errKeyNotFound.WithAttributes("key", key, "reference", ref.String())
+	}
+	if s, ok := v.(string); ok {
+		return []byte(s), nil
+	}
+	return json.Marshal(v)
+}
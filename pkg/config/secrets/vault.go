@@ -0,0 +1,88 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var errVaultRequest = errors.Define("vault_request", "Vault request failed with status {status}")
+
+// Vault resolves secrets from a Vault KV version 2 secret engine.
+// The path of the reference is the mount of the secret engine followed by the path of the secret.
+type Vault struct {
+	Address string
+	Token   string
+	Client  *http.Client
+}
+
+// VaultFromEnvironment returns a Vault resolver that uses the VAULT_ADDR, VAULT_TOKEN and
+// VAULT_TOKEN_FILE environment variables.
+func VaultFromEnvironment() *Vault {
+	v := &Vault{
+		Address: os.Getenv("VAULT_ADDR"),
+		Token:   os.Getenv("VAULT_TOKEN"),
+	}
+	if v.Token == "" {
+		if filename := os.Getenv("VAULT_TOKEN_FILE"); filename != "" {
+			if b, err := os.ReadFile(filename); err == nil {
+				v.Token = strings.TrimSpace(string(b))
+			}
+		}
+	}
+	return v
+}
+
+// Resolve implements Resolver.
+func (v *Vault) Resolve(ctx context.Context, ref Reference) ([]byte, error) {
+	mount, path, ok := strings.Cut(strings.Trim(ref.Path, "/"), "/")
+	if !ok || v.Address == "" {
+		return nil, errInvalidRef.WithAttributes("reference", ref.String())
+	}
+	url := fmt.Sprintf("%s/v1/%s/data/%s", strings.TrimSuffix(v.Address, "/"), mount, path)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Vault-Token", v.Token)
+	client := v.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	res, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errVaultRequest.WithAttributes("status", res.StatusCode)
+	}
+	var body struct {
+		Data struct {
+			Data map[string]any `json:"data"`
+		} `json:"data"`
+	}
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return nil, err
+	}
+	return valueOf(ref, body.Data.Data)
+}
@@ -46,8 +46,47 @@ type Base struct {
 
 // Log represents configuration for the logger.
 type Log struct {
-	Format string    `name:"format" description:"Log format to write (console, json)"`
-	Level  log.Level `name:"level" description:"The minimum level log messages must have to be shown"`
+	Format         string            `name:"format" description:"Log format to write (console, json)"`
+	Level          log.Level         `name:"level" description:"The minimum level log messages must have to be shown"`
+	LevelOverrides map[string]string `name:"level-overrides" description:"The minimum level log messages must have to be shown per namespace (namespace=level)"` //nolint:lll
+	Sampling       LogSampling       `name:"sampling"`
+	Syslog         LogSyslog         `name:"syslog"`
+	Loki           LogLoki           `name:"loki"`
+}
+
+// NamespaceLevels returns the parsed levels per namespace.
+func (c Log) NamespaceLevels() (map[string]log.Level, error) {
+	return log.ParseNamespaceLevels(c.LevelOverrides)
+}
+
+// LogSampling represents configuration for the sampling of log messages.
+type LogSampling struct {
+	Enable     bool          `name:"enable" description:"Enable sampling of log messages with the same level and message"`
+	Interval   time.Duration `name:"interval" description:"Interval in which log messages are sampled"`
+	Initial    uint64        `name:"initial" description:"Number of log messages with the same level and message to log in each interval"`                           //nolint:lll
+	Thereafter uint64        `name:"thereafter" description:"Log every Nth log message with the same level and message after the initial messages in each interval"` //nolint:lll
+}
+
+// LogSyslog represents configuration for sending log messages to a syslog server.
+type LogSyslog struct {
+	Enable    bool   `name:"enable" description:"Send log messages to a syslog server (RFC 5424)"`
+	Network   string `name:"network" description:"Network of the syslog server (udp, tcp)"`
+	Address   string `name:"address" description:"Address of the syslog server"`
+	AppName   string `name:"app-name" description:"Application name in the syslog messages"`
+	Facility  int    `name:"facility" description:"Facility of the syslog messages"`
+	QueueSize int    `name:"queue-size" description:"Number of log messages to buffer before dropping them"`
+}
+
+// LogLoki represents configuration for pushing log messages to Grafana Loki.
+type LogLoki struct {
+	Enable        bool              `name:"enable" description:"Push log messages to Grafana Loki"`
+	URL           string            `name:"url" description:"URL of the Loki push API"`
+	Headers       map[string]string `name:"headers" description:"HTTP headers of the push requests"`
+	Labels        map[string]string `name:"labels" description:"Labels of the log streams"`
+	BatchSize     int               `name:"batch-size" description:"Maximum number of log messages per push request"`
+	BatchInterval time.Duration     `name:"batch-interval" description:"Maximum time to buffer log messages before pushing them"`
+	Timeout       time.Duration     `name:"timeout" description:"Timeout of the push requests"`
+	QueueSize     int               `name:"queue-size" description:"Number of log messages to buffer before dropping them"`
 }
 
 // Sentry represents configuration for error tracking using Sentry.
@@ -66,6 +105,9 @@ type GRPC struct {
 	TrustedProxies []string `name:"trusted-proxies" description:"CIDRs of trusted reverse proxies"`
 
 	LogIgnoreMethods []string `name:"log-ignore-methods" description:"List of paths for which successful requests will not be logged"` //nolint:lll
+
+	Reflection       bool `name:"reflection" description:"Enable gRPC server reflection"`
+	RichErrorDetails bool `name:"rich-error-details" description:"Add google.rpc.ErrorInfo and google.rpc.BadRequest details to errors"` //nolint:lll
 }
 
 // Cookie represents cookie configuration.
@@ -95,6 +137,23 @@ type Health struct {
 	Password string `name:"password" description:"Password to protect health endpoint (username is health)"`
 }
 
+// Reload represents the configuration reload endpoint configuration.
+type Reload struct {
+	Enable   bool   `name:"enable" description:"Enable configuration reload endpoint on HTTP server"`
+	Password string `name:"password" description:"Password to protect configuration reload endpoint (username is reload)"`
+}
+
+// Status represents the status page configuration.
+type Status struct {
+	Enable   bool          `name:"enable" description:"Enable the public status page on HTTP server"`
+	Interval time.Duration `name:"interval" description:"Interval at which the health checks of the status page are measured"` //nolint:lll
+}
+
+// Debug represents the debug API configuration.
+type Debug struct {
+	Enable bool `name:"enable" description:"Enable the debug API for admins (profiles, runtime statistics and tuning)"`
+}
+
 // HTTPStaticConfig represents the HTTP static file server configuration.
 type HTTPStaticConfig struct {
 	Mount      string   `name:"mount" description:"Path on the server where static assets will be served"`
@@ -114,6 +173,11 @@ type HTTP struct {
 	PProf           PProf            `name:"pprof"`
 	Metrics         Metrics          `name:"metrics"`
 	Health          Health           `name:"health"`
+	Reload          Reload           `name:"reload"`
+	Status          Status           `name:"status"`
+	Debug           Debug            `name:"debug"`
+
+	StreamHeartbeatInterval time.Duration `name:"stream-heartbeat-interval" description:"Interval of heartbeats in idle NDJSON and event streams (0 is disabled)"` //nolint:lll
 }
 
 // CloudEvents represents configuration for the cloud events backend.
@@ -157,7 +221,16 @@ type Events struct {
 type Rights struct {
 	// TTL is the duration that entries will remain in the cache before being
 	// garbage collected.
-	TTL time.Duration `name:"ttl" description:"Validity of Identity Server responses"`
+	TTL    time.Duration `name:"ttl" description:"Validity of Identity Server responses"`
+	Tokens RightsTokens  `name:"tokens"`
+}
+
+// RightsTokens represents the configuration to verify the JSON Web Tokens that the Identity Server issues
+// for API keys. Verification is enabled when the JSON Web Key Set URL is configured.
+type RightsTokens struct {
+	JWKSURL  string        `name:"jwks-url" description:"URL of the JSON Web Key Set of the Identity Server that verifies tokens locally"` //nolint:lll
+	Issuer   string        `name:"issuer" description:"Issuer of the tokens"`
+	CacheTTL time.Duration `name:"cache-ttl" description:"Validity of the JSON Web Key Set"`
 }
 
 // KeyVaultCache represents the configuration for key vault caching.
@@ -332,6 +405,27 @@ type FrequencyPlansConfig struct {
 	Directory    string            `name:"directory" description:"OS filesystem directory, which contains frequency plans"` //nolint:lll
 	URL          string            `name:"url" description:"URL, which contains frequency plans"`
 	Blob         BlobPathConfig    `name:"blob"`
+
+	Overrides FrequencyPlansOverridesConfig `name:"overrides" description:"Operator defined frequency plans that are layered on top of the frequency plans source"` //nolint:lll
+}
+
+// FrequencyPlansOverridesConfig represents the configuration of operator defined frequency plans.
+type FrequencyPlansOverridesConfig struct {
+	Static    map[string][]byte `name:"-"`
+	Directory string            `name:"directory" description:"OS filesystem directory, which contains frequency-plans.yml and the frequency plans files"` //nolint:lll
+}
+
+// Fetcher returns a fetch.Interface based on the configuration.
+// If no overrides are configured, this method returns nil.
+func (c FrequencyPlansOverridesConfig) Fetcher() fetch.Interface {
+	switch {
+	case c.Static != nil:
+		return fetch.NewMemFetcher(c.Static)
+	case c.Directory != "":
+		return fetch.FromFilesystem(c.Directory)
+	default:
+		return nil
+	}
 }
 
 // Fetcher returns a fetch.Interface based on the configuration.
@@ -379,6 +473,19 @@ func (c FrequencyPlansConfig) Fetcher(
 	}
 }
 
+// InteropClientCircuitBreaker represents the circuit breaker configuration of interop client targets.
+type InteropClientCircuitBreaker struct {
+	FailureThreshold int           `name:"failure-threshold" description:"Number of consecutive failures after which requests to a target are rejected (0 is disabled)"` //nolint:lll
+	OpenDuration     time.Duration `name:"open-duration" description:"Time to reject requests to a target before trying again"`                                          //nolint:lll
+}
+
+// InteropClientRetry represents the retry configuration of idempotent interop client messages.
+type InteropClientRetry struct {
+	Attempts  int           `name:"attempts" description:"Maximum number of attempts of idempotent messages (0 or 1 is disabled)"` //nolint:lll
+	Backoff   time.Duration `name:"backoff" description:"Initial time between attempts, doubling every attempt"`
+	ResultTTL time.Duration `name:"result-ttl" description:"Time to keep the result of a retried message for subsequent requests"` //nolint:lll
+}
+
 // InteropClient represents the client-side interoperability through LoRaWAN Backend Interfaces configuration.
 type InteropClient struct {
 	ConfigSource string         `name:"config-source" description:"Source of the interoperability client configuration (directory, url, blob)"` //nolint:lll
@@ -386,6 +493,10 @@ type InteropClient struct {
 	URL          string         `name:"url" description:"URL, which contains interoperability client configuration"`
 	Blob         BlobPathConfig `name:"blob"`
 
+	Timeout        time.Duration               `name:"timeout" description:"Timeout of requests to targets that do not configure a timeout"` //nolint:lll
+	CircuitBreaker InteropClientCircuitBreaker `name:"circuit-breaker"`
+	Retry          InteropClientRetry          `name:"retry"`
+
 	BlobConfig BlobConfig `name:"-"`
 }
 
@@ -477,6 +588,14 @@ type PacketBrokerInteropAuth struct {
 	TokenIssuer string `name:"token-issuer" description:"Required issuer of Packet Broker tokens"`
 }
 
+// InteropSenderClientCertificates represents the verification of TLS client certificates of senders.
+type InteropSenderClientCertificates struct {
+	RefreshInterval time.Duration       `name:"refresh-interval" description:"Interval to fetch the client CAs of sender IDs again (0 is disabled)"` //nolint:lll
+	Fingerprints    map[string][]string `name:"fingerprints" description:"SHA-256 fingerprints of client certificates to allow per sender ID"`       //nolint:lll
+	CRL             bool                `name:"crl" description:"Check revocation of client certificates with their CRL distribution points"`        //nolint:lll
+	OCSP            bool                `name:"ocsp" description:"Require a valid stapled OCSP response of client certificates (requires TLS 1.3)"`  //nolint:lll
+}
+
 // InteropServer represents the server-side interoperability through LoRaWAN Backend Interfaces configuration.
 type InteropServer struct {
 	Listen           string `name:"listen" description:"Address for the interop server for LoRaWAN Backend Interfaces to listen on"`         //nolint:lll
@@ -486,9 +605,16 @@ type InteropServer struct {
 	SenderClientCA           SenderClientCA    `name:"sender-client-ca" description:"Client CAs for sender IDs to trust (DEPRECATED)"`                               //nolint:lll
 	SenderClientCADeprecated map[string]string `name:"sender-client-cas" description:"Path to PEM encoded file with client CAs of sender IDs to trust (DEPRECATED)"` //nolint:lll
 
+	SenderClientCertificates InteropSenderClientCertificates `name:"sender-client-certificates" description:"Verification of TLS client certificates of senders"` //nolint:lll
+
 	PacketBroker PacketBrokerInteropAuth `name:"packet-broker"`
 }
 
+// Secrets represents the configuration of secret references in configuration values.
+type Secrets struct {
+	RefreshInterval time.Duration `name:"refresh-interval" description:"Interval to resolve secret references again and reload the configuration (0 is disabled)"` //nolint:lll
+}
+
 // ServiceBase represents base service configuration.
 type ServiceBase struct {
 	Base             `name:",squash"`
@@ -509,6 +635,7 @@ type ServiceBase struct {
 	Tracing          tracing.Config       `name:"tracing" yaml:"tracing" description:"Tracing configuration"`
 	SkipVersionCheck bool                 `name:"skip-version-check" yaml:"skip-version-check" description:"Skip version checks"` //nolint:lll
 	Telemetry        telemetry.Config     `name:"telemetry" yaml:"telemetry" description:"Telemetry configuration"`
+	Secrets          Secrets              `name:"secrets" yaml:"secrets" description:"Secret references configuration"`
 }
 
 // FrequencyPlansFetcher returns a fetch.Interface based on the frequency plans configuration.
@@ -560,8 +687,13 @@ type RateLimiting struct {
 	URL          string         `name:"url" description:"URL, which contains rate limiting configuration"`
 	Blob         BlobPathConfig `name:"blob"`
 
+	Provider string                `name:"provider" description:"Rate limiting store provider (memory, redis)"`
 	Memory   RateLimitingMemory    `name:"memory" description:"In-memory rate limiting store configuration"`
+	Redis    redis.Config          `name:"redis" description:"Redis rate limiting store configuration"`
 	Profiles []RateLimitingProfile `name:"profiles" description:"Rate limiting profiles"`
+
+	ExportCallers     bool          `name:"export-callers" description:"Export rate limited requests per caller as metrics"`
+	OverridesCacheTTL time.Duration `name:"overrides-cache-ttl" description:"Time that rate limit overrides are cached"`
 }
 
 // Fetcher returns fetch.Interface defined by conf.
@@ -47,6 +47,19 @@ func WithTLSClientAuth(
 	})
 }
 
+// WithTLSClientCAsFunc sets the client CAs of each TLS handshake to the certificate pool returned by cas.
+// This allows changing the client CAs without restarting the listener.
+func WithTLSClientCAsFunc(cas func() *x509.CertPool) Option {
+	return ConfigOptionFunc(func(c *tls.Config) {
+		c.GetConfigForClient = func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			conf := c.Clone()
+			conf.GetConfigForClient = nil
+			conf.ClientCAs = cas()
+			return conf, nil
+		}
+	})
+}
+
 // WithTLSCertificates sets TLS certificates.
 func WithTLSCertificates(certificates ...tls.Certificate) Option {
 	return ConfigOptionFunc(func(c *tls.Config) {
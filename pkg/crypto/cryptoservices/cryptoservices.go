@@ -18,6 +18,7 @@ package cryptoservices
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -77,3 +78,32 @@ type NetworkApplication interface {
 	Network
 	Application
 }
+
+// NetworkSession performs cryptographic operations with network session keys.
+// The keys are passed as key envelopes, so that implementations may perform the operations without exposing the keys,
+// for example when the keys are held by a hardware security module.
+type NetworkSession interface {
+	// EncryptData encrypts or decrypts the FOpts or FRMPayload of a data message.
+	// See crypto.EncryptUplink and crypto.EncryptDownlink.
+	EncryptData(
+		ctx context.Context,
+		key *ttnpb.KeyEnvelope,
+		downlink bool,
+		addr types.DevAddr,
+		fCnt uint32,
+		payload []byte,
+		opts ...crypto.EncryptionOption,
+	) ([]byte, error)
+	// ComputeDataMIC computes the MIC of a data message. See crypto.ComputeDataMIC.
+	ComputeDataMIC(
+		ctx context.Context,
+		key *ttnpb.KeyEnvelope,
+		downlink bool,
+		confFCnt uint32,
+		txDRIdx uint8,
+		txChIdx uint8,
+		addr types.DevAddr,
+		fCnt uint32,
+		payload []byte,
+	) ([4]byte, error)
+}
@@ -0,0 +1,264 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/grpc"
+)
+
+// NetworkSessionServiceName is the name of the gRPC service for network session cryptographic operations.
+// The service has the following methods:
+//
+//	rpc EncryptData(EncryptDataRequest) returns (CryptoServicePayloadResponse);
+//	rpc ComputeDataMIC(ComputeDataMICRequest) returns (CryptoServicePayloadResponse);
+//
+// The request messages are defined by EncryptDataRequest and ComputeDataMICRequest.
+const NetworkSessionServiceName = "ttn.lorawan.v3.NetworkSessionCryptoService"
+
+// EncryptDataRequest is the request message of the EncryptData method of the network session service.
+type EncryptDataRequest struct {
+	// Key is the network session key.
+	Key *ttnpb.KeyEnvelope `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Downlink indicates whether the data message is a downlink message.
+	Downlink bool `protobuf:"varint,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	// DevAddr is the device address of the end device.
+	DevAddr []byte `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// FCnt is the full frame counter.
+	FCnt uint32 `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// FrameTypeConstant is the frame type constant, see crypto.WithFrameTypeConstant.
+	FrameTypeConstant []byte `protobuf:"bytes,5,opt,name=frame_type_constant,json=frameTypeConstant,proto3" json:"frame_type_constant,omitempty"`
+	// Payload is the FOpts or FRMPayload to encrypt or decrypt.
+	Payload []byte `protobuf:"bytes,6,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (m *EncryptDataRequest) Reset() { *m = EncryptDataRequest{} }
+
+// String implements proto.Message.
+func (m *EncryptDataRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage implements proto.Message.
+func (*EncryptDataRequest) ProtoMessage() {}
+
+// ComputeDataMICRequest is the request message of the ComputeDataMIC method of the network session service.
+type ComputeDataMICRequest struct {
+	// Key is the network session key.
+	Key *ttnpb.KeyEnvelope `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	// Downlink indicates whether the data message is a downlink message.
+	Downlink bool `protobuf:"varint,2,opt,name=downlink,proto3" json:"downlink,omitempty"`
+	// DevAddr is the device address of the end device.
+	DevAddr []byte `protobuf:"bytes,3,opt,name=dev_addr,json=devAddr,proto3" json:"dev_addr,omitempty"`
+	// FCnt is the full frame counter.
+	FCnt uint32 `protobuf:"varint,4,opt,name=f_cnt,json=fCnt,proto3" json:"f_cnt,omitempty"`
+	// ConfFCnt is the frame counter of the confirmed message that is acknowledged.
+	ConfFCnt uint32 `protobuf:"varint,5,opt,name=conf_f_cnt,json=confFCnt,proto3" json:"conf_f_cnt,omitempty"`
+	// TxDrIndex is the data rate index of the uplink message.
+	TxDrIndex uint32 `protobuf:"varint,6,opt,name=tx_dr_index,json=txDrIndex,proto3" json:"tx_dr_index,omitempty"`
+	// TxChIndex is the channel index of the uplink message.
+	TxChIndex uint32 `protobuf:"varint,7,opt,name=tx_ch_index,json=txChIndex,proto3" json:"tx_ch_index,omitempty"`
+	// Payload is the message without MIC.
+	Payload []byte `protobuf:"bytes,8,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+// Reset implements proto.Message.
+func (m *ComputeDataMICRequest) Reset() { *m = ComputeDataMICRequest{} }
+
+// String implements proto.Message.
+func (m *ComputeDataMICRequest) String() string { return fmt.Sprintf("%+v", *m) }
+
+// ProtoMessage implements proto.Message.
+func (*ComputeDataMICRequest) ProtoMessage() {}
+
+type networkSessionRPCClient struct {
+	cc       *grpc.ClientConn
+	callOpts []grpc.CallOption
+}
+
+// NewNetworkSessionRPCClient returns a network session service which uses a gRPC service on the given connection.
+// The keys are not unwrapped by the client, so the service must be able to unwrap the keys.
+func NewNetworkSessionRPCClient(cc *grpc.ClientConn, callOpts ...grpc.CallOption) NetworkSession {
+	return &networkSessionRPCClient{
+		cc:       cc,
+		callOpts: callOpts,
+	}
+}
+
+func (s *networkSessionRPCClient) EncryptData(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+	opts ...crypto.EncryptionOption,
+) ([]byte, error) {
+	frameTypeConstant := crypto.FrameTypeConstant(opts...)
+	res := &ttnpb.CryptoServicePayloadResponse{}
+	if err := s.cc.Invoke(ctx, "/"+NetworkSessionServiceName+"/EncryptData", &EncryptDataRequest{
+		Key:               key,
+		Downlink:          downlink,
+		DevAddr:           addr.Bytes(),
+		FCnt:              fCnt,
+		FrameTypeConstant: frameTypeConstant[:],
+		Payload:           payload,
+	}, res, s.callOpts...); err != nil {
+		return nil, err
+	}
+	return res.Payload, nil
+}
+
+func (s *networkSessionRPCClient) ComputeDataMIC(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	confFCnt uint32,
+	txDRIdx uint8,
+	txChIdx uint8,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+) (mic [4]byte, err error) {
+	res := &ttnpb.CryptoServicePayloadResponse{}
+	if err := s.cc.Invoke(ctx, "/"+NetworkSessionServiceName+"/ComputeDataMIC", &ComputeDataMICRequest{
+		Key:       key,
+		Downlink:  downlink,
+		DevAddr:   addr.Bytes(),
+		FCnt:      fCnt,
+		ConfFCnt:  confFCnt,
+		TxDrIndex: uint32(txDRIdx),
+		TxChIndex: uint32(txChIdx),
+		Payload:   payload,
+	}, res, s.callOpts...); err != nil {
+		return mic, err
+	}
+	if len(res.Payload) != len(mic) {
+		return mic, errInvalidMICLength.WithAttributes("length", len(res.Payload))
+	}
+	copy(mic[:], res.Payload)
+	return mic, nil
+}
+
+var (
+	errInvalidMICLength    = errors.DefineDataLoss("invalid_mic_length", "invalid MIC length `{length}`")
+	errInvalidRequestField = errors.DefineInvalidArgument("invalid_request_field", "invalid request field `{field}`")
+)
+
+func encryptDataHandler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := &EncryptDataRequest{}
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		in := req.(*EncryptDataRequest)
+		if in.Key == nil {
+			return nil, errInvalidRequestField.WithAttributes("field", "key")
+		}
+		var addr types.DevAddr
+		if err := addr.UnmarshalBinary(in.DevAddr); err != nil {
+			return nil, errInvalidRequestField.WithAttributes("field", "dev_addr").WithCause(err)
+		}
+		var opts []crypto.EncryptionOption
+		switch len(in.FrameTypeConstant) {
+		case 0:
+		case 4:
+			var frameTypeConstant [4]byte
+			copy(frameTypeConstant[:], in.FrameTypeConstant)
+			opts = append(opts, crypto.WithFrameTypeConstant(frameTypeConstant))
+		default:
+			return nil, errInvalidRequestField.WithAttributes("field", "frame_type_constant")
+		}
+		payload, err := srv.(NetworkSession).EncryptData(ctx, in.Key, in.Downlink, addr, in.FCnt, in.Payload, opts...)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.CryptoServicePayloadResponse{Payload: payload}, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + NetworkSessionServiceName + "/EncryptData",
+	}, handler)
+}
+
+func computeDataMICHandler(
+	srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor,
+) (any, error) {
+	in := &ComputeDataMICRequest{}
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	handler := func(ctx context.Context, req any) (any, error) {
+		in := req.(*ComputeDataMICRequest)
+		if in.Key == nil {
+			return nil, errInvalidRequestField.WithAttributes("field", "key")
+		}
+		var addr types.DevAddr
+		if err := addr.UnmarshalBinary(in.DevAddr); err != nil {
+			return nil, errInvalidRequestField.WithAttributes("field", "dev_addr").WithCause(err)
+		}
+		if in.TxDrIndex > 0xff {
+			return nil, errInvalidRequestField.WithAttributes("field", "tx_dr_index")
+		}
+		if in.TxChIndex > 0xff {
+			return nil, errInvalidRequestField.WithAttributes("field", "tx_ch_index")
+		}
+		mic, err := srv.(NetworkSession).ComputeDataMIC(
+			ctx, in.Key, in.Downlink, in.ConfFCnt, uint8(in.TxDrIndex), uint8(in.TxChIndex), addr, in.FCnt, in.Payload,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.CryptoServicePayloadResponse{Payload: mic[:]}, nil
+	}
+	if interceptor == nil {
+		return handler(ctx, in)
+	}
+	return interceptor(ctx, in, &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/" + NetworkSessionServiceName + "/ComputeDataMIC",
+	}, handler)
+}
+
+var networkSessionServiceDesc = grpc.ServiceDesc{
+	ServiceName: NetworkSessionServiceName,
+	HandlerType: (*NetworkSession)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "EncryptData",
+			Handler:    encryptDataHandler,
+		},
+		{
+			MethodName: "ComputeDataMIC",
+			Handler:    computeDataMICHandler,
+		},
+	},
+}
+
+// RegisterNetworkSessionServer registers the network session service on the gRPC server.
+// This can be used by crypto servers that perform the network session cryptographic operations.
+func RegisterNetworkSessionServer(s *grpc.Server, srv NetworkSession) {
+	s.RegisterService(&networkSessionServiceDesc, srv)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+type keyServiceNetworkSession struct {
+	crypto.KeyService
+}
+
+// NewNetworkSessionKeyService returns a network session service which unwraps the keys with the given key service.
+func NewNetworkSessionKeyService(keyService crypto.KeyService) NetworkSession {
+	return &keyServiceNetworkSession{
+		KeyService: keyService,
+	}
+}
+
+func (s *keyServiceNetworkSession) EncryptData(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+	opts ...crypto.EncryptionOption,
+) ([]byte, error) {
+	k, err := cryptoutil.UnwrapAES128Key(ctx, key, s.KeyService)
+	if err != nil {
+		return nil, err
+	}
+	if downlink {
+		return crypto.EncryptDownlink(k, addr, fCnt, payload, opts...)
+	}
+	return crypto.EncryptUplink(k, addr, fCnt, payload, opts...)
+}
+
+func (s *keyServiceNetworkSession) ComputeDataMIC(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	confFCnt uint32,
+	txDRIdx uint8,
+	txChIdx uint8,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+) ([4]byte, error) {
+	k, err := cryptoutil.UnwrapAES128Key(ctx, key, s.KeyService)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return crypto.ComputeDataMIC(k, downlink, confFCnt, txDRIdx, txChIdx, addr, fCnt, payload)
+}
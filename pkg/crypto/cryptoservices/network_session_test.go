@@ -0,0 +1,117 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cryptoservices_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestNetworkSession(t *testing.T) {
+	ctx := test.Context()
+	key := types.AES128Key{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8}
+	kek := types.AES128Key{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	// Only the crypto server has the KEK.
+	keyService := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"hsm": kek[:],
+	}))
+	keyEnvelope, err := cryptoutil.WrapAES128Key(ctx, key, "hsm", keyService)
+	if err != nil {
+		t.Fatalf("Failed to wrap key: %s", err)
+	}
+	localSvc := NewNetworkSessionKeyService(keyService)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		panic(err)
+	}
+	defer lis.Close()
+	s := grpc.NewServer()
+	RegisterNetworkSessionServer(s, localSvc)
+	go s.Serve(lis)
+	defer s.Stop()
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	if err != nil {
+		panic(err)
+	}
+	defer conn.Close()
+
+	addr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	payload := []byte{0x40, 0x42, 0x42, 0x01, 0x26, 0x00, 0x2a, 0x00, 0x01, 0x02, 0x03}
+	frameTypeConstant := [4]byte{0x01, 0x00, 0x00, 0x00}
+
+	for _, svc := range []NetworkSession{
+		localSvc,
+		NewNetworkSessionRPCClient(conn),
+	} {
+		t.Run(fmt.Sprintf("%T", svc), func(t *testing.T) {
+			t.Run("EncryptData", func(t *testing.T) {
+				a := assertions.New(t)
+
+				res, err := svc.EncryptData(ctx, keyEnvelope, false, addr, 42, payload[8:])
+				a.So(err, should.BeNil)
+				a.So(res, should.Resemble, test.Must(crypto.EncryptUplink(key, addr, 42, payload[8:])))
+
+				res, err = svc.EncryptData(
+					ctx, keyEnvelope, true, addr, 42, payload[8:], crypto.WithFrameTypeConstant(frameTypeConstant),
+				)
+				a.So(err, should.BeNil)
+				a.So(res, should.Resemble, test.Must(crypto.EncryptDownlink(
+					key, addr, 42, payload[8:], crypto.WithFrameTypeConstant(frameTypeConstant),
+				)))
+
+				_, err = svc.EncryptData(ctx, &ttnpb.KeyEnvelope{
+					KekLabel:     "unknown",
+					EncryptedKey: keyEnvelope.EncryptedKey,
+				}, false, addr, 42, payload[8:])
+				a.So(err, should.NotBeNil)
+			})
+
+			t.Run("ComputeDataMIC", func(t *testing.T) {
+				a := assertions.New(t)
+
+				mic, err := svc.ComputeDataMIC(ctx, keyEnvelope, false, 0, 0, 0, addr, 42, payload)
+				a.So(err, should.BeNil)
+				a.So(mic, should.Equal, test.Must(crypto.ComputeLegacyUplinkMIC(key, addr, 42, payload)))
+
+				cmacF := test.Must(crypto.ComputeLegacyUplinkMIC(key, addr, 42, payload))
+				mic, err = svc.ComputeDataMIC(ctx, keyEnvelope, false, 3, 5, 2, addr, 42, payload)
+				a.So(err, should.BeNil)
+				fullMIC := test.Must(crypto.ComputeUplinkMICFromLegacy(cmacF, key, 3, 5, 2, addr, 42, payload))
+				a.So(mic[:2], should.Resemble, fullMIC[:2])
+
+				mic, err = svc.ComputeDataMIC(ctx, keyEnvelope, true, 0, 0, 0, addr, 42, payload)
+				a.So(err, should.BeNil)
+				a.So(mic, should.Equal, test.Must(crypto.ComputeLegacyDownlinkMIC(key, addr, 42, payload)))
+
+				mic, err = svc.ComputeDataMIC(ctx, keyEnvelope, true, 3, 0, 0, addr, 42, payload)
+				a.So(err, should.BeNil)
+				a.So(mic, should.Equal, test.Must(crypto.ComputeDownlinkMIC(key, addr, 3, 42, payload)))
+			})
+		})
+	}
+}
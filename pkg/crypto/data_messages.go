@@ -115,11 +115,23 @@ func DecryptDownlink(key types.AES128Key, addr types.DevAddr, fCnt uint32, paylo
 	return encryptMessage(key, 1, addr, fCnt, payload, opts...)
 }
 
-func computeMIC(key types.AES128Key, dir uint8, confFCnt uint16, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
+// FrameTypeConstant returns the frame type constant that is used for encryption with the given options.
+// See WithFrameTypeConstant.
+func FrameTypeConstant(opts ...EncryptionOption) [4]byte {
+	encOpts := &encryptionOptions{}
+	for _, opt := range opts {
+		opt(encOpts)
+	}
+	return encOpts.frameTypeConstant
+}
+
+func computeMIC(key types.AES128Key, dir uint8, confFCnt uint16, txDRIdx uint8, txChIdx uint8, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
 	hash, _ := cmac.New(key[:])
 	var b0 [aes.BlockSize]byte
 	b0[0] = 0x49
 	binary.LittleEndian.PutUint16(b0[1:3], confFCnt)
+	b0[3] = txDRIdx
+	b0[4] = txChIdx
 	b0[5] = dir
 	copy(b0[6:10], reverse(addr[:]))
 	binary.LittleEndian.PutUint32(b0[10:14], fCnt)
@@ -137,37 +149,37 @@ func computeMIC(key types.AES128Key, dir uint8, confFCnt uint16, addr types.DevA
 	return mic, nil
 }
 
+// ComputeDataMIC computes the first 4 bytes of the CMAC of the B0 (or B1) block and the payload of a data message.
+// The Message Integrity Codes of data messages are composed of this value:
+// - For legacy uplinks, txDRIdx, txChIdx and confFCnt are zero, see ComputeLegacyUplinkMIC
+// - For uplinks, the first 2 bytes are the first 2 bytes of the MIC, see ComputeUplinkMICFromLegacy
+// - For downlinks, txDRIdx and txChIdx are zero, see ComputeLegacyDownlinkMIC and ComputeDownlinkMIC
+func ComputeDataMIC(key types.AES128Key, downlink bool, confFCnt uint32, txDRIdx uint8, txChIdx uint8, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
+	var dir uint8
+	if downlink {
+		dir = 1
+	}
+	return computeMIC(key, dir, uint16(confFCnt), txDRIdx, txChIdx, addr, fCnt, payload)
+}
+
 // ComputeLegacyUplinkMIC computes the Uplink Message Integrity Code.
 // - The payload contains MHDR | FHDR | FPort | FRMPayload
 // - The NwkSKey is used
 func ComputeLegacyUplinkMIC(key types.AES128Key, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
-	return computeMIC(key, 0, 0, addr, fCnt, payload)
+	return computeMIC(key, 0, 0, 0, 0, addr, fCnt, payload)
 }
 
 // ComputeUplinkMICFromLegacy computes the Uplink Message Integrity Code from legacy MIC.
 // - The payload contains MHDR | FHDR | FPort | FRMPayload
 // - If this uplink has the ACK bit set, confFCnt must be set to the FCnt of the last downlink.
 func ComputeUplinkMICFromLegacy(cmacF [4]byte, sNwkSIntKey types.AES128Key, confFCnt uint32, txDRIdx uint8, txChIdx uint8, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
-	sHash, _ := cmac.New(sNwkSIntKey[:])
-	var b1 [aes.BlockSize]byte
-	b1[0] = 0x49
-	binary.LittleEndian.PutUint16(b1[1:3], uint16(confFCnt))
-	b1[3] = txDRIdx
-	b1[4] = txChIdx
-	copy(b1[6:10], reverse(addr[:]))
-	binary.LittleEndian.PutUint32(b1[10:14], fCnt)
-	b1[15] = uint8(len(payload))
-	_, err := sHash.Write(b1[:])
-	if err != nil {
-		return [4]byte{}, err
-	}
-	_, err = sHash.Write(payload)
+	cmacS, err := computeMIC(sNwkSIntKey, 0, uint16(confFCnt), txDRIdx, txChIdx, addr, fCnt, payload)
 	if err != nil {
 		return [4]byte{}, err
 	}
 	var mic [4]byte
-	copy(mic[:2], sHash.Sum([]byte{}))
-	copy(mic[2:], cmacF[:])
+	copy(mic[:2], cmacS[:2])
+	copy(mic[2:], cmacF[:2])
 	return mic, nil
 }
 
@@ -175,7 +187,7 @@ func ComputeUplinkMICFromLegacy(cmacF [4]byte, sNwkSIntKey types.AES128Key, conf
 // - The payload contains MHDR | FHDR | FPort | FRMPayload
 // - If this uplink has the ACK bit set, confFCnt must be set to the FCnt of the last downlink.
 func ComputeUplinkMIC(sNwkSIntKey, fNwkSIntKey types.AES128Key, confFCnt uint32, txDRIdx uint8, txChIdx uint8, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
-	cmacF, err := computeMIC(fNwkSIntKey, 0, 0, addr, fCnt, payload)
+	cmacF, err := computeMIC(fNwkSIntKey, 0, 0, 0, 0, addr, fCnt, payload)
 	if err != nil {
 		return [4]byte{}, err
 	}
@@ -186,7 +198,7 @@ func ComputeUplinkMIC(sNwkSIntKey, fNwkSIntKey types.AES128Key, confFCnt uint32,
 // - The payload contains MHDR | FHDR | FPort | FRMPayload
 // - The NwkSKey is used
 func ComputeLegacyDownlinkMIC(key types.AES128Key, addr types.DevAddr, fCnt uint32, payload []byte) ([4]byte, error) {
-	return computeMIC(key, 1, 0, addr, fCnt, payload)
+	return computeMIC(key, 1, 0, 0, 0, addr, fCnt, payload)
 }
 
 // ComputeDownlinkMIC computes the Downlink Message Integrity Code.
@@ -194,5 +206,5 @@ func ComputeLegacyDownlinkMIC(key types.AES128Key, addr types.DevAddr, fCnt uint
 // - If this downlink has the ACK bit set, confFCnt must be set to the FCnt of the last uplink
 // - The SNwkSIntKey is used
 func ComputeDownlinkMIC(key types.AES128Key, addr types.DevAddr, confFCnt uint32, fCnt uint32, payload []byte) ([4]byte, error) {
-	return computeMIC(key, 1, uint16(confFCnt), addr, fCnt, payload)
+	return computeMIC(key, 1, uint16(confFCnt), 0, 0, addr, fCnt, payload)
 }
@@ -0,0 +1,69 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/aes"
+	"encoding/binary"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errEncryptedFineTimestampSize = errInvalidSize("encrypted_fine_timestamp", "encrypted fine timestamp", "16")
+	errFineTimestampRange         = errors.DefineInvalidArgument(
+		"fine_timestamp_range", "fine timestamp `{fine_timestamp}` out of range",
+	)
+)
+
+// maxFineTimestamp is the maximum fine timestamp in nanoseconds.
+const maxFineTimestamp = 999999999
+
+// EncryptFineTimestamp encrypts the fine timestamp in nanoseconds as gateways with fine timestamping do.
+// The fine timestamp is stored big endian in the first four bytes of a single AES-128 block, which is encrypted in
+// ECB mode.
+func EncryptFineTimestamp(key types.AES128Key, ns uint32) ([]byte, error) {
+	if ns > maxFineTimestamp {
+		return nil, errFineTimestampRange.WithAttributes("fine_timestamp", ns)
+	}
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	res := make([]byte, aes.BlockSize)
+	binary.BigEndian.PutUint32(res, ns)
+	block.Encrypt(res, res)
+	return res, nil
+}
+
+// DecryptFineTimestamp decrypts the encrypted fine timestamp and returns the fine timestamp in nanoseconds.
+// A fine timestamp that is out of range indicates that the key is incorrect.
+func DecryptFineTimestamp(key types.AES128Key, encrypted []byte) (uint32, error) {
+	if len(encrypted) != aes.BlockSize {
+		return 0, errEncryptedFineTimestampSize.WithAttributes("size", len(encrypted))
+	}
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return 0, err
+	}
+	var plaintext [aes.BlockSize]byte
+	block.Decrypt(plaintext[:], encrypted)
+	ns := binary.BigEndian.Uint32(plaintext[:4])
+	if ns > maxFineTimestamp {
+		return 0, errFineTimestampRange.WithAttributes("fine_timestamp", ns)
+	}
+	return ns, nil
+}
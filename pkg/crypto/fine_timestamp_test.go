@@ -0,0 +1,56 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFineTimestamp(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	key := types.AES128Key{0x2b, 0x7e, 0x15, 0x16, 0x28, 0xae, 0xd2, 0xa6, 0xab, 0xf7, 0x15, 0x88, 0x09, 0xcf, 0x4f, 0x3c}
+	for _, ns := range []uint32{0, 1, 123456789, 999999999} {
+		encrypted, err := EncryptFineTimestamp(key, ns)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(encrypted, should.HaveLength, 16)
+		decrypted, err := DecryptFineTimestamp(key, encrypted)
+		if a.So(err, should.BeNil) {
+			a.So(decrypted, should.Equal, ns)
+		}
+	}
+
+	_, err := EncryptFineTimestamp(key, 1000000000)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = DecryptFineTimestamp(key, []byte{0x1, 0x2})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	encrypted, err := EncryptFineTimestamp(key, 42)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = DecryptFineTimestamp(types.AES128Key{0x1}, encrypted)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/aes"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+func deriveMulticastKey(key types.AES128Key, t byte, addr []byte) (derived types.AES128Key) {
+	buf := make([]byte, 16)
+	buf[0] = t
+	copy(buf[1:], addr)
+	block, _ := aes.NewCipher(key[:])
+	block.Encrypt(derived[:], buf)
+	return
+}
+
+// DeriveMcRootKey derives the McRootKey of the remote multicast setup from the AppKey of a LoRaWAN 1.1 end device.
+func DeriveMcRootKey(appKey types.AES128Key) types.AES128Key {
+	return deriveMulticastKey(appKey, 0x20, nil)
+}
+
+// DeriveLegacyMcRootKey derives the McRootKey of the remote multicast setup from the GenAppKey of a
+// LoRaWAN 1.0.x end device.
+func DeriveLegacyMcRootKey(genAppKey types.AES128Key) types.AES128Key {
+	return deriveMulticastKey(genAppKey, 0x00, nil)
+}
+
+// DeriveMcKEKey derives the McKEKey, which encrypts the McKey of multicast groups, from the McRootKey.
+func DeriveMcKEKey(mcRootKey types.AES128Key) types.AES128Key {
+	return deriveMulticastKey(mcRootKey, 0x00, nil)
+}
+
+// EncryptMcKey encrypts the McKey of a multicast group with the McKEKey of an end device.
+// The McKey is encrypted with the AES decrypt operation, so that the end device only needs the AES encrypt operation
+// to decrypt the McKey.
+func EncryptMcKey(mcKEKey, mcKey types.AES128Key) (encrypted types.AES128Key) {
+	block, _ := aes.NewCipher(mcKEKey[:])
+	block.Decrypt(encrypted[:], mcKey[:])
+	return
+}
+
+// DeriveMcAppSKey derives the McAppSKey of the multicast group with the McAddr from the McKey.
+func DeriveMcAppSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMulticastKey(mcKey, 0x01, reverse(mcAddr[:]))
+}
+
+// DeriveMcNwkSKey derives the McNwkSKey of the multicast group with the McAddr from the McKey.
+func DeriveMcNwkSKey(mcKey types.AES128Key, mcAddr types.DevAddr) types.AES128Key {
+	return deriveMulticastKey(mcKey, 0x02, reverse(mcAddr[:]))
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMulticastKeyDerivation(t *testing.T) {
+	a := assertions.New(t)
+
+	key := types.AES128Key{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16}
+	mcKey := types.AES128Key{0xA1, 0xA2, 0xA3, 0xA4, 0xA5, 0xA6, 0xA7, 0xA8, 0xA9, 0xAA, 0xAB, 0xAC, 0xAD, 0xAE, 0xAF, 0xB0}
+	mcAddr := types.DevAddr{0x01, 0x02, 0x03, 0x04}
+
+	mcRootKey := DeriveMcRootKey(key)
+	a.So(mcRootKey, should.Equal, types.AES128Key{0xCC, 0xC7, 0xA8, 0x53, 0xA3, 0xC2, 0x41, 0xAC, 0xEE, 0x98, 0x23, 0x4E, 0xD6, 0x70, 0x21, 0x7E})
+
+	legacyMcRootKey := DeriveLegacyMcRootKey(key)
+	a.So(legacyMcRootKey, should.Equal, types.AES128Key{0x4C, 0x9E, 0xD8, 0x88, 0x18, 0xE8, 0xD4, 0x42, 0xA3, 0xDC, 0xD7, 0xF2, 0x54, 0x76, 0x8C, 0xFC})
+
+	mcKEKey := DeriveMcKEKey(mcRootKey)
+	a.So(mcKEKey, should.Equal, types.AES128Key{0x15, 0xD5, 0x0B, 0x55, 0x70, 0x18, 0xDF, 0xFE, 0x45, 0x37, 0x3A, 0x55, 0x7C, 0x6E, 0x7D, 0xE7})
+
+	encryptedMcKey := EncryptMcKey(mcKEKey, mcKey)
+	a.So(encryptedMcKey, should.Equal, types.AES128Key{0x1C, 0xA0, 0x61, 0xB7, 0xA0, 0x6A, 0x20, 0xC4, 0xE5, 0x4B, 0xFE, 0xE5, 0xEC, 0x79, 0x4D, 0x8F})
+
+	mcAppSKey := DeriveMcAppSKey(mcKey, mcAddr)
+	a.So(mcAppSKey, should.Equal, types.AES128Key{0x31, 0x1F, 0xBC, 0x56, 0x2F, 0xF5, 0xEC, 0x91, 0xB0, 0xF2, 0x16, 0xB3, 0xB3, 0x53, 0xBB, 0xBA})
+
+	mcNwkSKey := DeriveMcNwkSKey(mcKey, mcAddr)
+	a.So(mcNwkSKey, should.Equal, types.AES128Key{0x22, 0x56, 0xCA, 0x56, 0x6A, 0xE9, 0xF7, 0xB8, 0x9B, 0xAF, 0x6D, 0x48, 0xA1, 0x78, 0xBF, 0x66})
+}
@@ -81,4 +81,9 @@ type baseConfig struct {
 		JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
 		Type     string              `yaml:"type"`
 	} `yaml:"join-servers"`
+	SecureElements []struct {
+		File     string              `yaml:"file"`
+		JoinEUIs []types.EUI64Prefix `yaml:"join-euis"`
+		Type     string              `yaml:"type"`
+	} `yaml:"secure-elements"`
 }
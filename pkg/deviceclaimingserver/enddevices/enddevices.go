@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/secureelement"
 	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
 	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
@@ -53,6 +54,15 @@ type EndDeviceClaimer interface {
 	) error
 }
 
+// SecureElementProvider verifies the ownership of end devices with secure elements with the vendor of the secure
+// element, before the end device is claimed and the keys are transferred.
+type SecureElementProvider interface {
+	// SupportsJoinEUI returns whether the secure elements of the vendor use this JoinEUI.
+	SupportsJoinEUI(joinEUI types.EUI64) bool
+	// VerifyOwnership verifies that the ownership token proves ownership of the End Device.
+	VerifyOwnership(ctx context.Context, joinEUI, devEUI types.EUI64, ownershipToken string) error
+}
+
 // Component abstracts the underlying *component.Component.
 type Component interface {
 	httpclient.Provider
@@ -64,17 +74,21 @@ type Component interface {
 
 const (
 	ttjsV2Type = "ttjsv2"
+
+	secureElementHTTPType = "http"
 )
 
 // Upstream abstracts EndDeviceClaimingServer.
 type Upstream struct {
-	claimers map[string]EndDeviceClaimer
+	claimers               map[string]EndDeviceClaimer
+	secureElementProviders map[string]SecureElementProvider
 }
 
 // NewUpstream returns a new Upstream.
 func NewUpstream(ctx context.Context, c Component, conf Config, opts ...Option) (*Upstream, error) {
 	upstream := &Upstream{
-		claimers: make(map[string]EndDeviceClaimer),
+		claimers:               make(map[string]EndDeviceClaimer),
+		secureElementProviders: make(map[string]SecureElementProvider),
 	}
 	for _, opt := range opts {
 		opt(upstream)
@@ -137,6 +151,36 @@ func NewUpstream(ctx context.Context, c Component, conf Config, opts ...Option)
 		upstream.claimers[clientName] = claimer
 	}
 
+	// Setup secure element providers.
+	for _, se := range baseConfig.SecureElements {
+		fileParts := strings.Split(filepath.ToSlash(se.File), "/")
+		fetcher := fetch.WithBasePath(fetcher, fileParts[:len(fileParts)-1]...)
+		fileName := fileParts[len(fileParts)-1]
+		configBytes, err := fetcher.File(fileName)
+		if err != nil {
+			return nil, err
+		}
+
+		var provider SecureElementProvider
+		switch se.Type {
+		case secureElementHTTPType:
+			var seConf secureelement.ConfigFile
+			if err := yaml.UnmarshalStrict(configBytes, &seConf); err != nil {
+				return nil, err
+			}
+			provider = secureelement.NewClient(c, fetcher, secureelement.Config{
+				JoinEUIPrefixes: se.JoinEUIs,
+				ConfigFile:      seConf,
+			})
+		default:
+			log.FromContext(ctx).WithField("type", se.Type).Warn("Unknown secure element provider type")
+			continue
+		}
+
+		providerName := strings.Trim(fileName, filepath.Ext(fileName))
+		upstream.secureElementProviders[providerName] = provider
+	}
+
 	return upstream, nil
 }
 
@@ -150,6 +194,13 @@ func WithClaimer(name string, claimer EndDeviceClaimer) Option {
 	}
 }
 
+// WithSecureElementProvider adds a secure element provider to Upstream.
+func WithSecureElementProvider(name string, provider SecureElementProvider) Option {
+	return func(upstream *Upstream) {
+		upstream.secureElementProviders[name] = provider
+	}
+}
+
 // JoinEUIClaimer returns the EndDeviceClaimer for the given JoinEUI.
 func (upstream *Upstream) JoinEUIClaimer(_ context.Context, joinEUI types.EUI64) EndDeviceClaimer {
 	for _, claimer := range upstream.claimers {
@@ -159,3 +210,14 @@ func (upstream *Upstream) JoinEUIClaimer(_ context.Context, joinEUI types.EUI64)
 	}
 	return nil
 }
+
+// JoinEUISecureElementProvider returns the SecureElementProvider for the given JoinEUI.
+// End devices with a JoinEUI without SecureElementProvider do not have secure elements that require verification.
+func (upstream *Upstream) JoinEUISecureElementProvider(_ context.Context, joinEUI types.EUI64) SecureElementProvider {
+	for _, provider := range upstream.secureElementProviders {
+		if provider.SupportsJoinEUI(joinEUI) {
+			return provider
+		}
+	}
+	return nil
+}
@@ -55,4 +55,7 @@ func TestUpstream(t *testing.T) {
 	supportedJoinEUI := types.EUI64{0x80, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x0C}
 	claimer = upstream.JoinEUIClaimer(ctx, supportedJoinEUI)
 	a.So(claimer, should.NotBeNil)
+
+	a.So(upstream.JoinEUISecureElementProvider(ctx, unsupportedJoinEUI), should.BeNil)
+	a.So(upstream.JoinEUISecureElementProvider(ctx, supportedJoinEUI), should.NotBeNil)
 }
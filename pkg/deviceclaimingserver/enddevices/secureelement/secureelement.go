@@ -0,0 +1,166 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package secureelement provides the ownership verification client for the claim APIs of secure element vendors.
+package secureelement
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/ttjsv2"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// ConfigFile defines the configuration file for a secure element vendor claim API.
+type ConfigFile struct {
+	URL     string            `yaml:"url"`
+	Headers map[string]string `yaml:"headers"`
+	TLS     ttjsv2.TLSConfig  `yaml:"tls"`
+}
+
+// Config is the configuration for a secure element vendor claim API client.
+type Config struct {
+	JoinEUIPrefixes []types.EUI64Prefix
+	ConfigFile
+}
+
+// Component abstracts the component.
+type Component interface {
+	httpclient.Provider
+	KeyService() crypto.KeyService
+}
+
+// Client verifies the ownership of end devices with secure elements with the claim API of the vendor.
+type Client struct {
+	Component
+
+	fetcher fetch.Interface
+	config  Config
+}
+
+// NewClient applies the config and returns a new Client.
+func NewClient(c Component, fetcher fetch.Interface, conf Config) *Client {
+	return &Client{
+		Component: c,
+		fetcher:   fetcher,
+		config:    conf,
+	}
+}
+
+// SupportsJoinEUI implements SecureElementProvider.
+func (c *Client) SupportsJoinEUI(eui types.EUI64) bool {
+	for _, prefix := range c.config.JoinEUIPrefixes {
+		if eui.HasPrefix(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Client) httpClient(ctx context.Context) (*http.Client, error) {
+	var opts []httpclient.Option
+	if !c.config.TLS.IsZero() {
+		tlsConf, err := c.config.TLS.TLSConfig(c.fetcher, c.KeyService())
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, httpclient.WithTLSConfig(tlsConf))
+	}
+	return c.HTTPClient(ctx, opts...)
+}
+
+// verifyOwnershipRequest is the request to verify the ownership of an end device.
+type verifyOwnershipRequest struct {
+	JoinEUI        types.EUI64 `json:"join_eui"`
+	OwnershipToken string      `json:"ownership_token"`
+}
+
+var (
+	errBadRequest = errors.DefineInvalidArgument(
+		"bad_request", "bad request to secure element vendor",
+	)
+	errSecureElementNotFound = errors.DefineNotFound(
+		"secure_element_not_found", "secure element of device with EUI `{dev_eui}` not found",
+	)
+	errOwnershipNotVerified = errors.DefinePermissionDenied(
+		"ownership_not_verified", "ownership of device with EUI `{dev_eui}` not verified by secure element vendor",
+	)
+	errUnauthenticated = errors.DefineUnauthenticated(
+		"unauthenticated", "unauthenticated with secure element vendor",
+	)
+)
+
+// VerifyOwnership implements SecureElementProvider.
+func (c *Client) VerifyOwnership(ctx context.Context, joinEUI, devEUI types.EUI64, ownershipToken string) error {
+	reqURL := fmt.Sprintf("%s/devices/%s/ownership", c.config.URL, devEUI.String())
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"dev_eui", devEUI,
+		"join_eui", joinEUI,
+		"url", reqURL,
+	))
+
+	buf, err := json.Marshal(&verifyOwnershipRequest{
+		JoinEUI:        joinEUI,
+		OwnershipToken: ownershipToken,
+	})
+	if err != nil {
+		return err
+	}
+
+	logger.Debug("Verify end device ownership with secure element vendor")
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, reqURL, bytes.NewReader(buf))
+	if err != nil {
+		return err
+	}
+	request.Header.Set("Content-Type", "application/json")
+	for key, value := range c.config.Headers {
+		request.Header.Set(key, value)
+	}
+
+	client, err := c.httpClient(ctx)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return nil
+	}
+	logger.WithField("status_code", resp.StatusCode).Warn("Failed to verify end device ownership")
+	switch resp.StatusCode {
+	case http.StatusBadRequest:
+		return errBadRequest.New()
+	case http.StatusNotFound:
+		return errSecureElementNotFound.WithAttributes("dev_eui", devEUI)
+	case http.StatusForbidden:
+		return errOwnershipNotVerified.WithAttributes("dev_eui", devEUI)
+	case http.StatusUnauthorized:
+		return errUnauthenticated.New()
+	default:
+		return errors.FromHTTPStatusCode(resp.StatusCode)
+	}
+}
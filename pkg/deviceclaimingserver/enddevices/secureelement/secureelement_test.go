@@ -0,0 +1,87 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package secureelement_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	. "go.thethings.network/lorawan-stack/v3/pkg/deviceclaimingserver/enddevices/secureelement"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestVerifyOwnership(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	joinEUI := types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x01}
+	ownedDevEUI := types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x30}
+	unknownDevEUI := types.EUI64{0x00, 0x04, 0xa3, 0x0b, 0x00, 0x1c, 0x05, 0x31}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var req struct {
+			JoinEUI        types.EUI64 `json:"join_eui"`
+			OwnershipToken string      `json:"ownership_token"`
+		}
+		if r.Method != http.MethodPost || json.NewDecoder(r.Body).Decode(&req) != nil || !req.JoinEUI.Equal(joinEUI) {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		switch {
+		case r.URL.Path != "/devices/"+ownedDevEUI.String()+"/ownership":
+			w.WriteHeader(http.StatusNotFound)
+		case req.OwnershipToken != "BEEF1234":
+			w.WriteHeader(http.StatusForbidden)
+		default:
+			w.WriteHeader(http.StatusNoContent)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	c := componenttest.NewComponent(t, &component.Config{})
+	client := NewClient(c, nil, Config{
+		JoinEUIPrefixes: []types.EUI64Prefix{{EUI64: joinEUI, Length: 64}},
+		ConfigFile: ConfigFile{
+			URL: srv.URL,
+			Headers: map[string]string{
+				"Authorization": "Bearer secret",
+			},
+		},
+	})
+	a.So(client.SupportsJoinEUI(joinEUI), should.BeTrue)
+	a.So(client.SupportsJoinEUI(types.EUI64{0x70, 0xb3, 0xd5, 0x7e, 0xd0, 0x00, 0x00, 0x02}), should.BeFalse)
+
+	a.So(client.VerifyOwnership(ctx, joinEUI, ownedDevEUI, "BEEF1234"), should.BeNil)
+	a.So(errors.IsPermissionDenied(client.VerifyOwnership(ctx, joinEUI, ownedDevEUI, "BEEF4321")), should.BeTrue)
+	a.So(errors.IsNotFound(client.VerifyOwnership(ctx, joinEUI, unknownDevEUI, "BEEF1234")), should.BeTrue)
+
+	unauthenticated := NewClient(c, nil, Config{
+		ConfigFile: ConfigFile{
+			URL: srv.URL,
+		},
+	})
+	a.So(errors.IsUnauthenticated(unauthenticated.VerifyOwnership(ctx, joinEUI, ownedDevEUI, "BEEF1234")), should.BeTrue)
+}
@@ -62,12 +62,20 @@ func (edcs *endDeviceClaimingServer) Claim(
 	// Check that the collaborator has necessary rights before attempting to claim it on an upstream.
 	// Since this is part of the create device flow,
 	// we check that the collaborator has the rights to create devices in the application.
+	// API keys that are restricted to claiming end devices into the application are also allowed,
+	// so that application owners can delegate claiming to device manufacturers.
 	targetAppID := req.GetTargetApplicationIds()
-	if err := rights.RequireApplication(ctx, targetAppID,
-		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
-	); err != nil {
+	appRights, err := rights.ListApplication(ctx, targetAppID)
+	if err != nil {
 		return nil, err
 	}
+	if !appRights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM) {
+		if err := rights.RequireApplication(ctx, targetAppID,
+			ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+		); err != nil {
+			return nil, err
+		}
+	}
 
 	var (
 		joinEUI, devEUI         types.EUI64
@@ -109,7 +117,15 @@ func (edcs *endDeviceClaimingServer) Claim(
 		return nil, errClaimingNotSupported.WithAttributes("eui", joinEUI)
 	}
 
-	err := claimer.Claim(ctx, joinEUI, devEUI, claimAuthenticationCode)
+	// End devices with secure elements are only claimed when the vendor verifies the ownership,
+	// since claiming transfers the keys that are provisioned in the secure element.
+	if provider := edcs.DCS.endDeviceClaimingUpstream.JoinEUISecureElementProvider(ctx, joinEUI); provider != nil {
+		if err := provider.VerifyOwnership(ctx, joinEUI, devEUI, claimAuthenticationCode); err != nil {
+			return nil, err
+		}
+	}
+
+	err = claimer.Claim(ctx, joinEUI, devEUI, claimAuthenticationCode)
 	if err != nil {
 		return nil, err
 	}
@@ -46,6 +46,7 @@ var (
 		ApplicationId: "test-application",
 	}
 	registeredApplicationKey     = "test-key"
+	registeredClaimKey           = "test-claim-key"
 	registeredEndDeviceID        = "test-end-device"
 	deviceIDWithoutEUIs          = "test-device-without-euis"
 	deviceIDClaimingNotSupported = "test-device-without-claiming-support"
@@ -102,6 +103,15 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 				return nil
 			},
 		}),
+		enddevices.WithSecureElementProvider("test", &MockSecureElementProvider{
+			JoinEUIs: []types.EUI64{registeredJoinEUI},
+			VerifyOwnershipFunc: func(_ context.Context, _, _ types.EUI64, ownershipToken string) error {
+				if ownershipToken != authenticationCode {
+					return errOwnershipNotVerified.New()
+				}
+				return nil
+			},
+		}),
 	)
 	a.So(err, should.BeNil)
 	dcs, err := New(c, &Config{}, WithEndDeviceClaimingUpstream(mockUpstream))
@@ -126,6 +136,11 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 		AuthValue: "invalid-key",
 	})
 
+	claimCallOpt := grpc.PerRPCCredentials(rpcmetadata.MD{
+		AuthType:  "Bearer",
+		AuthValue: registeredClaimKey,
+	})
+
 	// Register entities.
 	is.ApplicationRegistry().Add(
 		ctx,
@@ -134,6 +149,12 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
 		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
 	)
+	is.ApplicationRegistry().Add(
+		ctx,
+		registeredApplicationIDs,
+		registeredClaimKey,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM,
+	)
 	is.EndDeviceRegistry().Add(
 		ctx,
 		&ttnpb.EndDevice{
@@ -249,6 +270,22 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 				return errors.IsPermissionDenied(err)
 			},
 		},
+		{
+			Name: "SecureElementOwnershipNotVerified",
+			Req: &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+					AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+						JoinEui:            registeredJoinEUI.Bytes(),
+						DevEui:             registeredDevEUI.Bytes(),
+						AuthenticationCode: "BEEF4321",
+					},
+				},
+				TargetApplicationIds: registeredApplicationIDs,
+				TargetDeviceId:       "target-device",
+			},
+			CallOpts:       authorizedCallOpt,
+			ErrorAssertion: errors.IsPermissionDenied,
+		},
 		{
 			Name: "ValidDevice",
 			Req: &ttnpb.ClaimEndDeviceRequest{
@@ -264,6 +301,21 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 			},
 			CallOpts: authorizedCallOpt,
 		},
+		{
+			Name: "ValidDeviceWithClaimKey",
+			Req: &ttnpb.ClaimEndDeviceRequest{
+				SourceDevice: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers_{
+					AuthenticatedIdentifiers: &ttnpb.ClaimEndDeviceRequest_AuthenticatedIdentifiers{
+						JoinEui:            registeredJoinEUI.Bytes(),
+						DevEui:             registeredDevEUI.Bytes(),
+						AuthenticationCode: authenticationCode,
+					},
+				},
+				TargetApplicationIds: registeredApplicationIDs,
+				TargetDeviceId:       "target-device",
+			},
+			CallOpts: claimCallOpt,
+		},
 	} {
 		tc := tc
 		t.Run(tc.Name, func(t *testing.T) {
@@ -328,6 +380,13 @@ func TestEndDeviceClaimingServer(t *testing.T) {
 	a.So(err, should.BeNil)
 	a.So(status, should.NotBeNil)
 
+	// The claim key can not be used to read the claim status.
+	_, err = edcsClient.GetClaimStatus(ctx, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: registeredApplicationIDs,
+		DeviceId:       registeredEndDeviceID,
+	}, claimCallOpt)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
 	// Unclaim.
 	_, err = edcsClient.Unclaim(ctx, &ttnpb.EndDeviceIdentifiers{
 		ApplicationIds: registeredApplicationIDs,
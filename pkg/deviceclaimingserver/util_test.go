@@ -17,6 +17,7 @@ package deviceclaimingserver_test
 import (
 	"context"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 )
@@ -72,3 +73,29 @@ func (m MockClaimer) BatchUnclaim(
 ) error {
 	return m.BatchUnclaimFunc(ctx, ids)
 }
+
+var errOwnershipNotVerified = errors.DefinePermissionDenied("ownership_not_verified", "ownership not verified")
+
+// MockSecureElementProvider is a mock SecureElementProvider.
+type MockSecureElementProvider struct {
+	JoinEUIs []types.EUI64
+
+	VerifyOwnershipFunc func(context.Context, types.EUI64, types.EUI64, string) error
+}
+
+// SupportsJoinEUI returns whether the secure elements of the vendor use this JoinEUI.
+func (m MockSecureElementProvider) SupportsJoinEUI(joinEUI types.EUI64) bool {
+	for _, eui := range m.JoinEUIs {
+		if eui.Equal(joinEUI) {
+			return true
+		}
+	}
+	return false
+}
+
+// VerifyOwnership verifies that the ownership token proves ownership of the End Device.
+func (m MockSecureElementProvider) VerifyOwnership(
+	ctx context.Context, joinEUI, devEUI types.EUI64, ownershipToken string,
+) error {
+	return m.VerifyOwnershipFunc(ctx, joinEUI, devEUI, ownershipToken)
+}
@@ -20,6 +20,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/bleve"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 )
 
@@ -37,7 +38,8 @@ type Config struct {
 type StoreConfig struct {
 	Store store.Store `name:"-"`
 
-	Bleve bleve.Config `name:"bleve"`
+	Bleve   bleve.Config   `name:"bleve"`
+	Private private.Config `name:"private"`
 }
 
 // NewStore creates a new Store for end devices.
@@ -46,7 +48,14 @@ func (c Config) NewStore(ctx context.Context, blobConf config.BlobConfig) (store
 		return c.Store.Store, nil
 	}
 
-	return c.Store.Bleve.NewStore(ctx)
+	s, err := c.Store.Bleve.NewStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if catalog := c.Store.Private.Catalog(); catalog != nil {
+		return private.NewStore(s, catalog), nil
+	}
+	return s, nil
 }
 
 var errUnknownSource = errors.DefineInvalidArgument("unknown_source", "unknown source `{source}`")
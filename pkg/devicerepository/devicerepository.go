@@ -22,6 +22,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
 	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
@@ -39,7 +40,8 @@ type DeviceRepository struct {
 
 	config *Config
 
-	store store.Store
+	store   store.Store
+	catalog *private.Catalog
 }
 
 // New returns a new *DeviceRepository.
@@ -49,16 +51,23 @@ func New(c *component.Component, conf *Config) (*DeviceRepository, error) {
 		ctx:       log.NewContextWithField(c.Context(), "namespace", "devicerepository"),
 		config:    conf,
 
-		store: conf.Store.Store,
+		store:   conf.Store.Store,
+		catalog: conf.Store.Private.Catalog(),
 	}
 
 	c.RegisterGRPC(dr)
+	c.RegisterWeb(dr)
 
 	c.GRPC.RegisterUnaryHook(
 		"/ttn.lorawan.v3.DeviceRepository",
 		rpclog.NamespaceHook,
 		rpclog.UnaryNamespaceHook("devicerepository"),
 	)
+	c.GRPC.RegisterUnaryHook(
+		"/ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry",
+		rpclog.NamespaceHook,
+		rpclog.UnaryNamespaceHook("devicerepository"),
+	)
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.DeviceRepository", cluster.HookName, c.ClusterAuthUnaryHook())
 	return dr, nil
 }
@@ -76,9 +85,15 @@ func (*DeviceRepository) Roles() []ttnpb.ClusterRole {
 // RegisterServices registers services provided by dr at s.
 func (dr *DeviceRepository) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterDeviceRepositoryServer(s, dr)
+	if dr.catalog != nil {
+		ttnpb.RegisterDeviceRepositoryPrivateVendorRegistryServer(s, &privateVendorRegistryServer{catalog: dr.catalog})
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (dr *DeviceRepository) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterDeviceRepositoryHandler(dr.Context(), s, conn) //nolint:errcheck
+	if dr.catalog != nil {
+		ttnpb.RegisterDeviceRepositoryPrivateVendorRegistryHandler(dr.Context(), s, conn) //nolint:errcheck
+	}
 }
@@ -0,0 +1,353 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"runtime"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors"
+	"go.thethings.network/lorawan-stack/v3/pkg/messageprocessors/javascript"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+const (
+	// defaultFormatterTestIterations is the default number of times that each test vector is run.
+	defaultFormatterTestIterations = 1
+	// maxFormatterTestIterations is the maximum number of times that each test vector is run.
+	maxFormatterTestIterations = 100
+)
+
+const (
+	formatterUplinkDecoder   = "uplink_decoder"
+	formatterDownlinkDecoder = "downlink_decoder"
+	formatterDownlinkEncoder = "downlink_encoder"
+
+	formatterTestSourceExample = "example"
+	formatterTestSourceVector  = "vector"
+)
+
+var (
+	errUnsupportedFormatter = errors.DefineFailedPrecondition(
+		"unsupported_formatter", "unsupported formatter `{formatter}`",
+	)
+	errTooManyIterations = errors.DefineInvalidArgument(
+		"too_many_iterations", "number of iterations exceeds `{max}`",
+	)
+	errMissingExpectedErrors = errors.DefineAborted(
+		"missing_expected_errors", "formatter succeeded but expected errors `{errors}`",
+	)
+	errOutputMismatch = errors.DefineAborted("output_mismatch", "output does not match expected output")
+)
+
+// formatterTestRequest is a request to test the payload formatters of an end device version.
+// The payload formatters are tested with the examples in the Device Repository and with the given vectors.
+type formatterTestRequest struct {
+	VersionIDs *ttnpb.EndDeviceVersionIdentifiers
+	Iterations uint32
+
+	UplinkDecoderVectors   []*ttnpb.MessagePayloadDecoder_Example
+	DownlinkDecoderVectors []*ttnpb.MessagePayloadDecoder_Example
+	DownlinkEncoderVectors []*ttnpb.MessagePayloadEncoder_Example
+}
+
+// formatterTestResult is the result of running a payload formatter with a single example or vector.
+type formatterTestResult struct {
+	Formatter   string `json:"formatter"`
+	CodecID     string `json:"codec_id,omitempty"`
+	Source      string `json:"source"`
+	Description string `json:"description,omitempty"`
+	Passed      bool   `json:"passed"`
+	Error       string `json:"error,omitempty"`
+	// Duration is the average execution time of the formatter.
+	Duration time.Duration `json:"duration"`
+	// AllocatedBytes is the average number of bytes that are allocated by the formatter.
+	// This is measured for the whole process, so it is only indicative if other work is going on.
+	AllocatedBytes uint64 `json:"allocated_bytes"`
+}
+
+// formatterTestResponse contains the results of testing the payload formatters of an end device version.
+type formatterTestResponse struct {
+	Passed  bool                   `json:"passed"`
+	Results []*formatterTestResult `json:"results"`
+}
+
+// measure runs f the given number of times and returns the average duration, the average number of allocated bytes
+// and the first error.
+func measure(iterations uint32, f func() error) (time.Duration, uint64, error) {
+	var before, after runtime.MemStats
+	runtime.ReadMemStats(&before)
+	start := time.Now()
+	var err error
+	for i := uint32(0); i < iterations; i++ {
+		if err = f(); err != nil {
+			break
+		}
+	}
+	duration := time.Since(start)
+	runtime.ReadMemStats(&after)
+	return duration / time.Duration(iterations), (after.TotalAlloc - before.TotalAlloc) / uint64(iterations), err
+}
+
+// structsEqual returns whether the structs are equal after normalizing the number values.
+func structsEqual(a, b *structpb.Struct) bool {
+	return reflect.DeepEqual(a.AsMap(), b.AsMap())
+}
+
+// formatterTest tests a single payload formatter.
+type formatterTest struct {
+	formatter  string
+	codecID    string
+	iterations uint32
+}
+
+func (t formatterTest) result(
+	source, description string, err error, d time.Duration, allocated uint64,
+) *formatterTestResult {
+	res := &formatterTestResult{
+		Formatter:      t.formatter,
+		CodecID:        t.codecID,
+		Source:         source,
+		Description:    description,
+		Passed:         err == nil,
+		Duration:       d,
+		AllocatedBytes: allocated,
+	}
+	if err != nil {
+		res.Error = err.Error()
+	}
+	return res
+}
+
+// compare returns an error if the formatter returned an unexpected result.
+// If errors are expected, the formatter must fail.
+func compare(expectedErrors []string, err error, equal func() bool) error {
+	if len(expectedErrors) > 0 {
+		if err == nil {
+			return errMissingExpectedErrors.WithAttributes("errors", expectedErrors)
+		}
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !equal() {
+		return errOutputMismatch.New()
+	}
+	return nil
+}
+
+func (t formatterTest) runDecoder(
+	ctx context.Context,
+	source string,
+	examples []*ttnpb.MessagePayloadDecoder_Example,
+	decode func(context.Context, *ttnpb.EncodedMessagePayload) (*structpb.Struct, error),
+) []*formatterTestResult {
+	res := make([]*formatterTestResult, 0, len(examples))
+	for _, example := range examples {
+		var decoded *structpb.Struct
+		d, allocated, err := measure(t.iterations, func() (err error) {
+			decoded, err = decode(ctx, example.GetInput())
+			return err
+		})
+		err = compare(example.GetOutput().GetErrors(), err, func() bool {
+			return structsEqual(decoded, example.GetOutput().GetData())
+		})
+		res = append(res, t.result(source, example.GetDescription(), err, d, allocated))
+	}
+	return res
+}
+
+func (t formatterTest) runEncoder(
+	ctx context.Context,
+	source string,
+	examples []*ttnpb.MessagePayloadEncoder_Example,
+	encode func(context.Context, *ttnpb.DecodedMessagePayload) (*ttnpb.EncodedMessagePayload, error),
+) []*formatterTestResult {
+	res := make([]*formatterTestResult, 0, len(examples))
+	for _, example := range examples {
+		var encoded *ttnpb.EncodedMessagePayload
+		d, allocated, err := measure(t.iterations, func() (err error) {
+			encoded, err = encode(ctx, example.GetInput())
+			return err
+		})
+		expected := example.GetOutput()
+		err = compare(expected.GetErrors(), err, func() bool {
+			return bytes.Equal(encoded.FrmPayload, expected.GetFrmPayload()) &&
+				(expected.GetFPort() == 0 || encoded.FPort == expected.GetFPort())
+		})
+		res = append(res, t.result(source, example.GetDescription(), err, d, allocated))
+	}
+	return res
+}
+
+// testFormatters runs the examples of the payload formatters of the end device version and the given vectors.
+// A formatter that fails to compile fails all its examples and vectors.
+func testFormatters(
+	ctx context.Context, st store.Store, req *formatterTestRequest,
+) (*formatterTestResponse, error) {
+	switch {
+	case req.Iterations == 0:
+		req.Iterations = defaultFormatterTestIterations
+	case req.Iterations > maxFormatterTestIterations:
+		return nil, errTooManyIterations.WithAttributes("max", maxFormatterTestIterations)
+	}
+	codecReq := &ttnpb.GetPayloadFormatterRequest{
+		VersionIds: req.VersionIDs,
+		FieldMask:  &fieldmaskpb.FieldMask{Paths: []string{"formatter", "formatter_parameter", "codec_id", "examples"}},
+	}
+	host := javascript.New()
+	res := &formatterTestResponse{}
+
+	// The end device version is not found if none of the formatters is found.
+	var notFoundErr error
+	found := false
+	handle := func(err error) error {
+		if errors.IsNotFound(err) {
+			notFoundErr = err
+			return nil
+		}
+		return err
+	}
+
+	if decoder, err := st.GetUplinkDecoder(codecReq); err == nil {
+		found = true
+		t := formatterTest{formatterUplinkDecoder, decoder.CodecId, req.Iterations}
+		run, err := compileUplinkDecoder(ctx, host, decoder)
+		if err != nil {
+			run = func(context.Context, *ttnpb.EncodedMessagePayload) (*structpb.Struct, error) { return nil, err }
+		}
+		res.Results = append(res.Results, t.runDecoder(ctx, formatterTestSourceExample, decoder.Examples, run)...)
+		res.Results = append(res.Results, t.runDecoder(ctx, formatterTestSourceVector, req.UplinkDecoderVectors, run)...)
+	} else if err := handle(err); err != nil {
+		return nil, err
+	}
+
+	if decoder, err := st.GetDownlinkDecoder(codecReq); err == nil {
+		found = true
+		t := formatterTest{formatterDownlinkDecoder, decoder.CodecId, req.Iterations}
+		run, err := compileDownlinkDecoder(ctx, host, decoder)
+		if err != nil {
+			run = func(context.Context, *ttnpb.EncodedMessagePayload) (*structpb.Struct, error) { return nil, err }
+		}
+		res.Results = append(res.Results, t.runDecoder(ctx, formatterTestSourceExample, decoder.Examples, run)...)
+		res.Results = append(res.Results, t.runDecoder(ctx, formatterTestSourceVector, req.DownlinkDecoderVectors, run)...)
+	} else if err := handle(err); err != nil {
+		return nil, err
+	}
+
+	if encoder, err := st.GetDownlinkEncoder(codecReq); err == nil {
+		found = true
+		t := formatterTest{formatterDownlinkEncoder, encoder.CodecId, req.Iterations}
+		run, err := compileDownlinkEncoder(ctx, host, encoder)
+		if err != nil {
+			run = func(context.Context, *ttnpb.DecodedMessagePayload) (*ttnpb.EncodedMessagePayload, error) {
+				return nil, err
+			}
+		}
+		res.Results = append(res.Results, t.runEncoder(ctx, formatterTestSourceExample, encoder.Examples, run)...)
+		res.Results = append(res.Results, t.runEncoder(ctx, formatterTestSourceVector, req.DownlinkEncoderVectors, run)...)
+	} else if err := handle(err); err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, notFoundErr
+	}
+	res.Passed = true
+	for _, r := range res.Results {
+		res.Passed = res.Passed && r.Passed
+	}
+	return res, nil
+}
+
+func compileUplinkDecoder(
+	ctx context.Context, host messageprocessors.CompilablePayloadEncoderDecoder, decoder *ttnpb.MessagePayloadDecoder,
+) (func(context.Context, *ttnpb.EncodedMessagePayload) (*structpb.Struct, error), error) {
+	if decoder.Formatter != ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT {
+		return nil, errUnsupportedFormatter.WithAttributes("formatter", decoder.Formatter)
+	}
+	f, err := host.CompileUplinkDecoder(ctx, decoder.FormatterParameter)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, input *ttnpb.EncodedMessagePayload) (*structpb.Struct, error) {
+		msg := &ttnpb.ApplicationUplink{
+			FPort:      input.GetFPort(),
+			FrmPayload: input.GetFrmPayload(),
+		}
+		if err := f(ctx, nil, nil, msg); err != nil {
+			return nil, err
+		}
+		return msg.DecodedPayload, nil
+	}, nil
+}
+
+func compileDownlinkDecoder(
+	ctx context.Context, host messageprocessors.CompilablePayloadEncoderDecoder, decoder *ttnpb.MessagePayloadDecoder,
+) (func(context.Context, *ttnpb.EncodedMessagePayload) (*structpb.Struct, error), error) {
+	if decoder.Formatter != ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT {
+		return nil, errUnsupportedFormatter.WithAttributes("formatter", decoder.Formatter)
+	}
+	f, err := host.CompileDownlinkDecoder(ctx, decoder.FormatterParameter)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, input *ttnpb.EncodedMessagePayload) (*structpb.Struct, error) {
+		msg := &ttnpb.ApplicationDownlink{
+			FPort:      input.GetFPort(),
+			FrmPayload: input.GetFrmPayload(),
+		}
+		if err := f(ctx, nil, nil, msg); err != nil {
+			return nil, err
+		}
+		return msg.DecodedPayload, nil
+	}, nil
+}
+
+func compileDownlinkEncoder(
+	ctx context.Context, host messageprocessors.CompilablePayloadEncoderDecoder, encoder *ttnpb.MessagePayloadEncoder,
+) (func(context.Context, *ttnpb.DecodedMessagePayload) (*ttnpb.EncodedMessagePayload, error), error) {
+	if encoder.Formatter != ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT {
+		return nil, errUnsupportedFormatter.WithAttributes("formatter", encoder.Formatter)
+	}
+	f, err := host.CompileDownlinkEncoder(ctx, encoder.FormatterParameter)
+	if err != nil {
+		return nil, err
+	}
+	return func(ctx context.Context, input *ttnpb.DecodedMessagePayload) (*ttnpb.EncodedMessagePayload, error) {
+		data := input.GetData()
+		if data == nil {
+			data = &structpb.Struct{}
+		}
+		msg := &ttnpb.ApplicationDownlink{
+			DecodedPayload: data,
+		}
+		if err := f(ctx, nil, nil, msg); err != nil {
+			return nil, err
+		}
+		return &ttnpb.EncodedMessagePayload{
+			FPort:      msg.FPort,
+			FrmPayload: msg.FrmPayload,
+		}, nil
+	}, nil
+}
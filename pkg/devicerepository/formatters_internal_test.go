@@ -0,0 +1,146 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var errTestNotFound = errors.DefineNotFound("test_not_found", "not found")
+
+type formatterStore struct {
+	store.Store
+
+	uplinkDecoder   *ttnpb.MessagePayloadDecoder
+	downlinkEncoder *ttnpb.MessagePayloadEncoder
+}
+
+func (s *formatterStore) GetUplinkDecoder(store.GetCodecRequest) (*ttnpb.MessagePayloadDecoder, error) {
+	if s.uplinkDecoder == nil {
+		return nil, errTestNotFound.New()
+	}
+	return s.uplinkDecoder, nil
+}
+
+func (*formatterStore) GetDownlinkDecoder(store.GetCodecRequest) (*ttnpb.MessagePayloadDecoder, error) {
+	return nil, errTestNotFound.New()
+}
+
+func (s *formatterStore) GetDownlinkEncoder(store.GetCodecRequest) (*ttnpb.MessagePayloadEncoder, error) {
+	if s.downlinkEncoder == nil {
+		return nil, errTestNotFound.New()
+	}
+	return s.downlinkEncoder, nil
+}
+
+func mustStruct(t *testing.T, m map[string]any) *structpb.Struct {
+	t.Helper()
+	s, err := structpb.NewStruct(m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestTestFormatters(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	st := &formatterStore{
+		uplinkDecoder: &ttnpb.MessagePayloadDecoder{
+			Formatter: ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			FormatterParameter: `function decodeUplink(input) {
+				if (input.fPort !== 1) {
+					return { errors: ["unknown fPort"] };
+				}
+				return { data: { temperature: input.bytes[0] } };
+			}`,
+			CodecId: "codec",
+			Examples: []*ttnpb.MessagePayloadDecoder_Example{
+				{
+					Description: "temperature",
+					Input:       &ttnpb.EncodedMessagePayload{FPort: 1, FrmPayload: []byte{21}},
+					Output:      &ttnpb.DecodedMessagePayload{Data: mustStruct(t, map[string]any{"temperature": 21})},
+				},
+				{
+					Description: "unknown fPort",
+					Input:       &ttnpb.EncodedMessagePayload{FPort: 2, FrmPayload: []byte{21}},
+					Output:      &ttnpb.DecodedMessagePayload{Errors: []string{"unknown fPort"}},
+				},
+			},
+		},
+		downlinkEncoder: &ttnpb.MessagePayloadEncoder{
+			Formatter:          ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT,
+			FormatterParameter: `function encodeDownlink(input) { syntax error }`,
+			CodecId:            "codec",
+		},
+	}
+
+	res, err := testFormatters(ctx, st, &formatterTestRequest{
+		VersionIDs: &ttnpb.EndDeviceVersionIdentifiers{BrandId: "brand", ModelId: "model"},
+		Iterations: 3,
+		UplinkDecoderVectors: []*ttnpb.MessagePayloadDecoder_Example{
+			{
+				Description: "wrong temperature",
+				Input:       &ttnpb.EncodedMessagePayload{FPort: 1, FrmPayload: []byte{22}},
+				Output:      &ttnpb.DecodedMessagePayload{Data: mustStruct(t, map[string]any{"temperature": 21})},
+			},
+		},
+		DownlinkEncoderVectors: []*ttnpb.MessagePayloadEncoder_Example{
+			{
+				Description: "set interval",
+				Input:       &ttnpb.DecodedMessagePayload{Data: mustStruct(t, map[string]any{"interval": 60})},
+				Output:      &ttnpb.EncodedMessagePayload{FPort: 1, FrmPayload: []byte{60}},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(res.Passed, should.BeFalse)
+	if !a.So(res.Results, should.HaveLength, 4) {
+		t.FailNow()
+	}
+	for i, expected := range []struct {
+		formatter, source, description string
+		passed                         bool
+	}{
+		{formatterUplinkDecoder, formatterTestSourceExample, "temperature", true},
+		{formatterUplinkDecoder, formatterTestSourceExample, "unknown fPort", true},
+		{formatterUplinkDecoder, formatterTestSourceVector, "wrong temperature", false},
+		{formatterDownlinkEncoder, formatterTestSourceVector, "set interval", false},
+	} {
+		res := res.Results[i]
+		a.So(res.Formatter, should.Equal, expected.formatter)
+		a.So(res.Source, should.Equal, expected.source)
+		a.So(res.Description, should.Equal, expected.description)
+		a.So(res.Passed, should.Equal, expected.passed)
+		a.So(res.Error == "", should.Equal, expected.passed)
+		a.So(res.CodecID, should.Equal, "codec")
+	}
+
+	_, err = testFormatters(ctx, &formatterStore{}, &formatterTestRequest{})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	_, err = testFormatters(ctx, st, &formatterTestRequest{Iterations: maxFormatterTestIterations + 1})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
@@ -0,0 +1,123 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+// maxFormatterTestRequestSize is the maximum size of a formatter test request.
+const maxFormatterTestRequestSize = 1 << 20 // 1 MB.
+
+var errDecodeFormatterTest = errors.DefineInvalidArgument("decode_formatter_test", "decode formatter test request")
+
+// RegisterRoutes registers the web frontend routes.
+//
+// The formatter test route runs the examples of the payload formatters of an end device version, and the test vectors
+// in the request body, and reports whether they passed with the execution time and memory usage.
+func (dr *DeviceRepository) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/dr").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("devicerepository")),
+		ratelimit.HTTPMiddleware(dr.Component.RateLimiter(), "http:dr"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+	router.HandleFunc(
+		"/brands/{brand_id}/models/{model_id}/{firmware_version}/{band_id}/formatters/test", dr.handleTestFormatters,
+	).Methods(http.MethodPost)
+}
+
+// decodeFormatterTestRequest decodes the test vectors of a formatter test request.
+func decodeFormatterTestRequest(r *http.Request, w http.ResponseWriter) (*formatterTestRequest, error) {
+	vars := mux.Vars(r)
+	req := &formatterTestRequest{
+		VersionIDs: &ttnpb.EndDeviceVersionIdentifiers{
+			BrandId:         vars["brand_id"],
+			ModelId:         vars["model_id"],
+			FirmwareVersion: vars["firmware_version"],
+			BandId:          vars["band_id"],
+		},
+	}
+	if err := req.VersionIDs.ValidateFields("brand_id", "model_id", "firmware_version", "band_id"); err != nil {
+		return nil, err
+	}
+	var body struct {
+		Iterations      uint32            `json:"iterations"`
+		UplinkDecoder   []json.RawMessage `json:"uplink_decoder"`
+		DownlinkDecoder []json.RawMessage `json:"downlink_decoder"`
+		DownlinkEncoder []json.RawMessage `json:"downlink_encoder"`
+	}
+	if r.ContentLength != 0 {
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxFormatterTestRequestSize))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(&body); err != nil {
+			return nil, errDecodeFormatterTest.WithCause(err)
+		}
+	}
+	req.Iterations = body.Iterations
+	for _, b := range body.UplinkDecoder {
+		vector := &ttnpb.MessagePayloadDecoder_Example{}
+		if err := jsonpb.TTN().Unmarshal(b, vector); err != nil {
+			return nil, errDecodeFormatterTest.WithCause(err)
+		}
+		req.UplinkDecoderVectors = append(req.UplinkDecoderVectors, vector)
+	}
+	for _, b := range body.DownlinkDecoder {
+		vector := &ttnpb.MessagePayloadDecoder_Example{}
+		if err := jsonpb.TTN().Unmarshal(b, vector); err != nil {
+			return nil, errDecodeFormatterTest.WithCause(err)
+		}
+		req.DownlinkDecoderVectors = append(req.DownlinkDecoderVectors, vector)
+	}
+	for _, b := range body.DownlinkEncoder {
+		vector := &ttnpb.MessagePayloadEncoder_Example{}
+		if err := jsonpb.TTN().Unmarshal(b, vector); err != nil {
+			return nil, errDecodeFormatterTest.WithCause(err)
+		}
+		req.DownlinkEncoderVectors = append(req.DownlinkEncoderVectors, vector)
+	}
+	return req, nil
+}
+
+func (dr *DeviceRepository) handleTestFormatters(w http.ResponseWriter, r *http.Request) {
+	if err := rights.RequireAuthentication(r.Context()); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	req, err := decodeFormatterTestRequest(r, w)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	res, err := testFormatters(r.Context(), dr.store, req)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(res)
+}
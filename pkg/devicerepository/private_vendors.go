@@ -0,0 +1,89 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+func privateVendorToPB(v remote.Vendor) *ttnpb.DeviceRepositoryPrivateVendor {
+	return &ttnpb.DeviceRepositoryPrivateVendor{
+		BrandId:              v.ID,
+		Name:                 v.Name,
+		LoraAllianceVendorId: v.VendorID,
+		Draft:                v.Draft,
+	}
+}
+
+// privateVendorRegistryServer allows admins to list, upload and delete the vendors of the private vendor catalog.
+type privateVendorRegistryServer struct {
+	ttnpb.UnimplementedDeviceRepositoryPrivateVendorRegistryServer
+
+	catalog *private.Catalog
+}
+
+// List implements ttnpb.DeviceRepositoryPrivateVendorRegistryServer.
+func (s *privateVendorRegistryServer) List(
+	ctx context.Context, _ *emptypb.Empty,
+) (*ttnpb.DeviceRepositoryPrivateVendors, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	vendors, err := s.catalog.Vendors()
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.DeviceRepositoryPrivateVendors{
+		Vendors: make([]*ttnpb.DeviceRepositoryPrivateVendor, 0, len(vendors)),
+	}
+	for _, v := range vendors {
+		res.Vendors = append(res.Vendors, privateVendorToPB(v))
+	}
+	return res, nil
+}
+
+// Upload implements ttnpb.DeviceRepositoryPrivateVendorRegistryServer.
+func (s *privateVendorRegistryServer) Upload(
+	ctx context.Context, req *ttnpb.UploadDeviceRepositoryPrivateVendorRequest,
+) (*ttnpb.DeviceRepositoryPrivateVendor, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	vendor, err := s.catalog.Upload(req.BrandId, bytes.NewReader(req.Archive))
+	if err != nil {
+		return nil, err
+	}
+	return privateVendorToPB(*vendor), nil
+}
+
+// Delete implements ttnpb.DeviceRepositoryPrivateVendorRegistryServer.
+func (s *privateVendorRegistryServer) Delete(
+	ctx context.Context, req *ttnpb.DeleteDeviceRepositoryPrivateVendorRequest,
+) (*emptypb.Empty, error) {
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if err := s.catalog.Delete(req.BrandId); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
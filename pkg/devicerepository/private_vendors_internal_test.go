@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicerepository
+
+import (
+	"context"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestPrivateVendorRegistry(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	srv := &privateVendorRegistryServer{
+		catalog: private.Config{Directory: t.TempDir()}.Catalog(),
+	}
+
+	userCtx := rights.NewContextWithAuthInfo(context.Background(), &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_INFO),
+	})
+	_, err := srv.List(userCtx, ttnpb.Empty)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+	_, err = srv.Upload(userCtx, &ttnpb.UploadDeviceRepositoryPrivateVendorRequest{
+		BrandId: "foo-vendor",
+		Archive: []byte("archive"),
+	})
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	ctx := rights.NewContextWithAuthInfo(context.Background(), &ttnpb.AuthInfoResponse{
+		UniversalRights: ttnpb.AllAdminRights.Implied(),
+		IsAdmin:         true,
+	})
+	vendors, err := srv.List(ctx, ttnpb.Empty)
+	if a.So(err, should.BeNil) {
+		a.So(vendors.Vendors, should.BeEmpty)
+	}
+	_, err = srv.Upload(ctx, &ttnpb.UploadDeviceRepositoryPrivateVendorRequest{
+		BrandId: "foo-vendor",
+		Archive: []byte("not a gzipped tar archive"),
+	})
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	_, err = srv.Delete(ctx, &ttnpb.DeleteDeviceRepositoryPrivateVendorRequest{BrandId: "foo-vendor"})
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
@@ -0,0 +1,343 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package private
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// VendorFileName is the name of the file in a vendor archive that contains the vendor information.
+	VendorFileName = "vendor.yaml"
+	// MaxArchiveSize is the maximum size of an uncompressed vendor archive.
+	MaxArchiveSize = 32 << 20 // 32 MB.
+
+	vendorDirectory = "vendor"
+	indexFileName   = "index.yaml"
+)
+
+var (
+	errInvalidArchive   = errors.DefineInvalidArgument("invalid_archive", "invalid vendor archive")
+	errArchiveTooLarge  = errors.DefineInvalidArgument("archive_too_large", "vendor archive larger than `{max}` bytes")
+	errInvalidFileName  = errors.DefineInvalidArgument("invalid_file_name", "invalid file name `{file_name}`")
+	errMissingFile      = errors.DefineInvalidArgument("missing_file", "missing file `{file_name}`")
+	errInvalidFile      = errors.DefineInvalidArgument("invalid_file", "invalid file `{file_name}`")
+	errInvalidVendorID  = errors.DefineInvalidArgument("invalid_vendor_id", "invalid vendor ID `{vendor_id}`")
+	errInvalidModelID   = errors.DefineInvalidArgument("invalid_model_id", "invalid model ID `{model_id}`")
+	errVendorIDMismatch = errors.DefineInvalidArgument("vendor_id_mismatch",
+		"vendor ID `{vendor_id}` in `vendor.yaml` does not match `{expected}`",
+	)
+	errExternalProfile = errors.DefineInvalidArgument("external_profile",
+		"profile `{profile_id}` of model `{model_id}` refers to vendor `{profile_vendor_id}`",
+	)
+	errVendorNotFound = errors.DefineNotFound("vendor_not_found", "vendor `{vendor_id}` not found")
+)
+
+// Catalog is a private vendor catalog on the local filesystem.
+//
+// The catalog uses the layout of the Device Repository: vendor/index.yaml lists the vendors, and vendor/<vendor-id>
+// contains the end device index, models, profiles and codecs of each vendor.
+type Catalog struct {
+	directory string
+
+	mu sync.Mutex // Serializes writes.
+}
+
+// NewCatalog returns a private vendor catalog in the given directory.
+func NewCatalog(directory string) *Catalog {
+	return &Catalog{
+		directory: directory,
+	}
+}
+
+// Store returns a store that reads from the catalog.
+func (c *Catalog) Store() store.Store {
+	return remote.NewRemoteStore(fetch.FromFilesystem(c.directory))
+}
+
+func (c *Catalog) indexPath() string {
+	return filepath.Join(c.directory, vendorDirectory, indexFileName)
+}
+
+// Vendors returns the vendors in the catalog.
+func (c *Catalog) Vendors() ([]remote.Vendor, error) {
+	b, err := os.ReadFile(c.indexPath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	index := remote.VendorsIndex{}
+	if err := yaml.Unmarshal(b, &index); err != nil {
+		return nil, err
+	}
+	return index.Vendors, nil
+}
+
+// Upload validates the gzipped tar archive of a vendor and adds the vendor to the catalog.
+// If the vendor already exists in the catalog, the vendor is replaced.
+//
+// The archive contains the vendor information in vendor.yaml, the end device index in index.yaml, and the models,
+// profiles and codecs that are referenced by the end device index, all in the root of the archive.
+func (c *Catalog) Upload(vendorID string, r io.Reader) (*remote.Vendor, error) {
+	files, err := readArchive(r)
+	if err != nil {
+		return nil, err
+	}
+	vendor, err := validateVendor(vendorID, files)
+	if err != nil {
+		return nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vendors, err := c.Vendors()
+	if err != nil {
+		return nil, err
+	}
+	root := filepath.Join(c.directory, vendorDirectory)
+	tmp := filepath.Join(root, "."+vendorID+".upload")
+	if err := os.RemoveAll(tmp); err != nil {
+		return nil, err
+	}
+	if err := os.MkdirAll(tmp, 0o755); err != nil {
+		return nil, err
+	}
+	for name, b := range files {
+		if name == VendorFileName {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(tmp, name), b, 0o644); err != nil {
+			return nil, err
+		}
+	}
+	dst := filepath.Join(root, vendorID)
+	if err := os.RemoveAll(dst); err != nil {
+		return nil, err
+	}
+	if err := os.Rename(tmp, dst); err != nil {
+		return nil, err
+	}
+
+	replaced := false
+	for i, v := range vendors {
+		if v.ID == vendorID {
+			vendors[i], replaced = *vendor, true
+		}
+	}
+	if !replaced {
+		vendors = append(vendors, *vendor)
+	}
+	if err := c.writeIndex(vendors); err != nil {
+		return nil, err
+	}
+	return vendor, nil
+}
+
+// Delete removes the vendor from the catalog.
+func (c *Catalog) Delete(vendorID string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	vendors, err := c.Vendors()
+	if err != nil {
+		return err
+	}
+	remaining := make([]remote.Vendor, 0, len(vendors))
+	for _, v := range vendors {
+		if v.ID != vendorID {
+			remaining = append(remaining, v)
+		}
+	}
+	if len(remaining) == len(vendors) {
+		return errVendorNotFound.WithAttributes("vendor_id", vendorID)
+	}
+	if err := c.writeIndex(remaining); err != nil {
+		return err
+	}
+	return os.RemoveAll(filepath.Join(c.directory, vendorDirectory, vendorID))
+}
+
+// writeIndex replaces the vendor index atomically.
+func (c *Catalog) writeIndex(vendors []remote.Vendor) error {
+	b, err := yaml.Marshal(remote.VendorsIndex{Vendors: vendors})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(c.indexPath()), 0o755); err != nil {
+		return err
+	}
+	tmp := c.indexPath() + ".tmp"
+	if err := os.WriteFile(tmp, b, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, c.indexPath())
+}
+
+// readArchive reads the files of the gzipped tar archive.
+// Only flat YAML and JavaScript files are allowed.
+func readArchive(r io.Reader) (map[string][]byte, error) {
+	gz, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, errInvalidArchive.WithCause(err)
+	}
+	defer gz.Close()
+	lr := &io.LimitedReader{R: gz, N: MaxArchiveSize + 1}
+	tr := tar.NewReader(lr)
+	files := make(map[string][]byte)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			if lr.N <= 0 {
+				return nil, errArchiveTooLarge.WithAttributes("max", MaxArchiveSize)
+			}
+			return nil, errInvalidArchive.WithCause(err)
+		}
+		switch hdr.Typeflag {
+		case tar.TypeDir:
+			continue
+		case tar.TypeReg:
+		default:
+			return nil, errInvalidFileName.WithAttributes("file_name", hdr.Name)
+		}
+		name := strings.TrimPrefix(path.Clean(hdr.Name), "./")
+		if strings.ContainsAny(name, `/\`) || strings.HasPrefix(name, ".") ||
+			(path.Ext(name) != ".yaml" && path.Ext(name) != ".js") {
+			return nil, errInvalidFileName.WithAttributes("file_name", hdr.Name)
+		}
+		b, err := io.ReadAll(tr)
+		if err != nil {
+			return nil, errInvalidArchive.WithCause(err)
+		}
+		if lr.N <= 0 {
+			return nil, errArchiveTooLarge.WithAttributes("max", MaxArchiveSize)
+		}
+		files[name] = b
+	}
+	return files, nil
+}
+
+// validateVendor validates the files of a vendor against the Device Repository schema.
+// All models, profiles, codecs and codec scripts that are referenced must be present.
+func validateVendor(vendorID string, files map[string][]byte) (*remote.Vendor, error) {
+	if vendorID == "" {
+		return nil, errInvalidVendorID.WithAttributes("vendor_id", vendorID)
+	}
+	if err := (&ttnpb.EndDeviceVersionIdentifiers{BrandId: vendorID}).ValidateFields("brand_id"); err != nil {
+		return nil, errInvalidVendorID.WithAttributes("vendor_id", vendorID).WithCause(err)
+	}
+	unmarshal := func(name string, v any) error {
+		b, ok := files[name]
+		if !ok {
+			return errMissingFile.WithAttributes("file_name", name)
+		}
+		if err := yaml.Unmarshal(b, v); err != nil {
+			return errInvalidFile.WithAttributes("file_name", name).WithCause(err)
+		}
+		return nil
+	}
+
+	vendor := &remote.Vendor{}
+	if err := unmarshal(VendorFileName, vendor); err != nil {
+		return nil, err
+	}
+	if vendor.ID == "" {
+		vendor.ID = vendorID
+	}
+	if vendor.ID != vendorID {
+		return nil, errVendorIDMismatch.WithAttributes(
+			"vendor_id", vendor.ID,
+			"expected", vendorID,
+		)
+	}
+	if _, err := vendor.ToPB(ttnpb.EndDeviceBrandFieldPathsNested...); err != nil {
+		return nil, errInvalidFile.WithAttributes("file_name", VendorFileName).WithCause(err)
+	}
+
+	index := remote.VendorEndDevicesIndex{}
+	if err := unmarshal(indexFileName, &index); err != nil {
+		return nil, err
+	}
+	for _, modelID := range index.EndDevices {
+		if modelID == "" {
+			return nil, errInvalidModelID.WithAttributes("model_id", modelID)
+		}
+		if err := (&ttnpb.EndDeviceVersionIdentifiers{ModelId: modelID}).ValidateFields("model_id"); err != nil {
+			return nil, errInvalidModelID.WithAttributes("model_id", modelID).WithCause(err)
+		}
+		name := modelID + ".yaml"
+		model := remote.EndDeviceModel{}
+		if err := unmarshal(name, &model); err != nil {
+			return nil, err
+		}
+		pb, err := model.ToPB(vendorID, modelID, ttnpb.EndDeviceModelFieldPathsNested...)
+		if err != nil {
+			return nil, errInvalidFile.WithAttributes("file_name", name).WithCause(err)
+		}
+		for _, fwVersion := range pb.FirmwareVersions {
+			for _, profile := range fwVersion.Profiles {
+				if profile.VendorId != "" && profile.VendorId != vendorID {
+					return nil, errExternalProfile.WithAttributes(
+						"profile_id", profile.ProfileId,
+						"model_id", modelID,
+						"profile_vendor_id", profile.VendorId,
+					)
+				}
+				if err := unmarshal(profile.ProfileId+".yaml", &store.EndDeviceProfile{}); err != nil {
+					return nil, err
+				}
+				if profile.CodecId == "" {
+					continue
+				}
+				codecs := remote.EndDeviceCodecs{}
+				if err := unmarshal(profile.CodecId+".yaml", &codecs); err != nil {
+					return nil, err
+				}
+				for _, fileName := range []string{
+					codecs.UplinkDecoder.FileName,
+					codecs.DownlinkDecoder.FileName,
+					codecs.DownlinkEncoder.FileName,
+				} {
+					if fileName == "" {
+						continue
+					}
+					if _, ok := files[fileName]; !ok {
+						return nil, errMissingFile.WithAttributes("file_name", fileName)
+					}
+				}
+			}
+		}
+	}
+	return vendor, nil
+}
@@ -0,0 +1,213 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package private_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+// vendorFiles returns the files of the foo-vendor vendor of the remote store test data, with the given vendor.yaml.
+func vendorFiles(t *testing.T, vendor string) map[string][]byte {
+	t.Helper()
+	dir := filepath.Join("..", "remote", "testdata", "vendor", "foo-vendor")
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string][]byte{
+		private.VendorFileName: []byte(vendor),
+	}
+	for _, entry := range entries {
+		b, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[entry.Name()] = b
+	}
+	return files
+}
+
+// makeArchive returns a gzipped tar archive with the given files.
+func makeArchive(t *testing.T, files map[string][]byte) *bytes.Buffer {
+	t.Helper()
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gz)
+	for name, b := range files {
+		if err := tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(b)),
+			Typeflag: tar.TypeReg,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write(b); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf
+}
+
+func TestCatalog(t *testing.T) {
+	a := assertions.New(t)
+
+	dir := t.TempDir()
+	c := private.NewCatalog(dir)
+
+	vendors, err := c.Vendors()
+	a.So(err, should.BeNil)
+	a.So(vendors, should.BeEmpty)
+
+	for _, tc := range []struct {
+		Name     string
+		VendorID string
+		Files    func(map[string][]byte)
+		Assert   func(error) bool
+	}{
+		{
+			Name:     "InvalidVendorID",
+			VendorID: "Bar_Vendor",
+			Assert:   errors.IsInvalidArgument,
+		},
+		{
+			Name:     "VendorIDMismatch",
+			VendorID: "other-vendor",
+			Assert:   errors.IsInvalidArgument,
+		},
+		{
+			Name:     "MissingIndex",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				delete(files, "index.yaml")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "MissingModel",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				delete(files, "dev2.yaml")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "MissingProfile",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				delete(files, "profile1.yaml")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "MissingCodecScript",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				delete(files, "b.js")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "InvalidModel",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				files["dev1.yaml"] = []byte("firmwareVersions: invalid")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "InvalidFileName",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				files["../dev3.yaml"] = []byte("name: Device 3")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:     "InvalidFileType",
+			VendorID: "bar-vendor",
+			Files: func(files map[string][]byte) {
+				files["run.sh"] = []byte("#!/bin/sh")
+			},
+			Assert: errors.IsInvalidArgument,
+		},
+	} {
+		t.Run(tc.Name, func(t *testing.T) {
+			a := assertions.New(t)
+			files := vendorFiles(t, "id: bar-vendor\nname: Bar Vendor\n")
+			if tc.Files != nil {
+				tc.Files(files)
+			}
+			_, err := c.Upload(tc.VendorID, makeArchive(t, files))
+			a.So(tc.Assert(err), should.BeTrue)
+		})
+	}
+
+	_, err = c.Upload("bar-vendor", bytes.NewBufferString("not an archive"))
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	vendors, err = c.Vendors()
+	a.So(err, should.BeNil)
+	a.So(vendors, should.BeEmpty)
+
+	vendor, err := c.Upload("bar-vendor", makeArchive(t, vendorFiles(t, "id: bar-vendor\nname: Bar Vendor\n")))
+	a.So(err, should.BeNil)
+	a.So(vendor, should.Resemble, &remote.Vendor{ID: "bar-vendor", Name: "Bar Vendor"})
+
+	// The vendor ID defaults to the uploaded vendor ID.
+	_, err = c.Upload("baz-vendor", makeArchive(t, vendorFiles(t, "name: Baz Vendor\n")))
+	a.So(err, should.BeNil)
+
+	// Uploading an existing vendor replaces the vendor.
+	_, err = c.Upload("bar-vendor", makeArchive(t, vendorFiles(t, "name: New Bar Vendor\nvendorID: 43\n")))
+	a.So(err, should.BeNil)
+
+	vendors, err = c.Vendors()
+	a.So(err, should.BeNil)
+	a.So(vendors, should.Resemble, []remote.Vendor{
+		{ID: "bar-vendor", Name: "New Bar Vendor", VendorID: 43},
+		{ID: "baz-vendor", Name: "Baz Vendor"},
+	})
+	_, err = os.Stat(filepath.Join(dir, "vendor", "bar-vendor", private.VendorFileName))
+	a.So(os.IsNotExist(err), should.BeTrue)
+
+	a.So(c.Delete("bar-vendor"), should.BeNil)
+	a.So(errors.IsNotFound(c.Delete("bar-vendor")), should.BeTrue)
+
+	vendors, err = c.Vendors()
+	a.So(err, should.BeNil)
+	a.So(vendors, should.Resemble, []remote.Vendor{
+		{ID: "baz-vendor", Name: "Baz Vendor"},
+	})
+	_, err = os.Stat(filepath.Join(dir, "vendor", "bar-vendor"))
+	a.So(os.IsNotExist(err), should.BeTrue)
+}
@@ -0,0 +1,29 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package private implements a private vendor catalog that extends the public Device Repository.
+package private
+
+// Config is the configuration of the private vendor catalog.
+type Config struct {
+	Directory string `name:"directory" description:"OS filesystem directory of the private vendor catalog (disabled when empty)"` //nolint:lll
+}
+
+// Catalog returns the private vendor catalog, or nil if the private vendor catalog is disabled.
+func (c Config) Catalog() *Catalog {
+	if c.Directory == "" {
+		return nil
+	}
+	return NewCatalog(c.Directory)
+}
@@ -0,0 +1,403 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package private
+
+import (
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// mergedStore merges the private vendor catalog with the public Device Repository.
+type mergedStore struct {
+	public  store.Store
+	private store.Store
+	catalog *Catalog
+}
+
+// NewStore returns a store that merges the private vendor catalog with the public Device Repository store.
+//
+// Vendors in the private catalog take precedence over brands in the public store with the same brand ID: the
+// brand, models, profiles, templates and codecs of such a brand are served from the private catalog only.
+// Listings contain the brands and models of both, with the private ones first unless an order is requested.
+// End device profiles that are looked up by vendor ID and vendor profile ID are looked up in the private catalog
+// first.
+func NewStore(public store.Store, catalog *Catalog) store.Store {
+	return &mergedStore{
+		public:  public,
+		private: catalog.Store(),
+		catalog: catalog,
+	}
+}
+
+// privateBrands returns the brand IDs of the vendors in the private catalog.
+func (s *mergedStore) privateBrands() (map[string]bool, error) {
+	vendors, err := s.catalog.Vendors()
+	if err != nil {
+		return nil, err
+	}
+	ids := make(map[string]bool, len(vendors))
+	for _, vendor := range vendors {
+		if vendor.Draft {
+			continue
+		}
+		ids[vendor.ID] = true
+	}
+	return ids, nil
+}
+
+// matchesSearch returns whether any of the values contains the search query, case-insensitively.
+// The private catalog is not indexed, so searching is limited to the identifiers and names.
+func matchesSearch(search string, values ...string) bool {
+	if search == "" {
+		return true
+	}
+	search = strings.ToLower(search)
+	for _, v := range values {
+		if strings.Contains(strings.ToLower(v), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// collectPublic lists consecutive pages of the public store until n items are collected that are not shadowed by
+// the private catalog, or until the public store has no more items. If n is zero, a single unlimited page is listed.
+// It returns the collected items and the total number of public items, excluding the shadowed items that are seen.
+func collectPublic[T any](
+	n uint32, list func(limit, page uint32) ([]T, uint32, error), shadowed func(T) bool,
+) ([]T, uint32, error) {
+	var res []T
+	var total, seen uint32
+	for page := uint32(1); ; page++ {
+		items, pageTotal, err := list(n, page)
+		if err != nil {
+			return nil, 0, err
+		}
+		total = pageTotal
+		for _, item := range items {
+			if shadowed(item) {
+				total--
+				continue
+			}
+			res = append(res, item)
+		}
+		seen += uint32(len(items))
+		if n == 0 || len(items) == 0 || uint32(len(res)) >= n || seen >= pageTotal {
+			return res, total, nil
+		}
+	}
+}
+
+// limitOf returns the number of items that are needed to return the page.
+func limitOf(limit, page uint32) uint32 {
+	if page == 0 {
+		page = 1
+	}
+	return limit * page
+}
+
+// mergePage merges the private and public items and returns the offset and the items of the requested page.
+// If less is nil, the private items come first.
+func mergePage[T any](private, public []T, less func(a, b T) bool, limit, page uint32) (uint32, []T) {
+	all := make([]T, 0, len(private)+len(public))
+	all = append(all, private...)
+	all = append(all, public...)
+	if less != nil {
+		sort.SliceStable(all, func(i, j int) bool { return less(all[i], all[j]) })
+	}
+	if page == 0 {
+		page = 1
+	}
+	start, end := (page-1)*limit, uint32(len(all))
+	if start >= end {
+		return 0, nil
+	}
+	if limit > 0 && start+limit < end {
+		end = start + limit
+	}
+	return start, all[start:end]
+}
+
+func brandLess(orderBy string) func(a, b *ttnpb.EndDeviceBrand) bool {
+	switch orderBy {
+	case "brand_id":
+		return func(a, b *ttnpb.EndDeviceBrand) bool { return a.BrandId < b.BrandId }
+	case "-brand_id":
+		return func(a, b *ttnpb.EndDeviceBrand) bool { return a.BrandId > b.BrandId }
+	case "name":
+		return func(a, b *ttnpb.EndDeviceBrand) bool { return a.Name < b.Name }
+	case "-name":
+		return func(a, b *ttnpb.EndDeviceBrand) bool { return a.Name > b.Name }
+	default:
+		return nil
+	}
+}
+
+func modelLess(orderBy string) func(a, b *ttnpb.EndDeviceModel) bool {
+	switch orderBy {
+	case "brand_id":
+		return func(a, b *ttnpb.EndDeviceModel) bool { return a.BrandId < b.BrandId }
+	case "-brand_id":
+		return func(a, b *ttnpb.EndDeviceModel) bool { return a.BrandId > b.BrandId }
+	case "model_id":
+		return func(a, b *ttnpb.EndDeviceModel) bool { return a.ModelId < b.ModelId }
+	case "-model_id":
+		return func(a, b *ttnpb.EndDeviceModel) bool { return a.ModelId > b.ModelId }
+	default:
+		return nil
+	}
+}
+
+// GetBrands implements store.Store.
+func (s *mergedStore) GetBrands(req store.GetBrandsRequest) (*store.GetBrandsResponse, error) {
+	privateBrands, err := s.privateBrands()
+	if err != nil {
+		return nil, err
+	}
+	if len(privateBrands) == 0 || (req.BrandID != "" && !privateBrands[req.BrandID]) {
+		return s.public.GetBrands(req)
+	}
+
+	paths := ttnpb.AddFields(req.Paths, "brand_id", "name")
+	res, err := s.private.GetBrands(store.GetBrandsRequest{
+		Paths: paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	private := make([]*ttnpb.EndDeviceBrand, 0, len(res.Brands))
+	for _, brand := range res.Brands {
+		if (req.BrandID == "" || brand.BrandId == req.BrandID) && matchesSearch(req.Search, brand.BrandId, brand.Name) {
+			private = append(private, brand)
+		}
+	}
+
+	var (
+		public      []*ttnpb.EndDeviceBrand
+		publicTotal uint32
+	)
+	if req.BrandID == "" {
+		public, publicTotal, err = collectPublic(
+			limitOf(req.Limit, req.Page),
+			func(limit, page uint32) ([]*ttnpb.EndDeviceBrand, uint32, error) {
+				publicReq := req
+				publicReq.Paths, publicReq.Limit, publicReq.Page = paths, limit, page
+				res, err := s.public.GetBrands(publicReq)
+				if err != nil {
+					return nil, 0, err
+				}
+				return res.Brands, res.Total, nil
+			},
+			func(brand *ttnpb.EndDeviceBrand) bool { return privateBrands[brand.BrandId] },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	offset, brands := mergePage(private, public, brandLess(req.OrderBy), req.Limit, req.Page)
+	for i, brand := range brands {
+		pb := &ttnpb.EndDeviceBrand{}
+		if err := pb.SetFields(brand, req.Paths...); err != nil {
+			return nil, err
+		}
+		brands[i] = pb
+	}
+	return &store.GetBrandsResponse{
+		Count:  uint32(len(brands)),
+		Offset: offset,
+		Total:  uint32(len(private)) + publicTotal,
+		Brands: brands,
+	}, nil
+}
+
+// GetModels implements store.Store.
+func (s *mergedStore) GetModels(req store.GetModelsRequest) (*store.GetModelsResponse, error) {
+	privateBrands, err := s.privateBrands()
+	if err != nil {
+		return nil, err
+	}
+	if len(privateBrands) == 0 || (req.BrandID != "" && !privateBrands[req.BrandID]) {
+		return s.public.GetModels(req)
+	}
+
+	paths := ttnpb.AddFields(req.Paths, "brand_id", "model_id", "name")
+	res, err := s.private.GetModels(store.GetModelsRequest{
+		BrandID: req.BrandID,
+		ModelID: req.ModelID,
+		Paths:   paths,
+	})
+	if err != nil {
+		return nil, err
+	}
+	private := make([]*ttnpb.EndDeviceModel, 0, len(res.Models))
+	for _, model := range res.Models {
+		if (req.ModelID == "" || model.ModelId == req.ModelID) &&
+			matchesSearch(req.Search, model.BrandId, model.ModelId, model.Name) {
+			private = append(private, model)
+		}
+	}
+
+	var (
+		public      []*ttnpb.EndDeviceModel
+		publicTotal uint32
+	)
+	if req.BrandID == "" {
+		public, publicTotal, err = collectPublic(
+			limitOf(req.Limit, req.Page),
+			func(limit, page uint32) ([]*ttnpb.EndDeviceModel, uint32, error) {
+				publicReq := req
+				publicReq.Paths, publicReq.Limit, publicReq.Page = paths, limit, page
+				res, err := s.public.GetModels(publicReq)
+				if err != nil {
+					return nil, 0, err
+				}
+				return res.Models, res.Total, nil
+			},
+			func(model *ttnpb.EndDeviceModel) bool { return privateBrands[model.BrandId] },
+		)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	offset, models := mergePage(private, public, modelLess(req.OrderBy), req.Limit, req.Page)
+	for i, model := range models {
+		pb := &ttnpb.EndDeviceModel{}
+		if err := pb.SetFields(model, req.Paths...); err != nil {
+			return nil, err
+		}
+		models[i] = pb
+	}
+	return &store.GetModelsResponse{
+		Count:  uint32(len(models)),
+		Offset: offset,
+		Total:  uint32(len(private)) + publicTotal,
+		Models: models,
+	}, nil
+}
+
+// GetEndDeviceProfiles implements store.Store.
+func (s *mergedStore) GetEndDeviceProfiles(
+	req store.GetEndDeviceProfilesRequest,
+) (*store.GetEndDeviceProfilesResponse, error) {
+	privateBrands, err := s.privateBrands()
+	if err != nil {
+		return nil, err
+	}
+	if req.BrandID == "" || !privateBrands[req.BrandID] {
+		return s.public.GetEndDeviceProfiles(req)
+	}
+	return s.private.GetEndDeviceProfiles(req)
+}
+
+// privateProfile returns the profile in the private catalog with the given vendor ID and vendor profile ID.
+// If the vendor ID is zero, the profiles of all vendors in the private catalog are considered.
+func (s *mergedStore) privateProfile(
+	ids *ttnpb.GetTemplateRequest_EndDeviceProfileIdentifiers,
+) (brandID string, profile *store.EndDeviceProfile, err error) {
+	vendors, err := s.catalog.Vendors()
+	if err != nil {
+		return "", nil, err
+	}
+	for _, vendor := range vendors {
+		if vendor.Draft || (ids.VendorId != 0 && vendor.VendorID != ids.VendorId) {
+			continue
+		}
+		res, err := s.private.GetEndDeviceProfiles(store.GetEndDeviceProfilesRequest{
+			BrandID: vendor.ID,
+		})
+		if err != nil {
+			return "", nil, err
+		}
+		for _, profile := range res.Profiles {
+			if profile.VendorProfileID == ids.VendorProfileId {
+				return vendor.ID, profile, nil
+			}
+		}
+	}
+	return "", nil, nil
+}
+
+// GetTemplate implements store.Store.
+func (s *mergedStore) GetTemplate(
+	req *ttnpb.GetTemplateRequest, profile *store.EndDeviceProfile,
+) (*ttnpb.EndDeviceTemplate, error) {
+	if ids := req.GetEndDeviceProfileIds(); ids != nil && req.VersionIds == nil {
+		brandID, privateProfile, err := s.privateProfile(ids)
+		if err != nil {
+			return nil, err
+		}
+		if privateProfile != nil {
+			req.VersionIds = &ttnpb.EndDeviceVersionIdentifiers{
+				BrandId: brandID,
+			}
+			return s.private.GetTemplate(req, privateProfile)
+		}
+		return s.public.GetTemplate(req, profile)
+	}
+	st, err := s.storeOf(req.GetVersionIds())
+	if err != nil {
+		return nil, err
+	}
+	return st.GetTemplate(req, profile)
+}
+
+// storeOf returns the store that contains the brand of the end device version.
+func (s *mergedStore) storeOf(ids *ttnpb.EndDeviceVersionIdentifiers) (store.Store, error) {
+	privateBrands, err := s.privateBrands()
+	if err != nil {
+		return nil, err
+	}
+	if privateBrands[ids.GetBrandId()] {
+		return s.private, nil
+	}
+	return s.public, nil
+}
+
+// GetUplinkDecoder implements store.Store.
+func (s *mergedStore) GetUplinkDecoder(req store.GetCodecRequest) (*ttnpb.MessagePayloadDecoder, error) {
+	st, err := s.storeOf(req.GetVersionIds())
+	if err != nil {
+		return nil, err
+	}
+	return st.GetUplinkDecoder(req)
+}
+
+// GetDownlinkDecoder implements store.Store.
+func (s *mergedStore) GetDownlinkDecoder(req store.GetCodecRequest) (*ttnpb.MessagePayloadDecoder, error) {
+	st, err := s.storeOf(req.GetVersionIds())
+	if err != nil {
+		return nil, err
+	}
+	return st.GetDownlinkDecoder(req)
+}
+
+// GetDownlinkEncoder implements store.Store.
+func (s *mergedStore) GetDownlinkEncoder(req store.GetCodecRequest) (*ttnpb.MessagePayloadEncoder, error) {
+	st, err := s.storeOf(req.GetVersionIds())
+	if err != nil {
+		return nil, err
+	}
+	return st.GetDownlinkEncoder(req)
+}
+
+// Close implements store.Store.
+func (s *mergedStore) Close() error {
+	return s.public.Close()
+}
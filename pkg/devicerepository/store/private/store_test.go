@@ -0,0 +1,173 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package private_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/private"
+	"go.thethings.network/lorawan-stack/v3/pkg/devicerepository/store/remote"
+	"go.thethings.network/lorawan-stack/v3/pkg/fetch"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestStore(t *testing.T) {
+	a := assertions.New(t)
+
+	c := private.NewCatalog(t.TempDir())
+	public := remote.NewRemoteStore(fetch.FromFilesystem("../remote/testdata"))
+	s := private.NewStore(public, c)
+
+	brandPaths := []string{"brand_id", "name"}
+
+	// Without private vendors, the public store is used as is.
+	res, err := s.GetBrands(store.GetBrandsRequest{Paths: brandPaths})
+	a.So(err, should.BeNil)
+	a.So(res.Brands, should.Resemble, []*ttnpb.EndDeviceBrand{
+		{BrandId: "foo-vendor", Name: "Foo Vendor"},
+		{BrandId: "full-vendor", Name: "Full Vendor"},
+	})
+
+	for vendorID, vendor := range map[string]string{
+		"foo-vendor": "name: Private Foo Vendor\n",
+		"bar-vendor": "name: Bar Vendor\nvendorID: 43\n",
+	} {
+		if _, err := c.Upload(vendorID, makeArchive(t, vendorFiles(t, vendor))); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+	vendors, err := c.Vendors()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	privateBrands := make([]*ttnpb.EndDeviceBrand, 0, len(vendors))
+	for _, vendor := range vendors {
+		privateBrands = append(privateBrands, &ttnpb.EndDeviceBrand{BrandId: vendor.ID, Name: vendor.Name})
+	}
+
+	t.Run("GetBrands", func(t *testing.T) {
+		for _, tc := range []struct {
+			Name     string
+			Request  store.GetBrandsRequest
+			Expected []*ttnpb.EndDeviceBrand
+			Total    uint32
+		}{
+			{
+				Name:     "All",
+				Request:  store.GetBrandsRequest{Paths: brandPaths},
+				Expected: append(privateBrands, &ttnpb.EndDeviceBrand{BrandId: "full-vendor", Name: "Full Vendor"}),
+				Total:    3,
+			},
+			{
+				Name:    "OrderByBrandID",
+				Request: store.GetBrandsRequest{Paths: brandPaths, OrderBy: "brand_id"},
+				Expected: []*ttnpb.EndDeviceBrand{
+					{BrandId: "bar-vendor", Name: "Bar Vendor"},
+					{BrandId: "foo-vendor", Name: "Private Foo Vendor"},
+					{BrandId: "full-vendor", Name: "Full Vendor"},
+				},
+				Total: 3,
+			},
+			{
+				Name:     "Page",
+				Request:  store.GetBrandsRequest{Paths: brandPaths, OrderBy: "brand_id", Limit: 2, Page: 2},
+				Expected: []*ttnpb.EndDeviceBrand{{BrandId: "full-vendor", Name: "Full Vendor"}},
+				Total:    3,
+			},
+			{
+				Name:     "PrivateBrand",
+				Request:  store.GetBrandsRequest{Paths: brandPaths, BrandID: "foo-vendor"},
+				Expected: []*ttnpb.EndDeviceBrand{{BrandId: "foo-vendor", Name: "Private Foo Vendor"}},
+				Total:    1,
+			},
+			{
+				// The remote store does not support searching, so only the private brands are filtered.
+				Name:    "Search",
+				Request: store.GetBrandsRequest{Paths: []string{"brand_id"}, Search: "bar"},
+				Expected: []*ttnpb.EndDeviceBrand{
+					{BrandId: "bar-vendor"},
+					{BrandId: "full-vendor"},
+				},
+				Total: 2,
+			},
+		} {
+			t.Run(tc.Name, func(t *testing.T) {
+				a := assertions.New(t)
+				res, err := s.GetBrands(tc.Request)
+				if !a.So(err, should.BeNil) {
+					t.FailNow()
+				}
+				a.So(res.Brands, should.Resemble, tc.Expected)
+				a.So(res.Total, should.Equal, tc.Total)
+			})
+		}
+	})
+
+	t.Run("GetModels", func(t *testing.T) {
+		a := assertions.New(t)
+		res, err := s.GetModels(store.GetModelsRequest{
+			Paths:   []string{"brand_id", "model_id"},
+			OrderBy: "brand_id",
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		brands := make(map[string]int)
+		for _, model := range res.Models {
+			brands[model.BrandId]++
+		}
+		a.So(brands, should.Resemble, map[string]int{
+			"bar-vendor":  2,
+			"foo-vendor":  2,
+			"full-vendor": 1,
+		})
+		a.So(res.Total, should.Equal, 5)
+		a.So(res.Models[0].BrandId, should.Equal, "bar-vendor")
+	})
+
+	t.Run("GetUplinkDecoder", func(t *testing.T) {
+		a := assertions.New(t)
+		req := &ttnpb.GetPayloadFormatterRequest{
+			VersionIds: &ttnpb.EndDeviceVersionIdentifiers{
+				BrandId:         "bar-vendor",
+				ModelId:         "dev1",
+				FirmwareVersion: "1.0",
+				BandId:          "US_902_928",
+			},
+		}
+		codec, err := s.GetUplinkDecoder(req)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(codec.Formatter, should.Equal, ttnpb.PayloadFormatter_FORMATTER_JAVASCRIPT)
+
+		req.VersionIds.BrandId = "unknown-vendor"
+		_, err = s.GetUplinkDecoder(req)
+		a.So(err, should.NotBeNil)
+	})
+
+	// Deleting a private vendor exposes the public brand again.
+	a.So(c.Delete("foo-vendor"), should.BeNil)
+	res, err = s.GetBrands(store.GetBrandsRequest{Paths: brandPaths})
+	a.So(err, should.BeNil)
+	a.So(res.Brands, should.Resemble, []*ttnpb.EndDeviceBrand{
+		{BrandId: "bar-vendor", Name: "Bar Vendor"},
+		{BrandId: "foo-vendor", Name: "Foo Vendor"},
+		{BrandId: "full-vendor", Name: "Full Vendor"},
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplateconverter
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+var (
+	getEndDeviceFromIS = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.EndDeviceRegistry/Get"].Allowed
+	getEndDeviceFromNS = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.NsEndDeviceRegistry/Get"].Allowed
+	getEndDeviceFromAS = ttnpb.RPCFieldMaskPaths["/ttn.lorawan.v3.AsEndDeviceRegistry/Get"].Allowed
+)
+
+// getEndDevice returns the end device from the Identity Server, merged with the end device from the Network Server
+// and the Application Server. The Network Server and Application Server are only requested if the end device is
+// registered on them. The authentication of the caller is forwarded.
+func (dtc *DeviceTemplateConverter) getEndDevice(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, paths []string,
+) (*ttnpb.EndDevice, error) {
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, dtc.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+
+	isPaths := ttnpb.AddFields(
+		ttnpb.AllowedFields(paths, getEndDeviceFromIS),
+		"network_server_address", "application_server_address",
+	)
+	cc, err := dtc.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	dev, err := ttnpb.NewEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: ids,
+		FieldMask:    &fieldmaskpb.FieldMask{Paths: isPaths},
+	}, callOpt)
+	if err != nil {
+		return nil, err
+	}
+
+	if nsPaths := ttnpb.AllowedFields(paths, getEndDeviceFromNS); len(nsPaths) > 0 && dev.NetworkServerAddress != "" {
+		cc, err := dtc.GetPeerConn(ctx, ttnpb.ClusterRole_NETWORK_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		nsDev, err := ttnpb.NewNsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIds: ids,
+			FieldMask:    &fieldmaskpb.FieldMask{Paths: nsPaths},
+		}, callOpt)
+		if err != nil {
+			return nil, err
+		}
+		if err := dev.SetFields(nsDev, nsPaths...); err != nil {
+			return nil, err
+		}
+	}
+
+	if asPaths := ttnpb.AllowedFields(paths, getEndDeviceFromAS); len(asPaths) > 0 &&
+		dev.ApplicationServerAddress != "" {
+		cc, err := dtc.GetPeerConn(ctx, ttnpb.ClusterRole_APPLICATION_SERVER, nil)
+		if err != nil {
+			return nil, err
+		}
+		asDev, err := ttnpb.NewAsEndDeviceRegistryClient(cc).Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIds: ids,
+			FieldMask:    &fieldmaskpb.FieldMask{Paths: asPaths},
+		}, callOpt)
+		if err != nil {
+			return nil, err
+		}
+		if err := dev.SetFields(asDev, asPaths...); err != nil {
+			return nil, err
+		}
+	}
+	return dev, nil
+}
+
+// CreateTemplateFromDevice returns a template with the configuration of the end device, so that the configuration
+// can be applied to new end devices. See devicetemplates.FromDevice for the fields that are part of the template.
+func (dtc *DeviceTemplateConverter) CreateTemplateFromDevice(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (*ttnpb.EndDeviceTemplate, error) {
+	dev, err := dtc.getEndDevice(ctx, ids, devicetemplates.FromDeviceFieldPaths)
+	if err != nil {
+		return nil, err
+	}
+	return devicetemplates.FromDevice(dev)
+}
@@ -29,7 +29,8 @@ import (
 
 // DeviceTemplateConverter implements the Device Template Converter component.
 //
-// The Device Template Converter exposes the EndDeviceTemplateConverter service.
+// The Device Template Converter exposes the EndDeviceTemplateConverter service, and creates templates from existing
+// end devices.
 type DeviceTemplateConverter struct {
 	*component.Component
 	ctx context.Context
@@ -67,6 +68,7 @@ func New(c *component.Component, conf *Config) (*DeviceTemplateConverter, error)
 	dtc.grpc.endDeviceTemplateConverter = &endDeviceTemplateConverterServer{DTC: dtc}
 
 	c.RegisterGRPC(dtc)
+	c.RegisterWeb(dtc)
 	return dtc, nil
 }
 
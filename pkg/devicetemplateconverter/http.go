@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplateconverter
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+// RegisterRoutes registers the web frontend routes.
+//
+// The template route returns a template with the configuration of an existing end device, which can be used to
+// create new end devices with the same configuration.
+func (dtc *DeviceTemplateConverter) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/dtc").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("devicetemplateconverter")),
+		ratelimit.HTTPMiddleware(dtc.Component.RateLimiter(), "http:dtc"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+	router.HandleFunc(
+		"/applications/{application_id}/devices/{device_id}/template", dtc.handleCreateTemplateFromDevice,
+	).Methods(http.MethodGet)
+}
+
+func (dtc *DeviceTemplateConverter) handleCreateTemplateFromDevice(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: vars["application_id"]},
+		DeviceId:       vars["device_id"],
+	}
+	if err := ids.ValidateFields("application_ids", "device_id"); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	template, err := dtc.CreateTemplateFromDevice(r.Context(), ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	b, err := jsonpb.TTN().Marshal(template)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_, _ = w.Write(b)
+}
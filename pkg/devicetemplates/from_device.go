@@ -0,0 +1,65 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates
+
+import (
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// FromDeviceFieldPaths are the end device fields that are copied to a template by FromDevice.
+// Identifiers, keys, sessions, MAC state and activity information are specific to an end device and are never copied.
+var FromDeviceFieldPaths = []string{
+	"application_server_address",
+	"attributes",
+	"formatters",
+	"frequency_plan_id",
+	"join_server_address",
+	"locations",
+	"lora_alliance_profile_ids",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"multicast",
+	"network_server_address",
+	"service_profile_id",
+	"skip_payload_crypto_override",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+	"version_ids",
+}
+
+// FromDevice returns a template with the configuration of the end device, so that the configuration can be applied
+// to new end devices. Only the fields in FromDeviceFieldPaths that are set in the end device are part of the template.
+func FromDevice(dev *ttnpb.EndDevice) (*ttnpb.EndDeviceTemplate, error) {
+	msg := dev.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	paths := make([]string, 0, len(FromDeviceFieldPaths))
+	for _, path := range FromDeviceFieldPaths {
+		if msg.Has(fields.ByName(protoreflect.Name(path))) {
+			paths = append(paths, path)
+		}
+	}
+	template := &ttnpb.EndDevice{}
+	if err := template.SetFields(dev, paths...); err != nil {
+		return nil, err
+	}
+	return &ttnpb.EndDeviceTemplate{
+		EndDevice: template,
+		FieldMask: &fieldmaskpb.FieldMask{Paths: paths},
+	}, nil
+}
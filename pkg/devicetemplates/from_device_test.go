@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicetemplates_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/devicetemplates"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFromDevice(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	dev := &ttnpb.EndDevice{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+			DeviceId:       "test-dev",
+			DevEui:         types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+		},
+		Name:       "Test Device",
+		Attributes: map[string]string{"site": "factory"},
+		VersionIds: &ttnpb.EndDeviceVersionIdentifiers{
+			BrandId: "test-brand",
+			ModelId: "test-model",
+		},
+		FrequencyPlanId:   "EU_863_870",
+		LorawanVersion:    ttnpb.MACVersion_MAC_V1_0_3,
+		LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_0_3_REV_A,
+		SupportsJoin:      true,
+		Formatters: &ttnpb.MessagePayloadFormatters{
+			UpFormatter: ttnpb.PayloadFormatter_FORMATTER_REPOSITORY,
+		},
+		RootKeys: &ttnpb.RootKeys{
+			AppKey: &ttnpb.KeyEnvelope{Key: types.AES128Key{0x1}.Bytes()},
+		},
+		Session: &ttnpb.Session{},
+	}
+
+	template, err := FromDevice(dev)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(template.FieldMask.GetPaths(), should.Resemble, []string{
+		"attributes",
+		"formatters",
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"supports_join",
+		"version_ids",
+	})
+	a.So(template.EndDevice.Ids, should.BeNil)
+	a.So(template.EndDevice.Name, should.BeEmpty)
+	a.So(template.EndDevice.RootKeys, should.BeNil)
+	a.So(template.EndDevice.Session, should.BeNil)
+	a.So(template.EndDevice.Attributes, should.Resemble, dev.Attributes)
+	a.So(template.EndDevice.VersionIds, should.Resemble, dev.VersionIds)
+	a.So(template.EndDevice.FrequencyPlanId, should.Equal, "EU_863_870")
+	a.So(template.EndDevice.SupportsJoin, should.BeTrue)
+}
@@ -0,0 +1,50 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func init() {
+	tmpl, err := email.NewTemplateFS(
+		fsys, "email_change",
+		email.FSTemplate{
+			SubjectTemplate:      "Please confirm the change of your email address on {{ .Network.Name }}",
+			HTMLTemplateBaseFile: "base.html.tmpl",
+			HTMLTemplateFile:     "email_change.html.tmpl",
+			TextTemplateFile:     "email_change.txt.tmpl",
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	email.RegisterTemplate(tmpl)
+}
+
+// EmailChangeData is the data for the email_change email.
+// The email is sent to both the old and the new email address, each with their own token.
+type EmailChangeData struct {
+	email.TemplateData
+	UserIDs         *ttnpb.UserIdentifiers
+	OldEmailAddress string
+	NewEmailAddress string
+	ID              string
+	Token           string
+	TTL             time.Duration
+}
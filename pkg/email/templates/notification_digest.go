@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+import (
+	"fmt"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+func init() {
+	tmpl, err := email.NewTemplateFS(
+		fsys, "notification_digest",
+		email.FSTemplate{
+			SubjectTemplate:      "You have {{ len .Notifications }} new notification{{ if gt (len .Notifications) 1 }}s{{ end }} on {{ .Network.Name }}", //nolint:lll
+			HTMLTemplateBaseFile: "base.html.tmpl",
+			HTMLTemplateFile:     "notification_digest.html.tmpl",
+			TextTemplateFile:     "notification_digest.txt.tmpl",
+		},
+	)
+	if err != nil {
+		panic(err)
+	}
+	email.RegisterTemplate(tmpl)
+}
+
+// NotificationDigestData is the data for the notification_digest email.
+type NotificationDigestData struct {
+	email.TemplateData
+	Notifications []*ttnpb.Notification
+}
+
+var notificationSummaries = map[string]string{
+	"api_key_changed":      "API key changed",
+	"api_key_created":      "API key created",
+	"client_requested":     "OAuth client requires approval",
+	"collaborator_changed": "Collaborator changed",
+	"entity_state_changed": "State changed",
+	"password_changed":     "Password changed",
+	"user_requested":       "User requires approval",
+}
+
+// NotificationSummary returns a short summary of the notification type, such as "API key created".
+func NotificationSummary(notificationType string) string {
+	if summary, ok := notificationSummaries[notificationType]; ok {
+		return summary
+	}
+	summary := strings.ReplaceAll(notificationType, "_", " ")
+	if summary == "" {
+		return summary
+	}
+	return strings.ToUpper(summary[:1]) + summary[1:]
+}
+
+// Summary returns a short summary of the type of the notification.
+func (*NotificationDigestData) Summary(notification *ttnpb.Notification) string {
+	return NotificationSummary(notification.GetNotificationType())
+}
+
+// NotificationsURL returns the URL to the notifications in the Console.
+func (d *NotificationDigestData) NotificationsURL() string {
+	return fmt.Sprintf("%s/notifications", strings.TrimSuffix(d.Network().ConsoleURL, "/"))
+}
@@ -64,6 +64,32 @@ func TestEmailTemplates(t *testing.T) {
 		TemplateName string
 		TemplateData email.TemplateData
 	}{
+		{
+			TemplateName: "email_change",
+			TemplateData: &EmailChangeData{
+				TemplateData:    testTemplateData,
+				UserIDs:         usrIDs,
+				OldEmailAddress: "john.doe@example.com",
+				NewEmailAddress: "jdoe@example.com",
+				ID:              "ID",
+				Token:           "TOKEN",
+				TTL:             time.Hour,
+			},
+		},
+
+		{
+			TemplateName: "email_changed",
+			TemplateData: &EmailChangedData{
+				TemplateData:    testTemplateData,
+				UserIDs:         usrIDs,
+				OldEmailAddress: "john.doe@example.com",
+				NewEmailAddress: "jdoe@example.com",
+				ID:              "ID",
+				RevertToken:     "TOKEN",
+				RevertWindow:    7 * 24 * time.Hour,
+			},
+		},
+
 		{
 			TemplateName: "invitation",
 			TemplateData: &InvitationData{
@@ -83,6 +109,25 @@ func TestEmailTemplates(t *testing.T) {
 			},
 		},
 
+		{
+			TemplateName: "notification_digest",
+			TemplateData: &NotificationDigestData{
+				TemplateData: testTemplateData,
+				Notifications: []*ttnpb.Notification{
+					{
+						EntityIds:        (&ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}).GetEntityIdentifiers(),
+						NotificationType: "api_key_created",
+						CreatedAt:        timestamppb.New(time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)),
+					},
+					{
+						EntityIds:        usrIDs.GetEntityIdentifiers(),
+						NotificationType: "password_changed",
+						CreatedAt:        timestamppb.New(time.Date(2023, 7, 1, 13, 30, 0, 0, time.UTC)),
+					},
+				},
+			},
+		},
+
 		{
 			TemplateName: "temporary_password",
 			TemplateData: &TemporaryPasswordData{
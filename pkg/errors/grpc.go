@@ -41,6 +41,16 @@ func FromGRPCStatus(grpcStatus *status.Status) *Error {
 		}
 	}
 	details, rest := ErrorDetailsFromProto(detailProtos...)
+	if details != nil {
+		// The rich details are derived from the TTN error details, so they are not kept.
+		filtered := rest[:0]
+		for _, msg := range rest {
+			if !isRichDetail(msg) {
+				filtered = append(filtered, msg)
+			}
+		}
+		rest = filtered
+	}
 	if len(rest) != 0 {
 		err.details = rest
 	}
@@ -111,29 +121,56 @@ func (e *Error) GRPCStatus() *status.Status {
 	return s
 }
 
+type interceptorOptions struct {
+	richDetails bool
+}
+
+// InterceptorOption is an option for the server interceptors.
+type InterceptorOption func(*interceptorOptions)
+
+// WithRichDetailsInterceptor configures the server interceptors to add the google.rpc.ErrorInfo
+// and google.rpc.BadRequest details to returned TTN errors. See RichDetails.
+func WithRichDetailsInterceptor(enable bool) InterceptorOption {
+	return func(o *interceptorOptions) {
+		o.richDetails = enable
+	}
+}
+
+func (o *interceptorOptions) convert(err error) error {
+	ttnErr, ok := From(err)
+	if !ok {
+		return err
+	}
+	if o.richDetails {
+		return richDetailsError{err: ttnErr}
+	}
+	return ttnErr
+}
+
 // UnaryServerInterceptor makes sure that returned TTN errors contain a CorrelationID.
-func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+func UnaryServerInterceptor(opts ...InterceptorOption) grpc.UnaryServerInterceptor {
+	options := &interceptorOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 	return func(
 		ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler,
 	) (any, error) {
 		res, err := handler(ctx, req)
-		if ttnErr, ok := From(err); ok {
-			err = ttnErr
-		}
-		return res, err
+		return res, options.convert(err)
 	}
 }
 
 // StreamServerInterceptor makes sure that returned TTN errors contain a CorrelationID.
-func StreamServerInterceptor() grpc.StreamServerInterceptor {
+func StreamServerInterceptor(opts ...InterceptorOption) grpc.StreamServerInterceptor {
+	options := &interceptorOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
 	return func(
 		srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler,
 	) error {
-		err := handler(srv, stream)
-		if ttnErr, ok := From(err); ok {
-			err = ttnErr
-		}
-		return err
+		return options.convert(handler(srv, stream))
 	}
 }
 
@@ -0,0 +1,122 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/protoadapt"
+)
+
+// richDetailsDomain is the domain of the google.rpc.ErrorInfo details.
+const richDetailsDomain = "thethings.network"
+
+// errorInfoReason returns the name of the error in the UPPER_SNAKE_CASE format of google.rpc.ErrorInfo.
+func errorInfoReason(name string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		default:
+			return '_'
+		}
+	}, name)
+}
+
+// RichDetails returns the google.rpc.ErrorInfo and google.rpc.BadRequest details of the error.
+// Generic gRPC tooling understands these details, while the TTN error details are specific to
+// The Things Stack. The BadRequest details contain the fields of invalid argument errors in the
+// error and its causes.
+func RichDetails(err *Error) []proto.Message {
+	if err == nil {
+		return nil
+	}
+	info := &errdetails.ErrorInfo{
+		Reason: errorInfoReason(err.Name()),
+		Domain: richDetailsDomain,
+		Metadata: map[string]string{
+			"namespace": err.Namespace(),
+		},
+	}
+	if correlationID := err.CorrelationID(); correlationID != "" {
+		info.Metadata["correlation_id"] = correlationID
+	}
+	for k, v := range err.PublicAttributes() {
+		info.Metadata[k] = fmt.Sprint(v)
+	}
+	details := []proto.Message{info}
+
+	badRequest := &errdetails.BadRequest{}
+	for cause := error(err); cause != nil; {
+		ttnErr, ok := From(cause)
+		if !ok {
+			break
+		}
+		if ttnErr.Code() == uint32(codes.InvalidArgument) {
+			if field, ok := ttnErr.PublicAttributes()["field"]; ok {
+				badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+					Field:       fmt.Sprint(field),
+					Description: ttnErr.FormatMessage(ttnErr.PublicAttributes()),
+				})
+			}
+		}
+		cause = ttnErr.Cause()
+	}
+	if len(badRequest.FieldViolations) > 0 {
+		details = append(details, badRequest)
+	}
+	return details
+}
+
+// isRichDetail returns whether the message is one of the details that is added by RichDetails.
+func isRichDetail(msg proto.Message) bool {
+	switch msg.(type) {
+	case *errdetails.ErrorInfo, *errdetails.BadRequest:
+		return true
+	default:
+		return false
+	}
+}
+
+// richDetailsError is an Error that adds RichDetails to its gRPC status.
+type richDetailsError struct {
+	err *Error
+}
+
+func (e richDetailsError) Error() string { return e.err.Error() }
+
+func (e richDetailsError) Unwrap() error { return e.err }
+
+// GRPCStatus returns the gRPC status of the error, with the rich details added to the TTN error details.
+func (e richDetailsError) GRPCStatus() *status.Status {
+	s := e.err.GRPCStatus()
+	details := RichDetails(e.err)
+	detailsV1 := make([]protoadapt.MessageV1, 0, len(details))
+	for _, d := range details {
+		detailsV1 = append(detailsV1, protoadapt.MessageV1Of(d))
+	}
+	withDetails, err := s.WithDetails(detailsV1...)
+	if err != nil {
+		return s
+	}
+	return withDetails
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package errors_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	_ "go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+)
+
+func TestRichDetails(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	errField := errors.DefineInvalidArgument("test_rich_details_field", "invalid field `{field}`")
+	errRequest := errors.DefineInvalidArgument("test_rich_details_request", "invalid request `{request}`")
+	errHello := errRequest.WithAttributes("request", "hello").WithCause(errField.WithAttributes("field", "ids.device_id"))
+
+	details := errors.RichDetails(errHello)
+	if !a.So(details, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	info, ok := details[0].(*errdetails.ErrorInfo)
+	if a.So(ok, should.BeTrue) {
+		a.So(info.Reason, should.Equal, "TEST_RICH_DETAILS_REQUEST")
+		a.So(info.Metadata["namespace"], should.Equal, "pkg/errors_test")
+		a.So(info.Metadata["request"], should.Equal, "hello")
+	}
+	badRequest, ok := details[1].(*errdetails.BadRequest)
+	if a.So(ok, should.BeTrue) && a.So(badRequest.FieldViolations, should.HaveLength, 1) {
+		a.So(badRequest.FieldViolations[0].Field, should.Equal, "ids.device_id")
+		a.So(badRequest.FieldViolations[0].Description, should.Equal, "invalid field `ids.device_id`")
+	}
+
+	for _, tc := range []struct {
+		Name        string
+		RichDetails bool
+		Details     int
+	}{
+		{Name: "Disabled", RichDetails: false, Details: 1},
+		{Name: "Enabled", RichDetails: true, Details: 3},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			interceptor := errors.UnaryServerInterceptor(errors.WithRichDetailsInterceptor(tc.RichDetails))
+			_, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{},
+				func(context.Context, any) (any, error) {
+					return nil, errHello
+				},
+			)
+			s, ok := status.FromError(err)
+			if !a.So(ok, should.BeTrue) {
+				t.FailNow()
+			}
+			a.So(s.Details(), should.HaveLength, tc.Details)
+
+			// The rich details are not kept when converting back to a TTN error.
+			converted := errors.FromGRPCStatus(s)
+			a.So(converted, should.EqualErrorOrDefinition, errHello)
+			a.So(errors.Details(converted), should.BeEmpty)
+		})
+	}
+}
@@ -0,0 +1,226 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package eventbridge implements the events bridge, which forwards selected events of the cluster
+// to an external HTTP endpoint, for example to feed SIEM or logging systems.
+package eventbridge
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+// Config represents the events bridge configuration.
+type Config struct {
+	Enable        bool              `name:"enable" description:"Enable the events bridge"`
+	URL           string            `name:"url" description:"URL of the HTTP endpoint to forward events to"`
+	Headers       map[string]string `name:"headers" description:"HTTP headers to send with each request, for example for authentication"`
+	Names         []string          `name:"names" description:"Names of the events to forward, either exact event names or wildcards (all events if empty)"`
+	BatchSize     int               `name:"batch-size" description:"Maximum number of events per request"`
+	BatchInterval time.Duration     `name:"batch-interval" description:"Maximum time to wait for a batch to fill up before forwarding it"`
+	QueueSize     int               `name:"queue-size" description:"Maximum number of batches waiting to be forwarded"`
+	Timeout       time.Duration     `name:"timeout" description:"Timeout of each request"`
+	MaxRetries    int               `name:"max-retries" description:"Maximum number of retries of a failed request"`
+	RetryBackoff  time.Duration     `name:"retry-backoff" description:"Initial time to wait before retrying a failed request, doubled after each retry"`
+}
+
+// Bridge implements the events bridge.
+//
+// The bridge subscribes to the events of the cluster and forwards them in batches to the
+// configured HTTP endpoint. Failed requests are retried with exponential backoff. When the
+// endpoint cannot keep up, batches are dropped once the queue is full. Only one instance in
+// the cluster should enable the bridge, as every instance receives all events.
+type Bridge struct {
+	*component.Component
+	ctx context.Context
+
+	config  *Config
+	names   []string
+	batches chan []events.Event
+	sender  *sender
+}
+
+const (
+	eventsBufferSize     = 1 << 10
+	defaultBatchSize     = 100
+	defaultBatchInterval = time.Second
+	defaultQueueSize     = 16
+)
+
+var (
+	errNoURL            = errors.DefineInvalidArgument("no_url", "no URL configured")
+	errUnknownEventName = errors.DefineInvalidArgument("unknown_event_name", "unknown event `{name}`")
+	errNoMatchingEvents = errors.DefineInvalidArgument("no_matching_events", "no events match `{name}`")
+)
+
+// New returns a new *Bridge.
+func New(c *component.Component, conf *Config) (*Bridge, error) {
+	ctx := log.NewContextWithField(c.Context(), "namespace", "eventbridge")
+	if conf.URL == "" {
+		return nil, errNoURL.New()
+	}
+	names, err := expandNames(conf.Names, events.All().Definitions())
+	if err != nil {
+		return nil, err
+	}
+	queueSize := conf.QueueSize
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	httpClient, err := c.HTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	b := &Bridge{
+		Component: c,
+		ctx:       ctx,
+		config:    conf,
+		names:     names,
+		batches:   make(chan []events.Event, queueSize),
+		sender:    newSender(httpClient, conf),
+	}
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "eventbridge_collect_events",
+		Func:    b.collectEvents,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "eventbridge_forward_events",
+		Func:    b.forwardEvents,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return b, nil
+}
+
+// Context returns the context of the events bridge.
+func (b *Bridge) Context() context.Context {
+	return b.ctx
+}
+
+// expandNames returns the names of the defined events that match the given names.
+// Names can be exact event names, or wildcards that match any sequence of characters.
+func expandNames(names []string, definitions []events.Definition) ([]string, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	definedNames := make(map[string]struct{}, len(definitions))
+	for _, def := range definitions {
+		definedNames[def.Name()] = struct{}{}
+	}
+	nameMap := make(map[string]struct{})
+	for _, name := range names {
+		if !strings.Contains(name, "*") {
+			if _, ok := definedNames[name]; !ok {
+				return nil, errUnknownEventName.WithAttributes("name", name)
+			}
+			nameMap[name] = struct{}{}
+			continue
+		}
+		// Wildcards match any sequence of characters.
+		re := regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(name), `\*`, ".*") + "$")
+		var found bool
+		for defined := range definedNames {
+			if re.MatchString(defined) {
+				nameMap[defined] = struct{}{}
+				found = true
+			}
+		}
+		if !found {
+			return nil, errNoMatchingEvents.WithAttributes("name", name)
+		}
+	}
+	out := make([]string, 0, len(nameMap))
+	for name := range nameMap {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out, nil
+}
+
+func (b *Bridge) collectEvents(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(events.Channel, eventsBufferSize)
+	if err := events.Subscribe(ctx, b.names, nil, ch); err != nil {
+		return err
+	}
+	batchSize := b.config.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+	batchInterval := b.config.BatchInterval
+	if batchInterval <= 0 {
+		batchInterval = defaultBatchInterval
+	}
+	ticker := time.NewTicker(batchInterval)
+	defer ticker.Stop()
+	batch := make([]events.Event, 0, batchSize)
+	enqueue := func() {
+		if len(batch) == 0 {
+			return
+		}
+		select {
+		case b.batches <- batch:
+		default:
+			log.FromContext(ctx).WithField("count", len(batch)).Warn("Events bridge queue full, drop events")
+			bridgeMetrics.eventsDropped.Add(float64(len(batch)))
+		}
+		batch = make([]events.Event, 0, batchSize)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-ch:
+			batch = append(batch, evt)
+			if len(batch) >= batchSize {
+				enqueue()
+			}
+		case <-ticker.C:
+			enqueue()
+		}
+	}
+}
+
+func (b *Bridge) forwardEvents(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case batch := <-b.batches:
+			if err := b.sender.send(ctx, batch); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				log.FromContext(ctx).WithError(err).WithField("count", len(batch)).Warn("Failed to forward events")
+				bridgeMetrics.eventsDropped.Add(float64(len(batch)))
+				continue
+			}
+			bridgeMetrics.eventsForwarded.Add(float64(len(batch)))
+		}
+	}
+}
@@ -0,0 +1,127 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	evtTestUp   = events.Define("test.eventbridge.up", "test uplink")
+	evtTestDown = events.Define("test.eventbridge.down", "test downlink")
+)
+
+func TestExpandNames(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	definitions := events.All().Definitions()
+
+	names, err := expandNames(nil, definitions)
+	a.So(err, should.BeNil)
+	a.So(names, should.BeEmpty)
+
+	names, err = expandNames([]string{"test.eventbridge.*"}, definitions)
+	a.So(err, should.BeNil)
+	a.So(names, should.Resemble, []string{"test.eventbridge.down", "test.eventbridge.up"})
+
+	names, err = expandNames([]string{"test.eventbridge.up", "test.*.up"}, definitions)
+	a.So(err, should.BeNil)
+	a.So(names, should.Resemble, []string{"test.eventbridge.up"})
+
+	_, err = expandNames([]string{"test.eventbridge.unknown"}, definitions)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	_, err = expandNames([]string{"unknown.*"}, definitions)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestSender(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var requests, failures atomic.Int32
+	failures.Store(2)
+	bodies := make(chan []byte, 4)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if r.Header.Get("Authorization") != "Bearer secret" || r.Header.Get("Content-Type") != "application/json" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if failures.Add(-1) >= 0 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		b, _ := io.ReadAll(r.Body)
+		bodies <- b
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	batch := []events.Event{
+		evtTestUp.NewWithIdentifiersAndData(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}, nil),
+		evtTestDown.NewWithIdentifiersAndData(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}, nil),
+	}
+
+	s := newSender(srv.Client(), &Config{
+		URL:          srv.URL,
+		Headers:      map[string]string{"Authorization": "Bearer secret"},
+		MaxRetries:   2,
+		RetryBackoff: test.Delay,
+	})
+	if !a.So(s.send(ctx, batch), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(requests.Load(), should.Equal, 3)
+
+	var body struct {
+		Events []struct {
+			Name        string `json:"name"`
+			Identifiers []any  `json:"identifiers"`
+		} `json:"events"`
+	}
+	select {
+	case b := <-bodies:
+		if !a.So(json.Unmarshal(b, &body), should.BeNil) {
+			t.FailNow()
+		}
+	case <-time.After(test.Delay):
+		t.Fatal("Timed out waiting for request")
+	}
+	if a.So(body.Events, should.HaveLength, 2) {
+		a.So(body.Events[0].Name, should.Equal, "test.eventbridge.up")
+		a.So(body.Events[0].Identifiers, should.HaveLength, 1)
+		a.So(body.Events[1].Name, should.Equal, "test.eventbridge.down")
+	}
+
+	// Retries are exhausted.
+	failures.Store(3)
+	err := s.send(ctx, batch)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(requests.Load(), should.Equal, 6)
+}
@@ -0,0 +1,58 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const subsystem = "eventbridge"
+
+var bridgeMetrics = &messageMetrics{
+	eventsForwarded: prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "events_forwarded_total",
+			Help:      "Total number of events forwarded by the events bridge",
+		},
+	),
+	eventsDropped: prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "events_dropped_total",
+			Help:      "Total number of events dropped by the events bridge",
+		},
+	),
+}
+
+func init() {
+	metrics.MustRegister(bridgeMetrics)
+}
+
+type messageMetrics struct {
+	eventsForwarded prometheus.Counter
+	eventsDropped   prometheus.Counter
+}
+
+func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.eventsForwarded.Describe(ch)
+	m.eventsDropped.Describe(ch)
+}
+
+func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.eventsForwarded.Collect(ch)
+	m.eventsDropped.Collect(ch)
+}
@@ -0,0 +1,139 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package eventbridge
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+const (
+	defaultTimeout      = 10 * time.Second
+	defaultRetryBackoff = time.Second
+	maxRetryBackoff     = time.Minute
+)
+
+var errRequest = errors.DefineUnavailable("request", "request to `{url}` failed with status `{status}`")
+
+// sender sends batches of events to the HTTP endpoint.
+type sender struct {
+	client       *http.Client
+	url          string
+	headers      map[string]string
+	timeout      time.Duration
+	maxRetries   int
+	retryBackoff time.Duration
+}
+
+func newSender(client *http.Client, conf *Config) *sender {
+	s := &sender{
+		client:       client,
+		url:          conf.URL,
+		headers:      conf.Headers,
+		timeout:      conf.Timeout,
+		maxRetries:   conf.MaxRetries,
+		retryBackoff: conf.RetryBackoff,
+	}
+	if s.timeout <= 0 {
+		s.timeout = defaultTimeout
+	}
+	if s.retryBackoff <= 0 {
+		s.retryBackoff = defaultRetryBackoff
+	}
+	return s
+}
+
+// marshalBatch marshals the events to a JSON object with the events in the `events` field.
+// Events that cannot be marshaled are skipped.
+func marshalBatch(ctx context.Context, batch []events.Event) ([]byte, error) {
+	msgs := make([]json.RawMessage, 0, len(batch))
+	for _, evt := range batch {
+		pb, err := events.Proto(evt)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to convert event to proto")
+			continue
+		}
+		b, err := jsonpb.TTN().Marshal(pb)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to marshal event")
+			continue
+		}
+		msgs = append(msgs, b)
+	}
+	return json.Marshal(struct {
+		Events []json.RawMessage `json:"events"`
+	}{
+		Events: msgs,
+	})
+}
+
+// send sends the batch of events, and retries with exponential backoff if the request fails.
+func (s *sender) send(ctx context.Context, batch []events.Event) error {
+	body, err := marshalBatch(ctx, batch)
+	if err != nil {
+		return err
+	}
+	backoff := s.retryBackoff
+	for attempt := 0; ; attempt++ {
+		err := s.post(ctx, body)
+		if err == nil || attempt >= s.maxRetries {
+			return err
+		}
+		log.FromContext(ctx).WithError(err).WithFields(log.Fields(
+			"attempt", attempt+1,
+			"backoff", backoff,
+		)).Debug("Failed to forward events, retry")
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(backoff):
+		}
+		if backoff *= 2; backoff > maxRetryBackoff {
+			backoff = maxRetryBackoff
+		}
+	}
+}
+
+func (s *sender) post(ctx context.Context, body []byte) error {
+	ctx, cancel := context.WithTimeout(ctx, s.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range s.headers {
+		req.Header.Set(key, value)
+	}
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errRequest.WithAttributes("url", s.url, "status", res.StatusCode)
+	}
+	return nil
+}
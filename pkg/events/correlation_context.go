@@ -20,21 +20,29 @@ import (
 	"sort"
 
 	ulid "github.com/oklog/ulid/v2"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 )
 
 type correlationKey struct{}
 
+// logFieldCorrelationIDs is the log field that contains the correlation IDs.
+const logFieldCorrelationIDs = "correlation_ids"
+
 // ContextWithCorrelationID returns a derived context with the correlation IDs if they were not already in there.
+// If the context has a logger, the correlation IDs are added to the fields of the logger.
 func ContextWithCorrelationID(ctx context.Context, cids ...string) context.Context {
 	cids = append(cids[:0:0], cids...)
 	sort.Strings(cids)
 	cids = uniqueStrings(cids)
 
-	existing, ok := ctx.Value(correlationKey{}).([]string)
-	if !ok {
-		return context.WithValue(ctx, correlationKey{}, cids)
+	if existing, ok := ctx.Value(correlationKey{}).([]string); ok {
+		cids = mergeStrings(existing, cids)
+	}
+	ctx = context.WithValue(ctx, correlationKey{}, cids)
+	if logger := log.FromContext(ctx); logger != log.Noop {
+		ctx = log.NewContext(ctx, logger.WithField(logFieldCorrelationIDs, cids))
 	}
-	return context.WithValue(ctx, correlationKey{}, mergeStrings(existing, cids))
+	return ctx
 }
 
 // CorrelationIDsFromContext returns the correlation IDs that are attached to the context.
@@ -19,6 +19,7 @@ import (
 
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
@@ -61,3 +62,22 @@ func TestCorrelationContext(t *testing.T) {
 		"c",
 	})
 }
+
+func TestCorrelationContextLogger(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	var entries []log.Entry
+	logger := log.NewLogger(log.HandlerFunc(func(e log.Entry) error {
+		entries = append(entries, e)
+		return nil
+	}))
+	ctx := log.NewContext(test.Context(), logger)
+
+	ctx = events.ContextWithCorrelationID(ctx, "foo")
+	ctx = events.ContextWithCorrelationID(ctx, "bar")
+	log.FromContext(ctx).Info("Test")
+	if a.So(entries, should.HaveLength, 1) {
+		a.So(entries[0].Fields().Fields()["correlation_ids"], should.Resemble, []string{"bar", "foo"})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"encoding/json"
+
+	"github.com/google/cel-go/cel"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// maxFilterLength is the maximum length of a filter expression.
+	maxFilterLength = 1024
+	// filterCostLimit is the maximum cost of evaluating a filter expression on an event.
+	filterCostLimit = 10000
+)
+
+// filterVariables are the variables that can be used in filter expressions.
+// These are the fields of the JSON representation of the event.
+var filterVariables = []string{
+	"name",
+	"time",
+	"identifiers",
+	"data",
+	"correlation_ids",
+	"origin",
+	"context",
+	"visibility",
+	"authentication",
+	"remote_ip",
+	"user_agent",
+	"unique_id",
+}
+
+var (
+	errFilterTooLong     = errors.DefineInvalidArgument("filter_too_long", "filter longer than `{max}` characters")
+	errInvalidFilter     = errors.DefineInvalidArgument("invalid_filter", "invalid filter")
+	errInvalidFilterType = errors.DefineInvalidArgument("invalid_filter_type", "filter of type `{type}` instead of bool")
+)
+
+// eventFilter is a compiled CEL expression that filters events.
+type eventFilter struct {
+	program cel.Program
+}
+
+func newEventFilter(expr string) (*eventFilter, error) {
+	if len(expr) > maxFilterLength {
+		return nil, errFilterTooLong.WithAttributes("max", maxFilterLength)
+	}
+	opts := make([]cel.EnvOption, 0, len(filterVariables))
+	for _, name := range filterVariables {
+		opts = append(opts, cel.Variable(name, cel.DynType))
+	}
+	env, err := cel.NewEnv(opts...)
+	if err != nil {
+		return nil, err
+	}
+	ast, issues := env.Compile(expr)
+	if err := issues.Err(); err != nil {
+		return nil, errInvalidFilter.WithCause(err)
+	}
+	if t := ast.OutputType(); t != cel.BoolType && t != cel.DynType {
+		return nil, errInvalidFilterType.WithAttributes("type", t.String())
+	}
+	program, err := env.Program(ast, cel.CostLimit(filterCostLimit))
+	if err != nil {
+		return nil, errInvalidFilter.WithCause(err)
+	}
+	return &eventFilter{program: program}, nil
+}
+
+// Match returns whether the filter expression evaluates to true on the event.
+// Events on which the expression fails to evaluate do not match.
+func (f *eventFilter) Match(evt *ttnpb.Event) bool {
+	b, err := jsonpb.TTN().Marshal(evt)
+	if err != nil {
+		return false
+	}
+	var fields map[string]any
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return false
+	}
+	vars := make(map[string]any, len(filterVariables))
+	for _, name := range filterVariables {
+		vars[name] = fields[name]
+	}
+	out, _, err := f.program.Eval(vars)
+	if err != nil {
+		return false
+	}
+	match, ok := out.Value().(bool)
+	return ok && match
+}
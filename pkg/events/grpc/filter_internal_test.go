@@ -0,0 +1,111 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package grpc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+func TestEventFilter(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	for _, expr := range []string{
+		"name ==",
+		"name + 1",
+		`"gs.up.receive"`,
+		strings.Repeat("a", maxFilterLength+1),
+	} {
+		_, err := newEventFilter(expr)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	data, err := anypb.New(&ttnpb.ApplicationUp{
+		Up: &ttnpb.ApplicationUp_UplinkMessage{
+			UplinkMessage: &ttnpb.ApplicationUplink{
+				FPort: 10,
+				RxMetadata: []*ttnpb.RxMetadata{
+					{Rssi: -100},
+					{Rssi: -120},
+				},
+			},
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	evt := &ttnpb.Event{
+		Name:   "as.up.data.forward",
+		Origin: "test",
+		Data:   data,
+	}
+
+	for _, tc := range []struct {
+		Expr  string
+		Match bool
+	}{
+		{Expr: `name == "as.up.data.forward"`, Match: true},
+		{Expr: `name.startsWith("gs.")`, Match: false},
+		{Expr: `origin == "test" && name.endsWith(".forward")`, Match: true},
+		{Expr: `data.uplink_message.f_port == 10`, Match: true},
+		{Expr: `data.uplink_message.f_port == 11`, Match: false},
+		{Expr: `data.uplink_message.rx_metadata.exists(md, md.rssi < -115)`, Match: true},
+		{Expr: `data.uplink_message.rx_metadata.all(md, md.rssi < -115)`, Match: false},
+		{Expr: `data.join_accept.session_key_id == ""`, Match: false},
+		{Expr: `remote_ip == "127.0.0.1"`, Match: false},
+	} {
+		f, err := newEventFilter(tc.Expr)
+		if !a.So(err, should.BeNil) {
+			continue
+		}
+		a.So(f.Match(evt), should.Equal, tc.Match)
+	}
+}
+
+func TestProcessNames(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	srv := &EventsServer{
+		definedNames: map[string]struct{}{
+			"gs.up.receive":      {},
+			"gs.up.drop":         {},
+			"gs.down.send":       {},
+			"ns.up.data.receive": {},
+		},
+	}
+
+	names, err := srv.processNames("gs.up.*")
+	a.So(err, should.BeNil)
+	a.So(names, should.Resemble, []string{"gs.up.drop", "gs.up.receive"})
+
+	names, err = srv.processNames("*.receive", "gs.down.send")
+	a.So(err, should.BeNil)
+	a.So(names, should.Resemble, []string{"gs.down.send", "gs.up.receive", "ns.up.data.receive"})
+
+	names, err = srv.processNames(`/^gs\.down\..+/`)
+	a.So(err, should.BeNil)
+	a.So(names, should.Resemble, []string{"gs.down.send"})
+
+	_, err = srv.processNames("as.*")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
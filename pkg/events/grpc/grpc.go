@@ -77,11 +77,19 @@ func (srv *EventsServer) processNames(names ...string) ([]string, error) {
 	}
 	nameMap := make(map[string]struct{})
 	for _, name := range names {
-		if strings.HasPrefix(name, "/") && strings.HasSuffix(name, "/") {
-			re, err := regexp.Compile(strings.Trim(name, "/"))
+		var re *regexp.Regexp
+		switch {
+		case strings.HasPrefix(name, "/") && strings.HasSuffix(name, "/"):
+			var err error
+			re, err = regexp.Compile(strings.Trim(name, "/"))
 			if err != nil {
 				return nil, errInvalidRegexp.WithCause(err)
 			}
+		case strings.Contains(name, "*"):
+			// Wildcards match any sequence of characters.
+			re = regexp.MustCompile("^" + strings.ReplaceAll(regexp.QuoteMeta(name), `\*`, ".*") + "$")
+		}
+		if re != nil {
 			var found bool
 			for defined := range srv.definedNames {
 				if re.MatchString(defined) {
@@ -130,6 +138,13 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 		return err
 	}
 
+	var filter *eventFilter
+	if req.Filter != "" {
+		if filter, err = newEventFilter(req.Filter); err != nil {
+			return err
+		}
+	}
+
 	ctx := stream.Context()
 
 	if err = rights.RequireAny(ctx, req.Identifiers...); err != nil {
@@ -222,6 +237,9 @@ func (srv *EventsServer) Stream(req *ttnpb.StreamEventsRequest, stream ttnpb.Eve
 				log.FromContext(ctx).WithError(err).Warn("Failed to convert event to proto")
 				continue
 			}
+			if filter != nil && !filter.Match(proto) {
+				continue
+			}
 			if err := stream.Send(proto); err != nil {
 				return err
 			}
@@ -28,7 +28,9 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/events/redis"
 	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
 var redisConfig = func() ttnredis.Config {
@@ -116,4 +118,45 @@ func TestRedisPubSubStore(t *testing.T) { //nolint:paralleltest
 	})
 }
 
-var _ events.Store = (*redis.PubSubStore)(nil)
+func TestRedisPubSubStoreTrimHistory(t *testing.T) { //nolint:paralleltest
+	taskStarter := task.StartTaskFunc(task.DefaultStartTask)
+
+	test.RunTest(t, test.TestConfig{
+		Timeout: timeout,
+		Func: func(ctx context.Context, a *assertions.Assertion) {
+			config := config.RedisEvents{
+				Config: redisConfig,
+			}
+			config.Store.Enable = true
+			pubsub := redis.NewPubSub(ctx, mockComponent{taskStarter}, config)
+			store := pubsub.(*redis.PubSubStore)
+			defer store.Close(ctx)
+
+			appID := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-trim-app"}
+			ids := []*ttnpb.EntityIdentifiers{appID.GetEntityIdentifiers()}
+
+			store.Publish(events.New(ctx, "test.trim.evt1", "test event 1", events.WithIdentifiers(appID)))
+			time.Sleep(timeout / 10)
+			trimAt := time.Now()
+			time.Sleep(timeout / 10)
+			store.Publish(events.New(ctx, "test.trim.evt2", "test event 2", events.WithIdentifiers(appID)))
+			time.Sleep(timeout / 10)
+
+			evts, err := store.FetchHistory(ctx, nil, ids, nil, 0)
+			a.So(err, should.BeNil)
+			a.So(evts, should.HaveLength, 2)
+
+			a.So(store.TrimHistory(ctx, appID.GetEntityIdentifiers(), trimAt), should.BeNil)
+
+			evts, err = store.FetchHistory(ctx, nil, ids, nil, 0)
+			if a.So(err, should.BeNil) && a.So(evts, should.HaveLength, 1) {
+				a.So(evts[0].Name(), should.Equal, "test.trim.evt2")
+			}
+		},
+	})
+}
+
+var (
+	_ events.Store          = (*redis.PubSubStore)(nil)
+	_ events.HistoryTrimmer = (*redis.PubSubStore)(nil)
+)
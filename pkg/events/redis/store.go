@@ -519,6 +519,15 @@ func (ps *PubSubStore) Publish(evs ...events.Event) {
 	}
 }
 
+// TrimHistory implements events.HistoryTrimmer.
+// The events are removed from the event stream of the entity. The event data expires with the history TTL.
+func (ps *PubSubStore) TrimHistory(ctx context.Context, ids *ttnpb.EntityIdentifiers, before time.Time) error {
+	if err := ps.client.XTrimMinID(ctx, ps.eventStream(ctx, ids), formatStreamTime(before)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
 // formatStreamTime constructs the minimal stream ID from the provided timestamp.
 // Redis stream identifiers are by default built from the number of milliseconds since
 // the UNIX epoch, and a sequence number.
@@ -37,3 +37,9 @@ type Store interface {
 		ctx context.Context, names []string, ids []*ttnpb.EntityIdentifiers, after *time.Time, tail int, hdl Handler,
 	) error
 }
+
+// HistoryTrimmer is implemented by Stores that can delete historical events of entities.
+type HistoryTrimmer interface {
+	// TrimHistory deletes the historical events of the entity that were published before the given time.
+	TrimHistory(ctx context.Context, ids *ttnpb.EntityIdentifiers, before time.Time) error
+}
@@ -444,6 +444,58 @@ func (fp FrequencyPlan) Validate() error {
 	return nil
 }
 
+var (
+	errTooManyChannels  = errors.DefineInvalidArgument("too_many_channels", "band `{band_id}` supports at most {max} {direction} channels")
+	errChannelFrequency = errors.DefineInvalidArgument("channel_frequency", "frequency {frequency} of {direction} channel `{index}` is outside the sub-bands of band `{band_id}`")
+	errChannelDataRate  = errors.DefineInvalidArgument("channel_data_rate", "data rate {data_rate} of {direction} channel `{index}` is not defined in band `{band_id}`")
+)
+
+// ValidateBand returns an error if the channels of the frequency plan do not comply with the band definition.
+// The number of channels, the channel frequencies and the channel data rates are validated.
+func (fp FrequencyPlan) ValidateBand() error {
+	phy, err := band.GetLatest(fp.BandID)
+	if err != nil {
+		return err
+	}
+	for _, d := range []struct {
+		direction string
+		channels  []Channel
+		max       uint8
+	}{
+		{"uplink", fp.UplinkChannels, phy.MaxUplinkChannels},
+		{"downlink", fp.DownlinkChannels, phy.MaxDownlinkChannels},
+	} {
+		if d.max > 0 && len(d.channels) > int(d.max) {
+			return errTooManyChannels.WithAttributes(
+				"band_id", phy.ID,
+				"max", d.max,
+				"direction", d.direction,
+			)
+		}
+		for i, ch := range d.channels {
+			if _, ok := phy.FindSubBand(ch.Frequency); len(phy.SubBands) > 0 && !ok {
+				return errChannelFrequency.WithAttributes(
+					"frequency", ch.Frequency,
+					"direction", d.direction,
+					"index", i,
+					"band_id", phy.ID,
+				)
+			}
+			for _, dr := range []uint8{ch.MinDataRate, ch.MaxDataRate} {
+				if _, ok := phy.DataRates[ttnpb.DataRateIndex(dr)]; !ok {
+					return errChannelDataRate.WithAttributes(
+						"data_rate", dr,
+						"direction", d.direction,
+						"index", i,
+						"band_id", phy.ID,
+					)
+				}
+			}
+		}
+	}
+	return nil
+}
+
 // RespectsDwellTime returns whether the transmission respects the frequency plan's dwell time restrictions.
 func (fp *FrequencyPlan) RespectsDwellTime(isDownlink bool, frequency uint64, duration time.Duration) bool {
 	var chDwellTime *ChannelDwellTime
@@ -525,6 +577,9 @@ type FrequencyPlanDescription struct {
 	BaseFrequency uint16 `yaml:"base-frequency"`
 	// File is the file where the frequency plan is defined.
 	File string `yaml:"file"`
+
+	// override indicates that the frequency plan is defined by the operator in the overrides.
+	override bool
 }
 
 var errFetchFailed = errors.Define("fetch", "fetching failed")
@@ -572,6 +627,8 @@ type queryResult struct {
 type Store struct {
 	Fetcher fetch.Interface
 
+	overrides fetch.Interface
+
 	descriptionsMu             sync.Mutex
 	descriptionsCache          frequencyPlanList
 	descriptionsFetchErrorTime time.Time
@@ -581,16 +638,58 @@ type Store struct {
 	frequencyPlansMu    sync.Mutex
 }
 
+// StoreOption is an option for the Store.
+type StoreOption func(*Store)
+
+// WithOverrides returns a StoreOption that layers the frequency plans of the given fetcher on top of
+// the frequency plans of the store's fetcher. The overrides contain a frequency-plans.yml file with
+// the descriptions of the frequency plans, like the frequency plans repository. Frequency plans in the
+// overrides replace frequency plans with the same ID, and are validated against their band definition.
+func WithOverrides(fetcher fetch.Interface) StoreOption {
+	return func(s *Store) {
+		s.overrides = fetcher
+	}
+}
+
 // NewStore of frequency plans.
-func NewStore(fetcher fetch.Interface) *Store {
-	return &Store{
+func NewStore(fetcher fetch.Interface, opts ...StoreOption) *Store {
+	s := &Store{
 		Fetcher:             fetcher,
 		frequencyPlansCache: map[string]queryResult{},
 	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
 }
 
-func (s *Store) fetchDescriptions() (frequencyPlanList, error) {
-	content, err := s.Fetcher.File("frequency-plans.yml")
+// SetFetcher replaces the fetcher and the options of the store and clears the cached frequency plans.
+func (s *Store) SetFetcher(fetcher fetch.Interface, opts ...StoreOption) {
+	s.frequencyPlansMu.Lock()
+	defer s.frequencyPlansMu.Unlock()
+	s.descriptionsMu.Lock()
+	defer s.descriptionsMu.Unlock()
+	s.Fetcher = fetcher
+	s.overrides = nil
+	for _, opt := range opts {
+		opt(s)
+	}
+	s.descriptionsCache = nil
+	s.descriptionsFetchErrorTime = time.Time{}
+	s.descriptionsFetchError = nil
+	s.frequencyPlansCache = map[string]queryResult{}
+}
+
+// fetcher returns the fetcher of the frequency plan files of the description.
+func (s *Store) fetcher(description FrequencyPlanDescription) fetch.Interface {
+	if description.override {
+		return s.overrides
+	}
+	return s.Fetcher
+}
+
+func fetchDescriptions(fetcher fetch.Interface) ([]*FrequencyPlanDescription, error) {
+	content, err := fetcher.File("frequency-plans.yml")
 	if err != nil {
 		return nil, errFetchFailed.WithCause(err)
 	}
@@ -598,14 +697,45 @@ func (s *Store) fetchDescriptions() (frequencyPlanList, error) {
 	if err = yaml.Unmarshal(content, &descriptions); err != nil {
 		return nil, errParseFile.WithCause(err)
 	}
+	return descriptions, nil
+}
+
+func (s *Store) fetchDescriptions() (frequencyPlanList, error) {
+	var descriptions []*FrequencyPlanDescription
+	if s.Fetcher != nil {
+		var err error
+		if descriptions, err = fetchDescriptions(s.Fetcher); err != nil {
+			return nil, err
+		}
+	}
+	if s.overrides != nil {
+		overrides, err := fetchDescriptions(s.overrides)
+		if err != nil {
+			return nil, err
+		}
+	nextOverride:
+		for _, override := range overrides {
+			override.override = true
+			for i, description := range descriptions {
+				if description.ID == override.ID {
+					descriptions[i] = override
+					continue nextOverride
+				}
+			}
+			descriptions = append(descriptions, override)
+		}
+	}
+	if s.Fetcher == nil && s.overrides == nil {
+		return nil, errNotConfigured.New()
+	}
 	descriptionsByID := make(map[string]*FrequencyPlanDescription, len(descriptions))
 	for _, description := range descriptions {
 		descriptionsByID[description.ID] = description
 	}
 	for _, description := range descriptions {
 		if description.BaseID != "" {
-			base := descriptionsByID[description.BaseID]
-			if description.BaseFrequency == 0 {
+			base, ok := descriptionsByID[description.BaseID]
+			if ok && description.BaseFrequency == 0 {
 				description.BaseFrequency = base.BaseFrequency
 			}
 		}
@@ -656,7 +786,7 @@ func (s *Store) getByID(id string) (*FrequencyPlan, error) {
 	if !ok {
 		return nil, errNotFound.WithAttributes("id", id)
 	}
-	proto, err := description.proto(s.Fetcher)
+	proto, err := description.proto(s.fetcher(description))
 	if err != nil {
 		return nil, errRead.WithCause(err).WithAttributes("id", id)
 	}
@@ -669,7 +799,7 @@ func (s *Store) getByID(id string) (*FrequencyPlan, error) {
 			)
 		}
 		var baseProto FrequencyPlan
-		baseProto, err = base.proto(s.Fetcher)
+		baseProto, err = base.proto(s.fetcher(base))
 		if err != nil {
 			return nil, errReadBase.WithCause(err).WithAttributes(
 				"id", description.ID,
@@ -681,6 +811,11 @@ func (s *Store) getByID(id string) (*FrequencyPlan, error) {
 	if err := proto.Validate(); err != nil {
 		return nil, errInvalid.WithCause(err)
 	}
+	if description.override {
+		if err := proto.ValidateBand(); err != nil {
+			return nil, errInvalid.WithCause(err)
+		}
+	}
 	return &proto, nil
 }
 
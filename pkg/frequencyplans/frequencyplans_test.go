@@ -237,6 +237,80 @@ uplink-channels:
 	}
 }
 
+func TestStoreOverrides(t *testing.T) {
+	a := assertions.New(t)
+
+	store := frequencyplans.NewStore(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: EU_863_870
+  name: Europe 863-870 MHz
+  base-frequency: 868
+  file: EU.yml
+- id: US_902_928
+  name: United States 902-928 MHz
+  base-frequency: 915
+  file: US.yml
+`),
+		"EU.yml": []byte(`band-id: EU_863_870
+uplink-channels:
+- frequency: 868100000
+  min-data-rate: 0
+  max-data-rate: 5
+`),
+		"US.yml": []byte(`band-id: US_902_928
+`),
+	}), frequencyplans.WithOverrides(fetch.NewMemFetcher(map[string][]byte{
+		"frequency-plans.yml": []byte(`- id: US_902_928
+  name: Private United States
+  base-frequency: 915
+  file: US.yml
+- id: EU_PRIVATE
+  base-id: EU_863_870
+  name: Private Europe
+  base-frequency: 868
+  file: EU_PRIVATE.yml
+- id: EU_INVALID
+  base-id: EU_863_870
+  name: Invalid Europe
+  base-frequency: 868
+  file: EU_INVALID.yml
+`),
+		"US.yml": []byte(`band-id: US_902_928
+uplink-channels:
+- frequency: 902300000
+  min-data-rate: 0
+  max-data-rate: 3
+`),
+		"EU_PRIVATE.yml": []byte(`uplink-channels:
+- frequency: 867100000
+  min-data-rate: 0
+  max-data-rate: 5
+`),
+		"EU_INVALID.yml": []byte(`uplink-channels:
+- frequency: 915000000
+  min-data-rate: 0
+  max-data-rate: 5
+`),
+	})))
+
+	ids, err := store.GetAllIDs()
+	a.So(err, should.BeNil)
+	a.So(ids, should.Resemble, []string{"EU_863_870", "US_902_928", "EU_PRIVATE", "EU_INVALID"})
+
+	us, err := store.GetByID("US_902_928")
+	if a.So(err, should.BeNil) && a.So(us.UplinkChannels, should.HaveLength, 1) {
+		a.So(us.UplinkChannels[0].Frequency, should.Equal, 902300000)
+	}
+
+	private, err := store.GetByID("EU_PRIVATE")
+	if a.So(err, should.BeNil) {
+		a.So(private.BandID, should.Equal, "EU_863_870")
+		a.So(private.UplinkChannels[0].Frequency, should.Equal, 867100000)
+	}
+
+	_, err = store.GetByID("EU_INVALID")
+	a.So(err, should.NotBeNil)
+}
+
 func TestProtoConversion(t *testing.T) {
 	for i, tc := range []struct {
 		Input  *frequencyplans.FrequencyPlan
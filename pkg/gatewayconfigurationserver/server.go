@@ -54,7 +54,10 @@ func New(c *component.Component, conf *Config) (*Server, error) {
 		config:    conf,
 	}
 
-	bsCUPS := conf.BasicStation.NewServer(c)
+	bsCUPS, err := conf.BasicStation.NewServer(c)
+	if err != nil {
+		return nil, err
+	}
 	_ = bsCUPS
 
 	v2GCS := gcsv2.New(c, gcsv2.WithTheThingsGatewayConfig(conf.TheThingsGateway))
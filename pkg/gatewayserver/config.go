@@ -45,6 +45,32 @@ type PacketBrokerConfig struct {
 	OnlineTTLMargin       time.Duration `name:"online-ttl-margin" description:"Time to extend the online status before it expires"`
 }
 
+// UplinkDeduplicationConfig configures the deduplication of uplink messages across Gateway Server instances.
+type UplinkDeduplicationConfig struct {
+	Deduplicator        UplinkDeduplicator `name:"-"`
+	Enable              bool               `name:"enable" description:"Deduplicate uplink messages across Gateway Server instances, and accumulate their metadata"`
+	DeduplicationWindow time.Duration      `name:"deduplication-window" description:"Time window during which duplicate messages are collected for metadata"`
+	CooldownWindow      time.Duration      `name:"cooldown-window" description:"Time window starting right after deduplication window, during which duplicate messages are discarded"`
+}
+
+// NetworkTimeConfig configures the network time, which is the absolute time of reference gateways with a GPS
+// disciplined clock. The network time is used to schedule absolute time downlink messages, such as class B beacons
+// and ping slots, on gateways without GPS.
+type NetworkTimeConfig struct {
+	ReferenceGateways []string      `name:"reference-gateways" description:"IDs of the gateways with a GPS disciplined clock that are references of the network time (disabled if empty)"`
+	TTL               time.Duration `name:"ttl" description:"Time after which network time samples of the reference gateways expire"`
+	MinSamples        int           `name:"min-samples" description:"Minimum number of network time samples to schedule absolute time downlink messages on gateways without GPS"`
+}
+
+// SpectralScanConfig configures the storage of the spectral scan reports of gateways, which contain the background
+// RSSI of the gateway channels.
+type SpectralScanConfig struct {
+	Registry    SpectralScanRegistry `name:"-"`
+	Enable      bool                 `name:"enable" description:"Store the spectral scan reports of gateways to track the noise floor of their channels"`
+	HistorySize int                  `name:"history-size" description:"Maximum number of spectral scan reports to store per gateway"`
+	TTL         time.Duration        `name:"ttl" description:"Time to keep the spectral scan reports of a gateway after the most recent report"`
+}
+
 // Config represents the Gateway Server configuration.
 type Config struct {
 	RequireRegisteredGateways bool `name:"require-registered-gateways" description:"Require the gateways to be registered in the Identity Server"`
@@ -65,10 +91,15 @@ type Config struct {
 	Forward      map[string][]string `name:"forward" description:"Forward the DevAddr prefixes to the specified hosts"`
 	PacketBroker PacketBrokerConfig  `name:"packetbroker" description:"Packet Broker upstream configuration"`
 
-	MQTT         config.MQTT        `name:"mqtt"`
-	MQTTV2       config.MQTT        `name:"mqtt-v2"`
-	UDP          UDPConfig          `name:"udp"`
-	BasicStation BasicStationConfig `name:"basic-station"`
+	UplinkDeduplication UplinkDeduplicationConfig `name:"uplink-deduplication" description:"Uplink deduplication across Gateway Server instances"`
+	NetworkTime         NetworkTimeConfig         `name:"network-time" description:"Network time of reference gateways with GPS"`
+	SpectralScan        SpectralScanConfig        `name:"spectral-scan" description:"Noise floor history of gateway channels from spectral scan reports"`
+
+	MQTT                config.MQTT        `name:"mqtt"`
+	MQTTV2              config.MQTT        `name:"mqtt-v2"`
+	MQTTV2Compatibility bool               `name:"mqtt-v2-compatibility" description:"Accept legacy The Things Stack V2 MQTT topics and messages on the MQTT frontend"`
+	UDP                 UDPConfig          `name:"udp"`
+	BasicStation        BasicStationConfig `name:"basic-station"`
 }
 
 // ForwardDevAddrPrefixes parses the configured forward map.
@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const connectionStatsStreamBufferSize = 64
+
+// StreamGatewayConnectionStats implements ttnpb.GsServer.
+func (gs *GatewayServer) StreamGatewayConnectionStats(
+	req *ttnpb.BatchGetGatewayConnectionStatsRequest, stream ttnpb.Gs_StreamGatewayConnectionStatsServer,
+) error {
+	ctx, cancel := context.WithCancel(stream.Context())
+	defer cancel()
+
+	// Subscribe before getting the current connection stats, so that no changes are missed.
+	entityIDs := make([]*ttnpb.EntityIdentifiers, 0, len(req.GatewayIds))
+	for _, ids := range req.GatewayIds {
+		entityIDs = append(entityIDs, ids.GetEntityIdentifiers())
+	}
+	ch := make(events.Channel, connectionStatsStreamBufferSize)
+	if err := events.Subscribe(ctx, []string{evtGatewayConnectionStats.Definition().Name()}, entityIDs, ch); err != nil {
+		return err
+	}
+	// BatchGetGatewayConnectionStats asserts the rights on the gateways.
+	current, err := gs.BatchGetGatewayConnectionStats(ctx, req)
+	if err != nil {
+		return err
+	}
+	for _, ids := range req.GatewayIds {
+		stats, ok := current.Entries[ids.GatewayId]
+		if !ok {
+			continue
+		}
+		if err := stream.Send(&ttnpb.GatewayConnectionStatsUpdate{
+			GatewayIds: ids,
+			Stats:      stats,
+		}); err != nil {
+			return err
+		}
+	}
+
+	paths := req.FieldMask.GetPaths()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-ch:
+			update, ok := connectionStatsUpdateFromEvent(evt, paths...)
+			if !ok {
+				continue
+			}
+			if err := stream.Send(update); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// connectionStatsUpdateFromEvent returns the stream message of the gateway connection stats event.
+// The field mask paths are applied to the connection stats.
+func connectionStatsUpdateFromEvent(
+	evt events.Event, paths ...string,
+) (*ttnpb.GatewayConnectionStatsUpdate, bool) {
+	stats, ok := evt.Data().(*ttnpb.GatewayConnectionStats)
+	if !ok {
+		return nil, false
+	}
+	var ids *ttnpb.GatewayIdentifiers
+	for _, entityIDs := range evt.Identifiers() {
+		if ids = entityIDs.GetGatewayIds(); ids != nil {
+			break
+		}
+	}
+	if ids == nil {
+		return nil, false
+	}
+	if len(paths) > 0 {
+		selected, err := applyGatewayConnectionStatsFieldMask(nil, stats, paths...)
+		if err != nil {
+			return nil, false
+		}
+		stats = selected
+	}
+	return &ttnpb.GatewayConnectionStatsUpdate{
+		GatewayIds: ids,
+		Stats:      stats,
+	}, true
+}
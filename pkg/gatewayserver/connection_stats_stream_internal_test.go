@@ -0,0 +1,59 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestConnectionStatsUpdateFromEvent(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}
+	stats := &ttnpb.GatewayConnectionStats{
+		ConnectedAt: timestamppb.New(time.Unix(1, 0)),
+		Protocol:    "udp",
+		UplinkCount: 42,
+	}
+
+	update, ok := connectionStatsUpdateFromEvent(evtGatewayConnectionStats.NewWithIdentifiersAndData(ctx, ids, stats))
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(update.GatewayIds, should.Resemble, ids)
+	a.So(update.Stats, should.Resemble, stats)
+
+	update, ok = connectionStatsUpdateFromEvent(
+		evtGatewayConnectionStats.NewWithIdentifiersAndData(ctx, ids, stats), "connected_at", "disconnected_at",
+	)
+	if !a.So(ok, should.BeTrue) {
+		t.FailNow()
+	}
+	a.So(update.Stats, should.Resemble, &ttnpb.GatewayConnectionStats{
+		ConnectedAt: stats.ConnectedAt,
+	})
+
+	// Events without connection stats are skipped.
+	_, ok = connectionStatsUpdateFromEvent(events.New(ctx, "test.event", "test event", events.WithIdentifiers(ids)))
+	a.So(ok, should.BeFalse)
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// FineTimestampKeyAttributePrefix is the prefix of the gateway attributes that contain the AES keys to decrypt
+// encrypted fine timestamps. The attribute key is the prefix followed by the key ID, i.e. the AES key index of the
+// gateway. The attribute value is the hex encoded key, or the KEK label and the hex encoded wrapped key separated by
+// a colon.
+const FineTimestampKeyAttributePrefix = "fine-timestamp-key-"
+
+var errFineTimestampKey = errors.DefineInvalidArgument(
+	"fine_timestamp_key", "invalid fine timestamp key `{key_id}`",
+)
+
+// parseFineTimestampKey parses the value of a fine timestamp key attribute.
+func parseFineTimestampKey(value string) (*ttnpb.KeyEnvelope, error) {
+	kekLabel, keyHex := "", value
+	if i := strings.LastIndexByte(value, ':'); i >= 0 {
+		kekLabel, keyHex = value[:i], value[i+1:]
+	}
+	key, err := hex.DecodeString(keyHex)
+	if err != nil {
+		return nil, err
+	}
+	// Keys without KEK label are taken from the encrypted key field in the clear, which validates the key length.
+	return &ttnpb.KeyEnvelope{KekLabel: kekLabel, EncryptedKey: key}, nil
+}
+
+// fineTimestampKeys returns the AES keys by key ID from the fine timestamp key attributes of the gateway.
+func fineTimestampKeys(
+	ctx context.Context, gtw *ttnpb.Gateway, ks crypto.KeyService,
+) (map[string]types.AES128Key, error) {
+	var res map[string]types.AES128Key
+	for attr, value := range gtw.GetAttributes() {
+		if !strings.HasPrefix(attr, FineTimestampKeyAttributePrefix) {
+			continue
+		}
+		keyID := strings.TrimPrefix(attr, FineTimestampKeyAttributePrefix)
+		ke, err := parseFineTimestampKey(value)
+		if err != nil {
+			return nil, errFineTimestampKey.WithAttributes("key_id", keyID).WithCause(err)
+		}
+		key, err := cryptoutil.UnwrapAES128Key(ctx, ke, ks)
+		if err != nil {
+			return nil, errFineTimestampKey.WithAttributes("key_id", keyID).WithCause(err)
+		}
+		if res == nil {
+			res = make(map[string]types.AES128Key)
+		}
+		res[keyID] = key
+	}
+	return res, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestFineTimestampKeys(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ks := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"test": {0x00, 0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f},
+	}))
+	key1 := types.AES128Key{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}
+	key2 := types.AES128Key{0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2, 0x2}
+	wrapped, err := cryptoutil.WrapAES128Key(ctx, key2, "test", ks)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	keys, err := FineTimestampKeys(ctx, &ttnpb.Gateway{
+		Attributes: map[string]string{
+			"fine-timestamp-key-0": hex.EncodeToString(key1[:]),
+			"fine-timestamp-key-1": "test:" + hex.EncodeToString(wrapped.EncryptedKey),
+			"other":                "value",
+		},
+	}, ks)
+	if a.So(err, should.BeNil) {
+		a.So(keys, should.Resemble, map[string]types.AES128Key{
+			"0": key1,
+			"1": key2,
+		})
+	}
+
+	keys, err = FineTimestampKeys(ctx, &ttnpb.Gateway{}, ks)
+	a.So(err, should.BeNil)
+	a.So(keys, should.BeEmpty)
+
+	for _, value := range []string{
+		"not-hex",
+		"0102",
+		"unknown:" + hex.EncodeToString(wrapped.EncryptedKey),
+	} {
+		_, err := FineTimestampKeys(ctx, &ttnpb.Gateway{
+			Attributes: map[string]string{
+				"fine-timestamp-key-0": value,
+			},
+		}, ks)
+		a.So(err, should.NotBeNil)
+	}
+}
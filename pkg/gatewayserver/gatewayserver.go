@@ -42,6 +42,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/udp"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/lbslns"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/ns"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/upstream/packetbroker"
@@ -84,6 +85,11 @@ type GatewayServer struct {
 	connections sync.Map // string to connectionEntry
 
 	statsRegistry GatewayConnectionStatsRegistry
+
+	networkTime           *scheduling.NetworkTime
+	networkTimeReferences map[string]struct{}
+
+	spectralScans SpectralScanRegistry
 }
 
 // Option configures GatewayServer.
@@ -128,6 +134,13 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 
 	ctx = log.NewContextWithField(ctx, "namespace", logNamespace)
 
+	if conf.UplinkDeduplication.Enable && conf.UplinkDeduplication.Deduplicator == nil {
+		return nil, errUplinkDeduplicator.New()
+	}
+	if conf.SpectralScan.Enable && conf.SpectralScan.Registry == nil {
+		return nil, errSpectralScanRegistry.New()
+	}
+
 	gs = &GatewayServer{
 		Component:                 c,
 		ctx:                       ctx,
@@ -141,6 +154,16 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 	for _, opt := range opts {
 		opt(gs)
 	}
+	if len(conf.NetworkTime.ReferenceGateways) > 0 {
+		gs.networkTime = scheduling.NewNetworkTime(conf.NetworkTime.TTL, conf.NetworkTime.MinSamples)
+		gs.networkTimeReferences = make(map[string]struct{}, len(conf.NetworkTime.ReferenceGateways))
+		for _, id := range conf.NetworkTime.ReferenceGateways {
+			gs.networkTimeReferences[id] = struct{}{}
+		}
+	}
+	if conf.SpectralScan.Enable {
+		gs.spectralScans = conf.SpectralScan.Registry
+	}
 
 	// Setup forwarding table.
 	for name, prefix := range gs.forward {
@@ -184,6 +207,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 			"/ttn.lorawan.v3.Ns",
 			"/ttn.lorawan.v3.NsGs",
 			"/ttn.lorawan.v3.GtwGs",
+			"/ttn.lorawan.v3.GatewayNoiseFloorService",
 		} {
 			c.GRPC.RegisterUnaryHook(filter, hook.name, hook.middleware)
 		}
@@ -222,12 +246,16 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 
 	// Start MQTT listeners.
 	for _, version := range []struct {
-		Format mqtt.Format
-		Config config.MQTT
+		Format  mqtt.Format
+		Config  config.MQTT
+		Options []mqtt.Option
 	}{
 		{
 			Format: mqtt.NewProtobuf(gs.ctx),
 			Config: conf.MQTT,
+			Options: []mqtt.Option{
+				mqtt.WithV2Compatibility(conf.MQTTV2Compatibility),
+			},
 		},
 		{
 			Format: mqtt.NewProtobufV2(gs.ctx),
@@ -262,7 +290,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (gs *GatewayServe
 						)
 					}
 					defer lis.Close()
-					return mqtt.Serve(ctx, gs, lis, version.Format, endpoint.Protocol())
+					return mqtt.Serve(ctx, gs, lis, version.Format, endpoint.Protocol(), version.Options...)
 				},
 				Restart: task.RestartOnFailure,
 				Backoff: task.DefaultBackoffConfig,
@@ -371,12 +399,18 @@ func (gs *GatewayServer) RegisterServices(s *grpc.Server) {
 				return &config.MQTTV2, nil
 			})),
 	))
+	if gs.spectralScans != nil {
+		ttnpb.RegisterGatewayNoiseFloorServiceServer(s, &noiseFloorServer{GS: gs})
+	}
 }
 
 // RegisterHandlers registers gRPC handlers.
 func (gs *GatewayServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
 	ttnpb.RegisterGsHandler(gs.Context(), s, conn)
 	ttnpb.RegisterGtwGsHandler(gs.Context(), s, conn)
+	if gs.spectralScans != nil {
+		ttnpb.RegisterGatewayNoiseFloorServiceHandler(gs.Context(), s, conn) //nolint:errcheck
+	}
 }
 
 // Roles returns the roles that the Gateway Server fulfills.
@@ -515,6 +549,27 @@ func (gs *GatewayServer) Connect(
 
 	ids = gtw.GetIds()
 
+	// Invalid fine timestamp keys must not prevent the gateway from connecting.
+	if keys, err := fineTimestampKeys(ctx, gtw, gs.KeyService()); err != nil {
+		logger.WithError(err).Warn("Failed to get fine timestamp keys")
+	} else if len(keys) > 0 {
+		opts = append(opts, io.WithFineTimestampKeys(keys))
+	}
+	// Invalid uplink filter attributes must not prevent the gateway from connecting.
+	if filterOpts, err := uplinkFilterOptions(gtw); err != nil {
+		logger.WithError(err).Warn("Failed to get uplink filter options")
+	} else {
+		opts = append(opts, filterOpts...)
+	}
+
+	if gs.networkTime != nil {
+		_, reference := gs.networkTimeReferences[ids.GatewayId]
+		opts = append(opts, io.WithNetworkTime(gs.networkTime, reference))
+	}
+	if gs.spectralScans != nil {
+		opts = append(opts, io.WithSpectralScans(true))
+	}
+
 	fps, err := gs.FrequencyPlansStore(ctx)
 	if err != nil {
 		return nil, err
@@ -552,6 +607,7 @@ func (gs *GatewayServer) Connect(
 	gs.startDisconnectOnChangeTask(connEntry)
 	gs.startHandleUpstreamTask(connEntry)
 	gs.startUpdateConnStatsTask(connEntry)
+	gs.startHandleSpectralScansTask(connEntry)
 	// Unauthenticated connections cannot update the gateway entity.
 	// As such, there is no reason to start these tasks, since they
 	// will perpetually fail.
@@ -745,6 +801,8 @@ type upstreamHost struct {
 	pool          workerpool.WorkerPool[any]
 	gtw           *ttnpb.Gateway
 	correlationID string
+
+	deduplicateUplink func(context.Context, *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, bool, error)
 }
 
 func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
@@ -797,6 +855,18 @@ func (host *upstreamHost) handlePacket(ctx context.Context, item any) {
 		default:
 			pass = true
 		}
+		if pass && host.deduplicateUplink != nil {
+			mds, first, err := host.deduplicateUplink(ctx, msg.Message)
+			switch {
+			case err != nil:
+				logger.WithError(err).Warn("Failed to deduplicate uplink")
+			case !first:
+				logger.Debug("Accumulated metadata of duplicate uplink")
+				pass = false
+			default:
+				msg.Message.RxMetadata = mds
+			}
+		}
 		if !pass {
 			break
 		}
@@ -869,6 +939,10 @@ func (gs *GatewayServer) handleUpstream(ctx context.Context, conn connectionEntr
 			gtw:           gtw,
 			correlationID: fmt.Sprintf("gs:up:host:%s", events.NewCorrelationID()),
 		}
+		// Packet Broker forwards the uplink messages of each gateway, so their metadata is not accumulated.
+		if gs.config.UplinkDeduplication.Enable && name != "packetbroker" {
+			host.deduplicateUplink = gs.deduplicateUplink
+		}
 		wp := workerpool.NewWorkerPool(workerpool.Config[any]{
 			Component:  gs,
 			Context:    ctx,
@@ -15,3 +15,7 @@
 package gatewayserver
 
 var ErrSchedule = errSchedule
+
+var FineTimestampKeys = fineTimestampKeys
+
+var UplinkFilterOptions = uplinkFilterOptions
@@ -25,6 +25,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/errorcontext"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
@@ -33,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/task"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 )
@@ -105,23 +107,31 @@ type Connection struct {
 	ctx       context.Context
 	cancelCtx errorcontext.CancelFunc
 
-	connectTime      time.Time
-	frontend         Frontend
-	gateway          *ttnpb.Gateway
-	gatewayPrimaryFP *frequencyplans.FrequencyPlan
-	gatewayFPs       map[string]*frequencyplans.FrequencyPlan
-	band             *band.Band
-	fps              *frequencyplans.Store
-	scheduler        *scheduling.Scheduler
-	rtts             *rtts
-	addr             *ttnpb.GatewayRemoteAddress
-	streamActive     func(MessageStream) bool
+	connectTime       time.Time
+	frontend          Frontend
+	gateway           *ttnpb.Gateway
+	gatewayPrimaryFP  *frequencyplans.FrequencyPlan
+	gatewayFPs        map[string]*frequencyplans.FrequencyPlan
+	band              *band.Band
+	fps               *frequencyplans.Store
+	scheduler         *scheduling.Scheduler
+	rtts              *rtts
+	addr              *ttnpb.GatewayRemoteAddress
+	streamActive      func(MessageStream) bool
+	fineTimestampKeys map[string]types.AES128Key
+	phyCRCValidation  bool
+	duplicateFilter   *duplicateFilter
+
+	networkTime          *scheduling.NetworkTime
+	networkTimeReference bool
 
 	upCh     chan *ttnpb.GatewayUplinkMessage
 	downCh   chan *ttnpb.DownlinkMessage
 	statusCh chan *ttnpb.GatewayStatus
 	txAckCh  chan *ttnpb.TxAcknowledgment
 
+	spectralScanCh chan *SpectralScan
+
 	statsChangedCh       chan struct{}
 	locChangedCh         chan struct{}
 	versionInfoChangedCh chan struct{}
@@ -146,7 +156,13 @@ var (
 )
 
 type connectionOptions struct {
-	streamActive func(MessageStream) bool
+	streamActive          func(MessageStream) bool
+	fineTimestampKeys     map[string]types.AES128Key
+	phyCRCValidation      bool
+	duplicateFilterWindow time.Duration
+	networkTime           *scheduling.NetworkTime
+	networkTimeReference  bool
+	spectralScans         bool
 }
 
 // ConnectionOption is a Connection option.
@@ -160,6 +176,46 @@ func WithStreamActive(f func(MessageStream) bool) ConnectionOption {
 	})
 }
 
+// WithFineTimestampKeys sets the AES keys by key ID to decrypt the encrypted fine timestamps of the gateway.
+func WithFineTimestampKeys(keys map[string]types.AES128Key) ConnectionOption {
+	return ConnectionOption(func(opts *connectionOptions) {
+		opts.fineTimestampKeys = keys
+	})
+}
+
+// WithPHYCRCValidation enables validation of the PHY payload CRC by the Gateway Server.
+// This is for gateways that forward uplinks before CRC processing and append the CRC to the PHY payload.
+func WithPHYCRCValidation(enable bool) ConnectionOption {
+	return ConnectionOption(func(opts *connectionOptions) {
+		opts.phyCRCValidation = enable
+	})
+}
+
+// WithDuplicateFilterWindow sets the window in which uplinks with the same PHY payload received by the gateway are
+// dropped. By default, only consecutive repeated uplinks are dropped.
+func WithDuplicateFilterWindow(d time.Duration) ConnectionOption {
+	return ConnectionOption(func(opts *connectionOptions) {
+		opts.duplicateFilterWindow = d
+	})
+}
+
+// WithNetworkTime sets the network time model that is used to schedule absolute time downlink messages if the
+// gateway has no absolute time. If reference is true, the GPS time of uplink messages of the gateway is recorded in
+// the network time model.
+func WithNetworkTime(networkTime *scheduling.NetworkTime, reference bool) ConnectionOption {
+	return ConnectionOption(func(opts *connectionOptions) {
+		opts.networkTime = networkTime
+		opts.networkTimeReference = reference
+	})
+}
+
+// WithSpectralScans enables the spectral scan reports of the gateway. By default, spectral scan reports are ignored.
+func WithSpectralScans(enable bool) ConnectionOption {
+	return ConnectionOption(func(opts *connectionOptions) {
+		opts.spectralScans = enable
+	})
+}
+
 // NewConnection instantiates a new gateway connection.
 func NewConnection(
 	ctx context.Context,
@@ -206,6 +262,15 @@ func NewConnection(
 		}
 	}
 
+	var dupFilter *duplicateFilter
+	if connectionOptions.duplicateFilterWindow > 0 {
+		dupFilter = newDuplicateFilter(connectionOptions.duplicateFilterWindow)
+	}
+	var spectralScanCh chan *SpectralScan
+	if connectionOptions.spectralScans {
+		spectralScanCh = make(chan *SpectralScan, bufferSize)
+	}
+
 	ctx, cancelCtx := errorcontext.New(ctx)
 	scheduler, err := scheduling.NewScheduler(
 		ctx, gatewayFPs, enforceDutyCycle, frontend.DutyCycleStyle(), scheduleAnytimeDelay, nil,
@@ -217,23 +282,31 @@ func NewConnection(
 		ctx:       ctx,
 		cancelCtx: cancelCtx,
 
-		connectTime:      time.Now(),
-		frontend:         frontend,
-		gateway:          gateway,
-		gatewayPrimaryFP: fp0,
-		gatewayFPs:       gatewayFPs,
-		band:             &phy,
-		fps:              fps,
-		scheduler:        scheduler,
-		addr:             addr,
-		rtts:             newRTTs(maxRTTs, rttTTL),
-		streamActive:     connectionOptions.streamActive,
+		connectTime:       time.Now(),
+		frontend:          frontend,
+		gateway:           gateway,
+		gatewayPrimaryFP:  fp0,
+		gatewayFPs:        gatewayFPs,
+		band:              &phy,
+		fps:               fps,
+		scheduler:         scheduler,
+		addr:              addr,
+		rtts:              newRTTs(maxRTTs, rttTTL),
+		streamActive:      connectionOptions.streamActive,
+		fineTimestampKeys: connectionOptions.fineTimestampKeys,
+		phyCRCValidation:  connectionOptions.phyCRCValidation,
+		duplicateFilter:   dupFilter,
+
+		networkTime:          connectionOptions.networkTime,
+		networkTimeReference: connectionOptions.networkTimeReference,
 
 		upCh:     make(chan *ttnpb.GatewayUplinkMessage, bufferSize),
 		downCh:   make(chan *ttnpb.DownlinkMessage, bufferSize),
 		statusCh: make(chan *ttnpb.GatewayStatus, bufferSize),
 		txAckCh:  make(chan *ttnpb.TxAcknowledgment, bufferSize),
 
+		spectralScanCh: spectralScanCh,
+
 		statsChangedCh:       make(chan struct{}, 1),
 		locChangedCh:         make(chan struct{}, 1),
 		versionInfoChangedCh: make(chan struct{}, 1),
@@ -278,9 +351,17 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage, frontendSync *FrontendClo
 	if err := up.ValidateFields(); err != nil {
 		return err
 	}
+	if c.phyCRCValidation {
+		if err := validatePHYCRC(up); err != nil {
+			return err
+		}
+	}
 	if c.discardRepeatedUplink(up) {
 		return nil
 	}
+	if c.discardDuplicateUplink(up) {
+		return nil
+	}
 
 	receivedAt := *ttnpb.StdTime(up.ReceivedAt)
 	gpsTime := func(mds []*ttnpb.RxMetadata) *timestamppb.Timestamp {
@@ -330,9 +411,14 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage, frontendSync *FrontendClo
 	receivedAtGateway := receivedAt
 	if _, _, median, _, count := c.RTTStats(100, receivedAt); count > 0 {
 		receivedAtGateway = receivedAt.Add(-median / 2)
+		// The reception time at the gateway is only known when the round-trip times are known.
+		if c.networkTimeReference && gpsTime != nil {
+			c.networkTime.Record(*ttnpb.StdTime(gpsTime), receivedAtGateway)
+		}
 	}
 	for _, md := range up.RxMetadata {
 		md.ReceivedAt = timestamppb.New(receivedAtGateway)
+		c.decryptFineTimestamp(md)
 
 		if md.AntennaIndex != 0 {
 			// TODO: Support downlink path to multiple antennas (https://github.com/TheThingsNetwork/lorawan-stack/issues/48)
@@ -383,6 +469,27 @@ func (c *Connection) HandleUp(up *ttnpb.UplinkMessage, frontendSync *FrontendClo
 	return nil
 }
 
+// decryptFineTimestamp decrypts the encrypted fine timestamp of the metadata, if the key is known.
+// The encrypted fine timestamp is kept if it cannot be decrypted, so that it can be decrypted further upstream.
+func (c *Connection) decryptFineTimestamp(md *ttnpb.RxMetadata) {
+	if len(md.EncryptedFineTimestamp) == 0 {
+		return
+	}
+	key, ok := c.fineTimestampKeys[md.EncryptedFineTimestampKeyId]
+	if !ok {
+		return
+	}
+	ns, err := crypto.DecryptFineTimestamp(key, md.EncryptedFineTimestamp)
+	if err != nil {
+		log.FromContext(c.ctx).WithError(err).WithField(
+			"key_id", md.EncryptedFineTimestampKeyId,
+		).Debug("Failed to decrypt fine timestamp")
+		return
+	}
+	md.FineTimestamp = uint64(ns)
+	md.EncryptedFineTimestamp, md.EncryptedFineTimestampKeyId = nil, ""
+}
+
 // HandleStatus updates the status stats and sends the status to the status channel.
 func (c *Connection) HandleStatus(status *ttnpb.GatewayStatus) (err error) {
 	defer func() {
@@ -669,6 +776,7 @@ func (c *Connection) ScheduleDown(path *ttnpb.DownlinkPath, msg *ttnpb.DownlinkM
 			RTTs:        c.rtts,
 			Priority:    request.Priority,
 			UplinkToken: uplinkToken, // uplinkToken is always present with class A downlink, but may be nil otherwise.
+			NetworkTime: c.networkTime,
 		})
 		if err != nil {
 			logger.WithError(err).Debug("Failed to schedule downlink in Rx window")
@@ -16,10 +16,14 @@ package io
 
 import (
 	"testing"
+	"time"
 
 	"github.com/smarty/assertions"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 )
 
@@ -152,3 +156,88 @@ func TestUplinkMessageFromProto(t *testing.T) {
 		antennas:      []uint32{0, 3},
 	})
 }
+
+func TestDecryptFineTimestamp(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	key := types.AES128Key{0x1, 0x2, 0x3, 0x4, 0x5, 0x6, 0x7, 0x8, 0x9, 0xa, 0xb, 0xc, 0xd, 0xe, 0xf, 0x10}
+	encrypted, err := crypto.EncryptFineTimestamp(key, 123456789)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	c := &Connection{
+		ctx: test.Context(),
+		fineTimestampKeys: map[string]types.AES128Key{
+			"1": key,
+		},
+	}
+
+	md := &ttnpb.RxMetadata{
+		EncryptedFineTimestamp:      encrypted,
+		EncryptedFineTimestampKeyId: "1",
+	}
+	c.decryptFineTimestamp(md)
+	a.So(md, should.Resemble, &ttnpb.RxMetadata{
+		FineTimestamp: 123456789,
+	})
+
+	// Unknown keys leave the encrypted fine timestamp as is.
+	md = &ttnpb.RxMetadata{
+		EncryptedFineTimestamp:      encrypted,
+		EncryptedFineTimestampKeyId: "2",
+	}
+	c.decryptFineTimestamp(md)
+	a.So(md.FineTimestamp, should.BeZeroValue)
+	a.So(md.EncryptedFineTimestamp, should.Resemble, encrypted)
+
+	// Incorrect keys leave the encrypted fine timestamp as is.
+	c.fineTimestampKeys["2"] = types.AES128Key{0x1}
+	c.decryptFineTimestamp(md)
+	a.So(md.FineTimestamp, should.BeZeroValue)
+	a.So(md.EncryptedFineTimestamp, should.Resemble, encrypted)
+}
+
+func TestValidatePHYCRC(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	// CRC-16/XMODEM check value of "123456789" is 0x31c3.
+	a.So(phyCRC([]byte("123456789")), should.Equal, 0x31c3)
+
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x1, 0x2, 0x3, 0x4},
+	}
+	crc := phyCRC(up.RawPayload)
+	up.RawPayload = append(up.RawPayload, byte(crc), byte(crc>>8))
+	if a.So(validatePHYCRC(up), should.BeNil) {
+		a.So(up.RawPayload, should.Resemble, []byte{0x40, 0x1, 0x2, 0x3, 0x4})
+		a.So(up.CrcStatus.GetValue(), should.BeTrue)
+	}
+
+	up = &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x1, 0x2, 0x3, 0x4, 0x0, 0x0},
+	}
+	a.So(validatePHYCRC(up), should.NotBeNil)
+	a.So(up.RawPayload, should.HaveLength, 7)
+	a.So(up.CrcStatus, should.BeNil)
+
+	up = &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x0, 0x0},
+	}
+	a.So(validatePHYCRC(up), should.NotBeNil)
+}
+
+func TestDuplicateFilter(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	f := newDuplicateFilter(time.Second)
+	now := time.Unix(0, 0)
+	a.So(f.seen([]byte{0x1}, now), should.BeFalse)
+	a.So(f.seen([]byte{0x2}, now), should.BeFalse)
+	a.So(f.seen([]byte{0x1}, now.Add(500*time.Millisecond)), should.BeTrue)
+	a.So(f.seen([]byte{0x1}, now.Add(time.Second)), should.BeFalse)
+	a.So(f.payloads, should.HaveLength, 1)
+	a.So(f.seen([]byte{0x1}, now.Add(1500*time.Millisecond)), should.BeTrue)
+}
@@ -19,6 +19,7 @@ import (
 	"fmt"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/TheThingsIndustries/mystique/pkg/auth"
@@ -42,9 +43,35 @@ import (
 
 const qosDownlink byte = 0
 
+type options struct {
+	v2Compatibility bool
+}
+
+// Option configures the MQTT frontend.
+type Option func(*options)
+
+// WithV2Compatibility enables the compatibility layer for gateways that use the legacy The Things Stack V2 topics
+// and messages. Uplink and status messages published on V2 topics are translated, and downlink messages are published
+// on the V2 downlink topic when the gateway subscribes to it.
+func WithV2Compatibility(enable bool) Option {
+	return func(opts *options) {
+		opts.v2Compatibility = enable
+	}
+}
+
 // Serve serves the MQTT frontend.
-func Serve(ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string) error {
+func Serve(
+	ctx context.Context, server io.Server, listener net.Listener, format Format, protocol string, opts ...Option,
+) error {
 	ctx = log.NewContextWithField(ctx, "namespace", "gatewayserver/io/mqtt")
+	options := &options{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	var v2Format Format
+	if options.v2Compatibility {
+		v2Format = NewProtobufV2(ctx)
+	}
 	lis := mqttnet.NewListener(listener, protocol)
 	go func() {
 		<-ctx.Done()
@@ -54,17 +81,21 @@ func Serve(ctx context.Context, server io.Server, listener net.Listener, format
 		ctx, lis, server,
 		ratelimit.GatewayAcceptMQTTConnectionResource, server.RateLimiter(),
 		func(ctx context.Context, mqttConn mqttnet.Conn) error {
-			return setupConnection(ctx, mqttConn, format, server)
+			return setupConnection(ctx, mqttConn, format, v2Format, server)
 		},
 	)
 }
 
 type connection struct {
 	format   Format
+	v2Format Format
 	server   io.Server
 	io       *io.Connection
 	tokens   io.DownlinkTokens
 	resource ratelimit.Resource
+
+	// v2Downlink indicates that the gateway subscribed to the V2 downlink topic.
+	v2Downlink atomic.Bool
 }
 
 func (*connection) Protocol() string            { return "mqtt" }
@@ -73,10 +104,11 @@ func (*connection) DutyCycleStyle() scheduling.DutyCycleStyle {
 	return scheduling.DefaultDutyCycleStyle
 }
 
-func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format, server io.Server) error {
+func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format, v2Format Format, server io.Server) error {
 	c := &connection{
-		format: format,
-		server: server,
+		format:   format,
+		v2Format: v2Format,
+		server:   server,
 	}
 
 	ctx = auth.NewContextWithInterface(ctx, c)
@@ -104,6 +136,7 @@ func setupConnection(ctx context.Context, mqttConn mqttnet.Conn, format Format,
 				ctx := events.ContextWithCorrelationID(ctx, correlationIDs...)
 				down.CorrelationIds = events.CorrelationIDsFromContext(ctx)
 
+				format := c.downlinkFormat()
 				buf, err := format.FromDownlink(down, c.io.Gateway().GetIds())
 				if err != nil {
 					logger.WithError(err).Warn("Failed to marshal downlink message")
@@ -191,6 +224,15 @@ func (c *connection) Connect(ctx context.Context, info *auth.Info) (context.Cont
 			c.format.TxAckTopic(uid),
 		},
 	}
+	if c.v2Format != nil {
+		access.reads = append(access.reads, c.v2Format.DownlinkTopic(uid))
+		access.writes = append(access.writes,
+			c.v2Format.BirthTopic(uid),
+			c.v2Format.LastWillTopic(uid),
+			c.v2Format.UplinkTopic(uid),
+			c.v2Format.StatusTopic(uid),
+		)
+	}
 	info.Metadata = access
 	info.Interface = c
 	return c.io.Context(), nil
@@ -200,15 +242,50 @@ var errNotAuthorized = errors.DefinePermissionDenied("not_authorized", "not auth
 
 func (c *connection) Subscribe(info *auth.Info, requestedTopic string, requestedQoS byte) (acceptedTopic string, acceptedQoS byte, err error) {
 	access := info.Metadata.(topicAccess)
+	requestedTopicParts := topic.Split(requestedTopic)
 	acceptedTopicParts := c.format.DownlinkTopic(access.gtwUID)
-	if !topic.MatchPath(acceptedTopicParts, topic.Split(requestedTopic)) {
-		return "", 0, errNotAuthorized.New()
+	if !topic.MatchPath(acceptedTopicParts, requestedTopicParts) {
+		if c.v2Format == nil {
+			return "", 0, errNotAuthorized.New()
+		}
+		acceptedTopicParts = c.v2Format.DownlinkTopic(access.gtwUID)
+		if !topic.MatchPath(acceptedTopicParts, requestedTopicParts) {
+			return "", 0, errNotAuthorized.New()
+		}
+		c.v2Downlink.Store(true)
 	}
 	acceptedTopic = topic.Join(acceptedTopicParts)
 	acceptedQoS = requestedQoS
 	return
 }
 
+// downlinkFormat returns the format of the downlink messages, which depends on the downlink topic that the gateway
+// subscribed to.
+func (c *connection) downlinkFormat() Format {
+	if c.v2Downlink.Load() {
+		return c.v2Format
+	}
+	return c.format
+}
+
+// uplinkFormat returns the format of the messages published on the given topic.
+func (c *connection) uplinkFormat(topicParts []string) Format {
+	if c.v2Format == nil {
+		return c.format
+	}
+	for _, isTopic := range []func([]string) bool{
+		c.v2Format.IsBirthTopic,
+		c.v2Format.IsLastWillTopic,
+		c.v2Format.IsUplinkTopic,
+		c.v2Format.IsStatusTopic,
+	} {
+		if isTopic(topicParts) {
+			return c.v2Format
+		}
+	}
+	return c.format
+}
+
 func (c *connection) CanRead(info *auth.Info, topicParts ...string) bool {
 	access := info.Metadata.(topicAccess)
 	for _, reads := range access.reads {
@@ -238,11 +315,12 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		return
 	}
 
+	format := c.uplinkFormat(pkt.TopicParts)
 	switch {
-	case c.format.IsBirthTopic(pkt.TopicParts):
-	case c.format.IsLastWillTopic(pkt.TopicParts):
-	case c.format.IsUplinkTopic(pkt.TopicParts):
-		up, err := c.format.ToUplink(pkt.Message, c.io.Gateway().GetIds())
+	case format.IsBirthTopic(pkt.TopicParts):
+	case format.IsLastWillTopic(pkt.TopicParts):
+	case format.IsUplinkTopic(pkt.TopicParts):
+		up, err := format.ToUplink(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal uplink message")
 			return
@@ -251,8 +329,8 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		if err := c.io.HandleUp(up, nil); err != nil {
 			logger.WithError(err).Warn("Failed to handle uplink message")
 		}
-	case c.format.IsStatusTopic(pkt.TopicParts):
-		status, err := c.format.ToStatus(pkt.Message, c.io.Gateway().GetIds())
+	case format.IsStatusTopic(pkt.TopicParts):
+		status, err := format.ToStatus(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal status message")
 			return
@@ -260,8 +338,8 @@ func (c *connection) deliver(pkt *packet.PublishPacket) {
 		if err := c.io.HandleStatus(status); err != nil {
 			logger.WithError(err).Warn("Failed to handle status message")
 		}
-	case c.format.IsTxAckTopic(pkt.TopicParts):
-		ack, err := c.format.ToTxAck(pkt.Message, c.io.Gateway().GetIds())
+	case format.IsTxAckTopic(pkt.TopicParts):
+		ack, err := format.ToTxAck(pkt.Message, c.io.Gateway().GetIds())
 		if err != nil {
 			logger.WithError(err).Warn("Failed to unmarshal Tx acknowledgment message")
 			return
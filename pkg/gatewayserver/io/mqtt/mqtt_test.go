@@ -23,6 +23,7 @@ import (
 
 	mqtt "github.com/eclipse/paho.mqtt.golang"
 	"github.com/smarty/assertions"
+	ttnpbv2 "go.thethings.network/lorawan-stack-legacy/v2/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/component"
@@ -479,3 +480,159 @@ func TestTraffic(t *testing.T) {
 		}
 	})
 }
+
+func TestV2Compatibility(t *testing.T) {
+	a := assertions.New(t)
+
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	ctx, cancelCtx := context.WithCancel(ctx)
+	defer cancelCtx()
+
+	is, isAddr, closeIS := mockis.New(ctx)
+	defer closeIS()
+	testGtw := mockis.DefaultGateway(registeredGatewayID, false, false)
+	is.GatewayRegistry().Add(ctx, registeredGatewayID, registeredGatewayKey, testGtw, testRights...)
+
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			GRPC: config.GRPC{
+				Listen:                      ":0",
+				AllowInsecureForCredentials: true,
+			},
+			Cluster: cluster.Config{
+				IdentityServer: isAddr,
+			},
+			FrequencyPlans: config.FrequencyPlansConfig{
+				ConfigSource: "static",
+				Static:       test.StaticFrequencyPlans,
+			},
+		},
+	})
+	componenttest.StartComponent(t, c)
+	defer c.Close()
+	mustHavePeer(ctx, c, ttnpb.ClusterRole_ENTITY_REGISTRY)
+
+	gs := mock.NewServer(c, is)
+	lis, err := net.Listen("tcp", ":0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	go Serve(ctx, gs, lis, NewProtobuf(ctx), "tcp", WithV2Compatibility(true))
+
+	clientOpts := mqtt.NewClientOptions()
+	clientOpts.AddBroker(fmt.Sprintf("tcp://%v", lis.Addr()))
+	clientOpts.SetUsername(registeredGatewayUID)
+	clientOpts.SetPassword(registeredGatewayKey)
+	client := mqtt.NewClient(clientOpts)
+	token := client.Connect()
+	if !token.WaitTimeout(timeout) {
+		t.Fatal("Connection timeout")
+	}
+	if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+
+	var conn *io.Connection
+	select {
+	case conn = <-gs.Connections():
+	case <-time.After(timeout):
+		t.Fatal("Connection timeout")
+	}
+	defer client.Disconnect(100)
+
+	for _, tc := range []struct {
+		Topic   string
+		Message proto.Message
+	}{
+		{
+			Topic: fmt.Sprintf("v3/%v/status", registeredGatewayUID),
+			Message: &ttnpb.GatewayStatus{
+				Time: timestamppb.Now(),
+				Ip:   []string{"1.1.1.1"},
+			},
+		},
+		{
+			Topic: fmt.Sprintf("%v/status", registeredGatewayUID),
+			Message: &ttnpbv2.StatusMessage{
+				TxIn: 5,
+				TxOk: 3,
+				RxIn: 15,
+				RxOk: 14,
+			},
+		},
+	} {
+		buf, err := proto.Marshal(tc.Message)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		token := client.Publish(tc.Topic, 1, false, buf)
+		if !token.WaitTimeout(timeout) {
+			t.Fatal("Publish timeout")
+		}
+		if !a.So(token.Error(), should.BeNil) {
+			t.FailNow()
+		}
+		select {
+		case status := <-conn.Status():
+			a.So(status, should.NotBeNil)
+		case <-time.After(timeout):
+			t.Fatalf("Receive expected status on %s timeout", tc.Topic)
+		}
+	}
+
+	// Subscribing to the V2 downlink topic makes the gateway receive V2 downlink messages.
+	downCh := make(chan []byte, 1)
+	token = client.Subscribe(fmt.Sprintf("%v/down", registeredGatewayUID), 1, func(_ mqtt.Client, msg mqtt.Message) {
+		downCh <- msg.Payload()
+	})
+	if !token.WaitTimeout(timeout) {
+		t.Fatal("Subscribe timeout")
+	}
+	if !a.So(token.Error(), should.BeNil) {
+		t.FailNow()
+	}
+	msg := &ttnpb.DownlinkMessage{
+		RawPayload: []byte{0x01},
+		Settings: &ttnpb.DownlinkMessage_Request{
+			Request: &ttnpb.TxRequest{
+				Class:    ttnpb.Class_CLASS_A,
+				Priority: ttnpb.TxSchedulePriority_NORMAL,
+				Rx1Delay: ttnpb.RxDelay_RX_DELAY_1,
+				Rx1DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 7,
+							Bandwidth:       125000,
+							CodingRate:      band.Cr4_5,
+						},
+					},
+				},
+				Rx1Frequency:    868100000,
+				FrequencyPlanId: test.EUFrequencyPlanID,
+			},
+		},
+	}
+	_, _, _, err = conn.ScheduleDown(&ttnpb.DownlinkPath{
+		Path: &ttnpb.DownlinkPath_UplinkToken{
+			UplinkToken: io.MustUplinkToken(
+				&ttnpb.GatewayAntennaIdentifiers{GatewayIds: registeredGatewayID},
+				100,
+				100000,
+				time.Unix(0, 100*1000),
+				nil,
+			),
+		},
+	}, msg)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	select {
+	case buf := <-downCh:
+		down := &ttnpbv2.DownlinkMessage{}
+		if a.So(proto.Unmarshal(buf, down), should.BeNil) {
+			a.So(down.Payload, should.Resemble, msg.RawPayload)
+		}
+	case <-time.After(timeout):
+		t.Fatal("Receive expected downlink timeout")
+	}
+}
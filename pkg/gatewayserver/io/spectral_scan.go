@@ -0,0 +1,87 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+// SpectralScanChannel is the background RSSI measured by a gateway on a channel.
+type SpectralScanChannel struct {
+	Frequency uint64  `json:"frequency"`
+	RSSI      float32 `json:"rssi"`
+}
+
+// SpectralScan is a spectral scan report of a gateway.
+type SpectralScan struct {
+	Time     time.Time             `json:"time"`
+	Channels []SpectralScanChannel `json:"channels"`
+}
+
+var (
+	errSpectralScanNoChannels = errors.DefineInvalidArgument(
+		"spectral_scan_no_channels",
+		"no channels in spectral scan",
+	)
+	errSpectralScanFrequency = errors.DefineInvalidArgument(
+		"spectral_scan_frequency",
+		"invalid frequency `{frequency}` in spectral scan",
+	)
+)
+
+// Validate returns an error if the spectral scan is invalid.
+func (s *SpectralScan) Validate() error {
+	if len(s.Channels) == 0 {
+		return errSpectralScanNoChannels.New()
+	}
+	for _, ch := range s.Channels {
+		if ch.Frequency == 0 {
+			return errSpectralScanFrequency.WithAttributes("frequency", ch.Frequency)
+		}
+	}
+	return nil
+}
+
+// HandleSpectralScan sends the spectral scan report to the spectral scan channel.
+// Spectral scan reports are ignored if they are not enabled for the connection.
+func (c *Connection) HandleSpectralScan(scan *SpectralScan) (err error) {
+	if c.spectralScanCh == nil {
+		return nil
+	}
+	defer func() {
+		if err != nil {
+			registerDropMessage(c.ctx, c.gateway, "spectral_scan", err)
+		}
+	}()
+	if err := scan.Validate(); err != nil {
+		return err
+	}
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case c.spectralScanCh <- scan:
+	default:
+		return errBufferFull.New()
+	}
+	return nil
+}
+
+// SpectralScans returns the spectral scan reports channel.
+// The channel is nil if spectral scan reports are not enabled for the connection.
+func (c *Connection) SpectralScans() <-chan *SpectralScan {
+	return c.spectralScanCh
+}
@@ -317,6 +317,11 @@ func (s *srv) handleUp(ctx context.Context, st *state, packet encoding.Packet) e
 				logger.WithError(err).Warn("Failed to handle status message")
 			}
 		}
+		if packet.Data.Spectral != nil {
+			if err := st.io.HandleSpectralScan(spectralScan(packet.Data.Spectral, packet.ReceivedAt)); err != nil {
+				logger.WithError(err).Warn("Failed to handle spectral scan")
+			}
+		}
 
 	case encoding.TxAck:
 		atomic.StoreInt64(&st.lastSeenPull, now.UnixNano())
@@ -564,3 +569,20 @@ func (st *state) createStreamActive(pushTimeout, pullTimeout time.Duration) func
 		}
 	}
 }
+
+func spectralScan(spectral *encoding.Spectral, receivedAt time.Time) *io.SpectralScan {
+	scan := &io.SpectralScan{
+		Time:     receivedAt,
+		Channels: make([]io.SpectralScanChannel, 0, len(spectral.Chan)),
+	}
+	if spectral.Time != nil {
+		scan.Time = time.Time(*spectral.Time)
+	}
+	for _, ch := range spectral.Chan {
+		scan.Channels = append(scan.Channels, io.SpectralScanChannel{
+			Frequency: uint64(ch.Freq * 1000000),
+			RSSI:      ch.RSSI,
+		})
+	}
+	return scan
+}
@@ -0,0 +1,116 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package io
+
+import (
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+// phyCRCLength is the length of the PHY payload CRC appended by gateways that forward uplinks before CRC processing.
+const phyCRCLength = 2
+
+var (
+	errPHYPayloadTooShort = errors.DefineInvalidArgument(
+		"phy_payload_too_short", "PHY payload with CRC is too short",
+	)
+	errPHYCRCMismatch = errors.DefineInvalidArgument(
+		"phy_crc_mismatch", "PHY payload CRC mismatch",
+	)
+)
+
+// phyCRC computes the CRC-16/CCITT (polynomial 0x1021, initial value 0x0000) of the PHY payload, as used by LoRa.
+func phyCRC(b []byte) uint16 {
+	var crc uint16
+	for _, v := range b {
+		crc ^= uint16(v) << 8
+		for i := 0; i < 8; i++ {
+			if crc&0x8000 != 0 {
+				crc = crc<<1 ^ 0x1021
+			} else {
+				crc <<= 1
+			}
+		}
+	}
+	return crc
+}
+
+// validatePHYCRC validates the little endian CRC that is appended to the raw payload of the uplink message.
+// If the CRC is valid, it is removed from the raw payload and the CRC status of the uplink message is set.
+func validatePHYCRC(up *ttnpb.UplinkMessage) error {
+	n := len(up.RawPayload) - phyCRCLength
+	if n <= 0 {
+		return errPHYPayloadTooShort.New()
+	}
+	if binary.LittleEndian.Uint16(up.RawPayload[n:]) != phyCRC(up.RawPayload[:n]) {
+		return errPHYCRCMismatch.New()
+	}
+	up.RawPayload = up.RawPayload[:n]
+	up.CrcStatus = wrapperspb.Bool(true)
+	return nil
+}
+
+// duplicateFilter keeps track of the PHY payloads received by a gateway in a time window.
+type duplicateFilter struct {
+	window time.Duration
+
+	mu       sync.Mutex
+	payloads map[uint64]time.Time
+}
+
+func newDuplicateFilter(window time.Duration) *duplicateFilter {
+	return &duplicateFilter{
+		window:   window,
+		payloads: make(map[uint64]time.Time),
+	}
+}
+
+// seen returns whether the PHY payload has been received in the window before the given time.
+// Otherwise, the PHY payload is recorded.
+func (f *duplicateFilter) seen(payload []byte, now time.Time) bool {
+	hash := payloadHash(payload)
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if t, ok := f.payloads[hash]; ok && now.Sub(t) < f.window {
+		return true
+	}
+	for h, t := range f.payloads {
+		if now.Sub(t) >= f.window {
+			delete(f.payloads, h)
+		}
+	}
+	f.payloads[hash] = now
+	return false
+}
+
+// discardDuplicateUplink returns true if the uplink message has the same PHY payload as an uplink message that was
+// received by the connection in the duplicate filter window. This is for gateways that forward the same uplink
+// multiple times, such as multi-demodulator SDR-based forwarders.
+func (c *Connection) discardDuplicateUplink(up *ttnpb.UplinkMessage) bool {
+	if c.duplicateFilter == nil {
+		return false
+	}
+	if !c.duplicateFilter.seen(up.RawPayload, *ttnpb.StdTime(up.ReceivedAt)) {
+		return false
+	}
+	log.FromContext(c.ctx).Debug("Dropped duplicate gateway uplink")
+	return true
+}
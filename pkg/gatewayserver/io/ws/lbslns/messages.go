@@ -33,6 +33,8 @@ const (
 	TypeUpstreamTxConfirmation       = "dntxed"
 	TypeUpstreamTimeSync             = "timesync"
 	TypeUpstreamRemoteShell          = "rmtsh"
+	// TypeUpstreamSpectralScan is an extension for gateways that report the background RSSI of their channels.
+	TypeUpstreamSpectralScan = "spectral_scan"
 
 	// Downstream types for messages from the Network
 	TypeDownstreamDownlinkMessage           = "dnmsg"
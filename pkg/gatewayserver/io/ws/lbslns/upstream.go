@@ -44,12 +44,31 @@ var (
 
 // UpInfo provides additional metadata on each upstream message.
 type UpInfo struct {
-	RxTime  float64 `json:"rxtime"`
-	RCtx    int64   `json:"rtcx"`
-	XTime   int64   `json:"xtime"`
-	GPSTime int64   `json:"gpstime"`
-	RSSI    float32 `json:"rssi"`
-	SNR     float32 `json:"snr"`
+	RxTime        float64 `json:"rxtime"`
+	RCtx          int64   `json:"rtcx"`
+	XTime         int64   `json:"xtime"`
+	GPSTime       int64   `json:"gpstime"`
+	FineTimestamp *int64  `json:"fts,omitempty"`
+	RSSI          float32 `json:"rssi"`
+	SNR           float32 `json:"snr"`
+}
+
+// fineTimestamp returns the fine timestamp in nanoseconds.
+// Gateways without fine timestamping omit the fine timestamp or report -1.
+func (u UpInfo) fineTimestamp() uint64 {
+	if u.FineTimestamp == nil || *u.FineTimestamp < 0 {
+		return 0
+	}
+	return uint64(*u.FineTimestamp)
+}
+
+// upInfoFineTimestamp returns the fine timestamp of the metadata, or nil if there is no fine timestamp.
+func upInfoFineTimestamp(md *ttnpb.RxMetadata) *int64 {
+	if md.FineTimestamp == 0 {
+		return nil
+	}
+	fts := int64(md.FineTimestamp)
+	return &fts
 }
 
 // RadioMetaData is a the metadata that is received as part of all upstream messages (except Tx Confirmation).
@@ -185,6 +204,49 @@ func (tsr TimeSyncResponse) MarshalJSON() ([]byte, error) {
 	})
 }
 
+// SpectralScan is the spectral scan report from the BasicStation.
+type SpectralScan struct {
+	// Time is the time of the scan in seconds since the Unix epoch. If zero, the time of receipt is used.
+	Time     float64               `json:"time,omitempty"`
+	Channels []SpectralScanChannel `json:"channels"`
+}
+
+// SpectralScanChannel is the background RSSI of a channel.
+type SpectralScanChannel struct {
+	Freq uint64  `json:"Freq"`
+	RSSI float32 `json:"RSSI"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (scan SpectralScan) MarshalJSON() ([]byte, error) {
+	type Alias SpectralScan
+	return json.Marshal(struct {
+		Type string `json:"msgtype"`
+		Alias
+	}{
+		Type:  TypeUpstreamSpectralScan,
+		Alias: Alias(scan),
+	})
+}
+
+// toSpectralScan converts the spectral scan report to an io.SpectralScan.
+func (scan SpectralScan) toSpectralScan(receivedAt time.Time) *io.SpectralScan {
+	res := &io.SpectralScan{
+		Time:     receivedAt,
+		Channels: make([]io.SpectralScanChannel, 0, len(scan.Channels)),
+	}
+	if scan.Time != 0 {
+		res.Time = ws.TimeFromUnixSeconds(scan.Time)
+	}
+	for _, ch := range scan.Channels {
+		res.Channels = append(res.Channels, io.SpectralScanChannel{
+			Frequency: ch.Freq,
+			RSSI:      ch.RSSI,
+		})
+	}
+	return res
+}
+
 // toUplinkMessage extracts fields from the Basics Station Join Request "jreq" message and converts them into an UplinkMessage for the network server.
 func (req *JoinRequest) toUplinkMessage(ids *ttnpb.GatewayIdentifiers, bandID string, receivedAt time.Time) (*ttnpb.UplinkMessage, error) {
 	var up ttnpb.UplinkMessage
@@ -219,14 +281,15 @@ func (req *JoinRequest) toUplinkMessage(ids *ttnpb.GatewayIdentifiers, bandID st
 	gpsTime := ws.TimePtrFromGPSTime(req.UpInfo.GPSTime)
 	up.RxMetadata = []*ttnpb.RxMetadata{
 		{
-			GatewayIds:   ids,
-			Time:         ttnpb.ProtoTime(tm),
-			GpsTime:      ttnpb.ProtoTime(gpsTime),
-			Timestamp:    timestamp,
-			Rssi:         req.RadioMetaData.UpInfo.RSSI,
-			ChannelRssi:  req.RadioMetaData.UpInfo.RSSI,
-			Snr:          req.RadioMetaData.UpInfo.SNR,
-			AntennaIndex: uint32(req.RadioMetaData.UpInfo.RCtx),
+			GatewayIds:    ids,
+			Time:          ttnpb.ProtoTime(tm),
+			GpsTime:       ttnpb.ProtoTime(gpsTime),
+			Timestamp:     timestamp,
+			Rssi:          req.RadioMetaData.UpInfo.RSSI,
+			ChannelRssi:   req.RadioMetaData.UpInfo.RSSI,
+			Snr:           req.RadioMetaData.UpInfo.SNR,
+			AntennaIndex:  uint32(req.RadioMetaData.UpInfo.RCtx),
+			FineTimestamp: req.RadioMetaData.UpInfo.fineTimestamp(),
 		},
 	}
 
@@ -292,12 +355,13 @@ func (req *JoinRequest) FromUplinkMessage(up *ttnpb.UplinkMessage, bandID string
 		DataRate:  int(drIdx),
 		Frequency: up.Settings.GetFrequency(),
 		UpInfo: UpInfo{
-			RCtx:    int64(rxMetadata.AntennaIndex),
-			XTime:   int64(rxMetadata.Timestamp),
-			RSSI:    rxMetadata.Rssi,
-			SNR:     rxMetadata.Snr,
-			RxTime:  rxTime,
-			GPSTime: gpsTime,
+			RCtx:          int64(rxMetadata.AntennaIndex),
+			XTime:         int64(rxMetadata.Timestamp),
+			RSSI:          rxMetadata.Rssi,
+			SNR:           rxMetadata.Snr,
+			RxTime:        rxTime,
+			GPSTime:       gpsTime,
+			FineTimestamp: upInfoFineTimestamp(rxMetadata),
 		},
 	}
 	return nil
@@ -371,14 +435,15 @@ func (updf *UplinkDataFrame) toUplinkMessage(ids *ttnpb.GatewayIdentifiers, band
 	tm := ws.TimePtrFromUpInfo(updf.UpInfo.GPSTime, updf.UpInfo.RxTime)
 	up.RxMetadata = []*ttnpb.RxMetadata{
 		{
-			GatewayIds:   ids,
-			Time:         ttnpb.ProtoTime(tm),
-			GpsTime:      ttnpb.ProtoTime(gpsTime),
-			Timestamp:    timestamp,
-			Rssi:         updf.RadioMetaData.UpInfo.RSSI,
-			ChannelRssi:  updf.RadioMetaData.UpInfo.RSSI,
-			Snr:          updf.RadioMetaData.UpInfo.SNR,
-			AntennaIndex: uint32(updf.RadioMetaData.UpInfo.RCtx),
+			GatewayIds:    ids,
+			Time:          ttnpb.ProtoTime(tm),
+			GpsTime:       ttnpb.ProtoTime(gpsTime),
+			Timestamp:     timestamp,
+			Rssi:          updf.RadioMetaData.UpInfo.RSSI,
+			ChannelRssi:   updf.RadioMetaData.UpInfo.RSSI,
+			Snr:           updf.RadioMetaData.UpInfo.SNR,
+			AntennaIndex:  uint32(updf.RadioMetaData.UpInfo.RCtx),
+			FineTimestamp: updf.RadioMetaData.UpInfo.fineTimestamp(),
 		},
 	}
 
@@ -457,12 +522,13 @@ func (updf *UplinkDataFrame) FromUplinkMessage(up *ttnpb.UplinkMessage, bandID s
 		DataRate:  int(drIdx),
 		Frequency: up.Settings.GetFrequency(),
 		UpInfo: UpInfo{
-			RCtx:    int64(rxMetadata.AntennaIndex),
-			XTime:   int64(rxMetadata.Timestamp),
-			RSSI:    rxMetadata.Rssi,
-			SNR:     rxMetadata.Snr,
-			RxTime:  rxTime,
-			GPSTime: gpsTime,
+			RCtx:          int64(rxMetadata.AntennaIndex),
+			XTime:         int64(rxMetadata.Timestamp),
+			RSSI:          rxMetadata.Rssi,
+			SNR:           rxMetadata.Snr,
+			RxTime:        rxTime,
+			GPSTime:       gpsTime,
+			FineTimestamp: upInfoFineTimestamp(rxMetadata),
 		},
 	}
 	return nil
@@ -629,6 +695,15 @@ func (f *lbsLNS) HandleUp(ctx context.Context, raw []byte, ids *ttnpb.GatewayIde
 		}
 		return req.Response(receivedAt).MarshalJSON()
 
+	case TypeUpstreamSpectralScan:
+		var scan SpectralScan
+		if err := json.Unmarshal(raw, &scan); err != nil {
+			return nil, err
+		}
+		if err := conn.HandleSpectralScan(scan.toSpectralScan(receivedAt)); err != nil {
+			logger.WithError(err).Warn("Failed to handle spectral scan")
+		}
+
 	case TypeUpstreamProprietaryDataFrame, TypeUpstreamRemoteShell:
 		logger.WithField("message_type", typ).Debug("Message type not implemented")
 
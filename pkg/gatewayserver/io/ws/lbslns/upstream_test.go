@@ -23,6 +23,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io/ws/id6"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -104,6 +105,16 @@ func TestMarshalJSON(t *testing.T) {
 			},
 			Expected: []byte(`{"msgtype":"timesync","txtime":123.456}`),
 		},
+		{
+			Name: "SpectralScan",
+			Message: SpectralScan{
+				Time: 1548059982,
+				Channels: []SpectralScanChannel{
+					{Freq: 868100000, RSSI: -115.5},
+				},
+			},
+			Expected: []byte(`{"msgtype":"spectral_scan","time":1548059982,"channels":[{"Freq":868100000,"RSSI":-115.5}]}`),
+		},
 	} {
 		tc := tc
 		t.Run(tc.Name, func(t *testing.T) {
@@ -356,6 +367,72 @@ func TestUplinkDataFrame(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name: "FineTimestamp",
+			UplinkDataFrame: UplinkDataFrame{
+				MHdr:       0x40,
+				DevAddr:    0x11223344,
+				FCtrl:      0x30,
+				FPort:      0x00,
+				FCnt:       25,
+				FOpts:      "FD",
+				FRMPayload: "5fcc",
+				MIC:        12345678,
+				RadioMetaData: RadioMetaData{
+					DataRate:  1,
+					Frequency: 868300000,
+					UpInfo: UpInfo{
+						RxTime:        1548059982,
+						XTime:         12666373963464220,
+						FineTimestamp: func(v int64) *int64 { return &v }(123456789),
+						RSSI:          89,
+						SNR:           9.25,
+					},
+				},
+			},
+			GatewayIds:      gtwID,
+			FrequencyPlanID: band.EU_863_870,
+			ExpectedUplinkMessage: &ttnpb.UplinkMessage{
+				Payload: &ttnpb.Message{
+					MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_UP, Major: ttnpb.Major_LORAWAN_R1},
+					Mic:  []byte{0x4E, 0x61, 0xBC, 0x00},
+					Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+						FPort:      0,
+						FrmPayload: []byte{0x5F, 0xCC},
+						FHdr: &ttnpb.FHDR{
+							DevAddr: []byte{0x11, 0x22, 0x33, 0x44},
+							FCtrl: &ttnpb.FCtrl{
+								Ack:    true,
+								ClassB: true,
+							},
+							FCnt:  25,
+							FOpts: []byte{0xFD},
+						},
+					}},
+				},
+				RxMetadata: []*ttnpb.RxMetadata{
+					{
+						GatewayIds:    gtwID,
+						Time:          timestamppb.New(time.Unix(1548059982, 0)),
+						Timestamp:     (uint32)(12666373963464220 & 0xFFFFFFFF),
+						FineTimestamp: 123456789,
+						Rssi:          89,
+						ChannelRssi:   89,
+						Snr:           9.25,
+					},
+				},
+				Settings: &ttnpb.TxSettings{
+					Timestamp: (uint32)(12666373963464220 & 0xFFFFFFFF),
+					Time:      timestamppb.New(time.Unix(1548059982, 0)),
+					Frequency: 868300000,
+					DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+						SpreadingFactor: 11,
+						Bandwidth:       125000,
+						CodingRate:      band.Cr4_5,
+					}}},
+				},
+			},
+		},
 		{
 			Name: "NegativeFPort",
 			UplinkDataFrame: UplinkDataFrame{
@@ -673,3 +750,27 @@ func TestTxAck(t *testing.T) {
 		t.Fatalf("Unexpected TxAck: %v", txAck)
 	}
 }
+
+func TestSpectralScan(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	receivedAt := time.Unix(1548059990, 0)
+
+	scan := SpectralScan{
+		Time: 1548059982,
+		Channels: []SpectralScanChannel{
+			{Freq: 868100000, RSSI: -115.5},
+			{Freq: 868300000, RSSI: -98},
+		},
+	}
+	a.So(scan.toSpectralScan(receivedAt), should.Resemble, &io.SpectralScan{
+		Time: time.Unix(1548059982, 0),
+		Channels: []io.SpectralScanChannel{
+			{Frequency: 868100000, RSSI: -115.5},
+			{Frequency: 868300000, RSSI: -98},
+		},
+	})
+
+	scan.Time = 0
+	a.So(scan.toSpectralScan(receivedAt).Time, should.Equal, receivedAt)
+}
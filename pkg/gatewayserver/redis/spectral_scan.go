@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database is corrupted")
+
+// SpectralScanRegistry is an implementation of gatewayserver.SpectralScanRegistry.
+// The spectral scan reports of a gateway are stored in a list, with the most recent report first.
+type SpectralScanRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *SpectralScanRegistry) key(ctx context.Context, ids *ttnpb.GatewayIdentifiers) string {
+	return r.Redis.Key("uid", unique.ID(ctx, ids))
+}
+
+// Add implements gatewayserver.SpectralScanRegistry.
+func (r *SpectralScanRegistry) Add(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, scan *io.SpectralScan, size int, ttl time.Duration,
+) error {
+	b, err := json.Marshal(scan)
+	if err != nil {
+		return err
+	}
+	k := r.key(ctx, ids)
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, b)
+		p.LTrim(ctx, k, 0, int64(size-1))
+		if ttl > 0 {
+			p.PExpire(ctx, k, ttl)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements gatewayserver.SpectralScanRegistry.
+func (r *SpectralScanRegistry) Range(ctx context.Context, ids *ttnpb.GatewayIdentifiers) ([]*io.SpectralScan, error) {
+	vs, err := r.Redis.LRange(ctx, r.key(ctx, ids), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	scans := make([]*io.SpectralScan, 0, len(vs))
+	for _, v := range vs {
+		scan := &io.SpectralScan{}
+		if err := json.Unmarshal([]byte(v), scan); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		scans = append(scans, scan)
+	}
+	return scans, nil
+}
@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSpectralScanRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &SpectralScanRegistry{Redis: cl}
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"}
+
+	scans, err := r.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(scans, should.BeEmpty)
+
+	now := time.Now().UTC()
+	for i := 0; i < 3; i++ {
+		err := r.Add(ctx, ids, &io.SpectralScan{
+			Time: now.Add(time.Duration(i) * time.Minute),
+			Channels: []io.SpectralScanChannel{
+				{Frequency: 868100000, RSSI: -120 + float32(i)},
+				{Frequency: 868300000, RSSI: -110},
+			},
+		}, 2, time.Hour)
+		a.So(err, should.BeNil)
+	}
+
+	scans, err = r.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	if a.So(scans, should.HaveLength, 2) {
+		a.So(scans[0].Time.Equal(now.Add(2*time.Minute)), should.BeTrue)
+		a.So(scans[0].Channels[0].RSSI, should.Equal, float32(-118))
+		a.So(scans[1].Time.Equal(now.Add(time.Minute)), should.BeTrue)
+	}
+
+	scans, err = r.Range(ctx, &ttnpb.GatewayIdentifiers{GatewayId: "other-gtw"})
+	a.So(err, should.BeNil)
+	a.So(scans, should.BeEmpty)
+}
@@ -0,0 +1,94 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/base64"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+)
+
+// UplinkDeduplicator deduplicates uplink messages across Gateway Server instances.
+type UplinkDeduplicator struct {
+	Redis *ttnredis.Client
+}
+
+var keyEncoding = base64.RawStdEncoding
+
+func (d *UplinkDeduplicator) uplinkKey(up *ttnpb.UplinkMessage) (string, error) {
+	drBytes, err := proto.Marshal(up.GetSettings().GetDataRate())
+	if err != nil {
+		return "", err
+	}
+	h := fnv.New64a()
+	_, _ = h.Write(up.RawPayload)
+	return d.Redis.Key(
+		keyEncoding.EncodeToString(h.Sum(nil)),
+		// NOTE: Data rate and frequency are included in the key to support retransmissions.
+		strconv.FormatUint(up.GetSettings().GetFrequency(), 32),
+		keyEncoding.EncodeToString(drBytes),
+	), nil
+}
+
+// DeduplicateUplink deduplicates up for window. Since highest precision allowed by Redis is milliseconds, window is
+// truncated to milliseconds.
+func (d *UplinkDeduplicator) DeduplicateUplink(
+	ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration,
+) (bool, error) {
+	k, err := d.uplinkKey(up)
+	if err != nil {
+		return false, err
+	}
+	msgs := make([]proto.Message, 0, len(up.RxMetadata))
+	for _, md := range up.RxMetadata {
+		msgs = append(msgs, md)
+	}
+	return ttnredis.DeduplicateProtos(ctx, d.Redis, k, window, msgs...)
+}
+
+// AccumulatedMetadata returns the accumulated metadata of up.
+func (d *UplinkDeduplicator) AccumulatedMetadata(
+	ctx context.Context, up *ttnpb.UplinkMessage,
+) ([]*ttnpb.RxMetadata, error) {
+	k, err := d.uplinkKey(up)
+	if err != nil {
+		return nil, err
+	}
+	var cmd ttnredis.ProtosCmd
+	if _, err := d.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		cmd = ttnredis.ListProtos(ctx, p, ttnredis.ListKey(k))
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	var mds []*ttnpb.RxMetadata
+	if err := cmd.Range(func() (proto.Message, func() (bool, error)) {
+		md := &ttnpb.RxMetadata{}
+		return md, func() (bool, error) {
+			mds = append(mds, md)
+			return true, nil
+		}
+	}); err != nil {
+		return nil, err
+	}
+	return mds, nil
+}
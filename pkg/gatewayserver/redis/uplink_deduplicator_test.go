@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUplinkDeduplicator(t *testing.T) {
+	a, ctx := test.New(t)
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	d := &UplinkDeduplicator{Redis: cl}
+
+	newUplink := func(gtwID string) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RawPayload: []byte{0x40, 0x01, 0x02, 0x03, 0x04},
+			Settings: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{Modulation: &ttnpb.DataRate_Lora{Lora: &ttnpb.LoRaDataRate{
+					SpreadingFactor: 7,
+					Bandwidth:       125000,
+				}}},
+				Frequency: 868100000,
+			},
+			RxMetadata: []*ttnpb.RxMetadata{{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: gtwID},
+			}},
+		}
+	}
+	up1, up2 := newUplink("gtw-1"), newUplink("gtw-2")
+
+	first, err := d.DeduplicateUplink(ctx, up1, Timeout)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+
+	first, err = d.DeduplicateUplink(ctx, up2, Timeout)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeFalse)
+
+	mds, err := d.AccumulatedMetadata(ctx, up1)
+	a.So(err, should.BeNil)
+	a.So(mds, should.HaveLength, 2)
+
+	// Retransmissions on another frequency are not duplicates.
+	up3 := newUplink("gtw-1")
+	up3.Settings.Frequency = 868300000
+	first, err = d.DeduplicateUplink(ctx, up3, Timeout)
+	a.So(err, should.BeNil)
+	a.So(first, should.BeTrue)
+}
@@ -18,6 +18,7 @@ import (
 	"context"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
@@ -41,6 +42,14 @@ type GatewayConnectionStatsRegistry interface {
 	) error
 }
 
+// SpectralScanRegistry stores the spectral scan reports of gateways.
+type SpectralScanRegistry interface {
+	// Add adds the spectral scan report of the gateway, keeps at most size reports and expires the reports after ttl.
+	Add(ctx context.Context, ids *ttnpb.GatewayIdentifiers, scan *io.SpectralScan, size int, ttl time.Duration) error
+	// Range returns the spectral scan reports of the gateway, the most recent first.
+	Range(ctx context.Context, ids *ttnpb.GatewayIdentifiers) ([]*io.SpectralScan, error)
+}
+
 // EntityRegistry abstracts the Identity server gateway functions.
 type EntityRegistry interface {
 	// AssertGatewayRights checks whether the gateway authentication (provied in the context) contains the required rights.
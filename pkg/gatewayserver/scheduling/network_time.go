@@ -0,0 +1,104 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// maxNetworkTimeSamples is the maximum number of samples that the network time model keeps.
+const maxNetworkTimeSamples = 256
+
+type networkTimeSample struct {
+	offset time.Duration
+	server time.Time
+}
+
+// NetworkTime is a model of the network time, which is the absolute time of reference gateways with a GPS
+// disciplined clock. The model keeps the offset between the network time and the server time, so that absolute time
+// downlink messages can be scheduled on gateways without GPS.
+type NetworkTime struct {
+	ttl        time.Duration
+	minSamples int
+
+	mu      sync.Mutex
+	samples []networkTimeSample
+	next    int
+}
+
+// NewNetworkTime returns a new network time model. Samples expire after the TTL, and the model is only used when at
+// least the minimum number of samples is available.
+func NewNetworkTime(ttl time.Duration, minSamples int) *NetworkTime {
+	if minSamples < 1 {
+		minSamples = 1
+	}
+	return &NetworkTime{
+		ttl:        ttl,
+		minSamples: minSamples,
+	}
+}
+
+// Record records that a reference gateway received a message at the given absolute gateway time, which corresponds
+// to the given server time.
+func (n *NetworkTime) Record(gateway, server time.Time) {
+	if n == nil {
+		return
+	}
+	sample := networkTimeSample{
+		offset: gateway.Sub(server),
+		server: server,
+	}
+	n.mu.Lock()
+	defer n.mu.Unlock()
+	if len(n.samples) < maxNetworkTimeSamples {
+		n.samples = append(n.samples, sample)
+		return
+	}
+	n.samples[n.next] = sample
+	n.next = (n.next + 1) % maxNetworkTimeSamples
+}
+
+// Offset returns the median offset between the network time and the server time of the samples that did not expire
+// at now. This method returns false if there are not enough samples.
+func (n *NetworkTime) Offset(now time.Time) (time.Duration, bool) {
+	if n == nil {
+		return 0, false
+	}
+	n.mu.Lock()
+	offsets := make([]time.Duration, 0, len(n.samples))
+	for _, s := range n.samples {
+		if now.Sub(s.server) <= n.ttl {
+			offsets = append(offsets, s.offset)
+		}
+	}
+	n.mu.Unlock()
+	if len(offsets) < n.minSamples {
+		return 0, false
+	}
+	sort.Slice(offsets, func(i, j int) bool { return offsets[i] < offsets[j] })
+	return offsets[len(offsets)/2], true
+}
+
+// ServerTime returns the server time that corresponds to the given network time.
+// This method returns false if there are not enough samples at now.
+func (n *NetworkTime) ServerTime(network, now time.Time) (time.Time, bool) {
+	offset, ok := n.Offset(now)
+	if !ok {
+		return time.Time{}, false
+	}
+	return network.Add(-offset), true
+}
@@ -0,0 +1,128 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package scheduling_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/scheduling"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNetworkTime(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	var disabled *scheduling.NetworkTime
+	disabled.Record(time.Unix(2, 0), time.Unix(0, 0))
+	_, ok := disabled.Offset(time.Unix(0, 0))
+	a.So(ok, should.BeFalse)
+
+	nt := scheduling.NewNetworkTime(time.Minute, 3)
+	server := time.Unix(1000, 0)
+	nt.Record(server.Add(2*time.Second), server)
+	nt.Record(server.Add(2*time.Second+time.Millisecond), server)
+	_, ok = nt.Offset(server)
+	a.So(ok, should.BeFalse)
+
+	// The offset is the median of the samples, which is robust against outliers.
+	nt.Record(server.Add(time.Hour), server)
+	offset, ok := nt.Offset(server)
+	a.So(ok, should.BeTrue)
+	a.So(offset, should.Equal, 2*time.Second+time.Millisecond)
+
+	serverTime, ok := nt.ServerTime(time.Unix(2000, 0), server)
+	a.So(ok, should.BeTrue)
+	a.So(serverTime, should.Equal, time.Unix(2000, 0).Add(-2*time.Second-time.Millisecond))
+
+	// The samples expire.
+	_, ok = nt.Offset(server.Add(2 * time.Minute))
+	a.So(ok, should.BeFalse)
+}
+
+func TestScheduleAtWithNetworkTime(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	fps := map[string]*frequencyplans.FrequencyPlan{test.EUFrequencyPlanID: {
+		BandID: band.EU_863_870,
+	}}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, false, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	// The gateway has no absolute time.
+	scheduler.Sync(0, time.Unix(0, 0))
+
+	// The network time is 2 seconds ahead of the server time.
+	nt := scheduling.NewNetworkTime(time.Minute, 1)
+	nt.Record(time.Unix(2, 0), time.Unix(0, 0))
+
+	settings := func(at time.Time) *ttnpb.TxSettings {
+		return &ttnpb.TxSettings{
+			DataRate: &ttnpb.DataRate{
+				Modulation: &ttnpb.DataRate_Lora{
+					Lora: &ttnpb.LoRaDataRate{
+						Bandwidth:       125000,
+						SpreadingFactor: 7,
+						CodingRate:      band.Cr4_5,
+					},
+				},
+			},
+			Frequency: 869525000,
+			Time:      timestamppb.New(at),
+		}
+	}
+	rtts := &mockRTTs{
+		Median:      20 * time.Millisecond,
+		NPercentile: 20 * time.Millisecond,
+		Count:       5,
+	}
+
+	em, _, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings(time.Unix(10, 0)),
+		RTTs:        rtts,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+		NetworkTime: nt,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(8*time.Second-10*time.Millisecond))
+
+	// Without network time, the server time is considered absolute time.
+	em, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 10,
+		TxSettings:  settings(time.Unix(20, 0)),
+		RTTs:        rtts,
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(20*time.Second-10*time.Millisecond))
+}
@@ -284,6 +284,9 @@ type Options struct {
 	RTTs        RTTs
 	Priority    ttnpb.TxSchedulePriority
 	UplinkToken *ttnpb.UplinkToken
+	// NetworkTime is the network time model that is used to convert absolute time to server time when the gateway
+	// has no absolute time.
+	NetworkTime *NetworkTime
 }
 
 // ScheduleAt attempts to schedule the given Tx settings with the given priority.
@@ -323,7 +326,12 @@ func (s *Scheduler) ScheduleAt(ctx context.Context, opts Options) (res Emission,
 			if medianRTT == nil {
 				return Emission{}, 0, errNoAbsoluteGatewayTime.New()
 			}
-			serverTime, ok := s.clock.FromServerTime(*ttnpb.StdTime(opts.Time))
+			// The server time is considered absolute time, unless the network time model knows the offset.
+			absolute := *ttnpb.StdTime(opts.Time)
+			if server, ok := opts.NetworkTime.ServerTime(absolute, s.timeSource.Now()); ok {
+				absolute = server
+			}
+			serverTime, ok := s.clock.FromServerTime(absolute)
 			if !ok {
 				return Emission{}, 0, errNoServerTime.New()
 			}
@@ -435,6 +435,58 @@ func TestScheduleAtWithFrequencyPlanDutyCycle(t *testing.T) {
 	}
 }
 
+func TestScheduleAtISM2400(t *testing.T) {
+	a := assertions.New(t)
+	ctx := test.Context()
+	fps := map[string]*frequencyplans.FrequencyPlan{
+		test.ISM2400FrequencyPlanID: test.FrequencyPlan(test.ISM2400FrequencyPlanID),
+	}
+	timeSource := &mockTimeSource{
+		Time: time.Unix(0, 0),
+	}
+	scheduler, err := scheduling.NewScheduler(ctx, fps, true, scheduling.DefaultDutyCycleStyle, nil, timeSource)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	scheduler.Sync(0, timeSource.Time)
+
+	settings := &ttnpb.TxSettings{
+		DataRate: &ttnpb.DataRate{
+			Modulation: &ttnpb.DataRate_Lora{
+				Lora: &ttnpb.LoRaDataRate{
+					Bandwidth:       812000,
+					SpreadingFactor: 12,
+					CodingRate:      band.Cr4_8LI,
+				},
+			},
+		},
+		Frequency: 2425000000,
+		Timestamp: 20000000,
+	}
+	em, _, err := scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 20,
+		TxSettings:  settings,
+		RTTs:        &mockRTTs{},
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(em.Starts(), should.Equal, scheduling.ConcentratorTime(20000000000))
+
+	// The sub-band of the ISM2400 band does not cover frequencies outside of the 2.4 GHz band.
+	settings = ttnpb.Clone(settings)
+	settings.Frequency = 868100000
+	settings.Timestamp = 30000000
+	_, _, err = scheduler.ScheduleAt(ctx, scheduling.Options{
+		PayloadSize: 20,
+		TxSettings:  settings,
+		RTTs:        &mockRTTs{},
+		Priority:    ttnpb.TxSchedulePriority_NORMAL,
+	})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+}
+
 func TestScheduleAnytime(t *testing.T) {
 	a := assertions.New(t)
 	ctx := test.Context()
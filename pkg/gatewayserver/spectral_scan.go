@@ -0,0 +1,143 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var errSpectralScanRegistry = errors.DefineInvalidArgument(
+	"spectral_scan_registry",
+	"invalid spectral scan registry",
+)
+
+func (gs *GatewayServer) startHandleSpectralScansTask(conn connectionEntry) {
+	if conn.SpectralScans() == nil {
+		return
+	}
+	conn.tasksDone.Add(1)
+	gs.StartTask(&task.Config{
+		Context: conn.Context(),
+		ID:      fmt.Sprintf("handle_spectral_scans_%s", unique.ID(conn.Context(), conn.Gateway().GetIds())),
+		Func: func(ctx context.Context) error {
+			gs.handleSpectralScans(ctx, conn)
+			return nil
+		},
+		Done:    conn.tasksDone.Done,
+		Restart: task.RestartNever,
+		Backoff: task.DialBackoffConfig,
+	})
+}
+
+// handleSpectralScans stores the spectral scan reports of the gateway connection.
+func (gs *GatewayServer) handleSpectralScans(ctx context.Context, conn connectionEntry) {
+	ids := conn.Gateway().GetIds()
+	conf := gs.config.SpectralScan
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case scan := <-conn.SpectralScans():
+			if err := gs.spectralScans.Add(ctx, ids, scan, conf.HistorySize, conf.TTL); err != nil {
+				log.FromContext(ctx).WithError(err).Warn("Failed to store spectral scan")
+			}
+		}
+	}
+}
+
+// noiseFloorChannels returns the noise floor history by channel from the spectral scan reports, the most recent
+// first. The channels are ordered by frequency. If frequency is non-zero, only that channel is returned.
+func noiseFloorChannels(scans []*io.SpectralScan, frequency uint64) []*ttnpb.GatewayNoiseFloorChannel {
+	byFrequency := make(map[uint64]*ttnpb.GatewayNoiseFloorChannel)
+	for _, scan := range scans {
+		for _, ch := range scan.Channels {
+			if frequency != 0 && ch.Frequency != frequency {
+				continue
+			}
+			res, ok := byFrequency[ch.Frequency]
+			if !ok {
+				res = &ttnpb.GatewayNoiseFloorChannel{
+					Frequency: ch.Frequency,
+					MaxRssi:   ch.RSSI,
+				}
+				byFrequency[ch.Frequency] = res
+			}
+			if ch.RSSI > res.MaxRssi {
+				res.MaxRssi = ch.RSSI
+			}
+			res.Samples = append(res.Samples, &ttnpb.GatewayNoiseFloorSample{
+				Time: timestamppb.New(scan.Time),
+				Rssi: ch.RSSI,
+			})
+		}
+	}
+	channels := make([]*ttnpb.GatewayNoiseFloorChannel, 0, len(byFrequency))
+	for _, ch := range byFrequency {
+		var sum float32
+		for _, sample := range ch.Samples {
+			sum += sample.Rssi
+		}
+		ch.AverageRssi = sum / float32(len(ch.Samples))
+		channels = append(channels, ch)
+	}
+	sort.Slice(channels, func(i, j int) bool {
+		return channels[i].Frequency < channels[j].Frequency
+	})
+	return channels
+}
+
+// GetNoiseFloor returns the noise floor history of the gateway channels. If frequency is non-zero, only the noise
+// floor history of that channel is returned.
+func (gs *GatewayServer) GetNoiseFloor(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, frequency uint64,
+) ([]*ttnpb.GatewayNoiseFloorChannel, error) {
+	if err := gs.entityRegistry.AssertGatewayRights(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_STATUS_READ); err != nil {
+		return nil, err
+	}
+	scans, err := gs.spectralScans.Range(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return noiseFloorChannels(scans, frequency), nil
+}
+
+type noiseFloorServer struct {
+	ttnpb.UnimplementedGatewayNoiseFloorServiceServer
+
+	GS *GatewayServer
+}
+
+// Get implements ttnpb.GatewayNoiseFloorServiceServer.
+func (s *noiseFloorServer) Get(
+	ctx context.Context, req *ttnpb.GetGatewayNoiseFloorRequest,
+) (*ttnpb.GatewayNoiseFloor, error) {
+	channels, err := s.GS.GetNoiseFloor(ctx, req.GatewayIds, req.Frequency)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.GatewayNoiseFloor{
+		Channels: channels,
+	}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNoiseFloorChannels(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	now := time.Now()
+	scans := []*io.SpectralScan{
+		{
+			Time: now,
+			Channels: []io.SpectralScanChannel{
+				{Frequency: 868300000, RSSI: -90},
+				{Frequency: 868100000, RSSI: -120},
+			},
+		},
+		{
+			Time: now.Add(-time.Minute),
+			Channels: []io.SpectralScanChannel{
+				{Frequency: 868100000, RSSI: -110},
+			},
+		},
+	}
+
+	a.So(noiseFloorChannels(scans, 0), should.Resemble, []*ttnpb.GatewayNoiseFloorChannel{
+		{
+			Frequency:   868100000,
+			AverageRssi: -115,
+			MaxRssi:     -110,
+			Samples: []*ttnpb.GatewayNoiseFloorSample{
+				{Time: timestamppb.New(now), Rssi: -120},
+				{Time: timestamppb.New(now.Add(-time.Minute)), Rssi: -110},
+			},
+		},
+		{
+			Frequency:   868300000,
+			AverageRssi: -90,
+			MaxRssi:     -90,
+			Samples: []*ttnpb.GatewayNoiseFloorSample{
+				{Time: timestamppb.New(now), Rssi: -90},
+			},
+		},
+	})
+	a.So(noiseFloorChannels(scans, 868300000), should.HaveLength, 1)
+	a.So(noiseFloorChannels(scans, 869525000), should.BeEmpty)
+	a.So(noiseFloorChannels(nil, 0), should.BeEmpty)
+}
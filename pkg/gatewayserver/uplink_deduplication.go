@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// UplinkDeduplicator deduplicates uplink messages that are received by multiple Gateway Server instances, and
+// accumulates their metadata.
+type UplinkDeduplicator interface {
+	// DeduplicateUplink deduplicates an uplink message for the given time window.
+	// DeduplicateUplink returns true if the uplink is not a duplicate, or false and error, if any, otherwise.
+	// The metadata of duplicates is accumulated.
+	DeduplicateUplink(ctx context.Context, up *ttnpb.UplinkMessage, window time.Duration) (first bool, err error)
+	// AccumulatedMetadata returns the accumulated metadata of the uplink message and error, if any.
+	AccumulatedMetadata(ctx context.Context, up *ttnpb.UplinkMessage) ([]*ttnpb.RxMetadata, error)
+}
+
+var errUplinkDeduplicator = errors.DefineInvalidArgument("uplink_deduplicator", "invalid uplink deduplicator")
+
+// deduplicateUplink deduplicates the uplink message across Gateway Server instances.
+// If the uplink message is the first occurrence, deduplicateUplink waits for the deduplication window and returns
+// the metadata of all occurrences. Otherwise, the metadata is accumulated and false is returned.
+func (gs *GatewayServer) deduplicateUplink(
+	ctx context.Context, up *ttnpb.UplinkMessage,
+) ([]*ttnpb.RxMetadata, bool, error) {
+	conf := gs.config.UplinkDeduplication
+	first, err := conf.Deduplicator.DeduplicateUplink(ctx, up, conf.DeduplicationWindow+conf.CooldownWindow)
+	if err != nil || !first {
+		return nil, false, err
+	}
+	timer := time.NewTimer(conf.DeduplicationWindow)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return nil, false, ctx.Err()
+	case <-timer.C:
+	}
+	mds, err := conf.Deduplicator.AccumulatedMetadata(ctx, up)
+	if err != nil {
+		return nil, false, err
+	}
+	log.FromContext(ctx).WithField("metadata_count", len(mds)).Debug("Accumulated uplink metadata")
+	return mds, true, nil
+}
@@ -0,0 +1,59 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver
+
+import (
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/gatewayserver/io"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	// PHYCRCValidationAttribute is the gateway attribute that enables PHY payload CRC validation by the Gateway Server.
+	// This is for gateways that forward uplinks before CRC processing, such as low-cost single-channel and
+	// SDR-based forwarders. The gateway appends the little endian CRC-16 of the PHY payload to the PHY payload.
+	// The attribute value is a boolean.
+	PHYCRCValidationAttribute = "phy-crc-validation"
+	// DuplicateFilterWindowAttribute is the gateway attribute that contains the window in which uplinks with the same
+	// PHY payload received by the gateway are dropped. The attribute value is a duration, i.e. 500ms.
+	DuplicateFilterWindowAttribute = "duplicate-filter-window"
+)
+
+var errUplinkFilterAttribute = errors.DefineInvalidArgument(
+	"uplink_filter_attribute", "invalid value of attribute `{attribute}`",
+)
+
+// uplinkFilterOptions returns the connection options for the uplink processing configured in the gateway attributes.
+func uplinkFilterOptions(gtw *ttnpb.Gateway) ([]io.ConnectionOption, error) {
+	var opts []io.ConnectionOption
+	if value, ok := gtw.GetAttributes()[PHYCRCValidationAttribute]; ok {
+		enable, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, errUplinkFilterAttribute.WithAttributes("attribute", PHYCRCValidationAttribute).WithCause(err)
+		}
+		opts = append(opts, io.WithPHYCRCValidation(enable))
+	}
+	if value, ok := gtw.GetAttributes()[DuplicateFilterWindowAttribute]; ok {
+		window, err := time.ParseDuration(value)
+		if err != nil {
+			return nil, errUplinkFilterAttribute.WithAttributes("attribute", DuplicateFilterWindowAttribute).WithCause(err)
+		}
+		opts = append(opts, io.WithDuplicateFilterWindow(window))
+	}
+	return opts, nil
+}
@@ -0,0 +1,51 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package gatewayserver_test
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/gatewayserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUplinkFilterOptions(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	opts, err := UplinkFilterOptions(&ttnpb.Gateway{})
+	a.So(err, should.BeNil)
+	a.So(opts, should.BeEmpty)
+
+	opts, err = UplinkFilterOptions(&ttnpb.Gateway{
+		Attributes: map[string]string{
+			"phy-crc-validation":      "true",
+			"duplicate-filter-window": "500ms",
+			"other":                   "value",
+		},
+	})
+	a.So(err, should.BeNil)
+	a.So(opts, should.HaveLength, 2)
+
+	for _, attributes := range []map[string]string{
+		{"phy-crc-validation": "yes please"},
+		{"duplicate-filter-window": "500"},
+	} {
+		_, err := UplinkFilterOptions(&ttnpb.Gateway{Attributes: attributes})
+		a.So(err, should.NotBeNil)
+	}
+}
@@ -18,6 +18,7 @@ package healthcheck
 import (
 	"context"
 	"net/http"
+	"time"
 )
 
 // Check is a function that determines the health of the HealtChecker.
@@ -29,4 +30,30 @@ type HealthChecker interface {
 	AddPgCheck(name string, dsn string) error
 	AddCheck(name string, check Check) error
 	GetHandler() http.Handler
+	Measure(ctx context.Context) *Status
+}
+
+// CheckStatus is the status of a check.
+type CheckStatus struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Failure string `json:"failure,omitempty"`
+}
+
+// Incident is a period in which a check failed.
+// The incident is ongoing as long as ResolvedAt is nil.
+type Incident struct {
+	Check      string     `json:"check"`
+	Failure    string     `json:"failure"`
+	StartedAt  time.Time  `json:"started_at"`
+	ResolvedAt *time.Time `json:"resolved_at,omitempty"`
+}
+
+// Status is the result of measuring the checks.
+// The incidents contain the ongoing and recently resolved incidents, most recent first.
+type Status struct {
+	Healthy    bool           `json:"healthy"`
+	Checks     []*CheckStatus `json:"checks"`
+	Incidents  []*Incident    `json:"incidents"`
+	MeasuredAt time.Time      `json:"measured_at"`
 }
@@ -15,15 +15,25 @@
 package healthcheck
 
 import (
+	"context"
 	"net/http"
+	"sort"
+	"sync"
 
 	"github.com/hellofresh/health-go/v5"
 	healthHttp "github.com/hellofresh/health-go/v5/checks/http"
 	healthPg "github.com/hellofresh/health-go/v5/checks/postgres"
 )
 
+// maxResolvedIncidents is the number of resolved incidents that are kept.
+const maxResolvedIncidents = 10
+
 type defaultHealthChecker struct {
 	h *health.Health
+
+	mu        sync.Mutex
+	names     []string
+	incidents []*Incident
 }
 
 // AddHTTPCheck implements HealthChecker.
@@ -44,10 +54,17 @@ func (hghc *defaultHealthChecker) AddPgCheck(name string, dsn string) error {
 
 // AddCheck implements HealthChecker.
 func (hghc *defaultHealthChecker) AddCheck(name string, check Check) error {
-	return hghc.h.Register(health.Config{
+	if err := hghc.h.Register(health.Config{
 		Name:  name,
 		Check: health.CheckFunc(check),
-	})
+	}); err != nil {
+		return err
+	}
+	hghc.mu.Lock()
+	hghc.names = append(hghc.names, name)
+	sort.Strings(hghc.names)
+	hghc.mu.Unlock()
+	return nil
 }
 
 // GetHandler implements HealthChecker.
@@ -55,6 +72,68 @@ func (hghc *defaultHealthChecker) GetHandler() http.Handler {
 	return hghc.h.Handler()
 }
 
+// Measure implements HealthChecker.
+// A failing check opens an incident, which is resolved when the check passes again.
+func (hghc *defaultHealthChecker) Measure(ctx context.Context) *Status {
+	check := hghc.h.Measure(ctx)
+
+	hghc.mu.Lock()
+	defer hghc.mu.Unlock()
+	status := &Status{
+		Healthy:    len(check.Failures) == 0,
+		Checks:     make([]*CheckStatus, 0, len(hghc.names)),
+		MeasuredAt: check.Timestamp,
+	}
+	for _, name := range hghc.names {
+		failure, failed := check.Failures[name]
+		status.Checks = append(status.Checks, &CheckStatus{
+			Name:    name,
+			Healthy: !failed,
+			Failure: failure,
+		})
+	}
+
+	ongoing := make(map[string]*Incident)
+	resolved := 0
+	incidents := make([]*Incident, 0, len(hghc.incidents)+len(check.Failures))
+	for _, incident := range hghc.incidents {
+		if incident.ResolvedAt == nil {
+			if _, failed := check.Failures[incident.Check]; failed {
+				ongoing[incident.Check] = incident
+			} else {
+				resolvedAt := check.Timestamp
+				incident.ResolvedAt = &resolvedAt
+			}
+		}
+		if incident.ResolvedAt != nil {
+			if resolved == maxResolvedIncidents {
+				continue
+			}
+			resolved++
+		}
+		incidents = append(incidents, incident)
+	}
+	for _, name := range hghc.names {
+		failure, failed := check.Failures[name]
+		if _, ok := ongoing[name]; !failed || ok {
+			continue
+		}
+		incidents = append([]*Incident{{
+			Check:     name,
+			Failure:   failure,
+			StartedAt: check.Timestamp,
+		}}, incidents...)
+	}
+	hghc.incidents = incidents
+
+	status.Incidents = make([]*Incident, 0, len(incidents))
+	for _, incident := range incidents {
+		incident := *incident
+		status.Incidents = append(status.Incidents, &incident)
+	}
+	return status
+}
+
 // NewDefaultHealthChecker creates a new HealthCheker implementation using hellofresh/health-go.
 func NewDefaultHealthChecker() (HealthChecker, error) {
 	h, err := health.New(health.WithSystemInfo())
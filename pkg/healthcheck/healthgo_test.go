@@ -24,6 +24,7 @@ import (
 	"net/url"
 	"os"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -239,3 +240,62 @@ func TestHealthCheckerWithFailingHTTPCheck(t *testing.T) {
 	}()
 	assertGetStatusCode(t, a, ln, 503)
 }
+
+func TestHealthCheckerMeasure(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	hc := getHealthCheckerWithPassingCheck(t)
+	var failing atomic.Bool
+	err := hc.AddCheck("toggle-check", func(ctx context.Context) error {
+		if failing.Load() {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	status := hc.Measure(ctx)
+	a.So(status.Healthy, should.BeTrue)
+	a.So(status.Checks, should.Resemble, []*healthcheck.CheckStatus{
+		{Name: "test-check", Healthy: true},
+		{Name: "toggle-check", Healthy: true},
+	})
+	a.So(status.Incidents, should.BeEmpty)
+
+	failing.Store(true)
+	status = hc.Measure(ctx)
+	a.So(status.Healthy, should.BeFalse)
+	a.So(status.Checks[1], should.Resemble, &healthcheck.CheckStatus{
+		Name: "toggle-check", Healthy: false, Failure: "failed",
+	})
+	if a.So(status.Incidents, should.HaveLength, 1) {
+		a.So(status.Incidents[0].Check, should.Equal, "toggle-check")
+		a.So(status.Incidents[0].Failure, should.Equal, "failed")
+		a.So(status.Incidents[0].ResolvedAt, should.BeNil)
+	}
+	startedAt := status.Incidents[0].StartedAt
+
+	// An ongoing incident is not opened again.
+	status = hc.Measure(ctx)
+	if a.So(status.Incidents, should.HaveLength, 1) {
+		a.So(status.Incidents[0].StartedAt, should.Equal, startedAt)
+	}
+
+	failing.Store(false)
+	status = hc.Measure(ctx)
+	a.So(status.Healthy, should.BeTrue)
+	if a.So(status.Incidents, should.HaveLength, 1) {
+		a.So(status.Incidents[0].StartedAt, should.Equal, startedAt)
+		a.So(status.Incidents[0].ResolvedAt, should.NotBeNil)
+	}
+
+	for i := 0; i < 15; i++ {
+		failing.Store(true)
+		hc.Measure(ctx)
+		failing.Store(false)
+		hc.Measure(ctx)
+	}
+	a.So(hc.Measure(ctx).Incidents, should.HaveLength, 10)
+}
@@ -0,0 +1,232 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+var (
+	errAPIKeyTokensDisabled = errors.DefineFailedPrecondition(
+		"api_key_tokens_disabled", "tokens for API keys are not enabled",
+	)
+	errAPIKeyTokenAuthorization = errors.DefinePermissionDenied(
+		"api_key_token_authorization", "tokens can only be requested with an API key",
+	)
+	errTokenExchangeScope = errors.DefineInvalidArgument(
+		"token_exchange_scope", "invalid scope `{scope}`",
+	)
+	errTokenExchangeRights = errors.DefinePermissionDenied(
+		"token_exchange_rights", "API key does not have the rights of the scope", "missing",
+	)
+	errTokenExchangeDevice = errors.DefinePermissionDenied(
+		"token_exchange_device", "only API keys of the application of the end device can be exchanged for its tokens",
+	)
+)
+
+// tokenExchangeAccessTokenType is the type of the tokens that are exchanged and issued, see RFC 8693.
+const tokenExchangeAccessTokenType = "urn:ietf:params:oauth:token-type:access_token"
+
+// parseTokenExchangeScope parses the space separated rights of the scope of a token exchange.
+func parseTokenExchangeScope(scope string) (*ttnpb.Rights, error) {
+	fields := strings.Fields(scope)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	res := make([]ttnpb.Right, 0, len(fields))
+	for _, field := range fields {
+		right, ok := ttnpb.Right_value[strings.ToUpper(field)]
+		if !ok {
+			return nil, errTokenExchangeScope.WithAttributes("scope", field)
+		}
+		res = append(res, ttnpb.Right(right))
+	}
+	return ttnpb.RightsFrom(res...), nil
+}
+
+// tokenExchangeScope returns the scope of the token that is requested in the token exchange.
+func tokenExchangeScope(req *ttnpb.ExchangeAPIKeyTokenRequest) (*apiKeyTokenScope, error) {
+	rights, err := parseTokenExchangeScope(req.GetScope())
+	if err != nil {
+		return nil, err
+	}
+	scope := &apiKeyTokenScope{
+		rights: rights,
+		ttl:    time.Duration(req.GetExpiresIn()) * time.Second,
+	}
+	if req.DeviceIds != nil {
+		// The application of the end device is validated against the API key when the token is issued.
+		if err := req.DeviceIds.ValidateFields("application_ids", "device_id"); err != nil {
+			return nil, err
+		}
+		scope.deviceIDs = req.DeviceIds
+	}
+	return scope, nil
+}
+
+// exchangeAPIKeyToken exchanges an API key for a short-lived token with fewer rights, a shorter lifetime
+// or restricted to a single end device, so that the token can be handed to processes that are less trusted
+// than the holder of the API key.
+func (is *IdentityServer) exchangeAPIKeyToken(
+	ctx context.Context, req *ttnpb.ExchangeAPIKeyTokenRequest,
+) (*ttnpb.ExchangeAPIKeyTokenResponse, error) {
+	scope, err := tokenExchangeScope(req)
+	if err != nil {
+		return nil, err
+	}
+	// The subject token authenticates the exchange, not the authorization of the request.
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+req.GetSubjectToken()))
+	ctx = is.withRequestAccessCache(ctx)
+	token, err := is.createAPIKeyToken(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.ExchangeAPIKeyTokenResponse{
+		AccessToken:     token.GetToken(),
+		IssuedTokenType: tokenExchangeAccessTokenType,
+		TokenType:       "Bearer",
+		ExpiresIn:       uint32(time.Until(token.GetExpiresAt().AsTime()).Round(time.Second) / time.Second),
+	}
+	if scope.rights != nil {
+		res.Scope = req.GetScope()
+	}
+	return res, nil
+}
+
+// apiKeyTokenIssuer returns the issuer of tokens for API keys, which is the issuer of the OpenID Connect provider.
+func (is *IdentityServer) apiKeyTokenIssuer(ctx context.Context) string {
+	return strings.TrimSuffix(is.configFromContext(ctx).OAuth.UI.CanonicalURL, "/")
+}
+
+// apiKeyTokenScope narrows the token that is issued for an API key.
+type apiKeyTokenScope struct {
+	// rights are the rights of the token. If nil, the token has all rights of the API key.
+	rights *ttnpb.Rights
+	// deviceIDs restrict the token to the end device. Only application API keys can be restricted to end devices.
+	deviceIDs *ttnpb.EndDeviceIdentifiers
+	// ttl is the lifetime of the token. If zero or larger than the configured TTL, the configured TTL is used.
+	ttl time.Duration
+}
+
+// createAPIKeyToken issues a token for the API key of the request. The token expires after the TTL,
+// or when the API key expires, whichever comes first.
+func (is *IdentityServer) createAPIKeyToken(
+	ctx context.Context, scope *apiKeyTokenScope,
+) (*ttnpb.APIKeyToken, error) {
+	ttl := is.configFromContext(ctx).APIKeyTokens.TTL
+	if len(is.signingKeys) == 0 || ttl <= 0 {
+		return nil, errAPIKeyTokensDisabled.New()
+	}
+	if scope.ttl > 0 && scope.ttl < ttl {
+		ttl = scope.ttl
+	}
+	// Tokens can not be renewed with tokens, as that would extend access beyond the lifetime of the API key.
+	if md := rpcmetadata.FromIncomingContext(ctx); rights.IsToken(md.AuthValue) {
+		return nil, errAPIKeyTokenAuthorization.New()
+	}
+	authInfo, err := is.authInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	apiKey := authInfo.GetApiKey()
+	if apiKey == nil {
+		return nil, errAPIKeyTokenAuthorization.New()
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(ttl)
+	if keyExpiresAt := ttnpb.StdTime(apiKey.GetApiKey().GetExpiresAt()); keyExpiresAt != nil &&
+		keyExpiresAt.Before(expiresAt) {
+		expiresAt = *keyExpiresAt
+	}
+	claims := &rights.TokenClaims{
+		Claims: jwt.Claims{
+			Issuer:    is.apiKeyTokenIssuer(ctx),
+			Subject:   apiKey.GetApiKey().GetId(),
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(expiresAt),
+		},
+		EntityIDs:       apiKey.GetEntityIds(),
+		Rights:          ttnpb.RightsFrom(apiKey.GetApiKey().GetRights()...).Implied(),
+		UniversalRights: authInfo.GetUniversalRights(),
+		IsAdmin:         authInfo.GetIsAdmin(),
+	}
+	if scope.rights != nil {
+		requested := scope.rights.Implied()
+		if missing := requested.Sub(claims.Rights).GetRights(); len(missing) > 0 {
+			names := make([]string, len(missing))
+			for i, right := range missing {
+				names[i] = right.String()
+			}
+			return nil, errTokenExchangeRights.WithAttributes("missing", names)
+		}
+		claims.Rights = requested
+		claims.UniversalRights, claims.IsAdmin = nil, false
+	}
+	if scope.deviceIDs != nil {
+		appIDs := apiKey.GetEntityIds().GetApplicationIds()
+		if appIDs == nil || appIDs.GetApplicationId() != scope.deviceIDs.GetApplicationIds().GetApplicationId() {
+			return nil, errTokenExchangeDevice.New()
+		}
+		claims.EntityIDs = scope.deviceIDs.GetEntityIdentifiers()
+		claims.UniversalRights, claims.IsAdmin = nil, false
+	}
+
+	key := is.signingKeys[0]
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.SignatureAlgorithm(key.Algorithm), Key: key},
+		(&jose.SignerOptions{}).WithType(rights.TokenType),
+	)
+	if err != nil {
+		return nil, err
+	}
+	token, err := jwt.Signed(signer).Claims(claims).CompactSerialize()
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.APIKeyToken{
+		Token:     token,
+		ExpiresAt: timestamppb.New(expiresAt.Truncate(time.Second)),
+	}, nil
+}
+
+// apiKeyTokenAuthInfo returns the authentication info of a token that was issued for an API key.
+// The API key is not looked up; the token is valid until it expires.
+func (is *IdentityServer) apiKeyTokenAuthInfo(ctx context.Context, token string) (*ttnpb.AuthInfoResponse, error) {
+	if len(is.signingKeys) == 0 {
+		return nil, errUnsupportedAuthorization.New()
+	}
+	keys := &jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, len(is.signingKeys))}
+	for i, key := range is.signingKeys {
+		keys.Keys[i] = key.Public()
+	}
+	claims, err := rights.ParseToken(token, keys, is.apiKeyTokenIssuer(ctx), time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return claims.AuthInfo(), nil
+}
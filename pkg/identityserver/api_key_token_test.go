@@ -0,0 +1,90 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestTokenExchangeScope(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	valid := func() *ttnpb.ExchangeAPIKeyTokenRequest {
+		return &ttnpb.ExchangeAPIKeyTokenRequest{
+			GrantType:        "urn:ietf:params:oauth:grant-type:token-exchange",
+			SubjectToken:     "NNSXS.KEYID.SECRET",
+			SubjectTokenType: tokenExchangeAccessTokenType,
+		}
+	}
+
+	req := valid()
+	a.So(req.ValidateFields(), should.BeNil)
+	scope, err := tokenExchangeScope(req)
+	if a.So(err, should.BeNil) {
+		a.So(scope, should.Resemble, &apiKeyTokenScope{})
+	}
+
+	req = valid()
+	req.Scope = "RIGHT_APPLICATION_TRAFFIC_READ right_application_traffic_down_write"
+	req.ExpiresIn = 300
+	req.DeviceIds = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"},
+		DeviceId:       "foo-dev",
+	}
+	a.So(req.ValidateFields(), should.BeNil)
+	scope, err = tokenExchangeScope(req)
+	if a.So(err, should.BeNil) {
+		a.So(scope.rights, should.Resemble, ttnpb.RightsFrom(
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+		))
+		a.So(scope.ttl, should.Equal, 5*time.Minute)
+		a.So(scope.deviceIDs, should.Resemble, req.DeviceIds)
+	}
+
+	for _, update := range []func(*ttnpb.ExchangeAPIKeyTokenRequest){
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) { req.GrantType = "client_credentials" },
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) {
+			req.SubjectTokenType = "urn:ietf:params:oauth:token-type:id_token"
+		},
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) {
+			req.RequestedTokenType = "urn:ietf:params:oauth:token-type:saml2"
+		},
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) { req.SubjectToken = "" },
+	} {
+		req := valid()
+		update(req)
+		a.So(req.ValidateFields(), should.NotBeNil)
+	}
+
+	for _, update := range []func(*ttnpb.ExchangeAPIKeyTokenRequest){
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) { req.Scope = "RIGHT_APPLICATION_EVERYTHING" },
+		func(req *ttnpb.ExchangeAPIKeyTokenRequest) {
+			req.DeviceIds = &ttnpb.EndDeviceIdentifiers{DeviceId: "foo-dev"}
+		},
+	} {
+		req := valid()
+		update(req)
+		_, err := tokenExchangeScope(req)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
@@ -406,3 +406,21 @@ func (aa *applicationAccess) SetCollaborator(ctx context.Context, req *ttnpb.Set
 func (aa *applicationAccess) ListCollaborators(ctx context.Context, req *ttnpb.ListApplicationCollaboratorsRequest) (*ttnpb.Collaborators, error) {
 	return aa.listApplicationCollaborators(ctx, req)
 }
+
+func (aa *applicationAccess) CreateClaimAuthorization(
+	ctx context.Context, req *ttnpb.CreateApplicationClaimAuthorizationRequest,
+) (*ttnpb.APIKey, error) {
+	return aa.createClaimAuthorization(ctx, req)
+}
+
+func (aa *applicationAccess) ListClaimAuthorizations(
+	ctx context.Context, req *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.APIKeys, error) {
+	return aa.listClaimAuthorizations(ctx, req)
+}
+
+func (aa *applicationAccess) DeleteClaimAuthorization(
+	ctx context.Context, req *ttnpb.DeleteApplicationClaimAuthorizationRequest,
+) (*emptypb.Empty, error) {
+	return aa.deleteClaimAuthorization(ctx, req)
+}
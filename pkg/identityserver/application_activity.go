@@ -0,0 +1,219 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights/rightsutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const defaultApplicationActivityLimit = 50
+
+var errApplicationActivityStorageDisabled = errors.DefineFailedPrecondition(
+	"application_activity_storage_disabled", "events storage is not enabled",
+)
+
+// applicationActivityEventNames are the names of the events of configuration changes of an application
+// that are part of the activity feed of the application.
+var applicationActivityEventNames = []string{
+	"application.update",
+	"application.api-key.create",
+	"application.api-key.update",
+	"application.api-key.delete",
+	"application.collaborator.update",
+	"application.collaborator.delete",
+	"application.role.update",
+	"application.role.delete",
+	"end_device.create",
+	"end_device.update",
+	"end_device.delete",
+	"end_device.batch.delete",
+	"end_device.batch.update",
+	"as.webhook.set",
+	"as.webhook.delete",
+	"as.pubsub.set",
+	"as.pubsub.delete",
+}
+
+// applicationActivityLimit returns the limit of the activity feed, or the default limit if it is not set.
+func applicationActivityLimit(limit uint32) int {
+	if limit == 0 {
+		return defaultApplicationActivityLimit
+	}
+	return int(limit)
+}
+
+// newApplicationActivity returns the activity of the event, without the actor.
+func newApplicationActivity(evt events.Event) (*ttnpb.ApplicationActivity, error) {
+	activity := &ttnpb.ApplicationActivity{
+		Time:           timestamppb.New(evt.Time()),
+		Name:           evt.Name(),
+		RemoteIp:       evt.RemoteIP(),
+		UserAgent:      evt.UserAgent(),
+		CorrelationIds: evt.CorrelationIds(),
+	}
+	if definition := events.GetDefinition(evt); definition != nil {
+		activity.Description = definition.Description()
+	}
+	for _, ids := range evt.Identifiers() {
+		if devIDs := ids.GetDeviceIds(); devIDs != nil {
+			activity.DeviceId = devIDs.GetDeviceId()
+			break
+		}
+	}
+	if msg, ok := evt.Data().(proto.Message); ok && msg != nil {
+		data, err := anypb.New(msg)
+		if err != nil {
+			return nil, err
+		}
+		activity.Data = data
+	}
+	return activity, nil
+}
+
+// applicationActivityActor returns the user or API key that authenticated the call of the event.
+// Only the owner of an API key and the user of an OAuth access token or session are returned, as the token
+// IDs of access tokens and sessions are only visible to the user.
+func applicationActivityActor(
+	ctx context.Context, st store.Store, tokenType, tokenID string,
+) (*ttnpb.ApplicationActivityActor, error) {
+	switch tokenType {
+	case auth.APIKey.String():
+		ids, key, err := st.GetAPIKeyByID(ctx, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ApplicationActivityActor{
+			UserId:         ids.GetUserIds().GetUserId(),
+			OrganizationId: ids.GetOrganizationIds().GetOrganizationId(),
+			ApiKeyId:       key.GetId(),
+			ApiKeyName:     key.GetName(),
+		}, nil
+	case auth.AccessToken.String():
+		token, err := st.GetAccessToken(ctx, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ApplicationActivityActor{
+			UserId:   token.GetUserIds().GetUserId(),
+			ClientId: token.GetClientIds().GetClientId(),
+		}, nil
+	case auth.SessionToken.String():
+		session, err := st.GetSessionByID(ctx, tokenID)
+		if err != nil {
+			return nil, err
+		}
+		return &ttnpb.ApplicationActivityActor{
+			UserId: session.GetUserIds().GetUserId(),
+		}, nil
+	default:
+		return nil, nil
+	}
+}
+
+// getApplicationActivity returns the configuration changes of the application with the users and API keys
+// that made them. Only the changes that are visible with the rights of the caller are returned.
+func (is *IdentityServer) getApplicationActivity(
+	ctx context.Context, req *ttnpb.GetApplicationActivityRequest,
+) (*ttnpb.ApplicationActivityFeed, error) {
+	ids := req.GetApplicationIds()
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	eventsStore, ok := events.DefaultPubSub().(events.Store)
+	if !ok {
+		return nil, errApplicationActivityStorageDisabled.New()
+	}
+	var after *time.Time
+	if req.After != nil {
+		t := req.After.AsTime()
+		after = &t
+	}
+	evts, err := eventsStore.FetchHistory(
+		ctx,
+		applicationActivityEventNames,
+		[]*ttnpb.EntityIdentifiers{ids.GetEntityIdentifiers()},
+		after,
+		applicationActivityLimit(req.GetLimit()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	feed := &ttnpb.ApplicationActivityFeed{
+		Activity: make([]*ttnpb.ApplicationActivity, 0, len(evts)),
+	}
+	// The token IDs of the activity, in the same order as the activity.
+	activityTokenIDs := make([]string, 0, len(evts))
+	tokenTypes := make(map[string]string)
+	for i := len(evts) - 1; i >= 0; i-- {
+		evt := evts[i]
+		isVisible, err := rightsutil.EventIsVisible(ctx, evt)
+		if err != nil {
+			return nil, err
+		}
+		if !isVisible {
+			continue
+		}
+		activity, err := newApplicationActivity(evt)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to convert event to application activity")
+			continue
+		}
+		feed.Activity = append(feed.Activity, activity)
+		activityTokenIDs = append(activityTokenIDs, evt.AuthTokenID())
+		if evt.AuthTokenID() != "" {
+			tokenTypes[evt.AuthTokenID()] = evt.AuthTokenType()
+		}
+	}
+	if len(tokenTypes) == 0 {
+		return feed, nil
+	}
+
+	actors := make(map[string]*ttnpb.ApplicationActivityActor, len(tokenTypes))
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		for tokenID, tokenType := range tokenTypes {
+			actor, err := applicationActivityActor(ctx, st, tokenType, tokenID)
+			if err != nil {
+				if errors.IsNotFound(err) {
+					// The API key, access token or session is deleted.
+					continue
+				}
+				return err
+			}
+			actors[tokenID] = actor
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i, activity := range feed.Activity {
+		activity.Actor = actors[activityTokenIDs[i]]
+	}
+	return feed, nil
+}
@@ -0,0 +1,74 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestApplicationActivityLimit(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(applicationActivityLimit(0), should.Equal, defaultApplicationActivityLimit)
+	a.So(applicationActivityLimit(10), should.Equal, 10)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	a.So((&ttnpb.GetApplicationActivityRequest{
+		ApplicationIds: appIDs,
+		Limit:          1000,
+		After:          timestamppb.New(time.Date(2023, time.September, 1, 12, 0, 0, 0, time.UTC)),
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.GetApplicationActivityRequest{
+		ApplicationIds: appIDs,
+		Limit:          10000,
+	}).ValidateFields(), should.NotBeNil)
+}
+
+func TestNewApplicationActivity(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}
+	activity, err := newApplicationActivity(evtCreateApplicationAPIKey.NewWithIdentifiersAndData(
+		ctx, appIDs, &ttnpb.APIKey{Id: "KEYID", Name: "integration"},
+	))
+	if a.So(err, should.BeNil) {
+		a.So(activity.Name, should.Equal, "application.api-key.create")
+		a.So(activity.Description, should.Equal, "create application API key")
+		a.So(activity.DeviceId, should.BeEmpty)
+		data, err := activity.Data.UnmarshalNew()
+		if a.So(err, should.BeNil) {
+			a.So(data.(*ttnpb.APIKey).GetId(), should.Equal, "KEYID")
+		}
+		a.So(activity.Actor, should.BeNil)
+	}
+
+	devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "foo-dev"}
+	activity, err = newApplicationActivity(evtCreateEndDevice.NewWithIdentifiersAndData(ctx, devIDs, nil))
+	if a.So(err, should.BeNil) {
+		a.So(activity.Name, should.Equal, "end_device.create")
+		a.So(activity.DeviceId, should.Equal, "foo-dev")
+		a.So(activity.Data, should.BeNil)
+	}
+}
@@ -433,11 +433,26 @@ func (is *IdentityServer) purgeApplication(
 		if err != nil {
 			return err
 		}
+		// delete related group memberships before purging the application
+		err = st.DeleteEntityGroupMemberships(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// delete related roles before purging the application
+		err = st.DeleteEntityRoles(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
 		// delete related contact info before purging the application
 		err = st.DeleteEntityContactInfo(ctx, ids)
 		if err != nil {
 			return err
 		}
+		// delete related labels before purging the application
+		err = st.DeleteEntityLabels(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return st.PurgeApplication(ctx, ids)
 	})
 	if err != nil {
@@ -524,3 +539,10 @@ func (ar *applicationRegistry) IssueDevEUI(
 ) (*ttnpb.IssueDevEUIResponse, error) {
 	return ar.issueDevEUI(ctx, req)
 }
+
+func (ar *applicationRegistry) GetActivity(
+	ctx context.Context,
+	req *ttnpb.GetApplicationActivityRequest,
+) (*ttnpb.ApplicationActivityFeed, error) {
+	return ar.getApplicationActivity(ctx, req)
+}
@@ -0,0 +1,195 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Branding is the branding of the Console and the Account app.
+type Branding struct {
+	LogoURL          string            `name:"logo-url" json:"logo_url,omitempty" description:"URL of the logo"`
+	PrimaryColor     string            `name:"primary-color" json:"primary_color,omitempty" description:"Primary color (#rrggbb)"`       //nolint:lll
+	SecondaryColor   string            `name:"secondary-color" json:"secondary_color,omitempty" description:"Secondary color (#rrggbb)"` //nolint:lll
+	FooterLinks      map[string]string `name:"footer-links" json:"footer_links,omitempty" description:"Links in the footer (title=URL)"` //nolint:lll
+	SupportURL       string            `name:"support-url" json:"support_url,omitempty" description:"URL of the support page"`
+	DocumentationURL string            `name:"documentation-url" json:"documentation_url,omitempty" description:"URL of the documentation"` //nolint:lll
+}
+
+var (
+	errBrandingURL = errors.DefineInvalidArgument(
+		"branding_url", "invalid branding URL `{url}` of `{field}`",
+	)
+	errBrandingColor = errors.DefineInvalidArgument(
+		"branding_color", "invalid branding color `{color}` of `{field}`",
+	)
+	errBrandingCorrupt = errors.DefineCorruption(
+		"branding_corrupt", "stored branding is corrupt",
+	)
+)
+
+var brandingColorRegexp = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+func validateBrandingURL(field, s string) error {
+	if s == "" {
+		return nil
+	}
+	u, err := url.Parse(s)
+	if err != nil {
+		return errBrandingURL.WithAttributes("url", s, "field", field).WithCause(err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errBrandingURL.WithAttributes("url", s, "field", field)
+	}
+	return nil
+}
+
+// Validate returns an error when the URLs or colors of the branding are invalid.
+func (b *Branding) Validate() error {
+	for _, color := range []struct {
+		field, value string
+	}{
+		{"primary_color", b.PrimaryColor},
+		{"secondary_color", b.SecondaryColor},
+	} {
+		if color.value != "" && !brandingColorRegexp.MatchString(color.value) {
+			return errBrandingColor.WithAttributes("color", color.value, "field", color.field)
+		}
+	}
+	for field, value := range map[string]string{
+		"logo_url":          b.LogoURL,
+		"support_url":       b.SupportURL,
+		"documentation_url": b.DocumentationURL,
+	} {
+		if err := validateBrandingURL(field, value); err != nil {
+			return err
+		}
+	}
+	for title, value := range b.FooterLinks {
+		if err := validateBrandingURL("footer_links."+title, value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+const brandingSettingKey = "branding"
+
+// brandingCache caches the branding, so that the Console and the Account app can request the
+// branding on every page load without querying the database.
+type brandingCache struct {
+	mu        sync.Mutex
+	branding  *Branding
+	expiresAt time.Time
+}
+
+func (is *IdentityServer) getBranding(ctx context.Context) (*Branding, error) {
+	is.branding.mu.Lock()
+	defer is.branding.mu.Unlock()
+	if is.branding.branding != nil && time.Now().Before(is.branding.expiresAt) {
+		return is.branding.branding, nil
+	}
+	var value []byte
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		value, err = st.GetSetting(ctx, brandingSettingKey)
+		return err
+	})
+	var branding *Branding
+	switch {
+	case errors.IsNotFound(err):
+		branding = &is.configFromContext(ctx).Branding.Default
+	case err != nil:
+		return nil, err
+	default:
+		branding = &Branding{}
+		if err := json.Unmarshal(value, branding); err != nil {
+			return nil, errBrandingCorrupt.WithCause(err)
+		}
+	}
+	is.branding.branding, is.branding.expiresAt = branding, time.Now().Add(is.config.Branding.CacheTTL)
+	return branding, nil
+}
+
+func (is *IdentityServer) setBranding(ctx context.Context, branding *Branding) error {
+	if err := branding.Validate(); err != nil {
+		return err
+	}
+	value, err := json.Marshal(branding)
+	if err != nil {
+		return err
+	}
+	is.branding.mu.Lock()
+	defer is.branding.mu.Unlock()
+	if err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.SetSetting(ctx, brandingSettingKey, value)
+	}); err != nil {
+		return err
+	}
+	is.branding.branding, is.branding.expiresAt = branding, time.Now().Add(is.config.Branding.CacheTTL)
+	return nil
+}
+
+func (b *Branding) toPB() *ttnpb.Branding {
+	return &ttnpb.Branding{
+		LogoUrl:          b.LogoURL,
+		PrimaryColor:     b.PrimaryColor,
+		SecondaryColor:   b.SecondaryColor,
+		FooterLinks:      b.FooterLinks,
+		SupportUrl:       b.SupportURL,
+		DocumentationUrl: b.DocumentationURL,
+	}
+}
+
+func brandingFromPB(pb *ttnpb.Branding) *Branding {
+	return &Branding{
+		LogoURL:          pb.GetLogoUrl(),
+		PrimaryColor:     pb.GetPrimaryColor(),
+		SecondaryColor:   pb.GetSecondaryColor(),
+		FooterLinks:      pb.GetFooterLinks(),
+		SupportURL:       pb.GetSupportUrl(),
+		DocumentationURL: pb.GetDocumentationUrl(),
+	}
+}
+
+// GetBranding implements ttnpb.IsServer.
+func (is *IdentityServer) GetBranding(ctx context.Context, _ *emptypb.Empty) (*ttnpb.Branding, error) {
+	branding, err := is.getBranding(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return branding.toPB(), nil
+}
+
+// SetBranding implements ttnpb.IsServer.
+func (is *IdentityServer) SetBranding(ctx context.Context, req *ttnpb.SetBrandingRequest) (*ttnpb.Branding, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	branding := brandingFromPB(req.GetBranding())
+	if err := is.setBranding(ctx, branding); err != nil {
+		return nil, err
+	}
+	return branding.toPB(), nil
+}
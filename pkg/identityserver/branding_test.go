@@ -0,0 +1,86 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBrandingValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name     string
+		Branding *Branding
+		Valid    bool
+	}{
+		{
+			Name:     "Empty",
+			Branding: &Branding{},
+			Valid:    true,
+		},
+		{
+			Name: "Valid",
+			Branding: &Branding{
+				LogoURL:        "https://example.com/logo.svg",
+				PrimaryColor:   "#0d83d0",
+				SecondaryColor: "#fff",
+				FooterLinks: map[string]string{
+					"Status": "https://status.example.com",
+				},
+				SupportURL:       "https://example.com/support",
+				DocumentationURL: "http://example.com/docs",
+			},
+			Valid: true,
+		},
+		{
+			Name:     "InvalidColor",
+			Branding: &Branding{PrimaryColor: "blue"},
+		},
+		{
+			Name:     "InvalidURLScheme",
+			Branding: &Branding{LogoURL: "javascript:alert(1)"},
+		},
+		{
+			Name:     "RelativeURL",
+			Branding: &Branding{SupportURL: "/support"},
+		},
+		{
+			Name: "InvalidFooterLink",
+			Branding: &Branding{
+				FooterLinks: map[string]string{
+					"Status": "ftp://status.example.com",
+				},
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(brandingFromPB(tc.Branding.toPB()), should.Resemble, tc.Branding)
+			err := tc.Branding.Validate()
+			if tc.Valid {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ type Account struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	UID string `bun:"uid,notnull"`
 
@@ -37,9 +37,10 @@ type APIKey struct {
 
 	APIKeyID string `bun:"api_key_id,nullzero"`
 
-	Key    string `bun:"key,nullzero"`
-	Rights []int  `bun:"rights,array,nullzero"`
-	Name   string `bun:"name,nullzero"`
+	Key          string `bun:"key,nullzero"`
+	Rights       []int  `bun:"rights,array,nullzero"`
+	DeniedRights []int  `bun:"denied_rights,array,nullzero"`
+	Name         string `bun:"name,nullzero"`
 
 	// EntityType is "application", "client", "end_device", "gateway", "organization" or "user".
 	EntityType string `bun:"entity_type,notnull"`
@@ -341,6 +342,76 @@ func (s *apiKeyStore) UpdateAPIKey(
 	return updatedPB, nil
 }
 
+func (s *apiKeyStore) getEntityAPIKeyModel(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string,
+) (*APIKey, error) {
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	model, err := s.getAPIKeyModelBy(ctx, combineApply(
+		s.selectWithEntityIDs(ctx, entityType, entityUUID),
+		s.selectWithAPIKeyID(ctx, id),
+	))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return nil, store.ErrAPIKeyNotFound.WithAttributes(
+				"entity_type", entityType,
+				"entity_id", entityID.IDString(),
+				"api_key_id", id,
+			)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *apiKeyStore) GetAPIKeyDeniedRights(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string,
+) (*ttnpb.Rights, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetAPIKeyDeniedRights", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("api_key_id", id),
+	))
+	defer span.End()
+
+	model, err := s.getEntityAPIKeyModel(ctx, entityID, id)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Rights{
+		Rights: convertIntSlice[int, ttnpb.Right](model.DeniedRights),
+	}, nil
+}
+
+func (s *apiKeyStore) SetAPIKeyDeniedRights(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string, denied *ttnpb.Rights,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetAPIKeyDeniedRights", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("api_key_id", id),
+	))
+	defer span.End()
+
+	model, err := s.getEntityAPIKeyModel(ctx, entityID, id)
+	if err != nil {
+		return err
+	}
+	model.DeniedRights = convertIntSlice[ttnpb.Right, int](denied.GetRights())
+
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column("denied_rights", "updated_at").
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
 func (s *apiKeyStore) DeleteAPIKey(
 	ctx context.Context, entityID *ttnpb.EntityIdentifiers, pb *ttnpb.APIKey,
 ) error {
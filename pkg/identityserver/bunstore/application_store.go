@@ -36,6 +36,7 @@ type Application struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	ApplicationID string `bun:"application_id,notnull"`
 
@@ -36,6 +36,7 @@ type Client struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	ClientID string `bun:"client_id,notnull"`
 
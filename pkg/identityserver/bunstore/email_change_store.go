@@ -0,0 +1,224 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"time"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// EmailChange is the email change model in the database.
+type EmailChange struct {
+	bun.BaseModel `bun:"table:email_changes,alias:ec"`
+
+	Model
+
+	User   *User  `bun:"rel:belongs-to,join:user_id=id"`
+	UserID string `bun:"user_id,notnull"`
+
+	OldEmailAddress string `bun:"old_email_address,notnull"`
+	NewEmailAddress string `bun:"new_email_address,notnull"`
+
+	OldToken       string     `bun:"old_token,notnull"`
+	NewToken       string     `bun:"new_token,notnull"`
+	OldConfirmedAt *time.Time `bun:"old_confirmed_at"`
+	NewConfirmedAt *time.Time `bun:"new_confirmed_at"`
+
+	RevertToken string     `bun:"revert_token,nullzero"`
+	CompletedAt *time.Time `bun:"completed_at"`
+
+	ExpiresAt time.Time `bun:"expires_at,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *EmailChange) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func emailChangeFromModel(m *EmailChange, userIDs *ttnpb.UserIdentifiers) *store.EmailChange {
+	change := &store.EmailChange{
+		ID:              m.ID,
+		UserIDs:         userIDs,
+		OldEmailAddress: m.OldEmailAddress,
+		NewEmailAddress: m.NewEmailAddress,
+		OldToken:        m.OldToken,
+		NewToken:        m.NewToken,
+		OldConfirmedAt:  m.OldConfirmedAt,
+		NewConfirmedAt:  m.NewConfirmedAt,
+		RevertToken:     m.RevertToken,
+		CompletedAt:     m.CompletedAt,
+		CreatedAt:       m.CreatedAt,
+		ExpiresAt:       m.ExpiresAt,
+	}
+	if userIDs == nil && m.User != nil {
+		change.UserIDs = &ttnpb.UserIdentifiers{
+			UserId: m.User.Account.UID,
+		}
+	}
+	return change
+}
+
+type emailChangeStore struct {
+	*entityStore
+}
+
+func newEmailChangeStore(baseStore *baseStore) *emailChangeStore {
+	return &emailChangeStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *emailChangeStore) CreateEmailChange(
+	ctx context.Context, change *store.EmailChange,
+) (*store.EmailChange, error) {
+	ctx, span := tracer.StartFromContext(ctx, "CreateEmailChange", trace.WithAttributes(
+		attribute.String("user_id", change.UserIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, change.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&EmailChange{}).
+		Where("user_id = ?", userUUID).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	model := &EmailChange{
+		UserID:          userUUID,
+		OldEmailAddress: change.OldEmailAddress,
+		NewEmailAddress: change.NewEmailAddress,
+		OldToken:        change.OldToken,
+		NewToken:        change.NewToken,
+		OldConfirmedAt:  cleanTimePtr(change.OldConfirmedAt),
+		NewConfirmedAt:  cleanTimePtr(change.NewConfirmedAt),
+		ExpiresAt:       cleanTime(change.ExpiresAt),
+	}
+
+	_, err = s.DB.NewInsert().
+		Model(model).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	return emailChangeFromModel(model, change.UserIDs), nil
+}
+
+func (s *emailChangeStore) getEmailChangeModel(ctx context.Context, id string) (*EmailChange, error) {
+	model := &EmailChange{}
+	err := s.newSelectModel(ctx, model).
+		Where("?TableAlias.id = ?", id).
+		Relation("User", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("account_uid")
+		}).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrEmailChangeNotFound.WithAttributes("reference", id)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *emailChangeStore) GetEmailChange(ctx context.Context, id string) (*store.EmailChange, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetEmailChange", trace.WithAttributes(
+		attribute.String("email_change_id", id),
+	))
+	defer span.End()
+
+	model, err := s.getEmailChangeModel(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return emailChangeFromModel(model, nil), nil
+}
+
+func (s *emailChangeStore) UpdateEmailChange(
+	ctx context.Context, change *store.EmailChange,
+) (*store.EmailChange, error) {
+	ctx, span := tracer.StartFromContext(ctx, "UpdateEmailChange", trace.WithAttributes(
+		attribute.String("email_change_id", change.ID),
+	))
+	defer span.End()
+
+	model, err := s.getEmailChangeModel(ctx, change.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	model.OldConfirmedAt = cleanTimePtr(change.OldConfirmedAt)
+	model.NewConfirmedAt = cleanTimePtr(change.NewConfirmedAt)
+	model.RevertToken = change.RevertToken
+	model.CompletedAt = cleanTimePtr(change.CompletedAt)
+	model.ExpiresAt = cleanTime(change.ExpiresAt)
+
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column(
+			"updated_at",
+			"old_confirmed_at", "new_confirmed_at",
+			"revert_token", "completed_at",
+			"expires_at",
+		).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	return emailChangeFromModel(model, nil), nil
+}
+
+func (s *emailChangeStore) DeleteEmailChange(ctx context.Context, id string) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteEmailChange", trace.WithAttributes(
+		attribute.String("email_change_id", id),
+	))
+	defer span.End()
+
+	model, err := s.getEmailChangeModel(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(model).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
@@ -37,6 +37,7 @@ type EndDevice struct {
 	bun.BaseModel `bun:"table:end_devices,alias:dev"`
 
 	Model
+	Tenant
 
 	ApplicationID string `bun:"application_id,notnull"`
 	DeviceID      string `bun:"device_id,notnull"`
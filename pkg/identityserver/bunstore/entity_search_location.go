@@ -0,0 +1,132 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// selectWithLocation selects the locations in the location model that match the geo query.
+// The bounding box uses the latitude and longitude index, the radius is evaluated with the haversine formula.
+func selectWithLocation(query store.GeoQuery) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		box := query.BoundingBox
+		q = q.Where(`?TableAlias."latitude" BETWEEN ? AND ?`, box.MinLatitude, box.MaxLatitude)
+		if box.MinLongitude > box.MaxLongitude {
+			q = q.WhereGroup(" AND ", func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.
+					Where(`?TableAlias."longitude" >= ?`, box.MinLongitude).
+					WhereOr(`?TableAlias."longitude" <= ?`, box.MaxLongitude)
+			})
+		} else {
+			q = q.Where(`?TableAlias."longitude" BETWEEN ? AND ?`, box.MinLongitude, box.MaxLongitude)
+		}
+		if query.Radius > 0 {
+			q = q.Where(
+				`2 * ? * ASIN(LEAST(1, SQRT(`+
+					`POWER(SIN(RADIANS(?TableAlias."latitude" - ?) / 2), 2) + `+
+					`COS(RADIANS(?)) * COS(RADIANS(?TableAlias."latitude")) * `+
+					`POWER(SIN(RADIANS(?TableAlias."longitude" - ?) / 2), 2)`+
+					`))) <= ?`,
+				store.EarthRadius,
+				query.CenterLatitude,
+				query.CenterLatitude,
+				query.CenterLongitude,
+				query.Radius,
+			)
+		}
+		return q
+	}
+}
+
+func (s *entitySearch) SearchGatewaysByLocation(
+	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, query store.GeoQuery,
+) ([]*ttnpb.GatewayIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SearchGatewaysByLocation")
+	defer span.End()
+
+	var selectors []func(*bun.SelectQuery) *bun.SelectQuery
+
+	if accountID != nil {
+		span.SetAttributes(
+			attribute.String("member_type", accountID.EntityType()),
+			attribute.String("member_id", accountID.IDString()),
+		)
+		selectWithUUID, err := s.selectWithUUIDsInMemberships(ctx, accountID, "gateway", accountID.EntityType() == "user")
+		if err != nil {
+			return nil, err
+		}
+		selectors = append(selectors, selectWithUUID)
+	}
+
+	antennaQuery := s.newSelectModel(ctx, &GatewayAntenna{}).
+		Column("gateway_id").
+		Apply(selectWithLocation(query))
+	selectors = append(selectors, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where(`?TableAlias."id" IN (?)`, antennaQuery)
+	})
+
+	pbs, err := s.listGatewaysBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+	if err != nil {
+		return nil, err
+	}
+
+	return getIDs(pbs, func(ids *ttnpb.GatewayIdentifiers) *ttnpb.GatewayIdentifiers {
+		return &ttnpb.GatewayIdentifiers{
+			GatewayId: ids.GatewayId,
+		}
+	}), nil
+}
+
+func (s *entitySearch) SearchEndDevicesByLocation(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, query store.GeoQuery,
+) ([]*ttnpb.EndDeviceIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SearchEndDevicesByLocation")
+	defer span.End()
+
+	var selectors []func(*bun.SelectQuery) *bun.SelectQuery
+
+	if appIDs != nil {
+		span.SetAttributes(
+			attribute.String("application_id", appIDs.GetApplicationId()),
+		)
+		selectors = append(selectors, s.endDeviceStore.selectWithID(ctx, appIDs.GetApplicationId()))
+	}
+
+	locationQuery := s.newSelectModel(ctx, &EndDeviceLocation{}).
+		Column("end_device_id").
+		Apply(selectWithLocation(query))
+	selectors = append(selectors, func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where(`?TableAlias."id" IN (?)`, locationQuery)
+	})
+
+	pbs, err := s.listEndDevicesBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+	if err != nil {
+		return nil, err
+	}
+
+	return getIDs(pbs, func(ids *ttnpb.EndDeviceIdentifiers) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: ids.ApplicationIds,
+			DeviceId:       ids.DeviceId,
+		}
+	}), nil
+}
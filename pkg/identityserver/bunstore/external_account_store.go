@@ -0,0 +1,202 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// ExternalAccount is the external account model in the database.
+type ExternalAccount struct {
+	bun.BaseModel `bun:"table:external_accounts,alias:ea"`
+
+	Model
+
+	User   *User  `bun:"rel:belongs-to,join:user_id=id"`
+	UserID string `bun:"user_id,notnull"`
+
+	Provider string `bun:"provider,notnull"`
+	Subject  string `bun:"subject,notnull"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *ExternalAccount) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func externalAccountFromModel(m *ExternalAccount, userIDs *ttnpb.UserIdentifiers) *store.ExternalAccount {
+	account := &store.ExternalAccount{
+		UserIDs:   userIDs,
+		Provider:  m.Provider,
+		Subject:   m.Subject,
+		CreatedAt: m.CreatedAt,
+	}
+	if userIDs == nil && m.User != nil {
+		account.UserIDs = &ttnpb.UserIdentifiers{
+			UserId: m.User.Account.UID,
+		}
+	}
+	return account
+}
+
+type externalAccountStore struct {
+	*entityStore
+}
+
+func newExternalAccountStore(baseStore *baseStore) *externalAccountStore {
+	return &externalAccountStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *externalAccountStore) CreateExternalAccount(
+	ctx context.Context, account *store.ExternalAccount,
+) (*store.ExternalAccount, error) {
+	ctx, span := tracer.StartFromContext(ctx, "CreateExternalAccount", trace.WithAttributes(
+		attribute.String("user_id", account.UserIDs.GetUserId()),
+		attribute.String("provider", account.Provider),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, account.UserIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	model := &ExternalAccount{
+		UserID:   userUUID,
+		Provider: account.Provider,
+		Subject:  account.Subject,
+	}
+
+	_, err = s.DB.NewInsert().
+		Model(model).
+		Exec(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsAlreadyExists(err) {
+			return nil, store.ErrExternalAccountAlreadyLinked.WithAttributes("provider", account.Provider)
+		}
+		return nil, err
+	}
+
+	return externalAccountFromModel(model, account.UserIDs), nil
+}
+
+func (s *externalAccountStore) GetExternalAccount(
+	ctx context.Context, provider, subject string,
+) (*store.ExternalAccount, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetExternalAccount", trace.WithAttributes(
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	model := &ExternalAccount{}
+	err := s.newSelectModel(ctx, model).
+		Where("provider = ?", provider).
+		Where("subject = ?", subject).
+		Relation("User", func(q *bun.SelectQuery) *bun.SelectQuery {
+			return q.Column("account_uid")
+		}).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrExternalAccountNotFound.WithAttributes("provider", provider)
+		}
+		return nil, err
+	}
+
+	return externalAccountFromModel(model, nil), nil
+}
+
+func (s *externalAccountStore) ListExternalAccounts(
+	ctx context.Context, userIDs *ttnpb.UserIdentifiers,
+) ([]*store.ExternalAccount, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListExternalAccounts", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	models := []*ExternalAccount{}
+	err = newSelectModels(ctx, s.DB, &models).
+		Where("user_id = ?", userUUID).
+		Order("provider").
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	accounts := make([]*store.ExternalAccount, len(models))
+	for i, model := range models {
+		accounts[i] = externalAccountFromModel(model, userIDs)
+	}
+
+	return accounts, nil
+}
+
+func (s *externalAccountStore) DeleteExternalAccount(
+	ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider string,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteExternalAccount", trace.WithAttributes(
+		attribute.String("user_id", userIDs.GetUserId()),
+		attribute.String("provider", provider),
+	))
+	defer span.End()
+
+	_, userUUID, err := s.getEntity(ctx, userIDs)
+	if err != nil {
+		return err
+	}
+
+	model := &ExternalAccount{}
+	err = s.newSelectModel(ctx, model).
+		Where("user_id = ?", userUUID).
+		Where("provider = ?", provider).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return store.ErrExternalAccountNotFound.WithAttributes("provider", provider)
+		}
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(model).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
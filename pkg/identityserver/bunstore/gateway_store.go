@@ -40,6 +40,7 @@ type Gateway struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	GatewayID  string  `bun:"gateway_id,notnull"`
 	GatewayEUI *string `bun:"gateway_eui"`
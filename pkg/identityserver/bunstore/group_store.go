@@ -0,0 +1,524 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// Group is the organization group model in the database.
+type Group struct {
+	bun.BaseModel `bun:"table:organization_groups,alias:grp"`
+
+	Model
+
+	// OrganizationID is the Account.ID of the organization.
+	OrganizationID string `bun:"organization_id,notnull"`
+
+	GroupID     string `bun:"group_id,notnull"`
+	Name        string `bun:"name,nullzero"`
+	Description string `bun:"description,nullzero"`
+}
+
+// GroupMember is the organization group member model in the database.
+type GroupMember struct {
+	bun.BaseModel `bun:"table:organization_group_members,alias:gusr"`
+
+	Model
+
+	GroupID string `bun:"group_id,notnull"`
+	// AccountID is the Account.ID of the user.
+	AccountID string `bun:"account_id,notnull"`
+}
+
+// GroupMembership is the organization group membership model in the database.
+type GroupMembership struct {
+	bun.BaseModel `bun:"table:organization_group_memberships,alias:gmem"`
+
+	Model
+
+	GroupID string `bun:"group_id,notnull"`
+	Rights  []int  `bun:"rights,array,nullzero"`
+
+	// EntityType is "application", "client" or "gateway".
+	EntityType string `bun:"entity_type,notnull"`
+	// EntityID is Application.ID, Client.ID or Gateway.ID.
+	EntityID string `bun:"entity_id,notnull"`
+}
+
+func groupFromModel(m *Group) *store.Group {
+	return &store.Group{
+		GroupID:     m.GroupID,
+		Name:        m.Name,
+		Description: m.Description,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+	}
+}
+
+type groupStore struct {
+	*entityStore
+}
+
+func newGroupStore(baseStore *baseStore) *groupStore {
+	return &groupStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *groupStore) getGroupModel(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+) (*Group, error) {
+	org, err := s.getAccountModel(ctx, orgID.EntityType(), orgID.IDString())
+	if err != nil {
+		return nil, err
+	}
+	model := &Group{}
+	err = s.newSelectModel(ctx, model).
+		Where("organization_id = ?", org.ID).
+		Where("group_id = ?", groupID).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrGroupNotFound.WithAttributes(
+				"group_id", groupID,
+				"organization_id", orgID.IDString(),
+			)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *groupStore) deleteGroups(ctx context.Context, groupUUIDs ...string) error {
+	if len(groupUUIDs) == 0 {
+		return nil
+	}
+	_, err := s.DB.NewDelete().
+		Model((*GroupMembership)(nil)).
+		Where("group_id IN (?)", bun.In(groupUUIDs)).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	_, err = s.DB.NewDelete().
+		Model((*GroupMember)(nil)).
+		Where("group_id IN (?)", bun.In(groupUUIDs)).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	_, err = s.DB.NewDelete().
+		Model((*Group)(nil)).
+		Where("id IN (?)", bun.In(groupUUIDs)).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *groupStore) ListGroups(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers) ([]*store.Group, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListGroups", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+	))
+	defer span.End()
+
+	org, err := s.getAccountModel(ctx, orgID.EntityType(), orgID.IDString())
+	if err != nil {
+		return nil, err
+	}
+
+	models := []*Group{}
+	err = newSelectModels(ctx, s.DB, &models).
+		Where("organization_id = ?", org.ID).
+		Order("group_id").
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	groups := make([]*store.Group, len(models))
+	for i, model := range models {
+		groups[i] = groupFromModel(model)
+	}
+	return groups, nil
+}
+
+func (s *groupStore) GetGroup(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+) (*store.Group, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetGroup", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+	))
+	defer span.End()
+
+	model, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	return groupFromModel(model), nil
+}
+
+func (s *groupStore) SetGroup(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, group *store.Group,
+) (*store.Group, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SetGroup", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", group.GroupID),
+	))
+	defer span.End()
+
+	model, err := s.getGroupModel(ctx, orgID, group.GroupID)
+	switch {
+	case err == nil:
+		model.Name = group.Name
+		model.Description = group.Description
+		_, err = s.DB.NewUpdate().
+			Model(model).
+			WherePK().
+			Column("name", "description", "updated_at").
+			Exec(ctx)
+	case errors.IsNotFound(err):
+		var org *Account
+		org, err = s.getAccountModel(ctx, orgID.EntityType(), orgID.IDString())
+		if err != nil {
+			return nil, err
+		}
+		model = &Group{
+			OrganizationID: org.ID,
+			GroupID:        group.GroupID,
+			Name:           group.Name,
+			Description:    group.Description,
+		}
+		_, err = s.DB.NewInsert().
+			Model(model).
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	return groupFromModel(model), nil
+}
+
+func (s *groupStore) DeleteGroup(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteGroup", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+	))
+	defer span.End()
+
+	model, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	return s.deleteGroups(ctx, model.ID)
+}
+
+func (s *groupStore) DeleteOrganizationGroups(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteOrganizationGroups", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+	))
+	defer span.End()
+
+	org, err := s.getAccountModel(store.WithSoftDeleted(ctx, false), orgID.EntityType(), orgID.IDString())
+	if err != nil {
+		return err
+	}
+
+	var groupUUIDs []string
+	err = s.newSelectModel(ctx, &Group{}).
+		Column("id").
+		Where("organization_id = ?", org.ID).
+		Scan(ctx, &groupUUIDs)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return s.deleteGroups(ctx, groupUUIDs...)
+}
+
+func (s *groupStore) ListGroupMembers(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+) ([]*ttnpb.UserIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListGroupMembers", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+	))
+	defer span.End()
+
+	group, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	memberQuery := s.newSelectModel(ctx, &GroupMember{}).
+		Column("account_id").
+		Where("group_id = ?", group.ID)
+
+	var uids []string
+	err = s.newSelectModel(ctx, &Account{}).
+		Column("uid").
+		Where("?TableAlias.account_type = ?", store.EntityUser).
+		Where("?TableAlias.id IN (?)", memberQuery).
+		Order("uid").
+		Scan(ctx, &uids)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	members := make([]*ttnpb.UserIdentifiers, len(uids))
+	for i, uid := range uids {
+		members[i] = &ttnpb.UserIdentifiers{UserId: uid}
+	}
+	return members, nil
+}
+
+func (s *groupStore) AddGroupMember(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string, userID *ttnpb.UserIdentifiers,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "AddGroupMember", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+		attribute.String("user_id", userID.IDString()),
+	))
+	defer span.End()
+
+	group, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	usr, err := s.getAccountModel(ctx, userID.EntityType(), userID.IDString())
+	if err != nil {
+		return err
+	}
+
+	exists, err := s.newSelectModel(ctx, &GroupMember{}).
+		Where("group_id = ?", group.ID).
+		Where("account_id = ?", usr.ID).
+		Exists(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	if exists {
+		return nil
+	}
+
+	_, err = s.DB.NewInsert().
+		Model(&GroupMember{
+			GroupID:   group.ID,
+			AccountID: usr.ID,
+		}).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *groupStore) RemoveGroupMember(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string, userID *ttnpb.UserIdentifiers,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "RemoveGroupMember", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+		attribute.String("user_id", userID.IDString()),
+	))
+	defer span.End()
+
+	group, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	usr, err := s.getAccountModel(ctx, userID.EntityType(), userID.IDString())
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model((*GroupMember)(nil)).
+		Where("group_id = ?", group.ID).
+		Where("account_id = ?", usr.ID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *groupStore) DeleteUserGroupMembers(ctx context.Context, userID *ttnpb.UserIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteUserGroupMembers", trace.WithAttributes(
+		attribute.String("user_id", userID.IDString()),
+	))
+	defer span.End()
+
+	usr, err := s.getAccountModel(store.WithSoftDeleted(ctx, false), userID.EntityType(), userID.IDString())
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model((*GroupMember)(nil)).
+		Where("account_id = ?", usr.ID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *groupStore) ListGroupMemberships(
+	ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+) ([]*store.GroupMembership, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListGroupMemberships", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+	))
+	defer span.End()
+
+	group, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return nil, err
+	}
+
+	models := []*GroupMembership{}
+	err = newSelectModels(ctx, s.DB, &models).
+		Where("group_id = ?", group.ID).
+		Order("entity_type", "created_at").
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	memberships := make([]*store.GroupMembership, 0, len(models))
+	for _, model := range models {
+		friendlyID, err := s.getEntityID(ctx, model.EntityType, model.EntityID)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				// The entity is deleted, but not yet purged.
+				continue
+			}
+			return nil, err
+		}
+		memberships = append(memberships, &store.GroupMembership{
+			EntityIdentifiers: getEntityIdentifiers(model.EntityType, friendlyID),
+			Rights: &ttnpb.Rights{
+				Rights: convertIntSlice[int, ttnpb.Right](model.Rights),
+			},
+		})
+	}
+	return memberships, nil
+}
+
+func (s *groupStore) SetGroupMembership(
+	ctx context.Context,
+	orgID *ttnpb.OrganizationIdentifiers,
+	groupID string,
+	entityID *ttnpb.EntityIdentifiers,
+	rights *ttnpb.Rights,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetGroupMembership", trace.WithAttributes(
+		attribute.String("organization_id", orgID.IDString()),
+		attribute.String("group_id", groupID),
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	group, err := s.getGroupModel(ctx, orgID, groupID)
+	if err != nil {
+		return err
+	}
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return err
+	}
+
+	if len(rights.GetRights()) == 0 {
+		_, err = s.DB.NewDelete().
+			Model((*GroupMembership)(nil)).
+			Where("group_id = ?", group.ID).
+			Where("entity_type = ?", entityType).
+			Where("entity_id = ?", entityUUID).
+			Exec(ctx)
+		if err != nil {
+			return storeutil.WrapDriverError(err)
+		}
+		return nil
+	}
+
+	model := &GroupMembership{}
+	err = s.newSelectModel(ctx, model).
+		Where("group_id = ?", group.ID).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Scan(ctx)
+	switch err = storeutil.WrapDriverError(err); {
+	case err == nil:
+		model.Rights = convertIntSlice[ttnpb.Right, int](rights.GetRights())
+		_, err = s.DB.NewUpdate().
+			Model(model).
+			WherePK().
+			Column("rights", "updated_at").
+			Exec(ctx)
+	case errors.IsNotFound(err):
+		model = &GroupMembership{
+			GroupID:    group.ID,
+			Rights:     convertIntSlice[ttnpb.Right, int](rights.GetRights()),
+			EntityType: entityType,
+			EntityID:   entityUUID,
+		}
+		_, err = s.DB.NewInsert().
+			Model(model).
+			Exec(ctx)
+	}
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *groupStore) DeleteEntityGroupMemberships(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteEntityGroupMemberships", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), entityID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model((*GroupMembership)(nil)).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
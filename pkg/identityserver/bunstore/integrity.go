@@ -0,0 +1,175 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// entityExists is a condition that is true if the entity of the row (with alias t) exists.
+// Entities that are soft-deleted still exist.
+const entityExists = `CASE t.entity_type
+	WHEN 'application' THEN EXISTS (SELECT 1 FROM applications WHERE id = t.entity_id)
+	WHEN 'client' THEN EXISTS (SELECT 1 FROM clients WHERE id = t.entity_id)
+	WHEN 'end_device' THEN EXISTS (SELECT 1 FROM end_devices WHERE id = t.entity_id)
+	WHEN 'gateway' THEN EXISTS (SELECT 1 FROM gateways WHERE id = t.entity_id)
+	WHEN 'organization' THEN EXISTS (SELECT 1 FROM organizations WHERE id = t.entity_id)
+	WHEN 'user' THEN EXISTS (SELECT 1 FROM users WHERE id = t.entity_id)
+	ELSE FALSE
+END`
+
+// integrityCheck is a check of the referential integrity of a table.
+type integrityCheck struct {
+	name        string
+	description string
+	table       string
+	// where selects the rows of the table (with alias t) that fail the check.
+	where string
+}
+
+// integrityChecks are the referential integrity checks of the database. Repairing a check can make rows fail
+// the checks that follow it, so the checks of referenced tables come before the checks of referencing tables.
+var integrityChecks = []integrityCheck{
+	{
+		name:        "account_entity",
+		description: "accounts of users or organizations that do not exist",
+		table:       "accounts",
+		where: `CASE t.account_type
+	WHEN 'organization' THEN NOT EXISTS (SELECT 1 FROM organizations WHERE id = t.account_id)
+	WHEN 'user' THEN NOT EXISTS (SELECT 1 FROM users WHERE id = t.account_id)
+	ELSE TRUE
+END`,
+	},
+	{
+		name:        "membership_account",
+		description: "memberships of accounts that do not exist",
+		table:       "memberships",
+		where:       "NOT EXISTS (SELECT 1 FROM accounts WHERE id = t.account_id)",
+	},
+	{
+		name:        "membership_entity",
+		description: "memberships on entities that do not exist",
+		table:       "memberships",
+		where:       "NOT " + entityExists,
+	},
+	{
+		name:        "api_key_entity",
+		description: "API keys of entities that do not exist",
+		table:       "api_keys",
+		where:       "NOT " + entityExists,
+	},
+	{
+		name:        "contact_info_entity",
+		description: "contact info of entities that do not exist",
+		table:       "contact_infos",
+		where:       "NOT " + entityExists,
+	},
+	{
+		name:        "role_entity",
+		description: "roles of entities that do not exist",
+		table:       "roles",
+		where:       "NOT " + entityExists,
+	},
+	{
+		name:        "label_entity",
+		description: "labels of entities that do not exist",
+		table:       "labels",
+		where:       "NOT " + entityExists,
+	},
+	{
+		name:        "group_organization",
+		description: "groups of organizations that do not exist",
+		table:       "organization_groups",
+		where:       "NOT EXISTS (SELECT 1 FROM accounts WHERE id = t.organization_id AND account_type = 'organization')",
+	},
+	{
+		name:        "group_member_group",
+		description: "members of groups that do not exist",
+		table:       "organization_group_members",
+		where:       "NOT EXISTS (SELECT 1 FROM organization_groups WHERE id = t.group_id)",
+	},
+	{
+		name:        "group_member_account",
+		description: "group members of accounts that do not exist",
+		table:       "organization_group_members",
+		where:       "NOT EXISTS (SELECT 1 FROM accounts WHERE id = t.account_id)",
+	},
+	{
+		name:        "group_membership_group",
+		description: "memberships of groups that do not exist",
+		table:       "organization_group_memberships",
+		where:       "NOT EXISTS (SELECT 1 FROM organization_groups WHERE id = t.group_id)",
+	},
+	{
+		name:        "group_membership_entity",
+		description: "group memberships on entities that do not exist",
+		table:       "organization_group_memberships",
+		where:       "NOT " + entityExists,
+	},
+}
+
+// CheckIntegrity implements store.IntegrityChecker.
+func (s *Store) CheckIntegrity(ctx context.Context, repair bool) ([]*store.IntegrityFinding, error) {
+	ctx, span := tracer.StartFromContext(ctx, "CheckIntegrity", trace.WithAttributes(
+		attribute.Bool("repair", repair),
+	))
+	defer span.End()
+
+	var findings []*store.IntegrityFinding
+	err := s.transact(ctx, func(ctx context.Context, tx bun.IDB) error {
+		for _, check := range integrityChecks {
+			var ids []string
+			err := tx.NewSelect().
+				TableExpr("? AS t", bun.Ident(check.table)).
+				Column("t.id").
+				Where(check.where).
+				Order("t.id").
+				Scan(ctx, &ids)
+			if err != nil {
+				return storeutil.WrapDriverError(err)
+			}
+			if len(ids) == 0 {
+				continue
+			}
+			findings = append(findings, &store.IntegrityFinding{
+				Check:       check.name,
+				Description: check.description,
+				IDs:         ids,
+			})
+			if !repair {
+				continue
+			}
+			_, err = tx.NewDelete().
+				TableExpr("? AS t", bun.Ident(check.table)).
+				Where("t.id IN (?)", bun.In(ids)).
+				Exec(ctx)
+			if err != nil {
+				return storeutil.WrapDriverError(err)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return findings, nil
+}
@@ -0,0 +1,275 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// Label is the label model in the database.
+type Label struct {
+	bun.BaseModel `bun:"table:labels,alias:lbl"`
+
+	UUID
+
+	// EntityType is "application", "client", "end_device", "gateway", "organization" or "user".
+	EntityType string `bun:"entity_type,notnull"`
+	// EntityID is Application.ID, Client.ID, EndDevice.ID, Gateway.ID, Organization.ID or User.ID.
+	EntityID string `bun:"entity_id,notnull"`
+
+	Key   string `bun:"key,notnull"`
+	Value string `bun:"value,notnull"`
+}
+
+func (Label) _isModel() {} // It doesn't embed Model, but it's still a model.
+
+type labelStore struct {
+	*entityStore
+}
+
+func newLabelStore(baseStore *baseStore) *labelStore {
+	return &labelStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *labelStore) getLabels(ctx context.Context, entityType, entityUUID string) (map[string]string, error) {
+	models := []*Label{}
+	err := newSelectModels(ctx, s.DB, &models).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityUUID).
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+	labels := make(map[string]string, len(models))
+	for _, model := range models {
+		labels[model.Key] = model.Value
+	}
+	return labels, nil
+}
+
+func (s *labelStore) GetLabels(ctx context.Context, entityID ttnpb.IDStringer) (map[string]string, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetLabels", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.getLabels(ctx, entityType, entityUUID)
+}
+
+func (s *labelStore) SetLabels(
+	ctx context.Context, entityID ttnpb.IDStringer, labels map[string]string,
+) (map[string]string, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SetLabels", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&Label{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityUUID).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	if len(labels) > 0 {
+		models := make([]*Label, 0, len(labels))
+		for k, v := range labels {
+			models = append(models, &Label{
+				EntityType: entityType,
+				EntityID:   entityUUID,
+				Key:        k,
+				Value:      v,
+			})
+		}
+		_, err = s.DB.NewInsert().
+			Model(&models).
+			Exec(ctx)
+		if err != nil {
+			return nil, storeutil.WrapDriverError(err)
+		}
+	}
+
+	return s.getLabels(ctx, entityType, entityUUID)
+}
+
+func (s *labelStore) DeleteEntityLabels(ctx context.Context, entityID ttnpb.IDStringer) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteEntityLabels", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), entityID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&Label{}).
+		Where("entity_type = ? AND entity_id = ?", entityType, entityUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	return nil
+}
+
+// selectWithLabels selects the entities of the entity type that have all the given labels.
+func (s *entitySearch) selectWithLabels(
+	ctx context.Context, entityType string, labels map[string]string,
+) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		for k, v := range labels {
+			labelQuery := s.newSelectModel(ctx, &Label{}).
+				Column("entity_id").
+				Where(`"entity_type" = ?`, entityType).
+				Where(`"key" = ?`, k).
+				Where(`"value" = ?`, v)
+			q = q.Where(`?TableAlias."id" IN (?)`, labelQuery)
+		}
+		return q
+	}
+}
+
+func (s *entitySearch) SearchEntitiesByLabels(
+	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityType string, labels map[string]string,
+) ([]*ttnpb.EntityIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SearchEntitiesByLabels", trace.WithAttributes(
+		attribute.String("entity_type", entityType),
+	))
+	defer span.End()
+
+	selectors := []func(*bun.SelectQuery) *bun.SelectQuery{
+		s.selectWithLabels(ctx, entityType, labels),
+	}
+
+	if accountID != nil {
+		span.SetAttributes(
+			attribute.String("member_type", accountID.EntityType()),
+			attribute.String("member_id", accountID.IDString()),
+		)
+		membershipEntityType := entityType
+		if entityType == "end_device" {
+			membershipEntityType = store.EntityApplication
+		}
+		if membershipEntityType == store.EntityUser {
+			// Users are not members of users.
+			return nil, nil
+		}
+		selectWithUUID, err := s.selectWithUUIDsInMemberships(
+			ctx,
+			accountID,
+			membershipEntityType,
+			accountID.EntityType() == "user" && membershipEntityType != store.EntityOrganization,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if entityType == "end_device" {
+			applicationQuery := s.newSelectModel(ctx, &Application{}).
+				Column("application_id").
+				Apply(selectWithUUID)
+			selectors = append(selectors, func(q *bun.SelectQuery) *bun.SelectQuery {
+				return q.Where(`?TableAlias."application_id" IN (?)`, applicationQuery)
+			})
+		} else {
+			selectors = append(selectors, selectWithUUID)
+		}
+	}
+
+	var ids []*ttnpb.EntityIdentifiers
+	switch entityType {
+	default:
+		panic(fmt.Errorf("invalid entity type: %s", entityType))
+	case store.EntityApplication:
+		pbs, err := s.listApplicationsBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, pb.GetIds().GetEntityIdentifiers())
+		}
+	case store.EntityClient:
+		pbs, err := s.listClientsBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, pb.GetIds().GetEntityIdentifiers())
+		}
+	case "end_device":
+		pbs, err := s.listEndDevicesBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, (&ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: pb.GetIds().GetApplicationIds(),
+				DeviceId:       pb.GetIds().GetDeviceId(),
+			}).GetEntityIdentifiers())
+		}
+	case store.EntityGateway:
+		pbs, err := s.listGatewaysBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, (&ttnpb.GatewayIdentifiers{
+				GatewayId: pb.GetIds().GetGatewayId(),
+			}).GetEntityIdentifiers())
+		}
+	case store.EntityOrganization:
+		pbs, err := s.listOrganizationsBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, pb.GetIds().GetEntityIdentifiers())
+		}
+	case store.EntityUser:
+		pbs, err := s.listUsersBy(ctx, combineApply(selectors...), store.FieldMask{"ids"})
+		if err != nil {
+			return nil, err
+		}
+		for _, pb := range pbs {
+			ids = append(ids, pb.GetIds().GetEntityIdentifiers())
+		}
+	}
+	return ids, nil
+}
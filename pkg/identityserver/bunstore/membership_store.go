@@ -37,7 +37,9 @@ type Membership struct {
 	AccountID string   `bun:"account_id,notnull"`
 	Account   *Account `bun:"rel:belongs-to,join:account_id=id"`
 
-	Rights []int `bun:"rights,array,nullzero"`
+	Rights       []int  `bun:"rights,array,nullzero"`
+	DeniedRights []int  `bun:"denied_rights,array,nullzero"`
+	Role         string `bun:"role,nullzero"`
 
 	EntityID   string `bun:"entity_id,notnull"`
 	EntityType string `bun:"entity_type,notnull"`
@@ -59,6 +61,7 @@ type directEntityMembership struct {
 	AccountID         string `bun:"account_id,notnull"`
 	AccountFriendlyID string `bun:"account_friendly_id,notnull"`
 	Rights            []int  `bun:"rights,array,nullzero"`
+	DeniedRights      []int  `bun:"denied_rights,array,nullzero"`
 	EntityType        string `bun:"entity_type,notnull"`
 	EntityID          string `bun:"entity_id,notnull"`
 	EntityFriendlyID  string `bun:"entity_friendly_id,notnull"`
@@ -73,9 +76,11 @@ type indirectEntityMembership struct {
 	UserAccountID                 string `bun:"user_account_id,notnull"`
 	UserAccountFriendlyID         string `bun:"user_account_friendly_id,notnull"`
 	UserRights                    []int  `bun:"user_rights,array,nullzero"`
+	UserDeniedRights              []int  `bun:"user_denied_rights,array,nullzero"`
 	OrganizationAccountID         string `bun:"organization_account_id,notnull"`
 	OrganizationAccountFriendlyID string `bun:"organization_account_friendly_id,notnull"`
 	EntityRights                  []int  `bun:"entity_rights,array,nullzero"`
+	EntityDeniedRights            []int  `bun:"entity_denied_rights,array,nullzero"`
 	EntityType                    string `bun:"entity_type,notnull"`
 	EntityID                      string `bun:"entity_id,notnull"`
 	EntityFriendlyID              string `bun:"entity_friendly_id,notnull"`
@@ -93,6 +98,38 @@ func newMembershipStore(baseStore *baseStore) *membershipStore {
 	}
 }
 
+func (s *entityStore) getMembershipModel(
+	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+) (*Membership, error) {
+	account, err := s.getAccountModel(ctx, accountID.EntityType(), accountID.IDString())
+	if err != nil {
+		return nil, err
+	}
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	model := &Membership{}
+	err = s.newSelectModel(ctx, model).
+		Where("account_id = ?", account.ID).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrMembershipNotFound.WithAttributes(
+				"account_type", accountID.EntityType(),
+				"account_id", accountID.IDString(),
+				"entity_type", entityID.EntityType(),
+				"entity_id", entityID.IDString(),
+			)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
 func (s *membershipStore) selectWithUUIDsInMemberships(
 	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityType string, includeIndirect bool,
 ) (func(*bun.SelectQuery) *bun.SelectQuery, error) {
@@ -270,6 +307,9 @@ func (s *membershipStore) FindAccountMembershipChains(
 			RightsOnEntity: &ttnpb.Rights{
 				Rights: convertIntSlice[int, ttnpb.Right](directMembership.Rights),
 			},
+			DeniedRightsOnEntity: &ttnpb.Rights{
+				Rights: convertIntSlice[int, ttnpb.Right](directMembership.DeniedRights),
+			},
 			EntityIdentifiers: getEntityIdentifiers(
 				directMembership.EntityType, directMembership.EntityFriendlyID,
 			),
@@ -295,12 +335,18 @@ func (s *membershipStore) FindAccountMembershipChains(
 			RightsOnOrganization: &ttnpb.Rights{
 				Rights: convertIntSlice[int, ttnpb.Right](indirectMembership.UserRights),
 			},
+			DeniedRightsOnOrganization: &ttnpb.Rights{
+				Rights: convertIntSlice[int, ttnpb.Right](indirectMembership.UserDeniedRights),
+			},
 			OrganizationIdentifiers: &ttnpb.OrganizationIdentifiers{
 				OrganizationId: indirectMembership.OrganizationAccountFriendlyID,
 			},
 			RightsOnEntity: &ttnpb.Rights{
 				Rights: convertIntSlice[int, ttnpb.Right](indirectMembership.EntityRights),
 			},
+			DeniedRightsOnEntity: &ttnpb.Rights{
+				Rights: convertIntSlice[int, ttnpb.Right](indirectMembership.EntityDeniedRights),
+			},
 			EntityIdentifiers: getEntityIdentifiers(
 				indirectMembership.EntityType, indirectMembership.EntityFriendlyID,
 			),
@@ -455,11 +501,12 @@ func (s *membershipStore) SetMember(
 	}
 
 	model.Rights = convertIntSlice[ttnpb.Right, int](rights.GetRights())
+	model.Role = ""
 
 	_, err = s.DB.NewUpdate().
 		Model(model).
 		WherePK().
-		Column("rights", "updated_at").
+		Column("rights", "role", "updated_at").
 		Exec(ctx)
 	if err != nil {
 		return storeutil.WrapDriverError(err)
@@ -468,6 +515,57 @@ func (s *membershipStore) SetMember(
 	return nil
 }
 
+func (s *membershipStore) GetMemberDeniedRights(
+	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+) (*ttnpb.Rights, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetMemberDeniedRights", trace.WithAttributes(
+		attribute.String("account_type", accountID.EntityType()),
+		attribute.String("account_id", accountID.IDString()),
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	model, err := s.getMembershipModel(ctx, accountID, entityID)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Rights{
+		Rights: convertIntSlice[int, ttnpb.Right](model.DeniedRights),
+	}, nil
+}
+
+func (s *membershipStore) SetMemberDeniedRights(
+	ctx context.Context,
+	accountID *ttnpb.OrganizationOrUserIdentifiers,
+	entityID *ttnpb.EntityIdentifiers,
+	denied *ttnpb.Rights,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetMemberDeniedRights", trace.WithAttributes(
+		attribute.String("account_type", accountID.EntityType()),
+		attribute.String("account_id", accountID.IDString()),
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	model, err := s.getMembershipModel(ctx, accountID, entityID)
+	if err != nil {
+		return err
+	}
+	model.DeniedRights = convertIntSlice[ttnpb.Right, int](denied.GetRights())
+
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column("denied_rights", "updated_at").
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
 // DeleteMember elminates the direct member rights attached to an entity.
 func (s *membershipStore) DeleteMember(
 	ctx context.Context, ids *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
@@ -20,6 +20,7 @@ import (
 
 	"github.com/uptrace/bun"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
 )
 
 // UUID can be embedded in models that should have a UUID field.
@@ -83,3 +84,24 @@ func selectWithSoftDeletedFromContext(ctx context.Context) func(*bun.SelectQuery
 		return q
 	}
 }
+
+// Tenant can be embedded in models of entities that belong to a tenant.
+type Tenant struct {
+	TenantID string `bun:"tenant_id,notnull"`
+}
+
+func (Tenant) _isTenant() {}
+
+var _ bun.BeforeInsertHook = (*Tenant)(nil)
+
+// BeforeInsert is a hook that sets the tenant of the model from the context on INSERT queries.
+func (*Tenant) BeforeInsert(ctx context.Context, query *bun.InsertQuery) error {
+	query.Value("tenant_id", "?", tenant.IDFromContext(ctx))
+	return nil
+}
+
+func selectWithTenantFromContext(ctx context.Context) func(*bun.SelectQuery) *bun.SelectQuery {
+	return func(q *bun.SelectQuery) *bun.SelectQuery {
+		return q.Where("?TableAlias.tenant_id = ?", tenant.IDFromContext(ctx))
+	}
+}
@@ -296,3 +296,41 @@ func (s *notificationStore) UpdateNotificationStatus(
 
 	return nil
 }
+
+func (s *notificationStore) ListNotificationReceivers(
+	ctx context.Context, createdAfter, createdBefore time.Time, statuses []ttnpb.NotificationStatus,
+) ([]*ttnpb.UserIdentifiers, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListNotificationReceivers")
+	defer span.End()
+
+	receiverQuery := s.DB.NewSelect().
+		Model((*NotificationReceiver)(nil)).
+		Column("rec.receiver_id").
+		Join("JOIN notifications AS n ON n.id = rec.notification_id").
+		Where("n.created_at > ?", createdAfter).
+		Where("n.created_at <= ?", createdBefore)
+
+	if len(statuses) > 0 {
+		receiverQuery = receiverQuery.Where(
+			"rec.status IN (?)",
+			bun.In(convertIntSlice[ttnpb.NotificationStatus, int](statuses)),
+		)
+	}
+
+	var userIDs []string
+	err := s.newSelectModel(ctx, &Account{}).
+		Column("uid").
+		Where("?TableAlias.account_type = ?", store.EntityUser).
+		Where("?TableAlias.account_id IN (?)", receiverQuery).
+		Order("uid").
+		Scan(ctx, &userIDs)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	pbs := make([]*ttnpb.UserIdentifiers, len(userIDs))
+	for i, userID := range userIDs {
+		pbs[i] = &ttnpb.UserIdentifiers{UserId: userID}
+	}
+	return pbs, nil
+}
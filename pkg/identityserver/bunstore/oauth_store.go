@@ -96,6 +96,9 @@ type AuthorizationCode struct {
 	RedirectURI string `bun:"redirect_uri,nullzero"`
 	State       string `bun:"state,nullzero"`
 
+	Nonce  string   `bun:"nonce,nullzero"`
+	Scopes []string `bun:"scopes,array,nullzero"`
+
 	ExpiresAt *time.Time `bun:"expires_at"`
 }
 
@@ -118,6 +121,8 @@ func authorizationCodeToPB(
 		Code:          m.Code,
 		RedirectUri:   m.RedirectURI,
 		State:         m.State,
+		Nonce:         m.Nonce,
+		Scopes:        m.Scopes,
 		CreatedAt:     timestamppb.New(m.CreatedAt),
 		ExpiresAt:     ttnpb.ProtoTime(m.ExpiresAt),
 	}
@@ -470,6 +475,8 @@ func (s *oauthStore) CreateAuthorizationCode(
 		Code:          pb.Code,
 		RedirectURI:   pb.RedirectUri,
 		State:         pb.State,
+		Nonce:         pb.Nonce,
+		Scopes:        pb.Scopes,
 		ExpiresAt:     cleanTimePtr(ttnpb.StdTime(pb.ExpiresAt)),
 	}
 
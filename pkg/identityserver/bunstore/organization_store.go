@@ -36,6 +36,7 @@ type Organization struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	Account EmbeddedAccount `bun:"embed:account_"`
 
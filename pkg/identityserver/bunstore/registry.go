@@ -35,12 +35,15 @@ func init() {
 		&Client{},
 		&ContactInfo{},
 		&ContactInfoValidation{},
+		&EmailChange{},
 		&EndDevice{},
 		&EndDeviceLocation{},
 		&EUIBlock{},
+		&ExternalAccount{},
 		&Gateway{},
 		&GatewayAntenna{},
 		&Invitation{},
+		&Label{},
 		&LoginToken{},
 		&Membership{},
 		&Notification{},
@@ -50,6 +53,7 @@ func init() {
 		&AccessToken{},
 		&Organization{},
 		&Picture{},
+		&Setting{},
 		&User{},
 		&UserSession{},
 	)
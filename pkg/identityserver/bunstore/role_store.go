@@ -0,0 +1,311 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// Role is the role model in the database.
+type Role struct {
+	bun.BaseModel `bun:"table:roles,alias:role"`
+
+	Model
+
+	EntityID   string `bun:"entity_id,notnull"`
+	EntityType string `bun:"entity_type,notnull"`
+
+	Name        string `bun:"name,notnull"`
+	Description string `bun:"description,nullzero"`
+	Rights      []int  `bun:"rights,array,nullzero"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *Role) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+func roleFromModel(m *Role) *store.Role {
+	return &store.Role{
+		Name:        m.Name,
+		Description: m.Description,
+		Rights: &ttnpb.Rights{
+			Rights: convertIntSlice[int, ttnpb.Right](m.Rights),
+		},
+		CreatedAt: m.CreatedAt,
+		UpdatedAt: m.UpdatedAt,
+	}
+}
+
+type roleStore struct {
+	*entityStore
+}
+
+func newRoleStore(baseStore *baseStore) *roleStore {
+	return &roleStore{
+		entityStore: newEntityStore(baseStore),
+	}
+}
+
+func (s *roleStore) getRoleModel(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, name string,
+) (*Role, error) {
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	model := &Role{}
+	err = s.newSelectModel(ctx, model).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Where("name = ?", name).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrRoleNotFound.WithAttributes(
+				"name", name,
+				"entity_type", entityID.EntityType(),
+				"entity_id", entityID.IDString(),
+			)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *roleStore) ListRoles(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*store.Role, error) {
+	ctx, span := tracer.StartFromContext(ctx, "ListRoles", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+
+	models := []*Role{}
+	err = newSelectModels(ctx, s.DB, &models).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Order("name").
+		Scan(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	roles := make([]*store.Role, len(models))
+	for i, model := range models {
+		roles[i] = roleFromModel(model)
+	}
+	return roles, nil
+}
+
+func (s *roleStore) GetRole(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, name string,
+) (*store.Role, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetRole", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	model, err := s.getRoleModel(ctx, entityID, name)
+	if err != nil {
+		return nil, err
+	}
+	return roleFromModel(model), nil
+}
+
+func (s *roleStore) SetRole(
+	ctx context.Context, entityID *ttnpb.EntityIdentifiers, role *store.Role,
+) (*store.Role, error) {
+	ctx, span := tracer.StartFromContext(ctx, "SetRole", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("name", role.Name),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(ctx, entityID)
+	if err != nil {
+		return nil, err
+	}
+	rights := convertIntSlice[ttnpb.Right, int](role.Rights.GetRights())
+
+	model, err := s.getRoleModel(ctx, entityID, role.Name)
+	switch {
+	case err == nil:
+		model.Description = role.Description
+		model.Rights = rights
+		_, err = s.DB.NewUpdate().
+			Model(model).
+			WherePK().
+			Column("description", "rights", "updated_at").
+			Exec(ctx)
+	case errors.IsNotFound(err):
+		model = &Role{
+			EntityID:    entityUUID,
+			EntityType:  entityType,
+			Name:        role.Name,
+			Description: role.Description,
+			Rights:      rights,
+		}
+		_, err = s.DB.NewInsert().
+			Model(model).
+			Exec(ctx)
+	}
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	// Propagate the rights of the role to the members that have the role.
+	_, err = s.DB.NewUpdate().
+		Model((*Membership)(nil)).
+		Set("rights = ?", pgdialect.Array(rights)).
+		Set("updated_at = ?", now()).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Where("role = ?", role.Name).
+		Exec(ctx)
+	if err != nil {
+		return nil, storeutil.WrapDriverError(err)
+	}
+
+	return roleFromModel(model), nil
+}
+
+func (s *roleStore) DeleteRole(ctx context.Context, entityID *ttnpb.EntityIdentifiers, name string) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteRole", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	model, err := s.getRoleModel(ctx, entityID, name)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewUpdate().
+		Model((*Membership)(nil)).
+		Set("role = NULL").
+		Where("entity_type = ?", model.EntityType).
+		Where("entity_id = ?", model.EntityID).
+		Where("role = ?", name).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(model).
+		WherePK().
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *roleStore) DeleteEntityRoles(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error {
+	ctx, span := tracer.StartFromContext(ctx, "DeleteEntityRoles", trace.WithAttributes(
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	entityType, entityUUID, err := s.getEntity(store.WithSoftDeleted(ctx, false), entityID)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.DB.NewDelete().
+		Model(&Role{}).
+		Where("entity_type = ?", entityType).
+		Where("entity_id = ?", entityUUID).
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
+
+func (s *roleStore) GetMemberRole(
+	ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+) (string, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetMemberRole", trace.WithAttributes(
+		attribute.String("account_type", accountID.EntityType()),
+		attribute.String("account_id", accountID.IDString()),
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+	))
+	defer span.End()
+
+	model, err := s.getMembershipModel(ctx, accountID, entityID)
+	if err != nil {
+		return "", err
+	}
+	return model.Role, nil
+}
+
+func (s *roleStore) SetMemberRole(
+	ctx context.Context,
+	accountID *ttnpb.OrganizationOrUserIdentifiers,
+	entityID *ttnpb.EntityIdentifiers,
+	name string,
+) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetMemberRole", trace.WithAttributes(
+		attribute.String("account_type", accountID.EntityType()),
+		attribute.String("account_id", accountID.IDString()),
+		attribute.String("entity_type", entityID.EntityType()),
+		attribute.String("entity_id", entityID.IDString()),
+		attribute.String("name", name),
+	))
+	defer span.End()
+
+	model, err := s.getMembershipModel(ctx, accountID, entityID)
+	if err != nil {
+		return err
+	}
+	model.Role = name
+
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column("role", "updated_at").
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
@@ -25,6 +25,9 @@ func applyContext(ctx context.Context, q *bun.SelectQuery, model interface{ _isM
 	if _, ok := model.(interface{ _isSoftDelete() }); ok {
 		q = q.Apply(selectWithSoftDeletedFromContext(ctx))
 	}
+	if _, ok := model.(interface{ _isTenant() }); ok {
+		q = q.Apply(selectWithTenantFromContext(ctx))
+	}
 	return q
 }
 
@@ -0,0 +1,119 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"context"
+
+	"github.com/uptrace/bun"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	storeutil "go.thethings.network/lorawan-stack/v3/pkg/util/store"
+)
+
+// Setting is the setting model in the database.
+type Setting struct {
+	bun.BaseModel `bun:"table:settings,alias:stg"`
+
+	Model
+
+	Key   string `bun:"key,notnull"`
+	Value []byte `bun:"value"`
+}
+
+// BeforeAppendModel is a hook that modifies the model on SELECT and UPDATE queries.
+func (m *Setting) BeforeAppendModel(ctx context.Context, query bun.Query) error {
+	if err := m.Model.BeforeAppendModel(ctx, query); err != nil {
+		return err
+	}
+	return nil
+}
+
+type settingStore struct {
+	*baseStore
+}
+
+func newSettingStore(baseStore *baseStore) *settingStore {
+	return &settingStore{
+		baseStore: baseStore,
+	}
+}
+
+func (s *settingStore) getSettingModel(ctx context.Context, key string) (*Setting, error) {
+	model := &Setting{}
+	err := s.newSelectModel(ctx, model).
+		Where("key = ?", key).
+		Scan(ctx)
+	if err != nil {
+		err = storeutil.WrapDriverError(err)
+		if errors.IsNotFound(err) {
+			return nil, store.ErrSettingNotFound.WithAttributes("key", key)
+		}
+		return nil, err
+	}
+	return model, nil
+}
+
+func (s *settingStore) GetSetting(ctx context.Context, key string) ([]byte, error) {
+	ctx, span := tracer.StartFromContext(ctx, "GetSetting", trace.WithAttributes(
+		attribute.String("key", key),
+	))
+	defer span.End()
+
+	model, err := s.getSettingModel(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return model.Value, nil
+}
+
+func (s *settingStore) SetSetting(ctx context.Context, key string, value []byte) error {
+	ctx, span := tracer.StartFromContext(ctx, "SetSetting", trace.WithAttributes(
+		attribute.String("key", key),
+	))
+	defer span.End()
+
+	model, err := s.getSettingModel(ctx, key)
+	if err != nil && !errors.IsNotFound(err) {
+		return err
+	}
+	if model == nil {
+		model = &Setting{
+			Key:   key,
+			Value: value,
+		}
+		_, err = s.DB.NewInsert().
+			Model(model).
+			Exec(ctx)
+		if err != nil {
+			return storeutil.WrapDriverError(err)
+		}
+		return nil
+	}
+
+	model.Value = value
+	_, err = s.DB.NewUpdate().
+		Model(model).
+		WherePK().
+		Column("updated_at", "value").
+		Exec(ctx)
+	if err != nil {
+		return storeutil.WrapDriverError(err)
+	}
+	return nil
+}
@@ -117,22 +117,28 @@ func newStore(baseStore *baseStore) *Store {
 	return &Store{
 		baseStore: baseStore,
 
-		applicationStore:  newApplicationStore(baseStore),
-		clientStore:       newClientStore(baseStore),
-		endDeviceStore:    newEndDeviceStore(baseStore),
-		gatewayStore:      newGatewayStore(baseStore),
-		organizationStore: newOrganizationStore(baseStore),
-		userStore:         newUserStore(baseStore),
-		userSessionStore:  newUserSessionStore(baseStore),
-		apiKeyStore:       newAPIKeyStore(baseStore),
-		membershipStore:   newMembershipStore(baseStore),
-		contactInfoStore:  newContactInfoStore(baseStore),
-		invitationStore:   newInvitationStore(baseStore),
-		loginTokenStore:   newLoginTokenStore(baseStore),
-		oauthStore:        newOAuthStore(baseStore),
-		euiStore:          newEUIStore(baseStore),
-		entitySearch:      newEntitySearch(baseStore),
-		notificationStore: newNotificationStore(baseStore),
+		applicationStore:     newApplicationStore(baseStore),
+		clientStore:          newClientStore(baseStore),
+		endDeviceStore:       newEndDeviceStore(baseStore),
+		gatewayStore:         newGatewayStore(baseStore),
+		organizationStore:    newOrganizationStore(baseStore),
+		userStore:            newUserStore(baseStore),
+		userSessionStore:     newUserSessionStore(baseStore),
+		apiKeyStore:          newAPIKeyStore(baseStore),
+		membershipStore:      newMembershipStore(baseStore),
+		contactInfoStore:     newContactInfoStore(baseStore),
+		invitationStore:      newInvitationStore(baseStore),
+		loginTokenStore:      newLoginTokenStore(baseStore),
+		oauthStore:           newOAuthStore(baseStore),
+		euiStore:             newEUIStore(baseStore),
+		entitySearch:         newEntitySearch(baseStore),
+		notificationStore:    newNotificationStore(baseStore),
+		settingStore:         newSettingStore(baseStore),
+		externalAccountStore: newExternalAccountStore(baseStore),
+		roleStore:            newRoleStore(baseStore),
+		emailChangeStore:     newEmailChangeStore(baseStore),
+		labelStore:           newLabelStore(baseStore),
+		groupStore:           newGroupStore(baseStore),
 	}
 }
 
@@ -167,6 +173,12 @@ type Store struct {
 	*euiStore
 	*entitySearch
 	*notificationStore
+	*settingStore
+	*externalAccountStore
+	*roleStore
+	*emailChangeStore
+	*labelStore
+	*groupStore
 }
 
 const (
@@ -185,6 +185,13 @@ func TestDeletedEntities(t *testing.T) {
 	st.TestDeletedEntities(t)
 }
 
+func TestTenants(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestTenants(t)
+}
+
 func TestEntitySearch(t *testing.T) {
 	t.Parallel()
 
@@ -199,3 +206,59 @@ func TestNotificationStore(t *testing.T) {
 	st := storetest.New(t, newTestStore)
 	st.TestNotificationStore(t)
 }
+
+func TestSettingStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestSettingStore(t)
+}
+
+func TestExternalAccountStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestExternalAccountStore(t)
+}
+
+func TestRoleStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestRoleStore(t)
+}
+
+func TestGroupStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestGroupStore(t)
+}
+
+func TestIntegrity(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestIntegrity(t)
+}
+
+func TestDeniedRights(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestDeniedRights(t)
+}
+
+func TestEmailChangeStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestEmailChangeStore(t)
+}
+
+func TestLabelStore(t *testing.T) {
+	t.Parallel()
+
+	st := storetest.New(t, newTestStore)
+	st.TestLabelStore(t)
+}
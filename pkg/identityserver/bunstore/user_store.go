@@ -37,6 +37,7 @@ type User struct {
 
 	Model
 	SoftDelete
+	Tenant
 
 	Account EmbeddedAccount `bun:"embed:account_"`
 
@@ -0,0 +1,137 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errClaimAuthorizationNotFound = errors.DefineNotFound(
+	"claim_authorization_not_found", "claim authorization `{api_key_id}` not found",
+)
+
+// isClaimAuthorization returns whether the API key only has the right to claim end devices.
+func isClaimAuthorization(key *ttnpb.APIKey) bool {
+	keyRights := ttnpb.RightsFrom(key.GetRights()...).Unique().GetRights()
+	return len(keyRights) == 1 && keyRights[0] == ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM
+}
+
+// createClaimAuthorization creates an API key that only has the right to claim end devices into the application.
+// Since claiming is part of creating end devices, the caller needs the right to create end devices, but not
+// the claim right itself.
+func (is *IdentityServer) createClaimAuthorization(
+	ctx context.Context, req *ttnpb.CreateApplicationClaimAuthorizationRequest,
+) (*ttnpb.APIKey, error) {
+	if err := rights.RequireApplication(ctx, req.GetApplicationIds(),
+		ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+	); err != nil {
+		return nil, err
+	}
+	key, token, err := GenerateAPIKey(
+		ctx, req.GetName(), ttnpb.StdTime(req.GetExpiresAt()), ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM,
+	)
+	if err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		key, err = st.CreateAPIKey(ctx, req.GetApplicationIds().GetEntityIdentifiers(), key)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	key.Key = ""
+
+	events.Publish(evtCreateApplicationAPIKey.NewWithIdentifiersAndData(ctx, req.GetApplicationIds(), key))
+	go is.notifyInternal(ctx, &ttnpb.CreateNotificationRequest{
+		EntityIds:        req.GetApplicationIds().GetEntityIdentifiers(),
+		NotificationType: "api_key_created",
+		Data:             ttnpb.MustMarshalAny(key),
+		Receivers: []ttnpb.NotificationReceiver{
+			ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_ADMINISTRATIVE_CONTACT,
+		},
+		Email: true,
+	})
+
+	key.Key = token
+	return key, nil
+}
+
+// listClaimAuthorizations returns the claim authorizations of the application.
+func (is *IdentityServer) listClaimAuthorizations(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers,
+) (*ttnpb.APIKeys, error) {
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS); err != nil {
+		return nil, err
+	}
+	var keys []*ttnpb.APIKey
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		keys, err = st.FindAPIKeys(ctx, ids.GetEntityIdentifiers())
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.APIKeys{ApiKeys: claimAuthorizations(keys)}, nil
+}
+
+// claimAuthorizations returns the API keys that are claim authorizations, without their secret value.
+func claimAuthorizations(keys []*ttnpb.APIKey) []*ttnpb.APIKey {
+	res := make([]*ttnpb.APIKey, 0, len(keys))
+	for _, key := range keys {
+		if isClaimAuthorization(key) {
+			key.Key = ""
+			res = append(res, key)
+		}
+	}
+	return res
+}
+
+// deleteClaimAuthorization deletes a claim authorization of the application.
+// Other API keys of the application can not be deleted this way.
+func (is *IdentityServer) deleteClaimAuthorization(
+	ctx context.Context, req *ttnpb.DeleteApplicationClaimAuthorizationRequest,
+) (*emptypb.Empty, error) {
+	ids := req.GetApplicationIds()
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS); err != nil {
+		return nil, err
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		key, err := st.GetAPIKey(ctx, ids.GetEntityIdentifiers(), req.GetKeyId())
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errClaimAuthorizationNotFound.WithAttributes("api_key_id", req.GetKeyId())
+			}
+			return err
+		}
+		if !isClaimAuthorization(key) {
+			return errClaimAuthorizationNotFound.WithAttributes("api_key_id", req.GetKeyId())
+		}
+		return st.DeleteAPIKey(ctx, ids.GetEntityIdentifiers(), key)
+	})
+	if err != nil {
+		return nil, err
+	}
+	events.Publish(evtDeleteApplicationAPIKey.NewWithIdentifiersAndData(ctx, ids, nil))
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestIsClaimAuthorization(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name     string
+		Rights   []ttnpb.Right
+		Expected bool
+	}{
+		{
+			Name:     "ClaimOnly",
+			Rights:   []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM},
+			Expected: true,
+		},
+		{
+			Name: "DuplicateClaim",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM,
+			},
+			Expected: true,
+		},
+		{
+			Name: "ClaimAndWrite",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+			},
+		},
+		{
+			Name:   "All",
+			Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_ALL},
+		},
+		{
+			Name: "NoRights",
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			a.So(isClaimAuthorization(&ttnpb.APIKey{Rights: tc.Rights}), should.Equal, tc.Expected)
+		})
+	}
+}
+
+func TestClaimAuthorizations(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	res := claimAuthorizations([]*ttnpb.APIKey{
+		{
+			Id:     "CLAIMKEY",
+			Key:    "secret",
+			Name:   "Manufacturer",
+			Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM},
+		},
+		{
+			Id:     "OTHERKEY",
+			Key:    "secret",
+			Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_ALL},
+		},
+	})
+	a.So(res, should.Resemble, []*ttnpb.APIKey{
+		{
+			Id:     "CLAIMKEY",
+			Name:   "Manufacturer",
+			Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_DEVICES_CLAIM},
+		},
+	})
+}
+
+func TestClaimAuthorizationRequestValidation(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	past := time.Now().Add(-time.Hour)
+	a.So((&ttnpb.CreateApplicationClaimAuthorizationRequest{
+		ApplicationIds: appIDs,
+		Name:           "Manufacturer",
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.CreateApplicationClaimAuthorizationRequest{
+		ApplicationIds: appIDs,
+		ExpiresAt:      ttnpb.ProtoTime(&past),
+	}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.DeleteApplicationClaimAuthorizationRequest{
+		ApplicationIds: appIDs,
+		KeyId:          "KEYID",
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.DeleteApplicationClaimAuthorizationRequest{
+		ApplicationIds: appIDs,
+	}).ValidateFields(), should.NotBeNil)
+}
@@ -435,11 +435,21 @@ func (is *IdentityServer) purgeClient(ctx context.Context, ids *ttnpb.ClientIden
 		if err != nil {
 			return err
 		}
+		// delete related group memberships before purging the client
+		err = st.DeleteEntityGroupMemberships(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
 		// delete related contact info before purging the client
 		err = st.DeleteEntityContactInfo(ctx, ids)
 		if err != nil {
 			return err
 		}
+		// delete related labels before purging the client
+		err = st.DeleteEntityLabels(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return st.PurgeClient(ctx, ids)
 	})
 	if err != nil {
@@ -90,6 +90,20 @@ type Config struct {
 		Enabled  bool          `name:"enabled" description:"enable users requesting login tokens"`
 		TokenTTL time.Duration `name:"token-ttl" description:"TTL of login tokens"`
 	} `name:"login-tokens"`
+	APIKeyTokens struct {
+		TTL time.Duration `name:"ttl" description:"Lifetime of the JSON Web Tokens that are issued for API keys. Tokens are signed with the OpenID Connect signing keys"` //nolint:lll
+	} `name:"api-key-tokens"`
+	PasswordReset struct {
+		Verifier string `name:"verifier" description:"Out-of-band verifier of password resets in addition to email (sms, or a verifier set by the deployment)"` //nolint:lll
+		SMS      struct {
+			URL string `name:"url" description:"URL of the SMS gateway webhook that sends the verification codes"`
+		} `name:"sms"`
+	} `name:"password-reset"`
+	EmailChange struct {
+		RequireConfirmation bool          `name:"require-confirmation" description:"Require changes of the primary email address of users to be confirmed from both the old and the new email address"` //nolint:lll
+		TokenTTL            time.Duration `name:"token-ttl" description:"TTL of email change confirmation tokens"`
+		RevertWindow        time.Duration `name:"revert-window" description:"How long after an email change it can be reverted from the old email address"` //nolint:lll
+	} `name:"email-change"`
 	Email struct {
 		email.Config `name:",squash"`
 		Dir          string               `name:"dir" description:"Directory to write emails to if the dir provider is used (development only)"` // nolint:lll
@@ -97,12 +111,25 @@ type Config struct {
 		SMTP         smtp.Config          `name:"smtp"`
 		Templates    emailTemplatesConfig `name:"templates"`
 	} `name:"email"`
+	Notifications struct {
+		Digest struct {
+			Enable   bool          `name:"enable" description:"Allow users to receive notification emails as a periodic digest"` //nolint:lll
+			Interval time.Duration `name:"interval" description:"Interval at which notification digests are sent"`
+		} `name:"digest"`
+		Slack ChatWebhookConfig `name:"slack" description:"Slack incoming webhook that receives admin notifications"`
+		Teams ChatWebhookConfig `name:"teams" description:"Microsoft Teams incoming webhook that receives admin notifications"` //nolint:lll
+	} `name:"notifications"`
 	EndDevices struct {
 		EncryptionKeyID string `name:"encryption-key-id" description:"ID of the key used to encrypt end device secrets at rest"` //nolint:lll
+		Inactivity      struct {
+			CheckInterval time.Duration     `name:"check-interval" description:"Interval at which the inactivity of end devices is checked"`          //nolint:lll
+			Thresholds    map[string]string `name:"thresholds" description:"Inactivity threshold of end devices by application ID (app-id=duration)"` //nolint:lll
+		} `name:"inactivity"`
 	} `name:"end-devices"`
 	Gateways struct {
-		EncryptionKeyID string        `name:"encryption-key-id" description:"ID of the key used to encrypt gateway secrets at rest"`
-		TokenValidity   time.Duration `name:"token-validity" description:"Time in seconds after creation when a gateway token is valid"` //nolint:lll
+		EncryptionKeyID       string        `name:"encryption-key-id" description:"ID of the key used to encrypt gateway secrets at rest"`
+		EncryptionKeyIDFormat string        `name:"encryption-key-id-format" description:"Format of the ID of the per-gateway key used to encrypt gateway secrets at rest, where {gateway_id} is replaced by the gateway ID"` //nolint:lll
+		TokenValidity         time.Duration `name:"token-validity" description:"Time in seconds after creation when a gateway token is valid"`                                                                                //nolint:lll
 	} `name:"gateways"`
 	Delete struct {
 		Restore time.Duration `name:"restore" description:"How long after soft-deletion an entity can be restored"`
@@ -113,6 +140,10 @@ type Config struct {
 		Prefix           ttntypes.EUI64Prefix `name:"prefix" description:"DevEUI block prefix"`
 		InitCounter      int64                `name:"init-counter" description:"Initial counter value for the addresses to be issued (default 0)"`
 	} `name:"dev-eui-block" description:"IEEE MAC block used to issue DevEUIs to devices that are not yet programmed"`
+	Branding struct {
+		CacheTTL time.Duration `name:"cache-ttl" description:"TTL of the branding cache"`
+		Default  Branding      `name:"default" description:"Branding that is used until the branding is set at runtime"`
+	} `name:"branding"`
 	Network struct {
 		NetID    ttntypes.NetID `name:"net-id" description:"NetID of this network"`
 		TenantID string         `name:"tenant-id" description:"Tenant ID in the host NetID"`
@@ -120,6 +151,28 @@ type Config struct {
 	TelemetryQueue telemetry.TaskQueue `name:"-"`
 }
 
+// ChatWebhookConfig is the configuration of a chat incoming webhook that receives admin notifications.
+type ChatWebhookConfig struct {
+	URL               string   `name:"url" description:"URL of the incoming webhook"`
+	NotificationTypes []string `name:"notification-types" description:"Types of admin notifications that are sent to the webhook (all if empty)"` //nolint:lll
+}
+
+// accepts returns whether the notification type is sent to the webhook.
+func (c ChatWebhookConfig) accepts(notificationType string) bool {
+	if c.URL == "" {
+		return false
+	}
+	if len(c.NotificationTypes) == 0 {
+		return true
+	}
+	for _, t := range c.NotificationTypes {
+		if t == notificationType {
+			return true
+		}
+	}
+	return false
+}
+
 type emailTemplatesConfig struct {
 	Source    string                `name:"source" description:"Source of the email template files (static, directory, url, blob)"`
 	Static    map[string][]byte     `name:"-"`
@@ -0,0 +1,266 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var (
+	errDeniedRight = errors.DefineInvalidArgument(
+		"denied_right", "right `{right}` can not be denied on {entity_type} entities",
+	)
+	errDeniedRightsOwner = errors.DefineFailedPrecondition(
+		"denied_rights_owner", "{entity_type} needs at least one collaborator with all rights",
+	)
+)
+
+// collaboratorRights returns the right to manage the collaborators of the entity type,
+// and the right that includes all rights on the entity type.
+func collaboratorRights(entityType string) (manage, all ttnpb.Right, err error) {
+	switch entityType {
+	case "application":
+		return ttnpb.Right_RIGHT_APPLICATION_SETTINGS_COLLABORATORS, ttnpb.Right_RIGHT_APPLICATION_ALL, nil
+	case "client":
+		return ttnpb.Right_RIGHT_CLIENT_SETTINGS_COLLABORATORS, ttnpb.Right_RIGHT_CLIENT_ALL, nil
+	case "gateway":
+		return ttnpb.Right_RIGHT_GATEWAY_SETTINGS_COLLABORATORS, ttnpb.Right_RIGHT_GATEWAY_ALL, nil
+	case "organization":
+		return ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS, ttnpb.Right_RIGHT_ORGANIZATION_ALL, nil
+	default:
+		return 0, 0, errEntityType.WithAttributes("entity_type", entityType)
+	}
+}
+
+// apiKeysRight returns the right to manage the API keys of the entity type.
+func apiKeysRight(entityType string) (ttnpb.Right, error) {
+	switch entityType {
+	case "application":
+		return ttnpb.Right_RIGHT_APPLICATION_SETTINGS_API_KEYS, nil
+	case "gateway":
+		return ttnpb.Right_RIGHT_GATEWAY_SETTINGS_API_KEYS, nil
+	case "organization":
+		return ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_API_KEYS, nil
+	case "user":
+		return ttnpb.Right_RIGHT_USER_SETTINGS_API_KEYS, nil
+	default:
+		return 0, errEntityType.WithAttributes("entity_type", entityType)
+	}
+}
+
+// validateDeniedRights validates that the denied rights can be granted on the entity.
+func validateDeniedRights(ids *ttnpb.EntityIdentifiers, denied *ttnpb.Rights) error {
+	potential := allPotentialRights(ids, ttnpb.AllRights)
+	for _, right := range denied.GetRights() {
+		if !potential.IncludesAll(right) {
+			return errDeniedRight.WithAttributes(
+				"right", right.String(),
+				"entity_type", ids.EntityType(),
+			)
+		}
+	}
+	return nil
+}
+
+// requireChangeDeniedRights requires the caller to have the rights that are denied before and after the change,
+// as denying rights removes them and no longer denying rights adds them.
+func requireChangeDeniedRights(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, existing, denied *ttnpb.Rights,
+) error {
+	changed := existing.Union(denied)
+	if len(changed.GetRights()) == 0 {
+		return nil
+	}
+	return requireEntityRights(ctx, ids, changed.GetRights()...)
+}
+
+func (is *IdentityServer) getCollaboratorDeniedRights(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, collaborator *ttnpb.OrganizationOrUserIdentifiers,
+) (*ttnpb.Rights, error) {
+	manage, _, err := collaboratorRights(ids.EntityType())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEntityRights(ctx, ids, manage); err != nil {
+		return nil, err
+	}
+	var res *ttnpb.Rights
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		denied, err := st.GetMemberDeniedRights(ctx, collaborator, ids)
+		if err != nil {
+			return err
+		}
+		res = denied.Sorted()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// setCollaboratorDeniedRights sets the denied rights of an existing collaborator.
+// The rights can not be denied if that leaves the entity without a collaborator with all rights.
+func (is *IdentityServer) setCollaboratorDeniedRights(
+	ctx context.Context,
+	ids *ttnpb.EntityIdentifiers,
+	collaborator *ttnpb.OrganizationOrUserIdentifiers,
+	req *ttnpb.Rights,
+) (*ttnpb.Rights, error) {
+	manage, all, err := collaboratorRights(ids.EntityType())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEntityRights(ctx, ids, manage); err != nil {
+		return nil, err
+	}
+	denied := req.Unique()
+	if err := validateDeniedRights(ids, denied); err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		granted, err := st.GetMember(ctx, collaborator, ids)
+		if err != nil {
+			return err
+		}
+		existing, err := st.GetMemberDeniedRights(ctx, collaborator, ids)
+		if err != nil {
+			return err
+		}
+		if err := requireChangeDeniedRights(ctx, ids, existing, denied); err != nil {
+			return err
+		}
+
+		if granted.Deny(existing).IncludesAll(all) && !granted.Deny(denied).IncludesAll(all) {
+			members, err := st.FindMembers(ctx, ids)
+			if err != nil {
+				return err
+			}
+			var hasOtherOwner bool
+			for _, member := range members {
+				if unique.ID(ctx, member.Ids) == unique.ID(ctx, collaborator) {
+					continue
+				}
+				memberDenied, err := st.GetMemberDeniedRights(ctx, member.Ids, ids)
+				if err != nil {
+					return err
+				}
+				if member.Rights.Deny(memberDenied).IncludesAll(all) {
+					hasOtherOwner = true
+					break
+				}
+			}
+			if !hasOtherOwner {
+				return errDeniedRightsOwner.WithAttributes("entity_type", ids.EntityType())
+			}
+		}
+
+		return st.SetMemberDeniedRights(ctx, collaborator, ids, denied)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return denied.Sorted(), nil
+}
+
+func (is *IdentityServer) getAPIKeyDeniedRights(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, keyID string,
+) (*ttnpb.Rights, error) {
+	manage, err := apiKeysRight(ids.EntityType())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEntityRights(ctx, ids, manage); err != nil {
+		return nil, err
+	}
+	var res *ttnpb.Rights
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		denied, err := st.GetAPIKeyDeniedRights(ctx, ids, keyID)
+		if err != nil {
+			return err
+		}
+		res = denied.Sorted()
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) setAPIKeyDeniedRights(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, keyID string, req *ttnpb.Rights,
+) (*ttnpb.Rights, error) {
+	manage, err := apiKeysRight(ids.EntityType())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEntityRights(ctx, ids, manage); err != nil {
+		return nil, err
+	}
+	denied := req.Unique()
+	if err := validateDeniedRights(ids, denied); err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		existing, err := st.GetAPIKeyDeniedRights(ctx, ids, keyID)
+		if err != nil {
+			return err
+		}
+		if err := requireChangeDeniedRights(ctx, ids, existing, denied); err != nil {
+			return err
+		}
+		return st.SetAPIKeyDeniedRights(ctx, ids, keyID, denied)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return denied.Sorted(), nil
+}
+
+type deniedRightsRegistry struct {
+	ttnpb.UnimplementedDeniedRightsRegistryServer
+
+	*IdentityServer
+}
+
+func (dr *deniedRightsRegistry) GetCollaboratorDeniedRights(
+	ctx context.Context, req *ttnpb.GetCollaboratorDeniedRightsRequest,
+) (*ttnpb.Rights, error) {
+	return dr.getCollaboratorDeniedRights(ctx, req.GetEntityIds(), req.GetCollaborator())
+}
+
+func (dr *deniedRightsRegistry) SetCollaboratorDeniedRights(
+	ctx context.Context, req *ttnpb.SetCollaboratorDeniedRightsRequest,
+) (*ttnpb.Rights, error) {
+	return dr.setCollaboratorDeniedRights(ctx, req.GetEntityIds(), req.GetCollaborator(), req.GetDeniedRights())
+}
+
+func (dr *deniedRightsRegistry) GetAPIKeyDeniedRights(
+	ctx context.Context, req *ttnpb.GetAPIKeyDeniedRightsRequest,
+) (*ttnpb.Rights, error) {
+	return dr.getAPIKeyDeniedRights(ctx, req.GetEntityIds(), req.GetKeyId())
+}
+
+func (dr *deniedRightsRegistry) SetAPIKeyDeniedRights(
+	ctx context.Context, req *ttnpb.SetAPIKeyDeniedRightsRequest,
+) (*ttnpb.Rights, error) {
+	return dr.setAPIKeyDeniedRights(ctx, req.GetEntityIds(), req.GetKeyId(), req.GetDeniedRights())
+}
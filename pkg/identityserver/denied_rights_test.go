@@ -0,0 +1,100 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidateDeniedRights(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}).GetEntityIdentifiers()
+	orgIDs := (&ttnpb.OrganizationIdentifiers{OrganizationId: "foo-org"}).GetEntityIdentifiers()
+	usrIDs := (&ttnpb.UserIdentifiers{UserId: "foo-usr"}).GetEntityIdentifiers()
+
+	a.So(validateDeniedRights(appIDs, nil), should.BeNil)
+	a.So(validateDeniedRights(appIDs, ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE)), should.BeNil)
+	a.So(errors.IsInvalidArgument(
+		validateDeniedRights(appIDs, ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_DELETE)),
+	), should.BeTrue)
+	a.So(validateDeniedRights(orgIDs, ttnpb.RightsFrom(
+		ttnpb.Right_RIGHT_ORGANIZATION_DELETE, ttnpb.Right_RIGHT_GATEWAY_DELETE,
+	)), should.BeNil)
+	a.So(errors.IsInvalidArgument(
+		validateDeniedRights(orgIDs, ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_DELETE)),
+	), should.BeTrue)
+	a.So(validateDeniedRights(usrIDs, ttnpb.RightsFrom(ttnpb.Right_RIGHT_USER_DELETE)), should.BeNil)
+}
+
+func TestDeniedRightsEntityTypes(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for _, entityType := range []string{"application", "client", "gateway", "organization"} {
+		manage, all, err := collaboratorRights(entityType)
+		if a.So(err, should.BeNil) {
+			a.So(all.Implied().IncludesAll(manage), should.BeTrue)
+		}
+	}
+	_, _, err := collaboratorRights("user")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	for _, entityType := range []string{"application", "gateway", "organization", "user"} {
+		_, err := apiKeysRight(entityType)
+		a.So(err, should.BeNil)
+	}
+	_, err = apiKeysRight("client")
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
+
+func TestDeniedRightsRequestValidation(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}).GetEntityIdentifiers()
+	usrIDs := (&ttnpb.UserIdentifiers{UserId: "foo-usr"}).GetOrganizationOrUserIdentifiers()
+
+	a.So((&ttnpb.SetCollaboratorDeniedRightsRequest{
+		EntityIds:    appIDs,
+		Collaborator: usrIDs,
+		DeniedRights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE),
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.SetCollaboratorDeniedRightsRequest{
+		EntityIds:    appIDs,
+		Collaborator: usrIDs,
+	}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.GetCollaboratorDeniedRightsRequest{
+		EntityIds: appIDs,
+	}).ValidateFields(), should.NotBeNil)
+
+	a.So((&ttnpb.SetAPIKeyDeniedRightsRequest{
+		EntityIds:    appIDs,
+		KeyId:        "FOO",
+		DeniedRights: &ttnpb.Rights{},
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.GetAPIKeyDeniedRightsRequest{
+		EntityIds: appIDs,
+	}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.GetAPIKeyDeniedRightsRequest{
+		KeyId: "FOO",
+	}).ValidateFields(), should.NotBeNil)
+}
@@ -0,0 +1,305 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"crypto/subtle"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/email/templates"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	evtRequestEmailChange = events.Define(
+		"user.email_change.request", "request change of email address",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtCompleteEmailChange = events.Define(
+		"user.email_change.complete", "complete change of email address",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithClientInfoFromContext(),
+	)
+	evtRevertEmailChange = events.Define(
+		"user.email_change.revert", "revert change of email address",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errEmailChangeToken = errors.DefinePermissionDenied(
+		"email_change_token", "invalid email change token",
+	)
+	errEmailChangeExpired = errors.DefineFailedPrecondition(
+		"email_change_expired", "email change expired",
+	)
+	errEmailChangeCompleted = errors.DefineFailedPrecondition(
+		"email_change_completed", "email change already completed",
+	)
+	errEmailChangeNotCompleted = errors.DefineFailedPrecondition(
+		"email_change_not_completed", "email change not completed",
+	)
+	errEmailAddressChanged = errors.DefineFailedPrecondition(
+		"email_address_changed", "email address of user changed since the email change was requested",
+	)
+)
+
+// requiresEmailChangeConfirmation returns whether the update of the primary email address needs to be confirmed.
+// Admins can update the primary email address without confirmation.
+//
+// If email changes require confirmation, a change of the primary email address of a user is not applied immediately.
+// Instead, a confirmation token is sent to both the old and the new email address, and the change is applied once
+// it is confirmed with both tokens. After that, a revert token is sent to the old email address, which can be used
+// to revert the change until the revert window expires. The tokens are used by the Account app, so ConfirmEmailChange
+// and RevertEmailChange do not require authentication.
+func requiresEmailChangeConfirmation(requireConfirmation, updatedByAdmin bool, paths []string) bool {
+	return requireConfirmation && !updatedByAdmin && ttnpb.HasAnyField(paths, "primary_email_address")
+}
+
+// newEmailChange returns the email change of the primary email address of the user to the new email address, or
+// nil if the user already has the new email address. If the old email address is not validated, it is not used to
+// confirm the change.
+func newEmailChange(
+	ctx context.Context, usr *ttnpb.User, newEmailAddress string, now time.Time, ttl time.Duration,
+) (*store.EmailChange, error) {
+	if usr.GetPrimaryEmailAddress() == newEmailAddress {
+		return nil, nil
+	}
+	oldToken, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	newToken, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return nil, err
+	}
+	change := &store.EmailChange{
+		UserIDs:         usr.GetIds(),
+		OldEmailAddress: usr.GetPrimaryEmailAddress(),
+		NewEmailAddress: newEmailAddress,
+		OldToken:        oldToken,
+		NewToken:        newToken,
+		ExpiresAt:       now.Add(ttl),
+	}
+	if usr.GetPrimaryEmailAddressValidatedAt() == nil {
+		change.OldConfirmedAt = &now
+	}
+	return change, nil
+}
+
+// createEmailChange creates the email change of the primary email address of the user. This replaces any pending
+// email change of the user. The caller sends the confirmation emails after the transaction.
+func (is *IdentityServer) createEmailChange(
+	ctx context.Context, st store.Store, ids *ttnpb.UserIdentifiers, newEmailAddress string,
+) (*store.EmailChange, error) {
+	usr, err := st.GetUser(ctx, ids, store.FieldMask{
+		"ids", "primary_email_address", "primary_email_address_validated_at",
+	})
+	if err != nil {
+		return nil, err
+	}
+	ttl := is.configFromContext(ctx).EmailChange.TokenTTL
+	change, err := newEmailChange(ctx, usr, newEmailAddress, time.Now(), ttl)
+	if err != nil || change == nil {
+		return nil, err
+	}
+	return st.CreateEmailChange(ctx, change)
+}
+
+// sendEmailChangeConfirmations sends the confirmation tokens of the email change to the old email address, unless
+// it is already confirmed, and to the new email address.
+func (is *IdentityServer) sendEmailChangeConfirmations(ctx context.Context, change *store.EmailChange) {
+	ttl := is.configFromContext(ctx).EmailChange.TokenTTL
+	send := func(address, token string) {
+		data := &templates.EmailChangeData{
+			UserIDs:         change.UserIDs,
+			OldEmailAddress: change.OldEmailAddress,
+			NewEmailAddress: change.NewEmailAddress,
+			ID:              change.ID,
+			Token:           token,
+			TTL:             ttl,
+		}
+		go is.SendTemplateEmailToUsers( // nolint:errcheck
+			is.FromRequestContext(ctx),
+			"email_change",
+			func(_ context.Context, tmplData email.TemplateData) (email.TemplateData, error) {
+				data.TemplateData = tmplData
+				return data, nil
+			},
+			&ttnpb.User{PrimaryEmailAddress: address},
+		)
+	}
+	if change.OldConfirmedAt == nil {
+		send(change.OldEmailAddress, change.OldToken)
+	}
+	send(change.NewEmailAddress, change.NewToken)
+	events.Publish(evtRequestEmailChange.NewWithIdentifiersAndData(ctx, change.UserIDs, nil))
+}
+
+func tokenEqual(token, expected string) bool {
+	return expected != "" && subtle.ConstantTimeCompare([]byte(token), []byte(expected)) == 1
+}
+
+// confirmEmailChange confirms the email change with the token of the old or the new email address. Once the change
+// is confirmed from both email addresses, the primary email address of the user is updated.
+func (is *IdentityServer) confirmEmailChange(
+	ctx context.Context, req *ttnpb.ConfirmEmailChangeRequest,
+) (*ttnpb.EmailChangeStatus, error) {
+	revertWindow := is.configFromContext(ctx).EmailChange.RevertWindow
+	var change *store.EmailChange
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		change, err = st.GetEmailChange(ctx, req.GetReference())
+		if err != nil {
+			return err
+		}
+		if change.CompletedAt != nil {
+			return errEmailChangeCompleted.New()
+		}
+		now := time.Now()
+		if change.ExpiresAt.Before(now) {
+			return errEmailChangeExpired.New()
+		}
+		switch {
+		case tokenEqual(req.GetToken(), change.OldToken):
+			change.OldConfirmedAt = &now
+		case tokenEqual(req.GetToken(), change.NewToken):
+			change.NewConfirmedAt = &now
+		default:
+			return errEmailChangeToken.New()
+		}
+		if change.OldConfirmedAt == nil || change.NewConfirmedAt == nil {
+			change, err = st.UpdateEmailChange(ctx, change)
+			return err
+		}
+
+		usr, err := st.GetUser(ctx, change.UserIDs, store.FieldMask{"primary_email_address"})
+		if err != nil {
+			return err
+		}
+		if usr.PrimaryEmailAddress != change.OldEmailAddress {
+			return errEmailAddressChanged.New()
+		}
+		if _, err := st.UpdateUser(ctx, &ttnpb.User{
+			Ids:                            change.UserIDs,
+			PrimaryEmailAddress:            change.NewEmailAddress,
+			PrimaryEmailAddressValidatedAt: timestamppb.New(now),
+		}, store.FieldMask{"primary_email_address", "primary_email_address_validated_at"}); err != nil {
+			return err
+		}
+		change.CompletedAt = &now
+		if revertWindow <= 0 {
+			return st.DeleteEmailChange(ctx, change.ID)
+		}
+		change.RevertToken, err = auth.GenerateKey(ctx)
+		if err != nil {
+			return err
+		}
+		change.ExpiresAt = now.Add(revertWindow)
+		change, err = st.UpdateEmailChange(ctx, change)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	status := &ttnpb.EmailChangeStatus{
+		OldConfirmed: change.OldConfirmedAt != nil,
+		NewConfirmed: change.NewConfirmedAt != nil,
+		Completed:    change.CompletedAt != nil,
+	}
+	if !status.Completed {
+		return status, nil
+	}
+
+	events.Publish(evtCompleteEmailChange.NewWithIdentifiersAndData(ctx, change.UserIDs, nil))
+	data := &templates.EmailChangedData{
+		UserIDs:         change.UserIDs,
+		OldEmailAddress: change.OldEmailAddress,
+		NewEmailAddress: change.NewEmailAddress,
+		ID:              change.ID,
+		RevertToken:     change.RevertToken,
+		RevertWindow:    revertWindow,
+	}
+	go is.SendTemplateEmailToUsers( // nolint:errcheck
+		is.FromRequestContext(ctx),
+		"email_changed",
+		func(_ context.Context, tmplData email.TemplateData) (email.TemplateData, error) {
+			data.TemplateData = tmplData
+			return data, nil
+		},
+		&ttnpb.User{PrimaryEmailAddress: change.OldEmailAddress},
+	)
+	return status, nil
+}
+
+// revertEmailChange reverts the completed email change with the revert token that was sent to the old email address.
+// The sessions of the user are deleted, since the change may have been made by someone else.
+func (is *IdentityServer) revertEmailChange(
+	ctx context.Context, req *ttnpb.RevertEmailChangeRequest,
+) (*emptypb.Empty, error) {
+	var change *store.EmailChange
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		change, err = st.GetEmailChange(ctx, req.GetReference())
+		if err != nil {
+			return err
+		}
+		if change.CompletedAt == nil {
+			return errEmailChangeNotCompleted.New()
+		}
+		now := time.Now()
+		if change.ExpiresAt.Before(now) {
+			return errEmailChangeExpired.New()
+		}
+		if !tokenEqual(req.GetToken(), change.RevertToken) {
+			return errEmailChangeToken.New()
+		}
+		usr, err := st.GetUser(ctx, change.UserIDs, store.FieldMask{"primary_email_address"})
+		if err != nil {
+			return err
+		}
+		if usr.PrimaryEmailAddress != change.NewEmailAddress {
+			return errEmailAddressChanged.New()
+		}
+		if _, err := st.UpdateUser(ctx, &ttnpb.User{
+			Ids:                            change.UserIDs,
+			PrimaryEmailAddress:            change.OldEmailAddress,
+			PrimaryEmailAddressValidatedAt: timestamppb.New(now),
+		}, store.FieldMask{"primary_email_address", "primary_email_address_validated_at"}); err != nil {
+			return err
+		}
+		if err := st.DeleteAllUserSessions(ctx, change.UserIDs); err != nil {
+			return err
+		}
+		return st.DeleteEmailChange(ctx, change.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).WithField("user_id", change.UserIDs.GetUserId()).Info("Reverted email change")
+	events.Publish(evtRevertEmailChange.NewWithIdentifiersAndData(ctx, change.UserIDs, nil))
+	return ttnpb.Empty, nil
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRequiresEmailChangeConfirmation(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	paths := []string{"name", "primary_email_address"}
+	a.So(requiresEmailChangeConfirmation(true, false, paths), should.BeTrue)
+	a.So(requiresEmailChangeConfirmation(false, false, paths), should.BeFalse)
+	a.So(requiresEmailChangeConfirmation(true, true, paths), should.BeFalse)
+	a.So(requiresEmailChangeConfirmation(true, false, []string{"name"}), should.BeFalse)
+}
+
+func TestNewEmailChange(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	now := time.Now()
+	usr := &ttnpb.User{
+		Ids:                            &ttnpb.UserIdentifiers{UserId: "foo-usr"},
+		PrimaryEmailAddress:            "old@example.com",
+		PrimaryEmailAddressValidatedAt: timestamppb.New(now.Add(-time.Hour)),
+	}
+
+	change, err := newEmailChange(ctx, usr, "old@example.com", now, time.Hour)
+	a.So(err, should.BeNil)
+	a.So(change, should.BeNil)
+
+	change, err = newEmailChange(ctx, usr, "new@example.com", now, time.Hour)
+	if a.So(err, should.BeNil) && a.So(change, should.NotBeNil) {
+		a.So(change.UserIDs, should.Resemble, usr.Ids)
+		a.So(change.OldEmailAddress, should.Equal, "old@example.com")
+		a.So(change.NewEmailAddress, should.Equal, "new@example.com")
+		a.So(change.OldToken, should.NotBeEmpty)
+		a.So(change.NewToken, should.NotBeEmpty)
+		a.So(change.OldToken, should.NotEqual, change.NewToken)
+		a.So(change.OldConfirmedAt, should.BeNil)
+		a.So(change.NewConfirmedAt, should.BeNil)
+		a.So(change.ExpiresAt, should.Equal, now.Add(time.Hour))
+	}
+
+	// The old email address is not validated, so it does not confirm the change.
+	usr.PrimaryEmailAddressValidatedAt = nil
+	change, err = newEmailChange(ctx, usr, "new@example.com", now, time.Hour)
+	if a.So(err, should.BeNil) && a.So(change, should.NotBeNil) {
+		a.So(change.OldConfirmedAt, should.NotBeNil)
+		a.So(change.NewConfirmedAt, should.BeNil)
+	}
+}
+
+func TestTokenEqual(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So(tokenEqual("TOKEN", "TOKEN"), should.BeTrue)
+	a.So(tokenEqual("TOKEN", "OTHER"), should.BeFalse)
+	a.So(tokenEqual("", ""), should.BeFalse)
+}
+
+func TestEmailChangeRequestValidation(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	a.So((&ttnpb.ConfirmEmailChangeRequest{
+		Reference: "0d4cf8e5-1d1a-4f5e-9a44-42d2a3d8c3e1",
+		Token:     "TOKEN",
+	}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.ConfirmEmailChangeRequest{
+		Reference: "not-a-reference",
+		Token:     "TOKEN",
+	}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.RevertEmailChangeRequest{
+		Reference: "0d4cf8e5-1d1a-4f5e-9a44-42d2a3d8c3e1",
+	}).ValidateFields(), should.NotBeNil)
+}
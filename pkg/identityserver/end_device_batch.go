@@ -0,0 +1,155 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/validator"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/devicebatch"
+	"google.golang.org/protobuf/proto"
+)
+
+var evtBatchUpdateEndDevices = events.Define(
+	"end_device.batch.update", "batch update end devices",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+	events.WithDataType(&ttnpb.EndDeviceIdentifiersList{}),
+	events.WithAuthFromContext(),
+	events.WithClientInfoFromContext(),
+	events.WithPropagateToParent(),
+)
+
+var errEndDeviceBatchUpdatePath = errors.DefineInvalidArgument(
+	"end_device_batch_update_path", "field `{path}` can not be updated in batch",
+)
+
+// endDeviceBatchUnsupportedPaths are the paths that need processing per end device when they are updated,
+// and are therefore not supported in batch updates.
+var endDeviceBatchUnsupportedPaths = []string{
+	"activated_at",
+	"claim_authentication_code",
+	"locations",
+	"picture",
+}
+
+// selectEndDevicesByLabels adds the IDs of the end devices of the application that have all the labels
+// of the label selector to the device IDs of the request.
+func (is *IdentityServer) selectEndDevicesByLabels(
+	ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest,
+) error {
+	labels, err := parseLabelSelector(req.LabelSelector)
+	if err != nil {
+		return err
+	}
+	var ids []*ttnpb.EntityIdentifiers
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		ids, err = st.SearchEntitiesByLabels(ctx, nil, labelEntityTypes["end_devices"], labels)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	selected := make(map[string]struct{}, len(req.DeviceIds))
+	for _, devID := range req.DeviceIds {
+		selected[devID] = struct{}{}
+	}
+	for _, entityIDs := range ids {
+		devIDs := entityIDs.GetDeviceIds()
+		if devIDs.GetApplicationIds().GetApplicationId() != req.ApplicationIds.GetApplicationId() {
+			continue
+		}
+		if _, ok := selected[devIDs.GetDeviceId()]; ok {
+			continue
+		}
+		selected[devIDs.GetDeviceId()] = struct{}{}
+		req.DeviceIds = append(req.DeviceIds, devIDs.GetDeviceId())
+	}
+	return nil
+}
+
+// batchUpdateEndDevices applies the same update to the end devices of the request in a single transaction,
+// so that either all end devices are updated, or none are.
+func (is *IdentityServer) batchUpdateEndDevices(
+	ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest,
+) (*ttnpb.BatchUpdateEndDevicesResponse, error) {
+	appIDs := req.ApplicationIds
+	if err := rights.RequireApplication(ctx, appIDs, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE); err != nil {
+		return nil, err
+	}
+	if req.LabelSelector != "" {
+		if err := is.selectEndDevicesByLabels(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+	if err := devicebatch.Validate(ctx, req); err != nil {
+		return nil, err
+	}
+	for _, path := range ttnpb.TopLevelFields(req.FieldMask.GetPaths()) {
+		if ttnpb.HasAnyField(endDeviceBatchUnsupportedPaths, path) {
+			return nil, errEndDeviceBatchUpdatePath.WithAttributes("path", path)
+		}
+	}
+	updateReq := &ttnpb.UpdateEndDeviceRequest{
+		EndDevice: proto.Clone(req.EndDevice).(*ttnpb.EndDevice),
+		FieldMask: req.FieldMask,
+	}
+	updateReq.EndDevice.Ids = &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: req.DeviceIds[0]}
+	if err := validator.ValidateMessage(ctx, ttnpb.EndDeviceRegistry_Update_FullMethodName, updateReq); err != nil {
+		return nil, err
+	}
+	paths := cleanFieldMaskPaths(ttnpb.EndDeviceFieldPathsNested, updateReq.FieldMask, nil, getPaths).GetPaths()
+
+	res := devicebatch.NewResponse(req.DeviceIds, ttnpb.BatchUpdateEndDevicesResponse_STATUS_SKIPPED)
+	updated := make([]*ttnpb.EndDeviceIdentifiers, 0, len(req.DeviceIds))
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		for i, devID := range req.DeviceIds {
+			dev := proto.Clone(req.EndDevice).(*ttnpb.EndDevice)
+			dev.Ids = &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: devID}
+			if _, err := st.UpdateEndDevice(ctx, dev, paths); err != nil {
+				devicebatch.Fail(res, i, err)
+				return err
+			}
+			res.Results[i].Status = ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED
+			updated = append(updated, dev.Ids)
+		}
+		return nil
+	})
+	if err != nil {
+		failed := false
+		for _, result := range res.Results {
+			switch result.Status {
+			case ttnpb.BatchUpdateEndDevicesResponse_STATUS_FAILED:
+				failed = true
+			case ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED:
+				// The transaction is rolled back, which reverts the end devices that were updated.
+				result.Status = ttnpb.BatchUpdateEndDevicesResponse_STATUS_REVERTED
+			}
+		}
+		if !failed {
+			return nil, err
+		}
+		return res, nil
+	}
+	res.Updated = true
+	events.Publish(evtBatchUpdateEndDevices.NewWithIdentifiersAndData(ctx, appIDs, &ttnpb.EndDeviceIdentifiersList{
+		EndDeviceIds: updated,
+	}))
+	return res, nil
+}
@@ -0,0 +1,252 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var errEndDeviceBatchDeletePeer = errors.DefineUnavailable(
+	"end_device_batch_delete_peer", "{component} unavailable for end device batch delete",
+)
+
+func setEndDeviceBatchDeleteError(r *ttnpb.BatchDeleteEndDevicesFromClusterResponse_Result, err error) {
+	if ttnErr, ok := errors.From(err); ok {
+		r.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+}
+
+type endDeviceRegistryClient interface {
+	Get(context.Context, *ttnpb.GetEndDeviceRequest, ...grpc.CallOption) (*ttnpb.EndDevice, error)
+	Set(context.Context, *ttnpb.SetEndDeviceRequest, ...grpc.CallOption) (*ttnpb.EndDevice, error)
+}
+
+type endDeviceBatchDeleter interface {
+	Delete(context.Context, *ttnpb.BatchDeleteEndDevicesRequest, ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+// endDeviceBatchDeleteComponent is a component that stores end devices, in the order in which the end devices
+// are deleted from the components.
+type endDeviceBatchDeleteComponent struct {
+	name      string
+	role      ttnpb.ClusterRole
+	getMethod string
+	setMethod string
+	address   func(*ttnpb.EndDevice) string
+	registry  func(*grpc.ClientConn) endDeviceRegistryClient
+	batch     func(*grpc.ClientConn) endDeviceBatchDeleter
+}
+
+var endDeviceBatchDeleteComponents = []endDeviceBatchDeleteComponent{
+	{
+		name:      "as",
+		role:      ttnpb.ClusterRole_APPLICATION_SERVER,
+		getMethod: ttnpb.AsEndDeviceRegistry_Get_FullMethodName,
+		setMethod: ttnpb.AsEndDeviceRegistry_Set_FullMethodName,
+		address:   (*ttnpb.EndDevice).GetApplicationServerAddress,
+		registry: func(cc *grpc.ClientConn) endDeviceRegistryClient {
+			return ttnpb.NewAsEndDeviceRegistryClient(cc)
+		},
+		batch: func(cc *grpc.ClientConn) endDeviceBatchDeleter {
+			return ttnpb.NewAsEndDeviceBatchRegistryClient(cc)
+		},
+	},
+	{
+		name:      "ns",
+		role:      ttnpb.ClusterRole_NETWORK_SERVER,
+		getMethod: ttnpb.NsEndDeviceRegistry_Get_FullMethodName,
+		setMethod: ttnpb.NsEndDeviceRegistry_Set_FullMethodName,
+		address:   (*ttnpb.EndDevice).GetNetworkServerAddress,
+		registry: func(cc *grpc.ClientConn) endDeviceRegistryClient {
+			return ttnpb.NewNsEndDeviceRegistryClient(cc)
+		},
+		batch: func(cc *grpc.ClientConn) endDeviceBatchDeleter {
+			return ttnpb.NewNsEndDeviceBatchRegistryClient(cc)
+		},
+	},
+	{
+		name:      "js",
+		role:      ttnpb.ClusterRole_JOIN_SERVER,
+		getMethod: ttnpb.JsEndDeviceRegistry_Get_FullMethodName,
+		setMethod: ttnpb.JsEndDeviceRegistry_Set_FullMethodName,
+		address:   (*ttnpb.EndDevice).GetJoinServerAddress,
+		registry: func(cc *grpc.ClientConn) endDeviceRegistryClient {
+			return ttnpb.NewJsEndDeviceRegistryClient(cc)
+		},
+		batch: func(cc *grpc.ClientConn) endDeviceBatchDeleter {
+			return ttnpb.NewJsEndDeviceBatchRegistryClient(cc)
+		},
+	},
+}
+
+// endDeviceSnapshotPaths returns the paths to retrieve from a component before deleting an end device, so that
+// the end device can be restored.
+func endDeviceSnapshotPaths(getMethod string) []string {
+	allowed := ttnpb.RPCFieldMaskPaths[getMethod].Allowed
+	paths := ttnpb.AllowedFields(ttnpb.TopLevelFields(allowed), allowed)
+	return ttnpb.ExcludeFields(paths, "ids", "created_at", "updated_at")
+}
+
+// endDeviceRestorePaths returns the paths to set in a component to restore a deleted end device from its snapshot.
+func endDeviceRestorePaths(setMethod string, dev *ttnpb.EndDevice, snapshotPaths []string) []string {
+	return ttnpb.AllowedReachableBottomLevelFields(
+		snapshotPaths, ttnpb.RPCFieldMaskPaths[setMethod].Allowed, dev.FieldIsZero,
+	)
+}
+
+// endDeviceBatchDeletion deletes end devices from a component, and restores them from their snapshots.
+type endDeviceBatchDeletion struct {
+	endDeviceBatchDeleteComponent
+	conn      *grpc.ClientConn
+	result    *ttnpb.BatchDeleteEndDevicesFromClusterResponse_Result
+	snapshots []*ttnpb.EndDevice
+}
+
+func (d *endDeviceBatchDeletion) snapshot(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, deviceIDs []string, opt grpc.CallOption,
+) error {
+	paths := endDeviceSnapshotPaths(d.getMethod)
+	registry := d.registry(d.conn)
+	for _, devID := range deviceIDs {
+		dev, err := registry.Get(ctx, &ttnpb.GetEndDeviceRequest{
+			EndDeviceIds: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: devID},
+			FieldMask:    ttnpb.FieldMask(paths...),
+		}, opt)
+		if err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		d.snapshots = append(d.snapshots, dev)
+	}
+	return nil
+}
+
+func (d *endDeviceBatchDeletion) restore(ctx context.Context, opt grpc.CallOption) {
+	snapshotPaths := endDeviceSnapshotPaths(d.getMethod)
+	registry := d.registry(d.conn)
+	d.result.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_RESTORED
+	for _, dev := range d.snapshots {
+		_, err := registry.Set(ctx, &ttnpb.SetEndDeviceRequest{
+			EndDevice: dev,
+			FieldMask: ttnpb.FieldMask(endDeviceRestorePaths(d.setMethod, dev, snapshotPaths)...),
+		}, opt)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).WithFields(log.Fields(
+				"component", d.name,
+				"device_uid", unique.ID(ctx, dev.Ids),
+			)).Warn("Failed to restore end device")
+			d.result.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_DELETED
+			setEndDeviceBatchDeleteError(d.result, err)
+		}
+	}
+}
+
+// restoreEndDevices restores the end devices in the components that they are deleted from, in reverse order.
+func restoreEndDevices(ctx context.Context, deletions []*endDeviceBatchDeletion, opt grpc.CallOption) {
+	for i := len(deletions) - 1; i >= 0; i-- {
+		deletions[i].restore(ctx, opt)
+	}
+}
+
+// batchDeleteEndDevicesFromCluster deletes the end devices from the Application Server, Network Server,
+// Join Server and Identity Server, in that order. Before deleting the end devices, the end devices are retrieved
+// from the components, so that the end devices can be restored in the components that they were already deleted
+// from when the deletion fails in a later component.
+func (is *IdentityServer) batchDeleteEndDevicesFromCluster(
+	ctx context.Context, req *ttnpb.BatchDeleteEndDevicesRequest,
+) (*ttnpb.BatchDeleteEndDevicesFromClusterResponse, error) {
+	if err := rights.RequireApplication(ctx, req.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	opt, err := rpcmetadata.WithForwardedAuth(ctx, is.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	devs, err := is.batchGetEndDevices(ctx, &ttnpb.BatchGetEndDevicesRequest{
+		ApplicationIds: req.ApplicationIds,
+		DeviceIds:      req.DeviceIds,
+		FieldMask: ttnpb.FieldMask(
+			"application_server_address",
+			"join_server_address",
+			"network_server_address",
+		),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ttnpb.BatchDeleteEndDevicesFromClusterResponse{}
+	deletions := make([]*endDeviceBatchDeletion, 0, len(endDeviceBatchDeleteComponents))
+	for _, component := range endDeviceBatchDeleteComponents {
+		result := &ttnpb.BatchDeleteEndDevicesFromClusterResponse_Result{Component: component.role}
+		res.Results = append(res.Results, result)
+		registered := false
+		for _, dev := range devs.EndDevices {
+			if component.address(dev) != "" {
+				registered = true
+				break
+			}
+		}
+		conn, err := is.GetPeerConn(ctx, component.role, nil)
+		if err != nil {
+			if !registered {
+				continue
+			}
+			return nil, errEndDeviceBatchDeletePeer.WithCause(err).WithAttributes("component", component.name)
+		}
+		deletion := &endDeviceBatchDeletion{endDeviceBatchDeleteComponent: component, conn: conn, result: result}
+		if err := deletion.snapshot(ctx, req.ApplicationIds, req.DeviceIds, opt); err != nil {
+			return nil, err
+		}
+		deletions = append(deletions, deletion)
+	}
+	isResult := &ttnpb.BatchDeleteEndDevicesFromClusterResponse_Result{
+		Component: ttnpb.ClusterRole_ENTITY_REGISTRY,
+	}
+	res.Results = append(res.Results, isResult)
+
+	for i, deletion := range deletions {
+		if _, err := deletion.batch(deletion.conn).Delete(ctx, req, opt); err != nil {
+			deletion.result.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_FAILED
+			setEndDeviceBatchDeleteError(deletion.result, err)
+			restoreEndDevices(ctx, deletions[:i], opt)
+			return res, nil
+		}
+		deletion.result.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_DELETED
+	}
+	if _, err := is.batchDeleteEndDevice(ctx, req); err != nil {
+		isResult.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_FAILED
+		setEndDeviceBatchDeleteError(isResult, err)
+		restoreEndDevices(ctx, deletions, opt)
+		return res, nil
+	}
+	isResult.Status = ttnpb.BatchDeleteEndDevicesFromClusterResponse_STATUS_DELETED
+	res.Deleted = true
+	return res, nil
+}
@@ -0,0 +1,49 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestEndDeviceSnapshotPaths(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for _, component := range endDeviceBatchDeleteComponents {
+		paths := endDeviceSnapshotPaths(component.getMethod)
+		a.So(paths, should.NotBeEmpty)
+		a.So(ttnpb.HasAnyField(paths, "ids", "created_at", "updated_at"), should.BeFalse)
+	}
+
+	nsSnapshotPaths := endDeviceSnapshotPaths(ttnpb.NsEndDeviceRegistry_Get_FullMethodName)
+	a.So(nsSnapshotPaths, should.Contain, "frequency_plan_id")
+	a.So(nsSnapshotPaths, should.Contain, "mac_settings")
+
+	dev := &ttnpb.EndDevice{
+		FrequencyPlanId: "EU_863_870",
+		MacSettings: &ttnpb.MACSettings{
+			Rx1Delay: &ttnpb.RxDelayValue{Value: ttnpb.RxDelay_RX_DELAY_5},
+		},
+	}
+	restorePaths := endDeviceRestorePaths(ttnpb.NsEndDeviceRegistry_Set_FullMethodName, dev, nsSnapshotPaths)
+	a.So(restorePaths, should.Contain, "frequency_plan_id")
+	a.So(restorePaths, should.Contain, "mac_settings.rx1_delay.value")
+	a.So(ttnpb.HasAnyField(restorePaths, "session"), should.BeFalse)
+}
@@ -0,0 +1,137 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var evtEndDeviceInactive = events.Define(
+	"end_device.inactive", "end device inactive",
+	events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+	events.WithDataType(&ttnpb.EndDevice{}),
+	events.WithPropagateToParent(),
+)
+
+var errInactivityThreshold = errors.DefineInvalidArgument(
+	"inactivity_threshold",
+	"invalid inactivity threshold `{threshold}` of application `{application_id}`",
+)
+
+// inactivityThresholds returns the inactivity thresholds of end devices by application ID.
+func (c Config) inactivityThresholds() (map[string]time.Duration, error) {
+	thresholds := make(map[string]time.Duration, len(c.EndDevices.Inactivity.Thresholds))
+	for appID, s := range c.EndDevices.Inactivity.Thresholds {
+		threshold, err := time.ParseDuration(s)
+		if err != nil {
+			return nil, errInactivityThreshold.WithAttributes(
+				"threshold", s,
+				"application_id", appID,
+			).WithCause(err)
+		}
+		if threshold <= 0 {
+			return nil, errInactivityThreshold.WithAttributes(
+				"threshold", s,
+				"application_id", appID,
+			)
+		}
+		thresholds[appID] = threshold
+	}
+	return thresholds, nil
+}
+
+// inactiveEndDevices returns the end devices that were last seen in the (from, to] interval.
+func inactiveEndDevices(devs []*ttnpb.EndDevice, from, to time.Time) []*ttnpb.EndDevice {
+	var inactive []*ttnpb.EndDevice
+	for _, dev := range devs {
+		lastSeenAt := ttnpb.StdTime(dev.GetLastSeenAt())
+		if lastSeenAt == nil || !lastSeenAt.After(from) || lastSeenAt.After(to) {
+			continue
+		}
+		inactive = append(inactive, dev)
+	}
+	return inactive
+}
+
+// initializeEndDeviceInactivityTask starts the task that publishes the end_device.inactive event
+// when end devices of applications with an inactivity threshold have not been seen for longer
+// than the threshold. The event is published once per period of inactivity.
+func (is *IdentityServer) initializeEndDeviceInactivityTask(ctx context.Context) error {
+	thresholds, err := is.config.inactivityThresholds()
+	if err != nil {
+		return err
+	}
+	interval := is.config.EndDevices.Inactivity.CheckInterval
+	if len(thresholds) == 0 || interval <= 0 {
+		return nil
+	}
+	is.RegisterTask(&task.Config{
+		Context: ctx,
+		ID:      "is_end_device_inactivity",
+		Backoff: task.DefaultBackoffConfig,
+		Restart: task.RestartAlways,
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			checkedAt := time.Now()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case now := <-ticker.C:
+					for appID, threshold := range thresholds {
+						if err := is.publishInactiveEndDevices(
+							ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: appID},
+							checkedAt.Add(-threshold), now.Add(-threshold),
+						); err != nil {
+							log.FromContext(ctx).WithError(err).WithField(
+								"application_id", appID,
+							).Warn("Failed to check inactivity of end devices")
+						}
+					}
+					checkedAt = now
+				}
+			}
+		},
+	})
+	return nil
+}
+
+// publishInactiveEndDevices publishes the end_device.inactive event for the end devices of the
+// application that were last seen in the (from, to] interval.
+func (is *IdentityServer) publishInactiveEndDevices(
+	ctx context.Context, ids *ttnpb.ApplicationIdentifiers, from, to time.Time,
+) error {
+	var devs []*ttnpb.EndDevice
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		devs, err = st.ListEndDevices(ctx, ids, []string{"ids", "last_seen_at"})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	for _, dev := range inactiveEndDevices(devs, from, to) {
+		events.Publish(evtEndDeviceInactive.NewWithIdentifiersAndData(ctx, dev.Ids, dev))
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestEndDeviceInactivity(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	var conf Config
+	conf.EndDevices.Inactivity.Thresholds = map[string]string{
+		"app1": "1h",
+		"app2": "24h",
+	}
+	thresholds, err := conf.inactivityThresholds()
+	if a.So(err, should.BeNil) {
+		a.So(thresholds, should.Resemble, map[string]time.Duration{
+			"app1": time.Hour,
+			"app2": 24 * time.Hour,
+		})
+	}
+	for _, s := range []string{"never", "0s", "-1h"} {
+		conf.EndDevices.Inactivity.Thresholds = map[string]string{"app1": s}
+		_, err := conf.inactivityThresholds()
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+
+	now := time.Now()
+	dev := func(id string, lastSeenAt time.Time) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			Ids: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+				DeviceId:       id,
+			},
+			LastSeenAt: timestamppb.New(lastSeenAt),
+		}
+	}
+	devs := []*ttnpb.EndDevice{
+		dev("dev1", now.Add(-3*time.Hour)),
+		dev("dev2", now.Add(-2*time.Hour)),
+		dev("dev3", now.Add(-90*time.Minute)),
+		dev("dev4", now.Add(-time.Hour)),
+		dev("dev5", now.Add(-time.Minute)),
+		{
+			Ids: &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+				DeviceId:       "dev6",
+			},
+		},
+	}
+	a.So(inactiveEndDevices(devs, now.Add(-2*time.Hour), now.Add(-time.Hour)), should.Resemble, devs[2:4])
+	a.So(inactiveEndDevices(devs, now.Add(-time.Hour), now), should.Resemble, devs[4:5])
+}
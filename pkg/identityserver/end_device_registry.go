@@ -443,6 +443,9 @@ func (is *IdentityServer) deleteEndDevice(ctx context.Context, ids *ttnpb.EndDev
 		return nil, err
 	}
 	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		if err := st.DeleteEntityLabels(ctx, ids); err != nil {
+			return err
+		}
 		return st.DeleteEndDevice(ctx, ids)
 	})
 	if err != nil {
@@ -489,6 +492,15 @@ func (is *IdentityServer) batchDeleteEndDevice(
 		deleted = []*ttnpb.EndDeviceIdentifiers{}
 	)
 	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		for _, deviceID := range req.DeviceIds {
+			err := st.DeleteEntityLabels(ctx, &ttnpb.EndDeviceIdentifiers{
+				ApplicationIds: req.ApplicationIds,
+				DeviceId:       deviceID,
+			})
+			if err != nil && !errors.IsNotFound(err) {
+				return err
+			}
+		}
 		deleted, err = st.BatchDeleteEndDevices(ctx, req.ApplicationIds, req.DeviceIds)
 		if err != nil {
 			return err
@@ -592,3 +604,17 @@ func (reg *endDeviceBatchRegistry) Get(
 ) (*ttnpb.EndDevices, error) {
 	return reg.batchGetEndDevices(ctx, req)
 }
+
+func (reg *endDeviceBatchRegistry) Update(
+	ctx context.Context,
+	req *ttnpb.BatchUpdateEndDevicesRequest,
+) (*ttnpb.BatchUpdateEndDevicesResponse, error) {
+	return reg.batchUpdateEndDevices(ctx, req)
+}
+
+func (reg *endDeviceBatchRegistry) DeleteFromCluster(
+	ctx context.Context,
+	req *ttnpb.BatchDeleteEndDevicesRequest,
+) (*ttnpb.BatchDeleteEndDevicesFromClusterResponse, error) {
+	return reg.batchDeleteEndDevicesFromCluster(ctx, req)
+}
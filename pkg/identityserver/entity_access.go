@@ -23,6 +23,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/auth"
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
@@ -91,6 +92,9 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 	}
 
 	token := md.AuthValue
+	if rights.IsToken(token) {
+		return is.apiKeyTokenAuthInfo(ctx, token)
+	}
 	tokenType, tokenID, tokenKey, err := auth.SplitToken(token)
 	if err != nil {
 		return nil, err
@@ -126,7 +130,11 @@ func (is *IdentityServer) authInfo(ctx context.Context) (info *ttnpb.AuthInfoRes
 				return errAPIKeyExpired.New()
 			}
 			apiKey.Key = ""
-			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Implied().GetRights()
+			denied, err := st.GetAPIKeyDeniedRights(ctx, ids, apiKey.GetId())
+			if err != nil {
+				return err
+			}
+			apiKey.Rights = ttnpb.RightsFrom(apiKey.Rights...).Deny(denied).GetRights()
 			res.AccessMethod = &ttnpb.AuthInfoResponse_ApiKey{
 				ApiKey: &ttnpb.AuthInfoResponse_APIKeyAccess{
 					ApiKey:    apiKey,
@@ -438,3 +446,13 @@ type entityAccess struct {
 func (ea *entityAccess) AuthInfo(ctx context.Context, _ *emptypb.Empty) (*ttnpb.AuthInfoResponse, error) {
 	return ea.authInfo(ctx)
 }
+
+func (ea *entityAccess) CreateAPIKeyToken(ctx context.Context, _ *emptypb.Empty) (*ttnpb.APIKeyToken, error) {
+	return ea.createAPIKeyToken(ctx, &apiKeyTokenScope{})
+}
+
+func (ea *entityAccess) ExchangeAPIKeyToken(
+	ctx context.Context, req *ttnpb.ExchangeAPIKeyTokenRequest,
+) (*ttnpb.ExchangeAPIKeyTokenResponse, error) {
+	return ea.exchangeAPIKeyToken(ctx, req)
+}
@@ -0,0 +1,184 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"google.golang.org/protobuf/types/known/structpb"
+)
+
+var (
+	evtRequestGatewayEUIReclaim = events.Define(
+		"gateway.eui.reclaim.request", "request reclaim of gateway EUI",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtReleaseGatewayEUI = events.Define(
+		"gateway.eui.release", "release gateway EUI",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtReclaimGatewayEUI = events.Define(
+		"gateway.eui.reclaim", "reclaim gateway EUI",
+		events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errGatewayEUI         = errors.DefineInvalidArgument("gateway_eui", "invalid gateway EUI `{gateway_eui}`")
+	errGatewayEUINotTaken = errors.DefineFailedPrecondition(
+		"gateway_eui_not_taken",
+		"gateway EUI `{gateway_eui}` is not registered, set the EUI of the gateway instead",
+	)
+	errGatewayEUIAlreadyOwned = errors.DefineFailedPrecondition(
+		"gateway_eui_already_owned",
+		"gateway EUI `{gateway_eui}` is already registered as `{gateway_id}`",
+	)
+)
+
+// requestGatewayEUIReclaim notifies the admins that the owner of the gateway requests to reclaim the EUI.
+func (is *IdentityServer) requestGatewayEUIReclaim(
+	ctx context.Context, req *ttnpb.RequestGatewayEUIReclaimRequest,
+) error {
+	ids := req.GetGatewayIds()
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		return err
+	}
+	eui := types.MustEUI64(req.GetEui()).OrZero()
+	if eui.IsZero() {
+		return errGatewayEUI.WithAttributes("gateway_eui", eui.String())
+	}
+	var holder *ttnpb.Gateway
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		if _, err := st.GetGateway(ctx, ids, store.FieldMask{"ids"}); err != nil {
+			return err
+		}
+		holder, err = st.GetGateway(ctx, &ttnpb.GatewayIdentifiers{Eui: eui.Bytes()}, store.FieldMask{"ids"})
+		if errors.IsNotFound(err) {
+			return errGatewayEUINotTaken.WithAttributes("gateway_eui", eui.String())
+		}
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if holder.GetIds().GetGatewayId() == ids.GetGatewayId() {
+		return errGatewayEUIAlreadyOwned.WithAttributes(
+			"gateway_eui", eui.String(),
+			"gateway_id", ids.GetGatewayId(),
+		)
+	}
+
+	data, err := structpb.NewStruct(map[string]any{
+		"gateway_eui":           eui.String(),
+		"gateway_id":            ids.GetGatewayId(),
+		"registered_gateway_id": holder.GetIds().GetGatewayId(),
+		"reason":                req.GetReason(),
+	})
+	if err != nil {
+		return err
+	}
+	if err := is.notifyAdminsInternal(ctx, &ttnpb.CreateNotificationRequest{
+		EntityIds:        ids.GetEntityIdentifiers(),
+		NotificationType: "gateway_eui_reclaim_requested",
+		Data:             ttnpb.MustMarshalAny(data),
+	}); err != nil {
+		return err
+	}
+	events.Publish(evtRequestGatewayEUIReclaim.NewWithIdentifiersAndData(ctx, ids, data))
+	return nil
+}
+
+// transferGatewayEUI releases the EUI from the gateway that is registered with it, if any, and sets the EUI of
+// the gateway with the given identifiers, if not nil. Only admins can release and transfer gateway EUIs.
+func (is *IdentityServer) transferGatewayEUI(
+	ctx context.Context, eui types.EUI64, ids *ttnpb.GatewayIdentifiers,
+) (*ttnpb.GatewayEUITransferResult, error) {
+	if err := is.RequireAdmin(ctx); err != nil {
+		return nil, err
+	}
+	if eui.IsZero() {
+		return nil, errGatewayEUI.WithAttributes("gateway_eui", eui.String())
+	}
+	res := &ttnpb.GatewayEUITransferResult{}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		if ids != nil {
+			gtw, err := st.GetGateway(ctx, ids, store.FieldMask{"ids"})
+			if err != nil {
+				return err
+			}
+			if types.MustEUI64(gtw.GetIds().GetEui()).OrZero().Equal(eui) {
+				return errGatewayEUIAlreadyOwned.WithAttributes(
+					"gateway_eui", eui.String(),
+					"gateway_id", ids.GetGatewayId(),
+				)
+			}
+		}
+		holder, err := st.GetGateway(ctx, &ttnpb.GatewayIdentifiers{Eui: eui.Bytes()}, store.FieldMask{"ids"})
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		if holder != nil {
+			if _, err := st.UpdateGateway(ctx, &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{GatewayId: holder.GetIds().GetGatewayId()},
+			}, store.FieldMask{"ids.eui"}); err != nil {
+				return err
+			}
+			res.ReleasedFrom = &ttnpb.GatewayIdentifiers{GatewayId: holder.GetIds().GetGatewayId()}
+		}
+		if ids != nil {
+			if _, err := st.UpdateGateway(ctx, &ttnpb.Gateway{
+				Ids: &ttnpb.GatewayIdentifiers{GatewayId: ids.GetGatewayId(), Eui: eui.Bytes()},
+			}, store.FieldMask{"ids.eui"}); err != nil {
+				return err
+			}
+			res.ReclaimedBy = &ttnpb.GatewayIdentifiers{GatewayId: ids.GetGatewayId(), Eui: eui.Bytes()}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.ReleasedFrom != nil {
+		events.Publish(evtReleaseGatewayEUI.NewWithIdentifiersAndData(ctx, res.ReleasedFrom, nil))
+		if err := is.notifyInternal(ctx, &ttnpb.CreateNotificationRequest{
+			EntityIds:        res.ReleasedFrom.GetEntityIdentifiers(),
+			NotificationType: "gateway_eui_released",
+			Receivers: []ttnpb.NotificationReceiver{
+				ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_ADMINISTRATIVE_CONTACT,
+				ttnpb.NotificationReceiver_NOTIFICATION_RECEIVER_TECHNICAL_CONTACT,
+			},
+		}); err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to notify contacts of gateway with released EUI")
+		}
+	}
+	if res.ReclaimedBy != nil {
+		events.Publish(evtReclaimGatewayEUI.NewWithIdentifiersAndData(ctx, res.ReclaimedBy, nil))
+	}
+	return res, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayEUIReclaimRequestJSON(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	req := &ttnpb.RequestGatewayEUIReclaimRequest{}
+	err := jsonpb.TTN().Unmarshal([]byte(`{
+		"gateway_ids": {"gateway_id": "owned-gtw"},
+		"eui": "0102030405060708",
+		"reason": "I own this gateway"
+	}`), req)
+	if a.So(err, should.BeNil) {
+		a.So(req, should.Resemble, &ttnpb.RequestGatewayEUIReclaimRequest{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "owned-gtw"},
+			Eui:        types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+			Reason:     "I own this gateway",
+		})
+		a.So(req.ValidateFields(), should.BeNil)
+	}
+
+	a.So((&ttnpb.ReleaseGatewayEUIRequest{Eui: []byte{0x01, 0x02, 0x03}}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.TransferGatewayEUIRequest{
+		Eui: types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+	}).ValidateFields(), should.NotBeNil)
+
+	b, err := jsonpb.TTN().Marshal(&ttnpb.GatewayEUITransferResult{
+		ReleasedFrom: &ttnpb.GatewayIdentifiers{GatewayId: "squatted-gtw"},
+		ReclaimedBy: &ttnpb.GatewayIdentifiers{
+			GatewayId: "owned-gtw",
+			Eui:       types.EUI64{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}.Bytes(),
+		},
+	})
+	if a.So(err, should.BeNil) {
+		a.So(string(b), should.Equal, `{"released_from":{"gateway_id":"squatted-gtw"},`+
+			`"reclaimed_by":{"gateway_id":"owned-gtw","eui":"0102030405060708"}}`)
+	}
+}
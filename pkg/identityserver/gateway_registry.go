@@ -126,48 +126,26 @@ func (is *IdentityServer) createGateway( // nolint:gocyclo
 	}
 
 	if reqGtw.LbsLnsSecret != nil {
-		value := reqGtw.LbsLnsSecret.Value
-		if is.config.Gateways.EncryptionKeyID != "" {
-			value, err = is.KeyService().Encrypt(ctx, reqGtw.LbsLnsSecret.Value, is.config.Gateways.EncryptionKeyID)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			log.FromContext(ctx).Warn("No encryption key defined, store LBS LNS Secret in plaintext")
+		if err := is.encryptGatewaySecret(ctx, reqGtw.GetIds(), reqGtw.LbsLnsSecret, "LBS LNS Secret"); err != nil {
+			return nil, err
 		}
-		reqGtw.LbsLnsSecret.Value = value
-		reqGtw.LbsLnsSecret.KeyId = is.config.Gateways.EncryptionKeyID
 	}
 
 	if reqGtw.TargetCupsKey != nil {
-		value := reqGtw.TargetCupsKey.Value
-		if is.config.Gateways.EncryptionKeyID != "" {
-			value, err = is.KeyService().Encrypt(ctx, reqGtw.TargetCupsKey.Value, is.config.Gateways.EncryptionKeyID)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			log.FromContext(ctx).Warn("No encryption key defined, store Target CUPS Key in plaintext")
+		if err := is.encryptGatewaySecret(ctx, reqGtw.GetIds(), reqGtw.TargetCupsKey, "Target CUPS Key"); err != nil {
+			return nil, err
 		}
-		reqGtw.TargetCupsKey.Value = value
-		reqGtw.TargetCupsKey.KeyId = is.config.Gateways.EncryptionKeyID
 	}
 
 	if reqGtw.ClaimAuthenticationCode != nil {
 		if err = validateClaimAuthenticationCode(reqGtw.ClaimAuthenticationCode); err != nil {
 			return nil, err
 		}
-		value := reqGtw.ClaimAuthenticationCode.Secret.Value
-		if is.config.Gateways.EncryptionKeyID != "" {
-			value, err = is.KeyService().Encrypt(ctx, value, is.config.Gateways.EncryptionKeyID)
-			if err != nil {
-				return nil, err
-			}
-		} else {
-			log.FromContext(ctx).Warn("No encryption key defined, store Claim Authentication Code in plaintext")
+		if err := is.encryptGatewaySecret(
+			ctx, reqGtw.GetIds(), reqGtw.ClaimAuthenticationCode.Secret, "Claim Authentication Code",
+		); err != nil {
+			return nil, err
 		}
-		reqGtw.ClaimAuthenticationCode.Secret.Value = value
-		reqGtw.ClaimAuthenticationCode.Secret.KeyId = is.config.Gateways.EncryptionKeyID
 	}
 	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
 		gtw, err = st.CreateGateway(ctx, reqGtw)
@@ -550,19 +528,10 @@ func (is *IdentityServer) updateGateway( // nolint:gocyclo
 		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
 			return nil, err
 		} else if reqGtw.LbsLnsSecret != nil {
-			value := reqGtw.LbsLnsSecret.Value
 			ptLBSLNSSecret = reqGtw.LbsLnsSecret.Value
-			if is.config.Gateways.EncryptionKeyID != "" {
-				value, err = is.KeyService().Encrypt(ctx, reqGtw.LbsLnsSecret.Value, is.config.Gateways.EncryptionKeyID)
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				logger := log.FromContext(ctx)
-				logger.Warn("No encryption key defined, store LBS LNS Secret in plaintext")
+			if err := is.encryptGatewaySecret(ctx, reqGtw.GetIds(), reqGtw.LbsLnsSecret, "LBS LNS Secret"); err != nil {
+				return nil, err
 			}
-			reqGtw.LbsLnsSecret.Value = value
-			reqGtw.LbsLnsSecret.KeyId = is.config.Gateways.EncryptionKeyID
 		}
 	}
 
@@ -570,19 +539,10 @@ func (is *IdentityServer) updateGateway( // nolint:gocyclo
 		if err := rights.RequireGateway(ctx, reqGtw.GetIds(), ttnpb.Right_RIGHT_GATEWAY_WRITE_SECRETS); err != nil {
 			return nil, err
 		} else if reqGtw.TargetCupsKey != nil {
-			value := reqGtw.TargetCupsKey.Value
 			ptTargetCUPSKeySecret = reqGtw.TargetCupsKey.Value
-			if is.config.Gateways.EncryptionKeyID != "" {
-				value, err = is.KeyService().Encrypt(ctx, reqGtw.TargetCupsKey.Value, is.config.Gateways.EncryptionKeyID)
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				logger := log.FromContext(ctx)
-				logger.Warn("No encryption key defined, store Target CUPS Key in plaintext")
+			if err := is.encryptGatewaySecret(ctx, reqGtw.GetIds(), reqGtw.TargetCupsKey, "Target CUPS Key"); err != nil {
+				return nil, err
 			}
-			reqGtw.TargetCupsKey.Value = value
-			reqGtw.TargetCupsKey.KeyId = is.config.Gateways.EncryptionKeyID
 		}
 	}
 
@@ -593,19 +553,12 @@ func (is *IdentityServer) updateGateway( // nolint:gocyclo
 			if err := validateClaimAuthenticationCode(reqGtw.ClaimAuthenticationCode); err != nil {
 				return nil, err
 			}
-			value := reqGtw.ClaimAuthenticationCode.Secret.Value
 			ptCACSecret = reqGtw.ClaimAuthenticationCode.Secret.Value
-			if is.config.Gateways.EncryptionKeyID != "" {
-				value, err = is.KeyService().Encrypt(ctx, value, is.config.Gateways.EncryptionKeyID)
-				if err != nil {
-					return nil, err
-				}
-			} else {
-				logger := log.FromContext(ctx)
-				logger.Warn("No encryption key defined, store Claim Authentication Code in plaintext")
+			if err := is.encryptGatewaySecret(
+				ctx, reqGtw.GetIds(), reqGtw.ClaimAuthenticationCode.Secret, "Claim Authentication Code",
+			); err != nil {
+				return nil, err
 			}
-			reqGtw.ClaimAuthenticationCode.Secret.Value = value
-			reqGtw.ClaimAuthenticationCode.Secret.KeyId = is.config.Gateways.EncryptionKeyID
 		}
 	}
 
@@ -714,11 +667,21 @@ func (is *IdentityServer) purgeGateway(ctx context.Context, ids *ttnpb.GatewayId
 		if err != nil {
 			return err
 		}
+		// delete related group memberships before purging the gateway
+		err = st.DeleteEntityGroupMemberships(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
 		// delete related contact info before purging the gateway
 		err = st.DeleteEntityContactInfo(ctx, ids)
 		if err != nil {
 			return err
 		}
+		// delete related labels before purging the gateway
+		err = st.DeleteEntityLabels(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return st.PurgeGateway(ctx, ids)
 	})
 	if err != nil {
@@ -762,6 +725,27 @@ func (gr *gatewayRegistry) GetIdentifiersForEUI(
 	return gr.getGatewayIdentifiersForEUI(ctx, req)
 }
 
+func (gr *gatewayRegistry) RequestEUIReclaim(
+	ctx context.Context, req *ttnpb.RequestGatewayEUIReclaimRequest,
+) (*emptypb.Empty, error) {
+	if err := gr.requestGatewayEUIReclaim(ctx, req); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (gr *gatewayRegistry) ReleaseEUI(
+	ctx context.Context, req *ttnpb.ReleaseGatewayEUIRequest,
+) (*ttnpb.GatewayEUITransferResult, error) {
+	return gr.transferGatewayEUI(ctx, types.MustEUI64(req.GetEui()).OrZero(), nil)
+}
+
+func (gr *gatewayRegistry) TransferEUI(
+	ctx context.Context, req *ttnpb.TransferGatewayEUIRequest,
+) (*ttnpb.GatewayEUITransferResult, error) {
+	return gr.transferGatewayEUI(ctx, types.MustEUI64(req.GetEui()).OrZero(), req.GetGatewayIds())
+}
+
 func (gr *gatewayRegistry) List(ctx context.Context, req *ttnpb.ListGatewaysRequest) (*ttnpb.Gateways, error) {
 	return gr.listGateways(ctx, req)
 }
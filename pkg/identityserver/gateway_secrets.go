@@ -0,0 +1,181 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// gatewaySecretsFieldMask is the field mask of the gateway secrets that are encrypted at rest.
+var gatewaySecretsFieldMask = []string{"ids", "lbs_lns_secret", "target_cups_key", "claim_authentication_code"}
+
+// GatewaySecrets encrypts gateway secrets at rest.
+// If KeyIDFormat is set and the key vault contains the per-gateway key, the per-gateway key is used.
+// Otherwise, the key with KeyID is used. If neither is set, the secrets are stored in plaintext.
+type GatewaySecrets struct {
+	KeyService  crypto.KeyService
+	KeyID       string
+	KeyIDFormat string
+}
+
+func (s GatewaySecrets) gatewayKeyID(ids *ttnpb.GatewayIdentifiers) string {
+	if s.KeyIDFormat == "" {
+		return ""
+	}
+	return strings.ReplaceAll(s.KeyIDFormat, "{gateway_id}", ids.GetGatewayId())
+}
+
+// Encrypt encrypts the secret value of the gateway. It returns the encrypted value and the ID of the key that
+// is used to encrypt it. If no key is configured, the value is returned as is with an empty key ID.
+func (s GatewaySecrets) Encrypt(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, value []byte,
+) ([]byte, string, error) {
+	if keyID := s.gatewayKeyID(ids); keyID != "" {
+		encrypted, err := s.KeyService.Encrypt(ctx, value, keyID)
+		if err == nil {
+			return encrypted, keyID, nil
+		}
+		if !errors.IsNotFound(err) {
+			return nil, "", err
+		}
+	}
+	if s.KeyID == "" {
+		return value, "", nil
+	}
+	encrypted, err := s.KeyService.Encrypt(ctx, value, s.KeyID)
+	if err != nil {
+		return nil, "", err
+	}
+	return encrypted, s.KeyID, nil
+}
+
+// Rotate re-encrypts the secret of the gateway if it is not encrypted with the key that Encrypt would use.
+// It returns whether the secret is re-encrypted.
+func (s GatewaySecrets) Rotate(ctx context.Context, ids *ttnpb.GatewayIdentifiers, secret *ttnpb.Secret) (bool, error) {
+	if secret == nil || len(secret.Value) == 0 {
+		return false, nil
+	}
+	plaintext := secret.Value
+	if secret.KeyId != "" {
+		var err error
+		plaintext, err = s.KeyService.Decrypt(ctx, secret.Value, secret.KeyId)
+		if err != nil {
+			return false, err
+		}
+	}
+	value, keyID, err := s.Encrypt(ctx, ids, plaintext)
+	if err != nil {
+		return false, err
+	}
+	if keyID == secret.KeyId {
+		return false, nil
+	}
+	secret.Value, secret.KeyId = value, keyID
+	return true, nil
+}
+
+// RotateGateway re-encrypts the secrets of the gateway that are not encrypted with the current key of the gateway.
+// It returns the field mask of the re-encrypted secrets.
+func (s GatewaySecrets) RotateGateway(ctx context.Context, gtw *ttnpb.Gateway) ([]string, error) {
+	var paths []string
+	for _, f := range []struct {
+		path   string
+		secret *ttnpb.Secret
+	}{
+		{path: "lbs_lns_secret", secret: gtw.LbsLnsSecret},
+		{path: "target_cups_key", secret: gtw.TargetCupsKey},
+		{path: "claim_authentication_code", secret: gtw.GetClaimAuthenticationCode().GetSecret()},
+	} {
+		rotated, err := s.Rotate(ctx, gtw.GetIds(), f.secret)
+		if err != nil {
+			return nil, err
+		}
+		if rotated {
+			paths = append(paths, f.path)
+		}
+	}
+	return paths, nil
+}
+
+// RotateGatewaySecrets re-encrypts the secrets of all gateways in the store that are not encrypted with the
+// current key of the gateway. If dryRun is set, the gateways are not updated.
+// It returns the number of gateways that have secrets to re-encrypt.
+func RotateGatewaySecrets(ctx context.Context, st store.GatewayStore, s GatewaySecrets, dryRun bool) (int, error) {
+	const limit = 100
+	logger := log.FromContext(ctx)
+	rotated := 0
+	for page := uint32(1); ; page++ {
+		var total uint64
+		gtws, err := st.FindGateways(store.WithPagination(ctx, limit, page, &total), nil, gatewaySecretsFieldMask)
+		if err != nil {
+			return rotated, err
+		}
+		for _, gtw := range gtws {
+			paths, err := s.RotateGateway(ctx, gtw)
+			if err != nil {
+				return rotated, err
+			}
+			if len(paths) == 0 {
+				continue
+			}
+			rotated++
+			logger := logger.WithFields(log.Fields(
+				"gateway_id", gtw.GetIds().GetGatewayId(),
+				"paths", paths,
+			))
+			if dryRun {
+				logger.Info("Would re-encrypt gateway secrets")
+				continue
+			}
+			if _, err := st.UpdateGateway(ctx, gtw, paths); err != nil {
+				return rotated, err
+			}
+			logger.Info("Re-encrypted gateway secrets")
+		}
+		if uint64(page)*limit >= total {
+			return rotated, nil
+		}
+	}
+}
+
+func (is *IdentityServer) gatewaySecrets() GatewaySecrets {
+	return GatewaySecrets{
+		KeyService:  is.KeyService(),
+		KeyID:       is.config.Gateways.EncryptionKeyID,
+		KeyIDFormat: is.config.Gateways.EncryptionKeyIDFormat,
+	}
+}
+
+// encryptGatewaySecret encrypts the secret of the gateway in place.
+func (is *IdentityServer) encryptGatewaySecret(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, secret *ttnpb.Secret, name string,
+) error {
+	value, keyID, err := is.gatewaySecrets().Encrypt(ctx, ids, secret.Value)
+	if err != nil {
+		return err
+	}
+	if keyID == "" {
+		log.FromContext(ctx).Warnf("No encryption key defined, store %s in plaintext", name)
+	}
+	secret.Value, secret.KeyId = value, keyID
+	return nil
+}
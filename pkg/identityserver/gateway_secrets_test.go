@@ -0,0 +1,94 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewaySecrets(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ks := crypto.NewKeyService(cryptoutil.NewMemKeyVault(map[string][]byte{
+		"is-gtw":            {0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d, 0x0e, 0x0f, 0x10},
+		"is-gtw-foo-gtw":    {0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19, 0x1a, 0x1b, 0x1c, 0x1d, 0x1e, 0x1f, 0x20},
+		"is-gtw-rotated-id": {0x21, 0x22, 0x23, 0x24, 0x25, 0x26, 0x27, 0x28, 0x29, 0x2a, 0x2b, 0x2c, 0x2d, 0x2e, 0x2f, 0x30},
+	}))
+	fooIDs := &ttnpb.GatewayIdentifiers{GatewayId: "foo-gtw"}
+	barIDs := &ttnpb.GatewayIdentifiers{GatewayId: "bar-gtw"}
+	plaintext := []byte("secret")
+
+	// Without keys, secrets are stored in plaintext.
+	value, keyID, err := GatewaySecrets{KeyService: ks}.Encrypt(ctx, fooIDs, plaintext)
+	a.So(err, should.BeNil)
+	a.So(value, should.Resemble, plaintext)
+	a.So(keyID, should.BeEmpty)
+
+	s := GatewaySecrets{KeyService: ks, KeyID: "is-gtw", KeyIDFormat: "is-gtw-{gateway_id}"}
+
+	// The per-gateway key is used if it exists.
+	value, keyID, err = s.Encrypt(ctx, fooIDs, plaintext)
+	a.So(err, should.BeNil)
+	a.So(keyID, should.Equal, "is-gtw-foo-gtw")
+	decrypted, err := ks.Decrypt(ctx, value, keyID)
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, plaintext)
+
+	// Otherwise, the static key is used.
+	value, keyID, err = s.Encrypt(ctx, barIDs, plaintext)
+	a.So(err, should.BeNil)
+	a.So(keyID, should.Equal, "is-gtw")
+	decrypted, err = ks.Decrypt(ctx, value, keyID)
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, plaintext)
+
+	// Secrets encrypted with the static key are rotated to the per-gateway key.
+	gtw := &ttnpb.Gateway{
+		Ids:          fooIDs,
+		LbsLnsSecret: &ttnpb.Secret{Value: value, KeyId: keyID},
+		ClaimAuthenticationCode: &ttnpb.GatewayClaimAuthenticationCode{
+			Secret: &ttnpb.Secret{Value: plaintext},
+		},
+	}
+	paths, err := s.RotateGateway(ctx, gtw)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{"lbs_lns_secret", "claim_authentication_code"})
+	a.So(gtw.LbsLnsSecret.KeyId, should.Equal, "is-gtw-foo-gtw")
+	a.So(gtw.ClaimAuthenticationCode.Secret.KeyId, should.Equal, "is-gtw-foo-gtw")
+	decrypted, err = ks.Decrypt(ctx, gtw.LbsLnsSecret.Value, gtw.LbsLnsSecret.KeyId)
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, plaintext)
+
+	// Secrets that are encrypted with the current key are not rotated.
+	paths, err = s.RotateGateway(ctx, gtw)
+	a.So(err, should.BeNil)
+	a.So(paths, should.BeEmpty)
+
+	// Rotating the key ID format re-encrypts the secrets.
+	s.KeyIDFormat = "is-gtw-rotated-id"
+	paths, err = s.RotateGateway(ctx, gtw)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{"lbs_lns_secret", "claim_authentication_code"})
+	decrypted, err = ks.Decrypt(ctx, gtw.ClaimAuthenticationCode.Secret.Value, "is-gtw-rotated-id")
+	a.So(err, should.BeNil)
+	a.So(decrypted, should.Resemble, plaintext)
+}
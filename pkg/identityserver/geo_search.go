@@ -0,0 +1,200 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultGeoSearchLimit is the number of results per page if the request does not set a limit.
+const defaultGeoSearchLimit = 100
+
+var (
+	errGeoSearchBoundingBox = errors.DefineInvalidArgument(
+		"geo_search_bounding_box", "invalid bounding box `{bbox}`",
+	)
+	errGeoSearchQuery = errors.DefineInvalidArgument(
+		"geo_search_query", "either bbox or radius must be set",
+	)
+)
+
+// geoQuery returns the store query of the bounding box or radius of a location based search.
+// The minimum longitude of the bounding box may be greater than the maximum longitude if the
+// bounding box crosses the antimeridian.
+func geoQuery(bbox *ttnpb.GeoBoundingBox, radius *ttnpb.GeoRadius) (store.GeoQuery, error) {
+	switch {
+	case bbox != nil:
+		if bbox.MinLatitude > bbox.MaxLatitude {
+			return store.GeoQuery{}, errGeoSearchBoundingBox.WithAttributes("bbox", fmt.Sprintf(
+				"%v,%v,%v,%v", bbox.MinLatitude, bbox.MinLongitude, bbox.MaxLatitude, bbox.MaxLongitude,
+			))
+		}
+		return store.GeoQuery{
+			BoundingBox: store.BoundingBox{
+				MinLatitude:  bbox.MinLatitude,
+				MinLongitude: bbox.MinLongitude,
+				MaxLatitude:  bbox.MaxLatitude,
+				MaxLongitude: bbox.MaxLongitude,
+			},
+		}, nil
+	case radius != nil:
+		return store.GeoQueryAround(radius.Latitude, radius.Longitude, radius.Radius), nil
+	default:
+		return store.GeoQuery{}, errGeoSearchQuery.New()
+	}
+}
+
+func geoSearchLimit(limit uint32) uint32 {
+	if limit == 0 {
+		return defaultGeoSearchLimit
+	}
+	return limit
+}
+
+// SearchGatewaysByLocation returns the gateways with an antenna in the area of the request that the caller
+// is a member of. Admins search all gateways. Gateways that the caller does not have the RIGHT_GATEWAY_INFO
+// right for are returned with their public fields only.
+func (rs *registrySearch) SearchGatewaysByLocation(
+	ctx context.Context, req *ttnpb.SearchGatewaysByLocationRequest,
+) (*ttnpb.Gateways, error) {
+	query, err := geoQuery(req.GetBbox(), req.GetRadius())
+	if err != nil {
+		return nil, err
+	}
+	req.FieldMask = cleanFieldMaskPaths(
+		ttnpb.GatewayFieldPathsNested, req.FieldMask, append(getPaths, "antennas"), nil,
+	)
+	res, total, err := rs.searchGatewaysByLocation(
+		ctx, query, req.FieldMask.GetPaths(), geoSearchLimit(req.Limit), req.Page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, total)
+	return res, nil
+}
+
+func (rs *registrySearch) searchGatewaysByLocation(
+	ctx context.Context, query store.GeoQuery, paths []string, limit, page uint32,
+) (res *ttnpb.Gateways, total uint64, err error) {
+	authInfo, err := rs.authInfo(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	member := authInfo.GetOrganizationOrUserIdentifiers()
+	if member == nil {
+		return nil, 0, errSearchForbidden.New()
+	}
+	if authInfo.IsAdmin {
+		member = nil
+	}
+
+	ctx = store.WithPagination(ctx, limit, page, &total)
+
+	res = &ttnpb.Gateways{}
+	var callerMemberships store.MembershipChains
+
+	err = rs.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		entityIDs, err := st.SearchGatewaysByLocation(ctx, member, query)
+		if err != nil {
+			return err
+		}
+		if len(entityIDs) == 0 {
+			return nil
+		}
+		if member != nil {
+			idStrings := make([]string, len(entityIDs))
+			for i, entityID := range entityIDs {
+				idStrings[i] = entityID.IDString()
+			}
+			callerMemberships, err = st.FindAccountMembershipChains(ctx, member, "gateway", idStrings...)
+			if err != nil {
+				return err
+			}
+		}
+		ctx = store.WithPagination(ctx, 0, 0, nil) // Reset pagination (already done in EntitySearch.SearchGatewaysByLocation).
+		res.Gateways, err = st.FindGateways(ctx, entityIDs, paths)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if member != nil {
+		for i, gtw := range res.Gateways {
+			entityRights := callerMemberships.GetRights(member, gtw.GetIds()).Union(authInfo.GetUniversalRights())
+			if !entityRights.IncludesAll(ttnpb.Right_RIGHT_GATEWAY_INFO) {
+				res.Gateways[i] = gtw.PublicSafe()
+			}
+		}
+	}
+
+	return res, total, nil
+}
+
+// SearchEndDevicesByLocation returns the end devices of the application with a location in the area of the request.
+func (rs *registrySearch) SearchEndDevicesByLocation(
+	ctx context.Context, req *ttnpb.SearchEndDevicesByLocationRequest,
+) (*ttnpb.EndDevices, error) {
+	query, err := geoQuery(req.GetBbox(), req.GetRadius())
+	if err != nil {
+		return nil, err
+	}
+	req.FieldMask = cleanFieldMaskPaths(
+		ttnpb.EndDeviceFieldPathsNested, req.FieldMask, append(getPaths, "locations"), nil,
+	)
+	res, total, err := rs.searchEndDevicesByLocation(
+		ctx, req.ApplicationIds, query, req.FieldMask.GetPaths(), geoSearchLimit(req.Limit), req.Page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, total)
+	return res, nil
+}
+
+func (rs *registrySearch) searchEndDevicesByLocation(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, query store.GeoQuery, paths []string, limit, page uint32,
+) (res *ttnpb.EndDevices, total uint64, err error) {
+	if err := rights.RequireApplication(ctx, appIDs, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		return nil, 0, err
+	}
+
+	ctx = store.WithPagination(ctx, limit, page, &total)
+
+	res = &ttnpb.EndDevices{}
+	err = rs.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		ids, err := st.SearchEndDevicesByLocation(ctx, appIDs, query)
+		if err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		ctx = store.WithPagination(ctx, 0, 0, nil) // Reset pagination (already done in EntitySearch.SearchEndDevicesByLocation).
+		res.EndDevices, err = st.FindEndDevices(ctx, ids, paths)
+		return err
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+	return res, total, nil
+}
@@ -0,0 +1,118 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGeoQuery(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name   string
+		BBox   *ttnpb.GeoBoundingBox
+		Radius *ttnpb.GeoRadius
+		Result store.GeoQuery
+		Valid  bool
+	}{
+		{
+			Name: "Empty",
+		},
+		{
+			Name: "BoundingBox",
+			BBox: &ttnpb.GeoBoundingBox{MinLatitude: 50, MinLongitude: 3.5, MaxLatitude: 53.5, MaxLongitude: 7.25},
+			Result: store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 50, MinLongitude: 3.5, MaxLatitude: 53.5, MaxLongitude: 7.25},
+			},
+			Valid: true,
+		},
+		{
+			Name: "BoundingBoxAntimeridian",
+			BBox: &ttnpb.GeoBoundingBox{MinLatitude: -20, MinLongitude: 170, MaxLatitude: -10, MaxLongitude: -170},
+			Result: store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: -20, MinLongitude: 170, MaxLatitude: -10, MaxLongitude: -170},
+			},
+			Valid: true,
+		},
+		{
+			Name: "BoundingBoxInvertedLatitudes",
+			BBox: &ttnpb.GeoBoundingBox{MinLatitude: 53.5, MinLongitude: 3.5, MaxLatitude: 50, MaxLongitude: 7.25},
+		},
+		{
+			Name:   "Radius",
+			Radius: &ttnpb.GeoRadius{Latitude: 52.37, Longitude: 4.89, Radius: 1000},
+			Result: store.GeoQueryAround(52.37, 4.89, 1000),
+			Valid:  true,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			query, err := geoQuery(tc.BBox, tc.Radius)
+			if tc.Valid {
+				a.So(err, should.BeNil)
+				a.So(query, should.Resemble, tc.Result)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestSearchByLocationRequestValidation(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So((&ttnpb.SearchGatewaysByLocationRequest{
+		Area: &ttnpb.SearchGatewaysByLocationRequest_Radius{
+			Radius: &ttnpb.GeoRadius{Latitude: 52.37, Longitude: 4.89, Radius: 1000},
+		},
+	}).ValidateFields(), should.BeNil)
+
+	for _, req := range []*ttnpb.SearchGatewaysByLocationRequest{
+		{},
+		{
+			Area: &ttnpb.SearchGatewaysByLocationRequest_Radius{
+				Radius: &ttnpb.GeoRadius{Latitude: 52.37, Longitude: 4.89, Radius: 2000000},
+			},
+		},
+		{
+			Area: &ttnpb.SearchGatewaysByLocationRequest_Radius{
+				Radius: &ttnpb.GeoRadius{Latitude: 52.37, Longitude: 190, Radius: 1000},
+			},
+		},
+		{
+			Area: &ttnpb.SearchGatewaysByLocationRequest_Bbox{
+				Bbox: &ttnpb.GeoBoundingBox{MinLatitude: 50, MinLongitude: 3.5, MaxLatitude: 95, MaxLongitude: 7.25},
+			},
+		},
+		{
+			Area: &ttnpb.SearchGatewaysByLocationRequest_Bbox{
+				Bbox: &ttnpb.GeoBoundingBox{MaxLatitude: 1},
+			},
+			Limit: 1001,
+		},
+	} {
+		a.So(req.ValidateFields(), should.NotBeNil)
+	}
+}
@@ -0,0 +1,431 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	evtUpdateOrganizationGroup = events.Define(
+		"organization.group.update", "update organization group",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteOrganizationGroup = events.Define(
+		"organization.group.delete", "delete organization group",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtUpdateOrganizationGroupMembers = events.Define(
+		"organization.group.members.update", "update organization group members",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtUpdateOrganizationGroupMemberships = events.Define(
+		"organization.group.memberships.update", "update organization group memberships",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errGroupRight = errors.DefineInvalidArgument(
+		"group_right", "right `{right}` can not be granted on {entity_type} entities",
+	)
+	errGroupEntity = errors.DefineInvalidArgument(
+		"group_entity_type", "groups can not be members of {entity_type} entities",
+	)
+	errGroupNoMember = errors.DefineFailedPrecondition(
+		"group_no_organization_member", "user `{user_id}` is not a member of organization `{organization_id}`",
+	)
+)
+
+func groupFromStore(g *store.Group) *ttnpb.OrganizationGroup {
+	return &ttnpb.OrganizationGroup{
+		GroupId:     g.GroupID,
+		Name:        g.Name,
+		Description: g.Description,
+	}
+}
+
+// validateGroupMembership validates that the rights of the group membership can be granted on the entity.
+func validateGroupMembership(ids *ttnpb.EntityIdentifiers, rights *ttnpb.Rights) error {
+	switch ids.EntityType() {
+	case "application", "client", "gateway":
+	default:
+		return errGroupEntity.WithAttributes("entity_type", ids.EntityType())
+	}
+	potential := allPotentialRights(ids, ttnpb.AllRights)
+	for _, right := range rights.GetRights() {
+		if !potential.IncludesAll(right) {
+			return errGroupRight.WithAttributes(
+				"right", right.String(),
+				"entity_type", ids.EntityType(),
+			)
+		}
+	}
+	return nil
+}
+
+func requireManageGroups(ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers) error {
+	return requireEntityRights(ctx, orgIDs.GetEntityIdentifiers(), ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS)
+}
+
+func (is *IdentityServer) listGroups(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers,
+) ([]*ttnpb.OrganizationGroup, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	var groups []*ttnpb.OrganizationGroup
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		stored, err := st.ListGroups(ctx, orgIDs)
+		if err != nil {
+			return err
+		}
+		groups = make([]*ttnpb.OrganizationGroup, len(stored))
+		for i, group := range stored {
+			groups[i] = groupFromStore(group)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groups, nil
+}
+
+func (is *IdentityServer) getGroup(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string,
+) (*ttnpb.OrganizationGroup, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	var group *store.Group
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		group, err = st.GetGroup(ctx, orgIDs, groupID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return groupFromStore(group), nil
+}
+
+func (is *IdentityServer) setGroup(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, group *ttnpb.OrganizationGroup,
+) (*ttnpb.OrganizationGroup, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	var updated *store.Group
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		updated, err = st.SetGroup(ctx, orgIDs, &store.Group{
+			GroupID:     group.GetGroupId(),
+			Name:        group.GetName(),
+			Description: group.GetDescription(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := groupFromStore(updated)
+	events.Publish(evtUpdateOrganizationGroup.New(ctx, events.WithIdentifiers(orgIDs), events.WithData(res)))
+	return res, nil
+}
+
+// deleteGroup deletes the group. The members of the group lose the rights of the memberships of the group.
+func (is *IdentityServer) deleteGroup(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string,
+) error {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return err
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.DeleteGroup(ctx, orgIDs, groupID)
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(evtDeleteOrganizationGroup.New(
+		ctx, events.WithIdentifiers(orgIDs), events.WithData(&ttnpb.OrganizationGroup{GroupId: groupID}),
+	))
+	return nil
+}
+
+func (is *IdentityServer) listGroupMembers(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string,
+) ([]*ttnpb.UserIdentifiers, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	var members []*ttnpb.UserIdentifiers
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		members, err = st.ListGroupMembers(ctx, orgIDs, groupID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return members, nil
+}
+
+// addGroupMember adds a user to the group. Only members of the organization can be members of its groups.
+func (is *IdentityServer) addGroupMember(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string, usrIDs *ttnpb.UserIdentifiers,
+) error {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return err
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		_, err := st.GetMember(ctx, usrIDs.GetOrganizationOrUserIdentifiers(), orgIDs.GetEntityIdentifiers())
+		if err != nil {
+			if errors.IsNotFound(err) {
+				return errGroupNoMember.WithAttributes(
+					"user_id", usrIDs.GetUserId(),
+					"organization_id", orgIDs.GetOrganizationId(),
+				)
+			}
+			return err
+		}
+		return st.AddGroupMember(ctx, orgIDs, groupID, usrIDs)
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(evtUpdateOrganizationGroupMembers.New(
+		ctx, events.WithIdentifiers(orgIDs, usrIDs), events.WithData(&ttnpb.OrganizationGroup{GroupId: groupID}),
+	))
+	return nil
+}
+
+func (is *IdentityServer) removeGroupMember(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string, usrIDs *ttnpb.UserIdentifiers,
+) error {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return err
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.RemoveGroupMember(ctx, orgIDs, groupID, usrIDs)
+	})
+	if err != nil {
+		return err
+	}
+	events.Publish(evtUpdateOrganizationGroupMembers.New(
+		ctx, events.WithIdentifiers(orgIDs, usrIDs), events.WithData(&ttnpb.OrganizationGroup{GroupId: groupID}),
+	))
+	return nil
+}
+
+func (is *IdentityServer) listGroupMemberships(
+	ctx context.Context, orgIDs *ttnpb.OrganizationIdentifiers, groupID string,
+) ([]*ttnpb.OrganizationGroupMembership, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	var memberships []*ttnpb.OrganizationGroupMembership
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		stored, err := st.ListGroupMemberships(ctx, orgIDs, groupID)
+		if err != nil {
+			return err
+		}
+		memberships = make([]*ttnpb.OrganizationGroupMembership, len(stored))
+		for i, membership := range stored {
+			memberships[i] = &ttnpb.OrganizationGroupMembership{
+				EntityIds: membership.EntityIdentifiers,
+				Rights:    membership.Rights,
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return memberships, nil
+}
+
+// setGroupMembership sets the rights of the group on the entity, or deletes the membership if there are no rights.
+// As with collaborators, the caller needs to be able to manage the collaborators of the entity, and needs to have
+// the rights that are added as well as the rights that are removed, unless the membership is deleted.
+func (is *IdentityServer) setGroupMembership(
+	ctx context.Context,
+	orgIDs *ttnpb.OrganizationIdentifiers,
+	groupID string,
+	ids *ttnpb.EntityIdentifiers,
+	rights *ttnpb.Rights,
+) (*ttnpb.OrganizationGroupMembership, error) {
+	if err := requireManageGroups(ctx, orgIDs); err != nil {
+		return nil, err
+	}
+	if err := validateGroupMembership(ids, rights); err != nil {
+		return nil, err
+	}
+	manage, _, err := collaboratorRights(ids.EntityType())
+	if err != nil {
+		return nil, err
+	}
+	if err := requireEntityRights(ctx, ids, manage); err != nil {
+		return nil, err
+	}
+	rights = rights.Unique()
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		memberships, err := st.ListGroupMemberships(ctx, orgIDs, groupID)
+		if err != nil {
+			return err
+		}
+		existing := &ttnpb.Rights{}
+		for _, membership := range memberships {
+			if membership.EntityIdentifiers.EntityType() == ids.EntityType() &&
+				membership.EntityIdentifiers.IDString() == ids.IDString() {
+				existing = membership.Rights
+			}
+		}
+		// Unless the membership is deleted, require the caller to have the removed rights as well.
+		changed := rights.Implied().Sub(existing.Implied())
+		if len(rights.GetRights()) > 0 {
+			changed = changed.Union(existing.Implied().Sub(rights.Implied()))
+		}
+		if len(changed.GetRights()) > 0 {
+			if err := requireEntityRights(ctx, ids, changed.GetRights()...); err != nil {
+				return err
+			}
+		}
+		return st.SetGroupMembership(ctx, orgIDs, groupID, ids, rights)
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := &ttnpb.OrganizationGroupMembership{
+		EntityIds: ids,
+		Rights:    rights,
+	}
+	events.Publish(evtUpdateOrganizationGroupMemberships.New(
+		ctx, events.WithIdentifiers(orgIDs, ids), events.WithData(res),
+	))
+	return res, nil
+}
+
+type organizationGroupRegistry struct {
+	ttnpb.UnimplementedOrganizationGroupRegistryServer
+
+	*IdentityServer
+}
+
+func (gr *organizationGroupRegistry) List(
+	ctx context.Context, req *ttnpb.OrganizationIdentifiers,
+) (*ttnpb.OrganizationGroups, error) {
+	groups, err := gr.listGroups(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.OrganizationGroups{Groups: groups}, nil
+}
+
+func (gr *organizationGroupRegistry) Get(
+	ctx context.Context, req *ttnpb.OrganizationGroupIdentifiers,
+) (*ttnpb.OrganizationGroup, error) {
+	return gr.getGroup(ctx, req.GetOrganizationIds(), req.GetGroupId())
+}
+
+func (gr *organizationGroupRegistry) Set(
+	ctx context.Context, req *ttnpb.SetOrganizationGroupRequest,
+) (*ttnpb.OrganizationGroup, error) {
+	return gr.setGroup(ctx, req.GetOrganizationIds(), req.GetGroup())
+}
+
+func (gr *organizationGroupRegistry) Delete(
+	ctx context.Context, req *ttnpb.OrganizationGroupIdentifiers,
+) (*emptypb.Empty, error) {
+	if err := gr.deleteGroup(ctx, req.GetOrganizationIds(), req.GetGroupId()); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (gr *organizationGroupRegistry) ListMembers(
+	ctx context.Context, req *ttnpb.OrganizationGroupIdentifiers,
+) (*ttnpb.OrganizationGroupMembers, error) {
+	members, err := gr.listGroupMembers(ctx, req.GetOrganizationIds(), req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.OrganizationGroupMembers{Members: members}, nil
+}
+
+func (gr *organizationGroupRegistry) AddMember(
+	ctx context.Context, req *ttnpb.OrganizationGroupMemberRequest,
+) (*emptypb.Empty, error) {
+	groupIDs := req.GetGroupIds()
+	if err := gr.addGroupMember(
+		ctx, groupIDs.GetOrganizationIds(), groupIDs.GetGroupId(), req.GetUserIds(),
+	); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (gr *organizationGroupRegistry) RemoveMember(
+	ctx context.Context, req *ttnpb.OrganizationGroupMemberRequest,
+) (*emptypb.Empty, error) {
+	groupIDs := req.GetGroupIds()
+	if err := gr.removeGroupMember(
+		ctx, groupIDs.GetOrganizationIds(), groupIDs.GetGroupId(), req.GetUserIds(),
+	); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (gr *organizationGroupRegistry) ListMemberships(
+	ctx context.Context, req *ttnpb.OrganizationGroupIdentifiers,
+) (*ttnpb.OrganizationGroupMemberships, error) {
+	memberships, err := gr.listGroupMemberships(ctx, req.GetOrganizationIds(), req.GetGroupId())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.OrganizationGroupMemberships{Memberships: memberships}, nil
+}
+
+func (gr *organizationGroupRegistry) SetMembership(
+	ctx context.Context, req *ttnpb.SetOrganizationGroupMembershipRequest,
+) (*ttnpb.OrganizationGroupMembership, error) {
+	groupIDs := req.GetGroupIds()
+	return gr.setGroupMembership(
+		ctx, groupIDs.GetOrganizationIds(), groupIDs.GetGroupId(), req.GetEntityIds(), req.GetRights(),
+	)
+}
+
+func (gr *organizationGroupRegistry) DeleteMembership(
+	ctx context.Context, req *ttnpb.DeleteOrganizationGroupMembershipRequest,
+) (*emptypb.Empty, error) {
+	groupIDs := req.GetGroupIds()
+	if _, err := gr.setGroupMembership(
+		ctx, groupIDs.GetOrganizationIds(), groupIDs.GetGroupId(), req.GetEntityIds(), nil,
+	); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
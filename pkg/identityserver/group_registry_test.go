@@ -0,0 +1,106 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"strings"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestOrganizationGroupValidation(t *testing.T) {
+	t.Parallel()
+
+	orgIDs := &ttnpb.OrganizationIdentifiers{OrganizationId: "foo-org"}
+	for _, tc := range []struct {
+		Name  string
+		Group *ttnpb.OrganizationGroup
+		Valid bool
+	}{
+		{
+			Name:  "Valid",
+			Group: &ttnpb.OrganizationGroup{GroupId: "field-technicians", Name: "Field Technicians"},
+			Valid: true,
+		},
+		{
+			Name:  "InvalidID",
+			Group: &ttnpb.OrganizationGroup{GroupId: "Field Technicians"},
+		},
+		{
+			Name:  "LongID",
+			Group: &ttnpb.OrganizationGroup{GroupId: strings.Repeat("a", 37)},
+		},
+		{
+			Name:  "LongName",
+			Group: &ttnpb.OrganizationGroup{GroupId: "field-technicians", Name: strings.Repeat("a", 51)},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			err := (&ttnpb.SetOrganizationGroupRequest{OrganizationIds: orgIDs, Group: tc.Group}).ValidateFields()
+			a.So(err == nil, should.Equal, tc.Valid)
+		})
+	}
+}
+
+func TestValidateGroupMembership(t *testing.T) {
+	t.Parallel()
+
+	appIDs := (&ttnpb.ApplicationIdentifiers{ApplicationId: "foo-app"}).GetEntityIdentifiers()
+	for _, tc := range []struct {
+		Name   string
+		IDs    *ttnpb.EntityIdentifiers
+		Rights *ttnpb.Rights
+		Assert func(error) bool
+	}{
+		{
+			Name:   "Valid",
+			IDs:    appIDs,
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE),
+			Assert: func(err error) bool { return err == nil },
+		},
+		{
+			Name:   "ValidAll",
+			IDs:    (&ttnpb.GatewayIdentifiers{GatewayId: "foo-gtw"}).GetEntityIdentifiers(),
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_ALL),
+			Assert: func(err error) bool { return err == nil },
+		},
+		{
+			Name:   "OtherEntityRight",
+			IDs:    appIDs,
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_INFO),
+			Assert: errors.IsInvalidArgument,
+		},
+		{
+			Name:   "UnsupportedEntityType",
+			IDs:    (&ttnpb.OrganizationIdentifiers{OrganizationId: "foo-org"}).GetEntityIdentifiers(),
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_ORGANIZATION_INFO),
+			Assert: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			a.So(tc.Assert(validateGroupMembership(tc.IDs, tc.Rights)), should.BeTrue)
+		})
+	}
+}
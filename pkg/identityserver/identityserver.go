@@ -38,9 +38,11 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpctracer"
 	telemetry "go.thethings.network/lorawan-stack/v3/pkg/telemetry/exporter"
 	"go.thethings.network/lorawan-stack/v3/pkg/telemetry/tracing/tracer"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/webui"
 	"google.golang.org/grpc"
+	"gopkg.in/square/go-jose.v2"
 )
 
 // IdentityServer implements the Identity Server component.
@@ -61,7 +63,16 @@ type IdentityServer struct {
 	account account.Server
 	oauth   oauth.Server
 
+	// signingKeys sign the JSON Web Tokens that are issued for API keys.
+	signingKeys []jose.JSONWebKey
+
 	telemetryQueue telemetry.TaskQueue
+
+	branding brandingCache
+
+	tenantConfig TenantConfigFunc
+
+	passwordResetVerifiers map[string]PasswordResetVerifier
 }
 
 // Context returns the context of the Identity Server.
@@ -74,6 +85,20 @@ func (is *IdentityServer) SetRedisCache(redis *redis.Client) {
 	is.redis = redis
 }
 
+// TenantConfigFunc returns the configuration of the tenant, or nil to use the configuration of
+// the Identity Server.
+type TenantConfigFunc func(ctx context.Context, tenantID string) *Config
+
+// SetTenantConfigFunc configures the given function for the configuration of tenants.
+func (is *IdentityServer) SetTenantConfigFunc(f TenantConfigFunc) {
+	is.tenantConfig = f
+}
+
+// SetLoginRiskEvaluator configures the given evaluator of the risk of logins to the Account app.
+func (is *IdentityServer) SetLoginRiskEvaluator(evaluator account.LoginRiskEvaluator) {
+	is.account.SetLoginRiskEvaluator(evaluator)
+}
+
 type ctxKeyType struct{}
 
 var ctxKey ctxKeyType
@@ -82,6 +107,11 @@ func (is *IdentityServer) configFromContext(ctx context.Context) *Config {
 	if config, ok := ctx.Value(ctxKey).(*Config); ok {
 		return config
 	}
+	if is.tenantConfig != nil {
+		if config := is.tenantConfig(ctx, tenant.IDFromContext(ctx)); config != nil {
+			return config
+		}
+	}
 	return is.config
 }
 
@@ -150,6 +180,10 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		telemetryQueue: config.TelemetryQueue,
 	}
 
+	if err := config.validateChatWebhooks(); err != nil {
+		return nil, err
+	}
+
 	if err := is.setupStore(); err != nil {
 		return nil, err
 	}
@@ -161,6 +195,13 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 		return nil, err
 	}
 
+	if is.config.OAuth.OpenIDConnect.Enabled() {
+		is.signingKeys, err = oauth.LoadSigningKeys(is.config.OAuth.OpenIDConnect.SigningKeyFiles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	is.account, err = account.NewServer(c, &accountAppStore{is.store}, is.config.OAuth, GenerateCSPString)
 	if err != nil {
 		return nil, err
@@ -177,6 +218,12 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 	if err := is.initializeTelemetryTasks(is.Context()); err != nil {
 		return nil, err
 	}
+	if err := is.initializeEndDeviceInactivityTask(is.Context()); err != nil {
+		return nil, err
+	}
+	if err := is.initializeNotificationDigestTask(is.Context()); err != nil {
+		return nil, err
+	}
 
 	for _, hook := range []struct {
 		name       string
@@ -193,12 +240,16 @@ func New(c *component.Component, config *Config) (is *IdentityServer, err error)
 			"/ttn.lorawan.v3.ApplicationAccess",
 			"/ttn.lorawan.v3.ClientRegistry",
 			"/ttn.lorawan.v3.ClientAccess",
+			"/ttn.lorawan.v3.DeniedRightsRegistry",
 			"/ttn.lorawan.v3.EndDeviceRegistry",
 			"/ttn.lorawan.v3.EndDeviceBatchRegistry",
 			"/ttn.lorawan.v3.GatewayRegistry",
 			"/ttn.lorawan.v3.GatewayAccess",
+			"/ttn.lorawan.v3.LabelRegistry",
 			"/ttn.lorawan.v3.OrganizationRegistry",
 			"/ttn.lorawan.v3.OrganizationAccess",
+			"/ttn.lorawan.v3.OrganizationGroupRegistry",
+			"/ttn.lorawan.v3.RoleRegistry",
 			"/ttn.lorawan.v3.UserRegistry",
 			"/ttn.lorawan.v3.UserAccess",
 			"/ttn.lorawan.v3.UserSessionRegistry",
@@ -241,11 +292,15 @@ func (is *IdentityServer) RegisterServices(s *grpc.Server) {
 	ttnpb.RegisterApplicationAccessServer(s, &applicationAccess{IdentityServer: is})
 	ttnpb.RegisterClientRegistryServer(s, &clientRegistry{IdentityServer: is})
 	ttnpb.RegisterClientAccessServer(s, &clientAccess{IdentityServer: is})
+	ttnpb.RegisterDeniedRightsRegistryServer(s, &deniedRightsRegistry{IdentityServer: is})
 	ttnpb.RegisterEndDeviceRegistryServer(s, &endDeviceRegistry{IdentityServer: is})
 	ttnpb.RegisterGatewayRegistryServer(s, &gatewayRegistry{IdentityServer: is})
 	ttnpb.RegisterGatewayAccessServer(s, &gatewayAccess{IdentityServer: is})
+	ttnpb.RegisterLabelRegistryServer(s, &labelRegistry{IdentityServer: is})
 	ttnpb.RegisterOrganizationRegistryServer(s, &organizationRegistry{IdentityServer: is})
 	ttnpb.RegisterOrganizationAccessServer(s, &organizationAccess{IdentityServer: is})
+	ttnpb.RegisterOrganizationGroupRegistryServer(s, &organizationGroupRegistry{IdentityServer: is})
+	ttnpb.RegisterRoleRegistryServer(s, &roleRegistry{IdentityServer: is})
 	ttnpb.RegisterUserRegistryServer(s, &userRegistry{IdentityServer: is})
 	ttnpb.RegisterUserAccessServer(s, &userAccess{IdentityServer: is})
 	ttnpb.RegisterUserSessionRegistryServer(s, &userSessionRegistry{IdentityServer: is})
@@ -266,11 +321,15 @@ func (is *IdentityServer) RegisterHandlers(s *runtime.ServeMux, conn *grpc.Clien
 	ttnpb.RegisterApplicationAccessHandler(is.Context(), s, conn)
 	ttnpb.RegisterClientRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterClientAccessHandler(is.Context(), s, conn)
+	ttnpb.RegisterDeniedRightsRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterEndDeviceRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterGatewayRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterGatewayAccessHandler(is.Context(), s, conn)
+	ttnpb.RegisterLabelRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterOrganizationRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterOrganizationAccessHandler(is.Context(), s, conn)
+	ttnpb.RegisterOrganizationGroupRegistryHandler(is.Context(), s, conn)
+	ttnpb.RegisterRoleRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserRegistryHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserAccessHandler(is.Context(), s, conn)
 	ttnpb.RegisterUserSessionRegistryHandler(is.Context(), s, conn)
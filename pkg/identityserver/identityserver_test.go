@@ -31,8 +31,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
 	"google.golang.org/grpc"
 )
 
@@ -209,3 +211,21 @@ func testWithIdentityServer(t *testing.T, f func(*IdentityServer, *grpc.ClientCo
 		}
 	}
 }
+
+func TestTenantConfig(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	defaultConfig, otherConfig := &Config{}, &Config{}
+	is := &IdentityServer{config: defaultConfig}
+	a.So(is.configFromContext(ctx), should.PointTo, defaultConfig)
+
+	is.SetTenantConfigFunc(func(_ context.Context, tenantID string) *Config {
+		if tenantID == "other" {
+			return otherConfig
+		}
+		return nil
+	})
+	a.So(is.configFromContext(ctx), should.PointTo, defaultConfig)
+	a.So(is.configFromContext(tenant.NewContextWithID(ctx, "other")), should.PointTo, otherConfig)
+}
@@ -0,0 +1,282 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"regexp"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+const (
+	maxLabels               = 32
+	defaultLabelSearchLimit = 100
+)
+
+var (
+	// labelKeyRegex matches label keys of up to 63 lowercase letters, digits, dashes, underscores and dots
+	// that start and end with a letter or digit.
+	labelKeyRegex = regexp.MustCompile(`^[a-z0-9]([a-z0-9._-]{0,61}[a-z0-9])?$`)
+	// labelValueRegex matches label values of up to 63 letters, digits, dashes, underscores and dots
+	// that start and end with a letter or digit.
+	labelValueRegex = regexp.MustCompile(`^[A-Za-z0-9]([A-Za-z0-9._-]{0,61}[A-Za-z0-9])?$`)
+)
+
+var (
+	errLabelKey        = errors.DefineInvalidArgument("label_key", "invalid label key `{key}`")
+	errLabelValue      = errors.DefineInvalidArgument("label_value", "invalid value `{value}` of label `{key}`")
+	errTooManyLabels   = errors.DefineInvalidArgument("too_many_labels", "more than {max} labels")
+	errLabelSelector   = errors.DefineInvalidArgument("label_selector", "invalid label selector `{selector}`")
+	errNoLabelSelector = errors.DefineInvalidArgument("no_label_selector", "no label selector")
+)
+
+// validateLabels validates the keys and values of the labels.
+func validateLabels(labels map[string]string) error {
+	if len(labels) > maxLabels {
+		return errTooManyLabels.WithAttributes("max", maxLabels)
+	}
+	for k, v := range labels {
+		if !labelKeyRegex.MatchString(k) {
+			return errLabelKey.WithAttributes("key", k)
+		}
+		if !labelValueRegex.MatchString(v) {
+			return errLabelValue.WithAttributes("key", k, "value", v)
+		}
+	}
+	return nil
+}
+
+// parseLabelSelector parses a comma separated label selector, such as `site=plant-7,floor=2`.
+// Entities match the selector if they have all the labels.
+func parseLabelSelector(s string) (map[string]string, error) {
+	if s == "" {
+		return nil, errNoLabelSelector.New()
+	}
+	labels := make(map[string]string)
+	for _, part := range strings.Split(s, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(part), "=")
+		if !ok {
+			return nil, errLabelSelector.WithAttributes("selector", s)
+		}
+		labels[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+	if err := validateLabels(labels); err != nil {
+		return nil, errLabelSelector.WithAttributes("selector", s).WithCause(err)
+	}
+	return labels, nil
+}
+
+// labelEntityTypes are the entity types that can be searched by label, keyed by their name in the API.
+var labelEntityTypes = map[string]string{
+	"applications":  store.EntityApplication,
+	"clients":       store.EntityClient,
+	"end_devices":   "end_device",
+	"gateways":      store.EntityGateway,
+	"organizations": store.EntityOrganization,
+	"users":         store.EntityUser,
+}
+
+// parseLabelEntityTypes parses the comma separated entity types to search. All entity types are searched
+// if none are given, except users if the caller is not an admin.
+func parseLabelEntityTypes(names []string, isAdmin bool) ([]string, error) {
+	if len(names) == 0 {
+		entityTypes := make([]string, 0, len(labelEntityTypes))
+		for _, entityType := range labelEntityTypes {
+			if entityType == store.EntityUser && !isAdmin {
+				continue
+			}
+			entityTypes = append(entityTypes, entityType)
+		}
+		sort.Strings(entityTypes)
+		return entityTypes, nil
+	}
+	var entityTypes []string
+	for _, name := range names {
+		entityType, ok := labelEntityTypes[name]
+		if !ok {
+			return nil, errEntityType.WithAttributes("entity_type", name)
+		}
+		if entityType == store.EntityUser && !isAdmin {
+			return nil, errSearchForbidden.New()
+		}
+		entityTypes = append(entityTypes, entityType)
+	}
+	return entityTypes, nil
+}
+
+// labelRights returns the rights to read and to write the labels of the entity.
+func labelRights(ids *ttnpb.EntityIdentifiers) (read, write ttnpb.Right, err error) {
+	switch ids.EntityType() {
+	case "application":
+		return ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC, nil
+	case "client":
+		return ttnpb.Right_RIGHT_CLIENT_INFO, ttnpb.Right_RIGHT_CLIENT_SETTINGS_BASIC, nil
+	case "end device":
+		return ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE, nil
+	case "gateway":
+		return ttnpb.Right_RIGHT_GATEWAY_INFO, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC, nil
+	case "organization":
+		return ttnpb.Right_RIGHT_ORGANIZATION_INFO, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_BASIC, nil
+	case "user":
+		return ttnpb.Right_RIGHT_USER_INFO, ttnpb.Right_RIGHT_USER_SETTINGS_BASIC, nil
+	default:
+		return 0, 0, errEntityType.WithAttributes("entity_type", ids.EntityType())
+	}
+}
+
+// requireLabelRights requires the caller to have the right on the entity. The rights on end devices are
+// the rights on the application of the end device.
+func requireLabelRights(ctx context.Context, ids *ttnpb.EntityIdentifiers, right ttnpb.Right) error {
+	if devIDs := ids.GetDeviceIds(); devIDs != nil {
+		return rights.RequireApplication(ctx, devIDs.GetApplicationIds(), right)
+	}
+	return requireEntityRights(ctx, ids, right)
+}
+
+func (is *IdentityServer) getLabels(ctx context.Context, ids *ttnpb.EntityIdentifiers) (*ttnpb.Labels, error) {
+	read, _, err := labelRights(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireLabelRights(ctx, ids, read); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.Labels{}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		res.Labels, err = st.GetLabels(ctx, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func (is *IdentityServer) setLabels(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, labels map[string]string,
+) (*ttnpb.Labels, error) {
+	_, write, err := labelRights(ids)
+	if err != nil {
+		return nil, err
+	}
+	if err := requireLabelRights(ctx, ids, write); err != nil {
+		return nil, err
+	}
+	if err := validateLabels(labels); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.Labels{}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		res.Labels, err = st.SetLabels(ctx, ids, labels)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// SearchEntitiesByLabels returns the entities of the given types that have all the given labels and that the
+// caller is a member of. End devices are returned if the caller is a member of their application.
+// Admins search all entities.
+func (rs *registrySearch) SearchEntitiesByLabels(
+	ctx context.Context, entityTypes []string, labels map[string]string, limit, page uint32,
+) (res *ttnpb.LabeledEntities, total uint64, err error) {
+	authInfo, err := rs.authInfo(ctx)
+	if err != nil {
+		return nil, 0, err
+	}
+	member := authInfo.GetOrganizationOrUserIdentifiers()
+	if member == nil {
+		return nil, 0, errSearchForbidden.New()
+	}
+	if authInfo.IsAdmin {
+		member = nil
+	}
+
+	var ids []*ttnpb.EntityIdentifiers
+	err = rs.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		for _, entityType := range entityTypes {
+			entityIDs, err := st.SearchEntitiesByLabels(ctx, member, entityType, labels)
+			if err != nil {
+				return err
+			}
+			ids = append(ids, entityIDs...)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	total = uint64(len(ids))
+	start := uint64(limit) * uint64(page-1)
+	if start > total {
+		start = total
+	}
+	end := start + uint64(limit)
+	if end > total {
+		end = total
+	}
+	return &ttnpb.LabeledEntities{Entities: ids[start:end]}, total, nil
+}
+
+type labelRegistry struct {
+	ttnpb.UnimplementedLabelRegistryServer
+
+	*IdentityServer
+}
+
+func (lr *labelRegistry) Get(ctx context.Context, req *ttnpb.GetLabelsRequest) (*ttnpb.Labels, error) {
+	return lr.getLabels(ctx, req.GetEntityIds())
+}
+
+func (lr *labelRegistry) Set(ctx context.Context, req *ttnpb.SetLabelsRequest) (*ttnpb.Labels, error) {
+	return lr.setLabels(ctx, req.GetEntityIds(), req.GetLabels())
+}
+
+func (lr *labelRegistry) Search(
+	ctx context.Context, req *ttnpb.SearchEntitiesByLabelsRequest,
+) (*ttnpb.LabeledEntities, error) {
+	labels, err := parseLabelSelector(req.GetSelector())
+	if err != nil {
+		return nil, err
+	}
+	entityTypes, err := parseLabelEntityTypes(req.GetEntityTypes(), lr.IsAdmin(ctx))
+	if err != nil {
+		return nil, err
+	}
+	limit, page := req.GetLimit(), req.GetPage()
+	if limit == 0 {
+		limit = defaultLabelSearchLimit
+	}
+	if page == 0 {
+		page = 1
+	}
+	res, total, err := (&registrySearch{IdentityServer: lr.IdentityServer}).SearchEntitiesByLabels(
+		ctx, entityTypes, labels, limit, page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	setTotalHeader(ctx, total)
+	return res, nil
+}
@@ -0,0 +1,93 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestValidateLabels(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	a.So(validateLabels(nil), should.BeNil)
+	a.So(validateLabels(map[string]string{
+		"site":            "plant-7",
+		"example.com_env": "Production",
+		"x":               "1",
+	}), should.BeNil)
+
+	for _, labels := range []map[string]string{
+		{"": "plant-7"},
+		{"Site": "plant-7"},
+		{"-site": "plant-7"},
+		{"site": ""},
+		{"site": "plant 7"},
+		{"site": "plant-7-"},
+		{strings.Repeat("a", 64): "plant-7"},
+		{"site": strings.Repeat("a", 64)},
+	} {
+		a.So(errors.IsInvalidArgument(validateLabels(labels)), should.BeTrue)
+	}
+
+	tooMany := make(map[string]string)
+	for i := 0; i <= maxLabels; i++ {
+		tooMany[fmt.Sprintf("key-%d", i)] = "value"
+	}
+	a.So(errors.IsInvalidArgument(validateLabels(tooMany)), should.BeTrue)
+}
+
+func TestParseLabelSelector(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	labels, err := parseLabelSelector("site=plant-7, floor=2")
+	a.So(err, should.BeNil)
+	a.So(labels, should.Resemble, map[string]string{"site": "plant-7", "floor": "2"})
+
+	for _, selector := range []string{"", "site", "site=", "Site=plant-7", "site=plant-7,"} {
+		_, err := parseLabelSelector(selector)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	}
+}
+
+func TestParseLabelEntityTypes(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	entityTypes, err := parseLabelEntityTypes(nil, false)
+	a.So(err, should.BeNil)
+	a.So(entityTypes, should.Resemble, []string{"application", "client", "end_device", "gateway", "organization"})
+
+	entityTypes, err = parseLabelEntityTypes(nil, true)
+	a.So(err, should.BeNil)
+	a.So(entityTypes, should.Contain, "user")
+
+	entityTypes, err = parseLabelEntityTypes([]string{"end_devices", "gateways"}, false)
+	a.So(err, should.BeNil)
+	a.So(entityTypes, should.Resemble, []string{"end_device", "gateway"})
+
+	_, err = parseLabelEntityTypes([]string{"users"}, false)
+	a.So(errors.IsPermissionDenied(err), should.BeTrue)
+
+	_, err = parseLabelEntityTypes([]string{"devices"}, true)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
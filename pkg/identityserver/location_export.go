@@ -0,0 +1,377 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"sort"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/genproto/googleapis/api/httpbody"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+)
+
+// defaultLocationExportLimit is the number of exported entities per page if the request does not set a limit.
+const defaultLocationExportLimit = 1000
+
+var errLocationExportField = errors.DefineInvalidArgument(
+	"location_export_field", "field `{field}` can not be exported",
+)
+
+const (
+	exportGatewayLocationsMethod   = "/ttn.lorawan.v3.EntityRegistrySearch/ExportGatewayLocations"
+	exportEndDeviceLocationsMethod = "/ttn.lorawan.v3.EndDeviceRegistrySearch/ExportEndDeviceLocations"
+)
+
+var (
+	// gatewayLocationExportFields are the gateway fields that can be exported as properties.
+	gatewayLocationExportFields = ttnpb.RPCFieldMaskPaths[exportGatewayLocationsMethod].Allowed
+	// endDeviceLocationExportFields are the end device fields that can be exported as properties.
+	endDeviceLocationExportFields = ttnpb.RPCFieldMaskPaths[exportEndDeviceLocationsMethod].Allowed
+)
+
+// worldGeoQuery is the geo query that matches all locations.
+var worldGeoQuery = store.GeoQuery{
+	BoundingBox: store.BoundingBox{MinLatitude: -90, MinLongitude: -180, MaxLatitude: 90, MaxLongitude: 180},
+}
+
+// locationExportPaths returns the paths of the field mask that are exported as properties.
+// The name is exported if no field mask is given.
+func locationExportPaths(fieldMask *fieldmaskpb.FieldMask, allowed []string) ([]string, error) {
+	paths := fieldMask.GetPaths()
+	if len(paths) == 0 {
+		return []string{"name"}, nil
+	}
+	for _, path := range paths {
+		if !ttnpb.ContainsField(path, allowed) {
+			return nil, errLocationExportField.WithAttributes("field", path)
+		}
+	}
+	return paths, nil
+}
+
+// storePaths returns the paths to retrieve from the store for the exported paths.
+func storePaths(paths []string, add ...string) []string {
+	out := append([]string(nil), add...)
+	for _, path := range paths {
+		if strings.HasPrefix(path, "ids.") {
+			continue
+		}
+		out = append(out, path)
+	}
+	return out
+}
+
+// locationExportProperties returns the values of the paths of the message as feature properties.
+// The properties are keyed by path and hold the JSON representation of the field.
+func locationExportProperties(msg interface{}, paths []string) (map[string]interface{}, error) {
+	b, err := jsonpb.TTN().Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	var fields map[string]interface{}
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	properties := make(map[string]interface{}, len(paths))
+	for _, path := range paths {
+		var value interface{} = fields
+		for _, name := range strings.Split(path, ".") {
+			m, ok := value.(map[string]interface{})
+			if !ok {
+				value = nil
+				break
+			}
+			value = m[name]
+		}
+		if value != nil {
+			properties[path] = value
+		}
+	}
+	return properties, nil
+}
+
+// locationFeature is a located entity that is exported as GeoJSON feature or KML placemark.
+type locationFeature struct {
+	Name       string
+	Location   *ttnpb.Location
+	Properties map[string]interface{}
+}
+
+func gatewayLocationFeatures(gtws []*ttnpb.Gateway, paths []string) ([]locationFeature, error) {
+	var features []locationFeature
+	for _, gtw := range gtws {
+		properties, err := locationExportProperties(gtw, paths)
+		if err != nil {
+			return nil, err
+		}
+		for i, antenna := range gtw.GetAntennas() {
+			if antenna.GetLocation() == nil {
+				continue
+			}
+			featureProperties := map[string]interface{}{
+				"gateway_id":    gtw.GetIds().GetGatewayId(),
+				"antenna_index": i,
+			}
+			for k, v := range properties {
+				featureProperties[k] = v
+			}
+			features = append(features, locationFeature{
+				Name:       gtw.GetIds().GetGatewayId(),
+				Location:   antenna.GetLocation(),
+				Properties: featureProperties,
+			})
+		}
+	}
+	return features, nil
+}
+
+func endDeviceLocationFeatures(devs []*ttnpb.EndDevice, paths []string) ([]locationFeature, error) {
+	var features []locationFeature
+	for _, dev := range devs {
+		properties, err := locationExportProperties(dev, paths)
+		if err != nil {
+			return nil, err
+		}
+		keys := make([]string, 0, len(dev.GetLocations()))
+		for key := range dev.GetLocations() {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			featureProperties := map[string]interface{}{
+				"application_id": dev.GetIds().GetApplicationIds().GetApplicationId(),
+				"device_id":      dev.GetIds().GetDeviceId(),
+				"location":       key,
+				"source":         dev.GetLocations()[key].GetSource().String(),
+			}
+			for k, v := range properties {
+				featureProperties[k] = v
+			}
+			features = append(features, locationFeature{
+				Name:       dev.GetIds().GetDeviceId(),
+				Location:   dev.GetLocations()[key],
+				Properties: featureProperties,
+			})
+		}
+	}
+	return features, nil
+}
+
+type geoJSONGeometry struct {
+	Type        string    `json:"type"`
+	Coordinates []float64 `json:"coordinates"`
+}
+
+type geoJSONFeature struct {
+	Type       string                 `json:"type"`
+	Geometry   geoJSONGeometry        `json:"geometry"`
+	Properties map[string]interface{} `json:"properties"`
+}
+
+type geoJSONFeatureCollection struct {
+	Type     string           `json:"type"`
+	Features []geoJSONFeature `json:"features"`
+}
+
+// marshalGeoJSON returns the features as GeoJSON feature collection (RFC 7946).
+func marshalGeoJSON(features []locationFeature) ([]byte, error) {
+	collection := geoJSONFeatureCollection{
+		Type:     "FeatureCollection",
+		Features: make([]geoJSONFeature, 0, len(features)),
+	}
+	for _, feature := range features {
+		coordinates := []float64{feature.Location.Longitude, feature.Location.Latitude}
+		if feature.Location.Altitude != 0 {
+			coordinates = append(coordinates, float64(feature.Location.Altitude))
+		}
+		collection.Features = append(collection.Features, geoJSONFeature{
+			Type: "Feature",
+			Geometry: geoJSONGeometry{
+				Type:        "Point",
+				Coordinates: coordinates,
+			},
+			Properties: feature.Properties,
+		})
+	}
+	return json.Marshal(collection)
+}
+
+type kmlData struct {
+	Name  string `xml:"name,attr"`
+	Value string `xml:"value"`
+}
+
+type kmlPlacemark struct {
+	Name         string    `xml:"name"`
+	ExtendedData []kmlData `xml:"ExtendedData>Data"`
+	Coordinates  string    `xml:"Point>coordinates"`
+}
+
+type kmlDocument struct {
+	XMLName    xml.Name       `xml:"http://www.opengis.net/kml/2.2 kml"`
+	Name       string         `xml:"Document>name"`
+	Placemarks []kmlPlacemark `xml:"Document>Placemark"`
+}
+
+// marshalKML returns the features as KML document. Property values that are not strings are
+// encoded as JSON.
+func marshalKML(name string, features []locationFeature) ([]byte, error) {
+	doc := kmlDocument{
+		Name:       name,
+		Placemarks: make([]kmlPlacemark, 0, len(features)),
+	}
+	for _, feature := range features {
+		keys := make([]string, 0, len(feature.Properties))
+		for key := range feature.Properties {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		data := make([]kmlData, 0, len(keys))
+		for _, key := range keys {
+			value, ok := feature.Properties[key].(string)
+			if !ok {
+				b, err := json.Marshal(feature.Properties[key])
+				if err != nil {
+					return nil, err
+				}
+				value = string(b)
+			}
+			data = append(data, kmlData{Name: key, Value: value})
+		}
+		doc.Placemarks = append(doc.Placemarks, kmlPlacemark{
+			Name:         feature.Name,
+			ExtendedData: data,
+			Coordinates: fmt.Sprintf(
+				"%v,%v,%v", feature.Location.Longitude, feature.Location.Latitude, feature.Location.Altitude,
+			),
+		})
+	}
+	b, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), b...), nil
+}
+
+// locationExportQuery returns the geo query of a location export.
+// All locations are exported if no bounding box or radius is given.
+func locationExportQuery(bbox *ttnpb.GeoBoundingBox, radius *ttnpb.GeoRadius) (store.GeoQuery, error) {
+	if bbox == nil && radius == nil {
+		return worldGeoQuery, nil
+	}
+	return geoQuery(bbox, radius)
+}
+
+func locationExportLimit(limit uint32) uint32 {
+	if limit == 0 {
+		return defaultLocationExportLimit
+	}
+	return limit
+}
+
+// ExportGatewayLocations exports the antenna locations of the gateways in the area of the request
+// as GeoJSON or KML.
+func (rs *registrySearch) ExportGatewayLocations(
+	ctx context.Context, req *ttnpb.ExportGatewayLocationsRequest,
+) (*httpbody.HttpBody, error) {
+	paths, err := locationExportPaths(req.FieldMask, gatewayLocationExportFields)
+	if err != nil {
+		return nil, err
+	}
+	query, err := locationExportQuery(req.GetBbox(), req.GetRadius())
+	if err != nil {
+		return nil, err
+	}
+	res, total, err := rs.searchGatewaysByLocation(
+		ctx, query, storePaths(paths, "ids", "antennas", "location_public", "status_public"),
+		locationExportLimit(req.Limit), req.Page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	features, err := gatewayLocationFeatures(res.Gateways, paths)
+	if err != nil {
+		return nil, err
+	}
+	return locationExport(ctx, "gateways", req.Format, features, total)
+}
+
+// ExportEndDeviceLocations exports the locations of the end devices of the application in the area of the request
+// as GeoJSON or KML.
+func (rs *registrySearch) ExportEndDeviceLocations(
+	ctx context.Context, req *ttnpb.ExportEndDeviceLocationsRequest,
+) (*httpbody.HttpBody, error) {
+	paths, err := locationExportPaths(req.FieldMask, endDeviceLocationExportFields)
+	if err != nil {
+		return nil, err
+	}
+	query, err := locationExportQuery(req.GetBbox(), req.GetRadius())
+	if err != nil {
+		return nil, err
+	}
+	res, total, err := rs.searchEndDevicesByLocation(
+		ctx, req.ApplicationIds, query, storePaths(paths, "ids", "locations"),
+		locationExportLimit(req.Limit), req.Page,
+	)
+	if err != nil {
+		return nil, err
+	}
+	features, err := endDeviceLocationFeatures(res.EndDevices, paths)
+	if err != nil {
+		return nil, err
+	}
+	return locationExport(ctx, req.ApplicationIds.GetApplicationId()+"-devices", req.Format, features, total)
+}
+
+// locationExport returns the features in the format as attachment with the name.
+func locationExport(
+	ctx context.Context, name string, format ttnpb.LocationExportFormat, features []locationFeature, total uint64,
+) (*httpbody.HttpBody, error) {
+	var (
+		b           []byte
+		err         error
+		contentType string
+		extension   string
+	)
+	switch format {
+	case ttnpb.LocationExportFormat_LOCATION_EXPORT_KML:
+		b, err = marshalKML(name, features)
+		contentType, extension = "application/vnd.google-earth.kml+xml", "kml"
+	default:
+		b, err = marshalGeoJSON(features)
+		contentType, extension = "application/geo+json", "geojson"
+	}
+	if err != nil {
+		return nil, err
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(
+		"content-disposition", fmt.Sprintf("attachment; filename=%q", name+"."+extension),
+	))
+	setTotalHeader(ctx, total)
+	return &httpbody.HttpBody{
+		ContentType: contentType,
+		Data:        b,
+	}, nil
+}
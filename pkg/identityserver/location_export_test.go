@@ -0,0 +1,162 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLocationExportPaths(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	paths, err := locationExportPaths(nil, gatewayLocationExportFields)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{"name"})
+
+	paths, err = locationExportPaths(ttnpb.FieldMask("name", "ids.eui", "attributes"), gatewayLocationExportFields)
+	a.So(err, should.BeNil)
+	a.So(paths, should.Resemble, []string{"name", "ids.eui", "attributes"})
+
+	_, err = locationExportPaths(ttnpb.FieldMask("name", "lbs_lns_secret"), gatewayLocationExportFields)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+
+	query, err := locationExportQuery(nil, nil)
+	a.So(err, should.BeNil)
+	a.So(query, should.Resemble, worldGeoQuery)
+
+	a.So(
+		storePaths([]string{"name", "ids.dev_eui"}, "ids", "locations"),
+		should.Resemble,
+		[]string{"ids", "locations", "name"},
+	)
+}
+
+func TestGatewayLocationExport(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	gtws := []*ttnpb.Gateway{
+		{
+			Ids: &ttnpb.GatewayIdentifiers{
+				GatewayId: "gtw-1",
+				Eui:       types.EUI64{0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1, 0x1}.Bytes(),
+			},
+			Name:       "Gateway 1",
+			Attributes: map[string]string{"site": "roof"},
+			Antennas: []*ttnpb.GatewayAntenna{
+				{Location: &ttnpb.Location{Latitude: 52.37, Longitude: 4.89, Altitude: 12}},
+				{},
+			},
+		},
+		{
+			Ids:      &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"},
+			Antennas: []*ttnpb.GatewayAntenna{{}},
+		},
+	}
+	features, err := gatewayLocationFeatures(gtws, []string{"name", "ids.eui", "attributes", "description"})
+	if !a.So(err, should.BeNil) || !a.So(features, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(features[0].Name, should.Equal, "gtw-1")
+	a.So(features[0].Properties, should.Resemble, map[string]interface{}{
+		"gateway_id":    "gtw-1",
+		"antenna_index": 0,
+		"name":          "Gateway 1",
+		"ids.eui":       "0101010101010101",
+		"attributes":    map[string]interface{}{"site": "roof"},
+	})
+
+	b, err := marshalGeoJSON(features)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	var collection map[string]interface{}
+	if !a.So(json.Unmarshal(b, &collection), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(collection["type"], should.Equal, "FeatureCollection")
+	a.So(collection["features"], should.Resemble, []interface{}{
+		map[string]interface{}{
+			"type": "Feature",
+			"geometry": map[string]interface{}{
+				"type":        "Point",
+				"coordinates": []interface{}{4.89, 52.37, 12.0},
+			},
+			"properties": map[string]interface{}{
+				"gateway_id":    "gtw-1",
+				"antenna_index": 0.0,
+				"name":          "Gateway 1",
+				"ids.eui":       "0101010101010101",
+				"attributes":    map[string]interface{}{"site": "roof"},
+			},
+		},
+	})
+
+	b, err = marshalKML("gateways", features)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	kml := string(b)
+	a.So(kml, should.StartWith, `<?xml version="1.0" encoding="UTF-8"?>`)
+	a.So(kml, should.ContainSubstring, `<kml xmlns="http://www.opengis.net/kml/2.2">`)
+	a.So(kml, should.ContainSubstring, `<name>gtw-1</name>`)
+	a.So(kml, should.ContainSubstring, `<Data name="attributes">`)
+	a.So(kml, should.ContainSubstring, `<value>{&#34;site&#34;:&#34;roof&#34;}</value>`)
+	a.So(kml, should.ContainSubstring, `<coordinates>4.89,52.37,12</coordinates>`)
+}
+
+func TestEndDeviceLocationExport(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	devs := []*ttnpb.EndDevice{{
+		Ids: &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app-1"},
+			DeviceId:       "dev-1",
+		},
+		Name: "Device 1",
+		Locations: map[string]*ttnpb.Location{
+			"user":  {Latitude: 52.37, Longitude: 4.89, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+			"frm-1": {Latitude: 52.38, Longitude: 4.9, Source: ttnpb.LocationSource_SOURCE_GPS},
+		},
+	}}
+	features, err := endDeviceLocationFeatures(devs, []string{"name"})
+	if !a.So(err, should.BeNil) || !a.So(features, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	a.So(features[0].Properties, should.Resemble, map[string]interface{}{
+		"application_id": "app-1",
+		"device_id":      "dev-1",
+		"location":       "frm-1",
+		"source":         "SOURCE_GPS",
+		"name":           "Device 1",
+	})
+	a.So(features[1].Properties["location"], should.Equal, "user")
+
+	b, err := marshalKML("app-1-devices", features)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(strings.Count(string(b), "<Placemark>"), should.Equal, 2)
+}
@@ -0,0 +1,238 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/email/templates"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errNotificationPreferencesCorrupt = errors.DefineCorruption(
+		"notification_preferences_corrupt", "notification preferences are corrupt",
+	)
+	errNotificationDigestDisabled = errors.DefineFailedPrecondition(
+		"notification_digest_disabled", "notification digests are disabled",
+	)
+)
+
+func notificationPreferencesSettingKey(ids *ttnpb.UserIdentifiers) string {
+	return "notification_preferences/" + ids.GetUserId()
+}
+
+func getNotificationPreferences(
+	ctx context.Context, st store.Store, ids *ttnpb.UserIdentifiers,
+) (*ttnpb.NotificationPreferences, error) {
+	value, err := st.GetSetting(ctx, notificationPreferencesSettingKey(ids))
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return &ttnpb.NotificationPreferences{}, nil
+		}
+		return nil, err
+	}
+	preferences := &ttnpb.NotificationPreferences{}
+	if err := jsonpb.TTN().Unmarshal(value, preferences); err != nil {
+		return nil, errNotificationPreferencesCorrupt.WithCause(err)
+	}
+	return preferences, nil
+}
+
+// getNotificationPreferences returns the notification preferences of the user.
+func (is *IdentityServer) getNotificationPreferences(
+	ctx context.Context, req *ttnpb.GetNotificationPreferencesRequest,
+) (preferences *ttnpb.NotificationPreferences, err error) {
+	if err := rights.RequireUser(ctx, req.UserIds, ttnpb.Right_RIGHT_USER_INFO); err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		preferences, err = getNotificationPreferences(ctx, st, req.UserIds)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return preferences, nil
+}
+
+// setNotificationPreferences sets the notification preferences of the user.
+func (is *IdentityServer) setNotificationPreferences(
+	ctx context.Context, req *ttnpb.SetNotificationPreferencesRequest,
+) (*ttnpb.NotificationPreferences, error) {
+	if err := rights.RequireUser(ctx, req.UserIds, ttnpb.Right_RIGHT_USER_SETTINGS_BASIC); err != nil {
+		return nil, err
+	}
+	if req.Preferences.EmailDigest && !is.configFromContext(ctx).Notifications.Digest.Enable {
+		return nil, errNotificationDigestDisabled.New()
+	}
+	value, err := jsonpb.TTN().Marshal(req.Preferences)
+	if err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.SetSetting(ctx, notificationPreferencesSettingKey(req.UserIds), value)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return req.Preferences, nil
+}
+
+// filterDigestReceivers returns the receivers that receive notification emails one by one.
+// If notification digests are disabled, all receivers are returned.
+func (is *IdentityServer) filterDigestReceivers(
+	ctx context.Context, receiverIDs []*ttnpb.UserIdentifiers,
+) ([]*ttnpb.UserIdentifiers, error) {
+	if !is.configFromContext(ctx).Notifications.Digest.Enable {
+		return receiverIDs, nil
+	}
+	out := make([]*ttnpb.UserIdentifiers, 0, len(receiverIDs))
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		for _, ids := range receiverIDs {
+			preferences, err := getNotificationPreferences(ctx, st, ids)
+			if err != nil {
+				return err
+			}
+			if !preferences.EmailDigest {
+				out = append(out, ids)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// notificationDigest returns the unseen notifications of the user that were created in the (from, to] interval
+// and that would have been sent by email, if the user receives notification digests.
+func notificationDigest(
+	ctx context.Context, st store.Store, ids *ttnpb.UserIdentifiers, from, to time.Time,
+) ([]*ttnpb.Notification, error) {
+	preferences, err := getNotificationPreferences(ctx, st, ids)
+	if err != nil || !preferences.EmailDigest {
+		return nil, err
+	}
+	notifications, err := st.ListNotifications(ctx, ids, []ttnpb.NotificationStatus{
+		ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNSEEN,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filterNotificationDigest(notifications, from, to), nil
+}
+
+// filterNotificationDigest returns the notifications that are sent by email and that were created in the
+// (from, to] interval.
+func filterNotificationDigest(notifications []*ttnpb.Notification, from, to time.Time) []*ttnpb.Notification {
+	var digest []*ttnpb.Notification
+	for _, notification := range notifications {
+		createdAt := ttnpb.StdTime(notification.GetCreatedAt())
+		if !notification.GetEmail() || createdAt == nil || !createdAt.After(from) || createdAt.After(to) {
+			continue
+		}
+		digest = append(digest, notification)
+	}
+	return digest
+}
+
+// sendNotificationDigests sends the notification digests of the notifications that were created in the
+// (from, to] interval.
+func (is *IdentityServer) sendNotificationDigests(ctx context.Context, from, to time.Time) error {
+	digests := make(map[*ttnpb.UserIdentifiers][]*ttnpb.Notification)
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		receiverIDs, err := st.ListNotificationReceivers(ctx, from, to, []ttnpb.NotificationStatus{
+			ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNSEEN,
+		})
+		if err != nil {
+			return err
+		}
+		for _, ids := range receiverIDs {
+			digest, err := notificationDigest(ctx, st, ids, from, to)
+			if err != nil {
+				return err
+			}
+			if len(digest) > 0 {
+				digests[ids] = digest
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	for ids, digest := range digests {
+		digest := digest
+		if err := is.SendTemplateEmailToUserIDs(
+			ctx,
+			"notification_digest",
+			func(_ context.Context, data email.TemplateData) (email.TemplateData, error) {
+				return &templates.NotificationDigestData{
+					TemplateData:  data,
+					Notifications: digest,
+				}, nil
+			},
+			ids,
+		); err != nil {
+			log.FromContext(ctx).WithError(err).WithField(
+				"user_id", ids.GetUserId(),
+			).Warn("Failed to send notification digest")
+		}
+	}
+	return nil
+}
+
+// initializeNotificationDigestTask starts the task that periodically sends the users that receive notification
+// digests an email with their unseen notifications.
+func (is *IdentityServer) initializeNotificationDigestTask(ctx context.Context) error {
+	interval := is.config.Notifications.Digest.Interval
+	if !is.config.Notifications.Digest.Enable || interval <= 0 {
+		return nil
+	}
+	is.RegisterTask(&task.Config{
+		Context: ctx,
+		ID:      "is_notification_digest",
+		Backoff: task.DefaultBackoffConfig,
+		Restart: task.RestartAlways,
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(interval)
+			defer ticker.Stop()
+			sentAt := time.Now()
+			for {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case now := <-ticker.C:
+					if err := is.sendNotificationDigests(ctx, sentAt, now); err != nil {
+						log.FromContext(ctx).WithError(err).Warn("Failed to send notification digests")
+						continue
+					}
+					sentAt = now
+				}
+			}
+		},
+	})
+	return nil
+}
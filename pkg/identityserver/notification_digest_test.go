@@ -0,0 +1,53 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNotificationDigest(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	now := time.Now()
+	notification := func(id string, createdAt time.Time, email bool) *ttnpb.Notification {
+		return &ttnpb.Notification{
+			Id:               id,
+			NotificationType: "api_key_created",
+			CreatedAt:        timestamppb.New(createdAt),
+			Email:            email,
+		}
+	}
+	notifications := []*ttnpb.Notification{
+		notification("1", now.Add(-25*time.Hour), true),
+		notification("2", now.Add(-24*time.Hour), true),
+		notification("3", now.Add(-12*time.Hour), true),
+		notification("4", now.Add(-6*time.Hour), false),
+		notification("5", now, true),
+		{Id: "6", Email: true},
+	}
+	a.So(filterNotificationDigest(notifications, now.Add(-24*time.Hour), now), should.Resemble, []*ttnpb.Notification{
+		notifications[2],
+		notifications[4],
+	})
+	a.So(filterNotificationDigest(notifications, now, now.Add(time.Hour)), should.BeEmpty)
+}
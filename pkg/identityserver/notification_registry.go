@@ -214,7 +214,12 @@ func (is *IdentityServer) createNotification(ctx context.Context, req *ttnpb.Cre
 	}
 
 	if req.Email {
-		if err := is.SendNotificationEmailToUserIDs(ctx, notification, receiverUserIDs...); err != nil {
+		// Receivers of notification digests receive the notification in the next digest.
+		emailReceiverIDs, err := is.filterDigestReceivers(ctx, receiverUserIDs)
+		if err != nil {
+			return nil, err
+		}
+		if err := is.SendNotificationEmailToUserIDs(ctx, notification, emailReceiverIDs...); err != nil {
 			return nil, err
 		}
 	}
@@ -260,8 +265,24 @@ func (is *IdentityServer) notifyAdminsInternal(ctx context.Context, req *ttnpb.C
 		return err
 	}
 
+	is.sendNotificationToChatWebhooks(ctx, notification)
+
 	if req.Email {
-		if err := is.SendNotificationEmailToUsers(ctx, notification, receivers...); err != nil {
+		emailReceiverIDs, err := is.filterDigestReceivers(ctx, receiverUserIDs)
+		if err != nil {
+			return err
+		}
+		emailReceiverSet := make(map[string]struct{}, len(emailReceiverIDs))
+		for _, ids := range emailReceiverIDs {
+			emailReceiverSet[ids.GetUserId()] = struct{}{}
+		}
+		emailReceivers := make([]*ttnpb.User, 0, len(emailReceiverIDs))
+		for _, receiver := range receivers {
+			if _, ok := emailReceiverSet[receiver.GetIds().GetUserId()]; ok {
+				emailReceivers = append(emailReceivers, receiver)
+			}
+		}
+		if err := is.SendNotificationEmailToUsers(ctx, notification, emailReceivers...); err != nil {
 			return err
 		}
 	}
@@ -321,3 +342,15 @@ func (cr *notificationRegistry) List(ctx context.Context, req *ttnpb.ListNotific
 func (cr *notificationRegistry) UpdateStatus(ctx context.Context, req *ttnpb.UpdateNotificationStatusRequest) (*emptypb.Empty, error) {
 	return cr.updateNotificationStatus(ctx, req)
 }
+
+func (cr *notificationRegistry) GetPreferences(
+	ctx context.Context, req *ttnpb.GetNotificationPreferencesRequest,
+) (*ttnpb.NotificationPreferences, error) {
+	return cr.getNotificationPreferences(ctx, req)
+}
+
+func (cr *notificationRegistry) SetPreferences(
+	ctx context.Context, req *ttnpb.SetNotificationPreferencesRequest,
+) (*ttnpb.NotificationPreferences, error) {
+	return cr.setNotificationPreferences(ctx, req)
+}
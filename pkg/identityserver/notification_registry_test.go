@@ -18,6 +18,7 @@ import (
 	"testing"
 	"time"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/storetest"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
@@ -96,5 +97,19 @@ func TestNotificationRegistry(t *testing.T) {
 		if a.So(err, should.BeNil) && a.So(list, should.NotBeNil) {
 			a.So(list.Notifications, should.BeEmpty)
 		}
+
+		preferences, err := svc.GetPreferences(ctx, &ttnpb.GetNotificationPreferencesRequest{
+			UserIds: usr1.GetIds(),
+		}, usr1Creds)
+		if a.So(err, should.BeNil) && a.So(preferences, should.NotBeNil) {
+			a.So(preferences.EmailDigest, should.BeFalse)
+		}
+
+		// Notification digests are not enabled in the test configuration.
+		_, err = svc.SetPreferences(ctx, &ttnpb.SetNotificationPreferencesRequest{
+			UserIds:     usr1.GetIds(),
+			Preferences: &ttnpb.NotificationPreferences{EmailDigest: true},
+		}, usr1Creds)
+		a.So(errors.IsFailedPrecondition(err), should.BeTrue)
 	}, withPrivateTestDatabase(p))
 }
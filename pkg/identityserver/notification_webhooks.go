@@ -0,0 +1,170 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/email/templates"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+var (
+	errChatWebhookURL = errors.DefineInvalidArgument(
+		"chat_webhook_url", "invalid `{webhook}` notification webhook URL `{url}`",
+	)
+	errChatWebhookStatus = errors.DefineUnavailable(
+		"chat_webhook_status", "`{webhook}` notification webhook responded with status `{status}`",
+	)
+)
+
+// chatMessage is a notification message for a chat channel.
+type chatMessage struct {
+	Title string
+	Text  string
+	URL   string
+}
+
+// slackPayload returns the payload of a Slack incoming webhook.
+func slackPayload(msg chatMessage) any {
+	return map[string]any{
+		"text": fmt.Sprintf("*%s*\n%s\n<%s|View in the Console>", msg.Title, msg.Text, msg.URL),
+	}
+}
+
+// teamsPayload returns the payload of a Microsoft Teams incoming webhook, which is a legacy actionable message card.
+func teamsPayload(msg chatMessage) any {
+	return map[string]any{
+		"@type":    "MessageCard",
+		"@context": "https://schema.org/extensions",
+		"summary":  msg.Title,
+		"title":    msg.Title,
+		"text":     msg.Text,
+		"potentialAction": []any{
+			map[string]any{
+				"@type": "OpenUri",
+				"name":  "View in the Console",
+				"targets": []any{
+					map[string]any{"os": "default", "uri": msg.URL},
+				},
+			},
+		},
+	}
+}
+
+type chatWebhook struct {
+	name    string
+	config  ChatWebhookConfig
+	payload func(chatMessage) any
+}
+
+func (c Config) chatWebhooks() []chatWebhook {
+	return []chatWebhook{
+		{name: "slack", config: c.Notifications.Slack, payload: slackPayload},
+		{name: "teams", config: c.Notifications.Teams, payload: teamsPayload},
+	}
+}
+
+// validateChatWebhooks returns an error if the URL of a configured chat webhook is invalid.
+func (c Config) validateChatWebhooks() error {
+	for _, webhook := range c.chatWebhooks() {
+		if webhook.config.URL == "" {
+			continue
+		}
+		u, err := url.Parse(webhook.config.URL)
+		if err != nil {
+			return errChatWebhookURL.WithAttributes("webhook", webhook.name, "url", webhook.config.URL).WithCause(err)
+		}
+		if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return errChatWebhookURL.WithAttributes("webhook", webhook.name, "url", webhook.config.URL)
+		}
+	}
+	return nil
+}
+
+// newChatMessage returns the chat message of the notification.
+func newChatMessage(networkConfig *email.NetworkConfig, notification *ttnpb.Notification) chatMessage {
+	entityIDs := notification.GetEntityIds()
+	text := fmt.Sprintf("%s `%s`", entityIDs.EntityType(), entityIDs.IDString())
+	if senderIDs := notification.GetSenderIds(); senderIDs != nil {
+		text += fmt.Sprintf(" by user `%s`", senderIDs.GetUserId())
+	}
+	return chatMessage{
+		Title: fmt.Sprintf("%s: %s", networkConfig.Name, templates.NotificationSummary(notification.GetNotificationType())),
+		Text:  text,
+		// The receivers of the chat channels are operators, so the URL is the one of the admin panel if applicable.
+		URL: email.NewNotificationTemplateData(
+			email.NewTemplateData(networkConfig, &ttnpb.User{Admin: true}), notification,
+		).ConsoleURL(),
+	}
+}
+
+// postChatWebhook posts the payload to the chat webhook.
+func postChatWebhook(ctx context.Context, client *http.Client, webhook chatWebhook, msg chatMessage) error {
+	body, err := json.Marshal(webhook.payload(msg))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, webhook.config.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errChatWebhookStatus.WithAttributes("webhook", webhook.name, "status", res.StatusCode)
+	}
+	return nil
+}
+
+// sendNotificationToChatWebhooks sends the admin notification to the configured chat webhooks that accept the
+// notification type. Failures are logged, as they should not prevent the notification from being delivered to
+// the admins.
+func (is *IdentityServer) sendNotificationToChatWebhooks(ctx context.Context, notification *ttnpb.Notification) {
+	config := is.configFromContext(ctx)
+	var client *http.Client
+	for _, webhook := range config.chatWebhooks() {
+		if !webhook.config.accepts(notification.GetNotificationType()) {
+			continue
+		}
+		logger := log.FromContext(ctx).WithFields(log.Fields(
+			"webhook", webhook.name,
+			"notification_type", notification.GetNotificationType(),
+		))
+		if client == nil {
+			var err error
+			if client, err = is.HTTPClient(ctx); err != nil {
+				logger.WithError(err).Warn("Failed to create HTTP client for notification webhooks")
+				return
+			}
+		}
+		msg := newChatMessage(&config.Email.Network, notification)
+		if err := postChatWebhook(ctx, client, webhook, msg); err != nil {
+			logger.WithError(err).Warn("Failed to send notification to webhook")
+		}
+	}
+}
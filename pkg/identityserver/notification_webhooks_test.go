@@ -0,0 +1,91 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/email"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestChatWebhooks(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var conf Config
+	a.So(conf.validateChatWebhooks(), should.BeNil)
+	conf.Notifications.Slack.URL = "ftp://hooks.example.com"
+	a.So(errors.IsInvalidArgument(conf.validateChatWebhooks()), should.BeTrue)
+	conf.Notifications.Slack.URL = "https://hooks.example.com/services/T000/B000/XXXX"
+	a.So(conf.validateChatWebhooks(), should.BeNil)
+
+	a.So(conf.Notifications.Teams.accepts("user_requested"), should.BeFalse)
+	a.So(conf.Notifications.Slack.accepts("user_requested"), should.BeTrue)
+	conf.Notifications.Slack.NotificationTypes = []string{"client_requested"}
+	a.So(conf.Notifications.Slack.accepts("user_requested"), should.BeFalse)
+	a.So(conf.Notifications.Slack.accepts("client_requested"), should.BeTrue)
+
+	msg := newChatMessage(&email.NetworkConfig{
+		Name:       "The Things Stack",
+		ConsoleURL: "https://console.example.com/console",
+	}, &ttnpb.Notification{
+		EntityIds:        (&ttnpb.UserIdentifiers{UserId: "new-user"}).GetEntityIdentifiers(),
+		NotificationType: "user_requested",
+	})
+	a.So(msg, should.Resemble, chatMessage{
+		Title: "The Things Stack: User requires approval",
+		Text:  "user `new-user`",
+		URL:   "https://console.example.com/console/admin-panel/user-management/new-user",
+	})
+
+	var (
+		received    map[string]any
+		contentType string
+		status      = http.StatusOK
+	)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		contentType = r.Header.Get("Content-Type")
+		b, _ := io.ReadAll(r.Body)
+		received = nil
+		_ = json.Unmarshal(b, &received)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	slack := chatWebhook{name: "slack", config: ChatWebhookConfig{URL: srv.URL}, payload: slackPayload}
+	a.So(postChatWebhook(ctx, srv.Client(), slack, msg), should.BeNil)
+	a.So(contentType, should.Equal, "application/json")
+	a.So(received, should.Resemble, map[string]any{
+		"text": "*The Things Stack: User requires approval*\nuser `new-user`\n" +
+			"<https://console.example.com/console/admin-panel/user-management/new-user|View in the Console>",
+	})
+
+	teams := chatWebhook{name: "teams", config: ChatWebhookConfig{URL: srv.URL}, payload: teamsPayload}
+	a.So(postChatWebhook(ctx, srv.Client(), teams, msg), should.BeNil)
+	a.So(received["@type"], should.Equal, "MessageCard")
+	a.So(received["title"], should.Equal, msg.Title)
+	a.So(received["text"], should.Equal, msg.Text)
+
+	status = http.StatusForbidden
+	a.So(errors.IsUnavailable(postChatWebhook(ctx, srv.Client(), slack, msg)), should.BeTrue)
+}
@@ -380,10 +380,25 @@ func (is *IdentityServer) purgeOrganization(
 		if err != nil {
 			return err
 		}
+		// Delete related roles before purging the organization.
+		err = st.DeleteEntityRoles(ctx, ids.GetEntityIdentifiers())
+		if err != nil {
+			return err
+		}
+		// Delete related groups before purging the organization.
+		err = st.DeleteOrganizationGroups(ctx, ids)
+		if err != nil {
+			return err
+		}
 		err = st.DeleteAccountMembers(ctx, ids.GetOrganizationOrUserIdentifiers())
 		if err != nil {
 			return err
 		}
+		// Delete related labels before purging the organization.
+		err = st.DeleteEntityLabels(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return st.PurgeOrganization(ctx, ids)
 	})
 	if err != nil {
@@ -0,0 +1,315 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha1" //nolint:gosec // TOTP uses HMAC-SHA1 (RFC 6238).
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	evtChallengePasswordReset = events.Define(
+		"user.password_reset.challenge", "challenge password reset",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithClientInfoFromContext(),
+	)
+	evtVerifyPasswordResetFail = events.Define(
+		"user.password_reset.verify.fail", "verify password reset failure",
+		events.WithVisibility(ttnpb.Right_RIGHT_USER_INFO),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errPasswordResetVerifierNotFound = errors.DefineNotFound(
+		"password_reset_verifier_not_found", "password reset verifier `{verifier}` not found",
+	)
+	errPasswordResetNotVerified = errors.DefineFailedPrecondition(
+		"password_reset_not_verified", "password resets are not verified out-of-band",
+	)
+	errPasswordResetCode = errors.DefineUnauthenticated(
+		"password_reset_code", "invalid password reset verification code",
+	)
+	errNoPhoneNumber = errors.DefineFailedPrecondition(
+		"no_phone_number", "no phone number in the contact info of the user",
+	)
+	errNoSMSURL = errors.DefineFailedPrecondition(
+		"no_sms_url", "no SMS gateway URL configured",
+	)
+	errSMSStatus = errors.DefineUnavailable(
+		"sms_status", "SMS gateway responded with status `{status}`",
+	)
+)
+
+// PasswordResetVerifier verifies the identity of users out-of-band, before they receive a temporary password to
+// reset their password. Deployments that consider email-only password resets insufficient configure a verifier.
+type PasswordResetVerifier interface {
+	// Challenge starts the verification of the user, for example by sending a code to the phone of the user.
+	Challenge(ctx context.Context, usr *ttnpb.User) error
+	// Verify returns whether the code verifies the user.
+	Verify(ctx context.Context, usr *ttnpb.User, code string) (bool, error)
+}
+
+// SetPasswordResetVerifier configures the given password reset verifier. The verifier is used if the
+// password-reset.verifier configuration is set to the name of the verifier.
+func (is *IdentityServer) SetPasswordResetVerifier(name string, verifier PasswordResetVerifier) {
+	if is.passwordResetVerifiers == nil {
+		is.passwordResetVerifiers = make(map[string]PasswordResetVerifier)
+	}
+	is.passwordResetVerifiers[name] = verifier
+}
+
+// passwordResetVerifier returns the configured password reset verifier, or nil if password resets are not verified
+// out-of-band.
+func (is *IdentityServer) passwordResetVerifier(ctx context.Context) (PasswordResetVerifier, error) {
+	config := is.configFromContext(ctx).PasswordReset
+	switch config.Verifier {
+	case "":
+		return nil, nil
+	case "sms":
+		if config.SMS.URL == "" {
+			return nil, errNoSMSURL.New()
+		}
+		return &smsPasswordResetVerifier{
+			url:    config.SMS.URL,
+			key:    is.GetBaseConfig(ctx).HTTP.Cookie.HashKey,
+			client: is,
+		}, nil
+	}
+	if verifier, ok := is.passwordResetVerifiers[config.Verifier]; ok {
+		return verifier, nil
+	}
+	return nil, errPasswordResetVerifierNotFound.WithAttributes("verifier", config.Verifier)
+}
+
+var passwordResetUserFields = store.FieldMask{"ids", "primary_email_address", "password_updated_at"}
+
+func (is *IdentityServer) getPasswordResetUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*ttnpb.User, error) {
+	var usr *ttnpb.User
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		usr, err = st.GetUser(ctx, ids, passwordResetUserFields)
+		if err != nil {
+			return err
+		}
+		usr.ContactInfo, err = st.GetContactInfo(ctx, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return usr, nil
+}
+
+// challengePasswordReset starts the out-of-band verification of the user that requests a password reset.
+func (is *IdentityServer) challengePasswordReset(
+	ctx context.Context, ids *ttnpb.UserIdentifiers, verifier PasswordResetVerifier,
+) error {
+	usr, err := is.getPasswordResetUser(ctx, ids)
+	if err != nil {
+		return err
+	}
+	if err := verifier.Challenge(ctx, usr); err != nil {
+		return err
+	}
+	events.Publish(evtChallengePasswordReset.NewWithIdentifiersAndData(ctx, ids, nil))
+	return nil
+}
+
+// verifyPasswordReset verifies the user with the code of the out-of-band verifier, and issues the temporary
+// password to reset the password of the user. The user requests a temporary password as usual, which starts
+// the challenge of the verifier instead of sending the temporary password.
+func (is *IdentityServer) verifyPasswordReset(
+	ctx context.Context, req *ttnpb.VerifyPasswordResetRequest,
+) (*emptypb.Empty, error) {
+	verifier, err := is.passwordResetVerifier(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if verifier == nil {
+		return nil, errPasswordResetNotVerified.New()
+	}
+	usr, err := is.getPasswordResetUser(ctx, req.GetUserIds())
+	if err != nil {
+		return nil, err
+	}
+	ok, err := verifier.Verify(ctx, usr, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		events.Publish(evtVerifyPasswordResetFail.NewWithIdentifiersAndData(ctx, req.GetUserIds(), nil))
+		return nil, errPasswordResetCode.New()
+	}
+	if err := is.issueTemporaryPassword(ctx, req.GetUserIds()); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+const (
+	totpDigits = 6
+	// totpSkew is the number of time steps before and after the current time step that are accepted.
+	totpSkew = 1
+)
+
+// totpCode returns the time-based one-time password (RFC 6238) of the secret at the given time.
+func totpCode(secret []byte, t time.Time, step time.Duration) string {
+	counter := uint64(t.Unix() / int64(step/time.Second))
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], counter)
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// verifyTOTPCode returns whether the code is the time-based one-time password of the secret around the given time.
+func verifyTOTPCode(secret []byte, code string, t time.Time, step time.Duration) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	if _, err := strconv.ParseUint(code, 10, 32); err != nil {
+		return false
+	}
+	for i := -totpSkew; i <= totpSkew; i++ {
+		if hmac.Equal([]byte(totpCode(secret, t.Add(time.Duration(i)*step), step)), []byte(code)) {
+			return true
+		}
+	}
+	return false
+}
+
+// totpPasswordResetVerifier verifies users with the codes of their authenticator app.
+type totpPasswordResetVerifier struct {
+	secret func(ctx context.Context, ids *ttnpb.UserIdentifiers) ([]byte, error)
+}
+
+// NewTOTPPasswordResetVerifier returns a password reset verifier that verifies users with time-based one-time
+// passwords (RFC 6238) of 6 digits with a time step of 30 seconds. The secret function returns the TOTP secret of
+// the user, which is managed by the deployment.
+func NewTOTPPasswordResetVerifier(
+	secret func(ctx context.Context, ids *ttnpb.UserIdentifiers) ([]byte, error),
+) PasswordResetVerifier {
+	return &totpPasswordResetVerifier{secret: secret}
+}
+
+const totpStep = 30 * time.Second
+
+// Challenge implements PasswordResetVerifier.
+// The user reads the code from their authenticator app, so there is nothing to send.
+func (*totpPasswordResetVerifier) Challenge(context.Context, *ttnpb.User) error {
+	return nil
+}
+
+// Verify implements PasswordResetVerifier.
+func (v *totpPasswordResetVerifier) Verify(ctx context.Context, usr *ttnpb.User, code string) (bool, error) {
+	secret, err := v.secret(ctx, usr.GetIds())
+	if err != nil {
+		return false, err
+	}
+	return verifyTOTPCode(secret, code, time.Now(), totpStep), nil
+}
+
+// smsPasswordResetVerifier sends verification codes to the phone number in the contact info of users.
+// The codes are time-based one-time passwords of a secret that is derived from the server key, the user ID and the
+// time of the last password update, so that no codes need to be stored and codes are invalidated by password resets.
+type smsPasswordResetVerifier struct {
+	url    string
+	key    []byte
+	client httpclient.Provider
+}
+
+// smsStep is the time step of the SMS codes, which allows for delays in the delivery of SMS messages.
+const smsStep = 5 * time.Minute
+
+func (v *smsPasswordResetVerifier) secret(usr *ttnpb.User) []byte {
+	mac := hmac.New(sha256.New, v.key)
+	mac.Write([]byte(usr.GetIds().GetUserId()))
+	if updatedAt := ttnpb.StdTime(usr.GetPasswordUpdatedAt()); updatedAt != nil {
+		mac.Write([]byte(strconv.FormatInt(updatedAt.UnixNano(), 10)))
+	}
+	return mac.Sum(nil)
+}
+
+func phoneNumber(usr *ttnpb.User) string {
+	for _, info := range usr.GetContactInfo() {
+		if info.GetContactMethod() == ttnpb.ContactMethod_CONTACT_METHOD_PHONE && info.GetValue() != "" {
+			return info.GetValue()
+		}
+	}
+	return ""
+}
+
+// smsMessage is the payload of the SMS gateway webhook.
+type smsMessage struct {
+	To      string `json:"to"`
+	Message string `json:"message"`
+}
+
+// Challenge implements PasswordResetVerifier.
+func (v *smsPasswordResetVerifier) Challenge(ctx context.Context, usr *ttnpb.User) error {
+	to := phoneNumber(usr)
+	if to == "" {
+		return errNoPhoneNumber.New()
+	}
+	body, err := json.Marshal(&smsMessage{
+		To:      to,
+		Message: fmt.Sprintf("Your password reset code is %s", totpCode(v.secret(usr), time.Now(), smsStep)),
+	})
+	if err != nil {
+		return err
+	}
+	client, err := v.client.HTTPClient(ctx)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, v.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	res, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errSMSStatus.WithAttributes("status", res.StatusCode)
+	}
+	return nil
+}
+
+// Verify implements PasswordResetVerifier.
+func (v *smsPasswordResetVerifier) Verify(_ context.Context, usr *ttnpb.User, code string) (bool, error) {
+	return verifyTOTPCode(v.secret(usr), code, time.Now(), smsStep), nil
+}
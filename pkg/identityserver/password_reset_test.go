@@ -0,0 +1,141 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestTOTPCode(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	// Test vectors of RFC 6238 (SHA1), truncated to 6 digits.
+	secret := []byte("12345678901234567890")
+	for unix, expected := range map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	} {
+		a.So(totpCode(secret, time.Unix(unix, 0), 30*time.Second), should.Equal, expected)
+	}
+
+	now := time.Unix(1111111109, 0)
+	a.So(verifyTOTPCode(secret, "081804", now, 30*time.Second), should.BeTrue)
+	a.So(verifyTOTPCode(secret, "081804", now.Add(30*time.Second), 30*time.Second), should.BeTrue)
+	a.So(verifyTOTPCode(secret, "081804", now.Add(90*time.Second), 30*time.Second), should.BeFalse)
+	a.So(verifyTOTPCode(secret, "000000", now, 30*time.Second), should.BeFalse)
+	a.So(verifyTOTPCode(secret, "81804", now, 30*time.Second), should.BeFalse)
+	a.So(verifyTOTPCode(secret, "+81804", now, 30*time.Second), should.BeFalse)
+}
+
+func TestTOTPPasswordResetVerifier(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	secret := []byte("12345678901234567890")
+	verifier := NewTOTPPasswordResetVerifier(func(context.Context, *ttnpb.UserIdentifiers) ([]byte, error) {
+		return secret, nil
+	})
+	usr := &ttnpb.User{Ids: &ttnpb.UserIdentifiers{UserId: "foo-usr"}}
+
+	a.So(verifier.Challenge(ctx, usr), should.BeNil)
+	ok, err := verifier.Verify(ctx, usr, totpCode(secret, time.Now(), totpStep))
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+}
+
+type testHTTPClientProvider struct {
+	*http.Client
+}
+
+func (p testHTTPClientProvider) HTTPClient(context.Context, ...httpclient.Option) (*http.Client, error) {
+	return p.Client, nil
+}
+
+func TestSMSPasswordResetVerifier(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	messages := make(chan *smsMessage, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		msg := &smsMessage{}
+		if err := json.NewDecoder(r.Body).Decode(msg); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		messages <- msg
+		w.WriteHeader(http.StatusAccepted)
+	}))
+	defer srv.Close()
+
+	verifier := &smsPasswordResetVerifier{
+		url:    srv.URL,
+		key:    []byte("key"),
+		client: testHTTPClientProvider{srv.Client()},
+	}
+	usr := &ttnpb.User{
+		Ids:               &ttnpb.UserIdentifiers{UserId: "foo-usr"},
+		PasswordUpdatedAt: timestamppb.New(time.Unix(1600000000, 0)),
+	}
+
+	err := verifier.Challenge(ctx, usr)
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	usr.ContactInfo = []*ttnpb.ContactInfo{
+		{ContactMethod: ttnpb.ContactMethod_CONTACT_METHOD_EMAIL, Value: "foo@example.com"},
+		{ContactMethod: ttnpb.ContactMethod_CONTACT_METHOD_PHONE, Value: "+31600000000"},
+	}
+	if !a.So(verifier.Challenge(ctx, usr), should.BeNil) {
+		t.FailNow()
+	}
+	msg := <-messages
+	a.So(msg.To, should.Equal, "+31600000000")
+	code := msg.Message[len(msg.Message)-totpDigits:]
+
+	ok, err := verifier.Verify(ctx, usr, code)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeTrue)
+
+	// The code is invalidated when the password is updated.
+	usr.PasswordUpdatedAt = timestamppb.Now()
+	ok, err = verifier.Verify(ctx, usr, code)
+	a.So(err, should.BeNil)
+	a.So(ok, should.BeFalse)
+}
+
+func TestVerifyPasswordResetRequestValidation(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	usrIDs := &ttnpb.UserIdentifiers{UserId: "foo-usr"}
+	a.So((&ttnpb.VerifyPasswordResetRequest{UserIds: usrIDs, Code: "123456"}).ValidateFields(), should.BeNil)
+	a.So((&ttnpb.VerifyPasswordResetRequest{UserIds: usrIDs}).ValidateFields(), should.NotBeNil)
+	a.So((&ttnpb.VerifyPasswordResetRequest{Code: "123456"}).ValidateFields(), should.NotBeNil)
+}
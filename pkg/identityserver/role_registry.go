@@ -0,0 +1,439 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"context"
+	"regexp"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	evtUpdateApplicationRole = events.Define(
+		"application.role.update", "update application role",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_COLLABORATORS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteApplicationRole = events.Define(
+		"application.role.delete", "delete application role",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_SETTINGS_COLLABORATORS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtUpdateOrganizationRole = events.Define(
+		"organization.role.update", "update organization role",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+	evtDeleteOrganizationRole = events.Define(
+		"organization.role.delete", "delete organization role",
+		events.WithVisibility(ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS),
+		events.WithAuthFromContext(),
+		events.WithClientInfoFromContext(),
+	)
+)
+
+var (
+	errEntityType      = errors.DefineInvalidArgument("entity_type", "invalid entity type `{entity_type}`")
+	errRoleEntityType  = errors.DefineInvalidArgument("role_entity_type", "{entity_type} entities have no roles")
+	errRoleName        = errors.DefineInvalidArgument("role_name", "invalid role name `{name}`")
+	errBuiltInRole     = errors.DefineFailedPrecondition("built_in_role", "role `{name}` is built in")
+	errRoleDescription = errors.DefineInvalidArgument(
+		"role_description", "role description is longer than {max} characters",
+	)
+	errRoleNoRights       = errors.DefineInvalidArgument("role_no_rights", "role `{name}` has no rights")
+	errRoleRight          = errors.DefineInvalidArgument("role_right", "right `{right}` can not be part of {entity_type} roles")
+	errCollaboratorNoRole = errors.DefineNotFound("collaborator_no_role", "collaborator has no role")
+)
+
+var roleNameRegexp = regexp.MustCompile(`^[a-z0-9](?:[-]?[a-z0-9]){2,}$`)
+
+const (
+	maxRoleNameLength      = 36
+	maxRoleDescriptionSize = 2000
+)
+
+// builtInRoles are the roles that every application or organization has.
+var builtInRoles = map[string][]*ttnpb.Role{
+	"application": {
+		{
+			Name:        "admin",
+			Description: "Full rights to the application",
+			Rights:      []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_ALL},
+		},
+		{
+			Name:        "operator",
+			Description: "Manage end devices and their traffic",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_APPLICATION_INFO,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+			},
+		},
+		{
+			Name:        "viewer",
+			Description: "View end devices and their traffic",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_APPLICATION_INFO,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+			},
+		},
+	},
+	"organization": {
+		{
+			Name:        "admin",
+			Description: "Full rights to the organization",
+			Rights:      []ttnpb.Right{ttnpb.Right_RIGHT_ORGANIZATION_ALL},
+		},
+		{
+			Name:        "operator",
+			Description: "Manage the end devices and gateways of the organization",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+				ttnpb.Right_RIGHT_ORGANIZATION_APPLICATIONS_LIST,
+				ttnpb.Right_RIGHT_ORGANIZATION_GATEWAYS_LIST,
+				ttnpb.Right_RIGHT_APPLICATION_INFO,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE,
+				ttnpb.Right_RIGHT_GATEWAY_INFO,
+				ttnpb.Right_RIGHT_GATEWAY_STATUS_READ,
+				ttnpb.Right_RIGHT_GATEWAY_LOCATION_READ,
+				ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ,
+			},
+		},
+		{
+			Name:        "viewer",
+			Description: "View the end devices and gateways of the organization",
+			Rights: []ttnpb.Right{
+				ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+				ttnpb.Right_RIGHT_ORGANIZATION_APPLICATIONS_LIST,
+				ttnpb.Right_RIGHT_ORGANIZATION_GATEWAYS_LIST,
+				ttnpb.Right_RIGHT_APPLICATION_INFO,
+				ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+				ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ,
+				ttnpb.Right_RIGHT_GATEWAY_INFO,
+				ttnpb.Right_RIGHT_GATEWAY_STATUS_READ,
+			},
+		},
+	},
+}
+
+func init() {
+	for _, roles := range builtInRoles {
+		for _, role := range roles {
+			role.BuiltIn = true
+		}
+	}
+}
+
+func builtInRole(entityType, name string) *ttnpb.Role {
+	for _, role := range builtInRoles[entityType] {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+func roleFromStore(r *store.Role) *ttnpb.Role {
+	return &ttnpb.Role{
+		Name:        r.Name,
+		Description: r.Description,
+		Rights:      r.Rights.GetRights(),
+	}
+}
+
+// roleRights returns the rights that roles of the entity type can have.
+func roleRights(entityType string) (rights *ttnpb.Rights, all ttnpb.Right, err error) {
+	switch entityType {
+	case "application":
+		return ttnpb.AllApplicationRights, ttnpb.Right_RIGHT_APPLICATION_ALL, nil
+	case "organization":
+		return ttnpb.AllOrganizationRights.Union(ttnpb.AllEntityRights), ttnpb.Right_RIGHT_ORGANIZATION_ALL, nil
+	default:
+		return nil, 0, errRoleEntityType.WithAttributes("entity_type", entityType)
+	}
+}
+
+// validateRole validates a custom role of an entity of the given type.
+// Custom roles can not have the _ALL right of the entity, so that the last collaborator with
+// all rights can not lose them by a change of a role.
+func validateRole(entityType string, role *ttnpb.Role) error {
+	if len(role.Name) > maxRoleNameLength || !roleNameRegexp.MatchString(role.Name) {
+		return errRoleName.WithAttributes("name", role.Name)
+	}
+	if builtInRole(entityType, role.Name) != nil {
+		return errBuiltInRole.WithAttributes("name", role.Name)
+	}
+	if len(role.Description) > maxRoleDescriptionSize {
+		return errRoleDescription.WithAttributes("max", maxRoleDescriptionSize)
+	}
+	if len(role.Rights) == 0 {
+		return errRoleNoRights.WithAttributes("name", role.Name)
+	}
+	allowed, all, err := roleRights(entityType)
+	if err != nil {
+		return err
+	}
+	for _, right := range role.Rights {
+		if right == all || !allowed.IncludesAll(right) {
+			return errRoleRight.WithAttributes(
+				"right", right.String(),
+				"entity_type", entityType,
+			)
+		}
+	}
+	return nil
+}
+
+// requireEntityRights requires the caller to have the rights on the entity.
+func requireEntityRights(ctx context.Context, ids *ttnpb.EntityIdentifiers, required ...ttnpb.Right) error {
+	switch ids.EntityType() {
+	case "application":
+		return rights.RequireApplication(ctx, ids.GetApplicationIds(), required...)
+	case "client":
+		return rights.RequireClient(ctx, ids.GetClientIds(), required...)
+	case "gateway":
+		return rights.RequireGateway(ctx, ids.GetGatewayIds(), required...)
+	case "organization":
+		return rights.RequireOrganization(ctx, ids.GetOrganizationIds(), required...)
+	case "user":
+		return rights.RequireUser(ctx, ids.GetUserIds(), required...)
+	default:
+		return errEntityType.WithAttributes("entity_type", ids.EntityType())
+	}
+}
+
+// requireManageRoles requires the caller to have the right to manage the collaborators of the entity.
+func requireManageRoles(ctx context.Context, ids *ttnpb.EntityIdentifiers) error {
+	switch ids.EntityType() {
+	case "application":
+		return requireEntityRights(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_COLLABORATORS)
+	case "organization":
+		return requireEntityRights(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_SETTINGS_MEMBERS)
+	default:
+		return errRoleEntityType.WithAttributes("entity_type", ids.EntityType())
+	}
+}
+
+func (is *IdentityServer) listRoles(ctx context.Context, ids *ttnpb.EntityIdentifiers) ([]*ttnpb.Role, error) {
+	if err := requireManageRoles(ctx, ids); err != nil {
+		return nil, err
+	}
+	roles := append([]*ttnpb.Role(nil), builtInRoles[ids.EntityType()]...)
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		custom, err := st.ListRoles(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, role := range custom {
+			roles = append(roles, roleFromStore(role))
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return roles, nil
+}
+
+func (is *IdentityServer) setRole(ctx context.Context, ids *ttnpb.EntityIdentifiers, role *ttnpb.Role) (*ttnpb.Role, error) {
+	if err := requireManageRoles(ctx, ids); err != nil {
+		return nil, err
+	}
+	if err := validateRole(ids.EntityType(), role); err != nil {
+		return nil, err
+	}
+	var updated *store.Role
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		required := ttnpb.RightsFrom(role.Rights...)
+		existing, err := st.GetRole(ctx, ids, role.Name)
+		if err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		// Changing a role changes the rights of its collaborators, so the caller needs to have
+		// the rights that are added as well as the rights that are removed.
+		if existing != nil {
+			required = required.Union(existing.Rights)
+		}
+		if err := requireEntityRights(ctx, ids, required.GetRights()...); err != nil {
+			return err
+		}
+		updated, err = st.SetRole(ctx, ids, &store.Role{
+			Name:        role.Name,
+			Description: role.Description,
+			Rights:      ttnpb.RightsFrom(role.Rights...).Unique(),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := roleFromStore(updated)
+	evt := evtUpdateApplicationRole
+	if ids.EntityType() == "organization" {
+		evt = evtUpdateOrganizationRole
+	}
+	events.Publish(evt.New(ctx, events.WithIdentifiers(ids), events.WithData(res)))
+	return res, nil
+}
+
+func (is *IdentityServer) deleteRole(ctx context.Context, ids *ttnpb.EntityIdentifiers, name string) error {
+	if err := requireManageRoles(ctx, ids); err != nil {
+		return err
+	}
+	if builtInRole(ids.EntityType(), name) != nil {
+		return errBuiltInRole.WithAttributes("name", name)
+	}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.DeleteRole(ctx, ids, name)
+	})
+	if err != nil {
+		return err
+	}
+	evt := evtDeleteApplicationRole
+	if ids.EntityType() == "organization" {
+		evt = evtDeleteOrganizationRole
+	}
+	events.Publish(evt.New(ctx, events.WithIdentifiers(ids)))
+	return nil
+}
+
+func (is *IdentityServer) getCollaboratorRole(
+	ctx context.Context, ids *ttnpb.EntityIdentifiers, collaborator *ttnpb.OrganizationOrUserIdentifiers,
+) (*ttnpb.CollaboratorRole, error) {
+	if err := requireManageRoles(ctx, ids); err != nil {
+		return nil, err
+	}
+	res := &ttnpb.CollaboratorRole{}
+	err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		res.Role, err = st.GetMemberRole(ctx, collaborator, ids)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if res.Role == "" {
+		return nil, errCollaboratorNoRole.New()
+	}
+	return res, nil
+}
+
+// setCollaboratorRole sets the rights of the collaborator to the rights of the role, and then assigns the role.
+// The rights are set in the same way as when setting the rights of the collaborator directly, so the caller
+// needs to have the rights that are added or removed, and the last collaborator with all rights is kept.
+func (is *IdentityServer) setCollaboratorRole(
+	ctx context.Context,
+	ids *ttnpb.EntityIdentifiers,
+	collaborator *ttnpb.OrganizationOrUserIdentifiers,
+	name string,
+) (*ttnpb.CollaboratorRole, error) {
+	if err := requireManageRoles(ctx, ids); err != nil {
+		return nil, err
+	}
+	role := builtInRole(ids.EntityType(), name)
+	if role == nil {
+		err := is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+			custom, err := st.GetRole(ctx, ids, name)
+			if err != nil {
+				return err
+			}
+			role = roleFromStore(custom)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var err error
+	member := &ttnpb.Collaborator{Ids: collaborator, Rights: role.Rights}
+	switch ids.EntityType() {
+	case "application":
+		_, err = is.setApplicationCollaborator(ctx, &ttnpb.SetApplicationCollaboratorRequest{
+			ApplicationIds: ids.GetApplicationIds(),
+			Collaborator:   member,
+		})
+	case "organization":
+		_, err = is.setOrganizationCollaborator(ctx, &ttnpb.SetOrganizationCollaboratorRequest{
+			OrganizationIds: ids.GetOrganizationIds(),
+			Collaborator:    member,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
+		return st.SetMemberRole(ctx, collaborator, ids, role.Name)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.CollaboratorRole{Role: role.Name}, nil
+}
+
+type roleRegistry struct {
+	ttnpb.UnimplementedRoleRegistryServer
+
+	*IdentityServer
+}
+
+func (rr *roleRegistry) List(ctx context.Context, req *ttnpb.ListRolesRequest) (*ttnpb.Roles, error) {
+	roles, err := rr.listRoles(ctx, req.GetEntityIds())
+	if err != nil {
+		return nil, err
+	}
+	return &ttnpb.Roles{Roles: roles}, nil
+}
+
+func (rr *roleRegistry) Set(ctx context.Context, req *ttnpb.SetRoleRequest) (*ttnpb.Role, error) {
+	return rr.setRole(ctx, req.GetEntityIds(), &ttnpb.Role{
+		Name:        req.GetRole().GetName(),
+		Description: req.GetRole().GetDescription(),
+		Rights:      req.GetRole().GetRights(),
+	})
+}
+
+func (rr *roleRegistry) Delete(ctx context.Context, req *ttnpb.DeleteRoleRequest) (*emptypb.Empty, error) {
+	if err := rr.deleteRole(ctx, req.GetEntityIds(), req.GetName()); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+func (rr *roleRegistry) GetCollaboratorRole(
+	ctx context.Context, req *ttnpb.GetCollaboratorRoleRequest,
+) (*ttnpb.CollaboratorRole, error) {
+	return rr.getCollaboratorRole(ctx, req.GetEntityIds(), req.GetCollaborator())
+}
+
+func (rr *roleRegistry) SetCollaboratorRole(
+	ctx context.Context, req *ttnpb.SetCollaboratorRoleRequest,
+) (*ttnpb.CollaboratorRole, error) {
+	return rr.setCollaboratorRole(ctx, req.GetEntityIds(), req.GetCollaborator(), req.GetRole())
+}
@@ -0,0 +1,117 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package identityserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBuiltInRoles(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	for entityType, roles := range builtInRoles {
+		allowed, all, err := roleRights(entityType)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		for _, role := range roles {
+			a.So(role.BuiltIn, should.BeTrue)
+			a.So(allowed.IncludesAll(role.Rights...), should.BeTrue)
+			a.So(builtInRole(entityType, role.Name), should.Equal, role)
+		}
+		a.So(builtInRole(entityType, "admin").Rights, should.Resemble, []ttnpb.Right{all})
+	}
+	a.So(builtInRole("gateway", "admin"), should.BeNil)
+	a.So(builtInRole("application", "support"), should.BeNil)
+}
+
+func TestValidateRole(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		Name       string
+		EntityType string
+		Role       *ttnpb.Role
+		Assert     func(error) bool
+	}{
+		{
+			Name:       "Valid",
+			EntityType: "application",
+			Role: &ttnpb.Role{
+				Name:   "support",
+				Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ},
+			},
+			Assert: func(err error) bool { return err == nil },
+		},
+		{
+			Name:       "ValidOrganizationEntityRights",
+			EntityType: "organization",
+			Role: &ttnpb.Role{
+				Name:   "gateway-operator",
+				Rights: []ttnpb.Right{ttnpb.Right_RIGHT_ORGANIZATION_INFO, ttnpb.Right_RIGHT_GATEWAY_ALL},
+			},
+			Assert: func(err error) bool { return err == nil },
+		},
+		{
+			Name:       "InvalidName",
+			EntityType: "application",
+			Role:       &ttnpb.Role{Name: "Support!", Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_INFO}},
+			Assert:     errors.IsInvalidArgument,
+		},
+		{
+			Name:       "BuiltIn",
+			EntityType: "application",
+			Role:       &ttnpb.Role{Name: "viewer", Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_INFO}},
+			Assert:     errors.IsFailedPrecondition,
+		},
+		{
+			Name:       "NoRights",
+			EntityType: "application",
+			Role:       &ttnpb.Role{Name: "support"},
+			Assert:     errors.IsInvalidArgument,
+		},
+		{
+			Name:       "AllRight",
+			EntityType: "application",
+			Role:       &ttnpb.Role{Name: "support", Rights: []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_ALL}},
+			Assert:     errors.IsInvalidArgument,
+		},
+		{
+			Name:       "OtherEntityRight",
+			EntityType: "application",
+			Role:       &ttnpb.Role{Name: "support", Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_INFO}},
+			Assert:     errors.IsInvalidArgument,
+		},
+		{
+			Name:       "UnsupportedEntityType",
+			EntityType: "gateway",
+			Role:       &ttnpb.Role{Name: "support", Rights: []ttnpb.Right{ttnpb.Right_RIGHT_GATEWAY_INFO}},
+			Assert:     errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			a.So(tc.Assert(validateRole(tc.EntityType, tc.Role)), should.BeTrue)
+		})
+	}
+}
@@ -0,0 +1,43 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// EmailChange is a change of the primary email address of a user that needs to be confirmed from both the old and
+// the new email address. After the change is completed, it can be reverted from the old email address until it expires.
+type EmailChange struct {
+	ID      string
+	UserIDs *ttnpb.UserIdentifiers
+
+	OldEmailAddress string
+	NewEmailAddress string
+
+	OldToken       string
+	NewToken       string
+	OldConfirmedAt *time.Time
+	NewConfirmedAt *time.Time
+
+	// RevertToken is set when the change is completed.
+	RevertToken string
+	CompletedAt *time.Time
+
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
@@ -128,4 +128,27 @@ var (
 	ErrContactInfoRestricted = errors.DefinePermissionDenied(
 		"contact_info_restricted", "contact information can only reference the caller",
 	)
+
+	ErrSettingNotFound = errors.DefineNotFound(
+		"setting_not_found", "setting `{key}` not found",
+	)
+
+	ErrExternalAccountNotFound = errors.DefineNotFound(
+		"external_account_not_found", "external account of provider `{provider}` not found",
+	)
+	ErrExternalAccountAlreadyLinked = errors.DefineAlreadyExists(
+		"external_account_already_linked", "external account of provider `{provider}` already linked",
+	)
+
+	ErrRoleNotFound = errors.DefineNotFound(
+		"role_not_found", "role `{name}` of {entity_type} entity with id `{entity_id}` not found",
+	)
+
+	ErrGroupNotFound = errors.DefineNotFound(
+		"group_not_found", "group `{group_id}` of organization `{organization_id}` not found",
+	)
+
+	ErrEmailChangeNotFound = errors.DefineNotFound(
+		"email_change_not_found", "email change with reference `{reference}` not found",
+	)
 )
@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import "math"
+
+// EarthRadius is the mean radius of the earth in meters.
+const EarthRadius = 6371008.8
+
+// BoundingBox is a geographic bounding box in degrees.
+// If MinLongitude is greater than MaxLongitude, the bounding box crosses the antimeridian.
+type BoundingBox struct {
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+}
+
+// Contains returns whether the location is within the bounding box.
+func (b BoundingBox) Contains(latitude, longitude float64) bool {
+	if latitude < b.MinLatitude || latitude > b.MaxLatitude {
+		return false
+	}
+	if b.MinLongitude > b.MaxLongitude {
+		return longitude >= b.MinLongitude || longitude <= b.MaxLongitude
+	}
+	return longitude >= b.MinLongitude && longitude <= b.MaxLongitude
+}
+
+// GeoQuery selects entities by the location of their gateway antennas or end device locations.
+type GeoQuery struct {
+	// BoundingBox is the bounding box that the location must be in.
+	BoundingBox BoundingBox
+	// If Radius is non-zero, the location must also be within Radius meters of the center.
+	CenterLatitude  float64
+	CenterLongitude float64
+	Radius          float64
+}
+
+// GeoQueryAround returns the geo query for the locations within radius meters of the center.
+func GeoQueryAround(latitude, longitude, radius float64) GeoQuery {
+	dLat := radius / EarthRadius * 180 / math.Pi
+	box := BoundingBox{
+		MinLatitude:  math.Max(latitude-dLat, -90),
+		MaxLatitude:  math.Min(latitude+dLat, 90),
+		MinLongitude: -180,
+		MaxLongitude: 180,
+	}
+	if box.MinLatitude > -90 && box.MaxLatitude < 90 {
+		dLng := dLat / math.Cos(latitude*math.Pi/180)
+		if dLng < 180 {
+			box.MinLongitude, box.MaxLongitude = longitude-dLng, longitude+dLng
+			if box.MinLongitude < -180 {
+				box.MinLongitude += 360
+			}
+			if box.MaxLongitude > 180 {
+				box.MaxLongitude -= 360
+			}
+		}
+	}
+	return GeoQuery{
+		BoundingBox:     box,
+		CenterLatitude:  latitude,
+		CenterLongitude: longitude,
+		Radius:          radius,
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"math"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestBoundingBoxContains(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	box := BoundingBox{MinLatitude: 50, MinLongitude: 3, MaxLatitude: 54, MaxLongitude: 8}
+	a.So(box.Contains(52, 5), should.BeTrue)
+	a.So(box.Contains(50, 3), should.BeTrue)
+	a.So(box.Contains(49, 5), should.BeFalse)
+	a.So(box.Contains(52, 9), should.BeFalse)
+
+	antimeridian := BoundingBox{MinLatitude: -20, MinLongitude: 170, MaxLatitude: -10, MaxLongitude: -170}
+	a.So(antimeridian.Contains(-15, 175), should.BeTrue)
+	a.So(antimeridian.Contains(-15, -175), should.BeTrue)
+	a.So(antimeridian.Contains(-15, 0), should.BeFalse)
+}
+
+func TestGeoQueryAround(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	// One degree of latitude is about 111.2 km.
+	q := GeoQueryAround(0, 0, 111195)
+	a.So(q.Radius, should.Equal, 111195)
+	a.So(math.Abs(q.BoundingBox.MaxLatitude-1), should.BeLessThan, 1e-3)
+	a.So(math.Abs(q.BoundingBox.MinLatitude+1), should.BeLessThan, 1e-3)
+	a.So(math.Abs(q.BoundingBox.MaxLongitude-1), should.BeLessThan, 1e-3)
+	a.So(math.Abs(q.BoundingBox.MinLongitude+1), should.BeLessThan, 1e-3)
+
+	// Longitudes are wider apart at higher latitudes.
+	q = GeoQueryAround(60, 0, 111195)
+	a.So(math.Abs(q.BoundingBox.MaxLongitude-2), should.BeLessThan, 1e-3)
+
+	// The bounding box wraps around the antimeridian.
+	q = GeoQueryAround(0, 179.5, 111195)
+	a.So(q.BoundingBox.MinLongitude, should.BeGreaterThan, q.BoundingBox.MaxLongitude)
+	a.So(q.BoundingBox.Contains(0, -179.8), should.BeTrue)
+	a.So(q.BoundingBox.Contains(0, 178.8), should.BeTrue)
+
+	// The bounding box covers all longitudes around the poles.
+	q = GeoQueryAround(89.5, 10, 111195)
+	a.So(q.BoundingBox.MaxLatitude, should.Equal, 90)
+	a.So(q.BoundingBox.MinLongitude, should.Equal, -180)
+	a.So(q.BoundingBox.MaxLongitude, should.Equal, 180)
+}
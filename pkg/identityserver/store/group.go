@@ -0,0 +1,37 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Group is a group of users within an organization. The members of the group get the rights of the
+// memberships of the group, limited to their own rights on the organization.
+type Group struct {
+	GroupID     string
+	Name        string
+	Description string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+// GroupMembership is the membership of a group on an application, client or gateway.
+type GroupMembership struct {
+	EntityIdentifiers *ttnpb.EntityIdentifiers
+	Rights            *ttnpb.Rights
+}
@@ -0,0 +1,26 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+// IntegrityFinding is a problem with the referential integrity of the database,
+// such as memberships or API keys of entities that no longer exist.
+type IntegrityFinding struct {
+	// Check is the name of the integrity check that found the problem.
+	Check string
+	// Description describes the rows that have the problem.
+	Description string
+	// IDs are the IDs of the rows that have the problem.
+	IDs []string
+}
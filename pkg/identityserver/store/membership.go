@@ -18,19 +18,23 @@ import "go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 
 // MembershipChain is a User -> (Membership -> Organization) -> Membership -> Entity chain.
 type MembershipChain struct {
-	UserIdentifiers         *ttnpb.UserIdentifiers
-	RightsOnOrganization    *ttnpb.Rights
-	OrganizationIdentifiers *ttnpb.OrganizationIdentifiers
-	RightsOnEntity          *ttnpb.Rights
-	EntityIdentifiers       *ttnpb.EntityIdentifiers
+	UserIdentifiers            *ttnpb.UserIdentifiers
+	RightsOnOrganization       *ttnpb.Rights
+	DeniedRightsOnOrganization *ttnpb.Rights
+	OrganizationIdentifiers    *ttnpb.OrganizationIdentifiers
+	RightsOnEntity             *ttnpb.Rights
+	DeniedRightsOnEntity       *ttnpb.Rights
+	EntityIdentifiers          *ttnpb.EntityIdentifiers
 }
 
-// GetRights returns the intersected rights.
+// GetRights returns the intersected rights, without the denied rights.
 func (m *MembershipChain) GetRights() *ttnpb.Rights {
 	if m.RightsOnOrganization == nil {
-		return m.RightsOnEntity.Implied()
+		return m.RightsOnEntity.Deny(m.DeniedRightsOnEntity)
 	}
-	return m.RightsOnEntity.Implied().Intersect(m.RightsOnOrganization.Implied())
+	return m.RightsOnEntity.Deny(m.DeniedRightsOnEntity).Intersect(
+		m.RightsOnOrganization.Deny(m.DeniedRightsOnOrganization),
+	)
 }
 
 // MembershipChains is a list of membership chains.
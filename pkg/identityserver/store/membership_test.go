@@ -0,0 +1,51 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package store
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMembershipChainDeniedRights(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	direct := &MembershipChain{
+		RightsOnEntity:       ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_ALL),
+		DeniedRightsOnEntity: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE),
+	}
+	rights := direct.GetRights()
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE), should.BeTrue)
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_ALL), should.BeFalse)
+
+	indirect := &MembershipChain{
+		RightsOnOrganization:       ttnpb.RightsFrom(ttnpb.Right_RIGHT_ALL),
+		DeniedRightsOnOrganization: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE),
+		RightsOnEntity:             ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_ALL),
+	}
+	rights = indirect.GetRights()
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeTrue)
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE), should.BeFalse)
+
+	indirect.DeniedRightsOnEntity = ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE)
+	rights = indirect.GetRights()
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+	a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO), should.BeTrue)
+}
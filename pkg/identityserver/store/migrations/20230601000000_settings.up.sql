@@ -0,0 +1,9 @@
+CREATE TABLE IF NOT EXISTS settings (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  key character varying NOT NULL,
+  value bytea
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS setting_key_index ON settings USING btree (key);
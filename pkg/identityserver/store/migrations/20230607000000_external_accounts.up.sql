@@ -0,0 +1,11 @@
+CREATE TABLE IF NOT EXISTS external_accounts (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  user_id uuid NOT NULL,
+  provider character varying NOT NULL,
+  subject character varying NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS external_account_subject_index ON external_accounts USING btree (provider, subject);
+CREATE UNIQUE INDEX IF NOT EXISTS external_account_user_index ON external_accounts USING btree (user_id, provider);
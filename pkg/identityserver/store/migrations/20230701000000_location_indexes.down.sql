@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS end_device_location_location_index;
+DROP INDEX IF EXISTS gateway_antenna_location_index;
@@ -0,0 +1,2 @@
+CREATE INDEX IF NOT EXISTS gateway_antenna_location_index ON gateway_antennas USING btree (latitude, longitude);
+CREATE INDEX IF NOT EXISTS end_device_location_location_index ON end_device_locations USING btree (latitude, longitude);
@@ -0,0 +1,53 @@
+DROP VIEW IF EXISTS user_accounts;
+DROP VIEW IF EXISTS organization_accounts;
+
+--bun:split
+DROP INDEX IF EXISTS account_uid_index;
+CREATE UNIQUE INDEX account_uid_index ON accounts USING btree (uid);
+DROP INDEX IF EXISTS uix_users_primary_email_address;
+CREATE UNIQUE INDEX uix_users_primary_email_address ON users USING btree ((LOWER(primary_email_address))) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS application_id_index;
+CREATE UNIQUE INDEX application_id_index ON applications USING btree (application_id);
+DROP INDEX IF EXISTS client_id_index;
+CREATE UNIQUE INDEX client_id_index ON clients USING btree (client_id);
+DROP INDEX IF EXISTS gateway_id_index;
+CREATE UNIQUE INDEX gateway_id_index ON gateways USING btree (gateway_id);
+DROP INDEX IF EXISTS end_device_id_index;
+CREATE UNIQUE INDEX end_device_id_index ON end_devices USING btree (application_id, device_id);
+
+--bun:split
+ALTER TABLE accounts DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE users DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE organizations DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE applications DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE clients DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE gateways DROP COLUMN IF EXISTS tenant_id;
+ALTER TABLE end_devices DROP COLUMN IF EXISTS tenant_id;
+
+--bun:split
+CREATE VIEW user_accounts AS
+SELECT
+  acc.id AS account_id,
+  acc.created_at AS account_created_at,
+  acc.updated_at AS account_updated_at,
+  acc.deleted_at AS account_deleted_at,
+  acc.uid AS account_uid,
+  usr.*
+FROM
+  accounts acc
+  JOIN users usr ON usr.id = acc.account_id
+  AND acc.account_type = 'user';
+
+--bun:split
+CREATE VIEW organization_accounts AS
+SELECT
+  acc.id AS account_id,
+  acc.created_at AS account_created_at,
+  acc.updated_at AS account_updated_at,
+  acc.deleted_at AS account_deleted_at,
+  acc.uid AS account_uid,
+  org.*
+FROM
+  accounts acc
+  JOIN organizations org ON org.id = acc.account_id
+  AND acc.account_type = 'organization';
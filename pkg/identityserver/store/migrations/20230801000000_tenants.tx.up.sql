@@ -0,0 +1,49 @@
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE users ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE applications ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE clients ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE gateways ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+ALTER TABLE end_devices ADD COLUMN IF NOT EXISTS tenant_id character varying DEFAULT 'default' NOT NULL;
+
+--bun:split
+DROP INDEX IF EXISTS account_uid_index;
+CREATE UNIQUE INDEX account_uid_index ON accounts USING btree (tenant_id, uid);
+DROP INDEX IF EXISTS uix_users_primary_email_address;
+CREATE UNIQUE INDEX uix_users_primary_email_address ON users USING btree (tenant_id, (LOWER(primary_email_address))) WHERE deleted_at IS NULL;
+DROP INDEX IF EXISTS application_id_index;
+CREATE UNIQUE INDEX application_id_index ON applications USING btree (tenant_id, application_id);
+DROP INDEX IF EXISTS client_id_index;
+CREATE UNIQUE INDEX client_id_index ON clients USING btree (tenant_id, client_id);
+DROP INDEX IF EXISTS gateway_id_index;
+CREATE UNIQUE INDEX gateway_id_index ON gateways USING btree (tenant_id, gateway_id);
+DROP INDEX IF EXISTS end_device_id_index;
+CREATE UNIQUE INDEX end_device_id_index ON end_devices USING btree (tenant_id, application_id, device_id);
+
+--bun:split
+CREATE OR REPLACE VIEW user_accounts AS
+SELECT
+  acc.id AS account_id,
+  acc.created_at AS account_created_at,
+  acc.updated_at AS account_updated_at,
+  acc.deleted_at AS account_deleted_at,
+  acc.uid AS account_uid,
+  usr.*
+FROM
+  accounts acc
+  JOIN users usr ON usr.id = acc.account_id
+  AND acc.account_type = 'user';
+
+--bun:split
+CREATE OR REPLACE VIEW organization_accounts AS
+SELECT
+  acc.id AS account_id,
+  acc.created_at AS account_created_at,
+  acc.updated_at AS account_updated_at,
+  acc.deleted_at AS account_deleted_at,
+  acc.uid AS account_uid,
+  org.*
+FROM
+  accounts acc
+  JOIN organizations org ON org.id = acc.account_id
+  AND acc.account_type = 'organization';
@@ -0,0 +1,3 @@
+ALTER TABLE memberships DROP COLUMN IF EXISTS role;
+
+DROP TABLE IF EXISTS roles;
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS roles (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  entity_type character varying NOT NULL,
+  entity_id uuid NOT NULL,
+  name character varying NOT NULL,
+  description character varying,
+  rights integer[]
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS role_name_index ON roles USING btree (entity_type, entity_id, name);
+
+ALTER TABLE memberships ADD COLUMN IF NOT EXISTS role character varying;
@@ -0,0 +1,18 @@
+CREATE TABLE IF NOT EXISTS email_changes (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  user_id uuid NOT NULL,
+  old_email_address character varying NOT NULL,
+  new_email_address character varying NOT NULL,
+  old_token character varying NOT NULL,
+  new_token character varying NOT NULL,
+  old_confirmed_at timestamp with time zone,
+  new_confirmed_at timestamp with time zone,
+  revert_token character varying,
+  completed_at timestamp with time zone,
+  expires_at timestamp with time zone NOT NULL
+);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS email_change_user_index ON email_changes USING btree (user_id);
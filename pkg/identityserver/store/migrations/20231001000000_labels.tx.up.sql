@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS labels (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  entity_id uuid NOT NULL,
+  entity_type character varying(32) NOT NULL,
+  key character varying NOT NULL,
+  value character varying NOT NULL
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS label_entity_key_index ON labels USING btree (entity_id, entity_type, key);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS label_key_value_index ON labels USING btree (entity_type, key, value);
@@ -0,0 +1,2 @@
+ALTER TABLE authorization_codes ADD COLUMN IF NOT EXISTS nonce character varying;
+ALTER TABLE authorization_codes ADD COLUMN IF NOT EXISTS scopes character varying[];
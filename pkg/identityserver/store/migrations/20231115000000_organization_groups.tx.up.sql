@@ -0,0 +1,108 @@
+CREATE TABLE IF NOT EXISTS organization_groups (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  organization_id uuid NOT NULL,
+  group_id character varying NOT NULL,
+  name character varying,
+  description character varying
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS organization_group_id_index ON organization_groups USING btree (organization_id, group_id);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS organization_group_members (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  group_id uuid NOT NULL,
+  account_id uuid NOT NULL
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS organization_group_member_index ON organization_group_members USING btree (group_id, account_id);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS organization_group_member_account_index ON organization_group_members USING btree (account_id);
+
+--bun:split
+CREATE TABLE IF NOT EXISTS organization_group_memberships (
+  id uuid PRIMARY KEY DEFAULT gen_random_uuid() NOT NULL,
+  created_at timestamp with time zone NOT NULL,
+  updated_at timestamp with time zone NOT NULL,
+  group_id uuid NOT NULL,
+  rights integer[],
+  entity_type character varying NOT NULL,
+  entity_id uuid NOT NULL
+);
+
+--bun:split
+CREATE UNIQUE INDEX IF NOT EXISTS organization_group_membership_index ON organization_group_memberships USING btree (group_id, entity_type, entity_id);
+
+--bun:split
+CREATE INDEX IF NOT EXISTS organization_group_membership_entity_index ON organization_group_memberships USING btree (entity_type, entity_id);
+
+--bun:split
+DROP VIEW IF EXISTS indirect_entity_memberships CASCADE;
+
+--bun:split
+CREATE VIEW indirect_entity_memberships AS
+SELECT
+  usr_acc.id AS user_account_id,
+  usr_acc.uid AS user_account_friendly_id,
+  dmem.rights AS user_rights,
+  dmem.denied_rights AS user_denied_rights,
+  org_acc.id AS organization_account_id,
+  org_acc.uid AS organization_account_friendly_id,
+  imem.rights AS entity_rights,
+  imem.denied_rights AS entity_denied_rights,
+  imem.entity_type AS entity_type,
+  imem.entity_id AS entity_id,
+  CASE
+    WHEN imem.entity_type = 'application' THEN (SELECT application_id FROM applications WHERE id = imem.entity_id)
+    WHEN imem.entity_type = 'client' THEN (SELECT client_id FROM clients WHERE id = imem.entity_id)
+    WHEN imem.entity_type = 'gateway' THEN (SELECT gateway_id FROM gateways WHERE id = imem.entity_id)
+  END AS entity_friendly_id
+FROM
+  accounts AS usr_acc
+  JOIN memberships AS dmem ON dmem.account_id = usr_acc.id
+  JOIN accounts org_acc ON dmem.entity_type = org_acc.account_type
+  AND dmem.entity_id = org_acc.account_id
+  JOIN memberships AS imem ON imem.account_id = org_acc.id
+WHERE
+  usr_acc.deleted_at IS NULL
+  AND usr_acc.account_type = 'user'
+  AND dmem.entity_type = 'organization'
+  AND org_acc.deleted_at IS NULL
+UNION ALL
+SELECT
+  usr_acc.id AS user_account_id,
+  usr_acc.uid AS user_account_friendly_id,
+  dmem.rights AS user_rights,
+  dmem.denied_rights AS user_denied_rights,
+  org_acc.id AS organization_account_id,
+  org_acc.uid AS organization_account_friendly_id,
+  gmem.rights AS entity_rights,
+  NULL::integer[] AS entity_denied_rights,
+  gmem.entity_type AS entity_type,
+  gmem.entity_id AS entity_id,
+  CASE
+    WHEN gmem.entity_type = 'application' THEN (SELECT application_id FROM applications WHERE id = gmem.entity_id)
+    WHEN gmem.entity_type = 'client' THEN (SELECT client_id FROM clients WHERE id = gmem.entity_id)
+    WHEN gmem.entity_type = 'gateway' THEN (SELECT gateway_id FROM gateways WHERE id = gmem.entity_id)
+  END AS entity_friendly_id
+FROM
+  accounts AS usr_acc
+  JOIN organization_group_members AS gusr ON gusr.account_id = usr_acc.id
+  JOIN organization_groups AS grp ON grp.id = gusr.group_id
+  JOIN accounts org_acc ON org_acc.id = grp.organization_id
+  JOIN memberships AS dmem ON dmem.account_id = usr_acc.id
+  AND dmem.entity_type = org_acc.account_type
+  AND dmem.entity_id = org_acc.account_id
+  JOIN organization_group_memberships AS gmem ON gmem.group_id = grp.id
+WHERE
+  usr_acc.deleted_at IS NULL
+  AND usr_acc.account_type = 'user'
+  AND dmem.entity_type = 'organization'
+  AND org_acc.deleted_at IS NULL;
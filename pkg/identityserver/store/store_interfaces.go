@@ -16,6 +16,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
@@ -216,6 +217,18 @@ type MembershipStore interface {
 		entityID *ttnpb.EntityIdentifiers,
 		rights *ttnpb.Rights,
 	) error
+	// Get the rights that are denied to a direct member on an entity.
+	GetMemberDeniedRights(
+		ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+	) (*ttnpb.Rights, error)
+	// Set the rights that are denied to a direct member on an entity. The denied rights are kept when
+	// the rights of the member are set. Passing no rights removes the denied rights.
+	SetMemberDeniedRights(
+		ctx context.Context,
+		id *ttnpb.OrganizationOrUserIdentifiers,
+		entityID *ttnpb.EntityIdentifiers,
+		denied *ttnpb.Rights,
+	) error
 	// DeleteMember elminates the direct member rights attached to an entity.
 	DeleteMember(ctx context.Context, id *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers) error
 	// Delete all member rights on an entity. Used for purging entities.
@@ -248,6 +261,14 @@ type APIKeyStore interface {
 	UpdateAPIKey(
 		ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey, fieldMask FieldMask,
 	) (*ttnpb.APIKey, error)
+	// Get the rights that are denied to an API key.
+	GetAPIKeyDeniedRights(
+		ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string,
+	) (*ttnpb.Rights, error)
+	// Set the rights that are denied to an API key. Passing no rights removes the denied rights.
+	SetAPIKeyDeniedRights(
+		ctx context.Context, entityID *ttnpb.EntityIdentifiers, id string, denied *ttnpb.Rights,
+	) error
 	// DeleteAPIKey deletes key rights on an entity.
 	DeleteAPIKey(ctx context.Context, entityID *ttnpb.EntityIdentifiers, key *ttnpb.APIKey) error
 	// Delete api keys deletes all api keys tied to an entity. Used when purging entities.
@@ -328,6 +349,18 @@ type EntitySearch interface {
 	SearchAccounts(
 		ctx context.Context, req *ttnpb.SearchAccountsRequest,
 	) ([]*ttnpb.OrganizationOrUserIdentifiers, error)
+	SearchGatewaysByLocation(
+		ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, query GeoQuery,
+	) ([]*ttnpb.GatewayIdentifiers, error)
+	SearchEndDevicesByLocation(
+		ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, query GeoQuery,
+	) ([]*ttnpb.EndDeviceIdentifiers, error)
+	// SearchEntitiesByLabels searches the entities of the entity type that have all the given labels.
+	// If the member is set, only the entities that the member is a (direct or indirect) member of are searched.
+	// End devices are searched in the applications that the member is a member of.
+	SearchEntitiesByLabels(
+		ctx context.Context, member *ttnpb.OrganizationOrUserIdentifiers, entityType string, labels map[string]string,
+	) ([]*ttnpb.EntityIdentifiers, error)
 }
 
 // ContactInfoStore interface for contact info validation.
@@ -368,6 +401,102 @@ type NotificationStore interface {
 		notificationIDs []string,
 		status ttnpb.NotificationStatus,
 	) error
+	// ListNotificationReceivers returns the identifiers of the users that received notifications that were
+	// created in the (createdAfter, createdBefore] interval and that have one of the given statuses.
+	ListNotificationReceivers(
+		ctx context.Context, createdAfter, createdBefore time.Time, statuses []ttnpb.NotificationStatus,
+	) ([]*ttnpb.UserIdentifiers, error)
+}
+
+// SettingStore interface for storing the runtime settings of the Identity Server.
+// The values of the settings are opaque to the store.
+type SettingStore interface {
+	GetSetting(ctx context.Context, key string) ([]byte, error)
+	SetSetting(ctx context.Context, key string, value []byte) error
+}
+
+// ExternalAccountStore interface for storing the external accounts that are linked to users.
+type ExternalAccountStore interface {
+	CreateExternalAccount(ctx context.Context, account *ExternalAccount) (*ExternalAccount, error)
+	GetExternalAccount(ctx context.Context, provider, subject string) (*ExternalAccount, error)
+	ListExternalAccounts(ctx context.Context, userIDs *ttnpb.UserIdentifiers) ([]*ExternalAccount, error)
+	DeleteExternalAccount(ctx context.Context, userIDs *ttnpb.UserIdentifiers, provider string) error
+}
+
+// EmailChangeStore interface for storing the pending changes of the primary email addresses of users.
+type EmailChangeStore interface {
+	// CreateEmailChange creates an email change, and deletes the other email changes of the user.
+	CreateEmailChange(ctx context.Context, change *EmailChange) (*EmailChange, error)
+	GetEmailChange(ctx context.Context, id string) (*EmailChange, error)
+	// UpdateEmailChange updates the confirmation, completion, revert token and expiry of the email change.
+	UpdateEmailChange(ctx context.Context, change *EmailChange) (*EmailChange, error)
+	DeleteEmailChange(ctx context.Context, id string) error
+}
+
+// LabelStore interface for storing the labels of entities.
+type LabelStore interface {
+	GetLabels(ctx context.Context, entityID ttnpb.IDStringer) (map[string]string, error)
+	// SetLabels replaces the labels of the entity.
+	SetLabels(ctx context.Context, entityID ttnpb.IDStringer, labels map[string]string) (map[string]string, error)
+	DeleteEntityLabels(ctx context.Context, entityID ttnpb.IDStringer) error
+}
+
+// RoleStore interface for storing the custom collaborator roles of applications and organizations.
+type RoleStore interface {
+	ListRoles(ctx context.Context, entityID *ttnpb.EntityIdentifiers) ([]*Role, error)
+	GetRole(ctx context.Context, entityID *ttnpb.EntityIdentifiers, name string) (*Role, error)
+	// SetRole creates or updates the role, and sets the rights of the members that have the role.
+	SetRole(ctx context.Context, entityID *ttnpb.EntityIdentifiers, role *Role) (*Role, error)
+	// DeleteRole deletes the role. Members that have the role keep their rights.
+	DeleteRole(ctx context.Context, entityID *ttnpb.EntityIdentifiers, name string) error
+	DeleteEntityRoles(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error
+
+	// GetMemberRole returns the role of the member, or an empty string if the member has no role.
+	GetMemberRole(
+		ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+	) (string, error)
+	// SetMemberRole sets the role of an existing member. SetMember resets the role of the member.
+	SetMemberRole(
+		ctx context.Context, accountID *ttnpb.OrganizationOrUserIdentifiers, entityID *ttnpb.EntityIdentifiers,
+		name string,
+	) error
+}
+
+// GroupStore interface for storing the user groups of organizations.
+type GroupStore interface {
+	ListGroups(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers) ([]*Group, error)
+	GetGroup(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string) (*Group, error)
+	// SetGroup creates the group, or updates the name and description of the group.
+	SetGroup(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, group *Group) (*Group, error)
+	// DeleteGroup deletes the group with its members and memberships.
+	DeleteGroup(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string) error
+	// DeleteOrganizationGroups deletes the groups of the organization with their members and memberships.
+	DeleteOrganizationGroups(ctx context.Context, orgID *ttnpb.OrganizationIdentifiers) error
+
+	ListGroupMembers(
+		ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+	) ([]*ttnpb.UserIdentifiers, error)
+	AddGroupMember(
+		ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string, userID *ttnpb.UserIdentifiers,
+	) error
+	RemoveGroupMember(
+		ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string, userID *ttnpb.UserIdentifiers,
+	) error
+	// DeleteUserGroupMembers removes the user from all groups.
+	DeleteUserGroupMembers(ctx context.Context, userID *ttnpb.UserIdentifiers) error
+
+	ListGroupMemberships(
+		ctx context.Context, orgID *ttnpb.OrganizationIdentifiers, groupID string,
+	) ([]*GroupMembership, error)
+	// SetGroupMembership sets the rights of the group on the entity. Empty rights delete the membership.
+	SetGroupMembership(
+		ctx context.Context,
+		orgID *ttnpb.OrganizationIdentifiers,
+		groupID string,
+		entityID *ttnpb.EntityIdentifiers,
+		rights *ttnpb.Rights,
+	) error
+	DeleteEntityGroupMemberships(ctx context.Context, entityID *ttnpb.EntityIdentifiers) error
 }
 
 // Store interface combines the interfaces of all individual stores.
@@ -387,6 +516,12 @@ type Store interface {
 	ContactInfoStore
 	EUIStore
 	NotificationStore
+	SettingStore
+	ExternalAccountStore
+	RoleStore
+	EmailChangeStore
+	LabelStore
+	GroupStore
 	EntitySearch
 }
 
@@ -396,3 +531,10 @@ type TransactionalStore interface {
 
 	Transact(ctx context.Context, fc func(context.Context, Store) error) error
 }
+
+// IntegrityChecker interface for checking and repairing the referential integrity of the database.
+type IntegrityChecker interface {
+	// CheckIntegrity returns the problems with the referential integrity of the database.
+	// If repair is true, the rows that have problems are deleted in a single transaction.
+	CheckIntegrity(ctx context.Context, repair bool) ([]*IntegrityFinding, error)
+}
@@ -0,0 +1,140 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestDeniedRights(t *T) {
+	app1 := st.population.NewApplication(nil)
+	org1 := st.population.NewOrganization(nil)
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.MembershipStore
+		is.APIKeyStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement MembershipStore and APIKeyStore")
+	}
+	defer s.Close()
+
+	a, ctx := test.New(t)
+	appAll := ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_ALL)
+	deleteRight := ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE)
+
+	for _, member := range []struct {
+		ids       *ttnpb.OrganizationOrUserIdentifiers
+		entityIDs *ttnpb.EntityIdentifiers
+		rights    *ttnpb.Rights
+	}{
+		{usr1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers(), appAll},
+		{org1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers(), appAll},
+		{usr2.GetOrganizationOrUserIdentifiers(), org1.GetEntityIdentifiers(), ttnpb.RightsFrom(ttnpb.Right_RIGHT_ALL)},
+	} {
+		if err := s.SetMember(ctx, member.ids, member.entityIDs, member.rights); !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	t.Run("Member", func(t *T) {
+		a, ctx := test.New(t)
+
+		denied, err := s.GetMemberDeniedRights(ctx, usr1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers())
+		if a.So(err, should.BeNil) {
+			a.So(denied.GetRights(), should.BeEmpty)
+		}
+
+		err = s.SetMemberDeniedRights(ctx, usr1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers(), deleteRight)
+		a.So(err, should.BeNil)
+
+		denied, err = s.GetMemberDeniedRights(ctx, usr1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers())
+		if a.So(err, should.BeNil) {
+			a.So(denied.GetRights(), should.Resemble, deleteRight.GetRights())
+		}
+
+		// Setting the rights of the member keeps the denied rights.
+		err = s.SetMember(ctx, usr1.GetOrganizationOrUserIdentifiers(), app1.GetEntityIdentifiers(), appAll)
+		a.So(err, should.BeNil)
+
+		chains, err := s.FindAccountMembershipChains(
+			ctx, usr1.GetOrganizationOrUserIdentifiers(), "application", app1.GetIds().GetApplicationId(),
+		)
+		if a.So(err, should.BeNil) && a.So(chains, should.HaveLength, 1) {
+			rights := chains[0].GetRights()
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO), should.BeTrue)
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+		}
+	})
+
+	t.Run("OrganizationMember", func(t *T) {
+		a, ctx := test.New(t)
+
+		err := s.SetMemberDeniedRights(ctx, usr2.GetOrganizationOrUserIdentifiers(), org1.GetEntityIdentifiers(), deleteRight)
+		a.So(err, should.BeNil)
+
+		chains, err := s.FindAccountMembershipChains(
+			ctx, usr2.GetOrganizationOrUserIdentifiers(), "application", app1.GetIds().GetApplicationId(),
+		)
+		if a.So(err, should.BeNil) && a.So(chains, should.HaveLength, 1) {
+			rights := chains[0].GetRights()
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO), should.BeTrue)
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+		}
+
+		err = s.SetMemberDeniedRights(ctx, usr2.GetOrganizationOrUserIdentifiers(), org1.GetEntityIdentifiers(), nil)
+		a.So(err, should.BeNil)
+
+		denied, err := s.GetMemberDeniedRights(ctx, usr2.GetOrganizationOrUserIdentifiers(), org1.GetEntityIdentifiers())
+		if a.So(err, should.BeNil) {
+			a.So(denied.GetRights(), should.BeEmpty)
+		}
+	})
+
+	t.Run("APIKey", func(t *T) {
+		a, ctx := test.New(t)
+
+		_, err := s.CreateAPIKey(ctx, app1.GetEntityIdentifiers(), &ttnpb.APIKey{
+			Id:     "DENIEDKEY",
+			Key:    "Hash",
+			Rights: appAll.GetRights(),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		denied, err := s.GetAPIKeyDeniedRights(ctx, app1.GetEntityIdentifiers(), "DENIEDKEY")
+		if a.So(err, should.BeNil) {
+			a.So(denied.GetRights(), should.BeEmpty)
+		}
+
+		err = s.SetAPIKeyDeniedRights(ctx, app1.GetEntityIdentifiers(), "DENIEDKEY", deleteRight)
+		a.So(err, should.BeNil)
+
+		denied, err = s.GetAPIKeyDeniedRights(ctx, app1.GetEntityIdentifiers(), "DENIEDKEY")
+		if a.So(err, should.BeNil) {
+			a.So(denied.GetRights(), should.Resemble, deleteRight.GetRights())
+		}
+	})
+}
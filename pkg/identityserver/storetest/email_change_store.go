@@ -0,0 +1,121 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestEmailChangeStore(t *T) {
+	usr1 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.EmailChangeStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement EmailChangeStore")
+	}
+	defer s.Close()
+
+	now := time.Now().Truncate(time.Millisecond)
+
+	var created *is.EmailChange
+
+	t.Run("CreateEmailChange", func(t *T) {
+		a, ctx := test.New(t)
+		var err error
+		created, err = s.CreateEmailChange(ctx, &is.EmailChange{
+			UserIDs:         usr1.GetIds(),
+			OldEmailAddress: "old@example.com",
+			NewEmailAddress: "new@example.com",
+			OldToken:        "OLD",
+			NewToken:        "NEW",
+			ExpiresAt:       now.Add(time.Hour),
+		})
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.ID, should.NotBeEmpty)
+			a.So(created.UserIDs, should.Resemble, usr1.GetIds())
+			a.So(created.OldConfirmedAt, should.BeNil)
+			a.So(created.NewConfirmedAt, should.BeNil)
+		}
+	})
+
+	t.Run("GetEmailChange", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.GetEmailChange(ctx, created.ID)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.UserIDs.GetUserId(), should.Equal, usr1.GetIds().GetUserId())
+			a.So(got.OldEmailAddress, should.Equal, "old@example.com")
+			a.So(got.NewEmailAddress, should.Equal, "new@example.com")
+			a.So(got.OldToken, should.Equal, "OLD")
+			a.So(got.NewToken, should.Equal, "NEW")
+			a.So(got.ExpiresAt.Equal(now.Add(time.Hour)), should.BeTrue)
+		}
+	})
+
+	t.Run("UpdateEmailChange", func(t *T) {
+		a, ctx := test.New(t)
+		created.OldConfirmedAt = &now
+		created.NewConfirmedAt = &now
+		created.CompletedAt = &now
+		created.RevertToken = "REVERT"
+		created.ExpiresAt = now.Add(24 * time.Hour)
+		updated, err := s.UpdateEmailChange(ctx, created)
+		if a.So(err, should.BeNil) && a.So(updated, should.NotBeNil) {
+			a.So(updated.RevertToken, should.Equal, "REVERT")
+		}
+
+		got, err := s.GetEmailChange(ctx, created.ID)
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.CompletedAt, should.NotBeNil)
+			a.So(got.RevertToken, should.Equal, "REVERT")
+			a.So(got.ExpiresAt.Equal(now.Add(24*time.Hour)), should.BeTrue)
+		}
+	})
+
+	t.Run("CreateEmailChange_Replace", func(t *T) {
+		a, ctx := test.New(t)
+		replaced, err := s.CreateEmailChange(ctx, &is.EmailChange{
+			UserIDs:         usr1.GetIds(),
+			OldEmailAddress: "old@example.com",
+			NewEmailAddress: "other@example.com",
+			OldToken:        "OLD2",
+			NewToken:        "NEW2",
+			ExpiresAt:       now.Add(time.Hour),
+		})
+		if a.So(err, should.BeNil) && a.So(replaced, should.NotBeNil) {
+			_, err = s.GetEmailChange(ctx, created.ID)
+			a.So(errors.IsNotFound(err), should.BeTrue)
+			created = replaced
+		}
+	})
+
+	t.Run("DeleteEmailChange", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteEmailChange(ctx, created.ID)
+		a.So(err, should.BeNil)
+
+		_, err = s.GetEmailChange(ctx, created.ID)
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+}
@@ -42,7 +42,12 @@ func (st *StoreTest) TestEntitySearch(t *T) {
 	dev1.Ids.DevEui = types.EUI64{2, 2, 2, 2, 2, 2, 2, 2}.Bytes()
 	dev1.Description = "This is the description of " + dev1.Name
 	dev1.Attributes = attributes
-	st.population.NewEndDevice(app2.GetIds())
+	dev1.Locations = map[string]*ttnpb.Location{
+		"user": {Latitude: 52.3676, Longitude: 4.9041, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+	}
+	st.population.NewEndDevice(app2.GetIds()).Locations = map[string]*ttnpb.Location{
+		"user": {Latitude: 48.8566, Longitude: 2.3522, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+	}
 
 	cli1 := st.population.NewClient(usr1.GetOrganizationOrUserIdentifiers())
 	cli1.Description = "This is the description of " + cli1.Name
@@ -54,7 +59,12 @@ func (st *StoreTest) TestEntitySearch(t *T) {
 	gtw1.Ids.Eui = types.EUI64{3, 3, 3, 3, 3, 3, 3, 3}.Bytes()
 	gtw1.Description = "This is the description of " + gtw1.Name
 	gtw1.Attributes = attributes
-	st.population.NewGateway(nil)
+	gtw1.Antennas = []*ttnpb.GatewayAntenna{{
+		Location: &ttnpb.Location{Latitude: 52.3676, Longitude: 4.9041, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+	}}
+	st.population.NewGateway(nil).Antennas = []*ttnpb.GatewayAntenna{{
+		Location: &ttnpb.Location{Latitude: 48.8566, Longitude: 2.3522, Source: ttnpb.LocationSource_SOURCE_REGISTRY},
+	}}
 
 	org1 := st.population.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
 	org1.Description = "This is the description of " + org1.Name
@@ -325,6 +335,32 @@ func (st *StoreTest) TestEntitySearch(t *T) {
 				a.So(ids[0], should.Resemble, dev1ID)
 			}
 		})
+		t.Run("BoundingBox", func(t *T) {
+			a, ctx := test.New(t)
+			ids, err := s.SearchEndDevicesByLocation(ctx, nil, store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 50, MinLongitude: 0, MaxLatitude: 55, MaxLongitude: 10},
+			})
+			if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+				a.So(ids[0], should.Resemble, dev1ID)
+			}
+			ids, err = s.SearchEndDevicesByLocation(ctx, app2.GetIds(), store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 45, MinLongitude: 0, MaxLatitude: 55, MaxLongitude: 10},
+			})
+			if a.So(err, should.BeNil) {
+				a.So(ids, should.HaveLength, 1)
+			}
+		})
+		t.Run("Radius", func(t *T) {
+			a, ctx := test.New(t)
+			ids, err := s.SearchEndDevicesByLocation(ctx, app1.GetIds(), store.GeoQueryAround(52.3702, 4.8952, 1000))
+			if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+				a.So(ids[0], should.Resemble, dev1ID)
+			}
+			ids, err = s.SearchEndDevicesByLocation(ctx, app1.GetIds(), store.GeoQueryAround(52.3702, 4.8952, 100))
+			if a.So(err, should.BeNil) {
+				a.So(ids, should.BeEmpty)
+			}
+		})
 	})
 
 	t.Run("Gateways", func(t *T) {
@@ -400,6 +436,34 @@ func (st *StoreTest) TestEntitySearch(t *T) {
 				a.So(ids[0], should.Resemble, gtw1ID)
 			}
 		})
+		t.Run("BoundingBox", func(t *T) {
+			a, ctx := test.New(t)
+			ids, err := s.SearchGatewaysByLocation(ctx, nil, store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 45, MinLongitude: 0, MaxLatitude: 55, MaxLongitude: 10},
+			})
+			if a.So(err, should.BeNil) {
+				a.So(ids, should.HaveLength, 2)
+			}
+			ids, err = s.SearchGatewaysByLocation(ctx, usr1.GetOrganizationOrUserIdentifiers(), store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 45, MinLongitude: 0, MaxLatitude: 55, MaxLongitude: 10},
+			})
+			if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+				a.So(ids[0], should.Resemble, gtw1ID)
+			}
+			ids, err = s.SearchGatewaysByLocation(ctx, nil, store.GeoQuery{
+				BoundingBox: store.BoundingBox{MinLatitude: 45, MinLongitude: 170, MaxLatitude: 55, MaxLongitude: -170},
+			})
+			if a.So(err, should.BeNil) {
+				a.So(ids, should.BeEmpty)
+			}
+		})
+		t.Run("Radius", func(t *T) {
+			a, ctx := test.New(t)
+			ids, err := s.SearchGatewaysByLocation(ctx, nil, store.GeoQueryAround(48.8584, 2.2945, 10000))
+			if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+				a.So(ids[0].GetGatewayId(), should.NotEqual, gtw1ID.GetGatewayId())
+			}
+		})
 	})
 
 	t.Run("Organizations", func(t *T) {
@@ -0,0 +1,99 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestExternalAccountStore(t *T) {
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.ExternalAccountStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement ExternalAccountStore")
+	}
+	defer s.Close()
+
+	t.Run("CreateExternalAccount", func(t *T) {
+		a, ctx := test.New(t)
+		created, err := s.CreateExternalAccount(ctx, &is.ExternalAccount{
+			UserIDs:  usr1.GetIds(),
+			Provider: "github",
+			Subject:  "12345",
+		})
+		if a.So(err, should.BeNil) && a.So(created, should.NotBeNil) {
+			a.So(created.UserIDs, should.Resemble, usr1.GetIds())
+			a.So(created.Provider, should.Equal, "github")
+			a.So(created.Subject, should.Equal, "12345")
+		}
+	})
+
+	t.Run("CreateExternalAccount_AlreadyLinked", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.CreateExternalAccount(ctx, &is.ExternalAccount{
+			UserIDs:  usr2.GetIds(),
+			Provider: "github",
+			Subject:  "12345",
+		})
+		a.So(errors.IsAlreadyExists(err), should.BeTrue)
+	})
+
+	t.Run("GetExternalAccount", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.GetExternalAccount(ctx, "github", "12345")
+		if a.So(err, should.BeNil) && a.So(got, should.NotBeNil) {
+			a.So(got.UserIDs.GetUserId(), should.Equal, usr1.GetIds().GetUserId())
+		}
+
+		_, err = s.GetExternalAccount(ctx, "google", "12345")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("ListExternalAccounts", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.ListExternalAccounts(ctx, usr1.GetIds())
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 1) {
+			a.So(got[0].Provider, should.Equal, "github")
+		}
+
+		got, err = s.ListExternalAccounts(ctx, usr2.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+
+	t.Run("DeleteExternalAccount", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteExternalAccount(ctx, usr1.GetIds(), "github")
+		a.So(err, should.BeNil)
+
+		err = s.DeleteExternalAccount(ctx, usr1.GetIds(), "github")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		_, err = s.GetExternalAccount(ctx, "github", "12345")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+}
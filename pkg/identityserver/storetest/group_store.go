@@ -0,0 +1,179 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestGroupStore(t *T) {
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+	org1 := st.population.NewOrganization(usr1.GetOrganizationOrUserIdentifiers())
+	st.population.NewMembership(
+		usr2.GetOrganizationOrUserIdentifiers(), org1.GetEntityIdentifiers(),
+		ttnpb.Right_RIGHT_ORGANIZATION_INFO,
+		ttnpb.Right_RIGHT_APPLICATION_INFO,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ,
+	)
+	app1 := st.population.NewApplication(nil)
+	gtw1 := st.population.NewGateway(nil)
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.MembershipStore
+		is.GroupStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement GroupStore")
+	}
+	defer s.Close()
+
+	orgID := org1.GetIds()
+	usrID := usr2.GetOrganizationOrUserIdentifiers()
+
+	t.Run("GetGroup_NotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.GetGroup(ctx, orgID, "field-technicians")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("SetGroup", func(t *T) {
+		a, ctx := test.New(t)
+		group, err := s.SetGroup(ctx, orgID, &is.Group{
+			GroupID: "field-technicians",
+			Name:    "Field Technicians",
+		})
+		if a.So(err, should.BeNil) && a.So(group, should.NotBeNil) {
+			a.So(group.GroupID, should.Equal, "field-technicians")
+			a.So(group.Name, should.Equal, "Field Technicians")
+			a.So(group.CreatedAt, should.NotBeZeroValue)
+		}
+
+		group, err = s.SetGroup(ctx, orgID, &is.Group{
+			GroupID:     "field-technicians",
+			Name:        "Field Technicians",
+			Description: "Technicians that install devices and gateways",
+		})
+		if a.So(err, should.BeNil) && a.So(group, should.NotBeNil) {
+			a.So(group.Description, should.Equal, "Technicians that install devices and gateways")
+		}
+
+		groups, err := s.ListGroups(ctx, orgID)
+		if a.So(err, should.BeNil) && a.So(groups, should.HaveLength, 1) {
+			a.So(groups[0].GroupID, should.Equal, "field-technicians")
+		}
+	})
+
+	t.Run("AddGroupMember", func(t *T) {
+		a, ctx := test.New(t)
+		for i := 0; i < 2; i++ {
+			err := s.AddGroupMember(ctx, orgID, "field-technicians", usr2.GetIds())
+			a.So(err, should.BeNil)
+		}
+		members, err := s.ListGroupMembers(ctx, orgID, "field-technicians")
+		if a.So(err, should.BeNil) && a.So(members, should.HaveLength, 1) {
+			a.So(members[0].GetUserId(), should.Equal, usr2.GetIds().GetUserId())
+		}
+	})
+
+	t.Run("SetGroupMembership", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetGroupMembership(
+			ctx, orgID, "field-technicians", app1.GetEntityIdentifiers(),
+			ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE),
+		)
+		a.So(err, should.BeNil)
+		err = s.SetGroupMembership(
+			ctx, orgID, "field-technicians", gtw1.GetEntityIdentifiers(),
+			ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_INFO),
+		)
+		a.So(err, should.BeNil)
+
+		memberships, err := s.ListGroupMemberships(ctx, orgID, "field-technicians")
+		if a.So(err, should.BeNil) {
+			a.So(memberships, should.HaveLength, 2)
+		}
+
+		// The members of the group get the rights of the group, limited to their rights on the organization.
+		chains, err := s.FindAccountMembershipChains(ctx, usrID, "application", app1.GetIds().GetApplicationId())
+		if a.So(err, should.BeNil) && a.So(chains, should.HaveLength, 1) {
+			rights := is.MembershipChains(chains).GetRights(usrID, app1.GetIds())
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO), should.BeTrue)
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DEVICES_WRITE), should.BeFalse)
+		}
+
+		err = s.SetGroupMembership(ctx, orgID, "field-technicians", gtw1.GetEntityIdentifiers(), nil)
+		a.So(err, should.BeNil)
+		memberships, err = s.ListGroupMemberships(ctx, orgID, "field-technicians")
+		if a.So(err, should.BeNil) && a.So(memberships, should.HaveLength, 1) {
+			a.So(memberships[0].EntityIdentifiers.GetApplicationIds(), should.Resemble, app1.GetIds())
+		}
+	})
+
+	t.Run("RemoveGroupMember", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.RemoveGroupMember(ctx, orgID, "field-technicians", usr2.GetIds())
+		a.So(err, should.BeNil)
+
+		chains, err := s.FindAccountMembershipChains(ctx, usrID, "application", app1.GetIds().GetApplicationId())
+		if a.So(err, should.BeNil) {
+			a.So(chains, should.BeEmpty)
+		}
+	})
+
+	t.Run("DeleteEntityGroupMemberships", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteEntityGroupMemberships(ctx, app1.GetEntityIdentifiers())
+		a.So(err, should.BeNil)
+
+		memberships, err := s.ListGroupMemberships(ctx, orgID, "field-technicians")
+		if a.So(err, should.BeNil) {
+			a.So(memberships, should.BeEmpty)
+		}
+	})
+
+	t.Run("DeleteGroup", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteGroup(ctx, orgID, "field-technicians")
+		a.So(err, should.BeNil)
+
+		_, err = s.GetGroup(ctx, orgID, "field-technicians")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("DeleteOrganizationGroups", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.SetGroup(ctx, orgID, &is.Group{GroupID: "installers"})
+		a.So(err, should.BeNil)
+		err = s.AddGroupMember(ctx, orgID, "installers", usr2.GetIds())
+		a.So(err, should.BeNil)
+
+		err = s.DeleteOrganizationGroups(ctx, orgID)
+		a.So(err, should.BeNil)
+
+		groups, err := s.ListGroups(ctx, orgID)
+		if a.So(err, should.BeNil) {
+			a.So(groups, should.BeEmpty)
+		}
+	})
+}
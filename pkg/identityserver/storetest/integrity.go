@@ -0,0 +1,102 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestIntegrity(t *T) {
+	usr1 := st.population.NewUser()
+	app1 := st.population.NewApplication(usr1.GetOrganizationOrUserIdentifiers())
+	st.population.NewAPIKey(app1.GetEntityIdentifiers(), ttnpb.Right_RIGHT_APPLICATION_INFO)
+	app2 := st.population.NewApplication(usr1.GetOrganizationOrUserIdentifiers())
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.ApplicationStore
+		is.MembershipStore
+		is.IntegrityChecker
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement IntegrityChecker")
+	}
+	defer s.Close()
+
+	t.Run("CheckIntegrity_Clean", func(t *T) {
+		a, ctx := test.New(t)
+		findings, err := s.CheckIntegrity(ctx, false)
+		if a.So(err, should.BeNil) {
+			a.So(findings, should.BeEmpty)
+		}
+	})
+
+	// Purging the application without deleting its memberships and API keys leaves them behind.
+	a, ctx := test.New(t)
+	err := s.PurgeApplication(ctx, app1.GetIds())
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	checks := func(findings []*is.IntegrityFinding) map[string]int {
+		res := make(map[string]int, len(findings))
+		for _, finding := range findings {
+			res[finding.Check] = len(finding.IDs)
+		}
+		return res
+	}
+
+	t.Run("CheckIntegrity", func(t *T) {
+		a, ctx := test.New(t)
+		findings, err := s.CheckIntegrity(ctx, false)
+		if a.So(err, should.BeNil) {
+			a.So(checks(findings), should.Resemble, map[string]int{
+				"membership_entity": 1,
+				"api_key_entity":    1,
+			})
+		}
+
+		// Without repair, nothing is deleted.
+		findings, err = s.CheckIntegrity(ctx, false)
+		if a.So(err, should.BeNil) {
+			a.So(findings, should.HaveLength, 2)
+		}
+	})
+
+	t.Run("CheckIntegrity_Repair", func(t *T) {
+		a, ctx := test.New(t)
+		findings, err := s.CheckIntegrity(ctx, true)
+		if a.So(err, should.BeNil) {
+			a.So(findings, should.HaveLength, 2)
+		}
+
+		findings, err = s.CheckIntegrity(ctx, false)
+		if a.So(err, should.BeNil) {
+			a.So(findings, should.BeEmpty)
+		}
+
+		// The memberships of other applications are kept.
+		members, err := s.FindMembers(ctx, app2.GetEntityIdentifiers())
+		if a.So(err, should.BeNil) {
+			a.So(members, should.HaveLength, 1)
+		}
+	})
+}
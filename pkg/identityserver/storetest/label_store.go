@@ -0,0 +1,130 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestLabelStore(t *T) {
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+	app1 := st.population.NewApplication(usr1.GetOrganizationOrUserIdentifiers())
+	app2 := st.population.NewApplication(usr2.GetOrganizationOrUserIdentifiers())
+	dev1 := st.population.NewEndDevice(app1.GetIds())
+	dev2 := st.population.NewEndDevice(app2.GetIds())
+	gtw1 := st.population.NewGateway(usr1.GetOrganizationOrUserIdentifiers())
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+
+		is.LabelStore
+		is.EntitySearch
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement LabelStore and EntitySearch")
+	}
+	defer s.Close()
+
+	labels := map[string]string{"site": "plant-7", "floor": "2"}
+
+	for _, ids := range []*ttnpb.EntityIdentifiers{
+		app1.GetIds().GetEntityIdentifiers(),
+		dev1.GetIds().GetEntityIdentifiers(),
+		dev2.GetIds().GetEntityIdentifiers(),
+		gtw1.GetIds().GetEntityIdentifiers(),
+	} {
+		t.Run("SetLabels_"+ids.EntityType(), func(t *T) {
+			a, ctx := test.New(t)
+			set, err := s.SetLabels(ctx, ids, labels)
+			if a.So(err, should.BeNil) {
+				a.So(set, should.Resemble, labels)
+			}
+		})
+	}
+
+	t.Run("GetLabels", func(t *T) {
+		a, ctx := test.New(t)
+		got, err := s.GetLabels(ctx, gtw1.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, labels)
+		}
+		got, err = s.GetLabels(ctx, app2.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+
+	t.Run("SearchEntitiesByLabels", func(t *T) {
+		a, ctx := test.New(t)
+
+		ids, err := s.SearchEntitiesByLabels(ctx, nil, "end_device", map[string]string{"site": "plant-7"})
+		if a.So(err, should.BeNil) {
+			a.So(ids, should.HaveLength, 2)
+		}
+
+		ids, err = s.SearchEntitiesByLabels(
+			ctx, usr1.GetOrganizationOrUserIdentifiers(), "end_device", map[string]string{"site": "plant-7"},
+		)
+		if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+			a.So(ids[0].GetDeviceIds().GetDeviceId(), should.Equal, dev1.GetIds().GetDeviceId())
+		}
+
+		ids, err = s.SearchEntitiesByLabels(
+			ctx, usr1.GetOrganizationOrUserIdentifiers(), is.EntityGateway, labels,
+		)
+		if a.So(err, should.BeNil) && a.So(ids, should.HaveLength, 1) {
+			a.So(ids[0].GetGatewayIds().GetGatewayId(), should.Equal, gtw1.GetIds().GetGatewayId())
+		}
+
+		ids, err = s.SearchEntitiesByLabels(
+			ctx, nil, is.EntityGateway, map[string]string{"site": "plant-7", "floor": "3"},
+		)
+		if a.So(err, should.BeNil) {
+			a.So(ids, should.BeEmpty)
+		}
+
+		ids, err = s.SearchEntitiesByLabels(
+			ctx, usr2.GetOrganizationOrUserIdentifiers(), is.EntityApplication, labels,
+		)
+		if a.So(err, should.BeNil) {
+			a.So(ids, should.BeEmpty)
+		}
+	})
+
+	t.Run("SetLabels_Replace", func(t *T) {
+		a, ctx := test.New(t)
+		set, err := s.SetLabels(ctx, gtw1.GetIds(), map[string]string{"site": "plant-8"})
+		if a.So(err, should.BeNil) {
+			a.So(set, should.Resemble, map[string]string{"site": "plant-8"})
+		}
+	})
+
+	t.Run("DeleteEntityLabels", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.DeleteEntityLabels(ctx, app1.GetIds())
+		a.So(err, should.BeNil)
+		got, err := s.GetLabels(ctx, app1.GetIds())
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+}
@@ -133,6 +133,26 @@ func (st *StoreTest) TestNotificationStore(t *T) {
 		}
 	})
 
+	t.Run("ListNotificationReceivers", func(t *T) {
+		a, ctx := test.New(t)
+		unseen := []ttnpb.NotificationStatus{ttnpb.NotificationStatus_NOTIFICATION_STATUS_UNSEEN}
+
+		got, err := s.ListNotificationReceivers(ctx, time.Now().Add(-time.Hour), time.Now(), nil)
+		if a.So(err, should.BeNil) && a.So(got, should.HaveLength, 2) {
+			a.So(got, should.Resemble, []*ttnpb.UserIdentifiers{usr1.GetIds(), usr2.GetIds()})
+		}
+
+		got, err = s.ListNotificationReceivers(ctx, time.Now().Add(-time.Hour), time.Now(), unseen)
+		if a.So(err, should.BeNil) {
+			a.So(got, should.Resemble, []*ttnpb.UserIdentifiers{usr2.GetIds()})
+		}
+
+		got, err = s.ListNotificationReceivers(ctx, time.Now(), time.Now().Add(time.Hour), unseen)
+		if a.So(err, should.BeNil) {
+			a.So(got, should.BeEmpty)
+		}
+	})
+
 	t.Run("ListNotifications_usr2", func(t *T) {
 		a, ctx := test.New(t)
 
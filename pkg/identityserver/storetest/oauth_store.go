@@ -194,6 +194,8 @@ func (st *StoreTest) TestOAuthStore(t *T) {
 			Code:          "CODE",
 			RedirectUri:   "https://example.com",
 			State:         "state",
+			Nonce:         "nonce",
+			Scopes:        []string{"openid", "email"},
 			ExpiresAt:     timestamppb.New(start.Add(5 * time.Minute)),
 		})
 		if a.So(err, should.BeNil) && a.So(createdAuthorizationCode, should.NotBeNil) {
@@ -204,6 +206,8 @@ func (st *StoreTest) TestOAuthStore(t *T) {
 			a.So(createdAuthorizationCode.Code, should.Equal, "CODE")
 			a.So(createdAuthorizationCode.RedirectUri, should.Equal, "https://example.com")
 			a.So(createdAuthorizationCode.State, should.Equal, "state")
+			a.So(createdAuthorizationCode.Nonce, should.Equal, "nonce")
+			a.So(createdAuthorizationCode.Scopes, should.Resemble, []string{"openid", "email"})
 			a.So(*ttnpb.StdTime(createdAuthorizationCode.ExpiresAt), should.Equal, start.Add(5*time.Minute))
 			a.So(*ttnpb.StdTime(createdAuthorizationCode.CreatedAt), should.HappenWithin, 5*time.Second, start)
 		}
@@ -0,0 +1,171 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestRoleStore(t *T) {
+	app1 := st.population.NewApplication(nil)
+	usr1 := st.population.NewUser()
+	usr2 := st.population.NewUser()
+
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.MembershipStore
+		is.RoleStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement RoleStore")
+	}
+	defer s.Close()
+
+	a, ctx := test.New(t)
+	entityID := app1.GetEntityIdentifiers()
+	for _, usr := range []*ttnpb.User{usr1, usr2} {
+		err := s.SetMember(
+			ctx, usr.GetOrganizationOrUserIdentifiers(), entityID,
+			ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO),
+		)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	t.Run("GetRole_NotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.GetRole(ctx, entityID, "support")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("SetRole", func(t *T) {
+		a, ctx := test.New(t)
+		role, err := s.SetRole(ctx, entityID, &is.Role{
+			Name:        "support",
+			Description: "Support engineers",
+			Rights:      ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		})
+		if a.So(err, should.BeNil) && a.So(role, should.NotBeNil) {
+			a.So(role.Name, should.Equal, "support")
+			a.So(role.Description, should.Equal, "Support engineers")
+			a.So(role.Rights.GetRights(), should.HaveLength, 2)
+			a.So(role.CreatedAt, should.NotBeZeroValue)
+		}
+	})
+
+	t.Run("SetMemberRole", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetMemberRole(ctx, usr1.GetOrganizationOrUserIdentifiers(), entityID, "support")
+		a.So(err, should.BeNil)
+
+		role, err := s.GetMemberRole(ctx, usr1.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(role, should.Equal, "support")
+		}
+		role, err = s.GetMemberRole(ctx, usr2.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(role, should.BeEmpty)
+		}
+	})
+
+	t.Run("SetRole_Propagate", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.SetRole(ctx, entityID, &is.Role{
+			Name:   "support",
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		})
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+
+		rights, err := s.GetMember(ctx, usr1.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ), should.BeTrue)
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ), should.BeFalse)
+		}
+		rights, err = s.GetMember(ctx, usr2.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ), should.BeFalse)
+		}
+	})
+
+	t.Run("ListRoles", func(t *T) {
+		a, ctx := test.New(t)
+		roles, err := s.ListRoles(ctx, entityID)
+		if a.So(err, should.BeNil) && a.So(roles, should.HaveLength, 1) {
+			a.So(roles[0].Name, should.Equal, "support")
+		}
+	})
+
+	t.Run("SetMember_ResetsRole", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetMember(
+			ctx, usr1.GetOrganizationOrUserIdentifiers(), entityID,
+			ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO),
+		)
+		a.So(err, should.BeNil)
+		role, err := s.GetMemberRole(ctx, usr1.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(role, should.BeEmpty)
+		}
+	})
+
+	t.Run("DeleteRole", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetMemberRole(ctx, usr2.GetOrganizationOrUserIdentifiers(), entityID, "support")
+		a.So(err, should.BeNil)
+
+		err = s.DeleteRole(ctx, entityID, "support")
+		a.So(err, should.BeNil)
+
+		_, err = s.GetRole(ctx, entityID, "support")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+
+		// The member keeps the rights of the deleted role.
+		role, err := s.GetMemberRole(ctx, usr2.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(role, should.BeEmpty)
+		}
+		rights, err := s.GetMember(ctx, usr2.GetOrganizationOrUserIdentifiers(), entityID)
+		if a.So(err, should.BeNil) {
+			a.So(rights.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ), should.BeTrue)
+		}
+	})
+
+	t.Run("DeleteEntityRoles", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.SetRole(ctx, entityID, &is.Role{
+			Name:   "viewer-plus",
+			Rights: ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_INFO),
+		})
+		a.So(err, should.BeNil)
+
+		err = s.DeleteEntityRoles(ctx, entityID)
+		a.So(err, should.BeNil)
+
+		roles, err := s.ListRoles(ctx, entityID)
+		if a.So(err, should.BeNil) {
+			a.So(roles, should.BeEmpty)
+		}
+	})
+}
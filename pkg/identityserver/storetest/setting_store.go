@@ -0,0 +1,66 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestSettingStore(t *T) {
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.SettingStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement SettingStore")
+	}
+	defer s.Close()
+
+	t.Run("GetSetting_NotFound", func(t *T) {
+		a, ctx := test.New(t)
+		_, err := s.GetSetting(ctx, "foo")
+		a.So(errors.IsNotFound(err), should.BeTrue)
+	})
+
+	t.Run("SetSetting", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetSetting(ctx, "foo", []byte(`{"bar":true}`))
+		a.So(err, should.BeNil)
+	})
+
+	t.Run("GetSetting", func(t *T) {
+		a, ctx := test.New(t)
+		value, err := s.GetSetting(ctx, "foo")
+		if a.So(err, should.BeNil) {
+			a.So(value, should.Resemble, []byte(`{"bar":true}`))
+		}
+	})
+
+	t.Run("SetSetting_Existing", func(t *T) {
+		a, ctx := test.New(t)
+		err := s.SetSetting(ctx, "foo", []byte(`{"bar":false}`))
+		a.So(err, should.BeNil)
+		value, err := s.GetSetting(ctx, "foo")
+		if a.So(err, should.BeNil) {
+			a.So(value, should.Resemble, []byte(`{"bar":false}`))
+		}
+	})
+}
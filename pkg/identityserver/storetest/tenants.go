@@ -0,0 +1,83 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storetest
+
+import (
+	. "testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	is "go.thethings.network/lorawan-stack/v3/pkg/identityserver/store"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func (st *StoreTest) TestTenants(t *T) {
+	s, ok := st.PrepareDB(t).(interface {
+		Store
+		is.ApplicationStore
+		is.UserStore
+	})
+	defer st.DestroyDB(t, false)
+	if !ok {
+		t.Skip("Store does not implement ApplicationStore and UserStore")
+	}
+	defer s.Close()
+
+	a, defaultCtx := test.New(t)
+	otherCtx := tenant.NewContextWithID(defaultCtx, "other")
+
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: "foo"}
+	_, err := s.CreateApplication(defaultCtx, &ttnpb.Application{Ids: ids, Name: "Default"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	_, err = s.GetApplication(otherCtx, ids, fieldMask("name"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	// The same identifiers can be used in another tenant.
+	_, err = s.CreateApplication(otherCtx, &ttnpb.Application{Ids: ids, Name: "Other"})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	app, err := s.GetApplication(defaultCtx, ids, fieldMask("name"))
+	if a.So(err, should.BeNil) {
+		a.So(app.Name, should.Equal, "Default")
+	}
+	app, err = s.GetApplication(otherCtx, ids, fieldMask("name"))
+	if a.So(err, should.BeNil) {
+		a.So(app.Name, should.Equal, "Other")
+	}
+
+	apps, err := s.FindApplications(otherCtx, nil, fieldMask("ids"))
+	if a.So(err, should.BeNil) {
+		a.So(apps, should.HaveLength, 1)
+	}
+
+	_, err = s.CreateUser(defaultCtx, &ttnpb.User{
+		Ids:                 &ttnpb.UserIdentifiers{UserId: "foo"},
+		PrimaryEmailAddress: "foo@example.com",
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	_, err = s.GetUser(otherCtx, &ttnpb.UserIdentifiers{UserId: "foo"}, fieldMask("ids"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	_, err = s.GetUserByPrimaryEmailAddress(otherCtx, "foo@example.com", fieldMask("ids"))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
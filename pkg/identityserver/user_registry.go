@@ -435,6 +435,14 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 		cleanContactInfo(req.User.ContactInfo)
 	}
 
+	// If email changes require confirmation, the primary email address is updated once the change is confirmed.
+	changingEmailAddress := requiresEmailChangeConfirmation(
+		is.configFromContext(ctx).EmailChange.RequireConfirmation, updatedByAdmin, req.FieldMask.GetPaths(),
+	)
+	if changingEmailAddress {
+		req.FieldMask.Paths = ttnpb.ExcludeFields(req.FieldMask.GetPaths(), "primary_email_address")
+	}
+
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "state") {
 		if !ttnpb.HasAnyField(req.FieldMask.GetPaths(), "state_description") {
 			req.FieldMask.Paths = append(req.FieldMask.GetPaths(), "state_description")
@@ -471,7 +479,14 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 		defer func() { is.setFullProfilePictureURL(ctx, usr) }()
 	}
 
+	var emailChange *store.EmailChange
 	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) (err error) {
+		if changingEmailAddress {
+			emailChange, err = is.createEmailChange(ctx, st, req.User.GetIds(), req.User.PrimaryEmailAddress)
+			if err != nil {
+				return err
+			}
+		}
 		if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "admin") {
 			if err := isLastAdmin(ctx, st, req.User.Ids); err != nil {
 				// Is updating the last admin to no longer be an admin.
@@ -518,6 +533,10 @@ func (is *IdentityServer) updateUser(ctx context.Context, req *ttnpb.UpdateUserR
 	if err != nil {
 		return nil, err
 	}
+	if emailChange != nil {
+		usr.PrimaryEmailAddress = emailChange.OldEmailAddress
+		is.sendEmailChangeConfirmations(ctx, emailChange)
+	}
 
 	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, req.User.GetIds(), req.FieldMask.GetPaths()))
 	if ttnpb.HasAnyField(req.FieldMask.GetPaths(), "state") {
@@ -653,19 +672,38 @@ func (is *IdentityServer) updateUserPassword(ctx context.Context, req *ttnpb.Upd
 var errTemporaryPasswordStillValid = errors.DefineInvalidArgument("temporary_password_still_valid", "previous temporary password still valid")
 
 func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnpb.CreateTemporaryPasswordRequest) (*emptypb.Empty, error) {
-	temporaryPassword, err := auth.GenerateKey(ctx)
+	verifier, err := is.passwordResetVerifier(ctx)
 	if err != nil {
 		return nil, err
 	}
+	if verifier != nil {
+		// The temporary password is created after the user is verified out-of-band.
+		if err := is.challengePasswordReset(ctx, req.GetUserIds(), verifier); err != nil {
+			return nil, err
+		}
+		return ttnpb.Empty, nil
+	}
+	if err := is.issueTemporaryPassword(ctx, req.GetUserIds()); err != nil {
+		return nil, err
+	}
+	return ttnpb.Empty, nil
+}
+
+// issueTemporaryPassword creates a temporary password for the user and sends it to the user by email.
+func (is *IdentityServer) issueTemporaryPassword(ctx context.Context, ids *ttnpb.UserIdentifiers) error {
+	temporaryPassword, err := auth.GenerateKey(ctx)
+	if err != nil {
+		return err
+	}
 	hashedTemporaryPassword, err := auth.Hash(ctx, temporaryPassword)
 	if err != nil {
-		return nil, err
+		return err
 	}
 	now := time.Now()
 	ttl := time.Hour
 	expires := now.Add(ttl)
 	err = is.store.Transact(ctx, func(ctx context.Context, st store.Store) error {
-		usr, err := st.GetUser(ctx, req.GetUserIds(), temporaryPasswordFieldMask)
+		usr, err := st.GetUser(ctx, ids, temporaryPasswordFieldMask)
 		if err != nil {
 			return err
 		}
@@ -678,23 +716,23 @@ func (is *IdentityServer) createTemporaryPassword(ctx context.Context, req *ttnp
 		return err
 	})
 	if err != nil {
-		return nil, err
+		return err
 	}
 
 	log.FromContext(ctx).WithFields(log.Fields(
-		"user_uid", unique.ID(ctx, req.GetUserIds()),
+		"user_uid", unique.ID(ctx, ids),
 		"temporary_password", temporaryPassword,
 	)).Info("Created temporary password")
-	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, req.GetUserIds(), updateTemporaryPasswordFieldMask))
+	events.Publish(evtUpdateUser.NewWithIdentifiersAndData(ctx, ids, updateTemporaryPasswordFieldMask))
 	go is.SendTemplateEmailToUserIDs(is.FromRequestContext(ctx), "temporary_password", func(ctx context.Context, data email.TemplateData) (email.TemplateData, error) {
 		return &templates.TemporaryPasswordData{
 			TemplateData:      data,
 			TemporaryPassword: temporaryPassword,
 			TTL:               ttl,
 		}, nil
-	}, req.GetUserIds())
+	}, ids)
 
-	return ttnpb.Empty, nil
+	return nil
 }
 
 func (is *IdentityServer) deleteUser(ctx context.Context, ids *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
@@ -764,6 +802,10 @@ func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifi
 		if err != nil {
 			return err
 		}
+		err = st.DeleteUserGroupMembers(ctx, ids)
+		if err != nil {
+			return err
+		}
 		err = st.DeleteUserAuthorizations(ctx, ids)
 		if err != nil {
 			return err
@@ -772,6 +814,20 @@ func (is *IdentityServer) purgeUser(ctx context.Context, ids *ttnpb.UserIdentifi
 		if err != nil {
 			return err
 		}
+		externalAccounts, err := st.ListExternalAccounts(ctx, ids)
+		if err != nil {
+			return err
+		}
+		for _, externalAccount := range externalAccounts {
+			if err := st.DeleteExternalAccount(ctx, ids, externalAccount.Provider); err != nil {
+				return err
+			}
+		}
+		// Delete related labels before purging the user.
+		err = st.DeleteEntityLabels(ctx, ids)
+		if err != nil {
+			return err
+		}
 		return st.PurgeUser(ctx, ids)
 	})
 	if err != nil {
@@ -811,6 +867,24 @@ func (ur *userRegistry) CreateTemporaryPassword(ctx context.Context, req *ttnpb.
 	return ur.createTemporaryPassword(ctx, req)
 }
 
+func (ur *userRegistry) VerifyPasswordReset(
+	ctx context.Context, req *ttnpb.VerifyPasswordResetRequest,
+) (*emptypb.Empty, error) {
+	return ur.verifyPasswordReset(ctx, req)
+}
+
+func (ur *userRegistry) ConfirmEmailChange(
+	ctx context.Context, req *ttnpb.ConfirmEmailChangeRequest,
+) (*ttnpb.EmailChangeStatus, error) {
+	return ur.confirmEmailChange(ctx, req)
+}
+
+func (ur *userRegistry) RevertEmailChange(
+	ctx context.Context, req *ttnpb.RevertEmailChangeRequest,
+) (*emptypb.Empty, error) {
+	return ur.revertEmailChange(ctx, req)
+}
+
 func (ur *userRegistry) Delete(ctx context.Context, req *ttnpb.UserIdentifiers) (*emptypb.Empty, error) {
 	return ur.deleteUser(ctx, req)
 }
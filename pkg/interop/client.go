@@ -132,9 +132,8 @@ func httpExchange(
 }
 
 type joinServerHTTPClient struct {
-	clientProvider httpclient.Provider
-	clientOpts     []httpclient.Option
-	protocol       ProtocolVersion
+	client   *http.Client
+	protocol ProtocolVersion
 	scheme,
 	dnsSuffix, fqdn string
 	port               uint32
@@ -142,14 +141,38 @@ type joinServerHTTPClient struct {
 	headers            map[string]string
 	username, password string
 	senderNSID         *types.EUI64
+	netID              string
+	timeout            time.Duration
+	breaker            *circuitBreaker
+	retrier            *asyncRetrier
 }
 
 func (cl joinServerHTTPClient) exchange(
-	ctx context.Context, pathFunc func(jsRPCPaths) string, pld, res any,
-) error {
-	client, err := cl.clientProvider.HTTPClient(ctx, cl.clientOpts...)
-	if err != nil {
-		return err
+	ctx context.Context, messageType MessageType, pathFunc func(jsRPCPaths) string, pld, res any,
+) (err error) {
+	now := time.Now()
+	if ok, until := cl.breaker.allow(now); !ok {
+		clientRequests.WithLabelValues(cl.netID, cl.fqdn, string(messageType), "circuit_breaker_open").Inc()
+		return errCircuitBreakerOpen.WithAttributes(
+			"target", cl.fqdn,
+			"until", until,
+		)
+	}
+	defer func() {
+		result := "success"
+		if err != nil {
+			result = "failure"
+		}
+		clientRequests.WithLabelValues(cl.netID, cl.fqdn, string(messageType), result).Inc()
+		clientRequestDuration.WithLabelValues(cl.netID, cl.fqdn, string(messageType)).Observe(time.Since(now).Seconds())
+		if !errors.IsCanceled(err) {
+			cl.breaker.report(time.Now(), !isTargetFailure(err))
+		}
+	}()
+	if cl.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, cl.timeout)
+		defer cancel()
 	}
 	scheme := cl.scheme
 	if scheme == "" {
@@ -168,7 +191,7 @@ func (cl joinServerHTTPClient) exchange(
 	if err != nil {
 		return err
 	}
-	return httpExchange(ctx, req.WithContext(ctx), res, client.Do)
+	return httpExchange(ctx, req.WithContext(ctx), res, cl.client.Do)
 }
 
 func parseResult(r Result) error {
@@ -186,11 +209,25 @@ func parseResult(r Result) error {
 }
 
 // GetAppSKey performs AppSKey request according to LoRaWAN Backend Interfaces specification.
+// AppSKey requests are idempotent and are retried asynchronously.
 func (cl joinServerHTTPClient) GetAppSKey(
 	ctx context.Context, asID string, req *ttnpb.SessionKeyRequest,
+) (*ttnpb.AppSKeyResponse, error) {
+	key := fmt.Sprintf("%s:%X:%X:%X", asID, req.JoinEui, req.DevEui, req.SessionKeyId)
+	res, err := cl.retrier.do(ctx, key, func(ctx context.Context) (any, error) {
+		return cl.getAppSKey(ctx, asID, req)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res.(*ttnpb.AppSKeyResponse), nil
+}
+
+func (cl joinServerHTTPClient) getAppSKey(
+	ctx context.Context, asID string, req *ttnpb.SessionKeyRequest,
 ) (*ttnpb.AppSKeyResponse, error) {
 	interopAns := &AppSKeyAns{}
-	if err := cl.exchange(ctx, jsRPCPaths.appSKey, &AppSKeyReq{
+	if err := cl.exchange(ctx, MessageTypeAppSKeyReq, jsRPCPaths.appSKey, &AppSKeyReq{
 		AsJsMessageHeader: AsJsMessageHeader{
 			MessageHeader: MessageHeader{
 				ProtocolVersion: cl.protocol,
@@ -260,7 +297,7 @@ func (cl joinServerHTTPClient) HandleJoinRequest(
 	}
 
 	interopAns := &JoinAns{}
-	if err := cl.exchange(ctx, jsRPCPaths.join, &JoinReq{
+	if err := cl.exchange(ctx, MessageTypeJoinReq, jsRPCPaths.join, &JoinReq{
 		NsJsMessageHeader: NsJsMessageHeader{
 			MessageHeader: MessageHeader{
 				ProtocolVersion: cl.protocol,
@@ -423,6 +460,8 @@ func NewClient(
 			Paths           jsRPCPaths      `yaml:"paths"`
 			Protocol        ProtocolVersion `yaml:"protocol"`
 			SenderNSID      *types.EUI64    `yaml:"sender-ns-id,omitempty"`
+			NetID           *types.NetID    `yaml:"net-id,omitempty"`
+			Timeout         time.Duration   `yaml:"timeout"`
 		}
 		if err := yaml.UnmarshalStrict(jsFileBytes, &jsConf); err != nil {
 			return nil, err
@@ -442,19 +481,51 @@ func NewClient(
 			if jsConf.DNSSuffix != "" || jsConf.FQDN == "" {
 				return nil, errDNSLookupNotSupported.New()
 			}
+			// The HTTP client is shared by all requests to the Join Server, so that connections are kept alive.
+			client, err := c.HTTPClient(ctx, opts...)
+			if err != nil {
+				return nil, err
+			}
+			timeout := jsConf.Timeout
+			if timeout == 0 {
+				timeout = conf.Timeout
+			}
+			var netID string
+			if jsConf.NetID != nil {
+				netID = jsConf.NetID.String()
+			}
+			fqdn := jsConf.FQDN
 			js = &joinServerHTTPClient{
-				clientProvider: c,
-				clientOpts:     opts,
-				protocol:       jsConf.Protocol,
-				senderNSID:     jsConf.SenderNSID,
-				scheme:         jsConf.Scheme,
-				dnsSuffix:      jsConf.DNSSuffix,
-				fqdn:           jsConf.FQDN,
-				port:           jsConf.Port,
-				paths:          jsConf.Paths,
-				headers:        jsConf.Headers,
-				username:       jsConf.BasicAuth.Username,
-				password:       jsConf.BasicAuth.Password,
+				client:     client,
+				protocol:   jsConf.Protocol,
+				senderNSID: jsConf.SenderNSID,
+				scheme:     jsConf.Scheme,
+				dnsSuffix:  jsConf.DNSSuffix,
+				fqdn:       fqdn,
+				port:       jsConf.Port,
+				paths:      jsConf.Paths,
+				headers:    jsConf.Headers,
+				username:   jsConf.BasicAuth.Username,
+				password:   jsConf.BasicAuth.Password,
+				netID:      netID,
+				timeout:    timeout,
+				breaker: &circuitBreaker{
+					threshold:    conf.CircuitBreaker.FailureThreshold,
+					openDuration: conf.CircuitBreaker.OpenDuration,
+					onChange: func(open bool) {
+						v := 0.0
+						if open {
+							v = 1
+						}
+						clientCircuitBreakerOpen.WithLabelValues(netID, fqdn).Set(v)
+					},
+				},
+				retrier: newAsyncRetrier(
+					ctx, conf.Retry.Attempts, conf.Retry.Backoff, conf.Retry.ResultTTL,
+					func() {
+						clientRetries.WithLabelValues(netID, fqdn, string(MessageTypeAppSKeyReq)).Inc()
+					},
+				),
 			}
 		default:
 			return nil, errUnknownProtocol.New()
@@ -0,0 +1,81 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+)
+
+var errCircuitBreakerOpen = errors.DefineUnavailable("circuit_breaker_open",
+	"circuit breaker open for `{target}` until `{until}`",
+)
+
+// circuitBreaker rejects requests to a target after a number of consecutive failures.
+// When the circuit breaker is open, requests are rejected until the open duration passes.
+// After that, a single request is let through: if it succeeds, the circuit breaker closes, otherwise it opens again.
+type circuitBreaker struct {
+	threshold    int
+	openDuration time.Duration
+	onChange     func(open bool)
+
+	mu        sync.Mutex
+	failures  int
+	openUntil time.Time
+	probing   bool
+}
+
+// allow returns whether a request is allowed at the given time.
+// If the circuit breaker is disabled, all requests are allowed.
+func (b *circuitBreaker) allow(now time.Time) (bool, time.Time) {
+	if b == nil || b.threshold <= 0 {
+		return true, time.Time{}
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.failures < b.threshold {
+		return true, time.Time{}
+	}
+	if now.Before(b.openUntil) || b.probing {
+		return false, b.openUntil
+	}
+	b.probing = true
+	return true, time.Time{}
+}
+
+// report reports the outcome of an allowed request.
+func (b *circuitBreaker) report(now time.Time, success bool) {
+	if b == nil || b.threshold <= 0 {
+		return
+	}
+	b.mu.Lock()
+	wasOpen := b.failures >= b.threshold
+	b.probing = false
+	if success {
+		b.failures = 0
+	} else {
+		b.failures++
+		if b.failures >= b.threshold {
+			b.openUntil = now.Add(b.openDuration)
+		}
+	}
+	isOpen := b.failures >= b.threshold
+	b.mu.Unlock()
+	if wasOpen != isOpen && b.onChange != nil {
+		b.onChange(isOpen)
+	}
+}
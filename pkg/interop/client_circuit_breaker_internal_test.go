@@ -0,0 +1,78 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	var changes []bool
+	b := &circuitBreaker{
+		threshold:    2,
+		openDuration: time.Minute,
+		onChange: func(open bool) {
+			changes = append(changes, open)
+		},
+	}
+	now := time.Unix(0, 0)
+
+	ok, _ := b.allow(now)
+	a.So(ok, should.BeTrue)
+	b.report(now, false)
+	ok, _ = b.allow(now)
+	a.So(ok, should.BeTrue)
+	b.report(now, false)
+
+	// The circuit breaker opens after two consecutive failures.
+	ok, until := b.allow(now.Add(time.Second))
+	a.So(ok, should.BeFalse)
+	a.So(until, should.Equal, now.Add(time.Minute))
+
+	// After the open duration, a single request is let through.
+	now = now.Add(time.Minute)
+	ok, _ = b.allow(now)
+	a.So(ok, should.BeTrue)
+	ok, _ = b.allow(now)
+	a.So(ok, should.BeFalse)
+
+	// The circuit breaker opens again when that request fails.
+	b.report(now, false)
+	ok, _ = b.allow(now.Add(time.Second))
+	a.So(ok, should.BeFalse)
+
+	// The circuit breaker closes when that request succeeds.
+	now = now.Add(time.Minute)
+	ok, _ = b.allow(now)
+	a.So(ok, should.BeTrue)
+	b.report(now, true)
+	ok, _ = b.allow(now)
+	a.So(ok, should.BeTrue)
+
+	a.So(changes, should.Resemble, []bool{true, false})
+
+	// A disabled circuit breaker allows all requests.
+	var disabled *circuitBreaker
+	ok, _ = disabled.allow(now)
+	a.So(ok, should.BeTrue)
+	disabled.report(now, false)
+}
@@ -0,0 +1,129 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+// isTargetFailure returns whether the error indicates that the target failed to handle the request,
+// as opposed to the target rejecting the request.
+func isTargetFailure(err error) bool {
+	return errors.IsUnknown(err) ||
+		errors.IsDeadlineExceeded(err) ||
+		errors.IsUnavailable(err) ||
+		errors.IsInternal(err) ||
+		errors.IsResourceExhausted(err)
+}
+
+// asyncRetrier retries idempotent messages in the background.
+// The attempts are bound to the lifetime of the retrier instead of the request, so that a caller that gives up
+// does not cancel the retries. Callers that send the same message while it is being retried wait for the same
+// result, and the successful result is kept for the result TTL to answer subsequent requests.
+type asyncRetrier struct {
+	ctx       context.Context
+	attempts  int
+	backoff   time.Duration
+	resultTTL time.Duration
+	onRetry   func()
+
+	mu      sync.Mutex
+	flights map[string]*retryFlight
+}
+
+type retryFlight struct {
+	done chan struct{}
+	res  any
+	err  error
+}
+
+func newAsyncRetrier(ctx context.Context, attempts int, backoff, resultTTL time.Duration, onRetry func()) *asyncRetrier {
+	return &asyncRetrier{
+		ctx:       ctx,
+		attempts:  attempts,
+		backoff:   backoff,
+		resultTTL: resultTTL,
+		onRetry:   onRetry,
+		flights:   make(map[string]*retryFlight),
+	}
+}
+
+// do calls fn until it succeeds, fails with an error that is not a target failure, or the attempts are exhausted.
+// The result is returned when it is available or when ctx is done, whichever comes first.
+func (r *asyncRetrier) do(ctx context.Context, key string, fn func(context.Context) (any, error)) (any, error) {
+	if r == nil || r.attempts <= 1 {
+		return fn(ctx)
+	}
+	r.mu.Lock()
+	f, ok := r.flights[key]
+	if !ok {
+		f = &retryFlight{
+			done: make(chan struct{}),
+		}
+		r.flights[key] = f
+		go r.run(log.NewContext(r.ctx, log.FromContext(ctx)), key, f, fn)
+	}
+	r.mu.Unlock()
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-f.done:
+		return f.res, f.err
+	}
+}
+
+func (r *asyncRetrier) run(ctx context.Context, key string, f *retryFlight, fn func(context.Context) (any, error)) {
+	backoff := r.backoff
+	for attempt := 1; ; attempt++ {
+		f.res, f.err = fn(ctx)
+		if f.err == nil || attempt >= r.attempts || !isTargetFailure(f.err) {
+			break
+		}
+		log.FromContext(ctx).WithError(f.err).WithField("attempt", attempt).Debug("Retry interop request")
+		if r.onRetry != nil {
+			r.onRetry()
+		}
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			f.res, f.err = nil, ctx.Err()
+		case <-timer.C:
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		backoff *= 2
+	}
+	close(f.done)
+
+	remove := func() {
+		r.mu.Lock()
+		if r.flights[key] == f {
+			delete(r.flights, key)
+		}
+		r.mu.Unlock()
+	}
+	if f.err != nil || r.resultTTL <= 0 {
+		remove()
+		return
+	}
+	time.AfterFunc(r.resultTTL, remove)
+}
@@ -0,0 +1,72 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var errTestUnavailable = errors.DefineUnavailable("test_unavailable", "unavailable")
+
+func TestAsyncRetrier(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	var retries int32
+	r := newAsyncRetrier(ctx, 3, test.Delay, time.Hour, func() {
+		atomic.AddInt32(&retries, 1)
+	})
+
+	// Target failures are retried until the call succeeds, also when the caller gives up.
+	var calls int32
+	fn := func(context.Context) (any, error) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			return nil, errTestUnavailable.New()
+		}
+		return "ok", nil
+	}
+	callCtx, cancel := context.WithTimeout(ctx, test.Delay/2)
+	_, err := r.do(callCtx, "key", fn)
+	cancel()
+	a.So(errors.IsDeadlineExceeded(err), should.BeTrue)
+
+	res, err := r.do(ctx, "key", fn)
+	a.So(err, should.BeNil)
+	a.So(res, should.Equal, "ok")
+	a.So(atomic.LoadInt32(&calls), should.Equal, 3)
+	a.So(atomic.LoadInt32(&retries), should.Equal, 2)
+
+	// The successful result is kept.
+	res, err = r.do(ctx, "key", fn)
+	a.So(err, should.BeNil)
+	a.So(res, should.Equal, "ok")
+	a.So(atomic.LoadInt32(&calls), should.Equal, 3)
+
+	// Errors that are not target failures are not retried.
+	calls = 0
+	_, err = r.do(ctx, "other", func(context.Context) (any, error) {
+		atomic.AddInt32(&calls, 1)
+		return nil, errNotRegistered.New()
+	})
+	a.So(errors.Resemble(err, errNotRegistered), should.BeTrue)
+	a.So(atomic.LoadInt32(&calls), should.Equal, 1)
+}
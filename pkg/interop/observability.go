@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const clientSubsystem = "interop_client"
+
+var clientRequests = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: clientSubsystem,
+		Name:      "requests_total",
+		Help:      "Number of requests to interop targets",
+	},
+	[]string{"net_id", "target", "message_type", "result"},
+)
+
+var clientRequestDuration = metrics.NewHistogramVec(
+	prometheus.HistogramOpts{
+		Subsystem: clientSubsystem,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of requests to interop targets",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	},
+	[]string{"net_id", "target", "message_type"},
+)
+
+var clientRetries = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: clientSubsystem,
+		Name:      "retries_total",
+		Help:      "Number of retried requests to interop targets",
+	},
+	[]string{"net_id", "target", "message_type"},
+)
+
+var clientCircuitBreakerOpen = metrics.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Subsystem: clientSubsystem,
+		Name:      "circuit_breaker_open",
+		Help:      "Whether the circuit breaker of the interop target is open",
+	},
+	[]string{"net_id", "target"},
+)
+
+func init() {
+	metrics.MustRegister(clientRequests, clientRequestDuration, clientRetries, clientCircuitBreakerOpen)
+}
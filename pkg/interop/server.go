@@ -21,6 +21,8 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"sync"
+	"time"
 
 	"github.com/gorilla/mux"
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
@@ -72,9 +74,13 @@ type Server struct {
 	router *mux.Router
 
 	// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
+	senderClientCAsMu  sync.RWMutex
 	senderClientCAs    map[string][]*x509.Certificate
 	senderClientCAPool *x509.CertPool
 
+	senderClientFingerprints map[string][]string
+	crls                     *crlCache
+
 	tokenVerifiers map[string]tokenVerifier
 
 	is IdentityServer
@@ -98,13 +104,6 @@ func NewServer(c Component, contextFillers []fillcontext.Filler, conf config.Int
 	if err != nil {
 		return nil, err
 	}
-	senderClientCAPool := x509.NewCertPool()
-	for _, certs := range senderClientCAs {
-		for _, cert := range certs {
-			senderClientCAPool.AddCert(cert)
-		}
-	}
-
 	tokenVerifiers := make(map[string]tokenVerifier)
 	if conf.PacketBroker.Enabled {
 		iss := conf.PacketBroker.TokenIssuer
@@ -123,10 +122,20 @@ func NewServer(c Component, contextFillers []fillcontext.Filler, conf config.Int
 	s := &Server{
 		config: conf,
 		// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
-		senderClientCAs:    senderClientCAs,
-		senderClientCAPool: senderClientCAPool,
-		tokenVerifiers:     tokenVerifiers,
-		js:                 &noopServer{},
+		senderClientFingerprints: normalizeFingerprints(conf.SenderClientCertificates.Fingerprints),
+		tokenVerifiers:           tokenVerifiers,
+		js:                       &noopServer{},
+	}
+	s.setSenderClientCAs(senderClientCAs)
+	if conf.SenderClientCertificates.CRL {
+		client, err := c.HTTPClient(ctx)
+		if err != nil {
+			return nil, err
+		}
+		s.crls = newCRLCache(client)
+	}
+	if interval := conf.SenderClientCertificates.RefreshInterval; interval > 0 {
+		go s.refreshSenderClientCAs(ctx, c, interval)
 	}
 
 	s.router = mux.NewRouter()
@@ -168,6 +177,8 @@ func (s *Server) RegisterJS(js JoinServer) {
 // ClientCAPool returns a certificate pool of all configured client CAs.
 // TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
 func (s *Server) ClientCAPool() *x509.CertPool {
+	s.senderClientCAsMu.RLock()
+	defer s.senderClientCAsMu.RUnlock()
 	return s.senderClientCAPool
 }
 
@@ -177,9 +188,45 @@ func (s *Server) ClientCAPool() *x509.CertPool {
 func (s *Server) SenderClientCAs(_ context.Context, senderID string) ([]*x509.Certificate, error) {
 	// TODO: Lookup partner CA by SenderID with DNS (https://github.com/TheThingsNetwork/lorawan-stack/issues/718).
 	// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
+	s.senderClientCAsMu.RLock()
+	defer s.senderClientCAsMu.RUnlock()
 	return s.senderClientCAs[senderID], nil
 }
 
+// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
+func (s *Server) setSenderClientCAs(senderClientCAs map[string][]*x509.Certificate) {
+	senderClientCAPool := x509.NewCertPool()
+	for _, certs := range senderClientCAs {
+		for _, cert := range certs {
+			senderClientCAPool.AddCert(cert)
+		}
+	}
+	s.senderClientCAsMu.Lock()
+	s.senderClientCAs, s.senderClientCAPool = senderClientCAs, senderClientCAPool
+	s.senderClientCAsMu.Unlock()
+}
+
+// refreshSenderClientCAs fetches the client CAs of sender IDs every interval until the context is done.
+// If fetching fails, the previously fetched client CAs remain trusted.
+// TODO: Remove (https://github.com/TheThingsNetwork/lorawan-stack/issues/6026)
+func (s *Server) refreshSenderClientCAs(ctx context.Context, c Component, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+		senderClientCAs, err := fetchSenderClientCAs(ctx, s.config, c)
+		if err != nil {
+			log.FromContext(ctx).WithError(err).Warn("Failed to refresh sender client CAs")
+			continue
+		}
+		s.setSenderClientCAs(senderClientCAs)
+	}
+}
+
 func (s *Server) handle() http.Handler {
 	senderAuthenticators := map[MessageType]senderAuthenticator{
 		MessageTypeJoinReq:    senderAuthenticatorFunc(s.authenticateNS),
@@ -32,6 +32,12 @@ func (s *Server) verifySenderCertificate(
 		peerCert, clientCA := chain[0], chain[len(chain)-1]
 		for _, senderClientCA := range senderClientCAs {
 			if clientCA.Equal(senderClientCA) {
+				if err := s.verifyCertificateFingerprint(senderID, peerCert); err != nil {
+					return nil, err
+				}
+				if err := s.verifyCertificateRevocation(ctx, chain, state.OCSPResponse); err != nil {
+					return nil, err
+				}
 				// If the TLS client certificate contains DNS addresses, use those.
 				// Otherwise, fallback to using CommonName as address.
 				if len(peerCert.DNSNames) > 0 {
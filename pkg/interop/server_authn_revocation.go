@@ -0,0 +1,241 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"golang.org/x/crypto/ocsp"
+)
+
+const (
+	// maxCRLSize is the maximum size of a fetched CRL.
+	maxCRLSize = 1 << 24 // 16 MB.
+	// defaultCRLCacheTTL is the time to cache a CRL that does not specify its next update.
+	defaultCRLCacheTTL = time.Hour
+)
+
+var (
+	errCertificateFingerprint = errors.DefineUnauthenticated("certificate_fingerprint",
+		"certificate fingerprint `{fingerprint}` not allowed for sender `{sender_id}`",
+	)
+	errCertificateRevoked = errors.DefineUnauthenticated("certificate_revoked",
+		"certificate with serial number `{serial_number}` revoked",
+	)
+	errFetchCRL  = errors.DefineUnauthenticated("fetch_crl", "fetch CRL from `{url}`")
+	errCRLStatus = errors.DefineUnauthenticated("crl_status",
+		"fetch CRL from `{url}` failed with status `{status}`",
+	)
+	errCRLSignature      = errors.DefineUnauthenticated("crl_signature", "invalid CRL signature from `{url}`")
+	errNoOCSPResponse    = errors.DefineUnauthenticated("no_ocsp_response", "no stapled OCSP response")
+	errOCSPResponse      = errors.DefineUnauthenticated("ocsp_response", "invalid stapled OCSP response")
+	errOCSPStatus        = errors.DefineUnauthenticated("ocsp_status", "OCSP certificate status `{status}`")
+	errOCSPResponseStale = errors.DefineUnauthenticated("ocsp_response_stale",
+		"stapled OCSP response expired at `{next_update}`",
+	)
+)
+
+// certificateFingerprint returns the hex encoded SHA-256 fingerprint of the certificate.
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
+// normalizeFingerprints returns the fingerprints in lowercase hex without separators.
+func normalizeFingerprints(fingerprints map[string][]string) map[string][]string {
+	if len(fingerprints) == 0 {
+		return nil
+	}
+	res := make(map[string][]string, len(fingerprints))
+	for senderID, fps := range fingerprints {
+		for _, fp := range fps {
+			fp = strings.ToLower(strings.NewReplacer(":", "", " ", "").Replace(fp))
+			res[senderID] = append(res[senderID], fp)
+		}
+	}
+	return res
+}
+
+// verifyCertificateFingerprint verifies that the certificate is allowed for the sender ID.
+// If no fingerprints are configured for the sender ID, any certificate issued by a trusted client CA is allowed.
+func (s *Server) verifyCertificateFingerprint(senderID string, cert *x509.Certificate) error {
+	fps, ok := s.senderClientFingerprints[senderID]
+	if !ok {
+		return nil
+	}
+	fp := certificateFingerprint(cert)
+	for _, allowed := range fps {
+		if fp == allowed {
+			return nil
+		}
+	}
+	return errCertificateFingerprint.WithAttributes(
+		"fingerprint", fp,
+		"sender_id", senderID,
+	)
+}
+
+// verifyCertificateRevocation verifies that the certificates in the verified chain are not revoked.
+// The last certificate in the chain is the trusted client CA.
+func (s *Server) verifyCertificateRevocation(
+	ctx context.Context, chain []*x509.Certificate, ocspResponse []byte,
+) error {
+	if len(chain) < 2 {
+		return nil
+	}
+	if s.config.SenderClientCertificates.OCSP {
+		if err := verifyOCSPResponse(ocspResponse, chain[0], chain[1], time.Now()); err != nil {
+			return err
+		}
+	}
+	if s.crls != nil {
+		for i := 0; i < len(chain)-1; i++ {
+			if err := s.crls.verify(ctx, chain[i], chain[i+1]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// verifyOCSPResponse verifies that the stapled OCSP response is signed by the issuer, or a responder delegated by
+// the issuer, and that the certificate is good at the given time.
+func verifyOCSPResponse(b []byte, cert, issuer *x509.Certificate, now time.Time) error {
+	if len(b) == 0 {
+		return errNoOCSPResponse.New()
+	}
+	res, err := ocsp.ParseResponseForCert(b, cert, issuer)
+	if err != nil {
+		return errOCSPResponse.WithCause(err)
+	}
+	switch res.Status {
+	case ocsp.Good:
+	case ocsp.Revoked:
+		return errCertificateRevoked.WithAttributes("serial_number", fmt.Sprintf("%X", cert.SerialNumber))
+	default:
+		return errOCSPStatus.WithAttributes("status", res.Status)
+	}
+	if !res.NextUpdate.IsZero() && now.After(res.NextUpdate) {
+		return errOCSPResponseStale.WithAttributes("next_update", res.NextUpdate)
+	}
+	return nil
+}
+
+// crlCache fetches and caches CRLs by their distribution point until their next update.
+type crlCache struct {
+	client *http.Client
+
+	mu    sync.Mutex
+	lists map[string]*cachedCRL
+}
+
+type cachedCRL struct {
+	list      *x509.RevocationList
+	expiresAt time.Time
+}
+
+func newCRLCache(client *http.Client) *crlCache {
+	return &crlCache{
+		client: client,
+		lists:  make(map[string]*cachedCRL),
+	}
+}
+
+// verify verifies that the certificate is not revoked by the issuer.
+// Certificates without CRL distribution points are not checked.
+func (c *crlCache) verify(ctx context.Context, cert, issuer *x509.Certificate) error {
+	for _, url := range cert.CRLDistributionPoints {
+		list, err := c.get(ctx, url, issuer)
+		if err != nil {
+			return err
+		}
+		for _, revoked := range list.RevokedCertificates {
+			if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+				return errCertificateRevoked.WithAttributes("serial_number", fmt.Sprintf("%X", cert.SerialNumber))
+			}
+		}
+	}
+	return nil
+}
+
+func (c *crlCache) get(ctx context.Context, url string, issuer *x509.Certificate) (*x509.RevocationList, error) {
+	now := time.Now()
+	c.mu.Lock()
+	cached, ok := c.lists[url]
+	c.mu.Unlock()
+	if ok && now.Before(cached.expiresAt) {
+		return cached.list, nil
+	}
+
+	list, err := c.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	if err := list.CheckSignatureFrom(issuer); err != nil {
+		return nil, errCRLSignature.WithAttributes("url", url).WithCause(err)
+	}
+	expiresAt := list.NextUpdate
+	if expiresAt.IsZero() {
+		expiresAt = now.Add(defaultCRLCacheTTL)
+	}
+	c.mu.Lock()
+	c.lists[url] = &cachedCRL{
+		list:      list,
+		expiresAt: expiresAt,
+	}
+	c.mu.Unlock()
+	return list, nil
+}
+
+func (c *crlCache) fetch(ctx context.Context, url string) (*x509.RevocationList, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, errFetchCRL.WithAttributes("url", url).WithCause(err)
+	}
+	res, err := c.client.Do(req)
+	if err != nil {
+		return nil, errFetchCRL.WithAttributes("url", url).WithCause(err)
+	}
+	defer res.Body.Close()
+	if res.StatusCode != http.StatusOK {
+		return nil, errCRLStatus.WithAttributes(
+			"url", url,
+			"status", res.StatusCode,
+		)
+	}
+	b, err := io.ReadAll(io.LimitReader(res.Body, maxCRLSize))
+	if err != nil {
+		return nil, errFetchCRL.WithAttributes("url", url).WithCause(err)
+	}
+	if block, _ := pem.Decode(b); block != nil && block.Type == "X509 CRL" {
+		b = block.Bytes
+	}
+	list, err := x509.ParseRevocationList(b)
+	if err != nil {
+		return nil, errFetchCRL.WithAttributes("url", url).WithCause(err)
+	}
+	return list, nil
+}
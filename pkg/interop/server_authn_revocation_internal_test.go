@@ -0,0 +1,175 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package interop
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"golang.org/x/crypto/ocsp"
+)
+
+type testCA struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newTestCA(t *testing.T) *testCA {
+	t.Helper()
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader))
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der := test.Must(x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key))
+	return &testCA{
+		cert: test.Must(x509.ParseCertificate(der)),
+		key:  key,
+	}
+}
+
+func (ca *testCA) issue(t *testing.T, serial int64, crlURL string) *x509.Certificate {
+	t.Helper()
+	key := test.Must(ecdsa.GenerateKey(elliptic.P256(), rand.Reader))
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	if crlURL != "" {
+		tmpl.CRLDistributionPoints = []string{crlURL}
+	}
+	der := test.Must(x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key))
+	return test.Must(x509.ParseCertificate(der))
+}
+
+func TestCRLCache(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ca, other := newTestCA(t), newTestCA(t)
+	crl := test.Must(x509.CreateRevocationList(rand.Reader, &x509.RevocationList{
+		Number:     big.NewInt(1),
+		ThisUpdate: time.Now().Add(-time.Hour),
+		NextUpdate: time.Now().Add(time.Hour),
+		RevokedCertificates: []pkix.RevokedCertificate{
+			{SerialNumber: big.NewInt(2), RevocationTime: time.Now().Add(-time.Minute)},
+		},
+	}, ca.cert, ca.key))
+
+	var fetches int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches++
+		w.Write(crl) //nolint:errcheck
+	}))
+	defer srv.Close()
+
+	cache := newCRLCache(srv.Client())
+
+	revoked := ca.issue(t, 2, srv.URL)
+	err := cache.verify(ctx, revoked, ca.cert)
+	a.So(errors.IsUnauthenticated(err), should.BeTrue)
+	a.So(errors.Resemble(err, errCertificateRevoked), should.BeTrue)
+
+	good := ca.issue(t, 3, srv.URL)
+	a.So(cache.verify(ctx, good, ca.cert), should.BeNil)
+	a.So(fetches, should.Equal, 1)
+
+	a.So(cache.verify(ctx, ca.issue(t, 4, ""), ca.cert), should.BeNil)
+
+	// The CRL is not signed by the issuer of this certificate.
+	foreign := other.issue(t, 5, srv.URL+"/other")
+	err = cache.verify(ctx, foreign, other.cert)
+	a.So(errors.Resemble(err, errCRLSignature), should.BeTrue)
+}
+
+func TestVerifyOCSPResponse(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	ca := newTestCA(t)
+	cert := ca.issue(t, 2, "")
+	now := time.Now()
+
+	makeResponse := func(status int, nextUpdate time.Time) []byte {
+		return test.Must(ocsp.CreateResponse(ca.cert, ca.cert, ocsp.Response{
+			Status:       status,
+			SerialNumber: cert.SerialNumber,
+			ThisUpdate:   now.Add(-time.Hour),
+			NextUpdate:   nextUpdate,
+			RevokedAt:    now.Add(-time.Minute),
+		}, ca.key))
+	}
+
+	a.So(verifyOCSPResponse(makeResponse(ocsp.Good, now.Add(time.Hour)), cert, ca.cert, now), should.BeNil)
+
+	for _, tc := range []struct {
+		Name     string
+		Response []byte
+		Issuer   *x509.Certificate
+		Error    *errors.Definition
+	}{
+		{
+			Name:  "Missing",
+			Error: errNoOCSPResponse,
+		},
+		{
+			Name:     "Revoked",
+			Response: makeResponse(ocsp.Revoked, now.Add(time.Hour)),
+			Error:    errCertificateRevoked,
+		},
+		{
+			Name:     "Unknown",
+			Response: makeResponse(ocsp.Unknown, now.Add(time.Hour)),
+			Error:    errOCSPStatus,
+		},
+		{
+			Name:     "Stale",
+			Response: makeResponse(ocsp.Good, now.Add(-time.Minute)),
+			Error:    errOCSPResponseStale,
+		},
+		{
+			Name:     "OtherIssuer",
+			Response: makeResponse(ocsp.Good, now.Add(time.Hour)),
+			Issuer:   newTestCA(t).cert,
+			Error:    errOCSPResponse,
+		},
+	} {
+		issuer := tc.Issuer
+		if issuer == nil {
+			issuer = ca.cert
+		}
+		err := verifyOCSPResponse(tc.Response, cert, issuer, now)
+		a.So(errors.Resemble(err, tc.Error), should.BeTrue)
+	}
+}
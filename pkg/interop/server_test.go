@@ -86,6 +86,7 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 		Name              string
 		JS                interop.JoinServer
 		ClientTLSConfig   *tls.Config
+		Fingerprints      map[string][]string
 		PacketBrokerToken bool
 		RequestBody       any
 		ResponseAssertion func(*assertions.Assertion, *http.Response) bool
@@ -149,6 +150,64 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 				return a.So(msg.Result.ResultCode, should.Equal, interop.ResultMalformedRequest)
 			},
 		},
+		{
+			Name:            "ClientTLS/JoinReq/FingerprintNotAllowed",
+			ClientTLSConfig: makeClientTLSConfig(),
+			Fingerprints: map[string][]string{
+				"000001": {"0000000000000000000000000000000000000000000000000000000000000000"},
+			},
+			RequestBody: &interop.JoinReq{
+				NsJsMessageHeader: interop.NsJsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeJoinReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.EUI64{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+				},
+				MACVersion: interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				if !a.So(err, should.BeNil) {
+					return false
+				}
+				return a.So(msg.Result.ResultCode, should.Equal, interop.ResultUnknownSender)
+			},
+		},
+		{
+			Name:            "ClientTLS/JoinReq/FingerprintAllowed",
+			ClientTLSConfig: makeClientTLSConfig(),
+			Fingerprints: map[string][]string{
+				"000001": {makeClientCertFingerprint()},
+			},
+			RequestBody: &interop.JoinReq{
+				NsJsMessageHeader: interop.NsJsMessageHeader{
+					MessageHeader: interop.MessageHeader{
+						MessageType:     interop.MessageTypeJoinReq,
+						ProtocolVersion: interop.ProtocolV1_0,
+					},
+					SenderID:   interop.NetID{0x0, 0x0, 0x01},
+					ReceiverID: interop.EUI64{0x42, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0, 0x0},
+				},
+				MACVersion: interop.MACVersion(ttnpb.MACVersion_MAC_V1_0_3),
+			},
+			ResponseAssertion: func(a *assertions.Assertion, res *http.Response) bool {
+				if !a.So(res.StatusCode, should.Equal, http.StatusOK) {
+					return false
+				}
+				var msg interop.ErrorMessage
+				err := json.NewDecoder(res.Body).Decode(&msg)
+				if !a.So(err, should.BeNil) {
+					return false
+				}
+				return a.So(msg.Result.ResultCode, should.Equal, interop.ResultMalformedRequest)
+			},
+		},
 		{
 			Name: "ClientTLS/JoinReq/UnknownDevEUI",
 			JS: mockTarget{
@@ -321,6 +380,9 @@ func TestServer(t *testing.T) { //nolint:gocyclo
 						Source:    "directory",
 						Directory: "testdata/server",
 					},
+					SenderClientCertificates: config.InteropSenderClientCertificates{
+						Fingerprints: tc.Fingerprints,
+					},
 					PacketBroker: config.PacketBrokerInteropAuth{
 						Enabled:     true,
 						TokenIssuer: pbIssuer, // Subject is the NSID and is used as authorized address.
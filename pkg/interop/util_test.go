@@ -17,8 +17,10 @@ package interop_test
 import (
 	"context"
 	"crypto/ed25519"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net"
@@ -75,6 +77,12 @@ func makeServerCertificates() []tls.Certificate {
 	}
 }
 
+func makeClientCertFingerprint() string {
+	cert := test.Must(x509.ParseCertificate(makeClientCertificates()[0].Certificate[0]))
+	sum := sha256.Sum256(cert.Raw)
+	return hex.EncodeToString(sum[:])
+}
+
 func makeClientTLSConfig() *tls.Config {
 	return &tls.Config{
 		MinVersion:   tls.VersionTLS12,
@@ -32,6 +32,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpctracer"
@@ -288,6 +289,9 @@ func (js *JoinServer) HandleJoin(ctx context.Context, req *ttnpb.JoinRequest, au
 	if !match {
 		return nil, errUnknownJoinEUI.New()
 	}
+	if err := ratelimit.Require(js.RateLimiter(), ratelimit.JoinServerJoinRequestResource(joinEUI, devEUI)); err != nil {
+		return nil, err
+	}
 
 	var handled bool
 	dev, err := js.devices.SetByEUI(ctx, joinEUI, devEUI,
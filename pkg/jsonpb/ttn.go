@@ -180,3 +180,17 @@ type ttnEventStream struct {
 func (*ttnEventStream) ContentType(_ any) string { return "text/event-stream" }
 
 func (*ttnEventStream) Delimiter() []byte { return []byte{'\n', '\n'} }
+
+// TTNNDJSON returns a TTN JsonPb marshaler with single newlines for
+// application/x-ndjson compatibility.
+func TTNNDJSON() runtime.Marshaler {
+	return &ttnNDJSON{TTNMarshaler: TTN()}
+}
+
+type ttnNDJSON struct {
+	*TTNMarshaler
+}
+
+func (*ttnNDJSON) ContentType(_ any) string { return "application/x-ndjson" }
+
+func (*ttnNDJSON) Delimiter() []byte { return []byte{'\n'} }
@@ -34,11 +34,12 @@ func NewLogger(handler Handler, opts ...Option) *Logger {
 
 // Logger implements Stack.
 type Logger struct {
-	mutex      sync.RWMutex
-	Level      Level
-	Handler    Handler
-	middleware []Middleware
-	stack      Handler
+	mutex           sync.RWMutex
+	Level           Level
+	Handler         Handler
+	middleware      []Middleware
+	stack           Handler
+	namespaceLevels []namespaceLevel
 }
 
 // Use installs the handler middleware.
@@ -62,18 +63,26 @@ func (l *Logger) Use(middleware Middleware) {
 	l.stack = handler
 }
 
+// SetLevel sets the level of the logger.
+// This can be used to change the level of the logger while it is in use.
+func (l *Logger) SetLevel(level Level) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.Level = level
+}
+
 // commit comits the entry to the handler.
 func (l *Logger) commit(e *entry) {
+	l.mutex.RLock()
 	handler := l.stack
 	if handler == nil {
 		handler = l.Handler
 	}
 
-	if handler != nil && l.Level <= e.level {
-		l.mutex.RLock()
-		defer l.mutex.RUnlock()
+	if handler != nil && l.level(e) <= e.level {
 		_ = handler.HandleLog(e)
 	}
+	l.mutex.RUnlock()
 
 	if e.Level() == FatalLevel {
 		os.Exit(1)
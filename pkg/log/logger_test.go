@@ -88,6 +88,14 @@ func TestLogger(t *testing.T) {
 		a.So(fields["foo"], should.Equal, 10)
 		a.So(fields["bar"], should.Equal, "baz")
 	}
+
+	logger.SetLevel(DebugLevel)
+	logger.Debug("Yo again!")
+	a.So(rec.entries, should.HaveLength, 5)
+	{
+		entry := rec.entries[4]
+		a.So(entry.Message(), should.Equal, "Yo again!")
+	}
 }
 
 func TestMiddleware(t *testing.T) {
@@ -140,3 +148,60 @@ func TestMiddleware(t *testing.T) {
 
 	a.So(rec.entries, should.HaveLength, 2)
 }
+
+func TestNamespaceLevels(t *testing.T) {
+	a := assertions.New(t)
+
+	rec := newRecorder()
+	logger := NewLogger(rec,
+		WithLevel(InfoLevel),
+		WithNamespaceLevels(map[string]Level{
+			"gatewayserver":        DebugLevel,
+			"gatewayserver/io/udp": WarnLevel,
+		}),
+	)
+
+	logger.Debug("No namespace")
+	a.So(rec.entries, should.HaveLength, 0)
+
+	logger.WithField("namespace", "networkserver").Debug("Other namespace")
+	a.So(rec.entries, should.HaveLength, 0)
+
+	logger.WithField("namespace", "gatewayserver").Debug("Namespace")
+	a.So(rec.entries, should.HaveLength, 1)
+
+	logger.WithField("namespace", "gatewayserver/io/ws").Debug("Sub namespace")
+	a.So(rec.entries, should.HaveLength, 2)
+
+	logger.WithField("namespace", "gatewayserverfoo").Debug("Namespace with same prefix")
+	a.So(rec.entries, should.HaveLength, 2)
+
+	udpLogger := logger.WithField("namespace", "gatewayserver/io/udp")
+	udpLogger.Info("More specific namespace")
+	a.So(rec.entries, should.HaveLength, 2)
+	udpLogger.Warn("More specific namespace")
+	a.So(rec.entries, should.HaveLength, 3)
+
+	logger.SetNamespaceLevels(nil)
+	logger.WithField("namespace", "gatewayserver").Debug("Namespace")
+	a.So(rec.entries, should.HaveLength, 3)
+}
+
+func TestParseNamespaceLevels(t *testing.T) {
+	a := assertions.New(t)
+
+	levels, err := ParseNamespaceLevels(map[string]string{
+		"networkserver": "debug",
+		"grpc":          "warn",
+	})
+	a.So(err, should.BeNil)
+	a.So(levels, should.Resemble, map[string]Level{
+		"networkserver": DebugLevel,
+		"grpc":          WarnLevel,
+	})
+
+	_, err = ParseNamespaceLevels(map[string]string{
+		"networkserver": "verbose",
+	})
+	a.So(err, should.NotBeNil)
+}
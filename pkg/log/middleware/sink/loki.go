@@ -0,0 +1,195 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+const (
+	defaultLokiBatchSize     = 100
+	defaultLokiBatchInterval = time.Second
+	defaultLokiTimeout       = 10 * time.Second
+)
+
+var (
+	errLokiURL     = errors.DefineInvalidArgument("loki_url", "no Loki URL configured")
+	errLokiRequest = errors.DefineUnavailable("loki_request", "Loki push request failed with status `{status}`")
+)
+
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+type lokiPusher struct {
+	client    *http.Client
+	url       string
+	headers   map[string]string
+	labels    map[string]string
+	timeout   time.Duration
+	batchSize int
+
+	mu    sync.Mutex
+	batch []log.Entry
+}
+
+// marshalBatch marshals the log entries to a Loki push request with a stream per log level.
+func (p *lokiPusher) marshalBatch(batch []log.Entry) ([]byte, error) {
+	streams := make(map[log.Level]*lokiStream)
+	var levels []log.Level
+	for _, entry := range batch {
+		stream, ok := streams[entry.Level()]
+		if !ok {
+			labels := make(map[string]string, len(p.labels)+1)
+			for k, v := range p.labels {
+				labels[k] = v
+			}
+			labels["level"] = entry.Level().String()
+			stream = &lokiStream{Stream: labels}
+			streams[entry.Level()] = stream
+			levels = append(levels, entry.Level())
+		}
+		stream.Values = append(stream.Values, [2]string{
+			strconv.FormatInt(entry.Timestamp().UnixNano(), 10),
+			string(marshalEntry(entry)),
+		})
+	}
+	req := struct {
+		Streams []*lokiStream `json:"streams"`
+	}{
+		Streams: make([]*lokiStream, 0, len(levels)),
+	}
+	for _, level := range levels {
+		req.Streams = append(req.Streams, streams[level])
+	}
+	return json.Marshal(req)
+}
+
+func (p *lokiPusher) push(ctx context.Context, batch []log.Entry) error {
+	body, err := p.marshalBatch(batch)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, p.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, value := range p.headers {
+		req.Header.Set(key, value)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	_, _ = io.Copy(io.Discard, res.Body)
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return errLokiRequest.WithAttributes("status", res.StatusCode)
+	}
+	return nil
+}
+
+// flush pushes the buffered log entries.
+func (p *lokiPusher) flush(ctx context.Context) {
+	p.mu.Lock()
+	batch := p.batch
+	p.batch = nil
+	p.mu.Unlock()
+	if len(batch) == 0 {
+		return
+	}
+	if err := p.push(ctx, batch); err != nil {
+		registerDropped("loki", len(batch))
+		return
+	}
+	registerSent("loki", len(batch))
+}
+
+// handle buffers the log entry, and pushes the buffered log entries when the batch is full.
+func (p *lokiPusher) handle(ctx context.Context, entry log.Entry) {
+	p.mu.Lock()
+	p.batch = append(p.batch, entry)
+	full := len(p.batch) >= p.batchSize
+	p.mu.Unlock()
+	if full {
+		p.flush(ctx)
+	}
+}
+
+// NewLoki returns a log middleware that pushes the log messages to Grafana Loki.
+func NewLoki(c Component, conf config.LogLoki) (log.Middleware, error) {
+	if conf.URL == "" {
+		return nil, errLokiURL.New()
+	}
+	ctx := c.Context()
+	client, err := c.HTTPClient(ctx)
+	if err != nil {
+		return nil, err
+	}
+	p := &lokiPusher{
+		client:    client,
+		url:       conf.URL,
+		headers:   conf.Headers,
+		labels:    conf.Labels,
+		timeout:   conf.Timeout,
+		batchSize: conf.BatchSize,
+	}
+	if p.timeout <= 0 {
+		p.timeout = defaultLokiTimeout
+	}
+	if p.batchSize <= 0 {
+		p.batchSize = defaultLokiBatchSize
+	}
+	batchInterval := conf.BatchInterval
+	if batchInterval <= 0 {
+		batchInterval = defaultLokiBatchInterval
+	}
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "log_sink_loki_flush",
+		Func: func(ctx context.Context) error {
+			ticker := time.NewTicker(batchInterval)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ctx.Done():
+					p.flush(context.Background())
+					return ctx.Err()
+				case <-ticker.C:
+					p.flush(ctx)
+				}
+			}
+		},
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return newSink(c, "loki", conf.QueueSize, p.handle), nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const (
+	subsystem = "log_sink"
+	sinkLabel = "sink"
+)
+
+var sinkMetrics = &messageMetrics{
+	messagesSent: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "messages_sent_total",
+			Help:      "Total number of log messages sent to log sinks",
+		},
+		[]string{sinkLabel},
+	),
+	messagesDropped: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "messages_dropped_total",
+			Help:      "Total number of log messages dropped by log sinks",
+		},
+		[]string{sinkLabel},
+	),
+}
+
+func init() {
+	metrics.MustRegister(sinkMetrics)
+}
+
+type messageMetrics struct {
+	messagesSent    *prometheus.CounterVec
+	messagesDropped *prometheus.CounterVec
+}
+
+func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.messagesSent.Describe(ch)
+	m.messagesDropped.Describe(ch)
+}
+
+func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.messagesSent.Collect(ch)
+	m.messagesDropped.Collect(ch)
+}
+
+func registerSent(sink string, n int) {
+	sinkMetrics.messagesSent.WithLabelValues(sink).Add(float64(n))
+}
+
+func registerDropped(sink string, n int) {
+	sinkMetrics.messagesDropped.WithLabelValues(sink).Add(float64(n))
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package sink implements pkg/log.Middleware that send log messages to external log systems.
+package sink
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/workerpool"
+)
+
+// Component is the interface of the component to the log sinks.
+type Component interface {
+	workerpool.Component
+	Context() context.Context
+	HTTPClient(context.Context, ...httpclient.Option) (*http.Client, error)
+}
+
+const defaultQueueSize = 1024
+
+// sink is a log.Middleware that publishes log messages to a worker pool,
+// which sends them to the external log system.
+type sink struct {
+	ctx  context.Context
+	name string
+	pool workerpool.WorkerPool[log.Entry]
+}
+
+func newSink(c Component, name string, queueSize int, handler workerpool.Handler[log.Entry]) *sink {
+	if queueSize <= 0 {
+		queueSize = defaultQueueSize
+	}
+	ctx := c.Context()
+	return &sink{
+		ctx:  ctx,
+		name: name,
+		pool: workerpool.NewWorkerPool(workerpool.Config[log.Entry]{
+			Component: c,
+			Context:   ctx,
+			Name:      "log_sink_" + name,
+			Handler:   handler,
+			// A single worker keeps the log messages in order.
+			MinWorkers: 1,
+			MaxWorkers: 1,
+			QueueSize:  queueSize,
+		}),
+	}
+}
+
+// Wrap implements log.Middleware.
+func (s *sink) Wrap(next log.Handler) log.Handler {
+	return log.HandlerFunc(func(entry log.Entry) error {
+		if err := s.pool.Publish(s.ctx, entry); err != nil {
+			registerDropped(s.name, 1)
+		}
+		return next.HandleLog(entry)
+	})
+}
+
+// marshalEntry marshals the log entry to a JSON object with the level, the message and the fields.
+// The log messages of the sinks do not get logged themselves, so errors are not returned but
+// the values that cannot be marshaled are formatted instead.
+func marshalEntry(entry log.Entry) []byte {
+	fields := entry.Fields().Fields()
+	obj := make(map[string]any, len(fields)+2)
+	for k, v := range fields {
+		switch v := v.(type) {
+		case error:
+			obj[k] = v.Error()
+		case fmt.Stringer:
+			obj[k] = v.String()
+		default:
+			if _, err := json.Marshal(v); err != nil {
+				obj[k] = fmt.Sprint(v)
+				continue
+			}
+			obj[k] = v
+		}
+	}
+	obj["level"] = entry.Level().String()
+	obj["msg"] = entry.Message()
+	b, err := json.Marshal(obj)
+	if err != nil {
+		b, _ = json.Marshal(map[string]string{
+			"level": entry.Level().String(),
+			"msg":   entry.Message(),
+		})
+	}
+	return b
+}
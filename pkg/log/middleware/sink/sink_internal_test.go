@@ -0,0 +1,182 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type testEntry struct {
+	level   log.Level
+	message string
+	fields  log.Fielder
+	time    time.Time
+}
+
+func (e testEntry) Level() log.Level     { return e.level }
+func (e testEntry) Fields() log.Fielder  { return e.fields }
+func (e testEntry) Message() string      { return e.message }
+func (e testEntry) Timestamp() time.Time { return e.time }
+
+var testTime = time.Date(2023, 10, 1, 12, 30, 0, 0, time.UTC)
+
+func TestMarshalEntry(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	b := marshalEntry(testEntry{
+		level:   log.WarnLevel,
+		message: "Test",
+		fields: log.Fields(
+			"namespace", "test",
+			"count", 42,
+			"error", errors.New("failed"),
+			"func", func() {},
+		),
+		time: testTime,
+	})
+	var obj map[string]any
+	if !a.So(json.Unmarshal(b, &obj), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(obj["level"], should.Equal, "warn")
+	a.So(obj["msg"], should.Equal, "Test")
+	a.So(obj["namespace"], should.Equal, "test")
+	a.So(obj["count"], should.Equal, 42)
+	a.So(obj["error"], should.Equal, "failed")
+	a.So(obj["func"], should.NotBeEmpty)
+}
+
+func TestSyslog(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	defer conn.Close()
+
+	w := &syslogWriter{
+		network:  "udp",
+		address:  conn.LocalAddr().String(),
+		appName:  "ttn-lw-stack",
+		facility: 16,
+		hostname: "host",
+		procID:   "1",
+	}
+	entry := testEntry{
+		level:   log.ErrorLevel,
+		message: "Test",
+		fields:  log.Fields("namespace", "gatewayserver/io/udp"),
+		time:    testTime,
+	}
+	msg := w.format(entry)
+	a.So(string(msg), should.Equal,
+		`<131>1 2023-10-01T12:30:00.000000Z host ttn-lw-stack 1 gatewayserver/io/udp - `+
+			`{"level":"error","msg":"Test","namespace":"gatewayserver/io/udp"}`,
+	)
+	a.So(string(w.format(testEntry{level: log.DebugLevel, fields: log.Fields(), time: testTime})), should.StartWith,
+		"<135>1 2023-10-01T12:30:00.000000Z host ttn-lw-stack 1 - - ",
+	)
+
+	if !a.So(w.write(msg), should.BeNil) {
+		t.FailNow()
+	}
+	buf := make([]byte, 1024)
+	_ = conn.SetReadDeadline(time.Now().Add(test.Delay << 8))
+	n, _, err := conn.ReadFrom(buf)
+	if a.So(err, should.BeNil) {
+		a.So(string(buf[:n]), should.Equal, string(msg))
+	}
+}
+
+func TestLoki(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := test.Context()
+
+	reqCh := make(chan *http.Request, 1)
+	bodyCh := make(chan []byte, 1)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		reqCh <- r
+		bodyCh <- body
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	p := &lokiPusher{
+		client:    srv.Client(),
+		url:       srv.URL,
+		headers:   map[string]string{"X-Scope-OrgID": "tenant"},
+		labels:    map[string]string{"job": "ttn-lw-stack"},
+		timeout:   time.Second,
+		batchSize: 2,
+	}
+
+	p.handle(ctx, testEntry{level: log.InfoLevel, message: "First", fields: log.Fields(), time: testTime})
+	select {
+	case <-reqCh:
+		t.Fatal("Expected no push before the batch is full")
+	default:
+	}
+	p.handle(ctx, testEntry{level: log.WarnLevel, message: "Second", fields: log.Fields(), time: testTime})
+
+	select {
+	case req := <-reqCh:
+		a.So(req.Method, should.Equal, http.MethodPost)
+		a.So(req.Header.Get("X-Scope-OrgID"), should.Equal, "tenant")
+		a.So(req.Header.Get("Content-Type"), should.Equal, "application/json")
+	case <-time.After(test.Delay << 8):
+		t.Fatal("Expected push")
+	}
+	var pushed struct {
+		Streams []lokiStream `json:"streams"`
+	}
+	if !a.So(json.Unmarshal(<-bodyCh, &pushed), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(pushed.Streams, should.Resemble, []lokiStream{
+		{
+			Stream: map[string]string{"job": "ttn-lw-stack", "level": "info"},
+			Values: [][2]string{{"1696163400000000000", `{"level":"info","msg":"First"}`}},
+		},
+		{
+			Stream: map[string]string{"job": "ttn-lw-stack", "level": "warn"},
+			Values: [][2]string{{"1696163400000000000", `{"level":"warn","msg":"Second"}`}},
+		},
+	})
+
+	// Nothing is pushed when there are no buffered log messages.
+	p.flush(ctx)
+	select {
+	case <-reqCh:
+		t.Fatal("Expected no push without log messages")
+	default:
+	}
+}
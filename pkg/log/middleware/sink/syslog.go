@@ -0,0 +1,175 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package sink
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+)
+
+const (
+	syslogDialTimeout  = 5 * time.Second
+	syslogWriteTimeout = 5 * time.Second
+	// syslogMaxMsgIDLength is the maximum length of the MSGID field of RFC 5424.
+	syslogMaxMsgIDLength = 32
+	// syslogDefaultFacility is the local0 facility.
+	syslogDefaultFacility = 16
+)
+
+var (
+	errSyslogNetwork  = errors.DefineInvalidArgument("syslog_network", "invalid syslog network `{network}`")
+	errSyslogAddress  = errors.DefineInvalidArgument("syslog_address", "no syslog address configured")
+	errSyslogFacility = errors.DefineInvalidArgument("syslog_facility", "invalid syslog facility `{facility}`")
+)
+
+// syslogSeverity returns the RFC 5424 severity of the log level.
+func syslogSeverity(level log.Level) int {
+	switch level {
+	case log.DebugLevel:
+		return 7
+	case log.InfoLevel:
+		return 6
+	case log.WarnLevel:
+		return 4
+	case log.ErrorLevel:
+		return 3
+	default:
+		return 2
+	}
+}
+
+// orNil returns the RFC 5424 NILVALUE if the value is empty.
+func orNil(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+type syslogWriter struct {
+	network  string
+	address  string
+	appName  string
+	facility int
+	hostname string
+	procID   string
+
+	mu   sync.Mutex
+	conn net.Conn
+}
+
+// format formats the log entry as RFC 5424 syslog message.
+// The message is the JSON representation of the log entry.
+func (w *syslogWriter) format(entry log.Entry) []byte {
+	var msgID string
+	if namespace, ok := entry.Fields().Fields()["namespace"].(string); ok {
+		msgID = strings.Map(func(r rune) rune {
+			if r <= ' ' || r > '~' {
+				return '_'
+			}
+			return r
+		}, namespace)
+		if len(msgID) > syslogMaxMsgIDLength {
+			msgID = msgID[:syslogMaxMsgIDLength]
+		}
+	}
+	return []byte(fmt.Sprintf("<%d>1 %s %s %s %s %s - %s",
+		w.facility*8+syslogSeverity(entry.Level()),
+		entry.Timestamp().UTC().Format("2006-01-02T15:04:05.000000Z07:00"),
+		w.hostname,
+		w.appName,
+		w.procID,
+		orNil(msgID),
+		marshalEntry(entry),
+	))
+}
+
+// write writes the syslog message to the connection.
+// Messages over stream connections are framed with octet counting (RFC 6587).
+// If writing fails, the connection is closed and the message is written once more on a new connection.
+func (w *syslogWriter) write(msg []byte) error {
+	if w.network != "udp" {
+		msg = append([]byte(fmt.Sprintf("%d ", len(msg))), msg...)
+	}
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	var err error
+	for attempt := 0; attempt < 2; attempt++ {
+		if w.conn == nil {
+			if w.conn, err = net.DialTimeout(w.network, w.address, syslogDialTimeout); err != nil {
+				w.conn = nil
+				continue
+			}
+		}
+		if err = w.conn.SetWriteDeadline(time.Now().Add(syslogWriteTimeout)); err == nil {
+			if _, err = w.conn.Write(msg); err == nil {
+				return nil
+			}
+		}
+		w.conn.Close()
+		w.conn = nil
+	}
+	return err
+}
+
+func (w *syslogWriter) handle(_ context.Context, entry log.Entry) {
+	if err := w.write(w.format(entry)); err != nil {
+		registerDropped("syslog", 1)
+		return
+	}
+	registerSent("syslog", 1)
+}
+
+// NewSyslog returns a log middleware that sends the log messages to a syslog server.
+func NewSyslog(c Component, conf config.LogSyslog) (log.Middleware, error) {
+	network := conf.Network
+	if network == "" {
+		network = "udp"
+	}
+	switch network {
+	case "udp", "tcp":
+	default:
+		return nil, errSyslogNetwork.WithAttributes("network", network)
+	}
+	if conf.Address == "" {
+		return nil, errSyslogAddress.New()
+	}
+	facility := conf.Facility
+	if facility == 0 {
+		facility = syslogDefaultFacility
+	}
+	if facility < 0 || facility > 23 {
+		return nil, errSyslogFacility.WithAttributes("facility", facility)
+	}
+	hostname, _ := os.Hostname()
+	w := &syslogWriter{
+		network:  network,
+		address:  conf.Address,
+		appName:  orNil(conf.AppName),
+		facility: facility,
+		hostname: orNil(hostname),
+		procID:   fmt.Sprint(os.Getpid()),
+	}
+	return newSink(c, "syslog", conf.QueueSize, w.handle), nil
+}
@@ -0,0 +1,96 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sort"
+	"strings"
+)
+
+// namespaceLevel is the minimum level of the log messages of a namespace.
+type namespaceLevel struct {
+	namespace string
+	level     Level
+}
+
+// matches returns whether the namespace level applies to the given namespace.
+// The namespace level applies to the namespace itself and its sub namespaces,
+// i.e. `gatewayserver/io` applies to `gatewayserver/io` and `gatewayserver/io/udp`.
+func (nl namespaceLevel) matches(namespace string) bool {
+	if !strings.HasPrefix(namespace, nl.namespace) {
+		return false
+	}
+	return len(namespace) == len(nl.namespace) || namespace[len(nl.namespace)] == '/'
+}
+
+// ParseNamespaceLevels parses the levels per namespace.
+func ParseNamespaceLevels(levels map[string]string) (map[string]Level, error) {
+	res := make(map[string]Level, len(levels))
+	for namespace, str := range levels {
+		level, err := ParseLevel(str)
+		if err != nil {
+			return nil, err
+		}
+		res[namespace] = level
+	}
+	return res, nil
+}
+
+// SetNamespaceLevels sets the minimum levels of log messages per namespace, overriding the level
+// of the logger for messages with the `namespace` field.
+// The level of a namespace also applies to its sub namespaces, unless these have a level themselves.
+// This can be used to change the levels of the logger while it is in use.
+func (l *Logger) SetNamespaceLevels(levels map[string]Level) {
+	namespaceLevels := make([]namespaceLevel, 0, len(levels))
+	for namespace, level := range levels {
+		if level == invalid {
+			continue
+		}
+		namespaceLevels = append(namespaceLevels, namespaceLevel{
+			namespace: strings.Trim(namespace, "/"),
+			level:     level,
+		})
+	}
+	// The most specific namespace takes precedence.
+	sort.Slice(namespaceLevels, func(i, j int) bool {
+		return len(namespaceLevels[i].namespace) > len(namespaceLevels[j].namespace)
+	})
+
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	l.namespaceLevels = namespaceLevels
+}
+
+// level returns the minimum level of the entry.
+// The caller must hold the read lock.
+func (l *Logger) level(e *entry) Level {
+	if len(l.namespaceLevels) == 0 || e.fields == nil {
+		return l.Level
+	}
+	v, ok := e.fields.Get("namespace")
+	if !ok {
+		return l.Level
+	}
+	namespace, ok := v.(string)
+	if !ok {
+		return l.Level
+	}
+	for _, nl := range l.namespaceLevels {
+		if nl.matches(namespace) {
+			return nl.level
+		}
+	}
+	return l.Level
+}
@@ -25,3 +25,10 @@ func WithLevel(level Level) Option {
 		}
 	}
 }
+
+// WithNamespaceLevels sets the levels per namespace on the logger.
+func WithNamespaceLevels(levels map[string]Level) Option {
+	return func(logger *Logger) {
+		logger.SetNamespaceLevels(levels)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log
+
+import (
+	"sync"
+	"time"
+)
+
+type samplerKey struct {
+	level   Level
+	message string
+}
+
+// sampler is a Middleware that samples log messages.
+type sampler struct {
+	interval   time.Duration
+	initial    uint64
+	thereafter uint64
+
+	mu          sync.Mutex
+	windowStart time.Time
+	counts      map[samplerKey]uint64
+}
+
+// NewSampler returns a middleware that samples log messages with the same level and message.
+// In each interval, the first initial messages are logged, and after that every thereafter-th message.
+// If thereafter is 0, no more messages are logged in the interval after the initial messages.
+// Messages with the error level and above are not sampled.
+func NewSampler(interval time.Duration, initial, thereafter uint64) Middleware {
+	return &sampler{
+		interval:   interval,
+		initial:    initial,
+		thereafter: thereafter,
+		counts:     make(map[samplerKey]uint64),
+	}
+}
+
+// allow returns whether the entry should be logged.
+func (s *sampler) allow(e Entry, now time.Time) bool {
+	if e.Level() >= ErrorLevel {
+		return true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if now.Sub(s.windowStart) >= s.interval {
+		s.windowStart = now
+		s.counts = make(map[samplerKey]uint64, len(s.counts))
+	}
+	key := samplerKey{level: e.Level(), message: e.Message()}
+	n := s.counts[key] + 1
+	s.counts[key] = n
+	if n <= s.initial {
+		return true
+	}
+	return s.thereafter > 0 && (n-s.initial)%s.thereafter == 0
+}
+
+// Wrap implements Middleware.
+func (s *sampler) Wrap(next Handler) Handler {
+	return HandlerFunc(func(e Entry) error {
+		if !s.allow(e, time.Now()) {
+			return nil
+		}
+		return next.HandleLog(e)
+	})
+}
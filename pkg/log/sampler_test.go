@@ -0,0 +1,47 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package log_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSampler(t *testing.T) {
+	a := assertions.New(t)
+
+	rec := newRecorder()
+	logger := NewLogger(rec, WithLevel(DebugLevel))
+	logger.Use(NewSampler(time.Hour, 2, 3))
+
+	for i := 0; i < 10; i++ {
+		logger.Info("Noisy")
+	}
+	// The first 2 messages, and every 3rd message after that.
+	a.So(rec.entries, should.HaveLength, 4)
+
+	logger.Debug("Noisy")
+	logger.Info("Other")
+	a.So(rec.entries, should.HaveLength, 6)
+
+	for i := 0; i < 10; i++ {
+		logger.Error("Noisy")
+	}
+	a.So(rec.entries, should.HaveLength, 16)
+}
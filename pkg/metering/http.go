@@ -0,0 +1,262 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmetadata"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+var (
+	errInvalidMonth  = errors.DefineInvalidArgument("invalid_month", "invalid month `{month}`, expected YYYY-MM")
+	errInvalidFormat = errors.DefineInvalidArgument("invalid_format", "invalid export format `{format}`")
+)
+
+// Usage is the usage of an application or an organization in a month.
+type Usage struct {
+	Month          string `json:"month"`
+	ApplicationID  string `json:"application_id,omitempty"`
+	OrganizationID string `json:"organization_id,omitempty"`
+	Operations     Counts `json:"operations"`
+	// Applications is the usage of the applications of the organization.
+	Applications []*Usage `json:"applications,omitempty"`
+}
+
+// RegisterRoutes registers the web frontend routes.
+//
+// The usage of applications requires the rights to read the application information, and the
+// usage of organizations requires the rights to list the applications of the organization.
+// Only admins can export the usage of all applications.
+func (m *Metering) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/metering/").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("metering")),
+		ratelimit.HTTPMiddleware(m.Component.RateLimiter(), "http:metering"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+	router.HandleFunc("/applications/{application_id}/usage", m.handleGetApplicationUsage).Methods(http.MethodGet)
+	router.HandleFunc("/organizations/{organization_id}/usage", m.handleGetOrganizationUsage).Methods(http.MethodGet)
+	router.HandleFunc("/usage", m.handleExportUsage).Methods(http.MethodGet)
+}
+
+// monthFromRequest returns the month of the month query parameter, or the current month.
+func monthFromRequest(r *http.Request) (string, error) {
+	month := r.URL.Query().Get("month")
+	if month == "" {
+		return Month(time.Now()), nil
+	}
+	if _, err := time.Parse(monthFormat, month); err != nil {
+		return "", errInvalidMonth.WithAttributes("month", month).WithCause(err)
+	}
+	return month, nil
+}
+
+func writeUsage(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (m *Metering) handleGetApplicationUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ids := &ttnpb.ApplicationIdentifiers{ApplicationId: mux.Vars(r)["application_id"]}
+	if err := ids.ValidateContext(ctx); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireApplication(ctx, ids, ttnpb.Right_RIGHT_APPLICATION_INFO); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	month, err := monthFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	uid := unique.ID(ctx, ids)
+	usage, err := m.store.Get(ctx, month, uid)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeUsage(w, newApplicationUsage(month, ids.ApplicationId, usage[uid]))
+}
+
+func newApplicationUsage(month, id string, counts Counts) *Usage {
+	if counts == nil {
+		counts = Counts{}
+	}
+	return &Usage{
+		Month:         month,
+		ApplicationID: id,
+		Operations:    counts,
+	}
+}
+
+const listApplicationsLimit = 1000
+
+// listOrganizationApplications lists the applications of the organization, with the credentials of the request.
+func (m *Metering) listOrganizationApplications(
+	ctx context.Context, ids *ttnpb.OrganizationIdentifiers,
+) ([]*ttnpb.ApplicationIdentifiers, error) {
+	cc, err := m.GetPeerConn(ctx, ttnpb.ClusterRole_ENTITY_REGISTRY, nil)
+	if err != nil {
+		return nil, err
+	}
+	callOpt, err := rpcmetadata.WithForwardedAuth(ctx, m.AllowInsecureForCredentials())
+	if err != nil {
+		return nil, err
+	}
+	client := ttnpb.NewApplicationRegistryClient(cc)
+	var appIDs []*ttnpb.ApplicationIdentifiers
+	for page := uint32(1); ; page++ {
+		res, err := client.List(ctx, &ttnpb.ListApplicationsRequest{
+			Collaborator: ids.GetOrganizationOrUserIdentifiers(),
+			FieldMask:    ttnpb.FieldMask("ids"),
+			Limit:        listApplicationsLimit,
+			Page:         page,
+		}, callOpt)
+		if err != nil {
+			return nil, err
+		}
+		for _, app := range res.Applications {
+			appIDs = append(appIDs, app.GetIds())
+		}
+		if len(res.Applications) < listApplicationsLimit {
+			return appIDs, nil
+		}
+	}
+}
+
+func (m *Metering) handleGetOrganizationUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ids := &ttnpb.OrganizationIdentifiers{OrganizationId: mux.Vars(r)["organization_id"]}
+	if err := ids.ValidateFields(); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireOrganization(ctx, ids, ttnpb.Right_RIGHT_ORGANIZATION_APPLICATIONS_LIST); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	month, err := monthFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	appIDs, err := m.listOrganizationApplications(ctx, ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	uids := make([]string, 0, len(appIDs))
+	for _, appIDs := range appIDs {
+		uids = append(uids, unique.ID(ctx, appIDs))
+	}
+	usage, err := m.store.Get(ctx, month, uids...)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	res := &Usage{
+		Month:          month,
+		OrganizationID: ids.OrganizationId,
+		Operations:     Counts{},
+	}
+	for i, appIDs := range appIDs {
+		counts := usage[uids[i]]
+		res.Operations.add(counts)
+		res.Applications = append(res.Applications, newApplicationUsage(month, appIDs.ApplicationId, counts))
+	}
+	writeUsage(w, res)
+}
+
+var operations = []Operation{OperationUplinks, OperationDownlinks, OperationWebhookDeliveries}
+
+// handleExportUsage exports the usage of all applications in the month, as JSON or CSV.
+func (m *Metering) handleExportUsage(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	if err := rights.RequireIsAdmin(ctx); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	month, err := monthFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		webhandlers.Error(w, r, errInvalidFormat.WithAttributes("format", format))
+		return
+	}
+	usage, err := m.store.List(ctx, month)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	uids := make([]string, 0, len(usage))
+	for uid := range usage {
+		uids = append(uids, uid)
+	}
+	sort.Strings(uids)
+
+	if format != "csv" {
+		res := make([]*Usage, 0, len(uids))
+		for _, uid := range uids {
+			res = append(res, newApplicationUsage(month, uid, usage[uid]))
+		}
+		writeUsage(w, struct {
+			Usage []*Usage `json:"usage"`
+		}{
+			Usage: res,
+		})
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/csv")
+	w.Header().Set("Content-Disposition", `attachment; filename="usage-`+month+`.csv"`)
+	w.WriteHeader(http.StatusOK)
+	cw := csv.NewWriter(w)
+	header := []string{"month", "application_id"}
+	for _, op := range operations {
+		header = append(header, string(op))
+	}
+	_ = cw.Write(header)
+	for _, uid := range uids {
+		record := []string{month, uid}
+		for _, op := range operations {
+			record = append(record, strconv.FormatInt(usage[uid][op], 10))
+		}
+		_ = cw.Write(record)
+	}
+	cw.Flush()
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package metering implements usage metering, which counts the billable operations of
+// applications from events, for usage-based billing.
+package metering
+
+import (
+	"context"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+)
+
+// Config represents the usage metering configuration.
+type Config struct {
+	Enable        bool          `name:"enable" description:"Enable usage metering"`
+	FlushInterval time.Duration `name:"flush-interval" description:"Interval to flush the usage counters to the store"`
+	Retention     time.Duration `name:"retention" description:"Time to keep the monthly usage after the last update"`
+}
+
+// Metering implements usage metering.
+//
+// Metering subscribes to the events of the cluster and counts the billable operations of
+// applications per month. The counters are flushed to the store periodically, so that the
+// store is not updated on every event. Only one instance in the cluster should enable
+// metering, as every instance counts all events.
+type Metering struct {
+	*component.Component
+	ctx context.Context
+
+	config  *Config
+	store   Store
+	counter *counter
+}
+
+const (
+	eventsBufferSize      = 1 << 10
+	defaultFlushInterval  = time.Minute
+	shutdownFlushDuration = 5 * time.Second
+)
+
+// New returns a new *Metering.
+func New(c *component.Component, conf *Config, store Store) (*Metering, error) {
+	ctx := log.NewContextWithField(c.Context(), "namespace", "metering")
+	m := &Metering{
+		Component: c,
+		ctx:       ctx,
+		config:    conf,
+		store:     store,
+		counter:   newCounter(),
+	}
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "metering_count_events",
+		Func:    m.countEvents,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	c.RegisterWeb(m)
+	return m, nil
+}
+
+// Context returns the context of the metering service.
+func (m *Metering) Context() context.Context {
+	return m.ctx
+}
+
+func (m *Metering) countEvents(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(events.Channel, eventsBufferSize)
+	if err := events.Subscribe(ctx, eventNames(), nil, ch); err != nil {
+		return err
+	}
+	flushInterval := m.config.FlushInterval
+	if flushInterval <= 0 {
+		flushInterval = defaultFlushInterval
+	}
+	ticker := time.NewTicker(flushInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// The task context is done, so flush the remaining counters with a new context.
+			flushCtx, cancel := context.WithTimeout(
+				log.NewContext(context.Background(), log.FromContext(ctx)), shutdownFlushDuration,
+			)
+			m.flush(flushCtx)
+			cancel()
+			return ctx.Err()
+		case evt := <-ch:
+			m.counter.handle(evt)
+		case <-ticker.C:
+			m.flush(ctx)
+		}
+	}
+}
+
+// flush adds the pending counters to the store. If the store fails, the counters are kept
+// and added with the next flush.
+func (m *Metering) flush(ctx context.Context) {
+	pending := m.counter.take()
+	for month, usage := range pending {
+		if err := m.store.Add(ctx, month, usage); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("month", month).Warn("Failed to flush usage")
+			m.counter.merge(month, usage)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package redis implements the usage store of the metering service on top of Redis.
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+var errDatabaseCorruption = errors.DefineCorruption("database_corruption", "database is corrupted")
+
+// UsageStore is an implementation of metering.Store.
+// The usage of an application in a month is stored in a hash with the operations as fields.
+// The applications with usage in a month are stored in a set.
+type UsageStore struct {
+	Redis *ttnredis.Client
+	// TTL is the time to keep the usage after the last update. If zero, the usage is kept forever.
+	TTL time.Duration
+}
+
+func (s *UsageStore) usageKey(month, uid string) string {
+	return s.Redis.Key("usage", month, uid)
+}
+
+func (s *UsageStore) applicationsKey(month string) string {
+	return s.Redis.Key("applications", month)
+}
+
+// Add implements metering.Store.
+func (s *UsageStore) Add(ctx context.Context, month string, usage map[string]metering.Counts) error {
+	if len(usage) == 0 {
+		return nil
+	}
+	if _, err := s.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		appsKey := s.applicationsKey(month)
+		for uid, counts := range usage {
+			k := s.usageKey(month, uid)
+			for op, n := range counts {
+				p.HIncrBy(ctx, k, string(op), n)
+			}
+			p.SAdd(ctx, appsKey, uid)
+			if s.TTL > 0 {
+				p.Expire(ctx, k, s.TTL)
+			}
+		}
+		if s.TTL > 0 {
+			p.Expire(ctx, appsKey, s.TTL)
+		}
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Get implements metering.Store.
+func (s *UsageStore) Get(ctx context.Context, month string, uids ...string) (map[string]metering.Counts, error) {
+	if len(uids) == 0 {
+		return map[string]metering.Counts{}, nil
+	}
+	cmds := make([]*redis.MapStringStringCmd, len(uids))
+	if _, err := s.Redis.Pipelined(ctx, func(p redis.Pipeliner) error {
+		for i, uid := range uids {
+			cmds[i] = p.HGetAll(ctx, s.usageKey(month, uid))
+		}
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	usage := make(map[string]metering.Counts, len(uids))
+	for i, cmd := range cmds {
+		vs := cmd.Val()
+		if len(vs) == 0 {
+			continue
+		}
+		counts := make(metering.Counts, len(vs))
+		for op, v := range vs {
+			n, err := strconv.ParseInt(v, 10, 64)
+			if err != nil {
+				return nil, errDatabaseCorruption.WithCause(err)
+			}
+			counts[metering.Operation(op)] = n
+		}
+		usage[uids[i]] = counts
+	}
+	return usage, nil
+}
+
+// List implements metering.Store.
+func (s *UsageStore) List(ctx context.Context, month string) (map[string]metering.Counts, error) {
+	uids, err := s.Redis.SMembers(ctx, s.applicationsKey(month)).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return s.Get(ctx, month, uids...)
+}
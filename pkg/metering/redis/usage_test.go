@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/metering"
+	"go.thethings.network/lorawan-stack/v3/pkg/metering/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestUsageStore(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	s := &redis.UsageStore{Redis: cl, TTL: test.Delay << 10}
+
+	usage, err := s.List(ctx, "2023-06")
+	a.So(err, should.BeNil)
+	a.So(usage, should.BeEmpty)
+
+	for i := 0; i < 2; i++ {
+		if !a.So(s.Add(ctx, "2023-06", map[string]metering.Counts{
+			"app1": {metering.OperationUplinks: 2, metering.OperationDownlinks: 1},
+			"app2": {metering.OperationWebhookDeliveries: 3},
+		}), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	if !a.So(s.Add(ctx, "2023-07", map[string]metering.Counts{
+		"app1": {metering.OperationUplinks: 1},
+	}), should.BeNil) {
+		t.FailNow()
+	}
+
+	usage, err = s.Get(ctx, "2023-06", "app1", "app3")
+	a.So(err, should.BeNil)
+	a.So(usage, should.Resemble, map[string]metering.Counts{
+		"app1": {metering.OperationUplinks: 4, metering.OperationDownlinks: 2},
+	})
+
+	usage, err = s.List(ctx, "2023-06")
+	a.So(err, should.BeNil)
+	a.So(usage, should.Resemble, map[string]metering.Counts{
+		"app1": {metering.OperationUplinks: 4, metering.OperationDownlinks: 2},
+		"app2": {metering.OperationWebhookDeliveries: 6},
+	})
+
+	usage, err = s.List(ctx, "2023-07")
+	a.So(err, should.BeNil)
+	a.So(usage, should.Resemble, map[string]metering.Counts{
+		"app1": {metering.OperationUplinks: 1},
+	})
+}
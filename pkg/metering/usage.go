@@ -0,0 +1,146 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// Operation is a billable operation.
+type Operation string
+
+const (
+	// OperationUplinks counts the uplink messages forwarded by the Application Server.
+	OperationUplinks Operation = "uplinks"
+	// OperationDownlinks counts the downlink messages scheduled by the Network Server.
+	OperationDownlinks Operation = "downlinks"
+	// OperationWebhookDeliveries counts the webhooks sent by the Application Server.
+	OperationWebhookDeliveries Operation = "webhook_deliveries"
+)
+
+// eventOperations maps the names of the counted events to the billable operation.
+var eventOperations = map[string]Operation{
+	"as.up.data.forward":            OperationUplinks,
+	"ns.down.data.schedule.success": OperationDownlinks,
+	"as.webhook.send":               OperationWebhookDeliveries,
+}
+
+func eventNames() []string {
+	names := make([]string, 0, len(eventOperations))
+	for name := range eventOperations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Counts is the number of billable operations.
+type Counts map[Operation]int64
+
+// add adds the counts of other to the counts.
+func (c Counts) add(other Counts) {
+	for op, n := range other {
+		c[op] += n
+	}
+}
+
+// monthFormat is the format of the month of the usage.
+const monthFormat = "2006-01"
+
+// Month returns the month of t, in UTC.
+func Month(t time.Time) string {
+	return t.UTC().Format(monthFormat)
+}
+
+// counter keeps the counts per application unique ID per month, until they are flushed to the store.
+type counter struct {
+	mu     sync.Mutex
+	counts map[string]map[string]Counts
+}
+
+func newCounter() *counter {
+	return &counter{
+		counts: make(map[string]map[string]Counts),
+	}
+}
+
+func (c *counter) handle(evt events.Event) {
+	op, ok := eventOperations[evt.Name()]
+	if !ok {
+		return
+	}
+	ctx, month := evt.Context(), Month(evt.Time())
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, ids := range evt.Identifiers() {
+		appIDs := ids.GetApplicationIds()
+		if appIDs == nil {
+			appIDs = ids.GetDeviceIds().GetApplicationIds()
+		}
+		if appIDs == nil {
+			continue
+		}
+		c.countsOf(month, unique.ID(ctx, appIDs))[op]++
+	}
+}
+
+func (c *counter) countsOf(month, uid string) Counts {
+	usage, ok := c.counts[month]
+	if !ok {
+		usage = make(map[string]Counts)
+		c.counts[month] = usage
+	}
+	counts, ok := usage[uid]
+	if !ok {
+		counts = make(Counts)
+		usage[uid] = counts
+	}
+	return counts
+}
+
+// take returns the pending counts and resets the counter.
+func (c *counter) take() map[string]map[string]Counts {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	counts := c.counts
+	c.counts = make(map[string]map[string]Counts)
+	return counts
+}
+
+// merge adds the counts of the applications in the month back to the counter.
+func (c *counter) merge(month string, usage map[string]Counts) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for uid, counts := range usage {
+		c.countsOf(month, uid).add(counts)
+	}
+}
+
+// Store stores the monthly usage of applications.
+type Store interface {
+	// Add adds the counts of the applications, by application unique ID, to the usage of the month.
+	Add(ctx context.Context, month string, usage map[string]Counts) error
+	// Get returns the usage of the applications, by application unique ID, in the month.
+	// Applications without usage are omitted.
+	Get(ctx context.Context, month string, uids ...string) (map[string]Counts, error)
+	// List returns the usage of all applications, by application unique ID, in the month.
+	List(ctx context.Context, month string) (map[string]Counts, error)
+}
@@ -0,0 +1,86 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package metering
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestCounter(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"}
+	devIDs := &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: "dev1"}
+	june := time.Date(2023, time.June, 30, 23, 59, 0, 0, time.UTC)
+	july := time.Date(2023, time.July, 1, 0, 0, 0, 0, time.UTC)
+
+	newEvent := func(name string, at time.Time, ids ...*ttnpb.EntityIdentifiers) events.Event {
+		evt, err := events.FromProto(&ttnpb.Event{
+			Name:        name,
+			Time:        timestamppb.New(at),
+			Identifiers: ids,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return evt
+	}
+
+	c := newCounter()
+	for _, evt := range []events.Event{
+		newEvent("as.up.data.forward", june, devIDs.GetEntityIdentifiers()),
+		newEvent("as.up.data.forward", june, devIDs.GetEntityIdentifiers()),
+		newEvent("as.up.data.forward", july, devIDs.GetEntityIdentifiers()),
+		newEvent("ns.down.data.schedule.success", june, devIDs.GetEntityIdentifiers()),
+		newEvent("as.webhook.send", june, devIDs.GetEntityIdentifiers()),
+		newEvent("as.webhook.send", june, (&ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app2"},
+			DeviceId:       "dev2",
+		}).GetEntityIdentifiers()),
+		newEvent("as.up.data.drop", june, devIDs.GetEntityIdentifiers()),
+		newEvent("as.up.data.forward", june),
+	} {
+		c.handle(evt)
+	}
+
+	pending := c.take()
+	a.So(pending, should.Resemble, map[string]map[string]Counts{
+		"2023-06": {
+			"app1": {OperationUplinks: 2, OperationDownlinks: 1, OperationWebhookDeliveries: 1},
+			"app2": {OperationWebhookDeliveries: 1},
+		},
+		"2023-07": {
+			"app1": {OperationUplinks: 1},
+		},
+	})
+	a.So(c.take(), should.BeEmpty)
+
+	// Counts that failed to flush are merged with the new counts.
+	c.handle(newEvent("as.up.data.forward", july, appIDs.GetEntityIdentifiers()))
+	c.merge("2023-07", pending["2023-07"])
+	a.So(c.take(), should.Resemble, map[string]map[string]Counts{
+		"2023-07": {
+			"app1": {OperationUplinks: 2},
+		},
+	})
+}
@@ -34,6 +34,9 @@ var Exporter http.Handler = exporter
 // Registry for metrics.
 var Registry prometheus.Registerer = registry
 
+// Gatherer gathers the metrics of the registry.
+var Gatherer prometheus.Gatherer = registry
+
 func init() {
 	registry.MustRegister(prometheus.NewProcessCollector(prometheus.ProcessCollectorOpts{}))
 	registry.MustRegister(prometheus.NewGoCollector())
@@ -0,0 +1,142 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// downlinkFailuresTTL is the time after which the consecutive downlink failures of an end device expire.
+const downlinkFailuresTTL = 24 * time.Hour
+
+// adaptiveRxEnabled returns whether the receive window parameters of end devices are adapted after consecutive
+// downlink failures.
+func (ns *NetworkServer) adaptiveRxEnabled() bool {
+	return ns.downlinkFailures != nil && ns.adaptiveRx.Threshold > 0
+}
+
+// recordDownlinkFailure counts a failed downlink of the end device.
+func (ns *NetworkServer) recordDownlinkFailure(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if !ns.adaptiveRxEnabled() || ids == nil {
+		return
+	}
+	n, err := ns.downlinkFailures.Increment(ctx, ids, downlinkFailuresTTL)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to count downlink failure")
+		return
+	}
+	log.FromContext(ctx).WithField("downlink_failures", n).Debug("Counted downlink failure")
+}
+
+// downlinkFailuresAfterUplink returns the number of consecutive failed downlinks of the end device after the
+// data uplink is matched. A not acknowledged confirmed downlink counts as failure, and an acknowledged confirmed
+// downlink clears the failures.
+func (ns *NetworkServer) downlinkFailuresAfterUplink(ctx context.Context, matched *matchResult) int {
+	if !ns.adaptiveRxEnabled() {
+		return 0
+	}
+	ids := matched.Device.Ids
+	var (
+		n   int
+		err error
+	)
+	switch {
+	case matched.ConfirmedDownlinkAck:
+		ns.clearDownlinkFailures(ctx, ids)
+		return 0
+	case matched.ConfirmedDownlinkNack:
+		n, err = ns.downlinkFailures.Increment(ctx, ids, downlinkFailuresTTL)
+	default:
+		n, err = ns.downlinkFailures.Get(ctx, ids)
+	}
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get downlink failures")
+		return 0
+	}
+	return n
+}
+
+// clearDownlinkFailures removes the consecutive downlink failures of the end device.
+func (ns *NetworkServer) clearDownlinkFailures(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if ns.downlinkFailures == nil {
+		return
+	}
+	if err := ns.downlinkFailures.Clear(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to clear downlink failures")
+	}
+}
+
+// nextRx1Delay returns the Rx1 delay that is one second longer than delay.
+func nextRx1Delay(delay ttnpb.RxDelay) ttnpb.RxDelay {
+	if delay == ttnpb.RxDelay_RX_DELAY_0 {
+		// RX_DELAY_0 is equivalent to RX_DELAY_1.
+		return ttnpb.RxDelay_RX_DELAY_2
+	}
+	return delay + 1
+}
+
+// adaptRxParameters adapts the desired receive window parameters of the end device after the given number of
+// consecutive downlink failures. The Rx1 delay is increased and the Rx2 data rate is lowered by one step, within
+// the configured bounds, so that end devices with drifting clocks get a wider margin to receive downlinks.
+// The RxTimingSetupReq and RxParamSetupReq MAC commands are enqueued by the downlink path, since the desired
+// parameters differ from the current parameters.
+// The paths of the changed parameters are returned, and the returned event builders are nil if nothing changed.
+func adaptRxParameters(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, conf AdaptiveRxConfig, failures int,
+) ([]string, events.Builders) {
+	if dev.GetMulticast() || dev.GetMacState().GetDesiredParameters() == nil {
+		return nil, nil
+	}
+	desiredParameters := dev.MacState.DesiredParameters
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"downlink_failures", failures,
+		"rx1_delay", desiredParameters.Rx1Delay,
+		"rx2_data_rate_index", desiredParameters.Rx2DataRateIndex,
+	))
+
+	var paths []string
+	if desiredParameters.Rx1Delay < conf.MaxRx1Delay {
+		desiredParameters.Rx1Delay = nextRx1Delay(desiredParameters.Rx1Delay)
+		paths = ttnpb.AddFields(paths, "mac_state.desired_parameters.rx1_delay")
+	}
+	for idx := desiredParameters.Rx2DataRateIndex; idx > ttnpb.DataRateIndex(conf.MinRx2DataRateIndex); {
+		idx--
+		if _, ok := phy.DataRates[idx]; ok {
+			desiredParameters.Rx2DataRateIndex = idx
+			paths = ttnpb.AddFields(paths, "mac_state.desired_parameters.rx2_data_rate_index")
+			break
+		}
+	}
+	if len(paths) == 0 {
+		logger.Warn("Receive window parameters at configured bounds, do not adapt after downlink failures")
+		return nil, nil
+	}
+	logger.WithFields(log.Fields(
+		"desired_rx1_delay", desiredParameters.Rx1Delay,
+		"desired_rx2_data_rate_index", desiredParameters.Rx2DataRateIndex,
+	)).Info("Adapt receive window parameters after downlink failures")
+	return paths, events.Builders{
+		evtAdaptRxParameters.With(events.WithData(&ttnpb.MACParameters{
+			Rx1Delay:         desiredParameters.Rx1Delay,
+			Rx2DataRateIndex: desiredParameters.Rx2DataRateIndex,
+		})),
+	}
+}
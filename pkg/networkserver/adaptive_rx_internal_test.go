@@ -0,0 +1,160 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockDownlinkFailures map[string]int
+
+func (m mockDownlinkFailures) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (int, error) {
+	return m[unique.ID(ctx, ids)], nil
+}
+
+func (m mockDownlinkFailures) Increment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, _ time.Duration,
+) (int, error) {
+	uid := unique.ID(ctx, ids)
+	m[uid]++
+	return m[uid], nil
+}
+
+func (m mockDownlinkFailures) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	delete(m, unique.ID(ctx, ids))
+	return nil
+}
+
+func TestDownlinkFailuresAfterUplink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	failures := mockDownlinkFailures{}
+	ns := &NetworkServer{
+		downlinkFailures: failures,
+		adaptiveRx:       AdaptiveRxConfig{Threshold: 3},
+	}
+	a.So(ns.adaptiveRxEnabled(), should.BeTrue)
+	a.So((&NetworkServer{downlinkFailures: failures}).adaptiveRxEnabled(), should.BeFalse)
+
+	ns.recordDownlinkFailure(ctx, ids)
+	a.So(ns.downlinkFailuresAfterUplink(ctx, &matchResult{
+		Device: &ttnpb.EndDevice{Ids: ids},
+	}), should.Equal, 1)
+	a.So(ns.downlinkFailuresAfterUplink(ctx, &matchResult{
+		Device:                &ttnpb.EndDevice{Ids: ids},
+		ConfirmedDownlinkNack: true,
+	}), should.Equal, 2)
+	a.So(ns.downlinkFailuresAfterUplink(ctx, &matchResult{
+		Device:               &ttnpb.EndDevice{Ids: ids},
+		ConfirmedDownlinkAck: true,
+	}), should.Equal, 0)
+	a.So(failures, should.BeEmpty)
+}
+
+func TestAdaptRxParameters(t *testing.T) {
+	t.Parallel()
+
+	phy, err := band.GetLatest(band.EU_863_870)
+	if err != nil {
+		t.Fatalf("Failed to get band: %v", err)
+	}
+	conf := AdaptiveRxConfig{
+		Threshold:           3,
+		MaxRx1Delay:         ttnpb.RxDelay_RX_DELAY_3,
+		MinRx2DataRateIndex: 1,
+	}
+	for _, tc := range []struct {
+		Name             string
+		Device           *ttnpb.EndDevice
+		ExpectedPaths    []string
+		ExpectedDelay    ttnpb.RxDelay
+		ExpectedDataRate ttnpb.DataRateIndex
+	}{
+		{
+			Name: "both",
+			Device: &ttnpb.EndDevice{MacState: &ttnpb.MACState{DesiredParameters: &ttnpb.MACParameters{
+				Rx1Delay:         ttnpb.RxDelay_RX_DELAY_1,
+				Rx2DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+			}}},
+			ExpectedPaths: []string{
+				"mac_state.desired_parameters.rx1_delay",
+				"mac_state.desired_parameters.rx2_data_rate_index",
+			},
+			ExpectedDelay:    ttnpb.RxDelay_RX_DELAY_2,
+			ExpectedDataRate: ttnpb.DataRateIndex_DATA_RATE_2,
+		},
+		{
+			Name: "zero delay",
+			Device: &ttnpb.EndDevice{MacState: &ttnpb.MACState{DesiredParameters: &ttnpb.MACParameters{
+				Rx1Delay:         ttnpb.RxDelay_RX_DELAY_0,
+				Rx2DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_1,
+			}}},
+			ExpectedPaths: []string{
+				"mac_state.desired_parameters.rx1_delay",
+			},
+			ExpectedDelay:    ttnpb.RxDelay_RX_DELAY_2,
+			ExpectedDataRate: ttnpb.DataRateIndex_DATA_RATE_1,
+		},
+		{
+			Name: "at bounds",
+			Device: &ttnpb.EndDevice{MacState: &ttnpb.MACState{DesiredParameters: &ttnpb.MACParameters{
+				Rx1Delay:         ttnpb.RxDelay_RX_DELAY_3,
+				Rx2DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_0,
+			}}},
+			ExpectedDelay:    ttnpb.RxDelay_RX_DELAY_3,
+			ExpectedDataRate: ttnpb.DataRateIndex_DATA_RATE_0,
+		},
+		{
+			Name: "multicast",
+			Device: &ttnpb.EndDevice{Multicast: true, MacState: &ttnpb.MACState{DesiredParameters: &ttnpb.MACParameters{
+				Rx1Delay:         ttnpb.RxDelay_RX_DELAY_1,
+				Rx2DataRateIndex: ttnpb.DataRateIndex_DATA_RATE_3,
+			}}},
+			ExpectedDelay:    ttnpb.RxDelay_RX_DELAY_1,
+			ExpectedDataRate: ttnpb.DataRateIndex_DATA_RATE_3,
+		},
+	} {
+		tc := tc
+		test.RunSubtest(t, test.SubtestConfig{
+			Name:     tc.Name,
+			Parallel: true,
+			Func: func(ctx context.Context, t *testing.T, a *assertions.Assertion) {
+				paths, evs := adaptRxParameters(ctx, tc.Device, &phy, conf, 3)
+				a.So(paths, should.Resemble, tc.ExpectedPaths)
+				if len(tc.ExpectedPaths) > 0 {
+					a.So(evs, should.HaveLength, 1)
+				} else {
+					a.So(evs, should.BeNil)
+				}
+				a.So(tc.Device.MacState.DesiredParameters.Rx1Delay, should.Equal, tc.ExpectedDelay)
+				a.So(tc.Device.MacState.DesiredParameters.Rx2DataRateIndex, should.Equal, tc.ExpectedDataRate)
+			},
+		})
+	}
+}
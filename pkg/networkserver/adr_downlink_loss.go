@@ -0,0 +1,86 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// confirmedDownlinkOutcomesTTL is the time after which the confirmed downlink outcomes of an end device expire.
+const confirmedDownlinkOutcomesTTL = 7 * 24 * time.Hour
+
+// adrDownlinkLossEnabled returns whether confirmed downlink loss is taken into account by the ADR algorithm.
+func (ns *NetworkServer) adrDownlinkLossEnabled() bool {
+	return ns.confirmedDownlinkOutcomes != nil && ns.adrDownlinkLoss.Weight > 0 && ns.adrDownlinkLoss.Window > 0
+}
+
+// adrMarginBackoffAfterUplink returns the ADR margin backoff in dB of the end device after the data uplink is
+// matched. The backoff is the configured weight scaled by the loss rate of the most recent confirmed downlinks,
+// so that end devices that hear the network poorly are assigned more conservative data rates.
+func (ns *NetworkServer) adrMarginBackoffAfterUplink(ctx context.Context, matched *matchResult) float32 {
+	if !ns.adrDownlinkLossEnabled() {
+		return 0
+	}
+	ids := matched.Device.Ids
+	var (
+		outcomes []bool
+		err      error
+	)
+	switch {
+	case matched.ConfirmedDownlinkAck, matched.ConfirmedDownlinkNack:
+		outcomes, err = ns.confirmedDownlinkOutcomes.Add(
+			ctx, ids, matched.ConfirmedDownlinkAck, ns.adrDownlinkLoss.Window, confirmedDownlinkOutcomesTTL,
+		)
+	default:
+		outcomes, err = ns.confirmedDownlinkOutcomes.Range(ctx, ids)
+	}
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get confirmed downlink outcomes")
+		return 0
+	}
+	if len(outcomes) == 0 || len(outcomes) < ns.adrDownlinkLoss.MinDownlinks {
+		return 0
+	}
+	var lost int
+	for _, acked := range outcomes {
+		if !acked {
+			lost++
+		}
+	}
+	if lost == 0 {
+		return 0
+	}
+	backoff := ns.adrDownlinkLoss.Weight * float32(lost) / float32(len(outcomes))
+	log.FromContext(ctx).WithFields(log.Fields(
+		"confirmed_downlinks", len(outcomes),
+		"confirmed_downlinks_lost", lost,
+		"adr_margin_backoff", backoff,
+	)).Debug("Back off ADR margin after confirmed downlink loss")
+	return backoff
+}
+
+// clearConfirmedDownlinkOutcomes removes the confirmed downlink outcomes of the end device.
+func (ns *NetworkServer) clearConfirmedDownlinkOutcomes(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if ns.confirmedDownlinkOutcomes == nil {
+		return
+	}
+	if err := ns.confirmedDownlinkOutcomes.Clear(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to clear confirmed downlink outcomes")
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockConfirmedDownlinkOutcomes map[string][]bool
+
+func (m mockConfirmedDownlinkOutcomes) Add(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, acked bool, size int, _ time.Duration,
+) ([]bool, error) {
+	uid := unique.ID(ctx, ids)
+	outcomes := append([]bool{acked}, m[uid]...)
+	if len(outcomes) > size {
+		outcomes = outcomes[:size]
+	}
+	m[uid] = outcomes
+	return outcomes, nil
+}
+
+func (m mockConfirmedDownlinkOutcomes) Range(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]bool, error) {
+	return m[unique.ID(ctx, ids)], nil
+}
+
+func (m mockConfirmedDownlinkOutcomes) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	delete(m, unique.ID(ctx, ids))
+	return nil
+}
+
+func TestADRMarginBackoffAfterUplink(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	outcomes := mockConfirmedDownlinkOutcomes{}
+	ns := &NetworkServer{
+		confirmedDownlinkOutcomes: outcomes,
+		adrDownlinkLoss: ADRDownlinkLossConfig{
+			Weight:       8,
+			Window:       4,
+			MinDownlinks: 2,
+		},
+	}
+	a.So(ns.adrDownlinkLossEnabled(), should.BeTrue)
+	a.So((&NetworkServer{confirmedDownlinkOutcomes: outcomes}).adrDownlinkLossEnabled(), should.BeFalse)
+
+	ack := &matchResult{Device: &ttnpb.EndDevice{Ids: ids}, ConfirmedDownlinkAck: true}
+	nack := &matchResult{Device: &ttnpb.EndDevice{Ids: ids}, ConfirmedDownlinkNack: true}
+	unconfirmed := &matchResult{Device: &ttnpb.EndDevice{Ids: ids}}
+
+	// Fewer than the minimum number of confirmed downlinks.
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, nack), should.Equal, 0)
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, ack), should.Equal, 4)
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, unconfirmed), should.Equal, 4)
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, nack), should.AlmostEqual, 8*2.0/3, 0.001)
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, nack), should.Equal, 6)
+	// The oldest outcome is dropped from the window.
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, nack), should.Equal, 6)
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, nack), should.Equal, 8)
+	for i := 0; i < 4; i++ {
+		ns.adrMarginBackoffAfterUplink(ctx, ack)
+	}
+	a.So(ns.adrMarginBackoffAfterUplink(ctx, unconfirmed), should.Equal, 0)
+
+	ns.clearConfirmedDownlinkOutcomes(ctx, ids)
+	a.So(outcomes, should.BeEmpty)
+}
@@ -84,6 +84,125 @@ func (c MACSettingConfig) Parse() (*ttnpb.MACSettings, error) {
 	return p, nil
 }
 
+// DevStatusPolicyConfig defines the DevStatusReq periodicity of a device status policy.
+type DevStatusPolicyConfig struct {
+	StatusTimePeriodicity  *time.Duration `name:"status-time-periodicity" description:"The interval after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+	StatusCountPeriodicity *uint32        `name:"status-count-periodicity" description:"Number of uplink messages after which a DevStatusReq MACCommand shall be sent by Network Server if not configured in device's MAC settings"`
+}
+
+// DevStatusPoliciesConfig defines the device status policies of applications.
+type DevStatusPoliciesConfig struct {
+	Battery      DevStatusPolicyConfig `name:"battery" description:"Device status policy for applications with battery powered end devices"`
+	Mains        DevStatusPolicyConfig `name:"mains" description:"Device status policy for applications with mains powered end devices"`
+	Applications map[string]string     `name:"applications" description:"Device status policy (battery, mains) by application ID"`
+}
+
+var errUnknownDevStatusPolicy = errors.DefineInvalidArgument("unknown_dev_status_policy", "unknown device status policy `{policy}` of application `{application_id}`")
+
+// Parse parses the configuration and returns the default MAC settings by application ID.
+// The default MAC settings of an application are the given defaults with the DevStatusReq
+// periodicity of the device status policy of the application.
+func (c DevStatusPoliciesConfig) Parse(defaults *ttnpb.MACSettings) (map[string]*ttnpb.MACSettings, error) {
+	policies := map[string]DevStatusPolicyConfig{
+		"battery": c.Battery,
+		"mains":   c.Mains,
+	}
+	settings := make(map[string]*ttnpb.MACSettings, len(c.Applications))
+	for appID, name := range c.Applications {
+		policy, ok := policies[name]
+		if !ok {
+			return nil, errUnknownDevStatusPolicy.WithAttributes("policy", name, "application_id", appID)
+		}
+		p := ttnpb.Clone(defaults)
+		if policy.StatusTimePeriodicity != nil {
+			p.StatusTimePeriodicity = ttnpb.ProtoDuration(policy.StatusTimePeriodicity)
+		}
+		if policy.StatusCountPeriodicity != nil {
+			p.StatusCountPeriodicity = &wrapperspb.UInt32Value{Value: *policy.StatusCountPeriodicity}
+		}
+		if err := p.ValidateFields(); err != nil {
+			return nil, err
+		}
+		settings[appID] = p
+	}
+	return settings, nil
+}
+
+// DeviceStatusHistoryConfig defines the configuration of the device status history.
+type DeviceStatusHistoryConfig struct {
+	History DeviceStatusHistory `name:"-"`
+	Size    int                 `name:"size" description:"Number of device status answers to keep per end device"`
+}
+
+// SessionHistoryConfig defines the configuration of the session history.
+type SessionHistoryConfig struct {
+	History SessionHistory `name:"-"`
+	Size    int            `name:"size" description:"Number of ended sessions to keep per end device"`
+}
+
+// PingSlotRetriesConfig defines the retries of class B application downlinks in subsequent ping slots.
+type PingSlotRetriesConfig struct {
+	Attempts    PingSlotAttempts `name:"-"`
+	MaxAttempts int              `name:"max-attempts" description:"Maximum number of ping slots in which a class B application downlink is attempted (0 to disable)"`
+	Jitter      float64          `name:"jitter" description:"Maximum random delay of a retry, as fraction of the ping slot period"`
+}
+
+// AdaptiveRxConfig defines the adaptation of the receive window parameters of end devices after consecutive
+// downlink failures.
+type AdaptiveRxConfig struct {
+	Failures            DownlinkFailures `name:"-"`
+	Threshold           int              `name:"threshold" description:"Number of consecutive failed downlinks after which the receive window parameters of an end device are adapted (0 to disable)"`
+	MaxRx1Delay         ttnpb.RxDelay    `name:"max-rx1-delay" description:"Maximum Rx1 delay to which the Rx1 delay of an end device is increased"`
+	MinRx2DataRateIndex uint32           `name:"min-rx2-data-rate-index" description:"Minimum Rx2 data rate index to which the Rx2 data rate of an end device is lowered"`
+}
+
+// ADRDownlinkLossConfig defines the backoff of the ADR algorithm for end devices that do not acknowledge
+// confirmed downlinks.
+type ADRDownlinkLossConfig struct {
+	Outcomes     ConfirmedDownlinkOutcomes `name:"-"`
+	Weight       float32                   `name:"weight" description:"ADR margin in dB that is subtracted from the link margin of an end device that acknowledges none of its confirmed downlinks (0 to disable)"`
+	Window       int                       `name:"window" description:"Number of most recent confirmed downlinks of which the loss rate is computed"`
+	MinDownlinks int                       `name:"min-downlinks" description:"Minimum number of confirmed downlinks before the loss rate is taken into account"`
+}
+
+// MICFailuresConfig defines the recording of data uplinks that are dropped because of a MIC mismatch.
+type MICFailuresConfig struct {
+	History MICFailureHistory `name:"-"`
+	Size    int               `name:"size" description:"Number of data uplinks with a MIC mismatch to keep per DevAddr (0 to disable)"`
+}
+
+// GatewayLatencyConfig defines the compensation of the backhaul latency of gateways in downlink scheduling.
+type GatewayLatencyConfig struct {
+	Enable bool          `name:"enable" description:"Take the latency of gateways, measured from uplink messages, into account when scheduling class A downlink"`
+	Margin time.Duration `name:"margin" description:"Margin added to the round-trip time of a gateway before a receive window"`
+	TTL    time.Duration `name:"ttl" description:"Time after which the measured latency of a gateway expires"`
+}
+
+// GatewayMaintenanceConfig defines the maintenance windows of gateways.
+type GatewayMaintenanceConfig struct {
+	Registry GatewayMaintenanceRegistry `name:"-"`
+	Enable   bool                       `name:"enable" description:"Suppress latency measurements and penalties of gateways during their maintenance windows"`
+	CacheTTL time.Duration              `name:"cache-ttl" description:"Time to cache the maintenance windows of gateways"`
+}
+
+// DevAddrBlocksConfig defines the device address blocks from which device addresses are allocated.
+type DevAddrBlocksConfig struct {
+	Registry DevAddrBlockRegistry `name:"-"`
+	Enable   bool                 `name:"enable" description:"Allocate device addresses from the device address blocks managed by admins"`
+	CacheTTL time.Duration        `name:"cache-ttl" description:"Time to cache the device address blocks"`
+}
+
+// MACVectorsConfig defines the recording of MAC test vectors.
+type MACVectorsConfig struct {
+	Directory    string   `name:"directory" description:"Directory to record anonymized MAC test vectors in (disabled if empty)"`
+	Applications []string `name:"applications" description:"Application IDs of which to record MAC test vectors (all applications if empty)"`
+}
+
+// CryptoServiceConfig defines the network session key operations that are performed by the Crypto Server.
+type CryptoServiceConfig struct {
+	KEKLabels []string `name:"kek-labels" description:"Labels of KEKs of network session keys that are held by the Crypto Server"`
+}
+
 // DownlinkPriorityConfig defines priorities for downlink messages.
 type DownlinkPriorityConfig struct {
 	// JoinAccept is the downlink priority for join-accept messages.
@@ -141,6 +260,7 @@ type Config struct {
 	NetID                    types.NetID                  `name:"net-id" description:"NetID of this Network Server"`
 	ClusterID                string                       `name:"cluster-id" description:"Cluster ID of this Network Server"`
 	DevAddrPrefixes          []types.DevAddrPrefix        `name:"dev-addr-prefixes" description:"Device address prefixes of this Network Server"`
+	DevAddrBlocks            DevAddrBlocksConfig          `name:"dev-addr-blocks" description:"Device address blocks of NetIDs of the cluster"`
 	DeduplicationWindow      time.Duration                `name:"deduplication-window" description:"Time window during which, duplicate messages are collected for metadata"`
 	CooldownWindow           time.Duration                `name:"cooldown-window" description:"Time window starting right after deduplication window, during which, duplicate messages are discarded"`
 	DownlinkPriorities       DownlinkPriorityConfig       `name:"downlink-priorities" description:"Downlink message priorities"`
@@ -148,6 +268,17 @@ type Config struct {
 	Interop                  InteropConfig                `name:"interop" description:"Interop client configuration"`
 	DeviceKEKLabel           string                       `name:"device-kek-label" description:"Label of KEK used to encrypt device keys at rest"`
 	DownlinkQueueCapacity    int                          `name:"downlink-queue-capacity" description:"Maximum downlink queue size per-session"`
+	DevStatusPolicies        DevStatusPoliciesConfig      `name:"dev-status-policies" description:"DevStatusReq policies of applications"`
+	DeviceStatusHistory      DeviceStatusHistoryConfig    `name:"device-status-history" description:"History of device status answers"`
+	SessionHistory           SessionHistoryConfig         `name:"session-history" description:"History of ended sessions of end devices"`
+	GatewayLatency           GatewayLatencyConfig         `name:"gateway-latency" description:"Compensation of gateway backhaul latency in downlink scheduling"`
+	GatewayMaintenance       GatewayMaintenanceConfig     `name:"gateway-maintenance" description:"Maintenance windows of gateways"`
+	PingSlotRetries          PingSlotRetriesConfig        `name:"ping-slot-retries" description:"Retries of class B application downlinks in subsequent ping slots"`
+	AdaptiveRx               AdaptiveRxConfig             `name:"adaptive-rx" description:"Adaptation of receive window parameters after consecutive downlink failures"`
+	ADRDownlinkLoss          ADRDownlinkLossConfig        `name:"adr-downlink-loss" description:"Backoff of the ADR algorithm after confirmed downlink loss"`
+	MICFailures              MICFailuresConfig            `name:"mic-failures" description:"Recording of data uplinks dropped because of a MIC mismatch"`
+	MACVectors               MACVectorsConfig             `name:"mac-vectors" description:"Recording of MAC command handling as replayable test vectors"`
+	CryptoService            CryptoServiceConfig          `name:"crypto-service" description:"Network session key operations by the Crypto Server"`
 }
 
 // DefaultConfig is the default Network Server configuration.
@@ -162,6 +293,9 @@ var DefaultConfig = Config{
 	DownlinkTaskQueue: DownlinkTaskQueueConfig{
 		NumConsumers: 1,
 	},
+	DevAddrBlocks: DevAddrBlocksConfig{
+		CacheTTL: time.Minute,
+	},
 	DeduplicationWindow: 200 * time.Millisecond,
 	CooldownWindow:      time.Second,
 	DownlinkPriorities: DownlinkPriorityConfig{
@@ -178,4 +312,39 @@ var DefaultConfig = Config{
 		StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
 	},
 	DownlinkQueueCapacity: 10000,
+	DevStatusPolicies: DevStatusPoliciesConfig{
+		Battery: DevStatusPolicyConfig{
+			StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(mac.DefaultStatusTimePeriodicity),
+			StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(mac.DefaultStatusCountPeriodicity),
+		},
+		Mains: DevStatusPolicyConfig{
+			StatusTimePeriodicity:  func(v time.Duration) *time.Duration { return &v }(time.Hour),
+			StatusCountPeriodicity: func(v uint32) *uint32 { return &v }(20),
+		},
+	},
+	DeviceStatusHistory: DeviceStatusHistoryConfig{
+		Size: 32,
+	},
+	SessionHistory: SessionHistoryConfig{
+		Size: 10,
+	},
+	GatewayLatency: GatewayLatencyConfig{
+		Enable: true,
+		Margin: 100 * time.Millisecond,
+		TTL:    time.Hour,
+	},
+	GatewayMaintenance: GatewayMaintenanceConfig{
+		CacheTTL: time.Minute,
+	},
+	PingSlotRetries: PingSlotRetriesConfig{
+		MaxAttempts: 8,
+		Jitter:      0.5,
+	},
+	AdaptiveRx: AdaptiveRxConfig{
+		MaxRx1Delay: ttnpb.RxDelay_RX_DELAY_7,
+	},
+	ADRDownlinkLoss: ADRDownlinkLossConfig{
+		Window:       16,
+		MinDownlinks: 4,
+	},
 }
@@ -0,0 +1,68 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+func TestDevStatusPoliciesConfig(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	defaults, err := DefaultConfig.DefaultMACSettings.Parse()
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	conf := DefaultConfig.DevStatusPolicies
+	conf.Applications = map[string]string{
+		"battery-app": "battery",
+		"mains-app":   "mains",
+	}
+	settings, err := conf.Parse(defaults)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(settings, should.HaveLength, 2)
+
+	dev := &ttnpb.EndDevice{}
+	a.So(mac.DeviceStatusTimePeriodicity(dev, settings["battery-app"]), should.Equal, mac.DefaultStatusTimePeriodicity)
+	a.So(mac.DeviceStatusCountPeriodicity(dev, settings["battery-app"]), should.Equal, mac.DefaultStatusCountPeriodicity)
+	a.So(mac.DeviceStatusTimePeriodicity(dev, settings["mains-app"]), should.Equal, time.Hour)
+	a.So(mac.DeviceStatusCountPeriodicity(dev, settings["mains-app"]), should.Equal, 20)
+	a.So(mac.DeviceADRMargin(dev, settings["mains-app"]), should.Equal, mac.DeviceADRMargin(dev, defaults))
+
+	// The MAC settings of the end device take precedence over the policy.
+	dev.MacSettings = &ttnpb.MACSettings{
+		StatusTimePeriodicity: durationpb.New(2 * time.Hour),
+	}
+	a.So(mac.DeviceStatusTimePeriodicity(dev, settings["mains-app"]), should.Equal, 2*time.Hour)
+
+	conf.Applications = map[string]string{
+		"solar-app": "solar",
+	}
+	_, err = conf.Parse(defaults)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
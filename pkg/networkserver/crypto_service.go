@@ -0,0 +1,85 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoservices"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// cryptoServiceHoldsKey returns whether the network session key is held by the Crypto Server.
+func (ns *NetworkServer) cryptoServiceHoldsKey(key *ttnpb.KeyEnvelope) bool {
+	if key.GetKekLabel() == "" {
+		return false
+	}
+	_, ok := ns.cryptoServiceKEKLabels[key.GetKekLabel()]
+	return ok
+}
+
+// networkSessionCrypto returns the service that performs the cryptographic operations with the network session key.
+// If the key is held by the Crypto Server, the operations are performed by the Crypto Server. Otherwise, the key is
+// unwrapped with the key service of the Network Server.
+func (ns *NetworkServer) networkSessionCrypto(
+	ctx context.Context, key *ttnpb.KeyEnvelope,
+) (cryptoservices.NetworkSession, error) {
+	if !ns.cryptoServiceHoldsKey(key) {
+		return cryptoservices.NewNetworkSessionKeyService(ns.KeyService()), nil
+	}
+	cc, err := ns.GetPeerConn(ctx, ttnpb.ClusterRole_CRYPTO_SERVER, nil)
+	if err != nil {
+		return nil, err
+	}
+	return cryptoservices.NewNetworkSessionRPCClient(cc, ns.WithClusterAuth()), nil
+}
+
+// encryptData encrypts or decrypts the FOpts or FRMPayload of a data message with the network session key.
+func (ns *NetworkServer) encryptData(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+	opts ...crypto.EncryptionOption,
+) ([]byte, error) {
+	svc, err := ns.networkSessionCrypto(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	return svc.EncryptData(ctx, key, downlink, addr, fCnt, payload, opts...)
+}
+
+// computeDataMIC computes the MIC of a data message with the network session key. See crypto.ComputeDataMIC.
+func (ns *NetworkServer) computeDataMIC(
+	ctx context.Context,
+	key *ttnpb.KeyEnvelope,
+	downlink bool,
+	confFCnt uint32,
+	txDRIdx uint8,
+	txChIdx uint8,
+	addr types.DevAddr,
+	fCnt uint32,
+	payload []byte,
+) ([4]byte, error) {
+	svc, err := ns.networkSessionCrypto(ctx, key)
+	if err != nil {
+		return [4]byte{}, err
+	}
+	return svc.ComputeDataMIC(ctx, key, downlink, confFCnt, txDRIdx, txChIdx, addr, fCnt, payload)
+}
@@ -0,0 +1,206 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"bytes"
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+var (
+	errNotDataFrame        = errors.DefineInvalidArgument("not_data_frame", "PHYPayload is not a data frame")
+	errUnknownFNwkSIntKey  = errors.DefineNotFound("unknown_f_nwk_s_int_key", "FNwkSIntKey is unknown")
+	errNoSessionForDevAddr = errors.DefineNotFound(
+		"no_session_for_dev_addr", "no session of the end device with DevAddr `{dev_addr}`",
+	)
+)
+
+// DecodePHYPayload implements ttnpb.NsServer.
+// The PHYPayload may be an uplink or a downlink of the current or pending session of the end device.
+// The application payload is not decrypted, as the Network Server does not have access to the AppSKey.
+func (ns *NetworkServer) DecodePHYPayload(
+	ctx context.Context, req *ttnpb.DecodeNsPHYPayloadRequest,
+) (*ttnpb.DecodeNsPHYPayloadResponse, error) {
+	ids := req.EndDeviceIds
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds,
+		ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ_KEYS,
+	); err != nil {
+		return nil, err
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"lorawan_version",
+		"mac_settings.supports_32_bit_f_cnt",
+		"mac_state.lorawan_version",
+		"pending_mac_state.lorawan_version",
+		"pending_session.dev_addr",
+		"pending_session.keys",
+		"session.dev_addr",
+		"session.keys",
+		"session.last_a_f_cnt_down",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
+	})
+	if err != nil {
+		return nil, err
+	}
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	phy, err := DeviceBand(dev, fps)
+	if err != nil {
+		return nil, err
+	}
+	return ns.decodePHYPayload(ctx, dev, phy, req.PhyPayload)
+}
+
+func (ns *NetworkServer) decodePHYPayload(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, rawPayload []byte,
+) (*ttnpb.DecodeNsPHYPayloadResponse, error) {
+	msg := &ttnpb.Message{}
+	if err := lorawan.UnmarshalMessage(rawPayload, msg); err != nil {
+		return nil, errDecodePayload.WithCause(err)
+	}
+	pld := msg.GetMacPayload()
+	if pld == nil {
+		return nil, errNotDataFrame.New()
+	}
+	var downlink bool
+	switch msg.MHdr.MType {
+	case ttnpb.MType_UNCONFIRMED_UP, ttnpb.MType_CONFIRMED_UP:
+	case ttnpb.MType_UNCONFIRMED_DOWN, ttnpb.MType_CONFIRMED_DOWN:
+		downlink = true
+	default:
+		return nil, errNotDataFrame.New()
+	}
+	devAddr := types.MustDevAddr(pld.FHdr.DevAddr).OrZero()
+
+	session, macVersion, pending := dev.Session, dev.GetMacState().GetLorawanVersion(), false
+	if !devAddr.Equal(types.MustDevAddr(session.GetDevAddr()).OrZero()) {
+		session, macVersion, pending = dev.PendingSession, dev.GetPendingMacState().GetLorawanVersion(), true
+		if !devAddr.Equal(types.MustDevAddr(session.GetDevAddr()).OrZero()) {
+			return nil, errNoSessionForDevAddr.WithAttributes("dev_addr", devAddr)
+		}
+	}
+	if macVersion == ttnpb.MACVersion_MAC_UNKNOWN {
+		macVersion = dev.LorawanVersion
+	}
+	decoded := &ttnpb.DecodeNsPHYPayloadResponse{
+		MType:          msg.MHdr.MType,
+		Major:          msg.MHdr.Major,
+		DevAddr:        devAddr.Bytes(),
+		PendingSession: pending,
+		LorawanVersion: macVersion,
+		FCnt:           pld.FHdr.FCnt,
+		FPort:          pld.FPort,
+		ReceivedMic:    msg.Mic,
+		FOpts:          pld.FHdr.FOpts,
+		FrmPayload:     pld.FrmPayload,
+	}
+
+	// NOTE: The frame counters of the session are the last frame counters processed by the Network Server, so the
+	// full frame counter is only correct for frames that are recent relative to the session.
+	supports32BitFCnt := mac.DeviceSupports32BitFCnt(dev, ns.defaultMACSettings)
+	micKey := session.GetKeys().GetFNwkSIntKey()
+	var confFCnt uint32
+	switch {
+	case !downlink:
+		decoded.FullFCnt = FullFCnt(uint16(pld.FHdr.FCnt), session.GetLastFCntUp(), supports32BitFCnt)
+		if micKey == nil {
+			return nil, errUnknownFNwkSIntKey.New()
+		}
+	default:
+		lastFCnt := session.GetLastNFCntDown()
+		if pld.FPort != 0 && !macspec.UseSharedFCntDown(macVersion) {
+			lastFCnt = session.GetLastAFCntDown()
+		}
+		decoded.FullFCnt = FullFCnt(uint16(pld.FHdr.FCnt), lastFCnt, supports32BitFCnt)
+		micKey = session.GetKeys().GetSNwkSIntKey()
+		if micKey == nil {
+			return nil, errUnknownSNwkSIntKey.New()
+		}
+		// NOTE: LoRaWAN 1.1 downlinks which acknowledge an uplink include the FCnt of the uplink in the MIC.
+		// It is assumed that the last uplink of the session is acknowledged.
+		if pld.FHdr.FCtrl.GetAck() && !macspec.UseLegacyMIC(macVersion) {
+			confFCnt = session.GetLastFCntUp()
+		}
+	}
+	computedMIC, err := ns.computeDataMIC(
+		ctx, micKey, downlink, confFCnt, 0, 0, devAddr, decoded.FullFCnt, rawPayload[:len(rawPayload)-4],
+	)
+	if err != nil {
+		return nil, errComputeMIC.WithCause(err)
+	}
+	if !downlink && !macspec.UseLegacyMIC(macVersion) {
+		decoded.ComputedMic = computedMIC[:2]
+		decoded.MicValid = bytes.Equal(msg.Mic[2:], computedMIC[:2])
+	} else {
+		decoded.ComputedMic = computedMIC[:]
+		decoded.MicValid = bytes.Equal(msg.Mic, computedMIC[:])
+	}
+
+	cmdBuf := pld.FHdr.FOpts
+	if pld.FPort == 0 && len(pld.FrmPayload) > 0 {
+		cmdBuf = pld.FrmPayload
+	}
+	cmdsInFOpts := len(pld.FHdr.FOpts) > 0
+	if len(cmdBuf) > 0 && (!cmdsInFOpts || macspec.EncryptFOpts(macVersion)) {
+		if session.GetKeys().GetNwkSEncKey() == nil {
+			return nil, errUnknownNwkSEncKey.New()
+		}
+		frameType := macspec.UplinkFrame
+		if downlink {
+			frameType = macspec.DownlinkFrame
+		}
+		encOpts := macspec.EncryptionOptions(macVersion, frameType, pld.FPort, cmdsInFOpts)
+		cmdBuf, err = ns.encryptData(
+			ctx, session.Keys.NwkSEncKey, downlink, devAddr, decoded.FullFCnt, cmdBuf, encOpts...,
+		)
+		if err != nil {
+			return nil, err
+		}
+		if cmdsInFOpts {
+			decoded.FOpts = cmdBuf
+		} else {
+			decoded.FrmPayload = cmdBuf
+		}
+	}
+	read := lorawan.DefaultMACCommands.ReadUplink
+	if downlink {
+		read = lorawan.DefaultMACCommands.ReadDownlink
+	}
+	for r := bytes.NewReader(cmdBuf); r.Len() > 0; {
+		cmd := &ttnpb.MACCommand{}
+		// NOTE: MAC commands decrypted with the wrong session keys are garbage, so reading stops at the first
+		// MAC command that cannot be read.
+		if err := read(*phy, r, cmd); err != nil {
+			break
+		}
+		decoded.MacCommands = append(decoded.MacCommands, cmd)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,226 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/band"
+	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDecodePHYPayload(t *testing.T) {
+	t.Parallel()
+	ctx := test.Context()
+
+	c := component.MustNew(
+		log.Noop,
+		&component.Config{
+			ServiceBase: config.ServiceBase{
+				FrequencyPlans: config.FrequencyPlansConfig{
+					ConfigSource: "static",
+					Static:       test.StaticFrequencyPlans,
+				},
+			},
+		},
+		component.WithClusterNew(func(context.Context, *cluster.Config, ...cluster.Option) (cluster.Cluster, error) {
+			return &test.MockCluster{
+				JoinFunc: test.ClusterJoinNilFunc,
+			}, nil
+		}),
+	)
+	componenttest.StartComponent(t, c)
+	ns := &NetworkServer{
+		Component:          c,
+		ctx:                ctx,
+		defaultMACSettings: &ttnpb.MACSettings{},
+	}
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	keys := &ttnpb.SessionKeys{
+		FNwkSIntKey: &ttnpb.KeyEnvelope{Key: types.AES128Key{0x01}.Bytes()},
+		SNwkSIntKey: &ttnpb.KeyEnvelope{Key: types.AES128Key{0x02}.Bytes()},
+		NwkSEncKey:  &ttnpb.KeyEnvelope{Key: types.AES128Key{0x03}.Bytes()},
+	}
+	makeDevice := func(macVersion ttnpb.MACVersion) *ttnpb.EndDevice {
+		return &ttnpb.EndDevice{
+			FrequencyPlanId:   band.EU_863_870,
+			LorawanPhyVersion: ttnpb.PHYVersion_RP001_V1_1_REV_B,
+			LorawanVersion:    macVersion,
+			MacState: &ttnpb.MACState{
+				LorawanVersion: macVersion,
+			},
+			Session: &ttnpb.Session{
+				DevAddr:       devAddr.Bytes(),
+				Keys:          keys,
+				LastFCntUp:    0x10002,
+				LastNFCntDown: 0x3,
+			},
+		}
+	}
+	phy := test.Must(DeviceBand(makeDevice(ttnpb.MACVersion_MAC_V1_0_3), frequencyplans.NewStore(test.FrequencyPlansFetcher)))
+	linkCheckReq := MakeUplinkMACBuffer(phy, ttnpb.MACCommandIdentifier_CID_LINK_CHECK)
+
+	for _, tc := range []struct {
+		Name       string
+		Device     *ttnpb.EndDevice
+		RawPayload []byte
+		Assertion  func(*assertions.Assertion, *ttnpb.DecodeNsPHYPayloadResponse, error) bool
+	}{
+		{
+			Name:       "join-request",
+			Device:     makeDevice(ttnpb.MACVersion_MAC_V1_0_3),
+			RawPayload: make([]byte, 23),
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(errors.IsInvalidArgument(err), should.BeTrue) && a.So(decoded, should.BeNil)
+			},
+		},
+		{
+			Name:   "unknown DevAddr",
+			Device: makeDevice(ttnpb.MACVersion_MAC_V1_0_3),
+			RawPayload: MakeDataUplink(DataUplinkConfig{
+				DataRate:    phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				MACVersion:  ttnpb.MACVersion_MAC_V1_0_3,
+				DevAddr:     types.DevAddr{0x26, 0x01, 0x43, 0x43},
+				FCnt:        0x10005,
+				SessionKeys: keys,
+			}).RawPayload,
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(errors.IsNotFound(err), should.BeTrue) && a.So(decoded, should.BeNil)
+			},
+		},
+		{
+			Name:   "1.0.3 uplink with MAC commands in FRMPayload",
+			Device: makeDevice(ttnpb.MACVersion_MAC_V1_0_3),
+			RawPayload: MakeDataUplink(DataUplinkConfig{
+				DataRate:    phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				MACVersion:  ttnpb.MACVersion_MAC_V1_0_3,
+				DevAddr:     devAddr,
+				FCnt:        0x10005,
+				FRMPayload:  linkCheckReq,
+				SessionKeys: keys,
+			}).RawPayload,
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(err, should.BeNil) &&
+					a.So(decoded.MType, should.Equal, ttnpb.MType_UNCONFIRMED_UP) &&
+					a.So(decoded.LorawanVersion, should.Equal, ttnpb.MACVersion_MAC_V1_0_3) &&
+					a.So(decoded.FCnt, should.Equal, 0x5) &&
+					a.So(decoded.FullFCnt, should.Equal, 0x10005) &&
+					a.So(decoded.MicValid, should.BeTrue) &&
+					a.So(decoded.ComputedMic, should.HaveLength, 4) &&
+					a.So(decoded.FrmPayload, should.Resemble, linkCheckReq) &&
+					a.So(decoded.MacCommands, should.Resemble, []*ttnpb.MACCommand{
+						ttnpb.MACCommandIdentifier_CID_LINK_CHECK.MACCommand(),
+					})
+			},
+		},
+		{
+			Name: "1.0.3 uplink with invalid MIC",
+			Device: func() *ttnpb.EndDevice {
+				dev := makeDevice(ttnpb.MACVersion_MAC_V1_0_3)
+				dev.Session.Keys = &ttnpb.SessionKeys{
+					FNwkSIntKey: &ttnpb.KeyEnvelope{Key: types.AES128Key{0x42}.Bytes()},
+				}
+				return dev
+			}(),
+			RawPayload: MakeDataUplink(DataUplinkConfig{
+				DataRate:    phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				MACVersion:  ttnpb.MACVersion_MAC_V1_0_3,
+				DevAddr:     devAddr,
+				FCnt:        0x10005,
+				FPort:       1,
+				FRMPayload:  []byte{0x01, 0x02},
+				SessionKeys: keys,
+			}).RawPayload,
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(err, should.BeNil) &&
+					a.So(decoded.MicValid, should.BeFalse) &&
+					a.So(decoded.FPort, should.Equal, 1) &&
+					a.So(decoded.MacCommands, should.BeEmpty)
+			},
+		},
+		{
+			Name:   "1.1 uplink with MAC commands in FOpts",
+			Device: makeDevice(ttnpb.MACVersion_MAC_V1_1),
+			RawPayload: MakeDataUplink(DataUplinkConfig{
+				DataRate:    phy.DataRates[ttnpb.DataRateIndex_DATA_RATE_0].Rate,
+				MACVersion:  ttnpb.MACVersion_MAC_V1_1,
+				DevAddr:     devAddr,
+				FCnt:        0x10005,
+				FPort:       1,
+				FOpts:       linkCheckReq,
+				FRMPayload:  []byte{0x01, 0x02},
+				SessionKeys: keys,
+			}).RawPayload,
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(err, should.BeNil) &&
+					a.So(decoded.MicValid, should.BeTrue) &&
+					a.So(decoded.ComputedMic, should.HaveLength, 2) &&
+					a.So(decoded.FOpts, should.Resemble, linkCheckReq) &&
+					a.So(decoded.MacCommands, should.Resemble, []*ttnpb.MACCommand{
+						ttnpb.MACCommandIdentifier_CID_LINK_CHECK.MACCommand(),
+					})
+			},
+		},
+		{
+			Name:   "1.0.3 downlink",
+			Device: makeDevice(ttnpb.MACVersion_MAC_V1_0_3),
+			RawPayload: func() []byte {
+				b := test.Must(lorawan.MarshalMessage(&ttnpb.Message{
+					MHdr: &ttnpb.MHDR{MType: ttnpb.MType_UNCONFIRMED_DOWN, Major: ttnpb.Major_LORAWAN_R1},
+					Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+						FHdr: &ttnpb.FHDR{
+							DevAddr: devAddr.Bytes(),
+							FCtrl:   &ttnpb.FCtrl{},
+							FCnt:    0x4,
+						},
+					}},
+				}))
+				mic := test.Must(crypto.ComputeLegacyDownlinkMIC(types.AES128Key{0x02}, devAddr, 0x4, b))
+				return append(b, mic[:]...)
+			}(),
+			Assertion: func(a *assertions.Assertion, decoded *ttnpb.DecodeNsPHYPayloadResponse, err error) bool {
+				return a.So(err, should.BeNil) &&
+					a.So(decoded.MType, should.Equal, ttnpb.MType_UNCONFIRMED_DOWN) &&
+					a.So(decoded.FullFCnt, should.Equal, 0x4) &&
+					a.So(decoded.MicValid, should.BeTrue)
+			},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			decoded, err := ns.decodePHYPayload(ctx, tc.Device, phy, tc.RawPayload)
+			a.So(tc.Assertion(a, decoded, err), should.BeTrue)
+		})
+	}
+}
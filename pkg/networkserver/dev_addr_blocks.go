@@ -0,0 +1,262 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"regexp"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// DevAddrBlockPolicy is the allocation policy of a device address block.
+type DevAddrBlockPolicy string
+
+const (
+	// DevAddrBlockPolicyShared allocates device addresses from the block to end devices of all applications
+	// that have no dedicated block.
+	DevAddrBlockPolicyShared DevAddrBlockPolicy = "shared"
+	// DevAddrBlockPolicyDedicated allocates device addresses from the block only to end devices of the applications
+	// of the block.
+	DevAddrBlockPolicyDedicated DevAddrBlockPolicy = "dedicated"
+	// DevAddrBlockPolicyReserved does not allocate new device addresses from the block.
+	// End devices that already have a device address in the block keep it.
+	DevAddrBlockPolicyReserved DevAddrBlockPolicy = "reserved"
+)
+
+// DevAddrBlock is a block of device addresses of a NetID, from which the Network Server allocates device addresses.
+type DevAddrBlock struct {
+	ID     string              `json:"id"`
+	NetID  types.NetID         `json:"net_id"`
+	Prefix types.DevAddrPrefix `json:"prefix"`
+	Policy DevAddrBlockPolicy  `json:"policy"`
+	// Applications are the IDs of the applications of which the end devices get device addresses from the block.
+	// Only dedicated blocks have applications.
+	Applications []string `json:"applications,omitempty"`
+}
+
+// DevAddrBlockStats are the statistics of the address utilization of a device address block.
+type DevAddrBlockStats struct {
+	// Capacity is the number of device addresses in the block.
+	Capacity uint64 `json:"capacity"`
+	// Allocations is the number of device addresses that have been allocated from the block.
+	// Device addresses are allocated randomly, so the same device address may be allocated more than once.
+	Allocations uint64 `json:"allocations"`
+	// Utilization is the number of allocations relative to the capacity of the block.
+	Utilization float64 `json:"utilization"`
+}
+
+var (
+	errDevAddrBlockID = errors.DefineInvalidArgument(
+		"dev_addr_block_id", "invalid device address block ID `{id}`",
+	)
+	errDevAddrBlockPolicy = errors.DefineInvalidArgument(
+		"dev_addr_block_policy", "invalid device address block policy `{policy}`",
+	)
+	errDevAddrBlockApplications = errors.DefineInvalidArgument(
+		"dev_addr_block_applications", "only dedicated device address blocks have applications",
+	)
+	errDevAddrBlockNoApplications = errors.DefineInvalidArgument(
+		"dev_addr_block_no_applications", "dedicated device address blocks must have applications",
+	)
+	errDevAddrBlockPrefix = errors.DefineInvalidArgument(
+		"dev_addr_block_prefix", "device address prefix `{prefix}` is not in the range of NetID `{net_id}`",
+	)
+	errDevAddrBlockOverlap = errors.DefineAlreadyExists(
+		"dev_addr_block_overlap", "device address prefix `{prefix}` overlaps with block `{id}`",
+	)
+	errDevAddrBlockNotFound = errors.DefineNotFound(
+		"dev_addr_block_not_found", "device address block `{id}` not found",
+	)
+)
+
+var devAddrBlockIDRegexp = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// Validate validates the device address block. The policy defaults to shared.
+func (b *DevAddrBlock) Validate() error {
+	if !devAddrBlockIDRegexp.MatchString(b.ID) {
+		return errDevAddrBlockID.WithAttributes("id", b.ID)
+	}
+	switch b.Policy {
+	case "":
+		b.Policy = DevAddrBlockPolicyShared
+	case DevAddrBlockPolicyShared, DevAddrBlockPolicyDedicated, DevAddrBlockPolicyReserved:
+	default:
+		return errDevAddrBlockPolicy.WithAttributes("policy", b.Policy)
+	}
+	if b.Policy == DevAddrBlockPolicyDedicated && len(b.Applications) == 0 {
+		return errDevAddrBlockNoApplications.New()
+	}
+	if b.Policy != DevAddrBlockPolicyDedicated && len(b.Applications) > 0 {
+		return errDevAddrBlockApplications.New()
+	}
+	for _, appID := range b.Applications {
+		if err := (&ttnpb.ApplicationIdentifiers{ApplicationId: appID}).ValidateFields(); err != nil {
+			return err
+		}
+	}
+	netIDAddr, err := types.NewDevAddr(b.NetID, nil)
+	if err != nil {
+		return err
+	}
+	netIDPrefix := types.DevAddrPrefix{
+		DevAddr: netIDAddr,
+		Length:  uint8(32 - types.NwkAddrBits(b.NetID)),
+	}
+	if b.Prefix.Length < netIDPrefix.Length || !netIDPrefix.Matches(b.Prefix.DevAddr) {
+		return errDevAddrBlockPrefix.WithAttributes("prefix", b.Prefix, "net_id", b.NetID)
+	}
+	return nil
+}
+
+// Overlaps returns whether the device address prefixes of the blocks overlap.
+func (b *DevAddrBlock) Overlaps(other *DevAddrBlock) bool {
+	return b.Prefix.Matches(other.Prefix.DevAddr) || other.Prefix.Matches(b.Prefix.DevAddr)
+}
+
+// Stats returns the statistics of the block with the given number of allocations.
+func (b *DevAddrBlock) Stats(allocations uint64) DevAddrBlockStats {
+	capacity := uint64(1) << (32 - b.Prefix.Length)
+	return DevAddrBlockStats{
+		Capacity:    capacity,
+		Allocations: allocations,
+		Utilization: float64(allocations) / float64(capacity),
+	}
+}
+
+// allocatableDevAddrBlocks returns the blocks from which device addresses are allocated to end devices of the
+// application. If the application has dedicated blocks, only those are returned. Otherwise, the shared blocks are
+// returned.
+func allocatableDevAddrBlocks(blocks []*DevAddrBlock, ids *ttnpb.ApplicationIdentifiers) []*DevAddrBlock {
+	var dedicated, shared []*DevAddrBlock
+	for _, b := range blocks {
+		switch b.Policy {
+		case DevAddrBlockPolicyShared:
+			shared = append(shared, b)
+		case DevAddrBlockPolicyDedicated:
+			for _, appID := range b.Applications {
+				if appID == ids.GetApplicationId() {
+					dedicated = append(dedicated, b)
+					break
+				}
+			}
+		}
+	}
+	if len(dedicated) > 0 {
+		return dedicated
+	}
+	return shared
+}
+
+// pickDevAddrBlock picks a random block, weighted by the size of the blocks.
+func pickDevAddrBlock(blocks []*DevAddrBlock) *DevAddrBlock {
+	totalWeight := int64(0)
+	for _, b := range blocks {
+		totalWeight += int64(1) << (32 - b.Prefix.Length)
+	}
+	r := random.Int63n(totalWeight)
+	for _, b := range blocks {
+		r -= int64(1) << (32 - b.Prefix.Length)
+		if r < 0 {
+			return b
+		}
+	}
+	panic("unreachable")
+}
+
+// devAddrBlockCache caches the device address blocks of the registry.
+type devAddrBlockCache struct {
+	registry DevAddrBlockRegistry
+	ttl      time.Duration
+
+	mu        sync.Mutex
+	blocks    []*DevAddrBlock
+	expiresAt time.Time
+}
+
+func (c *devAddrBlockCache) get(ctx context.Context) ([]*DevAddrBlock, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now := time.Now(); now.After(c.expiresAt) {
+		blocks, err := c.registry.Range(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.blocks, c.expiresAt = blocks, now.Add(c.ttl)
+	}
+	return c.blocks, nil
+}
+
+func (c *devAddrBlockCache) invalidate() {
+	c.mu.Lock()
+	c.expiresAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// allocateDevAddr allocates a device address for an end device of the application.
+// If no device address blocks are configured, or none of the blocks are allocatable for the application,
+// the device address is derived from the configured device address prefixes.
+func (ns *NetworkServer) allocateDevAddr(ctx context.Context, ids *ttnpb.ApplicationIdentifiers) types.DevAddr {
+	if ns.devAddrBlocks == nil {
+		return ns.newDevAddr(ctx)
+	}
+	logger := log.FromContext(ctx)
+	blocks, err := ns.devAddrBlocks.get(ctx)
+	if err != nil {
+		logger.WithError(err).Warn("Failed to get device address blocks")
+		return ns.newDevAddr(ctx)
+	}
+	blocks = allocatableDevAddrBlocks(blocks, ids)
+	if len(blocks) == 0 {
+		return ns.newDevAddr(ctx)
+	}
+	block := pickDevAddrBlock(blocks)
+	devAddr := makeNewDevAddrFunc(block.Prefix)(ctx)
+	if err := ns.devAddrBlocks.registry.AddAllocation(ctx, block.ID); err != nil {
+		logger.WithError(err).WithField("block_id", block.ID).Warn("Failed to count device address allocation")
+	}
+	return devAddr
+}
+
+func containsDevAddrPrefix(prefixes []types.DevAddrPrefix, prefix types.DevAddrPrefix) bool {
+	for _, p := range prefixes {
+		if p.Equal(prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// devAddrBlockPrefixes returns the device address prefixes of the device address blocks.
+func (ns *NetworkServer) devAddrBlockPrefixes(ctx context.Context) ([]types.DevAddrPrefix, error) {
+	if ns.devAddrBlocks == nil {
+		return nil, nil
+	}
+	blocks, err := ns.devAddrBlocks.get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	prefixes := make([]types.DevAddrPrefix, 0, len(blocks))
+	for _, b := range blocks {
+		prefixes = append(prefixes, b.Prefix)
+	}
+	return prefixes, nil
+}
@@ -0,0 +1,168 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type memDevAddrBlockRegistry struct {
+	blocks      []*DevAddrBlock
+	allocations map[string]uint64
+}
+
+func (r *memDevAddrBlockRegistry) Range(context.Context) ([]*DevAddrBlock, error) {
+	return r.blocks, nil
+}
+
+func (*memDevAddrBlockRegistry) Set(context.Context, *DevAddrBlock) error { return nil }
+
+func (*memDevAddrBlockRegistry) Delete(context.Context, string) error { return nil }
+
+func (r *memDevAddrBlockRegistry) AddAllocation(_ context.Context, id string) error {
+	r.allocations[id]++
+	return nil
+}
+
+func (r *memDevAddrBlockRegistry) Allocations(context.Context) (map[string]uint64, error) {
+	return r.allocations, nil
+}
+
+func TestDevAddrBlockValidate(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	netID := types.NetID{0x00, 0x00, 0x13}
+	block := &DevAddrBlock{
+		ID:     "block-1",
+		NetID:  netID,
+		Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+	}
+	if a.So(block.Validate(), should.BeNil) {
+		a.So(block.Policy, should.Equal, DevAddrBlockPolicyShared)
+	}
+
+	for _, invalid := range []*DevAddrBlock{
+		{
+			ID:     "b",
+			NetID:  netID,
+			Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+		},
+		{
+			ID:     "block-1",
+			NetID:  netID,
+			Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+			Policy: "unknown",
+		},
+		{
+			ID:     "block-1",
+			NetID:  netID,
+			Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+			Policy: DevAddrBlockPolicyDedicated,
+		},
+		{
+			ID:           "block-1",
+			NetID:        netID,
+			Prefix:       types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+			Applications: []string{"app1"},
+		},
+		{
+			ID:     "block-1",
+			NetID:  netID,
+			Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x28, 0x01, 0x00, 0x00}, Length: 16},
+		},
+		{
+			ID:     "block-1",
+			NetID:  netID,
+			Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 6},
+		},
+	} {
+		a.So(invalid.Validate(), should.NotBeNil)
+	}
+
+	other := &DevAddrBlock{
+		Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 8},
+	}
+	a.So(block.Overlaps(other), should.BeTrue)
+	a.So(other.Overlaps(block), should.BeTrue)
+	other.Prefix = types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x02, 0x00, 0x00}, Length: 16}
+	a.So(block.Overlaps(other), should.BeFalse)
+
+	a.So(block.Stats(16384), should.Resemble, DevAddrBlockStats{
+		Capacity:    65536,
+		Allocations: 16384,
+		Utilization: 0.25,
+	})
+}
+
+func TestAllocateDevAddr(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	fallback := types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 16}
+	shared := &DevAddrBlock{
+		ID:     "shared",
+		Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+		Policy: DevAddrBlockPolicyShared,
+	}
+	dedicated := &DevAddrBlock{
+		ID:           "dedicated",
+		Prefix:       types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x02, 0x00, 0x00}, Length: 16},
+		Policy:       DevAddrBlockPolicyDedicated,
+		Applications: []string{"app1"},
+	}
+	reserved := &DevAddrBlock{
+		ID:     "reserved",
+		Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x03, 0x00, 0x00}, Length: 16},
+		Policy: DevAddrBlockPolicyReserved,
+	}
+	registry := &memDevAddrBlockRegistry{
+		blocks:      []*DevAddrBlock{shared, dedicated, reserved},
+		allocations: map[string]uint64{},
+	}
+	ns := &NetworkServer{
+		newDevAddr: makeNewDevAddrFunc(fallback),
+		devAddrBlocks: &devAddrBlockCache{
+			registry: registry,
+			ttl:      time.Minute,
+		},
+	}
+
+	for i := 0; i < 10; i++ {
+		a.So(ns.allocateDevAddr(ctx, nil).HasPrefix(shared.Prefix), should.BeTrue)
+		a.So(ns.allocateDevAddr(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "app2"}).HasPrefix(shared.Prefix), should.BeTrue)
+		a.So(ns.allocateDevAddr(ctx, &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"}).HasPrefix(dedicated.Prefix), should.BeTrue)
+	}
+	a.So(registry.allocations, should.Resemble, map[string]uint64{
+		"shared":    20,
+		"dedicated": 10,
+	})
+
+	// Without allocatable blocks, the device address is derived from the configured prefixes.
+	registry.blocks = []*DevAddrBlock{dedicated, reserved}
+	ns.devAddrBlocks.invalidate()
+	a.So(ns.allocateDevAddr(ctx, nil).HasPrefix(fallback), should.BeTrue)
+
+	prefixes, err := ns.devAddrBlockPrefixes(ctx)
+	a.So(err, should.BeNil)
+	a.So(prefixes, should.Resemble, []types.DevAddrPrefix{dedicated.Prefix, reserved.Prefix})
+}
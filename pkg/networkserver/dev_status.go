@@ -0,0 +1,60 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultMACSettingsOf returns the default MAC settings of the application.
+// The default MAC settings of applications with a device status policy contain the DevStatusReq
+// periodicity of the policy. The MAC settings of the end device still take precedence.
+func (ns *NetworkServer) defaultMACSettingsOf(ids *ttnpb.ApplicationIdentifiers) *ttnpb.MACSettings {
+	if settings, ok := ns.applicationDefaultMACSettings[ids.GetApplicationId()]; ok {
+		return settings
+	}
+	return ns.defaultMACSettings
+}
+
+// recordDeviceStatus adds the last device status of dev to the device status history.
+func (ns *NetworkServer) recordDeviceStatus(ctx context.Context, dev *ttnpb.EndDevice) {
+	if ns.deviceStatusHistory == nil || ns.deviceStatusHistorySize <= 0 || dev.LastDevStatusReceivedAt == nil {
+		return
+	}
+	status := &DeviceStatus{
+		ReceivedAt:     *ttnpb.StdTime(dev.LastDevStatusReceivedAt),
+		PowerState:     dev.PowerState,
+		DownlinkMargin: dev.DownlinkMargin,
+	}
+	if v := dev.BatteryPercentage; v != nil {
+		status.BatteryPercentage = &v.Value
+	}
+	if err := ns.deviceStatusHistory.Add(ctx, dev.Ids, status, ns.deviceStatusHistorySize); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to add device status to history")
+	}
+}
+
+// clearDeviceStatus removes the device status history of the end device.
+func (ns *NetworkServer) clearDeviceStatus(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if ns.deviceStatusHistory == nil {
+		return
+	}
+	if err := ns.deviceStatusHistory.Clear(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to clear device status history")
+	}
+}
@@ -23,8 +23,6 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
 	"go.thethings.network/lorawan-stack/v3/pkg/cluster"
-	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
-	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
@@ -132,7 +130,7 @@ func (ns *NetworkServer) nextDataDownlinkTaskAt(ctx context.Context, dev *ttnpb.
 		log.FromContext(ctx).WithError(err).Warn("Failed to determine device band")
 		return time.Time{}, nil
 	}
-	slot, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt)
+	slot, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettingsOf(dev.Ids.ApplicationIds), earliestAt)
 	if !ok {
 		return time.Time{}, nil
 	}
@@ -269,7 +267,7 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 			mac.EnqueueForceRejoinReq,
 			mac.EnqueueRejoinParamSetupReq,
 			func(ctx context.Context, dev *ttnpb.EndDevice, maxDownLen uint16, maxUpLen uint16) mac.EnqueueState {
-				return mac.EnqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettings, transmitAt)
+				return mac.EnqueueDevStatusReq(ctx, dev, maxDownLen, maxUpLen, ns.defaultMACSettingsOf(dev.Ids.ApplicationIds), transmitAt)
 			},
 		}
 
@@ -511,17 +509,16 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 		if dev.GetSession().GetKeys().GetNwkSEncKey() == nil {
 			return nil, genState, errUnknownNwkSEncKey.New()
 		}
-		key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.NwkSEncKey, ns.KeyService())
-		if err != nil {
-			logger.WithField("kek_label", dev.Session.Keys.NwkSEncKey.KekLabel).WithError(err).Warn("Failed to unwrap NwkSEncKey")
-			return nil, genState, err
-		}
 		// pld.FullFCnt is either application downlink frame counter (AFCntDown),
 		// or the network downlink frame counter (NFCntDown), based on the (presence of the) FPort.
 		fCnt := pld.FullFCnt
 		encOpts := macspec.EncryptionOptions(dev.MacState.LorawanVersion, macspec.DownlinkFrame, pld.FPort, cmdsInFOpts)
-		cmdBuf, err = crypto.EncryptDownlink(key, types.MustDevAddr(dev.Session.DevAddr).OrZero(), fCnt, cmdBuf, encOpts...)
+		var err error
+		cmdBuf, err = ns.encryptData(
+			ctx, dev.Session.Keys.NwkSEncKey, true, types.MustDevAddr(dev.Session.DevAddr).OrZero(), fCnt, cmdBuf, encOpts...,
+		)
 		if err != nil {
+			logger.WithField("kek_label", dev.Session.Keys.NwkSEncKey.KekLabel).WithError(err).Warn("Failed to encrypt MAC commands")
 			return nil, genState, errEncryptMAC.WithCause(err)
 		}
 	}
@@ -582,35 +579,25 @@ func (ns *NetworkServer) generateDataDownlink(ctx context.Context, dev *ttnpb.En
 	if dev.Session.GetKeys().GetSNwkSIntKey() == nil {
 		return nil, genState, errUnknownSNwkSIntKey.New()
 	}
-	key, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.SNwkSIntKey, ns.KeyService())
-	if err != nil {
-		logger.WithField("kek_label", dev.Session.Keys.SNwkSIntKey.KekLabel).WithError(err).Warn("Failed to unwrap SNwkSIntKey")
-		return nil, genState, err
-	}
-
-	var mic [4]byte
-	if macspec.UseLegacyMIC(dev.MacState.LorawanVersion) {
-		mic, err = crypto.ComputeLegacyDownlinkMIC(
-			key,
-			types.MustDevAddr(dev.Session.DevAddr).OrZero(),
-			pld.FullFCnt,
-			b,
-		)
-	} else {
-		var confFCnt uint32
-		if pld.FHdr.FCtrl.Ack {
-			confFCnt = up.GetPayload().GetMacPayload().GetFullFCnt()
-		}
-		mic, err = crypto.ComputeDownlinkMIC(
-			key,
-			types.MustDevAddr(dev.Session.DevAddr).OrZero(),
-			confFCnt,
-			pld.FullFCnt,
-			b,
-		)
+	// The legacy MIC is computed without the frame counter of the acknowledged uplink.
+	var confFCnt uint32
+	if !macspec.UseLegacyMIC(dev.MacState.LorawanVersion) && pld.FHdr.FCtrl.Ack {
+		confFCnt = up.GetPayload().GetMacPayload().GetFullFCnt()
 	}
+	mic, err := ns.computeDataMIC(
+		ctx,
+		dev.Session.Keys.SNwkSIntKey,
+		true,
+		confFCnt,
+		0,
+		0,
+		types.MustDevAddr(dev.Session.DevAddr).OrZero(),
+		pld.FullFCnt,
+		b,
+	)
 	if err != nil {
-		return nil, genState, errComputeMIC.New()
+		logger.WithField("kek_label", dev.Session.Keys.SNwkSIntKey.KekLabel).WithError(err).Warn("Failed to compute MIC")
+		return nil, genState, errComputeMIC.WithCause(err)
 	}
 	b = append(b, mic[:]...)
 	msg.Mic = mic[:]
@@ -1387,6 +1374,9 @@ type downlinkAttemptResult struct {
 	QueuedApplicationUplinks   []*ttnpb.ApplicationUp
 	QueuedEvents               []events.Event
 	DownlinkTaskUpdateStrategy downlinkTaskUpdateStrategy
+	// RetryAt is the earliest time of the retry, if DownlinkTaskUpdateStrategy is retryDownlinkTask.
+	// If zero, the downlink is retried after downlinkRetryInterval.
+	RetryAt time.Time
 }
 
 func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, fp *frequencyplans.FrequencyPlan, slot *classADownlinkSlot, maxUpLength uint16) downlinkAttemptResult {
@@ -1411,7 +1401,8 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		}
 	}
 
-	now := time.Now()
+	// Downlink paths of gateways with a high latency may not reach the gateway in time for RX1.
+	paths, reachAt := ns.gatewayLatencies.ClassAPaths(ctx, paths, time.Now(), slot.RX1())
 	queuedEvents := []events.Event{}
 	rxParameters, rxParametersEvents, err := computeRxParameters(
 		ctx,
@@ -1419,7 +1410,7 @@ func (ns *NetworkServer) attemptClassADataDownlink(ctx context.Context, dev *ttn
 		dev.MacState,
 		slot.Uplink.DeviceChannelIndex,
 		slot.Uplink.Settings.DataRate,
-		now,
+		reachAt,
 		slot.RX1(),
 		slot.RX2(),
 		phy,
@@ -1694,6 +1685,12 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	} else {
 		paths := downlinkPathsFromRecentUplinks(dev.MacState.RecentUplinks...)
 		if len(paths) == 0 {
+			if ns.pingSlotRetriesEnabled(slot, genState.ApplicationDownlink) {
+				log.FromContext(ctx).Warn("No downlink path available for class B downlink")
+				if a, ok := ns.attemptPingSlotRetry(ctx, dev, genState, sets, queuedEvents, errNoPath.New()); ok {
+					return a
+				}
+			}
 			log.FromContext(ctx).Error("No downlink path available, skip class B/C downlink slot")
 			if genState.ApplicationDownlink != nil && ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
 				dev.Session.QueuedApplicationDownlinks = append(
@@ -1781,6 +1778,12 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 				}
 			}
 		}
+		if ns.pingSlotRetriesEnabled(slot, genState.ApplicationDownlink) {
+			logger.Warn("All Gateway Servers failed to schedule class B downlink")
+			if a, ok := ns.attemptPingSlotRetry(log.NewContext(ctx, logger), dev, genState, sets, queuedEvents, err); ok {
+				return a
+			}
+		}
 		logger.Warn("All Gateway Servers failed to schedule downlink, retry attempt")
 		if genState.NeedsDownlinkQueueUpdate {
 			dev.Session.QueuedApplicationDownlinks = append([]*ttnpb.ApplicationDownlink{genState.ApplicationDownlink}, dev.Session.QueuedApplicationDownlinks...)
@@ -1794,6 +1797,9 @@ func (ns *NetworkServer) attemptNetworkInitiatedDataDownlink(ctx context.Context
 	}
 
 	recordDataDownlink(dev, genState, genDown.NeedsMACAnswer, down, ns.defaultMACSettings)
+	if ns.pingSlotRetriesEnabled(slot, genState.ApplicationDownlink) {
+		ns.clearPingSlotAttempts(ctx, dev.Ids)
+	}
 	if genState.ApplicationDownlink != nil || genState.EvictDownlinkQueueIfScheduled {
 		sets = ttnpb.AddFields(sets, "session.queued_application_downlinks")
 	}
@@ -1844,6 +1850,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 		defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
 		taskUpdateStrategy := noDownlinkTask
+		var retryAt time.Time
 		dev, ctx, err := ns.devices.SetByID(ctx, devID.ApplicationIds, devID.DeviceId,
 			[]string{
 				"frequency_plan_id",
@@ -2083,7 +2090,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 				}
 				var earliestAt time.Time
 				for {
-					v, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettings, earliestAt)
+					v, ok := nextDataDownlinkSlot(ctx, dev, phy, ns.defaultMACSettingsOf(dev.Ids.ApplicationIds), earliestAt)
 					if !ok {
 						return dev, nil, nil
 					}
@@ -2124,6 +2131,7 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 						queuedEvents = append(queuedEvents, a.QueuedEvents...)
 						queuedApplicationUplinks = append(queuedApplicationUplinks, a.QueuedApplicationUplinks...)
 						taskUpdateStrategy = a.DownlinkTaskUpdateStrategy
+						retryAt = a.RetryAt
 						return dev, a.SetPaths, nil
 
 					default:
@@ -2143,7 +2151,10 @@ func (ns *NetworkServer) processDownlinkTask(ctx context.Context, consumerID str
 		case nextDownlinkTask:
 
 		case retryDownlinkTask:
-			earliestAt = time.Now().Add(downlinkRetryInterval + nsScheduleWindow())
+			earliestAt = retryAt
+			if earliestAt.IsZero() {
+				earliestAt = time.Now().Add(downlinkRetryInterval + nsScheduleWindow())
+			}
 
 		case noDownlinkTask:
 			return time.Time{}, nil
@@ -43,11 +43,15 @@ var (
 	errInvalidFieldValue                  = errors.DefineInvalidArgument("field_value", "invalid value of field `{field}`")
 	errInvalidFixedPaths                  = errors.DefineInvalidArgument("fixed_paths", "invalid fixed paths set in application downlink")
 	errInvalidPayload                     = errors.DefineInvalidArgument("payload", "invalid payload")
+	errJoinRequestRateLimited             = errors.DefineResourceExhausted("join_request_rate_limited", "join-request exceeds rate limit of `{resource}`")
 	errJoinServerNotFound                 = errors.DefineNotFound("join_server_not_found", "Join Server not found")
 	errNoPath                             = errors.DefineNotFound("no_downlink_path", "no downlink path available")
 	errOutdatedData                       = errors.DefineFailedPrecondition("outdated_data", "data is outdated")
+	errPingSlotAttempts                   = errors.DefineResourceExhausted("ping_slot_attempts", "class B downlink not scheduled in `{attempts}` ping slots")
 	errRawPayloadTooShort                 = errors.Define("raw_payload_too_short", "length of RawPayload must not be less than 4")
 	errSchedule                           = errors.Define("schedule", "all downlink scheduling attempts failed")
+	errSimulateDevAddr                    = errors.DefineFailedPrecondition("simulate_dev_addr", "DevAddr `{dev_addr}` does not match the session")
+	errSimulateMulticast                  = errors.DefineFailedPrecondition("simulate_multicast", "uplinks can not be simulated for multicast devices")
 	errUnknownMACState                    = errors.DefineFailedPrecondition("unknown_mac_state", "MAC state is unknown")
 	errUnknownNwkSEncKey                  = errors.DefineNotFound("unknown_nwk_s_enc_key", "NwkSEncKey is unknown")
 	errUnknownSession                     = errors.DefineNotFound("unknown_session", "unknown session")
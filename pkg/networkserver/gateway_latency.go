@@ -0,0 +1,144 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	// gatewayLatencyWeight is the inverse of the weight of a new latency sample in the smoothed latency of a gateway.
+	gatewayLatencyWeight = 8
+	// maxGatewayLatency is the maximum plausible latency of a gateway. Larger samples are ignored.
+	maxGatewayLatency = 10 * time.Second
+)
+
+// gatewayLatencies keeps the smoothed latency between gateways and the Network Server.
+//
+// The Gateway Server sets the time at which a gateway received an uplink message, taking the round-trip times of
+// the gateway connection into account. The time between that moment and the time at which the Network Server
+// receives the uplink message is the latency of the gateway backhaul and of the Gateway Server. A downlink message
+// takes roughly the same time in the opposite direction.
+//
+// Gateways in maintenance are not measured and their downlink paths are not deprioritized.
+type gatewayLatencies struct {
+	margin      time.Duration
+	ttl         time.Duration
+	maintenance *gatewayMaintenanceCache
+	entries     sync.Map // Gateway UID to *gatewayLatency.
+}
+
+type gatewayLatency struct {
+	mu        sync.Mutex
+	latency   time.Duration
+	updatedAt time.Time
+}
+
+func newGatewayLatencies(conf GatewayLatencyConfig) *gatewayLatencies {
+	if !conf.Enable {
+		return nil
+	}
+	return &gatewayLatencies{
+		margin: conf.Margin,
+		ttl:    conf.TTL,
+	}
+}
+
+// Record records the latencies of the gateways that received the uplink message at receivedAt.
+func (l *gatewayLatencies) Record(ctx context.Context, up *ttnpb.UplinkMessage, receivedAt time.Time) {
+	if l == nil {
+		return
+	}
+	for _, md := range up.RxMetadata {
+		if md.GatewayIds == nil || md.ReceivedAt == nil {
+			continue
+		}
+		d := receivedAt.Sub(*ttnpb.StdTime(md.ReceivedAt))
+		if d < 0 || d > maxGatewayLatency || l.maintenance.Active(ctx, md.GatewayIds, receivedAt) {
+			continue
+		}
+		v, _ := l.entries.LoadOrStore(unique.ID(ctx, md.GatewayIds), &gatewayLatency{})
+		e := v.(*gatewayLatency)
+		e.mu.Lock()
+		if e.updatedAt.IsZero() || receivedAt.Sub(e.updatedAt) > l.ttl {
+			e.latency = d
+		} else {
+			e.latency += (d - e.latency) / gatewayLatencyWeight
+		}
+		e.updatedAt = receivedAt
+		e.mu.Unlock()
+	}
+}
+
+// Get returns the smoothed latency of the gateway, if it is known at now.
+func (l *gatewayLatencies) Get(ctx context.Context, ids *ttnpb.GatewayIdentifiers, now time.Time) (time.Duration, bool) {
+	if l == nil || ids == nil {
+		return 0, false
+	}
+	v, ok := l.entries.Load(unique.ID(ctx, ids))
+	if !ok {
+		return 0, false
+	}
+	e := v.(*gatewayLatency)
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if now.Sub(e.updatedAt) > l.ttl {
+		return 0, false
+	}
+	return e.latency, true
+}
+
+// pathDelay returns the time that a class A downlink scheduled via the path needs before the receive window.
+// The receive window of the end device starts a latency earlier than the Network Server assumes, and the downlink
+// takes another latency to reach the gateway. If the latency of the gateway is unknown or the gateway is in
+// maintenance, the delay is zero.
+func (l *gatewayLatencies) pathDelay(ctx context.Context, path downlinkPath, now time.Time) time.Duration {
+	latency, ok := l.Get(ctx, path.GatewayIdentifiers, now)
+	if !ok || l.maintenance.Active(ctx, path.GatewayIdentifiers, now) {
+		return 0
+	}
+	return 2*latency + l.margin
+}
+
+// ClassAPaths orders the downlink paths so that the paths via which a downlink reaches the gateway before rx1 come
+// first, and keeps the order of the paths otherwise. It returns the ordered paths and the earliest time at which
+// a downlink scheduled at now reaches a gateway, relative to the receive windows computed by the Network Server.
+func (l *gatewayLatencies) ClassAPaths(
+	ctx context.Context, paths []downlinkPath, now, rx1 time.Time,
+) ([]downlinkPath, time.Time) {
+	if l == nil || len(paths) == 0 {
+		return paths, now
+	}
+	delays := make(map[*ttnpb.DownlinkPath]time.Duration, len(paths))
+	minDelay := time.Duration(-1)
+	for _, path := range paths {
+		d := l.pathDelay(ctx, path, now)
+		delays[path.DownlinkPath] = d
+		if minDelay < 0 || d < minDelay {
+			minDelay = d
+		}
+	}
+	paths = append(paths[:0:0], paths...)
+	sort.SliceStable(paths, func(i, j int) bool {
+		return now.Add(delays[paths[i].DownlinkPath]).Before(rx1) && !now.Add(delays[paths[j].DownlinkPath]).Before(rx1)
+	})
+	return paths, now.Add(minDelay)
+}
@@ -0,0 +1,115 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestGatewayLatencies(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	a.So(newGatewayLatencies(GatewayLatencyConfig{}), should.BeNil)
+
+	l := newGatewayLatencies(GatewayLatencyConfig{
+		Enable: true,
+		Margin: 100 * time.Millisecond,
+		TTL:    time.Hour,
+	})
+	fastIDs := &ttnpb.GatewayIdentifiers{GatewayId: "fast"}
+	slowIDs := &ttnpb.GatewayIdentifiers{GatewayId: "slow"}
+	unknownIDs := &ttnpb.GatewayIdentifiers{GatewayId: "unknown"}
+
+	now := time.Unix(1000, 0)
+	up := func(receivedAt time.Time, latencies map[*ttnpb.GatewayIdentifiers]time.Duration) *ttnpb.UplinkMessage {
+		msg := &ttnpb.UplinkMessage{}
+		for ids, d := range latencies {
+			msg.RxMetadata = append(msg.RxMetadata, &ttnpb.RxMetadata{
+				GatewayIds: ids,
+				ReceivedAt: timestamppb.New(receivedAt.Add(-d)),
+			})
+		}
+		msg.RxMetadata = append(msg.RxMetadata, &ttnpb.RxMetadata{GatewayIds: unknownIDs})
+		return msg
+	}
+	l.Record(ctx, up(now, map[*ttnpb.GatewayIdentifiers]time.Duration{
+		fastIDs: 20 * time.Millisecond,
+		slowIDs: 400 * time.Millisecond,
+	}), now)
+
+	d, ok := l.Get(ctx, fastIDs, now)
+	a.So(ok, should.BeTrue)
+	a.So(d, should.Equal, 20*time.Millisecond)
+	d, ok = l.Get(ctx, slowIDs, now)
+	a.So(ok, should.BeTrue)
+	a.So(d, should.Equal, 400*time.Millisecond)
+	_, ok = l.Get(ctx, unknownIDs, now)
+	a.So(ok, should.BeFalse)
+
+	// New samples are smoothed, and implausible samples are ignored.
+	now = now.Add(time.Minute)
+	l.Record(ctx, up(now, map[*ttnpb.GatewayIdentifiers]time.Duration{
+		fastIDs: time.Minute,
+		slowIDs: 800 * time.Millisecond,
+	}), now)
+	d, _ = l.Get(ctx, fastIDs, now)
+	a.So(d, should.Equal, 20*time.Millisecond)
+	d, _ = l.Get(ctx, slowIDs, now)
+	a.So(d, should.Equal, 450*time.Millisecond)
+
+	// The latency expires.
+	_, ok = l.Get(ctx, slowIDs, now.Add(2*time.Hour))
+	a.So(ok, should.BeFalse)
+
+	newPath := func(ids *ttnpb.GatewayIdentifiers) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: ids,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte(ids.GatewayId)},
+			},
+		}
+	}
+	slow, fast, unknown := newPath(slowIDs), newPath(fastIDs), newPath(unknownIDs)
+
+	// The slow gateway needs 2*450ms+100ms before RX1, which leaves it behind the other paths.
+	paths, reachAt := l.ClassAPaths(ctx, []downlinkPath{slow, fast}, now, now.Add(time.Second))
+	a.So(paths, should.Resemble, []downlinkPath{fast, slow})
+	a.So(reachAt, should.Equal, now.Add(140*time.Millisecond))
+
+	paths, reachAt = l.ClassAPaths(ctx, []downlinkPath{slow, fast}, now, now.Add(5*time.Second))
+	a.So(paths, should.Resemble, []downlinkPath{slow, fast})
+	a.So(reachAt, should.Equal, now.Add(140*time.Millisecond))
+
+	paths, reachAt = l.ClassAPaths(ctx, []downlinkPath{slow}, now, now.Add(time.Second))
+	a.So(paths, should.Resemble, []downlinkPath{slow})
+	a.So(reachAt, should.Equal, now.Add(time.Second))
+
+	paths, reachAt = l.ClassAPaths(ctx, []downlinkPath{slow, unknown}, now, now.Add(time.Second))
+	a.So(paths, should.Resemble, []downlinkPath{unknown, slow})
+	a.So(reachAt, should.Equal, now)
+
+	// Without latency compensation, the paths are unchanged.
+	var disabled *gatewayLatencies
+	paths, reachAt = disabled.ClassAPaths(ctx, []downlinkPath{slow, fast}, now, now.Add(time.Second))
+	a.So(paths, should.Resemble, []downlinkPath{slow, fast})
+	a.So(reachAt, should.Equal, now)
+}
@@ -0,0 +1,184 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"sync"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// GatewayMaintenanceRecurrence is the recurrence of a gateway maintenance window.
+type GatewayMaintenanceRecurrence string
+
+const (
+	// GatewayMaintenanceOnce is a maintenance window that does not recur.
+	GatewayMaintenanceOnce GatewayMaintenanceRecurrence = ""
+	// GatewayMaintenanceDaily is a maintenance window that recurs every 24 hours.
+	GatewayMaintenanceDaily GatewayMaintenanceRecurrence = "daily"
+	// GatewayMaintenanceWeekly is a maintenance window that recurs every 7 days.
+	GatewayMaintenanceWeekly GatewayMaintenanceRecurrence = "weekly"
+)
+
+// period returns the period of the recurrence, or zero if the recurrence does not recur.
+func (r GatewayMaintenanceRecurrence) period() time.Duration {
+	switch r {
+	case GatewayMaintenanceDaily:
+		return 24 * time.Hour
+	case GatewayMaintenanceWeekly:
+		return 7 * 24 * time.Hour
+	default:
+		return 0
+	}
+}
+
+// GatewayMaintenanceWindow is a time range during which a gateway is in maintenance.
+// Recurring windows repeat the time range with the period of the recurrence, until the optional until time.
+type GatewayMaintenanceWindow struct {
+	Start      time.Time                    `json:"start"`
+	End        time.Time                    `json:"end"`
+	Recurrence GatewayMaintenanceRecurrence `json:"recurrence,omitempty"`
+	Until      *time.Time                   `json:"until,omitempty"`
+}
+
+// Active returns whether the maintenance window is active at t.
+func (w GatewayMaintenanceWindow) Active(t time.Time) bool {
+	if t.Before(w.Start) || (w.Until != nil && !t.Before(*w.Until)) {
+		return false
+	}
+	elapsed := t.Sub(w.Start)
+	if period := w.Recurrence.period(); period > 0 {
+		elapsed %= period
+	}
+	return elapsed < w.End.Sub(w.Start)
+}
+
+// GatewayMaintenance are the maintenance windows of a gateway.
+// While a maintenance window is active, the Network Server does not measure the latency of the gateway and does not
+// deprioritize downlink paths via the gateway because of its latency.
+type GatewayMaintenance struct {
+	Windows []GatewayMaintenanceWindow `json:"windows"`
+}
+
+// maxGatewayMaintenanceWindows is the maximum number of maintenance windows of a gateway.
+const maxGatewayMaintenanceWindows = 16
+
+var (
+	errGatewayMaintenanceTooManyWindows = errors.DefineInvalidArgument(
+		"gateway_maintenance_too_many_windows", "more than `{max}` maintenance windows",
+	)
+	errGatewayMaintenanceWindowRange = errors.DefineInvalidArgument(
+		"gateway_maintenance_window_range", "maintenance window end must be after start",
+	)
+	errGatewayMaintenanceWindowRecurrence = errors.DefineInvalidArgument(
+		"gateway_maintenance_window_recurrence", "invalid maintenance window recurrence `{recurrence}`",
+	)
+	errGatewayMaintenanceWindowDuration = errors.DefineInvalidArgument(
+		"gateway_maintenance_window_duration", "recurring maintenance window must be shorter than its recurrence",
+	)
+	errGatewayMaintenanceWindowUntil = errors.DefineInvalidArgument(
+		"gateway_maintenance_window_until", "maintenance window until must be after start of recurring window",
+	)
+)
+
+// Validate validates the maintenance windows.
+func (m *GatewayMaintenance) Validate() error {
+	if len(m.Windows) > maxGatewayMaintenanceWindows {
+		return errGatewayMaintenanceTooManyWindows.WithAttributes("max", maxGatewayMaintenanceWindows)
+	}
+	for _, w := range m.Windows {
+		if !w.End.After(w.Start) {
+			return errGatewayMaintenanceWindowRange.New()
+		}
+		switch w.Recurrence {
+		case GatewayMaintenanceOnce:
+			if w.Until != nil {
+				return errGatewayMaintenanceWindowUntil.New()
+			}
+			continue
+		case GatewayMaintenanceDaily, GatewayMaintenanceWeekly:
+		default:
+			return errGatewayMaintenanceWindowRecurrence.WithAttributes("recurrence", w.Recurrence)
+		}
+		if w.End.Sub(w.Start) >= w.Recurrence.period() {
+			return errGatewayMaintenanceWindowDuration.New()
+		}
+		if w.Until != nil && !w.Until.After(w.Start) {
+			return errGatewayMaintenanceWindowUntil.New()
+		}
+	}
+	return nil
+}
+
+// Active returns whether any of the maintenance windows is active at t.
+func (m *GatewayMaintenance) Active(t time.Time) bool {
+	if m == nil {
+		return false
+	}
+	for _, w := range m.Windows {
+		if w.Active(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// gatewayMaintenanceCache caches the maintenance windows of the registry.
+type gatewayMaintenanceCache struct {
+	registry GatewayMaintenanceRegistry
+	ttl      time.Duration
+
+	mu          sync.Mutex
+	maintenance map[string]*GatewayMaintenance
+	expiresAt   time.Time
+}
+
+func (c *gatewayMaintenanceCache) get(ctx context.Context) (map[string]*GatewayMaintenance, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if now := time.Now(); now.After(c.expiresAt) {
+		maintenance, err := c.registry.Range(ctx)
+		if err != nil {
+			return nil, err
+		}
+		c.maintenance, c.expiresAt = maintenance, now.Add(c.ttl)
+	}
+	return c.maintenance, nil
+}
+
+func (c *gatewayMaintenanceCache) invalidate() {
+	c.mu.Lock()
+	c.expiresAt = time.Time{}
+	c.mu.Unlock()
+}
+
+// Active returns whether the gateway is in maintenance at t.
+// If the maintenance windows cannot be retrieved, the gateway is considered not to be in maintenance.
+func (c *gatewayMaintenanceCache) Active(ctx context.Context, ids *ttnpb.GatewayIdentifiers, t time.Time) bool {
+	if c == nil || ids == nil {
+		return false
+	}
+	maintenance, err := c.get(ctx)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to get gateway maintenance windows")
+		return false
+	}
+	return maintenance[unique.ID(ctx, ids)].Active(t)
+}
@@ -0,0 +1,200 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockGatewayMaintenanceRegistry map[string]*GatewayMaintenance
+
+func (mockGatewayMaintenanceRegistry) Get(context.Context, *ttnpb.GatewayIdentifiers) (*GatewayMaintenance, error) {
+	panic("not implemented")
+}
+
+func (mockGatewayMaintenanceRegistry) Set(context.Context, *ttnpb.GatewayIdentifiers, *GatewayMaintenance) error {
+	panic("not implemented")
+}
+
+func (mockGatewayMaintenanceRegistry) Delete(context.Context, *ttnpb.GatewayIdentifiers) error {
+	panic("not implemented")
+}
+
+func (r mockGatewayMaintenanceRegistry) Range(context.Context) (map[string]*GatewayMaintenance, error) {
+	return r, nil
+}
+
+func TestGatewayMaintenanceValidate(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2023, time.June, 1, 2, 0, 0, 0, time.UTC)
+	before := start.Add(-time.Hour)
+	for _, tc := range []struct {
+		Name   string
+		Window GatewayMaintenanceWindow
+		Valid  bool
+	}{
+		{
+			Name:   "Once",
+			Window: GatewayMaintenanceWindow{Start: start, End: start.Add(2 * time.Hour)},
+			Valid:  true,
+		},
+		{
+			Name: "Weekly",
+			Window: GatewayMaintenanceWindow{
+				Start: start, End: start.Add(2 * time.Hour), Recurrence: GatewayMaintenanceWeekly,
+			},
+			Valid: true,
+		},
+		{
+			Name:   "EndBeforeStart",
+			Window: GatewayMaintenanceWindow{Start: start, End: start.Add(-time.Hour)},
+		},
+		{
+			Name: "InvalidRecurrence",
+			Window: GatewayMaintenanceWindow{
+				Start: start, End: start.Add(time.Hour), Recurrence: "monthly",
+			},
+		},
+		{
+			Name: "LongerThanRecurrence",
+			Window: GatewayMaintenanceWindow{
+				Start: start, End: start.Add(24 * time.Hour), Recurrence: GatewayMaintenanceDaily,
+			},
+		},
+		{
+			Name: "UntilBeforeStart",
+			Window: GatewayMaintenanceWindow{
+				Start: start, End: start.Add(time.Hour), Recurrence: GatewayMaintenanceDaily, Until: &before,
+			},
+		},
+		{
+			Name:   "UntilWithoutRecurrence",
+			Window: GatewayMaintenanceWindow{Start: start, End: start.Add(time.Hour), Until: &before},
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a, _ := test.New(t)
+			err := (&GatewayMaintenance{Windows: []GatewayMaintenanceWindow{tc.Window}}).Validate()
+			if tc.Valid {
+				a.So(err, should.BeNil)
+			} else {
+				a.So(errors.IsInvalidArgument(err), should.BeTrue)
+			}
+		})
+	}
+}
+
+func TestGatewayMaintenanceActive(t *testing.T) {
+	t.Parallel()
+	a, _ := test.New(t)
+
+	start := time.Date(2023, time.June, 1, 2, 0, 0, 0, time.UTC)
+	until := start.Add(14 * 24 * time.Hour)
+	once := GatewayMaintenanceWindow{Start: start, End: start.Add(2 * time.Hour)}
+	daily := GatewayMaintenanceWindow{Start: start, End: start.Add(2 * time.Hour), Recurrence: GatewayMaintenanceDaily}
+	weekly := GatewayMaintenanceWindow{
+		Start: start, End: start.Add(2 * time.Hour), Recurrence: GatewayMaintenanceWeekly, Until: &until,
+	}
+
+	a.So(once.Active(start.Add(-time.Minute)), should.BeFalse)
+	a.So(once.Active(start), should.BeTrue)
+	a.So(once.Active(start.Add(time.Hour)), should.BeTrue)
+	a.So(once.Active(start.Add(2*time.Hour)), should.BeFalse)
+	a.So(once.Active(start.Add(24*time.Hour)), should.BeFalse)
+
+	a.So(daily.Active(start.Add(-23*time.Hour)), should.BeFalse)
+	a.So(daily.Active(start.Add(25*time.Hour)), should.BeTrue)
+	a.So(daily.Active(start.Add(27*time.Hour)), should.BeFalse)
+
+	a.So(weekly.Active(start.Add(24*time.Hour+time.Hour)), should.BeFalse)
+	a.So(weekly.Active(start.Add(7*24*time.Hour+time.Hour)), should.BeTrue)
+	a.So(weekly.Active(start.Add(14*24*time.Hour+time.Hour)), should.BeFalse)
+
+	var none *GatewayMaintenance
+	a.So(none.Active(start), should.BeFalse)
+	a.So((&GatewayMaintenance{Windows: []GatewayMaintenanceWindow{once, weekly}}).Active(start.Add(7*24*time.Hour)), should.BeTrue)
+}
+
+func TestGatewayLatenciesMaintenance(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	now := time.Unix(1000, 0)
+	fastIDs := &ttnpb.GatewayIdentifiers{GatewayId: "fast"}
+	slowIDs := &ttnpb.GatewayIdentifiers{GatewayId: "slow"}
+	maintenance := &gatewayMaintenanceCache{
+		registry: mockGatewayMaintenanceRegistry{
+			unique.ID(ctx, slowIDs): {
+				Windows: []GatewayMaintenanceWindow{{Start: now.Add(time.Minute), End: now.Add(time.Hour)}},
+			},
+		},
+		ttl: time.Hour,
+	}
+	a.So(maintenance.Active(ctx, slowIDs, now), should.BeFalse)
+	a.So(maintenance.Active(ctx, slowIDs, now.Add(time.Minute)), should.BeTrue)
+	a.So(maintenance.Active(ctx, fastIDs, now.Add(time.Minute)), should.BeFalse)
+
+	l := newGatewayLatencies(GatewayLatencyConfig{
+		Enable: true,
+		Margin: 100 * time.Millisecond,
+		TTL:    time.Hour,
+	})
+	l.maintenance = maintenance
+	up := func(receivedAt time.Time, latency time.Duration) *ttnpb.UplinkMessage {
+		return &ttnpb.UplinkMessage{
+			RxMetadata: []*ttnpb.RxMetadata{
+				{GatewayIds: fastIDs, ReceivedAt: timestamppb.New(receivedAt.Add(-20 * time.Millisecond))},
+				{GatewayIds: slowIDs, ReceivedAt: timestamppb.New(receivedAt.Add(-latency))},
+			},
+		}
+	}
+	l.Record(ctx, up(now, 400*time.Millisecond), now)
+
+	// Samples during maintenance are not recorded.
+	during := now.Add(2 * time.Minute)
+	l.Record(ctx, up(during, 5*time.Second), during)
+	d, ok := l.Get(ctx, slowIDs, during)
+	a.So(ok, should.BeTrue)
+	a.So(d, should.Equal, 400*time.Millisecond)
+
+	newPath := func(ids *ttnpb.GatewayIdentifiers) downlinkPath {
+		return downlinkPath{
+			GatewayIdentifiers: ids,
+			DownlinkPath: &ttnpb.DownlinkPath{
+				Path: &ttnpb.DownlinkPath_UplinkToken{UplinkToken: []byte(ids.GatewayId)},
+			},
+		}
+	}
+	slow, fast := newPath(slowIDs), newPath(fastIDs)
+
+	// The slow gateway is deprioritized outside of maintenance only.
+	paths, _ := l.ClassAPaths(ctx, []downlinkPath{slow, fast}, now, now.Add(500*time.Millisecond))
+	a.So(paths, should.Resemble, []downlinkPath{fast, slow})
+	paths, reachAt := l.ClassAPaths(ctx, []downlinkPath{slow, fast}, during, during.Add(500*time.Millisecond))
+	a.So(paths, should.Resemble, []downlinkPath{slow, fast})
+	a.So(reachAt, should.Equal, during)
+}
@@ -21,6 +21,7 @@ import (
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/emptypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
@@ -29,7 +30,7 @@ import (
 // GenerateDevAddr returns a device address assignment in the device address
 // range of the network server.
 func (ns *NetworkServer) GenerateDevAddr(ctx context.Context, req *emptypb.Empty) (*ttnpb.GenerateDevAddrResponse, error) {
-	devAddr := ns.newDevAddr(ctx)
+	devAddr := ns.allocateDevAddr(ctx, nil)
 	return &ttnpb.GenerateDevAddrResponse{DevAddr: devAddr.Bytes()}, nil
 }
 
@@ -96,7 +97,16 @@ func (ns *NetworkServer) GetDeviceAddressPrefixes(
 ) (*ttnpb.GetDeviceAdressPrefixesResponse, error) {
 	output := &ttnpb.GetDeviceAdressPrefixesResponse{}
 
-	prefixes := ns.devAddrPrefixes(ctx)
+	prefixes := append([]types.DevAddrPrefix(nil), ns.devAddrPrefixes(ctx)...)
+	blockPrefixes, err := ns.devAddrBlockPrefixes(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, blockPrefix := range blockPrefixes {
+		if !containsDevAddrPrefix(prefixes, blockPrefix) {
+			prefixes = append(prefixes, blockPrefix)
+		}
+	}
 
 	for _, devAddrPrefix := range prefixes {
 		output.DevAddrPrefixes = append(output.DevAddrPrefixes, devAddrPrefix.Bytes())
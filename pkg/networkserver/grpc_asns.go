@@ -20,6 +20,7 @@ import (
 	"fmt"
 
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
+	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
@@ -29,8 +30,10 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 type ApplicationUplinkQueueDrainFunc func(limit int, f func(...*ttnpb.ApplicationUp) error) error
@@ -427,3 +430,132 @@ func (ns *NetworkServer) DownlinkQueueList(ctx context.Context, ids *ttnpb.EndDe
 		Downlinks: append(dev.Session.GetQueuedApplicationDownlinks(), dev.PendingSession.GetQueuedApplicationDownlinks()...),
 	}, nil
 }
+
+// simulatedGatewayID is the gateway ID used in the RX metadata of simulated uplinks.
+const simulatedGatewayID = "simulated-gateway"
+
+// simulatedUplinkChannel returns the index of the first enabled uplink channel of the end device, with its frequency
+// and maximum data rate index.
+func simulatedUplinkChannel(dev *ttnpb.EndDevice) (uint8, *ttnpb.MACParameters_Channel, error) {
+	for i, ch := range dev.GetMacState().GetCurrentParameters().GetChannels() {
+		if ch.GetEnableUplink() && ch.GetUplinkFrequency() > 0 {
+			return uint8(i), ch, nil
+		}
+	}
+	return 0, nil, errUplinkChannelNotFound.New()
+}
+
+// SimulateUplink implements ttnpb.AsNsServer.
+func (ns *NetworkServer) SimulateUplink(ctx context.Context, req *ttnpb.AsNsSimulateUplinkRequest) (*ttnpb.UplinkMessage, error) {
+	if err := clusterauth.Authorized(ctx); err != nil {
+		return nil, err
+	}
+	dev, ctx, err := ns.devices.GetByID(ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId, []string{
+		"frequency_plan_id",
+		"lorawan_phy_version",
+		"mac_state.current_parameters.channels",
+		"mac_state.lorawan_version",
+		"multicast",
+		"session.dev_addr",
+		"session.keys.f_nwk_s_int_key",
+		"session.keys.s_nwk_s_int_key",
+	})
+	if err != nil {
+		logRegistryRPCError(ctx, err, "Failed to get device for simulated uplink")
+		return nil, err
+	}
+	if dev.Multicast {
+		return nil, errSimulateMulticast.New()
+	}
+	if dev.Session == nil {
+		return nil, errUnknownSession.New()
+	}
+	if dev.MacState == nil {
+		return nil, errUnknownMACState.New()
+	}
+	devAddr := types.MustDevAddr(req.DevAddr).OrZero()
+	if !devAddr.Equal(types.MustDevAddr(dev.Session.DevAddr).OrZero()) {
+		return nil, errSimulateDevAddr.WithAttributes("dev_addr", devAddr)
+	}
+
+	fps, err := ns.FrequencyPlansStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	phy, err := DeviceBand(dev, fps)
+	if err != nil {
+		return nil, err
+	}
+	chIdx, ch, err := simulatedUplinkChannel(dev)
+	if err != nil {
+		return nil, err
+	}
+	dr, ok := phy.DataRates[ch.MaxDataRateIndex]
+	if !ok {
+		return nil, errDataRateIndexNotFound.WithAttributes("index", ch.MaxDataRateIndex)
+	}
+
+	mType := ttnpb.MType_UNCONFIRMED_UP
+	if req.Confirmed {
+		mType = ttnpb.MType_CONFIRMED_UP
+	}
+	b, err := lorawan.MarshalMessage(&ttnpb.Message{
+		MHdr: &ttnpb.MHDR{
+			MType: mType,
+			Major: ttnpb.Major_LORAWAN_R1,
+		},
+		Payload: &ttnpb.Message_MacPayload{
+			MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{
+					DevAddr: devAddr.Bytes(),
+					FCtrl:   &ttnpb.FCtrl{},
+					FCnt:    req.FCnt & 0xffff,
+				},
+				FPort:      req.FPort,
+				FrmPayload: req.FrmPayload,
+			},
+		},
+	})
+	if err != nil {
+		return nil, errEncodePayload.WithCause(err)
+	}
+	mic, err := ns.computeDataMIC(ctx, dev.Session.Keys.FNwkSIntKey, false, 0, 0, 0, devAddr, req.FCnt, b)
+	if err != nil {
+		return nil, errComputeMIC.WithCause(err)
+	}
+	if !macspec.UseLegacyMIC(dev.MacState.LorawanVersion) {
+		cmacS, err := ns.computeDataMIC(
+			ctx, dev.Session.Keys.SNwkSIntKey, false, 0, uint8(ch.MaxDataRateIndex), chIdx, devAddr, req.FCnt, b,
+		)
+		if err != nil {
+			return nil, errComputeMIC.WithCause(err)
+		}
+		// The MIC is composed of the first 2 bytes of the cmacS and the cmacF. See crypto.ComputeUplinkMICFromLegacy.
+		copy(mic[2:], mic[:2])
+		copy(mic[:2], cmacS[:2])
+	}
+
+	now := time.Now()
+	timestamp := uint32(now.UnixNano() / int64(time.Microsecond))
+	up := &ttnpb.UplinkMessage{
+		RawPayload: append(b, mic[:]...),
+		Settings: &ttnpb.TxSettings{
+			DataRate:  ttnpb.Clone(dr.Rate),
+			Frequency: ch.UplinkFrequency,
+			Timestamp: timestamp,
+			Time:      timestamppb.New(now),
+		},
+		RxMetadata: []*ttnpb.RxMetadata{{
+			GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: simulatedGatewayID},
+			Time:        timestamppb.New(now),
+			Timestamp:   timestamp,
+			Rssi:        -42,
+			ChannelRssi: -42,
+			Snr:         4.2,
+		}},
+	}
+	if _, err := ns.HandleUplink(ctx, up); err != nil {
+		return nil, err
+	}
+	return up, nil
+}
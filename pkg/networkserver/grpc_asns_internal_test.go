@@ -0,0 +1,79 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSimulatedUplinkChannel(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		Name          string
+		Channels      []*ttnpb.MACParameters_Channel
+		ExpectedIndex uint8
+		ExpectedError *errors.Definition
+	}{
+		{
+			Name:          "No MAC state",
+			ExpectedError: errUplinkChannelNotFound,
+		},
+		{
+			Name: "No enabled channels",
+			Channels: []*ttnpb.MACParameters_Channel{
+				{UplinkFrequency: 868100000},
+				{UplinkFrequency: 868300000},
+			},
+			ExpectedError: errUplinkChannelNotFound,
+		},
+		{
+			Name: "First enabled channel",
+			Channels: []*ttnpb.MACParameters_Channel{
+				{UplinkFrequency: 868100000},
+				{EnableUplink: true},
+				{UplinkFrequency: 868500000, MaxDataRateIndex: ttnpb.DataRateIndex_DATA_RATE_5, EnableUplink: true},
+				{UplinkFrequency: 867100000, EnableUplink: true},
+			},
+			ExpectedIndex: 2,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			dev := &ttnpb.EndDevice{}
+			if tc.Channels != nil {
+				dev.MacState = &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{Channels: tc.Channels},
+				}
+			}
+			idx, ch, err := simulatedUplinkChannel(dev)
+			if tc.ExpectedError != nil {
+				a.So(err, should.HaveSameErrorDefinitionAs, tc.ExpectedError)
+				a.So(ch, should.BeNil)
+				return
+			}
+			if a.So(err, should.BeNil) {
+				a.So(idx, should.Equal, tc.ExpectedIndex)
+				a.So(ch, should.Resemble, tc.Channels[tc.ExpectedIndex])
+			}
+		})
+	}
+}
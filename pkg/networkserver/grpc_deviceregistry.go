@@ -2809,6 +2809,7 @@ func (ns *NetworkServer) ResetFactoryDefaults(ctx context.Context, req *ttnpb.Re
 		return nil, err
 	}
 
+	var endedSession *SessionHistoryEntry
 	dev, _, err := ns.devices.SetByID(ctx, req.EndDeviceIds.ApplicationIds, req.EndDeviceIds.DeviceId, addDeviceGetPaths(ttnpb.AddFields(append(req.FieldMask.GetPaths()[:0:0], req.FieldMask.GetPaths()...),
 		"frequency_plan_id",
 		"lorawan_phy_version",
@@ -2817,7 +2818,11 @@ func (ns *NetworkServer) ResetFactoryDefaults(ctx context.Context, req *ttnpb.Re
 		"multicast",
 		"session.dev_addr",
 		"session.keys",
+		"session.last_a_f_cnt_down",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
 		"session.queued_application_downlinks",
+		"session.started_at",
 		"supports_class_b",
 		"supports_class_c",
 		"supports_join",
@@ -2833,6 +2838,9 @@ func (ns *NetworkServer) ResetFactoryDefaults(ctx context.Context, req *ttnpb.Re
 		stored.PendingMacState = nil
 		stored.PendingSession = nil
 		stored.PowerState = ttnpb.PowerState_POWER_UNKNOWN
+		if stored.Session != nil {
+			endedSession = newSessionHistoryEntry(stored.Session, time.Now(), SessionEndReasonReset)
+		}
 		if stored.SupportsJoin {
 			stored.Session = nil
 		} else {
@@ -2871,6 +2879,7 @@ func (ns *NetworkServer) ResetFactoryDefaults(ctx context.Context, req *ttnpb.Re
 		logRegistryRPCError(ctx, err, "Failed to reset device state in registry")
 		return nil, err
 	}
+	ns.recordEndedSession(ctx, req.EndDeviceIds, endedSession)
 	if err := unwrapSelectedSessionKeys(ctx, ns.KeyService(), dev, req.FieldMask.GetPaths()...); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to unwrap selected keys")
 		return nil, err
@@ -2895,6 +2904,11 @@ func (ns *NetworkServer) Delete(ctx context.Context, req *ttnpb.EndDeviceIdentif
 		logRegistryRPCError(ctx, err, "Failed to delete device from registry")
 		return nil, err
 	}
+	ns.clearDeviceStatus(ctx, req)
+	ns.clearSessionHistory(ctx, req)
+	ns.clearPingSlotAttempts(ctx, req)
+	ns.clearDownlinkFailures(ctx, req)
+	ns.clearConfirmedDownlinkOutcomes(ctx, req)
 	if evt != nil {
 		events.Publish(evt)
 	}
@@ -2926,6 +2940,9 @@ func (srv *nsEndDeviceBatchRegistry) Delete(
 		return nil, err
 	}
 
+	for _, ids := range deleted {
+		srv.NS.clearDeviceStatus(ctx, ids)
+	}
 	if len(deleted) != 0 {
 		events.Publish(
 			evtBatchDeleteEndDevices.NewWithIdentifiersAndData(
@@ -22,13 +22,14 @@ import (
 
 	clusterauth "go.thethings.network/lorawan-stack/v3/pkg/auth/cluster"
 	"go.thethings.network/lorawan-stack/v3/pkg/band"
-	"go.thethings.network/lorawan-stack/v3/pkg/crypto"
 	"go.thethings.network/lorawan-stack/v3/pkg/crypto/cryptoutil"
 	"go.thethings.network/lorawan-stack/v3/pkg/encoding/lorawan"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/macvector"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
 	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
@@ -95,17 +96,23 @@ func maxRetransmissionDelay(rxDelay ttnpb.RxDelay) time.Duration {
 	return rxDelay.Duration() + time.Second + retransmissionWindow
 }
 
-func matchCmacF(ctx context.Context, fNwkSIntKey types.AES128Key, macVersion ttnpb.MACVersion, fCnt uint32, up *ttnpb.UplinkMessage) ([4]byte, bool) {
+func (ns *NetworkServer) matchCmacF(ctx context.Context, fNwkSIntKey *ttnpb.KeyEnvelope, macVersion ttnpb.MACVersion, fCnt uint32, up *ttnpb.UplinkMessage) ([4]byte, bool) {
 	trace.Log(ctx, "ns", "compute mic")
 	registerMICComputation(ctx)
-	cmacF, err := crypto.ComputeLegacyUplinkMIC(
+	// The cmacF is the legacy uplink MIC, computed with the FNwkSIntKey.
+	cmacF, err := ns.computeDataMIC(
+		ctx,
 		fNwkSIntKey,
+		false,
+		0,
+		0,
+		0,
 		types.MustDevAddr(up.Payload.GetMacPayload().FHdr.DevAddr).OrZero(),
 		fCnt,
 		up.RawPayload[:len(up.RawPayload)-4],
 	)
 	if err != nil {
-		log.FromContext(ctx).WithError(err).Error("Failed to compute cmacF")
+		log.FromContext(ctx).WithError(err).WithField("kek_label", fNwkSIntKey.GetKekLabel()).Warn("Failed to compute cmacF")
 		return [4]byte{}, false
 	}
 	var micMatch bool
@@ -114,16 +121,13 @@ func matchCmacF(ctx context.Context, fNwkSIntKey types.AES128Key, macVersion ttn
 	} else {
 		micMatch = bytes.Equal(up.Payload.Mic[2:], cmacF[:2])
 	}
-	if !micMatch {
-		return [4]byte{}, false
-	}
-	return cmacF, true
+	return cmacF, micMatch
 }
 
 type cmacFMatchingResult struct {
 	LastFCnt       uint32
 	IsPending      bool
-	FNwkSIntKey    types.AES128Key
+	FNwkSIntKey    *ttnpb.KeyEnvelope
 	LoRaWANVersion ttnpb.MACVersion
 	FullFCnt       uint32
 	CmacF          [4]byte
@@ -165,9 +169,13 @@ type matchResult struct {
 	DataRateIndex            ttnpb.DataRateIndex
 	DeferredMACHandlers      []macHandler
 	IsRetransmission         bool
+	MACVector                *macvector.Vector
 	QueuedApplicationUplinks []*ttnpb.ApplicationUp
 	QueuedEventBuilders      events.Builders
 	SetPaths                 []string
+	EndedSession             *SessionHistoryEntry
+	ConfirmedDownlinkAck     bool
+	ConfirmedDownlinkNack    bool
 }
 
 func applyCFList(cfList *ttnpb.CFList, phy *band.Band, chs ...*ttnpb.MACParameters_Channel) ([]*ttnpb.MACParameters_Channel, bool) {
@@ -244,6 +252,8 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 	pld := up.Payload.GetMacPayload()
 	devAddr := types.MustDevAddr(pld.FHdr.DevAddr).OrZero()
 	pendingAppDown := dev.MacState.GetPendingApplicationDownlink()
+	prevSession := dev.Session
+	var endedSession *SessionHistoryEntry
 
 	// NOTE: Device might have changed session since the CMACF match.
 	// E.g. We could have matched pending session by CMACF and device might
@@ -264,12 +274,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 		dev.PendingMacState != nil &&
 		devAddr.Equal(types.MustDevAddr(dev.PendingSession.DevAddr).OrZero()) &&
 		macspec.UseLegacyMIC(cmacFMatchResult.LoRaWANVersion) == macspec.UseLegacyMIC(dev.PendingMacState.LorawanVersion) {
-		fNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.PendingSession.Keys.FNwkSIntKey, ns.KeyService())
-		if err != nil {
-			log.FromContext(ctx).WithError(err).WithField("kek_label", dev.PendingSession.Keys.FNwkSIntKey.KekLabel).Warn("Failed to unwrap FNwkSIntKey")
-			return nil, false, nil
-		}
-		if cmacFMatchResult.FNwkSIntKey.Equal(fNwkSIntKey) {
+		if proto.Equal(cmacFMatchResult.FNwkSIntKey, dev.PendingSession.Keys.FNwkSIntKey) {
 			ctx = log.NewContextWithField(ctx, "mac_version", dev.PendingMacState.LorawanVersion)
 			if dev.PendingMacState.PendingJoinRequest == nil {
 				log.FromContext(ctx).Warn("Pending join-request missing")
@@ -305,12 +310,7 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 		macspec.UseLegacyMIC(cmacFMatchResult.LoRaWANVersion) == macspec.UseLegacyMIC(dev.MacState.LorawanVersion) &&
 		(cmacFMatchResult.FullFCnt == FullFCnt(uint16(pld.FHdr.FCnt), dev.Session.LastFCntUp, mac.DeviceSupports32BitFCnt(dev, ns.defaultMACSettings)) ||
 			cmacFMatchResult.FullFCnt == pld.FHdr.FCnt) {
-		fNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.FNwkSIntKey, ns.KeyService())
-		if err != nil {
-			log.FromContext(ctx).WithError(err).WithField("kek_label", dev.Session.Keys.FNwkSIntKey.KekLabel).Warn("Failed to unwrap FNwkSIntKey")
-			return nil, false, nil
-		}
-		if cmacFMatchResult.FNwkSIntKey.Equal(fNwkSIntKey) {
+		if proto.Equal(cmacFMatchResult.FNwkSIntKey, dev.Session.Keys.FNwkSIntKey) {
 			ctx = log.NewContextWithFields(ctx, log.Fields(
 				"last_f_cnt_up", dev.Session.LastFCntUp,
 				"mac_version", dev.MacState.LorawanVersion,
@@ -330,6 +330,9 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 				}
 
 				dev.MacState = macState
+				endedSession = newSessionHistoryEntry(
+					dev.Session, ttnpb.StdTimeOrZero(up.ReceivedAt), SessionEndReasonFCntReset,
+				)
 				dev.Session.StartedAt = up.ReceivedAt
 
 				trace.Log(ctx, "ns", "current session match with reset")
@@ -436,15 +439,11 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 			log.FromContext(ctx).Warn("Device missing NwkSEncKey in registry")
 			return nil, false, nil
 		}
-		key, err := cryptoutil.UnwrapAES128Key(ctx, session.Keys.NwkSEncKey, ns.KeyService())
-		if err != nil {
-			log.FromContext(ctx).WithField("kek_label", session.Keys.NwkSEncKey.KekLabel).WithError(err).Warn("Failed to unwrap NwkSEncKey")
-			return nil, false, nil
-		}
 		encOpts := macspec.EncryptionOptions(dev.MacState.LorawanVersion, macspec.UplinkFrame, pld.FPort, cmdsInFOpts)
-		cmdBuf, err = crypto.DecryptUplink(key, devAddr, cmacFMatchResult.FullFCnt, cmdBuf, encOpts...)
+		var err error
+		cmdBuf, err = ns.encryptData(ctx, session.Keys.NwkSEncKey, false, devAddr, cmacFMatchResult.FullFCnt, cmdBuf, encOpts...)
 		if err != nil {
-			log.FromContext(ctx).WithError(err).Warn("Failed to decrypt uplink")
+			log.FromContext(ctx).WithField("kek_label", session.Keys.NwkSEncKey.KekLabel).WithError(err).Warn("Failed to decrypt uplink")
 			return nil, false, nil
 		}
 	}
@@ -514,11 +513,149 @@ func (ns *NetworkServer) matchAndHandleDataUplink(ctx context.Context, dev *ttnp
 		}
 	}
 
-	var deferredMACHandlers []macHandler
+	var macVector *macvector.Vector
+	if len(cmds) > 0 && ns.shouldRecordMACVector(dev.Ids) {
+		macVector = macvector.New(dev, devAddr, cmds, cmacFMatchResult.FullFCnt, deduplicated, ns.defaultMACSettings)
+	}
+	macEventBuilders, deferredMACHandlers, setPaths := handleUplinkMACCommands(
+		ctx, dev, up, cmds, fps, ns.defaultMACSettings, devAddr, cmacFMatchResult.FullFCnt, deduplicated,
+	)
+	queuedEventBuilders = append(queuedEventBuilders, macEventBuilders...)
+
+	if matchType == pendingMatch {
+		if !macspec.UseRekeyInd(dev.MacState.LorawanVersion) {
+			dev.Ids.DevAddr = devAddr.Bytes()
+			dev.Session = dev.PendingSession
+		} else if dev.PendingSession != nil || dev.PendingMacState != nil || dev.MacState.PendingJoinRequest != nil {
+			logger.Debug("No RekeyInd received for LoRaWAN 1.1+ device")
+			return nil, false, nil
+		}
+		setPaths = ttnpb.AddFields(setPaths, "ids.dev_addr")
+	} else if dev.PendingSession != nil || dev.PendingMacState != nil {
+		// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
+	}
+	dev.MacState.PendingJoinRequest = nil
+	dev.PendingMacState = nil
+	dev.PendingSession = nil
+
+	chIdx, err := searchUplinkChannel(up.Settings.Frequency, dev.MacState)
+	if err != nil {
+		logger.WithError(err).Debug("Failed to determine channel index of uplink")
+		return nil, false, nil
+	}
+	logger = logger.WithField("device_channel_index", chIdx)
+	ctx = log.NewContext(ctx, logger)
+
+	// NOTE: Legacy MIC check is already performed.
+	if !macspec.UseLegacyMIC(dev.MacState.LorawanVersion) {
+		var confFCnt uint32
+		if pld.FHdr.FCtrl.Ack {
+			confFCnt = dev.Session.LastConfFCntDown
+		}
+		trace.Log(ctx, "ns", "compute mic")
+		registerMICComputation(ctx)
+		cmacS, err := ns.computeDataMIC(
+			ctx,
+			dev.Session.Keys.SNwkSIntKey,
+			false,
+			confFCnt,
+			uint8(drIdx),
+			chIdx,
+			devAddr,
+			cmacFMatchResult.FullFCnt,
+			up.RawPayload[:len(up.RawPayload)-4],
+		)
+		if err != nil {
+			logger.WithField("kek_label", dev.Session.Keys.SNwkSIntKey.GetKekLabel()).WithError(err).Warn("Failed to compute 1.1 MIC")
+			return nil, false, nil
+		}
+		// The MIC is composed of the first 2 bytes of the cmacS and the cmacF. See crypto.ComputeUplinkMICFromLegacy.
+		var fullMIC [4]byte
+		copy(fullMIC[:2], cmacS[:2])
+		copy(fullMIC[2:], cmacFMatchResult.CmacF[:2])
+		if !bytes.Equal(up.Payload.Mic, fullMIC[:]) {
+			trace.Log(ctx, "ns", "no mic match")
+			logger.Debug("Full MIC mismatch")
+			return nil, false, nil
+		}
+	}
+	dev.MacState.RxWindowsAvailable = true
+	dev.Session.LastFCntUp = cmacFMatchResult.FullFCnt
+
+	var queuedApplicationUplinks []*ttnpb.ApplicationUp
+	var confirmedDownlinkAck, confirmedDownlinkNack bool
+	if pendingAppDown != nil {
+		confirmedDownlinkAck, confirmedDownlinkNack = pld.FHdr.FCtrl.Ack, !pld.FHdr.FCtrl.Ack
+		if pld.FHdr.FCtrl.Ack {
+			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
+				{
+					EndDeviceIds: dev.Ids,
+					Up: &ttnpb.ApplicationUp_DownlinkAck{
+						DownlinkAck: pendingAppDown,
+					},
+					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+				},
+			}
+		} else {
+			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
+				{
+					EndDeviceIds: dev.Ids,
+					Up: &ttnpb.ApplicationUp_DownlinkNack{
+						DownlinkNack: pendingAppDown,
+					},
+					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
+				},
+			}
+		}
+		if dev.MacState != nil {
+			dev.MacState.PendingApplicationDownlink = nil
+		}
+	}
+	if matchType == pendingMatch && prevSession != nil && dev.Session != prevSession {
+		endedSession = newSessionHistoryEntry(prevSession, ttnpb.StdTimeOrZero(up.ReceivedAt), SessionEndReasonJoin)
+	}
+	return &matchResult{
+		cmacFMatchingResult:      cmacFMatchResult,
+		phy:                      phy,
+		Context:                  ctx,
+		Device:                   dev,
+		ChannelIndex:             chIdx,
+		DataRateIndex:            drIdx,
+		DeferredMACHandlers:      deferredMACHandlers,
+		IsRetransmission:         matchType == currentRetransmissionMatch,
+		MACVector:                macVector,
+		QueuedApplicationUplinks: queuedApplicationUplinks,
+		QueuedEventBuilders:      queuedEventBuilders,
+		EndedSession:             endedSession,
+		ConfirmedDownlinkAck:     confirmedDownlinkAck,
+		ConfirmedDownlinkNack:    confirmedDownlinkNack,
+		SetPaths: ttnpb.AddFields(setPaths,
+			"mac_state",
+			"pending_mac_state",
+			"pending_session",
+			"session",
+		),
+	}, true, nil
+}
+
+// handleUplinkMACCommands handles the MAC commands of a data uplink of the end device.
+// If the uplink is not deduplicated yet, the handlers of the MAC commands that depend on the metadata of all received
+// uplinks are returned, to be called after deduplication.
+func handleUplinkMACCommands(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	up *ttnpb.UplinkMessage,
+	cmds []*ttnpb.MACCommand,
+	fps *frequencyplans.Store,
+	defaults *ttnpb.MACSettings,
+	devAddr types.DevAddr,
+	fullFCnt uint32,
+	deduplicated bool,
+) (queued events.Builders, deferredMACHandlers []macHandler, setPaths []string) {
+	logger := log.FromContext(ctx)
 	if len(cmds) > 0 && !deduplicated {
 		deferredMACHandlers = make([]macHandler, 0, 2)
 	}
-	var setPaths []string
 	recentMACCommandIdentifiers := make([]ttnpb.MACCommandIdentifier, 0, 1)
 	dev.MacState.QueuedResponses = dev.MacState.QueuedResponses[:0]
 macLoop:
@@ -533,7 +670,7 @@ macLoop:
 		var err error
 		switch cmd.Cid {
 		case ttnpb.MACCommandIdentifier_CID_RESET:
-			evs, err = mac.HandleResetInd(ctx, dev, cmd.GetResetInd(), fps, ns.defaultMACSettings)
+			evs, err = mac.HandleResetInd(ctx, dev, cmd.GetResetInd(), fps, defaults)
 		case ttnpb.MACCommandIdentifier_CID_LINK_CHECK:
 			if !deduplicated {
 				deferredMACHandlers = append(deferredMACHandlers, makeDeferredMACHandler(dev, mac.HandleLinkCheckReq))
@@ -559,13 +696,13 @@ macLoop:
 				break
 			}
 			cmds = cmds[dupCount:]
-			evs, err = mac.HandleLinkADRAns(ctx, dev, pld, uint(dupCount), cmacFMatchResult.FullFCnt, fps)
+			evs, err = mac.HandleLinkADRAns(ctx, dev, pld, uint(dupCount), fullFCnt, fps)
 		case ttnpb.MACCommandIdentifier_CID_DUTY_CYCLE:
 			evs, err = mac.HandleDutyCycleAns(ctx, dev)
 		case ttnpb.MACCommandIdentifier_CID_RX_PARAM_SETUP:
 			evs, err = mac.HandleRxParamSetupAns(ctx, dev, cmd.GetRxParamSetupAns())
 		case ttnpb.MACCommandIdentifier_CID_DEV_STATUS:
-			evs, err = mac.HandleDevStatusAns(ctx, dev, cmd.GetDevStatusAns(), cmacFMatchResult.FullFCnt, *ttnpb.StdTime(up.ReceivedAt))
+			evs, err = mac.HandleDevStatusAns(ctx, dev, cmd.GetDevStatusAns(), fullFCnt, *ttnpb.StdTime(up.ReceivedAt))
 			if err == nil {
 				setPaths = ttnpb.AddFields(setPaths,
 					"battery_percentage",
@@ -608,132 +745,27 @@ macLoop:
 		default:
 			_, known := lorawan.DefaultMACCommands[cmd.Cid]
 			logger.WithField("known", known).Debug("Unknown MAC command received")
-			queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnknownMACCommand.BindData(cmd))
+			queued = append(queued, mac.EvtUnknownMACCommand.BindData(cmd))
 			break macLoop
 		}
 		if err != nil {
 			logger.WithError(err).Debug("Failed to process MAC command")
-			queuedEventBuilders = append(queuedEventBuilders, mac.EvtProcessMACCommandFail.BindData(err))
+			queued = append(queued, mac.EvtProcessMACCommandFail.BindData(err))
 			break macLoop
 		}
-		queuedEventBuilders = append(queuedEventBuilders, evs...)
+		queued = append(queued, evs...)
 		recentMACCommandIdentifiers = appendRecentMACCommandIdentifier(recentMACCommandIdentifiers, cmd.Cid)
 	}
 	dev.MacState.RecentMacCommandIdentifiers = recentMACCommandIdentifiers
 	if n := len(dev.MacState.PendingRequests); n > 0 {
 		logger.WithField("unanswered_request_count", n).Debug("MAC command buffer not fully answered")
-		queuedEventBuilders = append(queuedEventBuilders, mac.EvtUnansweredMACCommand.BindData(&ttnpb.MACCommands{
+		queued = append(queued, mac.EvtUnansweredMACCommand.BindData(&ttnpb.MACCommands{
 			Commands: slices.Clone(dev.MacState.PendingRequests),
 		}))
 		dev.MacState.PendingRequests = dev.MacState.PendingRequests[:0]
 	}
 
-	if matchType == pendingMatch {
-		if !macspec.UseRekeyInd(dev.MacState.LorawanVersion) {
-			dev.Ids.DevAddr = devAddr.Bytes()
-			dev.Session = dev.PendingSession
-		} else if dev.PendingSession != nil || dev.PendingMacState != nil || dev.MacState.PendingJoinRequest != nil {
-			logger.Debug("No RekeyInd received for LoRaWAN 1.1+ device")
-			return nil, false, nil
-		}
-		setPaths = ttnpb.AddFields(setPaths, "ids.dev_addr")
-	} else if dev.PendingSession != nil || dev.PendingMacState != nil {
-		// TODO: Notify AS of session recovery(https://github.com/TheThingsNetwork/lorawan-stack/issues/594)
-	}
-	dev.MacState.PendingJoinRequest = nil
-	dev.PendingMacState = nil
-	dev.PendingSession = nil
-
-	chIdx, err := searchUplinkChannel(up.Settings.Frequency, dev.MacState)
-	if err != nil {
-		logger.WithError(err).Debug("Failed to determine channel index of uplink")
-		return nil, false, nil
-	}
-	logger = logger.WithField("device_channel_index", chIdx)
-	ctx = log.NewContext(ctx, logger)
-
-	// NOTE: Legacy MIC check is already performed.
-	if !macspec.UseLegacyMIC(dev.MacState.LorawanVersion) {
-		sNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, dev.Session.Keys.SNwkSIntKey, ns.KeyService())
-		if err != nil {
-			logger.WithField("kek_label", dev.Session.Keys.SNwkSIntKey.GetKekLabel()).WithError(err).Warn("Failed to unwrap SNwkSIntKey")
-			return nil, false, nil
-		}
-
-		var confFCnt uint32
-		if pld.FHdr.FCtrl.Ack {
-			confFCnt = dev.Session.LastConfFCntDown
-		}
-		trace.Log(ctx, "ns", "compute mic")
-		registerMICComputation(ctx)
-		fullMIC, err := crypto.ComputeUplinkMICFromLegacy(
-			cmacFMatchResult.CmacF,
-			sNwkSIntKey,
-			confFCnt,
-			uint8(drIdx),
-			chIdx,
-			devAddr,
-			cmacFMatchResult.FullFCnt,
-			up.RawPayload[:len(up.RawPayload)-4],
-		)
-		if err != nil {
-			logger.WithError(err).Error("Failed to compute 1.1 MIC")
-			return nil, false, nil
-		}
-		if !bytes.Equal(up.Payload.Mic, fullMIC[:]) {
-			trace.Log(ctx, "ns", "no mic match")
-			logger.Debug("Full MIC mismatch")
-			return nil, false, nil
-		}
-	}
-	dev.MacState.RxWindowsAvailable = true
-	dev.Session.LastFCntUp = cmacFMatchResult.FullFCnt
-
-	var queuedApplicationUplinks []*ttnpb.ApplicationUp
-	if pendingAppDown != nil {
-		if pld.FHdr.FCtrl.Ack {
-			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
-				{
-					EndDeviceIds: dev.Ids,
-					Up: &ttnpb.ApplicationUp_DownlinkAck{
-						DownlinkAck: pendingAppDown,
-					},
-					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
-				},
-			}
-		} else {
-			queuedApplicationUplinks = []*ttnpb.ApplicationUp{
-				{
-					EndDeviceIds: dev.Ids,
-					Up: &ttnpb.ApplicationUp_DownlinkNack{
-						DownlinkNack: pendingAppDown,
-					},
-					CorrelationIds: append(pendingAppDown.CorrelationIds, up.CorrelationIds...),
-				},
-			}
-		}
-		if dev.MacState != nil {
-			dev.MacState.PendingApplicationDownlink = nil
-		}
-	}
-	return &matchResult{
-		cmacFMatchingResult:      cmacFMatchResult,
-		phy:                      phy,
-		Context:                  ctx,
-		Device:                   dev,
-		ChannelIndex:             chIdx,
-		DataRateIndex:            drIdx,
-		DeferredMACHandlers:      deferredMACHandlers,
-		IsRetransmission:         matchType == currentRetransmissionMatch,
-		QueuedApplicationUplinks: queuedApplicationUplinks,
-		QueuedEventBuilders:      queuedEventBuilders,
-		SetPaths: ttnpb.AddFields(setPaths,
-			"mac_state",
-			"pending_mac_state",
-			"pending_session",
-			"session",
-		),
-	}, true, nil
+	return queued, deferredMACHandlers, setPaths
 }
 
 func toMACStateRxMetadata(mds []*ttnpb.RxMetadata) []*ttnpb.MACState_UplinkMessage_RxMetadata {
@@ -893,6 +925,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	ctx, flushMatchStats := newContextWithMatchStats(ctx)
 	defer flushMatchStats()
 
+	micFailure := ns.newMICFailure(up)
 	var matched *matchResult
 	if err := ns.devices.RangeByUplinkMatches(ctx, up,
 		func(ctx context.Context, match *UplinkMatch) (bool, error) {
@@ -904,11 +937,6 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				"pending_session", match.IsPending,
 			))
 
-			fNwkSIntKey, err := cryptoutil.UnwrapAES128Key(ctx, match.FNwkSIntKey, ns.KeyService())
-			if err != nil {
-				log.FromContext(ctx).WithError(err).WithField("kek_label", match.FNwkSIntKey.KekLabel).Warn("Failed to unwrap FNwkSIntKey")
-				return false, nil
-			}
 			fCnt := FullFCnt(uint16(pld.FHdr.FCnt), match.LastFCnt, mac.DeviceSupports32BitFCnt(&ttnpb.EndDevice{
 				MacSettings: &ttnpb.MACSettings{
 					Supports_32BitFCnt: match.Supports32BitFCnt,
@@ -916,7 +944,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}, ns.defaultMACSettings))
 
 			var cmacF [4]byte
-			cmacF, ok = matchCmacF(ctx, fNwkSIntKey, match.LoRaWANVersion, fCnt, up)
+			cmacF, ok = ns.matchCmacF(ctx, match.FNwkSIntKey, match.LoRaWANVersion, fCnt, up)
 			if !ok && fCnt != pld.FHdr.FCnt && !pld.FHdr.FCtrl.Ack && !match.IsPending && mac.DeviceResetsFCnt(&ttnpb.EndDevice{
 				MacSettings: &ttnpb.MACSettings{
 					ResetsFCnt: match.ResetsFCnt,
@@ -924,13 +952,16 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			}, ns.defaultMACSettings) {
 				// FCnt reset
 				fCnt = pld.FHdr.FCnt
-				cmacF, ok = matchCmacF(ctx, fNwkSIntKey, match.LoRaWANVersion, fCnt, up)
+				cmacF, ok = ns.matchCmacF(ctx, match.FNwkSIntKey, match.LoRaWANVersion, fCnt, up)
 			}
 			if !ok {
 				trace.Log(ctx, "ns", "no mic match")
+				micFailure.addCandidate(match, fCnt, cmacF)
 				return false, nil
 			}
 			trace.Log(ctx, "ns", "mic match")
+			// The uplink is not dropped because of a MIC mismatch if the MIC of any end device matches.
+			micFailure = nil
 
 			ctx = log.NewContextWithField(ctx, "full_f_cnt_up", fCnt)
 			dev, ctx, err := ns.devices.GetByID(ctx, match.ApplicationIdentifiers, match.DeviceID, handleDataUplinkGetPaths[:])
@@ -941,7 +972,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			matched, ok, err = ns.matchAndHandleDataUplink(ctx, dev, up, false, cmacFMatchingResult{
 				LastFCnt:       match.LastFCnt,
 				IsPending:      match.IsPending,
-				FNwkSIntKey:    fNwkSIntKey,
+				FNwkSIntKey:    match.FNwkSIntKey,
 				LoRaWANVersion: match.LoRaWANVersion,
 				FullFCnt:       fCnt,
 				CmacF:          cmacF,
@@ -956,6 +987,7 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 		return errDeviceNotFound.WithCause(err)
 	}
 	if !ok {
+		ns.recordMICFailure(ctx, micFailure)
 		return errDeviceNotFound.New()
 	}
 
@@ -999,6 +1031,11 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	var queuedApplicationUplinks []*ttnpb.ApplicationUp
 	defer func() { ns.submitApplicationUplinks(ctx, queuedApplicationUplinks...) }()
 
+	downlinkFailures := ns.downlinkFailuresAfterUplink(ctx, matched)
+	adrMarginBackoff := ns.adrMarginBackoffAfterUplink(ctx, matched)
+	var adaptedRx bool
+
+	var devStatusDevice *ttnpb.EndDevice
 	stored, _, err := ns.devices.SetByID(ctx, matched.Device.Ids.ApplicationIds, matched.Device.Ids.DeviceId, handleDataUplinkGetPaths[:],
 		func(ctx context.Context, stored *ttnpb.EndDevice) (*ttnpb.EndDevice, []string, error) {
 			defer trace.StartRegion(ctx, "update stored device").End()
@@ -1023,11 +1060,20 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 
 			queuedApplicationUplinks = append(queuedApplicationUplinks, matched.QueuedApplicationUplinks...)
 			queuedEvents = append(queuedEvents, matched.QueuedEventBuilders.New(ctx, events.WithIdentifiers(matched.Device.Ids))...)
+			if v := matched.MACVector; v != nil {
+				if err := v.Complete(up, matched.Device); err != nil {
+					log.FromContext(ctx).WithError(err).Warn("Failed to complete MAC test vector")
+					matched.MACVector = nil
+				}
+			}
 
 			stored = matched.Device
 			paths := ttnpb.AddFields(matched.SetPaths,
 				"mac_state.recent_uplinks",
 			)
+			if ttnpb.HasAnyField(matched.SetPaths, "last_dev_status_received_at") {
+				devStatusDevice = stored
+			}
 			stored.MacState.RecentUplinks = appendRecentUplink(stored.MacState.RecentUplinks, up, recentUplinkCount)
 
 			if matched.DataRateIndex < stored.MacState.CurrentParameters.AdrDataRateIndex {
@@ -1042,6 +1088,13 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 				"mac_state.current_parameters.adr_data_rate_index",
 			)
 
+			if ns.adaptiveRxEnabled() && downlinkFailures >= ns.adaptiveRx.Threshold {
+				rxPaths, evs := adaptRxParameters(ctx, stored, matched.phy, ns.adaptiveRx, downlinkFailures)
+				paths = ttnpb.AddFields(paths, rxPaths...)
+				queuedEvents = append(queuedEvents, evs.New(ctx, events.WithIdentifiers(stored.Ids))...)
+				adaptedRx = true
+			}
+
 			adaptDataRate, resetDesiredParameters, staticADRSettings := mac.DeviceShouldAdaptDataRate(stored, ns.defaultMACSettings, matched.phy)
 			if resetDesiredParameters || staticADRSettings != nil {
 				paths = ttnpb.AddFields(paths,
@@ -1063,7 +1116,9 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 			if !pld.FHdr.FCtrl.Adr || !adaptDataRate {
 				return stored, paths, nil
 			}
-			if err := mac.AdaptDataRate(ctx, stored, matched.phy, ns.defaultMACSettings); err != nil {
+			if err := mac.AdaptDataRate(
+				ctx, stored, matched.phy, ns.defaultMACSettings, mac.WithADRMarginBackoff(adrMarginBackoff),
+			); err != nil {
 				log.FromContext(ctx).WithError(err).Info("Failed to adapt data rate, avoid ADR")
 			}
 			return stored, paths, nil
@@ -1076,6 +1131,16 @@ func (ns *NetworkServer) handleDataUplink(ctx context.Context, up *ttnpb.UplinkM
 	matched.Device = stored
 	ctx = matched.Context
 
+	if devStatusDevice != nil {
+		ns.recordDeviceStatus(ctx, devStatusDevice)
+	}
+	if adaptedRx {
+		ns.clearDownlinkFailures(ctx, stored.Ids)
+	}
+	if matched.MACVector != nil {
+		ns.recordMACVector(ctx, stored.Ids, matched.MACVector)
+	}
+	ns.recordEndedSession(ctx, stored.Ids, matched.EndedSession)
 	if err := ns.updateDataDownlinkTask(ctx, stored, time.Time{}); err != nil {
 		log.FromContext(ctx).WithError(err).Error("Failed to update downlink task queue after data uplink")
 	}
@@ -1215,6 +1280,11 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		publishEvents(ctx, queuedEvents...)
 	}()
 
+	if err := ns.limitJoinRequest(ctx, joinEUI, devEUI, up); err != nil {
+		log.FromContext(ctx).WithError(err).Debug("Join-request rate limited, drop")
+		return err
+	}
+
 	if !matched.SupportsJoin {
 		log.FromContext(ctx).Warn("ABP device sent a join-request, drop")
 		queuedEvents = append(queuedEvents, evtDropJoinRequest.NewWithIdentifiersAndData(ctx, matched.Ids, errABPJoinRequest))
@@ -1248,10 +1318,10 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		"device_channel_index", chIdx,
 	)
 
-	devAddr := ns.newDevAddr(ctx)
+	devAddr := ns.allocateDevAddr(ctx, matched.Ids.ApplicationIds)
 	const maxDevAddrGenerationRetries = 5
 	for i := 0; i < maxDevAddrGenerationRetries && matched.Session != nil && devAddr.Equal(types.MustDevAddr(matched.Session.DevAddr).OrZero()); i++ {
-		devAddr = ns.newDevAddr(ctx)
+		devAddr = ns.allocateDevAddr(ctx, matched.Ids.ApplicationIds)
 	}
 	ctx = log.NewContextWithField(ctx, "dev_addr", devAddr)
 	if matched.Session != nil && devAddr.Equal(types.MustDevAddr(matched.Session.DevAddr).OrZero()) {
@@ -1310,6 +1380,10 @@ func (ns *NetworkServer) handleJoinRequest(ctx context.Context, up *ttnpb.Uplink
 		keyEnvelopes = keyEnvelopes[:1]
 	}
 	for _, keyEnvelope := range keyEnvelopes {
+		if ns.cryptoServiceHoldsKey(keyEnvelope) {
+			// The key is held by the Crypto Server, so it is stored as received from the Join Server.
+			continue
+		}
 		unwrappedKey, err := cryptoutil.UnwrapAES128Key(ctx, keyEnvelope, ns.KeyService())
 		if err != nil {
 			return err
@@ -1412,6 +1486,7 @@ func (ns *NetworkServer) HandleUplink(ctx context.Context, up *ttnpb.UplinkMessa
 
 	registerUplinkLatency(ctx, up)
 	up.ReceivedAt = timestamppb.New(time.Now()) // NOTE: This is not equivalent to timestamppb.Now().
+	ns.gatewayLatencies.Record(ctx, up, *ttnpb.StdTime(up.ReceivedAt))
 
 	up.Payload = &ttnpb.Message{}
 	if err := lorawan.UnmarshalMessage(up.RawPayload, up.Payload); err != nil {
@@ -1515,6 +1590,7 @@ func (ns *NetworkServer) ReportTxAcknowledgment(
 	default:
 		transmissionError = errTransmission.WithAttributes("result", ack.GetResult().String())
 		queuedEvents = append(queuedEvents, evtTransmissionFail.NewWithIdentifiersAndData(ctx, ids, transmissionError))
+		ns.recordDownlinkFailure(ctx, ids)
 	}
 
 	macPayload := down.GetPayload().GetMacPayload()
@@ -0,0 +1,413 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+// apiRouter returns a router for the HTTP API routes under the path prefix, which applies the namespace,
+// the rate limiting class and the authorization metadata to the routes.
+func (ns *NetworkServer) apiRouter(server *web.Server, prefix string) *mux.Router {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + prefix).Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("networkserver")),
+		ratelimit.HTTPMiddleware(ns.Component.RateLimiter(), "http:ns"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+	return router
+}
+
+// RegisterRoutes registers the web frontend routes.
+//
+// The device status history route returns the most recent device status answers of an end device,
+// so that the battery and downlink margin of the end device can be followed over time.
+//
+// The session history route returns the current and the most recently ended sessions of an end device,
+// with the reason why each session ended, so that rejoins and frame counter resets can be traced.
+//
+// The device address block routes let admins manage the device address blocks of the cluster,
+// and return the address utilization of the blocks.
+//
+// The gateway maintenance routes let gateway administrators manage the maintenance windows of a gateway,
+// and return whether the gateway is currently in maintenance.
+//
+// The MIC failures route lets admins retrieve the most recent data uplinks of a DevAddr that were dropped because
+// their MIC did not match any end device, with the MICs computed with the keys of the candidate end devices,
+// so that key mismatches can be diagnosed.
+func (ns *NetworkServer) RegisterRoutes(server *web.Server) {
+	if ns.deviceStatusHistory != nil {
+		router := ns.apiRouter(server, "/ns/applications/{application_id}/devices/{device_id}/")
+		router.HandleFunc("/status-history", ns.handleGetDeviceStatusHistory).Methods(http.MethodGet)
+	}
+	if ns.sessionHistory != nil {
+		router := ns.apiRouter(server, "/ns/applications/{application_id}/devices/{device_id}/")
+		router.HandleFunc("/session-history", ns.handleGetSessionHistory).Methods(http.MethodGet)
+	}
+	if ns.devAddrBlocks != nil {
+		router := ns.apiRouter(server, "/ns/dev-addr-blocks")
+		router.Use(requireAdmin)
+		router.HandleFunc("", ns.handleListDevAddrBlocks).Methods(http.MethodGet)
+		router.HandleFunc("/{block_id}", ns.handleSetDevAddrBlock).Methods(http.MethodPut)
+		router.HandleFunc("/{block_id}", ns.handleDeleteDevAddrBlock).Methods(http.MethodDelete)
+	}
+	if ns.gatewayMaintenance != nil {
+		router := ns.apiRouter(server, "/ns/gateways/{gateway_id}/maintenance-windows")
+		router.HandleFunc("", ns.handleGetGatewayMaintenance).Methods(http.MethodGet)
+		router.HandleFunc("", ns.handleSetGatewayMaintenance).Methods(http.MethodPut)
+		router.HandleFunc("", ns.handleDeleteGatewayMaintenance).Methods(http.MethodDelete)
+	}
+	if ns.micFailures != nil && ns.micFailuresSize > 0 {
+		router := ns.apiRouter(server, "/ns/dev-addrs/{dev_addr}/mic-failures")
+		router.Use(requireAdmin)
+		router.HandleFunc("", ns.handleGetMICFailures).Methods(http.MethodGet)
+	}
+}
+
+func requireAdmin(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := rights.RequireIsAdmin(r.Context()); err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+func (ns *NetworkServer) handleGetDeviceStatusHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: vars["application_id"]},
+		DeviceId:       vars["device_id"],
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	statuses, err := ns.deviceStatusHistory.Range(ctx, ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if statuses == nil {
+		statuses = []*DeviceStatus{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(struct {
+		Statuses []*DeviceStatus `json:"statuses"`
+	}{
+		Statuses: statuses,
+	})
+}
+
+// GetSessionHistory returns the current session of the end device, if any, and the most recently ended sessions,
+// the most recent first.
+func (ns *NetworkServer) GetSessionHistory(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) (current *SessionHistoryEntry, ended []*SessionHistoryEntry, err error) {
+	dev, _, err := ns.devices.GetByID(ctx, ids.ApplicationIds, ids.DeviceId, []string{
+		"session.dev_addr",
+		"session.keys.session_key_id",
+		"session.last_a_f_cnt_down",
+		"session.last_f_cnt_up",
+		"session.last_n_f_cnt_down",
+		"session.started_at",
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	if dev.Session != nil {
+		current = newSessionHistoryEntry(dev.Session, time.Time{}, "")
+	}
+	ended, err = ns.sessionHistory.Range(ctx, ids)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ended == nil {
+		ended = []*SessionHistoryEntry{}
+	}
+	return current, ended, nil
+}
+
+func (ns *NetworkServer) handleGetSessionHistory(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	vars := mux.Vars(r)
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: vars["application_id"]},
+		DeviceId:       vars["device_id"],
+	}
+	if err := ids.ValidateContext(ctx); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireApplication(ctx, ids.ApplicationIds, ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	current, ended, err := ns.GetSessionHistory(ctx, ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(struct {
+		Current *SessionHistoryEntry   `json:"current"`
+		Ended   []*SessionHistoryEntry `json:"ended"`
+	}{
+		Current: current,
+		Ended:   ended,
+	})
+}
+
+var errDecodeDevAddrBlock = errors.DefineInvalidArgument("decode_dev_addr_block", "decode device address block")
+
+const maxDevAddrBlockSize = 1 << 14
+
+type devAddrBlockWithStats struct {
+	*DevAddrBlock
+	Stats DevAddrBlockStats `json:"stats"`
+}
+
+func (ns *NetworkServer) handleListDevAddrBlocks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	blocks, err := ns.devAddrBlocks.registry.Range(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	allocations, err := ns.devAddrBlocks.registry.Allocations(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	res := make([]devAddrBlockWithStats, 0, len(blocks))
+	for _, b := range blocks {
+		res = append(res, devAddrBlockWithStats{
+			DevAddrBlock: b,
+			Stats:        b.Stats(allocations[b.ID]),
+		})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(struct {
+		Blocks []devAddrBlockWithStats `json:"blocks"`
+	}{
+		Blocks: res,
+	})
+}
+
+func (ns *NetworkServer) handleSetDevAddrBlock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	block := &DevAddrBlock{}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxDevAddrBlockSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(block); err != nil {
+		webhandlers.Error(w, r, errDecodeDevAddrBlock.WithCause(err))
+		return
+	}
+	block.ID = mux.Vars(r)["block_id"]
+	if err := block.Validate(); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	blocks, err := ns.devAddrBlocks.registry.Range(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	for _, other := range blocks {
+		if other.ID != block.ID && other.Overlaps(block) {
+			webhandlers.Error(w, r, errDevAddrBlockOverlap.WithAttributes("prefix", block.Prefix, "id", other.ID))
+			return
+		}
+	}
+	if err := ns.devAddrBlocks.registry.Set(ctx, block); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ns.devAddrBlocks.invalidate()
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(block)
+}
+
+func (ns *NetworkServer) handleDeleteDevAddrBlock(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	id := mux.Vars(r)["block_id"]
+	blocks, err := ns.devAddrBlocks.registry.Range(ctx)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	found := false
+	for _, b := range blocks {
+		if b.ID == id {
+			found = true
+			break
+		}
+	}
+	if !found {
+		webhandlers.Error(w, r, errDevAddrBlockNotFound.WithAttributes("id", id))
+		return
+	}
+	if err := ns.devAddrBlocks.registry.Delete(ctx, id); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ns.devAddrBlocks.invalidate()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+var errDecodeGatewayMaintenance = errors.DefineInvalidArgument(
+	"decode_gateway_maintenance", "decode gateway maintenance windows",
+)
+
+const maxGatewayMaintenanceSize = 1 << 14
+
+type gatewayMaintenanceWithState struct {
+	*GatewayMaintenance
+	Active bool `json:"active"`
+}
+
+func gatewayIDsFromRequest(r *http.Request) (*ttnpb.GatewayIdentifiers, error) {
+	ids := &ttnpb.GatewayIdentifiers{GatewayId: mux.Vars(r)["gateway_id"]}
+	if err := ids.ValidateContext(r.Context()); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+func writeGatewayMaintenance(w http.ResponseWriter, maintenance *GatewayMaintenance) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(gatewayMaintenanceWithState{
+		GatewayMaintenance: maintenance,
+		Active:             maintenance.Active(time.Now()),
+	})
+}
+
+func (ns *NetworkServer) handleGetGatewayMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ids, err := gatewayIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_INFO); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	maintenance, err := ns.gatewayMaintenance.registry.Get(ctx, ids)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	writeGatewayMaintenance(w, maintenance)
+}
+
+func (ns *NetworkServer) handleSetGatewayMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ids, err := gatewayIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	maintenance := &GatewayMaintenance{}
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGatewayMaintenanceSize))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(maintenance); err != nil {
+		webhandlers.Error(w, r, errDecodeGatewayMaintenance.WithCause(err))
+		return
+	}
+	if err := maintenance.Validate(); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := ns.gatewayMaintenance.registry.Set(ctx, ids, maintenance); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ns.gatewayMaintenance.invalidate()
+	writeGatewayMaintenance(w, maintenance)
+}
+
+func (ns *NetworkServer) handleDeleteGatewayMaintenance(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	ids, err := gatewayIDsFromRequest(r)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := rights.RequireGateway(ctx, ids, ttnpb.Right_RIGHT_GATEWAY_SETTINGS_BASIC); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if err := ns.gatewayMaintenance.registry.Delete(ctx, ids); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	ns.gatewayMaintenance.invalidate()
+	w.WriteHeader(http.StatusNoContent)
+}
+
+var errInvalidDevAddr = errors.DefineInvalidArgument("dev_addr", "invalid DevAddr `{dev_addr}`")
+
+func (ns *NetworkServer) handleGetMICFailures(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	var devAddr types.DevAddr
+	if s := mux.Vars(r)["dev_addr"]; devAddr.UnmarshalText([]byte(s)) != nil {
+		webhandlers.Error(w, r, errInvalidDevAddr.WithAttributes("dev_addr", s))
+		return
+	}
+	failures, err := ns.micFailures.Range(ctx, devAddr)
+	if err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if failures == nil {
+		failures = []*MICFailure{}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(struct {
+		MICFailures []*MICFailure `json:"mic_failures"`
+	}{
+		MICFailures: failures,
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package macvector
+
+import (
+	"bytes"
+	"fmt"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// otherDevAddr is the device address of sessions other than the current session in MAC test vectors.
+var otherDevAddr = types.DevAddr{0x05, 0x06, 0x07, 0x08}
+
+// deviceFieldPaths are the end device fields that are recorded in MAC test vectors.
+// Identifiers, keys, locations and application data are never recorded.
+var deviceFieldPaths = []string{
+	"frequency_plan_id",
+	"lorawan_phy_version",
+	"lorawan_version",
+	"mac_settings",
+	"mac_state",
+	"multicast",
+	"pending_mac_state",
+	"supports_class_b",
+	"supports_class_c",
+	"supports_join",
+}
+
+// sessionFieldPaths are the session fields that are recorded in MAC test vectors.
+var sessionFieldPaths = []string{
+	"dev_addr",
+	"last_a_f_cnt_down",
+	"last_conf_f_cnt_down",
+	"last_f_cnt_up",
+	"last_n_f_cnt_down",
+}
+
+// anonymizer replaces device addresses and gateway identifiers consistently within a vector.
+type anonymizer struct {
+	devAddr  types.DevAddr
+	gateways map[string]string
+}
+
+func (a *anonymizer) devAddrBytes(b []byte) []byte {
+	switch {
+	case len(b) == 0:
+		return b
+	case bytes.Equal(b, a.devAddr.Bytes()):
+		return DevAddr.Bytes()
+	default:
+		return otherDevAddr.Bytes()
+	}
+}
+
+func (a *anonymizer) gatewayIDs(key string) *ttnpb.GatewayIdentifiers {
+	id, ok := a.gateways[key]
+	if !ok {
+		id = fmt.Sprintf("gateway-%d", len(a.gateways)+1)
+		a.gateways[key] = id
+	}
+	return &ttnpb.GatewayIdentifiers{GatewayId: id}
+}
+
+func (a *anonymizer) session(s *ttnpb.Session) *ttnpb.Session {
+	if s == nil {
+		return nil
+	}
+	res := &ttnpb.Session{}
+	if err := res.SetFields(s, sessionFieldPaths...); err != nil {
+		panic(err)
+	}
+	res.DevAddr = a.devAddrBytes(res.DevAddr)
+	return res
+}
+
+func (a *anonymizer) macState(s *ttnpb.MACState) {
+	if s == nil {
+		return
+	}
+	// Application downlinks and join-accepts contain application data and session keys.
+	s.PendingApplicationDownlink = nil
+	s.QueuedJoinAccept = nil
+	for _, up := range s.RecentUplinks {
+		up.CorrelationIds = nil
+		if pld := up.GetPayload().GetMacPayload(); pld != nil {
+			if pld.FPort != 0 {
+				pld.FrmPayload = nil
+			}
+			if pld.FHdr != nil {
+				pld.FHdr.DevAddr = a.devAddrBytes(pld.FHdr.DevAddr)
+			}
+		}
+		for _, md := range up.RxMetadata {
+			if md.GatewayIds != nil {
+				md.GatewayIds = a.gatewayIDs(md.GatewayIds.GatewayId)
+			}
+			md.UplinkToken = nil
+		}
+	}
+	for _, down := range s.RecentDownlinks {
+		down.CorrelationIds = nil
+	}
+}
+
+func (a *anonymizer) rxMetadata(mds []*ttnpb.RxMetadata) []*ttnpb.RxMetadata {
+	res := make([]*ttnpb.RxMetadata, 0, len(mds))
+	for _, md := range mds {
+		key := md.GetGatewayIds().GetGatewayId()
+		if pb := md.PacketBroker; pb != nil {
+			key = fmt.Sprintf("%s@%s/%s", pb.ForwarderClusterId, pb.ForwarderNetId, pb.ForwarderTenantId)
+		}
+		res = append(res, &ttnpb.RxMetadata{
+			GatewayIds:             a.gatewayIDs(key),
+			AntennaIndex:           md.AntennaIndex,
+			Time:                   md.Time,
+			Timestamp:              md.Timestamp,
+			FineTimestamp:          md.FineTimestamp,
+			Rssi:                   md.Rssi,
+			SignalRssi:             md.SignalRssi,
+			ChannelRssi:            md.ChannelRssi,
+			RssiStandardDeviation:  md.RssiStandardDeviation,
+			Snr:                    md.Snr,
+			FrequencyOffset:        md.FrequencyOffset,
+			DownlinkPathConstraint: md.DownlinkPathConstraint,
+			ReceivedAt:             md.ReceivedAt,
+			GpsTime:                md.GpsTime,
+		})
+	}
+	return res
+}
+
+// anonymizeDevice returns a copy of the end device with the fields in deviceFieldPaths and the counters of the
+// sessions, where the device addresses and gateway identifiers are replaced.
+func anonymizeDevice(dev *ttnpb.EndDevice, devAddr types.DevAddr) *ttnpb.EndDevice {
+	a := &anonymizer{
+		devAddr:  devAddr,
+		gateways: make(map[string]string),
+	}
+	res := &ttnpb.EndDevice{}
+	if err := res.SetFields(dev, deviceFieldPaths...); err != nil {
+		panic(err)
+	}
+	res = ttnpb.Clone(res)
+	res.Ids = &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "mac-vectors"},
+		DeviceId:       "device",
+		DevAddr:        a.devAddrBytes(dev.GetIds().GetDevAddr()),
+	}
+	res.Session = a.session(dev.Session)
+	res.PendingSession = a.session(dev.PendingSession)
+	a.macState(res.MacState)
+	a.macState(res.PendingMacState)
+	return res
+}
+
+// anonymizeUplink returns a copy of the uplink with the settings and the anonymized metadata.
+// The payload is not recorded, since the MAC commands are recorded separately.
+func anonymizeUplink(up *ttnpb.UplinkMessage) *ttnpb.UplinkMessage {
+	a := &anonymizer{
+		gateways: make(map[string]string),
+	}
+	return &ttnpb.UplinkMessage{
+		Settings:           ttnpb.Clone(up.Settings),
+		RxMetadata:         a.rxMetadata(ttnpb.CloneSlice(up.RxMetadata)),
+		ReceivedAt:         up.ReceivedAt,
+		ConsumedAirtime:    up.ConsumedAirtime,
+		DeviceChannelIndex: up.DeviceChannelIndex,
+	}
+}
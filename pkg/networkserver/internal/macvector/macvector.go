@@ -0,0 +1,259 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package macvector implements MAC test vectors, which are anonymized recordings of the handling of the MAC commands
+// of data uplinks by the Network Server. MAC test vectors are stored as golden files and replayed in tests to detect
+// regressions in MAC command handling.
+package macvector
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/jsonpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// Extension is the file extension of MAC test vectors.
+const Extension = ".json"
+
+// DevAddr is the device address of the current session in MAC test vectors.
+var DevAddr = types.DevAddr{0x01, 0x02, 0x03, 0x04}
+
+// ResultFieldPaths are the end device fields that are compared when replaying a MAC test vector.
+var ResultFieldPaths = []string{
+	"battery_percentage",
+	"downlink_margin",
+	"last_dev_status_received_at",
+	"mac_state.current_parameters",
+	"mac_state.desired_parameters",
+	"mac_state.device_class",
+	"mac_state.last_adr_change_f_cnt_up",
+	"mac_state.last_dev_status_f_cnt_up",
+	"mac_state.lorawan_version",
+	"mac_state.pending_requests",
+	"mac_state.ping_slot_periodicity",
+	"mac_state.queued_responses",
+	"mac_state.recent_mac_command_identifiers",
+	"mac_state.rejected_adr_data_rate_indexes",
+	"mac_state.rejected_adr_tx_power_indexes",
+	"mac_state.rejected_data_rate_ranges",
+	"mac_state.rejected_frequencies",
+	"power_state",
+}
+
+var (
+	errReadVector   = errors.DefineCorruption("read_vector", "read MAC test vector `{path}`")
+	errIncomplete   = errors.DefineCorruption("incomplete", "MAC test vector `{path}` is incomplete")
+	errResultFields = errors.DefineCorruption("result_fields", "set result fields")
+)
+
+// Vector is a MAC test vector.
+type Vector struct {
+	// RecordedAt is the time at which the vector was recorded.
+	RecordedAt time.Time
+	// Deduplicated indicates whether the MAC commands were handled after deduplication of the uplink.
+	Deduplicated bool
+	// FullFCnt is the full frame counter of the uplink.
+	FullFCnt uint32
+	// DefaultMACSettings are the default MAC settings of the Network Server for the end device.
+	DefaultMACSettings *ttnpb.MACSettings
+	// Device is the anonymized end device before the MAC commands are handled.
+	Device *ttnpb.EndDevice
+	// Uplink is the anonymized uplink message after deduplication.
+	Uplink *ttnpb.UplinkMessage
+	// Commands are the MAC commands of the uplink.
+	Commands []*ttnpb.MACCommand
+	// Result contains the ResultFieldPaths of the end device after the MAC commands are handled.
+	Result *ttnpb.EndDevice
+}
+
+// New returns a new vector for the MAC commands of the uplink of the end device with the given DevAddr.
+// The end device is anonymized and copied, so that it can be modified while handling the MAC commands.
+func New(
+	dev *ttnpb.EndDevice,
+	devAddr types.DevAddr,
+	cmds []*ttnpb.MACCommand,
+	fullFCnt uint32,
+	deduplicated bool,
+	defaults *ttnpb.MACSettings,
+) *Vector {
+	cmdsCopy := make([]*ttnpb.MACCommand, 0, len(cmds))
+	for _, cmd := range cmds {
+		cmdsCopy = append(cmdsCopy, ttnpb.Clone(cmd))
+	}
+	return &Vector{
+		RecordedAt:         time.Now().UTC(),
+		Deduplicated:       deduplicated,
+		FullFCnt:           fullFCnt,
+		DefaultMACSettings: ttnpb.Clone(defaults),
+		Device:             anonymizeDevice(dev, devAddr),
+		Commands:           cmdsCopy,
+	}
+}
+
+// Complete sets the anonymized uplink and the result of the vector from the end device after handling the MAC
+// commands.
+func (v *Vector) Complete(up *ttnpb.UplinkMessage, dev *ttnpb.EndDevice) error {
+	result, err := Result(dev)
+	if err != nil {
+		return err
+	}
+	v.Uplink = anonymizeUplink(up)
+	v.Result = result
+	return nil
+}
+
+// Result returns the ResultFieldPaths of the end device.
+func Result(dev *ttnpb.EndDevice) (*ttnpb.EndDevice, error) {
+	result := &ttnpb.EndDevice{}
+	if err := result.SetFields(dev, ResultFieldPaths...); err != nil {
+		return nil, errResultFields.WithCause(err)
+	}
+	return result, nil
+}
+
+type vectorJSON struct {
+	RecordedAt         time.Time       `json:"recorded_at"`
+	Deduplicated       bool            `json:"deduplicated"`
+	FullFCnt           uint32          `json:"full_f_cnt"`
+	DefaultMACSettings json.RawMessage `json:"default_mac_settings,omitempty"`
+	Device             json.RawMessage `json:"device"`
+	Uplink             json.RawMessage `json:"uplink"`
+	Commands           json.RawMessage `json:"commands"`
+	Result             json.RawMessage `json:"result"`
+}
+
+// MarshalJSON implements json.Marshaler.
+func (v Vector) MarshalJSON() ([]byte, error) {
+	m := jsonpb.TTN()
+	vj := vectorJSON{
+		RecordedAt:   v.RecordedAt,
+		Deduplicated: v.Deduplicated,
+		FullFCnt:     v.FullFCnt,
+	}
+	var err error
+	if v.DefaultMACSettings != nil {
+		if vj.DefaultMACSettings, err = m.Marshal(v.DefaultMACSettings); err != nil {
+			return nil, err
+		}
+	}
+	if vj.Device, err = m.Marshal(v.Device); err != nil {
+		return nil, err
+	}
+	if vj.Uplink, err = m.Marshal(v.Uplink); err != nil {
+		return nil, err
+	}
+	if vj.Commands, err = m.Marshal(&ttnpb.MACCommands{Commands: v.Commands}); err != nil {
+		return nil, err
+	}
+	if vj.Result, err = m.Marshal(v.Result); err != nil {
+		return nil, err
+	}
+	return json.Marshal(vj)
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (v *Vector) UnmarshalJSON(data []byte) error {
+	var vj vectorJSON
+	if err := json.Unmarshal(data, &vj); err != nil {
+		return err
+	}
+	m := jsonpb.TTN()
+	*v = Vector{
+		RecordedAt:   vj.RecordedAt,
+		Deduplicated: vj.Deduplicated,
+		FullFCnt:     vj.FullFCnt,
+		Device:       &ttnpb.EndDevice{},
+		Uplink:       &ttnpb.UplinkMessage{},
+		Result:       &ttnpb.EndDevice{},
+	}
+	if len(vj.DefaultMACSettings) > 0 {
+		v.DefaultMACSettings = &ttnpb.MACSettings{}
+		if err := m.Unmarshal(vj.DefaultMACSettings, v.DefaultMACSettings); err != nil {
+			return err
+		}
+	}
+	for _, f := range []struct {
+		data []byte
+		v    any
+	}{
+		{vj.Device, v.Device},
+		{vj.Uplink, v.Uplink},
+		{vj.Result, v.Result},
+	} {
+		if len(f.data) == 0 {
+			continue
+		}
+		if err := m.Unmarshal(f.data, f.v); err != nil {
+			return err
+		}
+	}
+	if len(vj.Commands) > 0 {
+		cmds := &ttnpb.MACCommands{}
+		if err := m.Unmarshal(vj.Commands, cmds); err != nil {
+			return err
+		}
+		v.Commands = cmds.Commands
+	}
+	return nil
+}
+
+// ReadFile reads the vector from the file at the given path.
+func ReadFile(path string) (*Vector, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errReadVector.WithAttributes("path", path).WithCause(err)
+	}
+	v := &Vector{}
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, errReadVector.WithAttributes("path", path).WithCause(err)
+	}
+	if v.Device.GetMacState() == nil || len(v.Commands) == 0 {
+		return nil, errIncomplete.WithAttributes("path", path)
+	}
+	return v, nil
+}
+
+// WriteFile writes the vector to the file at the given path.
+func WriteFile(path string, v *Vector) error {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Glob returns the paths of the vectors in the directory and its subdirectories, in lexical order.
+func Glob(dir string) ([]string, error) {
+	var paths []string
+	if err := filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() && filepath.Ext(path) == Extension {
+			paths = append(paths, path)
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	sort.Strings(paths)
+	return paths, nil
+}
@@ -0,0 +1,147 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package macvector_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	. "go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/macvector"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestRecordVector(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+		DevEui:         types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}.Bytes(),
+		DevAddr:        devAddr.Bytes(),
+	}
+	dev := &ttnpb.EndDevice{
+		Ids:             ids,
+		Name:            "Test Device",
+		FrequencyPlanId: "EU_863_870",
+		LorawanVersion:  ttnpb.MACVersion_MAC_V1_1,
+		Session: &ttnpb.Session{
+			DevAddr:    devAddr.Bytes(),
+			LastFCntUp: 41,
+			Keys: &ttnpb.SessionKeys{
+				FNwkSIntKey: &ttnpb.KeyEnvelope{Key: types.AES128Key{0x42}.Bytes()},
+			},
+		},
+		PendingSession: &ttnpb.Session{
+			DevAddr: types.DevAddr{0x26, 0x01, 0x43, 0x43}.Bytes(),
+		},
+		MacState: &ttnpb.MACState{
+			LorawanVersion: ttnpb.MACVersion_MAC_V1_1,
+			PendingApplicationDownlink: &ttnpb.ApplicationDownlink{
+				FrmPayload: []byte{0x1, 0x2},
+			},
+			RecentUplinks: []*ttnpb.MACState_UplinkMessage{{
+				Payload: &ttnpb.Message{Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+					FHdr:       &ttnpb.FHDR{DevAddr: devAddr.Bytes()},
+					FPort:      1,
+					FrmPayload: []byte{0x1, 0x2},
+				}}},
+				RxMetadata: []*ttnpb.MACState_UplinkMessage_RxMetadata{{
+					GatewayIds:  &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"},
+					UplinkToken: []byte{0x1},
+				}},
+			}},
+		},
+	}
+	cmds := []*ttnpb.MACCommand{ttnpb.MACCommandIdentifier_CID_LINK_CHECK.MACCommand()}
+
+	v := New(dev, devAddr, cmds, 42, true, &ttnpb.MACSettings{})
+	a.So(v.Device.Ids, should.Resemble, &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "mac-vectors"},
+		DeviceId:       "device",
+		DevAddr:        DevAddr.Bytes(),
+	})
+	a.So(v.Device.Name, should.BeEmpty)
+	a.So(v.Device.Session, should.Resemble, &ttnpb.Session{
+		DevAddr:    DevAddr.Bytes(),
+		LastFCntUp: 41,
+	})
+	a.So(v.Device.PendingSession.DevAddr, should.NotResemble, DevAddr.Bytes())
+	a.So(v.Device.MacState.PendingApplicationDownlink, should.BeNil)
+	recentUp := v.Device.MacState.RecentUplinks[0]
+	a.So(recentUp.Payload.GetMacPayload().FrmPayload, should.BeNil)
+	a.So(recentUp.Payload.GetMacPayload().FHdr.DevAddr, should.Resemble, DevAddr.Bytes())
+	a.So(recentUp.RxMetadata[0].GatewayIds.GatewayId, should.Equal, "gateway-1")
+	a.So(recentUp.RxMetadata[0].UplinkToken, should.BeNil)
+
+	// The recorded end device is a copy.
+	a.So(dev.Ids.DeviceId, should.Equal, "test-dev")
+	a.So(dev.MacState.PendingApplicationDownlink, should.NotBeNil)
+
+	dev.MacState.QueuedResponses = []*ttnpb.MACCommand{(&ttnpb.MACCommand_LinkCheckAns{
+		Margin:       10,
+		GatewayCount: 1,
+	}).MACCommand()}
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x1, 0x2},
+		RxMetadata: []*ttnpb.RxMetadata{{
+			GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "test-gtw"},
+			Location:   &ttnpb.Location{Latitude: 52},
+			Snr:        10,
+		}},
+		ReceivedAt: timestamppb.New(time.Unix(1, 0)),
+	}
+	if !a.So(v.Complete(up, dev), should.BeNil) {
+		t.FailNow()
+	}
+	a.So(v.Uplink.RawPayload, should.BeNil)
+	a.So(v.Uplink.RxMetadata, should.Resemble, []*ttnpb.RxMetadata{{
+		GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gateway-1"},
+		Snr:        10,
+	}})
+	a.So(v.Result.MacState.QueuedResponses, should.Resemble, dev.MacState.QueuedResponses)
+	a.So(v.Result.Ids, should.BeNil)
+
+	r := NewRecorder(t.TempDir())
+	path, err := r.Record(ids, v)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(filepath.Dir(path), should.Equal, r.DeviceDirectory(ids))
+
+	paths, err := Glob(filepath.Dir(r.DeviceDirectory(ids)))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(paths, should.Resemble, []string{path})
+
+	read, err := ReadFile(path)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(read.RecordedAt.Equal(v.RecordedAt), should.BeTrue)
+	a.So(read.FullFCnt, should.Equal, 42)
+	a.So(read.Deduplicated, should.BeTrue)
+	a.So(read.Device, should.Resemble, v.Device)
+	a.So(read.Uplink, should.Resemble, v.Uplink)
+	a.So(read.Commands, should.Resemble, v.Commands)
+	a.So(read.Result, should.Resemble, v.Result)
+}
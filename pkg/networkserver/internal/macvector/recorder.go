@@ -0,0 +1,56 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package macvector
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// Recorder records MAC test vectors in a directory.
+// The vectors of an end device are stored in a subdirectory that is named after a hash of the end device identifiers,
+// so that the vectors of an end device can be replayed in order without revealing its identifiers.
+type Recorder struct {
+	dir string
+}
+
+// NewRecorder returns a new Recorder that records MAC test vectors in the given directory.
+func NewRecorder(dir string) *Recorder {
+	return &Recorder{dir: dir}
+}
+
+// DeviceDirectory returns the directory of the vectors of the end device.
+func (r *Recorder) DeviceDirectory(ids *ttnpb.EndDeviceIdentifiers) string {
+	sum := sha256.Sum256([]byte(ids.GetApplicationIds().GetApplicationId() + "." + ids.GetDeviceId()))
+	return filepath.Join(r.dir, hex.EncodeToString(sum[:8]))
+}
+
+// Record writes the vector of the end device.
+func (r *Recorder) Record(ids *ttnpb.EndDeviceIdentifiers, v *Vector) (string, error) {
+	dir := r.DeviceDirectory(ids)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, fmt.Sprintf("%020d-%010d%s", v.RecordedAt.UnixNano(), v.FullFCnt, Extension))
+	if err := WriteFile(path, v); err != nil {
+		return "", err
+	}
+	return path, nil
+}
@@ -0,0 +1,62 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+const (
+	joinRequestLimitDevice  = "end_device"
+	joinRequestLimitGateway = "gateway"
+)
+
+// limitJoinRequest applies the rate limits of join-requests per end device and per gateway.
+// The join-request is dropped if the join-requests of the end device exceed their rate limit, or if all gateways that
+// received the join-request exceed their rate limit of forwarded join-requests. A dropped join-request is not
+// forwarded to the Join Server and not answered, so that the end device backs off according to its join-request
+// retransmission schedule.
+func (ns *NetworkServer) limitJoinRequest(
+	ctx context.Context, joinEUI, devEUI types.EUI64, up *ttnpb.UplinkMessage,
+) error {
+	limiter := ns.Component.RateLimiter()
+	if err := ratelimit.Require(limiter, ratelimit.NetworkServerJoinRequestResource(joinEUI, devEUI)); err != nil {
+		registerRateLimitJoinRequest(ctx, joinRequestLimitDevice)
+		return errJoinRequestRateLimited.WithAttributes("resource", joinRequestLimitDevice).WithCause(err)
+	}
+	var gateways, limited int
+	for _, md := range up.RxMetadata {
+		ids := md.GetGatewayIds()
+		if ids == nil {
+			continue
+		}
+		gateways++
+		if err := ratelimit.Require(limiter, ratelimit.NetworkServerGatewayJoinRequestResource(ctx, ids)); err != nil {
+			log.FromContext(ctx).WithField("gateway_uid", unique.ID(ctx, ids)).Debug("Gateway exceeds join-request rate limit")
+			limited++
+		}
+	}
+	if gateways > 0 && limited == gateways {
+		registerRateLimitJoinRequest(ctx, joinRequestLimitGateway)
+		return errJoinRequestRateLimited.WithAttributes("resource", joinRequestLimitGateway)
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestLimitJoinRequest(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	ns := &NetworkServer{
+		Component: componenttest.NewComponent(t, &component.Config{
+			ServiceBase: config.ServiceBase{
+				RateLimiting: config.RateLimiting{
+					Profiles: []config.RateLimitingProfile{
+						{
+							Name:         "devices",
+							MaxPerMin:    2,
+							Associations: []string{"ns:up:join:dev"},
+						},
+						{
+							Name:         "gateways",
+							MaxPerMin:    3,
+							Associations: []string{"ns:up:join:gtw"},
+						},
+					},
+				},
+			},
+		}),
+	}
+
+	joinEUI := types.EUI64{0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42, 0x42}
+	newUplink := func(gtwIDs ...string) *ttnpb.UplinkMessage {
+		up := &ttnpb.UplinkMessage{}
+		for _, id := range gtwIDs {
+			up.RxMetadata = append(up.RxMetadata, &ttnpb.RxMetadata{
+				GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: id},
+			})
+		}
+		return up
+	}
+
+	// The join-requests of an end device are limited.
+	dev1 := types.EUI64{0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01, 0x01}
+	a.So(ns.limitJoinRequest(ctx, joinEUI, dev1, newUplink("gtw-1")), should.BeNil)
+	a.So(ns.limitJoinRequest(ctx, joinEUI, dev1, newUplink("gtw-2")), should.BeNil)
+	err := ns.limitJoinRequest(ctx, joinEUI, dev1, newUplink("gtw-2"))
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	a.So(errors.Attributes(err)["resource"], should.Equal, joinRequestLimitDevice)
+
+	// The join-requests forwarded by a gateway are limited, unless another gateway received the join-request.
+	dev2 := types.EUI64{0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02, 0x02}
+	dev3 := types.EUI64{0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03, 0x03}
+	a.So(ns.limitJoinRequest(ctx, joinEUI, dev2, newUplink("gtw-1")), should.BeNil)
+	a.So(ns.limitJoinRequest(ctx, joinEUI, dev2, newUplink("gtw-1", "gtw-3")), should.BeNil)
+	a.So(ns.limitJoinRequest(ctx, joinEUI, dev3, newUplink("gtw-1", "gtw-3")), should.BeNil)
+	err = ns.limitJoinRequest(ctx, joinEUI, dev3, newUplink("gtw-1"))
+	a.So(errors.IsResourceExhausted(err), should.BeTrue)
+	a.So(errors.Attributes(err)["resource"], should.Equal, joinRequestLimitGateway)
+}
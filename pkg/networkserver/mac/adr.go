@@ -25,40 +25,50 @@ import (
 )
 
 var demodulationFloor = map[uint32]map[uint32]float32{
+	5: {
+		812_000: -2.5,
+	},
 	6: {
 		125_000: -5,
 		250_000: -2,
 		500_000: 1,
+		812_000: -5,
 	},
 	7: {
 		125_000: -7.5,
 		250_000: -4.5,
 		500_000: -1.5,
+		812_000: -7.5,
 	},
 	8: {
 		125_000: -10,
 		250_000: -7,
 		500_000: -4,
+		812_000: -10,
 	},
 	9: {
 		125_000: -12.5,
 		250_000: -9.5,
 		500_000: -6.5,
+		812_000: -12.5,
 	},
 	10: {
 		125_000: -15,
 		250_000: -12,
 		500_000: -9,
+		812_000: -15,
 	},
 	11: {
 		125_000: -17.5,
 		250_000: -14.5,
 		500_000: -11.5,
+		812_000: -17.5,
 	},
 	12: {
 		125_000: -20,
 		250_000: -17,
 		500_000: -14,
+		812_000: -20,
 	},
 }
 
@@ -490,7 +500,11 @@ func adrTxPowerRange(
 }
 
 func adrMargin(
-	ctx context.Context, dev *ttnpb.EndDevice, defaults *ttnpb.MACSettings, adrUplinks ...*ttnpb.MACState_UplinkMessage,
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	defaults *ttnpb.MACSettings,
+	backoff float32,
+	adrUplinks ...*ttnpb.MACState_UplinkMessage,
 ) (margin float32, optimal bool, ok bool, err error) {
 	maxSNR, ok := maxSNRFromMetadata(uplinkMetadata(adrUplinks...)...)
 	if !ok {
@@ -509,6 +523,9 @@ func adrMargin(
 		}
 		margin = maxSNR - df - DeviceADRMargin(dev, defaults)
 	}
+	// The backoff accounts for the downlink quality, as end devices that do not acknowledge confirmed downlinks
+	// hear the network poorly, even if the network hears the end devices well.
+	margin -= backoff
 	// We subtract an extra safety margin if we're afraid that we  don't have enough data
 	// for our decision.
 	optimal = len(adrUplinks) >= OptimalADRUplinkCount
@@ -616,7 +633,24 @@ func adrAdaptNbTrans(
 	desiredParameters.AdrNbTrans = clampNbTrans(dev, defaults, nbTrans)
 }
 
-func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
+// ADROption configures the ADR algorithm.
+type ADROption func(*adrOptions)
+
+type adrOptions struct {
+	marginBackoff float32
+}
+
+// WithADRMarginBackoff subtracts the backoff in dB from the link margin of the end device,
+// so that the ADR algorithm chooses more conservative data rates and transmission powers.
+func WithADRMarginBackoff(backoff float32) ADROption {
+	return func(opts *adrOptions) {
+		opts.marginBackoff = backoff
+	}
+}
+
+func adaptDataRate(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings, opts adrOptions,
+) error {
 	macState := dev.MacState
 	adrUplinks := adrUplinks(macState, phy)
 	if len(adrUplinks) == 0 {
@@ -630,7 +664,7 @@ func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 	if !ok {
 		return nil
 	}
-	margin, optimal, ok, err := adrMargin(ctx, dev, defaults, adrUplinks...)
+	margin, optimal, ok, err := adrMargin(ctx, dev, defaults, opts.marginBackoff, adrUplinks...)
 	if err != nil || !ok {
 		return err
 	}
@@ -651,9 +685,15 @@ func adaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, de
 }
 
 // AdaptDataRate adapts the end device desired ADR parameters based on previous transmissions and device settings.
-func AdaptDataRate(ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings) error {
+func AdaptDataRate(
+	ctx context.Context, dev *ttnpb.EndDevice, phy *band.Band, defaults *ttnpb.MACSettings, opts ...ADROption,
+) error {
 	if dev.MacState == nil {
 		return nil
 	}
-	return adaptDataRate(ctx, dev, phy, defaults)
+	var adrOpts adrOptions
+	for _, opt := range opts {
+		opt(&adrOpts)
+	}
+	return adaptDataRate(ctx, dev, phy, defaults, adrOpts)
 }
@@ -70,6 +70,47 @@ func TestAdaptDataRate(t *testing.T) {
 			},
 		},
 	})
+	ism2400Uplinks := ADRMatrixToUplinks([]ADRMatrixRow{
+		{FCnt: 10, MaxSNR: -6, GtwDiversity: 1},
+		{FCnt: 11, MaxSNR: -7, GtwDiversity: 1},
+		{FCnt: 12, MaxSNR: -8, GtwDiversity: 1},
+		{
+			FCnt: 13, MaxSNR: -7, GtwDiversity: 1,
+			TxSettings: &ttnpb.TxSettings{
+				DataRate: &ttnpb.DataRate{
+					Modulation: &ttnpb.DataRate_Lora{
+						Lora: &ttnpb.LoRaDataRate{
+							SpreadingFactor: 12,
+							Bandwidth:       812000,
+							CodingRate:      band.Cr4_8LI,
+						},
+					},
+				},
+			},
+		},
+	})
+	ism2400Channels := func() []*ttnpb.MACParameters_Channel {
+		return []*ttnpb.MACParameters_Channel{
+			{
+				UplinkFrequency:   2403000000,
+				DownlinkFrequency: 2403000000,
+				MaxDataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_7,
+				EnableUplink:      true,
+			},
+			{
+				UplinkFrequency:   2425000000,
+				DownlinkFrequency: 2425000000,
+				MaxDataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_7,
+				EnableUplink:      true,
+			},
+			{
+				UplinkFrequency:   2479000000,
+				DownlinkFrequency: 2479000000,
+				MaxDataRateIndex:  ttnpb.DataRateIndex_DATA_RATE_7,
+				EnableUplink:      true,
+			},
+		}
+	}
 	for _, tc := range []struct {
 		Name       string
 		Device     *ttnpb.EndDevice
@@ -268,6 +309,33 @@ func TestAdaptDataRate(t *testing.T) {
 				dev.MacState.DesiredParameters.AdrNbTrans = 1
 			},
 		},
+		{
+			Name: "ISM2400",
+			Device: &ttnpb.EndDevice{
+				FrequencyPlanId:   test.ISM2400FrequencyPlanID,
+				LorawanPhyVersion: ttnpb.PHYVersion_RP002_V1_0_3,
+				MacState: &ttnpb.MACState{
+					CurrentParameters: &ttnpb.MACParameters{
+						AdrNbTrans: 1,
+						Channels:   ism2400Channels(),
+					},
+					DesiredParameters: &ttnpb.MACParameters{
+						AdrNbTrans: 1,
+						Channels:   ism2400Channels(),
+					},
+					RecentUplinks: ism2400Uplinks,
+				},
+				MacSettings: &ttnpb.MACSettings{
+					AdrMargin: &wrapperspb.FloatValue{
+						Value: 2,
+					},
+				},
+			},
+			DeviceDiff: func(dev *ttnpb.EndDevice) {
+				dev.MacState.DesiredParameters.AdrDataRateIndex = ttnpb.DataRateIndex_DATA_RATE_3
+				dev.MacState.DesiredParameters.AdrTxPowerIndex = 1
+			},
+		},
 	} {
 		tc := tc
 		test.RunSubtest(t, test.SubtestConfig{
@@ -1865,6 +1933,7 @@ func TestADRMargin(t *testing.T) {
 
 		Device   *ttnpb.EndDevice
 		Defaults *ttnpb.MACSettings
+		Backoff  float32
 		Uplinks  []*ttnpb.MACState_UplinkMessage
 
 		AssertError func(error) bool
@@ -1939,13 +2008,38 @@ func TestADRMargin(t *testing.T) {
 			Optimal: true,
 			Ok:      true,
 		},
+		{
+			Name: "with backoff",
+			Device: &ttnpb.EndDevice{
+				MacSettings: &ttnpb.MACSettings{
+					Adr: &ttnpb.ADRSettings{
+						Mode: &ttnpb.ADRSettings_Dynamic{
+							Dynamic: &ttnpb.ADRSettings_DynamicMode{
+								Margin: wrapperspb.Float(15),
+							},
+						},
+					},
+				},
+			},
+			Backoff: 5,
+			Uplinks: repeatUplink(
+				newUplink(float32Ptr(7.125), 7, 125_000),
+				20,
+			),
+
+			// Best SNR of 7.125 dB, demodulation floor of -7.5 dB, margin of 15 dB, backoff of 5 dB.
+			// 7.125 - (-7.5) - 15 - 5 = -5.375.
+			Margin:  7.125 - (-7.5) - 15 - 5,
+			Optimal: true,
+			Ok:      true,
+		},
 	} {
 		tc := tc
 		t.Run(tc.Name, func(t *testing.T) {
 			t.Parallel()
 
 			a, ctx := test.New(t)
-			margin, optimal, ok, err := ADRMargin(ctx, tc.Device, tc.Defaults, tc.Uplinks...)
+			margin, optimal, ok, err := ADRMargin(ctx, tc.Device, tc.Defaults, tc.Backoff, tc.Uplinks...)
 			if assertError := tc.AssertError; assertError != nil {
 				a.So(assertError(err), should.BeTrue)
 			} else {
@@ -125,6 +125,14 @@ func beaconTimeBefore(t time.Time) time.Duration {
 	return gpstime.ToGPS(t) / BeaconPeriod * BeaconPeriod
 }
 
+// DevicePingSlotPeriod returns the period between the ping slots of the device, or 0 if the ping slot periodicity is unknown.
+func DevicePingSlotPeriod(dev *ttnpb.EndDevice) time.Duration {
+	if dev.GetMacState().GetPingSlotPeriodicity() == nil {
+		return 0
+	}
+	return time.Duration(1<<(5+dev.MacState.PingSlotPeriodicity.Value)) * pingSlotLen
+}
+
 // NextPingSlotAt returns the exact time instant before or at earliestAt when next ping slot can be open
 // given the data known by Network Server and true, if such time instant exists, otherwise it returns time.Time{} and false.
 func NextPingSlotAt(ctx context.Context, dev *ttnpb.EndDevice, earliestAt time.Time) (time.Time, bool) {
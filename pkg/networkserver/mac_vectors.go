@@ -0,0 +1,46 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/macvector"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// shouldRecordMACVector returns whether the MAC commands of the end device should be recorded as MAC test vectors.
+func (ns *NetworkServer) shouldRecordMACVector(ids *ttnpb.EndDeviceIdentifiers) bool {
+	if ns.macVectors == nil {
+		return false
+	}
+	if len(ns.macVectorApplications) == 0 {
+		return true
+	}
+	_, ok := ns.macVectorApplications[ids.GetApplicationIds().GetApplicationId()]
+	return ok
+}
+
+// recordMACVector records the MAC test vector of the end device.
+// Failures are logged, since the recording must not affect uplink handling.
+func (ns *NetworkServer) recordMACVector(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, v *macvector.Vector) {
+	path, err := ns.macVectors.Record(ids, v)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record MAC test vector")
+		return
+	}
+	log.FromContext(ctx).WithField("path", path).Debug("Recorded MAC test vector")
+}
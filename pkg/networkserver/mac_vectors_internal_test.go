@@ -0,0 +1,91 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"flag"
+	"path/filepath"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/frequencyplans"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/macvector"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var writeGoldenMACVectors = flag.Bool("write-golden", false, "Write golden files")
+
+// replayMACVector handles the MAC commands of the vector as the Network Server does, and returns the result.
+func replayMACVector(ctx context.Context, v *macvector.Vector, fps *frequencyplans.Store) (*ttnpb.EndDevice, error) {
+	dev := ttnpb.Clone(v.Device)
+	up := ttnpb.Clone(v.Uplink)
+	defaults := v.DefaultMACSettings
+	if defaults == nil {
+		defaults = &ttnpb.MACSettings{}
+	}
+	_, deferred, _ := handleUplinkMACCommands(
+		ctx, dev, up, ttnpb.CloneSlice(v.Commands), fps, defaults, macvector.DevAddr, v.FullFCnt, v.Deduplicated,
+	)
+	for _, f := range deferred {
+		if _, err := f(ctx, dev, up); err != nil {
+			break
+		}
+	}
+	return macvector.Result(dev)
+}
+
+// TestMACVectors replays the MAC test vectors in testdata/mac_vectors. Vectors recorded by a Network Server with
+// mac-vectors.directory configured can be added to the directory. If a change in MAC command handling is intended,
+// run this test with the -write-golden flag to update the expected results.
+func TestMACVectors(t *testing.T) {
+	t.Parallel()
+
+	paths, err := macvector.Glob(filepath.Join("testdata", "mac_vectors"))
+	if err != nil {
+		t.Fatalf("Failed to find MAC test vectors: %s", err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("No MAC test vectors found")
+	}
+	fps := frequencyplans.NewStore(test.FrequencyPlansFetcher)
+	for _, path := range paths {
+		path := path
+		t.Run(filepath.Base(path), func(t *testing.T) {
+			t.Parallel()
+			a, ctx := test.New(t)
+
+			v, err := macvector.ReadFile(path)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			result, err := replayMACVector(ctx, v, fps)
+			if !a.So(err, should.BeNil) {
+				t.FailNow()
+			}
+			if *writeGoldenMACVectors {
+				v.Result = result
+				if err := macvector.WriteFile(path, v); err != nil {
+					t.Fatalf("Failed to write golden file: %s", err)
+				}
+				return
+			}
+			if !a.So(result, should.Resemble, v.Result) {
+				t.Log("NOTE: If the change in MAC command handling is intended, run this test with the -write-golden flag.")
+			}
+		})
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/specification/macspec"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// newMICFailure returns the MIC failure of the data uplink, without candidates.
+// If MIC failures are not recorded, nil is returned.
+func (ns *NetworkServer) newMICFailure(up *ttnpb.UplinkMessage) *MICFailure {
+	if ns.micFailures == nil || ns.micFailuresSize <= 0 {
+		return nil
+	}
+	pld := up.Payload.GetMacPayload()
+	failure := &MICFailure{
+		ReceivedAt:  *ttnpb.StdTime(up.ReceivedAt),
+		DevAddr:     types.MustDevAddr(pld.GetFHdr().GetDevAddr()).OrZero(),
+		FCnt:        pld.GetFHdr().GetFCnt(),
+		RawPayload:  up.RawPayload,
+		ReceivedMIC: up.Payload.Mic,
+	}
+	for _, md := range up.RxMetadata {
+		if id := md.GetGatewayIds().GetGatewayId(); id != "" {
+			failure.GatewayIDs = append(failure.GatewayIDs, id)
+		}
+	}
+	return failure
+}
+
+// addCandidate adds the end device of the uplink match to the candidates of the MIC failure.
+// cmacF is the cmacF computed with the FNwkSIntKey of the end device.
+func (f *MICFailure) addCandidate(match *UplinkMatch, fCnt uint32, cmacF [4]byte) {
+	if f == nil {
+		return
+	}
+	computedMIC := cmacF[:]
+	if !macspec.UseLegacyMIC(match.LoRaWANVersion) {
+		computedMIC = cmacF[:2]
+	}
+	f.Candidates = append(f.Candidates, &MICFailureCandidate{
+		ApplicationID:  match.ApplicationIdentifiers.GetApplicationId(),
+		DeviceID:       match.DeviceID,
+		IsPending:      match.IsPending,
+		LoRaWANVersion: match.LoRaWANVersion,
+		FCnt:           fCnt,
+		ComputedMIC:    computedMIC,
+	})
+}
+
+// recordMICFailure adds the MIC failure to the history of its DevAddr.
+// MIC failures without candidates are not recorded, since the uplink is not dropped because of a MIC mismatch.
+func (ns *NetworkServer) recordMICFailure(ctx context.Context, failure *MICFailure) {
+	if failure == nil || len(failure.Candidates) == 0 {
+		return
+	}
+	if err := ns.micFailures.Add(ctx, failure.DevAddr, failure, ns.micFailuresSize); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to record MIC failure")
+	}
+}
@@ -0,0 +1,124 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type mockMICFailureHistory map[types.DevAddr][]*MICFailure
+
+func (m mockMICFailureHistory) Add(_ context.Context, devAddr types.DevAddr, failure *MICFailure, size int) error {
+	failures := append([]*MICFailure{failure}, m[devAddr]...)
+	if len(failures) > size {
+		failures = failures[:size]
+	}
+	m[devAddr] = failures
+	return nil
+}
+
+func (m mockMICFailureHistory) Range(_ context.Context, devAddr types.DevAddr) ([]*MICFailure, error) {
+	return m[devAddr], nil
+}
+
+func TestMICFailures(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	receivedAt := time.Unix(42, 0).UTC()
+	up := &ttnpb.UplinkMessage{
+		RawPayload: []byte{0x40, 0x42, 0x42, 0x01, 0x26, 0x00, 0x2a, 0x00, 0x01, 0x02, 0x03, 0x04},
+		Payload: &ttnpb.Message{
+			Mic: []byte{0x01, 0x02, 0x03, 0x04},
+			Payload: &ttnpb.Message_MacPayload{MacPayload: &ttnpb.MACPayload{
+				FHdr: &ttnpb.FHDR{DevAddr: devAddr.Bytes(), FCnt: 42},
+			}},
+		},
+		RxMetadata: []*ttnpb.RxMetadata{
+			{GatewayIds: &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}},
+			{},
+		},
+		ReceivedAt: timestamppb.New(receivedAt),
+	}
+
+	a.So((&NetworkServer{}).newMICFailure(up), should.BeNil)
+	// Candidates of disabled MIC failures are ignored.
+	var disabled *MICFailure
+	disabled.addCandidate(&UplinkMatch{}, 42, [4]byte{})
+
+	history := mockMICFailureHistory{}
+	ns := &NetworkServer{
+		micFailures:     history,
+		micFailuresSize: 2,
+	}
+	failure := ns.newMICFailure(up)
+	a.So(failure, should.Resemble, &MICFailure{
+		ReceivedAt:  receivedAt,
+		DevAddr:     devAddr,
+		FCnt:        42,
+		RawPayload:  up.RawPayload,
+		ReceivedMIC: []byte{0x01, 0x02, 0x03, 0x04},
+		GatewayIDs:  []string{"gtw-1"},
+	})
+
+	// Failures without candidates are not recorded.
+	ns.recordMICFailure(ctx, failure)
+	a.So(history, should.BeEmpty)
+
+	failure.addCandidate(&UplinkMatch{
+		ApplicationIdentifiers: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceID:               "test-dev-1",
+		LoRaWANVersion:         ttnpb.MACVersion_MAC_V1_0_3,
+	}, 42, [4]byte{0x05, 0x06, 0x07, 0x08})
+	failure.addCandidate(&UplinkMatch{
+		ApplicationIdentifiers: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceID:               "test-dev-2",
+		LoRaWANVersion:         ttnpb.MACVersion_MAC_V1_1,
+		IsPending:              true,
+	}, 65578, [4]byte{0x05, 0x06, 0x07, 0x08})
+	a.So(failure.Candidates, should.Resemble, []*MICFailureCandidate{
+		{
+			ApplicationID:  "test-app",
+			DeviceID:       "test-dev-1",
+			LoRaWANVersion: ttnpb.MACVersion_MAC_V1_0_3,
+			FCnt:           42,
+			ComputedMIC:    []byte{0x05, 0x06, 0x07, 0x08},
+		},
+		{
+			ApplicationID:  "test-app",
+			DeviceID:       "test-dev-2",
+			IsPending:      true,
+			LoRaWANVersion: ttnpb.MACVersion_MAC_V1_1,
+			FCnt:           65578,
+			ComputedMIC:    []byte{0x05, 0x06},
+		},
+	})
+
+	for i := 0; i < 3; i++ {
+		ns.recordMICFailure(ctx, failure)
+	}
+	failures, err := history.Range(ctx, devAddr)
+	a.So(err, should.BeNil)
+	a.So(failures, should.HaveLength, 2)
+}
@@ -28,6 +28,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/interop"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/macvector"
 	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/random"
 	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/hooks"
@@ -145,6 +146,7 @@ type NetworkServer struct {
 	clusterID       string
 	newDevAddr      newDevAddrFunc
 	devAddrPrefixes devAddrPrefixesFunc
+	devAddrBlocks   *devAddrBlockCache
 
 	applicationServers *sync.Map // string -> *applicationUpStream
 	applicationUplinks ApplicationUplinkQueue
@@ -155,7 +157,33 @@ type NetworkServer struct {
 	deduplicationWindow windowDurationFunc
 	collectionWindow    windowDurationFunc
 
-	defaultMACSettings *ttnpb.MACSettings
+	defaultMACSettings            *ttnpb.MACSettings
+	applicationDefaultMACSettings map[string]*ttnpb.MACSettings
+
+	deviceStatusHistory     DeviceStatusHistory
+	deviceStatusHistorySize int
+
+	sessionHistory     SessionHistory
+	sessionHistorySize int
+
+	gatewayLatencies   *gatewayLatencies
+	gatewayMaintenance *gatewayMaintenanceCache
+
+	pingSlotAttempts    PingSlotAttempts
+	pingSlotMaxAttempts int
+	pingSlotJitter      float64
+
+	downlinkFailures DownlinkFailures
+	adaptiveRx       AdaptiveRxConfig
+
+	confirmedDownlinkOutcomes ConfirmedDownlinkOutcomes
+	adrDownlinkLoss           ADRDownlinkLossConfig
+
+	micFailures     MICFailureHistory
+	micFailuresSize int
+
+	macVectors            *macvector.Recorder
+	macVectorApplications map[string]struct{}
 
 	interopClient InteropClient
 	interopNSID   *types.EUI64
@@ -165,6 +193,8 @@ type NetworkServer struct {
 	deviceKEKLabel        string
 	downlinkQueueCapacity int
 
+	cryptoServiceKEKLabels map[string]struct{}
+
 	scheduledDownlinkMatcher ScheduledDownlinkMatcher
 
 	uplinkSubmissionPool workerpool.WorkerPool[[]*ttnpb.ApplicationUp]
@@ -216,6 +246,10 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		panic(errInvalidConfiguration.WithCause(errors.New("ScheduledDownlinkMatcher is not specified")))
 	case conf.DownlinkQueueCapacity < 0:
 		return nil, errInvalidConfiguration.WithCause(errors.New("Downlink queue capacity must be greater than or equal to 0"))
+	case conf.DevAddrBlocks.Enable && conf.DevAddrBlocks.Registry == nil:
+		return nil, errInvalidConfiguration.WithCause(errors.New("DevAddrBlocks.Registry is not specified"))
+	case conf.GatewayMaintenance.Enable && conf.GatewayMaintenance.Registry == nil:
+		return nil, errInvalidConfiguration.WithCause(errors.New("GatewayMaintenance.Registry is not specified"))
 	case conf.DownlinkQueueCapacity > maxInt/2:
 		return nil, errInvalidConfiguration.WithCause(errors.New(fmt.Sprintf("Downlink queue capacity must be below %d", maxInt/2)))
 	}
@@ -253,28 +287,75 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 	if err != nil {
 		return nil, err
 	}
+	applicationDefaultMACSettings, err := conf.DevStatusPolicies.Parse(defaultMACSettings)
+	if err != nil {
+		return nil, err
+	}
 
 	ns := &NetworkServer{
-		Component:                c,
-		ctx:                      ctx,
-		netID:                    conf.NetID,
-		clusterID:                conf.ClusterID,
-		newDevAddr:               makeNewDevAddrFunc(devAddrPrefixes...),
-		devAddrPrefixes:          makeDevAddrPrefixesFunc(devAddrPrefixes...),
-		applicationServers:       &sync.Map{},
-		applicationUplinks:       conf.ApplicationUplinkQueue.Queue,
-		deduplicationWindow:      makeWindowDurationFunc(conf.DeduplicationWindow),
-		collectionWindow:         makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
-		devices:                  wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
-		downlinkTasks:            conf.DownlinkTaskQueue.Queue,
-		downlinkPriorities:       downlinkPriorities,
-		defaultMACSettings:       defaultMACSettings,
-		interopClient:            interopCl,
-		interopNSID:              conf.Interop.ID,
-		uplinkDeduplicator:       conf.UplinkDeduplicator,
-		deviceKEKLabel:           conf.DeviceKEKLabel,
-		downlinkQueueCapacity:    conf.DownlinkQueueCapacity,
-		scheduledDownlinkMatcher: conf.ScheduledDownlinkMatcher,
+		Component:                     c,
+		ctx:                           ctx,
+		netID:                         conf.NetID,
+		clusterID:                     conf.ClusterID,
+		newDevAddr:                    makeNewDevAddrFunc(devAddrPrefixes...),
+		devAddrPrefixes:               makeDevAddrPrefixesFunc(devAddrPrefixes...),
+		applicationServers:            &sync.Map{},
+		applicationUplinks:            conf.ApplicationUplinkQueue.Queue,
+		deduplicationWindow:           makeWindowDurationFunc(conf.DeduplicationWindow),
+		collectionWindow:              makeWindowDurationFunc(conf.DeduplicationWindow + conf.CooldownWindow),
+		devices:                       wrapEndDeviceRegistryWithReplacedFields(conf.Devices, replacedEndDeviceFields...),
+		downlinkTasks:                 conf.DownlinkTaskQueue.Queue,
+		downlinkPriorities:            downlinkPriorities,
+		defaultMACSettings:            defaultMACSettings,
+		interopClient:                 interopCl,
+		interopNSID:                   conf.Interop.ID,
+		uplinkDeduplicator:            conf.UplinkDeduplicator,
+		deviceKEKLabel:                conf.DeviceKEKLabel,
+		downlinkQueueCapacity:         conf.DownlinkQueueCapacity,
+		scheduledDownlinkMatcher:      conf.ScheduledDownlinkMatcher,
+		applicationDefaultMACSettings: applicationDefaultMACSettings,
+		deviceStatusHistory:           conf.DeviceStatusHistory.History,
+		deviceStatusHistorySize:       conf.DeviceStatusHistory.Size,
+		sessionHistory:                conf.SessionHistory.History,
+		sessionHistorySize:            conf.SessionHistory.Size,
+		gatewayLatencies:              newGatewayLatencies(conf.GatewayLatency),
+		pingSlotAttempts:              conf.PingSlotRetries.Attempts,
+		pingSlotMaxAttempts:           conf.PingSlotRetries.MaxAttempts,
+		pingSlotJitter:                conf.PingSlotRetries.Jitter,
+		downlinkFailures:              conf.AdaptiveRx.Failures,
+		adaptiveRx:                    conf.AdaptiveRx,
+		confirmedDownlinkOutcomes:     conf.ADRDownlinkLoss.Outcomes,
+		adrDownlinkLoss:               conf.ADRDownlinkLoss,
+		micFailures:                   conf.MICFailures.History,
+		micFailuresSize:               conf.MICFailures.Size,
+	}
+	if conf.DevAddrBlocks.Enable {
+		ns.devAddrBlocks = &devAddrBlockCache{
+			registry: conf.DevAddrBlocks.Registry,
+			ttl:      conf.DevAddrBlocks.CacheTTL,
+		}
+	}
+	if conf.GatewayMaintenance.Enable {
+		ns.gatewayMaintenance = &gatewayMaintenanceCache{
+			registry: conf.GatewayMaintenance.Registry,
+			ttl:      conf.GatewayMaintenance.CacheTTL,
+		}
+		if ns.gatewayLatencies != nil {
+			ns.gatewayLatencies.maintenance = ns.gatewayMaintenance
+		}
+	}
+	if conf.MACVectors.Directory != "" {
+		ns.macVectors = macvector.NewRecorder(conf.MACVectors.Directory)
+		ns.macVectorApplications = make(map[string]struct{}, len(conf.MACVectors.Applications))
+		for _, appID := range conf.MACVectors.Applications {
+			ns.macVectorApplications[appID] = struct{}{}
+		}
+	}
+	if len(conf.CryptoService.KEKLabels) > 0 {
+		ns.cryptoServiceKEKLabels = make(map[string]struct{}, len(conf.CryptoService.KEKLabels))
+		for _, label := range conf.CryptoService.KEKLabels {
+			ns.cryptoServiceKEKLabels[label] = struct{}{}
+		}
 	}
 	ns.uplinkSubmissionPool = workerpool.NewWorkerPool(workerpool.Config[[]*ttnpb.ApplicationUp]{
 		Component:  c,
@@ -364,6 +445,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*NetworkServer,
 		})
 	}
 	c.RegisterGRPC(ns)
+	c.RegisterWeb(ns)
 	return ns, nil
 }
 
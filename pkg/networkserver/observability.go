@@ -72,6 +72,22 @@ var (
 		events.WithErrorDataType(),
 		events.WithPropagateToParent(),
 	)
+	evtSchedulePingSlotRetry = events.Define(
+		"ns.down.data.ping_slot.retry", "retry class B data downlink in subsequent ping slot",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+	)
+	evtSchedulePingSlotFail = events.Define(
+		"ns.down.data.ping_slot.fail", "failed to schedule class B data downlink in ping slots",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
+		events.WithErrorDataType(),
+		events.WithPropagateToParent(),
+	)
+	evtAdaptRxParameters = events.Define(
+		"ns.mac.rx_parameters.adapt", "adapt receive window parameters after consecutive downlink failures",
+		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_DEVICES_READ),
+		events.WithDataType(&ttnpb.MACParameters{}),
+	)
 	evtReceiveJoinRequest = events.Define(
 		"ns.up.join.receive", "receive join-request",
 		events.WithVisibility(ttnpb.Right_RIGHT_APPLICATION_TRAFFIC_READ),
@@ -201,6 +217,14 @@ var nsMetrics = &messageMetrics{
 		},
 		[]string{messageType, "error"},
 	),
+	joinRequestsRateLimited: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "join_request_rate_limited_total",
+			Help:      "Total number of join-requests dropped because of rate limits",
+		},
+		[]string{"resource"},
+	),
 	uplinkForwarded: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
 			Subsystem: subsystem,
@@ -270,13 +294,14 @@ func init() {
 }
 
 type messageMetrics struct {
-	uplinkReceived    *metrics.ContextualCounterVec
-	uplinkDuplicates  *metrics.ContextualCounterVec
-	uplinkProcessed   *metrics.ContextualCounterVec
-	uplinkForwarded   *metrics.ContextualCounterVec
-	uplinkDropped     *metrics.ContextualCounterVec
-	uplinkGateways    *metrics.ContextualHistogramVec
-	gsNsUplinkLatency *metrics.ContextualHistogramVec
+	uplinkReceived          *metrics.ContextualCounterVec
+	uplinkDuplicates        *metrics.ContextualCounterVec
+	uplinkProcessed         *metrics.ContextualCounterVec
+	uplinkForwarded         *metrics.ContextualCounterVec
+	uplinkDropped           *metrics.ContextualCounterVec
+	joinRequestsRateLimited *metrics.ContextualCounterVec
+	uplinkGateways          *metrics.ContextualHistogramVec
+	gsNsUplinkLatency       *metrics.ContextualHistogramVec
 
 	matchCandidatesPerUplink *metrics.ContextualHistogramVec
 	micComputationsPerUplink *metrics.ContextualHistogramVec
@@ -291,6 +316,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.uplinkProcessed.Describe(ch)
 	m.uplinkForwarded.Describe(ch)
 	m.uplinkDropped.Describe(ch)
+	m.joinRequestsRateLimited.Describe(ch)
 	m.uplinkGateways.Describe(ch)
 	m.gsNsUplinkLatency.Describe(ch)
 
@@ -307,6 +333,7 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.uplinkProcessed.Collect(ch)
 	m.uplinkForwarded.Collect(ch)
 	m.uplinkDropped.Collect(ch)
+	m.joinRequestsRateLimited.Collect(ch)
 	m.uplinkGateways.Collect(ch)
 	m.gsNsUplinkLatency.Collect(ch)
 
@@ -353,6 +380,10 @@ func registerDropUplink(ctx context.Context, msg *ttnpb.UplinkMessage, err error
 	nsMetrics.uplinkDropped.WithLabelValues(ctx, mTypeLabel(msg.Payload.MHdr.MType), cause).Inc()
 }
 
+func registerRateLimitJoinRequest(ctx context.Context, resource string) {
+	nsMetrics.joinRequestsRateLimited.WithLabelValues(ctx, resource).Inc()
+}
+
 func registerUplinkLatency(ctx context.Context, msg *ttnpb.UplinkMessage) {
 	nsMetrics.gsNsUplinkLatency.WithLabelValues(ctx).Observe(time.Since(*ttnpb.StdTime(msg.ReceivedAt)).Seconds())
 }
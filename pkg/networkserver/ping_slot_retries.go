@@ -0,0 +1,119 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/mac"
+	"go.thethings.network/lorawan-stack/v3/pkg/random"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// pingSlotAttemptsTTL is the time after which the ping slot attempts of an end device expire.
+const pingSlotAttemptsTTL = 24 * time.Hour
+
+// pingSlotRetriesEnabled returns whether the application downlink attempted in the slot is retried in subsequent
+// ping slots if it cannot be scheduled.
+func (ns *NetworkServer) pingSlotRetriesEnabled(slot *networkInitiatedDownlinkSlot, down *ttnpb.ApplicationDownlink) bool {
+	return ns.pingSlotAttempts != nil && ns.pingSlotMaxAttempts > 0 && down != nil &&
+		slot.Class == ttnpb.Class_CLASS_B && !slot.IsApplicationTime
+}
+
+// pingSlotRetryAt returns the earliest time at which a class B downlink that failed at now is retried.
+// The retry is delayed by a random duration of at most jitter times the ping slot period, so that the retries of
+// end devices sharing the same gateways spread over the ping slots.
+func pingSlotRetryAt(dev *ttnpb.EndDevice, now time.Time, jitter float64) time.Time {
+	retryAt := now.Add(downlinkRetryInterval + nsScheduleWindow())
+	if d := int64(float64(mac.DevicePingSlotPeriod(dev)) * jitter); d > 0 {
+		retryAt = retryAt.Add(time.Duration(random.Int63n(d)))
+	}
+	return retryAt
+}
+
+// attemptPingSlotRetry handles the application downlink that could not be scheduled in a class B ping slot.
+// If the maximum number of attempts is not reached, the application downlink is put back in front of the queue and
+// retried in a subsequent ping slot. Otherwise, the application downlink fails.
+// If the attempts cannot be counted, false is returned and the failure is handled as without retries.
+func (ns *NetworkServer) attemptPingSlotRetry(
+	ctx context.Context,
+	dev *ttnpb.EndDevice,
+	genState generateDownlinkState,
+	sets []string,
+	queuedEvents []events.Event,
+	cause error,
+) (downlinkAttemptResult, bool) {
+	down := genState.ApplicationDownlink
+	attempts, err := ns.pingSlotAttempts.Increment(ctx, dev.Ids, down.FCnt, pingSlotAttemptsTTL)
+	if err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to count ping slot attempts")
+		return downlinkAttemptResult{}, false
+	}
+	logger := log.FromContext(ctx).WithFields(log.Fields(
+		"attempts", attempts,
+		"max_attempts", ns.pingSlotMaxAttempts,
+	))
+	if attempts < ns.pingSlotMaxAttempts {
+		logger.Debug("Retry class B downlink in subsequent ping slot")
+		if ttnpb.HasAnyField(sets, "session.queued_application_downlinks") {
+			dev.Session.QueuedApplicationDownlinks = append(
+				[]*ttnpb.ApplicationDownlink{down},
+				dev.Session.QueuedApplicationDownlinks...,
+			)
+		}
+		return downlinkAttemptResult{
+			SetPaths:                 sets,
+			QueuedApplicationUplinks: genState.appendApplicationUplinks(nil, false),
+			QueuedEvents: append(queuedEvents,
+				evtSchedulePingSlotRetry.NewWithIdentifiersAndData(ctx, dev.Ids, cause),
+			),
+			DownlinkTaskUpdateStrategy: retryDownlinkTask,
+			RetryAt:                    pingSlotRetryAt(dev, time.Now(), ns.pingSlotJitter),
+		}, true
+	}
+
+	logger.Warn("Class B downlink not scheduled in any ping slot, fail application downlink")
+	ns.clearPingSlotAttempts(ctx, dev.Ids)
+	failErr := errPingSlotAttempts.WithAttributes("attempts", attempts).WithCause(cause)
+	return downlinkAttemptResult{
+		SetPaths: ttnpb.AddFields(sets, "session.queued_application_downlinks"),
+		QueuedApplicationUplinks: append(genState.appendApplicationUplinks(nil, false), &ttnpb.ApplicationUp{
+			EndDeviceIds:   dev.Ids,
+			CorrelationIds: events.CorrelationIDsFromContext(ctx),
+			Up: &ttnpb.ApplicationUp_DownlinkFailed{
+				DownlinkFailed: &ttnpb.ApplicationDownlinkFailed{
+					Downlink: down,
+					Error:    ttnpb.ErrorDetailsToProto(failErr),
+				},
+			},
+		}),
+		QueuedEvents: append(queuedEvents,
+			evtSchedulePingSlotFail.NewWithIdentifiersAndData(ctx, dev.Ids, failErr),
+		),
+	}, true
+}
+
+// clearPingSlotAttempts removes the ping slot attempts of the end device.
+func (ns *NetworkServer) clearPingSlotAttempts(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if ns.pingSlotAttempts == nil {
+		return
+	}
+	if err := ns.pingSlotAttempts.Clear(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to clear ping slot attempts")
+	}
+}
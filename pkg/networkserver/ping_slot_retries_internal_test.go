@@ -0,0 +1,133 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+type mockPingSlotAttempts map[string]map[uint32]int
+
+func (m mockPingSlotAttempts) Increment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fCnt uint32, _ time.Duration,
+) (int, error) {
+	uid := unique.ID(ctx, ids)
+	if m[uid] == nil {
+		m[uid] = make(map[uint32]int)
+	}
+	m[uid][fCnt]++
+	return m[uid][fCnt], nil
+}
+
+func (m mockPingSlotAttempts) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	delete(m, unique.ID(ctx, ids))
+	return nil
+}
+
+func TestPingSlotRetryAt(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	now := time.Unix(42, 0)
+	base := now.Add(downlinkRetryInterval + nsScheduleWindow())
+	dev := &ttnpb.EndDevice{
+		MacState: &ttnpb.MACState{
+			PingSlotPeriodicity: &ttnpb.PingSlotPeriodValue{Value: ttnpb.PingSlotPeriod_PING_EVERY_4S},
+		},
+	}
+	a.So(pingSlotRetryAt(dev, now, 0), should.Equal, base)
+	a.So(pingSlotRetryAt(&ttnpb.EndDevice{}, now, 0.5), should.Equal, base)
+	for i := 0; i < 100; i++ {
+		retryAt := pingSlotRetryAt(dev, now, 0.5)
+		a.So(retryAt, should.HappenOnOrAfter, base)
+		a.So(retryAt, should.HappenBefore, base.Add(1920*time.Millisecond))
+	}
+}
+
+func TestAttemptPingSlotRetry(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	attempts := mockPingSlotAttempts{}
+	ns := &NetworkServer{
+		pingSlotAttempts:    attempts,
+		pingSlotMaxAttempts: 3,
+	}
+	slot := &networkInitiatedDownlinkSlot{Class: ttnpb.Class_CLASS_B}
+	appDown := &ttnpb.ApplicationDownlink{FCnt: 42, FrmPayload: []byte{0x01}}
+	a.So(ns.pingSlotRetriesEnabled(slot, appDown), should.BeTrue)
+	a.So(ns.pingSlotRetriesEnabled(slot, nil), should.BeFalse)
+	a.So(ns.pingSlotRetriesEnabled(&networkInitiatedDownlinkSlot{Class: ttnpb.Class_CLASS_C}, appDown), should.BeFalse)
+	a.So(ns.pingSlotRetriesEnabled(&networkInitiatedDownlinkSlot{
+		Class:             ttnpb.Class_CLASS_B,
+		IsApplicationTime: true,
+	}, appDown), should.BeFalse)
+	a.So((&NetworkServer{pingSlotAttempts: attempts}).pingSlotRetriesEnabled(slot, appDown), should.BeFalse)
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"},
+		DeviceId:       "test-dev",
+	}
+	sets := []string{"session.queued_application_downlinks"}
+	for i := 1; i < 3; i++ {
+		dev := &ttnpb.EndDevice{
+			Ids:     ids,
+			Session: &ttnpb.Session{},
+		}
+		res, ok := ns.attemptPingSlotRetry(ctx, dev, generateDownlinkState{
+			ApplicationDownlink:      appDown,
+			NeedsDownlinkQueueUpdate: true,
+		}, sets, nil, errNoPath.New())
+		a.So(ok, should.BeTrue)
+		a.So(res.DownlinkTaskUpdateStrategy, should.Equal, retryDownlinkTask)
+		a.So(res.RetryAt.IsZero(), should.BeFalse)
+		a.So(res.SetPaths, should.Resemble, sets)
+		a.So(res.QueuedApplicationUplinks, should.BeEmpty)
+		a.So(res.QueuedEvents, should.HaveLength, 1)
+		a.So(res.QueuedEvents[0].Name(), should.Equal, "ns.down.data.ping_slot.retry")
+		a.So(dev.Session.QueuedApplicationDownlinks, should.Resemble, []*ttnpb.ApplicationDownlink{appDown})
+	}
+
+	dev := &ttnpb.EndDevice{
+		Ids:     ids,
+		Session: &ttnpb.Session{},
+	}
+	res, ok := ns.attemptPingSlotRetry(ctx, dev, generateDownlinkState{
+		ApplicationDownlink:      appDown,
+		NeedsDownlinkQueueUpdate: true,
+	}, sets, nil, errNoPath.New())
+	a.So(ok, should.BeTrue)
+	a.So(res.DownlinkTaskUpdateStrategy, should.Equal, nextDownlinkTask)
+	a.So(res.SetPaths, should.Resemble, sets)
+	a.So(dev.Session.QueuedApplicationDownlinks, should.BeEmpty)
+	if a.So(res.QueuedApplicationUplinks, should.HaveLength, 1) {
+		failed := res.QueuedApplicationUplinks[0].GetDownlinkFailed()
+		a.So(failed.GetDownlink(), should.Resemble, appDown)
+		a.So(errors.IsResourceExhausted(ttnpb.ErrorDetailsFromProto(failed.GetError())), should.BeTrue)
+	}
+	if a.So(res.QueuedEvents, should.HaveLength, 1) {
+		a.So(res.QueuedEvents[0].Name(), should.Equal, "ns.down.data.ping_slot.fail")
+	}
+	a.So(attempts, should.BeEmpty)
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// ConfirmedDownlinkOutcomes is an implementation of networkserver.ConfirmedDownlinkOutcomes.
+// The outcomes of an end device are stored in a list, with the most recent outcome first.
+type ConfirmedDownlinkOutcomes struct {
+	Redis *ttnredis.Client
+}
+
+func (o *ConfirmedDownlinkOutcomes) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return UIDKey(o.Redis, unique.ID(ctx, ids))
+}
+
+func parseOutcomes(vs []string) []bool {
+	outcomes := make([]bool, 0, len(vs))
+	for _, v := range vs {
+		outcomes = append(outcomes, v == "1")
+	}
+	return outcomes
+}
+
+// Add implements networkserver.ConfirmedDownlinkOutcomes.
+func (o *ConfirmedDownlinkOutcomes) Add(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, acked bool, size int, ttl time.Duration,
+) ([]bool, error) {
+	v := "0"
+	if acked {
+		v = "1"
+	}
+	k := o.key(ctx, ids)
+	var lrange *redis.StringSliceCmd
+	if _, err := o.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, v)
+		p.LTrim(ctx, k, 0, int64(size-1))
+		p.PExpire(ctx, k, ttl)
+		lrange = p.LRange(ctx, k, 0, -1)
+		return nil
+	}); err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return parseOutcomes(lrange.Val()), nil
+}
+
+// Range implements networkserver.ConfirmedDownlinkOutcomes.
+func (o *ConfirmedDownlinkOutcomes) Range(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]bool, error) {
+	vs, err := o.Redis.LRange(ctx, o.key(ctx, ids), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	return parseOutcomes(vs), nil
+}
+
+// Clear implements networkserver.ConfirmedDownlinkOutcomes.
+func (o *ConfirmedDownlinkOutcomes) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := o.Redis.Del(ctx, o.key(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
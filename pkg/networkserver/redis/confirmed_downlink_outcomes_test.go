@@ -0,0 +1,67 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestConfirmedDownlinkOutcomes(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	outcomes := &redis.ConfirmedDownlinkOutcomes{Redis: cl}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+
+	vs, err := outcomes.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(vs, should.BeEmpty)
+
+	vs, err = outcomes.Add(ctx, ids, true, 3, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(vs, should.Resemble, []bool{true})
+
+	vs, err = outcomes.Add(ctx, ids, false, 3, time.Minute)
+	a.So(err, should.BeNil)
+	a.So(vs, should.Resemble, []bool{false, true})
+
+	for i := 0; i < 2; i++ {
+		_, err = outcomes.Add(ctx, ids, false, 3, time.Minute)
+		a.So(err, should.BeNil)
+	}
+	vs, err = outcomes.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(vs, should.Resemble, []bool{false, false, false})
+
+	a.So(outcomes.Clear(ctx, ids), should.BeNil)
+	vs, err = outcomes.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(vs, should.BeEmpty)
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"strconv"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+// DevAddrBlockRegistry is an implementation of networkserver.DevAddrBlockRegistry.
+// The blocks and the number of allocations are stored in hashes by block ID.
+type DevAddrBlockRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *DevAddrBlockRegistry) blocksKey() string {
+	return r.Redis.Key("blocks")
+}
+
+func (r *DevAddrBlockRegistry) allocationsKey() string {
+	return r.Redis.Key("allocations")
+}
+
+// Range implements networkserver.DevAddrBlockRegistry.
+func (r *DevAddrBlockRegistry) Range(ctx context.Context) ([]*networkserver.DevAddrBlock, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.blocksKey()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	blocks := make([]*networkserver.DevAddrBlock, 0, len(vs))
+	for _, v := range vs {
+		block := &networkserver.DevAddrBlock{}
+		if err := json.Unmarshal([]byte(v), block); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		blocks = append(blocks, block)
+	}
+	sort.Slice(blocks, func(i, j int) bool { return blocks[i].ID < blocks[j].ID })
+	return blocks, nil
+}
+
+// Set implements networkserver.DevAddrBlockRegistry.
+func (r *DevAddrBlockRegistry) Set(ctx context.Context, block *networkserver.DevAddrBlock) error {
+	b, err := json.Marshal(block)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.HSet(ctx, r.blocksKey(), block.ID, b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete implements networkserver.DevAddrBlockRegistry.
+func (r *DevAddrBlockRegistry) Delete(ctx context.Context, id string) error {
+	if _, err := r.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.HDel(ctx, r.blocksKey(), id)
+		p.HDel(ctx, r.allocationsKey(), id)
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// AddAllocation implements networkserver.DevAddrBlockRegistry.
+func (r *DevAddrBlockRegistry) AddAllocation(ctx context.Context, id string) error {
+	if err := r.Redis.HIncrBy(ctx, r.allocationsKey(), id, 1).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Allocations implements networkserver.DevAddrBlockRegistry.
+func (r *DevAddrBlockRegistry) Allocations(ctx context.Context) (map[string]uint64, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.allocationsKey()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	allocations := make(map[string]uint64, len(vs))
+	for id, v := range vs {
+		n, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		allocations[id] = n
+	}
+	return allocations, nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDevAddrBlockRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.DevAddrBlockRegistry{Redis: cl}
+
+	blocks, err := r.Range(ctx)
+	a.So(err, should.BeNil)
+	a.So(blocks, should.BeEmpty)
+
+	block1 := &networkserver.DevAddrBlock{
+		ID:     "block-1",
+		NetID:  types.NetID{0x00, 0x00, 0x13},
+		Prefix: types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x00, 0x00, 0x00}, Length: 16},
+		Policy: networkserver.DevAddrBlockPolicyShared,
+	}
+	block2 := &networkserver.DevAddrBlock{
+		ID:           "block-2",
+		NetID:        types.NetID{0x00, 0x00, 0x13},
+		Prefix:       types.DevAddrPrefix{DevAddr: types.DevAddr{0x26, 0x01, 0x00, 0x00}, Length: 16},
+		Policy:       networkserver.DevAddrBlockPolicyDedicated,
+		Applications: []string{"app1"},
+	}
+	for _, b := range []*networkserver.DevAddrBlock{block2, block1} {
+		if !a.So(r.Set(ctx, b), should.BeNil) {
+			t.FailNow()
+		}
+	}
+	blocks, err = r.Range(ctx)
+	a.So(err, should.BeNil)
+	a.So(blocks, should.Resemble, []*networkserver.DevAddrBlock{block1, block2})
+
+	for i := 0; i < 3; i++ {
+		a.So(r.AddAllocation(ctx, "block-1"), should.BeNil)
+	}
+	a.So(r.AddAllocation(ctx, "block-2"), should.BeNil)
+	allocations, err := r.Allocations(ctx)
+	a.So(err, should.BeNil)
+	a.So(allocations, should.Resemble, map[string]uint64{
+		"block-1": 3,
+		"block-2": 1,
+	})
+
+	a.So(r.Delete(ctx, "block-1"), should.BeNil)
+	blocks, err = r.Range(ctx)
+	a.So(err, should.BeNil)
+	a.So(blocks, should.Resemble, []*networkserver.DevAddrBlock{block2})
+	allocations, err = r.Allocations(ctx)
+	a.So(err, should.BeNil)
+	a.So(allocations, should.Resemble, map[string]uint64{
+		"block-2": 1,
+	})
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DeviceStatusHistory is an implementation of networkserver.DeviceStatusHistory.
+// The statuses of an end device are stored in a list, with the most recent status first.
+type DeviceStatusHistory struct {
+	Redis *ttnredis.Client
+}
+
+func (h *DeviceStatusHistory) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return UIDKey(h.Redis, unique.ID(ctx, ids))
+}
+
+// Add implements networkserver.DeviceStatusHistory.
+func (h *DeviceStatusHistory) Add(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, status *networkserver.DeviceStatus, size int,
+) error {
+	b, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	k := h.key(ctx, ids)
+	if _, err := h.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, b)
+		p.LTrim(ctx, k, 0, int64(size-1))
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements networkserver.DeviceStatusHistory.
+func (h *DeviceStatusHistory) Range(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]*networkserver.DeviceStatus, error) {
+	vs, err := h.Redis.LRange(ctx, h.key(ctx, ids), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	statuses := make([]*networkserver.DeviceStatus, 0, len(vs))
+	for _, v := range vs {
+		status := &networkserver.DeviceStatus{}
+		if err := json.Unmarshal([]byte(v), status); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses, nil
+}
+
+// Clear implements networkserver.DeviceStatusHistory.
+func (h *DeviceStatusHistory) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := h.Redis.Del(ctx, h.key(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDeviceStatusHistory(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	h := &redis.DeviceStatusHistory{Redis: cl}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+
+	statuses, err := h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.BeEmpty)
+
+	battery := float32(0.5)
+	var added []*networkserver.DeviceStatus
+	for i := 0; i < 4; i++ {
+		status := &networkserver.DeviceStatus{
+			ReceivedAt:        time.Unix(int64(i), 0).UTC(),
+			PowerState:        ttnpb.PowerState_POWER_BATTERY,
+			BatteryPercentage: &battery,
+			DownlinkMargin:    int32(i),
+		}
+		if !a.So(h.Add(ctx, ids, status, 3), should.BeNil) {
+			t.FailNow()
+		}
+		added = append([]*networkserver.DeviceStatus{status}, added...)
+	}
+
+	statuses, err = h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.Resemble, added[:3])
+
+	a.So(h.Clear(ctx, ids), should.BeNil)
+	statuses, err = h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(statuses, should.BeEmpty)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// DownlinkFailures is an implementation of networkserver.DownlinkFailures.
+// The failures of an end device are stored in a counter.
+type DownlinkFailures struct {
+	Redis *ttnredis.Client
+}
+
+func (f *DownlinkFailures) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return UIDKey(f.Redis, unique.ID(ctx, ids))
+}
+
+// Get implements networkserver.DownlinkFailures.
+func (f *DownlinkFailures) Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (int, error) {
+	n, err := f.Redis.Get(ctx, f.key(ctx, ids)).Int()
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+		return 0, ttnredis.ConvertError(err)
+	}
+	return n, nil
+}
+
+// Increment implements networkserver.DownlinkFailures.
+func (f *DownlinkFailures) Increment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, ttl time.Duration,
+) (int, error) {
+	k := f.key(ctx, ids)
+	var incr *redis.IntCmd
+	if _, err := f.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		incr = p.Incr(ctx, k)
+		p.PExpire(ctx, k, ttl)
+		return nil
+	}); err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+	return int(incr.Val()), nil
+}
+
+// Clear implements networkserver.DownlinkFailures.
+func (f *DownlinkFailures) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := f.Redis.Del(ctx, f.key(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
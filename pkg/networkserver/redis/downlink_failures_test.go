@@ -0,0 +1,60 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkFailures(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	failures := &redis.DownlinkFailures{Redis: cl}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+
+	n, err := failures.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+
+	for i := 1; i <= 3; i++ {
+		n, err := failures.Increment(ctx, ids, time.Minute)
+		a.So(err, should.BeNil)
+		a.So(n, should.Equal, i)
+	}
+	n, err = failures.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 3)
+
+	a.So(failures.Clear(ctx, ids), should.BeNil)
+	n, err = failures.Get(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(n, should.Equal, 0)
+}
@@ -0,0 +1,99 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+var errGatewayMaintenanceNotFound = errors.DefineNotFound(
+	"gateway_maintenance_not_found", "maintenance windows of gateway `{gateway_uid}` not found",
+)
+
+// GatewayMaintenanceRegistry is an implementation of networkserver.GatewayMaintenanceRegistry.
+// The maintenance windows are stored in a hash by gateway UID.
+type GatewayMaintenanceRegistry struct {
+	Redis *ttnredis.Client
+}
+
+func (r *GatewayMaintenanceRegistry) key() string {
+	return r.Redis.Key("gateways")
+}
+
+// Get implements networkserver.GatewayMaintenanceRegistry.
+func (r *GatewayMaintenanceRegistry) Get(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers,
+) (*networkserver.GatewayMaintenance, error) {
+	uid := unique.ID(ctx, ids)
+	v, err := r.Redis.HGet(ctx, r.key(), uid).Result()
+	if err != nil {
+		err = ttnredis.ConvertError(err)
+		if errors.IsNotFound(err) {
+			return nil, errGatewayMaintenanceNotFound.WithAttributes("gateway_uid", uid)
+		}
+		return nil, err
+	}
+	maintenance := &networkserver.GatewayMaintenance{}
+	if err := json.Unmarshal([]byte(v), maintenance); err != nil {
+		return nil, errDatabaseCorruption.WithCause(err)
+	}
+	return maintenance, nil
+}
+
+// Set implements networkserver.GatewayMaintenanceRegistry.
+func (r *GatewayMaintenanceRegistry) Set(
+	ctx context.Context, ids *ttnpb.GatewayIdentifiers, maintenance *networkserver.GatewayMaintenance,
+) error {
+	b, err := json.Marshal(maintenance)
+	if err != nil {
+		return err
+	}
+	if err := r.Redis.HSet(ctx, r.key(), unique.ID(ctx, ids), b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Delete implements networkserver.GatewayMaintenanceRegistry.
+func (r *GatewayMaintenanceRegistry) Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error {
+	if err := r.Redis.HDel(ctx, r.key(), unique.ID(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements networkserver.GatewayMaintenanceRegistry.
+func (r *GatewayMaintenanceRegistry) Range(ctx context.Context) (map[string]*networkserver.GatewayMaintenance, error) {
+	vs, err := r.Redis.HGetAll(ctx, r.key()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	res := make(map[string]*networkserver.GatewayMaintenance, len(vs))
+	for uid, v := range vs {
+		maintenance := &networkserver.GatewayMaintenance{}
+		if err := json.Unmarshal([]byte(v), maintenance); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		res[uid] = maintenance
+	}
+	return res, nil
+}
@@ -0,0 +1,80 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestGatewayMaintenanceRegistry(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	r := &redis.GatewayMaintenanceRegistry{Redis: cl}
+
+	ids1 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-1"}
+	ids2 := &ttnpb.GatewayIdentifiers{GatewayId: "gtw-2"}
+
+	_, err := r.Get(ctx, ids1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+
+	until := time.Date(2024, time.January, 1, 0, 0, 0, 0, time.UTC)
+	maintenance1 := &networkserver.GatewayMaintenance{
+		Windows: []networkserver.GatewayMaintenanceWindow{{
+			Start:      time.Date(2023, time.June, 1, 2, 0, 0, 0, time.UTC),
+			End:        time.Date(2023, time.June, 1, 4, 0, 0, 0, time.UTC),
+			Recurrence: networkserver.GatewayMaintenanceWeekly,
+			Until:      &until,
+		}},
+	}
+	maintenance2 := &networkserver.GatewayMaintenance{
+		Windows: []networkserver.GatewayMaintenanceWindow{{
+			Start: time.Date(2023, time.June, 2, 10, 0, 0, 0, time.UTC),
+			End:   time.Date(2023, time.June, 2, 12, 0, 0, 0, time.UTC),
+		}},
+	}
+	a.So(r.Set(ctx, ids1, maintenance1), should.BeNil)
+	a.So(r.Set(ctx, ids2, maintenance2), should.BeNil)
+
+	maintenance, err := r.Get(ctx, ids1)
+	a.So(err, should.BeNil)
+	a.So(maintenance, should.Resemble, maintenance1)
+
+	all, err := r.Range(ctx)
+	a.So(err, should.BeNil)
+	a.So(all, should.Resemble, map[string]*networkserver.GatewayMaintenance{
+		unique.ID(ctx, ids1): maintenance1,
+		unique.ID(ctx, ids2): maintenance2,
+	})
+
+	a.So(r.Delete(ctx, ids1), should.BeNil)
+	_, err = r.Get(ctx, ids1)
+	a.So(errors.IsNotFound(err), should.BeTrue)
+	all, err = r.Range(ctx)
+	a.So(err, should.BeNil)
+	a.So(all, should.HaveLength, 1)
+}
@@ -0,0 +1,71 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// MICFailureHistory is an implementation of networkserver.MICFailureHistory.
+// The MIC failures of a DevAddr are stored in a list, with the most recent failure first.
+type MICFailureHistory struct {
+	Redis *ttnredis.Client
+}
+
+func (h *MICFailureHistory) key(devAddr types.DevAddr) string {
+	return h.Redis.Key("dev-addr", devAddr.String())
+}
+
+// Add implements networkserver.MICFailureHistory.
+func (h *MICFailureHistory) Add(
+	ctx context.Context, devAddr types.DevAddr, failure *networkserver.MICFailure, size int,
+) error {
+	b, err := json.Marshal(failure)
+	if err != nil {
+		return err
+	}
+	k := h.key(devAddr)
+	if _, err := h.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, b)
+		p.LTrim(ctx, k, 0, int64(size-1))
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements networkserver.MICFailureHistory.
+func (h *MICFailureHistory) Range(ctx context.Context, devAddr types.DevAddr) ([]*networkserver.MICFailure, error) {
+	vs, err := h.Redis.LRange(ctx, h.key(devAddr), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	failures := make([]*networkserver.MICFailure, 0, len(vs))
+	for _, v := range vs {
+		failure := &networkserver.MICFailure{}
+		if err := json.Unmarshal([]byte(v), failure); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		failures = append(failures, failure)
+	}
+	return failures, nil
+}
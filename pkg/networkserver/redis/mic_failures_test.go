@@ -0,0 +1,73 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestMICFailureHistory(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	h := &redis.MICFailureHistory{Redis: cl}
+
+	devAddr := types.DevAddr{0x26, 0x01, 0x42, 0x42}
+	failures, err := h.Range(ctx, devAddr)
+	a.So(err, should.BeNil)
+	a.So(failures, should.BeEmpty)
+
+	var added []*networkserver.MICFailure
+	for i := 0; i < 4; i++ {
+		failure := &networkserver.MICFailure{
+			ReceivedAt:  time.Unix(int64(i), 0).UTC(),
+			DevAddr:     devAddr,
+			FCnt:        uint32(i),
+			RawPayload:  []byte{0x40, byte(i), 0x01, 0x02, 0x03, 0x04},
+			ReceivedMIC: []byte{0x01, 0x02, 0x03, 0x04},
+			GatewayIDs:  []string{"gtw1"},
+			Candidates: []*networkserver.MICFailureCandidate{{
+				ApplicationID:  "app1",
+				DeviceID:       "dev1",
+				LoRaWANVersion: ttnpb.MACVersion_MAC_V1_0_3,
+				FCnt:           uint32(i),
+				ComputedMIC:    []byte{byte(i), 0x02, 0x03, 0x04},
+			}},
+		}
+		if !a.So(h.Add(ctx, devAddr, failure, 3), should.BeNil) {
+			t.FailNow()
+		}
+		added = append([]*networkserver.MICFailure{failure}, added...)
+	}
+
+	failures, err = h.Range(ctx, devAddr)
+	a.So(err, should.BeNil)
+	a.So(failures, should.Resemble, added[:3])
+
+	failures, err = h.Range(ctx, types.DevAddr{0x26, 0x01, 0x42, 0x43})
+	a.So(err, should.BeNil)
+	a.So(failures, should.BeEmpty)
+}
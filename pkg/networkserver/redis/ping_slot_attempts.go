@@ -0,0 +1,60 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// PingSlotAttempts is an implementation of networkserver.PingSlotAttempts.
+// The attempts of an end device are stored in a hash, with the FCnt of the application downlink as field.
+type PingSlotAttempts struct {
+	Redis *ttnredis.Client
+}
+
+func (a *PingSlotAttempts) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return UIDKey(a.Redis, unique.ID(ctx, ids))
+}
+
+// Increment implements networkserver.PingSlotAttempts.
+func (a *PingSlotAttempts) Increment(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fCnt uint32, ttl time.Duration,
+) (int, error) {
+	k := a.key(ctx, ids)
+	var incr *redis.IntCmd
+	if _, err := a.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		incr = p.HIncrBy(ctx, k, strconv.FormatUint(uint64(fCnt), 10), 1)
+		p.PExpire(ctx, k, ttl)
+		return nil
+	}); err != nil {
+		return 0, ttnredis.ConvertError(err)
+	}
+	return int(incr.Val()), nil
+}
+
+// Clear implements networkserver.PingSlotAttempts.
+func (a *PingSlotAttempts) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := a.Redis.Del(ctx, a.key(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/redis/go-redis/v9"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+)
+
+// SessionHistory is an implementation of networkserver.SessionHistory.
+// The ended sessions of an end device are stored in a list, with the most recent session first.
+type SessionHistory struct {
+	Redis *ttnredis.Client
+}
+
+func (h *SessionHistory) key(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) string {
+	return UIDKey(h.Redis, unique.ID(ctx, ids))
+}
+
+// Add implements networkserver.SessionHistory.
+func (h *SessionHistory) Add(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, session *networkserver.SessionHistoryEntry, size int,
+) error {
+	b, err := json.Marshal(session)
+	if err != nil {
+		return err
+	}
+	k := h.key(ctx, ids)
+	if _, err := h.Redis.TxPipelined(ctx, func(p redis.Pipeliner) error {
+		p.LPush(ctx, k, b)
+		p.LTrim(ctx, k, 0, int64(size-1))
+		return nil
+	}); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// Range implements networkserver.SessionHistory.
+func (h *SessionHistory) Range(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers,
+) ([]*networkserver.SessionHistoryEntry, error) {
+	vs, err := h.Redis.LRange(ctx, h.key(ctx, ids), 0, -1).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	sessions := make([]*networkserver.SessionHistoryEntry, 0, len(vs))
+	for _, v := range vs {
+		session := &networkserver.SessionHistoryEntry{}
+		if err := json.Unmarshal([]byte(v), session); err != nil {
+			return nil, errDatabaseCorruption.WithCause(err)
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// Clear implements networkserver.SessionHistory.
+func (h *SessionHistory) Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error {
+	if err := h.Redis.Del(ctx, h.key(ctx, ids)).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package redis_test
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestSessionHistory(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "redis_test")
+	defer flush()
+	defer cl.Close()
+
+	h := &redis.SessionHistory{Redis: cl}
+
+	ids := &ttnpb.EndDeviceIdentifiers{
+		ApplicationIds: &ttnpb.ApplicationIdentifiers{
+			ApplicationId: "app1",
+		},
+		DeviceId: "dev1",
+	}
+
+	sessions, err := h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(sessions, should.BeEmpty)
+
+	var added []*networkserver.SessionHistoryEntry
+	for i := 0; i < 4; i++ {
+		startedAt := time.Unix(int64(i), 0).UTC()
+		endedAt := time.Unix(int64(i+1), 0).UTC()
+		session := &networkserver.SessionHistoryEntry{
+			DevAddr:       types.DevAddr{0x26, 0x01, 0x00, byte(i)},
+			SessionKeyID:  []byte{byte(i)},
+			StartedAt:     &startedAt,
+			EndedAt:       &endedAt,
+			EndReason:     networkserver.SessionEndReasonJoin,
+			LastFCntUp:    uint32(i * 10),
+			LastNFCntDown: uint32(i),
+			LastAFCntDown: uint32(i * 2),
+		}
+		if !a.So(h.Add(ctx, ids, session, 3), should.BeNil) {
+			t.FailNow()
+		}
+		added = append([]*networkserver.SessionHistoryEntry{session}, added...)
+	}
+
+	sessions, err = h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(sessions, should.Resemble, added[:3])
+
+	a.So(h.Clear(ctx, ids), should.BeNil)
+	sessions, err = h.Range(ctx, ids)
+	a.So(err, should.BeNil)
+	a.So(sessions, should.BeEmpty)
+}
@@ -20,6 +20,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/internal/registry"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"google.golang.org/protobuf/proto"
@@ -50,6 +51,135 @@ type DeviceRegistry interface {
 	) ([]*ttnpb.EndDeviceIdentifiers, error)
 }
 
+// DeviceStatus is the status of an end device as reported in a DevStatusAns.
+type DeviceStatus struct {
+	ReceivedAt        time.Time        `json:"received_at"`
+	PowerState        ttnpb.PowerState `json:"power_state"`
+	BatteryPercentage *float32         `json:"battery_percentage,omitempty"`
+	DownlinkMargin    int32            `json:"downlink_margin"`
+}
+
+// DeviceStatusHistory stores the most recent device statuses of end devices.
+type DeviceStatusHistory interface {
+	// Add adds the status of the end device to the history, and keeps at most size statuses.
+	Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, status *DeviceStatus, size int) error
+	// Range returns the statuses of the end device, the most recent first.
+	Range(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*DeviceStatus, error)
+	// Clear removes the statuses of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// SessionHistoryEntry is a session of an end device.
+type SessionHistoryEntry struct {
+	DevAddr       types.DevAddr    `json:"dev_addr"`
+	SessionKeyID  []byte           `json:"session_key_id,omitempty"`
+	StartedAt     *time.Time       `json:"started_at,omitempty"`
+	EndedAt       *time.Time       `json:"ended_at,omitempty"`
+	EndReason     SessionEndReason `json:"end_reason,omitempty"`
+	LastFCntUp    uint32           `json:"last_f_cnt_up"`
+	LastNFCntDown uint32           `json:"last_n_f_cnt_down"`
+	LastAFCntDown uint32           `json:"last_a_f_cnt_down"`
+}
+
+// SessionHistory stores the most recent ended sessions of end devices.
+type SessionHistory interface {
+	// Add adds the ended session of the end device to the history, and keeps at most size sessions.
+	Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, session *SessionHistoryEntry, size int) error
+	// Range returns the ended sessions of the end device, the most recent first.
+	Range(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]*SessionHistoryEntry, error)
+	// Clear removes the ended sessions of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// PingSlotAttempts counts the attempts to schedule class B application downlinks in ping slots.
+type PingSlotAttempts interface {
+	// Increment increments the number of attempts of the application downlink with the given FCnt,
+	// and returns the number of attempts. The attempts of the end device expire after ttl.
+	Increment(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, fCnt uint32, ttl time.Duration) (int, error)
+	// Clear removes the attempts of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// DownlinkFailures counts the consecutive failed downlinks of end devices.
+type DownlinkFailures interface {
+	// Get returns the number of consecutive failed downlinks of the end device.
+	Get(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) (int, error)
+	// Increment increments the number of consecutive failed downlinks of the end device,
+	// and returns the number of failures. The failures of the end device expire after ttl.
+	Increment(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, ttl time.Duration) (int, error)
+	// Clear removes the failures of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// ConfirmedDownlinkOutcomes stores whether the most recent confirmed downlinks of end devices were acknowledged.
+type ConfirmedDownlinkOutcomes interface {
+	// Add adds the outcome of a confirmed downlink of the end device, keeps at most size outcomes,
+	// and returns the outcomes, the most recent first. The outcomes of the end device expire after ttl.
+	Add(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, acked bool, size int, ttl time.Duration) ([]bool, error)
+	// Range returns the outcomes of the end device, the most recent first.
+	Range(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) ([]bool, error)
+	// Clear removes the outcomes of the end device.
+	Clear(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) error
+}
+
+// MICFailureCandidate is an end device whose FNwkSIntKey did not match the MIC of an uplink.
+type MICFailureCandidate struct {
+	ApplicationID  string           `json:"application_id"`
+	DeviceID       string           `json:"device_id"`
+	IsPending      bool             `json:"is_pending,omitempty"`
+	LoRaWANVersion ttnpb.MACVersion `json:"lorawan_version"`
+	FCnt           uint32           `json:"f_cnt"`
+	// ComputedMIC is the MIC computed with the FNwkSIntKey of the end device. For LoRaWAN 1.1 end devices,
+	// it contains the 2 bytes of the cmacF that are compared with the last 2 bytes of the received MIC.
+	ComputedMIC []byte `json:"computed_mic,omitempty"`
+}
+
+// MICFailure is a data uplink that was dropped because its MIC did not match any end device with its DevAddr.
+type MICFailure struct {
+	ReceivedAt  time.Time              `json:"received_at"`
+	DevAddr     types.DevAddr          `json:"dev_addr"`
+	FCnt        uint32                 `json:"f_cnt"`
+	RawPayload  []byte                 `json:"raw_payload"`
+	ReceivedMIC []byte                 `json:"received_mic"`
+	GatewayIDs  []string               `json:"gateway_ids,omitempty"`
+	Candidates  []*MICFailureCandidate `json:"candidates"`
+}
+
+// MICFailureHistory stores the most recent data uplinks that were dropped because of a MIC mismatch per DevAddr.
+type MICFailureHistory interface {
+	// Add adds the MIC failure to the history of the DevAddr, and keeps at most size failures.
+	Add(ctx context.Context, devAddr types.DevAddr, failure *MICFailure, size int) error
+	// Range returns the MIC failures of the DevAddr, the most recent first.
+	Range(ctx context.Context, devAddr types.DevAddr) ([]*MICFailure, error)
+}
+
+// DevAddrBlockRegistry stores the device address blocks of the cluster and the number of device addresses
+// allocated from the blocks.
+type DevAddrBlockRegistry interface {
+	// Range returns the device address blocks.
+	Range(ctx context.Context) ([]*DevAddrBlock, error)
+	// Set creates or updates the device address block.
+	Set(ctx context.Context, block *DevAddrBlock) error
+	// Delete removes the device address block and its number of allocations.
+	Delete(ctx context.Context, id string) error
+	// AddAllocation increments the number of device addresses allocated from the device address block.
+	AddAllocation(ctx context.Context, id string) error
+	// Allocations returns the number of device addresses allocated by device address block ID.
+	Allocations(ctx context.Context) (map[string]uint64, error)
+}
+
+// GatewayMaintenanceRegistry is a registry of the maintenance windows of gateways.
+type GatewayMaintenanceRegistry interface {
+	// Get returns the maintenance windows of the gateway.
+	Get(ctx context.Context, ids *ttnpb.GatewayIdentifiers) (*GatewayMaintenance, error)
+	// Set creates or updates the maintenance windows of the gateway.
+	Set(ctx context.Context, ids *ttnpb.GatewayIdentifiers, maintenance *GatewayMaintenance) error
+	// Delete removes the maintenance windows of the gateway.
+	Delete(ctx context.Context, ids *ttnpb.GatewayIdentifiers) error
+	// Range returns the maintenance windows of all gateways by gateway UID.
+	Range(ctx context.Context) (map[string]*GatewayMaintenance, error)
+}
+
 var errDeviceExists = errors.DefineAlreadyExists("device_exists", "device already exists")
 
 // CreateDevice creates device dev in r.
@@ -0,0 +1,78 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"context"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/networkserver/internal/time"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// SessionEndReason is the reason why a session of an end device ended.
+type SessionEndReason string
+
+const (
+	// SessionEndReasonJoin indicates that the session was replaced by the session of a (re)join.
+	SessionEndReasonJoin SessionEndReason = "join"
+	// SessionEndReasonFCntReset indicates that the end device reset its frame counters.
+	SessionEndReasonFCntReset SessionEndReason = "f_cnt_reset"
+	// SessionEndReasonReset indicates that the end device was reset to factory defaults.
+	SessionEndReasonReset SessionEndReason = "reset"
+)
+
+// newSessionHistoryEntry returns the session history entry of the given session.
+// If endedAt is zero, the session has not ended.
+func newSessionHistoryEntry(
+	session *ttnpb.Session, endedAt time.Time, reason SessionEndReason,
+) *SessionHistoryEntry {
+	entry := &SessionHistoryEntry{
+		DevAddr:       types.MustDevAddr(session.DevAddr).OrZero(),
+		SessionKeyID:  session.GetKeys().GetSessionKeyId(),
+		StartedAt:     ttnpb.StdTime(session.StartedAt),
+		LastFCntUp:    session.LastFCntUp,
+		LastNFCntDown: session.LastNFCntDown,
+		LastAFCntDown: session.LastAFCntDown,
+	}
+	if !endedAt.IsZero() {
+		entry.EndedAt = &endedAt
+		entry.EndReason = reason
+	}
+	return entry
+}
+
+// recordEndedSession adds the ended session to the session history of the end device.
+func (ns *NetworkServer) recordEndedSession(
+	ctx context.Context, ids *ttnpb.EndDeviceIdentifiers, entry *SessionHistoryEntry,
+) {
+	if ns.sessionHistory == nil || ns.sessionHistorySize <= 0 || entry == nil {
+		return
+	}
+	if err := ns.sessionHistory.Add(ctx, ids, entry, ns.sessionHistorySize); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to add ended session to history")
+	}
+}
+
+// clearSessionHistory removes the session history of the end device.
+func (ns *NetworkServer) clearSessionHistory(ctx context.Context, ids *ttnpb.EndDeviceIdentifiers) {
+	if ns.sessionHistory == nil {
+		return
+	}
+	if err := ns.sessionHistory.Clear(ctx, ids); err != nil {
+		log.FromContext(ctx).WithError(err).Warn("Failed to clear session history")
+	}
+}
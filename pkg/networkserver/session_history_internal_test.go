@@ -0,0 +1,63 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package networkserver
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestNewSessionHistoryEntry(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	startedAt := time.Unix(42, 0).UTC()
+	endedAt := time.Unix(84, 0).UTC()
+	session := &ttnpb.Session{
+		DevAddr: types.DevAddr{0x26, 0x01, 0x42, 0x42}.Bytes(),
+		Keys: &ttnpb.SessionKeys{
+			SessionKeyId: []byte{0x01, 0x02},
+		},
+		LastFCntUp:    42,
+		LastNFCntDown: 4,
+		LastAFCntDown: 2,
+		StartedAt:     timestamppb.New(startedAt),
+	}
+
+	a.So(newSessionHistoryEntry(session, time.Time{}, SessionEndReasonJoin), should.Resemble, &SessionHistoryEntry{
+		DevAddr:       types.DevAddr{0x26, 0x01, 0x42, 0x42},
+		SessionKeyID:  []byte{0x01, 0x02},
+		StartedAt:     &startedAt,
+		LastFCntUp:    42,
+		LastNFCntDown: 4,
+		LastAFCntDown: 2,
+	})
+	a.So(newSessionHistoryEntry(session, endedAt, SessionEndReasonFCntReset), should.Resemble, &SessionHistoryEntry{
+		DevAddr:       types.DevAddr{0x26, 0x01, 0x42, 0x42},
+		SessionKeyID:  []byte{0x01, 0x02},
+		StartedAt:     &startedAt,
+		EndedAt:       &endedAt,
+		EndReason:     SessionEndReasonFCntReset,
+		LastFCntUp:    42,
+		LastNFCntDown: 4,
+		LastAFCntDown: 2,
+	})
+}
@@ -0,0 +1,135 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package noc implements the Network Operations Center summary, which aggregates the state and
+// traffic of the cluster from events.
+package noc
+
+import (
+	"context"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/rpclog"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"google.golang.org/grpc"
+)
+
+// Config represents the Network Operations Center configuration.
+type Config struct {
+	Enable      bool          `name:"enable" description:"Enable the Network Operations Center summary"`
+	Cluster     string        `name:"cluster" description:"Name of the cluster in the summary"`
+	TopErrors   int           `name:"top-errors" description:"Number of most frequent error reasons in the summary"`
+	RateWindow  time.Duration `name:"rate-window" description:"Window over which the uplink and downlink rates are computed"`
+	ErrorWindow time.Duration `name:"error-window" description:"Window over which the error reasons are counted"`
+}
+
+var errWindow = errors.DefineInvalidArgument("window", "window `{window}` is shorter than `{min}`")
+
+// NOC implements the Network Operations Center.
+//
+// The NOC subscribes to the events of the cluster and keeps incremental counters, so that the
+// summary does not need to be computed from the metrics.
+type NOC struct {
+	ttnpb.UnimplementedNocServer
+
+	*component.Component
+	ctx context.Context
+
+	config     *Config
+	aggregator *aggregator
+}
+
+const eventsBufferSize = 1 << 10
+
+// New returns a new *NOC.
+func New(c *component.Component, conf *Config) (*NOC, error) {
+	for _, window := range []time.Duration{conf.RateWindow, conf.ErrorWindow} {
+		if window < bucketDuration {
+			return nil, errWindow.WithAttributes("window", window, "min", bucketDuration)
+		}
+	}
+	ctx := log.NewContextWithField(c.Context(), "namespace", "noc")
+	noc := &NOC{
+		Component:  c,
+		ctx:        ctx,
+		config:     conf,
+		aggregator: newAggregator(conf.RateWindow, conf.ErrorWindow),
+	}
+	c.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "noc_aggregate_events",
+		Func:    noc.aggregateEvents,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	c.RegisterGRPC(noc)
+	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.Noc", rpclog.NamespaceHook, rpclog.UnaryNamespaceHook("noc"))
+	return noc, nil
+}
+
+// Context returns the context of the NOC.
+func (noc *NOC) Context() context.Context {
+	return noc.ctx
+}
+
+// Roles returns the roles that the NOC fulfills.
+func (*NOC) Roles() []ttnpb.ClusterRole {
+	return nil
+}
+
+// RegisterServices registers services provided by noc at s.
+func (noc *NOC) RegisterServices(s *grpc.Server) {
+	ttnpb.RegisterNocServer(s, noc)
+}
+
+// RegisterHandlers registers gRPC handlers.
+func (noc *NOC) RegisterHandlers(s *runtime.ServeMux, conn *grpc.ClientConn) {
+	ttnpb.RegisterNocHandler(noc.Context(), s, conn) //nolint:errcheck
+}
+
+func (noc *NOC) aggregateEvents(ctx context.Context) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	ch := make(events.Channel, eventsBufferSize)
+	names := append(append(make([]string, 0, len(trafficEventNames)+len(errorEventNames)),
+		trafficEventNames...), errorEventNames...)
+	if err := events.Subscribe(ctx, names, nil, ch); err != nil {
+		return err
+	}
+	ticker := time.NewTicker(bucketDuration)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case evt := <-ch:
+			noc.aggregator.handle(evt)
+		case now := <-ticker.C:
+			noc.aggregator.compact(now)
+		}
+	}
+}
+
+// Summary returns the current summary of the cluster.
+func (noc *NOC) Summary() *ttnpb.NocSummary {
+	summary := noc.aggregator.summary(time.Now(), noc.config.TopErrors)
+	summary.Cluster = noc.config.Cluster
+	return summary
+}
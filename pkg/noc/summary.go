@@ -0,0 +1,229 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noc
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	bucketDuration = time.Minute
+	activeHour     = time.Hour
+	activeDay      = 24 * time.Hour
+)
+
+var (
+	trafficEventNames = []string{
+		"gs.gateway.connect",
+		"gs.gateway.disconnect",
+		"ns.up.data.process",
+		"ns.up.join.process",
+		"ns.down.transmission.success",
+	}
+	errorEventNames = []string{
+		"gs.up.drop",
+		"gs.down.schedule.fail",
+		"gs.down.tx.fail",
+		"ns.up.data.drop",
+		"ns.up.join.drop",
+		"ns.up.join.cluster.fail",
+		"ns.up.join.interop.fail",
+		"ns.down.data.schedule.fail",
+		"ns.down.join.schedule.fail",
+		"ns.down.transmission.fail",
+	}
+)
+
+// counter counts occurrences in buckets of bucketDuration.
+type counter map[int64]uint64
+
+func (c counter) add(t time.Time) {
+	c[t.Truncate(bucketDuration).Unix()]++
+}
+
+// since returns the number of occurrences in the buckets that end after t.
+func (c counter) since(t time.Time) (n uint64) {
+	from := t.Truncate(bucketDuration).Unix()
+	for bucket, v := range c {
+		if bucket >= from {
+			n += v
+		}
+	}
+	return n
+}
+
+// prune removes the buckets that end before t.
+func (c counter) prune(t time.Time) {
+	from := t.Truncate(bucketDuration).Unix()
+	for bucket := range c {
+		if bucket < from {
+			delete(c, bucket)
+		}
+	}
+}
+
+// aggregator aggregates events into a summary.
+// The handlers only update counters; the device activity is counted when the aggregator is compacted.
+type aggregator struct {
+	rateWindow, errorWindow time.Duration
+
+	mu sync.RWMutex
+
+	gateways  map[string]struct{}
+	devices   map[string]time.Time
+	uplinks   counter
+	downlinks counter
+	errors    map[string]counter
+
+	activeDevices *ttnpb.NocSummary_ActiveDevices
+}
+
+func newAggregator(rateWindow, errorWindow time.Duration) *aggregator {
+	return &aggregator{
+		rateWindow:    rateWindow,
+		errorWindow:   errorWindow,
+		activeDevices: &ttnpb.NocSummary_ActiveDevices{},
+		gateways:      make(map[string]struct{}),
+		devices:       make(map[string]time.Time),
+		uplinks:       make(counter),
+		downlinks:     make(counter),
+		errors:        make(map[string]counter),
+	}
+}
+
+func errorName(data any) (string, bool) {
+	switch data := data.(type) {
+	case *ttnpb.ErrorDetails:
+		ttnErr, ok := errors.From(ttnpb.ErrorDetailsFromProto(data))
+		if !ok {
+			return "", false
+		}
+		return ttnErr.FullName(), true
+	case error:
+		if ttnErr, ok := errors.From(data); ok {
+			return ttnErr.FullName(), true
+		}
+	}
+	return "", false
+}
+
+func (a *aggregator) handle(evt events.Event) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	ctx, t := evt.Context(), evt.Time()
+	switch evt.Name() {
+	case "gs.gateway.connect":
+		for _, ids := range evt.Identifiers() {
+			if gtwIDs := ids.GetGatewayIds(); gtwIDs != nil {
+				a.gateways[unique.ID(ctx, gtwIDs)] = struct{}{}
+			}
+		}
+		return
+	case "gs.gateway.disconnect":
+		for _, ids := range evt.Identifiers() {
+			if gtwIDs := ids.GetGatewayIds(); gtwIDs != nil {
+				delete(a.gateways, unique.ID(ctx, gtwIDs))
+			}
+		}
+		return
+	case "ns.up.data.process", "ns.up.join.process":
+		a.uplinks.add(t)
+		for _, ids := range evt.Identifiers() {
+			if devIDs := ids.GetDeviceIds(); devIDs != nil {
+				uid := unique.ID(ctx, devIDs)
+				if t.After(a.devices[uid]) {
+					a.devices[uid] = t
+				}
+			}
+		}
+		return
+	case "ns.down.transmission.success":
+		a.downlinks.add(t)
+		return
+	}
+	name, ok := errorName(evt.Data())
+	if !ok {
+		return
+	}
+	c, ok := a.errors[name]
+	if !ok {
+		c = make(counter)
+		a.errors[name] = c
+	}
+	c.add(t)
+}
+
+// compact removes the state that is no longer relevant at now and counts the active end devices.
+func (a *aggregator) compact(now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	active := &ttnpb.NocSummary_ActiveDevices{}
+	for uid, lastSeen := range a.devices {
+		switch age := now.Sub(lastSeen); {
+		case age > activeDay:
+			delete(a.devices, uid)
+			continue
+		case age <= activeHour:
+			active.LastHour++
+		}
+		active.LastDay++
+	}
+	a.activeDevices = active
+	a.uplinks.prune(now.Add(-a.rateWindow))
+	a.downlinks.prune(now.Add(-a.rateWindow))
+	for name, c := range a.errors {
+		c.prune(now.Add(-a.errorWindow))
+		if len(c) == 0 {
+			delete(a.errors, name)
+		}
+	}
+}
+
+// summary returns the summary at now with up to topErrors error reasons.
+func (a *aggregator) summary(now time.Time, topErrors int) *ttnpb.NocSummary {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	reasons := make([]*ttnpb.NocSummary_ErrorReason, 0, len(a.errors))
+	for name, c := range a.errors {
+		if n := c.since(now.Add(-a.errorWindow)); n > 0 {
+			reasons = append(reasons, &ttnpb.NocSummary_ErrorReason{Name: name, Count: n})
+		}
+	}
+	sort.Slice(reasons, func(i, j int) bool {
+		if reasons[i].Count != reasons[j].Count {
+			return reasons[i].Count > reasons[j].Count
+		}
+		return reasons[i].Name < reasons[j].Name
+	})
+	if len(reasons) > topErrors {
+		reasons = reasons[:topErrors]
+	}
+	return &ttnpb.NocSummary{
+		ConnectedGateways: uint32(len(a.gateways)),
+		ActiveDevices:     ttnpb.Clone(a.activeDevices),
+		UplinkRate:        float64(a.uplinks.since(now.Add(-a.rateWindow))) / a.rateWindow.Seconds(),
+		DownlinkRate:      float64(a.downlinks.since(now.Add(-a.rateWindow))) / a.rateWindow.Seconds(),
+		TopErrors:         reasons,
+		ComputedAt:        timestamppb.New(now),
+	}
+}
@@ -0,0 +1,92 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package noc
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+var (
+	errTest      = errors.DefineUnavailable("test", "test")
+	errOtherTest = errors.DefineAborted("other_test", "other test")
+)
+
+func TestAggregator(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	gtwIDs := &ttnpb.GatewayIdentifiers{GatewayId: "gtw1"}
+	devIDs := func(id string) *ttnpb.EndDeviceIdentifiers {
+		return &ttnpb.EndDeviceIdentifiers{
+			ApplicationIds: &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"},
+			DeviceId:       id,
+		}
+	}
+
+	agg := newAggregator(5*time.Minute, time.Hour)
+	for _, evt := range []events.Event{
+		events.New(ctx, "gs.gateway.connect", "", events.WithIdentifiers(gtwIDs)),
+		events.New(ctx, "gs.gateway.connect", "", events.WithIdentifiers(&ttnpb.GatewayIdentifiers{GatewayId: "gtw2"})),
+		events.New(ctx, "gs.gateway.disconnect", "", events.WithIdentifiers(gtwIDs)),
+		events.New(ctx, "ns.up.join.process", "", events.WithIdentifiers(devIDs("dev1"))),
+		events.New(ctx, "ns.up.data.process", "", events.WithIdentifiers(devIDs("dev1"))),
+		events.New(ctx, "ns.up.data.process", "", events.WithIdentifiers(devIDs("dev2"))),
+		events.New(ctx, "ns.down.transmission.success", "", events.WithIdentifiers(devIDs("dev1"))),
+		events.New(ctx, "ns.up.data.drop", "", events.WithData(errTest.New())),
+		events.New(ctx, "ns.up.data.drop", "", events.WithData(errTest.New())),
+		events.New(ctx, "ns.down.transmission.fail", "", events.WithData(ttnpb.ErrorDetailsToProto(errOtherTest.New()))),
+		events.New(ctx, "ns.down.transmission.fail", "", events.WithData(errOtherTest.New())),
+		events.New(ctx, "ns.down.data.schedule.fail", "", events.WithData(errTest.New())),
+		events.New(ctx, "ns.up.join.drop", ""),
+	} {
+		agg.handle(evt)
+	}
+
+	now := time.Now()
+	agg.compact(now)
+	summary := agg.summary(now, 1)
+	a.So(summary.ConnectedGateways, should.Equal, 1)
+	a.So(summary.ActiveDevices, should.Resemble, &ttnpb.NocSummary_ActiveDevices{LastHour: 2, LastDay: 2})
+	a.So(summary.UplinkRate, should.AlmostEqual, 3/agg.rateWindow.Seconds())
+	a.So(summary.DownlinkRate, should.AlmostEqual, 1/agg.rateWindow.Seconds())
+	a.So(summary.TopErrors, should.Resemble, []*ttnpb.NocSummary_ErrorReason{
+		{Name: "pkg/noc:test", Count: 3},
+	})
+	a.So(agg.summary(now, 3).TopErrors, should.Resemble, []*ttnpb.NocSummary_ErrorReason{
+		{Name: "pkg/noc:test", Count: 3},
+		{Name: "pkg/noc:other_test", Count: 2},
+	})
+
+	now = now.Add(2 * time.Hour)
+	agg.compact(now)
+	summary = agg.summary(now, 3)
+	a.So(summary.ActiveDevices, should.Resemble, &ttnpb.NocSummary_ActiveDevices{LastHour: 0, LastDay: 2})
+	a.So(summary.UplinkRate, should.Equal, 0)
+	a.So(summary.DownlinkRate, should.Equal, 0)
+	a.So(summary.TopErrors, should.BeEmpty)
+
+	now = now.Add(24 * time.Hour)
+	agg.compact(now)
+	a.So(agg.summary(now, 3).ActiveDevices, should.Resemble, &ttnpb.NocSummary_ActiveDevices{})
+	a.So(agg.devices, should.BeEmpty)
+	a.So(agg.errors, should.BeEmpty)
+}
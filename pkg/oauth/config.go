@@ -15,6 +15,8 @@
 package oauth
 
 import (
+	"time"
+
 	"go.thethings.network/lorawan-stack/v3/pkg/webui"
 )
 
@@ -39,9 +41,77 @@ type FrontendConfig struct {
 	ConsoleURL             string `json:"console_url" name:"console-url" description:"The URL that points to the root of the Console"`
 }
 
+// ExternalAccountProviderConfig is the configuration of an external identity provider.
+type ExternalAccountProviderConfig struct {
+	ClientID     string `name:"client-id" description:"The OAuth client ID registered at the external identity provider"`
+	ClientSecret string `name:"client-secret" description:"The OAuth client secret registered at the external identity provider"`
+}
+
+// Enabled returns whether users can link accounts of the external identity provider.
+func (c ExternalAccountProviderConfig) Enabled() bool {
+	return c.ClientID != ""
+}
+
+// ExternalAccountsConfig is the configuration of the external identity providers that users
+// can link to their account.
+type ExternalAccountsConfig struct {
+	GitHub ExternalAccountProviderConfig `name:"github"`
+	Google ExternalAccountProviderConfig `name:"google"`
+	OIDC   OIDCProviderConfig            `name:"oidc"`
+}
+
+// OIDCClaimsConfig maps the claims of the OpenID Connect user info response to user attributes.
+type OIDCClaimsConfig struct {
+	Subject       string `name:"subject" description:"Claim that contains the identifier of the account"`
+	Email         string `name:"email" description:"Claim that contains the email address of the account"`
+	EmailVerified string `name:"email-verified" description:"Claim that contains whether the email address is verified"`
+	Name          string `name:"name" description:"Claim that contains the name of the account"`
+	Groups        string `name:"groups" description:"Claim that contains the groups of the account"`
+}
+
+// OIDCProvisioningConfig is the configuration of just-in-time provisioning of users that login
+// with an OpenID Connect provider account that is not linked to a user yet.
+type OIDCProvisioningConfig struct {
+	Enabled              bool     `name:"enabled" description:"Create users for unlinked accounts on login"`
+	AllowedEmailDomains  []string `name:"allowed-email-domains" description:"Only provision users with email addresses in these domains (empty allows all)"`
+	RequireVerifiedEmail bool     `name:"require-verified-email" description:"Only provision users with a verified email address"`
+}
+
+// OIDCProviderConfig is the configuration of a generic OpenID Connect identity provider,
+// such as Login.gov, Keycloak or Azure AD.
+type OIDCProviderConfig struct {
+	ExternalAccountProviderConfig `name:",squash"`
+	AuthURL                       string                 `name:"auth-url" description:"Authorization endpoint of the identity provider"`
+	TokenURL                      string                 `name:"token-url" description:"Token endpoint of the identity provider"`
+	UserInfoURL                   string                 `name:"user-info-url" description:"User info endpoint of the identity provider"`
+	Scopes                        []string               `name:"scopes" description:"Scopes to request from the identity provider"`
+	Claims                        OIDCClaimsConfig       `name:"claims"`
+	AdminGroups                   []string               `name:"admin-groups" description:"Members of these groups are admin (empty does not change admin status)"`
+	Provisioning                  OIDCProvisioningConfig `name:"provisioning"`
+}
+
+// Enabled returns whether users can link accounts of the OpenID Connect provider.
+func (c OIDCProviderConfig) Enabled() bool {
+	return c.ClientID != "" && c.AuthURL != "" && c.TokenURL != "" && c.UserInfoURL != ""
+}
+
+// OpenIDConnectConfig is the configuration of the OpenID Connect provider. The canonical URL of the OAuth UI is the
+// issuer. The provider is enabled when signing keys are configured.
+type OpenIDConnectConfig struct {
+	SigningKeyFiles []string      `name:"signing-key-files" description:"Locations of the PEM encoded private keys that sign ID tokens. The first key signs, the other keys are published to verify ID tokens signed before rotation"`
+	IDTokenTTL      time.Duration `name:"id-token-ttl" description:"Lifetime of ID tokens"`
+}
+
+// Enabled returns whether the OpenID Connect provider is enabled.
+func (c OpenIDConnectConfig) Enabled() bool {
+	return len(c.SigningKeyFiles) > 0
+}
+
 // Config is the configuration for the OAuth server.
 type Config struct {
-	Mount       string   `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
-	UI          UIConfig `name:"ui"`
-	CSRFAuthKey []byte   `name:"-"`
+	Mount            string                 `name:"mount" description:"Path on the server where the Account application and OAuth services will be served"`
+	UI               UIConfig               `name:"ui"`
+	CSRFAuthKey      []byte                 `name:"-"`
+	ExternalAccounts ExternalAccountsConfig `name:"external-accounts"`
+	OpenIDConnect    OpenIDConnectConfig    `name:"openid-connect"`
 }
@@ -33,6 +33,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
 	"go.thethings.network/lorawan-stack/v3/pkg/webui"
+	"golang.org/x/exp/slices"
 )
 
 var tokenHashSettings auth.HashValidator = pbkdf2.PBKDF2{
@@ -88,10 +89,17 @@ func (s *server) Authorize(authorizePage http.Handler) http.HandlerFunc {
 			s.output(w, r, resp)
 			return
 		}
-		ar.UserData = userData{UserSessionIdentifiers: &ttnpb.UserSessionIdentifiers{
+		data := userData{UserSessionIdentifiers: &ttnpb.UserSessionIdentifiers{
 			UserIds:   session.GetUserIds(),
 			SessionId: session.SessionId,
 		}}
+		if s.oidcEnabled() {
+			data.Scopes = oidcScopes(ar.Scope)
+			if len(data.Scopes) > 0 {
+				data.Nonce = r.Form.Get("nonce")
+			}
+		}
+		ar.UserData = data
 		client := ar.Client.(osinClient).Client
 		if !clientHasGrant(client, ttnpb.GrantType_GRANT_AUTHORIZATION_CODE) {
 			resp.InternalError = errClientMissingGrant.WithAttributes("grant", "authorization_code")
@@ -296,6 +304,15 @@ func (s *server) Token(w http.ResponseWriter, r *http.Request) {
 	}
 	oauth2.FinishAccessRequest(resp, r, ar)
 	delete(resp.Output, "scope")
+	if !resp.IsError && ar.Type == osin.AUTHORIZATION_CODE && s.oidcEnabled() &&
+		slices.Contains(ar.AuthorizeData.UserData.(userData).Scopes, oidcScopeOpenID) {
+		idToken, err := s.idToken(r.Context(), ar.AuthorizeData)
+		if err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		resp.Output["id_token"] = idToken
+	}
 	s.output(w, r, resp)
 }
 
@@ -0,0 +1,366 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/openshift/osin"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"golang.org/x/exp/slices"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+const (
+	oidcScopeOpenID  = "openid"
+	oidcScopeProfile = "profile"
+	oidcScopeEmail   = "email"
+)
+
+const defaultIDTokenTTL = time.Hour
+
+// oidcScopes returns the OpenID Connect scopes in the given scope string.
+// It returns nil if the scope string does not contain the openid scope.
+func oidcScopes(scope string) []string {
+	var scopes []string
+	openID := false
+	for _, s := range strings.Fields(scope) {
+		switch s {
+		case oidcScopeOpenID:
+			openID = true
+		case oidcScopeProfile, oidcScopeEmail:
+		default:
+			continue
+		}
+		scopes = append(scopes, s)
+	}
+	if !openID {
+		return nil
+	}
+	return scopes
+}
+
+var (
+	errSigningKey          = errors.DefineInvalidArgument("signing_key", "invalid signing key `{file}`")
+	errSigningKeyAlgorithm = errors.DefineInvalidArgument(
+		"signing_key_algorithm", "unsupported algorithm of signing key `{file}`",
+	)
+	errInvalidAccessToken = errors.DefineUnauthenticated("invalid_access_token", "invalid or expired access token")
+)
+
+// signingAlgorithm returns the JWS algorithm of the given private key.
+func signingAlgorithm(key crypto.Signer) (jose.SignatureAlgorithm, bool) {
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return jose.RS256, true
+	case *ecdsa.PrivateKey:
+		switch k.Curve.Params().BitSize {
+		case 256:
+			return jose.ES256, true
+		case 384:
+			return jose.ES384, true
+		case 521:
+			return jose.ES512, true
+		}
+	case ed25519.PrivateKey:
+		return jose.EdDSA, true
+	}
+	return "", false
+}
+
+func parseSigningKey(b []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, errSigningKey.New()
+	}
+	if key, err := x509.ParsePKCS8PrivateKey(block.Bytes); err == nil {
+		if signer, ok := key.(crypto.Signer); ok {
+			return signer, nil
+		}
+		return nil, errSigningKey.New()
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	return x509.ParseECPrivateKey(block.Bytes)
+}
+
+// LoadSigningKeys loads the signing keys from the given files. The key ID is the JWK thumbprint of the public key.
+func LoadSigningKeys(files []string) ([]jose.JSONWebKey, error) {
+	keys := make([]jose.JSONWebKey, 0, len(files))
+	for _, file := range files {
+		b, err := os.ReadFile(file)
+		if err != nil {
+			return nil, errSigningKey.WithAttributes("file", file).WithCause(err)
+		}
+		signer, err := parseSigningKey(b)
+		if err != nil {
+			return nil, errSigningKey.WithAttributes("file", file).WithCause(err)
+		}
+		alg, ok := signingAlgorithm(signer)
+		if !ok {
+			return nil, errSigningKeyAlgorithm.WithAttributes("file", file)
+		}
+		key := jose.JSONWebKey{
+			Key:       signer,
+			Algorithm: string(alg),
+			Use:       "sig",
+		}
+		public := key.Public()
+		thumbprint, err := public.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, errSigningKey.WithAttributes("file", file).WithCause(err)
+		}
+		key.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+		keys = append(keys, key)
+	}
+	return keys, nil
+}
+
+func (s *server) oidcEnabled() bool { return len(s.signingKeys) > 0 }
+
+func (s *server) issuer(ctx context.Context) string {
+	return strings.TrimSuffix(s.configFromContext(ctx).UI.CanonicalURL, "/")
+}
+
+// profileClaims are the claims of the profile scope.
+type profileClaims struct {
+	Name              string `json:"name,omitempty"`
+	PreferredUsername string `json:"preferred_username"`
+	UpdatedAt         int64  `json:"updated_at,omitempty"`
+}
+
+// emailClaims are the claims of the email scope.
+type emailClaims struct {
+	Email         string `json:"email,omitempty"`
+	EmailVerified bool   `json:"email_verified"`
+}
+
+func userClaims(user *ttnpb.User, scopes []string) (*profileClaims, *emailClaims) {
+	var (
+		profile *profileClaims
+		email   *emailClaims
+	)
+	if slices.Contains(scopes, oidcScopeProfile) {
+		profile = &profileClaims{
+			Name:              user.GetName(),
+			PreferredUsername: user.GetIds().GetUserId(),
+		}
+		if updatedAt := ttnpb.StdTime(user.GetUpdatedAt()); updatedAt != nil {
+			profile.UpdatedAt = updatedAt.Unix()
+		}
+	}
+	if slices.Contains(scopes, oidcScopeEmail) && user.GetPrimaryEmailAddress() != "" {
+		email = &emailClaims{
+			Email:         user.GetPrimaryEmailAddress(),
+			EmailVerified: user.GetPrimaryEmailAddressValidatedAt() != nil,
+		}
+	}
+	return profile, email
+}
+
+var userClaimsFieldMask = []string{"name", "primary_email_address", "primary_email_address_validated_at"}
+
+// grantedScopes returns the OpenID Connect scopes that are granted with the given rights.
+// The profile and email scopes require the right to read user info.
+func grantedScopes(scopes []string, rights []ttnpb.Right) []string {
+	if ttnpb.RightsFrom(rights...).Implied().IncludesAll(ttnpb.Right_RIGHT_USER_INFO) {
+		return scopes
+	}
+	return nil
+}
+
+type idTokenClaims struct {
+	jwt.Claims
+	Nonce    string `json:"nonce,omitempty"`
+	AuthTime int64  `json:"auth_time,omitempty"`
+	*profileClaims
+	*emailClaims
+}
+
+// idToken returns the signed ID token for the given authorization.
+func (s *server) idToken(ctx context.Context, authorization *osin.AuthorizeData) (string, error) {
+	data := authorization.UserData.(userData)
+	userIDs := data.UserSessionIdentifiers.GetUserIds()
+	clientIDs := authorization.Client.(osinClient).GetIds()
+
+	now := s.now()
+	ttl := s.config.OpenIDConnect.IDTokenTTL
+	if ttl == 0 {
+		ttl = defaultIDTokenTTL
+	}
+	claims := idTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   s.issuer(ctx),
+			Subject:  userIDs.GetUserId(),
+			Audience: jwt.Audience{clientIDs.GetClientId()},
+			IssuedAt: jwt.NewNumericDate(now),
+			Expiry:   jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Nonce: data.Nonce,
+	}
+	if data.SessionId != "" {
+		session, err := s.store.GetSession(ctx, userIDs, data.SessionId)
+		if err != nil && !errors.IsNotFound(err) {
+			return "", err
+		}
+		if createdAt := ttnpb.StdTime(session.GetCreatedAt()); createdAt != nil {
+			claims.AuthTime = createdAt.Unix()
+		}
+	}
+	scopes := grantedScopes(data.Scopes, rightsFromScope(authorization.Scope))
+	if slices.Contains(scopes, oidcScopeProfile) || slices.Contains(scopes, oidcScopeEmail) {
+		user, err := s.store.GetUser(ctx, userIDs, userClaimsFieldMask)
+		if err != nil {
+			return "", err
+		}
+		claims.profileClaims, claims.emailClaims = userClaims(user, scopes)
+	}
+
+	key := s.signingKeys[0]
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: jose.SignatureAlgorithm(key.Algorithm), Key: key},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	if err != nil {
+		return "", err
+	}
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+type openIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// OpenIDConfiguration serves the OpenID Connect discovery document.
+func (s *server) OpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	issuer := s.issuer(r.Context())
+	algorithms := make([]string, 0, len(s.signingKeys))
+	for _, key := range s.signingKeys {
+		if !slices.Contains(algorithms, key.Algorithm) {
+			algorithms = append(algorithms, key.Algorithm)
+		}
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	webhandlers.JSON(w, r, openIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             issuer + "/authorize",
+		TokenEndpoint:                     issuer + "/token",
+		UserInfoEndpoint:                  issuer + "/userinfo",
+		JWKSURI:                           issuer + "/.well-known/jwks.json",
+		ScopesSupported:                   []string{oidcScopeOpenID, oidcScopeProfile, oidcScopeEmail},
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{"authorization_code", "refresh_token"},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  algorithms,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "auth_time", "nonce",
+			"name", "preferred_username", "updated_at", "email", "email_verified",
+		},
+	})
+}
+
+// JWKS serves the public keys that verify ID tokens.
+func (s *server) JWKS(w http.ResponseWriter, r *http.Request) {
+	keys := make([]jose.JSONWebKey, len(s.signingKeys))
+	for i, key := range s.signingKeys {
+		keys[i] = key.Public()
+	}
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	webhandlers.JSON(w, r, jose.JSONWebKeySet{Keys: keys})
+}
+
+type userInfo struct {
+	Subject string `json:"sub"`
+	*profileClaims
+	*emailClaims
+}
+
+// UserInfo serves the claims of the user that authorized the access token.
+// The profile and email claims are only returned if the access token has the right to read user info.
+func (s *server) UserInfo(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	unauthenticated := func() {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		webhandlers.Error(w, r, errInvalidAccessToken.New())
+	}
+	authType, authValue, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(authType, "Bearer") {
+		unauthenticated()
+		return
+	}
+	tokenType, id, key, err := auth.SplitToken(authValue)
+	if err != nil || tokenType != auth.AccessToken {
+		unauthenticated()
+		return
+	}
+	accessToken, err := s.store.GetAccessToken(ctx, id)
+	if err != nil {
+		if errors.IsNotFound(err) {
+			unauthenticated()
+			return
+		}
+		webhandlers.Error(w, r, err)
+		return
+	}
+	if valid, err := auth.Validate(accessToken.AccessToken, key); err != nil || !valid {
+		unauthenticated()
+		return
+	}
+	if expiresAt := ttnpb.StdTime(accessToken.ExpiresAt); expiresAt != nil && expiresAt.Before(s.now()) {
+		unauthenticated()
+		return
+	}
+	res := userInfo{Subject: accessToken.GetUserIds().GetUserId()}
+	if scopes := grantedScopes(
+		[]string{oidcScopeOpenID, oidcScopeProfile, oidcScopeEmail}, accessToken.Rights,
+	); len(scopes) > 0 {
+		user, err := s.store.GetUser(ctx, accessToken.GetUserIds(), userClaimsFieldMask)
+		if err != nil {
+			webhandlers.Error(w, r, err)
+			return
+		}
+		res.profileClaims, res.emailClaims = userClaims(user, scopes)
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	webhandlers.JSON(w, r, res)
+}
@@ -0,0 +1,276 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package oauth_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/pbkdf2"
+	"go.thethings.network/lorawan-stack/v3/pkg/component"
+	componenttest "go.thethings.network/lorawan-stack/v3/pkg/component/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/identityserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/oauth"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/webui"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+func writeSigningKey(t *testing.T) string {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(file, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: b}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestOpenIDConnect(t *testing.T) {
+	a, ctx := test.New(t)
+	store := &mockStore{}
+	c := componenttest.NewComponent(t, &component.Config{
+		ServiceBase: config.ServiceBase{
+			HTTP: config.HTTP{
+				Cookie: config.Cookie{
+					HashKey:  []byte("12345678123456781234567812345678"),
+					BlockKey: []byte("12345678123456781234567812345678"),
+				},
+			},
+		},
+	})
+	s, err := oauth.NewServer(c, store, oauth.Config{
+		Mount: "/oauth",
+		UI: oauth.UIConfig{
+			TemplateData: webui.TemplateData{
+				SiteName:     "The Things Network",
+				Title:        "OAuth",
+				CanonicalURL: "https://example.com/oauth",
+			},
+		},
+		OpenIDConnect: oauth.OpenIDConnectConfig{
+			SigningKeyFiles: []string{writeSigningKey(t), writeSigningKey(t)},
+		},
+	}, identityserver.GenerateCSPString)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.RegisterWeb(s)
+	componenttest.StartComponent(t, c)
+
+	serve := func(r *http.Request) *httptest.ResponseRecorder {
+		r.URL.Scheme, r.URL.Host = "http", r.Host
+		res := httptest.NewRecorder()
+		c.ServeHTTP(res, r)
+		return res
+	}
+
+	// Discovery document.
+	res := serve(httptest.NewRequest(http.MethodGet, "/oauth/.well-known/openid-configuration", nil))
+	if !a.So(res.Code, should.Equal, http.StatusOK) {
+		t.FailNow()
+	}
+	var discovery map[string]any
+	a.So(json.Unmarshal(res.Body.Bytes(), &discovery), should.BeNil)
+	a.So(discovery["issuer"], should.Equal, "https://example.com/oauth")
+	a.So(discovery["authorization_endpoint"], should.Equal, "https://example.com/oauth/authorize")
+	a.So(discovery["token_endpoint"], should.Equal, "https://example.com/oauth/token")
+	a.So(discovery["userinfo_endpoint"], should.Equal, "https://example.com/oauth/userinfo")
+	a.So(discovery["jwks_uri"], should.Equal, "https://example.com/oauth/.well-known/jwks.json")
+	a.So(discovery["id_token_signing_alg_values_supported"], should.Resemble, []any{"ES256"})
+
+	// Both the signing key and the rotated key are published.
+	res = serve(httptest.NewRequest(http.MethodGet, "/oauth/.well-known/jwks.json", nil))
+	if !a.So(res.Code, should.Equal, http.StatusOK) {
+		t.FailNow()
+	}
+	var jwks jose.JSONWebKeySet
+	a.So(json.Unmarshal(res.Body.Bytes(), &jwks), should.BeNil)
+	if !a.So(jwks.Keys, should.HaveLength, 2) {
+		t.FailNow()
+	}
+	for _, key := range jwks.Keys {
+		a.So(key.IsPublic(), should.BeTrue)
+		a.So(key.KeyID, should.NotBeEmpty)
+	}
+
+	// The nonce and the OpenID Connect scopes are stored with the authorization code.
+	store.reset()
+	store.res.session = mockSession
+	store.res.user = mockUser
+	store.res.client = &ttnpb.Client{
+		Ids:               mockClient.GetIds(),
+		State:             ttnpb.State_STATE_APPROVED,
+		Grants:            mockClient.Grants,
+		RedirectUris:      mockClient.RedirectUris,
+		Rights:            mockClient.Rights,
+		SkipAuthorization: true,
+	}
+	req := httptest.NewRequest(http.MethodGet, "/oauth/authorize?"+url.Values{
+		"client_id":     {"client"},
+		"redirect_uri":  {"http://uri/callback"},
+		"response_type": {"code"},
+		"scope":         {"openid email offline_access"},
+		"nonce":         {"the-nonce"},
+	}.Encode(), nil)
+	req.AddCookie(authCookie)
+	res = serve(req)
+	a.So(res.Code, should.Equal, http.StatusFound)
+	a.So(res.Header().Get("Location"), should.StartWith, "http://uri/callback?code=")
+	if a.So(store.calls, should.Contain, "CreateAuthorizationCode") {
+		a.So(store.req.authorizationCode.Nonce, should.Equal, "the-nonce")
+		a.So(store.req.authorizationCode.Scopes, should.Resemble, []string{"openid", "email"})
+	}
+
+	// The ID token is returned with the access token.
+	store.reset()
+	store.res.client = mockClient
+	store.res.session = mockSession
+	store.res.user = &ttnpb.User{
+		Ids:                            mockUser.GetIds(),
+		Name:                           "Test User",
+		PrimaryEmailAddress:            "user@example.com",
+		PrimaryEmailAddressValidatedAt: timestamppb.New(now),
+	}
+	store.res.authorizationCode = &ttnpb.OAuthAuthorizationCode{
+		UserIds:       mockUser.GetIds(),
+		ClientIds:     mockClient.GetIds(),
+		UserSessionId: mockSession.SessionId,
+		Rights:        mockClient.Rights,
+		Code:          "the code",
+		RedirectUri:   "http://uri/callback",
+		Nonce:         "the-nonce",
+		Scopes:        []string{"openid", "email"},
+		CreatedAt:     timestamppb.New(now),
+		ExpiresAt:     timestamppb.New(anHourFromNow),
+	}
+	req = httptest.NewRequest(http.MethodPost, "/oauth/token", strings.NewReader(url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {"the code"},
+		"redirect_uri":  {"http://uri/callback"},
+		"client_id":     {"client"},
+		"client_secret": {"secret"},
+	}.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	res = serve(req)
+	if !a.So(res.Code, should.Equal, http.StatusOK) {
+		t.FailNow()
+	}
+	var tokenRes struct {
+		AccessToken string `json:"access_token"`
+		IDToken     string `json:"id_token"`
+	}
+	a.So(json.Unmarshal(res.Body.Bytes(), &tokenRes), should.BeNil)
+	a.So(tokenRes.AccessToken, should.NotBeEmpty)
+	idToken, err := jwt.ParseSigned(tokenRes.IDToken)
+	if !a.So(err, should.BeNil) || !a.So(idToken.Headers, should.HaveLength, 1) {
+		t.FailNow()
+	}
+	a.So(idToken.Headers[0].KeyID, should.Equal, jwks.Keys[0].KeyID)
+	var claims struct {
+		jwt.Claims
+		Nonce         string `json:"nonce"`
+		AuthTime      int64  `json:"auth_time"`
+		Name          string `json:"name"`
+		Email         string `json:"email"`
+		EmailVerified bool   `json:"email_verified"`
+	}
+	if a.So(idToken.Claims(jwks.Keys[0], &claims), should.BeNil) {
+		a.So(claims.Validate(jwt.Expected{
+			Issuer:   "https://example.com/oauth",
+			Subject:  "user",
+			Audience: jwt.Audience{"client"},
+			Time:     now,
+		}), should.BeNil)
+		a.So(claims.Nonce, should.Equal, "the-nonce")
+		a.So(claims.AuthTime, should.Equal, now.Unix())
+		a.So(claims.Name, should.BeEmpty)
+		a.So(claims.Email, should.Equal, "user@example.com")
+		a.So(claims.EmailVerified, should.BeTrue)
+	}
+
+	// The claims of the user are returned with a valid access token.
+	hashValidator := pbkdf2.Default()
+	hashValidator.Iterations = 10
+	accessKey, err := auth.Hash(auth.NewContextWithHashValidator(ctx, hashValidator), "access-key")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.reset()
+	store.res.user = &ttnpb.User{
+		Ids:                 mockUser.GetIds(),
+		Name:                "Test User",
+		PrimaryEmailAddress: "user@example.com",
+	}
+	store.res.accessToken = &ttnpb.OAuthAccessToken{
+		UserIds:     mockUser.GetIds(),
+		ClientIds:   mockClient.GetIds(),
+		Id:          "token-id",
+		AccessToken: accessKey,
+		Rights:      []ttnpb.Right{ttnpb.Right_RIGHT_USER_INFO},
+		ExpiresAt:   timestamppb.New(anHourFromNow),
+	}
+	req = httptest.NewRequest(http.MethodGet, "/oauth/userinfo", nil)
+	req.Header.Set("Authorization", "Bearer "+auth.JoinToken(auth.AccessToken, "token-id", "access-key"))
+	res = serve(req)
+	if a.So(res.Code, should.Equal, http.StatusOK) {
+		var userInfo map[string]any
+		a.So(json.Unmarshal(res.Body.Bytes(), &userInfo), should.BeNil)
+		a.So(userInfo, should.Resemble, map[string]any{
+			"sub":                "user",
+			"name":               "Test User",
+			"preferred_username": "user",
+			"email":              "user@example.com",
+			"email_verified":     false,
+		})
+	}
+
+	// Only the subject is returned without the right to read user info.
+	store.res.accessToken.Rights = []ttnpb.Right{ttnpb.Right_RIGHT_APPLICATION_INFO}
+	res = serve(req)
+	if a.So(res.Code, should.Equal, http.StatusOK) {
+		a.So(res.Body.String(), should.Equal, `{"sub":"user"}`)
+	}
+
+	// Invalid access tokens are rejected.
+	req.Header.Set("Authorization", "Bearer "+auth.JoinToken(auth.AccessToken, "token-id", "other-key"))
+	res = serve(req)
+	a.So(res.Code, should.Equal, http.StatusUnauthorized)
+	a.So(res.Header().Get("WWW-Authenticate"), should.ContainSubstring, "invalid_token")
+}
@@ -34,6 +34,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
 	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
 	"go.thethings.network/lorawan-stack/v3/pkg/webui"
+	"gopkg.in/square/go-jose.v2"
 )
 
 // Server is the interface for the OAuth server.
@@ -52,6 +53,7 @@ type server struct {
 	session       session.Session
 	generateCSP   func(config *Config, nonce string) string
 	schemaDecoder *schema.Decoder
+	signingKeys   []jose.JSONWebKey
 }
 
 type sessionStore struct {
@@ -79,6 +81,14 @@ func NewServer(c *component.Component, store oauth_store.TransactionalInterface,
 		s.config.Mount = s.config.UI.MountPath()
 	}
 
+	if s.config.OpenIDConnect.Enabled() {
+		signingKeys, err := LoadSigningKeys(s.config.OpenIDConnect.SigningKeyFiles)
+		if err != nil {
+			return nil, err
+		}
+		s.signingKeys = signingKeys
+	}
+
 	s.osinConfig = &osin.ServerConfig{
 		AuthorizationExpiration: int32((5 * time.Minute).Seconds()),
 		AccessExpiration:        int32(time.Hour.Seconds()),
@@ -288,4 +298,10 @@ func (s *server) RegisterRoutes(server *web.Server) {
 
 	// No CSRF here:
 	router.Path("/token").HandlerFunc(s.Token).Methods(http.MethodPost)
+
+	if s.oidcEnabled() {
+		router.Path("/.well-known/openid-configuration").HandlerFunc(s.OpenIDConfiguration).Methods(http.MethodGet)
+		router.Path("/.well-known/jwks.json").HandlerFunc(s.JWKS).Methods(http.MethodGet)
+		router.Path("/userinfo").HandlerFunc(s.UserInfo).Methods(http.MethodGet, http.MethodPost)
+	}
 }
@@ -60,6 +60,10 @@ func (osinClient) GetUserData() any { return nil }
 type userData struct {
 	*ttnpb.UserSessionIdentifiers
 	ID string
+
+	// Nonce and Scopes are the nonce and the scopes of the OpenID Connect authentication request.
+	Nonce  string
+	Scopes []string
 }
 
 // storage wraps IS stores, while implementing the osin.Storage interface.
@@ -85,7 +89,8 @@ func (s *storage) GetClient(id string) (osin.Client, error) {
 }
 
 func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
-	userSessionIDs := data.UserData.(userData).UserSessionIdentifiers
+	ud := data.UserData.(userData)
+	userSessionIDs := ud.UserSessionIdentifiers
 	client := data.Client.(osinClient).Client
 	rights := rightsFromScope(data.Scope)
 	err := s.store.Transact(s.ctx, func(ctx context.Context, st oauth_store.Interface) (err error) {
@@ -108,6 +113,8 @@ func (s *storage) SaveAuthorize(data *osin.AuthorizeData) error {
 			Code:          data.Code,
 			RedirectUri:   data.RedirectUri,
 			State:         data.State,
+			Nonce:         ud.Nonce,
+			Scopes:        ud.Scopes,
 			CreatedAt:     timestamppb.New(data.CreatedAt),
 			ExpiresAt:     timestamppb.New(data.CreatedAt.Add(time.Duration(data.ExpiresIn) * time.Second)),
 		})
@@ -152,6 +159,8 @@ func (s *storage) LoadAuthorize(code string) (data *osin.AuthorizeData, err erro
 				UserIds:   authorizationCode.UserIds,
 				SessionId: authorizationCode.UserSessionId,
 			},
+			Nonce:  authorizationCode.Nonce,
+			Scopes: authorizationCode.Scopes,
 		},
 	}, nil
 }
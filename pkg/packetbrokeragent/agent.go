@@ -115,6 +115,9 @@ type Agent struct {
 	upstreamCh   chan *uplinkMessage
 	downstreamCh chan *downlinkMessage
 
+	roamingStats   *roamingStats
+	downlinkQuotas *downlinkQuotas
+
 	grpc struct {
 		pba   ttnpb.PbaServer
 		nsPba ttnpb.NsPbaServer
@@ -191,6 +194,11 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 		)
 	}
 
+	downlinkQuotas, err := newDownlinkQuotas(conf.Forwarder.DownlinkQuota)
+	if err != nil {
+		return nil, err
+	}
+
 	clusterIDBuilder := literalClusterID
 	clusterID, err := clusterIDBuilder(conf.ClusterID)
 	if err != nil {
@@ -224,6 +232,8 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 		forwarderConfig:      conf.Forwarder,
 		homeNetworkConfig:    conf.HomeNetwork,
 		devAddrPrefixes:      devAddrPrefixes,
+		roamingStats:         newRoamingStats(time.Now()),
+		downlinkQuotas:       downlinkQuotas,
 		tenantIDExtractor: func(_ context.Context) string {
 			return conf.TenantID
 		},
@@ -336,6 +346,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 			frequencyPlansStore: getFrequencyPlanStore,
 			upstreamCh:          a.upstreamCh,
 			mapperConn:          mapperConn,
+			roamingStats:        a.roamingStats,
 		}
 	} else {
 		a.grpc.gsPba = &disabledServer{}
@@ -387,6 +398,7 @@ func New(c *component.Component, conf *Config, opts ...Option) (*Agent, error) {
 	c.GRPC.RegisterUnaryHook("/ttn.lorawan.v3.GsPba", cluster.HookName, c.ClusterAuthUnaryHook())
 
 	c.RegisterGRPC(a)
+	c.RegisterWeb(a)
 	return a, nil
 }
 
@@ -720,6 +732,18 @@ func (a *Agent) handleDownlinkMessage(
 		forwarderNetID.String(), down.ForwarderTenantId, down.ForwarderClusterId,
 	).Inc()
 
+	if err := a.downlinkQuotas.Allow(homeNetworkNetID, receivedAt); err != nil {
+		logger.WithError(err).Debug("Drop downlink message of home network that exceeded its quota")
+		pbaMetrics.downlinkQuotaExceeded.WithLabelValues(ctx,
+			homeNetworkNetID.String(), down.HomeNetworkTenantId, down.HomeNetworkClusterId,
+		).Inc()
+		events.Publish(evtDropDownlinkQuotaExceeded.NewWithIdentifiersAndData(ctx, ids, err))
+		report.Result = &packetbroker.DownlinkMessageDeliveryStateChange_Error{
+			Error: packetbroker.DownlinkMessageProcessingError_DOWNLINK_UNKNOWN_ERROR,
+		}
+		return err
+	}
+
 	conn, err := a.GetPeerConn(ctx, ttnpb.ClusterRole_GATEWAY_SERVER, ids)
 	if err != nil {
 		logger.WithError(err).Warn("Failed to get Gateway Server peer")
@@ -1057,6 +1081,7 @@ func (a *Agent) handleUplinkMessage(
 		}
 		return err
 	}
+	a.roamingStats.addForeignNetworkUplink(forwarderNetID, up.ForwarderTenantId, up.ForwarderClusterId, time.Now())
 
 	return nil
 }
@@ -74,14 +74,22 @@ type OAuth2Config struct {
 
 // ForwarderConfig defines configuration of the Forwarder role.
 type ForwarderConfig struct {
-	Enable            bool             `name:"enable" description:"Enable Forwarder role"`
-	WorkerPool        WorkerPoolConfig `name:"worker-pool" description:"Workers pool configuration"`
-	TokenKey          []byte           `name:"token-key" description:"AES 128 or 256-bit key for encrypting tokens"`
-	TokenEncrypter    jose.Encrypter   `name:"-"`
-	IncludeGatewayEUI bool             `name:"include-gateway-eui" description:"Include the gateway EUI in forwarded metadata"`
-	IncludeGatewayID  bool             `name:"include-gateway-id" description:"Include the gateway ID in forwarded metadata"`
-	HashGatewayID     bool             `name:"hash-gateway-id" description:"Hash the gateway ID (if forwarded in the metadata)"`
-	GatewayOnlineTTL  time.Duration    `name:"gateway-online-ttl" description:"Time-to-live of online status reported to Packet Broker"`
+	Enable            bool                `name:"enable" description:"Enable Forwarder role"`
+	WorkerPool        WorkerPoolConfig    `name:"worker-pool" description:"Workers pool configuration"`
+	TokenKey          []byte              `name:"token-key" description:"AES 128 or 256-bit key for encrypting tokens"`
+	TokenEncrypter    jose.Encrypter      `name:"-"`
+	IncludeGatewayEUI bool                `name:"include-gateway-eui" description:"Include the gateway EUI in forwarded metadata"`
+	IncludeGatewayID  bool                `name:"include-gateway-id" description:"Include the gateway ID in forwarded metadata"`
+	HashGatewayID     bool                `name:"hash-gateway-id" description:"Hash the gateway ID (if forwarded in the metadata)"`
+	GatewayOnlineTTL  time.Duration       `name:"gateway-online-ttl" description:"Time-to-live of online status reported to Packet Broker"`
+	DownlinkQuota     DownlinkQuotaConfig `name:"downlink-quota" description:"Quota of downlink messages accepted from home networks"`
+}
+
+// DownlinkQuotaConfig defines the maximum number of downlink messages per hour that are accepted from each home
+// network to be transmitted by gateways of this network. A limit of 0 is unlimited.
+type DownlinkQuotaConfig struct {
+	Limit  uint64            `name:"limit" description:"Maximum number of downlink messages per hour accepted from each home network (0 is unlimited)"`
+	NetIDs map[string]string `name:"net-ids" description:"Maximum number of downlink messages per hour accepted from specific home networks (NetID=limit)"`
 }
 
 // HomeNetworkConfig defines the configuration of the Home Network role.
@@ -0,0 +1,98 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"strconv"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+)
+
+// downlinkQuotaWindow is the window in which the downlink messages of a home network are counted.
+const downlinkQuotaWindow = time.Hour
+
+var (
+	errDownlinkQuotaConfig   = errors.DefineFailedPrecondition("downlink_quota_config", "invalid downlink quota `{value}` of home network `{net_id}`")
+	errDownlinkQuotaExceeded = errors.DefineResourceExhausted("downlink_quota_exceeded", "downlink quota of home network `{net_id}` exceeded", "limit")
+)
+
+type downlinkQuotaCounter struct {
+	windowStart time.Time
+	count       uint64
+}
+
+// downlinkQuotas limits the number of downlink messages per hour that are accepted from home networks to be
+// transmitted by gateways of this network.
+type downlinkQuotas struct {
+	limit  uint64
+	netIDs map[types.NetID]uint64
+
+	mu       sync.Mutex
+	counters map[types.NetID]*downlinkQuotaCounter
+}
+
+func newDownlinkQuotas(conf DownlinkQuotaConfig) (*downlinkQuotas, error) {
+	q := &downlinkQuotas{
+		limit:    conf.Limit,
+		netIDs:   make(map[types.NetID]uint64, len(conf.NetIDs)),
+		counters: make(map[types.NetID]*downlinkQuotaCounter),
+	}
+	for s, v := range conf.NetIDs {
+		var netID types.NetID
+		if err := netID.UnmarshalText([]byte(s)); err != nil {
+			return nil, errNetID.WithAttributes("net_id", s).WithCause(err)
+		}
+		limit, err := strconv.ParseUint(v, 10, 64)
+		if err != nil {
+			return nil, errDownlinkQuotaConfig.WithAttributes("net_id", s, "value", v).WithCause(err)
+		}
+		q.netIDs[netID] = limit
+	}
+	return q, nil
+}
+
+func (q *downlinkQuotas) limitOf(netID types.NetID) uint64 {
+	if limit, ok := q.netIDs[netID]; ok {
+		return limit
+	}
+	return q.limit
+}
+
+// Allow counts a downlink message of the home network and returns an error if the quota of the home network in the
+// current window is exceeded. Downlink messages that exceed the quota are not counted.
+func (q *downlinkQuotas) Allow(netID types.NetID, now time.Time) error {
+	limit := q.limitOf(netID)
+	if limit == 0 {
+		return nil
+	}
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	c, ok := q.counters[netID]
+	if !ok {
+		c = &downlinkQuotaCounter{windowStart: now}
+		q.counters[netID] = c
+	}
+	if now.Sub(c.windowStart) >= downlinkQuotaWindow {
+		c.windowStart, c.count = now, 0
+	}
+	if c.count >= limit {
+		return errDownlinkQuotaExceeded.WithAttributes("net_id", netID, "limit", limit)
+	}
+	c.count++
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestDownlinkQuotas(t *testing.T) {
+	a, _ := test.New(t)
+
+	_, err := newDownlinkQuotas(DownlinkQuotaConfig{
+		NetIDs: map[string]string{"invalid": "1"},
+	})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+	_, err = newDownlinkQuotas(DownlinkQuotaConfig{
+		NetIDs: map[string]string{"000013": "-1"},
+	})
+	a.So(errors.IsFailedPrecondition(err), should.BeTrue)
+
+	q, err := newDownlinkQuotas(DownlinkQuotaConfig{
+		Limit: 2,
+		NetIDs: map[string]string{
+			"000013": "1",
+			"000042": "0",
+		},
+	})
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	now := time.Unix(0, 0)
+	netIDDefault, netID13, netID42 := types.NetID{0x0, 0x0, 0x1}, types.NetID{0x0, 0x0, 0x13}, types.NetID{0x0, 0x0, 0x42}
+
+	a.So(q.Allow(netIDDefault, now), should.BeNil)
+	a.So(q.Allow(netIDDefault, now.Add(time.Minute)), should.BeNil)
+	a.So(errors.IsResourceExhausted(q.Allow(netIDDefault, now.Add(2*time.Minute))), should.BeTrue)
+
+	a.So(q.Allow(netID13, now), should.BeNil)
+	a.So(errors.IsResourceExhausted(q.Allow(netID13, now)), should.BeTrue)
+
+	// A limit of 0 is unlimited.
+	for i := 0; i < 10; i++ {
+		a.So(q.Allow(netID42, now), should.BeNil)
+	}
+
+	// The quota is reset after the window.
+	a.So(q.Allow(netIDDefault, now.Add(downlinkQuotaWindow)), should.BeNil)
+	a.So(q.Allow(netID13, now.Add(downlinkQuotaWindow)), should.BeNil)
+	a.So(errors.IsResourceExhausted(q.Allow(netID13, now.Add(downlinkQuotaWindow))), should.BeTrue)
+}
@@ -63,6 +63,7 @@ type gsPbaServer struct {
 	frequencyPlansStore GetFrequencyPlansStore
 	upstreamCh          chan *uplinkMessage
 	mapperConn          *grpc.ClientConn
+	roamingStats        *roamingStats
 }
 
 // PublishUplink is called by the Gateway Server when an uplink message arrives and needs to get forwarded to Packet Broker.
@@ -95,6 +96,9 @@ func (s *gsPbaServer) PublishUplink(ctx context.Context, up *ttnpb.GatewayUplink
 	case <-ctx.Done():
 		return nil, ctx.Err()
 	case s.upstreamCh <- ctxMsg:
+		if md := up.Message.GetRxMetadata(); len(md) > 0 && md[0].GetGatewayIds() != nil {
+			s.roamingStats.addGatewayUplink(md[0].GatewayIds.GatewayId, time.Now())
+		}
 		return ttnpb.Empty, nil
 	}
 }
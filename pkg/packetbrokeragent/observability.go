@@ -16,7 +16,9 @@ package packetbrokeragent
 
 import (
 	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
 )
 
 const (
@@ -26,6 +28,12 @@ const (
 
 const subsystem = "pba"
 
+var evtDropDownlinkQuotaExceeded = events.Define(
+	"pba.down.quota.drop", "drop downlink message of home network that exceeded its quota",
+	events.WithVisibility(ttnpb.Right_RIGHT_GATEWAY_TRAFFIC_READ),
+	events.WithErrorDataType(),
+)
+
 var pbaMetrics = &messageMetrics{
 	uplinkReceived: metrics.NewContextualCounterVec(
 		prometheus.CounterOpts{
@@ -108,6 +116,18 @@ var pbaMetrics = &messageMetrics{
 			"forwarder_cluster_id",
 		},
 	),
+	downlinkQuotaExceeded: metrics.NewContextualCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "downlink_quota_exceeded_total",
+			Help:      "Total number of downlinks received from Packet Broker that are dropped because the home network exceeded its quota",
+		},
+		[]string{
+			"home_network_net_id",
+			"home_network_tenant_id",
+			"home_network_cluster_id",
+		},
+	),
 }
 
 func init() {
@@ -121,6 +141,7 @@ type messageMetrics struct {
 	downlinkForwarded     *metrics.ContextualCounterVec
 	uplinkStateReported   *metrics.ContextualCounterVec
 	downlinkStateReported *metrics.ContextualCounterVec
+	downlinkQuotaExceeded *metrics.ContextualCounterVec
 }
 
 func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
@@ -130,6 +151,7 @@ func (m messageMetrics) Describe(ch chan<- *prometheus.Desc) {
 	m.downlinkForwarded.Describe(ch)
 	m.uplinkStateReported.Describe(ch)
 	m.downlinkStateReported.Describe(ch)
+	m.downlinkQuotaExceeded.Describe(ch)
 }
 
 func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
@@ -139,4 +161,5 @@ func (m messageMetrics) Collect(ch chan<- prometheus.Metric) {
 	m.downlinkForwarded.Collect(ch)
 	m.uplinkStateReported.Collect(ch)
 	m.downlinkStateReported.Collect(ch)
+	m.downlinkQuotaExceeded.Collect(ch)
 }
@@ -0,0 +1,205 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/gorilla/mux"
+	"go.thethings.network/lorawan-stack/v3/pkg/auth/rights"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/web"
+	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
+	"go.thethings.network/lorawan-stack/v3/pkg/webmiddleware"
+)
+
+const (
+	// maxRoamingStatsEntries is the maximum number of foreign networks and gateways for which uplink messages are
+	// counted. Uplink messages of foreign networks and gateways beyond the maximum are not counted.
+	maxRoamingStatsEntries = 4096
+
+	// defaultRoamingStatsLimit is the default number of foreign networks and gateways returned.
+	defaultRoamingStatsLimit = 10
+)
+
+// ForeignNetworkRoamingStats contains the uplink messages of end devices of this network that are forwarded by a
+// foreign network through Packet Broker.
+type ForeignNetworkRoamingStats struct {
+	NetID        types.NetID `json:"net_id"`
+	TenantID     string      `json:"tenant_id,omitempty"`
+	ClusterID    string      `json:"cluster_id,omitempty"`
+	UplinkCount  uint64      `json:"uplink_count"`
+	LastUplinkAt time.Time   `json:"last_uplink_at"`
+}
+
+// GatewayRoamingStats contains the uplink messages received by a gateway of this network that are forwarded to
+// Packet Broker for foreign end devices.
+type GatewayRoamingStats struct {
+	GatewayID    string    `json:"gateway_id"`
+	UplinkCount  uint64    `json:"uplink_count"`
+	LastUplinkAt time.Time `json:"last_uplink_at"`
+}
+
+// RoamingStats contains the foreign networks that most frequently forward uplink messages of end devices of this
+// network, and the gateways of this network that most frequently serve foreign end devices.
+type RoamingStats struct {
+	Since           time.Time                     `json:"since"`
+	ForeignNetworks []*ForeignNetworkRoamingStats `json:"foreign_networks"`
+	Gateways        []*GatewayRoamingStats        `json:"gateways"`
+}
+
+type foreignNetworkKey struct {
+	netID     types.NetID
+	tenantID  string
+	clusterID string
+}
+
+// roamingStats aggregates the uplink messages exchanged with foreign networks through Packet Broker since the
+// Packet Broker Agent started.
+type roamingStats struct {
+	mu              sync.Mutex
+	since           time.Time
+	foreignNetworks map[foreignNetworkKey]*ForeignNetworkRoamingStats
+	gateways        map[string]*GatewayRoamingStats
+}
+
+func newRoamingStats(now time.Time) *roamingStats {
+	return &roamingStats{
+		since:           now,
+		foreignNetworks: make(map[foreignNetworkKey]*ForeignNetworkRoamingStats),
+		gateways:        make(map[string]*GatewayRoamingStats),
+	}
+}
+
+// addForeignNetworkUplink counts an uplink message of an end device of this network forwarded by the foreign network.
+func (s *roamingStats) addForeignNetworkUplink(netID types.NetID, tenantID, clusterID string, at time.Time) {
+	k := foreignNetworkKey{netID: netID, tenantID: tenantID, clusterID: clusterID}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.foreignNetworks[k]
+	if !ok {
+		if len(s.foreignNetworks) >= maxRoamingStatsEntries {
+			return
+		}
+		stats = &ForeignNetworkRoamingStats{
+			NetID:     netID,
+			TenantID:  tenantID,
+			ClusterID: clusterID,
+		}
+		s.foreignNetworks[k] = stats
+	}
+	stats.UplinkCount++
+	stats.LastUplinkAt = at
+}
+
+// addGatewayUplink counts an uplink message received by the gateway of this network and forwarded to Packet Broker.
+func (s *roamingStats) addGatewayUplink(gatewayID string, at time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	stats, ok := s.gateways[gatewayID]
+	if !ok {
+		if len(s.gateways) >= maxRoamingStatsEntries {
+			return
+		}
+		stats = &GatewayRoamingStats{GatewayID: gatewayID}
+		s.gateways[gatewayID] = stats
+	}
+	stats.UplinkCount++
+	stats.LastUplinkAt = at
+}
+
+// Top returns the limit foreign networks and gateways with the most uplink messages.
+func (s *roamingStats) Top(limit int) *RoamingStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	res := &RoamingStats{
+		Since:           s.since,
+		ForeignNetworks: make([]*ForeignNetworkRoamingStats, 0, len(s.foreignNetworks)),
+		Gateways:        make([]*GatewayRoamingStats, 0, len(s.gateways)),
+	}
+	for _, stats := range s.foreignNetworks {
+		stats := *stats
+		res.ForeignNetworks = append(res.ForeignNetworks, &stats)
+	}
+	for _, stats := range s.gateways {
+		stats := *stats
+		res.Gateways = append(res.Gateways, &stats)
+	}
+	sort.Slice(res.ForeignNetworks, func(i, j int) bool {
+		a, b := res.ForeignNetworks[i], res.ForeignNetworks[j]
+		if a.UplinkCount != b.UplinkCount {
+			return a.UplinkCount > b.UplinkCount
+		}
+		return a.LastUplinkAt.After(b.LastUplinkAt)
+	})
+	sort.Slice(res.Gateways, func(i, j int) bool {
+		a, b := res.Gateways[i], res.Gateways[j]
+		if a.UplinkCount != b.UplinkCount {
+			return a.UplinkCount > b.UplinkCount
+		}
+		return a.GatewayID < b.GatewayID
+	})
+	if len(res.ForeignNetworks) > limit {
+		res.ForeignNetworks = res.ForeignNetworks[:limit]
+	}
+	if len(res.Gateways) > limit {
+		res.Gateways = res.Gateways[:limit]
+	}
+	return res
+}
+
+// RegisterRoutes registers the web frontend routes.
+//
+// The roaming stats route lets admins retrieve the foreign networks that most frequently forward uplink messages of
+// end devices of this network, and the gateways of this network that most frequently serve foreign end devices,
+// to inform routing policy decisions.
+func (a *Agent) RegisterRoutes(server *web.Server) {
+	router := server.Prefix(ttnpb.HTTPAPIPrefix + "/pba/roaming-stats").Subrouter()
+	router.Use(
+		mux.MiddlewareFunc(webmiddleware.Namespace("packetbrokeragent")),
+		ratelimit.HTTPMiddleware(a.Component.RateLimiter(), "http:pba"),
+		mux.MiddlewareFunc(webmiddleware.Metadata("Authorization")),
+	)
+	router.HandleFunc("", a.handleGetRoamingStats).Methods(http.MethodGet)
+}
+
+var errRoamingStatsLimit = errors.DefineInvalidArgument("roaming_stats_limit", "invalid limit `{limit}`")
+
+func (a *Agent) handleGetRoamingStats(w http.ResponseWriter, r *http.Request) {
+	if err := rights.RequireIsAdmin(r.Context()); err != nil {
+		webhandlers.Error(w, r, err)
+		return
+	}
+	limit := defaultRoamingStatsLimit
+	if s := r.URL.Query().Get("limit"); s != "" {
+		n, err := strconv.Atoi(s)
+		if err != nil || n <= 0 || n > maxRoamingStatsEntries {
+			webhandlers.Error(w, r, errRoamingStatsLimit.WithAttributes("limit", s))
+			return
+		}
+		limit = n
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	_ = json.NewEncoder(w).Encode(a.roamingStats.Top(limit))
+}
@@ -0,0 +1,84 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package packetbrokeragent
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRoamingStats(t *testing.T) {
+	a, _ := test.New(t)
+
+	since := time.Unix(1, 0)
+	s := newRoamingStats(since)
+
+	t1, t2, t3 := time.Unix(10, 0), time.Unix(20, 0), time.Unix(30, 0)
+	s.addForeignNetworkUplink(types.NetID{0x0, 0x0, 0x13}, "tenant-a", "cluster-a", t1)
+	s.addForeignNetworkUplink(types.NetID{0x0, 0x0, 0x42}, "", "", t1)
+	s.addForeignNetworkUplink(types.NetID{0x0, 0x0, 0x42}, "", "", t2)
+	s.addForeignNetworkUplink(types.NetID{0x0, 0x0, 0x13}, "tenant-b", "", t3)
+	s.addGatewayUplink("gtw-b", t1)
+	s.addGatewayUplink("gtw-a", t2)
+	s.addGatewayUplink("gtw-c", t2)
+	s.addGatewayUplink("gtw-c", t3)
+
+	a.So(s.Top(2), should.Resemble, &RoamingStats{
+		Since: since,
+		ForeignNetworks: []*ForeignNetworkRoamingStats{
+			{
+				NetID:        types.NetID{0x0, 0x0, 0x42},
+				UplinkCount:  2,
+				LastUplinkAt: t2,
+			},
+			{
+				NetID:        types.NetID{0x0, 0x0, 0x13},
+				TenantID:     "tenant-b",
+				UplinkCount:  1,
+				LastUplinkAt: t3,
+			},
+		},
+		Gateways: []*GatewayRoamingStats{
+			{
+				GatewayID:    "gtw-c",
+				UplinkCount:  2,
+				LastUplinkAt: t3,
+			},
+			{
+				GatewayID:    "gtw-a",
+				UplinkCount:  1,
+				LastUplinkAt: t2,
+			},
+		},
+	})
+
+	// Returned stats are copies.
+	top := s.Top(1)
+	top.Gateways[0].UplinkCount = 42
+	a.So(s.Top(1).Gateways[0].UplinkCount, should.Equal, 2)
+
+	// New entries are not counted beyond the maximum, existing entries still are.
+	for i := 0; i < maxRoamingStatsEntries; i++ {
+		s.addGatewayUplink(fmt.Sprintf("gtw-%d", i), t1)
+	}
+	a.So(len(s.Top(maxRoamingStatsEntries).Gateways), should.Equal, maxRoamingStatsEntries)
+	s.addGatewayUplink("gtw-c", t3)
+	a.So(s.Top(1).Gateways[0].UplinkCount, should.Equal, 3)
+}
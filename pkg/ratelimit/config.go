@@ -22,6 +22,7 @@ import (
 	"go.thethings.network/lorawan-stack/v3/pkg/config"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
 	"go.thethings.network/lorawan-stack/v3/pkg/httpclient"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
 	"gopkg.in/yaml.v2"
 )
 
@@ -30,8 +31,54 @@ const defaultMaxSize = 1 << 12
 
 var errRateLimitExceeded = errors.DefineResourceExhausted("rate_limit_exceeded", "rate limit of `{rate}` accesses per minute exceeded for resource `{key}`")
 
+var errInvalidProvider = errors.DefineInvalidArgument("invalid_provider", "invalid rate limiting store provider `{provider}`")
+
+type options struct {
+	redisClient   *ttnredis.Client
+	overrideStore OverrideStore
+}
+
+// Option configures the rate limiter created by New.
+type Option func(*options)
+
+// WithRedisClient configures the Redis client used by the Redis rate limiting store.
+// If no client is given, a new client is created from the Redis configuration.
+func WithRedisClient(cl *ttnredis.Client) Option {
+	return func(o *options) {
+		o.redisClient = cl
+	}
+}
+
+// WithOverrideStore configures the store of the rate limit overrides.
+// If no store is given, overrides are not applied.
+func WithOverrideStore(store OverrideStore) Option {
+	return func(o *options) {
+		o.overrideStore = store
+	}
+}
+
 // New creates a new ratelimit.Interface from configuration.
-func New(ctx context.Context, conf config.RateLimiting, blobConf config.BlobConfig, httpClientProvider httpclient.Provider) (Interface, error) {
+func New(
+	ctx context.Context,
+	conf config.RateLimiting,
+	blobConf config.BlobConfig,
+	httpClientProvider httpclient.Provider,
+	opts ...Option,
+) (Interface, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	switch conf.Provider {
+	case "", "memory":
+	case "redis":
+		if o.redisClient == nil {
+			o.redisClient = ttnredis.New(conf.Redis.WithNamespace("ratelimit"))
+		}
+	default:
+		return nil, errInvalidProvider.WithAttributes("provider", conf.Provider)
+	}
+
 	defaultLimiter := &NoopRateLimiter{}
 	profiles := conf.Profiles
 
@@ -52,19 +99,49 @@ func New(ctx context.Context, conf config.RateLimiting, blobConf config.BlobConf
 		}
 		profiles = append(profiles, overrideProfiles.Profiles...)
 	}
-	if len(profiles) == 0 {
+	if len(profiles) == 0 && o.overrideStore == nil {
 		return defaultLimiter, nil
 	}
 
+	newStore := func(prefix string) (throttled.GCRAStore, error) {
+		if o.redisClient != nil {
+			return newRedisStore(ctx, o.redisClient, prefix), nil
+		}
+		size := conf.Memory.MaxSize
+		if size == 0 {
+			size = defaultMaxSize
+		}
+		return memstore.New(int(size))
+	}
 	l := &muxRateLimiter{
 		defaultLimiter: defaultLimiter,
 		limiters:       make(map[string]Interface, len(profiles)),
 	}
+	if o.overrideStore != nil {
+		cacheTTL := conf.OverridesCacheTTL
+		if cacheTTL == 0 {
+			cacheTTL = defaultOverridesCacheTTL
+		}
+		l.overrides = &overrideLimiter{
+			ctx:   ctx,
+			store: o.overrideStore,
+			newStore: func(subject string) (throttled.GCRAStore, error) {
+				return newStore("override:" + subject)
+			},
+			cacheTTL:      cacheTTL,
+			exportCallers: conf.ExportCallers,
+			cache:         make(map[string]*cachedOverride),
+		}
+	}
 	for _, profile := range profiles {
 		if len(profile.Associations) == 0 {
 			continue
 		}
-		limiter, err := NewProfile(ctx, profile, conf.Memory.MaxSize)
+		store, err := newStore(profile.Name)
+		if err != nil {
+			return nil, err
+		}
+		limiter, err := newProfile(ctx, profile, store, conf.ExportCallers)
 		if err != nil {
 			return nil, err
 		}
@@ -82,13 +159,19 @@ func NewProfile(ctx context.Context, conf config.RateLimitingProfile, size uint)
 	if size == 0 {
 		size = defaultMaxSize
 	}
-	if conf.MaxPerMin == 0 {
-		return nil, errInvalidRate.WithAttributes("rate", conf.MaxPerMin, "name", conf.Name)
-	}
 	store, err := memstore.New(int(size))
 	if err != nil {
 		return nil, err
 	}
+	return newProfile(ctx, conf, store, false)
+}
+
+func newProfile(
+	ctx context.Context, conf config.RateLimitingProfile, store throttled.GCRAStore, exportCallers bool,
+) (Interface, error) {
+	if conf.MaxPerMin == 0 {
+		return nil, errInvalidRate.WithAttributes("rate", conf.MaxPerMin, "name", conf.Name)
+	}
 	if conf.MaxBurst == 0 {
 		conf.MaxBurst = conf.MaxPerMin
 	}
@@ -100,5 +183,10 @@ func NewProfile(ctx context.Context, conf config.RateLimitingProfile, size uint)
 	if err != nil {
 		return nil, err
 	}
-	return &rateLimiter{ctx, limiter}, nil
+	return &rateLimiter{
+		ctx:           ctx,
+		name:          conf.Name,
+		limiter:       limiter,
+		exportCallers: exportCallers,
+	}, nil
 }
@@ -40,6 +40,26 @@ func grpcEntityFromRequest(ctx context.Context, req any) string {
 	return ""
 }
 
+// grpcApplicationFromRequest returns the identifiers of the application of the request, if any.
+func grpcApplicationFromRequest(req any) *ttnpb.ApplicationIdentifiers {
+	switch r := req.(type) {
+	case *ttnpb.ApplicationIdentifiers:
+		return r
+	case *ttnpb.EndDeviceIdentifiers:
+		return r.GetApplicationIds()
+	case interface {
+		GetApplicationIds() *ttnpb.ApplicationIdentifiers
+	}:
+		return r.GetApplicationIds()
+	case interface {
+		GetEndDeviceIds() *ttnpb.EndDeviceIdentifiers
+	}:
+		return r.GetEndDeviceIds().GetApplicationIds()
+	default:
+		return nil
+	}
+}
+
 func grpcIsClusterAuthCall(ctx context.Context) bool {
 	return rpcmetadata.FromIncomingContext(ctx).AuthType == clusterauth.AuthType
 }
@@ -17,7 +17,9 @@ package ratelimit
 import (
 	"net"
 	"net/http"
+	"strings"
 
+	"go.thethings.network/lorawan-stack/v3/pkg/auth"
 	"go.thethings.network/lorawan-stack/v3/pkg/webhandlers"
 )
 
@@ -31,6 +33,18 @@ func httpRemoteIP(r *http.Request) string {
 	return host
 }
 
+func httpAuthTokenID(r *http.Request) string {
+	authType, authValue, ok := strings.Cut(r.Header.Get("Authorization"), " ")
+	if !ok || !strings.EqualFold(authType, "Bearer") {
+		return ""
+	}
+	_, id, _, err := auth.SplitToken(authValue)
+	if err != nil {
+		return ""
+	}
+	return id
+}
+
 // HTTPMiddleware is an HTTP middleware that rate limits by remote IP and the request URL.
 // The remote IP is retrieved by the X-Real-IP header. Use this middleware after webmiddleware.ProxyHeaders()
 func HTTPMiddleware(limiter Interface, class string) func(http.Handler) http.Handler {
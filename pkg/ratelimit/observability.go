@@ -0,0 +1,44 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const subsystem = "ratelimit"
+
+var requests = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "requests_total",
+		Help:      "Number of requests checked by the rate limiter",
+	},
+	[]string{"profile", "result"},
+)
+
+var callerLimited = metrics.NewCounterVec(
+	prometheus.CounterOpts{
+		Subsystem: subsystem,
+		Name:      "caller_limited_total",
+		Help:      "Number of rate limited requests per caller",
+	},
+	[]string{"profile", "caller"},
+)
+
+func init() {
+	metrics.MustRegister(requests, callerLimited)
+}
@@ -0,0 +1,296 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/throttled/throttled"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+)
+
+// defaultOverridesCacheTTL is the default time that overrides are cached by the rate limiter.
+const defaultOverridesCacheTTL = time.Minute
+
+var (
+	errOverrideNotFound = errors.DefineNotFound(
+		"override_not_found", "rate limit override for `{subject}` not found",
+	)
+	errOverrideCorrupt = errors.DefineCorruption(
+		"override_corrupt", "stored rate limit override for `{subject}` is corrupt",
+	)
+)
+
+// Override is a custom rate limit for a subject, such as an API key or an application.
+// Overrides are evaluated before the rate limiting profiles of the resource classes.
+type Override struct {
+	Subject   string `json:"subject"`
+	MaxPerMin uint   `json:"max_per_min"`
+	MaxBurst  uint   `json:"max_burst,omitempty"`
+}
+
+// Validate returns an error when the rate of the override is invalid.
+func (o *Override) Validate() error {
+	if o.MaxPerMin == 0 {
+		return errInvalidRate.WithAttributes("rate", o.MaxPerMin, "name", o.Subject)
+	}
+	return nil
+}
+
+// APIKeySubject returns the override subject of the API key with the given ID.
+func APIKeySubject(id string) string {
+	return "api-key:" + id
+}
+
+// ApplicationSubject returns the override subject of the application.
+func ApplicationSubject(ids *ttnpb.ApplicationIdentifiers) string {
+	return "application:" + ids.GetApplicationId()
+}
+
+// OverrideStore stores rate limit overrides.
+type OverrideStore interface {
+	// GetOverride returns the override of the subject.
+	// If the subject has no override, a NotFound error is returned.
+	GetOverride(ctx context.Context, subject string) (*Override, error)
+	// ListOverrides returns all overrides, sorted by subject.
+	ListOverrides(ctx context.Context) ([]*Override, error)
+	// SetOverride creates or updates the override of the subject.
+	SetOverride(ctx context.Context, override *Override) error
+	// DeleteOverride deletes the override of the subject.
+	DeleteOverride(ctx context.Context, subject string) error
+}
+
+type memoryOverrideStore struct {
+	mu        sync.RWMutex
+	overrides map[string]Override
+}
+
+// NewMemoryOverrideStore returns a new OverrideStore that stores overrides in memory.
+// The overrides only apply to the rate limiters of this instance.
+func NewMemoryOverrideStore() OverrideStore {
+	return &memoryOverrideStore{
+		overrides: make(map[string]Override),
+	}
+}
+
+// GetOverride implements OverrideStore.
+func (s *memoryOverrideStore) GetOverride(_ context.Context, subject string) (*Override, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	override, ok := s.overrides[subject]
+	if !ok {
+		return nil, errOverrideNotFound.WithAttributes("subject", subject)
+	}
+	return &override, nil
+}
+
+// ListOverrides implements OverrideStore.
+func (s *memoryOverrideStore) ListOverrides(context.Context) ([]*Override, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	overrides := make([]*Override, 0, len(s.overrides))
+	for _, override := range s.overrides {
+		override := override
+		overrides = append(overrides, &override)
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].Subject < overrides[j].Subject })
+	return overrides, nil
+}
+
+// SetOverride implements OverrideStore.
+func (s *memoryOverrideStore) SetOverride(_ context.Context, override *Override) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.overrides[override.Subject] = *override
+	return nil
+}
+
+// DeleteOverride implements OverrideStore.
+func (s *memoryOverrideStore) DeleteOverride(_ context.Context, subject string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.overrides[subject]; !ok {
+		return errOverrideNotFound.WithAttributes("subject", subject)
+	}
+	delete(s.overrides, subject)
+	return nil
+}
+
+// redisOverrideStore stores the overrides in a Redis hash, with the subject as field.
+type redisOverrideStore struct {
+	cl *ttnredis.Client
+}
+
+// NewRedisOverrideStore returns a new OverrideStore that stores overrides in Redis.
+// The overrides apply to the rate limiters of all instances that share the same Redis store.
+func NewRedisOverrideStore(cl *ttnredis.Client) OverrideStore {
+	return &redisOverrideStore{cl: cl}
+}
+
+func (s *redisOverrideStore) key() string {
+	return s.cl.Key("overrides")
+}
+
+// GetOverride implements OverrideStore.
+func (s *redisOverrideStore) GetOverride(ctx context.Context, subject string) (*Override, error) {
+	b, err := s.cl.HGet(ctx, s.key(), subject).Bytes()
+	if err == redis.Nil {
+		return nil, errOverrideNotFound.WithAttributes("subject", subject)
+	}
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	override := &Override{}
+	if err := json.Unmarshal(b, override); err != nil {
+		return nil, errOverrideCorrupt.WithAttributes("subject", subject).WithCause(err)
+	}
+	return override, nil
+}
+
+// ListOverrides implements OverrideStore.
+func (s *redisOverrideStore) ListOverrides(ctx context.Context) ([]*Override, error) {
+	vs, err := s.cl.HGetAll(ctx, s.key()).Result()
+	if err != nil {
+		return nil, ttnredis.ConvertError(err)
+	}
+	overrides := make([]*Override, 0, len(vs))
+	for subject, v := range vs {
+		override := &Override{}
+		if err := json.Unmarshal([]byte(v), override); err != nil {
+			return nil, errOverrideCorrupt.WithAttributes("subject", subject).WithCause(err)
+		}
+		overrides = append(overrides, override)
+	}
+	sort.Slice(overrides, func(i, j int) bool { return overrides[i].Subject < overrides[j].Subject })
+	return overrides, nil
+}
+
+// SetOverride implements OverrideStore.
+func (s *redisOverrideStore) SetOverride(ctx context.Context, override *Override) error {
+	b, err := json.Marshal(override)
+	if err != nil {
+		return err
+	}
+	if err := s.cl.HSet(ctx, s.key(), override.Subject, b).Err(); err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	return nil
+}
+
+// DeleteOverride implements OverrideStore.
+func (s *redisOverrideStore) DeleteOverride(ctx context.Context, subject string) error {
+	n, err := s.cl.HDel(ctx, s.key(), subject).Result()
+	if err != nil {
+		return ttnredis.ConvertError(err)
+	}
+	if n == 0 {
+		return errOverrideNotFound.WithAttributes("subject", subject)
+	}
+	return nil
+}
+
+// overrideSubjecter is implemented by resources that can be subject to overrides.
+type overrideSubjecter interface {
+	overrideSubjects() []string
+}
+
+type cachedOverride struct {
+	override  *Override
+	limiter   Interface
+	expiresAt time.Time
+}
+
+// overrideLimiter rate limits resources of which a subject has an override.
+// The overrides are cached, so that the override store is not queried on every request.
+type overrideLimiter struct {
+	ctx           context.Context
+	store         OverrideStore
+	newStore      func(subject string) (throttled.GCRAStore, error)
+	cacheTTL      time.Duration
+	exportCallers bool
+
+	mu    sync.Mutex
+	cache map[string]*cachedOverride
+}
+
+func (l *overrideLimiter) limiter(subject string) Interface {
+	now := time.Now()
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	cached, ok := l.cache[subject]
+	if ok && now.Before(cached.expiresAt) {
+		return cached.limiter
+	}
+	override, err := l.store.GetOverride(l.ctx, subject)
+	if err != nil && !errors.IsNotFound(err) {
+		log.FromContext(l.ctx).WithError(err).WithField("subject", subject).Warn("Failed to get rate limit override")
+		if ok {
+			return cached.limiter
+		}
+		return nil
+	}
+	if !ok {
+		cached = &cachedOverride{}
+		l.cache[subject] = cached
+	}
+	cached.expiresAt = now.Add(l.cacheTTL)
+	switch {
+	case override == nil:
+		cached.override, cached.limiter = nil, nil
+	case cached.override == nil || *cached.override != *override:
+		// Keep the state of the limiter unless the override changed.
+		store, err := l.newStore(subject)
+		if err != nil {
+			log.FromContext(l.ctx).WithError(err).Warn("Failed to create rate limit override store")
+			return nil
+		}
+		limiter, err := newProfile(l.ctx, config.RateLimitingProfile{
+			Name:      "override",
+			MaxPerMin: override.MaxPerMin,
+			MaxBurst:  override.MaxBurst,
+		}, store, l.exportCallers)
+		if err != nil {
+			log.FromContext(l.ctx).WithError(err).WithField("subject", subject).Warn("Invalid rate limit override")
+			return nil
+		}
+		cached.override, cached.limiter = override, limiter
+	}
+	return cached.limiter
+}
+
+// RateLimit rate limits the resource by the override of the first subject of the resource
+// that has an override. It returns false if no subject of the resource has an override.
+func (l *overrideLimiter) RateLimit(resource Resource) (ok bool, limit bool, result Result) {
+	subjecter, isSubjecter := resource.(overrideSubjecter)
+	if !isSubjecter {
+		return false, false, Result{}
+	}
+	for _, subject := range subjecter.overrideSubjects() {
+		if limiter := l.limiter(subject); limiter != nil {
+			limit, result := limiter.RateLimit(resource)
+			return true, limit, result
+		}
+	}
+	return false, false, Result{}
+}
@@ -0,0 +1,107 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestOverrides(t *testing.T) {
+	a, ctx := test.New(t)
+
+	store := ratelimit.NewMemoryOverrideStore()
+	limiter, err := ratelimit.New(ctx, config.RateLimiting{
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "MQTT downlink",
+				MaxPerMin:    maxRate,
+				Associations: []string{"as:down:mqtt"},
+			},
+		},
+		// Do not cache the overrides, so that changes apply immediately.
+		OverridesCacheTTL: time.Nanosecond,
+	}, config.BlobConfig{}, nil, ratelimit.WithOverrideStore(store))
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	app1 := &ttnpb.ApplicationIdentifiers{ApplicationId: "app1"}
+	app2 := &ttnpb.ApplicationIdentifiers{ApplicationId: "app2"}
+	for _, override := range []*ratelimit.Override{
+		{Subject: ratelimit.ApplicationSubject(app1), MaxPerMin: 2},
+		{Subject: ratelimit.APIKeySubject("key1"), MaxPerMin: 3},
+	} {
+		if !a.So(store.SetOverride(ctx, override), should.BeNil) {
+			t.FailNow()
+		}
+	}
+
+	overrides, err := store.ListOverrides(ctx)
+	a.So(err, should.BeNil)
+	a.So(overrides, should.HaveLength, 2)
+	a.So(overrides[0].Subject, should.Equal, "api-key:key1")
+	a.So(overrides[1].Subject, should.Equal, "application:app1")
+
+	for _, tc := range []struct {
+		name     string
+		resource ratelimit.Resource
+		rate     uint
+	}{
+		{
+			name:     "Application",
+			resource: ratelimit.ApplicationMQTTDownResource(ctx, app1, ""),
+			rate:     2,
+		},
+		{
+			name:     "APIKeyBeforeApplication",
+			resource: ratelimit.ApplicationMQTTDownResource(ctx, app1, "key1"),
+			rate:     3,
+		},
+		{
+			name:     "ClassDefault",
+			resource: ratelimit.ApplicationMQTTDownResource(ctx, app2, "key2"),
+			rate:     maxRate,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			a := assertions.New(t)
+			for i := uint(0); i < tc.rate; i++ {
+				limit, result := limiter.RateLimit(tc.resource)
+				a.So(limit, should.BeFalse)
+				a.So(result.Limit, should.Equal, tc.rate)
+			}
+			limit, _ := limiter.RateLimit(tc.resource)
+			a.So(limit, should.BeTrue)
+		})
+	}
+
+	// Deleting the override applies the class default again.
+	a.So(store.DeleteOverride(ctx, ratelimit.ApplicationSubject(app1)), should.BeNil)
+	limit, result := limiter.RateLimit(ratelimit.ApplicationMQTTDownResource(ctx, app1, ""))
+	a.So(limit, should.BeFalse)
+	a.So(result.Limit, should.Equal, maxRate)
+
+	err = store.DeleteOverride(ctx, ratelimit.ApplicationSubject(app1))
+	a.So(errors.IsNotFound(err), should.BeTrue)
+}
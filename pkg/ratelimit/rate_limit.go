@@ -40,7 +40,10 @@ func (*NoopRateLimiter) RateLimit(Resource) (bool, Result) {
 
 type rateLimiter struct {
 	ctx     context.Context
+	name    string
 	limiter throttled.RateLimiter
+
+	exportCallers bool
 }
 
 // RateLimit implements ratelimit.Interface.
@@ -48,9 +51,18 @@ func (l *rateLimiter) RateLimit(resource Resource) (bool, Result) {
 	ok, result, err := l.limiter.RateLimit(resource.Key(), 1)
 	if err != nil {
 		// NOTE: The memstore.MemStore implementation does not fail.
-		log.FromContext(l.ctx).Error("Rate limiter failed")
+		log.FromContext(l.ctx).WithError(err).Error("Rate limiter failed")
+		requests.WithLabelValues(l.name, "error").Inc()
 		return true, Result{}
 	}
+	if ok {
+		requests.WithLabelValues(l.name, "limited").Inc()
+		if l.exportCallers {
+			callerLimited.WithLabelValues(l.name, resource.Key()).Inc()
+		}
+	} else {
+		requests.WithLabelValues(l.name, "allowed").Inc()
+	}
 
 	return ok, Result{
 		Limit:      result.Limit,
@@ -61,14 +73,21 @@ func (l *rateLimiter) RateLimit(resource Resource) (bool, Result) {
 }
 
 // muxRateLimiter is a ratelimit.Interface that supports multiple rate limiting profiles.
+// Overrides of the subjects of a resource are evaluated before the rate limiting profiles.
 // If no rate limiting profile is set for a resource class, then no rate limits are applied.
 type muxRateLimiter struct {
 	defaultLimiter Interface
 	limiters       map[string]Interface
+	overrides      *overrideLimiter
 }
 
 // RateLimit implements ratelimit.Interface.
 func (l *muxRateLimiter) RateLimit(resource Resource) (bool, Result) {
+	if l.overrides != nil {
+		if ok, limit, result := l.overrides.RateLimit(resource); ok {
+			return limit, result
+		}
+	}
 	for _, c := range resource.Classes() {
 		if limiter, ok := l.limiters[c]; ok {
 			return limiter.RateLimit(resource)
@@ -0,0 +1,115 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	ttnredis "go.thethings.network/lorawan-stack/v3/pkg/redis"
+)
+
+// compareAndSwapScript atomically replaces the value of KEYS[1] with ARGV[2] if
+// the current value is ARGV[1], and sets the TTL of the key to ARGV[3] milliseconds.
+// The script returns -1 if the key does not exist, 0 if the value does not match
+// and 1 if the value was swapped.
+var compareAndSwapScript = redis.NewScript(`local v = redis.call('get', KEYS[1])
+if v == false then
+	return -1
+end
+if v ~= ARGV[1] then
+	return 0
+end
+redis.call('set', KEYS[1], ARGV[2], 'px', ARGV[3])
+return 1`)
+
+// redisStore is a throttled.GCRAStore backed by Redis.
+// Rate limiter instances that share the same Redis store apply rate limits cluster-wide.
+type redisStore struct {
+	ctx    context.Context
+	cl     *ttnredis.Client
+	prefix string
+}
+
+func newRedisStore(ctx context.Context, cl *ttnredis.Client, prefix string) *redisStore {
+	return &redisStore{
+		ctx:    ctx,
+		cl:     cl,
+		prefix: prefix,
+	}
+}
+
+func (s *redisStore) key(k string) string {
+	return s.cl.Key(s.prefix, k)
+}
+
+// redisTTL returns the TTL, truncated to milliseconds. A TTL of zero would expire
+// the key immediately, so the minimum TTL is one millisecond.
+func redisTTL(ttl time.Duration) time.Duration {
+	if ttl < time.Millisecond {
+		return time.Millisecond
+	}
+	return ttl.Truncate(time.Millisecond)
+}
+
+// GetWithTime implements throttled.GCRAStore.
+// The returned time is the time of the Redis server, so that all rate limiter
+// instances share the same clock.
+func (s *redisStore) GetWithTime(key string) (int64, time.Time, error) {
+	var (
+		timeCmd *redis.TimeCmd
+		getCmd  *redis.StringCmd
+	)
+	if _, err := s.cl.Pipelined(s.ctx, func(p redis.Pipeliner) error {
+		timeCmd = p.Time(s.ctx)
+		getCmd = p.Get(s.ctx, s.key(key))
+		return nil
+	}); err != nil && err != redis.Nil {
+		return 0, time.Time{}, ttnredis.ConvertError(err)
+	}
+	now, err := timeCmd.Result()
+	if err != nil {
+		return 0, time.Time{}, ttnredis.ConvertError(err)
+	}
+	v, err := getCmd.Int64()
+	if err == redis.Nil {
+		return -1, now, nil
+	}
+	if err != nil {
+		return 0, time.Time{}, ttnredis.ConvertError(err)
+	}
+	return v, now, nil
+}
+
+// SetIfNotExistsWithTTL implements throttled.GCRAStore.
+func (s *redisStore) SetIfNotExistsWithTTL(key string, value int64, ttl time.Duration) (bool, error) {
+	ok, err := s.cl.SetNX(s.ctx, s.key(key), value, redisTTL(ttl)).Result()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return ok, nil
+}
+
+// CompareAndSwapWithTTL implements throttled.GCRAStore.
+func (s *redisStore) CompareAndSwapWithTTL(key string, old, new int64, ttl time.Duration) (bool, error) {
+	res, err := compareAndSwapScript.Run(
+		s.ctx, s.cl, []string{s.key(key)}, old, new, redisTTL(ttl).Milliseconds(),
+	).Int64()
+	if err != nil {
+		return false, ttnredis.ConvertError(err)
+	}
+	return res == 1, nil
+}
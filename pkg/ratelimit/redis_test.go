@@ -0,0 +1,80 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimit_test
+
+import (
+	"testing"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/config"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/ratelimit"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestRedisRateLimit(t *testing.T) {
+	a, ctx := test.New(t)
+
+	cl, flush := test.NewRedis(ctx, "ratelimit_test")
+	defer flush()
+	defer cl.Close()
+
+	conf := config.RateLimiting{
+		Provider: "redis",
+		Profiles: []config.RateLimitingProfile{
+			{
+				Name:         "Default profile",
+				MaxPerMin:    maxRate,
+				MaxBurst:     maxRate,
+				Associations: []string{"default"},
+			},
+		},
+	}
+
+	// Two limiters sharing the same Redis store behave like two instances in a cluster.
+	var limiters []ratelimit.Interface
+	for i := 0; i < 2; i++ {
+		limiter, err := ratelimit.New(ctx, conf, config.BlobConfig{}, nil, ratelimit.WithRedisClient(cl))
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		limiters = append(limiters, limiter)
+	}
+
+	resource := &mockResource{"key1", []string{"default"}}
+	for i := uint(0); i < maxRate; i++ {
+		limit, result := limiters[i%2].RateLimit(resource)
+		a.So(limit, should.BeFalse)
+		a.So(result.Limit, should.Equal, maxRate)
+		a.So(result.Remaining, should.Equal, maxRate-i-1)
+	}
+	for _, limiter := range limiters {
+		limit, result := limiter.RateLimit(resource)
+		a.So(limit, should.BeTrue)
+		a.So(result.Remaining, should.Equal, 0)
+		a.So(result.RetryAfter, should.BeGreaterThan, 0)
+	}
+
+	// Other resources are not limited.
+	limit, _ := limiters[0].RateLimit(&mockResource{"key2", []string{"default"}})
+	a.So(limit, should.BeFalse)
+}
+
+func TestInvalidProvider(t *testing.T) {
+	a, ctx := test.New(t)
+
+	_, err := ratelimit.New(ctx, config.RateLimiting{Provider: "unknown"}, config.BlobConfig{}, nil)
+	a.So(errors.IsInvalidArgument(err), should.BeTrue)
+}
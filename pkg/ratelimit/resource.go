@@ -22,6 +22,7 @@ import (
 
 	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/types"
 	"go.thethings.network/lorawan-stack/v3/pkg/unique"
 )
 
@@ -37,50 +38,71 @@ type Resource interface {
 }
 
 type resource struct {
-	key     string
-	classes []string
+	key      string
+	classes  []string
+	subjects []string
 }
 
-func (r *resource) Key() string       { return r.key }
-func (r *resource) Classes() []string { return r.classes }
+func (r *resource) Key() string                { return r.key }
+func (r *resource) Classes() []string          { return r.classes }
+func (r *resource) overrideSubjects() []string { return r.subjects }
+
+// overrideSubjectsOf returns the override subjects of the API key and the application.
+// The API key takes precedence over the application.
+func overrideSubjectsOf(authTokenID string, ids *ttnpb.ApplicationIdentifiers) []string {
+	var subjects []string
+	if authTokenID != "" && authTokenID != "unauthenticated" {
+		subjects = append(subjects, APIKeySubject(authTokenID))
+	}
+	if ids.GetApplicationId() != "" {
+		subjects = append(subjects, ApplicationSubject(ids))
+	}
+	return subjects
+}
 
 // httpRequestResource represents an HTTP request. Avoid using directly, use HTTPMiddleware instead.
 func httpRequestResource(r *http.Request, class string) Resource {
 	return &resource{
-		key:     fmt.Sprintf("%s:ip:%s:url:%s", class, httpRemoteIP(r), r.URL.Path),
-		classes: []string{class, "http"},
+		key:      fmt.Sprintf("%s:ip:%s:url:%s", class, httpRemoteIP(r), r.URL.Path),
+		classes:  []string{class, "http"},
+		subjects: overrideSubjectsOf(httpAuthTokenID(r), nil),
 	}
 }
 
 // grpcMethodResource represents a gRPC request.
 func grpcMethodResource(ctx context.Context, fullMethod string, req any) Resource {
 	key := fmt.Sprintf("grpc:method:%s:%s", fullMethod, grpcEntityFromRequest(ctx, req))
-	if authTokenID := grpcAuthTokenID(ctx); authTokenID != "" {
+	authTokenID := grpcAuthTokenID(ctx)
+	if authTokenID != "" {
 		key = fmt.Sprintf("%s:token:%s", key, authTokenID)
 	}
 	return &resource{
-		key:     key,
-		classes: []string{fmt.Sprintf("grpc:method:%s", fullMethod), "grpc:method"},
+		key:      key,
+		classes:  []string{fmt.Sprintf("grpc:method:%s", fullMethod), "grpc:method"},
+		subjects: overrideSubjectsOf(authTokenID, grpcApplicationFromRequest(req)),
 	}
 }
 
 // grpcStreamAcceptResource represents a new gRPC server stream.
 func grpcStreamAcceptResource(ctx context.Context, fullMethod string) Resource {
 	key := fmt.Sprintf("grpc:stream:accept:%s", fullMethod)
-	if authTokenID := grpcAuthTokenID(ctx); authTokenID != "" {
+	authTokenID := grpcAuthTokenID(ctx)
+	if authTokenID != "" {
 		key = fmt.Sprintf("%s:token:%s", key, authTokenID)
 	}
 	return &resource{
-		key:     key,
-		classes: []string{fmt.Sprintf("grpc:stream:accept:%s", fullMethod), "grpc:stream:accept"},
+		key:      key,
+		classes:  []string{fmt.Sprintf("grpc:stream:accept:%s", fullMethod), "grpc:stream:accept"},
+		subjects: overrideSubjectsOf(authTokenID, nil),
 	}
 }
 
 // grpcStreamUpResource represents client messages for a gRPC server stream.
 func grpcStreamUpResource(ctx context.Context, fullMethod string) Resource {
 	return &resource{
-		key:     fmt.Sprintf("grpc:stream:up:%s:streamID:%s", fullMethod, events.NewCorrelationID()),
-		classes: []string{fmt.Sprintf("grpc:stream:up:%s", fullMethod), "grpc:stream:up"},
+		key:      fmt.Sprintf("grpc:stream:up:%s:streamID:%s", fullMethod, events.NewCorrelationID()),
+		classes:  []string{fmt.Sprintf("grpc:stream:up:%s", fullMethod), "grpc:stream:up"},
+		subjects: overrideSubjectsOf(grpcAuthTokenID(ctx), nil),
 	}
 }
 
@@ -131,8 +153,9 @@ func ApplicationMQTTDownResource(ctx context.Context, ids *ttnpb.ApplicationIden
 		key = fmt.Sprintf("%s:token:%s", key, authTokenID)
 	}
 	return &resource{
-		key:     key,
-		classes: []string{"as:down:mqtt"},
+		key:      key,
+		classes:  []string{"as:down:mqtt"},
+		subjects: overrideSubjectsOf(authTokenID, ids),
 	}
 }
 
@@ -143,12 +166,37 @@ func ApplicationWebhooksDownResource(ctx context.Context, ids *ttnpb.EndDeviceId
 		key = fmt.Sprintf("%s:token:%s", key, authTokenID)
 	}
 	return &resource{
-		key:     key,
-		classes: []string{"as:down:web"},
+		key:      key,
+		classes:  []string{"as:down:web"},
+		subjects: overrideSubjectsOf(authTokenID, ids.GetApplicationIds()),
+	}
+}
+
+// NetworkServerJoinRequestResource represents join-requests of an end device received by the Network Server.
+func NetworkServerJoinRequestResource(joinEUI, devEUI types.EUI64) Resource {
+	return &resource{
+		key:     fmt.Sprintf("ns:up:join:dev:%s:%s", joinEUI, devEUI),
+		classes: []string{"ns:up:join:dev", "ns:up:join"},
+	}
+}
+
+// NetworkServerGatewayJoinRequestResource represents join-requests forwarded by a gateway to the Network Server.
+func NetworkServerGatewayJoinRequestResource(ctx context.Context, ids *ttnpb.GatewayIdentifiers) Resource {
+	return &resource{
+		key:     fmt.Sprintf("ns:up:join:gtw:%s", unique.ID(ctx, ids)),
+		classes: []string{"ns:up:join:gtw", "ns:up:join"},
+	}
+}
+
+// JoinServerJoinRequestResource represents join-requests of an end device handled by the Join Server.
+func JoinServerJoinRequestResource(joinEUI, devEUI types.EUI64) Resource {
+	return &resource{
+		key:     fmt.Sprintf("js:join:dev:%s:%s", joinEUI, devEUI),
+		classes: []string{"js:join:dev"},
 	}
 }
 
 // NewCustomResource returns a new resource. It is used internally by other components.
 func NewCustomResource(key string, classes ...string) Resource {
-	return &resource{key, classes}
+	return &resource{key: key, classes: classes}
 }
@@ -0,0 +1,211 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package circuitbreaker implements a gRPC client interceptor that fails calls fast when the server keeps failing.
+package circuitbreaker
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// StateClosed is the state in which calls are allowed.
+	StateClosed State = iota
+	// StateHalfOpen is the state in which a single trial call is allowed.
+	StateHalfOpen
+	// StateOpen is the state in which calls are rejected.
+	StateOpen
+)
+
+// String implements fmt.Stringer.
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half_open"
+	case StateOpen:
+		return "open"
+	default:
+		return "unknown"
+	}
+}
+
+// Config is the configuration of a circuit breaker.
+type Config struct {
+	Enable           bool          `name:"enable" description:"Enable the circuit breaker"`
+	FailureThreshold uint          `name:"failure-threshold" description:"Number of consecutive failed calls after which the circuit opens"` //nolint:lll
+	OpenTimeout      time.Duration `name:"open-timeout" description:"Time after which an open circuit allows a trial call"`
+}
+
+// Validator is a method that validates if an error counts as a failure of the server.
+type Validator func(error) bool
+
+// DefaultValidators is the set of functions that validate errors that count as a failure of the server.
+var DefaultValidators = []Validator{
+	Validator(errors.IsUnavailable),
+	Validator(errors.IsDeadlineExceeded),
+}
+
+var errOpen = errors.DefineUnavailable("open", "circuit breaker `{name}` is open")
+
+// Breaker is a circuit breaker.
+// The circuit opens after the configured number of consecutive failed calls, and rejects calls until the open
+// timeout passed. Then, a single trial call is allowed; if it succeeds the circuit closes, otherwise it opens again.
+type Breaker struct {
+	name       string
+	conf       Config
+	validators []Validator
+	now        func() time.Time
+
+	mu       sync.Mutex
+	state    State
+	failures uint
+	openedAt time.Time
+	trial    bool
+}
+
+// New returns a new circuit breaker with the given name.
+// The name is used in errors and metrics.
+func New(name string, conf Config, validators ...Validator) *Breaker {
+	if len(validators) == 0 {
+		validators = DefaultValidators
+	}
+	if conf.FailureThreshold == 0 {
+		conf.FailureThreshold = 1
+	}
+	b := &Breaker{
+		name:       name,
+		conf:       conf,
+		validators: validators,
+		now:        time.Now,
+	}
+	initState(name)
+	return b
+}
+
+// State returns the current state of the circuit breaker.
+func (b *Breaker) State() State {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.state
+}
+
+// setState must be called with the mutex held.
+func (b *Breaker) setState(state State) {
+	if b.state == state {
+		return
+	}
+	b.state = state
+	setState(b.name, state)
+}
+
+// allow returns whether a call is allowed, and whether the call is the trial call of a half-open circuit.
+func (b *Breaker) allow() (trial bool, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	switch b.state {
+	case StateOpen:
+		if b.now().Sub(b.openedAt) < b.conf.OpenTimeout {
+			break
+		}
+		b.setState(StateHalfOpen)
+		b.trial = true
+		return true, nil
+	case StateHalfOpen:
+		if b.trial {
+			break
+		}
+		b.trial = true
+		return true, nil
+	default:
+		return false, nil
+	}
+	registerRejected(b.name)
+	return false, errOpen.WithAttributes("name", b.name)
+}
+
+func (b *Breaker) isFailure(err error) bool {
+	if err == nil {
+		return false
+	}
+	for _, validator := range b.validators {
+		if validator(err) {
+			return true
+		}
+	}
+	return false
+}
+
+// done records the result of a call.
+func (b *Breaker) done(trial bool, err error) {
+	failure := b.isFailure(err)
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if trial {
+		b.trial = false
+	}
+	switch {
+	case b.state == StateHalfOpen && trial && failure:
+		b.openedAt = b.now()
+		b.setState(StateOpen)
+	case b.state == StateHalfOpen && trial:
+		b.failures = 0
+		b.setState(StateClosed)
+	case b.state == StateClosed && failure:
+		b.failures++
+		if b.failures >= b.conf.FailureThreshold {
+			b.failures = 0
+			b.openedAt = b.now()
+			b.setState(StateOpen)
+		}
+	case b.state == StateClosed:
+		b.failures = 0
+	}
+}
+
+// Do calls f if the circuit allows it, and records the result.
+func (b *Breaker) Do(f func() error) error {
+	trial, err := b.allow()
+	if err != nil {
+		return err
+	}
+	err = f()
+	b.done(trial, err)
+	return err
+}
+
+// UnaryClientInterceptor returns a new unary client interceptor that rejects calls when the circuit is open.
+func (b *Breaker) UnaryClientInterceptor() grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply any,
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		return b.Do(func() error {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		})
+	}
+}
@@ -0,0 +1,108 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"github.com/smarty/assertions/should"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"google.golang.org/grpc"
+)
+
+var (
+	errTestUnavailable = errors.DefineUnavailable("test_unavailable", "test unavailable")
+	errTestNotFound    = errors.DefineNotFound("test_not_found", "test not found")
+)
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	now := time.Unix(0, 0)
+	b := New("test", Config{
+		Enable:           true,
+		FailureThreshold: 3,
+		OpenTimeout:      10 * time.Second,
+	})
+	b.now = func() time.Time { return now }
+
+	fail := func() error { return errTestUnavailable.New() }
+	succeed := func() error { return nil }
+
+	// Errors that do not indicate a failure of the server reset the failures.
+	a.So(errors.IsUnavailable(b.Do(fail)), should.BeTrue)
+	a.So(errors.IsUnavailable(b.Do(fail)), should.BeTrue)
+	a.So(errors.IsNotFound(b.Do(func() error { return errTestNotFound.New() })), should.BeTrue)
+	a.So(errors.IsUnavailable(b.Do(fail)), should.BeTrue)
+	a.So(errors.IsUnavailable(b.Do(fail)), should.BeTrue)
+	a.So(b.State(), should.Equal, StateClosed)
+
+	// The third consecutive failure opens the circuit.
+	a.So(errors.IsUnavailable(b.Do(fail)), should.BeTrue)
+	a.So(b.State(), should.Equal, StateOpen)
+
+	called := false
+	err := b.Do(func() error {
+		called = true
+		return nil
+	})
+	a.So(errors.Resemble(err, errOpen), should.BeTrue)
+	a.So(called, should.BeFalse)
+
+	// After the open timeout, a single trial call is allowed.
+	now = now.Add(10 * time.Second)
+	err = b.Do(func() error {
+		a.So(b.State(), should.Equal, StateHalfOpen)
+		a.So(errors.Resemble(b.Do(succeed), errOpen), should.BeTrue)
+		return errTestUnavailable.New()
+	})
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	a.So(b.State(), should.Equal, StateOpen)
+	a.So(errors.Resemble(b.Do(succeed), errOpen), should.BeTrue)
+
+	// A successful trial call closes the circuit.
+	now = now.Add(10 * time.Second)
+	a.So(b.Do(succeed), should.BeNil)
+	a.So(b.State(), should.Equal, StateClosed)
+	a.So(b.Do(succeed), should.BeNil)
+}
+
+func TestUnaryClientInterceptor(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := context.Background()
+
+	b := New("test_interceptor", Config{
+		Enable:           true,
+		FailureThreshold: 1,
+		OpenTimeout:      time.Hour,
+	})
+	interceptor := b.UnaryClientInterceptor()
+
+	calls := 0
+	invoker := func(context.Context, string, any, any, *grpc.ClientConn, ...grpc.CallOption) error {
+		calls++
+		return errTestUnavailable.New()
+	}
+	err := interceptor(ctx, "/test.Service/Method", nil, nil, nil, invoker)
+	a.So(errors.IsUnavailable(err), should.BeTrue)
+	err = interceptor(ctx, "/test.Service/Method", nil, nil, nil, invoker)
+	a.So(errors.Resemble(err, errOpen), should.BeTrue)
+	a.So(calls, should.Equal, 1)
+}
@@ -0,0 +1,88 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package circuitbreaker
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"go.thethings.network/lorawan-stack/v3/pkg/metrics"
+)
+
+const (
+	subsystem  = "circuit_breaker"
+	nameLabel  = "name"
+	stateLabel = "state"
+)
+
+var breakerMetrics = &stateMetrics{
+	state: prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Subsystem: subsystem,
+			Name:      "state",
+			Help:      "State of circuit breakers (0 is closed, 1 is half open, 2 is open)",
+		},
+		[]string{nameLabel},
+	),
+	transitions: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "transitions_total",
+			Help:      "Total number of state transitions of circuit breakers",
+		},
+		[]string{nameLabel, stateLabel},
+	),
+	rejected: prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Subsystem: subsystem,
+			Name:      "rejected_total",
+			Help:      "Total number of calls rejected by circuit breakers",
+		},
+		[]string{nameLabel},
+	),
+}
+
+func init() {
+	metrics.MustRegister(breakerMetrics)
+}
+
+type stateMetrics struct {
+	state       *prometheus.GaugeVec
+	transitions *prometheus.CounterVec
+	rejected    *prometheus.CounterVec
+}
+
+func (m stateMetrics) Describe(ch chan<- *prometheus.Desc) {
+	m.state.Describe(ch)
+	m.transitions.Describe(ch)
+	m.rejected.Describe(ch)
+}
+
+func (m stateMetrics) Collect(ch chan<- prometheus.Metric) {
+	m.state.Collect(ch)
+	m.transitions.Collect(ch)
+	m.rejected.Collect(ch)
+}
+
+func initState(name string) {
+	breakerMetrics.state.WithLabelValues(name).Set(float64(StateClosed))
+}
+
+func setState(name string, state State) {
+	breakerMetrics.state.WithLabelValues(name).Set(float64(state))
+	breakerMetrics.transitions.WithLabelValues(name, state.String()).Inc()
+}
+
+func registerRejected(name string) {
+	breakerMetrics.rejected.WithLabelValues(name).Inc()
+}
@@ -23,6 +23,7 @@ import (
 	grpc_ctxtags "github.com/grpc-ecosystem/go-grpc-middleware/tags"
 	"go.thethings.network/lorawan-stack/v3/pkg/auth"
 	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/events"
 	"go.thethings.network/lorawan-stack/v3/pkg/log"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
@@ -58,6 +59,10 @@ func logFieldsForCall(ctx context.Context, fullMethodString string) (once, propa
 		"grpc.method", method,
 	)
 
+	if cids := events.CorrelationIDsFromContext(ctx); len(cids) > 0 {
+		propagated = propagated.WithField("correlation_ids", cids)
+	}
+
 	if ctxFields := grpc_ctxtags.Extract(ctx).Values(); len(ctxFields) > 0 {
 		once = once.With(ctxFields)
 	}
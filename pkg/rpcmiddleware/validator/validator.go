@@ -182,6 +182,13 @@ func validateMessage(ctx context.Context, fullMethod string, msg any) error {
 	}
 }
 
+// ValidateMessage validates the message like the interceptors validate the messages of the RPC with the given full
+// method name. This is used for messages that do not pass through the interceptors, such as the messages for each
+// end device of a batch RPC.
+func ValidateMessage(ctx context.Context, fullMethod string, msg any) error {
+	return validateMessage(ctx, fullMethod, msg)
+}
+
 // UnaryServerInterceptor returns a new unary server interceptor that validates
 // incoming messages if those incoming messages implement:
 //
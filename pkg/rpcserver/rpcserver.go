@@ -50,6 +50,7 @@ import (
 	_ "google.golang.org/grpc/encoding/gzip" // Register gzip compression.
 	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 )
 
 func init() {
@@ -67,6 +68,9 @@ type options struct {
 	trustedProxies     []string
 	logIgnoreMethods   []string
 	limiter            ratelimit.Interface
+	reflection         bool
+	richErrorDetails   bool
+	heartbeatInterval  time.Duration
 }
 
 // Option for the gRPC server
@@ -121,6 +125,28 @@ func WithRateLimiter(limiter ratelimit.Interface) Option {
 	}
 }
 
+// WithReflection enables gRPC server reflection, so that generic gRPC tooling can introspect the API.
+func WithReflection(enable bool) Option {
+	return func(o *options) {
+		o.reflection = enable
+	}
+}
+
+// WithRichErrorDetails adds the google.rpc.ErrorInfo and google.rpc.BadRequest details to returned errors.
+func WithRichErrorDetails(enable bool) Option {
+	return func(o *options) {
+		o.richErrorDetails = enable
+	}
+}
+
+// WithStreamHeartbeatInterval configures the interval of heartbeats in idle NDJSON and event streams
+// of the HTTP gateway. Heartbeats are disabled if the interval is not positive.
+func WithStreamHeartbeatInterval(interval time.Duration) Option {
+	return func(o *options) {
+		o.heartbeatInterval = interval
+	}
+}
+
 // ErrRPCRecovered is returned when a panic is caught from an RPC.
 var ErrRPCRecovered = errors.DefineInternal("rpc_recovered", "Internal Server Error")
 
@@ -135,7 +161,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 	for _, opt := range opts {
 		opt(options)
 	}
-	server := &Server{ctx: ctx, Hooks: &hooks.Hooks{}}
+	server := &Server{ctx: ctx, Hooks: &hooks.Hooks{}, heartbeatInterval: options.heartbeatInterval}
 	ctxtagsOpts := []grpc_ctxtags.Option{
 		grpc_ctxtags.WithFieldExtractor(grpc_ctxtags.CodeGenRequestFieldExtractor),
 	}
@@ -163,7 +189,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 		events.StreamServerInterceptor,
 		rpclog.StreamServerInterceptor(ctx, rpclog.WithIgnoreMethods(options.logIgnoreMethods)),
 		metrics.StreamServerInterceptor,
-		errors.StreamServerInterceptor(),
+		errors.StreamServerInterceptor(errors.WithRichDetailsInterceptor(options.richErrorDetails)),
 		// NOTE: All middleware that works with lorawan-stack/pkg/errors errors must be placed below.
 		sentrymiddleware.StreamServerInterceptor(),
 		grpc_recovery.StreamServerInterceptor(recoveryOpts...),
@@ -181,7 +207,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 		events.UnaryServerInterceptor,
 		rpclog.UnaryServerInterceptor(ctx, rpclog.WithIgnoreMethods(options.logIgnoreMethods)),
 		metrics.UnaryServerInterceptor,
-		errors.UnaryServerInterceptor(),
+		errors.UnaryServerInterceptor(errors.WithRichDetailsInterceptor(options.richErrorDetails)),
 		// NOTE: All middleware that works with lorawan-stack/pkg/errors errors must be placed below.
 		sentrymiddleware.UnaryServerInterceptor(),
 		grpc_recovery.UnaryServerInterceptor(recoveryOpts...),
@@ -213,9 +239,14 @@ func New(ctx context.Context, opts ...Option) *Server {
 		)),
 	}
 	server.Server = grpc.NewServer(append(baseOptions, options.serverOptions...)...)
+	if options.reflection {
+		reflection.Register(server.Server)
+	}
 	server.ServeMux = runtime.NewServeMux(
-		runtime.WithMarshalerOption("*", jsonpb.TTN()),
-		runtime.WithMarshalerOption("text/event-stream", jsonpb.TTNEventStream()),
+		runtime.WithMarshalerOption("*", &runtime.HTTPBodyMarshaler{Marshaler: jsonpb.TTN()}),
+		runtime.WithMarshalerOption(eventStreamContentType, &streamMarshaler{Marshaler: jsonpb.TTNEventStream()}),
+		runtime.WithMarshalerOption(ndjsonContentType, &streamMarshaler{Marshaler: jsonpb.TTNNDJSON()}),
+		runtime.WithForwardResponseOption(startStream),
 		runtime.WithErrorHandler(runtime.DefaultHTTPErrorHandler),
 		runtime.WithMetadata(func(ctx context.Context, req *http.Request) metadata.MD {
 			md := rpcmetadata.MD{
@@ -229,6 +260,7 @@ func New(ctx context.Context, opts ...Option) *Server {
 			switch s {
 			case "Forwarded",
 				"X-Request-Id",
+				"X-Correlation-Id",
 				"X-Forwarded-For",
 				"X-Real-Ip",
 				"X-Forwarded-Host",
@@ -243,6 +275,8 @@ func New(ctx context.Context, opts ...Option) *Server {
 		runtime.WithOutgoingHeaderMatcher(func(s string) (string, bool) {
 			// NOTE: When adding headers, also add them to CORSConfig in ../component/grpc.go.
 			switch s {
+			case "content-disposition":
+				return "Content-Disposition", true
 			case "x-total-count":
 				return "X-Total-Count", true
 			case "x-rate-limit-limit":
@@ -282,9 +316,35 @@ type Server struct {
 	*grpc.Server
 	*hooks.Hooks
 	*runtime.ServeMux
+
+	heartbeatInterval time.Duration
 }
 
 // ServeHTTP forwards requests to the gRPC gateway
 func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	s.ServeMux.ServeHTTP(w, r)
+	contentType := streamContentType(r)
+	if contentType == "" {
+		s.ServeMux.ServeHTTP(w, r)
+		return
+	}
+	r, err := withResumeToken(r)
+	if err != nil {
+		_, outbound := runtime.MarshalerForRequest(s.ServeMux, r)
+		runtime.HTTPError(r.Context(), s.ServeMux, outbound, w, r, err)
+		return
+	}
+	if s.heartbeatInterval <= 0 {
+		s.ServeMux.ServeHTTP(w, r)
+		return
+	}
+	sw := newStreamWriter(w, contentType)
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		sw.run(stop, s.heartbeatInterval)
+	}()
+	s.ServeMux.ServeHTTP(sw, r)
+	close(stop)
+	<-done
 }
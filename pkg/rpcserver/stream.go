@@ -0,0 +1,270 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+const (
+	eventStreamContentType = "text/event-stream"
+	ndjsonContentType      = "application/x-ndjson"
+
+	// resumeTokenHeader is the header in which event stream clients send the last received resume token.
+	resumeTokenHeader = "Last-Event-ID"
+	// resumeTokenQuery is the query parameter in which clients send the last received resume token.
+	resumeTokenQuery = "resume_token"
+	// resumeTokenField is the field of the streaming request from which the stream is resumed.
+	resumeTokenField = "after"
+)
+
+// heartbeatChunk is written to idle streams, so that clients and proxies keep the connection open.
+var heartbeatChunk = []byte(`{"heartbeat":{}}`)
+
+// resumeTokenFields are the timestamp fields of streamed messages that are used as resume token.
+var resumeTokenFields = []protoreflect.Name{"time", "received_at"}
+
+// streamContentType returns the content type of the stream that the request accepts. It returns an
+// empty string if the request does not accept a stream.
+func streamContentType(r *http.Request) string {
+	for _, accept := range r.Header.Values("Accept") {
+		switch accept {
+		case eventStreamContentType, ndjsonContentType:
+			return accept
+		}
+	}
+	return ""
+}
+
+func streamDelimiter(contentType string) []byte {
+	if contentType == eventStreamContentType {
+		return []byte{'\n', '\n'}
+	}
+	return []byte{'\n'}
+}
+
+// resumeToken returns the resume token of the streamed message. The resume token is the time of the
+// message, which clients send back in order to resume the stream after the message.
+func resumeToken(msg proto.Message) (string, bool) {
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for _, name := range resumeTokenFields {
+		fd := fields.ByName(name)
+		if fd == nil || fd.Message() == nil || !m.Has(fd) {
+			continue
+		}
+		ts, ok := m.Get(fd).Message().Interface().(*timestamppb.Timestamp)
+		if !ok {
+			continue
+		}
+		return ts.AsTime().UTC().Format(time.RFC3339Nano), true
+	}
+	return "", false
+}
+
+// streamMarshaler adds the resume token to the chunks of streams.
+type streamMarshaler struct {
+	runtime.Marshaler
+}
+
+// Marshal implements runtime.Marshaler.
+func (m *streamMarshaler) Marshal(v any) ([]byte, error) {
+	if chunk, ok := v.(map[string]any); ok {
+		if msg, ok := chunk["result"].(proto.Message); ok {
+			if token, ok := resumeToken(msg); ok {
+				chunk[resumeTokenQuery] = token
+			}
+		}
+	}
+	return m.Marshaler.Marshal(v)
+}
+
+// Delimiter implements runtime.Delimited.
+func (m *streamMarshaler) Delimiter() []byte {
+	if d, ok := m.Marshaler.(runtime.Delimited); ok {
+		return d.Delimiter()
+	}
+	return []byte{'\n'}
+}
+
+var errInvalidResumeToken = errors.DefineInvalidArgument("invalid_resume_token", "invalid resume token")
+
+// withResumeToken returns the request that resumes the stream after the resume token, if the client
+// sent one. For GET requests the resume token is set in the query, otherwise in the JSON body.
+// An explicit `after` field in the request takes precedence over the resume token.
+func withResumeToken(r *http.Request) (*http.Request, error) {
+	token := r.Header.Get(resumeTokenHeader)
+	query := r.URL.Query()
+	if v := query.Get(resumeTokenQuery); v != "" {
+		token = v
+	}
+	if token == "" {
+		return r, nil
+	}
+	if _, err := time.Parse(time.RFC3339Nano, token); err != nil {
+		return r, errInvalidResumeToken.WithCause(err)
+	}
+	r = r.Clone(r.Context())
+	if r.Method == http.MethodGet {
+		if !query.Has(resumeTokenField) {
+			query.Set(resumeTokenField, token)
+		}
+		query.Del(resumeTokenQuery)
+		r.URL.RawQuery = query.Encode()
+		return r, nil
+	}
+	body := map[string]json.RawMessage{}
+	if r.Body != nil {
+		b, err := io.ReadAll(r.Body)
+		if err != nil {
+			return r, err
+		}
+		if len(bytes.TrimSpace(b)) > 0 {
+			if err := json.Unmarshal(b, &body); err != nil {
+				return r, errInvalidResumeToken.WithCause(err)
+			}
+		}
+	}
+	if _, ok := body[resumeTokenField]; !ok {
+		body[resumeTokenField], _ = json.Marshal(token)
+	}
+	b, err := json.Marshal(body)
+	if err != nil {
+		return r, err
+	}
+	r.Body = io.NopCloser(bytes.NewReader(b))
+	r.ContentLength = int64(len(b))
+	return r, nil
+}
+
+// streamWriter is a http.ResponseWriter that writes heartbeats to idle streams.
+type streamWriter struct {
+	http.ResponseWriter
+	contentType string
+	delimiter   []byte
+
+	mu        sync.Mutex
+	active    bool
+	started   chan struct{}
+	startOnce sync.Once
+}
+
+func newStreamWriter(w http.ResponseWriter, contentType string) *streamWriter {
+	return &streamWriter{
+		ResponseWriter: w,
+		contentType:    contentType,
+		delimiter:      streamDelimiter(contentType),
+		started:        make(chan struct{}),
+	}
+}
+
+// Write implements http.ResponseWriter.
+func (w *streamWriter) Write(b []byte) (int, error) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.active = true
+	return w.ResponseWriter.Write(b)
+}
+
+// WriteHeader implements http.ResponseWriter.
+func (w *streamWriter) WriteHeader(code int) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.ResponseWriter.WriteHeader(code)
+}
+
+// Flush implements http.Flusher.
+func (w *streamWriter) Flush() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// Unwrap returns the underlying http.ResponseWriter.
+func (w *streamWriter) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+// start writes the response headers of the stream, after which heartbeats can be written.
+// This must be called from the handler goroutine, before the first message of the stream.
+func (w *streamWriter) start() {
+	w.startOnce.Do(func() {
+		w.Header().Set("Content-Type", w.contentType)
+		w.WriteHeader(http.StatusOK)
+		w.Flush()
+		close(w.started)
+	})
+}
+
+// heartbeat writes a heartbeat if nothing was written since the last heartbeat.
+func (w *streamWriter) heartbeat() {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.active {
+		w.active = false
+		return
+	}
+	if _, err := w.ResponseWriter.Write(append(heartbeatChunk, w.delimiter...)); err != nil {
+		return
+	}
+	if f, ok := w.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
+// run writes heartbeats at the given interval until stop is closed.
+func (w *streamWriter) run(stop <-chan struct{}, interval time.Duration) {
+	select {
+	case <-stop:
+		return
+	case <-w.started:
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			w.heartbeat()
+		}
+	}
+}
+
+// startStream is a forward response option of the gateway. The gateway calls it without message
+// when the stream is established, before the first message.
+func startStream(_ context.Context, w http.ResponseWriter, msg proto.Message) error {
+	if msg != nil {
+		return nil
+	}
+	if sw, ok := w.(*streamWriter); ok {
+		sw.start()
+	}
+	return nil
+}
@@ -0,0 +1,91 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rpcserver_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcserver"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestStream(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+
+	server := rpcserver.New(ctx, rpcserver.WithStreamHeartbeatInterval(10*test.Delay))
+
+	eventTime := time.Date(2023, 6, 1, 12, 0, 0, 123456789, time.UTC)
+	var after string
+	handler := func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		after = r.URL.Query().Get("after")
+		ctx := runtime.NewServerMetadataContext(r.Context(), runtime.ServerMetadata{})
+		_, outbound := runtime.MarshalerForRequest(server.ServeMux, r)
+		sent := false
+		runtime.ForwardResponseStream(ctx, server.ServeMux, outbound, w, r, func() (proto.Message, error) {
+			if sent {
+				return nil, io.EOF
+			}
+			// Wait for heartbeats before the first event.
+			time.Sleep(50 * test.Delay)
+			sent = true
+			return &ttnpb.Event{Name: "test", Time: timestamppb.New(eventTime)}, nil
+		}, server.ServeMux.GetForwardResponseOptions()...)
+	}
+	if err := server.ServeMux.HandlePath(http.MethodGet, "/stream", handler); !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+
+	for _, tc := range []struct {
+		ContentType string
+		Delimiter   string
+	}{
+		{ContentType: "application/x-ndjson", Delimiter: "\n"},
+		{ContentType: "text/event-stream", Delimiter: "\n\n"},
+	} {
+		after = ""
+		req := httptest.NewRequest(http.MethodGet, "/stream?resume_token=2023-06-01T11:00:00Z", nil)
+		req.Header.Set("Accept", tc.ContentType)
+		rec := httptest.NewRecorder()
+		server.ServeHTTP(rec, req)
+
+		a.So(after, should.Equal, "2023-06-01T11:00:00Z")
+		a.So(rec.Code, should.Equal, http.StatusOK)
+		a.So(rec.Header().Get("Content-Type"), should.Equal, tc.ContentType)
+		chunks := strings.Split(strings.TrimSuffix(rec.Body.String(), tc.Delimiter), tc.Delimiter)
+		if a.So(len(chunks), should.BeGreaterThan, 1) {
+			a.So(chunks[0], should.Equal, `{"heartbeat":{}}`)
+			a.So(chunks[len(chunks)-1], should.ContainSubstring, `"resume_token":"2023-06-01T12:00:00.123456789Z"`)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/stream", nil)
+	req.Header.Set("Accept", "application/x-ndjson")
+	req.Header.Set("Last-Event-ID", "invalid")
+	rec := httptest.NewRecorder()
+	server.ServeHTTP(rec, req)
+	a.So(rec.Code, should.Equal, http.StatusBadRequest)
+
+}
@@ -0,0 +1,257 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package spiffe implements authentication of cluster peers with SPIFFE X.509-SVIDs.
+//
+// The X.509-SVID and the trust bundle are read from files, as written by the SPIRE agent or the SPIFFE helper,
+// and are reloaded periodically to pick up rotated SVIDs.
+package spiffe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+const (
+	scheme                 = "spiffe"
+	defaultRefreshInterval = 30 * time.Second
+)
+
+// Config is the configuration of the SPIFFE X.509-SVID source.
+type Config struct {
+	Enable          bool          `name:"enable" description:"Authenticate cluster peers with SPIFFE X.509-SVIDs"`
+	TrustDomain     string        `name:"trust-domain" description:"SPIFFE trust domain of the cluster peers"`
+	Certificate     string        `name:"certificate" description:"Location of the X.509-SVID certificate chain"`
+	Key             string        `name:"key" description:"Location of the X.509-SVID private key"`
+	Bundle          string        `name:"bundle" description:"Location of the X.509 trust bundle of the trust domain"`
+	RefreshInterval time.Duration `name:"refresh-interval" description:"Interval at which the X.509-SVID and the trust bundle are reloaded"` //nolint:lll
+}
+
+var (
+	errTrustDomain      = errors.DefineInvalidArgument("trust_domain", "invalid trust domain `{trust_domain}`")
+	errReadFile         = errors.DefineInvalidArgument("read_file", "read `{file}`")
+	errSVID             = errors.DefineInvalidArgument("svid", "invalid X.509-SVID")
+	errSVIDID           = errors.DefineInvalidArgument("svid_id", "X.509-SVID must have exactly one SPIFFE ID")
+	errSVIDTrustDomain  = errors.DefineInvalidArgument("svid_trust_domain", "SPIFFE ID `{id}` is not in trust domain `{trust_domain}`") //nolint:lll
+	errBundle           = errors.DefineInvalidArgument("bundle", "invalid trust bundle")
+	errNoPeerSVID       = errors.DefineUnauthenticated("no_peer_svid", "no verified peer X.509-SVID")
+	errPeerTrustDomain  = errors.DefinePermissionDenied("peer_trust_domain", "peer SPIFFE ID `{id}` is not in trust domain `{trust_domain}`") //nolint:lll
+	errInvalidSPIFFEID  = errors.DefineInvalidArgument("spiffe_id", "invalid SPIFFE ID `{id}`")
+	errNoSPIFFEIDInCert = errors.DefineInvalidArgument("no_spiffe_id", "no SPIFFE ID in certificate")
+)
+
+// validTrustDomain returns whether the trust domain consists of lowercase letters, digits, dots, dashes and underscores.
+func validTrustDomain(td string) bool {
+	if td == "" {
+		return false
+	}
+	for _, r := range td {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9', r == '.', r == '-', r == '_':
+		default:
+			return false
+		}
+	}
+	return true
+}
+
+// idFromCertificate returns the SPIFFE ID of the certificate.
+func idFromCertificate(cert *x509.Certificate) (*url.URL, error) {
+	var id *url.URL
+	for _, uri := range cert.URIs {
+		if uri.Scheme != scheme {
+			continue
+		}
+		if id != nil {
+			return nil, errSVIDID.New()
+		}
+		id = uri
+	}
+	if id == nil {
+		return nil, errNoSPIFFEIDInCert.New()
+	}
+	if !validTrustDomain(id.Host) || id.User != nil || id.Port() != "" || id.RawQuery != "" || id.Fragment != "" {
+		return nil, errInvalidSPIFFEID.WithAttributes("id", id.String())
+	}
+	return id, nil
+}
+
+// Source is a source of the X.509-SVID of this peer and the trust bundle of the trust domain.
+type Source struct {
+	conf Config
+
+	mu     sync.RWMutex
+	svid   *tls.Certificate
+	id     *url.URL
+	bundle *x509.CertPool
+}
+
+// NewSource returns a new Source that loads the X.509-SVID and the trust bundle, and reloads them periodically.
+func NewSource(ctx context.Context, starter task.Starter, conf Config) (*Source, error) {
+	if !validTrustDomain(conf.TrustDomain) {
+		return nil, errTrustDomain.WithAttributes("trust_domain", conf.TrustDomain)
+	}
+	if conf.RefreshInterval <= 0 {
+		conf.RefreshInterval = defaultRefreshInterval
+	}
+	s := &Source{conf: conf}
+	if err := s.load(); err != nil {
+		return nil, err
+	}
+	starter.StartTask(&task.Config{
+		Context: ctx,
+		ID:      "spiffe_refresh",
+		Func:    s.refresh,
+		Restart: task.RestartOnFailure,
+		Backoff: task.DefaultBackoffConfig,
+	})
+	return s, nil
+}
+
+func readFile(name string) ([]byte, error) {
+	b, err := os.ReadFile(name)
+	if err != nil {
+		return nil, errReadFile.WithAttributes("file", name).WithCause(err)
+	}
+	return b, nil
+}
+
+// load loads the X.509-SVID and the trust bundle, and verifies the X.509-SVID with the trust bundle.
+func (s *Source) load() error {
+	certPEM, err := readFile(s.conf.Certificate)
+	if err != nil {
+		return err
+	}
+	keyPEM, err := readFile(s.conf.Key)
+	if err != nil {
+		return err
+	}
+	bundlePEM, err := readFile(s.conf.Bundle)
+	if err != nil {
+		return err
+	}
+
+	svid, err := tls.X509KeyPair(certPEM, keyPEM)
+	if err != nil {
+		return errSVID.WithCause(err)
+	}
+	leaf, err := x509.ParseCertificate(svid.Certificate[0])
+	if err != nil {
+		return errSVID.WithCause(err)
+	}
+	svid.Leaf = leaf
+	id, err := idFromCertificate(leaf)
+	if err != nil {
+		return errSVID.WithCause(err)
+	}
+	if id.Host != s.conf.TrustDomain {
+		return errSVIDTrustDomain.WithAttributes("id", id.String(), "trust_domain", s.conf.TrustDomain)
+	}
+
+	bundle := x509.NewCertPool()
+	if !bundle.AppendCertsFromPEM(bundlePEM) {
+		return errBundle.New()
+	}
+	intermediates := x509.NewCertPool()
+	for _, raw := range svid.Certificate[1:] {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return errSVID.WithCause(err)
+		}
+		intermediates.AddCert(cert)
+	}
+	if _, err := leaf.Verify(x509.VerifyOptions{
+		Roots:         bundle,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return errSVID.WithCause(err)
+	}
+
+	s.mu.Lock()
+	s.svid, s.id, s.bundle = &svid, id, bundle
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *Source) refresh(ctx context.Context) error {
+	logger := log.FromContext(ctx)
+	ticker := time.NewTicker(s.conf.RefreshInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+		if err := s.load(); err != nil {
+			logger.WithError(err).Warn("Failed to reload X.509-SVID")
+		}
+	}
+}
+
+// ID returns the SPIFFE ID of this peer.
+func (s *Source) ID() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.id.String()
+}
+
+// Bundle returns the trust bundle of the trust domain.
+func (s *Source) Bundle() *x509.CertPool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.bundle
+}
+
+// GetClientCertificate returns the X.509-SVID of this peer.
+// This can be used as tls.Config.GetClientCertificate.
+func (s *Source) GetClientCertificate(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.svid, nil
+}
+
+// VerifyPeer verifies that the gRPC peer of the context presented an X.509-SVID in the trust domain.
+// The X.509-SVID must have been verified with the trust bundle in the TLS handshake.
+func (s *Source) VerifyPeer(ctx context.Context) error {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return errNoPeerSVID.New()
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return errNoPeerSVID.New()
+	}
+	id, err := idFromCertificate(tlsInfo.State.VerifiedChains[0][0])
+	if err != nil {
+		return errNoPeerSVID.WithCause(err)
+	}
+	if !strings.EqualFold(id.Host, s.conf.TrustDomain) {
+		return errPeerTrustDomain.WithAttributes("id", id.String(), "trust_domain", s.conf.TrustDomain)
+	}
+	return nil
+}
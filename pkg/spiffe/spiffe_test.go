@@ -0,0 +1,265 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spiffe_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	. "go.thethings.network/lorawan-stack/v3/pkg/spiffe"
+	"go.thethings.network/lorawan-stack/v3/pkg/task"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+)
+
+type authority struct {
+	cert *x509.Certificate
+	key  *ecdsa.PrivateKey
+}
+
+func newAuthority(t *testing.T) *authority {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &authority{cert: cert, key: key}
+}
+
+func (ca *authority) issue(t *testing.T, id string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uri, err := url.Parse(id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+		URIs:         []*url.URL{uri},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca.cert, &key.PublicKey, ca.key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, key
+}
+
+func writePEM(t *testing.T, name, typ string, der []byte) {
+	t.Helper()
+	if err := os.WriteFile(name, pem.EncodeToMemory(&pem.Block{Type: typ, Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func writeFiles(t *testing.T, dir string, ca *authority, id string) Config {
+	t.Helper()
+	cert, key := ca.issue(t, id)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf := Config{
+		Enable:      true,
+		TrustDomain: "example.com",
+		Certificate: filepath.Join(dir, "svid.pem"),
+		Key:         filepath.Join(dir, "svid_key.pem"),
+		Bundle:      filepath.Join(dir, "bundle.pem"),
+	}
+	writePEM(t, conf.Certificate, "CERTIFICATE", cert.Raw)
+	writePEM(t, conf.Key, "EC PRIVATE KEY", keyDER)
+	writePEM(t, conf.Bundle, "CERTIFICATE", ca.cert.Raw)
+	return conf
+}
+
+func TestSource(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+	starter := task.StartTaskFunc(task.DefaultStartTask)
+
+	ca := newAuthority(t)
+	dir := t.TempDir()
+	conf := writeFiles(t, dir, ca, "spiffe://example.com/ns")
+
+	t.Run("InvalidTrustDomain", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		conf := conf
+		conf.TrustDomain = "Example.com"
+		_, err := NewSource(ctx, starter, conf)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("OtherTrustDomain", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		conf := conf
+		conf.TrustDomain = "example.org"
+		_, err := NewSource(ctx, starter, conf)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	t.Run("UntrustedSVID", func(t *testing.T) {
+		t.Parallel()
+		a := assertions.New(t)
+		conf := conf
+		conf.Bundle = filepath.Join(t.TempDir(), "bundle.pem")
+		writePEM(t, conf.Bundle, "CERTIFICATE", newAuthority(t).cert.Raw)
+		_, err := NewSource(ctx, starter, conf)
+		a.So(errors.IsInvalidArgument(err), should.BeTrue)
+	})
+
+	source, err := NewSource(ctx, starter, conf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(source.ID(), should.Equal, "spiffe://example.com/ns")
+	svid, err := source.GetClientCertificate(&tls.CertificateRequestInfo{})
+	if a.So(err, should.BeNil) {
+		a.So(svid.Leaf.URIs[0].String(), should.Equal, "spiffe://example.com/ns")
+	}
+	a.So(source.Bundle().Subjects(), should.HaveLength, 1) //nolint:staticcheck
+
+	peerContext := func(chains ...[]*x509.Certificate) *peer.Peer {
+		return &peer.Peer{
+			AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{VerifiedChains: chains}},
+		}
+	}
+	peerCert, _ := ca.issue(t, "spiffe://example.com/as")
+	otherCert, _ := ca.issue(t, "spiffe://example.org/as")
+
+	for _, tc := range []struct {
+		Name      string
+		Peer      *peer.Peer
+		Assertion func(error) bool
+	}{
+		{
+			Name:      "NoPeer",
+			Assertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:      "NoTLS",
+			Peer:      &peer.Peer{},
+			Assertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:      "NoClientCertificate",
+			Peer:      peerContext(),
+			Assertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:      "NoSPIFFEID",
+			Peer:      peerContext([]*x509.Certificate{ca.cert}),
+			Assertion: errors.IsUnauthenticated,
+		},
+		{
+			Name:      "OtherTrustDomain",
+			Peer:      peerContext([]*x509.Certificate{otherCert, ca.cert}),
+			Assertion: errors.IsPermissionDenied,
+		},
+		{
+			Name:      "Valid",
+			Peer:      peerContext([]*x509.Certificate{peerCert, ca.cert}),
+			Assertion: func(err error) bool { return err == nil },
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			ctx := ctx
+			if tc.Peer != nil {
+				ctx = peer.NewContext(ctx, tc.Peer)
+			}
+			a.So(tc.Assertion(source.VerifyPeer(ctx)), should.BeTrue)
+		})
+	}
+}
+
+func TestSourceRefresh(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+	ctx := log.NewContext(test.Context(), test.GetLogger(t))
+
+	ca := newAuthority(t)
+	dir := t.TempDir()
+	conf := writeFiles(t, dir, ca, "spiffe://example.com/ns")
+	conf.RefreshInterval = 10 * time.Millisecond
+
+	source, err := NewSource(ctx, task.StartTaskFunc(task.DefaultStartTask), conf)
+	if !a.So(err, should.BeNil) {
+		t.FailNow()
+	}
+	a.So(source.ID(), should.Equal, "spiffe://example.com/ns")
+
+	// Invalid material is ignored and the previous X.509-SVID is kept.
+	if err := os.WriteFile(conf.Certificate, []byte("invalid"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	time.Sleep(5 * conf.RefreshInterval)
+	a.So(source.ID(), should.Equal, "spiffe://example.com/ns")
+
+	writeFiles(t, dir, ca, "spiffe://example.com/ns-2")
+	for i := 0; i < 100 && source.ID() != "spiffe://example.com/ns-2"; i++ {
+		time.Sleep(conf.RefreshInterval)
+	}
+	a.So(source.ID(), should.Equal, "spiffe://example.com/ns-2")
+}
@@ -0,0 +1,42 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package tenant provides the tenant context of entities and requests.
+//
+// Deployments of The Things Stack have a single tenant with DefaultID. Multi-tenant deployments
+// set the tenant of each request in the context with NewContextWithID, after which the stores,
+// rights cache and configuration use the tenant of the context.
+package tenant
+
+import "context"
+
+// DefaultID is the ID of the tenant of single-tenant deployments.
+const DefaultID = "default"
+
+type tenantIDKeyType struct{}
+
+var tenantIDKey tenantIDKeyType
+
+// NewContextWithID returns a context with the tenant ID.
+func NewContextWithID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, tenantIDKey, id)
+}
+
+// IDFromContext returns the tenant ID from the context, or DefaultID if the context has no tenant.
+func IDFromContext(ctx context.Context) string {
+	if id, ok := ctx.Value(tenantIDKey).(string); ok && id != "" {
+		return id
+	}
+	return DefaultID
+}
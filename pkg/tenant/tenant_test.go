@@ -0,0 +1,34 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package tenant_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/tenant"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+func TestTenantContext(t *testing.T) {
+	t.Parallel()
+	a := assertions.New(t)
+
+	ctx := context.Background()
+	a.So(tenant.IDFromContext(ctx), should.Equal, tenant.DefaultID)
+	a.So(tenant.IDFromContext(tenant.NewContextWithID(ctx, "")), should.Equal, tenant.DefaultID)
+	a.So(tenant.IDFromContext(tenant.NewContextWithID(ctx, "foo")), should.Equal, "foo")
+}
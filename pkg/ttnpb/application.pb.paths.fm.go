@@ -55,6 +55,68 @@ var ApplicationsFieldPathsNested = []string{
 var ApplicationsFieldPathsTopLevel = []string{
 	"applications",
 }
+var GetApplicationActivityRequestFieldPathsNested = []string{
+	"after",
+	"application_ids",
+	"application_ids.application_id",
+	"limit",
+}
+
+var GetApplicationActivityRequestFieldPathsTopLevel = []string{
+	"after",
+	"application_ids",
+	"limit",
+}
+var ApplicationActivityActorFieldPathsNested = []string{
+	"api_key_id",
+	"api_key_name",
+	"client_id",
+	"organization_id",
+	"user_id",
+}
+
+var ApplicationActivityActorFieldPathsTopLevel = []string{
+	"api_key_id",
+	"api_key_name",
+	"client_id",
+	"organization_id",
+	"user_id",
+}
+var ApplicationActivityFieldPathsNested = []string{
+	"actor",
+	"actor.api_key_id",
+	"actor.api_key_name",
+	"actor.client_id",
+	"actor.organization_id",
+	"actor.user_id",
+	"correlation_ids",
+	"data",
+	"description",
+	"device_id",
+	"name",
+	"remote_ip",
+	"time",
+	"user_agent",
+}
+
+var ApplicationActivityFieldPathsTopLevel = []string{
+	"actor",
+	"correlation_ids",
+	"data",
+	"description",
+	"device_id",
+	"name",
+	"remote_ip",
+	"time",
+	"user_agent",
+}
+var ApplicationActivityFeedFieldPathsNested = []string{
+	"activity",
+}
+
+var ApplicationActivityFeedFieldPathsTopLevel = []string{
+	"activity",
+}
 var IssueDevEUIResponseFieldPathsNested = []string{
 	"dev_eui",
 }
@@ -230,6 +292,28 @@ var UpdateApplicationAPIKeyRequestFieldPathsTopLevel = []string{
 	"application_ids",
 	"field_mask",
 }
+var CreateApplicationClaimAuthorizationRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"expires_at",
+	"name",
+}
+
+var CreateApplicationClaimAuthorizationRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"expires_at",
+	"name",
+}
+var DeleteApplicationClaimAuthorizationRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"key_id",
+}
+
+var DeleteApplicationClaimAuthorizationRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"key_id",
+}
 var ListApplicationCollaboratorsRequestFieldPathsNested = []string{
 	"application_ids",
 	"application_ids.application_id",
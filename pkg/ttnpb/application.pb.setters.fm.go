@@ -215,6 +215,255 @@ func (dst *Applications) SetFields(src *Applications, paths ...string) error {
 	return nil
 }
 
+func (dst *GetApplicationActivityRequest) SetFields(src *GetApplicationActivityRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "after":
+			if len(subs) > 0 {
+				return fmt.Errorf("'after' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.After = src.After
+			} else {
+				dst.After = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationActivityActor) SetFields(src *ApplicationActivityActor, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UserId = src.UserId
+			} else {
+				var zero string
+				dst.UserId = zero
+			}
+		case "organization_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'organization_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OrganizationId = src.OrganizationId
+			} else {
+				var zero string
+				dst.OrganizationId = zero
+			}
+		case "api_key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApiKeyId = src.ApiKeyId
+			} else {
+				var zero string
+				dst.ApiKeyId = zero
+			}
+		case "api_key_name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'api_key_name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ApiKeyName = src.ApiKeyName
+			} else {
+				var zero string
+				dst.ApiKeyName = zero
+			}
+		case "client_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'client_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ClientId = src.ClientId
+			} else {
+				var zero string
+				dst.ClientId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationActivity) SetFields(src *ApplicationActivity, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "time":
+			if len(subs) > 0 {
+				return fmt.Errorf("'time' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Time = src.Time
+			} else {
+				dst.Time = nil
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "description":
+			if len(subs) > 0 {
+				return fmt.Errorf("'description' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Description = src.Description
+			} else {
+				var zero string
+				dst.Description = zero
+			}
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "data":
+			if len(subs) > 0 {
+				return fmt.Errorf("'data' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Data = src.Data
+			} else {
+				dst.Data = nil
+			}
+		case "actor":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationActivityActor
+				if (src == nil || src.Actor == nil) && dst.Actor == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Actor
+				}
+				if dst.Actor != nil {
+					newDst = dst.Actor
+				} else {
+					newDst = &ApplicationActivityActor{}
+					dst.Actor = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Actor = src.Actor
+				} else {
+					dst.Actor = nil
+				}
+			}
+		case "remote_ip":
+			if len(subs) > 0 {
+				return fmt.Errorf("'remote_ip' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RemoteIp = src.RemoteIp
+			} else {
+				var zero string
+				dst.RemoteIp = zero
+			}
+		case "user_agent":
+			if len(subs) > 0 {
+				return fmt.Errorf("'user_agent' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.UserAgent = src.UserAgent
+			} else {
+				var zero string
+				dst.UserAgent = zero
+			}
+		case "correlation_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'correlation_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.CorrelationIds = src.CorrelationIds
+			} else {
+				dst.CorrelationIds = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ApplicationActivityFeed) SetFields(src *ApplicationActivityFeed, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "activity":
+			if len(subs) > 0 {
+				return fmt.Errorf("'activity' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Activity = src.Activity
+			} else {
+				dst.Activity = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *IssueDevEUIResponse) SetFields(src *IssueDevEUIResponse, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -717,6 +966,107 @@ func (dst *UpdateApplicationAPIKeyRequest) SetFields(src *UpdateApplicationAPIKe
 	return nil
 }
 
+func (dst *CreateApplicationClaimAuthorizationRequest) SetFields(src *CreateApplicationClaimAuthorizationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "name":
+			if len(subs) > 0 {
+				return fmt.Errorf("'name' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Name = src.Name
+			} else {
+				var zero string
+				dst.Name = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DeleteApplicationClaimAuthorizationRequest) SetFields(src *DeleteApplicationClaimAuthorizationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "key_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'key_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.KeyId = src.KeyId
+			} else {
+				var zero string
+				dst.KeyId = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ListApplicationCollaboratorsRequest) SetFields(src *ListApplicationCollaboratorsRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -400,6 +400,447 @@ var _ interface {
 	ErrorName() string
 } = ApplicationsValidationError{}
 
+// ValidateFields checks the field values on GetApplicationActivityRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetApplicationActivityRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetApplicationActivityRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return GetApplicationActivityRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetApplicationActivityRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return GetApplicationActivityRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "after":
+
+			if v, ok := interface{}(m.GetAfter()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetApplicationActivityRequestValidationError{
+						field:  "after",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetApplicationActivityRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetApplicationActivityRequestValidationError is the validation error
+// returned by GetApplicationActivityRequest.ValidateFields if the designated
+// constraints aren't met.
+type GetApplicationActivityRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetApplicationActivityRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetApplicationActivityRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetApplicationActivityRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetApplicationActivityRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetApplicationActivityRequestValidationError) ErrorName() string {
+	return "GetApplicationActivityRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetApplicationActivityRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetApplicationActivityRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetApplicationActivityRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetApplicationActivityRequestValidationError{}
+
+// ValidateFields checks the field values on ApplicationActivityActor with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationActivityActor) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationActivityActorFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_id":
+			// no validation rules for UserId
+		case "organization_id":
+			// no validation rules for OrganizationId
+		case "api_key_id":
+			// no validation rules for ApiKeyId
+		case "api_key_name":
+			// no validation rules for ApiKeyName
+		case "client_id":
+			// no validation rules for ClientId
+		default:
+			return ApplicationActivityActorValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationActivityActorValidationError is the validation error returned by
+// ApplicationActivityActor.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationActivityActorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationActivityActorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationActivityActorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationActivityActorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationActivityActorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationActivityActorValidationError) ErrorName() string {
+	return "ApplicationActivityActorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationActivityActorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationActivityActor.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationActivityActorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationActivityActorValidationError{}
+
+// ValidateFields checks the field values on ApplicationActivity with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationActivity) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationActivityFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "time":
+
+			if v, ok := interface{}(m.GetTime()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationActivityValidationError{
+						field:  "time",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "name":
+			// no validation rules for Name
+		case "description":
+			// no validation rules for Description
+		case "device_id":
+			// no validation rules for DeviceId
+		case "data":
+
+			if v, ok := interface{}(m.GetData()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationActivityValidationError{
+						field:  "data",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "actor":
+
+			if v, ok := interface{}(m.GetActor()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationActivityValidationError{
+						field:  "actor",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "remote_ip":
+			// no validation rules for RemoteIp
+		case "user_agent":
+			// no validation rules for UserAgent
+		case "correlation_ids":
+
+		default:
+			return ApplicationActivityValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationActivityValidationError is the validation error returned by
+// ApplicationActivity.ValidateFields if the designated constraints aren't met.
+type ApplicationActivityValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationActivityValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationActivityValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationActivityValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationActivityValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationActivityValidationError) ErrorName() string {
+	return "ApplicationActivityValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationActivityValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationActivity.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationActivityValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationActivityValidationError{}
+
+// ValidateFields checks the field values on ApplicationActivityFeed with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationActivityFeed) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationActivityFeedFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "activity":
+
+			for idx, item := range m.GetActivity() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationActivityFeedValidationError{
+							field:  fmt.Sprintf("activity[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return ApplicationActivityFeedValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationActivityFeedValidationError is the validation error returned by
+// ApplicationActivityFeed.ValidateFields if the designated constraints aren't met.
+type ApplicationActivityFeedValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationActivityFeedValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationActivityFeedValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationActivityFeedValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationActivityFeedValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationActivityFeedValidationError) ErrorName() string {
+	return "ApplicationActivityFeedValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationActivityFeedValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationActivityFeed.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationActivityFeedValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationActivityFeedValidationError{}
+
 // ValidateFields checks the field values on IssueDevEUIResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1509,6 +1950,249 @@ var _ interface {
 	ErrorName() string
 } = UpdateApplicationAPIKeyRequestValidationError{}
 
+// ValidateFields checks the field values on
+// CreateApplicationClaimAuthorizationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *CreateApplicationClaimAuthorizationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CreateApplicationClaimAuthorizationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return CreateApplicationClaimAuthorizationRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return CreateApplicationClaimAuthorizationRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return CreateApplicationClaimAuthorizationRequestValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "expires_at":
+
+			if t := m.GetExpiresAt(); t != nil {
+				ts, err := t.AsTime(), t.CheckValid()
+				if err != nil {
+					return CreateApplicationClaimAuthorizationRequestValidationError{
+						field:  "expires_at",
+						reason: "value is not a valid timestamp",
+						cause:  err,
+					}
+				}
+
+				now := time.Now()
+
+				if ts.Sub(now) <= 0 {
+					return CreateApplicationClaimAuthorizationRequestValidationError{
+						field:  "expires_at",
+						reason: "value must be greater than now",
+					}
+				}
+
+			}
+
+		default:
+			return CreateApplicationClaimAuthorizationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CreateApplicationClaimAuthorizationRequestValidationError is the validation
+// error returned by CreateApplicationClaimAuthorizationRequest.ValidateFields
+// if the designated constraints aren't met.
+type CreateApplicationClaimAuthorizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateApplicationClaimAuthorizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateApplicationClaimAuthorizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateApplicationClaimAuthorizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateApplicationClaimAuthorizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateApplicationClaimAuthorizationRequestValidationError) ErrorName() string {
+	return "CreateApplicationClaimAuthorizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateApplicationClaimAuthorizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateApplicationClaimAuthorizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateApplicationClaimAuthorizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateApplicationClaimAuthorizationRequestValidationError{}
+
+// ValidateFields checks the field values on
+// DeleteApplicationClaimAuthorizationRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteApplicationClaimAuthorizationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteApplicationClaimAuthorizationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return DeleteApplicationClaimAuthorizationRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteApplicationClaimAuthorizationRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "key_id":
+
+			if utf8.RuneCountInString(m.GetKeyId()) < 1 {
+				return DeleteApplicationClaimAuthorizationRequestValidationError{
+					field:  "key_id",
+					reason: "value length must be at least 1 runes",
+				}
+			}
+
+		default:
+			return DeleteApplicationClaimAuthorizationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteApplicationClaimAuthorizationRequestValidationError is the validation
+// error returned by DeleteApplicationClaimAuthorizationRequest.ValidateFields
+// if the designated constraints aren't met.
+type DeleteApplicationClaimAuthorizationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) ErrorName() string {
+	return "DeleteApplicationClaimAuthorizationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteApplicationClaimAuthorizationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteApplicationClaimAuthorizationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteApplicationClaimAuthorizationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteApplicationClaimAuthorizationRequestValidationError{}
+
 // ValidateFields checks the field values on
 // ListApplicationCollaboratorsRequest with the rules defined in the proto
 // definition for this message. If any rules are violated, an error is returned.
@@ -42,6 +42,7 @@ const (
 	ApplicationRegistry_Restore_FullMethodName     = "/ttn.lorawan.v3.ApplicationRegistry/Restore"
 	ApplicationRegistry_Purge_FullMethodName       = "/ttn.lorawan.v3.ApplicationRegistry/Purge"
 	ApplicationRegistry_IssueDevEUI_FullMethodName = "/ttn.lorawan.v3.ApplicationRegistry/IssueDevEUI"
+	ApplicationRegistry_GetActivity_FullMethodName = "/ttn.lorawan.v3.ApplicationRegistry/GetActivity"
 )
 
 // ApplicationRegistryClient is the client API for ApplicationRegistry service.
@@ -79,6 +80,10 @@ type ApplicationRegistryClient interface {
 	// Request DevEUI from the configured address block for a device inside the application.
 	// The maximum number of DevEUI's issued per application can be configured.
 	IssueDevEUI(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*IssueDevEUIResponse, error)
+	// Get the activity feed of the application: the configuration changes of the application, most recent first,
+	// with the users and API keys that made them. Only the changes that are visible with the rights of the caller
+	// are returned. This requires the events storage.
+	GetActivity(ctx context.Context, in *GetApplicationActivityRequest, opts ...grpc.CallOption) (*ApplicationActivityFeed, error)
 }
 
 type applicationRegistryClient struct {
@@ -161,6 +166,15 @@ func (c *applicationRegistryClient) IssueDevEUI(ctx context.Context, in *Applica
 	return out, nil
 }
 
+func (c *applicationRegistryClient) GetActivity(ctx context.Context, in *GetApplicationActivityRequest, opts ...grpc.CallOption) (*ApplicationActivityFeed, error) {
+	out := new(ApplicationActivityFeed)
+	err := c.cc.Invoke(ctx, ApplicationRegistry_GetActivity_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationRegistryServer is the server API for ApplicationRegistry service.
 // All implementations must embed UnimplementedApplicationRegistryServer
 // for forward compatibility
@@ -196,6 +210,10 @@ type ApplicationRegistryServer interface {
 	// Request DevEUI from the configured address block for a device inside the application.
 	// The maximum number of DevEUI's issued per application can be configured.
 	IssueDevEUI(context.Context, *ApplicationIdentifiers) (*IssueDevEUIResponse, error)
+	// Get the activity feed of the application: the configuration changes of the application, most recent first,
+	// with the users and API keys that made them. Only the changes that are visible with the rights of the caller
+	// are returned. This requires the events storage.
+	GetActivity(context.Context, *GetApplicationActivityRequest) (*ApplicationActivityFeed, error)
 	mustEmbedUnimplementedApplicationRegistryServer()
 }
 
@@ -227,6 +245,9 @@ func (UnimplementedApplicationRegistryServer) Purge(context.Context, *Applicatio
 func (UnimplementedApplicationRegistryServer) IssueDevEUI(context.Context, *ApplicationIdentifiers) (*IssueDevEUIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method IssueDevEUI not implemented")
 }
+func (UnimplementedApplicationRegistryServer) GetActivity(context.Context, *GetApplicationActivityRequest) (*ApplicationActivityFeed, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActivity not implemented")
+}
 func (UnimplementedApplicationRegistryServer) mustEmbedUnimplementedApplicationRegistryServer() {}
 
 // UnsafeApplicationRegistryServer may be embedded to opt out of forward compatibility for this service.
@@ -384,6 +405,24 @@ func _ApplicationRegistry_IssueDevEUI_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationRegistry_GetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationActivityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationRegistryServer).GetActivity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationRegistry_GetActivity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationRegistryServer).GetActivity(ctx, req.(*GetApplicationActivityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApplicationRegistry_ServiceDesc is the grpc.ServiceDesc for ApplicationRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,20 +462,27 @@ var ApplicationRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "IssueDevEUI",
 			Handler:    _ApplicationRegistry_IssueDevEUI_Handler,
 		},
+		{
+			MethodName: "GetActivity",
+			Handler:    _ApplicationRegistry_GetActivity_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/application_services.proto",
 }
 
 const (
-	ApplicationAccess_ListRights_FullMethodName        = "/ttn.lorawan.v3.ApplicationAccess/ListRights"
-	ApplicationAccess_CreateAPIKey_FullMethodName      = "/ttn.lorawan.v3.ApplicationAccess/CreateAPIKey"
-	ApplicationAccess_ListAPIKeys_FullMethodName       = "/ttn.lorawan.v3.ApplicationAccess/ListAPIKeys"
-	ApplicationAccess_GetAPIKey_FullMethodName         = "/ttn.lorawan.v3.ApplicationAccess/GetAPIKey"
-	ApplicationAccess_UpdateAPIKey_FullMethodName      = "/ttn.lorawan.v3.ApplicationAccess/UpdateAPIKey"
-	ApplicationAccess_GetCollaborator_FullMethodName   = "/ttn.lorawan.v3.ApplicationAccess/GetCollaborator"
-	ApplicationAccess_SetCollaborator_FullMethodName   = "/ttn.lorawan.v3.ApplicationAccess/SetCollaborator"
-	ApplicationAccess_ListCollaborators_FullMethodName = "/ttn.lorawan.v3.ApplicationAccess/ListCollaborators"
+	ApplicationAccess_ListRights_FullMethodName               = "/ttn.lorawan.v3.ApplicationAccess/ListRights"
+	ApplicationAccess_CreateAPIKey_FullMethodName             = "/ttn.lorawan.v3.ApplicationAccess/CreateAPIKey"
+	ApplicationAccess_ListAPIKeys_FullMethodName              = "/ttn.lorawan.v3.ApplicationAccess/ListAPIKeys"
+	ApplicationAccess_GetAPIKey_FullMethodName                = "/ttn.lorawan.v3.ApplicationAccess/GetAPIKey"
+	ApplicationAccess_UpdateAPIKey_FullMethodName             = "/ttn.lorawan.v3.ApplicationAccess/UpdateAPIKey"
+	ApplicationAccess_GetCollaborator_FullMethodName          = "/ttn.lorawan.v3.ApplicationAccess/GetCollaborator"
+	ApplicationAccess_SetCollaborator_FullMethodName          = "/ttn.lorawan.v3.ApplicationAccess/SetCollaborator"
+	ApplicationAccess_ListCollaborators_FullMethodName        = "/ttn.lorawan.v3.ApplicationAccess/ListCollaborators"
+	ApplicationAccess_CreateClaimAuthorization_FullMethodName = "/ttn.lorawan.v3.ApplicationAccess/CreateClaimAuthorization"
+	ApplicationAccess_ListClaimAuthorizations_FullMethodName  = "/ttn.lorawan.v3.ApplicationAccess/ListClaimAuthorizations"
+	ApplicationAccess_DeleteClaimAuthorization_FullMethodName = "/ttn.lorawan.v3.ApplicationAccess/DeleteClaimAuthorization"
 )
 
 // ApplicationAccessClient is the client API for ApplicationAccess service.
@@ -464,6 +510,16 @@ type ApplicationAccessClient interface {
 	SetCollaborator(ctx context.Context, in *SetApplicationCollaboratorRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// List the collaborators on this application.
 	ListCollaborators(ctx context.Context, in *ListApplicationCollaboratorsRequest, opts ...grpc.CallOption) (*Collaborators, error)
+	// Create a claim authorization for this application.
+	// A claim authorization is an API key that only has the right to claim end devices into the application.
+	// Device manufacturers use it to claim end devices on behalf of the application owner.
+	// The caller is required to have the rights to manage API keys and to create end devices.
+	CreateClaimAuthorization(ctx context.Context, in *CreateApplicationClaimAuthorizationRequest, opts ...grpc.CallOption) (*APIKey, error)
+	// List the claim authorizations of this application.
+	ListClaimAuthorizations(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*APIKeys, error)
+	// Delete a claim authorization of this application.
+	// Other API keys of the application can not be deleted this way.
+	DeleteClaimAuthorization(ctx context.Context, in *DeleteApplicationClaimAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 }
 
 type applicationAccessClient struct {
@@ -546,6 +602,33 @@ func (c *applicationAccessClient) ListCollaborators(ctx context.Context, in *Lis
 	return out, nil
 }
 
+func (c *applicationAccessClient) CreateClaimAuthorization(ctx context.Context, in *CreateApplicationClaimAuthorizationRequest, opts ...grpc.CallOption) (*APIKey, error) {
+	out := new(APIKey)
+	err := c.cc.Invoke(ctx, ApplicationAccess_CreateClaimAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationAccessClient) ListClaimAuthorizations(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*APIKeys, error) {
+	out := new(APIKeys)
+	err := c.cc.Invoke(ctx, ApplicationAccess_ListClaimAuthorizations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationAccessClient) DeleteClaimAuthorization(ctx context.Context, in *DeleteApplicationClaimAuthorizationRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, ApplicationAccess_DeleteClaimAuthorization_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ApplicationAccessServer is the server API for ApplicationAccess service.
 // All implementations must embed UnimplementedApplicationAccessServer
 // for forward compatibility
@@ -571,6 +654,16 @@ type ApplicationAccessServer interface {
 	SetCollaborator(context.Context, *SetApplicationCollaboratorRequest) (*emptypb.Empty, error)
 	// List the collaborators on this application.
 	ListCollaborators(context.Context, *ListApplicationCollaboratorsRequest) (*Collaborators, error)
+	// Create a claim authorization for this application.
+	// A claim authorization is an API key that only has the right to claim end devices into the application.
+	// Device manufacturers use it to claim end devices on behalf of the application owner.
+	// The caller is required to have the rights to manage API keys and to create end devices.
+	CreateClaimAuthorization(context.Context, *CreateApplicationClaimAuthorizationRequest) (*APIKey, error)
+	// List the claim authorizations of this application.
+	ListClaimAuthorizations(context.Context, *ApplicationIdentifiers) (*APIKeys, error)
+	// Delete a claim authorization of this application.
+	// Other API keys of the application can not be deleted this way.
+	DeleteClaimAuthorization(context.Context, *DeleteApplicationClaimAuthorizationRequest) (*emptypb.Empty, error)
 	mustEmbedUnimplementedApplicationAccessServer()
 }
 
@@ -602,6 +695,15 @@ func (UnimplementedApplicationAccessServer) SetCollaborator(context.Context, *Se
 func (UnimplementedApplicationAccessServer) ListCollaborators(context.Context, *ListApplicationCollaboratorsRequest) (*Collaborators, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCollaborators not implemented")
 }
+func (UnimplementedApplicationAccessServer) CreateClaimAuthorization(context.Context, *CreateApplicationClaimAuthorizationRequest) (*APIKey, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateClaimAuthorization not implemented")
+}
+func (UnimplementedApplicationAccessServer) ListClaimAuthorizations(context.Context, *ApplicationIdentifiers) (*APIKeys, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimAuthorizations not implemented")
+}
+func (UnimplementedApplicationAccessServer) DeleteClaimAuthorization(context.Context, *DeleteApplicationClaimAuthorizationRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteClaimAuthorization not implemented")
+}
 func (UnimplementedApplicationAccessServer) mustEmbedUnimplementedApplicationAccessServer() {}
 
 // UnsafeApplicationAccessServer may be embedded to opt out of forward compatibility for this service.
@@ -759,6 +861,60 @@ func _ApplicationAccess_ListCollaborators_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ApplicationAccess_CreateClaimAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApplicationClaimAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationAccessServer).CreateClaimAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationAccess_CreateClaimAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationAccessServer).CreateClaimAuthorization(ctx, req.(*CreateApplicationClaimAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationAccess_ListClaimAuthorizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplicationIdentifiers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationAccessServer).ListClaimAuthorizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationAccess_ListClaimAuthorizations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationAccessServer).ListClaimAuthorizations(ctx, req.(*ApplicationIdentifiers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationAccess_DeleteClaimAuthorization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationClaimAuthorizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationAccessServer).DeleteClaimAuthorization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ApplicationAccess_DeleteClaimAuthorization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationAccessServer).DeleteClaimAuthorization(ctx, req.(*DeleteApplicationClaimAuthorizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ApplicationAccess_ServiceDesc is the grpc.ServiceDesc for ApplicationAccess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -798,6 +954,18 @@ var ApplicationAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ListCollaborators",
 			Handler:    _ApplicationAccess_ListCollaborators_Handler,
 		},
+		{
+			MethodName: "CreateClaimAuthorization",
+			Handler:    _ApplicationAccess_CreateClaimAuthorization_Handler,
+		},
+		{
+			MethodName: "ListClaimAuthorizations",
+			Handler:    _ApplicationAccess_ListClaimAuthorizations_Handler,
+		},
+		{
+			MethodName: "DeleteClaimAuthorization",
+			Handler:    _ApplicationAccess_DeleteClaimAuthorization_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/application_services.proto",
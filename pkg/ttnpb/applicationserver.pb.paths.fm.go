@@ -90,6 +90,57 @@ var GetAsConfigurationResponseFieldPathsNested = []string{
 var GetAsConfigurationResponseFieldPathsTopLevel = []string{
 	"configuration",
 }
+var SimulateNetworkUplinkRequestFieldPathsNested = []string{
+	"confirmed",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_port",
+	"frm_payload",
+}
+
+var SimulateNetworkUplinkRequestFieldPathsTopLevel = []string{
+	"confirmed",
+	"end_device_ids",
+	"f_port",
+	"frm_payload",
+}
+var DecodeAsPHYPayloadRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"full_f_cnt",
+	"phy_payload",
+}
+
+var DecodeAsPHYPayloadRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"full_f_cnt",
+	"phy_payload",
+}
+var DecodeAsPHYPayloadResponseFieldPathsNested = []string{
+	"dev_addr",
+	"f_port",
+	"frm_payload",
+	"full_f_cnt",
+	"pending_session",
+}
+
+var DecodeAsPHYPayloadResponseFieldPathsTopLevel = []string{
+	"dev_addr",
+	"f_port",
+	"frm_payload",
+	"full_f_cnt",
+	"pending_session",
+}
 var NsAsHandleUplinkRequestFieldPathsNested = []string{
 	"application_ups",
 }
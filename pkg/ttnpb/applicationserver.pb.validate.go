@@ -776,6 +776,341 @@ var _ interface {
 	ErrorName() string
 } = GetAsConfigurationResponseValidationError{}
 
+// ValidateFields checks the field values on SimulateNetworkUplinkRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SimulateNetworkUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SimulateNetworkUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return SimulateNetworkUplinkRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SimulateNetworkUplinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 223 {
+				return SimulateNetworkUplinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 223]",
+				}
+			}
+
+		case "frm_payload":
+
+			if len(m.GetFrmPayload()) > 250 {
+				return SimulateNetworkUplinkRequestValidationError{
+					field:  "frm_payload",
+					reason: "value length must be at most 250 bytes",
+				}
+			}
+
+		case "confirmed":
+			// no validation rules for Confirmed
+		default:
+			return SimulateNetworkUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SimulateNetworkUplinkRequestValidationError is the validation error returned
+// by SimulateNetworkUplinkRequest.ValidateFields if the designated
+// constraints aren't met.
+type SimulateNetworkUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SimulateNetworkUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SimulateNetworkUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SimulateNetworkUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SimulateNetworkUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SimulateNetworkUplinkRequestValidationError) ErrorName() string {
+	return "SimulateNetworkUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SimulateNetworkUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSimulateNetworkUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SimulateNetworkUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SimulateNetworkUplinkRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeAsPHYPayloadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeAsPHYPayloadRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeAsPHYPayloadRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return DecodeAsPHYPayloadRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeAsPHYPayloadRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "phy_payload":
+
+			if l := len(m.GetPhyPayload()); l < 12 || l > 256 {
+				return DecodeAsPHYPayloadRequestValidationError{
+					field:  "phy_payload",
+					reason: "value length must be between 12 and 256 bytes, inclusive",
+				}
+			}
+
+		case "full_f_cnt":
+
+			if v, ok := interface{}(m.GetFullFCnt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeAsPHYPayloadRequestValidationError{
+						field:  "full_f_cnt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DecodeAsPHYPayloadRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeAsPHYPayloadRequestValidationError is the validation error returned by
+// DecodeAsPHYPayloadRequest.ValidateFields if the designated constraints
+// aren't met.
+type DecodeAsPHYPayloadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeAsPHYPayloadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeAsPHYPayloadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeAsPHYPayloadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeAsPHYPayloadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeAsPHYPayloadRequestValidationError) ErrorName() string {
+	return "DecodeAsPHYPayloadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeAsPHYPayloadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeAsPHYPayloadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeAsPHYPayloadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeAsPHYPayloadRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeAsPHYPayloadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DecodeAsPHYPayloadResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeAsPHYPayloadResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "pending_session":
+			// no validation rules for PendingSession
+		case "full_f_cnt":
+			// no validation rules for FullFCnt
+		case "f_port":
+			// no validation rules for FPort
+		case "frm_payload":
+			// no validation rules for FrmPayload
+		default:
+			return DecodeAsPHYPayloadResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeAsPHYPayloadResponseValidationError is the validation error returned
+// by DecodeAsPHYPayloadResponse.ValidateFields if the designated constraints
+// aren't met.
+type DecodeAsPHYPayloadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeAsPHYPayloadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeAsPHYPayloadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeAsPHYPayloadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeAsPHYPayloadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeAsPHYPayloadResponseValidationError) ErrorName() string {
+	return "DecodeAsPHYPayloadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeAsPHYPayloadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeAsPHYPayloadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeAsPHYPayloadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeAsPHYPayloadResponseValidationError{}
+
 // ValidateFields checks the field values on NsAsHandleUplinkRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -0,0 +1,495 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.2
+// source: ttn/lorawan/v3/applicationserver_coverage.proto
+
+package ttnpb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type GatewayCoverageStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Min  float64 `protobuf:"fixed64,1,opt,name=min,proto3" json:"min,omitempty"`
+	Max  float64 `protobuf:"fixed64,2,opt,name=max,proto3" json:"max,omitempty"`
+	Mean float64 `protobuf:"fixed64,3,opt,name=mean,proto3" json:"mean,omitempty"`
+}
+
+func (x *GatewayCoverageStats) Reset() {
+	*x = GatewayCoverageStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayCoverageStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCoverageStats) ProtoMessage() {}
+
+func (x *GatewayCoverageStats) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCoverageStats.ProtoReflect.Descriptor instead.
+func (*GatewayCoverageStats) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewayCoverageStats) GetMin() float64 {
+	if x != nil {
+		return x.Min
+	}
+	return 0
+}
+
+func (x *GatewayCoverageStats) GetMax() float64 {
+	if x != nil {
+		return x.Max
+	}
+	return 0
+}
+
+func (x *GatewayCoverageStats) GetMean() float64 {
+	if x != nil {
+		return x.Mean
+	}
+	return 0
+}
+
+// The aggregated signal quality of the uplink messages that a gateway received from end devices in a geohash cell.
+type GatewayCoverageBucket struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Geohash string `protobuf:"bytes,1,opt,name=geohash,proto3" json:"geohash,omitempty"`
+	// The number of uplink messages in the bucket.
+	Count      uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Rssi       *GatewayCoverageStats  `protobuf:"bytes,3,opt,name=rssi,proto3" json:"rssi,omitempty"`
+	Snr        *GatewayCoverageStats  `protobuf:"bytes,4,opt,name=snr,proto3" json:"snr,omitempty"`
+	LastSeenAt *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+}
+
+func (x *GatewayCoverageBucket) Reset() {
+	*x = GatewayCoverageBucket{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayCoverageBucket) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCoverageBucket) ProtoMessage() {}
+
+func (x *GatewayCoverageBucket) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCoverageBucket.ProtoReflect.Descriptor instead.
+func (*GatewayCoverageBucket) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GatewayCoverageBucket) GetGeohash() string {
+	if x != nil {
+		return x.Geohash
+	}
+	return ""
+}
+
+func (x *GatewayCoverageBucket) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GatewayCoverageBucket) GetRssi() *GatewayCoverageStats {
+	if x != nil {
+		return x.Rssi
+	}
+	return nil
+}
+
+func (x *GatewayCoverageBucket) GetSnr() *GatewayCoverageStats {
+	if x != nil {
+		return x.Snr
+	}
+	return nil
+}
+
+func (x *GatewayCoverageBucket) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+type GatewayCoverage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Buckets []*GatewayCoverageBucket `protobuf:"bytes,1,rep,name=buckets,proto3" json:"buckets,omitempty"`
+}
+
+func (x *GatewayCoverage) Reset() {
+	*x = GatewayCoverage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayCoverage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayCoverage) ProtoMessage() {}
+
+func (x *GatewayCoverage) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayCoverage.ProtoReflect.Descriptor instead.
+func (*GatewayCoverage) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatewayCoverage) GetBuckets() []*GatewayCoverageBucket {
+	if x != nil {
+		return x.Buckets
+	}
+	return nil
+}
+
+type GetGatewayCoverageTileRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// The zoom level of the Web Mercator map tile.
+	Z uint32 `protobuf:"varint,2,opt,name=z,proto3" json:"z,omitempty"`
+	X uint32 `protobuf:"varint,3,opt,name=x,proto3" json:"x,omitempty"`
+	Y uint32 `protobuf:"varint,4,opt,name=y,proto3" json:"y,omitempty"`
+}
+
+func (x *GetGatewayCoverageTileRequest) Reset() {
+	*x = GetGatewayCoverageTileRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGatewayCoverageTileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayCoverageTileRequest) ProtoMessage() {}
+
+func (x *GetGatewayCoverageTileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayCoverageTileRequest.ProtoReflect.Descriptor instead.
+func (*GetGatewayCoverageTileRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGatewayCoverageTileRequest) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *GetGatewayCoverageTileRequest) GetZ() uint32 {
+	if x != nil {
+		return x.Z
+	}
+	return 0
+}
+
+func (x *GetGatewayCoverageTileRequest) GetX() uint32 {
+	if x != nil {
+		return x.X
+	}
+	return 0
+}
+
+func (x *GetGatewayCoverageTileRequest) GetY() uint32 {
+	if x != nil {
+		return x.Y
+	}
+	return 0
+}
+
+var File_ttn_lorawan_v3_applicationserver_coverage_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDesc = []byte{
+	0x0a, 0x2f, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x5f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
+	0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e,
+	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x68, 0x74, 0x74, 0x70,
+	0x62, 0x6f, 0x64, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x20, 0x74, 0x74, 0x6e,
+	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33, 0x2f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x4e, 0x0a, 0x14, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x10,
+	0x0a, 0x03, 0x6d, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d, 0x69, 0x6e,
+	0x12, 0x10, 0x0a, 0x03, 0x6d, 0x61, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x6d,
+	0x61, 0x78, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x04, 0x6d, 0x65, 0x61, 0x6e, 0x22, 0xf7, 0x01, 0x0a, 0x15, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x67, 0x65, 0x6f, 0x68, 0x61, 0x73, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x38, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x73, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x12, 0x36, 0x0a, 0x03, 0x73, 0x6e,
+	0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x03, 0x73,
+	0x6e, 0x72, 0x12, 0x3c, 0x0a, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x6e, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x65, 0x65, 0x6e, 0x41, 0x74,
+	0x22, 0x52, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72,
+	0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x07, 0x62, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x42, 0x75, 0x63, 0x6b, 0x65, 0x74, 0x52, 0x07, 0x62, 0x75, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42,
+	0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x49, 0x64, 0x73, 0x12, 0x15, 0x0a, 0x01, 0x7a, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x18, 0x16, 0x52, 0x01, 0x7a, 0x12, 0x0c, 0x0a, 0x01,
+	0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x78, 0x12, 0x0c, 0x0a, 0x01, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x01, 0x79, 0x32, 0xab, 0x02, 0x0a, 0x16, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x76, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x22, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x1a, 0x1f,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x22,
+	0x2a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x24, 0x12, 0x22, 0x2f, 0x61, 0x73, 0x2f, 0x67, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x12, 0x98, 0x01, 0x0a, 0x07,
+	0x47, 0x65, 0x74, 0x54, 0x69, 0x6c, 0x65, 0x12, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x43, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x67, 0x65, 0x54, 0x69, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x42, 0x6f, 0x64, 0x79, 0x22, 0x48, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x42, 0x12, 0x40, 0x2f, 0x61, 0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x2e,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x61, 0x67, 0x65, 0x2f, 0x74, 0x69, 0x6c, 0x65, 0x73, 0x2f, 0x7b, 0x7a, 0x7d, 0x2f, 0x7b,
+	0x78, 0x7d, 0x2f, 0x7b, 0x79, 0x7d, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65,
+	0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f,
+	0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescData = file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ttn_lorawan_v3_applicationserver_coverage_proto_goTypes = []interface{}{
+	(*GatewayCoverageStats)(nil),          // 0: ttn.lorawan.v3.GatewayCoverageStats
+	(*GatewayCoverageBucket)(nil),         // 1: ttn.lorawan.v3.GatewayCoverageBucket
+	(*GatewayCoverage)(nil),               // 2: ttn.lorawan.v3.GatewayCoverage
+	(*GetGatewayCoverageTileRequest)(nil), // 3: ttn.lorawan.v3.GetGatewayCoverageTileRequest
+	(*timestamppb.Timestamp)(nil),         // 4: google.protobuf.Timestamp
+	(*GatewayIdentifiers)(nil),            // 5: ttn.lorawan.v3.GatewayIdentifiers
+	(*httpbody.HttpBody)(nil),             // 6: google.api.HttpBody
+}
+var file_ttn_lorawan_v3_applicationserver_coverage_proto_depIdxs = []int32{
+	0, // 0: ttn.lorawan.v3.GatewayCoverageBucket.rssi:type_name -> ttn.lorawan.v3.GatewayCoverageStats
+	0, // 1: ttn.lorawan.v3.GatewayCoverageBucket.snr:type_name -> ttn.lorawan.v3.GatewayCoverageStats
+	4, // 2: ttn.lorawan.v3.GatewayCoverageBucket.last_seen_at:type_name -> google.protobuf.Timestamp
+	1, // 3: ttn.lorawan.v3.GatewayCoverage.buckets:type_name -> ttn.lorawan.v3.GatewayCoverageBucket
+	5, // 4: ttn.lorawan.v3.GetGatewayCoverageTileRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	5, // 5: ttn.lorawan.v3.GatewayCoverageService.Get:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	3, // 6: ttn.lorawan.v3.GatewayCoverageService.GetTile:input_type -> ttn.lorawan.v3.GetGatewayCoverageTileRequest
+	2, // 7: ttn.lorawan.v3.GatewayCoverageService.Get:output_type -> ttn.lorawan.v3.GatewayCoverage
+	6, // 8: ttn.lorawan.v3.GatewayCoverageService.GetTile:output_type -> google.api.HttpBody
+	7, // [7:9] is the sub-list for method output_type
+	5, // [5:7] is the sub-list for method input_type
+	5, // [5:5] is the sub-list for extension type_name
+	5, // [5:5] is the sub-list for extension extendee
+	0, // [0:5] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_applicationserver_coverage_proto_init() }
+func file_ttn_lorawan_v3_applicationserver_coverage_proto_init() {
+	if File_ttn_lorawan_v3_applicationserver_coverage_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCoverageStats); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCoverageBucket); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayCoverage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGatewayCoverageTileRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_applicationserver_coverage_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_applicationserver_coverage_proto_depIdxs,
+		MessageInfos:      file_ttn_lorawan_v3_applicationserver_coverage_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_applicationserver_coverage_proto = out.File
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_rawDesc = nil
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_goTypes = nil
+	file_ttn_lorawan_v3_applicationserver_coverage_proto_depIdxs = nil
+}
@@ -34,11 +34,13 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	As_GetLink_FullMethodName          = "/ttn.lorawan.v3.As/GetLink"
-	As_SetLink_FullMethodName          = "/ttn.lorawan.v3.As/SetLink"
-	As_DeleteLink_FullMethodName       = "/ttn.lorawan.v3.As/DeleteLink"
-	As_GetLinkStats_FullMethodName     = "/ttn.lorawan.v3.As/GetLinkStats"
-	As_GetConfiguration_FullMethodName = "/ttn.lorawan.v3.As/GetConfiguration"
+	As_GetLink_FullMethodName               = "/ttn.lorawan.v3.As/GetLink"
+	As_SetLink_FullMethodName               = "/ttn.lorawan.v3.As/SetLink"
+	As_DeleteLink_FullMethodName            = "/ttn.lorawan.v3.As/DeleteLink"
+	As_GetLinkStats_FullMethodName          = "/ttn.lorawan.v3.As/GetLinkStats"
+	As_GetConfiguration_FullMethodName      = "/ttn.lorawan.v3.As/GetConfiguration"
+	As_SimulateNetworkUplink_FullMethodName = "/ttn.lorawan.v3.As/SimulateNetworkUplink"
+	As_DecodePHYPayload_FullMethodName      = "/ttn.lorawan.v3.As/DecodePHYPayload"
 )
 
 // AsClient is the client API for As service.
@@ -60,6 +62,13 @@ type AsClient interface {
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(ctx context.Context, in *ApplicationIdentifiers, opts ...grpc.CallOption) (*ApplicationLinkStats, error)
 	GetConfiguration(ctx context.Context, in *GetAsConfigurationRequest, opts ...grpc.CallOption) (*GetAsConfigurationResponse, error)
+	// SimulateNetworkUplink encrypts the given FRMPayload with the application session key of the end device
+	// and lets the Network Server handle the resulting uplink message as if it was received by a gateway.
+	SimulateNetworkUplink(ctx context.Context, in *SimulateNetworkUplinkRequest, opts ...grpc.CallOption) (*UplinkMessage, error)
+	// DecodePHYPayload decrypts the application payload of the data frame PHYPayload of the end device
+	// with the AppSKey of its current or pending session, so that captured uplinks and downlinks can be inspected.
+	// This requires the right to read the end device keys.
+	DecodePHYPayload(ctx context.Context, in *DecodeAsPHYPayloadRequest, opts ...grpc.CallOption) (*DecodeAsPHYPayloadResponse, error)
 }
 
 type asClient struct {
@@ -115,6 +124,24 @@ func (c *asClient) GetConfiguration(ctx context.Context, in *GetAsConfigurationR
 	return out, nil
 }
 
+func (c *asClient) SimulateNetworkUplink(ctx context.Context, in *SimulateNetworkUplinkRequest, opts ...grpc.CallOption) (*UplinkMessage, error) {
+	out := new(UplinkMessage)
+	err := c.cc.Invoke(ctx, As_SimulateNetworkUplink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *asClient) DecodePHYPayload(ctx context.Context, in *DecodeAsPHYPayloadRequest, opts ...grpc.CallOption) (*DecodeAsPHYPayloadResponse, error) {
+	out := new(DecodeAsPHYPayloadResponse)
+	err := c.cc.Invoke(ctx, As_DecodePHYPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsServer is the server API for As service.
 // All implementations must embed UnimplementedAsServer
 // for forward compatibility
@@ -134,6 +161,13 @@ type AsServer interface {
 	// This call returns the error code of the link error if linking to a Network Server failed.
 	GetLinkStats(context.Context, *ApplicationIdentifiers) (*ApplicationLinkStats, error)
 	GetConfiguration(context.Context, *GetAsConfigurationRequest) (*GetAsConfigurationResponse, error)
+	// SimulateNetworkUplink encrypts the given FRMPayload with the application session key of the end device
+	// and lets the Network Server handle the resulting uplink message as if it was received by a gateway.
+	SimulateNetworkUplink(context.Context, *SimulateNetworkUplinkRequest) (*UplinkMessage, error)
+	// DecodePHYPayload decrypts the application payload of the data frame PHYPayload of the end device
+	// with the AppSKey of its current or pending session, so that captured uplinks and downlinks can be inspected.
+	// This requires the right to read the end device keys.
+	DecodePHYPayload(context.Context, *DecodeAsPHYPayloadRequest) (*DecodeAsPHYPayloadResponse, error)
 	mustEmbedUnimplementedAsServer()
 }
 
@@ -156,6 +190,12 @@ func (UnimplementedAsServer) GetLinkStats(context.Context, *ApplicationIdentifie
 func (UnimplementedAsServer) GetConfiguration(context.Context, *GetAsConfigurationRequest) (*GetAsConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
+func (UnimplementedAsServer) SimulateNetworkUplink(context.Context, *SimulateNetworkUplinkRequest) (*UplinkMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateNetworkUplink not implemented")
+}
+func (UnimplementedAsServer) DecodePHYPayload(context.Context, *DecodeAsPHYPayloadRequest) (*DecodeAsPHYPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodePHYPayload not implemented")
+}
 func (UnimplementedAsServer) mustEmbedUnimplementedAsServer() {}
 
 // UnsafeAsServer may be embedded to opt out of forward compatibility for this service.
@@ -259,6 +299,42 @@ func _As_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _As_SimulateNetworkUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateNetworkUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).SimulateNetworkUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: As_SimulateNetworkUplink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).SimulateNetworkUplink(ctx, req.(*SimulateNetworkUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _As_DecodePHYPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeAsPHYPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsServer).DecodePHYPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: As_DecodePHYPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsServer).DecodePHYPayload(ctx, req.(*DecodeAsPHYPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // As_ServiceDesc is the grpc.ServiceDesc for As service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -286,6 +362,14 @@ var As_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfiguration",
 			Handler:    _As_GetConfiguration_Handler,
 		},
+		{
+			MethodName: "SimulateNetworkUplink",
+			Handler:    _As_SimulateNetworkUplink_Handler,
+		},
+		{
+			MethodName: "DecodePHYPayload",
+			Handler:    _As_DecodePHYPayload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/applicationserver.proto",
@@ -987,6 +1071,7 @@ var AsEndDeviceRegistry_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	AsEndDeviceBatchRegistry_Delete_FullMethodName = "/ttn.lorawan.v3.AsEndDeviceBatchRegistry/Delete"
+	AsEndDeviceBatchRegistry_Update_FullMethodName = "/ttn.lorawan.v3.AsEndDeviceBatchRegistry/Update"
 )
 
 // AsEndDeviceBatchRegistryClient is the client API for AsEndDeviceBatchRegistry service.
@@ -997,6 +1082,9 @@ type AsEndDeviceBatchRegistryClient interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error)
 }
 
 type asEndDeviceBatchRegistryClient struct {
@@ -1016,6 +1104,15 @@ func (c *asEndDeviceBatchRegistryClient) Delete(ctx context.Context, in *BatchDe
 	return out, nil
 }
 
+func (c *asEndDeviceBatchRegistryClient) Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error) {
+	out := new(BatchUpdateEndDevicesResponse)
+	err := c.cc.Invoke(ctx, AsEndDeviceBatchRegistry_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsEndDeviceBatchRegistryServer is the server API for AsEndDeviceBatchRegistry service.
 // All implementations must embed UnimplementedAsEndDeviceBatchRegistryServer
 // for forward compatibility
@@ -1024,6 +1121,9 @@ type AsEndDeviceBatchRegistryServer interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error)
 	mustEmbedUnimplementedAsEndDeviceBatchRegistryServer()
 }
 
@@ -1034,6 +1134,9 @@ type UnimplementedAsEndDeviceBatchRegistryServer struct {
 func (UnimplementedAsEndDeviceBatchRegistryServer) Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedAsEndDeviceBatchRegistryServer) Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedAsEndDeviceBatchRegistryServer) mustEmbedUnimplementedAsEndDeviceBatchRegistryServer() {
 }
 
@@ -1066,6 +1169,24 @@ func _AsEndDeviceBatchRegistry_Delete_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AsEndDeviceBatchRegistry_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsEndDeviceBatchRegistryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AsEndDeviceBatchRegistry_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsEndDeviceBatchRegistryServer).Update(ctx, req.(*BatchUpdateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AsEndDeviceBatchRegistry_ServiceDesc is the grpc.ServiceDesc for AsEndDeviceBatchRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1077,6 +1198,10 @@ var AsEndDeviceBatchRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _AsEndDeviceBatchRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _AsEndDeviceBatchRegistry_Update_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/applicationserver.proto",
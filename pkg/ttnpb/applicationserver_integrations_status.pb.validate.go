@@ -0,0 +1,551 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on ApplicationIntegrationError with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ApplicationIntegrationError) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationIntegrationErrorFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "namespace":
+			// no validation rules for Namespace
+		case "name":
+			// no validation rules for Name
+		case "message":
+			// no validation rules for Message
+		case "at":
+
+			if v, ok := interface{}(m.GetAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationIntegrationErrorValidationError{
+						field:  "at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationIntegrationErrorValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationIntegrationErrorValidationError is the validation error returned
+// by ApplicationIntegrationError.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationIntegrationErrorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationIntegrationErrorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationIntegrationErrorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationIntegrationErrorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationIntegrationErrorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationIntegrationErrorValidationError) ErrorName() string {
+	return "ApplicationIntegrationErrorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationIntegrationErrorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationIntegrationError.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationIntegrationErrorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationIntegrationErrorValidationError{}
+
+// ValidateFields checks the field values on ApplicationWebhookStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationWebhookStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationWebhookStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "webhook_id":
+			// no validation rules for WebhookId
+		case "healthy":
+			// no validation rules for Healthy
+		case "failed_attempts":
+			// no validation rules for FailedAttempts
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationWebhookStatusValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationWebhookStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationWebhookStatusValidationError is the validation error returned by
+// ApplicationWebhookStatus.ValidateFields if the designated constraints
+// aren't met.
+type ApplicationWebhookStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationWebhookStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationWebhookStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationWebhookStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationWebhookStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationWebhookStatusValidationError) ErrorName() string {
+	return "ApplicationWebhookStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationWebhookStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationWebhookStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationWebhookStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationWebhookStatusValidationError{}
+
+// ValidateFields checks the field values on ApplicationPubSubStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationPubSubStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationPubSubStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "pub_sub_id":
+			// no validation rules for PubSubId
+		case "provider":
+			// no validation rules for Provider
+		case "connected":
+			// no validation rules for Connected
+		case "disabled":
+			// no validation rules for Disabled
+		case "failures":
+			// no validation rules for Failures
+		case "consecutive_failures":
+			// no validation rules for ConsecutiveFailures
+		case "last_error":
+
+			if v, ok := interface{}(m.GetLastError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationPubSubStatusValidationError{
+						field:  "last_error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ApplicationPubSubStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationPubSubStatusValidationError is the validation error returned by
+// ApplicationPubSubStatus.ValidateFields if the designated constraints aren't met.
+type ApplicationPubSubStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationPubSubStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationPubSubStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationPubSubStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationPubSubStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationPubSubStatusValidationError) ErrorName() string {
+	return "ApplicationPubSubStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationPubSubStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationPubSubStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationPubSubStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationPubSubStatusValidationError{}
+
+// ValidateFields checks the field values on ApplicationMQTTStatus with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ApplicationMQTTStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationMQTTStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "consumers":
+			// no validation rules for Consumers
+		default:
+			return ApplicationMQTTStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationMQTTStatusValidationError is the validation error returned by
+// ApplicationMQTTStatus.ValidateFields if the designated constraints aren't met.
+type ApplicationMQTTStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationMQTTStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationMQTTStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationMQTTStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationMQTTStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationMQTTStatusValidationError) ErrorName() string {
+	return "ApplicationMQTTStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationMQTTStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationMQTTStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationMQTTStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationMQTTStatusValidationError{}
+
+// ValidateFields checks the field values on ApplicationIntegrationStatuses
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ApplicationIntegrationStatuses) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ApplicationIntegrationStatusesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "webhooks":
+
+			for idx, item := range m.GetWebhooks() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationIntegrationStatusesValidationError{
+							field:  fmt.Sprintf("webhooks[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "pub_subs":
+
+			for idx, item := range m.GetPubSubs() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return ApplicationIntegrationStatusesValidationError{
+							field:  fmt.Sprintf("pub_subs[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		case "mqtt":
+
+			if v, ok := interface{}(m.GetMqtt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ApplicationIntegrationStatusesValidationError{
+						field:  "mqtt",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "subscriptions":
+			// no validation rules for Subscriptions
+		default:
+			return ApplicationIntegrationStatusesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ApplicationIntegrationStatusesValidationError is the validation error
+// returned by ApplicationIntegrationStatuses.ValidateFields if the designated
+// constraints aren't met.
+type ApplicationIntegrationStatusesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplicationIntegrationStatusesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplicationIntegrationStatusesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplicationIntegrationStatusesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplicationIntegrationStatusesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplicationIntegrationStatusesValidationError) ErrorName() string {
+	return "ApplicationIntegrationStatusesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplicationIntegrationStatusesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplicationIntegrationStatuses.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplicationIntegrationStatusesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplicationIntegrationStatusesValidationError{}
@@ -9,6 +9,7 @@ package ttnpb
 import (
 	golang "github.com/TheThingsIndustries/protoc-gen-go-json/golang"
 	jsonplugin "github.com/TheThingsIndustries/protoc-gen-go-json/jsonplugin"
+	types "go.thethings.network/lorawan-stack/v3/pkg/types"
 	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 )
 
@@ -474,6 +475,224 @@ func (x *GetAsConfigurationResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the SimulateNetworkUplinkRequest message to JSON.
+func (x *SimulateNetworkUplinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if len(x.FrmPayload) > 0 || s.HasField("frm_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frm_payload")
+		s.WriteBytes(x.FrmPayload)
+	}
+	if x.Confirmed || s.HasField("confirmed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("confirmed")
+		s.WriteBool(x.Confirmed)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SimulateNetworkUplinkRequest to JSON.
+func (x *SimulateNetworkUplinkRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SimulateNetworkUplinkRequest message from JSON.
+func (x *SimulateNetworkUplinkRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "frm_payload", "frmPayload":
+			s.AddField("frm_payload")
+			x.FrmPayload = s.ReadBytes()
+		case "confirmed":
+			s.AddField("confirmed")
+			x.Confirmed = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SimulateNetworkUplinkRequest from JSON.
+func (x *SimulateNetworkUplinkRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the DecodeAsPHYPayloadRequest message to JSON.
+func (x *DecodeAsPHYPayloadRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.PhyPayload) > 0 || s.HasField("phy_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("phy_payload")
+		s.WriteBytes(x.PhyPayload)
+	}
+	if x.FullFCnt != nil || s.HasField("full_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("full_f_cnt")
+		if x.FullFCnt == nil {
+			s.WriteNil()
+		} else {
+			s.WriteUint32(x.FullFCnt.Value)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DecodeAsPHYPayloadRequest to JSON.
+func (x *DecodeAsPHYPayloadRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DecodeAsPHYPayloadRequest message from JSON.
+func (x *DecodeAsPHYPayloadRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "phy_payload", "phyPayload":
+			s.AddField("phy_payload")
+			x.PhyPayload = s.ReadBytes()
+		case "full_f_cnt", "fullFCnt":
+			s.AddField("full_f_cnt")
+			if s.ReadNil() {
+				x.FullFCnt = nil
+				return
+			}
+			v := s.ReadWrappedUint32()
+			if s.Err() != nil {
+				return
+			}
+			x.FullFCnt = &wrapperspb.UInt32Value{Value: v}
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DecodeAsPHYPayloadRequest from JSON.
+func (x *DecodeAsPHYPayloadRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the DecodeAsPHYPayloadResponse message to JSON.
+func (x *DecodeAsPHYPayloadResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		types.MarshalHEXBytes(s.WithField("dev_addr"), x.DevAddr)
+	}
+	if x.PendingSession || s.HasField("pending_session") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_session")
+		s.WriteBool(x.PendingSession)
+	}
+	if x.FullFCnt != 0 || s.HasField("full_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("full_f_cnt")
+		s.WriteUint32(x.FullFCnt)
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if len(x.FrmPayload) > 0 || s.HasField("frm_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frm_payload")
+		s.WriteBytes(x.FrmPayload)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DecodeAsPHYPayloadResponse to JSON.
+func (x *DecodeAsPHYPayloadResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DecodeAsPHYPayloadResponse message from JSON.
+func (x *DecodeAsPHYPayloadResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr = types.Unmarshal4Bytes(s.WithField("dev_addr", false))
+		case "pending_session", "pendingSession":
+			s.AddField("pending_session")
+			x.PendingSession = s.ReadBool()
+		case "full_f_cnt", "fullFCnt":
+			s.AddField("full_f_cnt")
+			x.FullFCnt = s.ReadUint32()
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "frm_payload", "frmPayload":
+			s.AddField("frm_payload")
+			x.FrmPayload = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DecodeAsPHYPayloadResponse from JSON.
+func (x *DecodeAsPHYPayloadResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the NsAsHandleUplinkRequest message to JSON.
 func (x *NsAsHandleUplinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
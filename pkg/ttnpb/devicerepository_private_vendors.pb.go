@@ -0,0 +1,452 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.2
+// source: ttn/lorawan/v3/devicerepository_private_vendors.proto
+
+package ttnpb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// A vendor in the private vendor catalog of the Device Repository.
+type DeviceRepositoryPrivateVendor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrandId              string `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	Name                 string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	LoraAllianceVendorId uint32 `protobuf:"varint,3,opt,name=lora_alliance_vendor_id,json=loraAllianceVendorId,proto3" json:"lora_alliance_vendor_id,omitempty"`
+	Draft                bool   `protobuf:"varint,4,opt,name=draft,proto3" json:"draft,omitempty"`
+}
+
+func (x *DeviceRepositoryPrivateVendor) Reset() {
+	*x = DeviceRepositoryPrivateVendor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceRepositoryPrivateVendor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRepositoryPrivateVendor) ProtoMessage() {}
+
+func (x *DeviceRepositoryPrivateVendor) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRepositoryPrivateVendor.ProtoReflect.Descriptor instead.
+func (*DeviceRepositoryPrivateVendor) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeviceRepositoryPrivateVendor) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *DeviceRepositoryPrivateVendor) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DeviceRepositoryPrivateVendor) GetLoraAllianceVendorId() uint32 {
+	if x != nil {
+		return x.LoraAllianceVendorId
+	}
+	return 0
+}
+
+func (x *DeviceRepositoryPrivateVendor) GetDraft() bool {
+	if x != nil {
+		return x.Draft
+	}
+	return false
+}
+
+type DeviceRepositoryPrivateVendors struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Vendors []*DeviceRepositoryPrivateVendor `protobuf:"bytes,1,rep,name=vendors,proto3" json:"vendors,omitempty"`
+}
+
+func (x *DeviceRepositoryPrivateVendors) Reset() {
+	*x = DeviceRepositoryPrivateVendors{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeviceRepositoryPrivateVendors) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeviceRepositoryPrivateVendors) ProtoMessage() {}
+
+func (x *DeviceRepositoryPrivateVendors) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeviceRepositoryPrivateVendors.ProtoReflect.Descriptor instead.
+func (*DeviceRepositoryPrivateVendors) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeviceRepositoryPrivateVendors) GetVendors() []*DeviceRepositoryPrivateVendor {
+	if x != nil {
+		return x.Vendors
+	}
+	return nil
+}
+
+type UploadDeviceRepositoryPrivateVendorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrandId string `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+	// The gzipped tar archive of the vendor. The archive contains the vendor information in vendor.yaml,
+	// the end device index in index.yaml, and the models, profiles and codecs that are referenced by the
+	// end device index, all in the root of the archive.
+	Archive []byte `protobuf:"bytes,2,opt,name=archive,proto3" json:"archive,omitempty"`
+}
+
+func (x *UploadDeviceRepositoryPrivateVendorRequest) Reset() {
+	*x = UploadDeviceRepositoryPrivateVendorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadDeviceRepositoryPrivateVendorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadDeviceRepositoryPrivateVendorRequest) ProtoMessage() {}
+
+func (x *UploadDeviceRepositoryPrivateVendorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadDeviceRepositoryPrivateVendorRequest.ProtoReflect.Descriptor instead.
+func (*UploadDeviceRepositoryPrivateVendorRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *UploadDeviceRepositoryPrivateVendorRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+func (x *UploadDeviceRepositoryPrivateVendorRequest) GetArchive() []byte {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type DeleteDeviceRepositoryPrivateVendorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BrandId string `protobuf:"bytes,1,opt,name=brand_id,json=brandId,proto3" json:"brand_id,omitempty"`
+}
+
+func (x *DeleteDeviceRepositoryPrivateVendorRequest) Reset() {
+	*x = DeleteDeviceRepositoryPrivateVendorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteDeviceRepositoryPrivateVendorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeviceRepositoryPrivateVendorRequest) ProtoMessage() {}
+
+func (x *DeleteDeviceRepositoryPrivateVendorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeviceRepositoryPrivateVendorRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeviceRepositoryPrivateVendorRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteDeviceRepositoryPrivateVendorRequest) GetBrandId() string {
+	if x != nil {
+		return x.BrandId
+	}
+	return ""
+}
+
+var File_ttn_lorawan_v3_devicerepository_private_vendors_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDesc = []byte{
+	0x0a, 0x35, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x5f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x5f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x61, 0x70, 0x69, 0x2f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x9b, 0x01, 0x0a, 0x1d,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79,
+	0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x12, 0x19, 0x0a,
+	0x08, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x35, 0x0a, 0x17,
+	0x6c, 0x6f, 0x72, 0x61, 0x5f, 0x61, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x76, 0x65,
+	0x6e, 0x64, 0x6f, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x6c,
+	0x6f, 0x72, 0x61, 0x41, 0x6c, 0x6c, 0x69, 0x61, 0x6e, 0x63, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x64, 0x72, 0x61, 0x66, 0x74, 0x22, 0x69, 0x0a, 0x1e, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x12, 0x47, 0x0a, 0x07, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x74,
+	0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72,
+	0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x07, 0x76, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x2a, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72, 0x22, 0x18, 0x24, 0x32, 0x1e,
+	0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f, 0x3a, 0x5b, 0x2d, 0x5d, 0x3f,
+	0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32, 0x2c, 0x7d, 0x24, 0x52, 0x07,
+	0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x7a, 0x02, 0x10,
+	0x01, 0x52, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x22, 0x70, 0x0a, 0x2a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69,
+	0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f,
+	0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x62, 0x72, 0x61, 0x6e,
+	0x64, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x27, 0xfa, 0x42, 0x24, 0x72,
+	0x22, 0x18, 0x24, 0x32, 0x1e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x28, 0x3f,
+	0x3a, 0x5b, 0x2d, 0x5d, 0x3f, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x29, 0x7b, 0x32,
+	0x2c, 0x7d, 0x24, 0x52, 0x07, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x49, 0x64, 0x32, 0xc2, 0x03, 0x0a,
+	0x25, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72,
+	0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x6b, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x2e, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x12, 0x13,
+	0x2f, 0x64, 0x72, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x65, 0x6e, 0x64,
+	0x6f, 0x72, 0x73, 0x12, 0xa4, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x3a,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e,
+	0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69, 0x76,
+	0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x22, 0x2f, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x29, 0x3a, 0x07, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x1a, 0x1e, 0x2f, 0x64, 0x72, 0x2f,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x2f,
+	0x7b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x7d, 0x12, 0x84, 0x01, 0x0a, 0x06, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x79, 0x50, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x56, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x26, 0x82, 0xd3, 0xe4, 0x93, 0x02,
+	0x20, 0x2a, 0x1e, 0x2f, 0x64, 0x72, 0x2f, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x2f, 0x76,
+	0x65, 0x6e, 0x64, 0x6f, 0x72, 0x73, 0x2f, 0x7b, 0x62, 0x72, 0x61, 0x6e, 0x64, 0x5f, 0x69, 0x64,
+	0x7d, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67,
+	0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74,
+	0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescData = file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ttn_lorawan_v3_devicerepository_private_vendors_proto_goTypes = []interface{}{
+	(*DeviceRepositoryPrivateVendor)(nil),              // 0: ttn.lorawan.v3.DeviceRepositoryPrivateVendor
+	(*DeviceRepositoryPrivateVendors)(nil),             // 1: ttn.lorawan.v3.DeviceRepositoryPrivateVendors
+	(*UploadDeviceRepositoryPrivateVendorRequest)(nil), // 2: ttn.lorawan.v3.UploadDeviceRepositoryPrivateVendorRequest
+	(*DeleteDeviceRepositoryPrivateVendorRequest)(nil), // 3: ttn.lorawan.v3.DeleteDeviceRepositoryPrivateVendorRequest
+	(*emptypb.Empty)(nil),                              // 4: google.protobuf.Empty
+}
+var file_ttn_lorawan_v3_devicerepository_private_vendors_proto_depIdxs = []int32{
+	0, // 0: ttn.lorawan.v3.DeviceRepositoryPrivateVendors.vendors:type_name -> ttn.lorawan.v3.DeviceRepositoryPrivateVendor
+	4, // 1: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.List:input_type -> google.protobuf.Empty
+	2, // 2: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.Upload:input_type -> ttn.lorawan.v3.UploadDeviceRepositoryPrivateVendorRequest
+	3, // 3: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.Delete:input_type -> ttn.lorawan.v3.DeleteDeviceRepositoryPrivateVendorRequest
+	1, // 4: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.List:output_type -> ttn.lorawan.v3.DeviceRepositoryPrivateVendors
+	0, // 5: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.Upload:output_type -> ttn.lorawan.v3.DeviceRepositoryPrivateVendor
+	4, // 6: ttn.lorawan.v3.DeviceRepositoryPrivateVendorRegistry.Delete:output_type -> google.protobuf.Empty
+	4, // [4:7] is the sub-list for method output_type
+	1, // [1:4] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_devicerepository_private_vendors_proto_init() }
+func file_ttn_lorawan_v3_devicerepository_private_vendors_proto_init() {
+	if File_ttn_lorawan_v3_devicerepository_private_vendors_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceRepositoryPrivateVendor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeviceRepositoryPrivateVendors); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadDeviceRepositoryPrivateVendorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDeviceRepositoryPrivateVendorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_devicerepository_private_vendors_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_devicerepository_private_vendors_proto_depIdxs,
+		MessageInfos:      file_ttn_lorawan_v3_devicerepository_private_vendors_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_devicerepository_private_vendors_proto = out.File
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_rawDesc = nil
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_goTypes = nil
+	file_ttn_lorawan_v3_devicerepository_private_vendors_proto_depIdxs = nil
+}
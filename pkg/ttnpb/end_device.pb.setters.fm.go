@@ -3217,6 +3217,155 @@ func (dst *BatchGetEndDevicesRequest) SetFields(src *BatchGetEndDevicesRequest,
 	return nil
 }
 
+func (dst *BatchUpdateEndDevicesRequest) SetFields(src *BatchUpdateEndDevicesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_ids' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceIds = src.DeviceIds
+			} else {
+				dst.DeviceIds = nil
+			}
+		case "label_selector":
+			if len(subs) > 0 {
+				return fmt.Errorf("'label_selector' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LabelSelector = src.LabelSelector
+			} else {
+				var zero string
+				dst.LabelSelector = zero
+			}
+		case "end_device":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDevice
+				if (src == nil || src.EndDevice == nil) && dst.EndDevice == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDevice
+				}
+				if dst.EndDevice != nil {
+					newDst = dst.EndDevice
+				} else {
+					newDst = &EndDevice{}
+					dst.EndDevice = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDevice = src.EndDevice
+				} else {
+					dst.EndDevice = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *BatchUpdateEndDevicesResponse) SetFields(src *BatchUpdateEndDevicesResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "updated":
+			if len(subs) > 0 {
+				return fmt.Errorf("'updated' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Updated = src.Updated
+			} else {
+				var zero bool
+				dst.Updated = zero
+			}
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *BatchDeleteEndDevicesFromClusterResponse) SetFields(src *BatchDeleteEndDevicesFromClusterResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "deleted":
+			if len(subs) > 0 {
+				return fmt.Errorf("'deleted' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Deleted = src.Deleted
+			} else {
+				var zero bool
+				dst.Deleted = zero
+			}
+		case "results":
+			if len(subs) > 0 {
+				return fmt.Errorf("'results' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Results = src.Results
+			} else {
+				dst.Results = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *MACParameters_Channel) SetFields(src *MACParameters_Channel, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -4229,3 +4378,112 @@ func (dst *BatchUpdateEndDeviceLastSeenRequest_EndDeviceLastSeenUpdate) SetField
 	}
 	return nil
 }
+
+func (dst *BatchUpdateEndDevicesResponse_Result) SetFields(src *BatchUpdateEndDevicesResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "device_id":
+			if len(subs) > 0 {
+				return fmt.Errorf("'device_id' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DeviceId = src.DeviceId
+			} else {
+				var zero string
+				dst.DeviceId = zero
+			}
+		case "status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Status = src.Status
+			} else {
+				dst.Status = 0
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *BatchDeleteEndDevicesFromClusterResponse_Result) SetFields(src *BatchDeleteEndDevicesFromClusterResponse_Result, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "component":
+			if len(subs) > 0 {
+				return fmt.Errorf("'component' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Component = src.Component
+			} else {
+				dst.Component = 0
+			}
+		case "status":
+			if len(subs) > 0 {
+				return fmt.Errorf("'status' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Status = src.Status
+			} else {
+				dst.Status = 0
+			}
+		case "error":
+			if len(subs) > 0 {
+				var newDst, newSrc *ErrorDetails
+				if (src == nil || src.Error == nil) && dst.Error == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Error
+				}
+				if dst.Error != nil {
+					newDst = dst.Error
+				} else {
+					newDst = &ErrorDetails{}
+					dst.Error = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Error = src.Error
+				} else {
+					dst.Error = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
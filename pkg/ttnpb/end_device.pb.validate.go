@@ -4290,6 +4290,379 @@ var _ interface {
 
 var _BatchGetEndDevicesRequest_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
 
+// ValidateFields checks the field values on BatchUpdateEndDevicesRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BatchUpdateEndDevicesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchUpdateEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return BatchUpdateEndDevicesRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "device_ids":
+
+			if len(m.GetDeviceIds()) > 100 {
+				return BatchUpdateEndDevicesRequestValidationError{
+					field:  "device_ids",
+					reason: "value must contain no more than 100 item(s)",
+				}
+			}
+
+			for idx, item := range m.GetDeviceIds() {
+				_, _ = idx, item
+
+				if utf8.RuneCountInString(item) > 36 {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_BatchUpdateEndDevicesRequest_DeviceIds_Pattern.MatchString(item) {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("device_ids[%v]", idx),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+			}
+
+		case "label_selector":
+
+			if utf8.RuneCountInString(m.GetLabelSelector()) > 1024 {
+				return BatchUpdateEndDevicesRequestValidationError{
+					field:  "label_selector",
+					reason: "value length must be at most 1024 runes",
+				}
+			}
+
+		case "end_device":
+
+			if m.GetEndDevice() == nil {
+				return BatchUpdateEndDevicesRequestValidationError{
+					field:  "end_device",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDevice()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  "end_device",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchUpdateEndDevicesRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return BatchUpdateEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchUpdateEndDevicesRequestValidationError is the validation error returned
+// by BatchUpdateEndDevicesRequest.ValidateFields if the designated
+// constraints aren't met.
+type BatchUpdateEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateEndDevicesRequestValidationError) ErrorName() string {
+	return "BatchUpdateEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateEndDevicesRequestValidationError{}
+
+var _BatchUpdateEndDevicesRequest_DeviceIds_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on BatchUpdateEndDevicesResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *BatchUpdateEndDevicesResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchUpdateEndDevicesResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "updated":
+			// no validation rules for Updated
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchUpdateEndDevicesResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return BatchUpdateEndDevicesResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchUpdateEndDevicesResponseValidationError is the validation error
+// returned by BatchUpdateEndDevicesResponse.ValidateFields if the designated
+// constraints aren't met.
+type BatchUpdateEndDevicesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateEndDevicesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateEndDevicesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateEndDevicesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateEndDevicesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateEndDevicesResponseValidationError) ErrorName() string {
+	return "BatchUpdateEndDevicesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateEndDevicesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateEndDevicesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateEndDevicesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateEndDevicesResponseValidationError{}
+
+// ValidateFields checks the field values on
+// BatchDeleteEndDevicesFromClusterResponse with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *BatchDeleteEndDevicesFromClusterResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchDeleteEndDevicesFromClusterResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "deleted":
+			// no validation rules for Deleted
+		case "results":
+
+			for idx, item := range m.GetResults() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return BatchDeleteEndDevicesFromClusterResponseValidationError{
+							field:  fmt.Sprintf("results[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return BatchDeleteEndDevicesFromClusterResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchDeleteEndDevicesFromClusterResponseValidationError is the validation
+// error returned by BatchDeleteEndDevicesFromClusterResponse.ValidateFields
+// if the designated constraints aren't met.
+type BatchDeleteEndDevicesFromClusterResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) ErrorName() string {
+	return "BatchDeleteEndDevicesFromClusterResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteEndDevicesFromClusterResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteEndDevicesFromClusterResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteEndDevicesFromClusterResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteEndDevicesFromClusterResponseValidationError{}
+
 // ValidateFields checks the field values on MACParameters_Channel with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -6548,3 +6921,205 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchUpdateEndDeviceLastSeenRequest_EndDeviceLastSeenUpdateValidationError{}
+
+// ValidateFields checks the field values on
+// BatchUpdateEndDevicesResponse_Result with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *BatchUpdateEndDevicesResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchUpdateEndDevicesResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "device_id":
+			// no validation rules for DeviceId
+		case "status":
+			// no validation rules for Status
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchUpdateEndDevicesResponse_ResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return BatchUpdateEndDevicesResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchUpdateEndDevicesResponse_ResultValidationError is the validation error
+// returned by BatchUpdateEndDevicesResponse_Result.ValidateFields if the
+// designated constraints aren't met.
+type BatchUpdateEndDevicesResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) ErrorName() string {
+	return "BatchUpdateEndDevicesResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchUpdateEndDevicesResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchUpdateEndDevicesResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchUpdateEndDevicesResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchUpdateEndDevicesResponse_ResultValidationError{}
+
+// ValidateFields checks the field values on
+// BatchDeleteEndDevicesFromClusterResponse_Result with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *BatchDeleteEndDevicesFromClusterResponse_Result) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BatchDeleteEndDevicesFromClusterResponse_ResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "component":
+			// no validation rules for Component
+		case "status":
+			// no validation rules for Status
+		case "error":
+
+			if v, ok := interface{}(m.GetError()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return BatchDeleteEndDevicesFromClusterResponse_ResultValidationError{
+						field:  "error",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return BatchDeleteEndDevicesFromClusterResponse_ResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BatchDeleteEndDevicesFromClusterResponse_ResultValidationError is the
+// validation error returned by
+// BatchDeleteEndDevicesFromClusterResponse_Result.ValidateFields if the
+// designated constraints aren't met.
+type BatchDeleteEndDevicesFromClusterResponse_ResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) Field() string {
+	return e.field
+}
+
+// Reason function returns reason value.
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) Reason() string {
+	return e.reason
+}
+
+// Cause function returns cause value.
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) ErrorName() string {
+	return "BatchDeleteEndDevicesFromClusterResponse_ResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDeleteEndDevicesFromClusterResponse_ResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDeleteEndDevicesFromClusterResponse_Result.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDeleteEndDevicesFromClusterResponse_ResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDeleteEndDevicesFromClusterResponse_ResultValidationError{}
@@ -4142,3 +4142,475 @@ func (x *BatchGetEndDevicesRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalSt
 func (x *BatchGetEndDevicesRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the BatchUpdateEndDevicesRequest message to JSON.
+func (x *BatchUpdateEndDevicesRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if len(x.DeviceIds) > 0 || s.HasField("device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_ids")
+		s.WriteStringArray(x.DeviceIds)
+	}
+	if x.LabelSelector != "" || s.HasField("label_selector") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("label_selector")
+		s.WriteString(x.LabelSelector)
+	}
+	if x.EndDevice != nil || s.HasField("end_device") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device")
+		x.EndDevice.MarshalProtoJSON(s.WithField("end_device"))
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the BatchUpdateEndDevicesRequest to JSON.
+func (x *BatchUpdateEndDevicesRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the BatchUpdateEndDevicesRequest message from JSON.
+func (x *BatchUpdateEndDevicesRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "device_ids", "deviceIds":
+			s.AddField("device_ids")
+			if s.ReadNil() {
+				x.DeviceIds = nil
+				return
+			}
+			x.DeviceIds = s.ReadStringArray()
+		case "label_selector", "labelSelector":
+			s.AddField("label_selector")
+			x.LabelSelector = s.ReadString()
+		case "end_device", "endDevice":
+			if s.ReadNil() {
+				x.EndDevice = nil
+				return
+			}
+			x.EndDevice = &EndDevice{}
+			x.EndDevice.UnmarshalProtoJSON(s.WithField("end_device", true))
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the BatchUpdateEndDevicesRequest from JSON.
+func (x *BatchUpdateEndDevicesRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchUpdateEndDevicesResponse_Status to JSON.
+func (x BatchUpdateEndDevicesResponse_Status) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), BatchUpdateEndDevicesResponse_Status_name)
+}
+
+// MarshalText marshals the BatchUpdateEndDevicesResponse_Status to text.
+func (x BatchUpdateEndDevicesResponse_Status) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), BatchUpdateEndDevicesResponse_Status_name)), nil
+}
+
+// MarshalJSON marshals the BatchUpdateEndDevicesResponse_Status to JSON.
+func (x BatchUpdateEndDevicesResponse_Status) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// BatchUpdateEndDevicesResponse_Status_customvalue contains custom string values that extend BatchUpdateEndDevicesResponse_Status_value.
+var BatchUpdateEndDevicesResponse_Status_customvalue = map[string]int32{
+	"SKIPPED":  0,
+	"UPDATED":  1,
+	"FAILED":   2,
+	"REVERTED": 3,
+}
+
+// UnmarshalProtoJSON unmarshals the BatchUpdateEndDevicesResponse_Status from JSON.
+func (x *BatchUpdateEndDevicesResponse_Status) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(BatchUpdateEndDevicesResponse_Status_value, BatchUpdateEndDevicesResponse_Status_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Status enum: %v", err)
+		return
+	}
+	*x = BatchUpdateEndDevicesResponse_Status(v)
+}
+
+// UnmarshalText unmarshals the BatchUpdateEndDevicesResponse_Status from text.
+func (x *BatchUpdateEndDevicesResponse_Status) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), BatchUpdateEndDevicesResponse_Status_customvalue, BatchUpdateEndDevicesResponse_Status_value)
+	if err != nil {
+		return err
+	}
+	*x = BatchUpdateEndDevicesResponse_Status(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the BatchUpdateEndDevicesResponse_Status from JSON.
+func (x *BatchUpdateEndDevicesResponse_Status) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchUpdateEndDevicesResponse_Result message to JSON.
+func (x *BatchUpdateEndDevicesResponse_Result) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.DeviceId != "" || s.HasField("device_id") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_id")
+		s.WriteString(x.DeviceId)
+	}
+	if x.Status != 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		x.Status.MarshalProtoJSON(s)
+	}
+	if x.Error != nil || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Error)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the BatchUpdateEndDevicesResponse_Result to JSON.
+func (x *BatchUpdateEndDevicesResponse_Result) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the BatchUpdateEndDevicesResponse_Result message from JSON.
+func (x *BatchUpdateEndDevicesResponse_Result) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "device_id", "deviceId":
+			s.AddField("device_id")
+			x.DeviceId = s.ReadString()
+		case "status":
+			s.AddField("status")
+			x.Status.UnmarshalProtoJSON(s)
+		case "error":
+			s.AddField("error")
+			if s.ReadNil() {
+				x.Error = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.Error = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the BatchUpdateEndDevicesResponse_Result from JSON.
+func (x *BatchUpdateEndDevicesResponse_Result) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchUpdateEndDevicesResponse message to JSON.
+func (x *BatchUpdateEndDevicesResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Updated || s.HasField("updated") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("updated")
+		s.WriteBool(x.Updated)
+	}
+	if len(x.Results) > 0 || s.HasField("results") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("results")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Results {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("results"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the BatchUpdateEndDevicesResponse to JSON.
+func (x *BatchUpdateEndDevicesResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the BatchUpdateEndDevicesResponse message from JSON.
+func (x *BatchUpdateEndDevicesResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "updated":
+			s.AddField("updated")
+			x.Updated = s.ReadBool()
+		case "results":
+			s.AddField("results")
+			if s.ReadNil() {
+				x.Results = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Results = append(x.Results, nil)
+					return
+				}
+				v := &BatchUpdateEndDevicesResponse_Result{}
+				v.UnmarshalProtoJSON(s.WithField("results", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Results = append(x.Results, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the BatchUpdateEndDevicesResponse from JSON.
+func (x *BatchUpdateEndDevicesResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchDeleteEndDevicesFromClusterResponse_Status to JSON.
+func (x BatchDeleteEndDevicesFromClusterResponse_Status) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	s.WriteEnumString(int32(x), BatchDeleteEndDevicesFromClusterResponse_Status_name)
+}
+
+// MarshalText marshals the BatchDeleteEndDevicesFromClusterResponse_Status to text.
+func (x BatchDeleteEndDevicesFromClusterResponse_Status) MarshalText() ([]byte, error) {
+	return []byte(jsonplugin.GetEnumString(int32(x), BatchDeleteEndDevicesFromClusterResponse_Status_name)), nil
+}
+
+// MarshalJSON marshals the BatchDeleteEndDevicesFromClusterResponse_Status to JSON.
+func (x BatchDeleteEndDevicesFromClusterResponse_Status) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// BatchDeleteEndDevicesFromClusterResponse_Status_customvalue contains custom string values that extend BatchDeleteEndDevicesFromClusterResponse_Status_value.
+var BatchDeleteEndDevicesFromClusterResponse_Status_customvalue = map[string]int32{
+	"SKIPPED":  0,
+	"DELETED":  1,
+	"FAILED":   2,
+	"RESTORED": 3,
+}
+
+// UnmarshalProtoJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse_Status from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Status) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	v := s.ReadEnum(BatchDeleteEndDevicesFromClusterResponse_Status_value, BatchDeleteEndDevicesFromClusterResponse_Status_customvalue)
+	if err := s.Err(); err != nil {
+		s.SetErrorf("could not read Status enum: %v", err)
+		return
+	}
+	*x = BatchDeleteEndDevicesFromClusterResponse_Status(v)
+}
+
+// UnmarshalText unmarshals the BatchDeleteEndDevicesFromClusterResponse_Status from text.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Status) UnmarshalText(b []byte) error {
+	i, err := jsonplugin.ParseEnumString(string(b), BatchDeleteEndDevicesFromClusterResponse_Status_customvalue, BatchDeleteEndDevicesFromClusterResponse_Status_value)
+	if err != nil {
+		return err
+	}
+	*x = BatchDeleteEndDevicesFromClusterResponse_Status(i)
+	return nil
+}
+
+// UnmarshalJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse_Status from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Status) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchDeleteEndDevicesFromClusterResponse_Result message to JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Result) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Component != 0 || s.HasField("component") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("component")
+		s.WriteEnum(int32(x.Component), ClusterRole_name)
+	}
+	if x.Status != 0 || s.HasField("status") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("status")
+		x.Status.MarshalProtoJSON(s)
+	}
+	if x.Error != nil || s.HasField("error") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("error")
+		// NOTE: ErrorDetails does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.Error)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the BatchDeleteEndDevicesFromClusterResponse_Result to JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Result) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse_Result message from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Result) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "component":
+			s.AddField("component")
+			x.Component = ClusterRole(s.ReadEnum(ClusterRole_value))
+		case "status":
+			s.AddField("status")
+			x.Status.UnmarshalProtoJSON(s)
+		case "error":
+			s.AddField("error")
+			if s.ReadNil() {
+				x.Error = nil
+				return
+			}
+			// NOTE: ErrorDetails does not seem to implement UnmarshalProtoJSON.
+			var v ErrorDetails
+			golang.UnmarshalMessage(s, &v)
+			x.Error = &v
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse_Result from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse_Result) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the BatchDeleteEndDevicesFromClusterResponse message to JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Deleted || s.HasField("deleted") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("deleted")
+		s.WriteBool(x.Deleted)
+	}
+	if len(x.Results) > 0 || s.HasField("results") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("results")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.Results {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("results"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the BatchDeleteEndDevicesFromClusterResponse to JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse message from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "deleted":
+			s.AddField("deleted")
+			x.Deleted = s.ReadBool()
+		case "results":
+			s.AddField("results")
+			if s.ReadNil() {
+				x.Results = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.Results = append(x.Results, nil)
+					return
+				}
+				v := &BatchDeleteEndDevicesFromClusterResponse_Result{}
+				v.UnmarshalProtoJSON(s.WithField("results", false))
+				if s.Err() != nil {
+					return
+				}
+				x.Results = append(x.Results, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the BatchDeleteEndDevicesFromClusterResponse from JSON.
+func (x *BatchDeleteEndDevicesFromClusterResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
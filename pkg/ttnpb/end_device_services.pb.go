@@ -131,7 +131,7 @@ var file_ttn_lorawan_v3_end_device_services_proto_rawDesc = []byte{
 	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x45, 0x6e, 0x64, 0x44, 0x65,
 	0x76, 0x69, 0x63, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x22, 0x18, 0x82, 0xd3,
 	0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d, 0x2f, 0x65, 0x64, 0x74, 0x63, 0x2f, 0x63,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x30, 0x01, 0x32, 0xc4, 0x02, 0x0a, 0x16, 0x45, 0x6e, 0x64,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x30, 0x01, 0x32, 0xc3, 0x05, 0x0a, 0x16, 0x45, 0x6e, 0x64,
 	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42, 0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73,
 	0x74, 0x72, 0x79, 0x12, 0x92, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x29, 0x2e, 0x74, 0x74,
 	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74,
@@ -151,29 +151,56 @@ var file_ttn_lorawan_v3_end_device_services_proto_rawDesc = []byte{
 	0x3e, 0x2a, 0x3c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
 	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
 	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x42,
-	0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e,
-	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d,
-	0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12,
+	0xae, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e,
+	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x41, 0x3a,
+	0x01, 0x2a, 0x1a, 0x3c, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68,
+	0x12, 0xcb, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x43,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4e,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x48, 0x3a, 0x01, 0x2a, 0x22, 0x43, 0x2f, 0x61, 0x70, 0x70, 0x6c,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x2d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x31,
+	0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e,
+	0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73,
+	0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var file_ttn_lorawan_v3_end_device_services_proto_goTypes = []interface{}{
-	(*CreateEndDeviceRequest)(nil),                // 0: ttn.lorawan.v3.CreateEndDeviceRequest
-	(*GetEndDeviceRequest)(nil),                   // 1: ttn.lorawan.v3.GetEndDeviceRequest
-	(*GetEndDeviceIdentifiersForEUIsRequest)(nil), // 2: ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest
-	(*ListEndDevicesRequest)(nil),                 // 3: ttn.lorawan.v3.ListEndDevicesRequest
-	(*UpdateEndDeviceRequest)(nil),                // 4: ttn.lorawan.v3.UpdateEndDeviceRequest
-	(*BatchUpdateEndDeviceLastSeenRequest)(nil),   // 5: ttn.lorawan.v3.BatchUpdateEndDeviceLastSeenRequest
-	(*EndDeviceIdentifiers)(nil),                  // 6: ttn.lorawan.v3.EndDeviceIdentifiers
-	(*emptypb.Empty)(nil),                         // 7: google.protobuf.Empty
-	(*ConvertEndDeviceTemplateRequest)(nil),       // 8: ttn.lorawan.v3.ConvertEndDeviceTemplateRequest
-	(*BatchGetEndDevicesRequest)(nil),             // 9: ttn.lorawan.v3.BatchGetEndDevicesRequest
-	(*BatchDeleteEndDevicesRequest)(nil),          // 10: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	(*EndDevice)(nil),                             // 11: ttn.lorawan.v3.EndDevice
-	(*EndDevices)(nil),                            // 12: ttn.lorawan.v3.EndDevices
-	(*EndDeviceTemplateFormats)(nil),              // 13: ttn.lorawan.v3.EndDeviceTemplateFormats
-	(*EndDeviceTemplate)(nil),                     // 14: ttn.lorawan.v3.EndDeviceTemplate
+	(*CreateEndDeviceRequest)(nil),                   // 0: ttn.lorawan.v3.CreateEndDeviceRequest
+	(*GetEndDeviceRequest)(nil),                      // 1: ttn.lorawan.v3.GetEndDeviceRequest
+	(*GetEndDeviceIdentifiersForEUIsRequest)(nil),    // 2: ttn.lorawan.v3.GetEndDeviceIdentifiersForEUIsRequest
+	(*ListEndDevicesRequest)(nil),                    // 3: ttn.lorawan.v3.ListEndDevicesRequest
+	(*UpdateEndDeviceRequest)(nil),                   // 4: ttn.lorawan.v3.UpdateEndDeviceRequest
+	(*BatchUpdateEndDeviceLastSeenRequest)(nil),      // 5: ttn.lorawan.v3.BatchUpdateEndDeviceLastSeenRequest
+	(*EndDeviceIdentifiers)(nil),                     // 6: ttn.lorawan.v3.EndDeviceIdentifiers
+	(*emptypb.Empty)(nil),                            // 7: google.protobuf.Empty
+	(*ConvertEndDeviceTemplateRequest)(nil),          // 8: ttn.lorawan.v3.ConvertEndDeviceTemplateRequest
+	(*BatchGetEndDevicesRequest)(nil),                // 9: ttn.lorawan.v3.BatchGetEndDevicesRequest
+	(*BatchDeleteEndDevicesRequest)(nil),             // 10: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	(*BatchUpdateEndDevicesRequest)(nil),             // 11: ttn.lorawan.v3.BatchUpdateEndDevicesRequest
+	(*EndDevice)(nil),                                // 12: ttn.lorawan.v3.EndDevice
+	(*EndDevices)(nil),                               // 13: ttn.lorawan.v3.EndDevices
+	(*EndDeviceTemplateFormats)(nil),                 // 14: ttn.lorawan.v3.EndDeviceTemplateFormats
+	(*EndDeviceTemplate)(nil),                        // 15: ttn.lorawan.v3.EndDeviceTemplate
+	(*BatchUpdateEndDevicesResponse)(nil),            // 16: ttn.lorawan.v3.BatchUpdateEndDevicesResponse
+	(*BatchDeleteEndDevicesFromClusterResponse)(nil), // 17: ttn.lorawan.v3.BatchDeleteEndDevicesFromClusterResponse
 }
 var file_ttn_lorawan_v3_end_device_services_proto_depIdxs = []int32{
 	0,  // 0: ttn.lorawan.v3.EndDeviceRegistry.Create:input_type -> ttn.lorawan.v3.CreateEndDeviceRequest
@@ -187,19 +214,23 @@ var file_ttn_lorawan_v3_end_device_services_proto_depIdxs = []int32{
 	8,  // 8: ttn.lorawan.v3.EndDeviceTemplateConverter.Convert:input_type -> ttn.lorawan.v3.ConvertEndDeviceTemplateRequest
 	9,  // 9: ttn.lorawan.v3.EndDeviceBatchRegistry.Get:input_type -> ttn.lorawan.v3.BatchGetEndDevicesRequest
 	10, // 10: ttn.lorawan.v3.EndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	11, // 11: ttn.lorawan.v3.EndDeviceRegistry.Create:output_type -> ttn.lorawan.v3.EndDevice
-	11, // 12: ttn.lorawan.v3.EndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
-	6,  // 13: ttn.lorawan.v3.EndDeviceRegistry.GetIdentifiersForEUIs:output_type -> ttn.lorawan.v3.EndDeviceIdentifiers
-	12, // 14: ttn.lorawan.v3.EndDeviceRegistry.List:output_type -> ttn.lorawan.v3.EndDevices
-	11, // 15: ttn.lorawan.v3.EndDeviceRegistry.Update:output_type -> ttn.lorawan.v3.EndDevice
-	7,  // 16: ttn.lorawan.v3.EndDeviceRegistry.BatchUpdateLastSeen:output_type -> google.protobuf.Empty
-	7,  // 17: ttn.lorawan.v3.EndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
-	13, // 18: ttn.lorawan.v3.EndDeviceTemplateConverter.ListFormats:output_type -> ttn.lorawan.v3.EndDeviceTemplateFormats
-	14, // 19: ttn.lorawan.v3.EndDeviceTemplateConverter.Convert:output_type -> ttn.lorawan.v3.EndDeviceTemplate
-	12, // 20: ttn.lorawan.v3.EndDeviceBatchRegistry.Get:output_type -> ttn.lorawan.v3.EndDevices
-	7,  // 21: ttn.lorawan.v3.EndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
-	11, // [11:22] is the sub-list for method output_type
-	0,  // [0:11] is the sub-list for method input_type
+	11, // 11: ttn.lorawan.v3.EndDeviceBatchRegistry.Update:input_type -> ttn.lorawan.v3.BatchUpdateEndDevicesRequest
+	10, // 12: ttn.lorawan.v3.EndDeviceBatchRegistry.DeleteFromCluster:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
+	12, // 13: ttn.lorawan.v3.EndDeviceRegistry.Create:output_type -> ttn.lorawan.v3.EndDevice
+	12, // 14: ttn.lorawan.v3.EndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
+	6,  // 15: ttn.lorawan.v3.EndDeviceRegistry.GetIdentifiersForEUIs:output_type -> ttn.lorawan.v3.EndDeviceIdentifiers
+	13, // 16: ttn.lorawan.v3.EndDeviceRegistry.List:output_type -> ttn.lorawan.v3.EndDevices
+	12, // 17: ttn.lorawan.v3.EndDeviceRegistry.Update:output_type -> ttn.lorawan.v3.EndDevice
+	7,  // 18: ttn.lorawan.v3.EndDeviceRegistry.BatchUpdateLastSeen:output_type -> google.protobuf.Empty
+	7,  // 19: ttn.lorawan.v3.EndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
+	14, // 20: ttn.lorawan.v3.EndDeviceTemplateConverter.ListFormats:output_type -> ttn.lorawan.v3.EndDeviceTemplateFormats
+	15, // 21: ttn.lorawan.v3.EndDeviceTemplateConverter.Convert:output_type -> ttn.lorawan.v3.EndDeviceTemplate
+	13, // 22: ttn.lorawan.v3.EndDeviceBatchRegistry.Get:output_type -> ttn.lorawan.v3.EndDevices
+	7,  // 23: ttn.lorawan.v3.EndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
+	16, // 24: ttn.lorawan.v3.EndDeviceBatchRegistry.Update:output_type -> ttn.lorawan.v3.BatchUpdateEndDevicesResponse
+	17, // 25: ttn.lorawan.v3.EndDeviceBatchRegistry.DeleteFromCluster:output_type -> ttn.lorawan.v3.BatchDeleteEndDevicesFromClusterResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -550,8 +550,10 @@ var EndDeviceTemplateConverter_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EndDeviceBatchRegistry_Get_FullMethodName    = "/ttn.lorawan.v3.EndDeviceBatchRegistry/Get"
-	EndDeviceBatchRegistry_Delete_FullMethodName = "/ttn.lorawan.v3.EndDeviceBatchRegistry/Delete"
+	EndDeviceBatchRegistry_Get_FullMethodName               = "/ttn.lorawan.v3.EndDeviceBatchRegistry/Get"
+	EndDeviceBatchRegistry_Delete_FullMethodName            = "/ttn.lorawan.v3.EndDeviceBatchRegistry/Delete"
+	EndDeviceBatchRegistry_Update_FullMethodName            = "/ttn.lorawan.v3.EndDeviceBatchRegistry/Update"
+	EndDeviceBatchRegistry_DeleteFromCluster_FullMethodName = "/ttn.lorawan.v3.EndDeviceBatchRegistry/DeleteFromCluster"
 )
 
 // EndDeviceBatchRegistryClient is the client API for EndDeviceBatchRegistry service.
@@ -574,6 +576,24 @@ type EndDeviceBatchRegistryClient interface {
 	// of the DeviceClaimingServer.
 	// This is NOT done automatically.
 	Delete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of end devices within the same application.
+	// The end devices are selected by their IDs, by their labels, or both.
+	//
+	// This operation is atomic; either all devices are updated or none.
+	// This only updates the end devices in the Identity Server. Use the corresponding
+	// Update RPCs of NsEndDeviceBatchRegistry, AsEndDeviceBatchRegistry and
+	// JsEndDeviceBatchRegistry to update the fields that are stored in those components.
+	Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error)
+	// Delete a batch of end devices with the given IDs from the Application Server, Network Server,
+	// Join Server and Identity Server, in that order.
+	//
+	// Before deleting the end devices, the end devices are retrieved from the components, so that
+	// the end devices are restored in the components that they were already deleted from when the
+	// deletion fails in a later component. The result is returned per component.
+	// Devices not found are skipped and no error is returned.
+	// If the devices were claimed on a Join Server, use the BatchUnclaim RPC
+	// of the DeviceClaimingServer first.
+	DeleteFromCluster(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchDeleteEndDevicesFromClusterResponse, error)
 }
 
 type endDeviceBatchRegistryClient struct {
@@ -602,6 +622,24 @@ func (c *endDeviceBatchRegistryClient) Delete(ctx context.Context, in *BatchDele
 	return out, nil
 }
 
+func (c *endDeviceBatchRegistryClient) Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error) {
+	out := new(BatchUpdateEndDevicesResponse)
+	err := c.cc.Invoke(ctx, EndDeviceBatchRegistry_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endDeviceBatchRegistryClient) DeleteFromCluster(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*BatchDeleteEndDevicesFromClusterResponse, error) {
+	out := new(BatchDeleteEndDevicesFromClusterResponse)
+	err := c.cc.Invoke(ctx, EndDeviceBatchRegistry_DeleteFromCluster_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EndDeviceBatchRegistryServer is the server API for EndDeviceBatchRegistry service.
 // All implementations must embed UnimplementedEndDeviceBatchRegistryServer
 // for forward compatibility
@@ -622,6 +660,24 @@ type EndDeviceBatchRegistryServer interface {
 	// of the DeviceClaimingServer.
 	// This is NOT done automatically.
 	Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of end devices within the same application.
+	// The end devices are selected by their IDs, by their labels, or both.
+	//
+	// This operation is atomic; either all devices are updated or none.
+	// This only updates the end devices in the Identity Server. Use the corresponding
+	// Update RPCs of NsEndDeviceBatchRegistry, AsEndDeviceBatchRegistry and
+	// JsEndDeviceBatchRegistry to update the fields that are stored in those components.
+	Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error)
+	// Delete a batch of end devices with the given IDs from the Application Server, Network Server,
+	// Join Server and Identity Server, in that order.
+	//
+	// Before deleting the end devices, the end devices are retrieved from the components, so that
+	// the end devices are restored in the components that they were already deleted from when the
+	// deletion fails in a later component. The result is returned per component.
+	// Devices not found are skipped and no error is returned.
+	// If the devices were claimed on a Join Server, use the BatchUnclaim RPC
+	// of the DeviceClaimingServer first.
+	DeleteFromCluster(context.Context, *BatchDeleteEndDevicesRequest) (*BatchDeleteEndDevicesFromClusterResponse, error)
 	mustEmbedUnimplementedEndDeviceBatchRegistryServer()
 }
 
@@ -635,6 +691,12 @@ func (UnimplementedEndDeviceBatchRegistryServer) Get(context.Context, *BatchGetE
 func (UnimplementedEndDeviceBatchRegistryServer) Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedEndDeviceBatchRegistryServer) Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (UnimplementedEndDeviceBatchRegistryServer) DeleteFromCluster(context.Context, *BatchDeleteEndDevicesRequest) (*BatchDeleteEndDevicesFromClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromCluster not implemented")
+}
 func (UnimplementedEndDeviceBatchRegistryServer) mustEmbedUnimplementedEndDeviceBatchRegistryServer() {
 }
 
@@ -685,6 +747,42 @@ func _EndDeviceBatchRegistry_Delete_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndDeviceBatchRegistry_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceBatchRegistryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndDeviceBatchRegistry_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceBatchRegistryServer).Update(ctx, req.(*BatchUpdateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndDeviceBatchRegistry_DeleteFromCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDeleteEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceBatchRegistryServer).DeleteFromCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndDeviceBatchRegistry_DeleteFromCluster_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceBatchRegistryServer).DeleteFromCluster(ctx, req.(*BatchDeleteEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EndDeviceBatchRegistry_ServiceDesc is the grpc.ServiceDesc for EndDeviceBatchRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -700,6 +798,14 @@ var EndDeviceBatchRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _EndDeviceBatchRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _EndDeviceBatchRegistry_Update_Handler,
+		},
+		{
+			MethodName: "DeleteFromCluster",
+			Handler:    _EndDeviceBatchRegistry_DeleteFromCluster_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/end_device_services.proto",
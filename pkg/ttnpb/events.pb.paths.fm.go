@@ -37,6 +37,7 @@ var EventFieldPathsTopLevel = []string{
 }
 var StreamEventsRequestFieldPathsNested = []string{
 	"after",
+	"filter",
 	"identifiers",
 	"names",
 	"tail",
@@ -44,6 +45,7 @@ var StreamEventsRequestFieldPathsNested = []string{
 
 var StreamEventsRequestFieldPathsTopLevel = []string{
 	"after",
+	"filter",
 	"identifiers",
 	"names",
 	"tail",
@@ -200,6 +200,16 @@ func (dst *StreamEventsRequest) SetFields(src *StreamEventsRequest, paths ...str
 			} else {
 				dst.Names = nil
 			}
+		case "filter":
+			if len(subs) > 0 {
+				return fmt.Errorf("'filter' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Filter = src.Filter
+			} else {
+				var zero string
+				dst.Filter = zero
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
@@ -247,6 +247,8 @@ func (m *StreamEventsRequest) ValidateFields(paths ...string) error {
 
 		case "names":
 
+		case "filter":
+			// no validation rules for Filter
 		default:
 			return StreamEventsRequestValidationError{
 				field:  name,
@@ -254,6 +254,11 @@ func (x *StreamEventsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 		s.WriteObjectField("names")
 		s.WriteStringArray(x.Names)
 	}
+	if x.Filter != "" || s.HasField("filter") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("filter")
+		s.WriteString(x.Filter)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -310,6 +315,9 @@ func (x *StreamEventsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 				return
 			}
 			x.Names = s.ReadStringArray()
+		case "filter":
+			s.AddField("filter")
+			x.Filter = s.ReadString()
 		}
 	})
 }
@@ -646,6 +646,11 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		All:     EndDeviceFieldPathsNested,
 		Allowed: isEndDeviceReadFieldPaths,
 	},
+	"/ttn.lorawan.v3.EndDeviceBatchRegistry/Update": {
+		All:     EndDeviceFieldPathsNested,
+		Allowed: isEndDeviceWriteFieldPaths,
+		Set:     true,
+	},
 	"/ttn.lorawan.v3.EndDeviceRegistry/List": {
 		All:     EndDeviceFieldPathsNested,
 		Allowed: isEndDeviceReadFieldPaths,
@@ -655,6 +660,23 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		Allowed: isEndDeviceWriteFieldPaths,
 		Set:     true,
 	},
+	"/ttn.lorawan.v3.EndDeviceRegistrySearch/ExportEndDeviceLocations": {
+		All: EndDeviceFieldPathsNested,
+		Allowed: []string{
+			"application_server_address",
+			"attributes",
+			"created_at",
+			"description",
+			"ids.dev_eui",
+			"ids.join_eui",
+			"join_server_address",
+			"last_seen_at",
+			"name",
+			"network_server_address",
+			"updated_at",
+			"version_ids",
+		},
+	},
 	"/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevices": {
 		All:     EndDeviceFieldPathsNested,
 		Allowed: isEndDeviceReadFieldPaths,
@@ -1066,6 +1088,22 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	},
 
 	// Gateways:
+	"/ttn.lorawan.v3.EntityRegistrySearch/ExportGatewayLocations": {
+		All: GatewayFieldPathsNested,
+		Allowed: []string{
+			"attributes",
+			"created_at",
+			"description",
+			"frequency_plan_ids",
+			"gateway_server_address",
+			"ids.eui",
+			"location_public",
+			"name",
+			"status_public",
+			"updated_at",
+			"version_ids",
+		},
+	},
 	"/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways": {
 		All: GatewayFieldPathsNested,
 		Allowed: omitFields(GatewayFieldPathsNested,
@@ -1126,6 +1164,10 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 		All:     GatewayConnectionStatsFieldPathsNested,
 		Allowed: GatewayConnectionStatsFieldPathsNested,
 	},
+	"/ttn.lorawan.v3.Gs/StreamGatewayConnectionStats": {
+		All:     GatewayConnectionStatsFieldPathsNested,
+		Allowed: GatewayConnectionStatsFieldPathsNested,
+	},
 
 	// Gateway API Keys:
 	"/ttn.lorawan.v3.GatewayAccess/UpdateAPIKey": {
@@ -1223,6 +1265,22 @@ var RPCFieldMaskPaths = map[string]RPCFieldMaskPathValue{
 	},
 }
 
+func init() {
+	// Batch updates in the network layer registries allow the same field mask paths as Set.
+	for batch, set := range map[string]string{
+		"/ttn.lorawan.v3.AsEndDeviceBatchRegistry/Update": "/ttn.lorawan.v3.AsEndDeviceRegistry/Set",
+		"/ttn.lorawan.v3.JsEndDeviceBatchRegistry/Update": "/ttn.lorawan.v3.JsEndDeviceRegistry/Set",
+		"/ttn.lorawan.v3.NsEndDeviceBatchRegistry/Update": "/ttn.lorawan.v3.NsEndDeviceRegistry/Set",
+	} {
+		RPCFieldMaskPaths[batch] = RPCFieldMaskPaths[set]
+	}
+	// Location based searches allow the same field mask paths as the other searches.
+	RPCFieldMaskPaths["/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevicesByLocation"] =
+		RPCFieldMaskPaths["/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevices"]
+	RPCFieldMaskPaths["/ttn.lorawan.v3.EntityRegistrySearch/SearchGatewaysByLocation"] =
+		RPCFieldMaskPaths["/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways"]
+}
+
 func omitFields(fields []string, fieldsToOmit ...string) []string {
 	out := make([]string, 0, len(fields))
 nextField:
@@ -190,6 +190,50 @@ var GetGatewayIdentifiersForEUIRequestFieldPathsNested = []string{
 var GetGatewayIdentifiersForEUIRequestFieldPathsTopLevel = []string{
 	"eui",
 }
+var RequestGatewayEUIReclaimRequestFieldPathsNested = []string{
+	"eui",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"reason",
+}
+
+var RequestGatewayEUIReclaimRequestFieldPathsTopLevel = []string{
+	"eui",
+	"gateway_ids",
+	"reason",
+}
+var ReleaseGatewayEUIRequestFieldPathsNested = []string{
+	"eui",
+}
+
+var ReleaseGatewayEUIRequestFieldPathsTopLevel = []string{
+	"eui",
+}
+var TransferGatewayEUIRequestFieldPathsNested = []string{
+	"eui",
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+}
+
+var TransferGatewayEUIRequestFieldPathsTopLevel = []string{
+	"eui",
+	"gateway_ids",
+}
+var GatewayEUITransferResultFieldPathsNested = []string{
+	"reclaimed_by",
+	"reclaimed_by.eui",
+	"reclaimed_by.gateway_id",
+	"released_from",
+	"released_from.eui",
+	"released_from.gateway_id",
+}
+
+var GatewayEUITransferResultFieldPathsTopLevel = []string{
+	"reclaimed_by",
+	"released_from",
+}
 var ListGatewaysRequestFieldPathsNested = []string{
 	"collaborator",
 	"collaborator.ids",
@@ -793,6 +793,187 @@ func (dst *GetGatewayIdentifiersForEUIRequest) SetFields(src *GetGatewayIdentifi
 	return nil
 }
 
+func (dst *RequestGatewayEUIReclaimRequest) SetFields(src *RequestGatewayEUIReclaimRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Eui = src.Eui
+			} else {
+				dst.Eui = nil
+			}
+		case "reason":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reason' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reason = src.Reason
+			} else {
+				var zero string
+				dst.Reason = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ReleaseGatewayEUIRequest) SetFields(src *ReleaseGatewayEUIRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Eui = src.Eui
+			} else {
+				dst.Eui = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *TransferGatewayEUIRequest) SetFields(src *TransferGatewayEUIRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "eui":
+			if len(subs) > 0 {
+				return fmt.Errorf("'eui' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Eui = src.Eui
+			} else {
+				dst.Eui = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GatewayEUITransferResult) SetFields(src *GatewayEUITransferResult, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "released_from":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.ReleasedFrom == nil) && dst.ReleasedFrom == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ReleasedFrom
+				}
+				if dst.ReleasedFrom != nil {
+					newDst = dst.ReleasedFrom
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.ReleasedFrom = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReleasedFrom = src.ReleasedFrom
+				} else {
+					dst.ReleasedFrom = nil
+				}
+			}
+		case "reclaimed_by":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.ReclaimedBy == nil) && dst.ReclaimedBy == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ReclaimedBy
+				}
+				if dst.ReclaimedBy != nil {
+					newDst = dst.ReclaimedBy
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.ReclaimedBy = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ReclaimedBy = src.ReclaimedBy
+				} else {
+					dst.ReclaimedBy = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ListGatewaysRequest) SetFields(src *ListGatewaysRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
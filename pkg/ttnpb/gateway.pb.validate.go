@@ -1335,6 +1335,432 @@ var _ interface {
 	ErrorName() string
 } = GetGatewayIdentifiersForEUIRequestValidationError{}
 
+// ValidateFields checks the field values on RequestGatewayEUIReclaimRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *RequestGatewayEUIReclaimRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RequestGatewayEUIReclaimRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return RequestGatewayEUIReclaimRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return RequestGatewayEUIReclaimRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "eui":
+
+			if len(m.GetEui()) != 8 {
+				return RequestGatewayEUIReclaimRequestValidationError{
+					field:  "eui",
+					reason: "value length must be 8 bytes",
+				}
+			}
+
+		case "reason":
+
+			if utf8.RuneCountInString(m.GetReason()) > 2000 {
+				return RequestGatewayEUIReclaimRequestValidationError{
+					field:  "reason",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		default:
+			return RequestGatewayEUIReclaimRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RequestGatewayEUIReclaimRequestValidationError is the validation error
+// returned by RequestGatewayEUIReclaimRequest.ValidateFields if the
+// designated constraints aren't met.
+type RequestGatewayEUIReclaimRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RequestGatewayEUIReclaimRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RequestGatewayEUIReclaimRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RequestGatewayEUIReclaimRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RequestGatewayEUIReclaimRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RequestGatewayEUIReclaimRequestValidationError) ErrorName() string {
+	return "RequestGatewayEUIReclaimRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RequestGatewayEUIReclaimRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRequestGatewayEUIReclaimRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RequestGatewayEUIReclaimRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RequestGatewayEUIReclaimRequestValidationError{}
+
+// ValidateFields checks the field values on ReleaseGatewayEUIRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ReleaseGatewayEUIRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ReleaseGatewayEUIRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "eui":
+
+			if len(m.GetEui()) != 8 {
+				return ReleaseGatewayEUIRequestValidationError{
+					field:  "eui",
+					reason: "value length must be 8 bytes",
+				}
+			}
+
+		default:
+			return ReleaseGatewayEUIRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ReleaseGatewayEUIRequestValidationError is the validation error returned by
+// ReleaseGatewayEUIRequest.ValidateFields if the designated constraints
+// aren't met.
+type ReleaseGatewayEUIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReleaseGatewayEUIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReleaseGatewayEUIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReleaseGatewayEUIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReleaseGatewayEUIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReleaseGatewayEUIRequestValidationError) ErrorName() string {
+	return "ReleaseGatewayEUIRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReleaseGatewayEUIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReleaseGatewayEUIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReleaseGatewayEUIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReleaseGatewayEUIRequestValidationError{}
+
+// ValidateFields checks the field values on TransferGatewayEUIRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *TransferGatewayEUIRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = TransferGatewayEUIRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return TransferGatewayEUIRequestValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return TransferGatewayEUIRequestValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "eui":
+
+			if len(m.GetEui()) != 8 {
+				return TransferGatewayEUIRequestValidationError{
+					field:  "eui",
+					reason: "value length must be 8 bytes",
+				}
+			}
+
+		default:
+			return TransferGatewayEUIRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// TransferGatewayEUIRequestValidationError is the validation error returned by
+// TransferGatewayEUIRequest.ValidateFields if the designated constraints
+// aren't met.
+type TransferGatewayEUIRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TransferGatewayEUIRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TransferGatewayEUIRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TransferGatewayEUIRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TransferGatewayEUIRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TransferGatewayEUIRequestValidationError) ErrorName() string {
+	return "TransferGatewayEUIRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e TransferGatewayEUIRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTransferGatewayEUIRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TransferGatewayEUIRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TransferGatewayEUIRequestValidationError{}
+
+// ValidateFields checks the field values on GatewayEUITransferResult with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GatewayEUITransferResult) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayEUITransferResultFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "released_from":
+
+			if v, ok := interface{}(m.GetReleasedFrom()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayEUITransferResultValidationError{
+						field:  "released_from",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "reclaimed_by":
+
+			if v, ok := interface{}(m.GetReclaimedBy()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayEUITransferResultValidationError{
+						field:  "reclaimed_by",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayEUITransferResultValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayEUITransferResultValidationError is the validation error returned by
+// GatewayEUITransferResult.ValidateFields if the designated constraints
+// aren't met.
+type GatewayEUITransferResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayEUITransferResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayEUITransferResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayEUITransferResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayEUITransferResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayEUITransferResultValidationError) ErrorName() string {
+	return "GatewayEUITransferResultValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayEUITransferResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayEUITransferResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayEUITransferResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayEUITransferResultValidationError{}
+
 // ValidateFields checks the field values on ListGatewaysRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
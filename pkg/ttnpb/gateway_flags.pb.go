@@ -10,6 +10,7 @@ import (
 	flagsplugin "github.com/TheThingsIndustries/protoc-gen-go-flags/flagsplugin"
 	golang "github.com/TheThingsIndustries/protoc-gen-go-flags/golang"
 	pflag "github.com/spf13/pflag"
+	customflags "go.thethings.network/lorawan-stack/v3/cmd/ttn-lw-cli/customflags"
 )
 
 // AddSelectFlagsForGatewayVersionIdentifiers adds flags to select fields in GatewayVersionIdentifiers.
@@ -630,6 +631,83 @@ func (m *Gateway) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []str
 	return paths, nil
 }
 
+// AddSetFlagsForRequestGatewayEUIReclaimRequest adds flags to select fields in RequestGatewayEUIReclaimRequest.
+func AddSetFlagsForRequestGatewayEUIReclaimRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForGatewayIdentifiers(flags, flagsplugin.Prefix("gateway-ids", prefix), hidden)
+	flags.AddFlag(customflags.New8BytesFlag(flagsplugin.Prefix("eui", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("reason", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the RequestGatewayEUIReclaimRequest message from flags.
+func (m *RequestGatewayEUIReclaimRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("gateway_ids", prefix)); changed {
+		if m.GatewayIds == nil {
+			m.GatewayIds = &GatewayIdentifiers{}
+		}
+		if setPaths, err := m.GatewayIds.SetFromFlags(flags, flagsplugin.Prefix("gateway_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("eui", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Eui = val
+		paths = append(paths, flagsplugin.Prefix("eui", prefix))
+	}
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("reason", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Reason = val
+		paths = append(paths, flagsplugin.Prefix("reason", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForReleaseGatewayEUIRequest adds flags to select fields in ReleaseGatewayEUIRequest.
+func AddSetFlagsForReleaseGatewayEUIRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(customflags.New8BytesFlag(flagsplugin.Prefix("eui", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ReleaseGatewayEUIRequest message from flags.
+func (m *ReleaseGatewayEUIRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("eui", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Eui = val
+		paths = append(paths, flagsplugin.Prefix("eui", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForTransferGatewayEUIRequest adds flags to select fields in TransferGatewayEUIRequest.
+func AddSetFlagsForTransferGatewayEUIRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForGatewayIdentifiers(flags, flagsplugin.Prefix("gateway-ids", prefix), hidden)
+	flags.AddFlag(customflags.New8BytesFlag(flagsplugin.Prefix("eui", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the TransferGatewayEUIRequest message from flags.
+func (m *TransferGatewayEUIRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("gateway_ids", prefix)); changed {
+		if m.GatewayIds == nil {
+			m.GatewayIds = &GatewayIdentifiers{}
+		}
+		if setPaths, err := m.GatewayIds.SetFromFlags(flags, flagsplugin.Prefix("gateway_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if val, changed, err := customflags.GetExactBytes(flags, flagsplugin.Prefix("eui", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Eui = val
+		paths = append(paths, flagsplugin.Prefix("eui", prefix))
+	}
+	return paths, nil
+}
+
 // AddSetFlagsForListGatewaysRequest adds flags to select fields in ListGatewaysRequest.
 func AddSetFlagsForListGatewaysRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
 	AddSetFlagsForOrganizationOrUserIdentifiers(flags, flagsplugin.Prefix("collaborator", prefix), true)
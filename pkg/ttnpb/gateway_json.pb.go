@@ -678,6 +678,222 @@ func (x *GetGatewayIdentifiersForEUIRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the RequestGatewayEUIReclaimRequest message to JSON.
+func (x *RequestGatewayEUIReclaimRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if len(x.Eui) > 0 || s.HasField("eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("eui")
+		types.MarshalHEXBytes(s.WithField("eui"), x.Eui)
+	}
+	if x.Reason != "" || s.HasField("reason") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("reason")
+		s.WriteString(x.Reason)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the RequestGatewayEUIReclaimRequest to JSON.
+func (x *RequestGatewayEUIReclaimRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the RequestGatewayEUIReclaimRequest message from JSON.
+func (x *RequestGatewayEUIReclaimRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "eui":
+			s.AddField("eui")
+			x.Eui = types.Unmarshal8Bytes(s.WithField("eui", false))
+		case "reason":
+			s.AddField("reason")
+			x.Reason = s.ReadString()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the RequestGatewayEUIReclaimRequest from JSON.
+func (x *RequestGatewayEUIReclaimRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ReleaseGatewayEUIRequest message to JSON.
+func (x *ReleaseGatewayEUIRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if len(x.Eui) > 0 || s.HasField("eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("eui")
+		types.MarshalHEXBytes(s.WithField("eui"), x.Eui)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ReleaseGatewayEUIRequest to JSON.
+func (x *ReleaseGatewayEUIRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ReleaseGatewayEUIRequest message from JSON.
+func (x *ReleaseGatewayEUIRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "eui":
+			s.AddField("eui")
+			x.Eui = types.Unmarshal8Bytes(s.WithField("eui", false))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ReleaseGatewayEUIRequest from JSON.
+func (x *ReleaseGatewayEUIRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the TransferGatewayEUIRequest message to JSON.
+func (x *TransferGatewayEUIRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if len(x.Eui) > 0 || s.HasField("eui") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("eui")
+		types.MarshalHEXBytes(s.WithField("eui"), x.Eui)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the TransferGatewayEUIRequest to JSON.
+func (x *TransferGatewayEUIRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the TransferGatewayEUIRequest message from JSON.
+func (x *TransferGatewayEUIRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "eui":
+			s.AddField("eui")
+			x.Eui = types.Unmarshal8Bytes(s.WithField("eui", false))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the TransferGatewayEUIRequest from JSON.
+func (x *TransferGatewayEUIRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the GatewayEUITransferResult message to JSON.
+func (x *GatewayEUITransferResult) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ReleasedFrom != nil || s.HasField("released_from") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("released_from")
+		x.ReleasedFrom.MarshalProtoJSON(s.WithField("released_from"))
+	}
+	if x.ReclaimedBy != nil || s.HasField("reclaimed_by") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("reclaimed_by")
+		x.ReclaimedBy.MarshalProtoJSON(s.WithField("reclaimed_by"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayEUITransferResult to JSON.
+func (x *GatewayEUITransferResult) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayEUITransferResult message from JSON.
+func (x *GatewayEUITransferResult) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "released_from", "releasedFrom":
+			if s.ReadNil() {
+				x.ReleasedFrom = nil
+				return
+			}
+			x.ReleasedFrom = &GatewayIdentifiers{}
+			x.ReleasedFrom.UnmarshalProtoJSON(s.WithField("released_from", true))
+		case "reclaimed_by", "reclaimedBy":
+			if s.ReadNil() {
+				x.ReclaimedBy = nil
+				return
+			}
+			x.ReclaimedBy = &GatewayIdentifiers{}
+			x.ReclaimedBy.UnmarshalProtoJSON(s.WithField("reclaimed_by", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayEUITransferResult from JSON.
+func (x *GatewayEUITransferResult) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the ListGatewaysRequest message to JSON.
 func (x *ListGatewaysRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -120,7 +120,7 @@ var file_ttn_lorawan_v3_gateway_services_proto_rawDesc = []byte{
 	0x65, 0x6c, 0x64, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4d, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x66, 0x69, 0x65, 0x6c,
-	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x32, 0xd9, 0x08, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x64, 0x4d, 0x61, 0x73, 0x6b, 0x32, 0x99, 0x0c, 0x0a, 0x0f, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
 	0x79, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0xd3, 0x01, 0x0a, 0x06, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x12, 0x24, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
 	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x61, 0x74, 0x65,
@@ -148,7 +148,35 @@ var file_ttn_lorawan_v3_gateway_services_proto_rawDesc = []byte{
 	0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x46, 0x6f, 0x72,
 	0x45, 0x55, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x74, 0x74, 0x6e,
 	0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65,
-	0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0xd8,
+	0x77, 0x61, 0x79, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x12, 0x97,
+	0x01, 0x0a, 0x11, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x45, 0x55, 0x49, 0x52, 0x65, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x12, 0x2f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x47, 0x61, 0x74,
+	0x65, 0x77, 0x61, 0x79, 0x45, 0x55, 0x49, 0x52, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x39, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x33, 0x3a, 0x01, 0x2a, 0x22, 0x2e, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73,
+	0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x65, 0x75, 0x69,
+	0x2d, 0x72, 0x65, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x12, 0x82, 0x01, 0x0a, 0x0a, 0x52, 0x65, 0x6c,
+	0x65, 0x61, 0x73, 0x65, 0x45, 0x55, 0x49, 0x12, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x52, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x55, 0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x55, 0x49, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x20, 0x82, 0xd3, 0xe4,
+	0x93, 0x02, 0x1a, 0x3a, 0x01, 0x2a, 0x22, 0x15, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x2d, 0x65, 0x75, 0x69, 0x73, 0x2f, 0x72, 0x65, 0x6c, 0x65, 0x61, 0x73, 0x65, 0x12, 0x9e, 0x01,
+	0x0a, 0x0b, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x45, 0x55, 0x49, 0x12, 0x29, 0x2e,
+	0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x45, 0x55,
+	0x49, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x45, 0x55, 0x49, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x52, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x22, 0x3a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x34, 0x3a, 0x01, 0x2a, 0x22, 0x2f, 0x2f,
+	0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64,
+	0x7d, 0x2f, 0x65, 0x75, 0x69, 0x2d, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0xd8,
 	0x01, 0x0a, 0x04, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
 	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x47, 0x61, 0x74,
 	0x65, 0x77, 0x61, 0x79, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x74,
@@ -307,23 +335,27 @@ var file_ttn_lorawan_v3_gateway_services_proto_goTypes = []interface{}{
 	(*CreateGatewayRequest)(nil),               // 3: ttn.lorawan.v3.CreateGatewayRequest
 	(*GetGatewayRequest)(nil),                  // 4: ttn.lorawan.v3.GetGatewayRequest
 	(*GetGatewayIdentifiersForEUIRequest)(nil), // 5: ttn.lorawan.v3.GetGatewayIdentifiersForEUIRequest
-	(*ListGatewaysRequest)(nil),                // 6: ttn.lorawan.v3.ListGatewaysRequest
-	(*UpdateGatewayRequest)(nil),               // 7: ttn.lorawan.v3.UpdateGatewayRequest
-	(*CreateGatewayAPIKeyRequest)(nil),         // 8: ttn.lorawan.v3.CreateGatewayAPIKeyRequest
-	(*ListGatewayAPIKeysRequest)(nil),          // 9: ttn.lorawan.v3.ListGatewayAPIKeysRequest
-	(*GetGatewayAPIKeyRequest)(nil),            // 10: ttn.lorawan.v3.GetGatewayAPIKeyRequest
-	(*UpdateGatewayAPIKeyRequest)(nil),         // 11: ttn.lorawan.v3.UpdateGatewayAPIKeyRequest
-	(*GetGatewayCollaboratorRequest)(nil),      // 12: ttn.lorawan.v3.GetGatewayCollaboratorRequest
-	(*SetGatewayCollaboratorRequest)(nil),      // 13: ttn.lorawan.v3.SetGatewayCollaboratorRequest
-	(*ListGatewayCollaboratorsRequest)(nil),    // 14: ttn.lorawan.v3.ListGatewayCollaboratorsRequest
-	(*Gateway)(nil),                            // 15: ttn.lorawan.v3.Gateway
-	(*Gateways)(nil),                           // 16: ttn.lorawan.v3.Gateways
-	(*emptypb.Empty)(nil),                      // 17: google.protobuf.Empty
-	(*Rights)(nil),                             // 18: ttn.lorawan.v3.Rights
-	(*APIKey)(nil),                             // 19: ttn.lorawan.v3.APIKey
-	(*APIKeys)(nil),                            // 20: ttn.lorawan.v3.APIKeys
-	(*GetCollaboratorResponse)(nil),            // 21: ttn.lorawan.v3.GetCollaboratorResponse
-	(*Collaborators)(nil),                      // 22: ttn.lorawan.v3.Collaborators
+	(*RequestGatewayEUIReclaimRequest)(nil),    // 6: ttn.lorawan.v3.RequestGatewayEUIReclaimRequest
+	(*ReleaseGatewayEUIRequest)(nil),           // 7: ttn.lorawan.v3.ReleaseGatewayEUIRequest
+	(*TransferGatewayEUIRequest)(nil),          // 8: ttn.lorawan.v3.TransferGatewayEUIRequest
+	(*ListGatewaysRequest)(nil),                // 9: ttn.lorawan.v3.ListGatewaysRequest
+	(*UpdateGatewayRequest)(nil),               // 10: ttn.lorawan.v3.UpdateGatewayRequest
+	(*CreateGatewayAPIKeyRequest)(nil),         // 11: ttn.lorawan.v3.CreateGatewayAPIKeyRequest
+	(*ListGatewayAPIKeysRequest)(nil),          // 12: ttn.lorawan.v3.ListGatewayAPIKeysRequest
+	(*GetGatewayAPIKeyRequest)(nil),            // 13: ttn.lorawan.v3.GetGatewayAPIKeyRequest
+	(*UpdateGatewayAPIKeyRequest)(nil),         // 14: ttn.lorawan.v3.UpdateGatewayAPIKeyRequest
+	(*GetGatewayCollaboratorRequest)(nil),      // 15: ttn.lorawan.v3.GetGatewayCollaboratorRequest
+	(*SetGatewayCollaboratorRequest)(nil),      // 16: ttn.lorawan.v3.SetGatewayCollaboratorRequest
+	(*ListGatewayCollaboratorsRequest)(nil),    // 17: ttn.lorawan.v3.ListGatewayCollaboratorsRequest
+	(*Gateway)(nil),                            // 18: ttn.lorawan.v3.Gateway
+	(*emptypb.Empty)(nil),                      // 19: google.protobuf.Empty
+	(*GatewayEUITransferResult)(nil),           // 20: ttn.lorawan.v3.GatewayEUITransferResult
+	(*Gateways)(nil),                           // 21: ttn.lorawan.v3.Gateways
+	(*Rights)(nil),                             // 22: ttn.lorawan.v3.Rights
+	(*APIKey)(nil),                             // 23: ttn.lorawan.v3.APIKey
+	(*APIKeys)(nil),                            // 24: ttn.lorawan.v3.APIKeys
+	(*GetCollaboratorResponse)(nil),            // 25: ttn.lorawan.v3.GetCollaboratorResponse
+	(*Collaborators)(nil),                      // 26: ttn.lorawan.v3.Collaborators
 }
 var file_ttn_lorawan_v3_gateway_services_proto_depIdxs = []int32{
 	1,  // 0: ttn.lorawan.v3.PullGatewayConfigurationRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
@@ -331,39 +363,45 @@ var file_ttn_lorawan_v3_gateway_services_proto_depIdxs = []int32{
 	3,  // 2: ttn.lorawan.v3.GatewayRegistry.Create:input_type -> ttn.lorawan.v3.CreateGatewayRequest
 	4,  // 3: ttn.lorawan.v3.GatewayRegistry.Get:input_type -> ttn.lorawan.v3.GetGatewayRequest
 	5,  // 4: ttn.lorawan.v3.GatewayRegistry.GetIdentifiersForEUI:input_type -> ttn.lorawan.v3.GetGatewayIdentifiersForEUIRequest
-	6,  // 5: ttn.lorawan.v3.GatewayRegistry.List:input_type -> ttn.lorawan.v3.ListGatewaysRequest
-	7,  // 6: ttn.lorawan.v3.GatewayRegistry.Update:input_type -> ttn.lorawan.v3.UpdateGatewayRequest
-	1,  // 7: ttn.lorawan.v3.GatewayRegistry.Delete:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	1,  // 8: ttn.lorawan.v3.GatewayRegistry.Restore:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	1,  // 9: ttn.lorawan.v3.GatewayRegistry.Purge:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	1,  // 10: ttn.lorawan.v3.GatewayAccess.ListRights:input_type -> ttn.lorawan.v3.GatewayIdentifiers
-	8,  // 11: ttn.lorawan.v3.GatewayAccess.CreateAPIKey:input_type -> ttn.lorawan.v3.CreateGatewayAPIKeyRequest
-	9,  // 12: ttn.lorawan.v3.GatewayAccess.ListAPIKeys:input_type -> ttn.lorawan.v3.ListGatewayAPIKeysRequest
-	10, // 13: ttn.lorawan.v3.GatewayAccess.GetAPIKey:input_type -> ttn.lorawan.v3.GetGatewayAPIKeyRequest
-	11, // 14: ttn.lorawan.v3.GatewayAccess.UpdateAPIKey:input_type -> ttn.lorawan.v3.UpdateGatewayAPIKeyRequest
-	12, // 15: ttn.lorawan.v3.GatewayAccess.GetCollaborator:input_type -> ttn.lorawan.v3.GetGatewayCollaboratorRequest
-	13, // 16: ttn.lorawan.v3.GatewayAccess.SetCollaborator:input_type -> ttn.lorawan.v3.SetGatewayCollaboratorRequest
-	14, // 17: ttn.lorawan.v3.GatewayAccess.ListCollaborators:input_type -> ttn.lorawan.v3.ListGatewayCollaboratorsRequest
-	0,  // 18: ttn.lorawan.v3.GatewayConfigurator.PullConfiguration:input_type -> ttn.lorawan.v3.PullGatewayConfigurationRequest
-	15, // 19: ttn.lorawan.v3.GatewayRegistry.Create:output_type -> ttn.lorawan.v3.Gateway
-	15, // 20: ttn.lorawan.v3.GatewayRegistry.Get:output_type -> ttn.lorawan.v3.Gateway
-	1,  // 21: ttn.lorawan.v3.GatewayRegistry.GetIdentifiersForEUI:output_type -> ttn.lorawan.v3.GatewayIdentifiers
-	16, // 22: ttn.lorawan.v3.GatewayRegistry.List:output_type -> ttn.lorawan.v3.Gateways
-	15, // 23: ttn.lorawan.v3.GatewayRegistry.Update:output_type -> ttn.lorawan.v3.Gateway
-	17, // 24: ttn.lorawan.v3.GatewayRegistry.Delete:output_type -> google.protobuf.Empty
-	17, // 25: ttn.lorawan.v3.GatewayRegistry.Restore:output_type -> google.protobuf.Empty
-	17, // 26: ttn.lorawan.v3.GatewayRegistry.Purge:output_type -> google.protobuf.Empty
-	18, // 27: ttn.lorawan.v3.GatewayAccess.ListRights:output_type -> ttn.lorawan.v3.Rights
-	19, // 28: ttn.lorawan.v3.GatewayAccess.CreateAPIKey:output_type -> ttn.lorawan.v3.APIKey
-	20, // 29: ttn.lorawan.v3.GatewayAccess.ListAPIKeys:output_type -> ttn.lorawan.v3.APIKeys
-	19, // 30: ttn.lorawan.v3.GatewayAccess.GetAPIKey:output_type -> ttn.lorawan.v3.APIKey
-	19, // 31: ttn.lorawan.v3.GatewayAccess.UpdateAPIKey:output_type -> ttn.lorawan.v3.APIKey
-	21, // 32: ttn.lorawan.v3.GatewayAccess.GetCollaborator:output_type -> ttn.lorawan.v3.GetCollaboratorResponse
-	17, // 33: ttn.lorawan.v3.GatewayAccess.SetCollaborator:output_type -> google.protobuf.Empty
-	22, // 34: ttn.lorawan.v3.GatewayAccess.ListCollaborators:output_type -> ttn.lorawan.v3.Collaborators
-	15, // 35: ttn.lorawan.v3.GatewayConfigurator.PullConfiguration:output_type -> ttn.lorawan.v3.Gateway
-	19, // [19:36] is the sub-list for method output_type
-	2,  // [2:19] is the sub-list for method input_type
+	6,  // 5: ttn.lorawan.v3.GatewayRegistry.RequestEUIReclaim:input_type -> ttn.lorawan.v3.RequestGatewayEUIReclaimRequest
+	7,  // 6: ttn.lorawan.v3.GatewayRegistry.ReleaseEUI:input_type -> ttn.lorawan.v3.ReleaseGatewayEUIRequest
+	8,  // 7: ttn.lorawan.v3.GatewayRegistry.TransferEUI:input_type -> ttn.lorawan.v3.TransferGatewayEUIRequest
+	9,  // 8: ttn.lorawan.v3.GatewayRegistry.List:input_type -> ttn.lorawan.v3.ListGatewaysRequest
+	10, // 9: ttn.lorawan.v3.GatewayRegistry.Update:input_type -> ttn.lorawan.v3.UpdateGatewayRequest
+	1,  // 10: ttn.lorawan.v3.GatewayRegistry.Delete:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	1,  // 11: ttn.lorawan.v3.GatewayRegistry.Restore:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	1,  // 12: ttn.lorawan.v3.GatewayRegistry.Purge:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	1,  // 13: ttn.lorawan.v3.GatewayAccess.ListRights:input_type -> ttn.lorawan.v3.GatewayIdentifiers
+	11, // 14: ttn.lorawan.v3.GatewayAccess.CreateAPIKey:input_type -> ttn.lorawan.v3.CreateGatewayAPIKeyRequest
+	12, // 15: ttn.lorawan.v3.GatewayAccess.ListAPIKeys:input_type -> ttn.lorawan.v3.ListGatewayAPIKeysRequest
+	13, // 16: ttn.lorawan.v3.GatewayAccess.GetAPIKey:input_type -> ttn.lorawan.v3.GetGatewayAPIKeyRequest
+	14, // 17: ttn.lorawan.v3.GatewayAccess.UpdateAPIKey:input_type -> ttn.lorawan.v3.UpdateGatewayAPIKeyRequest
+	15, // 18: ttn.lorawan.v3.GatewayAccess.GetCollaborator:input_type -> ttn.lorawan.v3.GetGatewayCollaboratorRequest
+	16, // 19: ttn.lorawan.v3.GatewayAccess.SetCollaborator:input_type -> ttn.lorawan.v3.SetGatewayCollaboratorRequest
+	17, // 20: ttn.lorawan.v3.GatewayAccess.ListCollaborators:input_type -> ttn.lorawan.v3.ListGatewayCollaboratorsRequest
+	0,  // 21: ttn.lorawan.v3.GatewayConfigurator.PullConfiguration:input_type -> ttn.lorawan.v3.PullGatewayConfigurationRequest
+	18, // 22: ttn.lorawan.v3.GatewayRegistry.Create:output_type -> ttn.lorawan.v3.Gateway
+	18, // 23: ttn.lorawan.v3.GatewayRegistry.Get:output_type -> ttn.lorawan.v3.Gateway
+	1,  // 24: ttn.lorawan.v3.GatewayRegistry.GetIdentifiersForEUI:output_type -> ttn.lorawan.v3.GatewayIdentifiers
+	19, // 25: ttn.lorawan.v3.GatewayRegistry.RequestEUIReclaim:output_type -> google.protobuf.Empty
+	20, // 26: ttn.lorawan.v3.GatewayRegistry.ReleaseEUI:output_type -> ttn.lorawan.v3.GatewayEUITransferResult
+	20, // 27: ttn.lorawan.v3.GatewayRegistry.TransferEUI:output_type -> ttn.lorawan.v3.GatewayEUITransferResult
+	21, // 28: ttn.lorawan.v3.GatewayRegistry.List:output_type -> ttn.lorawan.v3.Gateways
+	18, // 29: ttn.lorawan.v3.GatewayRegistry.Update:output_type -> ttn.lorawan.v3.Gateway
+	19, // 30: ttn.lorawan.v3.GatewayRegistry.Delete:output_type -> google.protobuf.Empty
+	19, // 31: ttn.lorawan.v3.GatewayRegistry.Restore:output_type -> google.protobuf.Empty
+	19, // 32: ttn.lorawan.v3.GatewayRegistry.Purge:output_type -> google.protobuf.Empty
+	22, // 33: ttn.lorawan.v3.GatewayAccess.ListRights:output_type -> ttn.lorawan.v3.Rights
+	23, // 34: ttn.lorawan.v3.GatewayAccess.CreateAPIKey:output_type -> ttn.lorawan.v3.APIKey
+	24, // 35: ttn.lorawan.v3.GatewayAccess.ListAPIKeys:output_type -> ttn.lorawan.v3.APIKeys
+	23, // 36: ttn.lorawan.v3.GatewayAccess.GetAPIKey:output_type -> ttn.lorawan.v3.APIKey
+	23, // 37: ttn.lorawan.v3.GatewayAccess.UpdateAPIKey:output_type -> ttn.lorawan.v3.APIKey
+	25, // 38: ttn.lorawan.v3.GatewayAccess.GetCollaborator:output_type -> ttn.lorawan.v3.GetCollaboratorResponse
+	19, // 39: ttn.lorawan.v3.GatewayAccess.SetCollaborator:output_type -> google.protobuf.Empty
+	26, // 40: ttn.lorawan.v3.GatewayAccess.ListCollaborators:output_type -> ttn.lorawan.v3.Collaborators
+	18, // 41: ttn.lorawan.v3.GatewayConfigurator.PullConfiguration:output_type -> ttn.lorawan.v3.Gateway
+	22, // [22:42] is the sub-list for method output_type
+	2,  // [2:22] is the sub-list for method input_type
 	2,  // [2:2] is the sub-list for extension type_name
 	2,  // [2:2] is the sub-list for extension extendee
 	0,  // [0:2] is the sub-list for field type_name
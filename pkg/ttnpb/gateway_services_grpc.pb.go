@@ -37,6 +37,9 @@ const (
 	GatewayRegistry_Create_FullMethodName               = "/ttn.lorawan.v3.GatewayRegistry/Create"
 	GatewayRegistry_Get_FullMethodName                  = "/ttn.lorawan.v3.GatewayRegistry/Get"
 	GatewayRegistry_GetIdentifiersForEUI_FullMethodName = "/ttn.lorawan.v3.GatewayRegistry/GetIdentifiersForEUI"
+	GatewayRegistry_RequestEUIReclaim_FullMethodName    = "/ttn.lorawan.v3.GatewayRegistry/RequestEUIReclaim"
+	GatewayRegistry_ReleaseEUI_FullMethodName           = "/ttn.lorawan.v3.GatewayRegistry/ReleaseEUI"
+	GatewayRegistry_TransferEUI_FullMethodName          = "/ttn.lorawan.v3.GatewayRegistry/TransferEUI"
 	GatewayRegistry_List_FullMethodName                 = "/ttn.lorawan.v3.GatewayRegistry/List"
 	GatewayRegistry_Update_FullMethodName               = "/ttn.lorawan.v3.GatewayRegistry/Update"
 	GatewayRegistry_Delete_FullMethodName               = "/ttn.lorawan.v3.GatewayRegistry/Delete"
@@ -57,6 +60,15 @@ type GatewayRegistryClient interface {
 	Get(ctx context.Context, in *GetGatewayRequest, opts ...grpc.CallOption) (*Gateway, error)
 	// Get the identifiers of the gateway that has the given EUI registered.
 	GetIdentifiersForEUI(ctx context.Context, in *GetGatewayIdentifiersForEUIRequest, opts ...grpc.CallOption) (*GatewayIdentifiers, error)
+	// Request to reclaim the EUI of the gateway from the gateway that is registered with it.
+	// The admins are notified of the request, and transfer the EUI to the gateway after verifying the ownership.
+	RequestEUIReclaim(ctx context.Context, in *RequestGatewayEUIReclaimRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Release the EUI from the gateway that is registered with it, so that the owner can set the EUI of their gateway.
+	// This is only allowed for admins.
+	ReleaseEUI(ctx context.Context, in *ReleaseGatewayEUIRequest, opts ...grpc.CallOption) (*GatewayEUITransferResult, error)
+	// Transfer the EUI to the gateway, releasing it from the gateway that is registered with it.
+	// This is only allowed for admins.
+	TransferEUI(ctx context.Context, in *TransferGatewayEUIRequest, opts ...grpc.CallOption) (*GatewayEUITransferResult, error)
 	// List gateways where the given user or organization is a direct collaborator.
 	// If no user or organization is given, this returns the gateways the caller
 	// has access to.
@@ -114,6 +126,33 @@ func (c *gatewayRegistryClient) GetIdentifiersForEUI(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *gatewayRegistryClient) RequestEUIReclaim(ctx context.Context, in *RequestGatewayEUIReclaimRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, GatewayRegistry_RequestEUIReclaim_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayRegistryClient) ReleaseEUI(ctx context.Context, in *ReleaseGatewayEUIRequest, opts ...grpc.CallOption) (*GatewayEUITransferResult, error) {
+	out := new(GatewayEUITransferResult)
+	err := c.cc.Invoke(ctx, GatewayRegistry_ReleaseEUI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gatewayRegistryClient) TransferEUI(ctx context.Context, in *TransferGatewayEUIRequest, opts ...grpc.CallOption) (*GatewayEUITransferResult, error) {
+	out := new(GatewayEUITransferResult)
+	err := c.cc.Invoke(ctx, GatewayRegistry_TransferEUI_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *gatewayRegistryClient) List(ctx context.Context, in *ListGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error) {
 	out := new(Gateways)
 	err := c.cc.Invoke(ctx, GatewayRegistry_List_FullMethodName, in, out, opts...)
@@ -172,6 +211,15 @@ type GatewayRegistryServer interface {
 	Get(context.Context, *GetGatewayRequest) (*Gateway, error)
 	// Get the identifiers of the gateway that has the given EUI registered.
 	GetIdentifiersForEUI(context.Context, *GetGatewayIdentifiersForEUIRequest) (*GatewayIdentifiers, error)
+	// Request to reclaim the EUI of the gateway from the gateway that is registered with it.
+	// The admins are notified of the request, and transfer the EUI to the gateway after verifying the ownership.
+	RequestEUIReclaim(context.Context, *RequestGatewayEUIReclaimRequest) (*emptypb.Empty, error)
+	// Release the EUI from the gateway that is registered with it, so that the owner can set the EUI of their gateway.
+	// This is only allowed for admins.
+	ReleaseEUI(context.Context, *ReleaseGatewayEUIRequest) (*GatewayEUITransferResult, error)
+	// Transfer the EUI to the gateway, releasing it from the gateway that is registered with it.
+	// This is only allowed for admins.
+	TransferEUI(context.Context, *TransferGatewayEUIRequest) (*GatewayEUITransferResult, error)
 	// List gateways where the given user or organization is a direct collaborator.
 	// If no user or organization is given, this returns the gateways the caller
 	// has access to.
@@ -208,6 +256,15 @@ func (UnimplementedGatewayRegistryServer) Get(context.Context, *GetGatewayReques
 func (UnimplementedGatewayRegistryServer) GetIdentifiersForEUI(context.Context, *GetGatewayIdentifiersForEUIRequest) (*GatewayIdentifiers, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetIdentifiersForEUI not implemented")
 }
+func (UnimplementedGatewayRegistryServer) RequestEUIReclaim(context.Context, *RequestGatewayEUIReclaimRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestEUIReclaim not implemented")
+}
+func (UnimplementedGatewayRegistryServer) ReleaseEUI(context.Context, *ReleaseGatewayEUIRequest) (*GatewayEUITransferResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseEUI not implemented")
+}
+func (UnimplementedGatewayRegistryServer) TransferEUI(context.Context, *TransferGatewayEUIRequest) (*GatewayEUITransferResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferEUI not implemented")
+}
 func (UnimplementedGatewayRegistryServer) List(context.Context, *ListGatewaysRequest) (*Gateways, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
 }
@@ -290,6 +347,60 @@ func _GatewayRegistry_GetIdentifiersForEUI_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GatewayRegistry_RequestEUIReclaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestGatewayEUIReclaimRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).RequestEUIReclaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayRegistry_RequestEUIReclaim_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).RequestEUIReclaim(ctx, req.(*RequestGatewayEUIReclaimRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayRegistry_ReleaseEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseGatewayEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).ReleaseEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayRegistry_ReleaseEUI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).ReleaseEUI(ctx, req.(*ReleaseGatewayEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GatewayRegistry_TransferEUI_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferGatewayEUIRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GatewayRegistryServer).TransferEUI(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: GatewayRegistry_TransferEUI_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GatewayRegistryServer).TransferEUI(ctx, req.(*TransferGatewayEUIRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _GatewayRegistry_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListGatewaysRequest)
 	if err := dec(in); err != nil {
@@ -399,6 +510,18 @@ var GatewayRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetIdentifiersForEUI",
 			Handler:    _GatewayRegistry_GetIdentifiersForEUI_Handler,
 		},
+		{
+			MethodName: "RequestEUIReclaim",
+			Handler:    _GatewayRegistry_RequestEUIReclaim_Handler,
+		},
+		{
+			MethodName: "ReleaseEUI",
+			Handler:    _GatewayRegistry_ReleaseEUI_Handler,
+		},
+		{
+			MethodName: "TransferEUI",
+			Handler:    _GatewayRegistry_TransferEUI_Handler,
+		},
 		{
 			MethodName: "List",
 			Handler:    _GatewayRegistry_List_Handler,
@@ -297,3 +297,40 @@ var BatchGetGatewayConnectionStatsResponseFieldPathsNested = []string{
 var BatchGetGatewayConnectionStatsResponseFieldPathsTopLevel = []string{
 	"entries",
 }
+var GatewayConnectionStatsUpdateFieldPathsNested = []string{
+	"gateway_ids",
+	"gateway_ids.eui",
+	"gateway_ids.gateway_id",
+	"stats",
+	"stats.connected_at",
+	"stats.disconnected_at",
+	"stats.downlink_count",
+	"stats.gateway_remote_address",
+	"stats.gateway_remote_address.ip",
+	"stats.last_downlink_received_at",
+	"stats.last_status",
+	"stats.last_status.advanced",
+	"stats.last_status.antenna_locations",
+	"stats.last_status.boot_time",
+	"stats.last_status.ip",
+	"stats.last_status.metrics",
+	"stats.last_status.time",
+	"stats.last_status.versions",
+	"stats.last_status_received_at",
+	"stats.last_tx_acknowledgment_received_at",
+	"stats.last_uplink_received_at",
+	"stats.protocol",
+	"stats.round_trip_times",
+	"stats.round_trip_times.count",
+	"stats.round_trip_times.max",
+	"stats.round_trip_times.median",
+	"stats.round_trip_times.min",
+	"stats.sub_bands",
+	"stats.tx_acknowledgment_count",
+	"stats.uplink_count",
+}
+
+var GatewayConnectionStatsUpdateFieldPathsTopLevel = []string{
+	"gateway_ids",
+	"stats",
+}
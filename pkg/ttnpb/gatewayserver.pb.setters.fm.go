@@ -243,3 +243,64 @@ func (dst *BatchGetGatewayConnectionStatsResponse) SetFields(src *BatchGetGatewa
 	}
 	return nil
 }
+
+func (dst *GatewayConnectionStatsUpdate) SetFields(src *GatewayConnectionStatsUpdate, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "gateway_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayIdentifiers
+				if (src == nil || src.GatewayIds == nil) && dst.GatewayIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.GatewayIds
+				}
+				if dst.GatewayIds != nil {
+					newDst = dst.GatewayIds
+				} else {
+					newDst = &GatewayIdentifiers{}
+					dst.GatewayIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.GatewayIds = src.GatewayIds
+				} else {
+					dst.GatewayIds = nil
+				}
+			}
+		case "stats":
+			if len(subs) > 0 {
+				var newDst, newSrc *GatewayConnectionStats
+				if (src == nil || src.Stats == nil) && dst.Stats == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Stats
+				}
+				if dst.Stats != nil {
+					newDst = dst.Stats
+				} else {
+					newDst = &GatewayConnectionStats{}
+					dst.Stats = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Stats = src.Stats
+				} else {
+					dst.Stats = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -667,3 +667,116 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = BatchGetGatewayConnectionStatsResponseValidationError{}
+
+// ValidateFields checks the field values on GatewayConnectionStatsUpdate with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GatewayConnectionStatsUpdate) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GatewayConnectionStatsUpdateFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "gateway_ids":
+
+			if m.GetGatewayIds() == nil {
+				return GatewayConnectionStatsUpdateValidationError{
+					field:  "gateway_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGatewayIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsUpdateValidationError{
+						field:  "gateway_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "stats":
+
+			if v, ok := interface{}(m.GetStats()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GatewayConnectionStatsUpdateValidationError{
+						field:  "stats",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GatewayConnectionStatsUpdateValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GatewayConnectionStatsUpdateValidationError is the validation error returned
+// by GatewayConnectionStatsUpdate.ValidateFields if the designated
+// constraints aren't met.
+type GatewayConnectionStatsUpdateValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GatewayConnectionStatsUpdateValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GatewayConnectionStatsUpdateValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GatewayConnectionStatsUpdateValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GatewayConnectionStatsUpdateValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GatewayConnectionStatsUpdateValidationError) ErrorName() string {
+	return "GatewayConnectionStatsUpdateValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GatewayConnectionStatsUpdateValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGatewayConnectionStatsUpdate.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GatewayConnectionStatsUpdateValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GatewayConnectionStatsUpdateValidationError{}
@@ -374,6 +374,7 @@ var NsGs_ServiceDesc = grpc.ServiceDesc{
 const (
 	Gs_GetGatewayConnectionStats_FullMethodName      = "/ttn.lorawan.v3.Gs/GetGatewayConnectionStats"
 	Gs_BatchGetGatewayConnectionStats_FullMethodName = "/ttn.lorawan.v3.Gs/BatchGetGatewayConnectionStats"
+	Gs_StreamGatewayConnectionStats_FullMethodName   = "/ttn.lorawan.v3.Gs/StreamGatewayConnectionStats"
 )
 
 // GsClient is the client API for Gs service.
@@ -388,6 +389,11 @@ type GsClient interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(ctx context.Context, in *BatchGetGatewayConnectionStatsRequest, opts ...grpc.CallOption) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Stream the connection stats of a batch of gateways.
+	// The stream starts with the current connection stats of the connected gateways, followed by the
+	// connection stats published when gateways connect, disconnect and periodically while they are connected.
+	// The field mask is applied on each streamed update.
+	StreamGatewayConnectionStats(ctx context.Context, in *BatchGetGatewayConnectionStatsRequest, opts ...grpc.CallOption) (Gs_StreamGatewayConnectionStatsClient, error)
 }
 
 type gsClient struct {
@@ -416,6 +422,38 @@ func (c *gsClient) BatchGetGatewayConnectionStats(ctx context.Context, in *Batch
 	return out, nil
 }
 
+func (c *gsClient) StreamGatewayConnectionStats(ctx context.Context, in *BatchGetGatewayConnectionStatsRequest, opts ...grpc.CallOption) (Gs_StreamGatewayConnectionStatsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Gs_ServiceDesc.Streams[0], Gs_StreamGatewayConnectionStats_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gsStreamGatewayConnectionStatsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Gs_StreamGatewayConnectionStatsClient interface {
+	Recv() (*GatewayConnectionStatsUpdate, error)
+	grpc.ClientStream
+}
+
+type gsStreamGatewayConnectionStatsClient struct {
+	grpc.ClientStream
+}
+
+func (x *gsStreamGatewayConnectionStatsClient) Recv() (*GatewayConnectionStatsUpdate, error) {
+	m := new(GatewayConnectionStatsUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // GsServer is the server API for Gs service.
 // All implementations must embed UnimplementedGsServer
 // for forward compatibility
@@ -428,6 +466,11 @@ type GsServer interface {
 	// Gateways that are not connected or are part of a different cluster are ignored.
 	// It is up to the client to make sure that the gateways are in the requested cluster.
 	BatchGetGatewayConnectionStats(context.Context, *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error)
+	// Stream the connection stats of a batch of gateways.
+	// The stream starts with the current connection stats of the connected gateways, followed by the
+	// connection stats published when gateways connect, disconnect and periodically while they are connected.
+	// The field mask is applied on each streamed update.
+	StreamGatewayConnectionStats(*BatchGetGatewayConnectionStatsRequest, Gs_StreamGatewayConnectionStatsServer) error
 	mustEmbedUnimplementedGsServer()
 }
 
@@ -441,6 +484,9 @@ func (UnimplementedGsServer) GetGatewayConnectionStats(context.Context, *Gateway
 func (UnimplementedGsServer) BatchGetGatewayConnectionStats(context.Context, *BatchGetGatewayConnectionStatsRequest) (*BatchGetGatewayConnectionStatsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BatchGetGatewayConnectionStats not implemented")
 }
+func (UnimplementedGsServer) StreamGatewayConnectionStats(*BatchGetGatewayConnectionStatsRequest, Gs_StreamGatewayConnectionStatsServer) error {
+	return status.Errorf(codes.Unimplemented, "method StreamGatewayConnectionStats not implemented")
+}
 func (UnimplementedGsServer) mustEmbedUnimplementedGsServer() {}
 
 // UnsafeGsServer may be embedded to opt out of forward compatibility for this service.
@@ -490,6 +536,27 @@ func _Gs_BatchGetGatewayConnectionStats_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Gs_StreamGatewayConnectionStats_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BatchGetGatewayConnectionStatsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GsServer).StreamGatewayConnectionStats(m, &gsStreamGatewayConnectionStatsServer{stream})
+}
+
+type Gs_StreamGatewayConnectionStatsServer interface {
+	Send(*GatewayConnectionStatsUpdate) error
+	grpc.ServerStream
+}
+
+type gsStreamGatewayConnectionStatsServer struct {
+	grpc.ServerStream
+}
+
+func (x *gsStreamGatewayConnectionStatsServer) Send(m *GatewayConnectionStatsUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // Gs_ServiceDesc is the grpc.ServiceDesc for Gs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -506,6 +573,12 @@ var Gs_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Gs_BatchGetGatewayConnectionStats_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamGatewayConnectionStats",
+			Handler:       _Gs_StreamGatewayConnectionStats_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "ttn/lorawan/v3/gatewayserver.proto",
 }
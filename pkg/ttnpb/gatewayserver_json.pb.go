@@ -308,3 +308,61 @@ func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalProtoJSON(s *jsonplugin
 func (x *BatchGetGatewayConnectionStatsRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the GatewayConnectionStatsUpdate message to JSON.
+func (x *GatewayConnectionStatsUpdate) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GatewayIds != nil || s.HasField("gateway_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("gateway_ids")
+		x.GatewayIds.MarshalProtoJSON(s.WithField("gateway_ids"))
+	}
+	if x.Stats != nil || s.HasField("stats") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("stats")
+		x.Stats.MarshalProtoJSON(s.WithField("stats"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the GatewayConnectionStatsUpdate to JSON.
+func (x *GatewayConnectionStatsUpdate) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the GatewayConnectionStatsUpdate message from JSON.
+func (x *GatewayConnectionStatsUpdate) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "gateway_ids", "gatewayIds":
+			if s.ReadNil() {
+				x.GatewayIds = nil
+				return
+			}
+			x.GatewayIds = &GatewayIdentifiers{}
+			x.GatewayIds.UnmarshalProtoJSON(s.WithField("gateway_ids", true))
+		case "stats":
+			if s.ReadNil() {
+				x.Stats = nil
+				return
+			}
+			x.Stats = &GatewayConnectionStats{}
+			x.Stats.UnmarshalProtoJSON(s.WithField("stats", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the GatewayConnectionStatsUpdate from JSON.
+func (x *GatewayConnectionStatsUpdate) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
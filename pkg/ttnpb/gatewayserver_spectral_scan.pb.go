@@ -0,0 +1,446 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.30.0
+// 	protoc        v4.22.2
+// source: ttn/lorawan/v3/gatewayserver_spectral_scan.proto
+
+package ttnpb
+
+import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// The background RSSI of a gateway channel at a point in time.
+type GatewayNoiseFloorSample struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Time *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Rssi float32                `protobuf:"fixed32,2,opt,name=rssi,proto3" json:"rssi,omitempty"`
+}
+
+func (x *GatewayNoiseFloorSample) Reset() {
+	*x = GatewayNoiseFloorSample{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayNoiseFloorSample) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayNoiseFloorSample) ProtoMessage() {}
+
+func (x *GatewayNoiseFloorSample) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayNoiseFloorSample.ProtoReflect.Descriptor instead.
+func (*GatewayNoiseFloorSample) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GatewayNoiseFloorSample) GetTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Time
+	}
+	return nil
+}
+
+func (x *GatewayNoiseFloorSample) GetRssi() float32 {
+	if x != nil {
+		return x.Rssi
+	}
+	return 0
+}
+
+// The noise floor history of a gateway channel.
+type GatewayNoiseFloorChannel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Frequency   uint64  `protobuf:"varint,1,opt,name=frequency,proto3" json:"frequency,omitempty"`
+	AverageRssi float32 `protobuf:"fixed32,2,opt,name=average_rssi,json=averageRssi,proto3" json:"average_rssi,omitempty"`
+	MaxRssi     float32 `protobuf:"fixed32,3,opt,name=max_rssi,json=maxRssi,proto3" json:"max_rssi,omitempty"`
+	// The samples of the spectral scan reports, the most recent first.
+	Samples []*GatewayNoiseFloorSample `protobuf:"bytes,4,rep,name=samples,proto3" json:"samples,omitempty"`
+}
+
+func (x *GatewayNoiseFloorChannel) Reset() {
+	*x = GatewayNoiseFloorChannel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayNoiseFloorChannel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayNoiseFloorChannel) ProtoMessage() {}
+
+func (x *GatewayNoiseFloorChannel) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayNoiseFloorChannel.ProtoReflect.Descriptor instead.
+func (*GatewayNoiseFloorChannel) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GatewayNoiseFloorChannel) GetFrequency() uint64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+func (x *GatewayNoiseFloorChannel) GetAverageRssi() float32 {
+	if x != nil {
+		return x.AverageRssi
+	}
+	return 0
+}
+
+func (x *GatewayNoiseFloorChannel) GetMaxRssi() float32 {
+	if x != nil {
+		return x.MaxRssi
+	}
+	return 0
+}
+
+func (x *GatewayNoiseFloorChannel) GetSamples() []*GatewayNoiseFloorSample {
+	if x != nil {
+		return x.Samples
+	}
+	return nil
+}
+
+type GatewayNoiseFloor struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The channels, ordered by frequency.
+	Channels []*GatewayNoiseFloorChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+}
+
+func (x *GatewayNoiseFloor) Reset() {
+	*x = GatewayNoiseFloor{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GatewayNoiseFloor) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GatewayNoiseFloor) ProtoMessage() {}
+
+func (x *GatewayNoiseFloor) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GatewayNoiseFloor.ProtoReflect.Descriptor instead.
+func (*GatewayNoiseFloor) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GatewayNoiseFloor) GetChannels() []*GatewayNoiseFloorChannel {
+	if x != nil {
+		return x.Channels
+	}
+	return nil
+}
+
+type GetGatewayNoiseFloorRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	GatewayIds *GatewayIdentifiers `protobuf:"bytes,1,opt,name=gateway_ids,json=gatewayIds,proto3" json:"gateway_ids,omitempty"`
+	// If set, only the noise floor history of the channel with this frequency (Hz) is returned.
+	Frequency uint64 `protobuf:"varint,2,opt,name=frequency,proto3" json:"frequency,omitempty"`
+}
+
+func (x *GetGatewayNoiseFloorRequest) Reset() {
+	*x = GetGatewayNoiseFloorRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetGatewayNoiseFloorRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetGatewayNoiseFloorRequest) ProtoMessage() {}
+
+func (x *GetGatewayNoiseFloorRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetGatewayNoiseFloorRequest.ProtoReflect.Descriptor instead.
+func (*GetGatewayNoiseFloorRequest) Descriptor() ([]byte, []int) {
+	return file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetGatewayNoiseFloorRequest) GetGatewayIds() *GatewayIdentifiers {
+	if x != nil {
+		return x.GatewayIds
+	}
+	return nil
+}
+
+func (x *GetGatewayNoiseFloorRequest) GetFrequency() uint64 {
+	if x != nil {
+		return x.Frequency
+	}
+	return 0
+}
+
+var File_ttn_lorawan_v3_gatewayserver_spectral_scan_proto protoreflect.FileDescriptor
+
+var file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDesc = []byte{
+	0x0a, 0x30, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76, 0x33,
+	0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x5f, 0x73,
+	0x70, 0x65, 0x63, 0x74, 0x72, 0x61, 0x6c, 0x5f, 0x73, 0x63, 0x61, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x12, 0x0e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x61,
+	0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x74, 0x74, 0x6e, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2f, 0x76,
+	0x33, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61,
+	0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x5d, 0x0a, 0x17,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f,
+	0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x73, 0x73, 0x69, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x04, 0x72, 0x73, 0x73, 0x69, 0x22, 0xb9, 0x01, 0x0a, 0x18,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71,
+	0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65,
+	0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x12, 0x21, 0x0a, 0x0c, 0x61, 0x76, 0x65, 0x72, 0x61, 0x67,
+	0x65, 0x5f, 0x72, 0x73, 0x73, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x61, 0x76,
+	0x65, 0x72, 0x61, 0x67, 0x65, 0x52, 0x73, 0x73, 0x69, 0x12, 0x19, 0x0a, 0x08, 0x6d, 0x61, 0x78,
+	0x5f, 0x72, 0x73, 0x73, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x52, 0x73, 0x73, 0x69, 0x12, 0x41, 0x0a, 0x07, 0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
+	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f,
+	0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x53, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x52, 0x07,
+	0x73, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x47, 0x61, 0x74, 0x65, 0x77,
+	0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x12, 0x44, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e,
+	0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f,
+	0x72, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61,
+	0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0b, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f, 0x69, 0x64,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
+	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05,
+	0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x0a, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x49, 0x64,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x79, 0x32,
+	0xad, 0x01, 0x0a, 0x18, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65,
+	0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x90, 0x01, 0x0a,
+	0x03, 0x47, 0x65, 0x74, 0x12, 0x2b, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
+	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79,
+	0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46, 0x6c, 0x6f, 0x6f, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x21, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x47, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x4e, 0x6f, 0x69, 0x73, 0x65, 0x46,
+	0x6c, 0x6f, 0x6f, 0x72, 0x22, 0x39, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x33, 0x12, 0x31, 0x2f, 0x67,
+	0x73, 0x2f, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x73, 0x2f, 0x7b, 0x67, 0x61, 0x74, 0x65,
+	0x77, 0x61, 0x79, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x67, 0x61, 0x74, 0x65, 0x77, 0x61, 0x79, 0x5f,
+	0x69, 0x64, 0x7d, 0x2f, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x2d, 0x66, 0x6c, 0x6f, 0x6f, 0x72, 0x42,
+	0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e,
+	0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d,
+	0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e,
+	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescOnce sync.Once
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescData = file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDesc
+)
+
+func file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescGZIP() []byte {
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescOnce.Do(func() {
+		file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescData = protoimpl.X.CompressGZIP(file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescData)
+	})
+	return file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDescData
+}
+
+var file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_goTypes = []interface{}{
+	(*GatewayNoiseFloorSample)(nil),     // 0: ttn.lorawan.v3.GatewayNoiseFloorSample
+	(*GatewayNoiseFloorChannel)(nil),    // 1: ttn.lorawan.v3.GatewayNoiseFloorChannel
+	(*GatewayNoiseFloor)(nil),           // 2: ttn.lorawan.v3.GatewayNoiseFloor
+	(*GetGatewayNoiseFloorRequest)(nil), // 3: ttn.lorawan.v3.GetGatewayNoiseFloorRequest
+	(*timestamppb.Timestamp)(nil),       // 4: google.protobuf.Timestamp
+	(*GatewayIdentifiers)(nil),          // 5: ttn.lorawan.v3.GatewayIdentifiers
+}
+var file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_depIdxs = []int32{
+	4, // 0: ttn.lorawan.v3.GatewayNoiseFloorSample.time:type_name -> google.protobuf.Timestamp
+	0, // 1: ttn.lorawan.v3.GatewayNoiseFloorChannel.samples:type_name -> ttn.lorawan.v3.GatewayNoiseFloorSample
+	1, // 2: ttn.lorawan.v3.GatewayNoiseFloor.channels:type_name -> ttn.lorawan.v3.GatewayNoiseFloorChannel
+	5, // 3: ttn.lorawan.v3.GetGatewayNoiseFloorRequest.gateway_ids:type_name -> ttn.lorawan.v3.GatewayIdentifiers
+	3, // 4: ttn.lorawan.v3.GatewayNoiseFloorService.Get:input_type -> ttn.lorawan.v3.GetGatewayNoiseFloorRequest
+	2, // 5: ttn.lorawan.v3.GatewayNoiseFloorService.Get:output_type -> ttn.lorawan.v3.GatewayNoiseFloor
+	5, // [5:6] is the sub-list for method output_type
+	4, // [4:5] is the sub-list for method input_type
+	4, // [4:4] is the sub-list for extension type_name
+	4, // [4:4] is the sub-list for extension extendee
+	0, // [0:4] is the sub-list for field type_name
+}
+
+func init() { file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_init() }
+func file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_init() {
+	if File_ttn_lorawan_v3_gatewayserver_spectral_scan_proto != nil {
+		return
+	}
+	file_ttn_lorawan_v3_identifiers_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayNoiseFloorSample); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayNoiseFloorChannel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GatewayNoiseFloor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetGatewayNoiseFloorRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   4,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_goTypes,
+		DependencyIndexes: file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_depIdxs,
+		MessageInfos:      file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_msgTypes,
+	}.Build()
+	File_ttn_lorawan_v3_gatewayserver_spectral_scan_proto = out.File
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_rawDesc = nil
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_goTypes = nil
+	file_ttn_lorawan_v3_gatewayserver_spectral_scan_proto_depIdxs = nil
+}
@@ -129,6 +129,7 @@ func init() {
 	defineEnum(Right_RIGHT_APPLICATION_DEVICES_WRITE, "create devices in application")
 	defineEnum(Right_RIGHT_APPLICATION_DEVICES_READ_KEYS, "view device keys in application")
 	defineEnum(Right_RIGHT_APPLICATION_DEVICES_WRITE_KEYS, "edit device keys in application")
+	defineEnum(Right_RIGHT_APPLICATION_DEVICES_CLAIM, "claim devices into application")
 	defineEnum(Right_RIGHT_APPLICATION_TRAFFIC_READ, "read application traffic (uplink and downlink)")
 	defineEnum(Right_RIGHT_APPLICATION_TRAFFIC_UP_WRITE, "write uplink application traffic")
 	defineEnum(Right_RIGHT_APPLICATION_TRAFFIC_DOWN_WRITE, "write downlink application traffic")
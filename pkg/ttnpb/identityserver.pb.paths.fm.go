@@ -71,6 +71,55 @@ var AuthInfoResponseFieldPathsTopLevel = []string{
 	"is_admin",
 	"universal_rights",
 }
+var APIKeyTokenFieldPathsNested = []string{
+	"expires_at",
+	"token",
+}
+
+var APIKeyTokenFieldPathsTopLevel = []string{
+	"expires_at",
+	"token",
+}
+var ExchangeAPIKeyTokenRequestFieldPathsNested = []string{
+	"device_ids",
+	"device_ids.application_ids",
+	"device_ids.application_ids.application_id",
+	"device_ids.dev_addr",
+	"device_ids.dev_eui",
+	"device_ids.device_id",
+	"device_ids.join_eui",
+	"expires_in",
+	"grant_type",
+	"requested_token_type",
+	"scope",
+	"subject_token",
+	"subject_token_type",
+}
+
+var ExchangeAPIKeyTokenRequestFieldPathsTopLevel = []string{
+	"device_ids",
+	"expires_in",
+	"grant_type",
+	"requested_token_type",
+	"scope",
+	"subject_token",
+	"subject_token_type",
+}
+var ExchangeAPIKeyTokenResponseFieldPathsNested = []string{
+	"access_token",
+	"expires_in",
+	"issued_token_type",
+	"scope",
+	"token_type",
+}
+
+var ExchangeAPIKeyTokenResponseFieldPathsTopLevel = []string{
+	"access_token",
+	"expires_in",
+	"issued_token_type",
+	"scope",
+	"token_type",
+}
 var GetIsConfigurationRequestFieldPathsNested []string
 var GetIsConfigurationRequestFieldPathsTopLevel []string
 var IsConfigurationFieldPathsNested = []string{
@@ -154,6 +203,36 @@ var GetIsConfigurationResponseFieldPathsNested = []string{
 var GetIsConfigurationResponseFieldPathsTopLevel = []string{
 	"configuration",
 }
+var BrandingFieldPathsNested = []string{
+	"documentation_url",
+	"footer_links",
+	"logo_url",
+	"primary_color",
+	"secondary_color",
+	"support_url",
+}
+
+var BrandingFieldPathsTopLevel = []string{
+	"documentation_url",
+	"footer_links",
+	"logo_url",
+	"primary_color",
+	"secondary_color",
+	"support_url",
+}
+var SetBrandingRequestFieldPathsNested = []string{
+	"branding",
+	"branding.documentation_url",
+	"branding.footer_links",
+	"branding.logo_url",
+	"branding.primary_color",
+	"branding.secondary_color",
+	"branding.support_url",
+}
+
+var SetBrandingRequestFieldPathsTopLevel = []string{
+	"branding",
+}
 var AuthInfoResponse_APIKeyAccessFieldPathsNested = []string{
 	"api_key",
 	"api_key.created_at",
@@ -215,6 +215,193 @@ func (dst *AuthInfoResponse) SetFields(src *AuthInfoResponse, paths ...string) e
 	return nil
 }
 
+func (dst *APIKeyToken) SetFields(src *APIKeyToken, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Token = src.Token
+			} else {
+				var zero string
+				dst.Token = zero
+			}
+		case "expires_at":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_at' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresAt = src.ExpiresAt
+			} else {
+				dst.ExpiresAt = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExchangeAPIKeyTokenRequest) SetFields(src *ExchangeAPIKeyTokenRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "grant_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'grant_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.GrantType = src.GrantType
+			} else {
+				var zero string
+				dst.GrantType = zero
+			}
+		case "subject_token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subject_token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubjectToken = src.SubjectToken
+			} else {
+				var zero string
+				dst.SubjectToken = zero
+			}
+		case "subject_token_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'subject_token_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SubjectTokenType = src.SubjectTokenType
+			} else {
+				var zero string
+				dst.SubjectTokenType = zero
+			}
+		case "requested_token_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'requested_token_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.RequestedTokenType = src.RequestedTokenType
+			} else {
+				var zero string
+				dst.RequestedTokenType = zero
+			}
+		case "scope":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scope' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scope = src.Scope
+			} else {
+				var zero string
+				dst.Scope = zero
+			}
+		case "expires_in":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_in' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresIn = src.ExpiresIn
+			} else {
+				var zero uint32
+				dst.ExpiresIn = zero
+			}
+		case "device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.DeviceIds == nil) && dst.DeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.DeviceIds
+				}
+				if dst.DeviceIds != nil {
+					newDst = dst.DeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.DeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.DeviceIds = src.DeviceIds
+				} else {
+					dst.DeviceIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExchangeAPIKeyTokenResponse) SetFields(src *ExchangeAPIKeyTokenResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "access_token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'access_token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.AccessToken = src.AccessToken
+			} else {
+				var zero string
+				dst.AccessToken = zero
+			}
+		case "issued_token_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'issued_token_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.IssuedTokenType = src.IssuedTokenType
+			} else {
+				var zero string
+				dst.IssuedTokenType = zero
+			}
+		case "token_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.TokenType = src.TokenType
+			} else {
+				var zero string
+				dst.TokenType = zero
+			}
+		case "expires_in":
+			if len(subs) > 0 {
+				return fmt.Errorf("'expires_in' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ExpiresIn = src.ExpiresIn
+			} else {
+				var zero uint32
+				dst.ExpiresIn = zero
+			}
+		case "scope":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scope' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scope = src.Scope
+			} else {
+				var zero string
+				dst.Scope = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *GetIsConfigurationRequest) SetFields(src *GetIsConfigurationRequest, paths ...string) error {
 	if len(paths) != 0 {
 		return fmt.Errorf("message GetIsConfigurationRequest has no fields, but paths %s were specified", paths)
@@ -444,6 +631,112 @@ func (dst *GetIsConfigurationResponse) SetFields(src *GetIsConfigurationResponse
 	return nil
 }
 
+func (dst *Branding) SetFields(src *Branding, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "logo_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'logo_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LogoUrl = src.LogoUrl
+			} else {
+				var zero string
+				dst.LogoUrl = zero
+			}
+		case "primary_color":
+			if len(subs) > 0 {
+				return fmt.Errorf("'primary_color' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PrimaryColor = src.PrimaryColor
+			} else {
+				var zero string
+				dst.PrimaryColor = zero
+			}
+		case "secondary_color":
+			if len(subs) > 0 {
+				return fmt.Errorf("'secondary_color' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SecondaryColor = src.SecondaryColor
+			} else {
+				var zero string
+				dst.SecondaryColor = zero
+			}
+		case "footer_links":
+			if len(subs) > 0 {
+				return fmt.Errorf("'footer_links' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FooterLinks = src.FooterLinks
+			} else {
+				dst.FooterLinks = nil
+			}
+		case "support_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'support_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.SupportUrl = src.SupportUrl
+			} else {
+				var zero string
+				dst.SupportUrl = zero
+			}
+		case "documentation_url":
+			if len(subs) > 0 {
+				return fmt.Errorf("'documentation_url' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DocumentationUrl = src.DocumentationUrl
+			} else {
+				var zero string
+				dst.DocumentationUrl = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetBrandingRequest) SetFields(src *SetBrandingRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "branding":
+			if len(subs) > 0 {
+				var newDst, newSrc *Branding
+				if (src == nil || src.Branding == nil) && dst.Branding == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Branding
+				}
+				if dst.Branding != nil {
+					newDst = dst.Branding
+				} else {
+					newDst = &Branding{}
+					dst.Branding = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Branding = src.Branding
+				} else {
+					dst.Branding = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *AuthInfoResponse_APIKeyAccess) SetFields(src *AuthInfoResponse_APIKeyAccess, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
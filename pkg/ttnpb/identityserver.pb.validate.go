@@ -200,6 +200,338 @@ var _ interface {
 	ErrorName() string
 } = AuthInfoResponseValidationError{}
 
+// ValidateFields checks the field values on APIKeyToken with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *APIKeyToken) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = APIKeyTokenFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "token":
+			// no validation rules for Token
+		case "expires_at":
+
+			if v, ok := interface{}(m.GetExpiresAt()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return APIKeyTokenValidationError{
+						field:  "expires_at",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return APIKeyTokenValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// APIKeyTokenValidationError is the validation error returned by
+// APIKeyToken.ValidateFields if the designated constraints aren't met.
+type APIKeyTokenValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e APIKeyTokenValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e APIKeyTokenValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e APIKeyTokenValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e APIKeyTokenValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e APIKeyTokenValidationError) ErrorName() string { return "APIKeyTokenValidationError" }
+
+// Error satisfies the builtin error interface
+func (e APIKeyTokenValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAPIKeyToken.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = APIKeyTokenValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = APIKeyTokenValidationError{}
+
+// ValidateFields checks the field values on ExchangeAPIKeyTokenRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ExchangeAPIKeyTokenRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExchangeAPIKeyTokenRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "grant_type":
+
+			if m.GetGrantType() != "urn:ietf:params:oauth:grant-type:token-exchange" {
+				return ExchangeAPIKeyTokenRequestValidationError{
+					field:  "grant_type",
+					reason: "value must equal urn:ietf:params:oauth:grant-type:token-exchange",
+				}
+			}
+
+		case "subject_token":
+
+			if l := utf8.RuneCountInString(m.GetSubjectToken()); l < 1 || l > 2048 {
+				return ExchangeAPIKeyTokenRequestValidationError{
+					field:  "subject_token",
+					reason: "value length must be between 1 and 2048 runes, inclusive",
+				}
+			}
+
+		case "subject_token_type":
+
+			if m.GetSubjectTokenType() != "urn:ietf:params:oauth:token-type:access_token" {
+				return ExchangeAPIKeyTokenRequestValidationError{
+					field:  "subject_token_type",
+					reason: "value must equal urn:ietf:params:oauth:token-type:access_token",
+				}
+			}
+
+		case "requested_token_type":
+
+			if _, ok := _ExchangeAPIKeyTokenRequest_RequestedTokenType_InLookup[m.GetRequestedTokenType()]; !ok {
+				return ExchangeAPIKeyTokenRequestValidationError{
+					field:  "requested_token_type",
+					reason: "value must be in list [ urn:ietf:params:oauth:token-type:access_token urn:ietf:params:oauth:token-type:jwt]",
+				}
+			}
+
+		case "scope":
+
+			if utf8.RuneCountInString(m.GetScope()) > 4096 {
+				return ExchangeAPIKeyTokenRequestValidationError{
+					field:  "scope",
+					reason: "value length must be at most 4096 runes",
+				}
+			}
+
+		case "expires_in":
+			// no validation rules for ExpiresIn
+		case "device_ids":
+
+			if v, ok := interface{}(m.GetDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExchangeAPIKeyTokenRequestValidationError{
+						field:  "device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ExchangeAPIKeyTokenRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExchangeAPIKeyTokenRequestValidationError is the validation error returned
+// by ExchangeAPIKeyTokenRequest.ValidateFields if the designated constraints
+// aren't met.
+type ExchangeAPIKeyTokenRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeAPIKeyTokenRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeAPIKeyTokenRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeAPIKeyTokenRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeAPIKeyTokenRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeAPIKeyTokenRequestValidationError) ErrorName() string {
+	return "ExchangeAPIKeyTokenRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeAPIKeyTokenRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeAPIKeyTokenRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeAPIKeyTokenRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeAPIKeyTokenRequestValidationError{}
+
+var _ExchangeAPIKeyTokenRequest_RequestedTokenType_InLookup = map[string]struct{}{
+	"": {},
+	"urn:ietf:params:oauth:token-type:access_token": {},
+	"urn:ietf:params:oauth:token-type:jwt":          {},
+}
+
+// ValidateFields checks the field values on ExchangeAPIKeyTokenResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ExchangeAPIKeyTokenResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExchangeAPIKeyTokenResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "access_token":
+			// no validation rules for AccessToken
+		case "issued_token_type":
+			// no validation rules for IssuedTokenType
+		case "token_type":
+			// no validation rules for TokenType
+		case "expires_in":
+			// no validation rules for ExpiresIn
+		case "scope":
+			// no validation rules for Scope
+		default:
+			return ExchangeAPIKeyTokenResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExchangeAPIKeyTokenResponseValidationError is the validation error returned
+// by ExchangeAPIKeyTokenResponse.ValidateFields if the designated constraints
+// aren't met.
+type ExchangeAPIKeyTokenResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExchangeAPIKeyTokenResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExchangeAPIKeyTokenResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExchangeAPIKeyTokenResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExchangeAPIKeyTokenResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExchangeAPIKeyTokenResponseValidationError) ErrorName() string {
+	return "ExchangeAPIKeyTokenResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExchangeAPIKeyTokenResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExchangeAPIKeyTokenResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExchangeAPIKeyTokenResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExchangeAPIKeyTokenResponseValidationError{}
+
 // ValidateFields checks the field values on GetIsConfigurationRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -524,6 +856,265 @@ var _ interface {
 	ErrorName() string
 } = GetIsConfigurationResponseValidationError{}
 
+// ValidateFields checks the field values on Branding with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Branding) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = BrandingFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "logo_url":
+
+			if utf8.RuneCountInString(m.GetLogoUrl()) > 2048 {
+				return BrandingValidationError{
+					field:  "logo_url",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		case "primary_color":
+
+			if m.GetPrimaryColor() != "" {
+
+				if !_Branding_PrimaryColor_Pattern.MatchString(m.GetPrimaryColor()) {
+					return BrandingValidationError{
+						field:  "primary_color",
+						reason: "value does not match regex pattern \"^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$\"",
+					}
+				}
+
+			}
+
+		case "secondary_color":
+
+			if m.GetSecondaryColor() != "" {
+
+				if !_Branding_SecondaryColor_Pattern.MatchString(m.GetSecondaryColor()) {
+					return BrandingValidationError{
+						field:  "secondary_color",
+						reason: "value does not match regex pattern \"^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$\"",
+					}
+				}
+
+			}
+
+		case "footer_links":
+
+			if len(m.GetFooterLinks()) > 20 {
+				return BrandingValidationError{
+					field:  "footer_links",
+					reason: "value must contain no more than 20 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetFooterLinks() {
+				_ = val
+
+				// no validation rules for FooterLinks[key]
+
+				if utf8.RuneCountInString(val) > 2048 {
+					return BrandingValidationError{
+						field:  fmt.Sprintf("footer_links[%v]", key),
+						reason: "value length must be at most 2048 runes",
+					}
+				}
+
+			}
+
+		case "support_url":
+
+			if utf8.RuneCountInString(m.GetSupportUrl()) > 2048 {
+				return BrandingValidationError{
+					field:  "support_url",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		case "documentation_url":
+
+			if utf8.RuneCountInString(m.GetDocumentationUrl()) > 2048 {
+				return BrandingValidationError{
+					field:  "documentation_url",
+					reason: "value length must be at most 2048 runes",
+				}
+			}
+
+		default:
+			return BrandingValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// BrandingValidationError is the validation error returned by
+// Branding.ValidateFields if the designated constraints aren't met.
+type BrandingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BrandingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BrandingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BrandingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BrandingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BrandingValidationError) ErrorName() string { return "BrandingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e BrandingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBranding.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BrandingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BrandingValidationError{}
+
+var _Branding_PrimaryColor_Pattern = regexp.MustCompile("^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$")
+
+var _Branding_SecondaryColor_Pattern = regexp.MustCompile("^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$")
+
+// ValidateFields checks the field values on SetBrandingRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetBrandingRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetBrandingRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "branding":
+
+			if m.GetBranding() == nil {
+				return SetBrandingRequestValidationError{
+					field:  "branding",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetBranding()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetBrandingRequestValidationError{
+						field:  "branding",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetBrandingRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetBrandingRequestValidationError is the validation error returned by
+// SetBrandingRequest.ValidateFields if the designated constraints aren't met.
+type SetBrandingRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetBrandingRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetBrandingRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetBrandingRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetBrandingRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetBrandingRequestValidationError) ErrorName() string {
+	return "SetBrandingRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetBrandingRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetBrandingRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetBrandingRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetBrandingRequestValidationError{}
+
 // ValidateFields checks the field values on AuthInfoResponse_APIKeyAccess with
 // the rules defined in the proto definition for this message. If any rules
 // are violated, an error is returned.
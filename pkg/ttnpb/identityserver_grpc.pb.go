@@ -34,7 +34,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EntityAccess_AuthInfo_FullMethodName = "/ttn.lorawan.v3.EntityAccess/AuthInfo"
+	EntityAccess_AuthInfo_FullMethodName            = "/ttn.lorawan.v3.EntityAccess/AuthInfo"
+	EntityAccess_CreateAPIKeyToken_FullMethodName   = "/ttn.lorawan.v3.EntityAccess/CreateAPIKeyToken"
+	EntityAccess_ExchangeAPIKeyToken_FullMethodName = "/ttn.lorawan.v3.EntityAccess/ExchangeAPIKeyToken"
 )
 
 // EntityAccessClient is the client API for EntityAccess service.
@@ -43,6 +45,13 @@ const (
 type EntityAccessClient interface {
 	// AuthInfo returns information about the authentication that is used on the request.
 	AuthInfo(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*AuthInfoResponse, error)
+	// Create a short-lived token for the API key that is used on the request.
+	// The token expires after the configured lifetime, or when the API key expires, whichever comes first.
+	CreateAPIKeyToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*APIKeyToken, error)
+	// Exchange an API key for a short-lived token with fewer rights, a shorter lifetime
+	// or restricted to a single end device, so that the token can be handed to processes that are less trusted
+	// than the holder of the API key. The subject token authenticates the exchange.
+	ExchangeAPIKeyToken(ctx context.Context, in *ExchangeAPIKeyTokenRequest, opts ...grpc.CallOption) (*ExchangeAPIKeyTokenResponse, error)
 }
 
 type entityAccessClient struct {
@@ -62,12 +71,37 @@ func (c *entityAccessClient) AuthInfo(ctx context.Context, in *emptypb.Empty, op
 	return out, nil
 }
 
+func (c *entityAccessClient) CreateAPIKeyToken(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*APIKeyToken, error) {
+	out := new(APIKeyToken)
+	err := c.cc.Invoke(ctx, EntityAccess_CreateAPIKeyToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityAccessClient) ExchangeAPIKeyToken(ctx context.Context, in *ExchangeAPIKeyTokenRequest, opts ...grpc.CallOption) (*ExchangeAPIKeyTokenResponse, error) {
+	out := new(ExchangeAPIKeyTokenResponse)
+	err := c.cc.Invoke(ctx, EntityAccess_ExchangeAPIKeyToken_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntityAccessServer is the server API for EntityAccess service.
 // All implementations must embed UnimplementedEntityAccessServer
 // for forward compatibility
 type EntityAccessServer interface {
 	// AuthInfo returns information about the authentication that is used on the request.
 	AuthInfo(context.Context, *emptypb.Empty) (*AuthInfoResponse, error)
+	// Create a short-lived token for the API key that is used on the request.
+	// The token expires after the configured lifetime, or when the API key expires, whichever comes first.
+	CreateAPIKeyToken(context.Context, *emptypb.Empty) (*APIKeyToken, error)
+	// Exchange an API key for a short-lived token with fewer rights, a shorter lifetime
+	// or restricted to a single end device, so that the token can be handed to processes that are less trusted
+	// than the holder of the API key. The subject token authenticates the exchange.
+	ExchangeAPIKeyToken(context.Context, *ExchangeAPIKeyTokenRequest) (*ExchangeAPIKeyTokenResponse, error)
 	mustEmbedUnimplementedEntityAccessServer()
 }
 
@@ -78,6 +112,12 @@ type UnimplementedEntityAccessServer struct {
 func (UnimplementedEntityAccessServer) AuthInfo(context.Context, *emptypb.Empty) (*AuthInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AuthInfo not implemented")
 }
+func (UnimplementedEntityAccessServer) CreateAPIKeyToken(context.Context, *emptypb.Empty) (*APIKeyToken, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAPIKeyToken not implemented")
+}
+func (UnimplementedEntityAccessServer) ExchangeAPIKeyToken(context.Context, *ExchangeAPIKeyTokenRequest) (*ExchangeAPIKeyTokenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExchangeAPIKeyToken not implemented")
+}
 func (UnimplementedEntityAccessServer) mustEmbedUnimplementedEntityAccessServer() {}
 
 // UnsafeEntityAccessServer may be embedded to opt out of forward compatibility for this service.
@@ -109,6 +149,42 @@ func _EntityAccess_AuthInfo_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityAccess_CreateAPIKeyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityAccessServer).CreateAPIKeyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityAccess_CreateAPIKeyToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityAccessServer).CreateAPIKeyToken(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityAccess_ExchangeAPIKeyToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangeAPIKeyTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityAccessServer).ExchangeAPIKeyToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityAccess_ExchangeAPIKeyToken_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityAccessServer).ExchangeAPIKeyToken(ctx, req.(*ExchangeAPIKeyTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EntityAccess_ServiceDesc is the grpc.ServiceDesc for EntityAccess service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -120,6 +196,14 @@ var EntityAccess_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AuthInfo",
 			Handler:    _EntityAccess_AuthInfo_Handler,
 		},
+		{
+			MethodName: "CreateAPIKeyToken",
+			Handler:    _EntityAccess_CreateAPIKeyToken_Handler,
+		},
+		{
+			MethodName: "ExchangeAPIKeyToken",
+			Handler:    _EntityAccess_ExchangeAPIKeyToken_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/identityserver.proto",
@@ -127,6 +211,8 @@ var EntityAccess_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	Is_GetConfiguration_FullMethodName = "/ttn.lorawan.v3.Is/GetConfiguration"
+	Is_GetBranding_FullMethodName      = "/ttn.lorawan.v3.Is/GetBranding"
+	Is_SetBranding_FullMethodName      = "/ttn.lorawan.v3.Is/SetBranding"
 )
 
 // IsClient is the client API for Is service.
@@ -136,6 +222,11 @@ type IsClient interface {
 	// Get the configuration of the Identity Server. The response is typically used
 	// to enable or disable features in a user interface.
 	GetConfiguration(ctx context.Context, in *GetIsConfigurationRequest, opts ...grpc.CallOption) (*GetIsConfigurationResponse, error)
+	// Get the branding of the Console and the Account app.
+	// If no branding is set, the default branding from the configuration is returned.
+	GetBranding(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Branding, error)
+	// Set the branding of the Console and the Account app. This requires admin privileges.
+	SetBranding(ctx context.Context, in *SetBrandingRequest, opts ...grpc.CallOption) (*Branding, error)
 }
 
 type isClient struct {
@@ -155,6 +246,24 @@ func (c *isClient) GetConfiguration(ctx context.Context, in *GetIsConfigurationR
 	return out, nil
 }
 
+func (c *isClient) GetBranding(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Branding, error) {
+	out := new(Branding)
+	err := c.cc.Invoke(ctx, Is_GetBranding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *isClient) SetBranding(ctx context.Context, in *SetBrandingRequest, opts ...grpc.CallOption) (*Branding, error) {
+	out := new(Branding)
+	err := c.cc.Invoke(ctx, Is_SetBranding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // IsServer is the server API for Is service.
 // All implementations must embed UnimplementedIsServer
 // for forward compatibility
@@ -162,6 +271,11 @@ type IsServer interface {
 	// Get the configuration of the Identity Server. The response is typically used
 	// to enable or disable features in a user interface.
 	GetConfiguration(context.Context, *GetIsConfigurationRequest) (*GetIsConfigurationResponse, error)
+	// Get the branding of the Console and the Account app.
+	// If no branding is set, the default branding from the configuration is returned.
+	GetBranding(context.Context, *emptypb.Empty) (*Branding, error)
+	// Set the branding of the Console and the Account app. This requires admin privileges.
+	SetBranding(context.Context, *SetBrandingRequest) (*Branding, error)
 	mustEmbedUnimplementedIsServer()
 }
 
@@ -172,6 +286,12 @@ type UnimplementedIsServer struct {
 func (UnimplementedIsServer) GetConfiguration(context.Context, *GetIsConfigurationRequest) (*GetIsConfigurationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfiguration not implemented")
 }
+func (UnimplementedIsServer) GetBranding(context.Context, *emptypb.Empty) (*Branding, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetBranding not implemented")
+}
+func (UnimplementedIsServer) SetBranding(context.Context, *SetBrandingRequest) (*Branding, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBranding not implemented")
+}
 func (UnimplementedIsServer) mustEmbedUnimplementedIsServer() {}
 
 // UnsafeIsServer may be embedded to opt out of forward compatibility for this service.
@@ -203,6 +323,42 @@ func _Is_GetConfiguration_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Is_GetBranding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IsServer).GetBranding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Is_GetBranding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IsServer).GetBranding(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Is_SetBranding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetBrandingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IsServer).SetBranding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Is_SetBranding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IsServer).SetBranding(ctx, req.(*SetBrandingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Is_ServiceDesc is the grpc.ServiceDesc for Is service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -214,6 +370,14 @@ var Is_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetConfiguration",
 			Handler:    _Is_GetConfiguration_Handler,
 		},
+		{
+			MethodName: "GetBranding",
+			Handler:    _Is_GetBranding_Handler,
+		},
+		{
+			MethodName: "SetBranding",
+			Handler:    _Is_SetBranding_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/identityserver.proto",
@@ -250,3 +250,97 @@ func (x *AuthInfoResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
 func (x *AuthInfoResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the ExchangeAPIKeyTokenRequest message to JSON.
+func (x *ExchangeAPIKeyTokenRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.GrantType != "" || s.HasField("grant_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("grant_type")
+		s.WriteString(x.GrantType)
+	}
+	if x.SubjectToken != "" || s.HasField("subject_token") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("subject_token")
+		s.WriteString(x.SubjectToken)
+	}
+	if x.SubjectTokenType != "" || s.HasField("subject_token_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("subject_token_type")
+		s.WriteString(x.SubjectTokenType)
+	}
+	if x.RequestedTokenType != "" || s.HasField("requested_token_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("requested_token_type")
+		s.WriteString(x.RequestedTokenType)
+	}
+	if x.Scope != "" || s.HasField("scope") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("scope")
+		s.WriteString(x.Scope)
+	}
+	if x.ExpiresIn != 0 || s.HasField("expires_in") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("expires_in")
+		s.WriteUint32(x.ExpiresIn)
+	}
+	if x.DeviceIds != nil || s.HasField("device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("device_ids")
+		x.DeviceIds.MarshalProtoJSON(s.WithField("device_ids"))
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExchangeAPIKeyTokenRequest to JSON.
+func (x *ExchangeAPIKeyTokenRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExchangeAPIKeyTokenRequest message from JSON.
+func (x *ExchangeAPIKeyTokenRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "grant_type", "grantType":
+			s.AddField("grant_type")
+			x.GrantType = s.ReadString()
+		case "subject_token", "subjectToken":
+			s.AddField("subject_token")
+			x.SubjectToken = s.ReadString()
+		case "subject_token_type", "subjectTokenType":
+			s.AddField("subject_token_type")
+			x.SubjectTokenType = s.ReadString()
+		case "requested_token_type", "requestedTokenType":
+			s.AddField("requested_token_type")
+			x.RequestedTokenType = s.ReadString()
+		case "scope":
+			s.AddField("scope")
+			x.Scope = s.ReadString()
+		case "expires_in", "expiresIn":
+			s.AddField("expires_in")
+			x.ExpiresIn = s.ReadUint32()
+		case "device_ids", "deviceIds":
+			if s.ReadNil() {
+				x.DeviceIds = nil
+				return
+			}
+			x.DeviceIds = &EndDeviceIdentifiers{}
+			x.DeviceIds.UnmarshalProtoJSON(s.WithField("device_ids", true))
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExchangeAPIKeyTokenRequest from JSON.
+func (x *ExchangeAPIKeyTokenRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -1803,7 +1803,7 @@ var file_ttn_lorawan_v3_joinserver_proto_rawDesc = []byte{
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c,
 	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69,
 	0x63, 0x65, 0x73, 0x2f, 0x7b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x64, 0x7d, 0x32,
-	0xb4, 0x01, 0x0a, 0x18, 0x4a, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42,
+	0xe8, 0x02, 0x0a, 0x18, 0x4a, 0x73, 0x45, 0x6e, 0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x42,
 	0x61, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12, 0x97, 0x01, 0x0a,
 	0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
 	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x44, 0x65,
@@ -1814,61 +1814,72 @@ var file_ttn_lorawan_v3_joinserver_proto_rawDesc = []byte{
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x32, 0xb4, 0x04, 0x0a, 0x24, 0x41, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79, 0x12,
-	0xb1, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f,
-	0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76,
-	0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74,
-	0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22,
-	0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6a, 0x73, 0x2f, 0x61, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x12, 0xb4, 0x01, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x74, 0x74,
-	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x53, 0x65, 0x74,
+	0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x12, 0xb1, 0x01, 0x0a, 0x06, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x12, 0x2c, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e,
+	0x76, 0x33, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e,
+	0x64, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x45, 0x6e, 0x64, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x4a,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x44, 0x3a, 0x01, 0x2a, 0x1a, 0x3f, 0x2f, 0x6a, 0x73, 0x2f, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70,
+	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x64, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2f, 0x62, 0x61, 0x74, 0x63, 0x68, 0x32, 0xb4, 0x04, 0x0a, 0x24, 0x41,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x79, 0x12, 0xb1, 0x01, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x37, 0x2e, 0x74, 0x74,
+	0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74,
 	0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77,
 	0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69,
-	0x6e, 0x67, 0x73, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x3a, 0x01, 0x2a, 0x22, 0x3a,
-	0x2f, 0x6a, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xa0, 0x01, 0x0a, 0x06, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61,
-	0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x70, 0x70,
-	0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02,
-	0x3c, 0x2a, 0x3a, 0x2f, 0x6a, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x32, 0xe8, 0x01,
-	0x0a, 0x02, 0x4a, 0x73, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x45,
-	0x55, 0x49, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70,
-	0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e,
-	0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x50, 0x72, 0x65, 0x66, 0x69,
-	0x78, 0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12, 0x15, 0x2f, 0x6a, 0x73,
-	0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x65, 0x75, 0x69, 0x5f, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78,
-	0x65, 0x73, 0x12, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-	0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a,
-	0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
-	0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x45,
-	0x55, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x16, 0x12, 0x14, 0x2f, 0x6a, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f,
-	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x65, 0x75, 0x69, 0x42, 0x31, 0x5a, 0x2f, 0x67, 0x6f, 0x2e, 0x74,
-	0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x2f, 0x76,
-	0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+	0x6e, 0x67, 0x73, 0x22, 0x42, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x12, 0x3a, 0x2f, 0x6a, 0x73,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61,
+	0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xb4, 0x01, 0x0a, 0x03, 0x53, 0x65, 0x74, 0x12,
+	0x37, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33,
+	0x2e, 0x53, 0x65, 0x74, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c,
+	0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x45, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x3f, 0x3a,
+	0x01, 0x2a, 0x22, 0x3a, 0x2f, 0x6a, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0xa0,
+	0x01, 0x0a, 0x06, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x12, 0x3a, 0x2e, 0x74, 0x74, 0x6e, 0x2e,
+	0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x63, 0x74, 0x69,
+	0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x42, 0x82,
+	0xd3, 0xe4, 0x93, 0x02, 0x3c, 0x2a, 0x3a, 0x2f, 0x6a, 0x73, 0x2f, 0x61, 0x70, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x7b, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x2e, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x7d, 0x2f, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x32, 0xe8, 0x01, 0x0a, 0x02, 0x4a, 0x73, 0x12, 0x6c, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4a,
+	0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1f, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72,
+	0x61, 0x77, 0x61, 0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x50,
+	0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x22, 0x1d, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x17, 0x12,
+	0x15, 0x2f, 0x6a, 0x73, 0x2f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x65, 0x75, 0x69, 0x5f, 0x70, 0x72,
+	0x65, 0x66, 0x69, 0x78, 0x65, 0x73, 0x12, 0x74, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66,
+	0x61, 0x75, 0x6c, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x12, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x1a, 0x29, 0x2e, 0x74, 0x74, 0x6e, 0x2e, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61,
+	0x6e, 0x2e, 0x76, 0x33, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4a,
+	0x6f, 0x69, 0x6e, 0x45, 0x55, 0x49, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1c,
+	0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x12, 0x14, 0x2f, 0x6a, 0x73, 0x2f, 0x64, 0x65, 0x66, 0x61,
+	0x75, 0x6c, 0x74, 0x5f, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x65, 0x75, 0x69, 0x42, 0x31, 0x5a, 0x2f,
+	0x67, 0x6f, 0x2e, 0x74, 0x68, 0x65, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x73, 0x2e, 0x6e, 0x65, 0x74,
+	0x77, 0x6f, 0x72, 0x6b, 0x2f, 0x6c, 0x6f, 0x72, 0x61, 0x77, 0x61, 0x6e, 0x2d, 0x73, 0x74, 0x61,
+	0x63, 0x6b, 0x2f, 0x76, 0x33, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x74, 0x74, 0x6e, 0x70, 0x62, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1915,9 +1926,11 @@ var file_ttn_lorawan_v3_joinserver_proto_goTypes = []interface{}{
 	(*GetEndDeviceRequest)(nil),                            // 27: ttn.lorawan.v3.GetEndDeviceRequest
 	(*SetEndDeviceRequest)(nil),                            // 28: ttn.lorawan.v3.SetEndDeviceRequest
 	(*BatchDeleteEndDevicesRequest)(nil),                   // 29: ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	(*emptypb.Empty)(nil),                                  // 30: google.protobuf.Empty
-	(*JoinResponse)(nil),                                   // 31: ttn.lorawan.v3.JoinResponse
-	(*EndDevice)(nil),                                      // 32: ttn.lorawan.v3.EndDevice
+	(*BatchUpdateEndDevicesRequest)(nil),                   // 30: ttn.lorawan.v3.BatchUpdateEndDevicesRequest
+	(*emptypb.Empty)(nil),                                  // 31: google.protobuf.Empty
+	(*JoinResponse)(nil),                                   // 32: ttn.lorawan.v3.JoinResponse
+	(*EndDevice)(nil),                                      // 33: ttn.lorawan.v3.EndDevice
+	(*BatchUpdateEndDevicesResponse)(nil),                  // 34: ttn.lorawan.v3.BatchUpdateEndDevicesResponse
 }
 var file_ttn_lorawan_v3_joinserver_proto_depIdxs = []int32{
 	19, // 0: ttn.lorawan.v3.NwkSKeysResponse.f_nwk_s_int_key:type_name -> ttn.lorawan.v3.KeyEnvelope
@@ -1964,35 +1977,37 @@ var file_ttn_lorawan_v3_joinserver_proto_depIdxs = []int32{
 	8,  // 41: ttn.lorawan.v3.JsEndDeviceRegistry.Provision:input_type -> ttn.lorawan.v3.ProvisionEndDevicesRequest
 	20, // 42: ttn.lorawan.v3.JsEndDeviceRegistry.Delete:input_type -> ttn.lorawan.v3.EndDeviceIdentifiers
 	29, // 43: ttn.lorawan.v3.JsEndDeviceBatchRegistry.Delete:input_type -> ttn.lorawan.v3.BatchDeleteEndDevicesRequest
-	10, // 44: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Get:input_type -> ttn.lorawan.v3.GetApplicationActivationSettingsRequest
-	11, // 45: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Set:input_type -> ttn.lorawan.v3.SetApplicationActivationSettingsRequest
-	12, // 46: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Delete:input_type -> ttn.lorawan.v3.DeleteApplicationActivationSettingsRequest
-	30, // 47: ttn.lorawan.v3.Js.GetJoinEUIPrefixes:input_type -> google.protobuf.Empty
-	30, // 48: ttn.lorawan.v3.Js.GetDefaultJoinEUI:input_type -> google.protobuf.Empty
-	31, // 49: ttn.lorawan.v3.NsJs.HandleJoin:output_type -> ttn.lorawan.v3.JoinResponse
-	1,  // 50: ttn.lorawan.v3.NsJs.GetNwkSKeys:output_type -> ttn.lorawan.v3.NwkSKeysResponse
-	2,  // 51: ttn.lorawan.v3.AsJs.GetAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
-	2,  // 52: ttn.lorawan.v3.AppJs.GetAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
-	4,  // 53: ttn.lorawan.v3.NetworkCryptoService.JoinRequestMIC:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
-	4,  // 54: ttn.lorawan.v3.NetworkCryptoService.JoinAcceptMIC:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
-	4,  // 55: ttn.lorawan.v3.NetworkCryptoService.EncryptJoinAccept:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
-	4,  // 56: ttn.lorawan.v3.NetworkCryptoService.EncryptRejoinAccept:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
-	1,  // 57: ttn.lorawan.v3.NetworkCryptoService.DeriveNwkSKeys:output_type -> ttn.lorawan.v3.NwkSKeysResponse
-	19, // 58: ttn.lorawan.v3.NetworkCryptoService.GetNwkKey:output_type -> ttn.lorawan.v3.KeyEnvelope
-	2,  // 59: ttn.lorawan.v3.ApplicationCryptoService.DeriveAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
-	19, // 60: ttn.lorawan.v3.ApplicationCryptoService.GetAppKey:output_type -> ttn.lorawan.v3.KeyEnvelope
-	32, // 61: ttn.lorawan.v3.JsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
-	32, // 62: ttn.lorawan.v3.JsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
-	32, // 63: ttn.lorawan.v3.JsEndDeviceRegistry.Provision:output_type -> ttn.lorawan.v3.EndDevice
-	30, // 64: ttn.lorawan.v3.JsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
-	30, // 65: ttn.lorawan.v3.JsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
-	9,  // 66: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Get:output_type -> ttn.lorawan.v3.ApplicationActivationSettings
-	9,  // 67: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Set:output_type -> ttn.lorawan.v3.ApplicationActivationSettings
-	30, // 68: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Delete:output_type -> google.protobuf.Empty
-	14, // 69: ttn.lorawan.v3.Js.GetJoinEUIPrefixes:output_type -> ttn.lorawan.v3.JoinEUIPrefixes
-	15, // 70: ttn.lorawan.v3.Js.GetDefaultJoinEUI:output_type -> ttn.lorawan.v3.GetDefaultJoinEUIResponse
-	49, // [49:71] is the sub-list for method output_type
-	27, // [27:49] is the sub-list for method input_type
+	30, // 44: ttn.lorawan.v3.JsEndDeviceBatchRegistry.Update:input_type -> ttn.lorawan.v3.BatchUpdateEndDevicesRequest
+	10, // 45: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Get:input_type -> ttn.lorawan.v3.GetApplicationActivationSettingsRequest
+	11, // 46: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Set:input_type -> ttn.lorawan.v3.SetApplicationActivationSettingsRequest
+	12, // 47: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Delete:input_type -> ttn.lorawan.v3.DeleteApplicationActivationSettingsRequest
+	31, // 48: ttn.lorawan.v3.Js.GetJoinEUIPrefixes:input_type -> google.protobuf.Empty
+	31, // 49: ttn.lorawan.v3.Js.GetDefaultJoinEUI:input_type -> google.protobuf.Empty
+	32, // 50: ttn.lorawan.v3.NsJs.HandleJoin:output_type -> ttn.lorawan.v3.JoinResponse
+	1,  // 51: ttn.lorawan.v3.NsJs.GetNwkSKeys:output_type -> ttn.lorawan.v3.NwkSKeysResponse
+	2,  // 52: ttn.lorawan.v3.AsJs.GetAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
+	2,  // 53: ttn.lorawan.v3.AppJs.GetAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
+	4,  // 54: ttn.lorawan.v3.NetworkCryptoService.JoinRequestMIC:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
+	4,  // 55: ttn.lorawan.v3.NetworkCryptoService.JoinAcceptMIC:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
+	4,  // 56: ttn.lorawan.v3.NetworkCryptoService.EncryptJoinAccept:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
+	4,  // 57: ttn.lorawan.v3.NetworkCryptoService.EncryptRejoinAccept:output_type -> ttn.lorawan.v3.CryptoServicePayloadResponse
+	1,  // 58: ttn.lorawan.v3.NetworkCryptoService.DeriveNwkSKeys:output_type -> ttn.lorawan.v3.NwkSKeysResponse
+	19, // 59: ttn.lorawan.v3.NetworkCryptoService.GetNwkKey:output_type -> ttn.lorawan.v3.KeyEnvelope
+	2,  // 60: ttn.lorawan.v3.ApplicationCryptoService.DeriveAppSKey:output_type -> ttn.lorawan.v3.AppSKeyResponse
+	19, // 61: ttn.lorawan.v3.ApplicationCryptoService.GetAppKey:output_type -> ttn.lorawan.v3.KeyEnvelope
+	33, // 62: ttn.lorawan.v3.JsEndDeviceRegistry.Get:output_type -> ttn.lorawan.v3.EndDevice
+	33, // 63: ttn.lorawan.v3.JsEndDeviceRegistry.Set:output_type -> ttn.lorawan.v3.EndDevice
+	33, // 64: ttn.lorawan.v3.JsEndDeviceRegistry.Provision:output_type -> ttn.lorawan.v3.EndDevice
+	31, // 65: ttn.lorawan.v3.JsEndDeviceRegistry.Delete:output_type -> google.protobuf.Empty
+	31, // 66: ttn.lorawan.v3.JsEndDeviceBatchRegistry.Delete:output_type -> google.protobuf.Empty
+	34, // 67: ttn.lorawan.v3.JsEndDeviceBatchRegistry.Update:output_type -> ttn.lorawan.v3.BatchUpdateEndDevicesResponse
+	9,  // 68: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Get:output_type -> ttn.lorawan.v3.ApplicationActivationSettings
+	9,  // 69: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Set:output_type -> ttn.lorawan.v3.ApplicationActivationSettings
+	31, // 70: ttn.lorawan.v3.ApplicationActivationSettingRegistry.Delete:output_type -> google.protobuf.Empty
+	14, // 71: ttn.lorawan.v3.Js.GetJoinEUIPrefixes:output_type -> ttn.lorawan.v3.JoinEUIPrefixes
+	15, // 72: ttn.lorawan.v3.Js.GetDefaultJoinEUI:output_type -> ttn.lorawan.v3.GetDefaultJoinEUIResponse
+	50, // [50:73] is the sub-list for method output_type
+	27, // [27:50] is the sub-list for method input_type
 	27, // [27:27] is the sub-list for extension type_name
 	27, // [27:27] is the sub-list for extension extendee
 	0,  // [0:27] is the sub-list for field type_name
@@ -1015,6 +1015,7 @@ var JsEndDeviceRegistry_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	JsEndDeviceBatchRegistry_Delete_FullMethodName = "/ttn.lorawan.v3.JsEndDeviceBatchRegistry/Delete"
+	JsEndDeviceBatchRegistry_Update_FullMethodName = "/ttn.lorawan.v3.JsEndDeviceBatchRegistry/Update"
 )
 
 // JsEndDeviceBatchRegistryClient is the client API for JsEndDeviceBatchRegistry service.
@@ -1025,6 +1026,9 @@ type JsEndDeviceBatchRegistryClient interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error)
 }
 
 type jsEndDeviceBatchRegistryClient struct {
@@ -1044,6 +1048,15 @@ func (c *jsEndDeviceBatchRegistryClient) Delete(ctx context.Context, in *BatchDe
 	return out, nil
 }
 
+func (c *jsEndDeviceBatchRegistryClient) Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error) {
+	out := new(BatchUpdateEndDevicesResponse)
+	err := c.cc.Invoke(ctx, JsEndDeviceBatchRegistry_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // JsEndDeviceBatchRegistryServer is the server API for JsEndDeviceBatchRegistry service.
 // All implementations must embed UnimplementedJsEndDeviceBatchRegistryServer
 // for forward compatibility
@@ -1052,6 +1065,9 @@ type JsEndDeviceBatchRegistryServer interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error)
 	mustEmbedUnimplementedJsEndDeviceBatchRegistryServer()
 }
 
@@ -1062,6 +1078,9 @@ type UnimplementedJsEndDeviceBatchRegistryServer struct {
 func (UnimplementedJsEndDeviceBatchRegistryServer) Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedJsEndDeviceBatchRegistryServer) Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedJsEndDeviceBatchRegistryServer) mustEmbedUnimplementedJsEndDeviceBatchRegistryServer() {
 }
 
@@ -1094,6 +1113,24 @@ func _JsEndDeviceBatchRegistry_Delete_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _JsEndDeviceBatchRegistry_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(JsEndDeviceBatchRegistryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: JsEndDeviceBatchRegistry_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(JsEndDeviceBatchRegistryServer).Update(ctx, req.(*BatchUpdateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // JsEndDeviceBatchRegistry_ServiceDesc is the grpc.ServiceDesc for JsEndDeviceBatchRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1105,6 +1142,10 @@ var JsEndDeviceBatchRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _JsEndDeviceBatchRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _JsEndDeviceBatchRegistry_Update_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/joinserver.proto",
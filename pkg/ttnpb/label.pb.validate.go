@@ -0,0 +1,544 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on Labels with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Labels) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = LabelsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "labels":
+			// no validation rules for Labels
+		default:
+			return LabelsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// LabelsValidationError is the validation error returned by
+// Labels.ValidateFields if the designated constraints aren't met.
+type LabelsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LabelsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LabelsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LabelsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LabelsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LabelsValidationError) ErrorName() string { return "LabelsValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LabelsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLabels.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LabelsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LabelsValidationError{}
+
+// ValidateFields checks the field values on GetLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GetLabelsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetLabelsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return GetLabelsRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetLabelsRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetLabelsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetLabelsRequestValidationError is the validation error returned by
+// GetLabelsRequest.ValidateFields if the designated constraints aren't met.
+type GetLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLabelsRequestValidationError) ErrorName() string { return "GetLabelsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GetLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLabelsRequestValidationError{}
+
+// ValidateFields checks the field values on SetLabelsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetLabelsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetLabelsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return SetLabelsRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetLabelsRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "labels":
+			// no validation rules for Labels
+		default:
+			return SetLabelsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetLabelsRequestValidationError is the validation error returned by
+// SetLabelsRequest.ValidateFields if the designated constraints aren't met.
+type SetLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetLabelsRequestValidationError) ErrorName() string { return "SetLabelsRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetLabelsRequestValidationError{}
+
+// ValidateFields checks the field values on SearchEntitiesByLabelsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SearchEntitiesByLabelsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SearchEntitiesByLabelsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "selector":
+
+			if l := utf8.RuneCountInString(m.GetSelector()); l < 1 || l > 1024 {
+				return SearchEntitiesByLabelsRequestValidationError{
+					field:  "selector",
+					reason: "value length must be between 1 and 1024 runes, inclusive",
+				}
+			}
+
+		case "entity_types":
+
+			_SearchEntitiesByLabelsRequest_EntityTypes_Unique := make(map[string]struct{}, len(m.GetEntityTypes()))
+
+			for idx, item := range m.GetEntityTypes() {
+				_, _ = idx, item
+
+				if _, exists := _SearchEntitiesByLabelsRequest_EntityTypes_Unique[item]; exists {
+					return SearchEntitiesByLabelsRequestValidationError{
+						field:  fmt.Sprintf("entity_types[%v]", idx),
+						reason: "repeated value must contain unique items",
+					}
+				} else {
+					_SearchEntitiesByLabelsRequest_EntityTypes_Unique[item] = struct{}{}
+				}
+
+				if _, ok := _SearchEntitiesByLabelsRequest_EntityTypes_InLookup[item]; !ok {
+					return SearchEntitiesByLabelsRequestValidationError{
+						field:  fmt.Sprintf("entity_types[%v]", idx),
+						reason: "value must be in list [applications clients end_devices gateways organizations users]",
+					}
+				}
+
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return SearchEntitiesByLabelsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return SearchEntitiesByLabelsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SearchEntitiesByLabelsRequestValidationError is the validation error
+// returned by SearchEntitiesByLabelsRequest.ValidateFields if the designated
+// constraints aren't met.
+type SearchEntitiesByLabelsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEntitiesByLabelsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEntitiesByLabelsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEntitiesByLabelsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEntitiesByLabelsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEntitiesByLabelsRequestValidationError) ErrorName() string {
+	return "SearchEntitiesByLabelsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEntitiesByLabelsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEntitiesByLabelsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEntitiesByLabelsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEntitiesByLabelsRequestValidationError{}
+
+var _SearchEntitiesByLabelsRequest_EntityTypes_InLookup = map[string]struct{}{
+	"applications":  {},
+	"clients":       {},
+	"end_devices":   {},
+	"gateways":      {},
+	"organizations": {},
+	"users":         {},
+}
+
+// ValidateFields checks the field values on LabeledEntities with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *LabeledEntities) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = LabeledEntitiesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entities":
+
+			for idx, item := range m.GetEntities() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return LabeledEntitiesValidationError{
+							field:  fmt.Sprintf("entities[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return LabeledEntitiesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// LabeledEntitiesValidationError is the validation error returned by
+// LabeledEntities.ValidateFields if the designated constraints aren't met.
+type LabeledEntitiesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LabeledEntitiesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LabeledEntitiesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LabeledEntitiesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LabeledEntitiesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LabeledEntitiesValidationError) ErrorName() string { return "LabeledEntitiesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LabeledEntitiesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLabeledEntities.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LabeledEntitiesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LabeledEntitiesValidationError{}
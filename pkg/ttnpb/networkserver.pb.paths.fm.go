@@ -32,3 +32,74 @@ var GetDeviceAdressPrefixesResponseFieldPathsNested = []string{
 var GetDeviceAdressPrefixesResponseFieldPathsTopLevel = []string{
 	"dev_addr_prefixes",
 }
+var DecodeNsPHYPayloadRequestFieldPathsNested = []string{
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"phy_payload",
+}
+
+var DecodeNsPHYPayloadRequestFieldPathsTopLevel = []string{
+	"end_device_ids",
+	"phy_payload",
+}
+var DecodeNsPHYPayloadResponseFieldPathsNested = []string{
+	"computed_mic",
+	"dev_addr",
+	"f_cnt",
+	"f_opts",
+	"f_port",
+	"frm_payload",
+	"full_f_cnt",
+	"lorawan_version",
+	"m_type",
+	"mac_commands",
+	"major",
+	"mic_valid",
+	"pending_session",
+	"received_mic",
+}
+
+var DecodeNsPHYPayloadResponseFieldPathsTopLevel = []string{
+	"computed_mic",
+	"dev_addr",
+	"f_cnt",
+	"f_opts",
+	"f_port",
+	"frm_payload",
+	"full_f_cnt",
+	"lorawan_version",
+	"m_type",
+	"mac_commands",
+	"major",
+	"mic_valid",
+	"pending_session",
+	"received_mic",
+}
+var AsNsSimulateUplinkRequestFieldPathsNested = []string{
+	"confirmed",
+	"dev_addr",
+	"end_device_ids",
+	"end_device_ids.application_ids",
+	"end_device_ids.application_ids.application_id",
+	"end_device_ids.dev_addr",
+	"end_device_ids.dev_eui",
+	"end_device_ids.device_id",
+	"end_device_ids.join_eui",
+	"f_cnt",
+	"f_port",
+	"frm_payload",
+}
+
+var AsNsSimulateUplinkRequestFieldPathsTopLevel = []string{
+	"confirmed",
+	"dev_addr",
+	"end_device_ids",
+	"f_cnt",
+	"f_port",
+	"frm_payload",
+}
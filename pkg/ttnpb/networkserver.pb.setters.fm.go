@@ -93,3 +93,274 @@ func (dst *GetDeviceAdressPrefixesResponse) SetFields(src *GetDeviceAdressPrefix
 	}
 	return nil
 }
+
+func (dst *DecodeNsPHYPayloadRequest) SetFields(src *DecodeNsPHYPayloadRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "phy_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'phy_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PhyPayload = src.PhyPayload
+			} else {
+				dst.PhyPayload = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *DecodeNsPHYPayloadResponse) SetFields(src *DecodeNsPHYPayloadResponse, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "m_type":
+			if len(subs) > 0 {
+				return fmt.Errorf("'m_type' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MType = src.MType
+			} else {
+				dst.MType = 0
+			}
+		case "major":
+			if len(subs) > 0 {
+				return fmt.Errorf("'major' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Major = src.Major
+			} else {
+				dst.Major = 0
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				dst.DevAddr = nil
+			}
+		case "pending_session":
+			if len(subs) > 0 {
+				return fmt.Errorf("'pending_session' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.PendingSession = src.PendingSession
+			} else {
+				var zero bool
+				dst.PendingSession = zero
+			}
+		case "lorawan_version":
+			if len(subs) > 0 {
+				return fmt.Errorf("'lorawan_version' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.LorawanVersion = src.LorawanVersion
+			} else {
+				dst.LorawanVersion = 0
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "full_f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'full_f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FullFCnt = src.FullFCnt
+			} else {
+				var zero uint32
+				dst.FullFCnt = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "received_mic":
+			if len(subs) > 0 {
+				return fmt.Errorf("'received_mic' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ReceivedMic = src.ReceivedMic
+			} else {
+				dst.ReceivedMic = nil
+			}
+		case "computed_mic":
+			if len(subs) > 0 {
+				return fmt.Errorf("'computed_mic' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.ComputedMic = src.ComputedMic
+			} else {
+				dst.ComputedMic = nil
+			}
+		case "mic_valid":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mic_valid' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MicValid = src.MicValid
+			} else {
+				var zero bool
+				dst.MicValid = zero
+			}
+		case "f_opts":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_opts' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FOpts = src.FOpts
+			} else {
+				dst.FOpts = nil
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrmPayload = src.FrmPayload
+			} else {
+				dst.FrmPayload = nil
+			}
+		case "mac_commands":
+			if len(subs) > 0 {
+				return fmt.Errorf("'mac_commands' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MacCommands = src.MacCommands
+			} else {
+				dst.MacCommands = nil
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *AsNsSimulateUplinkRequest) SetFields(src *AsNsSimulateUplinkRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "end_device_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *EndDeviceIdentifiers
+				if (src == nil || src.EndDeviceIds == nil) && dst.EndDeviceIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.EndDeviceIds
+				}
+				if dst.EndDeviceIds != nil {
+					newDst = dst.EndDeviceIds
+				} else {
+					newDst = &EndDeviceIdentifiers{}
+					dst.EndDeviceIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.EndDeviceIds = src.EndDeviceIds
+				} else {
+					dst.EndDeviceIds = nil
+				}
+			}
+		case "dev_addr":
+			if len(subs) > 0 {
+				return fmt.Errorf("'dev_addr' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.DevAddr = src.DevAddr
+			} else {
+				dst.DevAddr = nil
+			}
+		case "f_cnt":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_cnt' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FCnt = src.FCnt
+			} else {
+				var zero uint32
+				dst.FCnt = zero
+			}
+		case "f_port":
+			if len(subs) > 0 {
+				return fmt.Errorf("'f_port' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FPort = src.FPort
+			} else {
+				var zero uint32
+				dst.FPort = zero
+			}
+		case "frm_payload":
+			if len(subs) > 0 {
+				return fmt.Errorf("'frm_payload' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FrmPayload = src.FrmPayload
+			} else {
+				dst.FrmPayload = nil
+			}
+		case "confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Confirmed = src.Confirmed
+			} else {
+				var zero bool
+				dst.Confirmed = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -413,3 +413,370 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetDeviceAdressPrefixesResponseValidationError{}
+
+// ValidateFields checks the field values on DecodeNsPHYPayloadRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DecodeNsPHYPayloadRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeNsPHYPayloadRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return DecodeNsPHYPayloadRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DecodeNsPHYPayloadRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "phy_payload":
+
+			if l := len(m.GetPhyPayload()); l < 12 || l > 256 {
+				return DecodeNsPHYPayloadRequestValidationError{
+					field:  "phy_payload",
+					reason: "value length must be between 12 and 256 bytes, inclusive",
+				}
+			}
+
+		default:
+			return DecodeNsPHYPayloadRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeNsPHYPayloadRequestValidationError is the validation error returned by
+// DecodeNsPHYPayloadRequest.ValidateFields if the designated constraints
+// aren't met.
+type DecodeNsPHYPayloadRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeNsPHYPayloadRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeNsPHYPayloadRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeNsPHYPayloadRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeNsPHYPayloadRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeNsPHYPayloadRequestValidationError) ErrorName() string {
+	return "DecodeNsPHYPayloadRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeNsPHYPayloadRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeNsPHYPayloadRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeNsPHYPayloadRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeNsPHYPayloadRequestValidationError{}
+
+// ValidateFields checks the field values on DecodeNsPHYPayloadResponse with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *DecodeNsPHYPayloadResponse) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DecodeNsPHYPayloadResponseFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "m_type":
+			// no validation rules for MType
+		case "major":
+			// no validation rules for Major
+		case "dev_addr":
+			// no validation rules for DevAddr
+		case "pending_session":
+			// no validation rules for PendingSession
+		case "lorawan_version":
+			// no validation rules for LorawanVersion
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "full_f_cnt":
+			// no validation rules for FullFCnt
+		case "f_port":
+			// no validation rules for FPort
+		case "received_mic":
+			// no validation rules for ReceivedMic
+		case "computed_mic":
+			// no validation rules for ComputedMic
+		case "mic_valid":
+			// no validation rules for MicValid
+		case "f_opts":
+			// no validation rules for FOpts
+		case "frm_payload":
+			// no validation rules for FrmPayload
+		case "mac_commands":
+
+			for idx, item := range m.GetMacCommands() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return DecodeNsPHYPayloadResponseValidationError{
+							field:  fmt.Sprintf("mac_commands[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return DecodeNsPHYPayloadResponseValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DecodeNsPHYPayloadResponseValidationError is the validation error returned
+// by DecodeNsPHYPayloadResponse.ValidateFields if the designated constraints
+// aren't met.
+type DecodeNsPHYPayloadResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DecodeNsPHYPayloadResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DecodeNsPHYPayloadResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DecodeNsPHYPayloadResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DecodeNsPHYPayloadResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DecodeNsPHYPayloadResponseValidationError) ErrorName() string {
+	return "DecodeNsPHYPayloadResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DecodeNsPHYPayloadResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDecodeNsPHYPayloadResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DecodeNsPHYPayloadResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DecodeNsPHYPayloadResponseValidationError{}
+
+// ValidateFields checks the field values on AsNsSimulateUplinkRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *AsNsSimulateUplinkRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = AsNsSimulateUplinkRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "end_device_ids":
+
+			if m.GetEndDeviceIds() == nil {
+				return AsNsSimulateUplinkRequestValidationError{
+					field:  "end_device_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEndDeviceIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return AsNsSimulateUplinkRequestValidationError{
+						field:  "end_device_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "dev_addr":
+
+			if len(m.GetDevAddr()) != 4 {
+				return AsNsSimulateUplinkRequestValidationError{
+					field:  "dev_addr",
+					reason: "value length must be 4 bytes",
+				}
+			}
+
+		case "f_cnt":
+			// no validation rules for FCnt
+		case "f_port":
+
+			if val := m.GetFPort(); val < 1 || val > 223 {
+				return AsNsSimulateUplinkRequestValidationError{
+					field:  "f_port",
+					reason: "value must be inside range [1, 223]",
+				}
+			}
+
+		case "frm_payload":
+
+			if len(m.GetFrmPayload()) > 250 {
+				return AsNsSimulateUplinkRequestValidationError{
+					field:  "frm_payload",
+					reason: "value length must be at most 250 bytes",
+				}
+			}
+
+		case "confirmed":
+			// no validation rules for Confirmed
+		default:
+			return AsNsSimulateUplinkRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// AsNsSimulateUplinkRequestValidationError is the validation error returned by
+// AsNsSimulateUplinkRequest.ValidateFields if the designated constraints
+// aren't met.
+type AsNsSimulateUplinkRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AsNsSimulateUplinkRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AsNsSimulateUplinkRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AsNsSimulateUplinkRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AsNsSimulateUplinkRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AsNsSimulateUplinkRequestValidationError) ErrorName() string {
+	return "AsNsSimulateUplinkRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AsNsSimulateUplinkRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAsNsSimulateUplinkRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AsNsSimulateUplinkRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AsNsSimulateUplinkRequestValidationError{}
@@ -38,6 +38,7 @@ const (
 	Ns_GetDefaultMACSettings_FullMethodName    = "/ttn.lorawan.v3.Ns/GetDefaultMACSettings"
 	Ns_GetNetID_FullMethodName                 = "/ttn.lorawan.v3.Ns/GetNetID"
 	Ns_GetDeviceAddressPrefixes_FullMethodName = "/ttn.lorawan.v3.Ns/GetDeviceAddressPrefixes"
+	Ns_DecodePHYPayload_FullMethodName         = "/ttn.lorawan.v3.Ns/DecodePHYPayload"
 )
 
 // NsClient is the client API for Ns service.
@@ -50,6 +51,10 @@ type NsClient interface {
 	GetDefaultMACSettings(ctx context.Context, in *GetDefaultMACSettingsRequest, opts ...grpc.CallOption) (*MACSettings, error)
 	GetNetID(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetNetIDResponse, error)
 	GetDeviceAddressPrefixes(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetDeviceAdressPrefixesResponse, error)
+	// DecodePHYPayload decodes the data frame PHYPayload of the end device with its network session keys,
+	// so that captured uplinks and downlinks can be inspected. The application payload is not decrypted.
+	// This requires the right to read the end device keys.
+	DecodePHYPayload(ctx context.Context, in *DecodeNsPHYPayloadRequest, opts ...grpc.CallOption) (*DecodeNsPHYPayloadResponse, error)
 }
 
 type nsClient struct {
@@ -96,6 +101,15 @@ func (c *nsClient) GetDeviceAddressPrefixes(ctx context.Context, in *emptypb.Emp
 	return out, nil
 }
 
+func (c *nsClient) DecodePHYPayload(ctx context.Context, in *DecodeNsPHYPayloadRequest, opts ...grpc.CallOption) (*DecodeNsPHYPayloadResponse, error) {
+	out := new(DecodeNsPHYPayloadResponse)
+	err := c.cc.Invoke(ctx, Ns_DecodePHYPayload_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsServer is the server API for Ns service.
 // All implementations must embed UnimplementedNsServer
 // for forward compatibility
@@ -106,6 +120,10 @@ type NsServer interface {
 	GetDefaultMACSettings(context.Context, *GetDefaultMACSettingsRequest) (*MACSettings, error)
 	GetNetID(context.Context, *emptypb.Empty) (*GetNetIDResponse, error)
 	GetDeviceAddressPrefixes(context.Context, *emptypb.Empty) (*GetDeviceAdressPrefixesResponse, error)
+	// DecodePHYPayload decodes the data frame PHYPayload of the end device with its network session keys,
+	// so that captured uplinks and downlinks can be inspected. The application payload is not decrypted.
+	// This requires the right to read the end device keys.
+	DecodePHYPayload(context.Context, *DecodeNsPHYPayloadRequest) (*DecodeNsPHYPayloadResponse, error)
 	mustEmbedUnimplementedNsServer()
 }
 
@@ -125,6 +143,9 @@ func (UnimplementedNsServer) GetNetID(context.Context, *emptypb.Empty) (*GetNetI
 func (UnimplementedNsServer) GetDeviceAddressPrefixes(context.Context, *emptypb.Empty) (*GetDeviceAdressPrefixesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetDeviceAddressPrefixes not implemented")
 }
+func (UnimplementedNsServer) DecodePHYPayload(context.Context, *DecodeNsPHYPayloadRequest) (*DecodeNsPHYPayloadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecodePHYPayload not implemented")
+}
 func (UnimplementedNsServer) mustEmbedUnimplementedNsServer() {}
 
 // UnsafeNsServer may be embedded to opt out of forward compatibility for this service.
@@ -210,6 +231,24 @@ func _Ns_GetDeviceAddressPrefixes_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Ns_DecodePHYPayload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecodeNsPHYPayloadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsServer).DecodePHYPayload(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Ns_DecodePHYPayload_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsServer).DecodePHYPayload(ctx, req.(*DecodeNsPHYPayloadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Ns_ServiceDesc is the grpc.ServiceDesc for Ns service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -233,6 +272,10 @@ var Ns_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetDeviceAddressPrefixes",
 			Handler:    _Ns_GetDeviceAddressPrefixes_Handler,
 		},
+		{
+			MethodName: "DecodePHYPayload",
+			Handler:    _Ns_DecodePHYPayload_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
@@ -242,6 +285,7 @@ const (
 	AsNs_DownlinkQueueReplace_FullMethodName = "/ttn.lorawan.v3.AsNs/DownlinkQueueReplace"
 	AsNs_DownlinkQueuePush_FullMethodName    = "/ttn.lorawan.v3.AsNs/DownlinkQueuePush"
 	AsNs_DownlinkQueueList_FullMethodName    = "/ttn.lorawan.v3.AsNs/DownlinkQueueList"
+	AsNs_SimulateUplink_FullMethodName       = "/ttn.lorawan.v3.AsNs/SimulateUplink"
 )
 
 // AsNsClient is the client API for AsNs service.
@@ -257,6 +301,9 @@ type AsNsClient interface {
 	DownlinkQueuePush(ctx context.Context, in *DownlinkQueueRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// List the items currently in the downlink queue.
 	DownlinkQueueList(ctx context.Context, in *EndDeviceIdentifiers, opts ...grpc.CallOption) (*ApplicationDownlinks, error)
+	// Simulate an uplink message with an FRMPayload that is encrypted by the Application Server.
+	// The Network Server computes the MIC and handles the uplink message as if it was received by a gateway.
+	SimulateUplink(ctx context.Context, in *AsNsSimulateUplinkRequest, opts ...grpc.CallOption) (*UplinkMessage, error)
 }
 
 type asNsClient struct {
@@ -294,6 +341,15 @@ func (c *asNsClient) DownlinkQueueList(ctx context.Context, in *EndDeviceIdentif
 	return out, nil
 }
 
+func (c *asNsClient) SimulateUplink(ctx context.Context, in *AsNsSimulateUplinkRequest, opts ...grpc.CallOption) (*UplinkMessage, error) {
+	out := new(UplinkMessage)
+	err := c.cc.Invoke(ctx, AsNs_SimulateUplink_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AsNsServer is the server API for AsNs service.
 // All implementations must embed UnimplementedAsNsServer
 // for forward compatibility
@@ -307,6 +363,9 @@ type AsNsServer interface {
 	DownlinkQueuePush(context.Context, *DownlinkQueueRequest) (*emptypb.Empty, error)
 	// List the items currently in the downlink queue.
 	DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error)
+	// Simulate an uplink message with an FRMPayload that is encrypted by the Application Server.
+	// The Network Server computes the MIC and handles the uplink message as if it was received by a gateway.
+	SimulateUplink(context.Context, *AsNsSimulateUplinkRequest) (*UplinkMessage, error)
 	mustEmbedUnimplementedAsNsServer()
 }
 
@@ -323,6 +382,9 @@ func (UnimplementedAsNsServer) DownlinkQueuePush(context.Context, *DownlinkQueue
 func (UnimplementedAsNsServer) DownlinkQueueList(context.Context, *EndDeviceIdentifiers) (*ApplicationDownlinks, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DownlinkQueueList not implemented")
 }
+func (UnimplementedAsNsServer) SimulateUplink(context.Context, *AsNsSimulateUplinkRequest) (*UplinkMessage, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateUplink not implemented")
+}
 func (UnimplementedAsNsServer) mustEmbedUnimplementedAsNsServer() {}
 
 // UnsafeAsNsServer may be embedded to opt out of forward compatibility for this service.
@@ -390,6 +452,24 @@ func _AsNs_DownlinkQueueList_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AsNs_SimulateUplink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AsNsSimulateUplinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AsNsServer).SimulateUplink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AsNs_SimulateUplink_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AsNsServer).SimulateUplink(ctx, req.(*AsNsSimulateUplinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // AsNs_ServiceDesc is the grpc.ServiceDesc for AsNs service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -409,6 +489,10 @@ var AsNs_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DownlinkQueueList",
 			Handler:    _AsNs_DownlinkQueueList_Handler,
 		},
+		{
+			MethodName: "SimulateUplink",
+			Handler:    _AsNs_SimulateUplink_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
@@ -760,6 +844,7 @@ var NsEndDeviceRegistry_ServiceDesc = grpc.ServiceDesc{
 
 const (
 	NsEndDeviceBatchRegistry_Delete_FullMethodName = "/ttn.lorawan.v3.NsEndDeviceBatchRegistry/Delete"
+	NsEndDeviceBatchRegistry_Update_FullMethodName = "/ttn.lorawan.v3.NsEndDeviceBatchRegistry/Update"
 )
 
 // NsEndDeviceBatchRegistryClient is the client API for NsEndDeviceBatchRegistry service.
@@ -770,6 +855,9 @@ type NsEndDeviceBatchRegistryClient interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(ctx context.Context, in *BatchDeleteEndDevicesRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error)
 }
 
 type nsEndDeviceBatchRegistryClient struct {
@@ -789,6 +877,15 @@ func (c *nsEndDeviceBatchRegistryClient) Delete(ctx context.Context, in *BatchDe
 	return out, nil
 }
 
+func (c *nsEndDeviceBatchRegistryClient) Update(ctx context.Context, in *BatchUpdateEndDevicesRequest, opts ...grpc.CallOption) (*BatchUpdateEndDevicesResponse, error) {
+	out := new(BatchUpdateEndDevicesResponse)
+	err := c.cc.Invoke(ctx, NsEndDeviceBatchRegistry_Update_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NsEndDeviceBatchRegistryServer is the server API for NsEndDeviceBatchRegistry service.
 // All implementations must embed UnimplementedNsEndDeviceBatchRegistryServer
 // for forward compatibility
@@ -797,6 +894,9 @@ type NsEndDeviceBatchRegistryServer interface {
 	// This operation is atomic; either all devices are deleted or none.
 	// Devices not found are skipped and no error is returned.
 	Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error)
+	// Apply the same field mask update to a list of devices within the same application.
+	// When the update of a device fails, the devices that are already updated are reverted.
+	Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error)
 	mustEmbedUnimplementedNsEndDeviceBatchRegistryServer()
 }
 
@@ -807,6 +907,9 @@ type UnimplementedNsEndDeviceBatchRegistryServer struct {
 func (UnimplementedNsEndDeviceBatchRegistryServer) Delete(context.Context, *BatchDeleteEndDevicesRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (UnimplementedNsEndDeviceBatchRegistryServer) Update(context.Context, *BatchUpdateEndDevicesRequest) (*BatchUpdateEndDevicesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
 func (UnimplementedNsEndDeviceBatchRegistryServer) mustEmbedUnimplementedNsEndDeviceBatchRegistryServer() {
 }
 
@@ -839,6 +942,24 @@ func _NsEndDeviceBatchRegistry_Delete_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NsEndDeviceBatchRegistry_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchUpdateEndDevicesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NsEndDeviceBatchRegistryServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NsEndDeviceBatchRegistry_Update_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NsEndDeviceBatchRegistryServer).Update(ctx, req.(*BatchUpdateEndDevicesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NsEndDeviceBatchRegistry_ServiceDesc is the grpc.ServiceDesc for NsEndDeviceBatchRegistry service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -850,6 +971,10 @@ var NsEndDeviceBatchRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _NsEndDeviceBatchRegistry_Delete_Handler,
 		},
+		{
+			MethodName: "Update",
+			Handler:    _NsEndDeviceBatchRegistry_Update_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/networkserver.proto",
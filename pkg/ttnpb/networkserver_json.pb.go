@@ -190,3 +190,310 @@ func (x *GetDeviceAdressPrefixesResponse) UnmarshalProtoJSON(s *jsonplugin.Unmar
 func (x *GetDeviceAdressPrefixesResponse) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the DecodeNsPHYPayloadRequest message to JSON.
+func (x *DecodeNsPHYPayloadRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.PhyPayload) > 0 || s.HasField("phy_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("phy_payload")
+		s.WriteBytes(x.PhyPayload)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DecodeNsPHYPayloadRequest to JSON.
+func (x *DecodeNsPHYPayloadRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DecodeNsPHYPayloadRequest message from JSON.
+func (x *DecodeNsPHYPayloadRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "phy_payload", "phyPayload":
+			s.AddField("phy_payload")
+			x.PhyPayload = s.ReadBytes()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DecodeNsPHYPayloadRequest from JSON.
+func (x *DecodeNsPHYPayloadRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the DecodeNsPHYPayloadResponse message to JSON.
+func (x *DecodeNsPHYPayloadResponse) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.MType != 0 || s.HasField("m_type") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("m_type")
+		x.MType.MarshalProtoJSON(s)
+	}
+	if x.Major != 0 || s.HasField("major") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("major")
+		x.Major.MarshalProtoJSON(s)
+	}
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		types.MarshalHEXBytes(s.WithField("dev_addr"), x.DevAddr)
+	}
+	if x.PendingSession || s.HasField("pending_session") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("pending_session")
+		s.WriteBool(x.PendingSession)
+	}
+	if x.LorawanVersion != 0 || s.HasField("lorawan_version") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("lorawan_version")
+		x.LorawanVersion.MarshalProtoJSON(s)
+	}
+	if x.FCnt != 0 || s.HasField("f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_cnt")
+		s.WriteUint32(x.FCnt)
+	}
+	if x.FullFCnt != 0 || s.HasField("full_f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("full_f_cnt")
+		s.WriteUint32(x.FullFCnt)
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if len(x.ReceivedMic) > 0 || s.HasField("received_mic") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("received_mic")
+		s.WriteBytes(x.ReceivedMic)
+	}
+	if len(x.ComputedMic) > 0 || s.HasField("computed_mic") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("computed_mic")
+		s.WriteBytes(x.ComputedMic)
+	}
+	if x.MicValid || s.HasField("mic_valid") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mic_valid")
+		s.WriteBool(x.MicValid)
+	}
+	if len(x.FOpts) > 0 || s.HasField("f_opts") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_opts")
+		s.WriteBytes(x.FOpts)
+	}
+	if len(x.FrmPayload) > 0 || s.HasField("frm_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frm_payload")
+		s.WriteBytes(x.FrmPayload)
+	}
+	if len(x.MacCommands) > 0 || s.HasField("mac_commands") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("mac_commands")
+		s.WriteArrayStart()
+		var wroteElement bool
+		for _, element := range x.MacCommands {
+			s.WriteMoreIf(&wroteElement)
+			element.MarshalProtoJSON(s.WithField("mac_commands"))
+		}
+		s.WriteArrayEnd()
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the DecodeNsPHYPayloadResponse to JSON.
+func (x *DecodeNsPHYPayloadResponse) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the DecodeNsPHYPayloadResponse message from JSON.
+func (x *DecodeNsPHYPayloadResponse) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "m_type", "mType":
+			s.AddField("m_type")
+			x.MType.UnmarshalProtoJSON(s)
+		case "major":
+			s.AddField("major")
+			x.Major.UnmarshalProtoJSON(s)
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr = types.Unmarshal4Bytes(s.WithField("dev_addr", false))
+		case "pending_session", "pendingSession":
+			s.AddField("pending_session")
+			x.PendingSession = s.ReadBool()
+		case "lorawan_version", "lorawanVersion":
+			s.AddField("lorawan_version")
+			x.LorawanVersion.UnmarshalProtoJSON(s)
+		case "f_cnt", "fCnt":
+			s.AddField("f_cnt")
+			x.FCnt = s.ReadUint32()
+		case "full_f_cnt", "fullFCnt":
+			s.AddField("full_f_cnt")
+			x.FullFCnt = s.ReadUint32()
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "received_mic", "receivedMic":
+			s.AddField("received_mic")
+			x.ReceivedMic = s.ReadBytes()
+		case "computed_mic", "computedMic":
+			s.AddField("computed_mic")
+			x.ComputedMic = s.ReadBytes()
+		case "mic_valid", "micValid":
+			s.AddField("mic_valid")
+			x.MicValid = s.ReadBool()
+		case "f_opts", "fOpts":
+			s.AddField("f_opts")
+			x.FOpts = s.ReadBytes()
+		case "frm_payload", "frmPayload":
+			s.AddField("frm_payload")
+			x.FrmPayload = s.ReadBytes()
+		case "mac_commands", "macCommands":
+			s.AddField("mac_commands")
+			if s.ReadNil() {
+				x.MacCommands = nil
+				return
+			}
+			s.ReadArray(func() {
+				if s.ReadNil() {
+					x.MacCommands = append(x.MacCommands, nil)
+					return
+				}
+				v := &MACCommand{}
+				v.UnmarshalProtoJSON(s.WithField("mac_commands", false))
+				if s.Err() != nil {
+					return
+				}
+				x.MacCommands = append(x.MacCommands, v)
+			})
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the DecodeNsPHYPayloadResponse from JSON.
+func (x *DecodeNsPHYPayloadResponse) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the AsNsSimulateUplinkRequest message to JSON.
+func (x *AsNsSimulateUplinkRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.EndDeviceIds != nil || s.HasField("end_device_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("end_device_ids")
+		x.EndDeviceIds.MarshalProtoJSON(s.WithField("end_device_ids"))
+	}
+	if len(x.DevAddr) > 0 || s.HasField("dev_addr") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("dev_addr")
+		types.MarshalHEXBytes(s.WithField("dev_addr"), x.DevAddr)
+	}
+	if x.FCnt != 0 || s.HasField("f_cnt") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_cnt")
+		s.WriteUint32(x.FCnt)
+	}
+	if x.FPort != 0 || s.HasField("f_port") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("f_port")
+		s.WriteUint32(x.FPort)
+	}
+	if len(x.FrmPayload) > 0 || s.HasField("frm_payload") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("frm_payload")
+		s.WriteBytes(x.FrmPayload)
+	}
+	if x.Confirmed || s.HasField("confirmed") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("confirmed")
+		s.WriteBool(x.Confirmed)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the AsNsSimulateUplinkRequest to JSON.
+func (x *AsNsSimulateUplinkRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the AsNsSimulateUplinkRequest message from JSON.
+func (x *AsNsSimulateUplinkRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "end_device_ids", "endDeviceIds":
+			if s.ReadNil() {
+				x.EndDeviceIds = nil
+				return
+			}
+			x.EndDeviceIds = &EndDeviceIdentifiers{}
+			x.EndDeviceIds.UnmarshalProtoJSON(s.WithField("end_device_ids", true))
+		case "dev_addr", "devAddr":
+			s.AddField("dev_addr")
+			x.DevAddr = types.Unmarshal4Bytes(s.WithField("dev_addr", false))
+		case "f_cnt", "fCnt":
+			s.AddField("f_cnt")
+			x.FCnt = s.ReadUint32()
+		case "f_port", "fPort":
+			s.AddField("f_port")
+			x.FPort = s.ReadUint32()
+		case "frm_payload", "frmPayload":
+			s.AddField("frm_payload")
+			x.FrmPayload = s.ReadBytes()
+		case "confirmed":
+			s.AddField("confirmed")
+			x.Confirmed = s.ReadBool()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the AsNsSimulateUplinkRequest from JSON.
+func (x *AsNsSimulateUplinkRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -130,6 +130,34 @@ var UpdateNotificationStatusRequestFieldPathsTopLevel = []string{
 	"receiver_ids",
 	"status",
 }
+var NotificationPreferencesFieldPathsNested = []string{
+	"email_digest",
+}
+
+var NotificationPreferencesFieldPathsTopLevel = []string{
+	"email_digest",
+}
+var GetNotificationPreferencesRequestFieldPathsNested = []string{
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var GetNotificationPreferencesRequestFieldPathsTopLevel = []string{
+	"user_ids",
+}
+var SetNotificationPreferencesRequestFieldPathsNested = []string{
+	"preferences",
+	"preferences.email_digest",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var SetNotificationPreferencesRequestFieldPathsTopLevel = []string{
+	"preferences",
+	"user_ids",
+}
 var EntityStateChangedNotificationFieldPathsNested = []string{
 	"state",
 	"state_description",
@@ -399,6 +399,124 @@ func (dst *UpdateNotificationStatusRequest) SetFields(src *UpdateNotificationSta
 	return nil
 }
 
+func (dst *NotificationPreferences) SetFields(src *NotificationPreferences, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "email_digest":
+			if len(subs) > 0 {
+				return fmt.Errorf("'email_digest' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.EmailDigest = src.EmailDigest
+			} else {
+				var zero bool
+				dst.EmailDigest = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GetNotificationPreferencesRequest) SetFields(src *GetNotificationPreferencesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.UserIds == nil) && dst.UserIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UserIds
+				}
+				if dst.UserIds != nil {
+					newDst = dst.UserIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.UserIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIds = src.UserIds
+				} else {
+					dst.UserIds = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SetNotificationPreferencesRequest) SetFields(src *SetNotificationPreferencesRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.UserIds == nil) && dst.UserIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UserIds
+				}
+				if dst.UserIds != nil {
+					newDst = dst.UserIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.UserIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIds = src.UserIds
+				} else {
+					dst.UserIds = nil
+				}
+			}
+		case "preferences":
+			if len(subs) > 0 {
+				var newDst, newSrc *NotificationPreferences
+				if (src == nil || src.Preferences == nil) && dst.Preferences == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.Preferences
+				}
+				if dst.Preferences != nil {
+					newDst = dst.Preferences
+				} else {
+					newDst = &NotificationPreferences{}
+					dst.Preferences = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.Preferences = src.Preferences
+				} else {
+					dst.Preferences = nil
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *EntityStateChangedNotification) SetFields(src *EntityStateChangedNotification, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -96,3 +96,65 @@ func (m *UpdateNotificationStatusRequest) SetFromFlags(flags *pflag.FlagSet, pre
 	}
 	return paths, nil
 }
+
+// AddSelectFlagsForNotificationPreferences adds flags to select fields in NotificationPreferences.
+func AddSelectFlagsForNotificationPreferences(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("email-digest", prefix), flagsplugin.SelectDesc(flagsplugin.Prefix("email-digest", prefix), false), flagsplugin.WithHidden(hidden)))
+}
+
+// SelectFromFlags outputs the fieldmask paths forNotificationPreferences message from select flags.
+func PathsFromSelectFlagsForNotificationPreferences(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, selected, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("email_digest", prefix)); err != nil {
+		return nil, err
+	} else if selected && val {
+		paths = append(paths, flagsplugin.Prefix("email_digest", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForNotificationPreferences adds flags to select fields in NotificationPreferences.
+func AddSetFlagsForNotificationPreferences(flags *pflag.FlagSet, prefix string, hidden bool) {
+	flags.AddFlag(flagsplugin.NewBoolFlag(flagsplugin.Prefix("email-digest", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the NotificationPreferences message from flags.
+func (m *NotificationPreferences) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if val, changed, err := flagsplugin.GetBool(flags, flagsplugin.Prefix("email_digest", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.EmailDigest = val
+		paths = append(paths, flagsplugin.Prefix("email_digest", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForSetNotificationPreferencesRequest adds flags to select fields in SetNotificationPreferencesRequest.
+func AddSetFlagsForSetNotificationPreferencesRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForUserIdentifiers(flags, flagsplugin.Prefix("user-ids", prefix), hidden)
+	AddSetFlagsForNotificationPreferences(flags, flagsplugin.Prefix("preferences", prefix), hidden)
+}
+
+// SetFromFlags sets the SetNotificationPreferencesRequest message from flags.
+func (m *SetNotificationPreferencesRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("user_ids", prefix)); changed {
+		if m.UserIds == nil {
+			m.UserIds = &UserIdentifiers{}
+		}
+		if setPaths, err := m.UserIds.SetFromFlags(flags, flagsplugin.Prefix("user_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("preferences", prefix)); changed {
+		if m.Preferences == nil {
+			m.Preferences = &NotificationPreferences{}
+		}
+		if setPaths, err := m.Preferences.SetFromFlags(flags, flagsplugin.Prefix("preferences", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	return paths, nil
+}
@@ -34,9 +34,11 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	NotificationService_Create_FullMethodName       = "/ttn.lorawan.v3.NotificationService/Create"
-	NotificationService_List_FullMethodName         = "/ttn.lorawan.v3.NotificationService/List"
-	NotificationService_UpdateStatus_FullMethodName = "/ttn.lorawan.v3.NotificationService/UpdateStatus"
+	NotificationService_Create_FullMethodName         = "/ttn.lorawan.v3.NotificationService/Create"
+	NotificationService_List_FullMethodName           = "/ttn.lorawan.v3.NotificationService/List"
+	NotificationService_UpdateStatus_FullMethodName   = "/ttn.lorawan.v3.NotificationService/UpdateStatus"
+	NotificationService_GetPreferences_FullMethodName = "/ttn.lorawan.v3.NotificationService/GetPreferences"
+	NotificationService_SetPreferences_FullMethodName = "/ttn.lorawan.v3.NotificationService/SetPreferences"
 )
 
 // NotificationServiceClient is the client API for NotificationService service.
@@ -51,6 +53,10 @@ type NotificationServiceClient interface {
 	List(ctx context.Context, in *ListNotificationsRequest, opts ...grpc.CallOption) (*ListNotificationsResponse, error)
 	// Batch-update multiple notifications to the same status.
 	UpdateStatus(ctx context.Context, in *UpdateNotificationStatusRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Get the notification preferences of a user.
+	GetPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
+	// Set the notification preferences of a user.
+	SetPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error)
 }
 
 type notificationServiceClient struct {
@@ -88,6 +94,24 @@ func (c *notificationServiceClient) UpdateStatus(ctx context.Context, in *Update
 	return out, nil
 }
 
+func (c *notificationServiceClient) GetPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, NotificationService_GetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationServiceClient) SetPreferences(ctx context.Context, in *SetNotificationPreferencesRequest, opts ...grpc.CallOption) (*NotificationPreferences, error) {
+	out := new(NotificationPreferences)
+	err := c.cc.Invoke(ctx, NotificationService_SetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationServiceServer is the server API for NotificationService service.
 // All implementations must embed UnimplementedNotificationServiceServer
 // for forward compatibility
@@ -100,6 +124,10 @@ type NotificationServiceServer interface {
 	List(context.Context, *ListNotificationsRequest) (*ListNotificationsResponse, error)
 	// Batch-update multiple notifications to the same status.
 	UpdateStatus(context.Context, *UpdateNotificationStatusRequest) (*emptypb.Empty, error)
+	// Get the notification preferences of a user.
+	GetPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error)
+	// Set the notification preferences of a user.
+	SetPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferences, error)
 	mustEmbedUnimplementedNotificationServiceServer()
 }
 
@@ -116,6 +144,12 @@ func (UnimplementedNotificationServiceServer) List(context.Context, *ListNotific
 func (UnimplementedNotificationServiceServer) UpdateStatus(context.Context, *UpdateNotificationStatusRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateStatus not implemented")
 }
+func (UnimplementedNotificationServiceServer) GetPreferences(context.Context, *GetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationServiceServer) SetPreferences(context.Context, *SetNotificationPreferencesRequest) (*NotificationPreferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreferences not implemented")
+}
 func (UnimplementedNotificationServiceServer) mustEmbedUnimplementedNotificationServiceServer() {}
 
 // UnsafeNotificationServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -183,6 +217,42 @@ func _NotificationService_UpdateStatus_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NotificationService_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).GetPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NotificationService_SetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationServiceServer).SetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NotificationService_SetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationServiceServer).SetPreferences(ctx, req.(*SetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NotificationService_ServiceDesc is the grpc.ServiceDesc for NotificationService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -202,6 +272,14 @@ var NotificationService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateStatus",
 			Handler:    _NotificationService_UpdateStatus_Handler,
 		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _NotificationService_GetPreferences_Handler,
+		},
+		{
+			MethodName: "SetPreferences",
+			Handler:    _NotificationService_SetPreferences_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/notification_service.proto",
@@ -60,8 +60,10 @@ var OAuthAuthorizationCodeFieldPathsNested = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
 	"redirect_uri",
 	"rights",
+	"scopes",
 	"state",
 	"user_ids",
 	"user_ids.email",
@@ -74,8 +76,10 @@ var OAuthAuthorizationCodeFieldPathsTopLevel = []string{
 	"code",
 	"created_at",
 	"expires_at",
+	"nonce",
 	"redirect_uri",
 	"rights",
+	"scopes",
 	"state",
 	"user_ids",
 	"user_session_id",
@@ -359,6 +359,25 @@ func (dst *OAuthAuthorizationCode) SetFields(src *OAuthAuthorizationCode, paths
 			} else {
 				dst.ExpiresAt = nil
 			}
+		case "nonce":
+			if len(subs) > 0 {
+				return fmt.Errorf("'nonce' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Nonce = src.Nonce
+			} else {
+				var zero string
+				dst.Nonce = zero
+			}
+		case "scopes":
+			if len(subs) > 0 {
+				return fmt.Errorf("'scopes' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Scopes = src.Scopes
+			} else {
+				dst.Scopes = nil
+			}
 
 		default:
 			return fmt.Errorf("invalid field: '%s'", name)
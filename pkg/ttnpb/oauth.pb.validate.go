@@ -626,6 +626,10 @@ func (m *OAuthAuthorizationCode) ValidateFields(paths ...string) error {
 				}
 			}
 
+		case "nonce":
+			// no validation rules for Nonce
+		case "scopes":
+
 		default:
 			return OAuthAuthorizationCodeValidationError{
 				field:  name,
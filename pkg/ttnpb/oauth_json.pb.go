@@ -271,6 +271,16 @@ func (x *OAuthAuthorizationCode) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 			golang.MarshalTimestamp(s, x.ExpiresAt)
 		}
 	}
+	if x.Nonce != "" || s.HasField("nonce") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("nonce")
+		s.WriteString(x.Nonce)
+	}
+	if len(x.Scopes) > 0 || s.HasField("scopes") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("scopes")
+		s.WriteStringArray(x.Scopes)
+	}
 	s.WriteObjectEnd()
 }
 
@@ -353,6 +363,16 @@ func (x *OAuthAuthorizationCode) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState
 				return
 			}
 			x.ExpiresAt = v
+		case "nonce":
+			s.AddField("nonce")
+			x.Nonce = s.ReadString()
+		case "scopes":
+			s.AddField("scopes")
+			if s.ReadNil() {
+				x.Scopes = nil
+				return
+			}
+			x.Scopes = s.ReadStringArray()
 		}
 	})
 }
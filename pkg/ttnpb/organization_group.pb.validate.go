@@ -0,0 +1,1170 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on OrganizationGroup with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OrganizationGroup) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "group_id":
+
+			if utf8.RuneCountInString(m.GetGroupId()) > 36 {
+				return OrganizationGroupValidationError{
+					field:  "group_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_OrganizationGroup_GroupId_Pattern.MatchString(m.GetGroupId()) {
+				return OrganizationGroupValidationError{
+					field:  "group_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 50 {
+				return OrganizationGroupValidationError{
+					field:  "name",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return OrganizationGroupValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		default:
+			return OrganizationGroupValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupValidationError is the validation error returned by
+// OrganizationGroup.ValidateFields if the designated constraints aren't met.
+type OrganizationGroupValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupValidationError) ErrorName() string {
+	return "OrganizationGroupValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroup.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupValidationError{}
+
+var _OrganizationGroup_GroupId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on OrganizationGroups with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OrganizationGroups) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "groups":
+
+			for idx, item := range m.GetGroups() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return OrganizationGroupsValidationError{
+							field:  fmt.Sprintf("groups[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return OrganizationGroupsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupsValidationError is the validation error returned by
+// OrganizationGroups.ValidateFields if the designated constraints aren't met.
+type OrganizationGroupsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupsValidationError) ErrorName() string {
+	return "OrganizationGroupsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroups.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupsValidationError{}
+
+// ValidateFields checks the field values on OrganizationGroupIdentifiers with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *OrganizationGroupIdentifiers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupIdentifiersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "organization_ids":
+
+			if m.GetOrganizationIds() == nil {
+				return OrganizationGroupIdentifiersValidationError{
+					field:  "organization_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOrganizationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationGroupIdentifiersValidationError{
+						field:  "organization_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "group_id":
+
+			if utf8.RuneCountInString(m.GetGroupId()) > 36 {
+				return OrganizationGroupIdentifiersValidationError{
+					field:  "group_id",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_OrganizationGroupIdentifiers_GroupId_Pattern.MatchString(m.GetGroupId()) {
+				return OrganizationGroupIdentifiersValidationError{
+					field:  "group_id",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return OrganizationGroupIdentifiersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupIdentifiersValidationError is the validation error returned
+// by OrganizationGroupIdentifiers.ValidateFields if the designated
+// constraints aren't met.
+type OrganizationGroupIdentifiersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupIdentifiersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupIdentifiersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupIdentifiersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupIdentifiersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupIdentifiersValidationError) ErrorName() string {
+	return "OrganizationGroupIdentifiersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupIdentifiersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroupIdentifiers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupIdentifiersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupIdentifiersValidationError{}
+
+var _OrganizationGroupIdentifiers_GroupId_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on SetOrganizationGroupRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SetOrganizationGroupRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetOrganizationGroupRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "organization_ids":
+
+			if m.GetOrganizationIds() == nil {
+				return SetOrganizationGroupRequestValidationError{
+					field:  "organization_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetOrganizationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetOrganizationGroupRequestValidationError{
+						field:  "organization_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "group":
+
+			if m.GetGroup() == nil {
+				return SetOrganizationGroupRequestValidationError{
+					field:  "group",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGroup()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetOrganizationGroupRequestValidationError{
+						field:  "group",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetOrganizationGroupRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetOrganizationGroupRequestValidationError is the validation error returned
+// by SetOrganizationGroupRequest.ValidateFields if the designated constraints
+// aren't met.
+type SetOrganizationGroupRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationGroupRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationGroupRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationGroupRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationGroupRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationGroupRequestValidationError) ErrorName() string {
+	return "SetOrganizationGroupRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationGroupRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationGroupRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationGroupRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationGroupRequestValidationError{}
+
+// ValidateFields checks the field values on OrganizationGroupMemberRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *OrganizationGroupMemberRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupMemberRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "group_ids":
+
+			if m.GetGroupIds() == nil {
+				return OrganizationGroupMemberRequestValidationError{
+					field:  "group_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGroupIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationGroupMemberRequestValidationError{
+						field:  "group_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "user_ids":
+
+			if m.GetUserIds() == nil {
+				return OrganizationGroupMemberRequestValidationError{
+					field:  "user_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetUserIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationGroupMemberRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return OrganizationGroupMemberRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupMemberRequestValidationError is the validation error
+// returned by OrganizationGroupMemberRequest.ValidateFields if the designated
+// constraints aren't met.
+type OrganizationGroupMemberRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupMemberRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupMemberRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupMemberRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupMemberRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupMemberRequestValidationError) ErrorName() string {
+	return "OrganizationGroupMemberRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupMemberRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroupMemberRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupMemberRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupMemberRequestValidationError{}
+
+// ValidateFields checks the field values on OrganizationGroupMembers with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *OrganizationGroupMembers) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupMembersFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "members":
+
+			for idx, item := range m.GetMembers() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return OrganizationGroupMembersValidationError{
+							field:  fmt.Sprintf("members[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return OrganizationGroupMembersValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupMembersValidationError is the validation error returned by
+// OrganizationGroupMembers.ValidateFields if the designated constraints
+// aren't met.
+type OrganizationGroupMembersValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupMembersValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupMembersValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupMembersValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupMembersValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupMembersValidationError) ErrorName() string {
+	return "OrganizationGroupMembersValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupMembersValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroupMembers.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupMembersValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupMembersValidationError{}
+
+// ValidateFields checks the field values on OrganizationGroupMembership with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *OrganizationGroupMembership) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupMembershipFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationGroupMembershipValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rights":
+
+			if v, ok := interface{}(m.GetRights()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return OrganizationGroupMembershipValidationError{
+						field:  "rights",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return OrganizationGroupMembershipValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupMembershipValidationError is the validation error returned
+// by OrganizationGroupMembership.ValidateFields if the designated constraints
+// aren't met.
+type OrganizationGroupMembershipValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupMembershipValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupMembershipValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupMembershipValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupMembershipValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupMembershipValidationError) ErrorName() string {
+	return "OrganizationGroupMembershipValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupMembershipValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroupMembership.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupMembershipValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupMembershipValidationError{}
+
+// ValidateFields checks the field values on OrganizationGroupMemberships with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *OrganizationGroupMemberships) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = OrganizationGroupMembershipsFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "memberships":
+
+			for idx, item := range m.GetMemberships() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return OrganizationGroupMembershipsValidationError{
+							field:  fmt.Sprintf("memberships[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return OrganizationGroupMembershipsValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// OrganizationGroupMembershipsValidationError is the validation error returned
+// by OrganizationGroupMemberships.ValidateFields if the designated
+// constraints aren't met.
+type OrganizationGroupMembershipsValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrganizationGroupMembershipsValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrganizationGroupMembershipsValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrganizationGroupMembershipsValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrganizationGroupMembershipsValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrganizationGroupMembershipsValidationError) ErrorName() string {
+	return "OrganizationGroupMembershipsValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrganizationGroupMembershipsValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrganizationGroupMemberships.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrganizationGroupMembershipsValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrganizationGroupMembershipsValidationError{}
+
+// ValidateFields checks the field values on
+// SetOrganizationGroupMembershipRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *SetOrganizationGroupMembershipRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetOrganizationGroupMembershipRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "group_ids":
+
+			if m.GetGroupIds() == nil {
+				return SetOrganizationGroupMembershipRequestValidationError{
+					field:  "group_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGroupIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetOrganizationGroupMembershipRequestValidationError{
+						field:  "group_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return SetOrganizationGroupMembershipRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetOrganizationGroupMembershipRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "rights":
+
+			if m.GetRights() == nil {
+				return SetOrganizationGroupMembershipRequestValidationError{
+					field:  "rights",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRights()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetOrganizationGroupMembershipRequestValidationError{
+						field:  "rights",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetOrganizationGroupMembershipRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetOrganizationGroupMembershipRequestValidationError is the validation error
+// returned by SetOrganizationGroupMembershipRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetOrganizationGroupMembershipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetOrganizationGroupMembershipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetOrganizationGroupMembershipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetOrganizationGroupMembershipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetOrganizationGroupMembershipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetOrganizationGroupMembershipRequestValidationError) ErrorName() string {
+	return "SetOrganizationGroupMembershipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetOrganizationGroupMembershipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetOrganizationGroupMembershipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetOrganizationGroupMembershipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetOrganizationGroupMembershipRequestValidationError{}
+
+// ValidateFields checks the field values on
+// DeleteOrganizationGroupMembershipRequest with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteOrganizationGroupMembershipRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteOrganizationGroupMembershipRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "group_ids":
+
+			if m.GetGroupIds() == nil {
+				return DeleteOrganizationGroupMembershipRequestValidationError{
+					field:  "group_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetGroupIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteOrganizationGroupMembershipRequestValidationError{
+						field:  "group_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return DeleteOrganizationGroupMembershipRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteOrganizationGroupMembershipRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return DeleteOrganizationGroupMembershipRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteOrganizationGroupMembershipRequestValidationError is the validation
+// error returned by DeleteOrganizationGroupMembershipRequest.ValidateFields
+// if the designated constraints aren't met.
+type DeleteOrganizationGroupMembershipRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteOrganizationGroupMembershipRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteOrganizationGroupMembershipRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteOrganizationGroupMembershipRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteOrganizationGroupMembershipRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteOrganizationGroupMembershipRequestValidationError) ErrorName() string {
+	return "DeleteOrganizationGroupMembershipRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteOrganizationGroupMembershipRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteOrganizationGroupMembershipRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteOrganizationGroupMembershipRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteOrganizationGroupMembershipRequestValidationError{}
@@ -0,0 +1,523 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on RateLimitOverride with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RateLimitOverride) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RateLimitOverrideFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "subject":
+			// no validation rules for Subject
+		case "max_per_min":
+			// no validation rules for MaxPerMin
+		case "max_burst":
+			// no validation rules for MaxBurst
+		default:
+			return RateLimitOverrideValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RateLimitOverrideValidationError is the validation error returned by
+// RateLimitOverride.ValidateFields if the designated constraints aren't met.
+type RateLimitOverrideValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitOverrideValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitOverrideValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitOverrideValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitOverrideValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitOverrideValidationError) ErrorName() string {
+	return "RateLimitOverrideValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RateLimitOverrideValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitOverride.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitOverrideValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitOverrideValidationError{}
+
+// ValidateFields checks the field values on RateLimitOverrides with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RateLimitOverrides) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RateLimitOverridesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "overrides":
+
+			for idx, item := range m.GetOverrides() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RateLimitOverridesValidationError{
+							field:  fmt.Sprintf("overrides[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RateLimitOverridesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RateLimitOverridesValidationError is the validation error returned by
+// RateLimitOverrides.ValidateFields if the designated constraints aren't met.
+type RateLimitOverridesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RateLimitOverridesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RateLimitOverridesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RateLimitOverridesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RateLimitOverridesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RateLimitOverridesValidationError) ErrorName() string {
+	return "RateLimitOverridesValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RateLimitOverridesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRateLimitOverrides.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RateLimitOverridesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RateLimitOverridesValidationError{}
+
+// ValidateFields checks the field values on SetAPIKeyRateLimitOverrideRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SetAPIKeyRateLimitOverrideRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetAPIKeyRateLimitOverrideRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "api_key_id":
+
+			if utf8.RuneCountInString(m.GetApiKeyId()) < 1 {
+				return SetAPIKeyRateLimitOverrideRequestValidationError{
+					field:  "api_key_id",
+					reason: "value length must be at least 1 runes",
+				}
+			}
+
+		case "max_per_min":
+
+			if m.GetMaxPerMin() <= 0 {
+				return SetAPIKeyRateLimitOverrideRequestValidationError{
+					field:  "max_per_min",
+					reason: "value must be greater than 0",
+				}
+			}
+
+		case "max_burst":
+			// no validation rules for MaxBurst
+		default:
+			return SetAPIKeyRateLimitOverrideRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetAPIKeyRateLimitOverrideRequestValidationError is the validation error
+// returned by SetAPIKeyRateLimitOverrideRequest.ValidateFields if the
+// designated constraints aren't met.
+type SetAPIKeyRateLimitOverrideRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) ErrorName() string {
+	return "SetAPIKeyRateLimitOverrideRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetAPIKeyRateLimitOverrideRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetAPIKeyRateLimitOverrideRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetAPIKeyRateLimitOverrideRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetAPIKeyRateLimitOverrideRequestValidationError{}
+
+// ValidateFields checks the field values on
+// DeleteAPIKeyRateLimitOverrideRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *DeleteAPIKeyRateLimitOverrideRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteAPIKeyRateLimitOverrideRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "api_key_id":
+
+			if utf8.RuneCountInString(m.GetApiKeyId()) < 1 {
+				return DeleteAPIKeyRateLimitOverrideRequestValidationError{
+					field:  "api_key_id",
+					reason: "value length must be at least 1 runes",
+				}
+			}
+
+		default:
+			return DeleteAPIKeyRateLimitOverrideRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteAPIKeyRateLimitOverrideRequestValidationError is the validation error
+// returned by DeleteAPIKeyRateLimitOverrideRequest.ValidateFields if the
+// designated constraints aren't met.
+type DeleteAPIKeyRateLimitOverrideRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) ErrorName() string {
+	return "DeleteAPIKeyRateLimitOverrideRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAPIKeyRateLimitOverrideRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAPIKeyRateLimitOverrideRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAPIKeyRateLimitOverrideRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAPIKeyRateLimitOverrideRequestValidationError{}
+
+// ValidateFields checks the field values on
+// SetApplicationRateLimitOverrideRequest with the rules defined in the proto
+// definition for this message. If any rules are violated, an error is returned.
+func (m *SetApplicationRateLimitOverrideRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetApplicationRateLimitOverrideRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return SetApplicationRateLimitOverrideRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetApplicationRateLimitOverrideRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "max_per_min":
+
+			if m.GetMaxPerMin() <= 0 {
+				return SetApplicationRateLimitOverrideRequestValidationError{
+					field:  "max_per_min",
+					reason: "value must be greater than 0",
+				}
+			}
+
+		case "max_burst":
+			// no validation rules for MaxBurst
+		default:
+			return SetApplicationRateLimitOverrideRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetApplicationRateLimitOverrideRequestValidationError is the validation
+// error returned by SetApplicationRateLimitOverrideRequest.ValidateFields if
+// the designated constraints aren't met.
+type SetApplicationRateLimitOverrideRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetApplicationRateLimitOverrideRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetApplicationRateLimitOverrideRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetApplicationRateLimitOverrideRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetApplicationRateLimitOverrideRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetApplicationRateLimitOverrideRequestValidationError) ErrorName() string {
+	return "SetApplicationRateLimitOverrideRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetApplicationRateLimitOverrideRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetApplicationRateLimitOverrideRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetApplicationRateLimitOverrideRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetApplicationRateLimitOverrideRequestValidationError{}
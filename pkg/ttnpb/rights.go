@@ -190,6 +190,24 @@ func (r *Rights) Implied() *Rights {
 	return s.rights()
 }
 
+// Deny returns the implied rights of r without the implied rights of denied.
+// Rights that imply a denied right, such as the _ALL rights, are removed as well,
+// so that the result does not imply any denied right.
+func (r *Rights) Deny(denied *Rights) *Rights {
+	implied := r.Implied()
+	if len(denied.GetRights()) == 0 {
+		return implied
+	}
+	allowed := implied.Sub(denied.Implied())
+	res := make([]Right, 0, len(allowed.GetRights()))
+	for _, right := range allowed.GetRights() {
+		if allowed.IncludesAll(right.Implied().GetRights()...) {
+			res = append(res, right)
+		}
+	}
+	return &Rights{Rights: res}
+}
+
 // IncludesAll returns true if r includes all given rights.
 func (r *Rights) IncludesAll(search ...Right) bool {
 	if r == nil {
@@ -53,6 +53,7 @@ var Right_customvalue = map[string]int32{
 	"APPLICATION_DEVICES_WRITE":          21,
 	"APPLICATION_DEVICES_READ_KEYS":      22,
 	"APPLICATION_DEVICES_WRITE_KEYS":     23,
+	"APPLICATION_DEVICES_CLAIM":          64,
 	"APPLICATION_TRAFFIC_READ":           24,
 	"APPLICATION_TRAFFIC_UP_WRITE":       25,
 	"APPLICATION_TRAFFIC_DOWN_WRITE":     26,
@@ -87,4 +87,23 @@ func TestRights(t *testing.T) {
 			should.BeFalse,
 		)
 	})
+	t.Run("Deny", func(t *testing.T) {
+		a := assertions.New(t)
+		a.So(nilRights.Deny(someAppRights).GetRights(), should.BeEmpty)
+		a.So(someAppRights.Deny(nil).GetRights(), should.HaveLength, 2)
+
+		appAll := ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_ALL)
+		denied := appAll.Deny(ttnpb.RightsFrom(ttnpb.Right_RIGHT_APPLICATION_DELETE))
+		a.So(denied.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_INFO, ttnpb.Right_RIGHT_APPLICATION_SETTINGS_BASIC), should.BeTrue)
+		a.So(denied.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+		a.So(denied.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_ALL), should.BeFalse)
+		a.So(denied.Implied().IncludesAll(ttnpb.Right_RIGHT_APPLICATION_DELETE), should.BeFalse)
+
+		all := ttnpb.RightsFrom(ttnpb.Right_RIGHT_ALL)
+		denied = all.Deny(ttnpb.RightsFrom(ttnpb.Right_RIGHT_GATEWAY_DELETE))
+		a.So(denied.IncludesAll(ttnpb.Right_RIGHT_ALL, ttnpb.Right_RIGHT_GATEWAY_ALL), should.BeFalse)
+		a.So(denied.IncludesAll(ttnpb.Right_RIGHT_APPLICATION_ALL, ttnpb.Right_RIGHT_GATEWAY_INFO), should.BeTrue)
+
+		a.So(appAll.Deny(appAll).GetRights(), should.BeEmpty)
+	})
 }
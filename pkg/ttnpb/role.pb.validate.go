@@ -0,0 +1,922 @@
+// Code generated by protoc-gen-fieldmask. DO NOT EDIT.
+
+package ttnpb
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// ensure the imports are used
+var (
+	_ = bytes.MinRead
+	_ = errors.New("")
+	_ = fmt.Print
+	_ = utf8.UTFMax
+	_ = (*regexp.Regexp)(nil)
+	_ = (*strings.Reader)(nil)
+	_ = net.IPv4len
+	_ = time.Duration(0)
+	_ = (*url.URL)(nil)
+	_ = (*mail.Address)(nil)
+	_ = anypb.Any{}
+)
+
+// ValidateFields checks the field values on Role with the rules defined in the
+// proto definition for this message. If any rules are violated, an error is returned.
+func (m *Role) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RoleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 36 {
+				return RoleValidationError{
+					field:  "name",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_Role_Name_Pattern.MatchString(m.GetName()) {
+				return RoleValidationError{
+					field:  "name",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		case "description":
+
+			if utf8.RuneCountInString(m.GetDescription()) > 2000 {
+				return RoleValidationError{
+					field:  "description",
+					reason: "value length must be at most 2000 runes",
+				}
+			}
+
+		case "rights":
+
+			for idx, item := range m.GetRights() {
+				_, _ = idx, item
+
+				if _, ok := Right_name[int32(item)]; !ok {
+					return RoleValidationError{
+						field:  fmt.Sprintf("rights[%v]", idx),
+						reason: "value must be one of the defined enum values",
+					}
+				}
+
+			}
+
+		case "built_in":
+			// no validation rules for BuiltIn
+		default:
+			return RoleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RoleValidationError is the validation error returned by Role.ValidateFields
+// if the designated constraints aren't met.
+type RoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleValidationError) ErrorName() string { return "RoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleValidationError{}
+
+var _Role_Name_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on Roles with the rules defined in
+// the proto definition for this message. If any rules are violated, an error
+// is returned.
+func (m *Roles) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RolesFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "roles":
+
+			for idx, item := range m.GetRoles() {
+				_, _ = idx, item
+
+				if v, ok := interface{}(item).(interface{ ValidateFields(...string) error }); ok {
+					if err := v.ValidateFields(subs...); err != nil {
+						return RolesValidationError{
+							field:  fmt.Sprintf("roles[%v]", idx),
+							reason: "embedded message failed validation",
+							cause:  err,
+						}
+					}
+				}
+
+			}
+
+		default:
+			return RolesValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// RolesValidationError is the validation error returned by
+// Roles.ValidateFields if the designated constraints aren't met.
+type RolesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RolesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RolesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RolesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RolesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RolesValidationError) ErrorName() string { return "RolesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RolesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoles.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RolesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RolesValidationError{}
+
+// ValidateFields checks the field values on ListRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ListRolesRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ListRolesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return ListRolesRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ListRolesRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return ListRolesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ListRolesRequestValidationError is the validation error returned by
+// ListRolesRequest.ValidateFields if the designated constraints aren't met.
+type ListRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListRolesRequestValidationError) ErrorName() string { return "ListRolesRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListRolesRequestValidationError{}
+
+// ValidateFields checks the field values on SetRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *SetRoleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetRoleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return SetRoleRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetRoleRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "role":
+
+			if m.GetRole() == nil {
+				return SetRoleRequestValidationError{
+					field:  "role",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetRole()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetRoleRequestValidationError{
+						field:  "role",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return SetRoleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetRoleRequestValidationError is the validation error returned by
+// SetRoleRequest.ValidateFields if the designated constraints aren't met.
+type SetRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetRoleRequestValidationError) ErrorName() string { return "SetRoleRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SetRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetRoleRequestValidationError{}
+
+// ValidateFields checks the field values on DeleteRoleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *DeleteRoleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = DeleteRoleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return DeleteRoleRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return DeleteRoleRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "name":
+
+			if utf8.RuneCountInString(m.GetName()) > 36 {
+				return DeleteRoleRequestValidationError{
+					field:  "name",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_DeleteRoleRequest_Name_Pattern.MatchString(m.GetName()) {
+				return DeleteRoleRequestValidationError{
+					field:  "name",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return DeleteRoleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteRoleRequestValidationError is the validation error returned by
+// DeleteRoleRequest.ValidateFields if the designated constraints aren't met.
+type DeleteRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteRoleRequestValidationError) ErrorName() string {
+	return "DeleteRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteRoleRequestValidationError{}
+
+var _DeleteRoleRequest_Name_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on GetCollaboratorRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *GetCollaboratorRoleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GetCollaboratorRoleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return GetCollaboratorRoleRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetCollaboratorRoleRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "collaborator":
+
+			if m.GetCollaborator() == nil {
+				return GetCollaboratorRoleRequestValidationError{
+					field:  "collaborator",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetCollaborator()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return GetCollaboratorRoleRequestValidationError{
+						field:  "collaborator",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		default:
+			return GetCollaboratorRoleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GetCollaboratorRoleRequestValidationError is the validation error returned
+// by GetCollaboratorRoleRequest.ValidateFields if the designated constraints
+// aren't met.
+type GetCollaboratorRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetCollaboratorRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetCollaboratorRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetCollaboratorRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetCollaboratorRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetCollaboratorRoleRequestValidationError) ErrorName() string {
+	return "GetCollaboratorRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetCollaboratorRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetCollaboratorRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetCollaboratorRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetCollaboratorRoleRequestValidationError{}
+
+// ValidateFields checks the field values on SetCollaboratorRoleRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *SetCollaboratorRoleRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SetCollaboratorRoleRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "entity_ids":
+
+			if m.GetEntityIds() == nil {
+				return SetCollaboratorRoleRequestValidationError{
+					field:  "entity_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetEntityIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetCollaboratorRoleRequestValidationError{
+						field:  "entity_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "collaborator":
+
+			if m.GetCollaborator() == nil {
+				return SetCollaboratorRoleRequestValidationError{
+					field:  "collaborator",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetCollaborator()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SetCollaboratorRoleRequestValidationError{
+						field:  "collaborator",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "role":
+
+			if utf8.RuneCountInString(m.GetRole()) > 36 {
+				return SetCollaboratorRoleRequestValidationError{
+					field:  "role",
+					reason: "value length must be at most 36 runes",
+				}
+			}
+
+			if !_SetCollaboratorRoleRequest_Role_Pattern.MatchString(m.GetRole()) {
+				return SetCollaboratorRoleRequestValidationError{
+					field:  "role",
+					reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+				}
+			}
+
+		default:
+			return SetCollaboratorRoleRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SetCollaboratorRoleRequestValidationError is the validation error returned
+// by SetCollaboratorRoleRequest.ValidateFields if the designated constraints
+// aren't met.
+type SetCollaboratorRoleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetCollaboratorRoleRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetCollaboratorRoleRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetCollaboratorRoleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetCollaboratorRoleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetCollaboratorRoleRequestValidationError) ErrorName() string {
+	return "SetCollaboratorRoleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetCollaboratorRoleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetCollaboratorRoleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetCollaboratorRoleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetCollaboratorRoleRequestValidationError{}
+
+var _SetCollaboratorRoleRequest_Role_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+// ValidateFields checks the field values on CollaboratorRole with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *CollaboratorRole) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = CollaboratorRoleFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "role":
+			// no validation rules for Role
+		default:
+			return CollaboratorRoleValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// CollaboratorRoleValidationError is the validation error returned by
+// CollaboratorRole.ValidateFields if the designated constraints aren't met.
+type CollaboratorRoleValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CollaboratorRoleValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CollaboratorRoleValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CollaboratorRoleValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CollaboratorRoleValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CollaboratorRoleValidationError) ErrorName() string { return "CollaboratorRoleValidationError" }
+
+// Error satisfies the builtin error interface
+func (e CollaboratorRoleValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCollaboratorRole.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CollaboratorRoleValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CollaboratorRoleValidationError{}
@@ -160,6 +160,76 @@ var SearchAccountsResponseFieldPathsNested = []string{
 var SearchAccountsResponseFieldPathsTopLevel = []string{
 	"account_ids",
 }
+var GeoBoundingBoxFieldPathsNested = []string{
+	"max_latitude",
+	"max_longitude",
+	"min_latitude",
+	"min_longitude",
+}
+
+var GeoBoundingBoxFieldPathsTopLevel = []string{
+	"max_latitude",
+	"max_longitude",
+	"min_latitude",
+	"min_longitude",
+}
+var GeoRadiusFieldPathsNested = []string{
+	"latitude",
+	"longitude",
+	"radius",
+}
+
+var GeoRadiusFieldPathsTopLevel = []string{
+	"latitude",
+	"longitude",
+	"radius",
+}
+var SearchGatewaysByLocationRequestFieldPathsNested = []string{
+	"area",
+	"area.bbox",
+	"area.bbox.max_latitude",
+	"area.bbox.max_longitude",
+	"area.bbox.min_latitude",
+	"area.bbox.min_longitude",
+	"area.radius",
+	"area.radius.latitude",
+	"area.radius.longitude",
+	"area.radius.radius",
+	"field_mask",
+	"limit",
+	"page",
+}
+
+var SearchGatewaysByLocationRequestFieldPathsTopLevel = []string{
+	"area",
+	"field_mask",
+	"limit",
+	"page",
+}
+var ExportGatewayLocationsRequestFieldPathsNested = []string{
+	"area",
+	"area.bbox",
+	"area.bbox.max_latitude",
+	"area.bbox.max_longitude",
+	"area.bbox.min_latitude",
+	"area.bbox.min_longitude",
+	"area.radius",
+	"area.radius.latitude",
+	"area.radius.longitude",
+	"area.radius.radius",
+	"field_mask",
+	"format",
+	"limit",
+	"page",
+}
+
+var ExportGatewayLocationsRequestFieldPathsTopLevel = []string{
+	"area",
+	"field_mask",
+	"format",
+	"limit",
+	"page",
+}
 var SearchEndDevicesRequestFieldPathsNested = []string{
 	"application_ids",
 	"application_ids.application_id",
@@ -192,3 +262,55 @@ var SearchEndDevicesRequestFieldPathsTopLevel = []string{
 	"page",
 	"query",
 }
+var SearchEndDevicesByLocationRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"area",
+	"area.bbox",
+	"area.bbox.max_latitude",
+	"area.bbox.max_longitude",
+	"area.bbox.min_latitude",
+	"area.bbox.min_longitude",
+	"area.radius",
+	"area.radius.latitude",
+	"area.radius.longitude",
+	"area.radius.radius",
+	"field_mask",
+	"limit",
+	"page",
+}
+
+var SearchEndDevicesByLocationRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"area",
+	"field_mask",
+	"limit",
+	"page",
+}
+var ExportEndDeviceLocationsRequestFieldPathsNested = []string{
+	"application_ids",
+	"application_ids.application_id",
+	"area",
+	"area.bbox",
+	"area.bbox.max_latitude",
+	"area.bbox.max_longitude",
+	"area.bbox.min_latitude",
+	"area.bbox.min_longitude",
+	"area.radius",
+	"area.radius.latitude",
+	"area.radius.longitude",
+	"area.radius.radius",
+	"field_mask",
+	"format",
+	"limit",
+	"page",
+}
+
+var ExportEndDeviceLocationsRequestFieldPathsTopLevel = []string{
+	"application_ids",
+	"area",
+	"field_mask",
+	"format",
+	"limit",
+	"page",
+}
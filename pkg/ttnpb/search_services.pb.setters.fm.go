@@ -793,6 +793,373 @@ func (dst *SearchAccountsResponse) SetFields(src *SearchAccountsResponse, paths
 	return nil
 }
 
+func (dst *GeoBoundingBox) SetFields(src *GeoBoundingBox, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "min_latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinLatitude = src.MinLatitude
+			} else {
+				var zero float64
+				dst.MinLatitude = zero
+			}
+		case "min_longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'min_longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MinLongitude = src.MinLongitude
+			} else {
+				var zero float64
+				dst.MinLongitude = zero
+			}
+		case "max_latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxLatitude = src.MaxLatitude
+			} else {
+				var zero float64
+				dst.MaxLatitude = zero
+			}
+		case "max_longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'max_longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.MaxLongitude = src.MaxLongitude
+			} else {
+				var zero float64
+				dst.MaxLongitude = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *GeoRadius) SetFields(src *GeoRadius, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "latitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'latitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Latitude = src.Latitude
+			} else {
+				var zero float64
+				dst.Latitude = zero
+			}
+		case "longitude":
+			if len(subs) > 0 {
+				return fmt.Errorf("'longitude' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Longitude = src.Longitude
+			} else {
+				var zero float64
+				dst.Longitude = zero
+			}
+		case "radius":
+			if len(subs) > 0 {
+				return fmt.Errorf("'radius' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Radius = src.Radius
+			} else {
+				var zero float64
+				dst.Radius = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *SearchGatewaysByLocationRequest) SetFields(src *SearchGatewaysByLocationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		case "area":
+			if len(subs) == 0 && src == nil {
+				dst.Area = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Area = src.Area
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "bbox":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*SearchGatewaysByLocationRequest_Bbox)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*SearchGatewaysByLocationRequest_Bbox)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoBoundingBox
+						if srcTypeOk {
+							newSrc = src.Area.(*SearchGatewaysByLocationRequest_Bbox).Bbox
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*SearchGatewaysByLocationRequest_Bbox).Bbox
+						} else if srcTypeOk {
+							newDst = &GeoBoundingBox{}
+							dst.Area = &SearchGatewaysByLocationRequest_Bbox{Bbox: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+				case "radius":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*SearchGatewaysByLocationRequest_Radius)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*SearchGatewaysByLocationRequest_Radius)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoRadius
+						if srcTypeOk {
+							newSrc = src.Area.(*SearchGatewaysByLocationRequest_Radius).Radius
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*SearchGatewaysByLocationRequest_Radius).Radius
+						} else if srcTypeOk {
+							newDst = &GeoRadius{}
+							dst.Area = &SearchGatewaysByLocationRequest_Radius{Radius: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExportGatewayLocationsRequest) SetFields(src *ExportGatewayLocationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				dst.Format = 0
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		case "area":
+			if len(subs) == 0 && src == nil {
+				dst.Area = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Area = src.Area
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "bbox":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*ExportGatewayLocationsRequest_Bbox)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*ExportGatewayLocationsRequest_Bbox)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoBoundingBox
+						if srcTypeOk {
+							newSrc = src.Area.(*ExportGatewayLocationsRequest_Bbox).Bbox
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*ExportGatewayLocationsRequest_Bbox).Bbox
+						} else if srcTypeOk {
+							newDst = &GeoBoundingBox{}
+							dst.Area = &ExportGatewayLocationsRequest_Bbox{Bbox: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+				case "radius":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*ExportGatewayLocationsRequest_Radius)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*ExportGatewayLocationsRequest_Radius)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoRadius
+						if srcTypeOk {
+							newSrc = src.Area.(*ExportGatewayLocationsRequest_Radius).Radius
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*ExportGatewayLocationsRequest_Radius).Radius
+						} else if srcTypeOk {
+							newDst = &GeoRadius{}
+							dst.Area = &ExportGatewayLocationsRequest_Radius{Radius: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *SearchEndDevicesRequest) SetFields(src *SearchEndDevicesRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -946,3 +1313,328 @@ func (dst *SearchEndDevicesRequest) SetFields(src *SearchEndDevicesRequest, path
 	}
 	return nil
 }
+
+func (dst *SearchEndDevicesByLocationRequest) SetFields(src *SearchEndDevicesByLocationRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		case "area":
+			if len(subs) == 0 && src == nil {
+				dst.Area = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Area = src.Area
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "bbox":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*SearchEndDevicesByLocationRequest_Bbox)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*SearchEndDevicesByLocationRequest_Bbox)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoBoundingBox
+						if srcTypeOk {
+							newSrc = src.Area.(*SearchEndDevicesByLocationRequest_Bbox).Bbox
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*SearchEndDevicesByLocationRequest_Bbox).Bbox
+						} else if srcTypeOk {
+							newDst = &GeoBoundingBox{}
+							dst.Area = &SearchEndDevicesByLocationRequest_Bbox{Bbox: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+				case "radius":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*SearchEndDevicesByLocationRequest_Radius)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*SearchEndDevicesByLocationRequest_Radius)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoRadius
+						if srcTypeOk {
+							newSrc = src.Area.(*SearchEndDevicesByLocationRequest_Radius).Radius
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*SearchEndDevicesByLocationRequest_Radius).Radius
+						} else if srcTypeOk {
+							newDst = &GeoRadius{}
+							dst.Area = &SearchEndDevicesByLocationRequest_Radius{Radius: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *ExportEndDeviceLocationsRequest) SetFields(src *ExportEndDeviceLocationsRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "application_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *ApplicationIdentifiers
+				if (src == nil || src.ApplicationIds == nil) && dst.ApplicationIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.ApplicationIds
+				}
+				if dst.ApplicationIds != nil {
+					newDst = dst.ApplicationIds
+				} else {
+					newDst = &ApplicationIdentifiers{}
+					dst.ApplicationIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.ApplicationIds = src.ApplicationIds
+				} else {
+					dst.ApplicationIds = nil
+				}
+			}
+		case "format":
+			if len(subs) > 0 {
+				return fmt.Errorf("'format' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Format = src.Format
+			} else {
+				dst.Format = 0
+			}
+		case "field_mask":
+			if len(subs) > 0 {
+				return fmt.Errorf("'field_mask' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.FieldMask = src.FieldMask
+			} else {
+				dst.FieldMask = nil
+			}
+		case "limit":
+			if len(subs) > 0 {
+				return fmt.Errorf("'limit' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Limit = src.Limit
+			} else {
+				var zero uint32
+				dst.Limit = zero
+			}
+		case "page":
+			if len(subs) > 0 {
+				return fmt.Errorf("'page' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Page = src.Page
+			} else {
+				var zero uint32
+				dst.Page = zero
+			}
+
+		case "area":
+			if len(subs) == 0 && src == nil {
+				dst.Area = nil
+				continue
+			} else if len(subs) == 0 {
+				dst.Area = src.Area
+				continue
+			}
+
+			subPathMap := _processPaths(subs)
+			if len(subPathMap) > 1 {
+				return fmt.Errorf("more than one field specified for oneof field '%s'", name)
+			}
+			for oneofName, oneofSubs := range subPathMap {
+				switch oneofName {
+				case "bbox":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*ExportEndDeviceLocationsRequest_Bbox)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*ExportEndDeviceLocationsRequest_Bbox)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'bbox', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoBoundingBox
+						if srcTypeOk {
+							newSrc = src.Area.(*ExportEndDeviceLocationsRequest_Bbox).Bbox
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*ExportEndDeviceLocationsRequest_Bbox).Bbox
+						} else if srcTypeOk {
+							newDst = &GeoBoundingBox{}
+							dst.Area = &ExportEndDeviceLocationsRequest_Bbox{Bbox: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+				case "radius":
+					var srcTypeOk bool
+					if src != nil {
+						_, srcTypeOk = src.Area.(*ExportEndDeviceLocationsRequest_Radius)
+					}
+					if srcValid := srcTypeOk || src == nil || src.Area == nil || len(oneofSubs) == 0; !srcValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in source")
+					}
+					_, dstTypeOk := dst.Area.(*ExportEndDeviceLocationsRequest_Radius)
+					if dstValid := dstTypeOk || dst.Area == nil || len(oneofSubs) == 0; !dstValid {
+						return fmt.Errorf("attempt to set oneof 'radius', while different oneof is set in destination")
+					}
+					if len(oneofSubs) > 0 {
+						var newDst, newSrc *GeoRadius
+						if srcTypeOk {
+							newSrc = src.Area.(*ExportEndDeviceLocationsRequest_Radius).Radius
+						}
+						if dstTypeOk {
+							newDst = dst.Area.(*ExportEndDeviceLocationsRequest_Radius).Radius
+						} else if srcTypeOk {
+							newDst = &GeoRadius{}
+							dst.Area = &ExportEndDeviceLocationsRequest_Radius{Radius: newDst}
+						} else {
+							dst.Area = nil
+							continue
+						}
+						if err := newDst.SetFields(newSrc, oneofSubs...); err != nil {
+							return err
+						}
+					} else {
+						if srcTypeOk {
+							dst.Area = src.Area
+						} else {
+							dst.Area = nil
+						}
+					}
+
+				default:
+					return fmt.Errorf("invalid oneof field: '%s.%s'", name, oneofName)
+				}
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
@@ -1356,6 +1356,538 @@ var _ interface {
 	ErrorName() string
 } = SearchAccountsResponseValidationError{}
 
+// ValidateFields checks the field values on GeoBoundingBox with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *GeoBoundingBox) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GeoBoundingBoxFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "min_latitude":
+
+			if val := m.GetMinLatitude(); val < -90 || val > 90 {
+				return GeoBoundingBoxValidationError{
+					field:  "min_latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "min_longitude":
+
+			if val := m.GetMinLongitude(); val < -180 || val > 180 {
+				return GeoBoundingBoxValidationError{
+					field:  "min_longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		case "max_latitude":
+
+			if val := m.GetMaxLatitude(); val < -90 || val > 90 {
+				return GeoBoundingBoxValidationError{
+					field:  "max_latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "max_longitude":
+
+			if val := m.GetMaxLongitude(); val < -180 || val > 180 {
+				return GeoBoundingBoxValidationError{
+					field:  "max_longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		default:
+			return GeoBoundingBoxValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GeoBoundingBoxValidationError is the validation error returned by
+// GeoBoundingBox.ValidateFields if the designated constraints aren't met.
+type GeoBoundingBoxValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoBoundingBoxValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoBoundingBoxValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoBoundingBoxValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoBoundingBoxValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoBoundingBoxValidationError) ErrorName() string { return "GeoBoundingBoxValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoBoundingBoxValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoBoundingBox.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoBoundingBoxValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoBoundingBoxValidationError{}
+
+// ValidateFields checks the field values on GeoRadius with the rules defined
+// in the proto definition for this message. If any rules are violated, an
+// error is returned.
+func (m *GeoRadius) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = GeoRadiusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "latitude":
+
+			if val := m.GetLatitude(); val < -90 || val > 90 {
+				return GeoRadiusValidationError{
+					field:  "latitude",
+					reason: "value must be inside range [-90, 90]",
+				}
+			}
+
+		case "longitude":
+
+			if val := m.GetLongitude(); val < -180 || val > 180 {
+				return GeoRadiusValidationError{
+					field:  "longitude",
+					reason: "value must be inside range [-180, 180]",
+				}
+			}
+
+		case "radius":
+
+			if val := m.GetRadius(); val <= 0 || val > 1e+06 {
+				return GeoRadiusValidationError{
+					field:  "radius",
+					reason: "value must be inside range (0, 1e+06]",
+				}
+			}
+
+		default:
+			return GeoRadiusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// GeoRadiusValidationError is the validation error returned by
+// GeoRadius.ValidateFields if the designated constraints aren't met.
+type GeoRadiusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GeoRadiusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GeoRadiusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GeoRadiusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GeoRadiusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GeoRadiusValidationError) ErrorName() string { return "GeoRadiusValidationError" }
+
+// Error satisfies the builtin error interface
+func (e GeoRadiusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGeoRadius.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GeoRadiusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GeoRadiusValidationError{}
+
+// ValidateFields checks the field values on SearchGatewaysByLocationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SearchGatewaysByLocationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SearchGatewaysByLocationRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchGatewaysByLocationRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return SearchGatewaysByLocationRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		case "area":
+			if m.Area == nil {
+				return SearchGatewaysByLocationRequestValidationError{
+					field:  "area",
+					reason: "value is required",
+				}
+			}
+			if len(subs) == 0 {
+				subs = []string{
+					"bbox", "radius",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "bbox":
+					w, ok := m.Area.(*SearchGatewaysByLocationRequest_Bbox)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetBbox()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return SearchGatewaysByLocationRequestValidationError{
+								field:  "bbox",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "radius":
+					w, ok := m.Area.(*SearchGatewaysByLocationRequest_Radius)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRadius()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return SearchGatewaysByLocationRequestValidationError{
+								field:  "radius",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return SearchGatewaysByLocationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SearchGatewaysByLocationRequestValidationError is the validation error
+// returned by SearchGatewaysByLocationRequest.ValidateFields if the
+// designated constraints aren't met.
+type SearchGatewaysByLocationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchGatewaysByLocationRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchGatewaysByLocationRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchGatewaysByLocationRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchGatewaysByLocationRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchGatewaysByLocationRequestValidationError) ErrorName() string {
+	return "SearchGatewaysByLocationRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchGatewaysByLocationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchGatewaysByLocationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchGatewaysByLocationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchGatewaysByLocationRequestValidationError{}
+
+// ValidateFields checks the field values on ExportGatewayLocationsRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *ExportGatewayLocationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExportGatewayLocationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "format":
+
+			if _, ok := LocationExportFormat_name[int32(m.GetFormat())]; !ok {
+				return ExportGatewayLocationsRequestValidationError{
+					field:  "format",
+					reason: "value must be one of the defined enum values",
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExportGatewayLocationsRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 10000 {
+				return ExportGatewayLocationsRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 10000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		case "area":
+			if len(subs) == 0 {
+				subs = []string{
+					"bbox", "radius",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "bbox":
+					w, ok := m.Area.(*ExportGatewayLocationsRequest_Bbox)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetBbox()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ExportGatewayLocationsRequestValidationError{
+								field:  "bbox",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "radius":
+					w, ok := m.Area.(*ExportGatewayLocationsRequest_Radius)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRadius()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ExportGatewayLocationsRequestValidationError{
+								field:  "radius",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
+		default:
+			return ExportGatewayLocationsRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// ExportGatewayLocationsRequestValidationError is the validation error
+// returned by ExportGatewayLocationsRequest.ValidateFields if the designated
+// constraints aren't met.
+type ExportGatewayLocationsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportGatewayLocationsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportGatewayLocationsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportGatewayLocationsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportGatewayLocationsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportGatewayLocationsRequestValidationError) ErrorName() string {
+	return "ExportGatewayLocationsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportGatewayLocationsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportGatewayLocationsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportGatewayLocationsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportGatewayLocationsRequestValidationError{}
+
 // ValidateFields checks the field values on SearchEndDevicesRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1365,7 +1897,257 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 	}
 
 	if len(paths) == 0 {
-		paths = SearchEndDevicesRequestFieldPathsNested
+		paths = SearchEndDevicesRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return SearchEndDevicesRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "query":
+
+			if utf8.RuneCountInString(m.GetQuery()) > 50 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "query",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "id_contains":
+
+			if utf8.RuneCountInString(m.GetIdContains()) > 50 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "id_contains",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "name_contains":
+
+			if utf8.RuneCountInString(m.GetNameContains()) > 50 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "name_contains",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "description_contains":
+
+			if utf8.RuneCountInString(m.GetDescriptionContains()) > 50 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "description_contains",
+					reason: "value length must be at most 50 runes",
+				}
+			}
+
+		case "attributes_contain":
+
+			if len(m.GetAttributesContain()) > 10 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "attributes_contain",
+					reason: "value must contain no more than 10 pair(s)",
+				}
+			}
+
+			for key, val := range m.GetAttributesContain() {
+				_ = val
+
+				if utf8.RuneCountInString(key) > 36 {
+					return SearchEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("attributes_contain[%v]", key),
+						reason: "value length must be at most 36 runes",
+					}
+				}
+
+				if !_SearchEndDevicesRequest_AttributesContain_Pattern.MatchString(key) {
+					return SearchEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("attributes_contain[%v]", key),
+						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
+					}
+				}
+
+				if utf8.RuneCountInString(val) > 50 {
+					return SearchEndDevicesRequestValidationError{
+						field:  fmt.Sprintf("attributes_contain[%v]", key),
+						reason: "value length must be at most 50 runes",
+					}
+				}
+
+			}
+
+		case "dev_eui_contains":
+
+			if utf8.RuneCountInString(m.GetDevEuiContains()) > 16 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "dev_eui_contains",
+					reason: "value length must be at most 16 runes",
+				}
+			}
+
+		case "join_eui_contains":
+
+			if utf8.RuneCountInString(m.GetJoinEuiContains()) > 16 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "join_eui_contains",
+					reason: "value length must be at most 16 runes",
+				}
+			}
+
+		case "dev_addr_contains":
+
+			if utf8.RuneCountInString(m.GetDevAddrContains()) > 8 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "dev_addr_contains",
+					reason: "value length must be at most 8 runes",
+				}
+			}
+
+		case "field_mask":
+
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "order":
+
+			if _, ok := _SearchEndDevicesRequest_Order_InLookup[m.GetOrder()]; !ok {
+				return SearchEndDevicesRequestValidationError{
+					field:  "order",
+					reason: "value must be in list [ device_id -device_id join_eui -join_eui dev_eui -dev_eui name -name description -description created_at -created_at last_seen_at -last_seen_at]",
+				}
+			}
+
+		case "limit":
+
+			if m.GetLimit() > 1000 {
+				return SearchEndDevicesRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
+				}
+			}
+
+		case "page":
+			// no validation rules for Page
+		default:
+			return SearchEndDevicesRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// SearchEndDevicesRequestValidationError is the validation error returned by
+// SearchEndDevicesRequest.ValidateFields if the designated constraints aren't met.
+type SearchEndDevicesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SearchEndDevicesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SearchEndDevicesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SearchEndDevicesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SearchEndDevicesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SearchEndDevicesRequestValidationError) ErrorName() string {
+	return "SearchEndDevicesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SearchEndDevicesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEndDevicesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEndDevicesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEndDevicesRequestValidationError{}
+
+var _SearchEndDevicesRequest_AttributesContain_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
+
+var _SearchEndDevicesRequest_Order_InLookup = map[string]struct{}{
+	"":              {},
+	"device_id":     {},
+	"-device_id":    {},
+	"join_eui":      {},
+	"-join_eui":     {},
+	"dev_eui":       {},
+	"-dev_eui":      {},
+	"name":          {},
+	"-name":         {},
+	"description":   {},
+	"-description":  {},
+	"created_at":    {},
+	"-created_at":   {},
+	"last_seen_at":  {},
+	"-last_seen_at": {},
+}
+
+// ValidateFields checks the field values on SearchEndDevicesByLocationRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *SearchEndDevicesByLocationRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = SearchEndDevicesByLocationRequestFieldPathsNested
 	}
 
 	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
@@ -1374,7 +2156,7 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 		case "application_ids":
 
 			if m.GetApplicationIds() == nil {
-				return SearchEndDevicesRequestValidationError{
+				return SearchEndDevicesByLocationRequestValidationError{
 					field:  "application_ids",
 					reason: "value is required",
 				}
@@ -1382,7 +2164,7 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 
 			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return SearchEndDevicesRequestValidationError{
+					return SearchEndDevicesByLocationRequestValidationError{
 						field:  "application_ids",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1390,101 +2172,185 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
-		case "query":
+		case "field_mask":
 
-			if utf8.RuneCountInString(m.GetQuery()) > 50 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "query",
-					reason: "value length must be at most 50 runes",
+			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return SearchEndDevicesByLocationRequestValidationError{
+						field:  "field_mask",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
-		case "id_contains":
+		case "limit":
 
-			if utf8.RuneCountInString(m.GetIdContains()) > 50 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "id_contains",
-					reason: "value length must be at most 50 runes",
+			if m.GetLimit() > 1000 {
+				return SearchEndDevicesByLocationRequestValidationError{
+					field:  "limit",
+					reason: "value must be less than or equal to 1000",
 				}
 			}
 
-		case "name_contains":
-
-			if utf8.RuneCountInString(m.GetNameContains()) > 50 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "name_contains",
-					reason: "value length must be at most 50 runes",
+		case "page":
+			// no validation rules for Page
+		case "area":
+			if m.Area == nil {
+				return SearchEndDevicesByLocationRequestValidationError{
+					field:  "area",
+					reason: "value is required",
 				}
 			}
-
-		case "description_contains":
-
-			if utf8.RuneCountInString(m.GetDescriptionContains()) > 50 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "description_contains",
-					reason: "value length must be at most 50 runes",
+			if len(subs) == 0 {
+				subs = []string{
+					"bbox", "radius",
 				}
 			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "bbox":
+					w, ok := m.Area.(*SearchEndDevicesByLocationRequest_Bbox)
+					if !ok || w == nil {
+						continue
+					}
 
-		case "attributes_contain":
+					if v, ok := interface{}(m.GetBbox()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return SearchEndDevicesByLocationRequestValidationError{
+								field:  "bbox",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "radius":
+					w, ok := m.Area.(*SearchEndDevicesByLocationRequest_Radius)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRadius()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return SearchEndDevicesByLocationRequestValidationError{
+								field:  "radius",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
 
-			if len(m.GetAttributesContain()) > 10 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "attributes_contain",
-					reason: "value must contain no more than 10 pair(s)",
 				}
 			}
+		default:
+			return SearchEndDevicesByLocationRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
 
-			for key, val := range m.GetAttributesContain() {
-				_ = val
+// SearchEndDevicesByLocationRequestValidationError is the validation error
+// returned by SearchEndDevicesByLocationRequest.ValidateFields if the
+// designated constraints aren't met.
+type SearchEndDevicesByLocationRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-				if utf8.RuneCountInString(key) > 36 {
-					return SearchEndDevicesRequestValidationError{
-						field:  fmt.Sprintf("attributes_contain[%v]", key),
-						reason: "value length must be at most 36 runes",
-					}
-				}
+// Field function returns field value.
+func (e SearchEndDevicesByLocationRequestValidationError) Field() string { return e.field }
 
-				if !_SearchEndDevicesRequest_AttributesContain_Pattern.MatchString(key) {
-					return SearchEndDevicesRequestValidationError{
-						field:  fmt.Sprintf("attributes_contain[%v]", key),
-						reason: "value does not match regex pattern \"^[a-z0-9](?:[-]?[a-z0-9]){2,}$\"",
-					}
-				}
+// Reason function returns reason value.
+func (e SearchEndDevicesByLocationRequestValidationError) Reason() string { return e.reason }
 
-				if utf8.RuneCountInString(val) > 50 {
-					return SearchEndDevicesRequestValidationError{
-						field:  fmt.Sprintf("attributes_contain[%v]", key),
-						reason: "value length must be at most 50 runes",
-					}
-				}
+// Cause function returns cause value.
+func (e SearchEndDevicesByLocationRequestValidationError) Cause() error { return e.cause }
 
-			}
+// Key function returns key value.
+func (e SearchEndDevicesByLocationRequestValidationError) Key() bool { return e.key }
 
-		case "dev_eui_contains":
+// ErrorName returns error name.
+func (e SearchEndDevicesByLocationRequestValidationError) ErrorName() string {
+	return "SearchEndDevicesByLocationRequestValidationError"
+}
 
-			if utf8.RuneCountInString(m.GetDevEuiContains()) > 16 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "dev_eui_contains",
-					reason: "value length must be at most 16 runes",
+// Error satisfies the builtin error interface
+func (e SearchEndDevicesByLocationRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSearchEndDevicesByLocationRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SearchEndDevicesByLocationRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SearchEndDevicesByLocationRequestValidationError{}
+
+// ValidateFields checks the field values on ExportEndDeviceLocationsRequest
+// with the rules defined in the proto definition for this message. If any
+// rules are violated, an error is returned.
+func (m *ExportEndDeviceLocationsRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ExportEndDeviceLocationsRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "application_ids":
+
+			if m.GetApplicationIds() == nil {
+				return ExportEndDeviceLocationsRequestValidationError{
+					field:  "application_ids",
+					reason: "value is required",
 				}
 			}
 
-		case "join_eui_contains":
-
-			if utf8.RuneCountInString(m.GetJoinEuiContains()) > 16 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "join_eui_contains",
-					reason: "value length must be at most 16 runes",
+			if v, ok := interface{}(m.GetApplicationIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return ExportEndDeviceLocationsRequestValidationError{
+						field:  "application_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
 				}
 			}
 
-		case "dev_addr_contains":
+		case "format":
 
-			if utf8.RuneCountInString(m.GetDevAddrContains()) > 8 {
-				return SearchEndDevicesRequestValidationError{
-					field:  "dev_addr_contains",
-					reason: "value length must be at most 8 runes",
+			if _, ok := LocationExportFormat_name[int32(m.GetFormat())]; !ok {
+				return ExportEndDeviceLocationsRequestValidationError{
+					field:  "format",
+					reason: "value must be one of the defined enum values",
 				}
 			}
 
@@ -1492,7 +2358,7 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 
 			if v, ok := interface{}(m.GetFieldMask()).(interface{ ValidateFields(...string) error }); ok {
 				if err := v.ValidateFields(subs...); err != nil {
-					return SearchEndDevicesRequestValidationError{
+					return ExportEndDeviceLocationsRequestValidationError{
 						field:  "field_mask",
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -1500,28 +2366,62 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 				}
 			}
 
-		case "order":
-
-			if _, ok := _SearchEndDevicesRequest_Order_InLookup[m.GetOrder()]; !ok {
-				return SearchEndDevicesRequestValidationError{
-					field:  "order",
-					reason: "value must be in list [ device_id -device_id join_eui -join_eui dev_eui -dev_eui name -name description -description created_at -created_at last_seen_at -last_seen_at]",
-				}
-			}
-
 		case "limit":
 
-			if m.GetLimit() > 1000 {
-				return SearchEndDevicesRequestValidationError{
+			if m.GetLimit() > 10000 {
+				return ExportEndDeviceLocationsRequestValidationError{
 					field:  "limit",
-					reason: "value must be less than or equal to 1000",
+					reason: "value must be less than or equal to 10000",
 				}
 			}
 
 		case "page":
 			// no validation rules for Page
+		case "area":
+			if len(subs) == 0 {
+				subs = []string{
+					"bbox", "radius",
+				}
+			}
+			for name, subs := range _processPaths(subs) {
+				_ = subs
+				switch name {
+				case "bbox":
+					w, ok := m.Area.(*ExportEndDeviceLocationsRequest_Bbox)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetBbox()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ExportEndDeviceLocationsRequestValidationError{
+								field:  "bbox",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				case "radius":
+					w, ok := m.Area.(*ExportEndDeviceLocationsRequest_Radius)
+					if !ok || w == nil {
+						continue
+					}
+
+					if v, ok := interface{}(m.GetRadius()).(interface{ ValidateFields(...string) error }); ok {
+						if err := v.ValidateFields(subs...); err != nil {
+							return ExportEndDeviceLocationsRequestValidationError{
+								field:  "radius",
+								reason: "embedded message failed validation",
+								cause:  err,
+							}
+						}
+					}
+
+				}
+			}
 		default:
-			return SearchEndDevicesRequestValidationError{
+			return ExportEndDeviceLocationsRequestValidationError{
 				field:  name,
 				reason: "invalid field path",
 			}
@@ -1530,9 +2430,10 @@ func (m *SearchEndDevicesRequest) ValidateFields(paths ...string) error {
 	return nil
 }
 
-// SearchEndDevicesRequestValidationError is the validation error returned by
-// SearchEndDevicesRequest.ValidateFields if the designated constraints aren't met.
-type SearchEndDevicesRequestValidationError struct {
+// ExportEndDeviceLocationsRequestValidationError is the validation error
+// returned by ExportEndDeviceLocationsRequest.ValidateFields if the
+// designated constraints aren't met.
+type ExportEndDeviceLocationsRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1540,24 +2441,24 @@ type SearchEndDevicesRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e SearchEndDevicesRequestValidationError) Field() string { return e.field }
+func (e ExportEndDeviceLocationsRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e SearchEndDevicesRequestValidationError) Reason() string { return e.reason }
+func (e ExportEndDeviceLocationsRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e SearchEndDevicesRequestValidationError) Cause() error { return e.cause }
+func (e ExportEndDeviceLocationsRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e SearchEndDevicesRequestValidationError) Key() bool { return e.key }
+func (e ExportEndDeviceLocationsRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e SearchEndDevicesRequestValidationError) ErrorName() string {
-	return "SearchEndDevicesRequestValidationError"
+func (e ExportEndDeviceLocationsRequestValidationError) ErrorName() string {
+	return "ExportEndDeviceLocationsRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e SearchEndDevicesRequestValidationError) Error() string {
+func (e ExportEndDeviceLocationsRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1569,14 +2470,14 @@ func (e SearchEndDevicesRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sSearchEndDevicesRequest.%s: %s%s",
+		"invalid %sExportEndDeviceLocationsRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = SearchEndDevicesRequestValidationError{}
+var _ error = ExportEndDeviceLocationsRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -1584,24 +2485,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = SearchEndDevicesRequestValidationError{}
-
-var _SearchEndDevicesRequest_AttributesContain_Pattern = regexp.MustCompile("^[a-z0-9](?:[-]?[a-z0-9]){2,}$")
-
-var _SearchEndDevicesRequest_Order_InLookup = map[string]struct{}{
-	"":              {},
-	"device_id":     {},
-	"-device_id":    {},
-	"join_eui":      {},
-	"-join_eui":     {},
-	"dev_eui":       {},
-	"-dev_eui":      {},
-	"name":          {},
-	"-name":         {},
-	"description":   {},
-	"-description":  {},
-	"created_at":    {},
-	"-created_at":   {},
-	"last_seen_at":  {},
-	"-last_seen_at": {},
-}
+} = ExportEndDeviceLocationsRequestValidationError{}
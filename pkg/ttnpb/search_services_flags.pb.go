@@ -517,6 +517,85 @@ func (m *SearchAccountsRequest) SetFromFlags(flags *pflag.FlagSet, prefix string
 	return paths, nil
 }
 
+// AddSetFlagsForSearchGatewaysByLocationRequest adds flags to select fields in SearchGatewaysByLocationRequest.
+func AddSetFlagsForSearchGatewaysByLocationRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("limit", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("page", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the SearchGatewaysByLocationRequest message from flags.
+func (m *SearchGatewaysByLocationRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("field_mask", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.FieldMask = golang.SetFieldMask(val)
+		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("limit", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Limit = val
+		paths = append(paths, flagsplugin.Prefix("limit", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("page", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Page = val
+		paths = append(paths, flagsplugin.Prefix("page", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForExportGatewayLocationsRequest adds flags to select fields in ExportGatewayLocationsRequest.
+func AddSetFlagsForExportGatewayLocationsRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("format", prefix), flagsplugin.EnumValueDesc(LocationExportFormat_value), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("limit", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("page", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ExportGatewayLocationsRequest message from flags.
+func (m *ExportGatewayLocationsRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("format", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		enumValue, err := flagsplugin.SetEnumString(val, LocationExportFormat_value)
+		if err != nil {
+			return nil, err
+		}
+		m.Format = LocationExportFormat(enumValue)
+		paths = append(paths, flagsplugin.Prefix("format", prefix))
+	}
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("field_mask", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.FieldMask = golang.SetFieldMask(val)
+		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("limit", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Limit = val
+		paths = append(paths, flagsplugin.Prefix("limit", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("page", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Page = val
+		paths = append(paths, flagsplugin.Prefix("page", prefix))
+	}
+	return paths, nil
+}
+
 // AddSetFlagsForSearchEndDevicesRequest adds flags to select fields in SearchEndDevicesRequest.
 func AddSetFlagsForSearchEndDevicesRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
 	AddSetFlagsForApplicationIdentifiers(flags, flagsplugin.Prefix("application-ids", prefix), hidden)
@@ -620,3 +699,104 @@ func (m *SearchEndDevicesRequest) SetFromFlags(flags *pflag.FlagSet, prefix stri
 	}
 	return paths, nil
 }
+
+// AddSetFlagsForSearchEndDevicesByLocationRequest adds flags to select fields in SearchEndDevicesByLocationRequest.
+func AddSetFlagsForSearchEndDevicesByLocationRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForApplicationIdentifiers(flags, flagsplugin.Prefix("application-ids", prefix), hidden)
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("limit", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("page", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the SearchEndDevicesByLocationRequest message from flags.
+func (m *SearchEndDevicesByLocationRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("application_ids", prefix)); changed {
+		if m.ApplicationIds == nil {
+			m.ApplicationIds = &ApplicationIdentifiers{}
+		}
+		if setPaths, err := m.ApplicationIds.SetFromFlags(flags, flagsplugin.Prefix("application_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("field_mask", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.FieldMask = golang.SetFieldMask(val)
+		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("limit", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Limit = val
+		paths = append(paths, flagsplugin.Prefix("limit", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("page", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Page = val
+		paths = append(paths, flagsplugin.Prefix("page", prefix))
+	}
+	return paths, nil
+}
+
+// AddSetFlagsForExportEndDeviceLocationsRequest adds flags to select fields in ExportEndDeviceLocationsRequest.
+func AddSetFlagsForExportEndDeviceLocationsRequest(flags *pflag.FlagSet, prefix string, hidden bool) {
+	AddSetFlagsForApplicationIdentifiers(flags, flagsplugin.Prefix("application-ids", prefix), hidden)
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	flags.AddFlag(flagsplugin.NewStringFlag(flagsplugin.Prefix("format", prefix), flagsplugin.EnumValueDesc(LocationExportFormat_value), flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewStringSliceFlag(flagsplugin.Prefix("field-mask", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("limit", prefix), "", flagsplugin.WithHidden(hidden)))
+	flags.AddFlag(flagsplugin.NewUint32Flag(flagsplugin.Prefix("page", prefix), "", flagsplugin.WithHidden(hidden)))
+}
+
+// SetFromFlags sets the ExportEndDeviceLocationsRequest message from flags.
+func (m *ExportEndDeviceLocationsRequest) SetFromFlags(flags *pflag.FlagSet, prefix string) (paths []string, err error) {
+	if changed := flagsplugin.IsAnyPrefixSet(flags, flagsplugin.Prefix("application_ids", prefix)); changed {
+		if m.ApplicationIds == nil {
+			m.ApplicationIds = &ApplicationIdentifiers{}
+		}
+		if setPaths, err := m.ApplicationIds.SetFromFlags(flags, flagsplugin.Prefix("application_ids", prefix)); err != nil {
+			return nil, err
+		} else {
+			paths = append(paths, setPaths...)
+		}
+	}
+	// FIXME: Skipping Bbox because it does not seem to implement AddSetFlags.
+	// FIXME: Skipping Radius because it does not seem to implement AddSetFlags.
+	if val, changed, err := flagsplugin.GetString(flags, flagsplugin.Prefix("format", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		enumValue, err := flagsplugin.SetEnumString(val, LocationExportFormat_value)
+		if err != nil {
+			return nil, err
+		}
+		m.Format = LocationExportFormat(enumValue)
+		paths = append(paths, flagsplugin.Prefix("format", prefix))
+	}
+	if val, changed, err := flagsplugin.GetStringSlice(flags, flagsplugin.Prefix("field_mask", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.FieldMask = golang.SetFieldMask(val)
+		paths = append(paths, flagsplugin.Prefix("field_mask", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("limit", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Limit = val
+		paths = append(paths, flagsplugin.Prefix("limit", prefix))
+	}
+	if val, changed, err := flagsplugin.GetUint32(flags, flagsplugin.Prefix("page", prefix)); err != nil {
+		return nil, err
+	} else if changed {
+		m.Page = val
+		paths = append(paths, flagsplugin.Prefix("page", prefix))
+	}
+	return paths, nil
+}
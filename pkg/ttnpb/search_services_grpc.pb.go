@@ -22,6 +22,7 @@ package ttnpb
 
 import (
 	context "context"
+	httpbody "google.golang.org/genproto/googleapis/api/httpbody"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -33,12 +34,14 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	EntityRegistrySearch_SearchApplications_FullMethodName  = "/ttn.lorawan.v3.EntityRegistrySearch/SearchApplications"
-	EntityRegistrySearch_SearchClients_FullMethodName       = "/ttn.lorawan.v3.EntityRegistrySearch/SearchClients"
-	EntityRegistrySearch_SearchGateways_FullMethodName      = "/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways"
-	EntityRegistrySearch_SearchOrganizations_FullMethodName = "/ttn.lorawan.v3.EntityRegistrySearch/SearchOrganizations"
-	EntityRegistrySearch_SearchUsers_FullMethodName         = "/ttn.lorawan.v3.EntityRegistrySearch/SearchUsers"
-	EntityRegistrySearch_SearchAccounts_FullMethodName      = "/ttn.lorawan.v3.EntityRegistrySearch/SearchAccounts"
+	EntityRegistrySearch_SearchApplications_FullMethodName       = "/ttn.lorawan.v3.EntityRegistrySearch/SearchApplications"
+	EntityRegistrySearch_SearchClients_FullMethodName            = "/ttn.lorawan.v3.EntityRegistrySearch/SearchClients"
+	EntityRegistrySearch_SearchGateways_FullMethodName           = "/ttn.lorawan.v3.EntityRegistrySearch/SearchGateways"
+	EntityRegistrySearch_SearchGatewaysByLocation_FullMethodName = "/ttn.lorawan.v3.EntityRegistrySearch/SearchGatewaysByLocation"
+	EntityRegistrySearch_ExportGatewayLocations_FullMethodName   = "/ttn.lorawan.v3.EntityRegistrySearch/ExportGatewayLocations"
+	EntityRegistrySearch_SearchOrganizations_FullMethodName      = "/ttn.lorawan.v3.EntityRegistrySearch/SearchOrganizations"
+	EntityRegistrySearch_SearchUsers_FullMethodName              = "/ttn.lorawan.v3.EntityRegistrySearch/SearchUsers"
+	EntityRegistrySearch_SearchAccounts_FullMethodName           = "/ttn.lorawan.v3.EntityRegistrySearch/SearchAccounts"
 )
 
 // EntityRegistrySearchClient is the client API for EntityRegistrySearch service.
@@ -54,6 +57,12 @@ type EntityRegistrySearchClient interface {
 	// Search for gateways that match the conditions specified in the request.
 	// Non-admin users will only match gateways that they have rights on.
 	SearchGateways(ctx context.Context, in *SearchGatewaysRequest, opts ...grpc.CallOption) (*Gateways, error)
+	// Search for gateways with an antenna in the given area.
+	// Gateways that the caller does not have the RIGHT_GATEWAY_INFO right for are returned with their public fields only.
+	SearchGatewaysByLocation(ctx context.Context, in *SearchGatewaysByLocationRequest, opts ...grpc.CallOption) (*Gateways, error)
+	// Export the antenna locations of the gateways in the given area as GeoJSON or KML,
+	// for importing coverage data into mapping tools.
+	ExportGatewayLocations(ctx context.Context, in *ExportGatewayLocationsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 	// Search for organizations that match the conditions specified in the request.
 	// Non-admin users will only match organizations that they have rights on.
 	SearchOrganizations(ctx context.Context, in *SearchOrganizationsRequest, opts ...grpc.CallOption) (*Organizations, error)
@@ -99,6 +108,24 @@ func (c *entityRegistrySearchClient) SearchGateways(ctx context.Context, in *Sea
 	return out, nil
 }
 
+func (c *entityRegistrySearchClient) SearchGatewaysByLocation(ctx context.Context, in *SearchGatewaysByLocationRequest, opts ...grpc.CallOption) (*Gateways, error) {
+	out := new(Gateways)
+	err := c.cc.Invoke(ctx, EntityRegistrySearch_SearchGatewaysByLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entityRegistrySearchClient) ExportGatewayLocations(ctx context.Context, in *ExportGatewayLocationsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, EntityRegistrySearch_ExportGatewayLocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *entityRegistrySearchClient) SearchOrganizations(ctx context.Context, in *SearchOrganizationsRequest, opts ...grpc.CallOption) (*Organizations, error) {
 	out := new(Organizations)
 	err := c.cc.Invoke(ctx, EntityRegistrySearch_SearchOrganizations_FullMethodName, in, out, opts...)
@@ -139,6 +166,12 @@ type EntityRegistrySearchServer interface {
 	// Search for gateways that match the conditions specified in the request.
 	// Non-admin users will only match gateways that they have rights on.
 	SearchGateways(context.Context, *SearchGatewaysRequest) (*Gateways, error)
+	// Search for gateways with an antenna in the given area.
+	// Gateways that the caller does not have the RIGHT_GATEWAY_INFO right for are returned with their public fields only.
+	SearchGatewaysByLocation(context.Context, *SearchGatewaysByLocationRequest) (*Gateways, error)
+	// Export the antenna locations of the gateways in the given area as GeoJSON or KML,
+	// for importing coverage data into mapping tools.
+	ExportGatewayLocations(context.Context, *ExportGatewayLocationsRequest) (*httpbody.HttpBody, error)
 	// Search for organizations that match the conditions specified in the request.
 	// Non-admin users will only match organizations that they have rights on.
 	SearchOrganizations(context.Context, *SearchOrganizationsRequest) (*Organizations, error)
@@ -163,6 +196,12 @@ func (UnimplementedEntityRegistrySearchServer) SearchClients(context.Context, *S
 func (UnimplementedEntityRegistrySearchServer) SearchGateways(context.Context, *SearchGatewaysRequest) (*Gateways, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchGateways not implemented")
 }
+func (UnimplementedEntityRegistrySearchServer) SearchGatewaysByLocation(context.Context, *SearchGatewaysByLocationRequest) (*Gateways, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchGatewaysByLocation not implemented")
+}
+func (UnimplementedEntityRegistrySearchServer) ExportGatewayLocations(context.Context, *ExportGatewayLocationsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportGatewayLocations not implemented")
+}
 func (UnimplementedEntityRegistrySearchServer) SearchOrganizations(context.Context, *SearchOrganizationsRequest) (*Organizations, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchOrganizations not implemented")
 }
@@ -239,6 +278,42 @@ func _EntityRegistrySearch_SearchGateways_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EntityRegistrySearch_SearchGatewaysByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGatewaysByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityRegistrySearchServer).SearchGatewaysByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityRegistrySearch_SearchGatewaysByLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityRegistrySearchServer).SearchGatewaysByLocation(ctx, req.(*SearchGatewaysByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EntityRegistrySearch_ExportGatewayLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportGatewayLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntityRegistrySearchServer).ExportGatewayLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EntityRegistrySearch_ExportGatewayLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntityRegistrySearchServer).ExportGatewayLocations(ctx, req.(*ExportGatewayLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _EntityRegistrySearch_SearchOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchOrganizationsRequest)
 	if err := dec(in); err != nil {
@@ -312,6 +387,14 @@ var EntityRegistrySearch_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchGateways",
 			Handler:    _EntityRegistrySearch_SearchGateways_Handler,
 		},
+		{
+			MethodName: "SearchGatewaysByLocation",
+			Handler:    _EntityRegistrySearch_SearchGatewaysByLocation_Handler,
+		},
+		{
+			MethodName: "ExportGatewayLocations",
+			Handler:    _EntityRegistrySearch_ExportGatewayLocations_Handler,
+		},
 		{
 			MethodName: "SearchOrganizations",
 			Handler:    _EntityRegistrySearch_SearchOrganizations_Handler,
@@ -330,7 +413,9 @@ var EntityRegistrySearch_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	EndDeviceRegistrySearch_SearchEndDevices_FullMethodName = "/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevices"
+	EndDeviceRegistrySearch_SearchEndDevices_FullMethodName           = "/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevices"
+	EndDeviceRegistrySearch_SearchEndDevicesByLocation_FullMethodName = "/ttn.lorawan.v3.EndDeviceRegistrySearch/SearchEndDevicesByLocation"
+	EndDeviceRegistrySearch_ExportEndDeviceLocations_FullMethodName   = "/ttn.lorawan.v3.EndDeviceRegistrySearch/ExportEndDeviceLocations"
 )
 
 // EndDeviceRegistrySearchClient is the client API for EndDeviceRegistrySearch service.
@@ -339,6 +424,11 @@ const (
 type EndDeviceRegistrySearchClient interface {
 	// Search for end devices in the given application that match the conditions specified in the request.
 	SearchEndDevices(ctx context.Context, in *SearchEndDevicesRequest, opts ...grpc.CallOption) (*EndDevices, error)
+	// Search for end devices in the given application with a location in the given area.
+	SearchEndDevicesByLocation(ctx context.Context, in *SearchEndDevicesByLocationRequest, opts ...grpc.CallOption) (*EndDevices, error)
+	// Export the locations of the end devices in the given application and area as GeoJSON or KML,
+	// for importing coverage data into mapping tools.
+	ExportEndDeviceLocations(ctx context.Context, in *ExportEndDeviceLocationsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error)
 }
 
 type endDeviceRegistrySearchClient struct {
@@ -358,12 +448,35 @@ func (c *endDeviceRegistrySearchClient) SearchEndDevices(ctx context.Context, in
 	return out, nil
 }
 
+func (c *endDeviceRegistrySearchClient) SearchEndDevicesByLocation(ctx context.Context, in *SearchEndDevicesByLocationRequest, opts ...grpc.CallOption) (*EndDevices, error) {
+	out := new(EndDevices)
+	err := c.cc.Invoke(ctx, EndDeviceRegistrySearch_SearchEndDevicesByLocation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *endDeviceRegistrySearchClient) ExportEndDeviceLocations(ctx context.Context, in *ExportEndDeviceLocationsRequest, opts ...grpc.CallOption) (*httpbody.HttpBody, error) {
+	out := new(httpbody.HttpBody)
+	err := c.cc.Invoke(ctx, EndDeviceRegistrySearch_ExportEndDeviceLocations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EndDeviceRegistrySearchServer is the server API for EndDeviceRegistrySearch service.
 // All implementations must embed UnimplementedEndDeviceRegistrySearchServer
 // for forward compatibility
 type EndDeviceRegistrySearchServer interface {
 	// Search for end devices in the given application that match the conditions specified in the request.
 	SearchEndDevices(context.Context, *SearchEndDevicesRequest) (*EndDevices, error)
+	// Search for end devices in the given application with a location in the given area.
+	SearchEndDevicesByLocation(context.Context, *SearchEndDevicesByLocationRequest) (*EndDevices, error)
+	// Export the locations of the end devices in the given application and area as GeoJSON or KML,
+	// for importing coverage data into mapping tools.
+	ExportEndDeviceLocations(context.Context, *ExportEndDeviceLocationsRequest) (*httpbody.HttpBody, error)
 	mustEmbedUnimplementedEndDeviceRegistrySearchServer()
 }
 
@@ -374,6 +487,12 @@ type UnimplementedEndDeviceRegistrySearchServer struct {
 func (UnimplementedEndDeviceRegistrySearchServer) SearchEndDevices(context.Context, *SearchEndDevicesRequest) (*EndDevices, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SearchEndDevices not implemented")
 }
+func (UnimplementedEndDeviceRegistrySearchServer) SearchEndDevicesByLocation(context.Context, *SearchEndDevicesByLocationRequest) (*EndDevices, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchEndDevicesByLocation not implemented")
+}
+func (UnimplementedEndDeviceRegistrySearchServer) ExportEndDeviceLocations(context.Context, *ExportEndDeviceLocationsRequest) (*httpbody.HttpBody, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportEndDeviceLocations not implemented")
+}
 func (UnimplementedEndDeviceRegistrySearchServer) mustEmbedUnimplementedEndDeviceRegistrySearchServer() {
 }
 
@@ -406,6 +525,42 @@ func _EndDeviceRegistrySearch_SearchEndDevices_Handler(srv interface{}, ctx cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _EndDeviceRegistrySearch_SearchEndDevicesByLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchEndDevicesByLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistrySearchServer).SearchEndDevicesByLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndDeviceRegistrySearch_SearchEndDevicesByLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistrySearchServer).SearchEndDevicesByLocation(ctx, req.(*SearchEndDevicesByLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _EndDeviceRegistrySearch_ExportEndDeviceLocations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportEndDeviceLocationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EndDeviceRegistrySearchServer).ExportEndDeviceLocations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: EndDeviceRegistrySearch_ExportEndDeviceLocations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EndDeviceRegistrySearchServer).ExportEndDeviceLocations(ctx, req.(*ExportEndDeviceLocationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // EndDeviceRegistrySearch_ServiceDesc is the grpc.ServiceDesc for EndDeviceRegistrySearch service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -417,6 +572,14 @@ var EndDeviceRegistrySearch_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SearchEndDevices",
 			Handler:    _EndDeviceRegistrySearch_SearchEndDevices_Handler,
 		},
+		{
+			MethodName: "SearchEndDevicesByLocation",
+			Handler:    _EndDeviceRegistrySearch_SearchEndDevicesByLocation_Handler,
+		},
+		{
+			MethodName: "ExportEndDeviceLocations",
+			Handler:    _EndDeviceRegistrySearch_ExportEndDeviceLocations_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ttn/lorawan/v3/search_services.proto",
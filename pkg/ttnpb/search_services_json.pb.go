@@ -881,6 +881,230 @@ func (x *SearchAccountsRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
 
+// MarshalProtoJSON marshals the SearchGatewaysByLocationRequest message to JSON.
+func (x *SearchGatewaysByLocationRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Area != nil {
+		switch ov := x.Area.(type) {
+		case *SearchGatewaysByLocationRequest_Bbox:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("bbox")
+			// NOTE: GeoBoundingBox does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Bbox)
+		case *SearchGatewaysByLocationRequest_Radius:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("radius")
+			// NOTE: GeoRadius does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Radius)
+		}
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SearchGatewaysByLocationRequest to JSON.
+func (x *SearchGatewaysByLocationRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SearchGatewaysByLocationRequest message from JSON.
+func (x *SearchGatewaysByLocationRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "bbox":
+			s.AddField("bbox")
+			ov := &SearchGatewaysByLocationRequest_Bbox{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Bbox = nil
+				return
+			}
+			// NOTE: GeoBoundingBox does not seem to implement UnmarshalProtoJSON.
+			var v GeoBoundingBox
+			golang.UnmarshalMessage(s, &v)
+			ov.Bbox = &v
+		case "radius":
+			s.AddField("radius")
+			ov := &SearchGatewaysByLocationRequest_Radius{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Radius = nil
+				return
+			}
+			// NOTE: GeoRadius does not seem to implement UnmarshalProtoJSON.
+			var v GeoRadius
+			golang.UnmarshalMessage(s, &v)
+			ov.Radius = &v
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SearchGatewaysByLocationRequest from JSON.
+func (x *SearchGatewaysByLocationRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExportGatewayLocationsRequest message to JSON.
+func (x *ExportGatewayLocationsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.Area != nil {
+		switch ov := x.Area.(type) {
+		case *ExportGatewayLocationsRequest_Bbox:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("bbox")
+			// NOTE: GeoBoundingBox does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Bbox)
+		case *ExportGatewayLocationsRequest_Radius:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("radius")
+			// NOTE: GeoRadius does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Radius)
+		}
+	}
+	if x.Format != 0 || s.HasField("format") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("format")
+		s.WriteEnum(int32(x.Format), LocationExportFormat_name)
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExportGatewayLocationsRequest to JSON.
+func (x *ExportGatewayLocationsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExportGatewayLocationsRequest message from JSON.
+func (x *ExportGatewayLocationsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "bbox":
+			s.AddField("bbox")
+			ov := &ExportGatewayLocationsRequest_Bbox{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Bbox = nil
+				return
+			}
+			// NOTE: GeoBoundingBox does not seem to implement UnmarshalProtoJSON.
+			var v GeoBoundingBox
+			golang.UnmarshalMessage(s, &v)
+			ov.Bbox = &v
+		case "radius":
+			s.AddField("radius")
+			ov := &ExportGatewayLocationsRequest_Radius{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Radius = nil
+				return
+			}
+			// NOTE: GeoRadius does not seem to implement UnmarshalProtoJSON.
+			var v GeoRadius
+			golang.UnmarshalMessage(s, &v)
+			ov.Radius = &v
+		case "format":
+			s.AddField("format")
+			x.Format = LocationExportFormat(s.ReadEnum(LocationExportFormat_value))
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExportGatewayLocationsRequest from JSON.
+func (x *ExportGatewayLocationsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
 // MarshalProtoJSON marshals the SearchEndDevicesRequest message to JSON.
 func (x *SearchEndDevicesRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
 	if x == nil {
@@ -1052,3 +1276,259 @@ func (x *SearchEndDevicesRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalStat
 func (x *SearchEndDevicesRequest) UnmarshalJSON(b []byte) error {
 	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
 }
+
+// MarshalProtoJSON marshals the SearchEndDevicesByLocationRequest message to JSON.
+func (x *SearchEndDevicesByLocationRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if x.Area != nil {
+		switch ov := x.Area.(type) {
+		case *SearchEndDevicesByLocationRequest_Bbox:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("bbox")
+			// NOTE: GeoBoundingBox does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Bbox)
+		case *SearchEndDevicesByLocationRequest_Radius:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("radius")
+			// NOTE: GeoRadius does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Radius)
+		}
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the SearchEndDevicesByLocationRequest to JSON.
+func (x *SearchEndDevicesByLocationRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the SearchEndDevicesByLocationRequest message from JSON.
+func (x *SearchEndDevicesByLocationRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "bbox":
+			s.AddField("bbox")
+			ov := &SearchEndDevicesByLocationRequest_Bbox{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Bbox = nil
+				return
+			}
+			// NOTE: GeoBoundingBox does not seem to implement UnmarshalProtoJSON.
+			var v GeoBoundingBox
+			golang.UnmarshalMessage(s, &v)
+			ov.Bbox = &v
+		case "radius":
+			s.AddField("radius")
+			ov := &SearchEndDevicesByLocationRequest_Radius{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Radius = nil
+				return
+			}
+			// NOTE: GeoRadius does not seem to implement UnmarshalProtoJSON.
+			var v GeoRadius
+			golang.UnmarshalMessage(s, &v)
+			ov.Radius = &v
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the SearchEndDevicesByLocationRequest from JSON.
+func (x *SearchEndDevicesByLocationRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
+
+// MarshalProtoJSON marshals the ExportEndDeviceLocationsRequest message to JSON.
+func (x *ExportEndDeviceLocationsRequest) MarshalProtoJSON(s *jsonplugin.MarshalState) {
+	if x == nil {
+		s.WriteNil()
+		return
+	}
+	s.WriteObjectStart()
+	var wroteField bool
+	if x.ApplicationIds != nil || s.HasField("application_ids") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("application_ids")
+		// NOTE: ApplicationIdentifiers does not seem to implement MarshalProtoJSON.
+		golang.MarshalMessage(s, x.ApplicationIds)
+	}
+	if x.Area != nil {
+		switch ov := x.Area.(type) {
+		case *ExportEndDeviceLocationsRequest_Bbox:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("bbox")
+			// NOTE: GeoBoundingBox does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Bbox)
+		case *ExportEndDeviceLocationsRequest_Radius:
+			s.WriteMoreIf(&wroteField)
+			s.WriteObjectField("radius")
+			// NOTE: GeoRadius does not seem to implement MarshalProtoJSON.
+			golang.MarshalMessage(s, ov.Radius)
+		}
+	}
+	if x.Format != 0 || s.HasField("format") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("format")
+		s.WriteEnum(int32(x.Format), LocationExportFormat_name)
+	}
+	if x.FieldMask != nil || s.HasField("field_mask") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("field_mask")
+		if x.FieldMask == nil {
+			s.WriteNil()
+		} else {
+			golang.MarshalLegacyFieldMask(s, x.FieldMask)
+		}
+	}
+	if x.Limit != 0 || s.HasField("limit") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("limit")
+		s.WriteUint32(x.Limit)
+	}
+	if x.Page != 0 || s.HasField("page") {
+		s.WriteMoreIf(&wroteField)
+		s.WriteObjectField("page")
+		s.WriteUint32(x.Page)
+	}
+	s.WriteObjectEnd()
+}
+
+// MarshalJSON marshals the ExportEndDeviceLocationsRequest to JSON.
+func (x *ExportEndDeviceLocationsRequest) MarshalJSON() ([]byte, error) {
+	return jsonplugin.DefaultMarshalerConfig.Marshal(x)
+}
+
+// UnmarshalProtoJSON unmarshals the ExportEndDeviceLocationsRequest message from JSON.
+func (x *ExportEndDeviceLocationsRequest) UnmarshalProtoJSON(s *jsonplugin.UnmarshalState) {
+	if s.ReadNil() {
+		return
+	}
+	s.ReadObject(func(key string) {
+		switch key {
+		default:
+			s.ReadAny() // ignore unknown field
+		case "application_ids", "applicationIds":
+			s.AddField("application_ids")
+			if s.ReadNil() {
+				x.ApplicationIds = nil
+				return
+			}
+			// NOTE: ApplicationIdentifiers does not seem to implement UnmarshalProtoJSON.
+			var v ApplicationIdentifiers
+			golang.UnmarshalMessage(s, &v)
+			x.ApplicationIds = &v
+		case "bbox":
+			s.AddField("bbox")
+			ov := &ExportEndDeviceLocationsRequest_Bbox{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Bbox = nil
+				return
+			}
+			// NOTE: GeoBoundingBox does not seem to implement UnmarshalProtoJSON.
+			var v GeoBoundingBox
+			golang.UnmarshalMessage(s, &v)
+			ov.Bbox = &v
+		case "radius":
+			s.AddField("radius")
+			ov := &ExportEndDeviceLocationsRequest_Radius{}
+			x.Area = ov
+			if s.ReadNil() {
+				ov.Radius = nil
+				return
+			}
+			// NOTE: GeoRadius does not seem to implement UnmarshalProtoJSON.
+			var v GeoRadius
+			golang.UnmarshalMessage(s, &v)
+			ov.Radius = &v
+		case "format":
+			s.AddField("format")
+			x.Format = LocationExportFormat(s.ReadEnum(LocationExportFormat_value))
+		case "field_mask", "fieldMask":
+			s.AddField("field_mask")
+			if s.ReadNil() {
+				x.FieldMask = nil
+				return
+			}
+			v := golang.UnmarshalFieldMask(s)
+			if s.Err() != nil {
+				return
+			}
+			x.FieldMask = v
+		case "limit":
+			s.AddField("limit")
+			x.Limit = s.ReadUint32()
+		case "page":
+			s.AddField("page")
+			x.Page = s.ReadUint32()
+		}
+	})
+}
+
+// UnmarshalJSON unmarshals the ExportEndDeviceLocationsRequest from JSON.
+func (x *ExportEndDeviceLocationsRequest) UnmarshalJSON(b []byte) error {
+	return jsonplugin.DefaultUnmarshalerConfig.Unmarshal(b, x)
+}
@@ -27,6 +27,7 @@ type Data struct {
 	Stat        *Stat        `json:"stat,omitempty"`
 	TxPacket    *TxPacket    `json:"txpk,omitempty"`
 	TxPacketAck *TxPacketAck `json:"txpk_ack,omitempty"`
+	Spectral    *Spectral    `json:"spectral,omitempty"`
 }
 
 // RxPacket contains a Rx message
@@ -103,6 +104,18 @@ type RSig struct {
 	Fdri   int32   `json:"fdri"`   // Frequency drift in Hz between start and end of a LR-FHSS packet (signed)
 }
 
+// Spectral contains a spectral scan report, as sent by spectral scan sidecars of the packet forwarder.
+type Spectral struct {
+	Time *CompactTime      `json:"time,omitempty"` // UTC time of the scan, us precision, ISO 8601 'compact' format
+	Chan []SpectralChannel `json:"chan"`           // Background RSSI per channel
+}
+
+// SpectralChannel contains the background RSSI of a channel.
+type SpectralChannel struct {
+	Freq float64 `json:"freq"` // Central frequency in MHz (unsigned float, Hz precision)
+	RSSI float32 `json:"rssi"` // Background RSSI in dBm (signed float, 0.1 dB precision)
+}
+
 // TxPacket contains a Tx message
 type TxPacket struct {
 	Imme bool         `json:"imme"`           // Send packet immediately (will ignore tmst & time)
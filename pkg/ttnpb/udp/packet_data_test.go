@@ -62,6 +62,34 @@ func TestStatusPacket(t *testing.T) {
 	a.So(d.Stat.TxNb, should.Equal, 0)
 }
 
+func TestSpectralPacket(t *testing.T) {
+	t.Parallel()
+	spectralPacket := `{
+		"spectral":{
+		   "time":"2017-06-08T09:40:42.123456Z",
+		   "chan":[
+			  {"freq":868.1,"rssi":-121.5},
+			  {"freq":868.3,"rssi":-98}
+		   ]
+		}
+	 }`
+	var d Data
+	err := json.Unmarshal([]byte(spectralPacket), &d)
+	if err != nil {
+		t.Error("Couldn't unmarshal spectral data:", err)
+	}
+
+	a := assertions.New(t)
+	if !a.So(d.Spectral, should.NotBeNil) {
+		t.FailNow()
+	}
+	a.So(d.Spectral.Time, should.NotBeNil)
+	a.So(d.Spectral.Chan, should.Resemble, []SpectralChannel{
+		{Freq: 868.1, RSSI: -121.5},
+		{Freq: 868.3, RSSI: -98},
+	})
+}
+
 func TestUplinkPacket(t *testing.T) {
 	t.Parallel()
 	uplinkPacket := `{
@@ -165,6 +165,17 @@ var CreateTemporaryPasswordRequestFieldPathsNested = []string{
 var CreateTemporaryPasswordRequestFieldPathsTopLevel = []string{
 	"user_ids",
 }
+var VerifyPasswordResetRequestFieldPathsNested = []string{
+	"code",
+	"user_ids",
+	"user_ids.email",
+	"user_ids.user_id",
+}
+
+var VerifyPasswordResetRequestFieldPathsTopLevel = []string{
+	"code",
+	"user_ids",
+}
 var UpdateUserPasswordRequestFieldPathsNested = []string{
 	"new",
 	"old",
@@ -180,6 +191,35 @@ var UpdateUserPasswordRequestFieldPathsTopLevel = []string{
 	"revoke_all_access",
 	"user_ids",
 }
+var ConfirmEmailChangeRequestFieldPathsNested = []string{
+	"reference",
+	"token",
+}
+
+var ConfirmEmailChangeRequestFieldPathsTopLevel = []string{
+	"reference",
+	"token",
+}
+var RevertEmailChangeRequestFieldPathsNested = []string{
+	"reference",
+	"token",
+}
+
+var RevertEmailChangeRequestFieldPathsTopLevel = []string{
+	"reference",
+	"token",
+}
+var EmailChangeStatusFieldPathsNested = []string{
+	"completed",
+	"new_confirmed",
+	"old_confirmed",
+}
+
+var EmailChangeStatusFieldPathsTopLevel = []string{
+	"completed",
+	"new_confirmed",
+	"old_confirmed",
+}
 var ListUserAPIKeysRequestFieldPathsNested = []string{
 	"limit",
 	"order",
@@ -487,6 +487,52 @@ func (dst *CreateTemporaryPasswordRequest) SetFields(src *CreateTemporaryPasswor
 	return nil
 }
 
+func (dst *VerifyPasswordResetRequest) SetFields(src *VerifyPasswordResetRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "user_ids":
+			if len(subs) > 0 {
+				var newDst, newSrc *UserIdentifiers
+				if (src == nil || src.UserIds == nil) && dst.UserIds == nil {
+					continue
+				}
+				if src != nil {
+					newSrc = src.UserIds
+				}
+				if dst.UserIds != nil {
+					newDst = dst.UserIds
+				} else {
+					newDst = &UserIdentifiers{}
+					dst.UserIds = newDst
+				}
+				if err := newDst.SetFields(newSrc, subs...); err != nil {
+					return err
+				}
+			} else {
+				if src != nil {
+					dst.UserIds = src.UserIds
+				} else {
+					dst.UserIds = nil
+				}
+			}
+		case "code":
+			if len(subs) > 0 {
+				return fmt.Errorf("'code' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Code = src.Code
+			} else {
+				var zero string
+				dst.Code = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *UpdateUserPasswordRequest) SetFields(src *UpdateUserPasswordRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -553,6 +599,109 @@ func (dst *UpdateUserPasswordRequest) SetFields(src *UpdateUserPasswordRequest,
 	return nil
 }
 
+func (dst *ConfirmEmailChangeRequest) SetFields(src *ConfirmEmailChangeRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "reference":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reference' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reference = src.Reference
+			} else {
+				var zero string
+				dst.Reference = zero
+			}
+		case "token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Token = src.Token
+			} else {
+				var zero string
+				dst.Token = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *RevertEmailChangeRequest) SetFields(src *RevertEmailChangeRequest, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "reference":
+			if len(subs) > 0 {
+				return fmt.Errorf("'reference' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Reference = src.Reference
+			} else {
+				var zero string
+				dst.Reference = zero
+			}
+		case "token":
+			if len(subs) > 0 {
+				return fmt.Errorf("'token' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Token = src.Token
+			} else {
+				var zero string
+				dst.Token = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
+func (dst *EmailChangeStatus) SetFields(src *EmailChangeStatus, paths ...string) error {
+	for name, subs := range _processPaths(paths) {
+		switch name {
+		case "old_confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'old_confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.OldConfirmed = src.OldConfirmed
+			} else {
+				var zero bool
+				dst.OldConfirmed = zero
+			}
+		case "new_confirmed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'new_confirmed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.NewConfirmed = src.NewConfirmed
+			} else {
+				var zero bool
+				dst.NewConfirmed = zero
+			}
+		case "completed":
+			if len(subs) > 0 {
+				return fmt.Errorf("'completed' has no subfields, but %s were specified", subs)
+			}
+			if src != nil {
+				dst.Completed = src.Completed
+			} else {
+				var zero bool
+				dst.Completed = zero
+			}
+
+		default:
+			return fmt.Errorf("invalid field: '%s'", name)
+		}
+	}
+	return nil
+}
+
 func (dst *ListUserAPIKeysRequest) SetFields(src *ListUserAPIKeysRequest, paths ...string) error {
 	for name, subs := range _processPaths(paths) {
 		switch name {
@@ -32,6 +32,9 @@ var (
 	_ = anypb.Any{}
 )
 
+// define the regex for a UUID once up-front
+var _user_uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
 // ValidateFields checks the field values on User with the rules defined in the
 // proto definition for this message. If any rules are violated, an error is returned.
 func (m *User) ValidateFields(paths ...string) error {
@@ -1054,6 +1057,116 @@ var _ interface {
 	ErrorName() string
 } = CreateTemporaryPasswordRequestValidationError{}
 
+// ValidateFields checks the field values on VerifyPasswordResetRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, an error is returned.
+func (m *VerifyPasswordResetRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = VerifyPasswordResetRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "user_ids":
+
+			if m.GetUserIds() == nil {
+				return VerifyPasswordResetRequestValidationError{
+					field:  "user_ids",
+					reason: "value is required",
+				}
+			}
+
+			if v, ok := interface{}(m.GetUserIds()).(interface{ ValidateFields(...string) error }); ok {
+				if err := v.ValidateFields(subs...); err != nil {
+					return VerifyPasswordResetRequestValidationError{
+						field:  "user_ids",
+						reason: "embedded message failed validation",
+						cause:  err,
+					}
+				}
+			}
+
+		case "code":
+
+			if l := utf8.RuneCountInString(m.GetCode()); l < 1 || l > 64 {
+				return VerifyPasswordResetRequestValidationError{
+					field:  "code",
+					reason: "value length must be between 1 and 64 runes, inclusive",
+				}
+			}
+
+		default:
+			return VerifyPasswordResetRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// VerifyPasswordResetRequestValidationError is the validation error returned
+// by VerifyPasswordResetRequest.ValidateFields if the designated constraints
+// aren't met.
+type VerifyPasswordResetRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyPasswordResetRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyPasswordResetRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyPasswordResetRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyPasswordResetRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyPasswordResetRequestValidationError) ErrorName() string {
+	return "VerifyPasswordResetRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyPasswordResetRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyPasswordResetRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyPasswordResetRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyPasswordResetRequestValidationError{}
+
 // ValidateFields checks the field values on UpdateUserPasswordRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
@@ -1175,6 +1288,311 @@ var _ interface {
 	ErrorName() string
 } = UpdateUserPasswordRequestValidationError{}
 
+// ValidateFields checks the field values on ConfirmEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *ConfirmEmailChangeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = ConfirmEmailChangeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "reference":
+
+			if err := m._validateUuid(m.GetReference()); err != nil {
+				return ConfirmEmailChangeRequestValidationError{
+					field:  "reference",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+			}
+
+		case "token":
+
+			if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 64 {
+				return ConfirmEmailChangeRequestValidationError{
+					field:  "token",
+					reason: "value length must be between 1 and 64 runes, inclusive",
+				}
+			}
+
+		default:
+			return ConfirmEmailChangeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+func (m *ConfirmEmailChangeRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// ConfirmEmailChangeRequestValidationError is the validation error returned by
+// ConfirmEmailChangeRequest.ValidateFields if the designated constraints
+// aren't met.
+type ConfirmEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ConfirmEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ConfirmEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ConfirmEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ConfirmEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ConfirmEmailChangeRequestValidationError) ErrorName() string {
+	return "ConfirmEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ConfirmEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sConfirmEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ConfirmEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ConfirmEmailChangeRequestValidationError{}
+
+// ValidateFields checks the field values on RevertEmailChangeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *RevertEmailChangeRequest) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = RevertEmailChangeRequestFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "reference":
+
+			if err := m._validateUuid(m.GetReference()); err != nil {
+				return RevertEmailChangeRequestValidationError{
+					field:  "reference",
+					reason: "value must be a valid UUID",
+					cause:  err,
+				}
+			}
+
+		case "token":
+
+			if l := utf8.RuneCountInString(m.GetToken()); l < 1 || l > 64 {
+				return RevertEmailChangeRequestValidationError{
+					field:  "token",
+					reason: "value length must be between 1 and 64 runes, inclusive",
+				}
+			}
+
+		default:
+			return RevertEmailChangeRequestValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+func (m *RevertEmailChangeRequest) _validateUuid(uuid string) error {
+	if matched := _user_uuidPattern.MatchString(uuid); !matched {
+		return errors.New("invalid uuid format")
+	}
+
+	return nil
+}
+
+// RevertEmailChangeRequestValidationError is the validation error returned by
+// RevertEmailChangeRequest.ValidateFields if the designated constraints
+// aren't met.
+type RevertEmailChangeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevertEmailChangeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevertEmailChangeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevertEmailChangeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevertEmailChangeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevertEmailChangeRequestValidationError) ErrorName() string {
+	return "RevertEmailChangeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevertEmailChangeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevertEmailChangeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevertEmailChangeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevertEmailChangeRequestValidationError{}
+
+// ValidateFields checks the field values on EmailChangeStatus with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, an error is returned.
+func (m *EmailChangeStatus) ValidateFields(paths ...string) error {
+	if m == nil {
+		return nil
+	}
+
+	if len(paths) == 0 {
+		paths = EmailChangeStatusFieldPathsNested
+	}
+
+	for name, subs := range _processPaths(append(paths[:0:0], paths...)) {
+		_ = subs
+		switch name {
+		case "old_confirmed":
+			// no validation rules for OldConfirmed
+		case "new_confirmed":
+			// no validation rules for NewConfirmed
+		case "completed":
+			// no validation rules for Completed
+		default:
+			return EmailChangeStatusValidationError{
+				field:  name,
+				reason: "invalid field path",
+			}
+		}
+	}
+	return nil
+}
+
+// EmailChangeStatusValidationError is the validation error returned by
+// EmailChangeStatus.ValidateFields if the designated constraints aren't met.
+type EmailChangeStatusValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e EmailChangeStatusValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e EmailChangeStatusValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e EmailChangeStatusValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e EmailChangeStatusValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e EmailChangeStatusValidationError) ErrorName() string {
+	return "EmailChangeStatusValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e EmailChangeStatusValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sEmailChangeStatus.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = EmailChangeStatusValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = EmailChangeStatusValidationError{}
+
 // ValidateFields checks the field values on ListUserAPIKeysRequest with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, an error is returned.
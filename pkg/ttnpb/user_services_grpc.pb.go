@@ -39,7 +39,10 @@ const (
 	UserRegistry_List_FullMethodName                    = "/ttn.lorawan.v3.UserRegistry/List"
 	UserRegistry_Update_FullMethodName                  = "/ttn.lorawan.v3.UserRegistry/Update"
 	UserRegistry_CreateTemporaryPassword_FullMethodName = "/ttn.lorawan.v3.UserRegistry/CreateTemporaryPassword"
+	UserRegistry_VerifyPasswordReset_FullMethodName     = "/ttn.lorawan.v3.UserRegistry/VerifyPasswordReset"
 	UserRegistry_UpdatePassword_FullMethodName          = "/ttn.lorawan.v3.UserRegistry/UpdatePassword"
+	UserRegistry_ConfirmEmailChange_FullMethodName      = "/ttn.lorawan.v3.UserRegistry/ConfirmEmailChange"
+	UserRegistry_RevertEmailChange_FullMethodName       = "/ttn.lorawan.v3.UserRegistry/RevertEmailChange"
 	UserRegistry_Delete_FullMethodName                  = "/ttn.lorawan.v3.UserRegistry/Delete"
 	UserRegistry_Restore_FullMethodName                 = "/ttn.lorawan.v3.UserRegistry/Restore"
 	UserRegistry_Purge_FullMethodName                   = "/ttn.lorawan.v3.UserRegistry/Purge"
@@ -63,8 +66,19 @@ type UserRegistryClient interface {
 	// Create a temporary password that can be used for updating a forgotten password.
 	// The generated password is sent to the user's email address.
 	CreateTemporaryPassword(ctx context.Context, in *CreateTemporaryPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Verify a password reset with the code of the out-of-band verifier, if password resets are verified out-of-band.
+	// Creating a temporary password then starts the verification instead of sending the temporary password.
+	// The generated password is sent to the user's email address once the password reset is verified.
+	VerifyPasswordReset(ctx context.Context, in *VerifyPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Update the password of the user.
 	UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	// Confirm a change of the primary email address of a user with the token that was sent
+	// to the old or the new email address. The email address is changed once the change
+	// is confirmed from both email addresses.
+	ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*EmailChangeStatus, error)
+	// Revert a completed change of the primary email address of a user with the token that was sent
+	// to the old email address. This also deletes the sessions of the user.
+	RevertEmailChange(ctx context.Context, in *RevertEmailChangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Delete the user. This may not release the user ID for reuse.
 	Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	// Restore a recently deleted user.
@@ -131,6 +145,15 @@ func (c *userRegistryClient) CreateTemporaryPassword(ctx context.Context, in *Cr
 	return out, nil
 }
 
+func (c *userRegistryClient) VerifyPasswordReset(ctx context.Context, in *VerifyPasswordResetRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserRegistry_VerifyPasswordReset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userRegistryClient) UpdatePassword(ctx context.Context, in *UpdateUserPasswordRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, UserRegistry_UpdatePassword_FullMethodName, in, out, opts...)
@@ -140,6 +163,24 @@ func (c *userRegistryClient) UpdatePassword(ctx context.Context, in *UpdateUserP
 	return out, nil
 }
 
+func (c *userRegistryClient) ConfirmEmailChange(ctx context.Context, in *ConfirmEmailChangeRequest, opts ...grpc.CallOption) (*EmailChangeStatus, error) {
+	out := new(EmailChangeStatus)
+	err := c.cc.Invoke(ctx, UserRegistry_ConfirmEmailChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userRegistryClient) RevertEmailChange(ctx context.Context, in *RevertEmailChangeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, UserRegistry_RevertEmailChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userRegistryClient) Delete(ctx context.Context, in *UserIdentifiers, opts ...grpc.CallOption) (*emptypb.Empty, error) {
 	out := new(emptypb.Empty)
 	err := c.cc.Invoke(ctx, UserRegistry_Delete_FullMethodName, in, out, opts...)
@@ -185,8 +226,19 @@ type UserRegistryServer interface {
 	// Create a temporary password that can be used for updating a forgotten password.
 	// The generated password is sent to the user's email address.
 	CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*emptypb.Empty, error)
+	// Verify a password reset with the code of the out-of-band verifier, if password resets are verified out-of-band.
+	// Creating a temporary password then starts the verification instead of sending the temporary password.
+	// The generated password is sent to the user's email address once the password reset is verified.
+	VerifyPasswordReset(context.Context, *VerifyPasswordResetRequest) (*emptypb.Empty, error)
 	// Update the password of the user.
 	UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*emptypb.Empty, error)
+	// Confirm a change of the primary email address of a user with the token that was sent
+	// to the old or the new email address. The email address is changed once the change
+	// is confirmed from both email addresses.
+	ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*EmailChangeStatus, error)
+	// Revert a completed change of the primary email address of a user with the token that was sent
+	// to the old email address. This also deletes the sessions of the user.
+	RevertEmailChange(context.Context, *RevertEmailChangeRequest) (*emptypb.Empty, error)
 	// Delete the user. This may not release the user ID for reuse.
 	Delete(context.Context, *UserIdentifiers) (*emptypb.Empty, error)
 	// Restore a recently deleted user.
@@ -220,9 +272,18 @@ func (UnimplementedUserRegistryServer) Update(context.Context, *UpdateUserReques
 func (UnimplementedUserRegistryServer) CreateTemporaryPassword(context.Context, *CreateTemporaryPasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateTemporaryPassword not implemented")
 }
+func (UnimplementedUserRegistryServer) VerifyPasswordReset(context.Context, *VerifyPasswordResetRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyPasswordReset not implemented")
+}
 func (UnimplementedUserRegistryServer) UpdatePassword(context.Context, *UpdateUserPasswordRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdatePassword not implemented")
 }
+func (UnimplementedUserRegistryServer) ConfirmEmailChange(context.Context, *ConfirmEmailChangeRequest) (*EmailChangeStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmEmailChange not implemented")
+}
+func (UnimplementedUserRegistryServer) RevertEmailChange(context.Context, *RevertEmailChangeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertEmailChange not implemented")
+}
 func (UnimplementedUserRegistryServer) Delete(context.Context, *UserIdentifiers) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
@@ -335,6 +396,24 @@ func _UserRegistry_CreateTemporaryPassword_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_VerifyPasswordReset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyPasswordResetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).VerifyPasswordReset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRegistry_VerifyPasswordReset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).VerifyPasswordReset(ctx, req.(*VerifyPasswordResetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserRegistry_UpdatePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserPasswordRequest)
 	if err := dec(in); err != nil {
@@ -353,6 +432,42 @@ func _UserRegistry_UpdatePassword_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserRegistry_ConfirmEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).ConfirmEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRegistry_ConfirmEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).ConfirmEmailChange(ctx, req.(*ConfirmEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserRegistry_RevertEmailChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertEmailChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserRegistryServer).RevertEmailChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserRegistry_RevertEmailChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserRegistryServer).RevertEmailChange(ctx, req.(*RevertEmailChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserRegistry_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UserIdentifiers)
 	if err := dec(in); err != nil {
@@ -434,10 +549,22 @@ var UserRegistry_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateTemporaryPassword",
 			Handler:    _UserRegistry_CreateTemporaryPassword_Handler,
 		},
+		{
+			MethodName: "VerifyPasswordReset",
+			Handler:    _UserRegistry_VerifyPasswordReset_Handler,
+		},
 		{
 			MethodName: "UpdatePassword",
 			Handler:    _UserRegistry_UpdatePassword_Handler,
 		},
+		{
+			MethodName: "ConfirmEmailChange",
+			Handler:    _UserRegistry_ConfirmEmailChange_Handler,
+		},
+		{
+			MethodName: "RevertEmailChange",
+			Handler:    _UserRegistry_RevertEmailChange_Handler,
+		},
 		{
 			MethodName: "Delete",
 			Handler:    _UserRegistry_Delete_Handler,
@@ -0,0 +1,194 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package devicebatch implements batch updates of end devices in the end device registries.
+package devicebatch
+
+import (
+	"context"
+	"strings"
+
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/log"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/validator"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	"go.thethings.network/lorawan-stack/v3/pkg/unique"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxDevices is the maximum number of end devices in a batch update.
+const MaxDevices = 100
+
+var (
+	errNoDevices       = errors.DefineInvalidArgument("no_devices", "no end devices")
+	errTooManyDevices  = errors.DefineInvalidArgument("too_many_devices", "more than {max} end devices")
+	errDuplicateDevice = errors.DefineInvalidArgument("duplicate_device", "duplicate end device `{device_id}`")
+	errNoFieldMask     = errors.DefineInvalidArgument("no_field_mask", "no field mask")
+	errFieldMaskPath   = errors.DefineInvalidArgument(
+		"field_mask_path", "field mask path `{path}` can not be updated in batch",
+	)
+	errLabelSelector = errors.DefineInvalidArgument(
+		"label_selector", "label selectors are only supported by the Identity Server",
+	)
+)
+
+// Validate validates the device IDs and the field mask of the request.
+func Validate(ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest) error {
+	if len(req.DeviceIds) == 0 {
+		return errNoDevices.New()
+	}
+	if len(req.DeviceIds) > MaxDevices {
+		return errTooManyDevices.WithAttributes("max", MaxDevices)
+	}
+	seen := make(map[string]struct{}, len(req.DeviceIds))
+	for _, devID := range req.DeviceIds {
+		if _, ok := seen[devID]; ok {
+			return errDuplicateDevice.WithAttributes("device_id", devID)
+		}
+		seen[devID] = struct{}{}
+		ids := &ttnpb.EndDeviceIdentifiers{ApplicationIds: req.ApplicationIds, DeviceId: devID}
+		if err := ids.ValidateContext(ctx); err != nil {
+			return err
+		}
+	}
+	paths := req.GetFieldMask().GetPaths()
+	if len(paths) == 0 {
+		return errNoFieldMask.New()
+	}
+	for _, path := range paths {
+		if path == "ids" || strings.HasPrefix(path, "ids.") {
+			return errFieldMaskPath.WithAttributes("path", path)
+		}
+	}
+	return nil
+}
+
+// NewResponse returns a response with a result for each device ID, with the given status.
+func NewResponse(
+	deviceIDs []string, status ttnpb.BatchUpdateEndDevicesResponse_Status,
+) *ttnpb.BatchUpdateEndDevicesResponse {
+	res := &ttnpb.BatchUpdateEndDevicesResponse{
+		Updated: status == ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED,
+		Results: make([]*ttnpb.BatchUpdateEndDevicesResponse_Result, 0, len(deviceIDs)),
+	}
+	for _, devID := range deviceIDs {
+		res.Results = append(res.Results, &ttnpb.BatchUpdateEndDevicesResponse_Result{
+			DeviceId: devID,
+			Status:   status,
+		})
+	}
+	return res
+}
+
+func setError(result *ttnpb.BatchUpdateEndDevicesResponse_Result, err error) {
+	if ttnErr, ok := errors.From(err); ok {
+		result.Error = ttnpb.ErrorDetailsToProto(ttnErr)
+	}
+}
+
+// Fail marks the update of the end device at index i of the response as failed with the given error.
+// The response is no longer updated.
+func Fail(res *ttnpb.BatchUpdateEndDevicesResponse, i int, err error) {
+	res.Updated = false
+	res.Results[i].Status = ttnpb.BatchUpdateEndDevicesResponse_STATUS_FAILED
+	setError(res.Results[i], err)
+}
+
+// Updater applies batch updates with the end device registry of a network layer component.
+// As these registries do not support transactions over multiple end devices, the Updater first retrieves
+// the current values of the fields to update, and restores these values when the update of an end device fails.
+type Updater struct {
+	// GetMethod and SetMethod are the full method names of the Get and Set RPCs of the registry,
+	// which determine the allowed field mask paths.
+	GetMethod, SetMethod string
+
+	Get func(context.Context, *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error)
+	Set func(context.Context, *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error)
+}
+
+func (u *Updater) get(
+	ctx context.Context, appIDs *ttnpb.ApplicationIdentifiers, devID string, paths []string,
+) (*ttnpb.EndDevice, error) {
+	req := &ttnpb.GetEndDeviceRequest{
+		EndDeviceIds: &ttnpb.EndDeviceIdentifiers{ApplicationIds: appIDs, DeviceId: devID},
+		FieldMask:    ttnpb.FieldMask(paths...),
+	}
+	if err := validator.ValidateMessage(ctx, u.GetMethod, req); err != nil {
+		return nil, err
+	}
+	return u.Get(ctx, req)
+}
+
+func (u *Updater) set(ctx context.Context, dev *ttnpb.EndDevice, paths []string) error {
+	req := &ttnpb.SetEndDeviceRequest{
+		EndDevice: dev,
+		FieldMask: ttnpb.FieldMask(paths...),
+	}
+	if err := validator.ValidateMessage(ctx, u.SetMethod, req); err != nil {
+		return err
+	}
+	_, err := u.Set(ctx, req)
+	return err
+}
+
+// Update applies the update to the end devices of the request.
+// The end devices are only updated when all of them exist. When the update of an end device fails,
+// the end devices that are updated before are reverted to the values that they had before the update.
+func (u *Updater) Update(
+	ctx context.Context, req *ttnpb.BatchUpdateEndDevicesRequest,
+) (*ttnpb.BatchUpdateEndDevicesResponse, error) {
+	if req.LabelSelector != "" {
+		return nil, errLabelSelector.New()
+	}
+	if err := Validate(ctx, req); err != nil {
+		return nil, err
+	}
+	paths := req.FieldMask.GetPaths()
+	res := NewResponse(req.DeviceIds, ttnpb.BatchUpdateEndDevicesResponse_STATUS_SKIPPED)
+	previous := make([]*ttnpb.EndDevice, len(req.DeviceIds))
+	for i, devID := range req.DeviceIds {
+		dev, err := u.get(ctx, req.ApplicationIds, devID, paths)
+		if err != nil {
+			Fail(res, i, err)
+			return res, nil
+		}
+		previous[i] = dev
+	}
+	for i, prev := range previous {
+		dev := proto.Clone(req.EndDevice).(*ttnpb.EndDevice)
+		dev.Ids = prev.Ids
+		if err := u.set(ctx, dev, paths); err != nil {
+			Fail(res, i, err)
+			u.revert(ctx, res, previous[:i], paths)
+			return res, nil
+		}
+		res.Results[i].Status = ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED
+	}
+	res.Updated = true
+	return res, nil
+}
+
+func (u *Updater) revert(
+	ctx context.Context, res *ttnpb.BatchUpdateEndDevicesResponse, previous []*ttnpb.EndDevice, paths []string,
+) {
+	for i, prev := range previous {
+		if err := u.set(ctx, prev, paths); err != nil {
+			log.FromContext(ctx).WithError(err).WithField("device_uid", unique.ID(ctx, prev.Ids)).
+				Warn("Failed to revert batch update of end device")
+			setError(res.Results[i], err)
+			continue
+		}
+		res.Results[i].Status = ttnpb.BatchUpdateEndDevicesResponse_STATUS_REVERTED
+	}
+}
@@ -0,0 +1,210 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package devicebatch_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smarty/assertions"
+	"go.thethings.network/lorawan-stack/v3/pkg/errors"
+	"go.thethings.network/lorawan-stack/v3/pkg/rpcmiddleware/validator"
+	"go.thethings.network/lorawan-stack/v3/pkg/ttnpb"
+	. "go.thethings.network/lorawan-stack/v3/pkg/util/devicebatch"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test"
+	"go.thethings.network/lorawan-stack/v3/pkg/util/test/assertions/should"
+)
+
+const (
+	getMethod = "/ttn.lorawan.v3.TestEndDeviceRegistry/Get"
+	setMethod = "/ttn.lorawan.v3.TestEndDeviceRegistry/Set"
+)
+
+func init() {
+	validator.RegisterAllowedFieldMaskPaths(getMethod, false, []string{"name"}, "name")
+	validator.RegisterAllowedFieldMaskPaths(setMethod, false, []string{"name"}, "name")
+}
+
+var (
+	errNotFound = errors.DefineNotFound("not_found", "not found")
+	errSet      = errors.DefineInternal("set", "set")
+)
+
+type mockRegistry struct {
+	names   map[string]string
+	failSet string
+}
+
+func (r *mockRegistry) Updater() *Updater {
+	return &Updater{
+		GetMethod: getMethod,
+		SetMethod: setMethod,
+		Get: func(_ context.Context, req *ttnpb.GetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+			name, ok := r.names[req.EndDeviceIds.DeviceId]
+			if !ok {
+				return nil, errNotFound.New()
+			}
+			return &ttnpb.EndDevice{Ids: req.EndDeviceIds, Name: name}, nil
+		},
+		Set: func(_ context.Context, req *ttnpb.SetEndDeviceRequest) (*ttnpb.EndDevice, error) {
+			if req.EndDevice.Ids.DeviceId == r.failSet && req.EndDevice.Name == "new" {
+				return nil, errSet.New()
+			}
+			r.names[req.EndDevice.Ids.DeviceId] = req.EndDevice.Name
+			return req.EndDevice, nil
+		},
+	}
+}
+
+func TestUpdater(t *testing.T) {
+	t.Parallel()
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	update := &ttnpb.BatchUpdateEndDevicesRequest{
+		ApplicationIds: appIDs,
+		DeviceIds:      []string{"dev-1", "dev-2", "dev-3"},
+		EndDevice:      &ttnpb.EndDevice{Name: "new"},
+		FieldMask:      ttnpb.FieldMask("name"),
+	}
+	statuses := func(res *ttnpb.BatchUpdateEndDevicesResponse) []ttnpb.BatchUpdateEndDevicesResponse_Status {
+		var ss []ttnpb.BatchUpdateEndDevicesResponse_Status
+		for _, r := range res.Results {
+			ss = append(ss, r.Status)
+		}
+		return ss
+	}
+
+	t.Run("Updated", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		reg := &mockRegistry{names: map[string]string{"dev-1": "a", "dev-2": "b", "dev-3": "c"}}
+		res, err := reg.Updater().Update(ctx, update)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Updated, should.BeTrue)
+		a.So(statuses(res), should.Resemble, []ttnpb.BatchUpdateEndDevicesResponse_Status{
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_UPDATED,
+		})
+		a.So(reg.names, should.Resemble, map[string]string{"dev-1": "new", "dev-2": "new", "dev-3": "new"})
+	})
+
+	t.Run("NotFound", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		reg := &mockRegistry{names: map[string]string{"dev-1": "a", "dev-3": "c"}}
+		res, err := reg.Updater().Update(ctx, update)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Updated, should.BeFalse)
+		a.So(statuses(res), should.Resemble, []ttnpb.BatchUpdateEndDevicesResponse_Status{
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_SKIPPED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_FAILED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_SKIPPED,
+		})
+		a.So(errors.IsNotFound(ttnpb.ErrorDetailsFromProto(res.Results[1].Error)), should.BeTrue)
+		a.So(reg.names, should.Resemble, map[string]string{"dev-1": "a", "dev-3": "c"})
+	})
+
+	t.Run("Reverted", func(t *testing.T) {
+		t.Parallel()
+		a, ctx := test.New(t)
+		reg := &mockRegistry{names: map[string]string{"dev-1": "a", "dev-2": "b", "dev-3": "c"}, failSet: "dev-3"}
+		res, err := reg.Updater().Update(ctx, update)
+		if !a.So(err, should.BeNil) {
+			t.FailNow()
+		}
+		a.So(res.Updated, should.BeFalse)
+		a.So(statuses(res), should.Resemble, []ttnpb.BatchUpdateEndDevicesResponse_Status{
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_REVERTED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_REVERTED,
+			ttnpb.BatchUpdateEndDevicesResponse_STATUS_FAILED,
+		})
+		a.So(errors.IsInternal(ttnpb.ErrorDetailsFromProto(res.Results[2].Error)), should.BeTrue)
+		a.So(reg.names, should.Resemble, map[string]string{"dev-1": "a", "dev-2": "b", "dev-3": "c"})
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	appIDs := &ttnpb.ApplicationIdentifiers{ApplicationId: "test-app"}
+	for _, tc := range []struct {
+		Name      string
+		Request   *ttnpb.BatchUpdateEndDevicesRequest
+		Assertion func(error) bool
+	}{
+		{
+			Name: "Valid",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				DeviceIds:      []string{"dev-1"},
+				FieldMask:      ttnpb.FieldMask("mac_settings.adr"),
+			},
+			Assertion: func(err error) bool { return err == nil },
+		},
+		{
+			Name: "NoDevices",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				FieldMask:      ttnpb.FieldMask("mac_settings.adr"),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "DuplicateDevice",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				DeviceIds:      []string{"dev-1", "dev-1"},
+				FieldMask:      ttnpb.FieldMask("mac_settings.adr"),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "InvalidDeviceID",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				DeviceIds:      []string{"Dev_1"},
+				FieldMask:      ttnpb.FieldMask("mac_settings.adr"),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "NoFieldMask",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				DeviceIds:      []string{"dev-1"},
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+		{
+			Name: "Identifiers",
+			Request: &ttnpb.BatchUpdateEndDevicesRequest{
+				ApplicationIds: appIDs,
+				DeviceIds:      []string{"dev-1"},
+				FieldMask:      ttnpb.FieldMask("ids.dev_eui"),
+			},
+			Assertion: errors.IsInvalidArgument,
+		},
+	} {
+		tc := tc
+		t.Run(tc.Name, func(t *testing.T) {
+			t.Parallel()
+			a := assertions.New(t)
+			a.So(tc.Assertion(Validate(test.Context(), tc.Request)), should.BeTrue)
+		})
+	}
+}
@@ -43,7 +43,11 @@ const (
 - id: EXAMPLE
   name: Example 866.1 MHz
   base-frequency: 868
-  file: EXAMPLE.yml`
+  file: EXAMPLE.yml
+- id: ISM_2400_3CH_DRAFT2
+  name: LoRa 2.4 GHz with 3 channels (Draft 2)
+  base-frequency: 2400
+  file: ISM_2400_3CH_DRAFT2.yml`
 
 	// EUFrequencyPlanID is a European frequency plan for testing.
 	EUFrequencyPlanID = "EU_863_870"
@@ -463,19 +467,64 @@ rx2-channel:
   radio: 0
 rx2-default-data-rate: 0
 max-eirp: 27`
+
+	// ISM2400FrequencyPlanID is a LoRa 2.4 GHz frequency plan for testing.
+	ISM2400FrequencyPlanID = "ISM_2400_3CH_DRAFT2"
+	ism2400FrequencyPlan   = `band-id: ISM_2400
+uplink-channels:
+- frequency: 2403000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+- frequency: 2425000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+- frequency: 2479000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+downlink-channels:
+- frequency: 2403000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+- frequency: 2425000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+- frequency: 2479000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+radios:
+- enable: true
+  chip-type: SX1280
+  frequency: 2425000000
+  rssi-offset: 0
+  tx:
+    min-frequency: 2400000000
+    max-frequency: 2500000000
+rx2-channel:
+  frequency: 2423000000
+  min-data-rate: 0
+  max-data-rate: 7
+  radio: 0
+rx2-default-data-rate: 0`
 )
 
 var (
 	// StaticFrequencyPlans contains the values used to mock a static
 	// frequencyStore in most tests component related
 	StaticFrequencyPlans = map[string][]byte{
-		"frequency-plans.yml":  []byte(frequencyPlansDescription),
-		"EU_863_870.yml":       []byte(euFrequencyPlan),
-		"KR_920_923.yml":       []byte(krFrequencyPlan),
-		"US_902_928_FSB_2.yml": []byte(usFrequencyPlan),
-		"AS_923_925_AU.yml":    []byte(asAUFrequencyPlan),
-		"AU_915_928_FSB_2.yml": []byte(auFrequencyPlan),
-		"EXAMPLE.yml":          []byte(exampleFrequencyPlan),
+		"frequency-plans.yml":     []byte(frequencyPlansDescription),
+		"EU_863_870.yml":          []byte(euFrequencyPlan),
+		"KR_920_923.yml":          []byte(krFrequencyPlan),
+		"US_902_928_FSB_2.yml":    []byte(usFrequencyPlan),
+		"AS_923_925_AU.yml":       []byte(asAUFrequencyPlan),
+		"AU_915_928_FSB_2.yml":    []byte(auFrequencyPlan),
+		"EXAMPLE.yml":             []byte(exampleFrequencyPlan),
+		"ISM_2400_3CH_DRAFT2.yml": []byte(ism2400FrequencyPlan),
 	}
 
 	// FrequencyPlansFetcher fetches frequency plans from memory.
@@ -236,11 +236,12 @@ func New(ctx context.Context, opts ...Option) (*Server, error) {
 		)),
 		mux.MiddlewareFunc(
 			webmiddleware.CORS(webmiddleware.CORSConfig{
-				AllowedHeaders: []string{"Authorization", "Content-Type", "X-CSRF-Token"},
+				AllowedHeaders: []string{"Authorization", "Content-Type", "Last-Event-ID", "X-CSRF-Token"},
 				AllowedMethods: []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete},
 				AllowedOrigins: []string{"*"},
 				ExposedHeaders: []string{
 					"Date",
+					"Content-Disposition",
 					"Content-Length",
 					"X-Rate-Limit-Limit",
 					"X-Rate-Limit-Available",
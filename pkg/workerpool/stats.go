@@ -0,0 +1,70 @@
+// Copyright © 2023 The Things Network Foundation, The Things Industries B.V.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package workerpool
+
+import (
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+// Stats are the statistics of a worker pool.
+type Stats struct {
+	Name        string `json:"name"`
+	Workers     int    `json:"workers"`
+	MinWorkers  int    `json:"min_workers"`
+	MaxWorkers  int    `json:"max_workers"`
+	QueueLength int    `json:"queue_length"`
+	QueueSize   int    `json:"queue_size"`
+}
+
+type statser interface {
+	stats() Stats
+}
+
+// pools contains the worker pools that are running.
+var pools sync.Map
+
+func registerPool(p statser) {
+	pools.Store(p, struct{}{})
+}
+
+func unregisterPool(p statser) {
+	pools.Delete(p)
+}
+
+func (wp *workerPool[T]) stats() Stats {
+	return Stats{
+		Name:        wp.Name,
+		Workers:     int(atomic.LoadInt32(&wp.workers)),
+		MinWorkers:  wp.MinWorkers,
+		MaxWorkers:  wp.MaxWorkers,
+		QueueLength: len(wp.mainQueue),
+		QueueSize:   cap(wp.mainQueue),
+	}
+}
+
+// AllStats returns the statistics of the running worker pools, sorted by name.
+func AllStats() []Stats {
+	var res []Stats
+	pools.Range(func(k, _ any) bool {
+		res = append(res, k.(statser).stats())
+		return true
+	})
+	sort.SliceStable(res, func(i, j int) bool {
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
@@ -273,6 +273,12 @@ func NewWorkerPool[T any](cfg Config[T]) WorkerPool[T] {
 		wp.spawnWorker(nil)
 	}
 
+	registerPool(wp)
+	go func() {
+		<-wp.Done()
+		unregisterPool(wp)
+	}()
+
 	return wp
 }
 
@@ -210,6 +210,52 @@ func testWorkerPool(t *testing.T, minWorkers int, maxWorkers int, queueSize int,
 	a.So(handlerCalls, should.Equal, expectedHandlerCalls)
 }
 
+func TestStats(t *testing.T) {
+	t.Parallel()
+	a, ctx := test.New(t)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	release := make(chan struct{})
+	defer close(release)
+	wp := workerpool.NewWorkerPool(workerpool.Config[int]{
+		Component:  &mockComponent{},
+		Context:    ctx,
+		Name:       "test_stats",
+		Handler:    func(context.Context, int) { <-release },
+		MinWorkers: 1,
+		MaxWorkers: 1,
+		QueueSize:  4,
+	})
+	for i := 0; i < 3; i++ {
+		a.So(wp.Publish(ctx, i), should.BeNil)
+	}
+
+	findStats := func() (workerpool.Stats, bool) {
+		for _, stats := range workerpool.AllStats() {
+			if stats.Name == "test_stats" {
+				return stats, true
+			}
+		}
+		return workerpool.Stats{}, false
+	}
+	stats, ok := findStats()
+	a.So(ok, should.BeTrue)
+	a.So(stats.Workers, should.Equal, 1)
+	a.So(stats.MinWorkers, should.Equal, 1)
+	a.So(stats.MaxWorkers, should.Equal, 1)
+	a.So(stats.QueueLength, should.BeBetweenOrEqual, 2, 3)
+	a.So(stats.QueueSize, should.Equal, 4)
+
+	cancel()
+	deadline := time.Now().Add(testTimeout)
+	for ok && time.Now().Before(deadline) {
+		time.Sleep(fastTimeout)
+		_, ok = findStats()
+	}
+	a.So(ok, should.BeFalse)
+}
+
 type mockComponent struct{}
 
 func (*mockComponent) StartTask(cfg *task.Config) {
@@ -97,7 +97,12 @@ export default async (payload, url) => {
     const lines = buffer.split(/\n\n/)
     buffer = lines.pop()
     for (const line of lines) {
-      notify(listeners[EVENTS.CHUNK], JSON.parse(line).result)
+      const chunk = JSON.parse(line)
+      // Skip the heartbeats of idle streams.
+      if ('heartbeat' in chunk) {
+        continue
+      }
+      notify(listeners[EVENTS.CHUNK], chunk.result)
     }
 
     return reader.read().then(onChunk)